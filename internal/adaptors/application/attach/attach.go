@@ -0,0 +1,113 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package attach
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+)
+
+// startupTimeout bounds the time to wait for a daemon started by this client to listen on its socket.
+const startupTimeout = 30 * time.Second
+
+const retryInterval = 100 * time.Millisecond
+
+type DaemonSocket interface {
+	Dial() (net.Conn, error)
+}
+
+type DaemonLauncher interface {
+	Launch() error
+}
+
+type OSLayer interface {
+	Stdin() io.Reader
+	Stdout() io.Writer
+	Stderr() io.Writer
+}
+
+// Attach relays the standard input and output of the MCP client to the daemon, and starts the daemon if it is not running.
+// As the daemon and its MATLAB session outlive the client, restarting the client only costs a reconnection.
+type Attach struct {
+	daemonSocket   DaemonSocket
+	daemonLauncher DaemonLauncher
+	osLayer        OSLayer
+}
+
+func New(
+	daemonSocket DaemonSocket,
+	daemonLauncher DaemonLauncher,
+	osLayer OSLayer,
+) *Attach {
+	return &Attach{
+		daemonSocket:   daemonSocket,
+		daemonLauncher: daemonLauncher,
+		osLayer:        osLayer,
+	}
+}
+
+// StartAndWaitForCompletion relays messages until the client closes the standard input, or the daemon closes the connection.
+// Failures are also written to stderr, as this mode has no log file.
+func (a *Attach) StartAndWaitForCompletion(ctx context.Context) error {
+	conn, err := a.connect(ctx)
+	if err != nil {
+		_, _ = fmt.Fprintf(a.osLayer.Stderr(), "Failed to attach to the MATLAB MCP Core Server daemon: %v\n", err)
+		return err
+	}
+	defer conn.Close() //nolint:errcheck // The connection is closed once the relay is over
+
+	return a.relay(conn)
+}
+
+func (a *Attach) connect(ctx context.Context) (net.Conn, error) {
+	conn, err := a.daemonSocket.Dial()
+	if err == nil {
+		return conn, nil
+	}
+
+	if err := a.daemonLauncher.Launch(); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, startupTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(retryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("the daemon did not start listening in time: %w", err)
+		case <-ticker.C:
+			conn, err = a.daemonSocket.Dial()
+			if err == nil {
+				return conn, nil
+			}
+		}
+	}
+}
+
+// relay copies the standard input to the daemon, and the responses of the daemon to the standard output.
+// Once the client closes the standard input, the daemon is told that no more requests are coming,
+// and the remaining responses are still relayed.
+func (a *Attach) relay(conn net.Conn) error {
+	go func() {
+		_, _ = io.Copy(conn, a.osLayer.Stdin())
+		if halfCloser, ok := conn.(interface{ CloseWrite() error }); ok {
+			_ = halfCloser.CloseWrite()
+		} else {
+			_ = conn.Close()
+		}
+	}()
+
+	_, err := io.Copy(a.osLayer.Stdout(), conn)
+	if errors.Is(err, net.ErrClosed) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,194 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package attach_test
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/attach"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/attach"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startEchoDaemon listens on a socket, and answers the first client with its whole input once the client closed its side.
+func startEchoDaemon(t *testing.T) net.Listener {
+	t.Helper()
+
+	listener, err := net.Listen("unix", filepath.Join(t.TempDir(), "daemon.sock"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close() //nolint:errcheck // Test connection
+
+		request, err := io.ReadAll(conn)
+		if err != nil {
+			return
+		}
+		_, _ = conn.Write(append([]byte("echo: "), request...))
+	}()
+
+	return listener
+}
+
+func dial(t *testing.T, listener net.Listener) net.Conn {
+	t.Helper()
+
+	conn, err := net.Dial("unix", listener.Addr().String())
+	require.NoError(t, err)
+	return conn
+}
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockDaemonSocket := &mocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockDaemonLauncher := &mocks.MockDaemonLauncher{}
+	defer mockDaemonLauncher.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	// Act
+	attachMode := attach.New(mockDaemonSocket, mockDaemonLauncher, mockOSLayer)
+
+	// Assert
+	assert.NotNil(t, attachMode)
+}
+
+func TestAttach_StartAndWaitForCompletion_DaemonRunning(t *testing.T) {
+	// Arrange
+	mockDaemonSocket := &mocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockDaemonLauncher := &mocks.MockDaemonLauncher{}
+	defer mockDaemonLauncher.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	listener := startEchoDaemon(t)
+	stdout := &bytes.Buffer{}
+
+	mockDaemonSocket.EXPECT().
+		Dial().
+		Return(dial(t, listener), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdin().
+		Return(strings.NewReader("{\"jsonrpc\":\"2.0\"}\n")).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(stdout).
+		Once()
+
+	attachMode := attach.New(mockDaemonSocket, mockDaemonLauncher, mockOSLayer)
+
+	// Act
+	err := attachMode.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "echo: {\"jsonrpc\":\"2.0\"}\n", stdout.String(), "The responses sent after the input was closed should still be relayed")
+}
+
+func TestAttach_StartAndWaitForCompletion_StartsDaemon(t *testing.T) {
+	// Arrange
+	mockDaemonSocket := &mocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockDaemonLauncher := &mocks.MockDaemonLauncher{}
+	defer mockDaemonLauncher.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	listener := startEchoDaemon(t)
+	stdout := &bytes.Buffer{}
+
+	mockDaemonSocket.EXPECT().
+		Dial().
+		Return(nil, assert.AnError).
+		Once()
+
+	mockDaemonLauncher.EXPECT().
+		Launch().
+		Return(nil).
+		Once()
+
+	mockDaemonSocket.EXPECT().
+		Dial().
+		Return(dial(t, listener), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdin().
+		Return(strings.NewReader("ping\n")).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(stdout).
+		Once()
+
+	attachMode := attach.New(mockDaemonSocket, mockDaemonLauncher, mockOSLayer)
+
+	// Act
+	err := attachMode.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "echo: ping\n", stdout.String())
+}
+
+func TestAttach_StartAndWaitForCompletion_LaunchError(t *testing.T) {
+	// Arrange
+	mockDaemonSocket := &mocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockDaemonLauncher := &mocks.MockDaemonLauncher{}
+	defer mockDaemonLauncher.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	stderr := &bytes.Buffer{}
+
+	mockDaemonSocket.EXPECT().
+		Dial().
+		Return(nil, assert.AnError).
+		Once()
+
+	mockDaemonLauncher.EXPECT().
+		Launch().
+		Return(assert.AnError).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stderr().
+		Return(stderr).
+		Once()
+
+	attachMode := attach.New(mockDaemonSocket, mockDaemonLauncher, mockOSLayer)
+
+	// Act
+	err := attachMode.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Contains(t, stderr.String(), "Failed to attach to the MATLAB MCP Core Server daemon")
+}
@@ -0,0 +1,218 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package cleanup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/directory"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+)
+
+// legacyGracePeriod is how long the folders which don't record the PID of their server are kept after they were last
+// written to, as they may belong to a running server of an earlier version.
+const legacyGracePeriod = 24 * time.Hour
+
+type Config interface {
+	CleanupOlderThan() time.Duration
+}
+
+type InstanceLock interface {
+	Path() string
+	Holder() (int, bool, error)
+	RemoveStale() error
+	IsProcessRunning(pid int) bool
+}
+
+type OSLayer interface {
+	TempDir() string
+	Stat(name string) (osfacade.FileInfo, error)
+	ReadFile(filePath string) ([]byte, error)
+	RemoveAll(path string) error
+	Stdout() io.Writer
+	Stderr() io.Writer
+}
+
+type FileLayer interface {
+	Glob(pattern string) ([]string, error)
+	WalkDir(root string, fn fs.WalkDirFunc) error
+}
+
+// Cleanup removes the state left behind by the servers which are no longer running: a stale lock file, and the folders
+// each server creates in the temporary folder for its logs, its MATLAB sessions and its artifacts.
+// It reports what was removed, and how much space was reclaimed.
+type Cleanup struct {
+	config       Config
+	instanceLock InstanceLock
+	osLayer      OSLayer
+	fileLayer    FileLayer
+}
+
+func New(
+	config Config,
+	instanceLock InstanceLock,
+	osLayer OSLayer,
+	fileLayer FileLayer,
+) *Cleanup {
+	return &Cleanup{
+		config:       config,
+		instanceLock: instanceLock,
+		osLayer:      osLayer,
+		fileLayer:    fileLayer,
+	}
+}
+
+func (c *Cleanup) StartAndWaitForCompletion(_ context.Context) error {
+	stdout := c.osLayer.Stdout()
+	stderr := c.osLayer.Stderr()
+
+	var failures []error
+
+	removedLock, err := c.removeStaleLock(stdout)
+	if err != nil {
+		failures = append(failures, err)
+		_, _ = fmt.Fprintf(stderr, "Failed to remove the stale lock file %s: %v\n", c.instanceLock.Path(), err)
+	}
+
+	dirs, err := c.fileLayer.Glob(filepath.Join(c.osLayer.TempDir(), directory.InstanceDirPattern+"*"))
+	if err != nil {
+		return fmt.Errorf("failed to list the server folders: %w", err)
+	}
+
+	now := time.Now()
+	olderThan := c.config.CleanupOlderThan()
+
+	removed := 0
+	var reclaimed int64
+	for _, dir := range dirs {
+		info, err := c.osLayer.Stat(dir)
+		if err != nil || !info.IsDir() {
+			continue
+		}
+
+		size, lastWrite := c.usage(dir)
+		if now.Sub(lastWrite) < olderThan || c.inUse(dir, now, lastWrite) {
+			continue
+		}
+
+		if err := c.osLayer.RemoveAll(dir); err != nil {
+			failures = append(failures, err)
+			_, _ = fmt.Fprintf(stderr, "Failed to remove %s: %v\n", dir, err)
+			continue
+		}
+
+		if _, err := fmt.Fprintf(stdout, "Removed %s (%s)\n", dir, formatSize(size)); err != nil {
+			return err
+		}
+		removed++
+		reclaimed += size
+	}
+
+	if removed > 0 {
+		folders := "server folders"
+		if removed == 1 {
+			folders = "server folder"
+		}
+		if _, err := fmt.Fprintf(stdout, "Reclaimed %s from %d %s.\n", formatSize(reclaimed), removed, folders); err != nil {
+			return err
+		}
+	}
+	if !removedLock && removed == 0 && len(failures) == 0 {
+		if _, err := fmt.Fprintln(stdout, "Nothing to clean up."); err != nil {
+			return err
+		}
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("failed to clean up: %w", errors.Join(failures...))
+	}
+	return nil
+}
+
+// removeStaleLock removes the lock file when the server holding it is no longer running.
+func (c *Cleanup) removeStaleLock(stdout io.Writer) (bool, error) {
+	pid, running, err := c.instanceLock.Holder()
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if running {
+		return false, nil
+	}
+
+	if err := c.instanceLock.RemoveStale(); err != nil {
+		return false, err
+	}
+
+	if pid == 0 {
+		_, err = fmt.Fprintf(stdout, "Removed the lock file %s, which held no valid PID\n", c.instanceLock.Path())
+	} else {
+		_, err = fmt.Fprintf(stdout, "Removed the lock file %s, left by a server (PID %d) which is no longer running\n", c.instanceLock.Path(), pid)
+	}
+	return true, err
+}
+
+// inUse is true when dir belongs to a running server. The folders created before servers recorded their PID are
+// considered in use until legacyGracePeriod after they were last written to.
+func (c *Cleanup) inUse(dir string, now time.Time, lastWrite time.Time) bool {
+	data, err := c.osLayer.ReadFile(filepath.Join(dir, directory.PIDFileName))
+	if err != nil {
+		return now.Sub(lastWrite) < legacyGracePeriod
+	}
+
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil || pid <= 0 {
+		return now.Sub(lastWrite) < legacyGracePeriod
+	}
+	return c.instanceLock.IsProcessRunning(pid)
+}
+
+// usage returns the size of the files in dir, and the last time any of them was written to.
+// The files which cannot be read are skipped, so that the folder is removed with what could be read.
+func (c *Cleanup) usage(dir string) (int64, time.Time) {
+	var size int64
+	var lastWrite time.Time
+	_ = c.fileLayer.WalkDir(dir, func(_ string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil
+		}
+		if !entry.IsDir() {
+			size += info.Size()
+		}
+		if info.ModTime().After(lastWrite) {
+			lastWrite = info.ModTime()
+		}
+		return nil
+	})
+	return size, lastWrite
+}
+
+func formatSize(bytes int64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+
+	value := float64(bytes)
+	for _, suffix := range []string{"KiB", "MiB", "GiB"} {
+		value /= unit
+		if value < unit || suffix == "GiB" {
+			return fmt.Sprintf("%.1f %s", value, suffix)
+		}
+	}
+	return fmt.Sprintf("%d B", bytes)
+}
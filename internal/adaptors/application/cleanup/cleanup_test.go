@@ -0,0 +1,243 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package cleanup_test
+
+import (
+	"bytes"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/cleanup"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/filefacade"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/cleanup"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	runningPID = 1234
+	stoppedPID = 5678
+)
+
+// createServerDir creates a server folder in tempDir, with a log file of size bytes written at lastWrite.
+// When pid is 0, the folder has no PID file, as the folders created by earlier versions.
+func createServerDir(t *testing.T, tempDir string, name string, pid int, size int, lastWrite time.Time) string {
+	t.Helper()
+
+	dir := filepath.Join(tempDir, name)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "artifacts-1"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "server.log"), bytes.Repeat([]byte("x"), size), 0o600))
+	paths := []string{filepath.Join(dir, "server.log"), filepath.Join(dir, "artifacts-1"), dir}
+	if pid != 0 {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "server.pid"), []byte(strconv.Itoa(pid)), 0o600))
+		paths = append([]string{filepath.Join(dir, "server.pid")}, paths...)
+	}
+	for _, path := range paths {
+		require.NoError(t, os.Chtimes(path, lastWrite, lastWrite))
+	}
+	return dir
+}
+
+func setUpOSLayer(mockOSLayer *mocks.MockOSLayer, mockFileLayer *mocks.MockFileLayer, tempDir string, stdout *bytes.Buffer, stderr *bytes.Buffer) {
+	fileLayer := filefacade.New()
+
+	mockOSLayer.EXPECT().Stdout().Return(stdout).Once()
+	mockOSLayer.EXPECT().Stderr().Return(stderr).Once()
+	mockOSLayer.EXPECT().TempDir().Return(tempDir).Once()
+
+	mockFileLayer.EXPECT().Glob(mock.Anything).RunAndReturn(fileLayer.Glob).Once()
+}
+
+func TestCleanup_StartAndWaitForCompletion_RemovesStaleState(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockInstanceLock := &mocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	tempDir := t.TempDir()
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	now := time.Now()
+
+	runningDir := createServerDir(t, tempDir, "matlab-mcp-core-server-1", runningPID, 100, now.Add(-48*time.Hour))
+	stoppedDir := createServerDir(t, tempDir, "matlab-mcp-core-server-2", stoppedPID, 2048, now)
+	recentLegacyDir := createServerDir(t, tempDir, "matlab-mcp-core-server-3", 0, 100, now.Add(-time.Hour))
+	oldLegacyDir := createServerDir(t, tempDir, "matlab-mcp-core-server-4", 0, 1024, now.Add(-48*time.Hour))
+	eventsFile := filepath.Join(tempDir, "matlab-mcp-core-server-events.json")
+	require.NoError(t, os.WriteFile(eventsFile, []byte("[]"), 0o600))
+
+	setUpOSLayer(mockOSLayer, mockFileLayer, tempDir, stdout, stderr)
+
+	osLayer := osfacade.New()
+	fileLayer := filefacade.New()
+
+	mockOSLayer.EXPECT().Stat(eventsFile).RunAndReturn(osLayer.Stat).Once()
+	for _, dir := range []string{runningDir, stoppedDir, recentLegacyDir, oldLegacyDir} {
+		mockOSLayer.EXPECT().Stat(dir).RunAndReturn(osLayer.Stat).Once()
+		mockOSLayer.EXPECT().ReadFile(filepath.Join(dir, "server.pid")).RunAndReturn(osLayer.ReadFile).Once()
+		mockFileLayer.EXPECT().WalkDir(dir, mock.Anything).RunAndReturn(fileLayer.WalkDir).Once()
+	}
+	mockOSLayer.EXPECT().RemoveAll(stoppedDir).RunAndReturn(osLayer.RemoveAll).Once()
+	mockOSLayer.EXPECT().RemoveAll(oldLegacyDir).RunAndReturn(osLayer.RemoveAll).Once()
+
+	mockInstanceLock.EXPECT().
+		Holder().
+		Return(stoppedPID, false, nil).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		RemoveStale().
+		Return(nil).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		Path().
+		Return(filepath.Join(tempDir, "matlab-mcp-core-server.lock")).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		IsProcessRunning(runningPID).
+		Return(true).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		IsProcessRunning(stoppedPID).
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		CleanupOlderThan().
+		Return(0).
+		Once()
+
+	cleanupMode := cleanup.New(mockConfig, mockInstanceLock, mockOSLayer, mockFileLayer)
+
+	// Act
+	err := cleanupMode.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.DirExists(t, runningDir)
+	assert.DirExists(t, recentLegacyDir)
+	assert.NoDirExists(t, stoppedDir)
+	assert.NoDirExists(t, oldLegacyDir)
+	assert.FileExists(t, eventsFile)
+
+	assert.Contains(t, stdout.String(), "left by a server (PID 5678) which is no longer running")
+	assert.Contains(t, stdout.String(), "Removed "+stoppedDir+" (2.0 KiB)")
+	assert.Contains(t, stdout.String(), "Removed "+oldLegacyDir+" (1.0 KiB)")
+	assert.Contains(t, stdout.String(), "Reclaimed 3.0 KiB from 2 server folders.")
+	assert.Empty(t, stderr.String())
+}
+
+func TestCleanup_StartAndWaitForCompletion_KeepsRecentFolders(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockInstanceLock := &mocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	tempDir := t.TempDir()
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	now := time.Now()
+
+	recentDir := createServerDir(t, tempDir, "matlab-mcp-core-server-1", stoppedPID, 100, now.Add(-time.Hour))
+
+	setUpOSLayer(mockOSLayer, mockFileLayer, tempDir, stdout, stderr)
+
+	mockOSLayer.EXPECT().Stat(recentDir).RunAndReturn(osfacade.New().Stat).Once()
+	mockFileLayer.EXPECT().WalkDir(recentDir, mock.Anything).RunAndReturn(filefacade.New().WalkDir).Once()
+
+	mockInstanceLock.EXPECT().
+		Holder().
+		Return(0, false, fs.ErrNotExist).
+		Once()
+
+	mockConfig.EXPECT().
+		CleanupOlderThan().
+		Return(24 * time.Hour).
+		Once()
+
+	cleanupMode := cleanup.New(mockConfig, mockInstanceLock, mockOSLayer, mockFileLayer)
+
+	// Act
+	err := cleanupMode.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.DirExists(t, recentDir)
+	assert.Equal(t, "Nothing to clean up.\n", stdout.String())
+}
+
+func TestCleanup_StartAndWaitForCompletion_RemoveStaleLockError(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockInstanceLock := &mocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	tempDir := t.TempDir()
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+
+	setUpOSLayer(mockOSLayer, mockFileLayer, tempDir, stdout, stderr)
+
+	mockInstanceLock.EXPECT().
+		Holder().
+		Return(stoppedPID, false, nil).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		RemoveStale().
+		Return(assert.AnError).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		Path().
+		Return(filepath.Join(tempDir, "matlab-mcp-core-server.lock")).
+		Once()
+
+	mockConfig.EXPECT().
+		CleanupOlderThan().
+		Return(0).
+		Once()
+
+	cleanupMode := cleanup.New(mockConfig, mockInstanceLock, mockOSLayer, mockFileLayer)
+
+	// Act
+	err := cleanupMode.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Contains(t, stderr.String(), "Failed to remove the stale lock file")
+	assert.Empty(t, stdout.String())
+}
@@ -0,0 +1,85 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package completion
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// bashScript is the completion script of bash. As bash splits --flag=value into three words, the script looks past
+// the = sign to find the flag whose value is completed.
+func bashScript(program string, commands []entities.CLICommand, flags []entities.CLIFlag) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# bash completion for %s\n", program)
+	fmt.Fprintf(&b, "# Load it with: source <(%s completion bash)\n\n", program)
+	fmt.Fprintf(&b, "_%s_completion() {\n", identifier(program))
+	b.WriteString(`    local cur="${COMP_WORDS[COMP_CWORD]}" prev="" assigned=0 command="" i
+    if [[ $COMP_CWORD -gt 0 ]]; then
+        prev="${COMP_WORDS[COMP_CWORD-1]}"
+    fi
+    if [[ "$cur" == "=" ]]; then
+        cur=""
+        assigned=1
+    elif [[ "$prev" == "=" && $COMP_CWORD -gt 1 ]]; then
+        prev="${COMP_WORDS[COMP_CWORD-2]}"
+        assigned=1
+    fi
+
+`)
+
+	b.WriteString("    case \"$prev\" in\n")
+	for _, flag := range flags {
+		switch flag.Completion {
+		case entities.CLICompletionValues:
+			fmt.Fprintf(&b, "        --%s)\n            COMPREPLY=($(compgen -W %q -- \"$cur\"))\n            return 0\n            ;;\n", flag.Name, strings.Join(flag.Values, " "))
+		case entities.CLICompletionMATLABRoot:
+			fmt.Fprintf(&b, "        --%s)\n            local IFS=$'\\n'\n            COMPREPLY=($(compgen -W \"$(\"${COMP_WORDS[0]/#\\~/$HOME}\" completion --complete-matlab-root 2>/dev/null)\" -- \"$cur\"))\n            return 0\n            ;;\n", flag.Name)
+		}
+	}
+	if folders := flagNames(flags, entities.CLICompletionFolder); len(folders) > 0 {
+		fmt.Fprintf(&b, "        %s)\n            compopt -o filenames 2>/dev/null\n            COMPREPLY=($(compgen -d -- \"$cur\"))\n            return 0\n            ;;\n", strings.Join(folders, "|"))
+	}
+	if files := flagNames(flags, entities.CLICompletionFile); len(files) > 0 {
+		fmt.Fprintf(&b, "        %s)\n            compopt -o filenames 2>/dev/null\n            COMPREPLY=($(compgen -f -- \"$cur\"))\n            return 0\n            ;;\n", strings.Join(files, "|"))
+	}
+	if texts := flagNames(flags, entities.CLICompletionText); len(texts) > 0 {
+		fmt.Fprintf(&b, "        %s)\n            return 0\n            ;;\n", strings.Join(texts, "|"))
+	}
+	b.WriteString("    esac\n\n")
+
+	if booleans := flagNames(flags, entities.CLICompletionNone); len(booleans) > 0 {
+		fmt.Fprintf(&b, "    if [[ $assigned -eq 1 ]]; then\n        case \"$prev\" in\n            %s)\n                COMPREPLY=($(compgen -W \"true false\" -- \"$cur\"))\n                ;;\n        esac\n        return 0\n    fi\n\n", strings.Join(booleans, "|"))
+	}
+
+	allFlags := flagNames(flags, entities.CLICompletionNone, entities.CLICompletionText, entities.CLICompletionValues, entities.CLICompletionFile, entities.CLICompletionFolder, entities.CLICompletionMATLABRoot)
+	fmt.Fprintf(&b, "    if [[ \"$cur\" == -* ]]; then\n        COMPREPLY=($(compgen -W %q -- \"$cur\"))\n        return 0\n    fi\n\n", strings.Join(allFlags, " "))
+
+	// The command is the first word which is neither a flag nor the value of a flag.
+	valueFlags := flagNames(flags, entities.CLICompletionText, entities.CLICompletionValues, entities.CLICompletionFile, entities.CLICompletionFolder, entities.CLICompletionMATLABRoot)
+	b.WriteString("    for ((i = 1; i < COMP_CWORD; i++)); do\n")
+	b.WriteString("        case \"${COMP_WORDS[i]}\" in\n            -*|=)\n                continue\n                ;;\n        esac\n")
+	fmt.Fprintf(&b, "        case \"${COMP_WORDS[i-1]}\" in\n            %s|=)\n                continue\n                ;;\n        esac\n", strings.Join(valueFlags, "|"))
+	b.WriteString("        command=\"${COMP_WORDS[i]}\"\n        break\n    done\n\n")
+
+	commandNames := make([]string, 0, len(commands))
+	for _, command := range commands {
+		commandNames = append(commandNames, command.Name)
+	}
+	b.WriteString("    case \"$command\" in\n")
+	fmt.Fprintf(&b, "        \"\")\n            COMPREPLY=($(compgen -W %q -- \"$cur\"))\n            ;;\n", strings.Join(commandNames, " "))
+	for _, command := range commands {
+		if len(command.Args) > 0 {
+			fmt.Fprintf(&b, "        %s)\n            COMPREPLY=($(compgen -W %q -- \"$cur\"))\n            ;;\n", command.Name, strings.Join(command.Args, " "))
+		}
+	}
+	b.WriteString("    esac\n")
+	b.WriteString("}\n\n")
+
+	fmt.Fprintf(&b, "complete -F _%s_completion %s\n", identifier(program), program)
+
+	return b.String()
+}
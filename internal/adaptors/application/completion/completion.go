@@ -0,0 +1,133 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package completion
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// defaultProgramName is the name the completions are registered for, when the name of the binary is unknown.
+const defaultProgramName = "matlab-mcp-core-server"
+
+type Config interface {
+	CompletionShell() entities.Shell
+	CompleteMATLABRoot() bool
+	CLICommands() []entities.CLICommand
+	CLIFlags() []entities.CLIFlag
+}
+
+type LoggerFactory interface {
+	GetGlobalLogger() entities.Logger
+}
+
+type MATLABRootGetter interface {
+	GetAll(logger entities.Logger) []string
+}
+
+type OSLayer interface {
+	Args() []string
+	Stdout() io.Writer
+}
+
+// Completion prints the completion script of a shell, for the commands and flags of the command line.
+// The scripts run the binary again to complete the MATLAB roots, so that they offer the MATLAB installations found
+// on the machine at the time of completion.
+type Completion struct {
+	config           Config
+	loggerFactory    LoggerFactory
+	matlabRootGetter MATLABRootGetter
+	osLayer          OSLayer
+}
+
+func New(
+	config Config,
+	loggerFactory LoggerFactory,
+	matlabRootGetter MATLABRootGetter,
+	osLayer OSLayer,
+) *Completion {
+	return &Completion{
+		config:           config,
+		loggerFactory:    loggerFactory,
+		matlabRootGetter: matlabRootGetter,
+		osLayer:          osLayer,
+	}
+}
+
+func (c *Completion) StartAndWaitForCompletion(_ context.Context) error {
+	stdout := c.osLayer.Stdout()
+
+	if c.config.CompleteMATLABRoot() {
+		for _, root := range c.matlabRootGetter.GetAll(c.loggerFactory.GetGlobalLogger()) {
+			if _, err := fmt.Fprintln(stdout, root); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	program := c.programName()
+	commands := c.config.CLICommands()
+	flags := c.config.CLIFlags()
+
+	var script string
+	switch shell := c.config.CompletionShell(); shell {
+	case entities.ShellBash:
+		script = bashScript(program, commands, flags)
+	case entities.ShellZsh:
+		script = zshScript(program, commands, flags)
+	case entities.ShellFish:
+		script = fishScript(program, commands, flags)
+	case entities.ShellPowerShell:
+		script = powerShellScript(program, commands, flags)
+	default:
+		return fmt.Errorf("unknown shell: %s", shell)
+	}
+
+	_, err := io.WriteString(stdout, script)
+	return err
+}
+
+// programName is the name of the binary, without the extension of executables on Windows, so that the completions
+// apply to the binary as the user named it, such as matlab-mcp-core-server-glnxa64.
+func (c *Completion) programName() string {
+	args := c.osLayer.Args()
+	if len(args) == 0 || args[0] == "" {
+		return defaultProgramName
+	}
+
+	name := filepath.Base(args[0])
+	if strings.EqualFold(filepath.Ext(name), ".exe") {
+		name = name[:len(name)-len(".exe")]
+	}
+	return name
+}
+
+// identifier turns name into an identifier usable as the name of a shell function.
+func identifier(name string) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+// flagNames returns the names of flags, prefixed with --, for the flags completed with one of completions.
+func flagNames(flags []entities.CLIFlag, completions ...entities.CLICompletion) []string {
+	var names []string
+	for _, flag := range flags {
+		for _, completion := range completions {
+			if flag.Completion == completion {
+				names = append(names, "--"+flag.Name)
+				break
+			}
+		}
+	}
+	return names
+}
@@ -0,0 +1,246 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package completion_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/completion"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/completion"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testCommands = []entities.CLICommand{
+		{Name: "status", Description: "Show the status of the server"},
+		{Name: "completion", Description: "Print the completion script of a shell", Args: []string{"bash", "zsh"}},
+	}
+	testFlags = []entities.CLIFlag{
+		{Name: "daemon", Description: "Serve in the background", Completion: entities.CLICompletionNone},
+		{Name: "log-level", Description: "The log level", Completion: entities.CLICompletionValues, Values: []string{"debug", "info"}},
+		{Name: "matlab-root", Description: "The MATLAB root", Completion: entities.CLICompletionMATLABRoot},
+		{Name: "allowed-folder", Description: "A folder MATLAB can access", Completion: entities.CLICompletionFolder, Repeatable: true},
+	}
+)
+
+func TestCompletion_StartAndWaitForCompletion_Scripts(t *testing.T) {
+	testCases := []struct {
+		name     string
+		shell    entities.Shell
+		expected []string
+	}{
+		{
+			name:  "bash",
+			shell: entities.ShellBash,
+			expected: []string{
+				"_matlab_mcp_core_server_completion() {",
+				`"status completion"`,
+				`"debug info"`,
+				"--daemon --log-level --matlab-root --allowed-folder",
+				"completion --complete-matlab-root",
+				"complete -F _matlab_mcp_core_server_completion matlab-mcp-core-server",
+			},
+		},
+		{
+			name:  "zsh",
+			shell: entities.ShellZsh,
+			expected: []string{
+				"#compdef matlab-mcp-core-server",
+				"'status:Show the status of the server'",
+				"'--daemon[Serve in the background]'",
+				"'--log-level=[The log level]:log-level:(debug info)'",
+				"'*--allowed-folder=[A folder MATLAB can access]:folder:_files -/'",
+				"compadd -- bash zsh",
+				"compdef _matlab_mcp_core_server matlab-mcp-core-server",
+			},
+		},
+		{
+			name:  "fish",
+			shell: entities.ShellFish,
+			expected: []string{
+				"complete -c matlab-mcp-core-server -n __fish_use_subcommand -a status -d 'Show the status of the server'",
+				"complete -c matlab-mcp-core-server -n '__fish_seen_subcommand_from completion' -a 'bash zsh'",
+				"complete -c matlab-mcp-core-server -l log-level -x -a 'debug info' -d 'The log level'",
+				"completion --complete-matlab-root",
+			},
+		},
+		{
+			name:  "powershell",
+			shell: entities.ShellPowerShell,
+			expected: []string{
+				"Register-ArgumentCompleter -Native -CommandName 'matlab-mcp-core-server', 'matlab-mcp-core-server.exe'",
+				"'status' = 'Show the status of the server'",
+				"'--log-level' = @('debug', 'info')",
+				"'--daemon' = @('true', 'false')",
+				"$matlabRootFlags = @('--matlab-root')",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockLoggerFactory := &mocks.MockLoggerFactory{}
+			defer mockLoggerFactory.AssertExpectations(t)
+
+			mockMATLABRootGetter := &mocks.MockMATLABRootGetter{}
+			defer mockMATLABRootGetter.AssertExpectations(t)
+
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			stdout := &bytes.Buffer{}
+
+			mockOSLayer.EXPECT().
+				Stdout().
+				Return(stdout).
+				Once()
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return([]string{"/usr/local/bin/matlab-mcp-core-server", "completion", string(testCase.shell)}).
+				Once()
+
+			mockConfig.EXPECT().
+				CompleteMATLABRoot().
+				Return(false).
+				Once()
+
+			mockConfig.EXPECT().
+				CLICommands().
+				Return(testCommands).
+				Once()
+
+			mockConfig.EXPECT().
+				CLIFlags().
+				Return(testFlags).
+				Once()
+
+			mockConfig.EXPECT().
+				CompletionShell().
+				Return(testCase.shell).
+				Once()
+
+			completionMode := completion.New(mockConfig, mockLoggerFactory, mockMATLABRootGetter, mockOSLayer)
+
+			// Act
+			err := completionMode.StartAndWaitForCompletion(t.Context())
+
+			// Assert
+			require.NoError(t, err)
+			for _, expected := range testCase.expected {
+				assert.Contains(t, stdout.String(), expected)
+			}
+		})
+	}
+}
+
+func TestCompletion_StartAndWaitForCompletion_ProgramNameWithoutExeExtension(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABRootGetter := &mocks.MockMATLABRootGetter{}
+	defer mockMATLABRootGetter.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	stdout := &bytes.Buffer{}
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(stdout).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return([]string{"matlab-mcp-core-server-win64.EXE", "completion", "bash"}).
+		Once()
+
+	mockConfig.EXPECT().
+		CompleteMATLABRoot().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		CLICommands().
+		Return(testCommands).
+		Once()
+
+	mockConfig.EXPECT().
+		CLIFlags().
+		Return(testFlags).
+		Once()
+
+	mockConfig.EXPECT().
+		CompletionShell().
+		Return(entities.ShellBash).
+		Once()
+
+	completionMode := completion.New(mockConfig, mockLoggerFactory, mockMATLABRootGetter, mockOSLayer)
+
+	// Act
+	err := completionMode.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "complete -F _matlab_mcp_core_server_win64_completion matlab-mcp-core-server-win64\n")
+}
+
+func TestCompletion_StartAndWaitForCompletion_MATLABRoots(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABRootGetter := &mocks.MockMATLABRootGetter{}
+	defer mockMATLABRootGetter.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	logger := testutils.NewInspectableLogger()
+	stdout := &bytes.Buffer{}
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(stdout).
+		Once()
+
+	mockConfig.EXPECT().
+		CompleteMATLABRoot().
+		Return(true).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(logger).
+		Once()
+
+	mockMATLABRootGetter.EXPECT().
+		GetAll(logger).
+		Return([]string{"/usr/local/MATLAB/R2025a", "/usr/local/MATLAB/R2024b"}).
+		Once()
+
+	completionMode := completion.New(mockConfig, mockLoggerFactory, mockMATLABRootGetter, mockOSLayer)
+
+	// Act
+	err := completionMode.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "/usr/local/MATLAB/R2025a\n/usr/local/MATLAB/R2024b\n", stdout.String())
+}
@@ -0,0 +1,55 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package completion
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// fishScript is the completion script of fish. File names are only offered for the flags taking a path.
+func fishScript(program string, commands []entities.CLICommand, flags []entities.CLIFlag) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# fish completion for %s\n", program)
+	fmt.Fprintf(&b, "# Load it with: %s completion fish | source, or save it as ~/.config/fish/completions/%s.fish\n\n", program, program)
+	fmt.Fprintf(&b, "complete -c %s -f\n\n", program)
+
+	for _, command := range commands {
+		fmt.Fprintf(&b, "complete -c %s -n __fish_use_subcommand -a %s -d %s\n", program, command.Name, fishQuote(command.Description))
+	}
+	for _, command := range commands {
+		if len(command.Args) > 0 {
+			fmt.Fprintf(&b, "complete -c %s -n %s -a %s\n", program, fishQuote("__fish_seen_subcommand_from "+command.Name), fishQuote(strings.Join(command.Args, " ")))
+		}
+	}
+	b.WriteString("\n")
+
+	for _, flag := range flags {
+		fmt.Fprintf(&b, "complete -c %s -l %s", program, flag.Name)
+		switch flag.Completion {
+		case entities.CLICompletionNone:
+		case entities.CLICompletionValues:
+			fmt.Fprintf(&b, " -x -a %s", fishQuote(strings.Join(flag.Values, " ")))
+		case entities.CLICompletionFile:
+			b.WriteString(" -r -F")
+		case entities.CLICompletionFolder:
+			b.WriteString(" -x -a '(__fish_complete_directories)'")
+		case entities.CLICompletionMATLABRoot:
+			// The binary is run as typed, so that the completions work when it is not on the PATH.
+			b.WriteString(" -x -a '(eval (commandline -opc)[1] completion --complete-matlab-root 2>/dev/null)'")
+		default:
+			b.WriteString(" -x")
+		}
+		fmt.Fprintf(&b, " -d %s\n", fishQuote(flag.Description))
+	}
+
+	return b.String()
+}
+
+// fishQuote quotes s as a single word.
+func fishQuote(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, "'", `\'`).Replace(s) + "'"
+}
@@ -0,0 +1,131 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package completion
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// powerShellScript is the completion script of PowerShell. Nothing is offered for the flags taking a path, so that
+// PowerShell falls back to completing file names.
+func powerShellScript(program string, commands []entities.CLICommand, flags []entities.CLIFlag) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# PowerShell completion for %s\n", program)
+	fmt.Fprintf(&b, "# Load it with: %s completion powershell | Out-String | Invoke-Expression, or add that line to $PROFILE\n\n", program)
+	fmt.Fprintf(&b, "Register-ArgumentCompleter -Native -CommandName %s, %s -ScriptBlock {\n", powerShellQuote(program), powerShellQuote(program+".exe"))
+	b.WriteString("    param($wordToComplete, $commandAst, $cursorPosition)\n\n")
+
+	b.WriteString("    $commands = [ordered]@{\n")
+	for _, command := range commands {
+		fmt.Fprintf(&b, "        %s = %s\n", powerShellQuote(command.Name), powerShellQuote(command.Description))
+	}
+	b.WriteString("    }\n")
+
+	b.WriteString("    $commandArgs = @{\n")
+	for _, command := range commands {
+		if len(command.Args) > 0 {
+			fmt.Fprintf(&b, "        %s = %s\n", powerShellQuote(command.Name), powerShellArray(command.Args))
+		}
+	}
+	b.WriteString("    }\n")
+
+	b.WriteString("    $flags = [ordered]@{\n")
+	for _, flag := range flags {
+		fmt.Fprintf(&b, "        %s = %s\n", powerShellQuote("--"+flag.Name), powerShellQuote(flag.Description))
+	}
+	b.WriteString("    }\n")
+
+	// Boolean flags only take a value after an = sign.
+	b.WriteString("    $flagValues = @{\n")
+	for _, flag := range flags {
+		switch flag.Completion {
+		case entities.CLICompletionValues:
+			fmt.Fprintf(&b, "        %s = %s\n", powerShellQuote("--"+flag.Name), powerShellArray(flag.Values))
+		case entities.CLICompletionNone:
+			fmt.Fprintf(&b, "        %s = %s\n", powerShellQuote("--"+flag.Name), powerShellArray([]string{"true", "false"}))
+		}
+	}
+	b.WriteString("    }\n")
+
+	fmt.Fprintf(&b, "    $valueFlags = %s\n", powerShellArray(flagNames(flags, entities.CLICompletionText, entities.CLICompletionValues, entities.CLICompletionFile, entities.CLICompletionFolder, entities.CLICompletionMATLABRoot)))
+	fmt.Fprintf(&b, "    $matlabRootFlags = %s\n\n", powerShellArray(flagNames(flags, entities.CLICompletionMATLABRoot)))
+
+	b.WriteString(`    $elements = @($commandAst.CommandElements | Where-Object { $_.Extent.EndOffset -lt $cursorPosition } | ForEach-Object { $_.Extent.Text })
+    $previous = if ($elements.Count -gt 1) { $elements[-1] } else { '' }
+
+    $flag = $null
+    $prefix = ''
+    $word = $wordToComplete
+    if ($wordToComplete -match '^(--[^=]+)=(.*)$') {
+        $flag = $Matches[1]
+        $prefix = "$flag="
+        $word = $Matches[2]
+    } elseif ($valueFlags -contains $previous) {
+        $flag = $previous
+    }
+
+    if ($flag) {
+        $values = @()
+        if ($flagValues.ContainsKey($flag) -and ($prefix -or $valueFlags -contains $flag)) {
+            $values = $flagValues[$flag]
+        } elseif ($matlabRootFlags -contains $flag) {
+            $values = @(& $elements[0] completion --complete-matlab-root 2>$null)
+        }
+        $values | Where-Object { $_ -like "$word*" } | ForEach-Object {
+            $text = "$prefix$_"
+            if ($text -match '\s') {
+                $text = "'" + ($text -replace "'", "''") + "'"
+            }
+            [System.Management.Automation.CompletionResult]::new($text, $_, 'ParameterValue', $_)
+        }
+        return
+    }
+
+    if ($wordToComplete -like '-*') {
+        $flags.GetEnumerator() | Where-Object { $_.Key -like "$wordToComplete*" } | ForEach-Object {
+            [System.Management.Automation.CompletionResult]::new($_.Key, $_.Key, 'ParameterName', $_.Value)
+        }
+        return
+    }
+
+    # The command is the first word which is neither a flag nor the value of a flag.
+    $command = $null
+    for ($i = 1; $i -lt $elements.Count; $i++) {
+        if ($elements[$i] -like '-*' -or $valueFlags -contains $elements[$i - 1]) {
+            continue
+        }
+        $command = $elements[$i]
+        break
+    }
+
+    if (-not $command) {
+        $commands.GetEnumerator() | Where-Object { $_.Key -like "$wordToComplete*" } | ForEach-Object {
+            [System.Management.Automation.CompletionResult]::new($_.Key, $_.Key, 'ParameterValue', $_.Value)
+        }
+    } elseif ($commandArgs.ContainsKey($command)) {
+        $commandArgs[$command] | Where-Object { $_ -like "$wordToComplete*" } | ForEach-Object {
+            [System.Management.Automation.CompletionResult]::new($_, $_, 'ParameterValue', $_)
+        }
+    }
+}
+`)
+
+	return b.String()
+}
+
+// powerShellQuote quotes s as a verbatim string.
+func powerShellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+func powerShellArray(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, powerShellQuote(value))
+	}
+	return "@(" + strings.Join(quoted, ", ") + ")"
+}
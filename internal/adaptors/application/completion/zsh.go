@@ -0,0 +1,90 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package completion
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// zshScript is the completion script of zsh, written with _arguments so that zsh shows the description of every
+// command and flag.
+func zshScript(program string, commands []entities.CLICommand, flags []entities.CLIFlag) string {
+	function := "_" + identifier(program)
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "#compdef %s\n", program)
+	fmt.Fprintf(&b, "# zsh completion for %s\n", program)
+	fmt.Fprintf(&b, "# Load it with: source <(%s completion zsh), or save it as %s in a folder of $fpath\n\n", program, function)
+	fmt.Fprintf(&b, "%s() {\n", function)
+	b.WriteString("    local bin=\"${words[1]/#\\~/$HOME}\"\n")
+	b.WriteString("    local curcontext=\"$curcontext\" state line\n")
+	b.WriteString("    local -a commands roots expl\n")
+	b.WriteString("    commands=(\n")
+	for _, command := range commands {
+		fmt.Fprintf(&b, "        %s\n", zshQuote(command.Name+":"+command.Description))
+	}
+	b.WriteString("    )\n\n")
+
+	b.WriteString("    _arguments -C \\\n")
+	for _, flag := range flags {
+		fmt.Fprintf(&b, "        %s \\\n", zshQuote(zshFlagSpec(flag)))
+	}
+	b.WriteString("        '1: :->command' \\\n")
+	b.WriteString("        '*:: :->args'\n\n")
+
+	b.WriteString("    case $state in\n")
+	b.WriteString("        command)\n            _describe -t commands 'command' commands\n            ;;\n")
+	b.WriteString("        matlab-root)\n            roots=(${(f)\"$(\"$bin\" completion --complete-matlab-root 2>/dev/null)\"})\n            _wanted matlab-roots expl 'MATLAB root' compadd -a roots\n            ;;\n")
+	b.WriteString("        args)\n            case $line[1] in\n")
+	for _, command := range commands {
+		if len(command.Args) > 0 {
+			fmt.Fprintf(&b, "                %s)\n                    compadd -- %s\n                    ;;\n", command.Name, strings.Join(command.Args, " "))
+		}
+	}
+	b.WriteString("            esac\n            ;;\n")
+	b.WriteString("    esac\n")
+	b.WriteString("}\n\n")
+
+	// When loaded from $fpath, the file is the body of the completion function, which must run at once.
+	fmt.Fprintf(&b, "if [[ \"${funcstack[1]}\" == %s ]]; then\n    %s \"$@\"\nelse\n    compdef %s %s\nfi\n", function, function, function, program)
+
+	return b.String()
+}
+
+// zshFlagSpec is the _arguments specification of flag.
+func zshFlagSpec(flag entities.CLIFlag) string {
+	repeat := ""
+	if flag.Repeatable {
+		repeat = "*"
+	}
+	description := "[" + zshEscapeDescription(flag.Description) + "]"
+
+	switch flag.Completion {
+	case entities.CLICompletionNone:
+		return repeat + "--" + flag.Name + description
+	case entities.CLICompletionValues:
+		return repeat + "--" + flag.Name + "=" + description + ":" + flag.Name + ":(" + strings.Join(flag.Values, " ") + ")"
+	case entities.CLICompletionFile:
+		return repeat + "--" + flag.Name + "=" + description + ":file:_files"
+	case entities.CLICompletionFolder:
+		return repeat + "--" + flag.Name + "=" + description + ":folder:_files -/"
+	case entities.CLICompletionMATLABRoot:
+		return repeat + "--" + flag.Name + "=" + description + ":MATLAB root:->matlab-root"
+	default:
+		return repeat + "--" + flag.Name + "=" + description + ":" + flag.Name + ": "
+	}
+}
+
+// zshEscapeDescription escapes the characters with a meaning in the descriptions of _arguments specifications.
+func zshEscapeDescription(description string) string {
+	return strings.NewReplacer(`\`, `\\`, "[", `\[`, "]", `\]`, ":", `\:`).Replace(description)
+}
+
+// zshQuote quotes s as a single word.
+func zshQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
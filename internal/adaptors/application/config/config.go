@@ -4,8 +4,12 @@ package config
 
 import (
 	"encoding/json"
+	"net/url"
+	"runtime"
 	"runtime/debug"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/spf13/pflag"
@@ -17,26 +21,167 @@ type OSLayer interface {
 }
 
 type Config struct {
-	osLayer OSLayer
+	osLayer       OSLayer
+	file          ConfigFile
+	fileSettings  map[string][]string
+	managedPolicy ManagedPolicy
 
+	// reloadLock guards the settings changed when the configuration is reloaded.
+	reloadLock sync.RWMutex
+
+	statusMode                       bool
+	statusEvents                     bool
+	doctorMode                       bool
+	doctorFix                        bool
+	logsMode                         bool
+	logsFollow                       bool
+	logsLevel                        entities.LogLevel
+	cleanupMode                      bool
+	cleanupOlderThan                 time.Duration
+	stopMode                         bool
+	serviceMode                      bool
+	serviceAction                    entities.ServiceAction
+	serviceServerArgs                []string
+	telemetryPreviewMode             bool
 	versionMode                      bool
+	replayMode                       bool
+	replayRecording                  string
+	replayServerArgs                 []string
+	replMode                         bool
+	replServerArgs                   []string
+	pipelineMode                     bool
+	pipelineScript                   string
+	pipelineServerArgs               []string
+	selfTestMode                     bool
+	selfTestServerArgs               []string
+	kernelMode                       bool
+	kernelConnectionFile             string
+	installMode                      bool
+	uninstallMode                    bool
+	installClients                   []entities.MCPClient
+	installServerArgs                []string
 	disableTelemetry                 bool
+	enableTelemetry                  bool
+	telemetryEndpoint                string
 	useSingleMATLABSession           bool
 	logLevel                         entities.LogLevel
+	logFile                          string
+	logComponentLevels               []string
+	logLevelsByComponent             map[string]entities.LogLevel
+	logMaxSizeMB                     int
+	logMaxAge                        time.Duration
+	logMaxFiles                      int
+	quiet                            bool
+	verbose                          bool
+	locale                           entities.Locale
 	preferredLocalMATLABRoot         string
+	matlabRelease                    string
+	sharedMATLABSessionFile          string
 	preferredMATLABStartingDirectory string
+	showMATLABDesktop                bool
+	slowCallThreshold                time.Duration
+	maxEvalTime                      time.Duration
+	maxOutputBytes                   int
+	maxFigures                       int
+	workerPoolSize                   int
+	streamOutputChunkSize            int
+	streamNotificationRate           float64
+	progressInterval                 time.Duration
+	maxResponseBytes                 []string
+	maxResponseBytesByTransport      map[entities.Transport]int
+	oversizeResponse                 entities.OversizeResponse
+	variableBinaryThreshold          int
+	variablePreviewThreshold         int
+	maxVariablePayload               int
+	maxArtifacts                     int
+	maxArtifactsMB                   int
+	lookupCacheTTL                   time.Duration
+	figureResolution                 int
+	workspaceDiff                    bool
+	memoryWarningMB                  int
+	memoryRestartMB                  int
+	memoryMitigation                 bool
+	memoryCheckInterval              time.Duration
+	healthCheckInterval              time.Duration
+	jobPollInterval                  time.Duration
+	rateLimit                        float64
+	rateLimitBurst                   int
+	maxConcurrentCalls               int
+	debugListenAddress               string
+	metricsListenAddress             string
+	sandbox                          bool
+	allowedFunctions                 []string
+	deniedFunctions                  []string
+	readOnly                         bool
+	dryRun                           bool
+	redactOutput                     bool
+	redactionPatterns                []string
+	requireApproval                  bool
+	configFile                       string
+	policyFile                       string
+	disabledTools                    []string
+	restrictFileAccess               bool
+	allowedFolders                   []string
+	blockNetwork                     bool
+	allowedHosts                     []string
+	recordSessionFolder              string
+	auditLogFile                     string
+	auditLogMaxMB                    int
+	recordMATLABFile                 string
+	replayMATLABFile                 string
+	matlabDriveFolder                string
+	realTimeTargets                  []string
+	productionServerURL              string
+	productionServerDeployFolder     string
+	eventWebhooks                    []string
+	eventSocket                      string
+	plugins                          []string
+	downstreamServersFile            string
+	encryptAtRest                    bool
+	strictTLS                        bool
+	matlabClientCertificateFile      string
+	matlabClientKeyFile              string
+	proxyURL                         string
+	noProxyHosts                     []string
+	daemonMode                       bool
+	attachMode                       bool
+	daemonSocket                     string
+	instanceID                       string
+	instancePerFolder                bool
+	serveTransport                   entities.Transport
+	completionMode                   bool
+	completionShell                  entities.Shell
+	completeMATLABRoot               bool
+	cliFlags                         []entities.CLIFlag
+	listenAddress                    string
+	restAPI                          bool
+	grpc                             bool
+	wsPingInterval                   time.Duration
+	authTokensFile                   string
 	watchdogMode                     bool
+	managedPolicyFile                string
+	managedToolPolicy                []byte
 }
 
+// New creates the configuration from the command line only.
 func New(
 	osLayer OSLayer,
 ) (*Config, error) {
+	return newConfig(osLayer, nil)
+}
+
+func newConfig(osLayer OSLayer, file ConfigFile) (*Config, error) {
 	flagSet := pflag.NewFlagSet(pflag.CommandLine.Name(), pflag.ContinueOnError)
 	err := setupFlags(flagSet)
 	if err != nil {
 		return nil, err
 	}
-	return createConfigWithFlagValues(osLayer, flagSet, osLayer.Args()[1:])
+
+	config, err := createConfigWithFlagValues(osLayer, file, flagSet, osLayer.Args()[1:])
+	if err != nil {
+		return nil, entities.NewExitError(entities.ExitCodeConfigError, err)
+	}
+	return config, nil
 }
 
 // Version will return the application version string.
@@ -54,41 +199,881 @@ func (c *Config) Version() string {
 	return buildInfo.Main.Path + " " + finalVersion
 }
 
+// BuildInfo returns the semantic version of the application, the git commit and date it was built from, and the Go version,
+// operating system and architecture it was built with. The commit and date are set using ldflags during build.
+// Otherwise, they are taken from the version control information recorded by the Go toolchain, if any.
+func (c *Config) BuildInfo() entities.BuildInfo {
+	info := entities.BuildInfo{
+		Version:   strings.TrimSpace(version),
+		Commit:    strings.TrimSpace(commit),
+		BuildDate: strings.TrimSpace(buildDate),
+		GoVersion: runtime.Version(),
+		OS:        runtime.GOOS,
+		Arch:      runtime.GOARCH,
+	}
+
+	if buildInfo, ok := c.osLayer.ReadBuildInfo(); ok && buildInfo != nil {
+		if version == unsetVersion {
+			info.Version = buildInfo.Main.Version
+		}
+		if buildInfo.GoVersion != "" {
+			info.GoVersion = buildInfo.GoVersion
+		}
+		for _, setting := range buildInfo.Settings {
+			switch {
+			case setting.Key == "vcs.revision" && info.Commit == "":
+				info.Commit = setting.Value
+			case setting.Key == "vcs.time" && info.BuildDate == "":
+				info.BuildDate = setting.Value
+			}
+		}
+	}
+
+	if info.Commit == "" {
+		info.Commit = unknownBuildInfo
+	}
+	if info.BuildDate == "" {
+		info.BuildDate = unknownBuildInfo
+	}
+
+	return info
+}
+
+// StatusMode is true when the server is invoked with the `status` command,
+// to report on the state of a running server instead of starting one.
+func (c *Config) StatusMode() bool {
+	return c.statusMode
+}
+
+func (c *Config) StatusEvents() bool {
+	return c.statusEvents
+}
+
+// DoctorMode is true when the server is invoked with the `doctor` command,
+// to check the setup of the machine and suggest fixes for the problems found.
+func (c *Config) DoctorMode() bool {
+	return c.doctorMode
+}
+
+// DoctorFix is true when the `doctor` command should apply the fixes that are safe to apply automatically.
+func (c *Config) DoctorFix() bool {
+	return c.doctorFix
+}
+
+// LogsMode is true when the server is invoked with the `logs` command,
+// to locate the log file of the running server and print its entries.
+func (c *Config) LogsMode() bool {
+	return c.logsMode
+}
+
+// LogsFollow is true when the `logs` command should keep printing the entries written to the log file.
+func (c *Config) LogsFollow() bool {
+	return c.logsFollow
+}
+
+// LogsLevel is the lowest level of the entries printed by the `logs` command, or empty to print all the entries.
+func (c *Config) LogsLevel() entities.LogLevel {
+	return c.logsLevel
+}
+
+// CleanupMode is true when the server is invoked with the `cleanup` command,
+// to remove the state left behind by the servers which are no longer running.
+func (c *Config) CleanupMode() bool {
+	return c.cleanupMode
+}
+
+// StopMode is true when the server is invoked with the `stop` command, to stop the running server.
+func (c *Config) StopMode() bool {
+	return c.stopMode
+}
+
+// CleanupOlderThan is how long the folders removed by the `cleanup` command must not have been written to.
+func (c *Config) CleanupOlderThan() time.Duration {
+	return c.cleanupOlderThan
+}
+
+// ServiceMode is true when the server is invoked with the `service` command,
+// to manage the service of the user running the server in the background.
+func (c *Config) ServiceMode() bool {
+	return c.serviceMode
+}
+
+// ServiceAction is the action named after the `service` command.
+func (c *Config) ServiceAction() entities.ServiceAction {
+	return c.serviceAction
+}
+
+// ServiceServerArgs are the arguments of the `service` command without the command and the action,
+// to run the server of the service with the same options.
+func (c *Config) ServiceServerArgs() []string {
+	return c.serviceServerArgs
+}
+
+// TelemetryPreviewMode is true when the server is invoked with the `telemetry-preview` command,
+// to print the usage report that is pending to be sent.
+func (c *Config) TelemetryPreviewMode() bool {
+	return c.telemetryPreviewMode
+}
+
 func (c *Config) VersionMode() bool {
 	return c.versionMode
 }
 
+// ReplayMode is true when the server is invoked with the `replay` command,
+// to re-run the tool calls of a recording against a fresh MATLAB session instead of serving a client.
+func (c *Config) ReplayMode() bool {
+	return c.replayMode
+}
+
+// ReplayRecording is the path of the recording to replay.
+func (c *Config) ReplayRecording() string {
+	return c.replayRecording
+}
+
+// ReplayServerArgs are the arguments of the `replay` command without the command and the recording,
+// to start the server re-running the recording with the same options.
+func (c *Config) ReplayServerArgs() []string {
+	return c.replayServerArgs
+}
+
+// REPLMode is true when the server is invoked with the `repl` command,
+// to call the tools of a new server interactively.
+func (c *Config) REPLMode() bool {
+	return c.replMode
+}
+
+// REPLServerArgs are the arguments of the `repl` command without the command, to start the server with.
+func (c *Config) REPLServerArgs() []string {
+	return c.replServerArgs
+}
+
+// PipelineMode is true when the server is invoked with the `run` command,
+// to run the tool calls of a script against a fresh MATLAB session, and exit with its outcome.
+func (c *Config) PipelineMode() bool {
+	return c.pipelineMode
+}
+
+// PipelineScript is the path of the script to run.
+func (c *Config) PipelineScript() string {
+	return c.pipelineScript
+}
+
+// PipelineServerArgs are the arguments of the `run` command without the command and the script, to start the server with.
+func (c *Config) PipelineServerArgs() []string {
+	return c.pipelineServerArgs
+}
+
+// SelfTestMode is true when the server is invoked with the `selftest` command,
+// to check that a new server works end to end.
+func (c *Config) SelfTestMode() bool {
+	return c.selfTestMode
+}
+
+// SelfTestServerArgs are the arguments of the `selftest` command without the command, to start the server with.
+func (c *Config) SelfTestServerArgs() []string {
+	return c.selfTestServerArgs
+}
+
+// KernelMode is true when the server is invoked with the `kernel` command,
+// to run as a Jupyter kernel evaluating notebooks in the MATLAB session of the daemon.
+func (c *Config) KernelMode() bool {
+	return c.kernelMode
+}
+
+// KernelConnectionFile is the path of the connection file Jupyter started the kernel with.
+func (c *Config) KernelConnectionFile() string {
+	return c.kernelConnectionFile
+}
+
+// InstallMode is true when the server is invoked with the `install` command,
+// to register it with MCP clients instead of serving one.
+func (c *Config) InstallMode() bool {
+	return c.installMode
+}
+
+// UninstallMode is true when the server is invoked with the `uninstall` command,
+// to remove it from the configuration of MCP clients.
+func (c *Config) UninstallMode() bool {
+	return c.uninstallMode
+}
+
+// InstallClients are the MCP clients named after the `install` or `uninstall` command.
+// When none is named, the command applies to all the clients found on the machine.
+func (c *Config) InstallClients() []entities.MCPClient {
+	return c.installClients
+}
+
+// InstallServerArgs are the arguments of the `install` command without the command and the clients,
+// to register the server with the same options.
+func (c *Config) InstallServerArgs() []string {
+	return c.installServerArgs
+}
+
 func (c *Config) DisableTelemetry() bool {
 	return c.disableTelemetry
 }
 
+// TelemetryEnabled is true only when the user explicitly opted in, and did not also disable telemetry.
+func (c *Config) TelemetryEnabled() bool {
+	return c.enableTelemetry && !c.disableTelemetry
+}
+
+func (c *Config) TelemetryEndpoint() string {
+	return c.telemetryEndpoint
+}
+
 func (c *Config) UseSingleMATLABSession() bool {
 	return c.useSingleMATLABSession
 }
 
 func (c *Config) LogLevel() entities.LogLevel {
+	c.reloadLock.RLock()
+	defer c.reloadLock.RUnlock()
 	return c.logLevel
 }
 
+// LogFile is the path of the file the log entries of the server are written to, or empty to write them to the
+// temporary folder of the server.
+func (c *Config) LogFile() string {
+	return c.logFile
+}
+
+// LogComponentLevels are the log levels of the components whose entries are not logged at the LogLevel, by the name
+// of the component.
+func (c *Config) LogComponentLevels() map[string]entities.LogLevel {
+	c.reloadLock.RLock()
+	defer c.reloadLock.RUnlock()
+	return c.logLevelsByComponent
+}
+
+// LogMaxSizeMB is the number of megabytes above which the log file is rotated. 0 if it is never rotated for its size.
+func (c *Config) LogMaxSizeMB() int {
+	return c.logMaxSizeMB
+}
+
+// LogMaxAge is the age above which the log file is rotated. 0 if it is never rotated for its age.
+func (c *Config) LogMaxAge() time.Duration {
+	return c.logMaxAge
+}
+
+// LogMaxFiles is the number of rotated log files kept. 0 if they are all kept.
+func (c *Config) LogMaxFiles() int {
+	return c.logMaxFiles
+}
+
+// Quiet is true when the log entries must only be written to the log file, and not to standard error.
+func (c *Config) Quiet() bool {
+	return c.quiet
+}
+
+// Verbose is true when the log entries of every level must be written to standard error, whatever the log level.
+func (c *Config) Verbose() bool {
+	return c.verbose
+}
+
+// Locale is the language of the messages shown to users, or empty to use the language of the system locale.
+func (c *Config) Locale() entities.Locale {
+	return c.locale
+}
+
 func (c *Config) PreferredLocalMATLABRoot() string {
 	return c.preferredLocalMATLABRoot
 }
 
+// MATLABRelease is the release of the local MATLAB installation to use, such as R2025a, or empty to use the first one.
+func (c *Config) MATLABRelease() string {
+	return c.matlabRelease
+}
+
+// SharedMATLABSessionFile is the session file of the running MATLAB to connect to instead of starting MATLAB, or empty
+// to start MATLAB.
+func (c *Config) SharedMATLABSessionFile() string {
+	return c.sharedMATLABSessionFile
+}
+
 func (c *Config) PreferredMATLABStartingDirectory() string {
 	return c.preferredMATLABStartingDirectory
 }
 
+// ShowMATLABDesktop is true when the MATLAB started by the server shows its desktop, and false when it is started with
+// -nodesktop -nosplash.
+func (c *Config) ShowMATLABDesktop() bool {
+	return c.showMATLABDesktop
+}
+
+func (c *Config) SlowCallThreshold() time.Duration {
+	c.reloadLock.RLock()
+	defer c.reloadLock.RUnlock()
+	return c.slowCallThreshold
+}
+
+// MaxEvalTime is the duration after which a MATLAB call is interrupted. 0 if there is no limit.
+func (c *Config) MaxEvalTime() time.Duration {
+	c.reloadLock.RLock()
+	defer c.reloadLock.RUnlock()
+	return c.maxEvalTime
+}
+
+// MaxOutputBytes is the size the output of a MATLAB call is truncated to. 0 if there is no limit.
+func (c *Config) MaxOutputBytes() int {
+	return c.maxOutputBytes
+}
+
+// MaxFigures is the number of figures returned from a MATLAB call. 0 if there is no limit.
+func (c *Config) MaxFigures() int {
+	return c.maxFigures
+}
+
+// WorkerPoolSize is the number of auxiliary MATLAB sessions running read-only tools. 0 if they run in the global MATLAB session.
+func (c *Config) WorkerPoolSize() int {
+	return c.workerPoolSize
+}
+
+// StreamOutputChunkSize is the size of the chunks in which long tool output is streamed to the client. 0 if output is not streamed.
+func (c *Config) StreamOutputChunkSize() int {
+	return c.streamOutputChunkSize
+}
+
+// StreamNotificationRate is the maximum sustained number of progress notifications per second for each client. 0 if there is no limit.
+func (c *Config) StreamNotificationRate() float64 {
+	return c.streamNotificationRate
+}
+
+// ProgressInterval is the interval at which progress notifications are sent while a tool call runs. 0 if they are not sent.
+func (c *Config) ProgressInterval() time.Duration {
+	return c.progressInterval
+}
+
+// MaxResponseBytes is the maximum number of bytes of each text output of a tool call result sent over transport. 0 if there is no limit.
+func (c *Config) MaxResponseBytes(transport entities.Transport) int {
+	if limit, ok := c.maxResponseBytesByTransport[transport]; ok {
+		return limit
+	}
+	return c.maxResponseBytesByTransport[""]
+}
+
+// OversizeResponse is how text outputs larger than MaxResponseBytes are shrunk.
+func (c *Config) OversizeResponse() entities.OversizeResponse {
+	return c.oversizeResponse
+}
+
+// VariableBinaryThreshold is the size in bytes above which workspace variables are read as MAT-files.
+func (c *Config) VariableBinaryThreshold() int {
+	return c.variableBinaryThreshold
+}
+
+// VariablePreviewThreshold is the size in bytes above which only a preview of workspace variables is read. 0 if variables are always read in full.
+func (c *Config) VariablePreviewThreshold() int {
+	return c.variablePreviewThreshold
+}
+
+// MaxVariablePayload is the size in bytes of the largest JSON value that can be set in the workspace. 0 if there is no
+// limit.
+func (c *Config) MaxVariablePayload() int {
+	return c.maxVariablePayload
+}
+
+// MaxArtifacts is the number of artifacts kept. 0 if there is no limit.
+func (c *Config) MaxArtifacts() int {
+	return c.maxArtifacts
+}
+
+// MaxArtifactsMB is the number of megabytes of artifacts kept. 0 if there is no limit.
+func (c *Config) MaxArtifactsMB() int {
+	return c.maxArtifactsMB
+}
+
+// LookupCacheTTL is the duration for which the results of lookups are cached. 0 if they are not cached.
+func (c *Config) LookupCacheTTL() time.Duration {
+	return c.lookupCacheTTL
+}
+
+// FigureResolution is the resolution, in dots per inch, at which figures are rendered in the background. 0 if figures are not rendered.
+func (c *Config) FigureResolution() int {
+	return c.figureResolution
+}
+
+// WorkspaceDiff is whether the changes of the workspace are returned after each evaluation.
+func (c *Config) WorkspaceDiff() bool {
+	return c.workspaceDiff
+}
+
+// MemoryWarningMB is the memory used by the MATLAB session, in megabytes, above which the MCP clients are warned. 0 if they are never warned.
+func (c *Config) MemoryWarningMB() int {
+	return c.memoryWarningMB
+}
+
+// MemoryRestartMB is the memory used by the MATLAB session, in megabytes, above which its workspace is saved and it is restarted.
+// 0 if it is never restarted.
+func (c *Config) MemoryRestartMB() int {
+	return c.memoryRestartMB
+}
+
+// MemoryMitigation is true when the caches of the MATLAB session are cleared once its memory exceeds the warning threshold.
+func (c *Config) MemoryMitigation() bool {
+	return c.memoryMitigation
+}
+
+// MemoryCheckInterval is the interval at which the memory used by the MATLAB session is checked.
+func (c *Config) MemoryCheckInterval() time.Duration {
+	return c.memoryCheckInterval
+}
+
+// HealthCheckInterval is the interval at which the MATLAB process started by the server is checked, to restart it if it crashed.
+// 0 if it is not checked.
+func (c *Config) HealthCheckInterval() time.Duration {
+	return c.healthCheckInterval
+}
+
+// JobPollInterval is the interval at which the state of a background job running on a worker is queried while a call waits for it.
+func (c *Config) JobPollInterval() time.Duration {
+	return c.jobPollInterval
+}
+
+// RateLimit is the maximum sustained number of tool calls per second for each client. 0 if there is no limit.
+func (c *Config) RateLimit() float64 {
+	return c.rateLimit
+}
+
+// RateLimitBurst is the number of tool calls a client can make at once. 0 if it is derived from RateLimit.
+func (c *Config) RateLimitBurst() int {
+	return c.rateLimitBurst
+}
+
+// MaxConcurrentCalls is the maximum number of tool calls running at the same time for each client. 0 if there is no limit.
+func (c *Config) MaxConcurrentCalls() int {
+	return c.maxConcurrentCalls
+}
+
+func (c *Config) DebugListenAddress() string {
+	return c.debugListenAddress
+}
+
+// MetricsListenAddress is the address the Prometheus metrics are served on. Empty if they are not served.
+func (c *Config) MetricsListenAddress() string {
+	return c.metricsListenAddress
+}
+
+// SandboxEnabled is true when submitted code must not be able to run shell commands or spawn processes.
+// Blocking the network enables the sandbox, as shell commands and external programs can access the network.
+func (c *Config) SandboxEnabled() bool {
+	return c.sandbox || c.blockNetwork
+}
+
+// AllowedFunctions are the functions that submitted code can call in the sandbox, although the sandbox blocks them.
+func (c *Config) AllowedFunctions() []string {
+	return c.allowedFunctions
+}
+
+// DeniedFunctions are the functions that submitted code cannot call in the sandbox, in addition to the ones it blocks.
+func (c *Config) DeniedFunctions() []string {
+	return c.deniedFunctions
+}
+
+// ReadOnly is true when only the tools that do not run MATLAB code or modify files must be exposed.
+func (c *Config) ReadOnly() bool {
+	return c.readOnly
+}
+
+// DryRun is true when the calls to the tools that run MATLAB code or stop MATLAB must be described, and not run.
+func (c *Config) DryRun() bool {
+	return c.dryRun
+}
+
+// RedactOutput is true when the built-in redaction rules must be applied to tool results and logged MATLAB output.
+func (c *Config) RedactOutput() bool {
+	return c.redactOutput
+}
+
+// RedactionPatterns are the regular expressions matching additional values to redact.
+func (c *Config) RedactionPatterns() []string {
+	return c.redactionPatterns
+}
+
+// RequireApproval is true when the user must approve the MATLAB code of every evaluation and script run.
+func (c *Config) RequireApproval() bool {
+	return c.requireApproval
+}
+
+// PolicyFile is the path to the file of rules that tool calls are evaluated against. Empty if there is none.
+func (c *Config) PolicyFile() string {
+	c.reloadLock.RLock()
+	defer c.reloadLock.RUnlock()
+	return c.policyFile
+}
+
+// DisabledTools are the names of the tools which must not be exposed, whatever the other settings.
+func (c *Config) DisabledTools() []string {
+	c.reloadLock.RLock()
+	defer c.reloadLock.RUnlock()
+	return c.disabledTools
+}
+
+// RestrictFileAccess is true when tools must only access the roots of the MCP client and the allowed folders.
+func (c *Config) RestrictFileAccess() bool {
+	return c.restrictFileAccess
+}
+
+// AllowedFolders are the folders tools can access, in addition to the roots of the MCP client, when file access is restricted.
+func (c *Config) AllowedFolders() []string {
+	return c.allowedFolders
+}
+
+// BlockNetwork is true when submitted code must not access the network, except for the allowed hosts.
+func (c *Config) BlockNetwork() bool {
+	return c.blockNetwork
+}
+
+// AllowedHosts are the hosts, with their subdomains, that submitted code can access when the network is blocked.
+func (c *Config) AllowedHosts() []string {
+	return c.allowedHosts
+}
+
+// RecordSessionFolder is the folder the tool calls are recorded to, or empty when the session is not recorded.
+func (c *Config) RecordSessionFolder() string {
+	return c.recordSessionFolder
+}
+
+// AuditLogFile is the file every tool call is appended to, or empty when tool calls are not audited.
+func (c *Config) AuditLogFile() string {
+	return c.auditLogFile
+}
+
+// AuditLogMaxMB is the number of megabytes above which the audit log is rotated. 0 if it is never rotated.
+func (c *Config) AuditLogMaxMB() int {
+	return c.auditLogMaxMB
+}
+
+// RecordMATLABFile is the fixture file the requests to the MATLAB sessions are recorded to, or empty when they are not
+// recorded.
+func (c *Config) RecordMATLABFile() string {
+	return c.recordMATLABFile
+}
+
+// ReplayMATLABFile is the fixture file whose responses are served instead of MATLAB, or empty when MATLAB runs.
+func (c *Config) ReplayMATLABFile() string {
+	return c.replayMATLABFile
+}
+
+// MATLABDriveFolder is the local MATLAB Drive folder, or empty when MATLAB Drive is not used.
+func (c *Config) MATLABDriveFolder() string {
+	return c.matlabDriveFolder
+}
+
+// RealTimeTargets are the names of the Simulink Real-Time targets that the tools may build for, deploy to, start, stop
+// and read signals from. Without targets, the real-time tools are not available.
+func (c *Config) RealTimeTargets() []string {
+	return c.realTimeTargets
+}
+
+// ProductionServerURL is the URL of the MATLAB Production Server instance that the tools report on and invoke, without
+// a trailing slash. Without it, the MATLAB Production Server tools are not available.
+func (c *Config) ProductionServerURL() string {
+	return c.productionServerURL
+}
+
+// ProductionServerDeployFolder is the auto_deploy folder of the MATLAB Production Server instance, where archives are
+// deployed. Without it, archives cannot be deployed.
+func (c *Config) ProductionServerDeployFolder() string {
+	return c.productionServerDeployFolder
+}
+
+// EventWebhooks are the URLs the server events are posted to.
+func (c *Config) EventWebhooks() []string {
+	return c.eventWebhooks
+}
+
+// EventSocket is the Unix domain socket the server events are written to, or empty when they are not.
+func (c *Config) EventSocket() string {
+	return c.eventSocket
+}
+
+// Plugins are the paths of the plugin executables providing additional tools.
+func (c *Config) Plugins() []string {
+	return c.plugins
+}
+
+// DownstreamServersFile is the JSON file of the downstream MCP servers whose tools are proxied, or empty when there
+// are none.
+func (c *Config) DownstreamServersFile() string {
+	return c.downstreamServersFile
+}
+
+// ToolSets are the groups of tools the server exposes, the same way the tools are selected when the server starts.
+// In read-only mode, the groups only expose their tools that neither run code nor modify files, and MATLAB Drive is left out.
+func (c *Config) ToolSets() []entities.ToolSet {
+	var toolSets []entities.ToolSet
+	if c.UseSingleMATLABSession() {
+		toolSets = append(toolSets, entities.ToolSetMATLAB, entities.ToolSetPython, entities.ToolSetSimulink)
+	} else {
+		toolSets = append(toolSets, entities.ToolSetMATLABSessions)
+	}
+	toolSets = append(toolSets, entities.ToolSetCodeAnalysis)
+
+	if c.MATLABDriveFolder() != "" && !c.ReadOnly() {
+		toolSets = append(toolSets, entities.ToolSetMATLABDrive)
+	}
+	if len(c.RealTimeTargets()) > 0 && c.UseSingleMATLABSession() {
+		toolSets = append(toolSets, entities.ToolSetSimulinkRealTime)
+	}
+	if c.ProductionServerURL() != "" {
+		toolSets = append(toolSets, entities.ToolSetProductionServer)
+	}
+	if len(c.Plugins()) > 0 {
+		toolSets = append(toolSets, entities.ToolSetPlugins)
+	}
+	if c.DownstreamServersFile() != "" {
+		toolSets = append(toolSets, entities.ToolSetDownstreamServers)
+	}
+
+	return toolSets
+}
+
+// EncryptAtRest is true when the session recordings and the events snapshot must be encrypted.
+func (c *Config) EncryptAtRest() bool {
+	return c.encryptAtRest
+}
+
+// StrictTLS is true when the certificate of the MATLAB session must be verified without clock skew tolerance,
+// and its fingerprint confirmed the first time it is trusted.
+func (c *Config) StrictTLS() bool {
+	return c.strictTLS
+}
+
+// MATLABClientCertificate is the PEM file of the certificate to authenticate with to MATLAB sessions, or empty when
+// MATLAB sessions do not require client certificates.
+func (c *Config) MATLABClientCertificate() string {
+	return c.matlabClientCertificateFile
+}
+
+// MATLABClientKey is the PEM file of the private key of MATLABClientCertificate.
+func (c *Config) MATLABClientKey() string {
+	return c.matlabClientKeyFile
+}
+
+// Proxy is the URL of the proxy to reach the HTTP(S) services through, or empty to use the proxy of the environment.
+// It can hold the credentials of an authenticated proxy.
+func (c *Config) Proxy() string {
+	return c.proxyURL
+}
+
+// NoProxy are the hosts, domains, IP addresses and CIDR ranges to reach without the proxy of Proxy.
+func (c *Config) NoProxy() []string {
+	return c.noProxyHosts
+}
+
+// DaemonMode is true when the server serves MCP clients on the daemon socket instead of on the standard input and output,
+// and keeps running when they disconnect.
+func (c *Config) DaemonMode() bool {
+	return c.daemonMode
+}
+
+// AttachMode is true when the standard input and output are relayed to the daemon, which is started if needed.
+func (c *Config) AttachMode() bool {
+	return c.attachMode
+}
+
+// DaemonSocket is the path of the socket of the daemon, or empty for the default path.
+func (c *Config) DaemonSocket() string {
+	return c.daemonSocket
+}
+
+// InstanceID is the name of the instance of the server, or empty for the default instance. Each instance has its own
+// lock, so that instances with different names run side by side.
+func (c *Config) InstanceID() string {
+	return c.instanceID
+}
+
+// ServeTransport is the transport the server serves MCP clients on, the standard input and output unless set by the serve command.
+func (c *Config) ServeTransport() entities.Transport {
+	return c.serveTransport
+}
+
+// ListenAddress is the loopback address the HTTP and WebSocket transports listen on.
+func (c *Config) ListenAddress() string {
+	return c.listenAddress
+}
+
+// RESTAPI is true when the HTTP transport also serves the tools as a REST API.
+func (c *Config) RESTAPI() bool {
+	return c.restAPI
+}
+
+// GRPC is true when the HTTP transport also serves the gRPC service of the server.
+func (c *Config) GRPC() bool {
+	return c.grpc
+}
+
+// WebSocketPingInterval is the interval at which the clients of the WebSocket transport are pinged. 0 if they are not.
+func (c *Config) WebSocketPingInterval() time.Duration {
+	return c.wsPingInterval
+}
+
+// AuthTokensFile is the file of the API keys the HTTP and WebSocket transports require, empty when they require none.
+func (c *Config) AuthTokensFile() string {
+	return c.authTokensFile
+}
+
+// CompletionMode is true when the server is invoked with the `completion` command, to print the completion script of a shell.
+func (c *Config) CompletionMode() bool {
+	return c.completionMode
+}
+
+// CompletionShell is the shell the `completion` command prints the completion script of.
+func (c *Config) CompletionShell() entities.Shell {
+	return c.completionShell
+}
+
+// CompleteMATLABRoot is true when the `completion` command is run by a completion script, to list the MATLAB roots
+// found on the machine instead of printing a script.
+func (c *Config) CompleteMATLABRoot() bool {
+	return c.completeMATLABRoot
+}
+
+// CLICommands are the commands of the command line, as offered by the shell completions.
+func (c *Config) CLICommands() []entities.CLICommand {
+	clients := make([]string, 0, len(entities.MCPClients))
+	for _, client := range entities.MCPClients {
+		clients = append(clients, string(client))
+	}
+
+	shells := make([]string, 0, len(entities.Shells))
+	for _, shell := range entities.Shells {
+		shells = append(shells, string(shell))
+	}
+
+	serviceActions := make([]string, 0, len(entities.ServiceActions))
+	for _, action := range entities.ServiceActions {
+		serviceActions = append(serviceActions, string(action))
+	}
+
+	cliCommands := make([]entities.CLICommand, 0, len(commands))
+	for _, command := range commands {
+		cliCommand := entities.CLICommand{
+			Name:        command.name,
+			Description: command.description,
+		}
+		switch command.name {
+		case installCommand, uninstallCommand:
+			cliCommand.Args = clients
+		case completionCommand:
+			cliCommand.Args = shells
+		case serviceCommand:
+			cliCommand.Args = serviceActions
+		case selfTestCommand:
+			cliCommand.Args = []string{serveCommand}
+		}
+		cliCommands = append(cliCommands, cliCommand)
+	}
+	return cliCommands
+}
+
+// CLIFlags are the flags of the command line, without the hidden ones, as offered by the shell completions.
+func (c *Config) CLIFlags() []entities.CLIFlag {
+	return c.cliFlags
+}
+
 func (c *Config) WatchdogMode() bool {
 	return c.watchdogMode
 }
 
 func (c *Config) RecordToLogger(logger entities.Logger) {
+	c.reloadLock.RLock()
+	defer c.reloadLock.RUnlock()
+
 	data, err := json.Marshal(map[string]any{
 		disableTelemetry:                 c.disableTelemetry,
+		enableTelemetry:                  c.enableTelemetry,
+		telemetryEndpoint:                c.telemetryEndpoint,
 		useSingleMATLABSession:           c.useSingleMATLABSession,
 		logLevel:                         c.logLevel,
+		logFile:                          c.logFile,
+		logComponentLevel:                c.logComponentLevels,
+		logMaxSizeMB:                     c.logMaxSizeMB,
+		logMaxAge:                        c.logMaxAge.String(),
+		logMaxFiles:                      c.logMaxFiles,
+		quiet:                            c.quiet,
+		verbose:                          c.verbose,
+		locale:                           c.locale,
 		preferredLocalMATLABRoot:         c.preferredLocalMATLABRoot,
+		matlabRelease:                    c.matlabRelease,
+		sharedMATLABSession:              c.sharedMATLABSessionFile,
 		preferredMATLABStartingDirectory: c.preferredMATLABStartingDirectory,
+		showMATLABDesktop:                c.showMATLABDesktop,
+		slowCallThreshold:                c.slowCallThreshold.String(),
+		maxEvalTime:                      c.maxEvalTime.String(),
+		maxOutputBytes:                   c.maxOutputBytes,
+		maxFigures:                       c.maxFigures,
+		workerPoolSize:                   c.workerPoolSize,
+		streamOutputChunkSize:            c.streamOutputChunkSize,
+		streamNotificationRate:           c.streamNotificationRate,
+		progressInterval:                 c.progressInterval.String(),
+		maxResponseBytes:                 c.maxResponseBytes,
+		oversizeResponse:                 c.oversizeResponse,
+		variableBinaryThreshold:          c.variableBinaryThreshold,
+		variablePreviewThreshold:         c.variablePreviewThreshold,
+		maxVariablePayload:               c.maxVariablePayload,
+		maxArtifacts:                     c.maxArtifacts,
+		maxArtifactsMB:                   c.maxArtifactsMB,
+		lookupCacheTTL:                   c.lookupCacheTTL.String(),
+		figureResolution:                 c.figureResolution,
+		workspaceDiff:                    c.workspaceDiff,
+		memoryWarningMB:                  c.memoryWarningMB,
+		memoryRestartMB:                  c.memoryRestartMB,
+		memoryMitigation:                 c.memoryMitigation,
+		memoryCheckInterval:              c.memoryCheckInterval.String(),
+		healthCheckInterval:              c.healthCheckInterval.String(),
+		jobPollInterval:                  c.jobPollInterval.String(),
+		rateLimit:                        c.rateLimit,
+		rateLimitBurst:                   c.rateLimitBurst,
+		maxConcurrentCalls:               c.maxConcurrentCalls,
+		debugListenAddress:               c.debugListenAddress,
+		metricsListenAddress:             c.metricsListenAddress,
+		sandbox:                          c.sandbox,
+		allowedFunction:                  c.allowedFunctions,
+		deniedFunction:                   c.deniedFunctions,
+		readOnly:                         c.readOnly,
+		dryRun:                           c.dryRun,
+		redactOutput:                     c.redactOutput,
+		redactPattern:                    c.redactionPatterns,
+		requireApproval:                  c.requireApproval,
+		configFile:                       c.configFile,
+		policyFile:                       c.policyFile,
+		disableTool:                      c.disabledTools,
+		restrictFileAccess:               c.restrictFileAccess,
+		allowedFolder:                    c.allowedFolders,
+		blockNetwork:                     c.blockNetwork,
+		allowedHost:                      c.allowedHosts,
+		recordSession:                    c.recordSessionFolder,
+		auditLog:                         c.auditLogFile,
+		auditLogMaxMB:                    c.auditLogMaxMB,
+		recordMATLAB:                     c.recordMATLABFile,
+		replayMATLAB:                     c.replayMATLABFile,
+		matlabDrive:                      c.matlabDriveFolder,
+		realTimeTarget:                   c.realTimeTargets,
+		productionServer:                 webhookOrigin(c.productionServerURL),
+		productionServerDeployFolder:     c.productionServerDeployFolder,
+		eventWebhook:                     webhookOrigins(c.eventWebhooks),
+		eventSocket:                      c.eventSocket,
+		plugin:                           c.plugins,
+		downstreamServers:                c.downstreamServersFile,
+		encryptAtRest:                    c.encryptAtRest,
+		strictTLS:                        c.strictTLS,
+		matlabClientCertificate:          c.matlabClientCertificateFile,
+		matlabClientKey:                  c.matlabClientKeyFile,
+		proxy:                            webhookOrigin(c.proxyURL),
+		noProxy:                          c.noProxyHosts,
+		daemon:                           c.daemonMode,
+		daemonSocket:                     c.daemonSocket,
+		instanceID:                       c.instanceID,
+		instancePerFolder:                c.instancePerFolder,
+		transport:                        c.serveTransport,
+		listen:                           c.listenAddress,
+		restAPI:                          c.restAPI,
+		grpc:                             c.grpc,
+		wsPingInterval:                   c.wsPingInterval.String(),
+		authTokensFile:                   c.authTokensFile,
+		"managed-policy":                 c.managedPolicyFile,
 	})
 	if err != nil {
 		logger.WithError(err).Error("Failed to serialize configuration")
@@ -96,3 +1081,24 @@ func (c *Config) RecordToLogger(logger entities.Logger) {
 	}
 	logger.With("config", string(data)).Info("Configuration state")
 }
+
+// webhookOrigins returns the scheme and host of the webhooks, without the path and query of their URL, which may hold
+// a token, so that the token is not written to the log file.
+func webhookOrigins(webhooks []string) []string {
+	origins := make([]string, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		if origin := webhookOrigin(webhook); origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+	return origins
+}
+
+// webhookOrigin returns the scheme and host of a URL, or "" if there is no URL.
+func webhookOrigin(webhook string) string {
+	webhookURL, err := url.Parse(webhook)
+	if err != nil || webhookURL.Host == "" {
+		return ""
+	}
+	return webhookURL.Scheme + "://" + webhookURL.Host
+}
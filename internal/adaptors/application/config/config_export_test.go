@@ -10,3 +10,12 @@ func SetVersionLikeLDFLAGSWould(t *testing.T, newVersion string) {
 	})
 	version = newVersion
 }
+
+func SetBuildMetadataLikeLDFLAGSWould(t *testing.T, newCommit string, newBuildDate string) {
+	t.Cleanup(func() {
+		commit = ""
+		buildDate = ""
+	})
+	commit = newCommit
+	buildDate = newBuildDate
+}
@@ -0,0 +1,87 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+)
+
+type ConfigFile interface {
+	DefaultPath() string
+	Read(path string) (map[string][]string, bool, error)
+}
+
+// NewWithConfigFile creates the configuration from the command line and the config file, if there is one.
+// A flag set on the command line takes precedence over the config file.
+func NewWithConfigFile(
+	osLayer OSLayer,
+	file ConfigFile,
+) (*Config, error) {
+	return newConfig(osLayer, file)
+}
+
+// ConfigFile is the path of the config file the flags were read from, or empty when there is none.
+func (c *Config) ConfigFile() string {
+	return c.configFile
+}
+
+// applyConfigFile sets the flags which are not set on the command line to their value in the config file, and returns
+// the path of the config file, or empty when there is none, with the settings of the file it applied.
+func applyConfigFile(flagSet *pflag.FlagSet, file ConfigFile) (string, map[string][]string, error) {
+	if file == nil {
+		return "", nil, nil
+	}
+
+	path, err := flagSet.GetString(configFile)
+	if err != nil {
+		return "", nil, err
+	}
+
+	explicit := path != ""
+	if !explicit {
+		path = file.DefaultPath()
+		if path == "" {
+			return "", nil, nil
+		}
+	}
+
+	settings, found, err := file.Read(path)
+	if err != nil {
+		return "", nil, err
+	}
+	if !found {
+		if explicit {
+			return "", nil, fmt.Errorf("config file not found: %s", path)
+		}
+		return "", nil, nil
+	}
+
+	applied := make(map[string][]string)
+	for name, values := range settings {
+		flag := flagSet.Lookup(name)
+		if flag == nil || flag.Hidden || name == configFile {
+			return "", nil, fmt.Errorf("unknown setting %s in config file %s", name, path)
+		}
+
+		if flag.Changed {
+			continue
+		}
+
+		// Bare invocations keep serving on the standard input and output, so a config file shared by all the commands
+		// only selects the transport of the serve command.
+		if (name == transport || name == listen) && flagSet.Arg(0) != serveCommand {
+			continue
+		}
+
+		for _, value := range values {
+			if err := flagSet.Set(name, value); err != nil {
+				return "", nil, fmt.Errorf("invalid value %q for %s in config file %s: %w", value, name, path, err)
+			}
+		}
+		applied[name] = values
+	}
+
+	return path, applied, nil
+}
@@ -0,0 +1,291 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/config"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	configmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const defaultConfigFilePath = "/home/user/.config/matlab-mcp/config.yaml"
+
+func TestNewWithConfigFile_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfigFile := &configmocks.MockConfigFile{}
+	defer mockConfigFile.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return([]string{"testprocess", "--log-level=warn"}).
+		Once()
+
+	mockConfigFile.EXPECT().
+		DefaultPath().
+		Return(defaultConfigFilePath).
+		Once()
+
+	mockConfigFile.EXPECT().
+		Read(defaultConfigFilePath).
+		Return(map[string][]string{
+			"matlab-root":    {"/opt/matlab/R2025a"},
+			"log-level":      {"debug"},
+			"allowed-folder": {"/home/user/project", "/home/user/data"},
+		}, true, nil).
+		Once()
+
+	// Act
+	cfg, err := config.NewWithConfigFile(mockOSLayer, mockConfigFile)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, defaultConfigFilePath, cfg.ConfigFile())
+	assert.Equal(t, "/opt/matlab/R2025a", cfg.PreferredLocalMATLABRoot())
+	assert.Equal(t, []string{"/home/user/project", "/home/user/data"}, cfg.AllowedFolders())
+	assert.Equal(t, entities.LogLevelWarn, cfg.LogLevel(), "The command line should take precedence over the config file")
+}
+
+func TestNewWithConfigFile_ExplicitPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfigFile := &configmocks.MockConfigFile{}
+	defer mockConfigFile.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return([]string{"testprocess", "--config=/home/user/project/matlab-mcp.yaml"}).
+		Once()
+
+	mockConfigFile.EXPECT().
+		Read("/home/user/project/matlab-mcp.yaml").
+		Return(map[string][]string{"max-figures": {"5"}}, true, nil).
+		Once()
+
+	// Act
+	cfg, err := config.NewWithConfigFile(mockOSLayer, mockConfigFile)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "/home/user/project/matlab-mcp.yaml", cfg.ConfigFile())
+	assert.Equal(t, 5, cfg.MaxFigures())
+}
+
+func TestNewWithConfigFile_NoDefaultFile(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfigFile := &configmocks.MockConfigFile{}
+	defer mockConfigFile.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return([]string{"testprocess"}).
+		Once()
+
+	mockConfigFile.EXPECT().
+		DefaultPath().
+		Return(defaultConfigFilePath).
+		Once()
+
+	mockConfigFile.EXPECT().
+		Read(defaultConfigFilePath).
+		Return(nil, false, nil).
+		Once()
+
+	// Act
+	cfg, err := config.NewWithConfigFile(mockOSLayer, mockConfigFile)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, cfg.ConfigFile())
+	assert.Equal(t, entities.LogLevelInfo, cfg.LogLevel())
+}
+
+func TestNewWithConfigFile_ExplicitPathNotFound(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfigFile := &configmocks.MockConfigFile{}
+	defer mockConfigFile.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return([]string{"testprocess", "--config=/home/user/missing.yaml"}).
+		Once()
+
+	mockConfigFile.EXPECT().
+		Read("/home/user/missing.yaml").
+		Return(nil, false, nil).
+		Once()
+
+	// Act
+	cfg, err := config.NewWithConfigFile(mockOSLayer, mockConfigFile)
+
+	// Assert
+	require.ErrorContains(t, err, "config file not found: /home/user/missing.yaml")
+	assert.Equal(t, entities.ExitCodeConfigError, entities.ExitCodeOf(err))
+	assert.Nil(t, cfg)
+}
+
+func TestNewWithConfigFile_ReadError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfigFile := &configmocks.MockConfigFile{}
+	defer mockConfigFile.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return([]string{"testprocess"}).
+		Once()
+
+	mockConfigFile.EXPECT().
+		DefaultPath().
+		Return(defaultConfigFilePath).
+		Once()
+
+	mockConfigFile.EXPECT().
+		Read(defaultConfigFilePath).
+		Return(nil, false, assert.AnError).
+		Once()
+
+	// Act
+	cfg, err := config.NewWithConfigFile(mockOSLayer, mockConfigFile)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, cfg)
+}
+
+func TestNewWithConfigFile_InvalidSettings(t *testing.T) {
+	testConfigs := []struct {
+		name          string
+		settings      map[string][]string
+		expectedError string
+	}{
+		{
+			name:          "unknown setting",
+			settings:      map[string][]string{"matlab-version": {"R2025a"}},
+			expectedError: "unknown setting matlab-version",
+		},
+		{
+			name:          "hidden setting",
+			settings:      map[string][]string{"watchdog": {"true"}},
+			expectedError: "unknown setting watchdog",
+		},
+		{
+			name:          "nested config file",
+			settings:      map[string][]string{"config": {"/home/user/other.yaml"}},
+			expectedError: "unknown setting config",
+		},
+		{
+			name:          "invalid value",
+			settings:      map[string][]string{"max-figures": {"many"}},
+			expectedError: `invalid value "many" for max-figures`,
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockConfigFile := &configmocks.MockConfigFile{}
+			defer mockConfigFile.AssertExpectations(t)
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return([]string{"testprocess"}).
+				Once()
+
+			mockConfigFile.EXPECT().
+				DefaultPath().
+				Return(defaultConfigFilePath).
+				Once()
+
+			mockConfigFile.EXPECT().
+				Read(defaultConfigFilePath).
+				Return(testConfig.settings, true, nil).
+				Once()
+
+			// Act
+			cfg, err := config.NewWithConfigFile(mockOSLayer, mockConfigFile)
+
+			// Assert
+			require.ErrorContains(t, err, testConfig.expectedError)
+			assert.Nil(t, cfg)
+		})
+	}
+}
+
+func TestNewWithConfigFile_TransportOnlyForServeCommand(t *testing.T) {
+	testConfigs := []struct {
+		name              string
+		args              []string
+		expectedTransport entities.Transport
+		expectedListen    string
+	}{
+		{
+			name:              "bare invocation",
+			args:              []string{},
+			expectedTransport: entities.TransportStdio,
+			expectedListen:    "",
+		},
+		{
+			name:              "serve command",
+			args:              []string{"serve"},
+			expectedTransport: entities.TransportHTTP,
+			expectedListen:    "127.0.0.1:8000",
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockConfigFile := &configmocks.MockConfigFile{}
+			defer mockConfigFile.AssertExpectations(t)
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(append([]string{"testprocess"}, testConfig.args...)).
+				Once()
+
+			mockConfigFile.EXPECT().
+				DefaultPath().
+				Return(defaultConfigFilePath).
+				Once()
+
+			mockConfigFile.EXPECT().
+				Read(defaultConfigFilePath).
+				Return(map[string][]string{
+					"transport": {"http"},
+					"listen":    {"127.0.0.1:8000"},
+				}, true, nil).
+				Once()
+
+			// Act
+			cfg, err := config.NewWithConfigFile(mockOSLayer, mockConfigFile)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, testConfig.expectedTransport, cfg.ServeTransport())
+			assert.Equal(t, testConfig.expectedListen, cfg.ListenAddress())
+		})
+	}
+}
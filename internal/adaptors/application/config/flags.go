@@ -3,114 +3,1887 @@
 package config
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"net"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/i18n"
 	"github.com/spf13/pflag"
 )
 
 const (
+	statusCommand           = "status"
+	telemetryPreviewCommand = "telemetry-preview"
+	replayCommand           = "replay"
+	replCommand             = "repl"
+	runCommand              = "run"
+	selfTestCommand         = "selftest"
+	kernelCommand           = "kernel"
+	versionCommand          = "version"
+	installCommand          = "install"
+	uninstallCommand        = "uninstall"
+	doctorCommand           = "doctor"
+	serveCommand            = "serve"
+	completionCommand       = "completion"
+	logsCommand             = "logs"
+	cleanupCommand          = "cleanup"
+	stopCommand             = "stop"
+	serviceCommand          = "service"
+
+	statusEvents             = "events"
+	statusEventsDefaultValue = false
+
+	doctorFix             = "fix"
+	doctorFixDefaultValue = false
+
+	logsFollow             = "follow"
+	logsFollowDefaultValue = false
+
+	logsLevel             = "level"
+	logsLevelDefaultValue = ""
+
+	cleanupOlderThan             = "older-than"
+	cleanupOlderThanDefaultValue = 0
+
+	runScript             = "script"
+	runScriptDefaultValue = ""
+
 	versionMode             = "version"
 	versionModeDefaultValue = false
 
-	disableTelemetry             = "disable-telemetry"
-	disableTelemetryDefaultValue = false
+	configFile             = "config"
+	configFileDefaultValue = ""
+
+	disableTelemetry             = "disable-telemetry"
+	disableTelemetryDefaultValue = false
+
+	enableTelemetry             = "enable-telemetry"
+	enableTelemetryDefaultValue = false
+
+	telemetryEndpoint             = "telemetry-endpoint"
+	telemetryEndpointDefaultValue = ""
+
+	useSingleMATLABSession             = "use-single-matlab-session"
+	useSingleMATLABSessionDefaultValue = true
+
+	preferredLocalMATLABRoot             = "matlab-root"
+	preferredLocalMATLABRootDefaultValue = ""
+
+	matlabRelease             = "matlab-release"
+	matlabReleaseDefaultValue = ""
+
+	sharedMATLABSession             = "shared-matlab-session"
+	sharedMATLABSessionDefaultValue = ""
+
+	preferredMATLABStartingDirectory             = "initial-working-folder"
+	preferredMATLABStartingDirectoryDefaultValue = ""
+
+	showMATLABDesktop             = "show-matlab-desktop"
+	showMATLABDesktopDefaultValue = true
+
+	logLevel             = "log-level"
+	logLevelDefaultValue = "info"
+
+	logFile             = "log-file"
+	logFileDefaultValue = ""
+
+	logComponentLevel = "log-component-level"
+
+	logMaxSizeMB             = "log-max-size-mb"
+	logMaxSizeMBDefaultValue = 100
+
+	logMaxAge             = "log-max-age"
+	logMaxAgeDefaultValue = time.Duration(0)
+
+	logMaxFiles             = "log-max-files"
+	logMaxFilesDefaultValue = 5
+
+	quiet             = "quiet"
+	quietDefaultValue = false
+
+	verbose             = "verbose"
+	verboseDefaultValue = false
+
+	locale             = "locale"
+	localeDefaultValue = ""
+
+	slowCallThreshold             = "slow-call-threshold"
+	slowCallThresholdDefaultValue = 30 * time.Second
+
+	maxEvalTime             = "max-eval-time"
+	maxEvalTimeDefaultValue = 0
+
+	maxOutputBytes             = "max-output-bytes"
+	maxOutputBytesDefaultValue = 0
+
+	maxFigures             = "max-figures"
+	maxFiguresDefaultValue = 0
+
+	rateLimit             = "rate-limit"
+	rateLimitDefaultValue = 0
+
+	rateLimitBurst             = "rate-limit-burst"
+	rateLimitBurstDefaultValue = 0
+
+	maxConcurrentCalls             = "max-concurrent-calls"
+	maxConcurrentCallsDefaultValue = 0
+
+	debugListenAddress             = "debug-listen"
+	debugListenAddressDefaultValue = ""
+
+	metricsListenAddress             = "metrics-listen"
+	metricsListenAddressDefaultValue = ""
+
+	sandbox             = "sandbox"
+	sandboxDefaultValue = false
+
+	allowedFunction = "allowed-function"
+
+	deniedFunction = "denied-function"
+
+	readOnly             = "read-only"
+	readOnlyDefaultValue = false
+
+	dryRun             = "dry-run"
+	dryRunDefaultValue = false
+
+	redactOutput             = "redact-output"
+	redactOutputDefaultValue = false
+
+	redactPattern = "redact-pattern"
+
+	requireApproval             = "require-approval"
+	requireApprovalDefaultValue = false
+
+	policyFile             = "policy-file"
+	policyFileDefaultValue = ""
+
+	disableTool = "disable-tool"
+
+	restrictFileAccess             = "restrict-file-access"
+	restrictFileAccessDefaultValue = false
+
+	allowedFolder = "allowed-folder"
+
+	blockNetwork             = "block-network"
+	blockNetworkDefaultValue = false
+
+	allowedHost = "allowed-host"
+
+	recordSession             = "record-session"
+	recordSessionDefaultValue = ""
+
+	auditLog             = "audit-log"
+	auditLogDefaultValue = ""
+
+	auditLogMaxMB             = "audit-log-max-mb"
+	auditLogMaxMBDefaultValue = 100
+
+	recordMATLAB             = "record-matlab"
+	recordMATLABDefaultValue = ""
+
+	replayMATLAB             = "replay-matlab"
+	replayMATLABDefaultValue = ""
+
+	matlabDrive             = "matlab-drive"
+	matlabDriveDefaultValue = ""
+
+	realTimeTarget = "realtime-target"
+
+	productionServer             = "production-server"
+	productionServerDefaultValue = ""
+
+	productionServerDeployFolder             = "production-server-deploy-folder"
+	productionServerDeployFolderDefaultValue = ""
+
+	eventWebhook = "event-webhook"
+
+	eventSocket             = "event-socket"
+	eventSocketDefaultValue = ""
+
+	plugin = "plugin"
+
+	downstreamServers             = "downstream-servers"
+	downstreamServersDefaultValue = ""
+
+	encryptAtRest             = "encrypt-at-rest"
+	encryptAtRestDefaultValue = false
+
+	strictTLS             = "strict-tls"
+	strictTLSDefaultValue = false
+
+	matlabClientCertificate             = "matlab-client-certificate"
+	matlabClientCertificateDefaultValue = ""
+
+	matlabClientKey             = "matlab-client-key"
+	matlabClientKeyDefaultValue = ""
+
+	proxy             = "proxy"
+	proxyDefaultValue = ""
+
+	noProxy = "no-proxy"
+
+	daemon             = "daemon"
+	daemonDefaultValue = false
+
+	attach             = "attach"
+	attachDefaultValue = false
+
+	daemonSocket             = "daemon-socket"
+	daemonSocketDefaultValue = ""
+
+	instanceID             = "instance-id"
+	instanceIDDefaultValue = ""
+
+	instancePerFolder             = "instance-per-folder"
+	instancePerFolderDefaultValue = false
+
+	transport             = "transport"
+	transportDefaultValue = string(entities.TransportStdio)
+
+	listen             = "listen"
+	listenDefaultValue = ""
+
+	restAPI             = "rest-api"
+	restAPIDefaultValue = false
+
+	grpc             = "grpc"
+	grpcDefaultValue = false
+
+	wsPingInterval             = "ws-ping-interval"
+	wsPingIntervalDefaultValue = 30 * time.Second
+
+	authTokensFile             = "auth-tokens-file"
+	authTokensFileDefaultValue = ""
+
+	workerPoolSize             = "worker-pool-size"
+	workerPoolSizeDefaultValue = 0
+
+	streamOutputChunkSize             = "stream-output-chunk-size"
+	streamOutputChunkSizeDefaultValue = 0
+
+	streamNotificationRate             = "stream-notification-rate"
+	streamNotificationRateDefaultValue = 0
+
+	progressInterval             = "progress-interval"
+	progressIntervalDefaultValue = 5 * time.Second
+
+	maxResponseBytes = "max-response-bytes"
+
+	oversizeResponse             = "oversize-response"
+	oversizeResponseDefaultValue = string(entities.OversizeResponseTruncate)
+
+	variableBinaryThreshold             = "variable-binary-threshold"
+	variableBinaryThresholdDefaultValue = 65536
+
+	variablePreviewThreshold             = "variable-preview-threshold"
+	variablePreviewThresholdDefaultValue = 16777216
+
+	maxVariablePayload             = "max-variable-payload"
+	maxVariablePayloadDefaultValue = 1048576
+
+	maxArtifacts             = "max-artifacts"
+	maxArtifactsDefaultValue = 100
+
+	maxArtifactsMB             = "max-artifacts-mb"
+	maxArtifactsMBDefaultValue = 1024
+
+	lookupCacheTTL             = "lookup-cache-ttl"
+	lookupCacheTTLDefaultValue = 30 * time.Minute
+
+	figureResolution             = "figure-resolution"
+	figureResolutionDefaultValue = 0
+
+	workspaceDiff             = "workspace-diff"
+	workspaceDiffDefaultValue = false
+
+	memoryWarningMB             = "memory-warning-mb"
+	memoryWarningMBDefaultValue = 0
+
+	memoryRestartMB             = "memory-restart-mb"
+	memoryRestartMBDefaultValue = 0
+
+	memoryMitigation             = "memory-mitigation"
+	memoryMitigationDefaultValue = false
+
+	memoryCheckInterval             = "memory-check-interval"
+	memoryCheckIntervalDefaultValue = 30 * time.Second
+
+	healthCheckInterval             = "health-check-interval"
+	healthCheckIntervalDefaultValue = 10 * time.Second
+
+	jobPollInterval             = "job-poll-interval"
+	jobPollIntervalDefaultValue = time.Second
+
+	watchdogMode             = "watchdog"
+	watchdogModeDefaultValue = false
+
+	completeMATLABRoot             = "complete-matlab-root"
+	completeMATLABRootDefaultValue = false
+)
+
+// selfTestFigureResolution is the resolution of the figures rendered by the server started by the selftest command,
+// unless set by its arguments.
+const selfTestFigureResolution = 72
+
+// commands are the commands of the command line, in the order they are offered by the shell completions.
+var commands = []struct {
+	name        string
+	description string
+}{
+	{serveCommand, "Serve MCP clients, on the standard input and output unless set by --" + transport},
+	{statusCommand, "Report on the state of the running server"},
+	{stopCommand, "Stop the running server, and wait for it to shut down"},
+	{doctorCommand, "Explain setup problems, and apply the fixes that are safe to apply automatically"},
+	{logsCommand, "Print the path of the log file of the running server, or its entries"},
+	{cleanupCommand, "Remove the lock file and the temporary folders left by the servers which are no longer running"},
+	{serviceCommand, "Run the server in the background as a service of the user"},
+	{installCommand, "Register the server with MCP clients"},
+	{uninstallCommand, "Remove the server from MCP clients"},
+	{replayCommand, "Re-run a session recording against a fresh MATLAB session"},
+	{replCommand, "Start a server, and call its tools interactively without an AI application"},
+	{runCommand, "Start a server, run the tool calls of a script, and report their outcome as JUnit and JSON, for CI"},
+	{selfTestCommand, "Start a server, and check that it initializes, lists its tools, evaluates code and captures figures"},
+	{kernelCommand, "Run as a Jupyter kernel evaluating notebooks in the MATLAB session of the daemon"},
+	{telemetryPreviewCommand, "Show the usage report that would be sent"},
+	{versionCommand, "Display the version of the server"},
+	{completionCommand, "Print the completion script of a shell"},
+}
+
+// flagValues are the values offered by the shell completions for the flags taking one of a set of values.
+var flagValues = map[string][]string{
+	logLevel:         {string(entities.LogLevelDebug), string(entities.LogLevelInfo), string(entities.LogLevelWarn), string(entities.LogLevelError)},
+	logsLevel:        {string(entities.LogLevelDebug), string(entities.LogLevelInfo), string(entities.LogLevelWarn), string(entities.LogLevelError)},
+	locale:           {string(entities.LocaleEnglish), string(entities.LocaleJapanese), string(entities.LocaleGerman), string(entities.LocaleChinese)},
+	oversizeResponse: {string(entities.OversizeResponseTruncate), string(entities.OversizeResponseSummarize), string(entities.OversizeResponseResource)},
+	transport:        {string(entities.TransportStdio), string(entities.TransportHTTP), string(entities.TransportWebSocket)},
+}
+
+// flagCompletions are how the shell completions complete the values of the flags taking a path.
+var flagCompletions = map[string]entities.CLICompletion{
+	preferredLocalMATLABRoot:         entities.CLICompletionMATLABRoot,
+	preferredMATLABStartingDirectory: entities.CLICompletionFolder,
+	allowedFolder:                    entities.CLICompletionFolder,
+	recordSession:                    entities.CLICompletionFolder,
+	auditLog:                         entities.CLICompletionFile,
+	recordMATLAB:                     entities.CLICompletionFile,
+	replayMATLAB:                     entities.CLICompletionFile,
+	matlabDrive:                      entities.CLICompletionFolder,
+	productionServerDeployFolder:     entities.CLICompletionFolder,
+	configFile:                       entities.CLICompletionFile,
+	logFile:                          entities.CLICompletionFile,
+	sharedMATLABSession:              entities.CLICompletionFile,
+	matlabClientCertificate:          entities.CLICompletionFile,
+	matlabClientKey:                  entities.CLICompletionFile,
+	policyFile:                       entities.CLICompletionFile,
+	runScript:                        entities.CLICompletionFile,
+	daemonSocket:                     entities.CLICompletionFile,
+	eventSocket:                      entities.CLICompletionFile,
+	plugin:                           entities.CLICompletionFile,
+	downstreamServers:                entities.CLICompletionFile,
+}
+
+func setupFlags(flagSet *pflag.FlagSet) error {
+	flagSet.Bool(versionMode, versionModeDefaultValue,
+		fmt.Sprintf("Display the version of the MATLAB MCP Core Server, with the git commit and date it was built from, and the Go version, operating system and architecture it was built with. Same as the %s command.", versionCommand),
+	)
+
+	flagSet.String(configFile, configFileDefaultValue,
+		"The path of a YAML file setting the flags of the server by name, such as matlab-root or log-level. Flags set on the command line take precedence over the file. Defaults to config.yaml in the matlab-mcp folder of the configuration folder of the user, such as ~/.config/matlab-mcp/config.yaml, when it exists.",
+	)
+
+	flagSet.Bool(disableTelemetry, disableTelemetryDefaultValue,
+		"Disable collection of usage data. By default, this software may collect information about you and your usage and send it to MathWorks. This data helps us improve our products and services.",
+	)
+
+	flagSet.Bool(enableTelemetry, enableTelemetryDefaultValue,
+		fmt.Sprintf("Opt in to reporting anonymized, aggregate usage counts (tool calls, error classes, MATLAB releases) to %s. Ignored when %s is set. Use the %s command to see what would be sent.", telemetryEndpoint, disableTelemetry, telemetryPreviewCommand),
+	)
+
+	flagSet.String(telemetryEndpoint, telemetryEndpointDefaultValue,
+		fmt.Sprintf("When %s is set, the HTTP(S) URL usage reports are posted to.", enableTelemetry),
+	)
+
+	flagSet.Bool(useSingleMATLABSession, useSingleMATLABSessionDefaultValue,
+		"When true, a MATLAB session is started when a MATLAB MCP Core Server starts, and stopped when the server is shut down. When false, the server can manage multiple MATLAB sessions.",
+	)
+
+	flagSet.String(logLevel, logLevelDefaultValue,
+		"The log level to use for the global logger (for session logs, the clients sets the log level). Valid values are: debug, info, warn, error.",
+	)
+
+	flagSet.String(logFile, logFileDefaultValue,
+		"If set, the absolute path of the file to write the log entries of the server to, instead of server.log in the temporary folder of the server. The file is overwritten when the server starts.",
+	)
+
+	flagSet.StringSlice(logComponentLevel, nil,
+		fmt.Sprintf("The log level of the entries of one component of the server, such as matlab-supervisor, as COMPONENT=LEVEL, instead of the %s. Can be repeated.", logLevel),
+	)
+
+	flagSet.Int(logMaxSizeMB, logMaxSizeMBDefaultValue,
+		"The number of megabytes above which the log file is rotated. Set to 0 to never rotate it for its size.",
+	)
+
+	flagSet.Duration(logMaxAge, logMaxAgeDefaultValue,
+		"The age above which the log file is rotated, such as 24h. Set to 0 to never rotate it for its age.",
+	)
+
+	flagSet.Int(logMaxFiles, logMaxFilesDefaultValue,
+		"The number of rotated log files kept next to the log file. The oldest ones are deleted. Set to 0 to keep them all.",
+	)
+
+	flagSet.Bool(quiet, quietDefaultValue,
+		"Write no log entries to standard error, only to the log file.",
+	)
+
+	flagSet.Bool(verbose, verboseDefaultValue,
+		fmt.Sprintf("Write the log entries of every level to standard error, whatever the %s. The log file keeps the entries at the log level or above.", logLevel),
+	)
+
+	flagSet.String(locale, localeDefaultValue,
+		fmt.Sprintf("The language of the messages shown to users, such as confirmations: %s, %s, %s or %s. By default, the language of the system locale, or %s if it is not supported.", entities.LocaleEnglish, entities.LocaleJapanese, entities.LocaleGerman, entities.LocaleChinese, entities.LocaleEnglish),
+	)
+
+	flagSet.String(preferredLocalMATLABRoot, preferredLocalMATLABRootDefaultValue,
+		fmt.Sprintf("When %s is true, if this is set, defines which local MATLAB installation to use. If not set, the first MATLAB installation on the PATH will be used.", useSingleMATLABSession),
+	)
+
+	flagSet.String(matlabRelease, matlabReleaseDefaultValue,
+		fmt.Sprintf("When %s is true, if this is set, such as R2025a, uses the first local MATLAB installation of this release, on the PATH or in the standard installation folders. Cannot be used with %s.", useSingleMATLABSession, preferredLocalMATLABRoot),
+	)
+
+	flagSet.String(sharedMATLABSession, sharedMATLABSessionDefaultValue,
+		fmt.Sprintf("When %s is true, if this is set, the absolute path of the session file written by a running MATLAB, such as a MATLAB desktop, to connect to instead of starting MATLAB. The server works in the workspace of this MATLAB, and leaves it running when it stops. Cannot be used with %s or %s.", useSingleMATLABSession, preferredLocalMATLABRoot, matlabRelease),
+	)
+
+	flagSet.String(preferredMATLABStartingDirectory, preferredMATLABStartingDirectoryDefaultValue,
+		fmt.Sprintf("When %s is true, if this is set, defines which starting directory MATLAB will use. If not set, MATLAB will use the default MATLAB's starting directory.", useSingleMATLABSession))
+
+	flagSet.Bool(showMATLABDesktop, showMATLABDesktopDefaultValue,
+		fmt.Sprintf("When %s is true, show the desktop of the MATLAB started by the server. If false, MATLAB is started without its desktop and splash screen, as with -nodesktop -nosplash.", useSingleMATLABSession),
+	)
+
+	flagSet.Duration(slowCallThreshold, slowCallThresholdDefaultValue,
+		"MATLAB calls taking longer than this duration are logged as warnings, with a breakdown of the time spent queued and executing. Set to 0 to disable.",
+	)
+
+	flagSet.Duration(maxEvalTime, maxEvalTimeDefaultValue,
+		"MATLAB calls running longer than this duration are interrupted, and fail with the partial output produced so far. Set to 0 to disable.",
+	)
+
+	flagSet.Int(maxOutputBytes, maxOutputBytesDefaultValue,
+		"The output of a MATLAB call is truncated to this number of bytes, and the call fails with the partial output. Set to 0 to disable.",
+	)
+
+	flagSet.Int(maxFigures, maxFiguresDefaultValue,
+		"Only this number of figures is returned from a MATLAB call, and the call fails with the partial output when it produces more. Set to 0 to disable.",
+	)
+
+	flagSet.Int(workerPoolSize, workerPoolSizeDefaultValue,
+		fmt.Sprintf("When %s is true, the number of auxiliary MATLAB sessions running read-only tools, concurrently with the calls in the main MATLAB session. Set to 0 to run every tool in the main MATLAB session.", useSingleMATLABSession),
+	)
+
+	flagSet.Int(streamOutputChunkSize, streamOutputChunkSizeDefaultValue,
+		"Tool output longer than this number of bytes is sent to the client as progress notifications of at most this size, and the result only holds its last chunk. Only applies to calls with a progress token. Set to 0 to disable.",
+	)
+
+	flagSet.Float64(streamNotificationRate, streamNotificationRateDefaultValue,
+		fmt.Sprintf("When %s is set, the maximum sustained number of progress notifications per second for each client. The output above the limit is dropped, and the result says how much was dropped. Set to 0 to disable.", streamOutputChunkSize),
+	)
+
+	flagSet.Duration(progressInterval, progressIntervalDefaultValue,
+		"While a tool call runs, the interval at which a progress notification with the time elapsed is sent to the client. Only applies to calls with a progress token. Set to 0 to disable.",
+	)
+
+	flagSet.StringSlice(maxResponseBytes, nil,
+		fmt.Sprintf("The maximum number of bytes of each text output of a tool call result. Larger outputs are shrunk as set by %s. Either a number for every transport, or TRANSPORT=BYTES for one transport, %s, %s, %s or %s. Can be repeated.", oversizeResponse, entities.TransportStdio, entities.TransportDaemon, entities.TransportHTTP, entities.TransportWebSocket),
+	)
+
+	flagSet.String(oversizeResponse, oversizeResponseDefaultValue,
+		fmt.Sprintf("How text outputs larger than %s are shrunk. Valid values are: %s, to keep their beginning and end; %s, to also keep the omitted lines mentioning errors and warnings; %s, to also save them in full as a resource.", maxResponseBytes, entities.OversizeResponseTruncate, entities.OversizeResponseSummarize, entities.OversizeResponseResource),
+	)
+
+	flagSet.Int(variableBinaryThreshold, variableBinaryThresholdDefaultValue,
+		"Workspace variables larger than this number of bytes are read as MAT-files, instead of JSON text.",
+	)
+
+	flagSet.Int(variablePreviewThreshold, variablePreviewThresholdDefaultValue,
+		"Workspace variables larger than this number of bytes are read as a preview, with statistics and a sample of the elements, instead of in full. Set to 0 to disable.",
+	)
+
+	flagSet.Int(maxVariablePayload, maxVariablePayloadDefaultValue,
+		"The maximum number of bytes of the JSON text of a value set in the workspace with set_matlab_variable. Set to 0 to disable.",
+	)
+
+	flagSet.Int(maxArtifacts, maxArtifactsDefaultValue,
+		"Only this number of artifacts, such as the MAT-files of large workspace variables, is kept. The files of the oldest artifacts are deleted. Set to 0 to disable.",
+	)
+
+	flagSet.Int(maxArtifactsMB, maxArtifactsMBDefaultValue,
+		"Only this number of megabytes of artifacts is kept. The files of the oldest artifacts are deleted. Set to 0 to disable.",
+	)
+
+	flagSet.Duration(lookupCacheTTL, lookupCacheTTLDefaultValue,
+		"The results of lookups, such as the list of installed toolboxes, are cached for this duration, unless code changing the MATLAB path or installing toolboxes is run. Set to 0 to disable.",
+	)
+
+	flagSet.Int(figureResolution, figureResolutionDefaultValue,
+		"The figures left open by evaluated code are rendered in the background at this resolution, in dots per inch, and returned as resource links. Set to 0 to disable.",
+	)
+
+	flagSet.Bool(workspaceDiff, workspaceDiffDefaultValue,
+		"After each evaluation in the MATLAB session, return the variables of the workspace that were added, modified or removed by the evaluated code, with a preview of their values.",
+	)
+
+	flagSet.Int(memoryWarningMB, memoryWarningMBDefaultValue,
+		"When the memory used by the MATLAB session exceeds this number of megabytes, warn the MCP clients. Set to 0 to disable.",
+	)
+
+	flagSet.Int(memoryRestartMB, memoryRestartMBDefaultValue,
+		"When the memory used by the MATLAB session exceeds this number of megabytes, save the workspace to a MAT-file and restart the MATLAB session, before the operating system stops it. Set to 0 to disable.",
+	)
+
+	flagSet.Bool(memoryMitigation, memoryMitigationDefaultValue,
+		fmt.Sprintf("When the memory used by the MATLAB session exceeds %s, clear the cached functions and collect the garbage of the MATLAB session.", memoryWarningMB),
+	)
+
+	flagSet.Duration(memoryCheckInterval, memoryCheckIntervalDefaultValue,
+		fmt.Sprintf("When %s or %s is set, the interval at which the memory used by the MATLAB session is checked.", memoryWarningMB, memoryRestartMB),
+	)
+
+	flagSet.Duration(healthCheckInterval, healthCheckIntervalDefaultValue,
+		fmt.Sprintf("When %s is true, the interval at which the server checks that the MATLAB process it started is still running, and restarts it if it crashed. Set to 0 to disable.", useSingleMATLABSession),
+	)
+
+	flagSet.Duration(jobPollInterval, jobPollIntervalDefaultValue,
+		"The interval at which the state of a background job running on a Parallel Computing Toolbox worker is queried, while a call waits for the job to finish. Jobs running in the MATLAB session signal when they finish instead.",
+	)
+
+	flagSet.Float64(rateLimit, rateLimitDefaultValue,
+		"The maximum sustained number of tool calls per second for each client. Calls above the limit are rejected. Set to 0 to disable.",
+	)
+
+	flagSet.Int(rateLimitBurst, rateLimitBurstDefaultValue,
+		fmt.Sprintf("When %s is set, the number of tool calls a client can make at once, above the sustained rate. Defaults to %s, rounded up.", rateLimit, rateLimit),
+	)
+
+	flagSet.Int(maxConcurrentCalls, maxConcurrentCallsDefaultValue,
+		"The maximum number of tool calls running at the same time for each client. Calls above the limit are rejected. Set to 0 to disable.",
+	)
+
+	flagSet.String(debugListenAddress, debugListenAddressDefaultValue,
+		"If set, serves pprof profiles and runtime metrics for the MCP server process on this address. Only loopback addresses are allowed, for example: 127.0.0.1:6060.",
+	)
+
+	flagSet.String(metricsListenAddress, metricsListenAddressDefaultValue,
+		"If set, serves Prometheus metrics of the tool calls, MATLAB and the HTTP transport at /metrics on this address, for example: 0.0.0.0:9464. The endpoint is not authenticated.",
+	)
+
+	flagSet.Bool(sandbox, sandboxDefaultValue,
+		"Reject submitted MATLAB code and scripts that run shell commands or spawn processes, for example with system, dos, unix, ! or java.lang.Runtime, and block these functions in the MATLAB session.",
+	)
+
+	flagSet.StringSlice(allowedFunction, nil,
+		fmt.Sprintf("When %s is set, a function that submitted code is allowed to call although the sandbox blocks it, such as dos, or delete when %s is set. Can be repeated.", sandbox, restrictFileAccess),
+	)
+
+	flagSet.StringSlice(deniedFunction, nil,
+		fmt.Sprintf("When %s is set, a function that submitted code is not allowed to call, in addition to the functions the sandbox blocks. Can be repeated.", sandbox),
+	)
+
+	flagSet.Bool(readOnly, readOnlyDefaultValue,
+		"Only expose tools that do not run MATLAB code or modify files, such as code analysis and toolbox detection.",
+	)
+
+	flagSet.Bool(dryRun, dryRunDefaultValue,
+		"Do not run the calls to the tools that run MATLAB code or stop MATLAB, and report what they would do instead, such as the code they would run and the files and paths it would change.",
+	)
+
+	flagSet.Bool(redactOutput, redactOutputDefaultValue,
+		"Redact API keys, tokens, private keys, license numbers, email addresses and other random-looking secrets from tool results and logged MATLAB output.",
+	)
+
+	flagSet.StringSlice(redactPattern, nil,
+		"A regular expression matching values to redact from tool results and logged MATLAB output, for example patient IDs. Can be repeated. If the expression has a group named secret, only this group is redacted.",
+	)
+
+	flagSet.Bool(requireApproval, requireApprovalDefaultValue,
+		"Show the MATLAB code of every evaluation and script run to the user, and only run it once the user approved it. Requires an MCP client supporting elicitation.",
+	)
+
+	flagSet.String(policyFile, policyFileDefaultValue,
+		"If set, a JSON file of rules deciding, for every tool call, whether the call is allowed, denied, or requires a confirmation from the user.",
+	)
+
+	flagSet.StringSlice(disableTool, nil,
+		"The name of a tool not to expose, such as evaluate_matlab_code. Can be repeated.",
+	)
+
+	flagSet.Bool(restrictFileAccess, restrictFileAccessDefaultValue,
+		fmt.Sprintf("Only allow tools to access files and folders in the roots of the MCP client, and in the folders set with %s.", allowedFolder),
+	)
+
+	flagSet.StringSlice(allowedFolder, nil,
+		fmt.Sprintf("When %s is set, an absolute path to a folder that tools are allowed to access, in addition to the roots of the MCP client. Can be repeated.", restrictFileAccess),
+	)
+
+	flagSet.Bool(blockNetwork, blockNetworkDefaultValue,
+		fmt.Sprintf("Reject submitted MATLAB code and scripts that access the network, for example with webread, websave, tcpclient or java.net, unless they only access the hosts set with %s. Without allowed hosts, also block these functions in the MATLAB session.", allowedHost),
+	)
+
+	flagSet.StringSlice(allowedHost, nil,
+		fmt.Sprintf("When %s is set, a host that submitted code is allowed to access, with its subdomains. Can be repeated.", blockNetwork),
+	)
+
+	flagSet.String(recordSession, recordSessionDefaultValue,
+		fmt.Sprintf("If set, a folder to record the tool calls of the session to, with their arguments and results, in a tamper-evident recording. Use the %s command to re-run a recording against a fresh MATLAB session.", replayCommand),
+	)
+
+	flagSet.String(auditLog, auditLogDefaultValue,
+		"If set, the absolute path of an append-only audit log, to which every tool call is written as a line of JSON, with the code it ran, its session, client and outcome.",
+	)
+
+	flagSet.Int(auditLogMaxMB, auditLogMaxMBDefaultValue,
+		fmt.Sprintf("The number of megabytes above which the %s file is rotated. Rotated files are kept. Set to 0 to never rotate.", auditLog),
+	)
+
+	flagSet.String(recordMATLAB, recordMATLABDefaultValue,
+		"If set, the absolute path of a fixture file to record the requests to the MATLAB sessions to, with the responses of MATLAB.",
+	)
+
+	flagSet.String(replayMATLAB, replayMATLABDefaultValue,
+		fmt.Sprintf("If set, the absolute path of a fixture file recorded with %s, whose responses are served instead of starting MATLAB, to run the server without a MATLAB installation.", recordMATLAB),
+	)
+
+	flagSet.String(matlabDrive, matlabDriveDefaultValue,
+		"If set, the absolute path of the local MATLAB Drive folder, kept in sync with the cloud by MATLAB Drive Connector. Its files are available as resources, and tools pull files from it into projects and push files and artifacts back to it.",
+	)
+
+	flagSet.StringSlice(realTimeTarget, nil,
+		"The name of a Simulink Real-Time target computer, as listed by slrealtime.Targets, that tools may build for, deploy to, start, stop and stream signals from. Can be repeated.",
+	)
+
+	flagSet.String(productionServer, productionServerDefaultValue,
+		"If set, the HTTP(S) URL of a MATLAB Production Server instance, whose health and deployed archives tools report, and whose functions tools invoke. Tools also package functions into deployable archives for it.",
+	)
+
+	flagSet.String(productionServerDeployFolder, productionServerDeployFolderDefaultValue,
+		fmt.Sprintf("If set with %s, the absolute path of the auto_deploy folder of the MATLAB Production Server instance, where tools deploy archives.", productionServer),
+	)
+
+	flagSet.StringSlice(eventWebhook, nil,
+		"An HTTP(S) URL to POST a JSON event to for every tool call, failed tool call, policy violation and MATLAB session start or restart. Can be repeated.",
+	)
+
+	flagSet.String(eventSocket, eventSocketDefaultValue,
+		"If set, the absolute path of a Unix domain socket to write the same JSON events to, one per line, for a local collector listening on it.",
+	)
+
+	flagSet.StringSlice(plugin, nil,
+		"The absolute path of a plugin executable providing additional tools. Can be repeated.",
+	)
+
+	flagSet.String(downstreamServers, downstreamServersDefaultValue,
+		"If set, the absolute path of a JSON file of downstream MCP servers, whose tools are served next to the tools of this server, prefixed with the name of their server.",
+	)
+
+	flagSet.Bool(encryptAtRest, encryptAtRestDefaultValue,
+		"Encrypt session recordings and the events snapshot written by the server, with a key held in the keychain of the operating system.",
+	)
+
+	flagSet.Bool(strictTLS, strictTLSDefaultValue,
+		"Verify the certificate of the MATLAB session without clock skew tolerance, and ask to confirm its fingerprint the first time it is trusted.",
+	)
+
+	flagSet.String(matlabClientCertificate, matlabClientCertificateDefaultValue,
+		fmt.Sprintf("If set, the absolute path of a PEM file holding the certificate the server authenticates with to MATLAB sessions which require client certificates. Requires %s.", matlabClientKey),
+	)
+
+	flagSet.String(matlabClientKey, matlabClientKeyDefaultValue,
+		fmt.Sprintf("If set, the absolute path of a PEM file holding the private key of the certificate set by %s.", matlabClientCertificate),
+	)
+
+	flagSet.String(proxy, proxyDefaultValue,
+		fmt.Sprintf("If set, the HTTP(S) URL of the proxy to reach the HTTP(S) services the server calls through, such as MATLAB Production Server, usage telemetry and event webhooks, instead of the proxy set by the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables. The URL can hold the user name and password of an authenticated proxy. Connections to the local machine never go through the proxy. See also %s.", noProxy),
+	)
+
+	flagSet.StringSlice(noProxy, nil,
+		fmt.Sprintf("When %s is set, a host, domain, IP address or CIDR range to reach without the proxy, with its subdomains. Can be repeated.", proxy),
+	)
+
+	flagSet.Bool(daemon, daemonDefaultValue,
+		fmt.Sprintf("Run as a daemon serving MCP clients on a local socket instead of on the standard input and output. The daemon and its MATLAB session keep running when clients disconnect. Clients connect with %s.", attach),
+	)
+
+	flagSet.Bool(attach, attachDefaultValue,
+		fmt.Sprintf("Connect the standard input and output to the daemon started with %s, and start the daemon if it is not running.", daemon),
+	)
+
+	flagSet.String(daemonSocket, daemonSocketDefaultValue,
+		fmt.Sprintf("The path of the local socket of the daemon, for %s and %s. Defaults to a socket in the temporary folder.", daemon, attach),
+	)
+
+	flagSet.String(instanceID, instanceIDDefaultValue,
+		fmt.Sprintf("The name of the instance of the server, so that several instances, for example one per project, run side by side. Each instance has its own lock, and the %s, %s, %s and %s commands act on the instance with this name. Letters, digits, '-' and '_' only, up to %d characters.", stopCommand, logsCommand, cleanupCommand, doctorCommand, maxInstanceIDLength),
+	)
+
+	flagSet.Bool(instancePerFolder, instancePerFolderDefaultValue,
+		fmt.Sprintf("Name the instance of the server after the folder set by %s, so that the servers of different projects run side by side. Cannot be used with %s.", preferredMATLABStartingDirectory, instanceID),
+	)
+
+	flagSet.String(transport, transportDefaultValue,
+		fmt.Sprintf("When running the %s command, the transport to serve MCP clients on: %s, %s or %s. The %s and %s transports listen on the address set by %s.", serveCommand, entities.TransportStdio, entities.TransportHTTP, entities.TransportWebSocket, entities.TransportHTTP, entities.TransportWebSocket, listen),
+	)
+
+	flagSet.String(listen, listenDefaultValue,
+		fmt.Sprintf("When running the %s command with the %s or %s transport, the address to listen on. Clients connect to the /mcp path. Only loopback addresses are allowed, for example: 127.0.0.1:8000.", serveCommand, entities.TransportHTTP, entities.TransportWebSocket),
+	)
+
+	flagSet.Bool(restAPI, restAPIDefaultValue,
+		fmt.Sprintf("When running the %s command with the %s transport, also serve the tools as a REST API next to the MCP endpoint: POST /api/tools/NAME calls a tool, and /api/openapi.json describes the API.", serveCommand, entities.TransportHTTP),
+	)
+
+	flagSet.Bool(grpc, grpcDefaultValue,
+		fmt.Sprintf("When running the %s command with the %s transport, also serve the gRPC service of the server on the listen address, over HTTP/2 without TLS.", serveCommand, entities.TransportHTTP),
+	)
+
+	flagSet.Duration(wsPingInterval, wsPingIntervalDefaultValue,
+		fmt.Sprintf("With the %s transport, the interval at which the clients are pinged. A client which sent nothing since the previous ping is disconnected. Set to 0 to disable.", entities.TransportWebSocket),
+	)
+
+	flagSet.String(authTokensFile, authTokensFileDefaultValue,
+		fmt.Sprintf("When running the %s command with the %s or %s transport, the absolute path of a file of API keys, one per line as NAME:TOKEN. Every request must then send one of the tokens as a bearer token. The file is read again when it changes, to rotate the keys without restarting the server.", serveCommand, entities.TransportHTTP, entities.TransportWebSocket),
+	)
+
+	flagSet.Bool(statusEvents, statusEventsDefaultValue,
+		fmt.Sprintf("When running the %s command, list the most recent events recorded by the MATLAB MCP Core Server.", statusCommand),
+	)
+
+	flagSet.Bool(doctorFix, doctorFixDefaultValue,
+		fmt.Sprintf("When running the %s command, apply the fixes that are safe to apply automatically, such as removing a stale lock file.", doctorCommand),
+	)
+
+	flagSet.Bool(logsFollow, logsFollowDefaultValue,
+		fmt.Sprintf("When running the %s command, print the entries of the log file, and keep printing the entries written to it until interrupted.", logsCommand),
+	)
+
+	flagSet.String(logsLevel, logsLevelDefaultValue,
+		fmt.Sprintf("When running the %s command, print the entries of the log file at this level or above: %s, %s, %s or %s.", logsCommand, entities.LogLevelDebug, entities.LogLevelInfo, entities.LogLevelWarn, entities.LogLevelError),
+	)
+
+	flagSet.Duration(cleanupOlderThan, cleanupOlderThanDefaultValue,
+		fmt.Sprintf("When running the %s command, only remove the folders which have not been written to for this long, for example: 168h.", cleanupCommand),
+	)
+
+	flagSet.String(runScript, runScriptDefaultValue,
+		fmt.Sprintf("When running the %s command, the path of the YAML or JSON script declaring the tool calls to run.", runCommand),
+	)
+
+	// Hidden flags, for internal use only
+	flagSet.Bool(watchdogMode, watchdogModeDefaultValue,
+		"INTERNAL USE ONLY.",
+	)
+	err := flagSet.MarkHidden(watchdogMode)
+	if err != nil {
+		return err
+	}
+
+	flagSet.Bool(completeMATLABRoot, completeMATLABRootDefaultValue,
+		"INTERNAL USE ONLY.",
+	)
+	err = flagSet.MarkHidden(completeMATLABRoot)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func createConfigWithFlagValues(osLayer OSLayer, file ConfigFile, flagSet *pflag.FlagSet, args []string) (*Config, error) {
+	err := flagSet.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	configFilePath, fileSettings, err := applyConfigFile(flagSet, file)
+	if err != nil {
+		return nil, err
+	}
+
+	var statusMode, stopMode, telemetryPreviewMode, replayMode, versionRequested, doctorMode, serveMode, completionMode, logsMode, cleanupMode, serviceMode bool
+	var completionShell entities.Shell
+	var serviceAction entities.ServiceAction
+	var serviceServerArgs []string
+	var replayRecording string
+	var replayServerArgs []string
+	var replMode bool
+	var replServerArgs []string
+	var pipelineMode bool
+	var pipelineScript string
+	var pipelineServerArgs []string
+	var selfTestMode bool
+	var selfTestServerArgs []string
+	var kernelMode bool
+	var kernelConnectionFile string
+	var installMode, uninstallMode bool
+	var installClients []entities.MCPClient
+	var installServerArgs []string
+	switch flagSet.Arg(0) {
+	case "":
+		break
+	case serveCommand:
+		serveMode = true
+	case statusCommand:
+		statusMode = true
+	case telemetryPreviewCommand:
+		telemetryPreviewMode = true
+	case doctorCommand:
+		doctorMode = true
+		// The doctor can check the address of the transport of the serve command.
+		extraArgs := flagSet.Args()[1:]
+		if len(extraArgs) == 1 && extraArgs[0] == serveCommand {
+			serveMode = true
+		} else if len(extraArgs) > 0 {
+			return nil, fmt.Errorf("unexpected argument: %s", extraArgs[0])
+		}
+	case logsCommand:
+		logsMode = true
+	case cleanupCommand:
+		cleanupMode = true
+	case stopCommand:
+		stopMode = true
+	case serviceCommand:
+		serviceMode = true
+		serviceAction = entities.ServiceAction(flagSet.Arg(1))
+		if !slices.Contains(entities.ServiceActions, serviceAction) {
+			actions := make([]string, 0, len(entities.ServiceActions))
+			for _, action := range entities.ServiceActions {
+				actions = append(actions, string(action))
+			}
+			if serviceAction == "" {
+				return nil, fmt.Errorf("the %s command needs an action, among: %s", serviceCommand, strings.Join(actions, ", "))
+			}
+			return nil, fmt.Errorf("unknown service action: %s, the supported actions are: %s", serviceAction, strings.Join(actions, ", "))
+		}
+		// The service can run the server with the serve command, to select its transport.
+		extraArgs := flagSet.Args()[2:]
+		if serviceAction == entities.ServiceActionInstall && len(extraArgs) == 1 && extraArgs[0] == serveCommand {
+			serveMode = true
+		} else if len(extraArgs) > 0 {
+			return nil, fmt.Errorf("unexpected argument: %s", extraArgs[0])
+		}
+		serviceServerArgs = withoutPositionalArgs(args, serviceCommand, string(serviceAction))
+	case versionCommand:
+		versionRequested = true
+	case replayCommand:
+		replayMode = true
+		replayRecording = flagSet.Arg(1)
+		if replayRecording == "" {
+			return nil, fmt.Errorf("the %s command needs the path of a recording", replayCommand)
+		}
+		replayServerArgs = withoutPositionalArgs(args, replayCommand, replayRecording)
+	case replCommand:
+		replMode = true
+		replServerArgs = withoutPositionalArgs(args, replCommand)
+	case runCommand:
+		pipelineMode = true
+		pipelineScript, err = flagSet.GetString(runScript)
+		if err != nil {
+			return nil, err
+		}
+		if pipelineScript == "" {
+			return nil, fmt.Errorf("the %s command needs a script, set with --%s", runCommand, runScript)
+		}
+		if extraArgs := flagSet.Args()[1:]; len(extraArgs) > 0 {
+			return nil, fmt.Errorf("unexpected argument: %s", extraArgs[0])
+		}
+		// The server runs the calls of the script, so it is not given the script.
+		pipelineServerArgs = withoutFlag(withoutPositionalArgs(args, runCommand), runScript)
+	case selfTestCommand:
+		selfTestMode = true
+		// The self-test can start the server with the serve command, to test its transport.
+		extraArgs := flagSet.Args()[1:]
+		if len(extraArgs) == 1 && extraArgs[0] == serveCommand {
+			serveMode = true
+		} else if len(extraArgs) > 0 {
+			return nil, fmt.Errorf("unexpected argument: %s", extraArgs[0])
+		}
+		selfTestServerArgs = withoutPositionalArgs(args, selfTestCommand)
+		// Figures are only captured when they are rendered.
+		if !flagSet.Changed(figureResolution) {
+			selfTestServerArgs = append(selfTestServerArgs, fmt.Sprintf("--%s=%d", figureResolution, selfTestFigureResolution))
+		}
+	case kernelCommand:
+		kernelMode = true
+		kernelConnectionFile = flagSet.Arg(1)
+		if kernelConnectionFile == "" {
+			return nil, fmt.Errorf("the %s command needs the path of a connection file", kernelCommand)
+		}
+	case installCommand, uninstallCommand:
+		installMode = flagSet.Arg(0) == installCommand
+		uninstallMode = !installMode
+		for _, name := range flagSet.Args()[1:] {
+			client := entities.MCPClient(name)
+			if !slices.Contains(entities.MCPClients, client) {
+				return nil, fmt.Errorf("unknown MCP client: %s", name)
+			}
+			installClients = append(installClients, client)
+		}
+		installServerArgs = withoutPositionalArgs(args, flagSet.Args()...)
+	case completionCommand:
+		completionMode = true
+		completionShell = entities.Shell(flagSet.Arg(1))
+	default:
+		return nil, fmt.Errorf("unknown command: %s", flagSet.Arg(0))
+	}
+
+	completeMATLABRoot, err := flagSet.GetBool(completeMATLABRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	// The shell completions list the MATLAB roots with the completion command, so that they need no shell.
+	if completionMode && !completeMATLABRoot && !slices.Contains(entities.Shells, completionShell) {
+		shells := make([]string, 0, len(entities.Shells))
+		for _, shell := range entities.Shells {
+			shells = append(shells, string(shell))
+		}
+		if completionShell == "" {
+			return nil, fmt.Errorf("the %s command needs a shell, among: %s", completionCommand, strings.Join(shells, ", "))
+		}
+		return nil, fmt.Errorf("unknown shell: %s, the supported shells are: %s", completionShell, strings.Join(shells, ", "))
+	}
+
+	var cliFlags []entities.CLIFlag
+	flagSet.VisitAll(func(flag *pflag.Flag) {
+		if !flag.Hidden {
+			cliFlags = append(cliFlags, newCLIFlag(flag))
+		}
+	})
+
+	statusEvents, err := flagSet.GetBool(statusEvents)
+	if err != nil {
+		return nil, err
+	}
+
+	doctorFix, err := flagSet.GetBool(doctorFix)
+	if err != nil {
+		return nil, err
+	}
+
+	logsFollow, err := flagSet.GetBool(logsFollow)
+	if err != nil {
+		return nil, err
+	}
+
+	logsLevelName, err := flagSet.GetString(logsLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	logsLevel := entities.LogLevel(strings.ToLower(strings.TrimSpace(logsLevelName)))
+	switch logsLevel {
+	case "", entities.LogLevelDebug, entities.LogLevelInfo, entities.LogLevelWarn, entities.LogLevelError:
+	default:
+		return nil, fmt.Errorf("unknown log level: %s", logsLevelName)
+	}
+
+	cleanupOlderThan, err := flagSet.GetDuration(cleanupOlderThan)
+	if err != nil {
+		return nil, err
+	}
+
+	if cleanupOlderThan < 0 {
+		return nil, fmt.Errorf("invalid cleanup age: %s", cleanupOlderThan)
+	}
+
+	versionMode, err := flagSet.GetBool(versionMode)
+	if err != nil {
+		return nil, err
+	}
+	versionMode = versionMode || versionRequested
+
+	disableTelemetry, err := flagSet.GetBool(disableTelemetry)
+	if err != nil {
+		return nil, err
+	}
+
+	enableTelemetry, err := flagSet.GetBool(enableTelemetry)
+	if err != nil {
+		return nil, err
+	}
+
+	telemetryEndpoint, err := flagSet.GetString(telemetryEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if enableTelemetry && !disableTelemetry {
+		if err := validateTelemetryEndpoint(telemetryEndpoint); err != nil {
+			return nil, fmt.Errorf("invalid telemetry endpoint: %w", err)
+		}
+	}
+
+	useSingleMATLABSession, err := flagSet.GetBool(useSingleMATLABSession)
+	if err != nil {
+		return nil, err
+	}
+
+	logLevel, err := flagSet.GetString(logLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	switch logLevel {
+	case string(entities.LogLevelDebug), string(entities.LogLevelInfo), string(entities.LogLevelWarn), string(entities.LogLevelError):
+		break
+	default:
+		return nil, fmt.Errorf("invalid log level: %s", logLevel)
+	}
+
+	logFilePath, err := flagSet.GetString(logFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if logFilePath != "" {
+		if !filepath.IsAbs(logFilePath) {
+			return nil, fmt.Errorf("invalid %s: %s is not an absolute path", logFile, logFilePath)
+		}
+		logFilePath = filepath.Clean(logFilePath)
+	}
+
+	logComponentLevels, err := flagSet.GetStringSlice(logComponentLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	logLevelsByComponent, err := parseLogComponentLevels(logComponentLevels)
+	if err != nil {
+		return nil, err
+	}
+
+	logMaxSizeMB, err := flagSet.GetInt(logMaxSizeMB)
+	if err != nil {
+		return nil, err
+	}
+
+	if logMaxSizeMB < 0 {
+		return nil, fmt.Errorf("invalid log max size MB: %d", logMaxSizeMB)
+	}
+
+	logMaxAge, err := flagSet.GetDuration(logMaxAge)
+	if err != nil {
+		return nil, err
+	}
+
+	if logMaxAge < 0 {
+		return nil, fmt.Errorf("invalid log max age: %s", logMaxAge)
+	}
+
+	logMaxFiles, err := flagSet.GetInt(logMaxFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	if logMaxFiles < 0 {
+		return nil, fmt.Errorf("invalid log max files: %d", logMaxFiles)
+	}
+
+	quietMode, err := flagSet.GetBool(quiet)
+	if err != nil {
+		return nil, err
+	}
+
+	verboseMode, err := flagSet.GetBool(verbose)
+	if err != nil {
+		return nil, err
+	}
+
+	if quietMode && verboseMode {
+		return nil, fmt.Errorf("%s and %s cannot be used together", quiet, verbose)
+	}
+
+	localeTag, err := flagSet.GetString(locale)
+	if err != nil {
+		return nil, err
+	}
+
+	var userLocale entities.Locale
+	if localeTag != "" {
+		var ok bool
+		userLocale, ok = i18n.ParseLocale(localeTag)
+		if !ok {
+			return nil, fmt.Errorf("invalid locale: %s", localeTag)
+		}
+	}
+
+	localMATLABRoot, err := flagSet.GetString(preferredLocalMATLABRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	localMATLABRelease, err := flagSet.GetString(matlabRelease)
+	if err != nil {
+		return nil, err
+	}
+
+	if localMATLABRelease != "" {
+		if !matlabReleasePattern.MatchString(localMATLABRelease) {
+			return nil, fmt.Errorf("invalid MATLAB release: %s", localMATLABRelease)
+		}
+		localMATLABRelease = "R" + strings.ToLower(localMATLABRelease[1:])
+
+		if localMATLABRoot != "" {
+			return nil, fmt.Errorf("%s cannot be used with %s", matlabRelease, preferredLocalMATLABRoot)
+		}
+	}
+
+	sharedMATLABSessionFile, err := flagSet.GetString(sharedMATLABSession)
+	if err != nil {
+		return nil, err
+	}
+
+	if sharedMATLABSessionFile != "" {
+		if !filepath.IsAbs(sharedMATLABSessionFile) {
+			return nil, fmt.Errorf("invalid %s: %s is not an absolute path", sharedMATLABSession, sharedMATLABSessionFile)
+		}
+		if localMATLABRoot != "" || localMATLABRelease != "" {
+			return nil, fmt.Errorf("%s cannot be used with %s or %s", sharedMATLABSession, preferredLocalMATLABRoot, matlabRelease)
+		}
+		sharedMATLABSessionFile = filepath.Clean(sharedMATLABSessionFile)
+	}
+
+	preferredMATLABStartingDirectory, err := flagSet.GetString(preferredMATLABStartingDirectory)
+	if err != nil {
+		return nil, err
+	}
+
+	showMATLABDesktop, err := flagSet.GetBool(showMATLABDesktop)
+	if err != nil {
+		return nil, err
+	}
+
+	slowCallThreshold, err := flagSet.GetDuration(slowCallThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	if slowCallThreshold < 0 {
+		return nil, fmt.Errorf("invalid slow call threshold: %s", slowCallThreshold)
+	}
+
+	maxEvalTime, err := flagSet.GetDuration(maxEvalTime)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxEvalTime < 0 {
+		return nil, fmt.Errorf("invalid max eval time: %s", maxEvalTime)
+	}
+
+	maxOutputBytes, err := flagSet.GetInt(maxOutputBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxOutputBytes < 0 {
+		return nil, fmt.Errorf("invalid max output bytes: %d", maxOutputBytes)
+	}
+
+	maxFigures, err := flagSet.GetInt(maxFigures)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxFigures < 0 {
+		return nil, fmt.Errorf("invalid max figures: %d", maxFigures)
+	}
+
+	workerPoolSize, err := flagSet.GetInt(workerPoolSize)
+	if err != nil {
+		return nil, err
+	}
+
+	if workerPoolSize < 0 {
+		return nil, fmt.Errorf("invalid worker pool size: %d", workerPoolSize)
+	}
+
+	streamOutputChunkSize, err := flagSet.GetInt(streamOutputChunkSize)
+	if err != nil {
+		return nil, err
+	}
+
+	if streamOutputChunkSize < 0 {
+		return nil, fmt.Errorf("invalid stream output chunk size: %d", streamOutputChunkSize)
+	}
+
+	streamNotificationRate, err := flagSet.GetFloat64(streamNotificationRate)
+	if err != nil {
+		return nil, err
+	}
+
+	if streamNotificationRate < 0 {
+		return nil, fmt.Errorf("invalid stream notification rate: %g", streamNotificationRate)
+	}
+
+	progressInterval, err := flagSet.GetDuration(progressInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	if progressInterval < 0 {
+		return nil, fmt.Errorf("invalid progress interval: %s", progressInterval)
+	}
+
+	maxResponseBytes, err := flagSet.GetStringSlice(maxResponseBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	maxResponseBytesByTransport, err := parseMaxResponseBytes(maxResponseBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	oversizeResponse, err := flagSet.GetString(oversizeResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	switch entities.OversizeResponse(oversizeResponse) {
+	case entities.OversizeResponseTruncate, entities.OversizeResponseSummarize:
+	case entities.OversizeResponseResource:
+		if !useSingleMATLABSession {
+			return nil, fmt.Errorf("invalid oversize response: %s requires a single MATLAB session", oversizeResponse)
+		}
+	default:
+		return nil, fmt.Errorf("invalid oversize response: %s", oversizeResponse)
+	}
+
+	variableBinaryThreshold, err := flagSet.GetInt(variableBinaryThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	if variableBinaryThreshold < 0 {
+		return nil, fmt.Errorf("invalid variable binary threshold: %d", variableBinaryThreshold)
+	}
+
+	variablePreviewThreshold, err := flagSet.GetInt(variablePreviewThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	if variablePreviewThreshold < 0 {
+		return nil, fmt.Errorf("invalid variable preview threshold: %d", variablePreviewThreshold)
+	}
+
+	maxVariablePayload, err := flagSet.GetInt(maxVariablePayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxVariablePayload < 0 {
+		return nil, fmt.Errorf("invalid max variable payload: %d", maxVariablePayload)
+	}
+
+	maxArtifacts, err := flagSet.GetInt(maxArtifacts)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxArtifacts < 0 {
+		return nil, fmt.Errorf("invalid max artifacts: %d", maxArtifacts)
+	}
+
+	maxArtifactsMB, err := flagSet.GetInt(maxArtifactsMB)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxArtifactsMB < 0 {
+		return nil, fmt.Errorf("invalid max artifacts MB: %d", maxArtifactsMB)
+	}
+
+	lookupCacheTTL, err := flagSet.GetDuration(lookupCacheTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	if lookupCacheTTL < 0 {
+		return nil, fmt.Errorf("invalid lookup cache TTL: %s", lookupCacheTTL)
+	}
+
+	figureResolution, err := flagSet.GetInt(figureResolution)
+	if err != nil {
+		return nil, err
+	}
+
+	if figureResolution < 0 {
+		return nil, fmt.Errorf("invalid figure resolution: %d", figureResolution)
+	}
+
+	workspaceDiff, err := flagSet.GetBool(workspaceDiff)
+	if err != nil {
+		return nil, err
+	}
+
+	memoryWarningMB, err := flagSet.GetInt(memoryWarningMB)
+	if err != nil {
+		return nil, err
+	}
+
+	if memoryWarningMB < 0 {
+		return nil, fmt.Errorf("invalid memory warning threshold: %d", memoryWarningMB)
+	}
+
+	memoryRestartMB, err := flagSet.GetInt(memoryRestartMB)
+	if err != nil {
+		return nil, err
+	}
+
+	if memoryRestartMB < 0 {
+		return nil, fmt.Errorf("invalid memory restart threshold: %d", memoryRestartMB)
+	}
+
+	if memoryWarningMB > 0 && memoryRestartMB > 0 && memoryWarningMB >= memoryRestartMB {
+		return nil, fmt.Errorf("invalid memory thresholds: the warning threshold %d must be lower than the restart threshold %d", memoryWarningMB, memoryRestartMB)
+	}
+
+	memoryMitigation, err := flagSet.GetBool(memoryMitigation)
+	if err != nil {
+		return nil, err
+	}
+
+	memoryCheckInterval, err := flagSet.GetDuration(memoryCheckInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	if memoryCheckInterval <= 0 {
+		return nil, fmt.Errorf("invalid memory check interval: %s", memoryCheckInterval)
+	}
+
+	healthCheckInterval, err := flagSet.GetDuration(healthCheckInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	if healthCheckInterval < 0 {
+		return nil, fmt.Errorf("invalid health check interval: %s", healthCheckInterval)
+	}
+
+	jobPollInterval, err := flagSet.GetDuration(jobPollInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	if jobPollInterval <= 0 {
+		return nil, fmt.Errorf("invalid job poll interval: %s", jobPollInterval)
+	}
+
+	rateLimit, err := flagSet.GetFloat64(rateLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	if rateLimit < 0 {
+		return nil, fmt.Errorf("invalid rate limit: %g", rateLimit)
+	}
+
+	rateLimitBurst, err := flagSet.GetInt(rateLimitBurst)
+	if err != nil {
+		return nil, err
+	}
+
+	if rateLimitBurst < 0 {
+		return nil, fmt.Errorf("invalid rate limit burst: %d", rateLimitBurst)
+	}
+
+	maxConcurrentCalls, err := flagSet.GetInt(maxConcurrentCalls)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxConcurrentCalls < 0 {
+		return nil, fmt.Errorf("invalid max concurrent calls: %d", maxConcurrentCalls)
+	}
+
+	debugListenAddress, err := flagSet.GetString(debugListenAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if debugListenAddress != "" {
+		if err := validateLoopbackAddress(debugListenAddress); err != nil {
+			return nil, fmt.Errorf("invalid debug listen address: %w", err)
+		}
+	}
+
+	metricsListenAddress, err := flagSet.GetString(metricsListenAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if metricsListenAddress != "" {
+		if _, _, err := net.SplitHostPort(metricsListenAddress); err != nil {
+			return nil, fmt.Errorf("invalid metrics listen address: %w", err)
+		}
+	}
+
+	sandbox, err := flagSet.GetBool(sandbox)
+	if err != nil {
+		return nil, err
+	}
+
+	allowedFunctions, err := flagSet.GetStringSlice(allowedFunction)
+	if err != nil {
+		return nil, err
+	}
+
+	deniedFunctions, err := flagSet.GetStringSlice(deniedFunction)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, function := range slices.Concat(allowedFunctions, deniedFunctions) {
+		if !functionNamePattern.MatchString(function) {
+			return nil, fmt.Errorf("invalid function: %s is not a MATLAB function name", function)
+		}
+	}
+
+	readOnly, err := flagSet.GetBool(readOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	dryRun, err := flagSet.GetBool(dryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	redactOutput, err := flagSet.GetBool(redactOutput)
+	if err != nil {
+		return nil, err
+	}
+
+	redactionPatterns, err := flagSet.GetStringSlice(redactPattern)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pattern := range redactionPatterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return nil, fmt.Errorf("invalid redact pattern: %w", err)
+		}
+	}
+
+	requireApproval, err := flagSet.GetBool(requireApproval)
+	if err != nil {
+		return nil, err
+	}
+
+	policyFile, err := flagSet.GetString(policyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	disabledTools, err := flagSet.GetStringSlice(disableTool)
+	if err != nil {
+		return nil, err
+	}
+
+	restrictFileAccess, err := flagSet.GetBool(restrictFileAccess)
+	if err != nil {
+		return nil, err
+	}
+
+	allowedFolders, err := flagSet.GetStringSlice(allowedFolder)
+	if err != nil {
+		return nil, err
+	}
 
-	useSingleMATLABSession             = "use-single-matlab-session"
-	useSingleMATLABSessionDefaultValue = true
+	for _, folder := range allowedFolders {
+		if !filepath.IsAbs(folder) {
+			return nil, fmt.Errorf("invalid allowed folder: %s is not an absolute path", folder)
+		}
+	}
 
-	preferredLocalMATLABRoot             = "matlab-root"
-	preferredLocalMATLABRootDefaultValue = ""
+	blockNetwork, err := flagSet.GetBool(blockNetwork)
+	if err != nil {
+		return nil, err
+	}
 
-	preferredMATLABStartingDirectory             = "initial-working-folder"
-	preferredMATLABStartingDirectoryDefaultValue = ""
+	allowedHosts, err := flagSet.GetStringSlice(allowedHost)
+	if err != nil {
+		return nil, err
+	}
 
-	logLevel             = "log-level"
-	logLevelDefaultValue = "info"
+	for i, host := range allowedHosts {
+		if host == "" || strings.ContainsAny(host, "/:@ ") {
+			return nil, fmt.Errorf("invalid allowed host: %s is not a host name", host)
+		}
+		allowedHosts[i] = strings.ToLower(host)
+	}
 
-	watchdogMode             = "watchdog"
-	watchdogModeDefaultValue = false
-)
+	recordSession, err := flagSet.GetString(recordSession)
+	if err != nil {
+		return nil, err
+	}
 
-func setupFlags(flagSet *pflag.FlagSet) error {
-	flagSet.Bool(versionMode, versionModeDefaultValue,
-		"Display the version of the MATLAB MCP Core Server.",
-	)
+	auditLogFile, err := flagSet.GetString(auditLog)
+	if err != nil {
+		return nil, err
+	}
 
-	flagSet.Bool(disableTelemetry, disableTelemetryDefaultValue,
-		"Disable collection of usage data. By default, this software may collect information about you and your usage and send it to MathWorks. This data helps us improve our products and services.",
-	)
+	if auditLogFile != "" {
+		if !filepath.IsAbs(auditLogFile) {
+			return nil, fmt.Errorf("invalid %s: %s is not an absolute path", auditLog, auditLogFile)
+		}
+		auditLogFile = filepath.Clean(auditLogFile)
+	}
 
-	flagSet.Bool(useSingleMATLABSession, useSingleMATLABSessionDefaultValue,
-		"When true, a MATLAB session is started when a MATLAB MCP Core Server starts, and stopped when the server is shut down. When false, the server can manage multiple MATLAB sessions.",
-	)
+	auditLogMaxMB, err := flagSet.GetInt(auditLogMaxMB)
+	if err != nil {
+		return nil, err
+	}
 
-	flagSet.String(logLevel, logLevelDefaultValue,
-		"The log level to use for the global logger (for session logs, the clients sets the log level). Valid values are: debug, info, warn, error.",
-	)
+	if auditLogMaxMB < 0 {
+		return nil, fmt.Errorf("invalid audit log max MB: %d", auditLogMaxMB)
+	}
 
-	flagSet.String(preferredLocalMATLABRoot, preferredLocalMATLABRootDefaultValue,
-		fmt.Sprintf("When %s is true, if this is set, defines which local MATLAB installation to use. If not set, the first MATLAB installation on the PATH will be used.", useSingleMATLABSession),
-	)
+	recordMATLABFile, err := flagSet.GetString(recordMATLAB)
+	if err != nil {
+		return nil, err
+	}
 
-	flagSet.String(preferredMATLABStartingDirectory, preferredMATLABStartingDirectoryDefaultValue,
-		fmt.Sprintf("When %s is true, if this is set, defines which starting directory MATLAB will use. If not set, MATLAB will use the default MATLAB's starting directory.", useSingleMATLABSession))
+	if recordMATLABFile != "" {
+		if !filepath.IsAbs(recordMATLABFile) {
+			return nil, fmt.Errorf("invalid %s: %s is not an absolute path", recordMATLAB, recordMATLABFile)
+		}
+		recordMATLABFile = filepath.Clean(recordMATLABFile)
+	}
 
-	// Hidden flags, for internal use only
-	flagSet.Bool(watchdogMode, watchdogModeDefaultValue,
-		"INTERNAL USE ONLY.",
-	)
-	err := flagSet.MarkHidden(watchdogMode)
+	replayMATLABFile, err := flagSet.GetString(replayMATLAB)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
-}
+	if replayMATLABFile != "" {
+		if !filepath.IsAbs(replayMATLABFile) {
+			return nil, fmt.Errorf("invalid %s: %s is not an absolute path", replayMATLAB, replayMATLABFile)
+		}
+		if recordMATLABFile != "" {
+			return nil, fmt.Errorf("%s and %s cannot be used together", recordMATLAB, replayMATLAB)
+		}
+		replayMATLABFile = filepath.Clean(replayMATLABFile)
+	}
 
-func createConfigWithFlagValues(osLayer OSLayer, flagSet *pflag.FlagSet, args []string) (*Config, error) {
-	err := flagSet.Parse(args)
+	matlabDriveFolder, err := flagSet.GetString(matlabDrive)
 	if err != nil {
 		return nil, err
 	}
 
-	versionMode, err := flagSet.GetBool(versionMode)
+	if matlabDriveFolder != "" {
+		if !filepath.IsAbs(matlabDriveFolder) {
+			return nil, fmt.Errorf("invalid %s: %s is not an absolute path", matlabDrive, matlabDriveFolder)
+		}
+		matlabDriveFolder = filepath.Clean(matlabDriveFolder)
+	}
+
+	realTimeTargets, err := flagSet.GetStringSlice(realTimeTarget)
 	if err != nil {
 		return nil, err
 	}
 
-	disableTelemetry, err := flagSet.GetBool(disableTelemetry)
+	for _, target := range realTimeTargets {
+		if target == "" || strings.ContainsAny(target, "'\"\n") {
+			return nil, fmt.Errorf("invalid %s: %q is not the name of a target", realTimeTarget, target)
+		}
+	}
+
+	productionServerURL, err := flagSet.GetString(productionServer)
 	if err != nil {
 		return nil, err
 	}
 
-	useSingleMATLABSession, err := flagSet.GetBool(useSingleMATLABSession)
+	if productionServerURL != "" {
+		if err := validateWebhook(productionServerURL); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", productionServer, err)
+		}
+		productionServerURL = strings.TrimSuffix(productionServerURL, "/")
+	}
+
+	productionServerDeployFolder, err := flagSet.GetString(productionServerDeployFolder)
 	if err != nil {
 		return nil, err
 	}
 
-	logLevel, err := flagSet.GetString(logLevel)
+	if productionServerDeployFolder != "" {
+		if productionServerURL == "" {
+			return nil, fmt.Errorf("%s must be set when %s is set", productionServer, productionServerDeployFolder)
+		}
+		if !filepath.IsAbs(productionServerDeployFolder) {
+			return nil, fmt.Errorf("invalid %s: %s is not an absolute path", productionServerDeployFolder, productionServerDeployFolder)
+		}
+		productionServerDeployFolder = filepath.Clean(productionServerDeployFolder)
+	}
+
+	eventWebhooks, err := flagSet.GetStringSlice(eventWebhook)
 	if err != nil {
 		return nil, err
 	}
 
-	switch logLevel {
-	case string(entities.LogLevelDebug), string(entities.LogLevelInfo), string(entities.LogLevelWarn), string(entities.LogLevelError):
-		break
-	default:
-		return nil, fmt.Errorf("invalid log level: %s", logLevel)
+	for _, webhook := range eventWebhooks {
+		if err := validateWebhook(webhook); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", eventWebhook, err)
+		}
 	}
 
-	preferredLocalMATLABRoot, err := flagSet.GetString(preferredLocalMATLABRoot)
+	eventSocketPath, err := flagSet.GetString(eventSocket)
 	if err != nil {
 		return nil, err
 	}
 
-	preferredMATLABStartingDirectory, err := flagSet.GetString(preferredMATLABStartingDirectory)
+	if eventSocketPath != "" && !filepath.IsAbs(eventSocketPath) {
+		return nil, fmt.Errorf("invalid %s: %s is not an absolute path", eventSocket, eventSocketPath)
+	}
+
+	plugins, err := flagSet.GetStringSlice(plugin)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, pluginPath := range plugins {
+		if !filepath.IsAbs(pluginPath) {
+			return nil, fmt.Errorf("invalid %s: %s is not an absolute path", plugin, pluginPath)
+		}
+		plugins[i] = filepath.Clean(pluginPath)
+	}
+
+	downstreamServersFile, err := flagSet.GetString(downstreamServers)
+	if err != nil {
+		return nil, err
+	}
+
+	if downstreamServersFile != "" {
+		if !filepath.IsAbs(downstreamServersFile) {
+			return nil, fmt.Errorf("invalid %s: %s is not an absolute path", downstreamServers, downstreamServersFile)
+		}
+		downstreamServersFile = filepath.Clean(downstreamServersFile)
+	}
+
+	encryptAtRest, err := flagSet.GetBool(encryptAtRest)
+	if err != nil {
+		return nil, err
+	}
+
+	strictTLS, err := flagSet.GetBool(strictTLS)
+	if err != nil {
+		return nil, err
+	}
+
+	matlabClientCertificateFile, err := flagSet.GetString(matlabClientCertificate)
+	if err != nil {
+		return nil, err
+	}
+
+	matlabClientKeyFile, err := flagSet.GetString(matlabClientKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if (matlabClientCertificateFile == "") != (matlabClientKeyFile == "") {
+		return nil, fmt.Errorf("%s and %s must be set together", matlabClientCertificate, matlabClientKey)
+	}
+
+	for _, file := range []*string{&matlabClientCertificateFile, &matlabClientKeyFile} {
+		if *file == "" {
+			continue
+		}
+		if !filepath.IsAbs(*file) {
+			return nil, fmt.Errorf("invalid MATLAB client certificate: %s is not an absolute path", *file)
+		}
+		*file = filepath.Clean(*file)
+	}
+
+	proxyURL, err := flagSet.GetString(proxy)
+	if err != nil {
+		return nil, err
+	}
+
+	if proxyURL != "" {
+		if err := validateWebhook(proxyURL); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", proxy, err)
+		}
+	}
+
+	noProxyHosts, err := flagSet.GetStringSlice(noProxy)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(noProxyHosts) > 0 && proxyURL == "" {
+		return nil, fmt.Errorf("%s must be set when %s is set", proxy, noProxy)
+	}
+
+	for i, host := range noProxyHosts {
+		if host == "" || strings.ContainsAny(host, ":@ ") {
+			return nil, fmt.Errorf("invalid %s: %s is not a host name, IP address or CIDR range", noProxy, host)
+		}
+		noProxyHosts[i] = strings.ToLower(host)
+	}
+
+	daemonMode, err := flagSet.GetBool(daemon)
+	if err != nil {
+		return nil, err
+	}
+
+	attachMode, err := flagSet.GetBool(attach)
+	if err != nil {
+		return nil, err
+	}
+
+	if daemonMode && attachMode {
+		return nil, fmt.Errorf("%s cannot be used with %s", attach, daemon)
+	}
+
+	daemonSocket, err := flagSet.GetString(daemonSocket)
+	if err != nil {
+		return nil, err
+	}
+
+	instanceName, err := flagSet.GetString(instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if instanceName != "" && (len(instanceName) > maxInstanceIDLength || !instanceIDPattern.MatchString(instanceName)) {
+		return nil, fmt.Errorf("invalid %s: %s", instanceID, instanceName)
+	}
+
+	instancePerFolderMode, err := flagSet.GetBool(instancePerFolder)
+	if err != nil {
+		return nil, err
+	}
+
+	if instancePerFolderMode {
+		if instanceName != "" {
+			return nil, fmt.Errorf("%s cannot be used with %s", instancePerFolder, instanceID)
+		}
+		if preferredMATLABStartingDirectory == "" {
+			return nil, fmt.Errorf("%s requires initial-working-folder", instancePerFolder)
+		}
+		instanceName = folderInstanceID(preferredMATLABStartingDirectory)
+	}
+
+	transportName, err := flagSet.GetString(transport)
+	if err != nil {
+		return nil, err
+	}
+
+	listenAddress, err := flagSet.GetString(listen)
+	if err != nil {
+		return nil, err
+	}
+
+	// Bare invocations keep serving on the standard input and output, as configured in existing clients.
+	if !serveMode && (flagSet.Changed(transport) || flagSet.Changed(listen)) {
+		return nil, fmt.Errorf("%s and %s can only be used with the %s command", transport, listen, serveCommand)
+	}
+
+	serveTransport := entities.Transport(strings.ToLower(strings.TrimSpace(transportName)))
+	if !slices.Contains(entities.ServeTransports, serveTransport) {
+		return nil, fmt.Errorf("invalid transport: %s", transportName)
+	}
+
+	if serveTransport == entities.TransportStdio {
+		if listenAddress != "" {
+			return nil, fmt.Errorf("%s cannot be used with the %s transport", listen, serveTransport)
+		}
+	} else {
+		if listenAddress == "" {
+			return nil, fmt.Errorf("the %s transport needs an address set by %s", serveTransport, listen)
+		}
+		if err := validateLoopbackAddress(listenAddress); err != nil {
+			return nil, fmt.Errorf("invalid listen address: %w", err)
+		}
+		if daemonMode || attachMode {
+			return nil, fmt.Errorf("%s and %s cannot be used with the %s transport", daemon, attach, serveTransport)
+		}
+	}
+
+	restAPIMode, err := flagSet.GetBool(restAPI)
+	if err != nil {
+		return nil, err
+	}
+
+	if restAPIMode && serveTransport != entities.TransportHTTP {
+		return nil, fmt.Errorf("%s can only be used with the %s command and the %s transport", restAPI, serveCommand, entities.TransportHTTP)
+	}
+
+	grpcMode, err := flagSet.GetBool(grpc)
+	if err != nil {
+		return nil, err
+	}
+
+	if grpcMode && serveTransport != entities.TransportHTTP {
+		return nil, fmt.Errorf("%s can only be used with the %s command and the %s transport", grpc, serveCommand, entities.TransportHTTP)
+	}
+
+	wsPingInterval, err := flagSet.GetDuration(wsPingInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	if wsPingInterval < 0 {
+		return nil, fmt.Errorf("invalid WebSocket ping interval: %s", wsPingInterval)
+	}
+
+	authTokensFilePath, err := flagSet.GetString(authTokensFile)
 	if err != nil {
 		return nil, err
 	}
 
+	if authTokensFilePath != "" {
+		if serveTransport == entities.TransportStdio {
+			return nil, fmt.Errorf("%s can only be used with the %s command and the %s or %s transport", authTokensFile, serveCommand, entities.TransportHTTP, entities.TransportWebSocket)
+		}
+		if !filepath.IsAbs(authTokensFilePath) {
+			return nil, fmt.Errorf("invalid %s: %s is not an absolute path", authTokensFile, authTokensFilePath)
+		}
+	}
+
+	// A daemon has no client on its standard input and output, to test it, use attach.
+	if selfTestMode && daemonMode {
+		return nil, fmt.Errorf("%s cannot be used with the %s command, use %s to test a daemon", daemon, selfTestCommand, attach)
+	}
+
+	// A kernel connects to a running daemon, and never runs MATLAB itself.
+	if kernelMode && (daemonMode || attachMode) {
+		return nil, fmt.Errorf("%s and %s cannot be used with the %s command", daemon, attach, kernelCommand)
+	}
+
+	// A service has no client on its standard input and output.
+	if serviceAction == entities.ServiceActionInstall {
+		if attachMode {
+			return nil, fmt.Errorf("%s cannot be used with the %s command", attach, serviceCommand)
+		}
+		if !daemonMode && serveTransport == entities.TransportStdio {
+			return nil, fmt.Errorf("the %s command runs the server in the background, with %s, or with the %s command and the %s or %s transport", serviceCommand, daemon, serveCommand, entities.TransportHTTP, entities.TransportWebSocket)
+		}
+	}
+
 	watchdogMode, err := flagSet.GetBool(watchdogMode)
 	if err != nil {
 		return nil, err
@@ -119,12 +1892,295 @@ func createConfigWithFlagValues(osLayer OSLayer, flagSet *pflag.FlagSet, args []
 	return &Config{
 		osLayer: osLayer,
 
+		statusMode:                       statusMode,
+		statusEvents:                     statusEvents,
+		doctorMode:                       doctorMode,
+		doctorFix:                        doctorFix,
+		logsMode:                         logsMode,
+		logsFollow:                       logsFollow,
+		logsLevel:                        logsLevel,
+		cleanupMode:                      cleanupMode,
+		cleanupOlderThan:                 cleanupOlderThan,
+		stopMode:                         stopMode,
+		serviceMode:                      serviceMode,
+		serviceAction:                    serviceAction,
+		serviceServerArgs:                serviceServerArgs,
+		telemetryPreviewMode:             telemetryPreviewMode,
+		replayMode:                       replayMode,
+		replayRecording:                  replayRecording,
+		replayServerArgs:                 replayServerArgs,
+		replMode:                         replMode,
+		replServerArgs:                   replServerArgs,
+		pipelineMode:                     pipelineMode,
+		pipelineScript:                   pipelineScript,
+		pipelineServerArgs:               pipelineServerArgs,
+		selfTestMode:                     selfTestMode,
+		selfTestServerArgs:               selfTestServerArgs,
+		kernelMode:                       kernelMode,
+		kernelConnectionFile:             kernelConnectionFile,
+		installMode:                      installMode,
+		uninstallMode:                    uninstallMode,
+		installClients:                   installClients,
+		installServerArgs:                installServerArgs,
 		versionMode:                      versionMode,
 		disableTelemetry:                 disableTelemetry,
+		enableTelemetry:                  enableTelemetry,
+		telemetryEndpoint:                telemetryEndpoint,
 		useSingleMATLABSession:           useSingleMATLABSession,
 		logLevel:                         entities.LogLevel(logLevel),
-		preferredLocalMATLABRoot:         preferredLocalMATLABRoot,
+		logFile:                          logFilePath,
+		logComponentLevels:               logComponentLevels,
+		logLevelsByComponent:             logLevelsByComponent,
+		logMaxSizeMB:                     logMaxSizeMB,
+		logMaxAge:                        logMaxAge,
+		logMaxFiles:                      logMaxFiles,
+		quiet:                            quietMode,
+		verbose:                          verboseMode,
+		locale:                           userLocale,
+		preferredLocalMATLABRoot:         localMATLABRoot,
+		matlabRelease:                    localMATLABRelease,
+		sharedMATLABSessionFile:          sharedMATLABSessionFile,
 		preferredMATLABStartingDirectory: preferredMATLABStartingDirectory,
+		showMATLABDesktop:                showMATLABDesktop,
+		slowCallThreshold:                slowCallThreshold,
+		maxEvalTime:                      maxEvalTime,
+		maxOutputBytes:                   maxOutputBytes,
+		maxFigures:                       maxFigures,
+		workerPoolSize:                   workerPoolSize,
+		streamOutputChunkSize:            streamOutputChunkSize,
+		streamNotificationRate:           streamNotificationRate,
+		progressInterval:                 progressInterval,
+		maxResponseBytes:                 maxResponseBytes,
+		maxResponseBytesByTransport:      maxResponseBytesByTransport,
+		oversizeResponse:                 entities.OversizeResponse(oversizeResponse),
+		variableBinaryThreshold:          variableBinaryThreshold,
+		variablePreviewThreshold:         variablePreviewThreshold,
+		maxVariablePayload:               maxVariablePayload,
+		maxArtifacts:                     maxArtifacts,
+		maxArtifactsMB:                   maxArtifactsMB,
+		lookupCacheTTL:                   lookupCacheTTL,
+		figureResolution:                 figureResolution,
+		workspaceDiff:                    workspaceDiff,
+		memoryWarningMB:                  memoryWarningMB,
+		memoryRestartMB:                  memoryRestartMB,
+		memoryMitigation:                 memoryMitigation,
+		memoryCheckInterval:              memoryCheckInterval,
+		healthCheckInterval:              healthCheckInterval,
+		jobPollInterval:                  jobPollInterval,
+		rateLimit:                        rateLimit,
+		rateLimitBurst:                   rateLimitBurst,
+		maxConcurrentCalls:               maxConcurrentCalls,
+		debugListenAddress:               debugListenAddress,
+		metricsListenAddress:             metricsListenAddress,
+		sandbox:                          sandbox,
+		allowedFunctions:                 allowedFunctions,
+		deniedFunctions:                  deniedFunctions,
+		readOnly:                         readOnly,
+		dryRun:                           dryRun,
+		redactOutput:                     redactOutput,
+		redactionPatterns:                redactionPatterns,
+		requireApproval:                  requireApproval,
+		configFile:                       configFilePath,
+		file:                             file,
+		fileSettings:                     fileSettings,
+		policyFile:                       policyFile,
+		disabledTools:                    disabledTools,
+		restrictFileAccess:               restrictFileAccess,
+		allowedFolders:                   allowedFolders,
+		blockNetwork:                     blockNetwork,
+		allowedHosts:                     allowedHosts,
+		recordSessionFolder:              recordSession,
+		auditLogFile:                     auditLogFile,
+		auditLogMaxMB:                    auditLogMaxMB,
+		recordMATLABFile:                 recordMATLABFile,
+		replayMATLABFile:                 replayMATLABFile,
+		matlabDriveFolder:                matlabDriveFolder,
+		realTimeTargets:                  realTimeTargets,
+		productionServerURL:              productionServerURL,
+		productionServerDeployFolder:     productionServerDeployFolder,
+		eventWebhooks:                    eventWebhooks,
+		eventSocket:                      eventSocketPath,
+		plugins:                          plugins,
+		downstreamServersFile:            downstreamServersFile,
+		encryptAtRest:                    encryptAtRest,
+		strictTLS:                        strictTLS,
+		matlabClientCertificateFile:      matlabClientCertificateFile,
+		matlabClientKeyFile:              matlabClientKeyFile,
+		proxyURL:                         proxyURL,
+		noProxyHosts:                     noProxyHosts,
+		daemonMode:                       daemonMode,
+		attachMode:                       attachMode,
+		daemonSocket:                     daemonSocket,
+		instanceID:                       instanceName,
+		instancePerFolder:                instancePerFolderMode,
+		serveTransport:                   serveTransport,
+		completionMode:                   completionMode,
+		completionShell:                  completionShell,
+		completeMATLABRoot:               completeMATLABRoot,
+		cliFlags:                         cliFlags,
+		listenAddress:                    listenAddress,
+		restAPI:                          restAPIMode,
+		grpc:                             grpcMode,
+		wsPingInterval:                   wsPingInterval,
+		authTokensFile:                   authTokensFilePath,
 		watchdogMode:                     watchdogMode,
 	}, nil
 }
+
+// newCLIFlag describes flag for the shell completions, with the first sentence of its usage as description.
+func newCLIFlag(flag *pflag.Flag) entities.CLIFlag {
+	description, _, _ := strings.Cut(flag.Usage, ". ")
+	cliFlag := entities.CLIFlag{
+		Name:        flag.Name,
+		Description: strings.TrimSuffix(description, "."),
+		Completion:  entities.CLICompletionText,
+		Repeatable:  strings.HasSuffix(flag.Value.Type(), "Slice"),
+	}
+
+	if values, ok := flagValues[flag.Name]; ok {
+		cliFlag.Completion = entities.CLICompletionValues
+		cliFlag.Values = values
+	} else if completion, ok := flagCompletions[flag.Name]; ok {
+		cliFlag.Completion = completion
+	} else if flag.Value.Type() == "bool" {
+		cliFlag.Completion = entities.CLICompletionNone
+	}
+
+	return cliFlag
+}
+
+// parseMaxResponseBytes parses the response size limits, each either a number of bytes for every transport,
+// stored under the empty transport, or TRANSPORT=BYTES for one transport.
+func parseMaxResponseBytes(values []string) (map[entities.Transport]int, error) {
+	limits := make(map[entities.Transport]int, len(values))
+	for _, value := range values {
+		var transport entities.Transport
+		bytes := value
+		if name, limit, found := strings.Cut(value, "="); found {
+			transport = entities.Transport(strings.ToLower(strings.TrimSpace(name)))
+			bytes = limit
+			if !slices.Contains([]entities.Transport{entities.TransportStdio, entities.TransportDaemon, entities.TransportHTTP, entities.TransportWebSocket}, transport) {
+				return nil, fmt.Errorf("invalid max response bytes: %s is not a transport", name)
+			}
+		}
+
+		limit, err := strconv.Atoi(strings.TrimSpace(bytes))
+		if err != nil || limit < 0 {
+			return nil, fmt.Errorf("invalid max response bytes: %s", value)
+		}
+		limits[transport] = limit
+	}
+	return limits, nil
+}
+
+// parseLogComponentLevels parses the log levels of components, each COMPONENT=LEVEL.
+func parseLogComponentLevels(values []string) (map[string]entities.LogLevel, error) {
+	levels := make(map[string]entities.LogLevel, len(values))
+	for _, value := range values {
+		component, level, found := strings.Cut(value, "=")
+		component = strings.TrimSpace(component)
+		level = strings.ToLower(strings.TrimSpace(level))
+		if !found || component == "" {
+			return nil, fmt.Errorf("invalid log component level: %s", value)
+		}
+		if !slices.Contains(logLevelsByDetail, entities.LogLevel(level)) {
+			return nil, fmt.Errorf("invalid log component level: %s is not a log level", level)
+		}
+		levels[component] = entities.LogLevel(level)
+	}
+	return levels, nil
+}
+
+// withoutPositionalArgs returns the arguments without the first occurrence of each of the positional arguments, in order.
+func withoutPositionalArgs(args []string, positionalArgs ...string) []string {
+	remaining := []string{}
+	for _, arg := range args {
+		if len(positionalArgs) > 0 && arg == positionalArgs[0] {
+			positionalArgs = positionalArgs[1:]
+			continue
+		}
+		remaining = append(remaining, arg)
+	}
+	return remaining
+}
+
+// withoutFlag removes a flag taking a value from the arguments, whether its value is in the same argument or the next.
+func withoutFlag(args []string, name string) []string {
+	remaining := []string{}
+	for i := 0; i < len(args); i++ {
+		switch arg := args[i]; {
+		case arg == "--"+name:
+			i++
+		case strings.HasPrefix(arg, "--"+name+"="):
+		default:
+			remaining = append(remaining, arg)
+		}
+	}
+	return remaining
+}
+
+func validateTelemetryEndpoint(endpoint string) error {
+	if endpoint == "" {
+		return fmt.Errorf("%s must be set when %s is set", telemetryEndpoint, enableTelemetry)
+	}
+
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return err
+	}
+
+	if (endpointURL.Scheme != "https" && endpointURL.Scheme != "http") || endpointURL.Host == "" {
+		return fmt.Errorf("%s is not an HTTP(S) URL", endpoint)
+	}
+
+	return nil
+}
+
+func validateWebhook(webhook string) error {
+	webhookURL, err := url.Parse(webhook)
+	if err != nil {
+		return err
+	}
+
+	if (webhookURL.Scheme != "https" && webhookURL.Scheme != "http") || webhookURL.Host == "" {
+		return fmt.Errorf("%s is not an HTTP(S) URL", webhook)
+	}
+
+	return nil
+}
+
+func validateLoopbackAddress(address string) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	if host == "localhost" {
+		return nil
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || !ip.IsLoopback() {
+		return fmt.Errorf("%s is not a loopback address", host)
+	}
+
+	return nil
+}
+
+// maxInstanceIDLength bounds the name of an instance, as it is part of the names of the lock file and of the socket of the daemon.
+const maxInstanceIDLength = 32
+
+var instanceIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// matlabReleasePattern matches the names of the MATLAB releases, such as R2025a, in any case.
+var matlabReleasePattern = regexp.MustCompile(`^[Rr]\d{4}[ABab]$`)
+
+// functionNamePattern matches the names of MATLAB functions, with their package, such as matlab.net.http.RequestMessage.
+var functionNamePattern = regexp.MustCompile(`^[A-Za-z]\w*(\.[A-Za-z]\w*)*$`)
+
+// folderInstanceID names the instance of a folder after a hash of its path, so that the name is a valid file name
+// whatever the path.
+func folderInstanceID(folder string) string {
+	hash := sha256.Sum256([]byte(filepath.Clean(folder)))
+	return "folder-" + hex.EncodeToString(hash[:8])
+}
@@ -0,0 +1,123 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package config
+
+import (
+	"slices"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/managedpolicy"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+type ManagedPolicy interface {
+	Path() string
+	Settings() (managedpolicy.Settings, bool)
+}
+
+// logLevelsByDetail lists the log levels from the most detailed to the least detailed.
+var logLevelsByDetail = []entities.LogLevel{
+	entities.LogLevelDebug,
+	entities.LogLevelInfo,
+	entities.LogLevelWarn,
+	entities.LogLevelError,
+}
+
+// NewWithManagedPolicy creates the configuration from the command line and the config file, and enforces the managed policy over it, if there is one.
+// For every setting, the stricter of the local configuration and the managed policy wins, so local configuration cannot weaken the managed policy.
+func NewWithManagedPolicy(
+	osLayer OSLayer,
+	managedPolicy ManagedPolicy,
+	file ConfigFile,
+) (*Config, error) {
+	config, err := NewWithConfigFile(osLayer, file)
+	if err != nil {
+		return nil, err
+	}
+
+	config.managedPolicy = managedPolicy
+	if settings, ok := managedPolicy.Settings(); ok {
+		config.enforceManagedPolicy(managedPolicy.Path(), settings)
+	}
+
+	return config, nil
+}
+
+// ManagedPolicyFile is the path of the managed policy enforced over the command line, or empty when there is none.
+func (c *Config) ManagedPolicyFile() string {
+	return c.managedPolicyFile
+}
+
+// ManagedToolPolicy is the tool policy document of the managed policy, or nil when there is none.
+// It is evaluated in addition to the policy file, and the stricter decision of the two wins.
+func (c *Config) ManagedToolPolicy() []byte {
+	return c.managedToolPolicy
+}
+
+func (c *Config) enforceManagedPolicy(path string, settings managedpolicy.Settings) {
+	c.managedPolicyFile = path
+	c.managedToolPolicy = settings.ToolPolicy
+
+	c.disableTelemetry = c.disableTelemetry || settings.DisableTelemetry
+	c.sandbox = c.sandbox || settings.Sandbox
+	c.readOnly = c.readOnly || settings.ReadOnly
+	c.requireApproval = c.requireApproval || settings.RequireApproval
+	c.redactOutput = c.redactOutput || settings.RedactOutput
+	c.restrictFileAccess = c.restrictFileAccess || settings.RestrictFileAccess
+	c.encryptAtRest = c.encryptAtRest || settings.EncryptAtRest
+	c.strictTLS = c.strictTLS || settings.StrictTLS
+
+	for _, pattern := range settings.RedactionPatterns {
+		if !slices.Contains(c.redactionPatterns, pattern) {
+			c.redactionPatterns = append(c.redactionPatterns, pattern)
+		}
+	}
+
+	// The folders allowed by the managed policy replace the local ones, as adding folders would weaken it.
+	if len(settings.AllowedFolders) > 0 {
+		c.allowedFolders = settings.AllowedFolders
+	}
+
+	// Functions allowed locally would weaken a managed sandbox, so the managed functions replace them.
+	if settings.Sandbox {
+		c.allowedFunctions = settings.AllowedFunctions
+	}
+	for _, function := range settings.DeniedFunctions {
+		if !slices.Contains(c.deniedFunctions, function) {
+			c.deniedFunctions = append(c.deniedFunctions, function)
+		}
+	}
+
+	// Hosts allowed locally would weaken a managed network block, so the managed hosts replace them.
+	if settings.BlockNetwork {
+		c.blockNetwork = true
+		c.allowedHosts = settings.AllowedHosts
+	}
+
+	c.maxEvalTime = stricterLimit(c.maxEvalTime, settings.MaxEvalTime)
+	c.maxOutputBytes = stricterLimit(c.maxOutputBytes, settings.MaxOutputBytes)
+	c.maxFigures = stricterLimit(c.maxFigures, settings.MaxFigures)
+	c.rateLimit = stricterLimit(c.rateLimit, settings.RateLimit)
+	c.rateLimitBurst = stricterLimit(c.rateLimitBurst, settings.RateLimitBurst)
+	c.maxConcurrentCalls = stricterLimit(c.maxConcurrentCalls, settings.MaxConcurrentCalls)
+
+	if settings.LogLevel != "" && slices.Index(logLevelsByDetail, c.logLevel) > slices.Index(logLevelsByDetail, settings.LogLevel) {
+		c.logLevel = settings.LogLevel
+	}
+	for component, level := range c.logLevelsByComponent {
+		if settings.LogLevel != "" && slices.Index(logLevelsByDetail, level) > slices.Index(logLevelsByDetail, settings.LogLevel) {
+			c.logLevelsByComponent[component] = settings.LogLevel
+		}
+	}
+}
+
+// stricterLimit returns the smaller of two limits, where 0 means no limit.
+func stricterLimit[T int | float64 | time.Duration](local, managed T) T {
+	if managed == 0 {
+		return local
+	}
+	if local == 0 {
+		return managed
+	}
+	return min(local, managed)
+}
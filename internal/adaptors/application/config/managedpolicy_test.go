@@ -0,0 +1,194 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package config_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/config"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/managedpolicy"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	configmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWithManagedPolicy_NoManagedPolicy(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockManagedPolicy := &configmocks.MockManagedPolicy{}
+	defer mockManagedPolicy.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return([]string{"testprocess", "--max-figures=5"}).
+		Once()
+
+	mockManagedPolicy.EXPECT().
+		Settings().
+		Return(managedpolicy.Settings{}, false).
+		Once()
+
+	// Act
+	cfg, err := config.NewWithManagedPolicy(mockOSLayer, mockManagedPolicy, nil)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 5, cfg.MaxFigures())
+	assert.Empty(t, cfg.ManagedPolicyFile())
+	assert.Nil(t, cfg.ManagedToolPolicy())
+}
+
+func TestNewWithManagedPolicy_InvalidArgs(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockManagedPolicy := &configmocks.MockManagedPolicy{}
+	defer mockManagedPolicy.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return([]string{"testprocess", "--max-figures=-1"}).
+		Once()
+
+	// Act
+	cfg, err := config.NewWithManagedPolicy(mockOSLayer, mockManagedPolicy, nil)
+
+	// Assert
+	require.ErrorContains(t, err, "invalid max figures")
+	assert.Nil(t, cfg)
+}
+
+func TestNewWithManagedPolicy_EnforcesManagedPolicy(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockManagedPolicy := &configmocks.MockManagedPolicy{}
+	defer mockManagedPolicy.AssertExpectations(t)
+
+	toolPolicy := json.RawMessage(`{"rules":[{"tool":"run_matlab_file","action":"deny"}]}`)
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return([]string{"testprocess",
+			"--log-level=error",
+			"--log-component-level=metrics=warn",
+			"--log-component-level=matlab-supervisor=debug",
+			"--allowed-function=dos",
+			"--denied-function=rmpath",
+			"--enable-telemetry", "--telemetry-endpoint=https://example.com/usage",
+			"--redact-pattern=PAT-[0-9]+",
+			"--allowed-folder=/home/user",
+			"--max-eval-time=10s",
+			"--max-output-bytes=1000000",
+			"--rate-limit=10",
+		}).
+		Once()
+
+	mockManagedPolicy.EXPECT().
+		Settings().
+		Return(managedpolicy.Settings{
+			DisableTelemetry:   true,
+			Sandbox:            true,
+			AllowedFunctions:   []string{"delete"},
+			DeniedFunctions:    []string{"cd", "rmpath"},
+			ReadOnly:           true,
+			RequireApproval:    true,
+			RedactOutput:       true,
+			RedactionPatterns:  []string{"MRN[0-9]{8}"},
+			RestrictFileAccess: true,
+			AllowedFolders:     []string{"/data"},
+			BlockNetwork:       true,
+			AllowedHosts:       []string{"data.example.com"},
+			EncryptAtRest:      true,
+			StrictTLS:          true,
+			MaxEvalTime:        time.Minute,
+			MaxOutputBytes:     65536,
+			MaxFigures:         10,
+			RateLimit:          2,
+			LogLevel:           entities.LogLevelInfo,
+			ToolPolicy:         toolPolicy,
+		}, true).
+		Once()
+
+	mockManagedPolicy.EXPECT().
+		Path().
+		Return("/etc/matlab-mcp-core-server/managed-policy.json").
+		Once()
+
+	// Act
+	cfg, err := config.NewWithManagedPolicy(mockOSLayer, mockManagedPolicy, nil)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "/etc/matlab-mcp-core-server/managed-policy.json", cfg.ManagedPolicyFile())
+	assert.JSONEq(t, string(toolPolicy), string(cfg.ManagedToolPolicy()))
+
+	assert.True(t, cfg.DisableTelemetry())
+	assert.False(t, cfg.TelemetryEnabled(), "Managed policy should override the opt in")
+	assert.True(t, cfg.SandboxEnabled())
+	assert.Equal(t, []string{"delete"}, cfg.AllowedFunctions(), "Managed functions should replace the local ones")
+	assert.Equal(t, []string{"rmpath", "cd"}, cfg.DeniedFunctions())
+	assert.True(t, cfg.ReadOnly())
+	assert.True(t, cfg.RequireApproval())
+	assert.True(t, cfg.RedactOutput())
+	assert.Equal(t, []string{"PAT-[0-9]+", "MRN[0-9]{8}"}, cfg.RedactionPatterns())
+	assert.True(t, cfg.RestrictFileAccess())
+	assert.Equal(t, []string{"/data"}, cfg.AllowedFolders(), "Managed folders should replace the local ones")
+	assert.True(t, cfg.BlockNetwork())
+	assert.Equal(t, []string{"data.example.com"}, cfg.AllowedHosts(), "Managed hosts should replace the local ones")
+	assert.True(t, cfg.EncryptAtRest())
+	assert.True(t, cfg.StrictTLS())
+
+	assert.Equal(t, 10*time.Second, cfg.MaxEvalTime(), "Stricter local limit should be kept")
+	assert.Equal(t, 65536, cfg.MaxOutputBytes(), "Stricter managed limit should win")
+	assert.Equal(t, 10, cfg.MaxFigures(), "Managed limit should apply when there is no local limit")
+	assert.InDelta(t, 2.0, cfg.RateLimit(), 0)
+	assert.Equal(t, 0, cfg.MaxConcurrentCalls(), "Limits unset in both should stay unlimited")
+
+	assert.Equal(t, entities.LogLevelInfo, cfg.LogLevel(), "Local log level should not be less detailed than the managed one")
+	assert.Equal(t, map[string]entities.LogLevel{"metrics": entities.LogLevelInfo, "matlab-supervisor": entities.LogLevelDebug}, cfg.LogComponentLevels(), "Local component log levels should not be less detailed than the managed one")
+}
+
+func TestNewWithManagedPolicy_LocalSettingsCanBeStricter(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockManagedPolicy := &configmocks.MockManagedPolicy{}
+	defer mockManagedPolicy.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return([]string{"testprocess", "--log-level=debug", "--sandbox", "--allowed-folder=/home/user", "--block-network", "--allowed-host=example.com"}).
+		Once()
+
+	mockManagedPolicy.EXPECT().
+		Settings().
+		Return(managedpolicy.Settings{
+			LogLevel: entities.LogLevelWarn,
+		}, true).
+		Once()
+
+	mockManagedPolicy.EXPECT().
+		Path().
+		Return("/etc/matlab-mcp-core-server/managed-policy.json").
+		Once()
+
+	// Act
+	cfg, err := config.NewWithManagedPolicy(mockOSLayer, mockManagedPolicy, nil)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, entities.LogLevelDebug, cfg.LogLevel())
+	assert.True(t, cfg.SandboxEnabled())
+	assert.Equal(t, []string{"/home/user"}, cfg.AllowedFolders())
+	assert.True(t, cfg.BlockNetwork())
+	assert.Equal(t, []string{"example.com"}, cfg.AllowedHosts())
+}
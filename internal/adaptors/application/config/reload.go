@@ -0,0 +1,80 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package config
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// reloadableSettings are the settings which can change while the server runs, without restarting the server or the
+// MATLAB session it manages. The other settings only take effect once the server restarts.
+var reloadableSettings = []string{
+	logLevel,
+	logComponentLevel,
+	maxEvalTime,
+	slowCallThreshold,
+	policyFile,
+	disableTool,
+}
+
+// Reload reads the command line and the config file again, enforces the managed policy over them, if there is one,
+// and applies the changes of the reloadable settings. It returns the settings it changed, and the settings changed in
+// the config file which only take effect once the server restarts. When the configuration is not valid anymore, no
+// setting changes, and the error is returned.
+func (c *Config) Reload() (entities.ConfigChanges, error) {
+	reloaded, err := newConfig(c.osLayer, c.file)
+	if err != nil {
+		return entities.ConfigChanges{}, err
+	}
+
+	if c.managedPolicy != nil {
+		if settings, ok := c.managedPolicy.Settings(); ok {
+			reloaded.enforceManagedPolicy(c.managedPolicy.Path(), settings)
+		}
+	}
+
+	c.reloadLock.Lock()
+	defer c.reloadLock.Unlock()
+
+	var changes entities.ConfigChanges
+	if reloaded.logLevel != c.logLevel {
+		c.logLevel = reloaded.logLevel
+		changes.Applied = append(changes.Applied, logLevel)
+	}
+	if !maps.Equal(reloaded.logLevelsByComponent, c.logLevelsByComponent) {
+		c.logComponentLevels = reloaded.logComponentLevels
+		c.logLevelsByComponent = reloaded.logLevelsByComponent
+		changes.Applied = append(changes.Applied, logComponentLevel)
+	}
+	if reloaded.maxEvalTime != c.maxEvalTime {
+		c.maxEvalTime = reloaded.maxEvalTime
+		changes.Applied = append(changes.Applied, maxEvalTime)
+	}
+	if reloaded.slowCallThreshold != c.slowCallThreshold {
+		c.slowCallThreshold = reloaded.slowCallThreshold
+		changes.Applied = append(changes.Applied, slowCallThreshold)
+	}
+	if reloaded.policyFile != c.policyFile {
+		c.policyFile = reloaded.policyFile
+		changes.Applied = append(changes.Applied, policyFile)
+	}
+	if !slices.Equal(reloaded.disabledTools, c.disabledTools) {
+		c.disabledTools = reloaded.disabledTools
+		changes.Applied = append(changes.Applied, disableTool)
+	}
+
+	names := slices.Concat(slices.Collect(maps.Keys(c.fileSettings)), slices.Collect(maps.Keys(reloaded.fileSettings)))
+	slices.Sort(names)
+	for _, name := range slices.Compact(names) {
+		if slices.Contains(reloadableSettings, name) || slices.Equal(c.fileSettings[name], reloaded.fileSettings[name]) {
+			continue
+		}
+		changes.RequiringRestart = append(changes.RequiringRestart, name)
+	}
+	c.fileSettings = reloaded.fileSettings
+
+	return changes, nil
+}
@@ -0,0 +1,205 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/config"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/managedpolicy"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	configmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_Reload_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfigFile := &configmocks.MockConfigFile{}
+	defer mockConfigFile.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return([]string{"testprocess"}).
+		Twice()
+
+	mockConfigFile.EXPECT().
+		DefaultPath().
+		Return(defaultConfigFilePath).
+		Twice()
+
+	mockConfigFile.EXPECT().
+		Read(defaultConfigFilePath).
+		Return(map[string][]string{
+			"log-level":     {"info"},
+			"max-eval-time": {"1m"},
+			"read-only":     {"true"},
+			"max-figures":   {"5"},
+		}, true, nil).
+		Once()
+
+	mockConfigFile.EXPECT().
+		Read(defaultConfigFilePath).
+		Return(map[string][]string{
+			"log-level":     {"debug"},
+			"max-eval-time": {"2m"},
+			"policy-file":   {"/home/user/policy.json"},
+			"disable-tool":  {"evaluate_matlab_code", "run_matlab_file"},
+			"max-figures":   {"5"},
+		}, true, nil).
+		Once()
+
+	cfg, err := config.NewWithConfigFile(mockOSLayer, mockConfigFile)
+	require.NoError(t, err)
+
+	// Act
+	changes, err := cfg.Reload()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"log-level", "max-eval-time", "policy-file", "disable-tool"}, changes.Applied)
+	assert.Equal(t, []string{"read-only"}, changes.RequiringRestart)
+	assert.Equal(t, entities.LogLevelDebug, cfg.LogLevel())
+	assert.Equal(t, 2*time.Minute, cfg.MaxEvalTime())
+	assert.Equal(t, "/home/user/policy.json", cfg.PolicyFile())
+	assert.Equal(t, []string{"evaluate_matlab_code", "run_matlab_file"}, cfg.DisabledTools())
+	assert.True(t, cfg.ReadOnly(), "Settings requiring a restart should keep their value")
+}
+
+func TestConfig_Reload_NoChange(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfigFile := &configmocks.MockConfigFile{}
+	defer mockConfigFile.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return([]string{"testprocess", "--log-level=warn"}).
+		Twice()
+
+	mockConfigFile.EXPECT().
+		DefaultPath().
+		Return(defaultConfigFilePath).
+		Twice()
+
+	mockConfigFile.EXPECT().
+		Read(defaultConfigFilePath).
+		Return(map[string][]string{"log-level": {"info"}}, true, nil).
+		Once()
+
+	mockConfigFile.EXPECT().
+		Read(defaultConfigFilePath).
+		Return(map[string][]string{"log-level": {"debug"}}, true, nil).
+		Once()
+
+	cfg, err := config.NewWithConfigFile(mockOSLayer, mockConfigFile)
+	require.NoError(t, err)
+
+	// Act
+	changes, err := cfg.Reload()
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, changes.Empty(), "The command line should take precedence over the reloaded config file")
+	assert.Equal(t, entities.LogLevelWarn, cfg.LogLevel())
+}
+
+func TestConfig_Reload_InvalidConfigFileKeepsSettings(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfigFile := &configmocks.MockConfigFile{}
+	defer mockConfigFile.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return([]string{"testprocess"}).
+		Twice()
+
+	mockConfigFile.EXPECT().
+		DefaultPath().
+		Return(defaultConfigFilePath).
+		Twice()
+
+	mockConfigFile.EXPECT().
+		Read(defaultConfigFilePath).
+		Return(map[string][]string{"max-eval-time": {"1m"}}, true, nil).
+		Once()
+
+	mockConfigFile.EXPECT().
+		Read(defaultConfigFilePath).
+		Return(map[string][]string{"max-eval-time": {"soon"}}, true, nil).
+		Once()
+
+	cfg, err := config.NewWithConfigFile(mockOSLayer, mockConfigFile)
+	require.NoError(t, err)
+
+	// Act
+	changes, err := cfg.Reload()
+
+	// Assert
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "max-eval-time")
+	assert.True(t, changes.Empty())
+	assert.Equal(t, time.Minute, cfg.MaxEvalTime(), "The settings in effect should be kept")
+}
+
+func TestConfig_Reload_EnforcesManagedPolicy(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockManagedPolicy := &configmocks.MockManagedPolicy{}
+	defer mockManagedPolicy.AssertExpectations(t)
+
+	mockConfigFile := &configmocks.MockConfigFile{}
+	defer mockConfigFile.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return([]string{"testprocess"}).
+		Twice()
+
+	mockManagedPolicy.EXPECT().
+		Path().
+		Return("/etc/matlab-mcp/policy.yaml").
+		Twice()
+
+	mockManagedPolicy.EXPECT().
+		Settings().
+		Return(managedpolicy.Settings{MaxEvalTime: time.Minute}, true).
+		Twice()
+
+	mockConfigFile.EXPECT().
+		DefaultPath().
+		Return(defaultConfigFilePath).
+		Twice()
+
+	mockConfigFile.EXPECT().
+		Read(defaultConfigFilePath).
+		Return(map[string][]string{"max-eval-time": {"30s"}}, true, nil).
+		Once()
+
+	mockConfigFile.EXPECT().
+		Read(defaultConfigFilePath).
+		Return(map[string][]string{"max-eval-time": {"10m"}}, true, nil).
+		Once()
+
+	cfg, err := config.NewWithManagedPolicy(mockOSLayer, mockManagedPolicy, mockConfigFile)
+	require.NoError(t, err)
+
+	// Act
+	changes, err := cfg.Reload()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"max-eval-time"}, changes.Applied)
+	assert.Equal(t, time.Minute, cfg.MaxEvalTime(), "A reload should not weaken the managed policy")
+}
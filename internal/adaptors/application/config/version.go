@@ -6,9 +6,16 @@ package config
 var (
 	// version is the semantic Version of the application
 	version = "(devel)" // DO NOT USE `version = unsetVersion`, it won't work as expected
+	// commit is the git commit the application was built from
+	commit = ""
+	// buildDate is when the application was built, in RFC 3339 format
+	buildDate = ""
 )
 
 const (
 	// unsetVersion is used as a default version if no explicit version is set during build
 	unsetVersion = "(devel)"
+
+	// unknownBuildInfo is used for the build metadata that is neither set during build, nor recorded by the Go toolchain
+	unknownBuildInfo = "unknown"
 )
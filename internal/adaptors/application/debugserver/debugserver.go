@@ -0,0 +1,148 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package debugserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+const shutdownTimeout = 5 * time.Second
+
+type Config interface {
+	DebugListenAddress() string
+}
+
+type LoggerFactory interface {
+	GetGlobalLogger() entities.Logger
+}
+
+type LifecycleSignaler interface {
+	AddShutdownFunction(shutdownFcn func() error)
+}
+
+// DebugServer exposes profiling and runtime information about the MCP server process on a loopback-only listener.
+// It is only started when a debug listen address is configured.
+type DebugServer struct {
+	config            Config
+	logger            entities.Logger
+	lifecycleSignaler LifecycleSignaler
+	startedAt         time.Time
+
+	listener net.Listener
+}
+
+func New(
+	config Config,
+	loggerFactory LoggerFactory,
+	lifecycleSignaler LifecycleSignaler,
+) *DebugServer {
+	return &DebugServer{
+		config:            config,
+		logger:            loggerFactory.GetGlobalLogger().With("component", "debug-server"),
+		lifecycleSignaler: lifecycleSignaler,
+		startedAt:         time.Now(),
+	}
+}
+
+func (d *DebugServer) Start() error {
+	address := d.config.DebugListenAddress()
+	if address == "" {
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+	d.listener = listener
+
+	httpServer := &http.Server{
+		Handler:           d.handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	logger := d.logger.With("address", listener.Addr().String())
+
+	go func() {
+		if err := httpServer.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.WithError(err).Warn("Debug server stopped unexpectedly")
+		}
+	}()
+
+	d.lifecycleSignaler.AddShutdownFunction(func() error {
+		logger.Debug("Stopping debug server")
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		return httpServer.Shutdown(ctx)
+	})
+
+	logger.Info("Debug server listening")
+	return nil
+}
+
+// Addr returns the address the debug server is listening on, or nil if it was not started.
+func (d *DebugServer) Addr() net.Addr {
+	if d.listener == nil {
+		return nil
+	}
+	return d.listener.Addr()
+}
+
+func (d *DebugServer) handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+
+	mux.Handle("/debug/vars", expvar.Handler())
+	mux.HandleFunc("/debug/runtime", d.handleRuntime)
+
+	return mux
+}
+
+type runtimeStats struct {
+	UptimeSeconds   float64 `json:"uptime_seconds"`
+	Goroutines      int     `json:"goroutines"`
+	GOMAXPROCS      int     `json:"gomaxprocs"`
+	HeapAllocBytes  uint64  `json:"heap_alloc_bytes"`
+	HeapInUseBytes  uint64  `json:"heap_inuse_bytes"`
+	TotalAllocBytes uint64  `json:"total_alloc_bytes"`
+	SysBytes        uint64  `json:"sys_bytes"`
+	NumGC           uint32  `json:"num_gc"`
+	PauseTotalNs    uint64  `json:"pause_total_ns"`
+}
+
+func (d *DebugServer) handleRuntime(responseWriter http.ResponseWriter, _ *http.Request) {
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+
+	stats := runtimeStats{
+		UptimeSeconds:   time.Since(d.startedAt).Seconds(),
+		Goroutines:      runtime.NumGoroutine(),
+		GOMAXPROCS:      runtime.GOMAXPROCS(0),
+		HeapAllocBytes:  memStats.HeapAlloc,
+		HeapInUseBytes:  memStats.HeapInuse,
+		TotalAllocBytes: memStats.TotalAlloc,
+		SysBytes:        memStats.Sys,
+		NumGC:           memStats.NumGC,
+		PauseTotalNs:    memStats.PauseTotalNs,
+	}
+
+	responseWriter.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(responseWriter).Encode(stats); err != nil {
+		d.logger.WithError(err).Warn("Failed to write runtime stats")
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package debugserver_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/debugserver"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/debugserver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	// Act
+	debugServer := debugserver.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler)
+
+	// Assert
+	assert.NotNil(t, debugServer)
+}
+
+func TestDebugServer_Start_DisabledWhenNoAddress(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	mockConfig.EXPECT().
+		DebugListenAddress().
+		Return("").
+		Once()
+
+	debugServer := debugserver.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler)
+
+	// Act
+	err := debugServer.Start()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Nil(t, debugServer.Addr())
+}
+
+func TestDebugServer_Start_ServesDebugEndpoints(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	mockConfig.EXPECT().
+		DebugListenAddress().
+		Return("127.0.0.1:0").
+		Once()
+
+	var shutdownFcn func() error
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.Anything).
+		Run(func(fcn func() error) {
+			shutdownFcn = fcn
+		}).
+		Return().
+		Once()
+
+	debugServer := debugserver.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler)
+
+	// Act
+	err := debugServer.Start()
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, debugServer.Addr())
+	require.NotNil(t, shutdownFcn)
+	defer func() {
+		require.NoError(t, shutdownFcn())
+	}()
+
+	baseURL := "http://" + debugServer.Addr().String()
+
+	runtimeResponse, err := http.Get(baseURL + "/debug/runtime")
+	require.NoError(t, err)
+	defer func() { _ = runtimeResponse.Body.Close() }()
+	assert.Equal(t, http.StatusOK, runtimeResponse.StatusCode)
+
+	var stats map[string]any
+	require.NoError(t, json.NewDecoder(runtimeResponse.Body).Decode(&stats))
+	assert.Contains(t, stats, "goroutines")
+	assert.Contains(t, stats, "heap_alloc_bytes")
+
+	pprofResponse, err := http.Get(baseURL + "/debug/pprof/")
+	require.NoError(t, err)
+	defer func() { _ = pprofResponse.Body.Close() }()
+	assert.Equal(t, http.StatusOK, pprofResponse.StatusCode)
+
+	varsResponse, err := http.Get(baseURL + "/debug/vars")
+	require.NoError(t, err)
+	defer func() { _ = varsResponse.Body.Close() }()
+	assert.Equal(t, http.StatusOK, varsResponse.StatusCode)
+}
+
+func TestDebugServer_Start_ListenErrors(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	mockConfig.EXPECT().
+		DebugListenAddress().
+		Return("127.0.0.1:-1").
+		Once()
+
+	debugServer := debugserver.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler)
+
+	// Act
+	err := debugServer.Start()
+
+	// Assert
+	require.Error(t, err)
+	assert.Nil(t, debugServer.Addr())
+}
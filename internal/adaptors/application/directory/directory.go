@@ -2,10 +2,22 @@
 
 package directory
 
-const defaultLogDirPattern = "matlab-mcp-core-server-"
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// InstanceDirPattern is the pattern of the names of the folders created in the temporary folder by each server instance.
+const InstanceDirPattern = "matlab-mcp-core-server-"
+
+// PIDFileName is the name of the file holding the PID of the process which created the folder,
+// so that the folders left by the processes which are no longer running can be found.
+const PIDFileName = "server.pid"
 
 type OSLayer interface {
 	MkdirTemp(dir string, pattern string) (string, error)
+	WriteFile(name string, data []byte, perm os.FileMode) error
 }
 
 type Directory struct {
@@ -17,7 +29,12 @@ type Directory struct {
 func New(
 	osFacade OSLayer,
 ) (*Directory, error) {
-	logDir, err := osFacade.MkdirTemp("", defaultLogDirPattern)
+	logDir, err := osFacade.MkdirTemp("", InstanceDirPattern)
+	if err != nil {
+		return nil, err
+	}
+
+	err = osFacade.WriteFile(filepath.Join(logDir, PIDFileName), []byte(strconv.Itoa(os.Getpid())), 0o600)
 	if err != nil {
 		return nil, err
 	}
@@ -3,6 +3,9 @@
 package directory_test
 
 import (
+	"os"
+	"path/filepath"
+	"strconv"
 	"testing"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/directory"
@@ -24,6 +27,11 @@ func TestNew_HappyPath(t *testing.T) {
 		Return(expectedLogDir, nil).
 		Once()
 
+	mockOSLayer.EXPECT().
+		WriteFile(filepath.Join(expectedLogDir, "server.pid"), []byte(strconv.Itoa(os.Getpid())), os.FileMode(0o600)).
+		Return(nil).
+		Once()
+
 	// Act
 	directoryInstance, err := directory.New(mockOSLayer)
 
@@ -52,6 +60,32 @@ func TestNew_MkdirTempError(t *testing.T) {
 	assert.Nil(t, directoryInstance, "Directory instance should be nil when error occurs")
 }
 
+func TestNew_WriteFileError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &directorymocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	logDir := "/tmp/matlab-mcp-core-server-12345"
+	expectedError := assert.AnError
+
+	mockOSLayer.EXPECT().
+		MkdirTemp("", mock.AnythingOfType("string")).
+		Return(logDir, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		WriteFile(filepath.Join(logDir, "server.pid"), mock.Anything, os.FileMode(0o600)).
+		Return(expectedError).
+		Once()
+
+	// Act
+	directoryInstance, err := directory.New(mockOSLayer)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "New should return the error from WriteFile")
+	assert.Nil(t, directoryInstance, "Directory instance should be nil when error occurs")
+}
+
 func TestBaseDir_HappyPath(t *testing.T) {
 	// Arrange
 	mockOSLayer := &directorymocks.MockOSLayer{}
@@ -64,6 +98,11 @@ func TestBaseDir_HappyPath(t *testing.T) {
 		Return(expectedLogDir, nil).
 		Once()
 
+	mockOSLayer.EXPECT().
+		WriteFile(filepath.Join(expectedLogDir, "server.pid"), []byte(strconv.Itoa(os.Getpid())), os.FileMode(0o600)).
+		Return(nil).
+		Once()
+
 	directoryInstance, err := directory.New(mockOSLayer)
 	require.NoError(t, err)
 
@@ -88,6 +127,11 @@ func TestMkdirTemp_HappyPath(t *testing.T) {
 		Return(baseLogDir, nil).
 		Once()
 
+	mockOSLayer.EXPECT().
+		WriteFile(filepath.Join(baseLogDir, "server.pid"), []byte(strconv.Itoa(os.Getpid())), os.FileMode(0o600)).
+		Return(nil).
+		Once()
+
 	mockOSLayer.EXPECT().
 		MkdirTemp(baseLogDir, pattern).
 		Return(expectedTempDir, nil).
@@ -118,6 +162,11 @@ func TestMkdirTemp_MkdirTempError(t *testing.T) {
 		Return(baseLogDir, nil).
 		Once()
 
+	mockOSLayer.EXPECT().
+		WriteFile(filepath.Join(baseLogDir, "server.pid"), []byte(strconv.Itoa(os.Getpid())), os.FileMode(0o600)).
+		Return(nil).
+		Once()
+
 	mockOSLayer.EXPECT().
 		MkdirTemp(baseLogDir, pattern).
 		Return("", expectedError).
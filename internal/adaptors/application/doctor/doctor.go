@@ -0,0 +1,568 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package doctor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/directory"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/i18n"
+)
+
+// minimumMATLABRelease is the oldest release of MATLAB the server supports.
+const minimumMATLABRelease = "R2020b"
+
+// licenseExpiryWarning is how long before the expiry of a license it is reported.
+const licenseExpiryWarning = 30 * 24 * time.Hour
+
+// dialTimeout bounds the time to connect to a local port, or to a license server.
+const dialTimeout = 3 * time.Second
+
+type Config interface {
+	PreferredLocalMATLABRoot() string
+	MATLABRelease() string
+	DoctorFix() bool
+	ServeTransport() entities.Transport
+	ListenAddress() string
+	DebugListenAddress() string
+	MetricsListenAddress() string
+}
+
+type LoggerFactory interface {
+	GetGlobalLogger() entities.Logger
+}
+
+type MATLABRootGetter interface {
+	GetAll(logger entities.Logger) []string
+}
+
+type MATLABVersionGetter interface {
+	Get(matlabRootLocation string) (datatypes.MatlabVersionInfo, error)
+}
+
+type InstanceLock interface {
+	Path() string
+	Holder() (int, bool, error)
+	RemoveStale() error
+	IsProcessRunning(pid int) bool
+}
+
+type DaemonSocket interface {
+	Path() string
+	Dial() (net.Conn, error)
+}
+
+type OSLayer interface {
+	Stdout() io.Writer
+	GOOS() string
+	ReadFile(name string) ([]byte, error)
+	TempDir() string
+}
+
+type FileLayer interface {
+	Glob(pattern string) ([]string, error)
+}
+
+type NetworkLayer interface {
+	Listen(network string, address string) (net.Listener, error)
+	DialTimeout(network string, address string, timeout time.Duration) (net.Conn, error)
+}
+
+type Localizer interface {
+	Locale() entities.Locale
+}
+
+type severity string
+
+const (
+	severityOK      severity = "ok"
+	severityWarning severity = "warning"
+	severityProblem severity = "problem"
+)
+
+// finding is the outcome of a check, with a suggestion to fix it, and the fix itself when it is safe to apply automatically.
+type finding struct {
+	check      string
+	severity   severity
+	message    string
+	suggestion string
+	fix        func() error
+	// matlabNotFound is set on the problems that prevent the server from finding a usable MATLAB.
+	matlabNotFound bool
+}
+
+// Doctor checks the setup the MATLAB MCP Core Server depends on: MATLAB and its license, the other server instances
+// and the folders they left behind, the local connections to MATLAB, and the ports the server listens on. It explains the problems found, suggests how to fix them, and applies the fixes
+// that are safe to apply automatically when asked to.
+type Doctor struct {
+	config              Config
+	loggerFactory       LoggerFactory
+	matlabRootGetter    MATLABRootGetter
+	matlabVersionGetter MATLABVersionGetter
+	instanceLock        InstanceLock
+	daemonSocket        DaemonSocket
+	osLayer             OSLayer
+	fileLayer           FileLayer
+	networkLayer        NetworkLayer
+	localizer           Localizer
+}
+
+func New(
+	config Config,
+	loggerFactory LoggerFactory,
+	matlabRootGetter MATLABRootGetter,
+	matlabVersionGetter MATLABVersionGetter,
+	instanceLock InstanceLock,
+	daemonSocket DaemonSocket,
+	osLayer OSLayer,
+	fileLayer FileLayer,
+	networkLayer NetworkLayer,
+	localizer Localizer,
+) *Doctor {
+	return &Doctor{
+		config:              config,
+		loggerFactory:       loggerFactory,
+		matlabRootGetter:    matlabRootGetter,
+		matlabVersionGetter: matlabVersionGetter,
+		instanceLock:        instanceLock,
+		daemonSocket:        daemonSocket,
+		osLayer:             osLayer,
+		fileLayer:           fileLayer,
+		networkLayer:        networkLayer,
+		localizer:           localizer,
+	}
+}
+
+// StartAndWaitForCompletion runs the checks and prints their findings, and fails if any problem is left.
+func (d *Doctor) StartAndWaitForCompletion(_ context.Context) error {
+	logger := d.loggerFactory.GetGlobalLogger()
+	locale := d.localizer.Locale()
+
+	findings := d.checkMATLAB(logger)
+	findings = append(findings, d.checkInstanceLock(), d.checkServerFolders(), d.checkLocalConnections())
+	findings = append(findings, d.checkListenAddresses()...)
+
+	stdout := d.osLayer.Stdout()
+	problems, warnings, fixes := 0, 0, 0
+	matlabNotFound := false
+	for _, f := range findings {
+		if f.fix != nil && d.config.DoctorFix() {
+			if err := f.fix(); err != nil {
+				logger.WithError(err).With("check", f.check).Warn("Failed to apply doctor fix")
+				f.suggestion = fmt.Sprintf("%s The automatic fix failed: %v.", f.suggestion, err)
+			} else {
+				f.severity = severityOK
+				f.message += " Fixed."
+				f.suggestion = ""
+			}
+			f.fix = nil
+		}
+
+		switch f.severity {
+		case severityProblem:
+			problems++
+			matlabNotFound = matlabNotFound || f.matlabNotFound
+		case severityWarning:
+			warnings++
+		}
+		if f.fix != nil {
+			fixes++
+		}
+
+		if _, err := fmt.Fprintf(stdout, "%-9s %s: %s\n", "["+string(f.severity)+"]", f.check, f.message); err != nil {
+			return err
+		}
+		if f.suggestion != "" {
+			if _, err := fmt.Fprintf(stdout, "%-9s %s\n", "", i18n.Translate(locale, i18n.MessageDoctorSuggestedFix, f.suggestion)); err != nil {
+				return err
+			}
+		}
+	}
+
+	summary := "\n" + i18n.Translate(locale, i18n.MessageDoctorNoProblems) + "\n"
+	if problems+warnings > 0 {
+		summary = "\n" + i18n.Translate(locale, i18n.MessageDoctorFound,
+			i18n.TranslateCount(locale, problems, i18n.MessageProblemCount),
+			i18n.TranslateCount(locale, warnings, i18n.MessageWarningCount),
+		) + "\n"
+	}
+	if fixes > 0 {
+		summary += i18n.Translate(locale, i18n.MessageDoctorFixHint, i18n.TranslateCount(locale, fixes, i18n.MessageFixCount)) + "\n"
+	}
+	if _, err := fmt.Fprint(stdout, summary); err != nil {
+		return err
+	}
+
+	if problems > 0 {
+		err := fmt.Errorf("doctor found %s", plural(problems, "problem"))
+		if matlabNotFound {
+			return entities.NewExitError(entities.ExitCodeMATLABNotFound, err)
+		}
+		return err
+	}
+	return nil
+}
+
+// checkMATLAB finds the MATLAB the server would start, the same way the server does, and checks its release and license.
+func (d *Doctor) checkMATLAB(logger entities.Logger) []finding {
+	const check = "MATLAB"
+
+	root := d.config.PreferredLocalMATLABRoot()
+	rootSuggestion := "Set --matlab-root to the folder returned by the matlabroot function in MATLAB."
+	if root == "" {
+		roots := d.matlabRootGetter.GetAll(logger)
+		if len(roots) == 0 {
+			return []finding{{
+				check:          check,
+				severity:       severityProblem,
+				message:        "No MATLAB was found on the system PATH or in the standard installation folders.",
+				suggestion:     "Add the bin folder of a MATLAB installation to the system PATH, or set --matlab-root to the installation folder.",
+				matlabNotFound: true,
+			}}
+		}
+		root = roots[0]
+		rootSuggestion = "Repair the MATLAB installation, or remove it from the system PATH. " + rootSuggestion
+
+		if release := d.config.MATLABRelease(); release != "" {
+			root = d.findMATLABRelease(roots, release)
+			if root == "" {
+				return []finding{{
+					check:          check,
+					severity:       severityProblem,
+					message:        fmt.Sprintf("No MATLAB %s was found on the system PATH or in the standard installation folders.", release),
+					suggestion:     "Install MATLAB " + release + ", or set --matlab-release to the release of an installed MATLAB.",
+					matlabNotFound: true,
+				}}
+			}
+		}
+	}
+
+	version, err := d.matlabVersionGetter.Get(root)
+	if err != nil {
+		return []finding{{
+			check:          check,
+			severity:       severityProblem,
+			message:        fmt.Sprintf("%s is not a valid MATLAB installation: %v.", root, err),
+			suggestion:     rootSuggestion,
+			matlabNotFound: true,
+		}}
+	}
+
+	release := version.ReleaseFamily
+	if release < minimumMATLABRelease {
+		return []finding{{
+			check:      check,
+			severity:   severityProblem,
+			message:    fmt.Sprintf("MATLAB %s found in %s, but the server needs MATLAB %s or later.", release, root, minimumMATLABRelease),
+			suggestion: "Install a newer release of MATLAB, and add it to the system PATH or set --matlab-root to its installation folder.",
+		}}
+	}
+
+	return []finding{
+		{
+			check:    check,
+			severity: severityOK,
+			message:  fmt.Sprintf("MATLAB %s found in %s.", release, root),
+		},
+		d.checkLicense(release, root),
+	}
+}
+
+// findMATLABRelease returns the first of roots whose release is release, the same way the server selects it, or an
+// empty string if there is none.
+func (d *Doctor) findMATLABRelease(roots []string, release string) string {
+	for _, root := range roots {
+		version, err := d.matlabVersionGetter.Get(root)
+		if err == nil && version.ReleaseFamily == release {
+			return root
+		}
+	}
+	return ""
+}
+
+// checkLicense checks the license files of a MATLAB installation: whether the MATLAB license has expired, or whether
+// the license server can be reached. MATLAB installations using online licensing have no license file to check.
+func (d *Doctor) checkLicense(release string, root string) finding {
+	const check = "MATLAB license"
+
+	licenseDir := filepath.Join(root, "licenses")
+	var paths []string
+	for _, pattern := range []string{"*.lic", "*.dat"} {
+		matches, err := d.fileLayer.Glob(filepath.Join(licenseDir, pattern))
+		if err == nil {
+			paths = append(paths, matches...)
+		}
+	}
+
+	var latest time.Time
+	var latestPath string
+	var servers []string
+	permanent := false
+	for _, path := range paths {
+		content, err := d.osLayer.ReadFile(path)
+		if err != nil {
+			continue
+		}
+
+		file := parseLicenseFile(string(content))
+		for _, feature := range file.features {
+			if feature.name != matlabLicenseFeature {
+				continue
+			}
+			if feature.permanent {
+				permanent = true
+			} else if feature.expires.After(latest) {
+				latest, latestPath = feature.expires, path
+			}
+		}
+		servers = append(servers, file.servers...)
+	}
+
+	renewSuggestion := "Renew the license in the MathWorks License Center (https://www.mathworks.com/licensecenter), then activate MATLAB again."
+	now := time.Now()
+	// A license can be used until the end of its last day.
+	end := latest.AddDate(0, 0, 1)
+
+	switch {
+	case permanent:
+		return finding{check: check, severity: severityOK, message: fmt.Sprintf("MATLAB %s has a perpetual license.", release)}
+	case !latest.IsZero() && now.After(end):
+		return finding{
+			check:      check,
+			severity:   severityProblem,
+			message:    fmt.Sprintf("MATLAB %s found, but its license file %s expired on %s.", release, latestPath, latest.Format(time.DateOnly)),
+			suggestion: renewSuggestion,
+		}
+	case !latest.IsZero() && end.Sub(now) < licenseExpiryWarning:
+		return finding{
+			check:      check,
+			severity:   severityWarning,
+			message:    fmt.Sprintf("The license file %s of MATLAB %s expires on %s.", latestPath, release, latest.Format(time.DateOnly)),
+			suggestion: renewSuggestion,
+		}
+	case !latest.IsZero():
+		return finding{check: check, severity: severityOK, message: fmt.Sprintf("MATLAB %s is licensed until %s.", release, latest.Format(time.DateOnly))}
+	case len(servers) > 0:
+		return d.checkLicenseServers(check, release, servers)
+	default:
+		return finding{check: check, severity: severityOK, message: fmt.Sprintf("No license file was found in %s, MATLAB %s uses online licensing.", licenseDir, release)}
+	}
+}
+
+// checkLicenseServers checks that one of the license servers MATLAB is pointed to can be reached.
+func (d *Doctor) checkLicenseServers(check string, release string, servers []string) finding {
+	var lastErr error
+	for _, server := range servers {
+		conn, err := d.networkLayer.DialTimeout("tcp", server, dialTimeout)
+		if err == nil {
+			_ = conn.Close()
+			return finding{check: check, severity: severityOK, message: fmt.Sprintf("MATLAB %s uses the license server %s, which can be reached.", release, server)}
+		}
+		lastErr = err
+	}
+
+	_, port, _ := net.SplitHostPort(servers[len(servers)-1])
+	return finding{
+		check:      check,
+		severity:   severityProblem,
+		message:    fmt.Sprintf("MATLAB %s uses the license server %s, which cannot be reached: %v.", release, servers[len(servers)-1], lastErr),
+		suggestion: fmt.Sprintf("Check that the license server is running, and that no firewall rule blocks the connections to its port %s.", port),
+	}
+}
+
+// checkInstanceLock checks whether another server instance is running, or left its lock file behind.
+func (d *Doctor) checkInstanceLock() finding {
+	const check = "Server instances"
+
+	pid, running, err := d.instanceLock.Holder()
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return finding{check: check, severity: severityOK, message: "No other server instance is running."}
+	case err != nil:
+		return finding{
+			check:      check,
+			severity:   severityProblem,
+			message:    fmt.Sprintf("The lock file %s cannot be read: %v.", d.instanceLock.Path(), err),
+			suggestion: fmt.Sprintf("Delete %s.", d.instanceLock.Path()),
+		}
+	case running:
+		if conn, err := d.daemonSocket.Dial(); err == nil {
+			_ = conn.Close()
+			return finding{
+				check:      check,
+				severity:   severityWarning,
+				message:    fmt.Sprintf("A daemon (PID %d) is running, and serving clients on %s.", pid, d.daemonSocket.Path()),
+				suggestion: fmt.Sprintf("Start the server with --attach to connect to it, or stop it by running %s.", d.killCommand(pid)),
+			}
+		}
+		return finding{
+			check:      check,
+			severity:   severityWarning,
+			message:    fmt.Sprintf("Another server instance (PID %d) is running. A new server stops it when it starts, but a new daemon fails to start.", pid),
+			suggestion: fmt.Sprintf("Close the AI application it serves, or stop it by running %s.", d.killCommand(pid)),
+		}
+	default:
+		return finding{
+			check:      check,
+			severity:   severityWarning,
+			message:    fmt.Sprintf("The lock file %s was left behind by a server instance that is no longer running.", d.instanceLock.Path()),
+			suggestion: fmt.Sprintf("Delete %s.", d.instanceLock.Path()),
+			fix:        d.instanceLock.RemoveStale,
+		}
+	}
+}
+
+// checkLocalConnections checks that a local port can be listened on and connected to, as the server connects to MATLAB
+// through a local port.
+func (d *Doctor) checkLocalConnections() finding {
+	const check = "Local connections"
+	const suggestion = "The server connects to MATLAB through a local port. Allow the connections to 127.0.0.1 in the firewall rules and the security software of the machine."
+
+	listener, err := d.networkLayer.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return finding{
+			check:      check,
+			severity:   severityProblem,
+			message:    fmt.Sprintf("A local port cannot be listened on: %v.", err),
+			suggestion: suggestion,
+		}
+	}
+	defer func() {
+		_ = listener.Close()
+	}()
+
+	conn, err := d.networkLayer.DialTimeout("tcp", listener.Addr().String(), dialTimeout)
+	if err != nil {
+		return finding{
+			check:      check,
+			severity:   severityProblem,
+			message:    fmt.Sprintf("The connections to the local port %s are blocked: %v.", listener.Addr(), err),
+			suggestion: suggestion,
+		}
+	}
+	_ = conn.Close()
+
+	return finding{check: check, severity: severityOK, message: "Local ports can be connected to."}
+}
+
+// checkServerFolders checks whether the servers which are no longer running, for example because they stopped
+// unexpectedly, left their folders behind in the temporary folder, with their logs and the files of their MATLAB
+// sessions. The folders created before servers recorded their PID are not reported, as their server is unknown.
+func (d *Doctor) checkServerFolders() finding {
+	const check = "Server folders"
+
+	tempDir := d.osLayer.TempDir()
+	dirs, err := d.fileLayer.Glob(filepath.Join(tempDir, directory.InstanceDirPattern+"*"))
+	if err != nil {
+		return finding{
+			check:    check,
+			severity: severityWarning,
+			message:  fmt.Sprintf("The server folders in %s cannot be listed: %v.", tempDir, err),
+		}
+	}
+
+	stale := 0
+	for _, dir := range dirs {
+		data, err := d.osLayer.ReadFile(filepath.Join(dir, directory.PIDFileName))
+		if err != nil {
+			continue
+		}
+		pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+		if err != nil || pid <= 0 || d.instanceLock.IsProcessRunning(pid) {
+			continue
+		}
+		stale++
+	}
+
+	if stale == 0 {
+		return finding{check: check, severity: severityOK, message: "No server instance that is no longer running left its folder behind."}
+	}
+	return finding{
+		check:      check,
+		severity:   severityWarning,
+		message:    fmt.Sprintf("%s in %s %s left behind by server instances that are no longer running, with their logs and MATLAB session files.", plural(stale, "folder"), tempDir, pluralVerb(stale)),
+		suggestion: "Run the cleanup command to delete them, once you no longer need their logs.",
+	}
+}
+
+// checkListenAddresses checks that the addresses the server is set to listen on are free: the address of the transport
+// of the serve command, and the addresses of the debug endpoints and of the metrics.
+func (d *Doctor) checkListenAddresses() []finding {
+	const check = "Ports"
+
+	type listenAddress struct {
+		flag    string
+		address string
+	}
+	var addresses []listenAddress
+	if d.config.ServeTransport() != entities.TransportStdio {
+		addresses = append(addresses, listenAddress{"listen", d.config.ListenAddress()})
+	}
+	addresses = append(addresses,
+		listenAddress{"debug-listen", d.config.DebugListenAddress()},
+		listenAddress{"metrics-listen", d.config.MetricsListenAddress()},
+	)
+
+	var findings []finding
+	for _, a := range addresses {
+		if a.address == "" {
+			continue
+		}
+
+		listener, err := d.networkLayer.Listen("tcp", a.address)
+		if err != nil {
+			_, port, _ := net.SplitHostPort(a.address)
+			findings = append(findings, finding{
+				check:      check,
+				severity:   severityProblem,
+				message:    fmt.Sprintf("The address %s set by --%s cannot be listened on: %v.", a.address, a.flag, err),
+				suggestion: fmt.Sprintf("Stop the program using the port %s, which %s shows, or set --%s to another port.", port, d.portOwnerCommand(port), a.flag),
+			})
+			continue
+		}
+		_ = listener.Close()
+
+		findings = append(findings, finding{check: check, severity: severityOK, message: fmt.Sprintf("The address %s set by --%s is free.", a.address, a.flag)})
+	}
+	return findings
+}
+
+func (d *Doctor) portOwnerCommand(port string) string {
+	if d.osLayer.GOOS() == "windows" {
+		return fmt.Sprintf("`netstat -ano | findstr :%s`", port)
+	}
+	return fmt.Sprintf("`lsof -i :%s`", port)
+}
+
+func (d *Doctor) killCommand(pid int) string {
+	if d.osLayer.GOOS() == "windows" {
+		return fmt.Sprintf("`taskkill /PID %d /F`", pid)
+	}
+	return fmt.Sprintf("`kill %d`", pid)
+}
+
+// pluralVerb is the form of "to be" matching a count of n.
+func pluralVerb(n int) string {
+	if n == 1 {
+		return "was"
+	}
+	return "were"
+}
+
+func plural(n int, word string) string {
+	if n == 1 {
+		return fmt.Sprintf("1 %s", word)
+	}
+	return fmt.Sprintf("%d %ss", n, word)
+}
@@ -0,0 +1,1164 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package doctor_test
+
+import (
+	"bytes"
+	"errors"
+	"io/fs"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/doctor"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	doctormocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/doctor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	matlabRoot   = "/usr/local/MATLAB/R2024a"
+	tempDir      = "/tmp"
+	lockFilePath = "/tmp/matlab-mcp-core-server.lock"
+	socketPath   = "/tmp/matlab-mcp-core-server.sock"
+)
+
+var licenseFilePath = filepath.Join(matlabRoot, "licenses", "license.lic")
+
+func arrangeMATLAB(mockConfig *doctormocks.MockConfig, mockMATLABRootGetter *doctormocks.MockMATLABRootGetter, mockMATLABVersionGetter *doctormocks.MockMATLABVersionGetter, mockLogger *testutils.InspectableLogger, release string) {
+	mockConfig.EXPECT().PreferredLocalMATLABRoot().Return("").Once()
+	mockMATLABRootGetter.EXPECT().GetAll(mockLogger).Return([]string{matlabRoot}).Once()
+	mockConfig.EXPECT().MATLABRelease().Return("").Once()
+	mockMATLABVersionGetter.EXPECT().Get(matlabRoot).Return(datatypes.MatlabVersionInfo{ReleaseFamily: release}, nil).Once()
+}
+
+func arrangeLicenseFile(mockFileLayer *doctormocks.MockFileLayer, mockOSLayer *doctormocks.MockOSLayer, content string) {
+	mockFileLayer.EXPECT().Glob(filepath.Join(matlabRoot, "licenses", "*.lic")).Return([]string{licenseFilePath}, nil).Once()
+	mockFileLayer.EXPECT().Glob(filepath.Join(matlabRoot, "licenses", "*.dat")).Return(nil, nil).Once()
+	mockOSLayer.EXPECT().ReadFile(licenseFilePath).Return([]byte(content), nil).Once()
+}
+
+func arrangeNoOtherInstance(mockInstanceLock *doctormocks.MockInstanceLock) {
+	mockInstanceLock.EXPECT().Holder().Return(0, false, fs.ErrNotExist).Once()
+}
+
+func arrangeLocalConnections(t *testing.T, mockNetworkLayer *doctormocks.MockNetworkLayer, dialErr error) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	mockNetworkLayer.EXPECT().Listen("tcp", "127.0.0.1:0").Return(listener, nil).Once()
+
+	if dialErr != nil {
+		mockNetworkLayer.EXPECT().DialTimeout("tcp", listener.Addr().String(), mock.Anything).Return(nil, dialErr).Once()
+		return
+	}
+
+	client, server := net.Pipe()
+	t.Cleanup(func() {
+		_ = server.Close()
+	})
+	mockNetworkLayer.EXPECT().DialTimeout("tcp", listener.Addr().String(), mock.Anything).Return(client, nil).Once()
+}
+
+func arrangeNoServerFolders(mockOSLayer *doctormocks.MockOSLayer, mockFileLayer *doctormocks.MockFileLayer) {
+	mockOSLayer.EXPECT().TempDir().Return(tempDir).Once()
+	mockFileLayer.EXPECT().Glob(filepath.Join(tempDir, "matlab-mcp-core-server-*")).Return(nil, nil).Once()
+}
+
+func arrangeNoListenAddresses(mockConfig *doctormocks.MockConfig) {
+	mockConfig.EXPECT().ServeTransport().Return(entities.TransportStdio).Once()
+	mockConfig.EXPECT().DebugListenAddress().Return("").Once()
+	mockConfig.EXPECT().MetricsListenAddress().Return("").Once()
+}
+
+func TestDoctor_NoProblems(t *testing.T) {
+	// Arrange
+	mockConfig := &doctormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &doctormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABRootGetter := &doctormocks.MockMATLABRootGetter{}
+	defer mockMATLABRootGetter.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &doctormocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockInstanceLock := &doctormocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockDaemonSocket := &doctormocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockOSLayer := &doctormocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &doctormocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockNetworkLayer := &doctormocks.MockNetworkLayer{}
+	defer mockNetworkLayer.AssertExpectations(t)
+
+	mockLocalizer := &doctormocks.MockLocalizer{}
+	defer mockLocalizer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	stdout := &bytes.Buffer{}
+
+	mockLoggerFactory.EXPECT().GetGlobalLogger().Return(mockLogger).Once()
+	mockLocalizer.EXPECT().Locale().Return(entities.LocaleEnglish).Once()
+	mockOSLayer.EXPECT().Stdout().Return(stdout).Once()
+	arrangeMATLAB(mockConfig, mockMATLABRootGetter, mockMATLABVersionGetter, mockLogger, "R2024a")
+	arrangeLicenseFile(mockFileLayer, mockOSLayer, "INCREMENT MATLAB MLM 45 permanent uncounted \\\n\tVENDOR_STRING=QQ HOSTID=ANY SIGN=\"0123\"\n")
+	arrangeNoOtherInstance(mockInstanceLock)
+	arrangeLocalConnections(t, mockNetworkLayer, nil)
+	arrangeNoServerFolders(mockOSLayer, mockFileLayer)
+	arrangeNoListenAddresses(mockConfig)
+
+	d := doctor.New(mockConfig, mockLoggerFactory, mockMATLABRootGetter, mockMATLABVersionGetter, mockInstanceLock, mockDaemonSocket, mockOSLayer, mockFileLayer, mockNetworkLayer, mockLocalizer)
+
+	// Act
+	err := d.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "[ok]      MATLAB: MATLAB R2024a found in "+matlabRoot+".\n"+
+		"[ok]      MATLAB license: MATLAB R2024a has a perpetual license.\n"+
+		"[ok]      Server instances: No other server instance is running.\n"+
+		"[ok]      Server folders: No server instance that is no longer running left its folder behind.\n"+
+		"[ok]      Local connections: Local ports can be connected to.\n"+
+		"\nNo problems found.\n", stdout.String())
+}
+
+func TestDoctor_ExpiredLicense(t *testing.T) {
+	// Arrange
+	mockConfig := &doctormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &doctormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABRootGetter := &doctormocks.MockMATLABRootGetter{}
+	defer mockMATLABRootGetter.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &doctormocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockInstanceLock := &doctormocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockDaemonSocket := &doctormocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockOSLayer := &doctormocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &doctormocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockNetworkLayer := &doctormocks.MockNetworkLayer{}
+	defer mockNetworkLayer.AssertExpectations(t)
+
+	mockLocalizer := &doctormocks.MockLocalizer{}
+	defer mockLocalizer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	stdout := &bytes.Buffer{}
+
+	mockLoggerFactory.EXPECT().GetGlobalLogger().Return(mockLogger).Once()
+	mockLocalizer.EXPECT().Locale().Return(entities.LocaleEnglish).Once()
+	mockOSLayer.EXPECT().Stdout().Return(stdout).Once()
+	arrangeMATLAB(mockConfig, mockMATLABRootGetter, mockMATLABVersionGetter, mockLogger, "R2024a")
+	arrangeLicenseFile(mockFileLayer, mockOSLayer, "INCREMENT MATLAB MLM 45 31-dec-2001 uncounted HOSTID=ANY\nINCREMENT Simulink MLM 45 permanent uncounted HOSTID=ANY\n")
+	arrangeNoOtherInstance(mockInstanceLock)
+	arrangeLocalConnections(t, mockNetworkLayer, nil)
+	arrangeNoServerFolders(mockOSLayer, mockFileLayer)
+	arrangeNoListenAddresses(mockConfig)
+
+	d := doctor.New(mockConfig, mockLoggerFactory, mockMATLABRootGetter, mockMATLABVersionGetter, mockInstanceLock, mockDaemonSocket, mockOSLayer, mockFileLayer, mockNetworkLayer, mockLocalizer)
+
+	// Act
+	err := d.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.EqualError(t, err, "doctor found 1 problem")
+	assert.Contains(t, stdout.String(), "[problem] MATLAB license: MATLAB R2024a found, but its license file "+licenseFilePath+" expired on 2001-12-31.\n"+
+		"          Suggested fix: Renew the license in the MathWorks License Center")
+	assert.Contains(t, stdout.String(), "Found 1 problem and 0 warnings.")
+}
+
+func TestDoctor_LicenseExpiresSoon(t *testing.T) {
+	// Arrange
+	expires := time.Now().AddDate(0, 0, 10)
+
+	mockConfig := &doctormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &doctormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABRootGetter := &doctormocks.MockMATLABRootGetter{}
+	defer mockMATLABRootGetter.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &doctormocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockInstanceLock := &doctormocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockDaemonSocket := &doctormocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockOSLayer := &doctormocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &doctormocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockNetworkLayer := &doctormocks.MockNetworkLayer{}
+	defer mockNetworkLayer.AssertExpectations(t)
+
+	mockLocalizer := &doctormocks.MockLocalizer{}
+	defer mockLocalizer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	stdout := &bytes.Buffer{}
+
+	mockLoggerFactory.EXPECT().GetGlobalLogger().Return(mockLogger).Once()
+	mockLocalizer.EXPECT().Locale().Return(entities.LocaleEnglish).Once()
+	mockOSLayer.EXPECT().Stdout().Return(stdout).Once()
+	arrangeMATLAB(mockConfig, mockMATLABRootGetter, mockMATLABVersionGetter, mockLogger, "R2024a")
+	arrangeLicenseFile(mockFileLayer, mockOSLayer, "FEATURE MATLAB MLM 45 "+strings.ToLower(expires.Format("2-Jan-2006"))+" uncounted HOSTID=ANY\n")
+	arrangeNoOtherInstance(mockInstanceLock)
+	arrangeLocalConnections(t, mockNetworkLayer, nil)
+	arrangeNoServerFolders(mockOSLayer, mockFileLayer)
+	arrangeNoListenAddresses(mockConfig)
+
+	d := doctor.New(mockConfig, mockLoggerFactory, mockMATLABRootGetter, mockMATLABVersionGetter, mockInstanceLock, mockDaemonSocket, mockOSLayer, mockFileLayer, mockNetworkLayer, mockLocalizer)
+
+	// Act
+	err := d.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "[warning] MATLAB license: The license file "+licenseFilePath+" of MATLAB R2024a expires on "+expires.Format(time.DateOnly)+".\n")
+}
+
+func TestDoctor_LicenseServerUnreachable(t *testing.T) {
+	// Arrange
+	mockConfig := &doctormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &doctormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABRootGetter := &doctormocks.MockMATLABRootGetter{}
+	defer mockMATLABRootGetter.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &doctormocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockInstanceLock := &doctormocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockDaemonSocket := &doctormocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockOSLayer := &doctormocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &doctormocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockNetworkLayer := &doctormocks.MockNetworkLayer{}
+	defer mockNetworkLayer.AssertExpectations(t)
+
+	mockLocalizer := &doctormocks.MockLocalizer{}
+	defer mockLocalizer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	stdout := &bytes.Buffer{}
+
+	mockLoggerFactory.EXPECT().GetGlobalLogger().Return(mockLogger).Once()
+	mockLocalizer.EXPECT().Locale().Return(entities.LocaleEnglish).Once()
+	mockOSLayer.EXPECT().Stdout().Return(stdout).Once()
+	arrangeMATLAB(mockConfig, mockMATLABRootGetter, mockMATLABVersionGetter, mockLogger, "R2024a")
+	arrangeLicenseFile(mockFileLayer, mockOSLayer, "SERVER licenses.example.com 0123456789AB 27000\nUSE_SERVER\n")
+	mockNetworkLayer.EXPECT().DialTimeout("tcp", "licenses.example.com:27000", mock.Anything).Return(nil, errors.New("i/o timeout")).Once()
+	arrangeNoOtherInstance(mockInstanceLock)
+	arrangeLocalConnections(t, mockNetworkLayer, nil)
+	arrangeNoServerFolders(mockOSLayer, mockFileLayer)
+	arrangeNoListenAddresses(mockConfig)
+
+	d := doctor.New(mockConfig, mockLoggerFactory, mockMATLABRootGetter, mockMATLABVersionGetter, mockInstanceLock, mockDaemonSocket, mockOSLayer, mockFileLayer, mockNetworkLayer, mockLocalizer)
+
+	// Act
+	err := d.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.EqualError(t, err, "doctor found 1 problem")
+	assert.Contains(t, stdout.String(), "[problem] MATLAB license: MATLAB R2024a uses the license server licenses.example.com:27000, which cannot be reached: i/o timeout.\n"+
+		"          Suggested fix: Check that the license server is running, and that no firewall rule blocks the connections to its port 27000.\n")
+}
+
+func TestDoctor_NoMATLABFound(t *testing.T) {
+	// Arrange
+	mockConfig := &doctormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &doctormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABRootGetter := &doctormocks.MockMATLABRootGetter{}
+	defer mockMATLABRootGetter.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &doctormocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockInstanceLock := &doctormocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockDaemonSocket := &doctormocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockOSLayer := &doctormocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &doctormocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockNetworkLayer := &doctormocks.MockNetworkLayer{}
+	defer mockNetworkLayer.AssertExpectations(t)
+
+	mockLocalizer := &doctormocks.MockLocalizer{}
+	defer mockLocalizer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	stdout := &bytes.Buffer{}
+
+	mockLoggerFactory.EXPECT().GetGlobalLogger().Return(mockLogger).Once()
+	mockLocalizer.EXPECT().Locale().Return(entities.LocaleEnglish).Once()
+	mockOSLayer.EXPECT().Stdout().Return(stdout).Once()
+	mockConfig.EXPECT().PreferredLocalMATLABRoot().Return("").Once()
+	mockMATLABRootGetter.EXPECT().GetAll(mockLogger).Return(nil).Once()
+	arrangeNoOtherInstance(mockInstanceLock)
+	arrangeLocalConnections(t, mockNetworkLayer, nil)
+	arrangeNoServerFolders(mockOSLayer, mockFileLayer)
+	arrangeNoListenAddresses(mockConfig)
+
+	d := doctor.New(mockConfig, mockLoggerFactory, mockMATLABRootGetter, mockMATLABVersionGetter, mockInstanceLock, mockDaemonSocket, mockOSLayer, mockFileLayer, mockNetworkLayer, mockLocalizer)
+
+	// Act
+	err := d.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.EqualError(t, err, "doctor found 1 problem")
+	assert.Equal(t, entities.ExitCodeMATLABNotFound, entities.ExitCodeOf(err))
+	assert.Contains(t, stdout.String(), "[problem] MATLAB: No MATLAB was found on the system PATH or in the standard installation folders.\n")
+	assert.NotContains(t, stdout.String(), "MATLAB license")
+}
+
+func TestDoctor_InvalidPreferredMATLABRoot(t *testing.T) {
+	// Arrange
+	mockConfig := &doctormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &doctormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABRootGetter := &doctormocks.MockMATLABRootGetter{}
+	defer mockMATLABRootGetter.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &doctormocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockInstanceLock := &doctormocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockDaemonSocket := &doctormocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockOSLayer := &doctormocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &doctormocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockNetworkLayer := &doctormocks.MockNetworkLayer{}
+	defer mockNetworkLayer.AssertExpectations(t)
+
+	mockLocalizer := &doctormocks.MockLocalizer{}
+	defer mockLocalizer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	stdout := &bytes.Buffer{}
+
+	mockLoggerFactory.EXPECT().GetGlobalLogger().Return(mockLogger).Once()
+	mockLocalizer.EXPECT().Locale().Return(entities.LocaleEnglish).Once()
+	mockOSLayer.EXPECT().Stdout().Return(stdout).Once()
+	mockConfig.EXPECT().PreferredLocalMATLABRoot().Return("/home/user/MATLAB").Once()
+	mockMATLABVersionGetter.EXPECT().Get("/home/user/MATLAB").Return(datatypes.MatlabVersionInfo{}, fs.ErrNotExist).Once()
+	arrangeNoOtherInstance(mockInstanceLock)
+	arrangeLocalConnections(t, mockNetworkLayer, nil)
+	arrangeNoServerFolders(mockOSLayer, mockFileLayer)
+	arrangeNoListenAddresses(mockConfig)
+
+	d := doctor.New(mockConfig, mockLoggerFactory, mockMATLABRootGetter, mockMATLABVersionGetter, mockInstanceLock, mockDaemonSocket, mockOSLayer, mockFileLayer, mockNetworkLayer, mockLocalizer)
+
+	// Act
+	err := d.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.EqualError(t, err, "doctor found 1 problem")
+	assert.Contains(t, stdout.String(), "[problem] MATLAB: /home/user/MATLAB is not a valid MATLAB installation: file does not exist.\n"+
+		"          Suggested fix: Set --matlab-root to the folder returned by the matlabroot function in MATLAB.\n")
+}
+
+func TestDoctor_UnsupportedMATLABRelease(t *testing.T) {
+	// Arrange
+	mockConfig := &doctormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &doctormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABRootGetter := &doctormocks.MockMATLABRootGetter{}
+	defer mockMATLABRootGetter.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &doctormocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockInstanceLock := &doctormocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockDaemonSocket := &doctormocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockOSLayer := &doctormocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &doctormocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockNetworkLayer := &doctormocks.MockNetworkLayer{}
+	defer mockNetworkLayer.AssertExpectations(t)
+
+	mockLocalizer := &doctormocks.MockLocalizer{}
+	defer mockLocalizer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	stdout := &bytes.Buffer{}
+
+	mockLoggerFactory.EXPECT().GetGlobalLogger().Return(mockLogger).Once()
+	mockLocalizer.EXPECT().Locale().Return(entities.LocaleEnglish).Once()
+	mockOSLayer.EXPECT().Stdout().Return(stdout).Once()
+	arrangeMATLAB(mockConfig, mockMATLABRootGetter, mockMATLABVersionGetter, mockLogger, "R2019b")
+	arrangeNoOtherInstance(mockInstanceLock)
+	arrangeLocalConnections(t, mockNetworkLayer, nil)
+	arrangeNoServerFolders(mockOSLayer, mockFileLayer)
+	arrangeNoListenAddresses(mockConfig)
+
+	d := doctor.New(mockConfig, mockLoggerFactory, mockMATLABRootGetter, mockMATLABVersionGetter, mockInstanceLock, mockDaemonSocket, mockOSLayer, mockFileLayer, mockNetworkLayer, mockLocalizer)
+
+	// Act
+	err := d.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.EqualError(t, err, "doctor found 1 problem")
+	assert.Equal(t, entities.ExitCodeFailure, entities.ExitCodeOf(err))
+	assert.Contains(t, stdout.String(), "[problem] MATLAB: MATLAB R2019b found in "+matlabRoot+", but the server needs MATLAB R2020b or later.\n")
+}
+
+func TestDoctor_MATLABRelease(t *testing.T) {
+	// Arrange
+	mockConfig := &doctormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &doctormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABRootGetter := &doctormocks.MockMATLABRootGetter{}
+	defer mockMATLABRootGetter.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &doctormocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockInstanceLock := &doctormocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockDaemonSocket := &doctormocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockOSLayer := &doctormocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &doctormocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockNetworkLayer := &doctormocks.MockNetworkLayer{}
+	defer mockNetworkLayer.AssertExpectations(t)
+
+	mockLocalizer := &doctormocks.MockLocalizer{}
+	defer mockLocalizer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	stdout := &bytes.Buffer{}
+
+	mockLoggerFactory.EXPECT().GetGlobalLogger().Return(mockLogger).Once()
+	mockLocalizer.EXPECT().Locale().Return(entities.LocaleEnglish).Once()
+	mockOSLayer.EXPECT().Stdout().Return(stdout).Once()
+	mockConfig.EXPECT().PreferredLocalMATLABRoot().Return("").Once()
+	mockConfig.EXPECT().MATLABRelease().Return("R2023b").Once()
+	otherRoot := filepath.Join("/usr/local/MATLAB", "R2025a")
+	mockMATLABRootGetter.EXPECT().GetAll(mockLogger).Return([]string{otherRoot, matlabRoot}).Once()
+	mockMATLABVersionGetter.EXPECT().Get(otherRoot).Return(datatypes.MatlabVersionInfo{ReleaseFamily: "R2025a"}, nil).Once()
+	mockMATLABVersionGetter.EXPECT().Get(matlabRoot).Return(datatypes.MatlabVersionInfo{ReleaseFamily: "R2023b"}, nil).Twice()
+	arrangeLicenseFile(mockFileLayer, mockOSLayer, "INCREMENT MATLAB MLM 45 permanent uncounted \\\n\tVENDOR_STRING=QQ HOSTID=ANY SIGN=\"0123\"\n")
+	arrangeNoOtherInstance(mockInstanceLock)
+	arrangeLocalConnections(t, mockNetworkLayer, nil)
+	arrangeNoServerFolders(mockOSLayer, mockFileLayer)
+	arrangeNoListenAddresses(mockConfig)
+
+	d := doctor.New(mockConfig, mockLoggerFactory, mockMATLABRootGetter, mockMATLABVersionGetter, mockInstanceLock, mockDaemonSocket, mockOSLayer, mockFileLayer, mockNetworkLayer, mockLocalizer)
+
+	// Act
+	err := d.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "[ok]      MATLAB: MATLAB R2023b found in "+matlabRoot+".\n")
+}
+
+func TestDoctor_MATLABReleaseNotFound(t *testing.T) {
+	// Arrange
+	mockConfig := &doctormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &doctormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABRootGetter := &doctormocks.MockMATLABRootGetter{}
+	defer mockMATLABRootGetter.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &doctormocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockInstanceLock := &doctormocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockDaemonSocket := &doctormocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockOSLayer := &doctormocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &doctormocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockNetworkLayer := &doctormocks.MockNetworkLayer{}
+	defer mockNetworkLayer.AssertExpectations(t)
+
+	mockLocalizer := &doctormocks.MockLocalizer{}
+	defer mockLocalizer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	stdout := &bytes.Buffer{}
+
+	mockLoggerFactory.EXPECT().GetGlobalLogger().Return(mockLogger).Once()
+	mockLocalizer.EXPECT().Locale().Return(entities.LocaleEnglish).Once()
+	mockOSLayer.EXPECT().Stdout().Return(stdout).Once()
+	mockConfig.EXPECT().PreferredLocalMATLABRoot().Return("").Once()
+	mockConfig.EXPECT().MATLABRelease().Return("R2022a").Once()
+	mockMATLABRootGetter.EXPECT().GetAll(mockLogger).Return([]string{matlabRoot}).Once()
+	mockMATLABVersionGetter.EXPECT().Get(matlabRoot).Return(datatypes.MatlabVersionInfo{ReleaseFamily: "R2024a"}, nil).Once()
+	arrangeNoOtherInstance(mockInstanceLock)
+	arrangeLocalConnections(t, mockNetworkLayer, nil)
+	arrangeNoServerFolders(mockOSLayer, mockFileLayer)
+	arrangeNoListenAddresses(mockConfig)
+
+	d := doctor.New(mockConfig, mockLoggerFactory, mockMATLABRootGetter, mockMATLABVersionGetter, mockInstanceLock, mockDaemonSocket, mockOSLayer, mockFileLayer, mockNetworkLayer, mockLocalizer)
+
+	// Act
+	err := d.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.EqualError(t, err, "doctor found 1 problem")
+	assert.Equal(t, entities.ExitCodeMATLABNotFound, entities.ExitCodeOf(err))
+	assert.Contains(t, stdout.String(), "[problem] MATLAB: No MATLAB R2022a was found on the system PATH or in the standard installation folders.\n")
+}
+
+func TestDoctor_StaleLockFile(t *testing.T) {
+	// Arrange
+	mockConfig := &doctormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &doctormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABRootGetter := &doctormocks.MockMATLABRootGetter{}
+	defer mockMATLABRootGetter.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &doctormocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockInstanceLock := &doctormocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockDaemonSocket := &doctormocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockOSLayer := &doctormocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &doctormocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockNetworkLayer := &doctormocks.MockNetworkLayer{}
+	defer mockNetworkLayer.AssertExpectations(t)
+
+	mockLocalizer := &doctormocks.MockLocalizer{}
+	defer mockLocalizer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	stdout := &bytes.Buffer{}
+
+	mockLoggerFactory.EXPECT().GetGlobalLogger().Return(mockLogger).Once()
+	mockLocalizer.EXPECT().Locale().Return(entities.LocaleEnglish).Once()
+	mockOSLayer.EXPECT().Stdout().Return(stdout).Once()
+	mockConfig.EXPECT().DoctorFix().Return(false).Once()
+	arrangeMATLAB(mockConfig, mockMATLABRootGetter, mockMATLABVersionGetter, mockLogger, "R2024a")
+	arrangeLicenseFile(mockFileLayer, mockOSLayer, "INCREMENT MATLAB MLM 45 01-jan-0 uncounted HOSTID=ANY\n")
+	mockInstanceLock.EXPECT().Holder().Return(1234, false, nil).Once()
+	mockInstanceLock.EXPECT().Path().Return(lockFilePath).Twice()
+	arrangeLocalConnections(t, mockNetworkLayer, nil)
+	arrangeNoServerFolders(mockOSLayer, mockFileLayer)
+	arrangeNoListenAddresses(mockConfig)
+
+	d := doctor.New(mockConfig, mockLoggerFactory, mockMATLABRootGetter, mockMATLABVersionGetter, mockInstanceLock, mockDaemonSocket, mockOSLayer, mockFileLayer, mockNetworkLayer, mockLocalizer)
+
+	// Act
+	err := d.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "[warning] Server instances: The lock file "+lockFilePath+" was left behind by a server instance that is no longer running.\n"+
+		"          Suggested fix: Delete "+lockFilePath+".\n")
+	assert.Contains(t, stdout.String(), "Found 0 problems and 1 warning.\nRun the doctor command with --fix to apply the 1 fix that can be applied automatically.\n")
+}
+
+func TestDoctor_StaleLockFileInJapanese(t *testing.T) {
+	// Arrange
+	mockConfig := &doctormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &doctormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABRootGetter := &doctormocks.MockMATLABRootGetter{}
+	defer mockMATLABRootGetter.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &doctormocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockInstanceLock := &doctormocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockDaemonSocket := &doctormocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockOSLayer := &doctormocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &doctormocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockNetworkLayer := &doctormocks.MockNetworkLayer{}
+	defer mockNetworkLayer.AssertExpectations(t)
+
+	mockLocalizer := &doctormocks.MockLocalizer{}
+	defer mockLocalizer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	stdout := &bytes.Buffer{}
+
+	mockLoggerFactory.EXPECT().GetGlobalLogger().Return(mockLogger).Once()
+	mockLocalizer.EXPECT().Locale().Return(entities.LocaleJapanese).Once()
+	mockOSLayer.EXPECT().Stdout().Return(stdout).Once()
+	mockConfig.EXPECT().DoctorFix().Return(false).Once()
+	arrangeMATLAB(mockConfig, mockMATLABRootGetter, mockMATLABVersionGetter, mockLogger, "R2024a")
+	arrangeLicenseFile(mockFileLayer, mockOSLayer, "INCREMENT MATLAB MLM 45 01-jan-0 uncounted HOSTID=ANY\n")
+	mockInstanceLock.EXPECT().Holder().Return(1234, false, nil).Once()
+	mockInstanceLock.EXPECT().Path().Return(lockFilePath).Twice()
+	arrangeLocalConnections(t, mockNetworkLayer, nil)
+	arrangeNoServerFolders(mockOSLayer, mockFileLayer)
+	arrangeNoListenAddresses(mockConfig)
+
+	d := doctor.New(mockConfig, mockLoggerFactory, mockMATLABRootGetter, mockMATLABVersionGetter, mockInstanceLock, mockDaemonSocket, mockOSLayer, mockFileLayer, mockNetworkLayer, mockLocalizer)
+
+	// Act
+	err := d.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "          推奨される対処: Delete "+lockFilePath+".\n")
+	assert.Contains(t, stdout.String(), "0 件の問題と1 件の警告が見つかりました。\n自動で適用できる1 件の修正を適用するには、doctor コマンドに --fix を付けて実行してください。\n")
+}
+
+func TestDoctor_FixRemovesStaleLockFile(t *testing.T) {
+	// Arrange
+	mockConfig := &doctormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &doctormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABRootGetter := &doctormocks.MockMATLABRootGetter{}
+	defer mockMATLABRootGetter.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &doctormocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockInstanceLock := &doctormocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockDaemonSocket := &doctormocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockOSLayer := &doctormocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &doctormocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockNetworkLayer := &doctormocks.MockNetworkLayer{}
+	defer mockNetworkLayer.AssertExpectations(t)
+
+	mockLocalizer := &doctormocks.MockLocalizer{}
+	defer mockLocalizer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	stdout := &bytes.Buffer{}
+
+	mockLoggerFactory.EXPECT().GetGlobalLogger().Return(mockLogger).Once()
+	mockLocalizer.EXPECT().Locale().Return(entities.LocaleEnglish).Once()
+	mockOSLayer.EXPECT().Stdout().Return(stdout).Once()
+	mockConfig.EXPECT().DoctorFix().Return(true).Once()
+	arrangeMATLAB(mockConfig, mockMATLABRootGetter, mockMATLABVersionGetter, mockLogger, "R2024a")
+	arrangeLicenseFile(mockFileLayer, mockOSLayer, "INCREMENT MATLAB MLM 45 permanent uncounted HOSTID=ANY\n")
+	mockInstanceLock.EXPECT().Holder().Return(1234, false, nil).Once()
+	mockInstanceLock.EXPECT().Path().Return(lockFilePath).Twice()
+	mockInstanceLock.EXPECT().RemoveStale().Return(nil).Once()
+	arrangeLocalConnections(t, mockNetworkLayer, nil)
+	arrangeNoServerFolders(mockOSLayer, mockFileLayer)
+	arrangeNoListenAddresses(mockConfig)
+
+	d := doctor.New(mockConfig, mockLoggerFactory, mockMATLABRootGetter, mockMATLABVersionGetter, mockInstanceLock, mockDaemonSocket, mockOSLayer, mockFileLayer, mockNetworkLayer, mockLocalizer)
+
+	// Act
+	err := d.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "[ok]      Server instances: The lock file "+lockFilePath+" was left behind by a server instance that is no longer running. Fixed.\n")
+	assert.Contains(t, stdout.String(), "\nNo problems found.\n")
+}
+
+func TestDoctor_FixFails(t *testing.T) {
+	// Arrange
+	mockConfig := &doctormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &doctormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABRootGetter := &doctormocks.MockMATLABRootGetter{}
+	defer mockMATLABRootGetter.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &doctormocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockInstanceLock := &doctormocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockDaemonSocket := &doctormocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockOSLayer := &doctormocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &doctormocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockNetworkLayer := &doctormocks.MockNetworkLayer{}
+	defer mockNetworkLayer.AssertExpectations(t)
+
+	mockLocalizer := &doctormocks.MockLocalizer{}
+	defer mockLocalizer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	stdout := &bytes.Buffer{}
+
+	mockLoggerFactory.EXPECT().GetGlobalLogger().Return(mockLogger).Once()
+	mockLocalizer.EXPECT().Locale().Return(entities.LocaleEnglish).Once()
+	mockOSLayer.EXPECT().Stdout().Return(stdout).Once()
+	mockConfig.EXPECT().DoctorFix().Return(true).Once()
+	arrangeMATLAB(mockConfig, mockMATLABRootGetter, mockMATLABVersionGetter, mockLogger, "R2024a")
+	arrangeLicenseFile(mockFileLayer, mockOSLayer, "INCREMENT MATLAB MLM 45 permanent uncounted HOSTID=ANY\n")
+	mockInstanceLock.EXPECT().Holder().Return(1234, false, nil).Once()
+	mockInstanceLock.EXPECT().Path().Return(lockFilePath).Twice()
+	mockInstanceLock.EXPECT().RemoveStale().Return(fs.ErrPermission).Once()
+	arrangeLocalConnections(t, mockNetworkLayer, nil)
+	arrangeNoServerFolders(mockOSLayer, mockFileLayer)
+	arrangeNoListenAddresses(mockConfig)
+
+	d := doctor.New(mockConfig, mockLoggerFactory, mockMATLABRootGetter, mockMATLABVersionGetter, mockInstanceLock, mockDaemonSocket, mockOSLayer, mockFileLayer, mockNetworkLayer, mockLocalizer)
+
+	// Act
+	err := d.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "Suggested fix: Delete "+lockFilePath+". The automatic fix failed: permission denied.\n")
+	assert.NotContains(t, stdout.String(), "--fix")
+	assert.NotEmpty(t, mockLogger.WarnLogs())
+}
+
+func TestDoctor_RunningDaemon(t *testing.T) {
+	// Arrange
+	client, server := net.Pipe()
+	defer func() {
+		_ = server.Close()
+	}()
+
+	mockConfig := &doctormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &doctormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABRootGetter := &doctormocks.MockMATLABRootGetter{}
+	defer mockMATLABRootGetter.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &doctormocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockInstanceLock := &doctormocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockDaemonSocket := &doctormocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockOSLayer := &doctormocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &doctormocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockNetworkLayer := &doctormocks.MockNetworkLayer{}
+	defer mockNetworkLayer.AssertExpectations(t)
+
+	mockLocalizer := &doctormocks.MockLocalizer{}
+	defer mockLocalizer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	stdout := &bytes.Buffer{}
+
+	mockLoggerFactory.EXPECT().GetGlobalLogger().Return(mockLogger).Once()
+	mockLocalizer.EXPECT().Locale().Return(entities.LocaleEnglish).Once()
+	mockOSLayer.EXPECT().Stdout().Return(stdout).Once()
+	arrangeMATLAB(mockConfig, mockMATLABRootGetter, mockMATLABVersionGetter, mockLogger, "R2024a")
+	arrangeLicenseFile(mockFileLayer, mockOSLayer, "INCREMENT MATLAB MLM 45 permanent uncounted HOSTID=ANY\n")
+	mockInstanceLock.EXPECT().Holder().Return(1234, true, nil).Once()
+	mockDaemonSocket.EXPECT().Dial().Return(client, nil).Once()
+	mockDaemonSocket.EXPECT().Path().Return(socketPath).Once()
+	mockOSLayer.EXPECT().GOOS().Return("linux").Once()
+	arrangeLocalConnections(t, mockNetworkLayer, nil)
+	arrangeNoServerFolders(mockOSLayer, mockFileLayer)
+	arrangeNoListenAddresses(mockConfig)
+
+	d := doctor.New(mockConfig, mockLoggerFactory, mockMATLABRootGetter, mockMATLABVersionGetter, mockInstanceLock, mockDaemonSocket, mockOSLayer, mockFileLayer, mockNetworkLayer, mockLocalizer)
+
+	// Act
+	err := d.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "[warning] Server instances: A daemon (PID 1234) is running, and serving clients on "+socketPath+".\n"+
+		"          Suggested fix: Start the server with --attach to connect to it, or stop it by running `kill 1234`.\n")
+}
+
+func TestDoctor_RunningInstance(t *testing.T) {
+	// Arrange
+	mockConfig := &doctormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &doctormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABRootGetter := &doctormocks.MockMATLABRootGetter{}
+	defer mockMATLABRootGetter.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &doctormocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockInstanceLock := &doctormocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockDaemonSocket := &doctormocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockOSLayer := &doctormocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &doctormocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockNetworkLayer := &doctormocks.MockNetworkLayer{}
+	defer mockNetworkLayer.AssertExpectations(t)
+
+	mockLocalizer := &doctormocks.MockLocalizer{}
+	defer mockLocalizer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	stdout := &bytes.Buffer{}
+
+	mockLoggerFactory.EXPECT().GetGlobalLogger().Return(mockLogger).Once()
+	mockLocalizer.EXPECT().Locale().Return(entities.LocaleEnglish).Once()
+	mockOSLayer.EXPECT().Stdout().Return(stdout).Once()
+	arrangeMATLAB(mockConfig, mockMATLABRootGetter, mockMATLABVersionGetter, mockLogger, "R2024a")
+	arrangeLicenseFile(mockFileLayer, mockOSLayer, "INCREMENT MATLAB MLM 45 permanent uncounted HOSTID=ANY\n")
+	mockInstanceLock.EXPECT().Holder().Return(1234, true, nil).Once()
+	mockDaemonSocket.EXPECT().Dial().Return(nil, fs.ErrNotExist).Once()
+	mockOSLayer.EXPECT().GOOS().Return("windows").Once()
+	arrangeLocalConnections(t, mockNetworkLayer, nil)
+	arrangeNoServerFolders(mockOSLayer, mockFileLayer)
+	arrangeNoListenAddresses(mockConfig)
+
+	d := doctor.New(mockConfig, mockLoggerFactory, mockMATLABRootGetter, mockMATLABVersionGetter, mockInstanceLock, mockDaemonSocket, mockOSLayer, mockFileLayer, mockNetworkLayer, mockLocalizer)
+
+	// Act
+	err := d.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "[warning] Server instances: Another server instance (PID 1234) is running.")
+	assert.Contains(t, stdout.String(), "stop it by running `taskkill /PID 1234 /F`.\n")
+}
+
+func TestDoctor_LocalConnectionsBlocked(t *testing.T) {
+	// Arrange
+	mockConfig := &doctormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &doctormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABRootGetter := &doctormocks.MockMATLABRootGetter{}
+	defer mockMATLABRootGetter.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &doctormocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockInstanceLock := &doctormocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockDaemonSocket := &doctormocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockOSLayer := &doctormocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &doctormocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockNetworkLayer := &doctormocks.MockNetworkLayer{}
+	defer mockNetworkLayer.AssertExpectations(t)
+
+	mockLocalizer := &doctormocks.MockLocalizer{}
+	defer mockLocalizer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	stdout := &bytes.Buffer{}
+
+	mockLoggerFactory.EXPECT().GetGlobalLogger().Return(mockLogger).Once()
+	mockLocalizer.EXPECT().Locale().Return(entities.LocaleEnglish).Once()
+	mockOSLayer.EXPECT().Stdout().Return(stdout).Once()
+	arrangeMATLAB(mockConfig, mockMATLABRootGetter, mockMATLABVersionGetter, mockLogger, "R2024a")
+	arrangeLicenseFile(mockFileLayer, mockOSLayer, "INCREMENT MATLAB MLM 45 permanent uncounted HOSTID=ANY\n")
+	arrangeNoOtherInstance(mockInstanceLock)
+	arrangeLocalConnections(t, mockNetworkLayer, errors.New("connection refused"))
+	arrangeNoServerFolders(mockOSLayer, mockFileLayer)
+	arrangeNoListenAddresses(mockConfig)
+
+	d := doctor.New(mockConfig, mockLoggerFactory, mockMATLABRootGetter, mockMATLABVersionGetter, mockInstanceLock, mockDaemonSocket, mockOSLayer, mockFileLayer, mockNetworkLayer, mockLocalizer)
+
+	// Act
+	err := d.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.EqualError(t, err, "doctor found 1 problem")
+	assert.Contains(t, stdout.String(), "[problem] Local connections: The connections to the local port 127.0.0.1:")
+	assert.Contains(t, stdout.String(), "Suggested fix: The server connects to MATLAB through a local port. Allow the connections to 127.0.0.1 in the firewall rules")
+}
+
+func TestDoctor_StaleServerFolders(t *testing.T) {
+	// Arrange
+	staleDirs := []string{filepath.Join(tempDir, "matlab-mcp-core-server-1"), filepath.Join(tempDir, "matlab-mcp-core-server-2")}
+	runningDir := filepath.Join(tempDir, "matlab-mcp-core-server-3")
+	legacyDir := filepath.Join(tempDir, "matlab-mcp-core-server-4")
+
+	mockConfig := &doctormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &doctormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABRootGetter := &doctormocks.MockMATLABRootGetter{}
+	defer mockMATLABRootGetter.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &doctormocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockInstanceLock := &doctormocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockDaemonSocket := &doctormocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockOSLayer := &doctormocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &doctormocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockNetworkLayer := &doctormocks.MockNetworkLayer{}
+	defer mockNetworkLayer.AssertExpectations(t)
+
+	mockLocalizer := &doctormocks.MockLocalizer{}
+	defer mockLocalizer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	stdout := &bytes.Buffer{}
+
+	mockLoggerFactory.EXPECT().GetGlobalLogger().Return(mockLogger).Once()
+	mockLocalizer.EXPECT().Locale().Return(entities.LocaleEnglish).Once()
+	mockOSLayer.EXPECT().Stdout().Return(stdout).Once()
+	arrangeMATLAB(mockConfig, mockMATLABRootGetter, mockMATLABVersionGetter, mockLogger, "R2024a")
+	arrangeLicenseFile(mockFileLayer, mockOSLayer, "INCREMENT MATLAB MLM 45 permanent uncounted HOSTID=ANY\n")
+	arrangeNoOtherInstance(mockInstanceLock)
+	mockOSLayer.EXPECT().TempDir().Return(tempDir).Once()
+	mockFileLayer.EXPECT().Glob(filepath.Join(tempDir, "matlab-mcp-core-server-*")).Return(append(staleDirs, runningDir, legacyDir), nil).Once()
+	mockOSLayer.EXPECT().ReadFile(filepath.Join(staleDirs[0], "server.pid")).Return([]byte("101\n"), nil).Once()
+	mockOSLayer.EXPECT().ReadFile(filepath.Join(staleDirs[1], "server.pid")).Return([]byte("102"), nil).Once()
+	mockOSLayer.EXPECT().ReadFile(filepath.Join(runningDir, "server.pid")).Return([]byte("103"), nil).Once()
+	mockOSLayer.EXPECT().ReadFile(filepath.Join(legacyDir, "server.pid")).Return(nil, fs.ErrNotExist).Once()
+	mockInstanceLock.EXPECT().IsProcessRunning(101).Return(false).Once()
+	mockInstanceLock.EXPECT().IsProcessRunning(102).Return(false).Once()
+	mockInstanceLock.EXPECT().IsProcessRunning(103).Return(true).Once()
+	arrangeLocalConnections(t, mockNetworkLayer, nil)
+	arrangeNoListenAddresses(mockConfig)
+
+	d := doctor.New(mockConfig, mockLoggerFactory, mockMATLABRootGetter, mockMATLABVersionGetter, mockInstanceLock, mockDaemonSocket, mockOSLayer, mockFileLayer, mockNetworkLayer, mockLocalizer)
+
+	// Act
+	err := d.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "[warning] Server folders: 2 folders in "+tempDir+" were left behind by server instances that are no longer running, with their logs and MATLAB session files.\n"+
+		"          Suggested fix: Run the cleanup command to delete them, once you no longer need their logs.\n")
+	assert.Contains(t, stdout.String(), "Found 0 problems and 1 warning.\n")
+}
+
+func TestDoctor_ListenAddressInUse(t *testing.T) {
+	// Arrange
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	mockConfig := &doctormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &doctormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABRootGetter := &doctormocks.MockMATLABRootGetter{}
+	defer mockMATLABRootGetter.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &doctormocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockInstanceLock := &doctormocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockDaemonSocket := &doctormocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockOSLayer := &doctormocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &doctormocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockNetworkLayer := &doctormocks.MockNetworkLayer{}
+	defer mockNetworkLayer.AssertExpectations(t)
+
+	mockLocalizer := &doctormocks.MockLocalizer{}
+	defer mockLocalizer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	stdout := &bytes.Buffer{}
+
+	mockLoggerFactory.EXPECT().GetGlobalLogger().Return(mockLogger).Once()
+	mockLocalizer.EXPECT().Locale().Return(entities.LocaleEnglish).Once()
+	mockOSLayer.EXPECT().Stdout().Return(stdout).Once()
+	arrangeMATLAB(mockConfig, mockMATLABRootGetter, mockMATLABVersionGetter, mockLogger, "R2024a")
+	arrangeLicenseFile(mockFileLayer, mockOSLayer, "INCREMENT MATLAB MLM 45 permanent uncounted HOSTID=ANY\n")
+	arrangeNoOtherInstance(mockInstanceLock)
+	arrangeNoServerFolders(mockOSLayer, mockFileLayer)
+	arrangeLocalConnections(t, mockNetworkLayer, nil)
+	mockConfig.EXPECT().ServeTransport().Return(entities.TransportHTTP).Once()
+	mockConfig.EXPECT().ListenAddress().Return("127.0.0.1:8000").Once()
+	mockConfig.EXPECT().DebugListenAddress().Return("").Once()
+	mockConfig.EXPECT().MetricsListenAddress().Return("127.0.0.1:9464").Once()
+	mockNetworkLayer.EXPECT().Listen("tcp", "127.0.0.1:8000").Return(nil, errors.New("address already in use")).Once()
+	mockNetworkLayer.EXPECT().Listen("tcp", "127.0.0.1:9464").Return(listener, nil).Once()
+	mockOSLayer.EXPECT().GOOS().Return("linux").Once()
+
+	d := doctor.New(mockConfig, mockLoggerFactory, mockMATLABRootGetter, mockMATLABVersionGetter, mockInstanceLock, mockDaemonSocket, mockOSLayer, mockFileLayer, mockNetworkLayer, mockLocalizer)
+
+	// Act
+	err = d.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.EqualError(t, err, "doctor found 1 problem")
+	assert.Contains(t, stdout.String(), "[problem] Ports: The address 127.0.0.1:8000 set by --listen cannot be listened on: address already in use.\n"+
+		"          Suggested fix: Stop the program using the port 8000, which `lsof -i :8000` shows, or set --listen to another port.\n")
+	assert.Contains(t, stdout.String(), "[ok]      Ports: The address 127.0.0.1:9464 set by --metrics-listen is free.\n")
+}
@@ -0,0 +1,90 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package doctor
+
+import (
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// matlabLicenseFeature is the name of the feature licensing MATLAB itself in license files.
+const matlabLicenseFeature = "MATLAB"
+
+const licenseDateLayout = "2-Jan-2006"
+
+// licenseFeature is a FEATURE or INCREMENT line of a license file.
+type licenseFeature struct {
+	name      string
+	permanent bool
+	// expires is the last day the feature can be used on.
+	expires time.Time
+}
+
+// licenseFile is the content of a license file relevant to the checks: the features it grants, and the license
+// servers it points to.
+type licenseFile struct {
+	features []licenseFeature
+	servers  []string
+}
+
+// parseLicenseFile parses the lines of a FlexNet license file. Lines which cannot be parsed are ignored.
+func parseLicenseFile(content string) licenseFile {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	content = strings.ReplaceAll(content, "\\\n", " ")
+
+	var file licenseFile
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch strings.ToUpper(fields[0]) {
+		case "FEATURE", "INCREMENT":
+			if len(fields) < 5 {
+				continue
+			}
+			feature, ok := parseLicenseExpiry(fields[4])
+			if !ok {
+				continue
+			}
+			feature.name = fields[1]
+			file.features = append(file.features, feature)
+		case "SERVER":
+			// SERVER host hostid [port], where the port defaults to a range that cannot be checked with a single connection.
+			if len(fields) < 4 {
+				continue
+			}
+			if _, err := strconv.Atoi(fields[3]); err != nil {
+				continue
+			}
+			file.servers = append(file.servers, net.JoinHostPort(fields[1], fields[3]))
+		}
+	}
+
+	return file
+}
+
+// parseLicenseExpiry parses the expiry date of a feature: "permanent", a date with a year of 0 for features which
+// do not expire, or a date like 31-dec-2025.
+func parseLicenseExpiry(expiry string) (licenseFeature, bool) {
+	if strings.EqualFold(expiry, "permanent") {
+		return licenseFeature{permanent: true}, true
+	}
+
+	parts := strings.Split(expiry, "-")
+	if len(parts) == 3 {
+		if year, err := strconv.Atoi(parts[2]); err == nil && year == 0 {
+			return licenseFeature{permanent: true}, true
+		}
+	}
+
+	expires, err := time.Parse(licenseDateLayout, expiry)
+	if err != nil {
+		return licenseFeature{}, false
+	}
+
+	return licenseFeature{expires: expires}, true
+}
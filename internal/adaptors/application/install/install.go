@@ -0,0 +1,414 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package install
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+)
+
+// serverName is the name the server is registered under in the configuration files of the clients.
+const serverName = "matlab"
+
+// binaryName is the prefix of the names of the server binaries, used to find entries registered under another name.
+const binaryName = "matlab-mcp-core-server"
+
+const backupSuffix = ".bak"
+
+const configFilePermissions = 0o600
+
+type Config interface {
+	UninstallMode() bool
+	InstallClients() []entities.MCPClient
+	InstallServerArgs() []string
+}
+
+type OSLayer interface {
+	Stdout() io.Writer
+	Stderr() io.Writer
+	Executable() (string, error)
+	EvalSymlinks(path string) (string, error)
+	UserHomeDir() (string, error)
+	UserConfigDir() (string, error)
+	Stat(name string) (osfacade.FileInfo, error)
+	ReadFile(name string) ([]byte, error)
+	WriteFile(name string, data []byte, perm os.FileMode) error
+}
+
+// clientConfig is where, and how, a client stores the MCP servers it starts.
+type clientConfig struct {
+	displayName string
+	path        string
+	// detectPath is the file or folder showing that the client is installed.
+	detectPath string
+	serversKey string
+	// stdioType is true when the entries of the client declare their transport.
+	stdioType bool
+}
+
+// Install registers the MATLAB MCP Core Server with MCP clients, or removes it, by editing their configuration files.
+// Existing entries are updated in place, so that running it again after an upgrade points the clients to the new binary.
+// Files are backed up before they are changed.
+type Install struct {
+	config  Config
+	osLayer OSLayer
+}
+
+func New(
+	config Config,
+	osLayer OSLayer,
+) *Install {
+	return &Install{
+		config:  config,
+		osLayer: osLayer,
+	}
+}
+
+// StartAndWaitForCompletion updates the configuration of the clients, and fails if any of them could not be updated.
+// Failures are also written to stderr, as this mode has no log file.
+func (i *Install) StartAndWaitForCompletion(_ context.Context) error {
+	if err := i.run(); err != nil {
+		_, _ = fmt.Fprintf(i.osLayer.Stderr(), "%s failed: %v\n", i.commandName(), err)
+		return err
+	}
+	return nil
+}
+
+func (i *Install) commandName() string {
+	if i.config.UninstallMode() {
+		return "Uninstall"
+	}
+	return "Install"
+}
+
+func (i *Install) run() error {
+	configs, err := i.clientConfigs()
+	if err != nil {
+		return err
+	}
+
+	clients := i.config.InstallClients()
+	detectedOnly := len(clients) == 0
+	if detectedOnly {
+		clients = entities.MCPClients
+	}
+
+	var command string
+	if !i.config.UninstallMode() {
+		command, err = i.binaryPath()
+		if err != nil {
+			return err
+		}
+	}
+
+	stdout := i.osLayer.Stdout()
+	updated, failed := 0, 0
+	for _, client := range clients {
+		clientConfig := configs[client]
+
+		if _, err := i.osLayer.Stat(clientConfig.detectPath); err != nil {
+			if detectedOnly {
+				continue
+			}
+			failed++
+			if _, err := fmt.Fprintf(stdout, "%s: failed: the client was not found in %s\n", clientConfig.displayName, clientConfig.detectPath); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var outcome string
+		if i.config.UninstallMode() {
+			outcome, err = i.unregister(clientConfig)
+		} else {
+			outcome, err = i.register(clientConfig, command, i.config.InstallServerArgs())
+		}
+		if err != nil {
+			failed++
+			outcome = fmt.Sprintf("failed: %v", err)
+		} else {
+			updated++
+		}
+
+		if _, err := fmt.Fprintf(stdout, "%s: %s\n", clientConfig.displayName, outcome); err != nil {
+			return err
+		}
+	}
+
+	if updated+failed == 0 {
+		names := make([]string, 0, len(entities.MCPClients))
+		for _, client := range entities.MCPClients {
+			names = append(names, string(client))
+		}
+		return fmt.Errorf("no MCP client was found, name the clients to update after the command, among: %s", strings.Join(names, ", "))
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d clients could not be updated", failed, updated+failed)
+	}
+
+	_, err = fmt.Fprintln(stdout, "Restart the clients to apply the changes.")
+	return err
+}
+
+func (i *Install) clientConfigs() (map[entities.MCPClient]clientConfig, error) {
+	home, err := i.osLayer.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to find the home folder: %w", err)
+	}
+
+	configDir, err := i.osLayer.UserConfigDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to find the configuration folder: %w", err)
+	}
+
+	return map[entities.MCPClient]clientConfig{
+		entities.MCPClientClaudeCode: {
+			displayName: "Claude Code",
+			path:        filepath.Join(home, ".claude.json"),
+			detectPath:  filepath.Join(home, ".claude.json"),
+			serversKey:  "mcpServers",
+			stdioType:   true,
+		},
+		entities.MCPClientClaudeDesktop: {
+			displayName: "Claude Desktop",
+			path:        filepath.Join(configDir, "Claude", "claude_desktop_config.json"),
+			detectPath:  filepath.Join(configDir, "Claude"),
+			serversKey:  "mcpServers",
+		},
+		entities.MCPClientCursor: {
+			displayName: "Cursor",
+			path:        filepath.Join(home, ".cursor", "mcp.json"),
+			detectPath:  filepath.Join(home, ".cursor"),
+			serversKey:  "mcpServers",
+		},
+		entities.MCPClientVSCode: {
+			displayName: "VS Code",
+			path:        filepath.Join(configDir, "Code", "User", "mcp.json"),
+			detectPath:  filepath.Join(configDir, "Code", "User"),
+			serversKey:  "servers",
+			stdioType:   true,
+		},
+	}, nil
+}
+
+// binaryPath is the path of the running binary, with symbolic links resolved, so that the clients keep working
+// if the link is moved.
+func (i *Install) binaryPath() (string, error) {
+	executable, err := i.osLayer.Executable()
+	if err != nil {
+		return "", fmt.Errorf("failed to find the path of the server binary: %w", err)
+	}
+
+	path, err := i.osLayer.EvalSymlinks(executable)
+	if err != nil {
+		return "", fmt.Errorf("failed to find the path of the server binary: %w", err)
+	}
+
+	return path, nil
+}
+
+// register adds the server to the configuration file of a client, or updates its existing entry. The arguments of an
+// existing entry are kept when no arguments are given, so that only the path of the binary changes after an upgrade.
+func (i *Install) register(clientConfig clientConfig, command string, args []string) (string, error) {
+	file, err := i.readConfigFile(clientConfig)
+	if err != nil {
+		return "", err
+	}
+
+	name, entry, err := findEntry(file.servers)
+	if err != nil {
+		return "", err
+	}
+
+	previous, err := json.Marshal(entry)
+	if err != nil {
+		return "", err
+	}
+
+	existing := name != ""
+	if !existing {
+		name = serverName
+		entry = map[string]json.RawMessage{}
+	}
+
+	if len(args) == 0 {
+		if _, ok := entry["args"]; !ok {
+			entry["args"] = json.RawMessage("[]")
+		}
+	} else if entry["args"], err = json.Marshal(args); err != nil {
+		return "", err
+	}
+	if entry["command"], err = json.Marshal(command); err != nil {
+		return "", err
+	}
+	if clientConfig.stdioType {
+		entry["type"] = json.RawMessage(`"stdio"`)
+	}
+
+	current, err := json.Marshal(entry)
+	if err != nil {
+		return "", err
+	}
+	if bytes.Equal(previous, current) {
+		return fmt.Sprintf("already registered as %q in %s", name, clientConfig.path), nil
+	}
+
+	file.servers[name] = current
+	backup, err := i.writeConfigFile(clientConfig, file)
+	if err != nil {
+		return "", err
+	}
+
+	if !existing {
+		return fmt.Sprintf("registered as %q in %s%s", name, clientConfig.path, backupNote(backup)), nil
+	}
+	return fmt.Sprintf("updated %q in %s%s", name, clientConfig.path, backupNote(backup)), nil
+}
+
+// unregister removes the server from the configuration file of a client.
+func (i *Install) unregister(clientConfig clientConfig) (string, error) {
+	file, err := i.readConfigFile(clientConfig)
+	if err != nil {
+		return "", err
+	}
+
+	name, _, err := findEntry(file.servers)
+	if err != nil {
+		return "", err
+	}
+	if name == "" {
+		return fmt.Sprintf("not registered in %s", clientConfig.path), nil
+	}
+
+	delete(file.servers, name)
+	backup, err := i.writeConfigFile(clientConfig, file)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("removed %q from %s%s", name, clientConfig.path, backupNote(backup)), nil
+}
+
+// configFile is the content of the configuration file of a client. Only the servers are decoded, the other fields are
+// written back as they were read.
+type configFile struct {
+	content []byte
+	fields  map[string]json.RawMessage
+	servers map[string]json.RawMessage
+}
+
+func (i *Install) readConfigFile(clientConfig clientConfig) (configFile, error) {
+	file := configFile{
+		fields:  map[string]json.RawMessage{},
+		servers: map[string]json.RawMessage{},
+	}
+
+	content, err := i.osLayer.ReadFile(clientConfig.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return file, nil
+	}
+	if err != nil {
+		return configFile{}, fmt.Errorf("failed to read %s: %w", clientConfig.path, err)
+	}
+	file.content = content
+
+	if len(bytes.TrimSpace(content)) == 0 {
+		return file, nil
+	}
+
+	if err := json.Unmarshal(content, &file.fields); err != nil {
+		return configFile{}, fmt.Errorf("%s is not a JSON object, edit it by hand: %w", clientConfig.path, err)
+	}
+
+	if raw, ok := file.fields[clientConfig.serversKey]; ok && string(raw) != "null" {
+		if err := json.Unmarshal(raw, &file.servers); err != nil {
+			return configFile{}, fmt.Errorf("the %s field of %s is not a JSON object, edit it by hand: %w", clientConfig.serversKey, clientConfig.path, err)
+		}
+	}
+
+	return file, nil
+}
+
+// writeConfigFile writes the configuration file of a client, after copying its previous content to a backup file.
+// It returns the path of the backup file, or an empty string if the file did not exist.
+func (i *Install) writeConfigFile(clientConfig clientConfig, file configFile) (string, error) {
+	servers, err := json.Marshal(file.servers)
+	if err != nil {
+		return "", err
+	}
+	file.fields[clientConfig.serversKey] = servers
+
+	data, err := json.MarshalIndent(file.fields, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	backup := ""
+	if file.content != nil {
+		backup = clientConfig.path + backupSuffix
+		if err := i.osLayer.WriteFile(backup, file.content, configFilePermissions); err != nil {
+			return "", fmt.Errorf("failed to back up %s: %w", clientConfig.path, err)
+		}
+	}
+
+	if err := i.osLayer.WriteFile(clientConfig.path, append(data, '\n'), configFilePermissions); err != nil {
+		return "", fmt.Errorf("failed to write %s: %w", clientConfig.path, err)
+	}
+
+	return backup, nil
+}
+
+// findEntry returns the entry of the server, registered as serverName, or else under any name running a server binary.
+// It returns an empty name when the server is not registered.
+func findEntry(servers map[string]json.RawMessage) (string, map[string]json.RawMessage, error) {
+	names := make([]string, 0, len(servers))
+	for name := range servers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	// Look at the entry named after the server first.
+	if index := slices.Index(names, serverName); index > 0 {
+		names[0], names[index] = names[index], names[0]
+	}
+
+	for _, name := range names {
+		var entry map[string]json.RawMessage
+		if err := json.Unmarshal(servers[name], &entry); err != nil {
+			if name == serverName {
+				return "", nil, fmt.Errorf("the %q entry is not a JSON object, edit it by hand: %w", name, err)
+			}
+			continue
+		}
+
+		if name == serverName {
+			return name, entry, nil
+		}
+
+		var command string
+		if err := json.Unmarshal(entry["command"], &command); err == nil && strings.HasPrefix(filepath.Base(command), binaryName) {
+			return name, entry, nil
+		}
+	}
+
+	return "", nil, nil
+}
+
+func backupNote(backup string) string {
+	if backup == "" {
+		return ""
+	}
+	return fmt.Sprintf(", previous version backed up to %s", backup)
+}
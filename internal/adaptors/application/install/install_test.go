@@ -0,0 +1,378 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package install_test
+
+import (
+	"bytes"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/install"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	installmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/install"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	homeDir    = "/home/user"
+	configDir  = "/home/user/.config"
+	binaryPath = "/opt/matlab-mcp/matlab-mcp-core-server"
+)
+
+var (
+	claudeCodeFile = filepath.Join(homeDir, ".claude.json")
+	cursorDir      = filepath.Join(homeDir, ".cursor")
+	cursorFile     = filepath.Join(cursorDir, "mcp.json")
+	claudeDir      = filepath.Join(configDir, "Claude")
+	vsCodeDir      = filepath.Join(configDir, "Code", "User")
+)
+
+func arrangeDirectories(mockOSLayer *installmocks.MockOSLayer) {
+	mockOSLayer.EXPECT().
+		UserHomeDir().
+		Return(homeDir, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		UserConfigDir().
+		Return(configDir, nil).
+		Once()
+}
+
+func arrangeBinaryPath(mockOSLayer *installmocks.MockOSLayer) {
+	mockOSLayer.EXPECT().
+		Executable().
+		Return("/usr/local/bin/matlab-mcp-core-server", nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		EvalSymlinks("/usr/local/bin/matlab-mcp-core-server").
+		Return(binaryPath, nil).
+		Once()
+}
+
+func arrangeDetectedClients(mockOSLayer *installmocks.MockOSLayer, detected ...string) {
+	for _, path := range []string{claudeCodeFile, claudeDir, cursorDir, vsCodeDir} {
+		var err error
+		if !slices.Contains(detected, path) {
+			err = fs.ErrNotExist
+		}
+		mockOSLayer.EXPECT().
+			Stat(path).
+			Return(nil, err).
+			Once()
+	}
+}
+
+func TestInstall_RegistersDetectedClients(t *testing.T) {
+	// Arrange
+	mockConfig := &installmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &installmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	stdout := &bytes.Buffer{}
+	existingContent := []byte(`{"numStartups": 3, "mcpServers": {"other": {"command": "npx"}}}`)
+
+	mockConfig.EXPECT().UninstallMode().Return(false)
+	mockConfig.EXPECT().InstallClients().Return(nil).Once()
+	mockConfig.EXPECT().InstallServerArgs().Return([]string{"--initial-working-folder=/home/user/work"})
+
+	arrangeDirectories(mockOSLayer)
+	arrangeBinaryPath(mockOSLayer)
+	arrangeDetectedClients(mockOSLayer, claudeCodeFile, cursorDir)
+	mockOSLayer.EXPECT().Stdout().Return(stdout).Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(claudeCodeFile).
+		Return(existingContent, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		WriteFile(claudeCodeFile+".bak", existingContent, os.FileMode(0o600)).
+		Return(nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		WriteFile(claudeCodeFile, mock.Anything, os.FileMode(0o600)).
+		RunAndReturn(func(_ string, data []byte, _ os.FileMode) error {
+			assert.JSONEq(t, `{
+				"numStartups": 3,
+				"mcpServers": {
+					"other": {"command": "npx"},
+					"matlab": {"type": "stdio", "command": "/opt/matlab-mcp/matlab-mcp-core-server", "args": ["--initial-working-folder=/home/user/work"]}
+				}
+			}`, string(data))
+			return nil
+		}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(cursorFile).
+		Return(nil, fs.ErrNotExist).
+		Once()
+
+	mockOSLayer.EXPECT().
+		WriteFile(cursorFile, mock.Anything, os.FileMode(0o600)).
+		RunAndReturn(func(_ string, data []byte, _ os.FileMode) error {
+			assert.JSONEq(t, `{
+				"mcpServers": {
+					"matlab": {"command": "/opt/matlab-mcp/matlab-mcp-core-server", "args": ["--initial-working-folder=/home/user/work"]}
+				}
+			}`, string(data))
+			return nil
+		}).
+		Once()
+
+	installer := install.New(mockConfig, mockOSLayer)
+
+	// Act
+	err := installer.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), `Claude Code: registered as "matlab" in `+claudeCodeFile+", previous version backed up to "+claudeCodeFile+".bak")
+	assert.Contains(t, stdout.String(), `Cursor: registered as "matlab" in `+cursorFile+"\n")
+	assert.Contains(t, stdout.String(), "Restart the clients to apply the changes.")
+}
+
+func TestInstall_UpdatesBinaryPathOfExistingEntry(t *testing.T) {
+	// Arrange
+	mockConfig := &installmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &installmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	stdout := &bytes.Buffer{}
+	existingContent := []byte(`{"mcpServers": {"matlab-server": {"command": "/old/matlab-mcp-core-server-glnxa64", "args": ["--log-level=debug"], "env": {"MW_VAR": "1"}}}}`)
+
+	mockConfig.EXPECT().UninstallMode().Return(false)
+	mockConfig.EXPECT().InstallClients().Return([]entities.MCPClient{entities.MCPClientCursor}).Once()
+	mockConfig.EXPECT().InstallServerArgs().Return([]string{}).Once()
+
+	arrangeDirectories(mockOSLayer)
+	arrangeBinaryPath(mockOSLayer)
+	mockOSLayer.EXPECT().Stat(cursorDir).Return(nil, nil).Once()
+	mockOSLayer.EXPECT().Stdout().Return(stdout).Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(cursorFile).
+		Return(existingContent, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		WriteFile(cursorFile+".bak", existingContent, os.FileMode(0o600)).
+		Return(nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		WriteFile(cursorFile, mock.Anything, os.FileMode(0o600)).
+		RunAndReturn(func(_ string, data []byte, _ os.FileMode) error {
+			assert.JSONEq(t, `{
+				"mcpServers": {
+					"matlab-server": {"command": "/opt/matlab-mcp/matlab-mcp-core-server", "args": ["--log-level=debug"], "env": {"MW_VAR": "1"}}
+				}
+			}`, string(data))
+			return nil
+		}).
+		Once()
+
+	installer := install.New(mockConfig, mockOSLayer)
+
+	// Act
+	err := installer.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), `Cursor: updated "matlab-server" in `+cursorFile)
+}
+
+func TestInstall_AlreadyRegisteredIsNotWritten(t *testing.T) {
+	// Arrange
+	mockConfig := &installmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &installmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	stdout := &bytes.Buffer{}
+
+	mockConfig.EXPECT().UninstallMode().Return(false)
+	mockConfig.EXPECT().InstallClients().Return([]entities.MCPClient{entities.MCPClientCursor}).Once()
+	mockConfig.EXPECT().InstallServerArgs().Return([]string{}).Once()
+
+	arrangeDirectories(mockOSLayer)
+	arrangeBinaryPath(mockOSLayer)
+	mockOSLayer.EXPECT().Stat(cursorDir).Return(nil, nil).Once()
+	mockOSLayer.EXPECT().Stdout().Return(stdout).Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(cursorFile).
+		Return([]byte(`{"mcpServers": {"matlab": {"command": "/opt/matlab-mcp/matlab-mcp-core-server", "args": []}}}`), nil).
+		Once()
+
+	installer := install.New(mockConfig, mockOSLayer)
+
+	// Act
+	err := installer.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), `Cursor: already registered as "matlab" in `+cursorFile)
+}
+
+func TestInstall_InvalidConfigFileIsNotOverwritten(t *testing.T) {
+	// Arrange
+	mockConfig := &installmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &installmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+
+	mockConfig.EXPECT().UninstallMode().Return(false)
+	mockConfig.EXPECT().InstallClients().Return([]entities.MCPClient{entities.MCPClientCursor}).Once()
+	mockConfig.EXPECT().InstallServerArgs().Return([]string{}).Once()
+
+	arrangeDirectories(mockOSLayer)
+	arrangeBinaryPath(mockOSLayer)
+	mockOSLayer.EXPECT().Stat(cursorDir).Return(nil, nil).Once()
+	mockOSLayer.EXPECT().Stdout().Return(stdout).Once()
+	mockOSLayer.EXPECT().Stderr().Return(stderr).Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(cursorFile).
+		Return([]byte(`{"mcpServers": { // comment`), nil).
+		Once()
+
+	installer := install.New(mockConfig, mockOSLayer)
+
+	// Act
+	err := installer.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.EqualError(t, err, "1 of 1 clients could not be updated")
+	assert.Contains(t, stdout.String(), "Cursor: failed: "+cursorFile+" is not a JSON object, edit it by hand")
+	assert.Equal(t, "Install failed: 1 of 1 clients could not be updated\n", stderr.String())
+}
+
+func TestInstall_NamedClientNotFound(t *testing.T) {
+	// Arrange
+	mockConfig := &installmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &installmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+
+	mockConfig.EXPECT().UninstallMode().Return(false)
+	mockConfig.EXPECT().InstallClients().Return([]entities.MCPClient{entities.MCPClientClaudeDesktop}).Once()
+
+	arrangeDirectories(mockOSLayer)
+	arrangeBinaryPath(mockOSLayer)
+	mockOSLayer.EXPECT().Stat(claudeDir).Return(nil, fs.ErrNotExist).Once()
+	mockOSLayer.EXPECT().Stdout().Return(stdout).Once()
+	mockOSLayer.EXPECT().Stderr().Return(stderr).Once()
+
+	installer := install.New(mockConfig, mockOSLayer)
+
+	// Act
+	err := installer.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.EqualError(t, err, "1 of 1 clients could not be updated")
+	assert.Equal(t, "Claude Desktop: failed: the client was not found in "+claudeDir+"\n", stdout.String())
+}
+
+func TestInstall_NoClientFound(t *testing.T) {
+	// Arrange
+	mockConfig := &installmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &installmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+
+	mockConfig.EXPECT().UninstallMode().Return(false)
+	mockConfig.EXPECT().InstallClients().Return(nil).Once()
+
+	arrangeDirectories(mockOSLayer)
+	arrangeBinaryPath(mockOSLayer)
+	arrangeDetectedClients(mockOSLayer)
+	mockOSLayer.EXPECT().Stdout().Return(stdout).Once()
+	mockOSLayer.EXPECT().Stderr().Return(stderr).Once()
+
+	installer := install.New(mockConfig, mockOSLayer)
+
+	// Act
+	err := installer.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.ErrorContains(t, err, "no MCP client was found, name the clients to update after the command, among: claude-code, claude-desktop, cursor, vscode")
+	assert.Empty(t, stdout.String())
+}
+
+func TestUninstall_RemovesEntry(t *testing.T) {
+	// Arrange
+	mockConfig := &installmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &installmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	stdout := &bytes.Buffer{}
+	existingContent := []byte(`{"mcpServers": {"other": {"command": "npx"}, "matlab": {"command": "/opt/matlab-mcp/matlab-mcp-core-server", "args": []}}}`)
+
+	mockConfig.EXPECT().UninstallMode().Return(true)
+	mockConfig.EXPECT().InstallClients().Return(nil).Once()
+
+	arrangeDirectories(mockOSLayer)
+	arrangeDetectedClients(mockOSLayer, cursorDir, vsCodeDir)
+	mockOSLayer.EXPECT().Stdout().Return(stdout).Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(cursorFile).
+		Return(existingContent, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		WriteFile(cursorFile+".bak", existingContent, os.FileMode(0o600)).
+		Return(nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		WriteFile(cursorFile, mock.Anything, os.FileMode(0o600)).
+		RunAndReturn(func(_ string, data []byte, _ os.FileMode) error {
+			assert.JSONEq(t, `{"mcpServers": {"other": {"command": "npx"}}}`, string(data))
+			return nil
+		}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(vsCodeDir, "mcp.json")).
+		Return(nil, fs.ErrNotExist).
+		Once()
+
+	installer := install.New(mockConfig, mockOSLayer)
+
+	// Act
+	err := installer.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), `Cursor: removed "matlab" from `+cursorFile+", previous version backed up to "+cursorFile+".bak")
+	assert.Contains(t, stdout.String(), "VS Code: not registered in "+filepath.Join(vsCodeDir, "mcp.json"))
+}
@@ -0,0 +1,68 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package jupyterkernel
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"net"
+	"sync"
+
+	"github.com/modelcontextprotocol/go-sdk/jsonrpc"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// daemonTransport is an MCP client transport over a connection to the daemon socket,
+// which exchanges newline-delimited JSON messages as the standard input and output transport does.
+type daemonTransport struct {
+	conn net.Conn
+}
+
+func (t *daemonTransport) Connect(context.Context) (mcp.Connection, error) {
+	return &daemonConnection{
+		conn:      t.conn,
+		reader:    bufio.NewReader(t.conn),
+		writeLock: new(sync.Mutex),
+	}, nil
+}
+
+type daemonConnection struct {
+	conn      net.Conn
+	reader    *bufio.Reader
+	writeLock *sync.Mutex
+}
+
+func (c *daemonConnection) Read(context.Context) (jsonrpc.Message, error) {
+	for {
+		line, err := c.reader.ReadBytes('\n')
+		line = bytes.TrimSpace(line)
+		if len(line) > 0 {
+			return jsonrpc.DecodeMessage(line)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (c *daemonConnection) Write(_ context.Context, message jsonrpc.Message) error {
+	data, err := jsonrpc.EncodeMessage(message)
+	if err != nil {
+		return err
+	}
+
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+
+	_, err = c.conn.Write(append(data, '\n'))
+	return err
+}
+
+func (c *daemonConnection) Close() error {
+	return c.conn.Close()
+}
+
+func (c *daemonConnection) SessionID() string {
+	return ""
+}
@@ -0,0 +1,467 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package jupyterkernel
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/zmtp"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	clientName   = "matlab-mcp-core-server-kernel"
+	evalToolName = "evaluate_matlab_code"
+
+	signatureScheme = "hmac-sha256"
+)
+
+type Config interface {
+	Version() string
+	KernelConnectionFile() string
+}
+
+type DaemonSocket interface {
+	Dial() (net.Conn, error)
+}
+
+type NetLayer interface {
+	Listen(network string, address string) (net.Listener, error)
+}
+
+type OSLayer interface {
+	ReadFile(filePath string) ([]byte, error)
+	Getwd() (string, error)
+	Stderr() io.Writer
+}
+
+// connectionFile is the file Jupyter writes before it starts a kernel, with the ports the kernel listens on.
+type connectionFile struct {
+	Transport       string `json:"transport"`
+	IP              string `json:"ip"`
+	ShellPort       int    `json:"shell_port"`
+	IOPubPort       int    `json:"iopub_port"`
+	StdinPort       int    `json:"stdin_port"`
+	ControlPort     int    `json:"control_port"`
+	HeartbeatPort   int    `json:"hb_port"`
+	Key             string `json:"key"`
+	SignatureScheme string `json:"signature_scheme"`
+}
+
+// Kernel is a Jupyter kernel evaluating the cells of a notebook in the MATLAB session of the daemon,
+// so that the notebook and the MCP clients attached to the daemon share the same workspace.
+// It connects to the daemon as one more MCP client, and does not start it: stopping the kernel leaves MATLAB running.
+type Kernel struct {
+	config       Config
+	daemonSocket DaemonSocket
+	netLayer     NetLayer
+	osLayer      OSLayer
+}
+
+func New(
+	config Config,
+	daemonSocket DaemonSocket,
+	netLayer NetLayer,
+	osLayer OSLayer,
+) *Kernel {
+	return &Kernel{
+		config:       config,
+		daemonSocket: daemonSocket,
+		netLayer:     netLayer,
+		osLayer:      osLayer,
+	}
+}
+
+// StartAndWaitForCompletion serves the frontends of the kernel until one of them requests a shutdown.
+// Failures are also written to stderr, which Jupyter shows in its log, as this mode has no log file.
+func (k *Kernel) StartAndWaitForCompletion(ctx context.Context) error {
+	if err := k.run(ctx); err != nil {
+		_, _ = fmt.Fprintf(k.osLayer.Stderr(), "MATLAB Jupyter kernel failed: %v\n", err)
+		return err
+	}
+	return nil
+}
+
+func (k *Kernel) run(ctx context.Context) error {
+	connection, err := k.readConnectionFile()
+	if err != nil {
+		return err
+	}
+
+	projectPath, err := k.osLayer.Getwd()
+	if err != nil {
+		return err
+	}
+
+	conn, err := k.daemonSocket.Dial()
+	if err != nil {
+		return fmt.Errorf("the MATLAB MCP Core Server daemon is not running, start it with --daemon, or start an MCP client with --attach: %w", err)
+	}
+
+	client := mcp.NewClient(&mcp.Implementation{Name: clientName, Version: k.config.Version()}, nil)
+	session, err := client.Connect(ctx, &daemonTransport{conn: conn}, nil)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("failed to connect to the MATLAB MCP Core Server daemon: %w", err)
+	}
+	defer func() {
+		_ = session.Close()
+	}()
+
+	sockets, err := k.listen(connection)
+	if err != nil {
+		return err
+	}
+	defer sockets.close()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	state := &kernelState{
+		version:     k.config.Version(),
+		session:     session,
+		projectPath: projectPath,
+		signer:      signer{key: []byte(connection.Key)},
+		sockets:     sockets,
+		shutdown:    cancel,
+	}
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.Go(func() error { return state.serveHeartbeat(groupCtx) })
+	group.Go(func() error { return state.serveRequests(groupCtx, sockets.shell) })
+	group.Go(func() error { return state.serveRequests(groupCtx, sockets.control) })
+	group.Go(func() error { return state.discardRequests(groupCtx, sockets.stdin) })
+
+	err = group.Wait()
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+	return err
+}
+
+func (k *Kernel) readConnectionFile() (connectionFile, error) {
+	path := k.config.KernelConnectionFile()
+
+	data, err := k.osLayer.ReadFile(path)
+	if err != nil {
+		return connectionFile{}, fmt.Errorf("failed to read the connection file: %w", err)
+	}
+
+	var connection connectionFile
+	if err := json.Unmarshal(data, &connection); err != nil {
+		return connectionFile{}, fmt.Errorf("failed to parse the connection file %s: %w", path, err)
+	}
+
+	if connection.Transport != "" && connection.Transport != "tcp" {
+		return connectionFile{}, fmt.Errorf("the %s transport of the connection file is not supported, only tcp is", connection.Transport)
+	}
+	if connection.Key != "" && connection.SignatureScheme != "" && connection.SignatureScheme != signatureScheme {
+		return connectionFile{}, fmt.Errorf("the %s signature scheme of the connection file is not supported, only %s is", connection.SignatureScheme, signatureScheme)
+	}
+
+	return connection, nil
+}
+
+// sockets are the sockets of the kernel, one per channel of the messaging protocol.
+type sockets struct {
+	shell     *zmtp.Socket
+	control   *zmtp.Socket
+	stdin     *zmtp.Socket
+	iopub     *zmtp.Socket
+	heartbeat *zmtp.Socket
+}
+
+func (k *Kernel) listen(connection connectionFile) (*sockets, error) {
+	result := &sockets{}
+
+	channels := []struct {
+		socket     **zmtp.Socket
+		port       int
+		socketType zmtp.SocketType
+	}{
+		{&result.shell, connection.ShellPort, zmtp.SocketTypeRouter},
+		{&result.control, connection.ControlPort, zmtp.SocketTypeRouter},
+		{&result.stdin, connection.StdinPort, zmtp.SocketTypeRouter},
+		{&result.iopub, connection.IOPubPort, zmtp.SocketTypePub},
+		{&result.heartbeat, connection.HeartbeatPort, zmtp.SocketTypeRep},
+	}
+
+	for _, channel := range channels {
+		listener, err := k.netLayer.Listen("tcp", net.JoinHostPort(connection.IP, strconv.Itoa(channel.port)))
+		if err != nil {
+			result.close()
+			return nil, fmt.Errorf("failed to listen on the ports of the connection file: %w", err)
+		}
+		*channel.socket = zmtp.NewSocket(listener, channel.socketType)
+	}
+
+	return result, nil
+}
+
+func (s *sockets) close() {
+	for _, socket := range []*zmtp.Socket{s.shell, s.control, s.stdin, s.iopub, s.heartbeat} {
+		if socket != nil {
+			_ = socket.Close()
+		}
+	}
+}
+
+// kernelState is the state shared by the channels of a running kernel.
+type kernelState struct {
+	version     string
+	session     *mcp.ClientSession
+	projectPath string
+	signer      signer
+	sockets     *sockets
+	shutdown    context.CancelFunc
+
+	lock           sync.Mutex
+	executionCount int
+	interrupt      context.CancelFunc
+}
+
+// serveHeartbeat echoes the heartbeats of the frontends, so that they know the kernel is alive.
+func (s *kernelState) serveHeartbeat(ctx context.Context) error {
+	for {
+		request, err := s.sockets.heartbeat.Receive(ctx)
+		if err != nil {
+			return err
+		}
+		_ = s.sockets.heartbeat.Reply(request, request.Frames)
+	}
+}
+
+// discardRequests receives the messages of a channel the kernel does not use, as the kernel never asks for input.
+func (s *kernelState) discardRequests(ctx context.Context, socket *zmtp.Socket) error {
+	for {
+		if _, err := socket.Receive(ctx); err != nil {
+			return err
+		}
+	}
+}
+
+// serveRequests answers the requests received on the shell or control channel, one at a time.
+// Requests that cannot be decoded, such as those with an invalid signature, are dropped as the protocol requires.
+func (s *kernelState) serveRequests(ctx context.Context, socket *zmtp.Socket) error {
+	for {
+		received, err := socket.Receive(ctx)
+		if err != nil {
+			return err
+		}
+
+		request, err := s.signer.decode(received.Frames)
+		if err != nil {
+			continue
+		}
+
+		s.publish(request, "status", map[string]any{"execution_state": "busy"})
+		err = s.handle(ctx, socket, received, request)
+		s.publish(request, "status", map[string]any{"execution_state": "idle"})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *kernelState) handle(ctx context.Context, socket *zmtp.Socket, received zmtp.Message, request message) error {
+	replyType := strings.TrimSuffix(request.header.MessageType, "_request") + "_reply"
+
+	switch request.header.MessageType {
+	case "kernel_info_request":
+		return s.reply(socket, received, request, replyType, s.kernelInfo())
+	case "execute_request":
+		return s.reply(socket, received, request, replyType, s.execute(ctx, request))
+	case "is_complete_request":
+		return s.reply(socket, received, request, replyType, map[string]any{"status": "complete"})
+	case "complete_request":
+		var content struct {
+			CursorPosition int `json:"cursor_pos"`
+		}
+		_ = json.Unmarshal(request.content, &content)
+		return s.reply(socket, received, request, replyType, map[string]any{
+			"status": "ok", "matches": []string{}, "cursor_start": content.CursorPosition, "cursor_end": content.CursorPosition, "metadata": map[string]any{},
+		})
+	case "inspect_request":
+		return s.reply(socket, received, request, replyType, map[string]any{"status": "ok", "found": false, "data": map[string]any{}, "metadata": map[string]any{}})
+	case "history_request":
+		return s.reply(socket, received, request, replyType, map[string]any{"status": "ok", "history": []any{}})
+	case "comm_info_request":
+		return s.reply(socket, received, request, replyType, map[string]any{"status": "ok", "comms": map[string]any{}})
+	case "interrupt_request":
+		s.lock.Lock()
+		if s.interrupt != nil {
+			s.interrupt()
+		}
+		s.lock.Unlock()
+		return s.reply(socket, received, request, replyType, map[string]any{"status": "ok"})
+	case "shutdown_request":
+		var content struct {
+			Restart bool `json:"restart"`
+		}
+		_ = json.Unmarshal(request.content, &content)
+		err := s.reply(socket, received, request, replyType, map[string]any{"status": "ok", "restart": content.Restart})
+		s.shutdown()
+		return err
+	default:
+		return nil
+	}
+}
+
+func (s *kernelState) kernelInfo() map[string]any {
+	return map[string]any{
+		"status":                 "ok",
+		"protocol_version":       protocolVersion,
+		"implementation":         clientName,
+		"implementation_version": s.version,
+		"language_info": map[string]any{
+			"name":           "matlab",
+			"mimetype":       "text/x-matlab",
+			"file_extension": ".m",
+		},
+		"banner": "MATLAB, in the session of the MATLAB MCP Core Server " + s.version,
+	}
+}
+
+// execute evaluates the code of a cell with the evaluate_matlab_code tool, in the working folder of the kernel,
+// and publishes its outputs and figures.
+func (s *kernelState) execute(ctx context.Context, request message) map[string]any {
+	var content struct {
+		Code   string `json:"code"`
+		Silent bool   `json:"silent"`
+	}
+	if err := json.Unmarshal(request.content, &content); err != nil {
+		return s.publishError(request, "MalformedRequest", err.Error(), s.currentExecutionCount())
+	}
+
+	s.lock.Lock()
+	if !content.Silent {
+		s.executionCount++
+	}
+	executionCount := s.executionCount
+	ctx, cancel := context.WithCancel(ctx)
+	s.interrupt = cancel
+	s.lock.Unlock()
+
+	defer func() {
+		s.lock.Lock()
+		s.interrupt = nil
+		s.lock.Unlock()
+		cancel()
+	}()
+
+	if !content.Silent {
+		s.publish(request, "execute_input", map[string]any{"code": content.Code, "execution_count": executionCount})
+	}
+
+	if strings.TrimSpace(content.Code) == "" {
+		return map[string]any{"status": "ok", "execution_count": executionCount, "user_expressions": map[string]any{}}
+	}
+
+	result, err := s.session.CallTool(ctx, &mcp.CallToolParams{
+		Name:      evalToolName,
+		Arguments: map[string]any{"project_path": s.projectPath, "code": content.Code},
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return s.publishError(request, "KeyboardInterrupt", "The execution was interrupted", executionCount)
+		}
+		return s.publishError(request, "MCPError", err.Error(), executionCount)
+	}
+
+	var texts []string
+	for _, item := range result.Content {
+		switch item := item.(type) {
+		case *mcp.TextContent:
+			texts = append(texts, item.Text)
+			if !result.IsError && !content.Silent {
+				s.publish(request, "stream", map[string]any{"name": "stdout", "text": item.Text})
+			}
+		case *mcp.ImageContent:
+			if !content.Silent {
+				s.publishImage(request, item.MIMEType, item.Data)
+			}
+		case *mcp.ResourceLink:
+			if !content.Silent && strings.HasPrefix(item.MIMEType, "image/") {
+				s.publishFigure(ctx, request, item.URI)
+			}
+		}
+	}
+
+	if result.IsError {
+		return s.publishError(request, "MATLABError", strings.Join(texts, "\n"), executionCount)
+	}
+
+	return map[string]any{"status": "ok", "execution_count": executionCount, "user_expressions": map[string]any{}}
+}
+
+// publishFigure reads a figure the server returned as a link to its resource, and publishes it.
+// A figure that cannot be read is skipped, as the output of the cell was already published.
+func (s *kernelState) publishFigure(ctx context.Context, request message, uri string) {
+	result, err := s.session.ReadResource(ctx, &mcp.ReadResourceParams{URI: uri})
+	if err != nil {
+		return
+	}
+	for _, resourceContents := range result.Contents {
+		if len(resourceContents.Blob) > 0 {
+			s.publishImage(request, resourceContents.MIMEType, resourceContents.Blob)
+		}
+	}
+}
+
+func (s *kernelState) publishImage(request message, mimeType string, data []byte) {
+	s.publish(request, "display_data", map[string]any{
+		"data":      map[string]any{mimeType: base64.StdEncoding.EncodeToString(data)},
+		"metadata":  map[string]any{},
+		"transient": map[string]any{},
+	})
+}
+
+// publishError publishes an error on the IOPub channel, and returns the content of the matching error reply.
+func (s *kernelState) publishError(request message, name string, value string, executionCount int) map[string]any {
+	content := map[string]any{
+		"status":    "error",
+		"ename":     name,
+		"evalue":    value,
+		"traceback": strings.Split(value, "\n"),
+	}
+	s.publish(request, "error", content)
+
+	content["execution_count"] = executionCount
+	return content
+}
+
+func (s *kernelState) currentExecutionCount() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.executionCount
+}
+
+func (s *kernelState) reply(socket *zmtp.Socket, received zmtp.Message, request message, replyType string, content any) error {
+	frames, err := s.signer.encode(request.identities, request, replyType, content)
+	if err != nil {
+		return err
+	}
+	// A frontend that disconnected does not get the reply, and the kernel keeps serving the others.
+	_ = socket.Reply(received, frames)
+	return nil
+}
+
+// publish sends a message to every frontend on the IOPub channel, with the message type as topic.
+func (s *kernelState) publish(request message, messageType string, content any) {
+	frames, err := s.signer.encode([][]byte{[]byte(messageType)}, request, messageType, content)
+	if err != nil {
+		return
+	}
+	_ = s.sockets.iopub.Send(frames)
+}
@@ -0,0 +1,14 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package jupyterkernel
+
+import (
+	"net"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// NewDaemonTransport returns the transport the kernel connects to the daemon with, for tests to serve the daemon side.
+func NewDaemonTransport(conn net.Conn) mcp.Transport {
+	return &daemonTransport{conn: conn}
+}
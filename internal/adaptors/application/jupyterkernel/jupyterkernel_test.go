@@ -0,0 +1,625 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package jupyterkernel_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/jupyterkernel"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/jupyterkernel"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testKey         = "a0436f6c-1916-498b-8eb9-e81ab9368e84"
+	testProjectPath = "/home/user/notebooks"
+)
+
+var testConnectionFile = []byte(`{
+	"transport": "tcp",
+	"ip": "127.0.0.1",
+	"shell_port": 0,
+	"iopub_port": 0,
+	"stdin_port": 0,
+	"control_port": 0,
+	"hb_port": 0,
+	"key": "` + testKey + `",
+	"signature_scheme": "hmac-sha256"
+}`)
+
+// newDaemonConn serves an MCP server whose evaluate_matlab_code tool echoes the code, fails when the code is "error",
+// and returns a link to a figure when the code is "plot", on the daemon side of the returned connection.
+func newDaemonConn(t *testing.T) (net.Conn, *[]map[string]any) {
+	t.Helper()
+
+	calls := &[]map[string]any{}
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test-daemon", Version: "v1.2.3"}, nil)
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "evaluate_matlab_code"}, func(ctx context.Context, req *mcp.CallToolRequest, input map[string]any) (*mcp.CallToolResult, any, error) {
+		*calls = append(*calls, input)
+		code, _ := input["code"].(string)
+		switch code {
+		case "error":
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "Undefined variable y."}}}, nil, nil
+		case "plot":
+			return &mcp.CallToolResult{Content: []mcp.Content{&mcp.ResourceLink{URI: "matlab://figures/1", Name: "Figure 1", MIMEType: "image/png"}}}, nil, nil
+		default:
+			return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: code}}}, nil, nil
+		}
+	})
+
+	mcpServer.AddResource(&mcp.Resource{URI: "matlab://figures/1", Name: "Figure 1", MIMEType: "image/png"}, func(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+		return &mcp.ReadResourceResult{Contents: []*mcp.ResourceContents{{URI: req.Params.URI, MIMEType: "image/png", Blob: []byte("png")}}}, nil
+	})
+
+	daemonConn, kernelConn := net.Pipe()
+	serverSession, err := mcpServer.Connect(t.Context(), jupyterkernel.NewDaemonTransport(daemonConn), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = serverSession.Close() })
+
+	return kernelConn, calls
+}
+
+// testPeer is the client side of a ZMTP connection to a socket of the kernel, as a Jupyter frontend opens it.
+type testPeer struct {
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+func connect(t *testing.T, listener net.Listener, socketType string) *testPeer {
+	t.Helper()
+
+	conn, err := net.Dial("tcp", listener.Addr().String())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	require.NoError(t, conn.SetDeadline(time.Now().Add(10*time.Second)))
+
+	p := &testPeer{conn: conn, reader: bufio.NewReader(conn)}
+
+	greeting := make([]byte, 64)
+	greeting[0] = 0xFF
+	greeting[9] = 0x7F
+	greeting[10] = 3
+	copy(greeting[12:], "NULL")
+	_, err = conn.Write(greeting)
+	require.NoError(t, err)
+	_, err = io.ReadFull(p.reader, make([]byte, 64))
+	require.NoError(t, err)
+
+	ready := []byte("\x05READY\x0bSocket-Type")
+	ready = binary.BigEndian.AppendUint32(ready, uint32(len(socketType)))
+	ready = append(ready, socketType...)
+	p.writeFrame(t, 0x04, ready)
+	p.readFrames(t)
+
+	return p
+}
+
+func (p *testPeer) writeFrame(t *testing.T, flags byte, body []byte) {
+	t.Helper()
+
+	frame := []byte{flags, byte(len(body))}
+	if len(body) > 255 {
+		frame = binary.BigEndian.AppendUint64([]byte{flags | 0x02}, uint64(len(body)))
+	}
+	_, err := p.conn.Write(append(frame, body...))
+	require.NoError(t, err)
+}
+
+func (p *testPeer) writeFrames(t *testing.T, frames [][]byte) {
+	t.Helper()
+
+	for i, frame := range frames {
+		var flags byte
+		if i < len(frames)-1 {
+			flags = 0x01
+		}
+		p.writeFrame(t, flags, frame)
+	}
+}
+
+func (p *testPeer) readFrames(t *testing.T) [][]byte {
+	t.Helper()
+
+	var frames [][]byte
+	for {
+		flags, err := p.reader.ReadByte()
+		require.NoError(t, err)
+
+		var size uint64
+		if flags&0x02 != 0 {
+			require.NoError(t, binary.Read(p.reader, binary.BigEndian, &size))
+		} else {
+			shortSize, err := p.reader.ReadByte()
+			require.NoError(t, err)
+			size = uint64(shortSize)
+		}
+
+		body := make([]byte, size)
+		_, err = io.ReadFull(p.reader, body)
+		require.NoError(t, err)
+
+		frames = append(frames, body)
+		if flags&0x01 == 0 {
+			return frames
+		}
+	}
+}
+
+type testMessage struct {
+	messageType string
+	content     map[string]any
+}
+
+func sign(parts ...[]byte) []byte {
+	mac := hmac.New(sha256.New, []byte(testKey))
+	for _, part := range parts {
+		mac.Write(part)
+	}
+	return []byte(hex.EncodeToString(mac.Sum(nil)))
+}
+
+func (p *testPeer) request(t *testing.T, messageType string, content map[string]any) {
+	t.Helper()
+
+	header, err := json.Marshal(map[string]any{"msg_id": messageType + "-1", "session": "session-1", "username": "user", "msg_type": messageType, "version": "5.3"})
+	require.NoError(t, err)
+	contentData, err := json.Marshal(content)
+	require.NoError(t, err)
+
+	parts := [][]byte{header, []byte("{}"), []byte("{}"), contentData}
+	p.writeFrames(t, append([][]byte{[]byte("<IDS|MSG>"), sign(parts...)}, parts...))
+}
+
+func (p *testPeer) receive(t *testing.T) testMessage {
+	t.Helper()
+
+	frames := p.readFrames(t)
+	index := 0
+	for index < len(frames) && string(frames[index]) != "<IDS|MSG>" {
+		index++
+	}
+	require.Len(t, frames, index+6)
+	assert.Equal(t, string(sign(frames[index+2:index+6]...)), string(frames[index+1]), "messages of the kernel should be signed")
+
+	var header struct {
+		MessageType string `json:"msg_type"`
+	}
+	require.NoError(t, json.Unmarshal(frames[index+2], &header))
+	var parentHeader struct {
+		Session string `json:"session"`
+	}
+	require.NoError(t, json.Unmarshal(frames[index+3], &parentHeader))
+	assert.Equal(t, "session-1", parentHeader.Session)
+
+	var content map[string]any
+	require.NoError(t, json.Unmarshal(frames[index+5], &content))
+	return testMessage{messageType: header.MessageType, content: content}
+}
+
+// receiveUntil reads the messages published on the IOPub channel until one of messageType.
+func (p *testPeer) receiveUntil(t *testing.T, messageType string) testMessage {
+	t.Helper()
+
+	for {
+		message := p.receive(t)
+		if message.messageType == messageType {
+			return message
+		}
+	}
+}
+
+// expectListen makes the kernel listen on free ports, and sends its listeners in the order of the channels:
+// shell, control, stdin, IOPub and heartbeat.
+func expectListen(t *testing.T, mockNetLayer *mocks.MockNetLayer) <-chan net.Listener {
+	t.Helper()
+
+	listeners := make(chan net.Listener, 5)
+	mockNetLayer.EXPECT().
+		Listen("tcp", "127.0.0.1:0").
+		RunAndReturn(func(network string, address string) (net.Listener, error) {
+			listener, err := net.Listen(network, address)
+			if err == nil {
+				listeners <- listener
+			}
+			return listener, err
+		}).
+		Times(5)
+	return listeners
+}
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockDaemonSocket := &mocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockNetLayer := &mocks.MockNetLayer{}
+	defer mockNetLayer.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	// Act
+	kernel := jupyterkernel.New(mockConfig, mockDaemonSocket, mockNetLayer, mockOSLayer)
+
+	// Assert
+	assert.NotNil(t, kernel)
+}
+
+func TestKernel_StartAndWaitForCompletion_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockDaemonSocket := &mocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockNetLayer := &mocks.MockNetLayer{}
+	defer mockNetLayer.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	kernelConn, calls := newDaemonConn(t)
+
+	mockConfig.EXPECT().
+		KernelConnectionFile().
+		Return("/run/jupyter/kernel-1.json").
+		Once()
+
+	mockConfig.EXPECT().
+		Version().
+		Return("v1.2.3")
+
+	mockOSLayer.EXPECT().
+		ReadFile("/run/jupyter/kernel-1.json").
+		Return(testConnectionFile, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Getwd().
+		Return(testProjectPath, nil).
+		Once()
+
+	mockDaemonSocket.EXPECT().
+		Dial().
+		Return(kernelConn, nil).
+		Once()
+
+	listeners := expectListen(t, mockNetLayer)
+
+	kernel := jupyterkernel.New(mockConfig, mockDaemonSocket, mockNetLayer, mockOSLayer)
+
+	// Act
+	errC := make(chan error, 1)
+	go func() {
+		errC <- kernel.StartAndWaitForCompletion(t.Context())
+	}()
+
+	shell := connect(t, <-listeners, "DEALER")
+	control := connect(t, <-listeners, "DEALER")
+	<-listeners
+	iopub := connect(t, <-listeners, "SUB")
+	heartbeat := connect(t, <-listeners, "REQ")
+
+	heartbeat.writeFrames(t, [][]byte{{}, []byte("ping")})
+	heartbeatReply := heartbeat.readFrames(t)
+
+	shell.request(t, "kernel_info_request", map[string]any{})
+	kernelInfoReply := shell.receive(t)
+
+	shell.request(t, "execute_request", map[string]any{"code": "x = 1", "silent": false})
+	executeReply := shell.receive(t)
+	executeInput := iopub.receiveUntil(t, "execute_input")
+	stream := iopub.receiveUntil(t, "stream")
+
+	shell.request(t, "execute_request", map[string]any{"code": "plot", "silent": false})
+	plotReply := shell.receive(t)
+	displayData := iopub.receiveUntil(t, "display_data")
+
+	shell.request(t, "execute_request", map[string]any{"code": "error", "silent": false})
+	errorReply := shell.receive(t)
+	errorMessage := iopub.receiveUntil(t, "error")
+
+	control.request(t, "shutdown_request", map[string]any{"restart": false})
+	shutdownReply := control.receive(t)
+
+	var err error
+	select {
+	case err = <-errC:
+	case <-time.After(10 * time.Second):
+		t.Fatal("the kernel did not stop after the shutdown request")
+	}
+
+	// Assert
+	require.NoError(t, err)
+
+	assert.Equal(t, [][]byte{{}, []byte("ping")}, heartbeatReply)
+
+	assert.Equal(t, "kernel_info_reply", kernelInfoReply.messageType)
+	assert.Equal(t, "ok", kernelInfoReply.content["status"])
+	assert.Equal(t, "5.3", kernelInfoReply.content["protocol_version"])
+	assert.Equal(t, "v1.2.3", kernelInfoReply.content["implementation_version"])
+	assert.Equal(t, "matlab", kernelInfoReply.content["language_info"].(map[string]any)["name"])
+
+	assert.Equal(t, "execute_reply", executeReply.messageType)
+	assert.Equal(t, "ok", executeReply.content["status"])
+	assert.InDelta(t, 1, executeReply.content["execution_count"], 0)
+	assert.Equal(t, "x = 1", executeInput.content["code"])
+	assert.Equal(t, map[string]any{"name": "stdout", "text": "x = 1"}, stream.content)
+
+	assert.Equal(t, "ok", plotReply.content["status"])
+	assert.InDelta(t, 2, plotReply.content["execution_count"], 0)
+	assert.Equal(t, map[string]any{"image/png": "cG5n"}, displayData.content["data"])
+
+	assert.Equal(t, "error", errorReply.content["status"])
+	assert.Equal(t, "MATLABError", errorReply.content["ename"])
+	assert.Equal(t, "Undefined variable y.", errorReply.content["evalue"])
+	assert.Equal(t, "Undefined variable y.", errorMessage.content["evalue"])
+
+	assert.Equal(t, "shutdown_reply", shutdownReply.messageType)
+	assert.Equal(t, "ok", shutdownReply.content["status"])
+
+	require.Len(t, *calls, 3)
+	assert.Equal(t, map[string]any{"project_path": testProjectPath, "code": "x = 1"}, (*calls)[0])
+}
+
+func TestKernel_StartAndWaitForCompletion_InvalidSignature(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockDaemonSocket := &mocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockNetLayer := &mocks.MockNetLayer{}
+	defer mockNetLayer.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	kernelConn, calls := newDaemonConn(t)
+
+	mockConfig.EXPECT().
+		KernelConnectionFile().
+		Return("/run/jupyter/kernel-1.json").
+		Once()
+
+	mockConfig.EXPECT().
+		Version().
+		Return("v1.2.3")
+
+	mockOSLayer.EXPECT().
+		ReadFile("/run/jupyter/kernel-1.json").
+		Return(testConnectionFile, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Getwd().
+		Return(testProjectPath, nil).
+		Once()
+
+	mockDaemonSocket.EXPECT().
+		Dial().
+		Return(kernelConn, nil).
+		Once()
+
+	listeners := expectListen(t, mockNetLayer)
+
+	kernel := jupyterkernel.New(mockConfig, mockDaemonSocket, mockNetLayer, mockOSLayer)
+
+	// Act
+	errC := make(chan error, 1)
+	go func() {
+		errC <- kernel.StartAndWaitForCompletion(t.Context())
+	}()
+
+	shell := connect(t, <-listeners, "DEALER")
+	control := connect(t, <-listeners, "DEALER")
+
+	header := []byte(`{"msg_id": "1", "session": "session-1", "msg_type": "execute_request", "version": "5.3"}`)
+	shell.writeFrames(t, [][]byte{[]byte("<IDS|MSG>"), []byte("forged"), header, []byte("{}"), []byte("{}"), []byte(`{"code": "delete('*')"}`)})
+
+	control.request(t, "shutdown_request", map[string]any{"restart": false})
+	shutdownReply := control.receive(t)
+	err := <-errC
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "shutdown_reply", shutdownReply.messageType)
+	assert.Empty(t, *calls, "a message with an invalid signature should be dropped")
+}
+
+func TestKernel_StartAndWaitForCompletion_DaemonNotRunning(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockDaemonSocket := &mocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockNetLayer := &mocks.MockNetLayer{}
+	defer mockNetLayer.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	stderr := &bytes.Buffer{}
+	dialErr := errors.New("connection refused")
+
+	mockConfig.EXPECT().
+		KernelConnectionFile().
+		Return("/run/jupyter/kernel-1.json").
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile("/run/jupyter/kernel-1.json").
+		Return(testConnectionFile, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Getwd().
+		Return(testProjectPath, nil).
+		Once()
+
+	mockDaemonSocket.EXPECT().
+		Dial().
+		Return(nil, dialErr).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stderr().
+		Return(stderr).
+		Once()
+
+	kernel := jupyterkernel.New(mockConfig, mockDaemonSocket, mockNetLayer, mockOSLayer)
+
+	// Act
+	err := kernel.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.ErrorIs(t, err, dialErr)
+	assert.Contains(t, stderr.String(), "the MATLAB MCP Core Server daemon is not running")
+}
+
+func TestKernel_StartAndWaitForCompletion_InvalidConnectionFile(t *testing.T) {
+	testCases := []struct {
+		name          string
+		content       string
+		expectedError string
+	}{
+		{
+			name:          "malformed",
+			content:       "{",
+			expectedError: "failed to parse the connection file",
+		},
+		{
+			name:          "IPC transport",
+			content:       `{"transport": "ipc", "ip": "kernel"}`,
+			expectedError: "the ipc transport of the connection file is not supported",
+		},
+		{
+			name:          "signature scheme",
+			content:       `{"transport": "tcp", "key": "secret", "signature_scheme": "hmac-md5"}`,
+			expectedError: "the hmac-md5 signature scheme of the connection file is not supported",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockDaemonSocket := &mocks.MockDaemonSocket{}
+			defer mockDaemonSocket.AssertExpectations(t)
+
+			mockNetLayer := &mocks.MockNetLayer{}
+			defer mockNetLayer.AssertExpectations(t)
+
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockConfig.EXPECT().
+				KernelConnectionFile().
+				Return("kernel-1.json").
+				Once()
+
+			mockOSLayer.EXPECT().
+				ReadFile("kernel-1.json").
+				Return([]byte(testCase.content), nil).
+				Once()
+
+			mockOSLayer.EXPECT().
+				Stderr().
+				Return(io.Discard).
+				Once()
+
+			kernel := jupyterkernel.New(mockConfig, mockDaemonSocket, mockNetLayer, mockOSLayer)
+
+			// Act
+			err := kernel.StartAndWaitForCompletion(t.Context())
+
+			// Assert
+			require.ErrorContains(t, err, testCase.expectedError)
+		})
+	}
+}
+
+func TestKernel_StartAndWaitForCompletion_ListenError(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockDaemonSocket := &mocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockNetLayer := &mocks.MockNetLayer{}
+	defer mockNetLayer.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	kernelConn, _ := newDaemonConn(t)
+	listenErr := errors.New("address already in use")
+
+	mockConfig.EXPECT().
+		KernelConnectionFile().
+		Return("kernel-1.json").
+		Once()
+
+	mockConfig.EXPECT().
+		Version().
+		Return("v1.2.3").
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile("kernel-1.json").
+		Return(testConnectionFile, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Getwd().
+		Return(testProjectPath, nil).
+		Once()
+
+	mockDaemonSocket.EXPECT().
+		Dial().
+		Return(kernelConn, nil).
+		Once()
+
+	mockNetLayer.EXPECT().
+		Listen("tcp", mock.Anything).
+		Return(nil, listenErr).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stderr().
+		Return(io.Discard).
+		Once()
+
+	kernel := jupyterkernel.New(mockConfig, mockDaemonSocket, mockNetLayer, mockOSLayer)
+
+	// Act
+	err := kernel.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.ErrorIs(t, err, listenErr)
+}
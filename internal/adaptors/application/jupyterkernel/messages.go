@@ -0,0 +1,121 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package jupyterkernel
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// protocolVersion is the version of the Jupyter messaging protocol the kernel implements.
+const protocolVersion = "5.3"
+
+// delimiter separates the routing identities of a message from its signed parts.
+var delimiter = []byte("<IDS|MSG>")
+
+type header struct {
+	MessageID   string `json:"msg_id"`
+	Session     string `json:"session"`
+	Username    string `json:"username"`
+	Date        string `json:"date"`
+	MessageType string `json:"msg_type"`
+	Version     string `json:"version"`
+}
+
+// message is a message of the Jupyter messaging protocol, with the identities of the peer it was received from.
+type message struct {
+	identities   [][]byte
+	header       header
+	parentHeader json.RawMessage
+	metadata     json.RawMessage
+	content      json.RawMessage
+}
+
+// signer signs and verifies the messages with the key of the connection file. An empty key disables signatures.
+type signer struct {
+	key []byte
+}
+
+func (s signer) sign(parts ...[]byte) []byte {
+	if len(s.key) == 0 {
+		return nil
+	}
+
+	mac := hmac.New(sha256.New, s.key)
+	for _, part := range parts {
+		mac.Write(part)
+	}
+	return []byte(hex.EncodeToString(mac.Sum(nil)))
+}
+
+// decode parses the frames of a message, and verifies its signature.
+func (s signer) decode(frames [][]byte) (message, error) {
+	index := -1
+	for i, frame := range frames {
+		if bytes.Equal(frame, delimiter) {
+			index = i
+			break
+		}
+	}
+	if index < 0 || len(frames) < index+6 {
+		return message{}, fmt.Errorf("malformed message")
+	}
+
+	signature := frames[index+1]
+	parts := frames[index+2 : index+6]
+	if len(s.key) > 0 && !hmac.Equal(signature, s.sign(parts...)) {
+		return message{}, fmt.Errorf("invalid message signature")
+	}
+
+	var h header
+	if err := json.Unmarshal(parts[0], &h); err != nil {
+		return message{}, fmt.Errorf("malformed message header: %w", err)
+	}
+
+	return message{
+		identities:   frames[:index],
+		header:       h,
+		parentHeader: parts[1],
+		metadata:     parts[2],
+		content:      parts[3],
+	}, nil
+}
+
+// encode returns the frames of a message of messageType, in reply to parent, sent to identities.
+func (s signer) encode(identities [][]byte, parent message, messageType string, content any) ([][]byte, error) {
+	headerData, err := json.Marshal(header{
+		MessageID:   uuid.NewString(),
+		Session:     parent.header.Session,
+		Username:    parent.header.Username,
+		Date:        time.Now().UTC().Format(time.RFC3339Nano),
+		MessageType: messageType,
+		Version:     protocolVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	parentHeaderData, err := json.Marshal(parent.header)
+	if err != nil {
+		return nil, err
+	}
+
+	contentData, err := json.Marshal(content)
+	if err != nil {
+		return nil, err
+	}
+
+	parts := [][]byte{headerData, parentHeaderData, []byte("{}"), contentData}
+
+	frames := make([][]byte, 0, len(identities)+2+len(parts))
+	frames = append(frames, identities...)
+	frames = append(frames, delimiter, s.sign(parts...))
+	return append(frames, parts...), nil
+}
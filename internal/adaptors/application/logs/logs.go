@@ -0,0 +1,224 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package logs
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+)
+
+// defaultPollInterval is how often a followed log file is checked for new entries.
+const defaultPollInterval = 250 * time.Millisecond
+
+type Config interface {
+	LogsFollow() bool
+	LogsLevel() entities.LogLevel
+}
+
+type EventReader interface {
+	Read() ([]entities.Event, error)
+}
+
+type InstanceLock interface {
+	Holder() (int, bool, error)
+}
+
+type OSLayer interface {
+	Open(path string) (osfacade.File, error)
+	Stdout() io.Writer
+	Stderr() io.Writer
+}
+
+// Logs locates the log file of the running MATLAB MCP Core Server from the events it recorded,
+// so users don't have to look for it in the temporary folder.
+// Without --follow or --level, it prints the path of the log file, so that it can be passed to other tools.
+type Logs struct {
+	config       Config
+	eventReader  EventReader
+	instanceLock InstanceLock
+	osLayer      OSLayer
+
+	pollInterval time.Duration
+}
+
+func New(
+	config Config,
+	eventReader EventReader,
+	instanceLock InstanceLock,
+	osLayer OSLayer,
+) *Logs {
+	return &Logs{
+		config:       config,
+		eventReader:  eventReader,
+		instanceLock: instanceLock,
+		osLayer:      osLayer,
+
+		pollInterval: defaultPollInterval,
+	}
+}
+
+func (l *Logs) StartAndWaitForCompletion(ctx context.Context) error {
+	err := l.run(ctx)
+	if err != nil {
+		_, _ = fmt.Fprintf(l.osLayer.Stderr(), "Failed to read the server logs: %v\n", err)
+	}
+	return err
+}
+
+func (l *Logs) run(ctx context.Context) error {
+	logFile, err := l.findLogFile()
+	if err != nil {
+		return err
+	}
+
+	level := l.config.LogsLevel()
+	follow := l.config.LogsFollow()
+	if level == "" && !follow {
+		_, err := fmt.Fprintln(l.osLayer.Stdout(), logFile)
+		return err
+	}
+
+	var minimum *slog.Level
+	if level != "" {
+		minimum = new(slog.Level)
+		if err := minimum.UnmarshalText([]byte(level)); err != nil {
+			return err
+		}
+	}
+
+	file, err := l.osLayer.Open(logFile)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	return l.printEntries(ctx, file, minimum, follow)
+}
+
+// findLogFile returns the log file recorded by the server holding the instance lock, or else by the last server started,
+// in which case it explains on stderr why that log file is used.
+func (l *Logs) findLogFile() (string, error) {
+	events, err := l.eventReader.Read()
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return "", fmt.Errorf("failed to read server events: %w", err)
+	}
+
+	holderPID, holderRunning, err := l.instanceLock.Holder()
+	if err != nil {
+		holderRunning = false
+	}
+
+	var lastLogFile string
+	lastPID := 0
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].Kind != entities.EventKindServerStarted {
+			continue
+		}
+		logFile, ok := events[i].Details["log-file"].(string)
+		if !ok || logFile == "" {
+			continue
+		}
+		pid := detailAsInt(events[i].Details["pid"])
+
+		if holderRunning && pid == holderPID {
+			return logFile, nil
+		}
+		if lastLogFile == "" {
+			lastLogFile = logFile
+			lastPID = pid
+		}
+	}
+
+	if lastLogFile == "" {
+		return "", fmt.Errorf("no MATLAB MCP Core Server has recorded its log file yet")
+	}
+
+	if holderRunning {
+		_, _ = fmt.Fprintf(l.osLayer.Stderr(), "The running server (PID %d) has not finished starting, showing the log file of the previous server (PID %d).\n", holderPID, lastPID)
+	} else {
+		_, _ = fmt.Fprintf(l.osLayer.Stderr(), "No server is running, showing the log file of the last server started (PID %d).\n", lastPID)
+	}
+	return lastLogFile, nil
+}
+
+// printEntries prints the entries of the log file at minimum level or above, or all the entries when minimum is nil.
+// When follow is true, it keeps printing the entries written to the log file until ctx is done.
+func (l *Logs) printEntries(ctx context.Context, file io.Reader, minimum *slog.Level, follow bool) error {
+	stdout := l.osLayer.Stdout()
+	reader := bufio.NewReader(file)
+
+	// A line is only printed once complete, as the server may be writing it.
+	var pending strings.Builder
+	for {
+		line, err := reader.ReadString('\n')
+		pending.WriteString(line)
+
+		if err == nil {
+			if entry := pending.String(); matchesLevel(entry, minimum) {
+				if _, err := io.WriteString(stdout, entry); err != nil {
+					return err
+				}
+			}
+			pending.Reset()
+			continue
+		}
+		if !errors.Is(err, io.EOF) {
+			return err
+		}
+
+		if !follow {
+			if entry := pending.String(); entry != "" && matchesLevel(entry, minimum) {
+				_, err := fmt.Fprintln(stdout, entry)
+				return err
+			}
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(l.pollInterval):
+		}
+	}
+}
+
+// matchesLevel is true when entry, a JSON log entry, is at minimum level or above.
+// The lines which are not JSON log entries only match when there is no minimum level.
+func matchesLevel(entry string, minimum *slog.Level) bool {
+	if minimum == nil {
+		return true
+	}
+
+	var fields struct {
+		Level *slog.Level `json:"level"`
+	}
+	if err := json.Unmarshal([]byte(entry), &fields); err != nil || fields.Level == nil {
+		return false
+	}
+	return *fields.Level >= *minimum
+}
+
+// detailAsInt converts a numeric event detail, which is a float64 once read back from the events snapshot.
+func detailAsInt(detail any) int {
+	switch value := detail.(type) {
+	case int:
+		return value
+	case float64:
+		return int(value)
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,13 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package logs
+
+import (
+	"time"
+)
+
+// Only allow test to modify the poll interval for now.
+
+func (l *Logs) SetPollInterval(pollInterval time.Duration) {
+	l.pollInterval = pollInterval
+}
@@ -0,0 +1,358 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package logs_test
+
+import (
+	"bytes"
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/logs"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/logs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testLogEntries = `{"time":"2025-01-01T10:00:00Z","level":"DEBUG","msg":"Checking MATLAB"}
+{"time":"2025-01-01T10:00:01Z","level":"INFO","msg":"MATLAB MCP Core Server application startup complete"}
+{"time":"2025-01-01T10:00:02Z","level":"WARN","msg":"MATLAB global initialization failed"}
+{"time":"2025-01-01T10:00:03Z","level":"ERROR","msg":"Tool call failed"}
+`
+
+func serverStarted(pid int, logFile string) entities.Event {
+	return entities.Event{
+		Time:    time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+		Kind:    entities.EventKindServerStarted,
+		Message: "Server started",
+		Details: map[string]any{"pid": float64(pid), "log-file": logFile},
+	}
+}
+
+func TestLogs_StartAndWaitForCompletion_PrintsLogFileOfRunningServer(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockEventReader := &mocks.MockEventReader{}
+	defer mockEventReader.AssertExpectations(t)
+
+	mockInstanceLock := &mocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	stdout := &bytes.Buffer{}
+
+	// The last server started listens on the network, and does not hold the instance lock.
+	mockEventReader.EXPECT().
+		Read().
+		Return([]entities.Event{
+			serverStarted(1234, "/tmp/matlab-mcp-core-server-1/server.log"),
+			serverStarted(5678, "/tmp/matlab-mcp-core-server-2/server.log"),
+		}, nil).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		Holder().
+		Return(1234, true, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		LogsLevel().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		LogsFollow().
+		Return(false).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(stdout).
+		Once()
+
+	logsMode := logs.New(mockConfig, mockEventReader, mockInstanceLock, mockOSLayer)
+
+	// Act
+	err := logsMode.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "/tmp/matlab-mcp-core-server-1/server.log\n", stdout.String())
+}
+
+func TestLogs_StartAndWaitForCompletion_NoServerRunning(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockEventReader := &mocks.MockEventReader{}
+	defer mockEventReader.AssertExpectations(t)
+
+	mockInstanceLock := &mocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+
+	mockEventReader.EXPECT().
+		Read().
+		Return([]entities.Event{
+			serverStarted(1234, "/tmp/matlab-mcp-core-server-1/server.log"),
+			serverStarted(5678, "/tmp/matlab-mcp-core-server-2/server.log"),
+		}, nil).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		Holder().
+		Return(0, false, fs.ErrNotExist).
+		Once()
+
+	mockConfig.EXPECT().
+		LogsLevel().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		LogsFollow().
+		Return(false).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(stdout).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stderr().
+		Return(stderr).
+		Once()
+
+	logsMode := logs.New(mockConfig, mockEventReader, mockInstanceLock, mockOSLayer)
+
+	// Act
+	err := logsMode.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "/tmp/matlab-mcp-core-server-2/server.log\n", stdout.String())
+	assert.Contains(t, stderr.String(), "No server is running, showing the log file of the last server started (PID 5678)")
+}
+
+func TestLogs_StartAndWaitForCompletion_NoLogFileRecorded(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockEventReader := &mocks.MockEventReader{}
+	defer mockEventReader.AssertExpectations(t)
+
+	mockInstanceLock := &mocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	stderr := &bytes.Buffer{}
+
+	mockEventReader.EXPECT().
+		Read().
+		Return(nil, fs.ErrNotExist).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		Holder().
+		Return(0, false, fs.ErrNotExist).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stderr().
+		Return(stderr).
+		Once()
+
+	logsMode := logs.New(mockConfig, mockEventReader, mockInstanceLock, mockOSLayer)
+
+	// Act
+	err := logsMode.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.ErrorContains(t, err, "no MATLAB MCP Core Server has recorded its log file yet")
+	assert.Contains(t, stderr.String(), "Failed to read the server logs")
+}
+
+func TestLogs_StartAndWaitForCompletion_FiltersByLevel(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockEventReader := &mocks.MockEventReader{}
+	defer mockEventReader.AssertExpectations(t)
+
+	mockInstanceLock := &mocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	logFile := filepath.Join(t.TempDir(), "server.log")
+	require.NoError(t, os.WriteFile(logFile, []byte(testLogEntries), 0o600))
+
+	stdout := &bytes.Buffer{}
+
+	mockEventReader.EXPECT().
+		Read().
+		Return([]entities.Event{serverStarted(1234, logFile)}, nil).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		Holder().
+		Return(1234, true, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		LogsLevel().
+		Return(entities.LogLevelWarn).
+		Once()
+
+	mockConfig.EXPECT().
+		LogsFollow().
+		Return(false).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Open(logFile).
+		Return(osfacade.New().Open(logFile)).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(stdout).
+		Once()
+
+	logsMode := logs.New(mockConfig, mockEventReader, mockInstanceLock, mockOSLayer)
+
+	// Act
+	err := logsMode.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, `{"time":"2025-01-01T10:00:02Z","level":"WARN","msg":"MATLAB global initialization failed"}
+{"time":"2025-01-01T10:00:03Z","level":"ERROR","msg":"Tool call failed"}
+`, stdout.String())
+}
+
+func TestLogs_StartAndWaitForCompletion_Follow(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockEventReader := &mocks.MockEventReader{}
+	defer mockEventReader.AssertExpectations(t)
+
+	mockInstanceLock := &mocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	logFile := filepath.Join(t.TempDir(), "server.log")
+	require.NoError(t, os.WriteFile(logFile, []byte(testLogEntries), 0o600))
+
+	stdout := &lockedBuffer{}
+
+	mockEventReader.EXPECT().
+		Read().
+		Return([]entities.Event{serverStarted(1234, logFile)}, nil).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		Holder().
+		Return(1234, true, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		LogsLevel().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		LogsFollow().
+		Return(true).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Open(logFile).
+		Return(osfacade.New().Open(logFile)).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(stdout).
+		Once()
+
+	logsMode := logs.New(mockConfig, mockEventReader, mockInstanceLock, mockOSLayer)
+	logsMode.SetPollInterval(10 * time.Millisecond)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	errC := make(chan error, 1)
+
+	// Act
+	go func() {
+		errC <- logsMode.StartAndWaitForCompletion(ctx)
+	}()
+
+	require.Eventually(t, func() bool {
+		return stdout.String() == testLogEntries
+	}, time.Second, 10*time.Millisecond)
+
+	file, err := os.OpenFile(logFile, os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	_, err = file.WriteString(`{"time":"2025-01-01T10:00:04Z","level":"INFO",`)
+	require.NoError(t, err)
+
+	// Incomplete entries are not printed.
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, testLogEntries, stdout.String())
+
+	_, err = file.WriteString(`"msg":"Received termination signal"}` + "\n")
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	// Assert
+	require.Eventually(t, func() bool {
+		return stdout.String() == testLogEntries+`{"time":"2025-01-01T10:00:04Z","level":"INFO","msg":"Received termination signal"}`+"\n"
+	}, time.Second, 10*time.Millisecond)
+
+	cancel()
+	require.NoError(t, <-errC)
+}
+
+// lockedBuffer is a bytes.Buffer which can be written while it is read.
+type lockedBuffer struct {
+	lock   sync.Mutex
+	buffer bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.buffer.Write(p)
+}
+
+func (b *lockedBuffer) String() string {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.buffer.String()
+}
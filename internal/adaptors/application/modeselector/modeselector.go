@@ -4,15 +4,28 @@ package modeselector
 
 import (
 	"context"
-	"fmt"
-	"io"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 )
 
 type Config interface {
-	Version() string
 	VersionMode() bool
+	StatusMode() bool
+	TelemetryPreviewMode() bool
+	ReplayMode() bool
+	AttachMode() bool
+	DoctorMode() bool
+	InstallMode() bool
+	UninstallMode() bool
+	CompletionMode() bool
+	LogsMode() bool
+	CleanupMode() bool
+	StopMode() bool
+	ServiceMode() bool
+	REPLMode() bool
+	PipelineMode() bool
+	SelfTestMode() bool
+	KernelMode() bool
 	WatchdogMode() bool
 }
 
@@ -24,39 +37,253 @@ type OrchestratorFactory interface { //nolint:iface // Intentional interface for
 	Create() (entities.Mode, error)
 }
 
-type OSLayer interface {
-	Stdout() io.Writer
+type StatusFactory interface { //nolint:iface // Intentional interface for deps injection
+	Create() (entities.Mode, error)
+}
+
+type TelemetryPreviewFactory interface { //nolint:iface // Intentional interface for deps injection
+	Create() (entities.Mode, error)
+}
+
+type ReplayFactory interface { //nolint:iface // Intentional interface for deps injection
+	Create() (entities.Mode, error)
+}
+
+type AttachFactory interface { //nolint:iface // Intentional interface for deps injection
+	Create() (entities.Mode, error)
+}
+
+type DoctorFactory interface { //nolint:iface // Intentional interface for deps injection
+	Create() (entities.Mode, error)
+}
+
+type InstallFactory interface { //nolint:iface // Intentional interface for deps injection
+	Create() (entities.Mode, error)
+}
+
+type CompletionFactory interface { //nolint:iface // Intentional interface for deps injection
+	Create() (entities.Mode, error)
+}
+
+type LogsFactory interface { //nolint:iface // Intentional interface for deps injection
+	Create() (entities.Mode, error)
+}
+
+type CleanupFactory interface { //nolint:iface // Intentional interface for deps injection
+	Create() (entities.Mode, error)
+}
+
+type REPLFactory interface { //nolint:iface // Intentional interface for deps injection
+	Create() (entities.Mode, error)
+}
+
+type PipelineFactory interface { //nolint:iface // Intentional interface for deps injection
+	Create() (entities.Mode, error)
+}
+
+type StopFactory interface { //nolint:iface // Intentional interface for deps injection
+	Create() (entities.Mode, error)
+}
+
+type ServiceFactory interface { //nolint:iface // Intentional interface for deps injection
+	Create() (entities.Mode, error)
+}
+
+type SelfTestFactory interface { //nolint:iface // Intentional interface for deps injection
+	Create() (entities.Mode, error)
+}
+
+type KernelFactory interface { //nolint:iface // Intentional interface for deps injection
+	Create() (entities.Mode, error)
+}
+
+type VersionFactory interface { //nolint:iface // Intentional interface for deps injection
+	Create() (entities.Mode, error)
 }
 
 // ModeSelector is the top level object of the MATLAB MCP Core Server.
 // It will be imported in `main.go` to start the application, and wait for it's completion.
 // It will select which mode to run in based on the configuration, and defer construction of the required objects until the mode is known.
 type ModeSelector struct {
-	config                 Config
-	watchdogProcessFactory WatchdogProcessFactory
-	orchestratorFactory    OrchestratorFactory
-	osLayer                OSLayer
+	config                  Config
+	watchdogProcessFactory  WatchdogProcessFactory
+	orchestratorFactory     OrchestratorFactory
+	statusFactory           StatusFactory
+	telemetryPreviewFactory TelemetryPreviewFactory
+	replayFactory           ReplayFactory
+	attachFactory           AttachFactory
+	doctorFactory           DoctorFactory
+	installFactory          InstallFactory
+	completionFactory       CompletionFactory
+	logsFactory             LogsFactory
+	cleanupFactory          CleanupFactory
+	stopFactory             StopFactory
+	serviceFactory          ServiceFactory
+	replFactory             REPLFactory
+	pipelineFactory         PipelineFactory
+	selfTestFactory         SelfTestFactory
+	kernelFactory           KernelFactory
+	versionFactory          VersionFactory
 }
 
 func New(
 	config Config,
 	watchdogProcessFactory WatchdogProcessFactory,
 	orchestratorFactory OrchestratorFactory,
-	osLayer OSLayer,
+	statusFactory StatusFactory,
+	telemetryPreviewFactory TelemetryPreviewFactory,
+	replayFactory ReplayFactory,
+	attachFactory AttachFactory,
+	doctorFactory DoctorFactory,
+	installFactory InstallFactory,
+	completionFactory CompletionFactory,
+	logsFactory LogsFactory,
+	cleanupFactory CleanupFactory,
+	stopFactory StopFactory,
+	serviceFactory ServiceFactory,
+	replFactory REPLFactory,
+	pipelineFactory PipelineFactory,
+	selfTestFactory SelfTestFactory,
+	kernelFactory KernelFactory,
+	versionFactory VersionFactory,
 ) *ModeSelector {
 	return &ModeSelector{
-		config:                 config,
-		watchdogProcessFactory: watchdogProcessFactory,
-		orchestratorFactory:    orchestratorFactory,
-		osLayer:                osLayer,
+		config:                  config,
+		watchdogProcessFactory:  watchdogProcessFactory,
+		orchestratorFactory:     orchestratorFactory,
+		statusFactory:           statusFactory,
+		telemetryPreviewFactory: telemetryPreviewFactory,
+		replayFactory:           replayFactory,
+		attachFactory:           attachFactory,
+		doctorFactory:           doctorFactory,
+		installFactory:          installFactory,
+		completionFactory:       completionFactory,
+		logsFactory:             logsFactory,
+		cleanupFactory:          cleanupFactory,
+		stopFactory:             stopFactory,
+		serviceFactory:          serviceFactory,
+		replFactory:             replFactory,
+		pipelineFactory:         pipelineFactory,
+		selfTestFactory:         selfTestFactory,
+		kernelFactory:           kernelFactory,
+		versionFactory:          versionFactory,
 	}
 }
 
 func (a *ModeSelector) StartAndWaitForCompletion(ctx context.Context) error {
 	switch {
 	case a.config.VersionMode():
-		_, err := fmt.Fprintf(a.osLayer.Stdout(), "%s\n", a.config.Version())
-		return err
+		version, err := a.versionFactory.Create()
+		if err != nil {
+			return err
+		}
+
+		return version.StartAndWaitForCompletion(ctx)
+	case a.config.StatusMode():
+		status, err := a.statusFactory.Create()
+		if err != nil {
+			return err
+		}
+
+		return status.StartAndWaitForCompletion(ctx)
+	case a.config.TelemetryPreviewMode():
+		telemetryPreview, err := a.telemetryPreviewFactory.Create()
+		if err != nil {
+			return err
+		}
+
+		return telemetryPreview.StartAndWaitForCompletion(ctx)
+	case a.config.ReplayMode():
+		replay, err := a.replayFactory.Create()
+		if err != nil {
+			return err
+		}
+
+		return replay.StartAndWaitForCompletion(ctx)
+	case a.config.REPLMode():
+		repl, err := a.replFactory.Create()
+		if err != nil {
+			return err
+		}
+
+		return repl.StartAndWaitForCompletion(ctx)
+	case a.config.PipelineMode():
+		pipeline, err := a.pipelineFactory.Create()
+		if err != nil {
+			return err
+		}
+
+		return pipeline.StartAndWaitForCompletion(ctx)
+	case a.config.SelfTestMode():
+		selfTest, err := a.selfTestFactory.Create()
+		if err != nil {
+			return err
+		}
+
+		return selfTest.StartAndWaitForCompletion(ctx)
+	case a.config.KernelMode():
+		kernel, err := a.kernelFactory.Create()
+		if err != nil {
+			return err
+		}
+
+		return kernel.StartAndWaitForCompletion(ctx)
+	case a.config.DoctorMode():
+		doctor, err := a.doctorFactory.Create()
+		if err != nil {
+			return err
+		}
+
+		return doctor.StartAndWaitForCompletion(ctx)
+	case a.config.InstallMode(), a.config.UninstallMode():
+		install, err := a.installFactory.Create()
+		if err != nil {
+			return err
+		}
+
+		return install.StartAndWaitForCompletion(ctx)
+	case a.config.CompletionMode():
+		completion, err := a.completionFactory.Create()
+		if err != nil {
+			return err
+		}
+
+		return completion.StartAndWaitForCompletion(ctx)
+	case a.config.LogsMode():
+		logs, err := a.logsFactory.Create()
+		if err != nil {
+			return err
+		}
+
+		return logs.StartAndWaitForCompletion(ctx)
+	case a.config.CleanupMode():
+		cleanup, err := a.cleanupFactory.Create()
+		if err != nil {
+			return err
+		}
+
+		return cleanup.StartAndWaitForCompletion(ctx)
+	case a.config.StopMode():
+		stop, err := a.stopFactory.Create()
+		if err != nil {
+			return err
+		}
+
+		return stop.StartAndWaitForCompletion(ctx)
+	case a.config.ServiceMode():
+		service, err := a.serviceFactory.Create()
+		if err != nil {
+			return err
+		}
+
+		return service.StartAndWaitForCompletion(ctx)
+	case a.config.AttachMode():
+		attach, err := a.attachFactory.Create()
+		if err != nil {
+			return err
+		}
+
+		return attach.StartAndWaitForCompletion(ctx)
 	case a.config.WatchdogMode():
 		watchdogProcess, err := a.watchdogProcessFactory.Create()
 		if err != nil {
@@ -3,7 +3,6 @@
 package modeselector_test
 
 import (
-	"fmt"
 	"testing"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/modeselector"
@@ -24,15 +23,75 @@ func TestNew_HappyPath(t *testing.T) {
 	mockOrchestratorFactory := &modeselectormocks.MockOrchestratorFactory{}
 	defer mockOrchestratorFactory.AssertExpectations(t)
 
-	mockOsLayer := &modeselectormocks.MockOSLayer{}
-	defer mockOsLayer.AssertExpectations(t)
+	mockStatusFactory := &modeselectormocks.MockStatusFactory{}
+	defer mockStatusFactory.AssertExpectations(t)
+
+	mockTelemetryPreviewFactory := &modeselectormocks.MockTelemetryPreviewFactory{}
+	defer mockTelemetryPreviewFactory.AssertExpectations(t)
+
+	mockReplayFactory := &modeselectormocks.MockReplayFactory{}
+	defer mockReplayFactory.AssertExpectations(t)
+
+	mockAttachFactory := &modeselectormocks.MockAttachFactory{}
+	defer mockAttachFactory.AssertExpectations(t)
+
+	mockDoctorFactory := &modeselectormocks.MockDoctorFactory{}
+	defer mockDoctorFactory.AssertExpectations(t)
+
+	mockInstallFactory := &modeselectormocks.MockInstallFactory{}
+	defer mockInstallFactory.AssertExpectations(t)
+
+	mockCompletionFactory := &modeselectormocks.MockCompletionFactory{}
+	defer mockCompletionFactory.AssertExpectations(t)
+
+	mockLogsFactory := &modeselectormocks.MockLogsFactory{}
+	defer mockLogsFactory.AssertExpectations(t)
+
+	mockCleanupFactory := &modeselectormocks.MockCleanupFactory{}
+	defer mockCleanupFactory.AssertExpectations(t)
+
+	mockStopFactory := &modeselectormocks.MockStopFactory{}
+	defer mockStopFactory.AssertExpectations(t)
+
+	mockServiceFactory := &modeselectormocks.MockServiceFactory{}
+	defer mockServiceFactory.AssertExpectations(t)
+
+	mockREPLFactory := &modeselectormocks.MockREPLFactory{}
+	defer mockREPLFactory.AssertExpectations(t)
+
+	mockPipelineFactory := &modeselectormocks.MockPipelineFactory{}
+	defer mockPipelineFactory.AssertExpectations(t)
+
+	mockSelfTestFactory := &modeselectormocks.MockSelfTestFactory{}
+	defer mockSelfTestFactory.AssertExpectations(t)
+
+	mockKernelFactory := &modeselectormocks.MockKernelFactory{}
+	defer mockKernelFactory.AssertExpectations(t)
+
+	mockVersionFactory := &modeselectormocks.MockVersionFactory{}
+	defer mockVersionFactory.AssertExpectations(t)
 
 	// Act
 	modeSelectorInstance := modeselector.New(
 		mockConfig,
 		mockWatchdogProcessFactory,
 		mockOrchestratorFactory,
-		mockOsLayer,
+		mockStatusFactory,
+		mockTelemetryPreviewFactory,
+		mockReplayFactory,
+		mockAttachFactory,
+		mockDoctorFactory,
+		mockInstallFactory,
+		mockCompletionFactory,
+		mockLogsFactory,
+		mockCleanupFactory,
+		mockStopFactory,
+		mockServiceFactory,
+		mockREPLFactory,
+		mockPipelineFactory,
+		mockSelfTestFactory,
+		mockKernelFactory,
+		mockVersionFactory,
 	)
 
 	// Assert
@@ -50,49 +109,104 @@ func TestStartAndWaitForCompletion_VersionMode_HappyPath(t *testing.T) {
 	mockOrchestratorFactory := &modeselectormocks.MockOrchestratorFactory{}
 	defer mockOrchestratorFactory.AssertExpectations(t)
 
-	mockOsLayer := &modeselectormocks.MockOSLayer{}
-	defer mockOsLayer.AssertExpectations(t)
+	mockStatusFactory := &modeselectormocks.MockStatusFactory{}
+	defer mockStatusFactory.AssertExpectations(t)
+
+	mockTelemetryPreviewFactory := &modeselectormocks.MockTelemetryPreviewFactory{}
+	defer mockTelemetryPreviewFactory.AssertExpectations(t)
 
-	mockStdout := &entitiesmocks.MockWriter{}
-	defer mockStdout.AssertExpectations(t)
+	mockReplayFactory := &modeselectormocks.MockReplayFactory{}
+	defer mockReplayFactory.AssertExpectations(t)
 
-	expectedVersion := "25.6.68"
+	mockAttachFactory := &modeselectormocks.MockAttachFactory{}
+	defer mockAttachFactory.AssertExpectations(t)
+
+	mockDoctorFactory := &modeselectormocks.MockDoctorFactory{}
+	defer mockDoctorFactory.AssertExpectations(t)
+
+	mockInstallFactory := &modeselectormocks.MockInstallFactory{}
+	defer mockInstallFactory.AssertExpectations(t)
+
+	mockCompletionFactory := &modeselectormocks.MockCompletionFactory{}
+	defer mockCompletionFactory.AssertExpectations(t)
+
+	mockLogsFactory := &modeselectormocks.MockLogsFactory{}
+	defer mockLogsFactory.AssertExpectations(t)
+
+	mockCleanupFactory := &modeselectormocks.MockCleanupFactory{}
+	defer mockCleanupFactory.AssertExpectations(t)
+
+	mockStopFactory := &modeselectormocks.MockStopFactory{}
+	defer mockStopFactory.AssertExpectations(t)
+
+	mockServiceFactory := &modeselectormocks.MockServiceFactory{}
+	defer mockServiceFactory.AssertExpectations(t)
+
+	mockREPLFactory := &modeselectormocks.MockREPLFactory{}
+	defer mockREPLFactory.AssertExpectations(t)
+
+	mockPipelineFactory := &modeselectormocks.MockPipelineFactory{}
+	defer mockPipelineFactory.AssertExpectations(t)
+
+	mockSelfTestFactory := &modeselectormocks.MockSelfTestFactory{}
+	defer mockSelfTestFactory.AssertExpectations(t)
+
+	mockKernelFactory := &modeselectormocks.MockKernelFactory{}
+	defer mockKernelFactory.AssertExpectations(t)
+
+	mockVersionFactory := &modeselectormocks.MockVersionFactory{}
+	defer mockVersionFactory.AssertExpectations(t)
+
+	mockVersion := &entitiesmocks.MockMode{}
+	defer mockVersion.AssertExpectations(t)
+
+	ctx := t.Context()
 
 	mockConfig.EXPECT().
 		VersionMode().
 		Return(true).
 		Once()
 
-	mockOsLayer.EXPECT().
-		Stdout().
-		Return(mockStdout).
-		Once()
-
-	mockConfig.EXPECT().
-		Version().
-		Return(expectedVersion).
+	mockVersionFactory.EXPECT().
+		Create().
+		Return(mockVersion, nil).
 		Once()
 
-	mockStdout.EXPECT().
-		Write([]byte(fmt.Sprintf("%s\n", expectedVersion))).
-		Return(len(expectedVersion)+1, nil).
+	mockVersion.EXPECT().
+		StartAndWaitForCompletion(ctx).
+		Return(nil).
 		Once()
 
 	modeSelectorInstance := modeselector.New(
 		mockConfig,
 		mockWatchdogProcessFactory,
 		mockOrchestratorFactory,
-		mockOsLayer,
+		mockStatusFactory,
+		mockTelemetryPreviewFactory,
+		mockReplayFactory,
+		mockAttachFactory,
+		mockDoctorFactory,
+		mockInstallFactory,
+		mockCompletionFactory,
+		mockLogsFactory,
+		mockCleanupFactory,
+		mockStopFactory,
+		mockServiceFactory,
+		mockREPLFactory,
+		mockPipelineFactory,
+		mockSelfTestFactory,
+		mockKernelFactory,
+		mockVersionFactory,
 	)
 
 	// Act
-	err := modeSelectorInstance.StartAndWaitForCompletion(t.Context())
+	err := modeSelectorInstance.StartAndWaitForCompletion(ctx)
 
 	// Assert
 	require.NoError(t, err, "StartAndWaitForCompletion should not return an error in version mode")
 }
 
-func TestStartAndWaitForCompletion_VersionMode_WriteError(t *testing.T) {
+func TestStartAndWaitForCompletion_VersionMode_CreateError(t *testing.T) {
 	// Arrange
 	mockConfig := &modeselectormocks.MockConfig{}
 	defer mockConfig.AssertExpectations(t)
@@ -103,13 +217,54 @@ func TestStartAndWaitForCompletion_VersionMode_WriteError(t *testing.T) {
 	mockOrchestratorFactory := &modeselectormocks.MockOrchestratorFactory{}
 	defer mockOrchestratorFactory.AssertExpectations(t)
 
-	mockOsLayer := &modeselectormocks.MockOSLayer{}
-	defer mockOsLayer.AssertExpectations(t)
+	mockStatusFactory := &modeselectormocks.MockStatusFactory{}
+	defer mockStatusFactory.AssertExpectations(t)
+
+	mockTelemetryPreviewFactory := &modeselectormocks.MockTelemetryPreviewFactory{}
+	defer mockTelemetryPreviewFactory.AssertExpectations(t)
+
+	mockReplayFactory := &modeselectormocks.MockReplayFactory{}
+	defer mockReplayFactory.AssertExpectations(t)
+
+	mockAttachFactory := &modeselectormocks.MockAttachFactory{}
+	defer mockAttachFactory.AssertExpectations(t)
+
+	mockDoctorFactory := &modeselectormocks.MockDoctorFactory{}
+	defer mockDoctorFactory.AssertExpectations(t)
+
+	mockInstallFactory := &modeselectormocks.MockInstallFactory{}
+	defer mockInstallFactory.AssertExpectations(t)
+
+	mockCompletionFactory := &modeselectormocks.MockCompletionFactory{}
+	defer mockCompletionFactory.AssertExpectations(t)
+
+	mockLogsFactory := &modeselectormocks.MockLogsFactory{}
+	defer mockLogsFactory.AssertExpectations(t)
+
+	mockCleanupFactory := &modeselectormocks.MockCleanupFactory{}
+	defer mockCleanupFactory.AssertExpectations(t)
+
+	mockStopFactory := &modeselectormocks.MockStopFactory{}
+	defer mockStopFactory.AssertExpectations(t)
 
-	mockStdout := &entitiesmocks.MockWriter{}
-	defer mockStdout.AssertExpectations(t)
+	mockServiceFactory := &modeselectormocks.MockServiceFactory{}
+	defer mockServiceFactory.AssertExpectations(t)
+
+	mockREPLFactory := &modeselectormocks.MockREPLFactory{}
+	defer mockREPLFactory.AssertExpectations(t)
+
+	mockPipelineFactory := &modeselectormocks.MockPipelineFactory{}
+	defer mockPipelineFactory.AssertExpectations(t)
+
+	mockSelfTestFactory := &modeselectormocks.MockSelfTestFactory{}
+	defer mockSelfTestFactory.AssertExpectations(t)
+
+	mockKernelFactory := &modeselectormocks.MockKernelFactory{}
+	defer mockKernelFactory.AssertExpectations(t)
+
+	mockVersionFactory := &modeselectormocks.MockVersionFactory{}
+	defer mockVersionFactory.AssertExpectations(t)
 
-	expectedVersion := "25.6.68"
 	expectedError := assert.AnError
 
 	mockConfig.EXPECT().
@@ -117,36 +272,41 @@ func TestStartAndWaitForCompletion_VersionMode_WriteError(t *testing.T) {
 		Return(true).
 		Once()
 
-	mockOsLayer.EXPECT().
-		Stdout().
-		Return(mockStdout).
-		Once()
-
-	mockConfig.EXPECT().
-		Version().
-		Return(expectedVersion).
-		Once()
-
-	mockStdout.EXPECT().
-		Write([]byte(fmt.Sprintf("%s\n", expectedVersion))).
-		Return(0, expectedError).
+	mockVersionFactory.EXPECT().
+		Create().
+		Return(nil, expectedError).
 		Once()
 
 	modeSelectorInstance := modeselector.New(
 		mockConfig,
 		mockWatchdogProcessFactory,
 		mockOrchestratorFactory,
-		mockOsLayer,
+		mockStatusFactory,
+		mockTelemetryPreviewFactory,
+		mockReplayFactory,
+		mockAttachFactory,
+		mockDoctorFactory,
+		mockInstallFactory,
+		mockCompletionFactory,
+		mockLogsFactory,
+		mockCleanupFactory,
+		mockStopFactory,
+		mockServiceFactory,
+		mockREPLFactory,
+		mockPipelineFactory,
+		mockSelfTestFactory,
+		mockKernelFactory,
+		mockVersionFactory,
 	)
 
 	// Act
 	err := modeSelectorInstance.StartAndWaitForCompletion(t.Context())
 
 	// Assert
-	require.ErrorIs(t, err, expectedError, "StartAndWaitForCompletion should return the error from Write")
+	require.ErrorIs(t, err, expectedError, "StartAndWaitForCompletion should return the error from Create")
 }
 
-func TestStartAndWaitForCompletion_WatchdogMode_HappyPath(t *testing.T) {
+func TestStartAndWaitForCompletion_StatusMode_HappyPath(t *testing.T) {
 	// Arrange
 	mockConfig := &modeselectormocks.MockConfig{}
 	defer mockConfig.AssertExpectations(t)
@@ -157,11 +317,56 @@ func TestStartAndWaitForCompletion_WatchdogMode_HappyPath(t *testing.T) {
 	mockOrchestratorFactory := &modeselectormocks.MockOrchestratorFactory{}
 	defer mockOrchestratorFactory.AssertExpectations(t)
 
-	mockOsLayer := &modeselectormocks.MockOSLayer{}
-	defer mockOsLayer.AssertExpectations(t)
+	mockStatusFactory := &modeselectormocks.MockStatusFactory{}
+	defer mockStatusFactory.AssertExpectations(t)
 
-	mockWatchdogProcess := &entitiesmocks.MockMode{}
-	defer mockWatchdogProcess.AssertExpectations(t)
+	mockTelemetryPreviewFactory := &modeselectormocks.MockTelemetryPreviewFactory{}
+	defer mockTelemetryPreviewFactory.AssertExpectations(t)
+
+	mockReplayFactory := &modeselectormocks.MockReplayFactory{}
+	defer mockReplayFactory.AssertExpectations(t)
+
+	mockAttachFactory := &modeselectormocks.MockAttachFactory{}
+	defer mockAttachFactory.AssertExpectations(t)
+
+	mockDoctorFactory := &modeselectormocks.MockDoctorFactory{}
+	defer mockDoctorFactory.AssertExpectations(t)
+
+	mockInstallFactory := &modeselectormocks.MockInstallFactory{}
+	defer mockInstallFactory.AssertExpectations(t)
+
+	mockCompletionFactory := &modeselectormocks.MockCompletionFactory{}
+	defer mockCompletionFactory.AssertExpectations(t)
+
+	mockLogsFactory := &modeselectormocks.MockLogsFactory{}
+	defer mockLogsFactory.AssertExpectations(t)
+
+	mockCleanupFactory := &modeselectormocks.MockCleanupFactory{}
+	defer mockCleanupFactory.AssertExpectations(t)
+
+	mockStopFactory := &modeselectormocks.MockStopFactory{}
+	defer mockStopFactory.AssertExpectations(t)
+
+	mockServiceFactory := &modeselectormocks.MockServiceFactory{}
+	defer mockServiceFactory.AssertExpectations(t)
+
+	mockREPLFactory := &modeselectormocks.MockREPLFactory{}
+	defer mockREPLFactory.AssertExpectations(t)
+
+	mockPipelineFactory := &modeselectormocks.MockPipelineFactory{}
+	defer mockPipelineFactory.AssertExpectations(t)
+
+	mockSelfTestFactory := &modeselectormocks.MockSelfTestFactory{}
+	defer mockSelfTestFactory.AssertExpectations(t)
+
+	mockKernelFactory := &modeselectormocks.MockKernelFactory{}
+	defer mockKernelFactory.AssertExpectations(t)
+
+	mockVersionFactory := &modeselectormocks.MockVersionFactory{}
+	defer mockVersionFactory.AssertExpectations(t)
+
+	mockStatus := &entitiesmocks.MockMode{}
+	defer mockStatus.AssertExpectations(t)
 
 	ctx := t.Context()
 
@@ -171,16 +376,16 @@ func TestStartAndWaitForCompletion_WatchdogMode_HappyPath(t *testing.T) {
 		Once()
 
 	mockConfig.EXPECT().
-		WatchdogMode().
+		StatusMode().
 		Return(true).
 		Once()
 
-	mockWatchdogProcessFactory.EXPECT().
+	mockStatusFactory.EXPECT().
 		Create().
-		Return(mockWatchdogProcess, nil).
+		Return(mockStatus, nil).
 		Once()
 
-	mockWatchdogProcess.EXPECT().
+	mockStatus.EXPECT().
 		StartAndWaitForCompletion(ctx).
 		Return(nil).
 		Once()
@@ -189,17 +394,32 @@ func TestStartAndWaitForCompletion_WatchdogMode_HappyPath(t *testing.T) {
 		mockConfig,
 		mockWatchdogProcessFactory,
 		mockOrchestratorFactory,
-		mockOsLayer,
+		mockStatusFactory,
+		mockTelemetryPreviewFactory,
+		mockReplayFactory,
+		mockAttachFactory,
+		mockDoctorFactory,
+		mockInstallFactory,
+		mockCompletionFactory,
+		mockLogsFactory,
+		mockCleanupFactory,
+		mockStopFactory,
+		mockServiceFactory,
+		mockREPLFactory,
+		mockPipelineFactory,
+		mockSelfTestFactory,
+		mockKernelFactory,
+		mockVersionFactory,
 	)
 
 	// Act
 	err := modeSelectorInstance.StartAndWaitForCompletion(ctx)
 
 	// Assert
-	require.NoError(t, err, "StartAndWaitForCompletion should not return an error in watchdog mode")
+	require.NoError(t, err, "StartAndWaitForCompletion should not return an error in status mode")
 }
 
-func TestStartAndWaitForCompletion_WatchdogMode_CreateError(t *testing.T) {
+func TestStartAndWaitForCompletion_StatusMode_CreateError(t *testing.T) {
 	// Arrange
 	mockConfig := &modeselectormocks.MockConfig{}
 	defer mockConfig.AssertExpectations(t)
@@ -210,8 +430,53 @@ func TestStartAndWaitForCompletion_WatchdogMode_CreateError(t *testing.T) {
 	mockOrchestratorFactory := &modeselectormocks.MockOrchestratorFactory{}
 	defer mockOrchestratorFactory.AssertExpectations(t)
 
-	mockOsLayer := &modeselectormocks.MockOSLayer{}
-	defer mockOsLayer.AssertExpectations(t)
+	mockStatusFactory := &modeselectormocks.MockStatusFactory{}
+	defer mockStatusFactory.AssertExpectations(t)
+
+	mockTelemetryPreviewFactory := &modeselectormocks.MockTelemetryPreviewFactory{}
+	defer mockTelemetryPreviewFactory.AssertExpectations(t)
+
+	mockReplayFactory := &modeselectormocks.MockReplayFactory{}
+	defer mockReplayFactory.AssertExpectations(t)
+
+	mockAttachFactory := &modeselectormocks.MockAttachFactory{}
+	defer mockAttachFactory.AssertExpectations(t)
+
+	mockDoctorFactory := &modeselectormocks.MockDoctorFactory{}
+	defer mockDoctorFactory.AssertExpectations(t)
+
+	mockInstallFactory := &modeselectormocks.MockInstallFactory{}
+	defer mockInstallFactory.AssertExpectations(t)
+
+	mockCompletionFactory := &modeselectormocks.MockCompletionFactory{}
+	defer mockCompletionFactory.AssertExpectations(t)
+
+	mockLogsFactory := &modeselectormocks.MockLogsFactory{}
+	defer mockLogsFactory.AssertExpectations(t)
+
+	mockCleanupFactory := &modeselectormocks.MockCleanupFactory{}
+	defer mockCleanupFactory.AssertExpectations(t)
+
+	mockStopFactory := &modeselectormocks.MockStopFactory{}
+	defer mockStopFactory.AssertExpectations(t)
+
+	mockServiceFactory := &modeselectormocks.MockServiceFactory{}
+	defer mockServiceFactory.AssertExpectations(t)
+
+	mockREPLFactory := &modeselectormocks.MockREPLFactory{}
+	defer mockREPLFactory.AssertExpectations(t)
+
+	mockPipelineFactory := &modeselectormocks.MockPipelineFactory{}
+	defer mockPipelineFactory.AssertExpectations(t)
+
+	mockSelfTestFactory := &modeselectormocks.MockSelfTestFactory{}
+	defer mockSelfTestFactory.AssertExpectations(t)
+
+	mockKernelFactory := &modeselectormocks.MockKernelFactory{}
+	defer mockKernelFactory.AssertExpectations(t)
+
+	mockVersionFactory := &modeselectormocks.MockVersionFactory{}
+	defer mockVersionFactory.AssertExpectations(t)
 
 	expectedError := assert.AnError
 
@@ -221,11 +486,11 @@ func TestStartAndWaitForCompletion_WatchdogMode_CreateError(t *testing.T) {
 		Once()
 
 	mockConfig.EXPECT().
-		WatchdogMode().
+		StatusMode().
 		Return(true).
 		Once()
 
-	mockWatchdogProcessFactory.EXPECT().
+	mockStatusFactory.EXPECT().
 		Create().
 		Return(nil, expectedError).
 		Once()
@@ -234,7 +499,22 @@ func TestStartAndWaitForCompletion_WatchdogMode_CreateError(t *testing.T) {
 		mockConfig,
 		mockWatchdogProcessFactory,
 		mockOrchestratorFactory,
-		mockOsLayer,
+		mockStatusFactory,
+		mockTelemetryPreviewFactory,
+		mockReplayFactory,
+		mockAttachFactory,
+		mockDoctorFactory,
+		mockInstallFactory,
+		mockCompletionFactory,
+		mockLogsFactory,
+		mockCleanupFactory,
+		mockStopFactory,
+		mockServiceFactory,
+		mockREPLFactory,
+		mockPipelineFactory,
+		mockSelfTestFactory,
+		mockKernelFactory,
+		mockVersionFactory,
 	)
 
 	// Act
@@ -244,7 +524,7 @@ func TestStartAndWaitForCompletion_WatchdogMode_CreateError(t *testing.T) {
 	require.ErrorIs(t, err, expectedError, "StartAndWaitForCompletion should return the error from Create")
 }
 
-func TestStartAndWaitForCompletion_WatchdogMode_StartAndWaitError(t *testing.T) {
+func TestStartAndWaitForCompletion_TelemetryPreviewMode_HappyPath(t *testing.T) {
 	// Arrange
 	mockConfig := &modeselectormocks.MockConfig{}
 	defer mockConfig.AssertExpectations(t)
@@ -255,13 +535,57 @@ func TestStartAndWaitForCompletion_WatchdogMode_StartAndWaitError(t *testing.T)
 	mockOrchestratorFactory := &modeselectormocks.MockOrchestratorFactory{}
 	defer mockOrchestratorFactory.AssertExpectations(t)
 
-	mockOsLayer := &modeselectormocks.MockOSLayer{}
-	defer mockOsLayer.AssertExpectations(t)
+	mockStatusFactory := &modeselectormocks.MockStatusFactory{}
+	defer mockStatusFactory.AssertExpectations(t)
 
-	mockWatchdogProcess := &entitiesmocks.MockMode{}
-	defer mockWatchdogProcess.AssertExpectations(t)
+	mockTelemetryPreviewFactory := &modeselectormocks.MockTelemetryPreviewFactory{}
+	defer mockTelemetryPreviewFactory.AssertExpectations(t)
+
+	mockReplayFactory := &modeselectormocks.MockReplayFactory{}
+	defer mockReplayFactory.AssertExpectations(t)
+
+	mockAttachFactory := &modeselectormocks.MockAttachFactory{}
+	defer mockAttachFactory.AssertExpectations(t)
+
+	mockDoctorFactory := &modeselectormocks.MockDoctorFactory{}
+	defer mockDoctorFactory.AssertExpectations(t)
+
+	mockInstallFactory := &modeselectormocks.MockInstallFactory{}
+	defer mockInstallFactory.AssertExpectations(t)
+
+	mockCompletionFactory := &modeselectormocks.MockCompletionFactory{}
+	defer mockCompletionFactory.AssertExpectations(t)
+
+	mockLogsFactory := &modeselectormocks.MockLogsFactory{}
+	defer mockLogsFactory.AssertExpectations(t)
+
+	mockCleanupFactory := &modeselectormocks.MockCleanupFactory{}
+	defer mockCleanupFactory.AssertExpectations(t)
+
+	mockStopFactory := &modeselectormocks.MockStopFactory{}
+	defer mockStopFactory.AssertExpectations(t)
+
+	mockServiceFactory := &modeselectormocks.MockServiceFactory{}
+	defer mockServiceFactory.AssertExpectations(t)
+
+	mockREPLFactory := &modeselectormocks.MockREPLFactory{}
+	defer mockREPLFactory.AssertExpectations(t)
+
+	mockPipelineFactory := &modeselectormocks.MockPipelineFactory{}
+	defer mockPipelineFactory.AssertExpectations(t)
+
+	mockSelfTestFactory := &modeselectormocks.MockSelfTestFactory{}
+	defer mockSelfTestFactory.AssertExpectations(t)
+
+	mockKernelFactory := &modeselectormocks.MockKernelFactory{}
+	defer mockKernelFactory.AssertExpectations(t)
+
+	mockVersionFactory := &modeselectormocks.MockVersionFactory{}
+	defer mockVersionFactory.AssertExpectations(t)
+
+	mockTelemetryPreview := &entitiesmocks.MockMode{}
+	defer mockTelemetryPreview.AssertExpectations(t)
 
-	expectedError := assert.AnError
 	ctx := t.Context()
 
 	mockConfig.EXPECT().
@@ -270,35 +594,55 @@ func TestStartAndWaitForCompletion_WatchdogMode_StartAndWaitError(t *testing.T)
 		Once()
 
 	mockConfig.EXPECT().
-		WatchdogMode().
+		StatusMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		TelemetryPreviewMode().
 		Return(true).
 		Once()
 
-	mockWatchdogProcessFactory.EXPECT().
+	mockTelemetryPreviewFactory.EXPECT().
 		Create().
-		Return(mockWatchdogProcess, nil).
+		Return(mockTelemetryPreview, nil).
 		Once()
 
-	mockWatchdogProcess.EXPECT().
+	mockTelemetryPreview.EXPECT().
 		StartAndWaitForCompletion(ctx).
-		Return(expectedError).
+		Return(nil).
 		Once()
 
 	modeSelectorInstance := modeselector.New(
 		mockConfig,
 		mockWatchdogProcessFactory,
 		mockOrchestratorFactory,
-		mockOsLayer,
+		mockStatusFactory,
+		mockTelemetryPreviewFactory,
+		mockReplayFactory,
+		mockAttachFactory,
+		mockDoctorFactory,
+		mockInstallFactory,
+		mockCompletionFactory,
+		mockLogsFactory,
+		mockCleanupFactory,
+		mockStopFactory,
+		mockServiceFactory,
+		mockREPLFactory,
+		mockPipelineFactory,
+		mockSelfTestFactory,
+		mockKernelFactory,
+		mockVersionFactory,
 	)
 
 	// Act
 	err := modeSelectorInstance.StartAndWaitForCompletion(ctx)
 
 	// Assert
-	require.ErrorIs(t, err, expectedError, "StartAndWaitForCompletion should return the error from StartAndWaitForCompletion")
+	require.NoError(t, err, "StartAndWaitForCompletion should not return an error in telemetry preview mode")
 }
 
-func TestStartAndWaitForCompletion_DefaultMode_HappyPath(t *testing.T) {
+func TestStartAndWaitForCompletion_ReplayMode_HappyPath(t *testing.T) {
 	// Arrange
 	mockConfig := &modeselectormocks.MockConfig{}
 	defer mockConfig.AssertExpectations(t)
@@ -309,11 +653,56 @@ func TestStartAndWaitForCompletion_DefaultMode_HappyPath(t *testing.T) {
 	mockOrchestratorFactory := &modeselectormocks.MockOrchestratorFactory{}
 	defer mockOrchestratorFactory.AssertExpectations(t)
 
-	mockOsLayer := &modeselectormocks.MockOSLayer{}
-	defer mockOsLayer.AssertExpectations(t)
+	mockStatusFactory := &modeselectormocks.MockStatusFactory{}
+	defer mockStatusFactory.AssertExpectations(t)
 
-	mockOrchestrator := &entitiesmocks.MockMode{}
-	defer mockOrchestrator.AssertExpectations(t)
+	mockTelemetryPreviewFactory := &modeselectormocks.MockTelemetryPreviewFactory{}
+	defer mockTelemetryPreviewFactory.AssertExpectations(t)
+
+	mockReplayFactory := &modeselectormocks.MockReplayFactory{}
+	defer mockReplayFactory.AssertExpectations(t)
+
+	mockAttachFactory := &modeselectormocks.MockAttachFactory{}
+	defer mockAttachFactory.AssertExpectations(t)
+
+	mockDoctorFactory := &modeselectormocks.MockDoctorFactory{}
+	defer mockDoctorFactory.AssertExpectations(t)
+
+	mockInstallFactory := &modeselectormocks.MockInstallFactory{}
+	defer mockInstallFactory.AssertExpectations(t)
+
+	mockCompletionFactory := &modeselectormocks.MockCompletionFactory{}
+	defer mockCompletionFactory.AssertExpectations(t)
+
+	mockLogsFactory := &modeselectormocks.MockLogsFactory{}
+	defer mockLogsFactory.AssertExpectations(t)
+
+	mockCleanupFactory := &modeselectormocks.MockCleanupFactory{}
+	defer mockCleanupFactory.AssertExpectations(t)
+
+	mockStopFactory := &modeselectormocks.MockStopFactory{}
+	defer mockStopFactory.AssertExpectations(t)
+
+	mockServiceFactory := &modeselectormocks.MockServiceFactory{}
+	defer mockServiceFactory.AssertExpectations(t)
+
+	mockREPLFactory := &modeselectormocks.MockREPLFactory{}
+	defer mockREPLFactory.AssertExpectations(t)
+
+	mockPipelineFactory := &modeselectormocks.MockPipelineFactory{}
+	defer mockPipelineFactory.AssertExpectations(t)
+
+	mockSelfTestFactory := &modeselectormocks.MockSelfTestFactory{}
+	defer mockSelfTestFactory.AssertExpectations(t)
+
+	mockKernelFactory := &modeselectormocks.MockKernelFactory{}
+	defer mockKernelFactory.AssertExpectations(t)
+
+	mockVersionFactory := &modeselectormocks.MockVersionFactory{}
+	defer mockVersionFactory.AssertExpectations(t)
+
+	mockReplay := &entitiesmocks.MockMode{}
+	defer mockReplay.AssertExpectations(t)
 
 	ctx := t.Context()
 
@@ -323,16 +712,26 @@ func TestStartAndWaitForCompletion_DefaultMode_HappyPath(t *testing.T) {
 		Once()
 
 	mockConfig.EXPECT().
-		WatchdogMode().
+		StatusMode().
 		Return(false).
 		Once()
 
-	mockOrchestratorFactory.EXPECT().
+	mockConfig.EXPECT().
+		TelemetryPreviewMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ReplayMode().
+		Return(true).
+		Once()
+
+	mockReplayFactory.EXPECT().
 		Create().
-		Return(mockOrchestrator, nil).
+		Return(mockReplay, nil).
 		Once()
 
-	mockOrchestrator.EXPECT().
+	mockReplay.EXPECT().
 		StartAndWaitForCompletion(ctx).
 		Return(nil).
 		Once()
@@ -341,17 +740,32 @@ func TestStartAndWaitForCompletion_DefaultMode_HappyPath(t *testing.T) {
 		mockConfig,
 		mockWatchdogProcessFactory,
 		mockOrchestratorFactory,
-		mockOsLayer,
+		mockStatusFactory,
+		mockTelemetryPreviewFactory,
+		mockReplayFactory,
+		mockAttachFactory,
+		mockDoctorFactory,
+		mockInstallFactory,
+		mockCompletionFactory,
+		mockLogsFactory,
+		mockCleanupFactory,
+		mockStopFactory,
+		mockServiceFactory,
+		mockREPLFactory,
+		mockPipelineFactory,
+		mockSelfTestFactory,
+		mockKernelFactory,
+		mockVersionFactory,
 	)
 
 	// Act
 	err := modeSelectorInstance.StartAndWaitForCompletion(ctx)
 
 	// Assert
-	require.NoError(t, err, "StartAndWaitForCompletion should not return an error in default mode")
+	require.NoError(t, err, "StartAndWaitForCompletion should not return an error in replay mode")
 }
 
-func TestStartAndWaitForCompletion_DefaultMode_CreateError(t *testing.T) {
+func TestStartAndWaitForCompletion_REPLMode_HappyPath(t *testing.T) {
 	// Arrange
 	mockConfig := &modeselectormocks.MockConfig{}
 	defer mockConfig.AssertExpectations(t)
@@ -362,10 +776,58 @@ func TestStartAndWaitForCompletion_DefaultMode_CreateError(t *testing.T) {
 	mockOrchestratorFactory := &modeselectormocks.MockOrchestratorFactory{}
 	defer mockOrchestratorFactory.AssertExpectations(t)
 
-	mockOsLayer := &modeselectormocks.MockOSLayer{}
-	defer mockOsLayer.AssertExpectations(t)
+	mockStatusFactory := &modeselectormocks.MockStatusFactory{}
+	defer mockStatusFactory.AssertExpectations(t)
 
-	expectedError := assert.AnError
+	mockTelemetryPreviewFactory := &modeselectormocks.MockTelemetryPreviewFactory{}
+	defer mockTelemetryPreviewFactory.AssertExpectations(t)
+
+	mockReplayFactory := &modeselectormocks.MockReplayFactory{}
+	defer mockReplayFactory.AssertExpectations(t)
+
+	mockAttachFactory := &modeselectormocks.MockAttachFactory{}
+	defer mockAttachFactory.AssertExpectations(t)
+
+	mockDoctorFactory := &modeselectormocks.MockDoctorFactory{}
+	defer mockDoctorFactory.AssertExpectations(t)
+
+	mockInstallFactory := &modeselectormocks.MockInstallFactory{}
+	defer mockInstallFactory.AssertExpectations(t)
+
+	mockCompletionFactory := &modeselectormocks.MockCompletionFactory{}
+	defer mockCompletionFactory.AssertExpectations(t)
+
+	mockLogsFactory := &modeselectormocks.MockLogsFactory{}
+	defer mockLogsFactory.AssertExpectations(t)
+
+	mockCleanupFactory := &modeselectormocks.MockCleanupFactory{}
+	defer mockCleanupFactory.AssertExpectations(t)
+
+	mockStopFactory := &modeselectormocks.MockStopFactory{}
+	defer mockStopFactory.AssertExpectations(t)
+
+	mockServiceFactory := &modeselectormocks.MockServiceFactory{}
+	defer mockServiceFactory.AssertExpectations(t)
+
+	mockREPLFactory := &modeselectormocks.MockREPLFactory{}
+	defer mockREPLFactory.AssertExpectations(t)
+
+	mockPipelineFactory := &modeselectormocks.MockPipelineFactory{}
+	defer mockPipelineFactory.AssertExpectations(t)
+
+	mockSelfTestFactory := &modeselectormocks.MockSelfTestFactory{}
+	defer mockSelfTestFactory.AssertExpectations(t)
+
+	mockKernelFactory := &modeselectormocks.MockKernelFactory{}
+	defer mockKernelFactory.AssertExpectations(t)
+
+	mockVersionFactory := &modeselectormocks.MockVersionFactory{}
+	defer mockVersionFactory.AssertExpectations(t)
+
+	mockREPL := &entitiesmocks.MockMode{}
+	defer mockREPL.AssertExpectations(t)
+
+	ctx := t.Context()
 
 	mockConfig.EXPECT().
 		VersionMode().
@@ -373,30 +835,65 @@ func TestStartAndWaitForCompletion_DefaultMode_CreateError(t *testing.T) {
 		Once()
 
 	mockConfig.EXPECT().
-		WatchdogMode().
+		StatusMode().
 		Return(false).
 		Once()
 
-	mockOrchestratorFactory.EXPECT().
+	mockConfig.EXPECT().
+		TelemetryPreviewMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ReplayMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		REPLMode().
+		Return(true).
+		Once()
+
+	mockREPLFactory.EXPECT().
 		Create().
-		Return(nil, expectedError).
+		Return(mockREPL, nil).
+		Once()
+
+	mockREPL.EXPECT().
+		StartAndWaitForCompletion(ctx).
+		Return(nil).
 		Once()
 
 	modeSelectorInstance := modeselector.New(
 		mockConfig,
 		mockWatchdogProcessFactory,
 		mockOrchestratorFactory,
-		mockOsLayer,
+		mockStatusFactory,
+		mockTelemetryPreviewFactory,
+		mockReplayFactory,
+		mockAttachFactory,
+		mockDoctorFactory,
+		mockInstallFactory,
+		mockCompletionFactory,
+		mockLogsFactory,
+		mockCleanupFactory,
+		mockStopFactory,
+		mockServiceFactory,
+		mockREPLFactory,
+		mockPipelineFactory,
+		mockSelfTestFactory,
+		mockKernelFactory,
+		mockVersionFactory,
 	)
 
 	// Act
-	err := modeSelectorInstance.StartAndWaitForCompletion(t.Context())
+	err := modeSelectorInstance.StartAndWaitForCompletion(ctx)
 
 	// Assert
-	require.ErrorIs(t, err, expectedError, "StartAndWaitForCompletion should return the error from Create")
+	require.NoError(t, err, "StartAndWaitForCompletion should not return an error in REPL mode")
 }
 
-func TestStartAndWaitForCompletion_DefaultMode_StartAndWaitError(t *testing.T) {
+func TestStartAndWaitForCompletion_PipelineMode_HappyPath(t *testing.T) {
 	// Arrange
 	mockConfig := &modeselectormocks.MockConfig{}
 	defer mockConfig.AssertExpectations(t)
@@ -407,13 +904,57 @@ func TestStartAndWaitForCompletion_DefaultMode_StartAndWaitError(t *testing.T) {
 	mockOrchestratorFactory := &modeselectormocks.MockOrchestratorFactory{}
 	defer mockOrchestratorFactory.AssertExpectations(t)
 
-	mockOsLayer := &modeselectormocks.MockOSLayer{}
-	defer mockOsLayer.AssertExpectations(t)
+	mockStatusFactory := &modeselectormocks.MockStatusFactory{}
+	defer mockStatusFactory.AssertExpectations(t)
 
-	mockOrchestrator := &entitiesmocks.MockMode{}
-	defer mockOrchestrator.AssertExpectations(t)
+	mockTelemetryPreviewFactory := &modeselectormocks.MockTelemetryPreviewFactory{}
+	defer mockTelemetryPreviewFactory.AssertExpectations(t)
+
+	mockReplayFactory := &modeselectormocks.MockReplayFactory{}
+	defer mockReplayFactory.AssertExpectations(t)
+
+	mockAttachFactory := &modeselectormocks.MockAttachFactory{}
+	defer mockAttachFactory.AssertExpectations(t)
+
+	mockDoctorFactory := &modeselectormocks.MockDoctorFactory{}
+	defer mockDoctorFactory.AssertExpectations(t)
+
+	mockInstallFactory := &modeselectormocks.MockInstallFactory{}
+	defer mockInstallFactory.AssertExpectations(t)
+
+	mockCompletionFactory := &modeselectormocks.MockCompletionFactory{}
+	defer mockCompletionFactory.AssertExpectations(t)
+
+	mockLogsFactory := &modeselectormocks.MockLogsFactory{}
+	defer mockLogsFactory.AssertExpectations(t)
+
+	mockCleanupFactory := &modeselectormocks.MockCleanupFactory{}
+	defer mockCleanupFactory.AssertExpectations(t)
+
+	mockStopFactory := &modeselectormocks.MockStopFactory{}
+	defer mockStopFactory.AssertExpectations(t)
+
+	mockServiceFactory := &modeselectormocks.MockServiceFactory{}
+	defer mockServiceFactory.AssertExpectations(t)
+
+	mockREPLFactory := &modeselectormocks.MockREPLFactory{}
+	defer mockREPLFactory.AssertExpectations(t)
+
+	mockPipelineFactory := &modeselectormocks.MockPipelineFactory{}
+	defer mockPipelineFactory.AssertExpectations(t)
+
+	mockSelfTestFactory := &modeselectormocks.MockSelfTestFactory{}
+	defer mockSelfTestFactory.AssertExpectations(t)
+
+	mockKernelFactory := &modeselectormocks.MockKernelFactory{}
+	defer mockKernelFactory.AssertExpectations(t)
+
+	mockVersionFactory := &modeselectormocks.MockVersionFactory{}
+	defer mockVersionFactory.AssertExpectations(t)
+
+	mockPipeline := &entitiesmocks.MockMode{}
+	defer mockPipeline.AssertExpectations(t)
 
-	expectedError := assert.AnError
 	ctx := t.Context()
 
 	mockConfig.EXPECT().
@@ -421,6 +962,2963 @@ func TestStartAndWaitForCompletion_DefaultMode_StartAndWaitError(t *testing.T) {
 		Return(false).
 		Once()
 
+	mockConfig.EXPECT().
+		StatusMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		TelemetryPreviewMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ReplayMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		REPLMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		PipelineMode().
+		Return(true).
+		Once()
+
+	mockPipelineFactory.EXPECT().
+		Create().
+		Return(mockPipeline, nil).
+		Once()
+
+	mockPipeline.EXPECT().
+		StartAndWaitForCompletion(ctx).
+		Return(nil).
+		Once()
+
+	modeSelectorInstance := modeselector.New(
+		mockConfig,
+		mockWatchdogProcessFactory,
+		mockOrchestratorFactory,
+		mockStatusFactory,
+		mockTelemetryPreviewFactory,
+		mockReplayFactory,
+		mockAttachFactory,
+		mockDoctorFactory,
+		mockInstallFactory,
+		mockCompletionFactory,
+		mockLogsFactory,
+		mockCleanupFactory,
+		mockStopFactory,
+		mockServiceFactory,
+		mockREPLFactory,
+		mockPipelineFactory,
+		mockSelfTestFactory,
+		mockKernelFactory,
+		mockVersionFactory,
+	)
+
+	// Act
+	err := modeSelectorInstance.StartAndWaitForCompletion(ctx)
+
+	// Assert
+	require.NoError(t, err, "StartAndWaitForCompletion should not return an error in pipeline mode")
+}
+
+func TestStartAndWaitForCompletion_SelfTestMode_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &modeselectormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockWatchdogProcessFactory := &modeselectormocks.MockWatchdogProcessFactory{}
+	defer mockWatchdogProcessFactory.AssertExpectations(t)
+
+	mockOrchestratorFactory := &modeselectormocks.MockOrchestratorFactory{}
+	defer mockOrchestratorFactory.AssertExpectations(t)
+
+	mockStatusFactory := &modeselectormocks.MockStatusFactory{}
+	defer mockStatusFactory.AssertExpectations(t)
+
+	mockTelemetryPreviewFactory := &modeselectormocks.MockTelemetryPreviewFactory{}
+	defer mockTelemetryPreviewFactory.AssertExpectations(t)
+
+	mockReplayFactory := &modeselectormocks.MockReplayFactory{}
+	defer mockReplayFactory.AssertExpectations(t)
+
+	mockAttachFactory := &modeselectormocks.MockAttachFactory{}
+	defer mockAttachFactory.AssertExpectations(t)
+
+	mockDoctorFactory := &modeselectormocks.MockDoctorFactory{}
+	defer mockDoctorFactory.AssertExpectations(t)
+
+	mockInstallFactory := &modeselectormocks.MockInstallFactory{}
+	defer mockInstallFactory.AssertExpectations(t)
+
+	mockCompletionFactory := &modeselectormocks.MockCompletionFactory{}
+	defer mockCompletionFactory.AssertExpectations(t)
+
+	mockLogsFactory := &modeselectormocks.MockLogsFactory{}
+	defer mockLogsFactory.AssertExpectations(t)
+
+	mockCleanupFactory := &modeselectormocks.MockCleanupFactory{}
+	defer mockCleanupFactory.AssertExpectations(t)
+
+	mockStopFactory := &modeselectormocks.MockStopFactory{}
+	defer mockStopFactory.AssertExpectations(t)
+
+	mockServiceFactory := &modeselectormocks.MockServiceFactory{}
+	defer mockServiceFactory.AssertExpectations(t)
+
+	mockREPLFactory := &modeselectormocks.MockREPLFactory{}
+	defer mockREPLFactory.AssertExpectations(t)
+
+	mockPipelineFactory := &modeselectormocks.MockPipelineFactory{}
+	defer mockPipelineFactory.AssertExpectations(t)
+
+	mockSelfTestFactory := &modeselectormocks.MockSelfTestFactory{}
+	defer mockSelfTestFactory.AssertExpectations(t)
+
+	mockKernelFactory := &modeselectormocks.MockKernelFactory{}
+	defer mockKernelFactory.AssertExpectations(t)
+
+	mockVersionFactory := &modeselectormocks.MockVersionFactory{}
+	defer mockVersionFactory.AssertExpectations(t)
+
+	mockSelfTest := &entitiesmocks.MockMode{}
+	defer mockSelfTest.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		VersionMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		StatusMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		TelemetryPreviewMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ReplayMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		REPLMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		PipelineMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		SelfTestMode().
+		Return(true).
+		Once()
+
+	mockSelfTestFactory.EXPECT().
+		Create().
+		Return(mockSelfTest, nil).
+		Once()
+
+	mockSelfTest.EXPECT().
+		StartAndWaitForCompletion(ctx).
+		Return(nil).
+		Once()
+
+	modeSelectorInstance := modeselector.New(
+		mockConfig,
+		mockWatchdogProcessFactory,
+		mockOrchestratorFactory,
+		mockStatusFactory,
+		mockTelemetryPreviewFactory,
+		mockReplayFactory,
+		mockAttachFactory,
+		mockDoctorFactory,
+		mockInstallFactory,
+		mockCompletionFactory,
+		mockLogsFactory,
+		mockCleanupFactory,
+		mockStopFactory,
+		mockServiceFactory,
+		mockREPLFactory,
+		mockPipelineFactory,
+		mockSelfTestFactory,
+		mockKernelFactory,
+		mockVersionFactory,
+	)
+
+	// Act
+	err := modeSelectorInstance.StartAndWaitForCompletion(ctx)
+
+	// Assert
+	require.NoError(t, err, "StartAndWaitForCompletion should not return an error in self-test mode")
+}
+
+func TestStartAndWaitForCompletion_KernelMode_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &modeselectormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockWatchdogProcessFactory := &modeselectormocks.MockWatchdogProcessFactory{}
+	defer mockWatchdogProcessFactory.AssertExpectations(t)
+
+	mockOrchestratorFactory := &modeselectormocks.MockOrchestratorFactory{}
+	defer mockOrchestratorFactory.AssertExpectations(t)
+
+	mockStatusFactory := &modeselectormocks.MockStatusFactory{}
+	defer mockStatusFactory.AssertExpectations(t)
+
+	mockTelemetryPreviewFactory := &modeselectormocks.MockTelemetryPreviewFactory{}
+	defer mockTelemetryPreviewFactory.AssertExpectations(t)
+
+	mockReplayFactory := &modeselectormocks.MockReplayFactory{}
+	defer mockReplayFactory.AssertExpectations(t)
+
+	mockAttachFactory := &modeselectormocks.MockAttachFactory{}
+	defer mockAttachFactory.AssertExpectations(t)
+
+	mockDoctorFactory := &modeselectormocks.MockDoctorFactory{}
+	defer mockDoctorFactory.AssertExpectations(t)
+
+	mockInstallFactory := &modeselectormocks.MockInstallFactory{}
+	defer mockInstallFactory.AssertExpectations(t)
+
+	mockCompletionFactory := &modeselectormocks.MockCompletionFactory{}
+	defer mockCompletionFactory.AssertExpectations(t)
+
+	mockLogsFactory := &modeselectormocks.MockLogsFactory{}
+	defer mockLogsFactory.AssertExpectations(t)
+
+	mockCleanupFactory := &modeselectormocks.MockCleanupFactory{}
+	defer mockCleanupFactory.AssertExpectations(t)
+
+	mockStopFactory := &modeselectormocks.MockStopFactory{}
+	defer mockStopFactory.AssertExpectations(t)
+
+	mockServiceFactory := &modeselectormocks.MockServiceFactory{}
+	defer mockServiceFactory.AssertExpectations(t)
+
+	mockREPLFactory := &modeselectormocks.MockREPLFactory{}
+	defer mockREPLFactory.AssertExpectations(t)
+
+	mockPipelineFactory := &modeselectormocks.MockPipelineFactory{}
+	defer mockPipelineFactory.AssertExpectations(t)
+
+	mockSelfTestFactory := &modeselectormocks.MockSelfTestFactory{}
+	defer mockSelfTestFactory.AssertExpectations(t)
+
+	mockKernelFactory := &modeselectormocks.MockKernelFactory{}
+	defer mockKernelFactory.AssertExpectations(t)
+
+	mockVersionFactory := &modeselectormocks.MockVersionFactory{}
+	defer mockVersionFactory.AssertExpectations(t)
+
+	mockKernel := &entitiesmocks.MockMode{}
+	defer mockKernel.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		VersionMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		StatusMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		TelemetryPreviewMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ReplayMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		REPLMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		PipelineMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		SelfTestMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		KernelMode().
+		Return(true).
+		Once()
+
+	mockKernelFactory.EXPECT().
+		Create().
+		Return(mockKernel, nil).
+		Once()
+
+	mockKernel.EXPECT().
+		StartAndWaitForCompletion(ctx).
+		Return(nil).
+		Once()
+
+	modeSelectorInstance := modeselector.New(
+		mockConfig,
+		mockWatchdogProcessFactory,
+		mockOrchestratorFactory,
+		mockStatusFactory,
+		mockTelemetryPreviewFactory,
+		mockReplayFactory,
+		mockAttachFactory,
+		mockDoctorFactory,
+		mockInstallFactory,
+		mockCompletionFactory,
+		mockLogsFactory,
+		mockCleanupFactory,
+		mockStopFactory,
+		mockServiceFactory,
+		mockREPLFactory,
+		mockPipelineFactory,
+		mockSelfTestFactory,
+		mockKernelFactory,
+		mockVersionFactory,
+	)
+
+	// Act
+	err := modeSelectorInstance.StartAndWaitForCompletion(ctx)
+
+	// Assert
+	require.NoError(t, err, "StartAndWaitForCompletion should not return an error in kernel mode")
+}
+
+func TestStartAndWaitForCompletion_AttachMode_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &modeselectormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockWatchdogProcessFactory := &modeselectormocks.MockWatchdogProcessFactory{}
+	defer mockWatchdogProcessFactory.AssertExpectations(t)
+
+	mockOrchestratorFactory := &modeselectormocks.MockOrchestratorFactory{}
+	defer mockOrchestratorFactory.AssertExpectations(t)
+
+	mockStatusFactory := &modeselectormocks.MockStatusFactory{}
+	defer mockStatusFactory.AssertExpectations(t)
+
+	mockTelemetryPreviewFactory := &modeselectormocks.MockTelemetryPreviewFactory{}
+	defer mockTelemetryPreviewFactory.AssertExpectations(t)
+
+	mockReplayFactory := &modeselectormocks.MockReplayFactory{}
+	defer mockReplayFactory.AssertExpectations(t)
+
+	mockAttachFactory := &modeselectormocks.MockAttachFactory{}
+	defer mockAttachFactory.AssertExpectations(t)
+
+	mockDoctorFactory := &modeselectormocks.MockDoctorFactory{}
+	defer mockDoctorFactory.AssertExpectations(t)
+
+	mockInstallFactory := &modeselectormocks.MockInstallFactory{}
+	defer mockInstallFactory.AssertExpectations(t)
+
+	mockCompletionFactory := &modeselectormocks.MockCompletionFactory{}
+	defer mockCompletionFactory.AssertExpectations(t)
+
+	mockLogsFactory := &modeselectormocks.MockLogsFactory{}
+	defer mockLogsFactory.AssertExpectations(t)
+
+	mockCleanupFactory := &modeselectormocks.MockCleanupFactory{}
+	defer mockCleanupFactory.AssertExpectations(t)
+
+	mockStopFactory := &modeselectormocks.MockStopFactory{}
+	defer mockStopFactory.AssertExpectations(t)
+
+	mockServiceFactory := &modeselectormocks.MockServiceFactory{}
+	defer mockServiceFactory.AssertExpectations(t)
+
+	mockREPLFactory := &modeselectormocks.MockREPLFactory{}
+	defer mockREPLFactory.AssertExpectations(t)
+
+	mockPipelineFactory := &modeselectormocks.MockPipelineFactory{}
+	defer mockPipelineFactory.AssertExpectations(t)
+
+	mockSelfTestFactory := &modeselectormocks.MockSelfTestFactory{}
+	defer mockSelfTestFactory.AssertExpectations(t)
+
+	mockKernelFactory := &modeselectormocks.MockKernelFactory{}
+	defer mockKernelFactory.AssertExpectations(t)
+
+	mockVersionFactory := &modeselectormocks.MockVersionFactory{}
+	defer mockVersionFactory.AssertExpectations(t)
+
+	mockAttach := &entitiesmocks.MockMode{}
+	defer mockAttach.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		VersionMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		StatusMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		TelemetryPreviewMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ReplayMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		REPLMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		PipelineMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		SelfTestMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		KernelMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DoctorMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		InstallMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		UninstallMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		CompletionMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		LogsMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		CleanupMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		StopMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ServiceMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		AttachMode().
+		Return(true).
+		Once()
+
+	mockAttachFactory.EXPECT().
+		Create().
+		Return(mockAttach, nil).
+		Once()
+
+	mockAttach.EXPECT().
+		StartAndWaitForCompletion(ctx).
+		Return(nil).
+		Once()
+
+	modeSelectorInstance := modeselector.New(
+		mockConfig,
+		mockWatchdogProcessFactory,
+		mockOrchestratorFactory,
+		mockStatusFactory,
+		mockTelemetryPreviewFactory,
+		mockReplayFactory,
+		mockAttachFactory,
+		mockDoctorFactory,
+		mockInstallFactory,
+		mockCompletionFactory,
+		mockLogsFactory,
+		mockCleanupFactory,
+		mockStopFactory,
+		mockServiceFactory,
+		mockREPLFactory,
+		mockPipelineFactory,
+		mockSelfTestFactory,
+		mockKernelFactory,
+		mockVersionFactory,
+	)
+
+	// Act
+	err := modeSelectorInstance.StartAndWaitForCompletion(ctx)
+
+	// Assert
+	require.NoError(t, err, "StartAndWaitForCompletion should not return an error in attach mode")
+}
+
+func TestStartAndWaitForCompletion_DoctorMode_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &modeselectormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockWatchdogProcessFactory := &modeselectormocks.MockWatchdogProcessFactory{}
+	defer mockWatchdogProcessFactory.AssertExpectations(t)
+
+	mockOrchestratorFactory := &modeselectormocks.MockOrchestratorFactory{}
+	defer mockOrchestratorFactory.AssertExpectations(t)
+
+	mockStatusFactory := &modeselectormocks.MockStatusFactory{}
+	defer mockStatusFactory.AssertExpectations(t)
+
+	mockTelemetryPreviewFactory := &modeselectormocks.MockTelemetryPreviewFactory{}
+	defer mockTelemetryPreviewFactory.AssertExpectations(t)
+
+	mockReplayFactory := &modeselectormocks.MockReplayFactory{}
+	defer mockReplayFactory.AssertExpectations(t)
+
+	mockAttachFactory := &modeselectormocks.MockAttachFactory{}
+	defer mockAttachFactory.AssertExpectations(t)
+
+	mockDoctorFactory := &modeselectormocks.MockDoctorFactory{}
+	defer mockDoctorFactory.AssertExpectations(t)
+
+	mockInstallFactory := &modeselectormocks.MockInstallFactory{}
+	defer mockInstallFactory.AssertExpectations(t)
+
+	mockCompletionFactory := &modeselectormocks.MockCompletionFactory{}
+	defer mockCompletionFactory.AssertExpectations(t)
+
+	mockLogsFactory := &modeselectormocks.MockLogsFactory{}
+	defer mockLogsFactory.AssertExpectations(t)
+
+	mockCleanupFactory := &modeselectormocks.MockCleanupFactory{}
+	defer mockCleanupFactory.AssertExpectations(t)
+
+	mockStopFactory := &modeselectormocks.MockStopFactory{}
+	defer mockStopFactory.AssertExpectations(t)
+
+	mockServiceFactory := &modeselectormocks.MockServiceFactory{}
+	defer mockServiceFactory.AssertExpectations(t)
+
+	mockREPLFactory := &modeselectormocks.MockREPLFactory{}
+	defer mockREPLFactory.AssertExpectations(t)
+
+	mockPipelineFactory := &modeselectormocks.MockPipelineFactory{}
+	defer mockPipelineFactory.AssertExpectations(t)
+
+	mockSelfTestFactory := &modeselectormocks.MockSelfTestFactory{}
+	defer mockSelfTestFactory.AssertExpectations(t)
+
+	mockKernelFactory := &modeselectormocks.MockKernelFactory{}
+	defer mockKernelFactory.AssertExpectations(t)
+
+	mockVersionFactory := &modeselectormocks.MockVersionFactory{}
+	defer mockVersionFactory.AssertExpectations(t)
+
+	mockDoctor := &entitiesmocks.MockMode{}
+	defer mockDoctor.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		VersionMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		StatusMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		TelemetryPreviewMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ReplayMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		REPLMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		PipelineMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		SelfTestMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		KernelMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DoctorMode().
+		Return(true).
+		Once()
+
+	mockDoctorFactory.EXPECT().
+		Create().
+		Return(mockDoctor, nil).
+		Once()
+
+	mockDoctor.EXPECT().
+		StartAndWaitForCompletion(ctx).
+		Return(nil).
+		Once()
+
+	modeSelectorInstance := modeselector.New(
+		mockConfig,
+		mockWatchdogProcessFactory,
+		mockOrchestratorFactory,
+		mockStatusFactory,
+		mockTelemetryPreviewFactory,
+		mockReplayFactory,
+		mockAttachFactory,
+		mockDoctorFactory,
+		mockInstallFactory,
+		mockCompletionFactory,
+		mockLogsFactory,
+		mockCleanupFactory,
+		mockStopFactory,
+		mockServiceFactory,
+		mockREPLFactory,
+		mockPipelineFactory,
+		mockSelfTestFactory,
+		mockKernelFactory,
+		mockVersionFactory,
+	)
+
+	// Act
+	err := modeSelectorInstance.StartAndWaitForCompletion(ctx)
+
+	// Assert
+	require.NoError(t, err, "StartAndWaitForCompletion should not return an error in doctor mode")
+}
+
+func TestStartAndWaitForCompletion_InstallMode_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &modeselectormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockWatchdogProcessFactory := &modeselectormocks.MockWatchdogProcessFactory{}
+	defer mockWatchdogProcessFactory.AssertExpectations(t)
+
+	mockOrchestratorFactory := &modeselectormocks.MockOrchestratorFactory{}
+	defer mockOrchestratorFactory.AssertExpectations(t)
+
+	mockStatusFactory := &modeselectormocks.MockStatusFactory{}
+	defer mockStatusFactory.AssertExpectations(t)
+
+	mockTelemetryPreviewFactory := &modeselectormocks.MockTelemetryPreviewFactory{}
+	defer mockTelemetryPreviewFactory.AssertExpectations(t)
+
+	mockReplayFactory := &modeselectormocks.MockReplayFactory{}
+	defer mockReplayFactory.AssertExpectations(t)
+
+	mockAttachFactory := &modeselectormocks.MockAttachFactory{}
+	defer mockAttachFactory.AssertExpectations(t)
+
+	mockDoctorFactory := &modeselectormocks.MockDoctorFactory{}
+	defer mockDoctorFactory.AssertExpectations(t)
+
+	mockInstallFactory := &modeselectormocks.MockInstallFactory{}
+	defer mockInstallFactory.AssertExpectations(t)
+
+	mockCompletionFactory := &modeselectormocks.MockCompletionFactory{}
+	defer mockCompletionFactory.AssertExpectations(t)
+
+	mockLogsFactory := &modeselectormocks.MockLogsFactory{}
+	defer mockLogsFactory.AssertExpectations(t)
+
+	mockCleanupFactory := &modeselectormocks.MockCleanupFactory{}
+	defer mockCleanupFactory.AssertExpectations(t)
+
+	mockStopFactory := &modeselectormocks.MockStopFactory{}
+	defer mockStopFactory.AssertExpectations(t)
+
+	mockServiceFactory := &modeselectormocks.MockServiceFactory{}
+	defer mockServiceFactory.AssertExpectations(t)
+
+	mockREPLFactory := &modeselectormocks.MockREPLFactory{}
+	defer mockREPLFactory.AssertExpectations(t)
+
+	mockPipelineFactory := &modeselectormocks.MockPipelineFactory{}
+	defer mockPipelineFactory.AssertExpectations(t)
+
+	mockSelfTestFactory := &modeselectormocks.MockSelfTestFactory{}
+	defer mockSelfTestFactory.AssertExpectations(t)
+
+	mockKernelFactory := &modeselectormocks.MockKernelFactory{}
+	defer mockKernelFactory.AssertExpectations(t)
+
+	mockVersionFactory := &modeselectormocks.MockVersionFactory{}
+	defer mockVersionFactory.AssertExpectations(t)
+
+	mockInstall := &entitiesmocks.MockMode{}
+	defer mockInstall.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		VersionMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		StatusMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		TelemetryPreviewMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ReplayMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		REPLMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		PipelineMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		SelfTestMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		KernelMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DoctorMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		InstallMode().
+		Return(true).
+		Once()
+
+	mockInstallFactory.EXPECT().
+		Create().
+		Return(mockInstall, nil).
+		Once()
+
+	mockInstall.EXPECT().
+		StartAndWaitForCompletion(ctx).
+		Return(nil).
+		Once()
+
+	modeSelectorInstance := modeselector.New(
+		mockConfig,
+		mockWatchdogProcessFactory,
+		mockOrchestratorFactory,
+		mockStatusFactory,
+		mockTelemetryPreviewFactory,
+		mockReplayFactory,
+		mockAttachFactory,
+		mockDoctorFactory,
+		mockInstallFactory,
+		mockCompletionFactory,
+		mockLogsFactory,
+		mockCleanupFactory,
+		mockStopFactory,
+		mockServiceFactory,
+		mockREPLFactory,
+		mockPipelineFactory,
+		mockSelfTestFactory,
+		mockKernelFactory,
+		mockVersionFactory,
+	)
+
+	// Act
+	err := modeSelectorInstance.StartAndWaitForCompletion(ctx)
+
+	// Assert
+	require.NoError(t, err, "StartAndWaitForCompletion should not return an error in install mode")
+}
+
+func TestStartAndWaitForCompletion_UninstallMode_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &modeselectormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockWatchdogProcessFactory := &modeselectormocks.MockWatchdogProcessFactory{}
+	defer mockWatchdogProcessFactory.AssertExpectations(t)
+
+	mockOrchestratorFactory := &modeselectormocks.MockOrchestratorFactory{}
+	defer mockOrchestratorFactory.AssertExpectations(t)
+
+	mockStatusFactory := &modeselectormocks.MockStatusFactory{}
+	defer mockStatusFactory.AssertExpectations(t)
+
+	mockTelemetryPreviewFactory := &modeselectormocks.MockTelemetryPreviewFactory{}
+	defer mockTelemetryPreviewFactory.AssertExpectations(t)
+
+	mockReplayFactory := &modeselectormocks.MockReplayFactory{}
+	defer mockReplayFactory.AssertExpectations(t)
+
+	mockAttachFactory := &modeselectormocks.MockAttachFactory{}
+	defer mockAttachFactory.AssertExpectations(t)
+
+	mockDoctorFactory := &modeselectormocks.MockDoctorFactory{}
+	defer mockDoctorFactory.AssertExpectations(t)
+
+	mockInstallFactory := &modeselectormocks.MockInstallFactory{}
+	defer mockInstallFactory.AssertExpectations(t)
+
+	mockCompletionFactory := &modeselectormocks.MockCompletionFactory{}
+	defer mockCompletionFactory.AssertExpectations(t)
+
+	mockLogsFactory := &modeselectormocks.MockLogsFactory{}
+	defer mockLogsFactory.AssertExpectations(t)
+
+	mockCleanupFactory := &modeselectormocks.MockCleanupFactory{}
+	defer mockCleanupFactory.AssertExpectations(t)
+
+	mockStopFactory := &modeselectormocks.MockStopFactory{}
+	defer mockStopFactory.AssertExpectations(t)
+
+	mockServiceFactory := &modeselectormocks.MockServiceFactory{}
+	defer mockServiceFactory.AssertExpectations(t)
+
+	mockREPLFactory := &modeselectormocks.MockREPLFactory{}
+	defer mockREPLFactory.AssertExpectations(t)
+
+	mockPipelineFactory := &modeselectormocks.MockPipelineFactory{}
+	defer mockPipelineFactory.AssertExpectations(t)
+
+	mockSelfTestFactory := &modeselectormocks.MockSelfTestFactory{}
+	defer mockSelfTestFactory.AssertExpectations(t)
+
+	mockKernelFactory := &modeselectormocks.MockKernelFactory{}
+	defer mockKernelFactory.AssertExpectations(t)
+
+	mockVersionFactory := &modeselectormocks.MockVersionFactory{}
+	defer mockVersionFactory.AssertExpectations(t)
+
+	mockInstall := &entitiesmocks.MockMode{}
+	defer mockInstall.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		VersionMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		StatusMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		TelemetryPreviewMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ReplayMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		REPLMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		PipelineMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		SelfTestMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		KernelMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DoctorMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		InstallMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		UninstallMode().
+		Return(true).
+		Once()
+
+	mockInstallFactory.EXPECT().
+		Create().
+		Return(mockInstall, nil).
+		Once()
+
+	mockInstall.EXPECT().
+		StartAndWaitForCompletion(ctx).
+		Return(nil).
+		Once()
+
+	modeSelectorInstance := modeselector.New(
+		mockConfig,
+		mockWatchdogProcessFactory,
+		mockOrchestratorFactory,
+		mockStatusFactory,
+		mockTelemetryPreviewFactory,
+		mockReplayFactory,
+		mockAttachFactory,
+		mockDoctorFactory,
+		mockInstallFactory,
+		mockCompletionFactory,
+		mockLogsFactory,
+		mockCleanupFactory,
+		mockStopFactory,
+		mockServiceFactory,
+		mockREPLFactory,
+		mockPipelineFactory,
+		mockSelfTestFactory,
+		mockKernelFactory,
+		mockVersionFactory,
+	)
+
+	// Act
+	err := modeSelectorInstance.StartAndWaitForCompletion(ctx)
+
+	// Assert
+	require.NoError(t, err, "StartAndWaitForCompletion should not return an error in uninstall mode")
+}
+
+func TestStartAndWaitForCompletion_CompletionMode_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &modeselectormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockWatchdogProcessFactory := &modeselectormocks.MockWatchdogProcessFactory{}
+	defer mockWatchdogProcessFactory.AssertExpectations(t)
+
+	mockOrchestratorFactory := &modeselectormocks.MockOrchestratorFactory{}
+	defer mockOrchestratorFactory.AssertExpectations(t)
+
+	mockStatusFactory := &modeselectormocks.MockStatusFactory{}
+	defer mockStatusFactory.AssertExpectations(t)
+
+	mockTelemetryPreviewFactory := &modeselectormocks.MockTelemetryPreviewFactory{}
+	defer mockTelemetryPreviewFactory.AssertExpectations(t)
+
+	mockReplayFactory := &modeselectormocks.MockReplayFactory{}
+	defer mockReplayFactory.AssertExpectations(t)
+
+	mockAttachFactory := &modeselectormocks.MockAttachFactory{}
+	defer mockAttachFactory.AssertExpectations(t)
+
+	mockDoctorFactory := &modeselectormocks.MockDoctorFactory{}
+	defer mockDoctorFactory.AssertExpectations(t)
+
+	mockInstallFactory := &modeselectormocks.MockInstallFactory{}
+	defer mockInstallFactory.AssertExpectations(t)
+
+	mockCompletionFactory := &modeselectormocks.MockCompletionFactory{}
+	defer mockCompletionFactory.AssertExpectations(t)
+
+	mockLogsFactory := &modeselectormocks.MockLogsFactory{}
+	defer mockLogsFactory.AssertExpectations(t)
+
+	mockCleanupFactory := &modeselectormocks.MockCleanupFactory{}
+	defer mockCleanupFactory.AssertExpectations(t)
+
+	mockStopFactory := &modeselectormocks.MockStopFactory{}
+	defer mockStopFactory.AssertExpectations(t)
+
+	mockServiceFactory := &modeselectormocks.MockServiceFactory{}
+	defer mockServiceFactory.AssertExpectations(t)
+
+	mockREPLFactory := &modeselectormocks.MockREPLFactory{}
+	defer mockREPLFactory.AssertExpectations(t)
+
+	mockPipelineFactory := &modeselectormocks.MockPipelineFactory{}
+	defer mockPipelineFactory.AssertExpectations(t)
+
+	mockSelfTestFactory := &modeselectormocks.MockSelfTestFactory{}
+	defer mockSelfTestFactory.AssertExpectations(t)
+
+	mockKernelFactory := &modeselectormocks.MockKernelFactory{}
+	defer mockKernelFactory.AssertExpectations(t)
+
+	mockVersionFactory := &modeselectormocks.MockVersionFactory{}
+	defer mockVersionFactory.AssertExpectations(t)
+
+	mockCompletion := &entitiesmocks.MockMode{}
+	defer mockCompletion.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		VersionMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		StatusMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		TelemetryPreviewMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ReplayMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		REPLMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		PipelineMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		SelfTestMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		KernelMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DoctorMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		InstallMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		UninstallMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		CompletionMode().
+		Return(true).
+		Once()
+
+	mockCompletionFactory.EXPECT().
+		Create().
+		Return(mockCompletion, nil).
+		Once()
+
+	mockCompletion.EXPECT().
+		StartAndWaitForCompletion(ctx).
+		Return(nil).
+		Once()
+
+	modeSelectorInstance := modeselector.New(
+		mockConfig,
+		mockWatchdogProcessFactory,
+		mockOrchestratorFactory,
+		mockStatusFactory,
+		mockTelemetryPreviewFactory,
+		mockReplayFactory,
+		mockAttachFactory,
+		mockDoctorFactory,
+		mockInstallFactory,
+		mockCompletionFactory,
+		mockLogsFactory,
+		mockCleanupFactory,
+		mockStopFactory,
+		mockServiceFactory,
+		mockREPLFactory,
+		mockPipelineFactory,
+		mockSelfTestFactory,
+		mockKernelFactory,
+		mockVersionFactory,
+	)
+
+	// Act
+	err := modeSelectorInstance.StartAndWaitForCompletion(ctx)
+
+	// Assert
+	require.NoError(t, err, "StartAndWaitForCompletion should not return an error in completion mode")
+}
+
+func TestStartAndWaitForCompletion_LogsMode_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &modeselectormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockWatchdogProcessFactory := &modeselectormocks.MockWatchdogProcessFactory{}
+	defer mockWatchdogProcessFactory.AssertExpectations(t)
+
+	mockOrchestratorFactory := &modeselectormocks.MockOrchestratorFactory{}
+	defer mockOrchestratorFactory.AssertExpectations(t)
+
+	mockStatusFactory := &modeselectormocks.MockStatusFactory{}
+	defer mockStatusFactory.AssertExpectations(t)
+
+	mockTelemetryPreviewFactory := &modeselectormocks.MockTelemetryPreviewFactory{}
+	defer mockTelemetryPreviewFactory.AssertExpectations(t)
+
+	mockReplayFactory := &modeselectormocks.MockReplayFactory{}
+	defer mockReplayFactory.AssertExpectations(t)
+
+	mockAttachFactory := &modeselectormocks.MockAttachFactory{}
+	defer mockAttachFactory.AssertExpectations(t)
+
+	mockDoctorFactory := &modeselectormocks.MockDoctorFactory{}
+	defer mockDoctorFactory.AssertExpectations(t)
+
+	mockInstallFactory := &modeselectormocks.MockInstallFactory{}
+	defer mockInstallFactory.AssertExpectations(t)
+
+	mockCompletionFactory := &modeselectormocks.MockCompletionFactory{}
+	defer mockCompletionFactory.AssertExpectations(t)
+
+	mockLogsFactory := &modeselectormocks.MockLogsFactory{}
+	defer mockLogsFactory.AssertExpectations(t)
+
+	mockCleanupFactory := &modeselectormocks.MockCleanupFactory{}
+	defer mockCleanupFactory.AssertExpectations(t)
+
+	mockStopFactory := &modeselectormocks.MockStopFactory{}
+	defer mockStopFactory.AssertExpectations(t)
+
+	mockServiceFactory := &modeselectormocks.MockServiceFactory{}
+	defer mockServiceFactory.AssertExpectations(t)
+
+	mockREPLFactory := &modeselectormocks.MockREPLFactory{}
+	defer mockREPLFactory.AssertExpectations(t)
+
+	mockPipelineFactory := &modeselectormocks.MockPipelineFactory{}
+	defer mockPipelineFactory.AssertExpectations(t)
+
+	mockSelfTestFactory := &modeselectormocks.MockSelfTestFactory{}
+	defer mockSelfTestFactory.AssertExpectations(t)
+
+	mockKernelFactory := &modeselectormocks.MockKernelFactory{}
+	defer mockKernelFactory.AssertExpectations(t)
+
+	mockVersionFactory := &modeselectormocks.MockVersionFactory{}
+	defer mockVersionFactory.AssertExpectations(t)
+
+	mockLogs := &entitiesmocks.MockMode{}
+	defer mockLogs.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		VersionMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		StatusMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		TelemetryPreviewMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ReplayMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		REPLMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		PipelineMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		SelfTestMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		KernelMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DoctorMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		InstallMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		UninstallMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		CompletionMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		LogsMode().
+		Return(true).
+		Once()
+
+	mockLogsFactory.EXPECT().
+		Create().
+		Return(mockLogs, nil).
+		Once()
+
+	mockLogs.EXPECT().
+		StartAndWaitForCompletion(ctx).
+		Return(nil).
+		Once()
+
+	modeSelectorInstance := modeselector.New(
+		mockConfig,
+		mockWatchdogProcessFactory,
+		mockOrchestratorFactory,
+		mockStatusFactory,
+		mockTelemetryPreviewFactory,
+		mockReplayFactory,
+		mockAttachFactory,
+		mockDoctorFactory,
+		mockInstallFactory,
+		mockCompletionFactory,
+		mockLogsFactory,
+		mockCleanupFactory,
+		mockStopFactory,
+		mockServiceFactory,
+		mockREPLFactory,
+		mockPipelineFactory,
+		mockSelfTestFactory,
+		mockKernelFactory,
+		mockVersionFactory,
+	)
+
+	// Act
+	err := modeSelectorInstance.StartAndWaitForCompletion(ctx)
+
+	// Assert
+	require.NoError(t, err, "StartAndWaitForCompletion should not return an error in logs mode")
+}
+
+func TestStartAndWaitForCompletion_CleanupMode_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &modeselectormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockWatchdogProcessFactory := &modeselectormocks.MockWatchdogProcessFactory{}
+	defer mockWatchdogProcessFactory.AssertExpectations(t)
+
+	mockOrchestratorFactory := &modeselectormocks.MockOrchestratorFactory{}
+	defer mockOrchestratorFactory.AssertExpectations(t)
+
+	mockStatusFactory := &modeselectormocks.MockStatusFactory{}
+	defer mockStatusFactory.AssertExpectations(t)
+
+	mockTelemetryPreviewFactory := &modeselectormocks.MockTelemetryPreviewFactory{}
+	defer mockTelemetryPreviewFactory.AssertExpectations(t)
+
+	mockReplayFactory := &modeselectormocks.MockReplayFactory{}
+	defer mockReplayFactory.AssertExpectations(t)
+
+	mockAttachFactory := &modeselectormocks.MockAttachFactory{}
+	defer mockAttachFactory.AssertExpectations(t)
+
+	mockDoctorFactory := &modeselectormocks.MockDoctorFactory{}
+	defer mockDoctorFactory.AssertExpectations(t)
+
+	mockInstallFactory := &modeselectormocks.MockInstallFactory{}
+	defer mockInstallFactory.AssertExpectations(t)
+
+	mockCompletionFactory := &modeselectormocks.MockCompletionFactory{}
+	defer mockCompletionFactory.AssertExpectations(t)
+
+	mockLogsFactory := &modeselectormocks.MockLogsFactory{}
+	defer mockLogsFactory.AssertExpectations(t)
+
+	mockCleanupFactory := &modeselectormocks.MockCleanupFactory{}
+	defer mockCleanupFactory.AssertExpectations(t)
+
+	mockStopFactory := &modeselectormocks.MockStopFactory{}
+	defer mockStopFactory.AssertExpectations(t)
+
+	mockServiceFactory := &modeselectormocks.MockServiceFactory{}
+	defer mockServiceFactory.AssertExpectations(t)
+
+	mockREPLFactory := &modeselectormocks.MockREPLFactory{}
+	defer mockREPLFactory.AssertExpectations(t)
+
+	mockPipelineFactory := &modeselectormocks.MockPipelineFactory{}
+	defer mockPipelineFactory.AssertExpectations(t)
+
+	mockSelfTestFactory := &modeselectormocks.MockSelfTestFactory{}
+	defer mockSelfTestFactory.AssertExpectations(t)
+
+	mockKernelFactory := &modeselectormocks.MockKernelFactory{}
+	defer mockKernelFactory.AssertExpectations(t)
+
+	mockVersionFactory := &modeselectormocks.MockVersionFactory{}
+	defer mockVersionFactory.AssertExpectations(t)
+
+	mockCleanup := &entitiesmocks.MockMode{}
+	defer mockCleanup.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		VersionMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		StatusMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		TelemetryPreviewMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ReplayMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		REPLMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		PipelineMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		SelfTestMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		KernelMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DoctorMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		InstallMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		UninstallMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		CompletionMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		LogsMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		CleanupMode().
+		Return(true).
+		Once()
+
+	mockCleanupFactory.EXPECT().
+		Create().
+		Return(mockCleanup, nil).
+		Once()
+
+	mockCleanup.EXPECT().
+		StartAndWaitForCompletion(ctx).
+		Return(nil).
+		Once()
+
+	modeSelectorInstance := modeselector.New(
+		mockConfig,
+		mockWatchdogProcessFactory,
+		mockOrchestratorFactory,
+		mockStatusFactory,
+		mockTelemetryPreviewFactory,
+		mockReplayFactory,
+		mockAttachFactory,
+		mockDoctorFactory,
+		mockInstallFactory,
+		mockCompletionFactory,
+		mockLogsFactory,
+		mockCleanupFactory,
+		mockStopFactory,
+		mockServiceFactory,
+		mockREPLFactory,
+		mockPipelineFactory,
+		mockSelfTestFactory,
+		mockKernelFactory,
+		mockVersionFactory,
+	)
+
+	// Act
+	err := modeSelectorInstance.StartAndWaitForCompletion(ctx)
+
+	// Assert
+	require.NoError(t, err, "StartAndWaitForCompletion should not return an error in cleanup mode")
+}
+
+func TestStartAndWaitForCompletion_StopMode_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &modeselectormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockWatchdogProcessFactory := &modeselectormocks.MockWatchdogProcessFactory{}
+	defer mockWatchdogProcessFactory.AssertExpectations(t)
+
+	mockOrchestratorFactory := &modeselectormocks.MockOrchestratorFactory{}
+	defer mockOrchestratorFactory.AssertExpectations(t)
+
+	mockStatusFactory := &modeselectormocks.MockStatusFactory{}
+	defer mockStatusFactory.AssertExpectations(t)
+
+	mockTelemetryPreviewFactory := &modeselectormocks.MockTelemetryPreviewFactory{}
+	defer mockTelemetryPreviewFactory.AssertExpectations(t)
+
+	mockReplayFactory := &modeselectormocks.MockReplayFactory{}
+	defer mockReplayFactory.AssertExpectations(t)
+
+	mockAttachFactory := &modeselectormocks.MockAttachFactory{}
+	defer mockAttachFactory.AssertExpectations(t)
+
+	mockDoctorFactory := &modeselectormocks.MockDoctorFactory{}
+	defer mockDoctorFactory.AssertExpectations(t)
+
+	mockInstallFactory := &modeselectormocks.MockInstallFactory{}
+	defer mockInstallFactory.AssertExpectations(t)
+
+	mockCompletionFactory := &modeselectormocks.MockCompletionFactory{}
+	defer mockCompletionFactory.AssertExpectations(t)
+
+	mockLogsFactory := &modeselectormocks.MockLogsFactory{}
+	defer mockLogsFactory.AssertExpectations(t)
+
+	mockCleanupFactory := &modeselectormocks.MockCleanupFactory{}
+	defer mockCleanupFactory.AssertExpectations(t)
+
+	mockStopFactory := &modeselectormocks.MockStopFactory{}
+	defer mockStopFactory.AssertExpectations(t)
+
+	mockServiceFactory := &modeselectormocks.MockServiceFactory{}
+	defer mockServiceFactory.AssertExpectations(t)
+
+	mockREPLFactory := &modeselectormocks.MockREPLFactory{}
+	defer mockREPLFactory.AssertExpectations(t)
+
+	mockPipelineFactory := &modeselectormocks.MockPipelineFactory{}
+	defer mockPipelineFactory.AssertExpectations(t)
+
+	mockSelfTestFactory := &modeselectormocks.MockSelfTestFactory{}
+	defer mockSelfTestFactory.AssertExpectations(t)
+
+	mockKernelFactory := &modeselectormocks.MockKernelFactory{}
+	defer mockKernelFactory.AssertExpectations(t)
+
+	mockVersionFactory := &modeselectormocks.MockVersionFactory{}
+	defer mockVersionFactory.AssertExpectations(t)
+
+	mockStop := &entitiesmocks.MockMode{}
+	defer mockStop.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		VersionMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		StatusMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		TelemetryPreviewMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ReplayMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		REPLMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		PipelineMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		SelfTestMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		KernelMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DoctorMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		InstallMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		UninstallMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		CompletionMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		LogsMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		CleanupMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		StopMode().
+		Return(true).
+		Once()
+
+	mockStopFactory.EXPECT().
+		Create().
+		Return(mockStop, nil).
+		Once()
+
+	mockStop.EXPECT().
+		StartAndWaitForCompletion(ctx).
+		Return(nil).
+		Once()
+
+	modeSelectorInstance := modeselector.New(
+		mockConfig,
+		mockWatchdogProcessFactory,
+		mockOrchestratorFactory,
+		mockStatusFactory,
+		mockTelemetryPreviewFactory,
+		mockReplayFactory,
+		mockAttachFactory,
+		mockDoctorFactory,
+		mockInstallFactory,
+		mockCompletionFactory,
+		mockLogsFactory,
+		mockCleanupFactory,
+		mockStopFactory,
+		mockServiceFactory,
+		mockREPLFactory,
+		mockPipelineFactory,
+		mockSelfTestFactory,
+		mockKernelFactory,
+		mockVersionFactory,
+	)
+
+	// Act
+	err := modeSelectorInstance.StartAndWaitForCompletion(ctx)
+
+	// Assert
+	require.NoError(t, err, "StartAndWaitForCompletion should not return an error in stop mode")
+}
+
+func TestStartAndWaitForCompletion_ServiceMode_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &modeselectormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockWatchdogProcessFactory := &modeselectormocks.MockWatchdogProcessFactory{}
+	defer mockWatchdogProcessFactory.AssertExpectations(t)
+
+	mockOrchestratorFactory := &modeselectormocks.MockOrchestratorFactory{}
+	defer mockOrchestratorFactory.AssertExpectations(t)
+
+	mockStatusFactory := &modeselectormocks.MockStatusFactory{}
+	defer mockStatusFactory.AssertExpectations(t)
+
+	mockTelemetryPreviewFactory := &modeselectormocks.MockTelemetryPreviewFactory{}
+	defer mockTelemetryPreviewFactory.AssertExpectations(t)
+
+	mockReplayFactory := &modeselectormocks.MockReplayFactory{}
+	defer mockReplayFactory.AssertExpectations(t)
+
+	mockAttachFactory := &modeselectormocks.MockAttachFactory{}
+	defer mockAttachFactory.AssertExpectations(t)
+
+	mockDoctorFactory := &modeselectormocks.MockDoctorFactory{}
+	defer mockDoctorFactory.AssertExpectations(t)
+
+	mockInstallFactory := &modeselectormocks.MockInstallFactory{}
+	defer mockInstallFactory.AssertExpectations(t)
+
+	mockCompletionFactory := &modeselectormocks.MockCompletionFactory{}
+	defer mockCompletionFactory.AssertExpectations(t)
+
+	mockLogsFactory := &modeselectormocks.MockLogsFactory{}
+	defer mockLogsFactory.AssertExpectations(t)
+
+	mockCleanupFactory := &modeselectormocks.MockCleanupFactory{}
+	defer mockCleanupFactory.AssertExpectations(t)
+
+	mockStopFactory := &modeselectormocks.MockStopFactory{}
+	defer mockStopFactory.AssertExpectations(t)
+
+	mockServiceFactory := &modeselectormocks.MockServiceFactory{}
+	defer mockServiceFactory.AssertExpectations(t)
+
+	mockREPLFactory := &modeselectormocks.MockREPLFactory{}
+	defer mockREPLFactory.AssertExpectations(t)
+
+	mockPipelineFactory := &modeselectormocks.MockPipelineFactory{}
+	defer mockPipelineFactory.AssertExpectations(t)
+
+	mockSelfTestFactory := &modeselectormocks.MockSelfTestFactory{}
+	defer mockSelfTestFactory.AssertExpectations(t)
+
+	mockKernelFactory := &modeselectormocks.MockKernelFactory{}
+	defer mockKernelFactory.AssertExpectations(t)
+
+	mockVersionFactory := &modeselectormocks.MockVersionFactory{}
+	defer mockVersionFactory.AssertExpectations(t)
+
+	mockService := &entitiesmocks.MockMode{}
+	defer mockService.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		VersionMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		StatusMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		TelemetryPreviewMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ReplayMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		REPLMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		PipelineMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		SelfTestMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		KernelMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DoctorMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		InstallMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		UninstallMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		CompletionMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		LogsMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		CleanupMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		StopMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ServiceMode().
+		Return(true).
+		Once()
+
+	mockServiceFactory.EXPECT().
+		Create().
+		Return(mockService, nil).
+		Once()
+
+	mockService.EXPECT().
+		StartAndWaitForCompletion(ctx).
+		Return(nil).
+		Once()
+
+	modeSelectorInstance := modeselector.New(
+		mockConfig,
+		mockWatchdogProcessFactory,
+		mockOrchestratorFactory,
+		mockStatusFactory,
+		mockTelemetryPreviewFactory,
+		mockReplayFactory,
+		mockAttachFactory,
+		mockDoctorFactory,
+		mockInstallFactory,
+		mockCompletionFactory,
+		mockLogsFactory,
+		mockCleanupFactory,
+		mockStopFactory,
+		mockServiceFactory,
+		mockREPLFactory,
+		mockPipelineFactory,
+		mockSelfTestFactory,
+		mockKernelFactory,
+		mockVersionFactory,
+	)
+
+	// Act
+	err := modeSelectorInstance.StartAndWaitForCompletion(ctx)
+
+	// Assert
+	require.NoError(t, err, "StartAndWaitForCompletion should not return an error in service mode")
+}
+
+func TestStartAndWaitForCompletion_WatchdogMode_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &modeselectormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockWatchdogProcessFactory := &modeselectormocks.MockWatchdogProcessFactory{}
+	defer mockWatchdogProcessFactory.AssertExpectations(t)
+
+	mockOrchestratorFactory := &modeselectormocks.MockOrchestratorFactory{}
+	defer mockOrchestratorFactory.AssertExpectations(t)
+
+	mockStatusFactory := &modeselectormocks.MockStatusFactory{}
+	defer mockStatusFactory.AssertExpectations(t)
+
+	mockTelemetryPreviewFactory := &modeselectormocks.MockTelemetryPreviewFactory{}
+	defer mockTelemetryPreviewFactory.AssertExpectations(t)
+
+	mockReplayFactory := &modeselectormocks.MockReplayFactory{}
+	defer mockReplayFactory.AssertExpectations(t)
+
+	mockAttachFactory := &modeselectormocks.MockAttachFactory{}
+	defer mockAttachFactory.AssertExpectations(t)
+
+	mockDoctorFactory := &modeselectormocks.MockDoctorFactory{}
+	defer mockDoctorFactory.AssertExpectations(t)
+
+	mockInstallFactory := &modeselectormocks.MockInstallFactory{}
+	defer mockInstallFactory.AssertExpectations(t)
+
+	mockCompletionFactory := &modeselectormocks.MockCompletionFactory{}
+	defer mockCompletionFactory.AssertExpectations(t)
+
+	mockLogsFactory := &modeselectormocks.MockLogsFactory{}
+	defer mockLogsFactory.AssertExpectations(t)
+
+	mockCleanupFactory := &modeselectormocks.MockCleanupFactory{}
+	defer mockCleanupFactory.AssertExpectations(t)
+
+	mockStopFactory := &modeselectormocks.MockStopFactory{}
+	defer mockStopFactory.AssertExpectations(t)
+
+	mockServiceFactory := &modeselectormocks.MockServiceFactory{}
+	defer mockServiceFactory.AssertExpectations(t)
+
+	mockREPLFactory := &modeselectormocks.MockREPLFactory{}
+	defer mockREPLFactory.AssertExpectations(t)
+
+	mockPipelineFactory := &modeselectormocks.MockPipelineFactory{}
+	defer mockPipelineFactory.AssertExpectations(t)
+
+	mockSelfTestFactory := &modeselectormocks.MockSelfTestFactory{}
+	defer mockSelfTestFactory.AssertExpectations(t)
+
+	mockKernelFactory := &modeselectormocks.MockKernelFactory{}
+	defer mockKernelFactory.AssertExpectations(t)
+
+	mockVersionFactory := &modeselectormocks.MockVersionFactory{}
+	defer mockVersionFactory.AssertExpectations(t)
+
+	mockWatchdogProcess := &entitiesmocks.MockMode{}
+	defer mockWatchdogProcess.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		VersionMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		StatusMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		TelemetryPreviewMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ReplayMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		REPLMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		PipelineMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		SelfTestMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		KernelMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DoctorMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		InstallMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		UninstallMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		CompletionMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		LogsMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		CleanupMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		StopMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ServiceMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		AttachMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		WatchdogMode().
+		Return(true).
+		Once()
+
+	mockWatchdogProcessFactory.EXPECT().
+		Create().
+		Return(mockWatchdogProcess, nil).
+		Once()
+
+	mockWatchdogProcess.EXPECT().
+		StartAndWaitForCompletion(ctx).
+		Return(nil).
+		Once()
+
+	modeSelectorInstance := modeselector.New(
+		mockConfig,
+		mockWatchdogProcessFactory,
+		mockOrchestratorFactory,
+		mockStatusFactory,
+		mockTelemetryPreviewFactory,
+		mockReplayFactory,
+		mockAttachFactory,
+		mockDoctorFactory,
+		mockInstallFactory,
+		mockCompletionFactory,
+		mockLogsFactory,
+		mockCleanupFactory,
+		mockStopFactory,
+		mockServiceFactory,
+		mockREPLFactory,
+		mockPipelineFactory,
+		mockSelfTestFactory,
+		mockKernelFactory,
+		mockVersionFactory,
+	)
+
+	// Act
+	err := modeSelectorInstance.StartAndWaitForCompletion(ctx)
+
+	// Assert
+	require.NoError(t, err, "StartAndWaitForCompletion should not return an error in watchdog mode")
+}
+
+func TestStartAndWaitForCompletion_WatchdogMode_CreateError(t *testing.T) {
+	// Arrange
+	mockConfig := &modeselectormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockWatchdogProcessFactory := &modeselectormocks.MockWatchdogProcessFactory{}
+	defer mockWatchdogProcessFactory.AssertExpectations(t)
+
+	mockOrchestratorFactory := &modeselectormocks.MockOrchestratorFactory{}
+	defer mockOrchestratorFactory.AssertExpectations(t)
+
+	mockStatusFactory := &modeselectormocks.MockStatusFactory{}
+	defer mockStatusFactory.AssertExpectations(t)
+
+	mockTelemetryPreviewFactory := &modeselectormocks.MockTelemetryPreviewFactory{}
+	defer mockTelemetryPreviewFactory.AssertExpectations(t)
+
+	mockReplayFactory := &modeselectormocks.MockReplayFactory{}
+	defer mockReplayFactory.AssertExpectations(t)
+
+	mockAttachFactory := &modeselectormocks.MockAttachFactory{}
+	defer mockAttachFactory.AssertExpectations(t)
+
+	mockDoctorFactory := &modeselectormocks.MockDoctorFactory{}
+	defer mockDoctorFactory.AssertExpectations(t)
+
+	mockInstallFactory := &modeselectormocks.MockInstallFactory{}
+	defer mockInstallFactory.AssertExpectations(t)
+
+	mockCompletionFactory := &modeselectormocks.MockCompletionFactory{}
+	defer mockCompletionFactory.AssertExpectations(t)
+
+	mockLogsFactory := &modeselectormocks.MockLogsFactory{}
+	defer mockLogsFactory.AssertExpectations(t)
+
+	mockCleanupFactory := &modeselectormocks.MockCleanupFactory{}
+	defer mockCleanupFactory.AssertExpectations(t)
+
+	mockStopFactory := &modeselectormocks.MockStopFactory{}
+	defer mockStopFactory.AssertExpectations(t)
+
+	mockServiceFactory := &modeselectormocks.MockServiceFactory{}
+	defer mockServiceFactory.AssertExpectations(t)
+
+	mockREPLFactory := &modeselectormocks.MockREPLFactory{}
+	defer mockREPLFactory.AssertExpectations(t)
+
+	mockPipelineFactory := &modeselectormocks.MockPipelineFactory{}
+	defer mockPipelineFactory.AssertExpectations(t)
+
+	mockSelfTestFactory := &modeselectormocks.MockSelfTestFactory{}
+	defer mockSelfTestFactory.AssertExpectations(t)
+
+	mockKernelFactory := &modeselectormocks.MockKernelFactory{}
+	defer mockKernelFactory.AssertExpectations(t)
+
+	mockVersionFactory := &modeselectormocks.MockVersionFactory{}
+	defer mockVersionFactory.AssertExpectations(t)
+
+	expectedError := assert.AnError
+
+	mockConfig.EXPECT().
+		VersionMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		StatusMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		TelemetryPreviewMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ReplayMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		REPLMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		PipelineMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		SelfTestMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		KernelMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DoctorMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		InstallMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		UninstallMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		CompletionMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		LogsMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		CleanupMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		StopMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ServiceMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		AttachMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		WatchdogMode().
+		Return(true).
+		Once()
+
+	mockWatchdogProcessFactory.EXPECT().
+		Create().
+		Return(nil, expectedError).
+		Once()
+
+	modeSelectorInstance := modeselector.New(
+		mockConfig,
+		mockWatchdogProcessFactory,
+		mockOrchestratorFactory,
+		mockStatusFactory,
+		mockTelemetryPreviewFactory,
+		mockReplayFactory,
+		mockAttachFactory,
+		mockDoctorFactory,
+		mockInstallFactory,
+		mockCompletionFactory,
+		mockLogsFactory,
+		mockCleanupFactory,
+		mockStopFactory,
+		mockServiceFactory,
+		mockREPLFactory,
+		mockPipelineFactory,
+		mockSelfTestFactory,
+		mockKernelFactory,
+		mockVersionFactory,
+	)
+
+	// Act
+	err := modeSelectorInstance.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "StartAndWaitForCompletion should return the error from Create")
+}
+
+func TestStartAndWaitForCompletion_WatchdogMode_StartAndWaitError(t *testing.T) {
+	// Arrange
+	mockConfig := &modeselectormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockWatchdogProcessFactory := &modeselectormocks.MockWatchdogProcessFactory{}
+	defer mockWatchdogProcessFactory.AssertExpectations(t)
+
+	mockOrchestratorFactory := &modeselectormocks.MockOrchestratorFactory{}
+	defer mockOrchestratorFactory.AssertExpectations(t)
+
+	mockStatusFactory := &modeselectormocks.MockStatusFactory{}
+	defer mockStatusFactory.AssertExpectations(t)
+
+	mockTelemetryPreviewFactory := &modeselectormocks.MockTelemetryPreviewFactory{}
+	defer mockTelemetryPreviewFactory.AssertExpectations(t)
+
+	mockReplayFactory := &modeselectormocks.MockReplayFactory{}
+	defer mockReplayFactory.AssertExpectations(t)
+
+	mockAttachFactory := &modeselectormocks.MockAttachFactory{}
+	defer mockAttachFactory.AssertExpectations(t)
+
+	mockDoctorFactory := &modeselectormocks.MockDoctorFactory{}
+	defer mockDoctorFactory.AssertExpectations(t)
+
+	mockInstallFactory := &modeselectormocks.MockInstallFactory{}
+	defer mockInstallFactory.AssertExpectations(t)
+
+	mockCompletionFactory := &modeselectormocks.MockCompletionFactory{}
+	defer mockCompletionFactory.AssertExpectations(t)
+
+	mockLogsFactory := &modeselectormocks.MockLogsFactory{}
+	defer mockLogsFactory.AssertExpectations(t)
+
+	mockCleanupFactory := &modeselectormocks.MockCleanupFactory{}
+	defer mockCleanupFactory.AssertExpectations(t)
+
+	mockStopFactory := &modeselectormocks.MockStopFactory{}
+	defer mockStopFactory.AssertExpectations(t)
+
+	mockServiceFactory := &modeselectormocks.MockServiceFactory{}
+	defer mockServiceFactory.AssertExpectations(t)
+
+	mockREPLFactory := &modeselectormocks.MockREPLFactory{}
+	defer mockREPLFactory.AssertExpectations(t)
+
+	mockPipelineFactory := &modeselectormocks.MockPipelineFactory{}
+	defer mockPipelineFactory.AssertExpectations(t)
+
+	mockSelfTestFactory := &modeselectormocks.MockSelfTestFactory{}
+	defer mockSelfTestFactory.AssertExpectations(t)
+
+	mockKernelFactory := &modeselectormocks.MockKernelFactory{}
+	defer mockKernelFactory.AssertExpectations(t)
+
+	mockVersionFactory := &modeselectormocks.MockVersionFactory{}
+	defer mockVersionFactory.AssertExpectations(t)
+
+	mockWatchdogProcess := &entitiesmocks.MockMode{}
+	defer mockWatchdogProcess.AssertExpectations(t)
+
+	expectedError := assert.AnError
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		VersionMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		StatusMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		TelemetryPreviewMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ReplayMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		REPLMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		PipelineMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		SelfTestMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		KernelMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DoctorMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		InstallMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		UninstallMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		CompletionMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		LogsMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		CleanupMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		StopMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ServiceMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		AttachMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		WatchdogMode().
+		Return(true).
+		Once()
+
+	mockWatchdogProcessFactory.EXPECT().
+		Create().
+		Return(mockWatchdogProcess, nil).
+		Once()
+
+	mockWatchdogProcess.EXPECT().
+		StartAndWaitForCompletion(ctx).
+		Return(expectedError).
+		Once()
+
+	modeSelectorInstance := modeselector.New(
+		mockConfig,
+		mockWatchdogProcessFactory,
+		mockOrchestratorFactory,
+		mockStatusFactory,
+		mockTelemetryPreviewFactory,
+		mockReplayFactory,
+		mockAttachFactory,
+		mockDoctorFactory,
+		mockInstallFactory,
+		mockCompletionFactory,
+		mockLogsFactory,
+		mockCleanupFactory,
+		mockStopFactory,
+		mockServiceFactory,
+		mockREPLFactory,
+		mockPipelineFactory,
+		mockSelfTestFactory,
+		mockKernelFactory,
+		mockVersionFactory,
+	)
+
+	// Act
+	err := modeSelectorInstance.StartAndWaitForCompletion(ctx)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "StartAndWaitForCompletion should return the error from StartAndWaitForCompletion")
+}
+
+func TestStartAndWaitForCompletion_DefaultMode_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &modeselectormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockWatchdogProcessFactory := &modeselectormocks.MockWatchdogProcessFactory{}
+	defer mockWatchdogProcessFactory.AssertExpectations(t)
+
+	mockOrchestratorFactory := &modeselectormocks.MockOrchestratorFactory{}
+	defer mockOrchestratorFactory.AssertExpectations(t)
+
+	mockStatusFactory := &modeselectormocks.MockStatusFactory{}
+	defer mockStatusFactory.AssertExpectations(t)
+
+	mockTelemetryPreviewFactory := &modeselectormocks.MockTelemetryPreviewFactory{}
+	defer mockTelemetryPreviewFactory.AssertExpectations(t)
+
+	mockReplayFactory := &modeselectormocks.MockReplayFactory{}
+	defer mockReplayFactory.AssertExpectations(t)
+
+	mockAttachFactory := &modeselectormocks.MockAttachFactory{}
+	defer mockAttachFactory.AssertExpectations(t)
+
+	mockDoctorFactory := &modeselectormocks.MockDoctorFactory{}
+	defer mockDoctorFactory.AssertExpectations(t)
+
+	mockInstallFactory := &modeselectormocks.MockInstallFactory{}
+	defer mockInstallFactory.AssertExpectations(t)
+
+	mockCompletionFactory := &modeselectormocks.MockCompletionFactory{}
+	defer mockCompletionFactory.AssertExpectations(t)
+
+	mockLogsFactory := &modeselectormocks.MockLogsFactory{}
+	defer mockLogsFactory.AssertExpectations(t)
+
+	mockCleanupFactory := &modeselectormocks.MockCleanupFactory{}
+	defer mockCleanupFactory.AssertExpectations(t)
+
+	mockStopFactory := &modeselectormocks.MockStopFactory{}
+	defer mockStopFactory.AssertExpectations(t)
+
+	mockServiceFactory := &modeselectormocks.MockServiceFactory{}
+	defer mockServiceFactory.AssertExpectations(t)
+
+	mockREPLFactory := &modeselectormocks.MockREPLFactory{}
+	defer mockREPLFactory.AssertExpectations(t)
+
+	mockPipelineFactory := &modeselectormocks.MockPipelineFactory{}
+	defer mockPipelineFactory.AssertExpectations(t)
+
+	mockSelfTestFactory := &modeselectormocks.MockSelfTestFactory{}
+	defer mockSelfTestFactory.AssertExpectations(t)
+
+	mockKernelFactory := &modeselectormocks.MockKernelFactory{}
+	defer mockKernelFactory.AssertExpectations(t)
+
+	mockVersionFactory := &modeselectormocks.MockVersionFactory{}
+	defer mockVersionFactory.AssertExpectations(t)
+
+	mockOrchestrator := &entitiesmocks.MockMode{}
+	defer mockOrchestrator.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		VersionMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		StatusMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		TelemetryPreviewMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ReplayMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		REPLMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		PipelineMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		SelfTestMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		KernelMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DoctorMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		InstallMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		UninstallMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		CompletionMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		LogsMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		CleanupMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		StopMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ServiceMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		AttachMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		WatchdogMode().
+		Return(false).
+		Once()
+
+	mockOrchestratorFactory.EXPECT().
+		Create().
+		Return(mockOrchestrator, nil).
+		Once()
+
+	mockOrchestrator.EXPECT().
+		StartAndWaitForCompletion(ctx).
+		Return(nil).
+		Once()
+
+	modeSelectorInstance := modeselector.New(
+		mockConfig,
+		mockWatchdogProcessFactory,
+		mockOrchestratorFactory,
+		mockStatusFactory,
+		mockTelemetryPreviewFactory,
+		mockReplayFactory,
+		mockAttachFactory,
+		mockDoctorFactory,
+		mockInstallFactory,
+		mockCompletionFactory,
+		mockLogsFactory,
+		mockCleanupFactory,
+		mockStopFactory,
+		mockServiceFactory,
+		mockREPLFactory,
+		mockPipelineFactory,
+		mockSelfTestFactory,
+		mockKernelFactory,
+		mockVersionFactory,
+	)
+
+	// Act
+	err := modeSelectorInstance.StartAndWaitForCompletion(ctx)
+
+	// Assert
+	require.NoError(t, err, "StartAndWaitForCompletion should not return an error in default mode")
+}
+
+func TestStartAndWaitForCompletion_DefaultMode_CreateError(t *testing.T) {
+	// Arrange
+	mockConfig := &modeselectormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockWatchdogProcessFactory := &modeselectormocks.MockWatchdogProcessFactory{}
+	defer mockWatchdogProcessFactory.AssertExpectations(t)
+
+	mockOrchestratorFactory := &modeselectormocks.MockOrchestratorFactory{}
+	defer mockOrchestratorFactory.AssertExpectations(t)
+
+	mockStatusFactory := &modeselectormocks.MockStatusFactory{}
+	defer mockStatusFactory.AssertExpectations(t)
+
+	mockTelemetryPreviewFactory := &modeselectormocks.MockTelemetryPreviewFactory{}
+	defer mockTelemetryPreviewFactory.AssertExpectations(t)
+
+	mockReplayFactory := &modeselectormocks.MockReplayFactory{}
+	defer mockReplayFactory.AssertExpectations(t)
+
+	mockAttachFactory := &modeselectormocks.MockAttachFactory{}
+	defer mockAttachFactory.AssertExpectations(t)
+
+	mockDoctorFactory := &modeselectormocks.MockDoctorFactory{}
+	defer mockDoctorFactory.AssertExpectations(t)
+
+	mockInstallFactory := &modeselectormocks.MockInstallFactory{}
+	defer mockInstallFactory.AssertExpectations(t)
+
+	mockCompletionFactory := &modeselectormocks.MockCompletionFactory{}
+	defer mockCompletionFactory.AssertExpectations(t)
+
+	mockLogsFactory := &modeselectormocks.MockLogsFactory{}
+	defer mockLogsFactory.AssertExpectations(t)
+
+	mockCleanupFactory := &modeselectormocks.MockCleanupFactory{}
+	defer mockCleanupFactory.AssertExpectations(t)
+
+	mockStopFactory := &modeselectormocks.MockStopFactory{}
+	defer mockStopFactory.AssertExpectations(t)
+
+	mockServiceFactory := &modeselectormocks.MockServiceFactory{}
+	defer mockServiceFactory.AssertExpectations(t)
+
+	mockREPLFactory := &modeselectormocks.MockREPLFactory{}
+	defer mockREPLFactory.AssertExpectations(t)
+
+	mockPipelineFactory := &modeselectormocks.MockPipelineFactory{}
+	defer mockPipelineFactory.AssertExpectations(t)
+
+	mockSelfTestFactory := &modeselectormocks.MockSelfTestFactory{}
+	defer mockSelfTestFactory.AssertExpectations(t)
+
+	mockKernelFactory := &modeselectormocks.MockKernelFactory{}
+	defer mockKernelFactory.AssertExpectations(t)
+
+	mockVersionFactory := &modeselectormocks.MockVersionFactory{}
+	defer mockVersionFactory.AssertExpectations(t)
+
+	expectedError := assert.AnError
+
+	mockConfig.EXPECT().
+		VersionMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		StatusMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		TelemetryPreviewMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ReplayMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		REPLMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		PipelineMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		SelfTestMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		KernelMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DoctorMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		InstallMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		UninstallMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		CompletionMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		LogsMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		CleanupMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		StopMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ServiceMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		AttachMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		WatchdogMode().
+		Return(false).
+		Once()
+
+	mockOrchestratorFactory.EXPECT().
+		Create().
+		Return(nil, expectedError).
+		Once()
+
+	modeSelectorInstance := modeselector.New(
+		mockConfig,
+		mockWatchdogProcessFactory,
+		mockOrchestratorFactory,
+		mockStatusFactory,
+		mockTelemetryPreviewFactory,
+		mockReplayFactory,
+		mockAttachFactory,
+		mockDoctorFactory,
+		mockInstallFactory,
+		mockCompletionFactory,
+		mockLogsFactory,
+		mockCleanupFactory,
+		mockStopFactory,
+		mockServiceFactory,
+		mockREPLFactory,
+		mockPipelineFactory,
+		mockSelfTestFactory,
+		mockKernelFactory,
+		mockVersionFactory,
+	)
+
+	// Act
+	err := modeSelectorInstance.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "StartAndWaitForCompletion should return the error from Create")
+}
+
+func TestStartAndWaitForCompletion_DefaultMode_StartAndWaitError(t *testing.T) {
+	// Arrange
+	mockConfig := &modeselectormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockWatchdogProcessFactory := &modeselectormocks.MockWatchdogProcessFactory{}
+	defer mockWatchdogProcessFactory.AssertExpectations(t)
+
+	mockOrchestratorFactory := &modeselectormocks.MockOrchestratorFactory{}
+	defer mockOrchestratorFactory.AssertExpectations(t)
+
+	mockStatusFactory := &modeselectormocks.MockStatusFactory{}
+	defer mockStatusFactory.AssertExpectations(t)
+
+	mockTelemetryPreviewFactory := &modeselectormocks.MockTelemetryPreviewFactory{}
+	defer mockTelemetryPreviewFactory.AssertExpectations(t)
+
+	mockReplayFactory := &modeselectormocks.MockReplayFactory{}
+	defer mockReplayFactory.AssertExpectations(t)
+
+	mockAttachFactory := &modeselectormocks.MockAttachFactory{}
+	defer mockAttachFactory.AssertExpectations(t)
+
+	mockDoctorFactory := &modeselectormocks.MockDoctorFactory{}
+	defer mockDoctorFactory.AssertExpectations(t)
+
+	mockInstallFactory := &modeselectormocks.MockInstallFactory{}
+	defer mockInstallFactory.AssertExpectations(t)
+
+	mockCompletionFactory := &modeselectormocks.MockCompletionFactory{}
+	defer mockCompletionFactory.AssertExpectations(t)
+
+	mockLogsFactory := &modeselectormocks.MockLogsFactory{}
+	defer mockLogsFactory.AssertExpectations(t)
+
+	mockCleanupFactory := &modeselectormocks.MockCleanupFactory{}
+	defer mockCleanupFactory.AssertExpectations(t)
+
+	mockStopFactory := &modeselectormocks.MockStopFactory{}
+	defer mockStopFactory.AssertExpectations(t)
+
+	mockServiceFactory := &modeselectormocks.MockServiceFactory{}
+	defer mockServiceFactory.AssertExpectations(t)
+
+	mockREPLFactory := &modeselectormocks.MockREPLFactory{}
+	defer mockREPLFactory.AssertExpectations(t)
+
+	mockPipelineFactory := &modeselectormocks.MockPipelineFactory{}
+	defer mockPipelineFactory.AssertExpectations(t)
+
+	mockSelfTestFactory := &modeselectormocks.MockSelfTestFactory{}
+	defer mockSelfTestFactory.AssertExpectations(t)
+
+	mockKernelFactory := &modeselectormocks.MockKernelFactory{}
+	defer mockKernelFactory.AssertExpectations(t)
+
+	mockVersionFactory := &modeselectormocks.MockVersionFactory{}
+	defer mockVersionFactory.AssertExpectations(t)
+
+	mockOrchestrator := &entitiesmocks.MockMode{}
+	defer mockOrchestrator.AssertExpectations(t)
+
+	expectedError := assert.AnError
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		VersionMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		StatusMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		TelemetryPreviewMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ReplayMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		REPLMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		PipelineMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		SelfTestMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		KernelMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DoctorMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		InstallMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		UninstallMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		CompletionMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		LogsMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		CleanupMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		StopMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ServiceMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		AttachMode().
+		Return(false).
+		Once()
+
 	mockConfig.EXPECT().
 		WatchdogMode().
 		Return(false).
@@ -440,7 +3938,22 @@ func TestStartAndWaitForCompletion_DefaultMode_StartAndWaitError(t *testing.T) {
 		mockConfig,
 		mockWatchdogProcessFactory,
 		mockOrchestratorFactory,
-		mockOsLayer,
+		mockStatusFactory,
+		mockTelemetryPreviewFactory,
+		mockReplayFactory,
+		mockAttachFactory,
+		mockDoctorFactory,
+		mockInstallFactory,
+		mockCompletionFactory,
+		mockLogsFactory,
+		mockCleanupFactory,
+		mockStopFactory,
+		mockServiceFactory,
+		mockREPLFactory,
+		mockPipelineFactory,
+		mockSelfTestFactory,
+		mockKernelFactory,
+		mockVersionFactory,
 	)
 
 	// Act
@@ -4,8 +4,11 @@ package orchestrator
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"os"
 
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/logger"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 )
 
@@ -16,11 +19,16 @@ type LifecycleSignaler interface {
 
 type Config interface {
 	UseSingleMATLABSession() bool
+	DaemonMode() bool
+	ServeTransport() entities.Transport
+	BuildInfo() entities.BuildInfo
+	LogFile() string
 	RecordToLogger(logger entities.Logger)
 }
 
 type Server interface {
 	Run() error
+	Drain() error
 }
 
 type WatchdogClient interface {
@@ -28,6 +36,14 @@ type WatchdogClient interface {
 	Stop() error
 }
 
+type DebugServer interface {
+	Start() error
+}
+
+type MetricsServer interface {
+	Start() error
+}
+
 type LoggerFactory interface {
 	GetGlobalLogger() entities.Logger
 }
@@ -44,15 +60,45 @@ type Directory interface {
 	BaseDir() string
 }
 
+type InstanceLock interface {
+	TryLockWithKill(killExisting bool) (bool, error)
+	TakenOverPID() (int, bool)
+	Unlock() error
+}
+
+type EventRecorder interface {
+	Record(kind entities.EventKind, message string, details map[string]any)
+}
+
+type MemoryWatchdog interface {
+	Start(ctx context.Context)
+}
+
+type MATLABSupervisor interface {
+	Start(ctx context.Context)
+}
+
+type ConfigReloader interface {
+	Start()
+}
+
 // Orchestrator
 type Orchestrator struct {
 	lifecycleSignaler LifecycleSignaler
 	config            Config
 	server            Server
 	watchdogClient    WatchdogClient
+	debugServer       DebugServer
+	metricsServer     MetricsServer
 	logger            entities.Logger
 	osSignaler        OSSignaler
 	globalMATLAB      GlobalMATLAB
+	instanceLock      InstanceLock
+	eventRecorder     EventRecorder
+	memoryWatchdog    MemoryWatchdog
+	matlabSupervisor  MATLABSupervisor
+	configReloader    ConfigReloader
+	logDir            string
 }
 
 func New(
@@ -60,67 +106,168 @@ func New(
 	config Config,
 	server Server,
 	watchdogClient WatchdogClient,
+	debugServer DebugServer,
+	metricsServer MetricsServer,
 	loggerFactory LoggerFactory,
 	osSignaler OSSignaler,
 	globalMATLAB GlobalMATLAB,
 	directory Directory,
+	instanceLock InstanceLock,
+	eventRecorder EventRecorder,
+	memoryWatchdog MemoryWatchdog,
+	matlabSupervisor MATLABSupervisor,
+	configReloader ConfigReloader,
 ) *Orchestrator {
+	logDir := directory.BaseDir()
+
 	orchestrator := &Orchestrator{
 		lifecycleSignaler: lifecycleSignaler,
 		config:            config,
 		server:            server,
 		watchdogClient:    watchdogClient,
-		logger:            loggerFactory.GetGlobalLogger().With("log-dir", directory.BaseDir()),
+		debugServer:       debugServer,
+		metricsServer:     metricsServer,
+		logger:            loggerFactory.GetGlobalLogger().With("log-dir", logDir),
 		osSignaler:        osSignaler,
 		globalMATLAB:      globalMATLAB,
+		instanceLock:      instanceLock,
+		eventRecorder:     eventRecorder,
+		memoryWatchdog:    memoryWatchdog,
+		matlabSupervisor:  matlabSupervisor,
+		configReloader:    configReloader,
+		logDir:            logDir,
 	}
 	return orchestrator
 }
 
-func (o *Orchestrator) StartAndWaitForCompletion(ctx context.Context) error {
+// StartAndWaitForCompletion runs the server until it receives SIGINT or SIGTERM, or until its client disconnects.
+// It fails if the server failed, or if it could not stop gracefully, such as when tool calls in flight were cancelled.
+func (o *Orchestrator) StartAndWaitForCompletion(ctx context.Context) (err error) {
+	// Take over from any existing instance, to ensure a fresh start when the client restarts the MCP server.
+	// A daemon does not take over, so that when several clients start it at the same time, the first one keeps running.
+	// Neither does a server listening for clients on the network, which is started by the user rather than by a client.
+	takeOver := !o.config.DaemonMode() && o.config.ServeTransport() == entities.TransportStdio
+	acquired, err := o.instanceLock.TryLockWithKill(takeOver)
+	if err != nil {
+		return err
+	}
+	if !acquired {
+		return entities.NewExitError(entities.ExitCodeAlreadyRunning, fmt.Errorf("MATLAB MCP Core Server is already running, only one instance is allowed"))
+	}
+	defer func() {
+		if err := o.instanceLock.Unlock(); err != nil {
+			o.logger.WithError(err).Warn("Failed to release instance lock")
+		}
+	}()
+
+	// Signals are handled from now on, rather than once the server started, so that a server asked to stop while MATLAB
+	// starts still stops MATLAB and releases the instance lock, instead of being killed with MATLAB left running.
+	// A signal cancels the context of the startup, so that the server does not wait for MATLAB to finish starting.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	interruptC := o.osSignaler.InterruptSignalChan()
+	go func() {
+		select {
+		case signal := <-interruptC:
+			o.logger.With("signal", signal.String()).Info("Received termination signal")
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	if pid, ok := o.instanceLock.TakenOverPID(); ok {
+		o.logger.With("previous-pid", pid).Info("Took over from an existing MATLAB MCP Core Server instance")
+		o.eventRecorder.Record(entities.EventKindInstanceTakeover, "Took over from an existing server instance", map[string]any{
+			"previous-pid": pid,
+		})
+	}
+
 	defer func() {
 		o.logger.Info("Initiating MATLAB MCP Core Server application shutdown")
+		o.eventRecorder.Record(entities.EventKindServerStopping, "Server shutting down", nil)
+
+		// The tool calls in flight are answered before the shutdown functions stop MATLAB.
+		drainErr := o.server.Drain()
+		if drainErr != nil {
+			o.logger.WithError(drainErr).Warn("Failed to drain the tool calls in flight")
+		}
+
 		o.lifecycleSignaler.RequestShutdown()
 
-		err := o.lifecycleSignaler.WaitForShutdownToComplete()
-		if err != nil {
-			o.logger.WithError(err).Warn("MATLAB MCP Core Server application shutdown failed")
+		shutdownErr := o.lifecycleSignaler.WaitForShutdownToComplete()
+		if shutdownErr != nil {
+			o.logger.WithError(shutdownErr).Warn("MATLAB MCP Core Server application shutdown failed")
 		}
 
 		o.logger.Debug("Shutdown functions have all completed, stopping the watchdog")
-		err = o.watchdogClient.Stop()
-		if err != nil {
+		if err := o.watchdogClient.Stop(); err != nil {
 			o.logger.WithError(err).Warn("Watchdog shutdown failed")
 		}
 
+		if err == nil {
+			err = errors.Join(drainErr, shutdownErr)
+		}
+
 		o.logger.Info("MATLAB MCP Core Server application shutdown complete")
 	}()
 
 	o.logger.Info("Initiating MATLAB MCP Core Server application startup")
 	o.config.RecordToLogger(o.logger)
 
-	err := o.watchdogClient.Start()
+	err = o.watchdogClient.Start()
 	if err != nil {
 		return err
 	}
 
+	if err := o.debugServer.Start(); err != nil {
+		o.logger.WithError(err).Warn("Failed to start debug server, continuing without it")
+	}
+
+	if err := o.metricsServer.Start(); err != nil {
+		o.logger.WithError(err).Warn("Failed to start metrics server, continuing without it")
+	}
+
 	serverErrC := make(chan error, 1)
 	go func() {
 		serverErrC <- o.server.Run()
 	}()
 
+	o.configReloader.Start()
+
 	if o.config.UseSingleMATLABSession() {
 		err := o.globalMATLAB.Initialize(ctx, o.logger)
+		if err != nil && ctx.Err() != nil {
+			o.logger.WithError(err).Info("MATLAB startup was interrupted")
+			return nil
+		}
 		if err != nil {
 			o.logger.WithError(err).Warn("MATLAB global initialization failed")
+			o.eventRecorder.Record(entities.EventKindMATLABSessionStartFailed, "MATLAB failed to start", map[string]any{
+				"error": err.Error(),
+			})
+		} else {
+			o.eventRecorder.Record(entities.EventKindMATLABSessionStarted, "MATLAB session started", nil)
+			o.memoryWatchdog.Start(ctx)
+			o.matlabSupervisor.Start(ctx)
 		}
 	}
 
 	o.logger.Info("MATLAB MCP Core Server application startup complete")
+	buildInfo := o.config.BuildInfo()
+	logFile := o.config.LogFile()
+	if logFile == "" {
+		logFile = logger.LogFilePath(o.logDir)
+	}
+	o.eventRecorder.Record(entities.EventKindServerStarted, "Server started", map[string]any{
+		"pid":      os.Getpid(),
+		"version":  buildInfo.Version,
+		"commit":   buildInfo.Commit,
+		"log-file": logFile,
+	})
 
 	select {
-	case <-o.osSignaler.InterruptSignalChan():
-		o.logger.Info("Received termination signal")
+	case <-ctx.Done():
 		return nil
 	case err := <-serverErrC:
 		return err
@@ -3,14 +3,18 @@
 package orchestrator_test
 
 import (
+	"context"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/orchestrator"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
 	orchestratormocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/orchestrator"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -42,6 +46,27 @@ func TestNew_HappyPath(t *testing.T) {
 	mockDirectory := &orchestratormocks.MockDirectory{}
 	defer mockDirectory.AssertExpectations(t)
 
+	mockDebugServer := &orchestratormocks.MockDebugServer{}
+	defer mockDebugServer.AssertExpectations(t)
+
+	mockMetricsServer := &orchestratormocks.MockMetricsServer{}
+	defer mockMetricsServer.AssertExpectations(t)
+
+	mockInstanceLock := &orchestratormocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockEventRecorder := &orchestratormocks.MockEventRecorder{}
+	defer mockEventRecorder.AssertExpectations(t)
+
+	mockMemoryWatchdog := &orchestratormocks.MockMemoryWatchdog{}
+	defer mockMemoryWatchdog.AssertExpectations(t)
+
+	mockMATLABSupervisor := &orchestratormocks.MockMATLABSupervisor{}
+	defer mockMATLABSupervisor.AssertExpectations(t)
+
+	mockConfigReloader := &orchestratormocks.MockConfigReloader{}
+	defer mockConfigReloader.AssertExpectations(t)
+
 	mockLoggerFactory.EXPECT().
 		GetGlobalLogger().
 		Return(mockLogger).
@@ -58,10 +83,17 @@ func TestNew_HappyPath(t *testing.T) {
 		mockConfig,
 		mockServer,
 		mockWatchdogClient,
+		mockDebugServer,
+		mockMetricsServer,
 		mockLoggerFactory,
 		mockSignalLayer,
 		mockGlobalMATLABManager,
 		mockDirectory,
+		mockInstanceLock,
+		mockEventRecorder,
+		mockMemoryWatchdog,
+		mockMATLABSupervisor,
+		mockConfigReloader,
 	)
 
 	// Assert
@@ -96,8 +128,35 @@ func TestOrchestrator_StartAndWaitForCompletion_HappyPath(t *testing.T) {
 	mockDirectory := &orchestratormocks.MockDirectory{}
 	defer mockDirectory.AssertExpectations(t)
 
+	mockDebugServer := &orchestratormocks.MockDebugServer{}
+	defer mockDebugServer.AssertExpectations(t)
+
+	mockMetricsServer := &orchestratormocks.MockMetricsServer{}
+	defer mockMetricsServer.AssertExpectations(t)
+
+	mockInstanceLock := &orchestratormocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockEventRecorder := &orchestratormocks.MockEventRecorder{}
+	defer mockEventRecorder.AssertExpectations(t)
+
+	mockMemoryWatchdog := &orchestratormocks.MockMemoryWatchdog{}
+	defer mockMemoryWatchdog.AssertExpectations(t)
+
+	mockMATLABSupervisor := &orchestratormocks.MockMATLABSupervisor{}
+	defer mockMATLABSupervisor.AssertExpectations(t)
+
+	mockConfigReloader := &orchestratormocks.MockConfigReloader{}
+	defer mockConfigReloader.AssertExpectations(t)
+
+	mockConfigReloader.EXPECT().
+		Start().
+		Return().
+		Once()
+
 	ctx := t.Context()
 	interruptC := getInterruptChannel()
+	logDir := t.TempDir()
 
 	mockLoggerFactory.EXPECT().
 		GetGlobalLogger().
@@ -106,9 +165,59 @@ func TestOrchestrator_StartAndWaitForCompletion_HappyPath(t *testing.T) {
 
 	mockDirectory.EXPECT().
 		BaseDir().
+		Return(logDir).
+		Once()
+
+	mockConfig.EXPECT().
+		DaemonMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ServeTransport().
+		Return(entities.TransportStdio).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		TryLockWithKill(true).
+		Return(true, nil).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		TakenOverPID().
+		Return(0, false).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		Unlock().
+		Return(nil).
+		Once()
+
+	mockEventRecorder.EXPECT().
+		Record(entities.EventKindMATLABSessionStarted, mock.Anything, mock.Anything).
+		Return().
+		Once()
+
+	mockEventRecorder.EXPECT().
+		Record(entities.EventKindServerStarted, mock.Anything, map[string]any{"pid": os.Getpid(), "version": "v1.2.3", "commit": "0123abcd", "log-file": filepath.Join(logDir, "server.log")}).
+		Return().
+		Once()
+
+	mockConfig.EXPECT().
+		BuildInfo().
+		Return(entities.BuildInfo{Version: "v1.2.3", Commit: "0123abcd"}).
+		Once()
+
+	mockConfig.EXPECT().
+		LogFile().
 		Return("").
 		Once()
 
+	mockEventRecorder.EXPECT().
+		Record(entities.EventKindServerStopping, mock.Anything, mock.Anything).
+		Return().
+		Once()
+
 	mockConfig.EXPECT().
 		RecordToLogger(mockLogger.AsMockArg()).
 		Return().
@@ -119,6 +228,16 @@ func TestOrchestrator_StartAndWaitForCompletion_HappyPath(t *testing.T) {
 		Return(nil).
 		Once()
 
+	mockDebugServer.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	mockMetricsServer.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
 	// Server should run indefinitely (simulate with a blocking channel)
 	serverStarted := make(chan struct{})
 
@@ -140,15 +259,30 @@ func TestOrchestrator_StartAndWaitForCompletion_HappyPath(t *testing.T) {
 		Once()
 
 	mockGlobalMATLABManager.EXPECT().
-		Initialize(ctx, mockLogger.AsMockArg()).
+		Initialize(mock.Anything, mockLogger.AsMockArg()).
 		Return(nil).
 		Once()
 
+	mockMemoryWatchdog.EXPECT().
+		Start(mock.Anything).
+		Return().
+		Once()
+
+	mockMATLABSupervisor.EXPECT().
+		Start(mock.Anything).
+		Return().
+		Once()
+
 	mockSignalLayer.EXPECT().
 		InterruptSignalChan().
 		Return(interruptC).
 		Once()
 
+	mockServer.EXPECT().
+		Drain().
+		Return(nil).
+		Once()
+
 	mockLifecycleSignaler.EXPECT().
 		RequestShutdown().
 		Return().
@@ -169,10 +303,17 @@ func TestOrchestrator_StartAndWaitForCompletion_HappyPath(t *testing.T) {
 		mockConfig,
 		mockServer,
 		mockWatchdogClient,
+		mockDebugServer,
+		mockMetricsServer,
 		mockLoggerFactory,
 		mockSignalLayer,
 		mockGlobalMATLABManager,
 		mockDirectory,
+		mockInstanceLock,
+		mockEventRecorder,
+		mockMemoryWatchdog,
+		mockMATLABSupervisor,
+		mockConfigReloader,
 	)
 
 	// Act
@@ -189,7 +330,7 @@ func TestOrchestrator_StartAndWaitForCompletion_HappyPath(t *testing.T) {
 	require.NoError(t, <-errC, "StartAndWaitForCompletion should not return an error on signal interrupt")
 }
 
-func TestOrchestrator_StartAndWaitForCompletion_ServerError(t *testing.T) {
+func TestOrchestrator_StartAndWaitForCompletion_SignalWhileMATLABStarts(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
@@ -217,10 +358,35 @@ func TestOrchestrator_StartAndWaitForCompletion_ServerError(t *testing.T) {
 	mockDirectory := &orchestratormocks.MockDirectory{}
 	defer mockDirectory.AssertExpectations(t)
 
+	mockDebugServer := &orchestratormocks.MockDebugServer{}
+	defer mockDebugServer.AssertExpectations(t)
+
+	mockMetricsServer := &orchestratormocks.MockMetricsServer{}
+	defer mockMetricsServer.AssertExpectations(t)
+
+	mockInstanceLock := &orchestratormocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockEventRecorder := &orchestratormocks.MockEventRecorder{}
+	defer mockEventRecorder.AssertExpectations(t)
+
+	mockMemoryWatchdog := &orchestratormocks.MockMemoryWatchdog{}
+	defer mockMemoryWatchdog.AssertExpectations(t)
+
+	mockMATLABSupervisor := &orchestratormocks.MockMATLABSupervisor{}
+	defer mockMATLABSupervisor.AssertExpectations(t)
+
+	mockConfigReloader := &orchestratormocks.MockConfigReloader{}
+	defer mockConfigReloader.AssertExpectations(t)
+
+	mockConfigReloader.EXPECT().
+		Start().
+		Return().
+		Once()
+
 	ctx := t.Context()
 	interruptC := getInterruptChannel()
-
-	expectedError := assert.AnError
+	logDir := t.TempDir()
 
 	mockLoggerFactory.EXPECT().
 		GetGlobalLogger().
@@ -229,7 +395,37 @@ func TestOrchestrator_StartAndWaitForCompletion_ServerError(t *testing.T) {
 
 	mockDirectory.EXPECT().
 		BaseDir().
-		Return("").
+		Return(logDir).
+		Once()
+
+	mockConfig.EXPECT().
+		DaemonMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ServeTransport().
+		Return(entities.TransportStdio).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		TryLockWithKill(true).
+		Return(true, nil).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		TakenOverPID().
+		Return(0, false).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		Unlock().
+		Return(nil).
+		Once()
+
+	mockEventRecorder.EXPECT().
+		Record(entities.EventKindServerStopping, mock.Anything, mock.Anything).
+		Return().
 		Once()
 
 	mockConfig.EXPECT().
@@ -242,19 +438,41 @@ func TestOrchestrator_StartAndWaitForCompletion_ServerError(t *testing.T) {
 		Return(nil).
 		Once()
 
+	mockDebugServer.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	mockMetricsServer.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	stopServer := make(chan struct{})
+	defer close(stopServer)
+
+	// The server may not have started running yet when the shutdown completes.
 	mockServer.EXPECT().
 		Run().
-		Return(expectedError).
-		Once()
+		RunAndReturn(func() error {
+			<-stopServer
+			return nil
+		}).
+		Maybe()
 
 	mockConfig.EXPECT().
 		UseSingleMATLABSession().
 		Return(true).
 		Once()
 
+	// The signal arrives while MATLAB starts, which only completes once the startup is cancelled.
 	mockGlobalMATLABManager.EXPECT().
-		Initialize(ctx, mockLogger.AsMockArg()).
-		Return(nil).
+		Initialize(mock.Anything, mockLogger.AsMockArg()).
+		RunAndReturn(func(ctx context.Context, _ entities.Logger) error {
+			sendInterruptSignal(interruptC)
+			<-ctx.Done()
+			return ctx.Err()
+		}).
 		Once()
 
 	mockSignalLayer.EXPECT().
@@ -262,6 +480,11 @@ func TestOrchestrator_StartAndWaitForCompletion_ServerError(t *testing.T) {
 		Return(interruptC).
 		Once()
 
+	mockServer.EXPECT().
+		Drain().
+		Return(nil).
+		Once()
+
 	mockLifecycleSignaler.EXPECT().
 		RequestShutdown().
 		Return().
@@ -282,20 +505,33 @@ func TestOrchestrator_StartAndWaitForCompletion_ServerError(t *testing.T) {
 		mockConfig,
 		mockServer,
 		mockWatchdogClient,
+		mockDebugServer,
+		mockMetricsServer,
 		mockLoggerFactory,
 		mockSignalLayer,
 		mockGlobalMATLABManager,
 		mockDirectory,
+		mockInstanceLock,
+		mockEventRecorder,
+		mockMemoryWatchdog,
+		mockMATLABSupervisor,
+		mockConfigReloader,
 	)
 
 	// Act
-	err := orchestratorInstance.StartAndWaitForCompletion(ctx)
+	errC := make(chan error)
+	go func() {
+		errC <- orchestratorInstance.StartAndWaitForCompletion(ctx)
+	}()
 
 	// Assert
-	assert.ErrorIs(t, err, expectedError, "Error should be the server error")
+	require.NoError(t, <-errC, "A signal received while MATLAB starts should stop the server gracefully")
+	mockLifecycleSignaler.AssertCalled(t, "RequestShutdown")
+	mockInstanceLock.AssertCalled(t, "Unlock")
+	mockEventRecorder.AssertNotCalled(t, "Record", entities.EventKindMATLABSessionStartFailed, mock.Anything, mock.Anything)
 }
 
-func TestOrchestrator_StartAndWaitForCompletion_InitializeMATLABErrorDoesNotTriggerShutdown(t *testing.T) {
+func TestOrchestrator_StartAndWaitForCompletion_ServerError(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
@@ -308,14 +544,14 @@ func TestOrchestrator_StartAndWaitForCompletion_InitializeMATLABErrorDoesNotTrig
 	mockServer := &orchestratormocks.MockServer{}
 	defer mockServer.AssertExpectations(t)
 
-	mockLoggerFactory := &orchestratormocks.MockLoggerFactory{}
-	defer mockLoggerFactory.AssertExpectations(t)
-
 	mockWatchdogClient := &orchestratormocks.MockWatchdogClient{}
 	defer mockWatchdogClient.AssertExpectations(t)
 
+	mockLoggerFactory := &orchestratormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
 	mockSignalLayer := &orchestratormocks.MockOSSignaler{}
-	defer mockSignalLayer.AssertExpectations(t)
+	defer mockLoggerFactory.AssertExpectations(t)
 
 	mockGlobalMATLABManager := &orchestratormocks.MockGlobalMATLAB{}
 	defer mockGlobalMATLABManager.AssertExpectations(t)
@@ -323,10 +559,36 @@ func TestOrchestrator_StartAndWaitForCompletion_InitializeMATLABErrorDoesNotTrig
 	mockDirectory := &orchestratormocks.MockDirectory{}
 	defer mockDirectory.AssertExpectations(t)
 
+	mockDebugServer := &orchestratormocks.MockDebugServer{}
+	defer mockDebugServer.AssertExpectations(t)
+
+	mockMetricsServer := &orchestratormocks.MockMetricsServer{}
+	defer mockMetricsServer.AssertExpectations(t)
+
+	mockInstanceLock := &orchestratormocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockEventRecorder := &orchestratormocks.MockEventRecorder{}
+	defer mockEventRecorder.AssertExpectations(t)
+
+	mockMemoryWatchdog := &orchestratormocks.MockMemoryWatchdog{}
+	defer mockMemoryWatchdog.AssertExpectations(t)
+
+	mockMATLABSupervisor := &orchestratormocks.MockMATLABSupervisor{}
+	defer mockMATLABSupervisor.AssertExpectations(t)
+
+	mockConfigReloader := &orchestratormocks.MockConfigReloader{}
+	defer mockConfigReloader.AssertExpectations(t)
+
+	mockConfigReloader.EXPECT().
+		Start().
+		Return().
+		Once()
+
 	ctx := t.Context()
-	expectedError := assert.AnError
+	interruptC := getInterruptChannel()
 
-	closeServerRoutine := make(chan struct{})
+	expectedError := assert.AnError
 
 	mockLoggerFactory.EXPECT().
 		GetGlobalLogger().
@@ -338,22 +600,68 @@ func TestOrchestrator_StartAndWaitForCompletion_InitializeMATLABErrorDoesNotTrig
 		Return("").
 		Once()
 
+	mockConfig.EXPECT().
+		DaemonMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ServeTransport().
+		Return(entities.TransportStdio).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		TryLockWithKill(true).
+		Return(true, nil).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		TakenOverPID().
+		Return(0, false).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		Unlock().
+		Return(nil).
+		Once()
+
+	mockEventRecorder.EXPECT().
+		Record(mock.Anything, mock.Anything, mock.Anything).
+		Return()
+
 	mockConfig.EXPECT().
 		RecordToLogger(mockLogger.AsMockArg()).
 		Return().
 		Once()
 
+	mockConfig.EXPECT().
+		BuildInfo().
+		Return(entities.BuildInfo{}).
+		Once()
+
+	mockConfig.EXPECT().
+		LogFile().
+		Return("").
+		Once()
+
 	mockWatchdogClient.EXPECT().
 		Start().
 		Return(nil).
 		Once()
 
+	mockDebugServer.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	mockMetricsServer.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
 	mockServer.EXPECT().
 		Run().
-		RunAndReturn(func() error {
-			<-closeServerRoutine
-			return nil
-		}).
+		Return(expectedError).
 		Once()
 
 	mockConfig.EXPECT().
@@ -362,28 +670,38 @@ func TestOrchestrator_StartAndWaitForCompletion_InitializeMATLABErrorDoesNotTrig
 		Once()
 
 	mockGlobalMATLABManager.EXPECT().
-		Initialize(ctx, mockLogger.AsMockArg()).
-		Return(expectedError).
+		Initialize(mock.Anything, mockLogger.AsMockArg()).
+		Return(nil).
+		Once()
+
+	mockMemoryWatchdog.EXPECT().
+		Start(mock.Anything).
+		Return().
+		Once()
+
+	mockMATLABSupervisor.EXPECT().
+		Start(mock.Anything).
+		Return().
 		Once()
 
 	mockSignalLayer.EXPECT().
 		InterruptSignalChan().
-		Return(getInterruptChannel()).
+		Return(interruptC).
 		Once()
 
-	isShutdownCalled := make(chan struct{})
+	mockServer.EXPECT().
+		Drain().
+		Return(nil).
+		Once()
 
 	mockLifecycleSignaler.EXPECT().
 		RequestShutdown().
 		Return().
-		Run(func() {
-			close(isShutdownCalled)
-		}).
 		Once()
 
 	mockLifecycleSignaler.EXPECT().
 		WaitForShutdownToComplete().
-		Return(expectedError).
+		Return(nil).
 		Once()
 
 	mockWatchdogClient.EXPECT().
@@ -396,31 +714,27 @@ func TestOrchestrator_StartAndWaitForCompletion_InitializeMATLABErrorDoesNotTrig
 		mockConfig,
 		mockServer,
 		mockWatchdogClient,
+		mockDebugServer,
+		mockMetricsServer,
 		mockLoggerFactory,
 		mockSignalLayer,
 		mockGlobalMATLABManager,
 		mockDirectory,
+		mockInstanceLock,
+		mockEventRecorder,
+		mockMemoryWatchdog,
+		mockMATLABSupervisor,
+		mockConfigReloader,
 	)
 
 	// Act
-	errC := make(chan error)
-	go func() {
-		errC <- orchestratorInstance.StartAndWaitForCompletion(ctx)
-	}()
+	err := orchestratorInstance.StartAndWaitForCompletion(ctx)
 
 	// Assert
-	select {
-	case <-isShutdownCalled:
-		t.Fatal("RequestShutdown should not be called when MATLAB initialization fails")
-	case <-time.After(10 * time.Millisecond):
-		// Expected behavior: no shutdown request should occur
-	}
-
-	close(closeServerRoutine)
-	require.NoError(t, <-errC, "StartAndWaitForCompletion should not return an error")
+	assert.ErrorIs(t, err, expectedError, "Error should be the server error")
 }
 
-func TestOrchestrator_StartAndWaitForCompletion_WaitForShutdownToCompleteError(t *testing.T) {
+func TestOrchestrator_StartAndWaitForCompletion_InitializeMATLABErrorDoesNotTriggerShutdown(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
@@ -431,16 +745,16 @@ func TestOrchestrator_StartAndWaitForCompletion_WaitForShutdownToCompleteError(t
 	defer mockConfig.AssertExpectations(t)
 
 	mockServer := &orchestratormocks.MockServer{}
-	defer mockConfig.AssertExpectations(t)
-
-	mockWatchdogClient := &orchestratormocks.MockWatchdogClient{}
-	defer mockWatchdogClient.AssertExpectations(t)
+	defer mockServer.AssertExpectations(t)
 
 	mockLoggerFactory := &orchestratormocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockWatchdogClient := &orchestratormocks.MockWatchdogClient{}
+	defer mockWatchdogClient.AssertExpectations(t)
+
 	mockSignalLayer := &orchestratormocks.MockOSSignaler{}
-	defer mockLoggerFactory.AssertExpectations(t)
+	defer mockSignalLayer.AssertExpectations(t)
 
 	mockGlobalMATLABManager := &orchestratormocks.MockGlobalMATLAB{}
 	defer mockGlobalMATLABManager.AssertExpectations(t)
@@ -448,11 +762,37 @@ func TestOrchestrator_StartAndWaitForCompletion_WaitForShutdownToCompleteError(t
 	mockDirectory := &orchestratormocks.MockDirectory{}
 	defer mockDirectory.AssertExpectations(t)
 
-	ctx := t.Context()
-	interruptC := getInterruptChannel()
+	mockDebugServer := &orchestratormocks.MockDebugServer{}
+	defer mockDebugServer.AssertExpectations(t)
+
+	mockMetricsServer := &orchestratormocks.MockMetricsServer{}
+	defer mockMetricsServer.AssertExpectations(t)
+
+	mockInstanceLock := &orchestratormocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockEventRecorder := &orchestratormocks.MockEventRecorder{}
+	defer mockEventRecorder.AssertExpectations(t)
+
+	mockMemoryWatchdog := &orchestratormocks.MockMemoryWatchdog{}
+	defer mockMemoryWatchdog.AssertExpectations(t)
+
+	mockMATLABSupervisor := &orchestratormocks.MockMATLABSupervisor{}
+	defer mockMATLABSupervisor.AssertExpectations(t)
+
+	mockConfigReloader := &orchestratormocks.MockConfigReloader{}
+	defer mockConfigReloader.AssertExpectations(t)
 
+	mockConfigReloader.EXPECT().
+		Start().
+		Return().
+		Once()
+
+	ctx := t.Context()
 	expectedError := assert.AnError
 
+	closeServerRoutine := make(chan struct{})
+
 	mockLoggerFactory.EXPECT().
 		GetGlobalLogger().
 		Return(mockLogger).
@@ -464,43 +804,105 @@ func TestOrchestrator_StartAndWaitForCompletion_WaitForShutdownToCompleteError(t
 		Once()
 
 	mockConfig.EXPECT().
-		RecordToLogger(mockLogger.AsMockArg()).
-		Return().
+		DaemonMode().
+		Return(false).
 		Once()
 
-	mockWatchdogClient.EXPECT().
-		Start().
-		Return(nil).
+	mockConfig.EXPECT().
+		ServeTransport().
+		Return(entities.TransportStdio).
 		Once()
 
-	mockServer.EXPECT().
-		Run().
+	mockInstanceLock.EXPECT().
+		TryLockWithKill(true).
+		Return(true, nil).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		TakenOverPID().
+		Return(0, false).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		Unlock().
+		Return(nil).
+		Once()
+
+	mockEventRecorder.EXPECT().
+		Record(mock.Anything, mock.Anything, mock.Anything).
+		Return()
+
+	mockConfig.EXPECT().
+		RecordToLogger(mockLogger.AsMockArg()).
+		Return().
+		Once()
+
+	mockConfig.EXPECT().
+		BuildInfo().
+		Return(entities.BuildInfo{}).
+		Once()
+
+	mockConfig.EXPECT().
+		LogFile().
+		Return("").
+		Once()
+
+	mockWatchdogClient.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	mockDebugServer.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	mockMetricsServer.EXPECT().
+		Start().
 		Return(nil).
 		Once()
 
+	mockServer.EXPECT().
+		Run().
+		RunAndReturn(func() error {
+			<-closeServerRoutine
+			return nil
+		}).
+		Once()
+
 	mockConfig.EXPECT().
 		UseSingleMATLABSession().
 		Return(true).
 		Once()
 
 	mockGlobalMATLABManager.EXPECT().
-		Initialize(ctx, mockLogger.AsMockArg()).
-		Return(nil).
+		Initialize(mock.Anything, mockLogger.AsMockArg()).
+		Return(expectedError).
 		Once()
 
 	mockSignalLayer.EXPECT().
 		InterruptSignalChan().
-		Return(interruptC).
+		Return(getInterruptChannel()).
+		Once()
+
+	isShutdownCalled := make(chan struct{})
+
+	mockServer.EXPECT().
+		Drain().
+		Return(nil).
 		Once()
 
 	mockLifecycleSignaler.EXPECT().
 		RequestShutdown().
 		Return().
+		Run(func() {
+			close(isShutdownCalled)
+		}).
 		Once()
 
 	mockLifecycleSignaler.EXPECT().
 		WaitForShutdownToComplete().
-		Return(expectedError).
+		Return(nil).
 		Once()
 
 	mockWatchdogClient.EXPECT().
@@ -513,10 +915,17 @@ func TestOrchestrator_StartAndWaitForCompletion_WaitForShutdownToCompleteError(t
 		mockConfig,
 		mockServer,
 		mockWatchdogClient,
+		mockDebugServer,
+		mockMetricsServer,
 		mockLoggerFactory,
 		mockSignalLayer,
 		mockGlobalMATLABManager,
 		mockDirectory,
+		mockInstanceLock,
+		mockEventRecorder,
+		mockMemoryWatchdog,
+		mockMATLABSupervisor,
+		mockConfigReloader,
 	)
 
 	// Act
@@ -526,23 +935,18 @@ func TestOrchestrator_StartAndWaitForCompletion_WaitForShutdownToCompleteError(t
 	}()
 
 	// Assert
-	require.NoError(t, <-errC, "StartAndWaitForCompletion should not return an error on signal interrupt")
-
-	// This is mostly optional
-	logs := mockLogger.WarnLogs()
-
-	fields, found := logs["MATLAB MCP Core Server application shutdown failed"]
-	require.True(t, found, "Expected a warning log about shutdown failure")
-
-	errField, found := fields["error"]
-	require.True(t, found, "Expected an error field in the warning log")
+	select {
+	case <-isShutdownCalled:
+		t.Fatal("RequestShutdown should not be called when MATLAB initialization fails")
+	case <-time.After(10 * time.Millisecond):
+		// Expected behavior: no shutdown request should occur
+	}
 
-	err, ok := errField.(error)
-	require.True(t, ok, "Error field should be of type error")
-	require.ErrorIs(t, err, expectedError, "Logged error should match the shutdown error")
+	close(closeServerRoutine)
+	require.NoError(t, <-errC, "StartAndWaitForCompletion should not return an error")
 }
 
-func TestOrchestrator_runMATLABMCPServerMain_MultipleSession_HappyPath(t *testing.T) {
+func TestOrchestrator_StartAndWaitForCompletion_WaitForShutdownToCompleteError(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
@@ -553,7 +957,7 @@ func TestOrchestrator_runMATLABMCPServerMain_MultipleSession_HappyPath(t *testin
 	defer mockConfig.AssertExpectations(t)
 
 	mockServer := &orchestratormocks.MockServer{}
-	defer mockServer.AssertExpectations(t)
+	defer mockConfig.AssertExpectations(t)
 
 	mockWatchdogClient := &orchestratormocks.MockWatchdogClient{}
 	defer mockWatchdogClient.AssertExpectations(t)
@@ -562,17 +966,45 @@ func TestOrchestrator_runMATLABMCPServerMain_MultipleSession_HappyPath(t *testin
 	defer mockLoggerFactory.AssertExpectations(t)
 
 	mockSignalLayer := &orchestratormocks.MockOSSignaler{}
-	defer mockSignalLayer.AssertExpectations(t)
+	defer mockLoggerFactory.AssertExpectations(t)
 
 	mockGlobalMATLABManager := &orchestratormocks.MockGlobalMATLAB{}
-	defer mockGlobalMATLABManager.AssertExpectations(t) // Implicit assertion here, Initialize should not be called
+	defer mockGlobalMATLABManager.AssertExpectations(t)
 
 	mockDirectory := &orchestratormocks.MockDirectory{}
 	defer mockDirectory.AssertExpectations(t)
 
+	mockDebugServer := &orchestratormocks.MockDebugServer{}
+	defer mockDebugServer.AssertExpectations(t)
+
+	mockMetricsServer := &orchestratormocks.MockMetricsServer{}
+	defer mockMetricsServer.AssertExpectations(t)
+
+	mockInstanceLock := &orchestratormocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockEventRecorder := &orchestratormocks.MockEventRecorder{}
+	defer mockEventRecorder.AssertExpectations(t)
+
+	mockMemoryWatchdog := &orchestratormocks.MockMemoryWatchdog{}
+	defer mockMemoryWatchdog.AssertExpectations(t)
+
+	mockMATLABSupervisor := &orchestratormocks.MockMATLABSupervisor{}
+	defer mockMATLABSupervisor.AssertExpectations(t)
+
+	mockConfigReloader := &orchestratormocks.MockConfigReloader{}
+	defer mockConfigReloader.AssertExpectations(t)
+
+	mockConfigReloader.EXPECT().
+		Start().
+		Return().
+		Once()
+
 	ctx := t.Context()
 	interruptC := getInterruptChannel()
 
+	expectedError := assert.AnError
+
 	mockLoggerFactory.EXPECT().
 		GetGlobalLogger().
 		Return(mockLogger).
@@ -583,16 +1015,65 @@ func TestOrchestrator_runMATLABMCPServerMain_MultipleSession_HappyPath(t *testin
 		Return("").
 		Once()
 
+	mockConfig.EXPECT().
+		DaemonMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ServeTransport().
+		Return(entities.TransportStdio).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		TryLockWithKill(true).
+		Return(true, nil).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		TakenOverPID().
+		Return(0, false).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		Unlock().
+		Return(nil).
+		Once()
+
+	mockEventRecorder.EXPECT().
+		Record(mock.Anything, mock.Anything, mock.Anything).
+		Return()
+
 	mockConfig.EXPECT().
 		RecordToLogger(mockLogger.AsMockArg()).
 		Return().
 		Once()
 
+	mockConfig.EXPECT().
+		BuildInfo().
+		Return(entities.BuildInfo{}).
+		Once()
+
+	mockConfig.EXPECT().
+		LogFile().
+		Return("").
+		Once()
+
 	mockWatchdogClient.EXPECT().
 		Start().
 		Return(nil).
 		Once()
 
+	mockDebugServer.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	mockMetricsServer.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
 	mockServer.EXPECT().
 		Run().
 		Return(nil).
@@ -600,7 +1081,22 @@ func TestOrchestrator_runMATLABMCPServerMain_MultipleSession_HappyPath(t *testin
 
 	mockConfig.EXPECT().
 		UseSingleMATLABSession().
-		Return(false).
+		Return(true).
+		Once()
+
+	mockGlobalMATLABManager.EXPECT().
+		Initialize(mock.Anything, mockLogger.AsMockArg()).
+		Return(nil).
+		Once()
+
+	mockMemoryWatchdog.EXPECT().
+		Start(mock.Anything).
+		Return().
+		Once()
+
+	mockMATLABSupervisor.EXPECT().
+		Start(mock.Anything).
+		Return().
 		Once()
 
 	mockSignalLayer.EXPECT().
@@ -608,6 +1104,11 @@ func TestOrchestrator_runMATLABMCPServerMain_MultipleSession_HappyPath(t *testin
 		Return(interruptC).
 		Once()
 
+	mockServer.EXPECT().
+		Drain().
+		Return(nil).
+		Once()
+
 	mockLifecycleSignaler.EXPECT().
 		RequestShutdown().
 		Return().
@@ -615,7 +1116,7 @@ func TestOrchestrator_runMATLABMCPServerMain_MultipleSession_HappyPath(t *testin
 
 	mockLifecycleSignaler.EXPECT().
 		WaitForShutdownToComplete().
-		Return(nil).
+		Return(expectedError).
 		Once()
 
 	mockWatchdogClient.EXPECT().
@@ -628,17 +1129,898 @@ func TestOrchestrator_runMATLABMCPServerMain_MultipleSession_HappyPath(t *testin
 		mockConfig,
 		mockServer,
 		mockWatchdogClient,
+		mockDebugServer,
+		mockMetricsServer,
 		mockLoggerFactory,
 		mockSignalLayer,
 		mockGlobalMATLABManager,
 		mockDirectory,
+		mockInstanceLock,
+		mockEventRecorder,
+		mockMemoryWatchdog,
+		mockMATLABSupervisor,
+		mockConfigReloader,
 	)
 
 	// Act
-	err := orchestratorInstance.StartAndWaitForCompletion(ctx)
+	errC := make(chan error)
+	go func() {
+		errC <- orchestratorInstance.StartAndWaitForCompletion(ctx)
+	}()
 
 	// Assert
-	require.NoError(t, err)
+	require.ErrorIs(t, <-errC, expectedError, "StartAndWaitForCompletion should fail when the shutdown failed")
+
+	// This is mostly optional
+	logs := mockLogger.WarnLogs()
+
+	fields, found := logs["MATLAB MCP Core Server application shutdown failed"]
+	require.True(t, found, "Expected a warning log about shutdown failure")
+
+	errField, found := fields["error"]
+	require.True(t, found, "Expected an error field in the warning log")
+
+	err, ok := errField.(error)
+	require.True(t, ok, "Error field should be of type error")
+	require.ErrorIs(t, err, expectedError, "Logged error should match the shutdown error")
+}
+
+func TestOrchestrator_StartAndWaitForCompletion_DrainError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLifecycleSignaler := &orchestratormocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfig := &orchestratormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockServer := &orchestratormocks.MockServer{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockWatchdogClient := &orchestratormocks.MockWatchdogClient{}
+	defer mockWatchdogClient.AssertExpectations(t)
+
+	mockLoggerFactory := &orchestratormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSignalLayer := &orchestratormocks.MockOSSignaler{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockGlobalMATLABManager := &orchestratormocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLABManager.AssertExpectations(t)
+
+	mockDirectory := &orchestratormocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockDebugServer := &orchestratormocks.MockDebugServer{}
+	defer mockDebugServer.AssertExpectations(t)
+
+	mockMetricsServer := &orchestratormocks.MockMetricsServer{}
+	defer mockMetricsServer.AssertExpectations(t)
+
+	mockInstanceLock := &orchestratormocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockEventRecorder := &orchestratormocks.MockEventRecorder{}
+	defer mockEventRecorder.AssertExpectations(t)
+
+	mockMemoryWatchdog := &orchestratormocks.MockMemoryWatchdog{}
+	defer mockMemoryWatchdog.AssertExpectations(t)
+
+	mockMATLABSupervisor := &orchestratormocks.MockMATLABSupervisor{}
+	defer mockMATLABSupervisor.AssertExpectations(t)
+
+	mockConfigReloader := &orchestratormocks.MockConfigReloader{}
+	defer mockConfigReloader.AssertExpectations(t)
+
+	mockConfigReloader.EXPECT().
+		Start().
+		Return().
+		Once()
+
+	ctx := t.Context()
+	interruptC := getInterruptChannel()
+
+	expectedError := assert.AnError
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockDirectory.EXPECT().
+		BaseDir().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		DaemonMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ServeTransport().
+		Return(entities.TransportStdio).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		TryLockWithKill(true).
+		Return(true, nil).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		TakenOverPID().
+		Return(0, false).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		Unlock().
+		Return(nil).
+		Once()
+
+	mockEventRecorder.EXPECT().
+		Record(mock.Anything, mock.Anything, mock.Anything).
+		Return()
+
+	mockConfig.EXPECT().
+		RecordToLogger(mockLogger.AsMockArg()).
+		Return().
+		Once()
+
+	mockConfig.EXPECT().
+		BuildInfo().
+		Return(entities.BuildInfo{}).
+		Once()
+
+	mockConfig.EXPECT().
+		LogFile().
+		Return("").
+		Once()
+
+	mockWatchdogClient.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	mockDebugServer.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	mockMetricsServer.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	mockServer.EXPECT().
+		Run().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(true).
+		Once()
+
+	mockGlobalMATLABManager.EXPECT().
+		Initialize(mock.Anything, mockLogger.AsMockArg()).
+		Return(nil).
+		Once()
+
+	mockMemoryWatchdog.EXPECT().
+		Start(mock.Anything).
+		Return().
+		Once()
+
+	mockMATLABSupervisor.EXPECT().
+		Start(mock.Anything).
+		Return().
+		Once()
+
+	mockSignalLayer.EXPECT().
+		InterruptSignalChan().
+		Return(interruptC).
+		Once()
+
+	mockServer.EXPECT().
+		Drain().
+		Return(expectedError).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		RequestShutdown().
+		Return().
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		WaitForShutdownToComplete().
+		Return(nil).
+		Once()
+
+	mockWatchdogClient.EXPECT().
+		Stop().
+		Return(nil).
+		Once()
+
+	orchestratorInstance := orchestrator.New(
+		mockLifecycleSignaler,
+		mockConfig,
+		mockServer,
+		mockWatchdogClient,
+		mockDebugServer,
+		mockMetricsServer,
+		mockLoggerFactory,
+		mockSignalLayer,
+		mockGlobalMATLABManager,
+		mockDirectory,
+		mockInstanceLock,
+		mockEventRecorder,
+		mockMemoryWatchdog,
+		mockMATLABSupervisor,
+		mockConfigReloader,
+	)
+
+	// Act
+	errC := make(chan error)
+	go func() {
+		errC <- orchestratorInstance.StartAndWaitForCompletion(ctx)
+	}()
+
+	// Assert
+	require.ErrorIs(t, <-errC, expectedError, "StartAndWaitForCompletion should fail when tool calls in flight were cancelled")
+
+	// This is mostly optional
+	logs := mockLogger.WarnLogs()
+
+	fields, found := logs["Failed to drain the tool calls in flight"]
+	require.True(t, found, "Expected a warning log about the drain failure")
+
+	errField, found := fields["error"]
+	require.True(t, found, "Expected an error field in the warning log")
+
+	err, ok := errField.(error)
+	require.True(t, ok, "Error field should be of type error")
+	require.ErrorIs(t, err, expectedError, "Logged error should match the drain error")
+}
+
+func TestOrchestrator_runMATLABMCPServerMain_MultipleSession_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLifecycleSignaler := &orchestratormocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfig := &orchestratormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockServer := &orchestratormocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	mockWatchdogClient := &orchestratormocks.MockWatchdogClient{}
+	defer mockWatchdogClient.AssertExpectations(t)
+
+	mockLoggerFactory := &orchestratormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSignalLayer := &orchestratormocks.MockOSSignaler{}
+	defer mockSignalLayer.AssertExpectations(t)
+
+	mockGlobalMATLABManager := &orchestratormocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLABManager.AssertExpectations(t) // Implicit assertion here, Initialize should not be called
+
+	mockDirectory := &orchestratormocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockDebugServer := &orchestratormocks.MockDebugServer{}
+	defer mockDebugServer.AssertExpectations(t)
+
+	mockMetricsServer := &orchestratormocks.MockMetricsServer{}
+	defer mockMetricsServer.AssertExpectations(t)
+
+	mockInstanceLock := &orchestratormocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockEventRecorder := &orchestratormocks.MockEventRecorder{}
+	defer mockEventRecorder.AssertExpectations(t)
+
+	mockMemoryWatchdog := &orchestratormocks.MockMemoryWatchdog{}
+	defer mockMemoryWatchdog.AssertExpectations(t)
+
+	mockMATLABSupervisor := &orchestratormocks.MockMATLABSupervisor{}
+	defer mockMATLABSupervisor.AssertExpectations(t)
+
+	mockConfigReloader := &orchestratormocks.MockConfigReloader{}
+	defer mockConfigReloader.AssertExpectations(t)
+
+	mockConfigReloader.EXPECT().
+		Start().
+		Return().
+		Once()
+
+	ctx := t.Context()
+	interruptC := getInterruptChannel()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockDirectory.EXPECT().
+		BaseDir().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		DaemonMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ServeTransport().
+		Return(entities.TransportStdio).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		TryLockWithKill(true).
+		Return(true, nil).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		TakenOverPID().
+		Return(0, false).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		Unlock().
+		Return(nil).
+		Once()
+
+	mockEventRecorder.EXPECT().
+		Record(mock.Anything, mock.Anything, mock.Anything).
+		Return()
+
+	mockConfig.EXPECT().
+		RecordToLogger(mockLogger.AsMockArg()).
+		Return().
+		Once()
+
+	mockConfig.EXPECT().
+		BuildInfo().
+		Return(entities.BuildInfo{}).
+		Once()
+
+	mockConfig.EXPECT().
+		LogFile().
+		Return("").
+		Once()
+
+	mockWatchdogClient.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	mockDebugServer.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	mockMetricsServer.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	mockServer.EXPECT().
+		Run().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false).
+		Once()
+
+	mockSignalLayer.EXPECT().
+		InterruptSignalChan().
+		Return(interruptC).
+		Once()
+
+	mockServer.EXPECT().
+		Drain().
+		Return(nil).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		RequestShutdown().
+		Return().
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		WaitForShutdownToComplete().
+		Return(nil).
+		Once()
+
+	mockWatchdogClient.EXPECT().
+		Stop().
+		Return(nil).
+		Once()
+
+	orchestratorInstance := orchestrator.New(
+		mockLifecycleSignaler,
+		mockConfig,
+		mockServer,
+		mockWatchdogClient,
+		mockDebugServer,
+		mockMetricsServer,
+		mockLoggerFactory,
+		mockSignalLayer,
+		mockGlobalMATLABManager,
+		mockDirectory,
+		mockInstanceLock,
+		mockEventRecorder,
+		mockMemoryWatchdog,
+		mockMATLABSupervisor,
+		mockConfigReloader,
+	)
+
+	// Act
+	err := orchestratorInstance.StartAndWaitForCompletion(ctx)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestOrchestrator_StartAndWaitForCompletion_InstanceLockError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLifecycleSignaler := &orchestratormocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfig := &orchestratormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockServer := &orchestratormocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	mockWatchdogClient := &orchestratormocks.MockWatchdogClient{}
+	defer mockWatchdogClient.AssertExpectations(t)
+
+	mockLoggerFactory := &orchestratormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSignalLayer := &orchestratormocks.MockOSSignaler{}
+	defer mockSignalLayer.AssertExpectations(t)
+
+	mockGlobalMATLABManager := &orchestratormocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLABManager.AssertExpectations(t)
+
+	mockDirectory := &orchestratormocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockDebugServer := &orchestratormocks.MockDebugServer{}
+	defer mockDebugServer.AssertExpectations(t)
+
+	mockMetricsServer := &orchestratormocks.MockMetricsServer{}
+	defer mockMetricsServer.AssertExpectations(t)
+
+	mockInstanceLock := &orchestratormocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockEventRecorder := &orchestratormocks.MockEventRecorder{}
+	defer mockEventRecorder.AssertExpectations(t)
+
+	mockMemoryWatchdog := &orchestratormocks.MockMemoryWatchdog{}
+	defer mockMemoryWatchdog.AssertExpectations(t)
+
+	mockMATLABSupervisor := &orchestratormocks.MockMATLABSupervisor{}
+	defer mockMATLABSupervisor.AssertExpectations(t)
+
+	mockConfigReloader := &orchestratormocks.MockConfigReloader{}
+	defer mockConfigReloader.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockDirectory.EXPECT().
+		BaseDir().
+		Return("").
+		Once()
+
+	expectedError := assert.AnError
+
+	mockConfig.EXPECT().
+		DaemonMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ServeTransport().
+		Return(entities.TransportStdio).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		TryLockWithKill(true).
+		Return(false, expectedError).
+		Once()
+
+	orchestratorInstance := orchestrator.New(
+		mockLifecycleSignaler,
+		mockConfig,
+		mockServer,
+		mockWatchdogClient,
+		mockDebugServer,
+		mockMetricsServer,
+		mockLoggerFactory,
+		mockSignalLayer,
+		mockGlobalMATLABManager,
+		mockDirectory,
+		mockInstanceLock,
+		mockEventRecorder,
+		mockMemoryWatchdog,
+		mockMATLABSupervisor,
+		mockConfigReloader,
+	)
+
+	// Act
+	err := orchestratorInstance.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Error should be the instance lock error")
+}
+
+func TestOrchestrator_StartAndWaitForCompletion_InstanceLockNotAcquired(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLifecycleSignaler := &orchestratormocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfig := &orchestratormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockServer := &orchestratormocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	mockWatchdogClient := &orchestratormocks.MockWatchdogClient{}
+	defer mockWatchdogClient.AssertExpectations(t)
+
+	mockLoggerFactory := &orchestratormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSignalLayer := &orchestratormocks.MockOSSignaler{}
+	defer mockSignalLayer.AssertExpectations(t)
+
+	mockGlobalMATLABManager := &orchestratormocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLABManager.AssertExpectations(t)
+
+	mockDirectory := &orchestratormocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockDebugServer := &orchestratormocks.MockDebugServer{}
+	defer mockDebugServer.AssertExpectations(t)
+
+	mockMetricsServer := &orchestratormocks.MockMetricsServer{}
+	defer mockMetricsServer.AssertExpectations(t)
+
+	mockInstanceLock := &orchestratormocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockEventRecorder := &orchestratormocks.MockEventRecorder{}
+	defer mockEventRecorder.AssertExpectations(t)
+
+	mockMemoryWatchdog := &orchestratormocks.MockMemoryWatchdog{}
+	defer mockMemoryWatchdog.AssertExpectations(t)
+
+	mockMATLABSupervisor := &orchestratormocks.MockMATLABSupervisor{}
+	defer mockMATLABSupervisor.AssertExpectations(t)
+
+	mockConfigReloader := &orchestratormocks.MockConfigReloader{}
+	defer mockConfigReloader.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockDirectory.EXPECT().
+		BaseDir().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		DaemonMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ServeTransport().
+		Return(entities.TransportStdio).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		TryLockWithKill(true).
+		Return(false, nil).
+		Once()
+
+	orchestratorInstance := orchestrator.New(
+		mockLifecycleSignaler,
+		mockConfig,
+		mockServer,
+		mockWatchdogClient,
+		mockDebugServer,
+		mockMetricsServer,
+		mockLoggerFactory,
+		mockSignalLayer,
+		mockGlobalMATLABManager,
+		mockDirectory,
+		mockInstanceLock,
+		mockEventRecorder,
+		mockMemoryWatchdog,
+		mockMATLABSupervisor,
+		mockConfigReloader,
+	)
+
+	// Act
+	err := orchestratorInstance.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.Error(t, err, "StartAndWaitForCompletion should fail when another instance holds the lock")
+	assert.Equal(t, entities.ExitCodeAlreadyRunning, entities.ExitCodeOf(err))
+}
+
+func TestOrchestrator_StartAndWaitForCompletion_DaemonDoesNotTakeOver(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLifecycleSignaler := &orchestratormocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfig := &orchestratormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockServer := &orchestratormocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	mockWatchdogClient := &orchestratormocks.MockWatchdogClient{}
+	defer mockWatchdogClient.AssertExpectations(t)
+
+	mockLoggerFactory := &orchestratormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSignalLayer := &orchestratormocks.MockOSSignaler{}
+	defer mockSignalLayer.AssertExpectations(t)
+
+	mockGlobalMATLABManager := &orchestratormocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLABManager.AssertExpectations(t)
+
+	mockDirectory := &orchestratormocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockDebugServer := &orchestratormocks.MockDebugServer{}
+	defer mockDebugServer.AssertExpectations(t)
+
+	mockMetricsServer := &orchestratormocks.MockMetricsServer{}
+	defer mockMetricsServer.AssertExpectations(t)
+
+	mockInstanceLock := &orchestratormocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockEventRecorder := &orchestratormocks.MockEventRecorder{}
+	defer mockEventRecorder.AssertExpectations(t)
+
+	mockMemoryWatchdog := &orchestratormocks.MockMemoryWatchdog{}
+	defer mockMemoryWatchdog.AssertExpectations(t)
+
+	mockMATLABSupervisor := &orchestratormocks.MockMATLABSupervisor{}
+	defer mockMATLABSupervisor.AssertExpectations(t)
+
+	mockConfigReloader := &orchestratormocks.MockConfigReloader{}
+	defer mockConfigReloader.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockDirectory.EXPECT().
+		BaseDir().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		DaemonMode().
+		Return(true).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		TryLockWithKill(false).
+		Return(false, nil).
+		Once()
+
+	orchestratorInstance := orchestrator.New(
+		mockLifecycleSignaler,
+		mockConfig,
+		mockServer,
+		mockWatchdogClient,
+		mockDebugServer,
+		mockMetricsServer,
+		mockLoggerFactory,
+		mockSignalLayer,
+		mockGlobalMATLABManager,
+		mockDirectory,
+		mockInstanceLock,
+		mockEventRecorder,
+		mockMemoryWatchdog,
+		mockMATLABSupervisor,
+		mockConfigReloader,
+	)
+
+	// Act
+	err := orchestratorInstance.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.Error(t, err, "A daemon should not take over from a running instance")
+}
+
+func TestOrchestrator_StartAndWaitForCompletion_TakeoverIsRecorded(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLifecycleSignaler := &orchestratormocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfig := &orchestratormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockServer := &orchestratormocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	mockWatchdogClient := &orchestratormocks.MockWatchdogClient{}
+	defer mockWatchdogClient.AssertExpectations(t)
+
+	mockLoggerFactory := &orchestratormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSignalLayer := &orchestratormocks.MockOSSignaler{}
+	defer mockSignalLayer.AssertExpectations(t)
+
+	mockGlobalMATLABManager := &orchestratormocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLABManager.AssertExpectations(t)
+
+	mockDirectory := &orchestratormocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockDebugServer := &orchestratormocks.MockDebugServer{}
+	defer mockDebugServer.AssertExpectations(t)
+
+	mockMetricsServer := &orchestratormocks.MockMetricsServer{}
+	defer mockMetricsServer.AssertExpectations(t)
+
+	mockInstanceLock := &orchestratormocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockEventRecorder := &orchestratormocks.MockEventRecorder{}
+	defer mockEventRecorder.AssertExpectations(t)
+
+	mockMemoryWatchdog := &orchestratormocks.MockMemoryWatchdog{}
+	defer mockMemoryWatchdog.AssertExpectations(t)
+
+	mockMATLABSupervisor := &orchestratormocks.MockMATLABSupervisor{}
+	defer mockMATLABSupervisor.AssertExpectations(t)
+
+	mockConfigReloader := &orchestratormocks.MockConfigReloader{}
+	defer mockConfigReloader.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockDirectory.EXPECT().
+		BaseDir().
+		Return("").
+		Once()
+
+	expectedPreviousPID := 4242
+	expectedError := assert.AnError
+
+	mockConfig.EXPECT().
+		DaemonMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ServeTransport().
+		Return(entities.TransportStdio).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		TryLockWithKill(true).
+		Return(true, nil).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		TakenOverPID().
+		Return(expectedPreviousPID, true).
+		Once()
+
+	mockSignalLayer.EXPECT().
+		InterruptSignalChan().
+		Return(getInterruptChannel()).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		Unlock().
+		Return(nil).
+		Once()
+
+	mockEventRecorder.EXPECT().
+		Record(entities.EventKindInstanceTakeover, mock.Anything, map[string]any{"previous-pid": expectedPreviousPID}).
+		Return().
+		Once()
+
+	mockEventRecorder.EXPECT().
+		Record(entities.EventKindServerStopping, mock.Anything, mock.Anything).
+		Return().
+		Once()
+
+	mockConfig.EXPECT().
+		RecordToLogger(mockLogger.AsMockArg()).
+		Return().
+		Once()
+
+	mockWatchdogClient.EXPECT().
+		Start().
+		Return(expectedError).
+		Once()
+
+	mockServer.EXPECT().
+		Drain().
+		Return(nil).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		RequestShutdown().
+		Return().
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		WaitForShutdownToComplete().
+		Return(nil).
+		Once()
+
+	mockWatchdogClient.EXPECT().
+		Stop().
+		Return(nil).
+		Once()
+
+	orchestratorInstance := orchestrator.New(
+		mockLifecycleSignaler,
+		mockConfig,
+		mockServer,
+		mockWatchdogClient,
+		mockDebugServer,
+		mockMetricsServer,
+		mockLoggerFactory,
+		mockSignalLayer,
+		mockGlobalMATLABManager,
+		mockDirectory,
+		mockInstanceLock,
+		mockEventRecorder,
+		mockMemoryWatchdog,
+		mockMATLABSupervisor,
+		mockConfigReloader,
+	)
+
+	// Act
+	err := orchestratorInstance.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Error should be the watchdog start error")
+	assert.Contains(t, mockLogger.InfoLogs(), "Took over from an existing MATLAB MCP Core Server instance")
 }
 
 func getInterruptChannel() chan os.Signal {
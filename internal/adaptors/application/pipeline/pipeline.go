@@ -0,0 +1,285 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	reportFolderPermissions = 0o755
+	reportFilePermissions   = 0o644
+)
+
+type Config interface {
+	PipelineScript() string
+	PipelineServerArgs() []string
+}
+
+type ServerLauncher interface {
+	Launch(ctx context.Context, args []string) (*mcp.ClientSession, error)
+}
+
+type OSLayer interface {
+	ReadFile(filePath string) ([]byte, error)
+	WriteFile(name string, data []byte, perm os.FileMode) error
+	MkdirAll(path string, perm os.FileMode) error
+	Stdout() io.Writer
+	Stderr() io.Writer
+}
+
+// Pipeline runs the tool calls of a script against a fresh MATLAB MCP Core Server and MATLAB session, without an AI
+// application, and reports their outcome as JUnit and JSON, so that the tools the AI application uses also run in CI.
+type Pipeline struct {
+	config         Config
+	serverLauncher ServerLauncher
+	osLayer        OSLayer
+}
+
+func New(
+	config Config,
+	serverLauncher ServerLauncher,
+	osLayer OSLayer,
+) *Pipeline {
+	return &Pipeline{
+		config:         config,
+		serverLauncher: serverLauncher,
+		osLayer:        osLayer,
+	}
+}
+
+// StartAndWaitForCompletion runs the script, writes its reports, and fails if any of its steps failed.
+// Failures are also written to stderr, as this mode has no log file.
+func (p *Pipeline) StartAndWaitForCompletion(ctx context.Context) error {
+	if err := p.run(ctx); err != nil {
+		_, _ = fmt.Fprintf(p.osLayer.Stderr(), "Run failed: %v\n", err)
+		return err
+	}
+	return nil
+}
+
+func (p *Pipeline) run(ctx context.Context) error {
+	scriptPath := p.config.PipelineScript()
+	stdout := p.osLayer.Stdout()
+
+	data, err := p.osLayer.ReadFile(scriptPath)
+	if err != nil {
+		return fmt.Errorf("failed to read the script: %w", err)
+	}
+
+	script, err := ParseScript(data)
+	if err != nil {
+		return fmt.Errorf("invalid script %s: %w", scriptPath, err)
+	}
+	if script.Name == "" {
+		script.Name = strings.TrimSuffix(filepath.Base(scriptPath), filepath.Ext(scriptPath))
+	}
+
+	if _, err := fmt.Fprintf(stdout, "Running %d steps of %s.\n", len(script.Steps), script.Name); err != nil {
+		return err
+	}
+
+	session, err := p.serverLauncher.Launch(ctx, p.config.PipelineServerArgs())
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = session.Close()
+	}()
+
+	folder := filepath.Dir(scriptPath)
+	figuresFolder := reportPath(folder, script.Reports.Figures)
+	if figuresFolder != "" {
+		if err := p.osLayer.MkdirAll(figuresFolder, reportFolderPermissions); err != nil {
+			return fmt.Errorf("failed to create the figures folder: %w", err)
+		}
+	}
+
+	start := time.Now()
+	results := make([]StepResult, 0, len(script.Steps))
+	stopped := false
+	for i, step := range script.Steps {
+		var result StepResult
+		if stopped {
+			result = StepResult{Name: step.Name, Tool: step.Tool, Status: StepStatusSkipped}
+		} else {
+			result = p.runStep(ctx, session, step, i+1, figuresFolder)
+			stopped = result.Status == StepStatusFailed && !step.ContinueOnFailure
+		}
+		results = append(results, result)
+
+		if _, err := fmt.Fprintf(stdout, "[%d/%d] %s\n", i+1, len(script.Steps), describe(result)); err != nil {
+			return err
+		}
+	}
+
+	runReport := newReport(script.Name, time.Since(start), results)
+	if err := p.writeReports(stdout, folder, script.Reports, runReport); err != nil {
+		return err
+	}
+
+	if runReport.Failures > 0 {
+		return fmt.Errorf("%d of %d steps failed", runReport.Failures, len(results))
+	}
+
+	_, err = fmt.Fprintf(stdout, "All %d steps passed.\n", len(results))
+	return err
+}
+
+// runStep calls the tool of a step, and decides whether the step passed from the outcome of the call and the
+// expectations of the step.
+func (p *Pipeline) runStep(ctx context.Context, session *mcp.ClientSession, step Step, number int, figuresFolder string) StepResult {
+	result := StepResult{Name: step.Name, Tool: step.Tool}
+
+	callCtx := ctx
+	if step.Timeout > 0 {
+		var cancel context.CancelFunc
+		callCtx, cancel = context.WithTimeout(ctx, step.Timeout)
+		defer cancel()
+	}
+
+	params := &mcp.CallToolParams{Name: step.Tool}
+	if len(step.Arguments) > 0 {
+		params.Arguments = step.Arguments
+	}
+
+	start := time.Now()
+	callResult, err := session.CallTool(callCtx, params)
+	result.Duration = time.Since(start)
+
+	failed := false
+	switch {
+	case err != nil:
+		failed = true
+		result.Output = err.Error()
+	default:
+		var images []*mcp.ImageContent
+		result.Output, images = splitContent(callResult.Content)
+		result.StructuredContent = callResult.StructuredContent
+		failed = callResult.IsError
+
+		if figuresFolder != "" {
+			result.Figures, err = p.writeFigures(figuresFolder, number, images)
+			if err != nil {
+				result.Status = StepStatusFailed
+				result.Failure = err.Error()
+				return result
+			}
+		}
+	}
+
+	switch {
+	case step.ExpectError && !failed:
+		result.Failure = "the call succeeded, but was expected to fail"
+	case !step.ExpectError && failed:
+		result.Failure = "the call failed"
+	case step.ExpectOutput != "" && !strings.Contains(result.Output, step.ExpectOutput):
+		result.Failure = fmt.Sprintf("the output does not contain %q", step.ExpectOutput)
+	}
+
+	result.Status = StepStatusPassed
+	if result.Failure != "" {
+		result.Status = StepStatusFailed
+	}
+	return result
+}
+
+func (p *Pipeline) writeFigures(folder string, number int, images []*mcp.ImageContent) ([]string, error) {
+	var paths []string
+	for i, image := range images {
+		path := filepath.Join(folder, fmt.Sprintf("step%d-figure%d%s", number, i+1, imageExtension(image.MIMEType)))
+		if err := p.osLayer.WriteFile(path, image.Data, reportFilePermissions); err != nil {
+			return nil, fmt.Errorf("failed to write the figure %s: %w", path, err)
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+func (p *Pipeline) writeReports(stdout io.Writer, folder string, reports Reports, runReport Report) error {
+	for _, report := range []struct {
+		kind   string
+		path   string
+		encode func(Report) ([]byte, error)
+	}{
+		{"JUnit", reportPath(folder, reports.JUnit), EncodeJUnit},
+		{"JSON", reportPath(folder, reports.JSON), EncodeJSON},
+	} {
+		if report.path == "" {
+			continue
+		}
+
+		data, err := report.encode(runReport)
+		if err != nil {
+			return fmt.Errorf("failed to encode the %s report: %w", report.kind, err)
+		}
+
+		if err := p.osLayer.MkdirAll(filepath.Dir(report.path), reportFolderPermissions); err != nil {
+			return fmt.Errorf("failed to create the folder of the %s report: %w", report.kind, err)
+		}
+
+		if err := p.osLayer.WriteFile(report.path, data, reportFilePermissions); err != nil {
+			return fmt.Errorf("failed to write the %s report: %w", report.kind, err)
+		}
+
+		if _, err := fmt.Fprintf(stdout, "Wrote the %s report to %s.\n", report.kind, report.path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func reportPath(folder string, path string) string {
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(folder, path)
+}
+
+// splitContent returns the text of the content, and its images.
+func splitContent(content []mcp.Content) (string, []*mcp.ImageContent) {
+	var text strings.Builder
+	var images []*mcp.ImageContent
+	for _, c := range content {
+		switch c := c.(type) {
+		case *mcp.TextContent:
+			text.WriteString(c.Text)
+		case *mcp.ImageContent:
+			images = append(images, c)
+		}
+	}
+	return text.String(), images
+}
+
+func imageExtension(mimeType string) string {
+	switch mimeType {
+	case "image/png":
+		return ".png"
+	case "image/jpeg":
+		return ".jpg"
+	case "image/svg+xml":
+		return ".svg"
+	default:
+		return ".bin"
+	}
+}
+
+func describe(result StepResult) string {
+	switch result.Status {
+	case StepStatusSkipped:
+		return fmt.Sprintf("%s (%s): skipped", result.Name, result.Tool)
+	case StepStatusFailed:
+		return fmt.Sprintf("%s (%s): failed in %s: %s", result.Name, result.Tool, result.Duration.Round(time.Millisecond), result.Failure)
+	default:
+		return fmt.Sprintf("%s (%s): passed in %s", result.Name, result.Tool, result.Duration.Round(time.Millisecond))
+	}
+}
@@ -0,0 +1,456 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package pipeline_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/pipeline"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/pipeline"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const scriptPath = "/home/user/ci/pipeline.yaml"
+
+var pngData = []byte{0x89, 'P', 'N', 'G'}
+
+// newServerSession connects to an MCP server whose evaluate_matlab_code tool echoes the code, fails when the code is
+// "error", and returns a figure when the code is "plot", with a run_matlab_test_file tool returning structured content.
+func newServerSession(t *testing.T) *mcp.ClientSession {
+	t.Helper()
+
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test-server"}, nil)
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "evaluate_matlab_code"}, func(ctx context.Context, req *mcp.CallToolRequest, input struct {
+		Code string `json:"code"`
+	}) (*mcp.CallToolResult, any, error) {
+		content := []mcp.Content{&mcp.TextContent{Text: input.Code}}
+		if input.Code == "plot" {
+			content = append(content, &mcp.ImageContent{MIMEType: "image/png", Data: pngData})
+		}
+		return &mcp.CallToolResult{IsError: input.Code == "error", Content: content}, nil, nil
+	})
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "run_matlab_test_file"}, func(ctx context.Context, req *mcp.CallToolRequest, input struct {
+		ScriptPath string `json:"script_path"`
+	}) (*mcp.CallToolResult, struct {
+		Passed int `json:"passed"`
+	}, error) {
+		return nil, struct {
+			Passed int `json:"passed"`
+		}{Passed: 3}, nil
+	})
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := mcpServer.Connect(t.Context(), serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = serverSession.Close() })
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	clientSession, err := client.Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+
+	return clientSession
+}
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockServerLauncher := &mocks.MockServerLauncher{}
+	defer mockServerLauncher.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	// Act
+	p := pipeline.New(mockConfig, mockServerLauncher, mockOSLayer)
+
+	// Assert
+	assert.NotNil(t, p)
+}
+
+func TestPipeline_StartAndWaitForCompletion_Passed(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockServerLauncher := &mocks.MockServerLauncher{}
+	defer mockServerLauncher.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	stdout := &bytes.Buffer{}
+	files := map[string][]byte{}
+	script := `
+name: nightly
+reports:
+  junit: reports/junit.xml
+  json: /home/user/reports/results.json
+  figures: reports/figures
+steps:
+  - name: Unit tests
+    tool: run_matlab_test_file
+    arguments:
+      script_path: /home/user/project/tests/testFoo.m
+  - name: Plot
+    tool: evaluate_matlab_code
+    arguments:
+      code: plot
+    expect_output: plot
+  - tool: evaluate_matlab_code
+    arguments:
+      code: error
+    expect_error: true
+`
+
+	mockConfig.EXPECT().
+		PipelineScript().
+		Return(scriptPath).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(stdout).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(scriptPath).
+		Return([]byte(script), nil).
+		Once()
+
+	mockConfig.EXPECT().
+		PipelineServerArgs().
+		Return([]string{"--matlab-root=/home/matlab"}).
+		Once()
+
+	mockServerLauncher.EXPECT().
+		Launch(t.Context(), []string{"--matlab-root=/home/matlab"}).
+		Return(newServerSession(t), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		MkdirAll("/home/user/ci/reports/figures", os.FileMode(0o755)).
+		Return(nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		MkdirAll("/home/user/ci/reports", os.FileMode(0o755)).
+		Return(nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		MkdirAll("/home/user/reports", os.FileMode(0o755)).
+		Return(nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		WriteFile(mock.Anything, mock.Anything, os.FileMode(0o644)).
+		RunAndReturn(func(name string, data []byte, _ os.FileMode) error {
+			files[name] = data
+			return nil
+		}).
+		Times(3)
+
+	p := pipeline.New(mockConfig, mockServerLauncher, mockOSLayer)
+
+	// Act
+	err := p.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "Running 3 steps of nightly.\n")
+	assert.Contains(t, stdout.String(), "[1/3] Unit tests (run_matlab_test_file): passed in ")
+	assert.Contains(t, stdout.String(), "[3/3] evaluate_matlab_code (evaluate_matlab_code): passed in ")
+	assert.Contains(t, stdout.String(), "Wrote the JUnit report to /home/user/ci/reports/junit.xml.\n")
+	assert.Contains(t, stdout.String(), "All 3 steps passed.\n")
+
+	assert.Equal(t, pngData, files["/home/user/ci/reports/figures/step2-figure1.png"])
+
+	var results struct {
+		Name   string `json:"name"`
+		Passed bool   `json:"passed"`
+		Steps  []struct {
+			Name              string   `json:"name"`
+			Status            string   `json:"status"`
+			Output            string   `json:"output"`
+			StructuredContent any      `json:"structuredContent"`
+			Figures           []string `json:"figures"`
+		} `json:"steps"`
+	}
+	require.NoError(t, json.Unmarshal(files["/home/user/reports/results.json"], &results))
+	assert.Equal(t, "nightly", results.Name)
+	assert.True(t, results.Passed)
+	require.Len(t, results.Steps, 3)
+	assert.Equal(t, map[string]any{"passed": 3.0}, results.Steps[0].StructuredContent)
+	assert.Equal(t, []string{"/home/user/ci/reports/figures/step2-figure1.png"}, results.Steps[1].Figures)
+	assert.Equal(t, "error", results.Steps[2].Output)
+
+	junit := string(files["/home/user/ci/reports/junit.xml"])
+	assert.Contains(t, junit, `<testsuites name="nightly" tests="3" failures="0" skipped="0"`)
+	assert.Contains(t, junit, `<testcase name="Unit tests" classname="run_matlab_test_file"`)
+}
+
+func TestPipeline_StartAndWaitForCompletion_Failed(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockServerLauncher := &mocks.MockServerLauncher{}
+	defer mockServerLauncher.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	var junit []byte
+	script := `
+reports:
+  junit: junit.xml
+steps:
+  - name: Expected output
+    tool: evaluate_matlab_code
+    arguments:
+      code: disp(1)
+    expect_output: "2"
+    continue_on_failure: true
+  - name: Failing call
+    tool: evaluate_matlab_code
+    arguments:
+      code: error
+  - name: After the failure
+    tool: evaluate_matlab_code
+    arguments:
+      code: disp(3)
+`
+
+	mockConfig.EXPECT().
+		PipelineScript().
+		Return(scriptPath).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(stdout).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(scriptPath).
+		Return([]byte(script), nil).
+		Once()
+
+	mockConfig.EXPECT().
+		PipelineServerArgs().
+		Return([]string{"--matlab-root=/home/matlab"}).
+		Once()
+
+	mockServerLauncher.EXPECT().
+		Launch(t.Context(), []string{"--matlab-root=/home/matlab"}).
+		Return(newServerSession(t), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		MkdirAll("/home/user/ci", os.FileMode(0o755)).
+		Return(nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		WriteFile("/home/user/ci/junit.xml", mock.Anything, os.FileMode(0o644)).
+		RunAndReturn(func(_ string, data []byte, _ os.FileMode) error {
+			junit = data
+			return nil
+		}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stderr().
+		Return(stderr).
+		Once()
+
+	p := pipeline.New(mockConfig, mockServerLauncher, mockOSLayer)
+
+	// Act
+	err := p.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.EqualError(t, err, "2 of 3 steps failed")
+	assert.Equal(t, "Run failed: 2 of 3 steps failed\n", stderr.String())
+	assert.Contains(t, stdout.String(), "Running 3 steps of pipeline.\n", "The script should be named after its file")
+	assert.Contains(t, stdout.String(), `: the output does not contain "2"`)
+	assert.Contains(t, stdout.String(), "[2/3] Failing call (evaluate_matlab_code): failed in ")
+	assert.Contains(t, stdout.String(), "[3/3] After the failure (evaluate_matlab_code): skipped\n")
+
+	assert.Contains(t, string(junit), `<testsuites name="pipeline" tests="3" failures="2" skipped="1"`)
+	assert.Contains(t, string(junit), `<failure message="the call failed">error</failure>`)
+	assert.Contains(t, string(junit), `<skipped></skipped>`)
+}
+
+func TestPipeline_StartAndWaitForCompletion_UnknownTool(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockServerLauncher := &mocks.MockServerLauncher{}
+	defer mockServerLauncher.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	stdout := &bytes.Buffer{}
+	script := `
+steps:
+  - tool: delete_everything
+`
+
+	mockConfig.EXPECT().
+		PipelineScript().
+		Return(scriptPath).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(stdout).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(scriptPath).
+		Return([]byte(script), nil).
+		Once()
+
+	mockConfig.EXPECT().
+		PipelineServerArgs().
+		Return([]string{"--matlab-root=/home/matlab"}).
+		Once()
+
+	mockServerLauncher.EXPECT().
+		Launch(t.Context(), []string{"--matlab-root=/home/matlab"}).
+		Return(newServerSession(t), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stderr().
+		Return(&bytes.Buffer{}).
+		Once()
+
+	p := pipeline.New(mockConfig, mockServerLauncher, mockOSLayer)
+
+	// Act
+	err := p.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.EqualError(t, err, "1 of 1 steps failed")
+	assert.Contains(t, stdout.String(), "[1/1] delete_everything (delete_everything): failed in ")
+}
+
+func TestPipeline_StartAndWaitForCompletion_InvalidScript(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockServerLauncher := &mocks.MockServerLauncher{}
+	defer mockServerLauncher.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	stderr := &bytes.Buffer{}
+	script := `
+steps:
+  - tool: evaluate_matlab_code
+    argumnets:
+      code: disp(1)
+`
+
+	mockConfig.EXPECT().
+		PipelineScript().
+		Return(scriptPath).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(&bytes.Buffer{}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(scriptPath).
+		Return([]byte(script), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stderr().
+		Return(stderr).
+		Once()
+
+	p := pipeline.New(mockConfig, mockServerLauncher, mockOSLayer)
+
+	// Act
+	err := p.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.ErrorContains(t, err, "invalid script /home/user/ci/pipeline.yaml")
+	require.ErrorContains(t, err, "field argumnets not found")
+	assert.Contains(t, stderr.String(), "Run failed: invalid script /home/user/ci/pipeline.yaml")
+}
+
+func TestPipeline_StartAndWaitForCompletion_LaunchError(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockServerLauncher := &mocks.MockServerLauncher{}
+	defer mockServerLauncher.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	script := `
+steps:
+  - tool: evaluate_matlab_code
+`
+
+	mockConfig.EXPECT().
+		PipelineScript().
+		Return(scriptPath).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(&bytes.Buffer{}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(scriptPath).
+		Return([]byte(script), nil).
+		Once()
+
+	mockConfig.EXPECT().
+		PipelineServerArgs().
+		Return([]string{}).
+		Once()
+
+	mockServerLauncher.EXPECT().
+		Launch(t.Context(), []string{}).
+		Return(nil, assert.AnError).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stderr().
+		Return(&bytes.Buffer{}).
+		Once()
+
+	p := pipeline.New(mockConfig, mockServerLauncher, mockOSLayer)
+
+	// Act
+	err := p.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
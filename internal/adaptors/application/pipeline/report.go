@@ -0,0 +1,178 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package pipeline
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"strconv"
+	"time"
+)
+
+type StepStatus string
+
+const (
+	StepStatusPassed  StepStatus = "passed"
+	StepStatusFailed  StepStatus = "failed"
+	StepStatusSkipped StepStatus = "skipped"
+)
+
+// StepResult is the outcome of a step of a script.
+type StepResult struct {
+	Name              string
+	Tool              string
+	Status            StepStatus
+	Duration          time.Duration
+	Output            string
+	Failure           string
+	StructuredContent any
+	Figures           []string
+}
+
+// Report is the outcome of a run of a script.
+type Report struct {
+	Name     string
+	Duration time.Duration
+	Failures int
+	Skipped  int
+	Steps    []StepResult
+}
+
+func newReport(name string, duration time.Duration, steps []StepResult) Report {
+	report := Report{Name: name, Duration: duration, Steps: steps}
+	for _, step := range steps {
+		switch step.Status {
+		case StepStatusFailed:
+			report.Failures++
+		case StepStatusSkipped:
+			report.Skipped++
+		}
+	}
+	return report
+}
+
+type jsonReport struct {
+	Name            string           `json:"name"`
+	Passed          bool             `json:"passed"`
+	DurationSeconds float64          `json:"durationSeconds"`
+	Steps           []jsonStepResult `json:"steps"`
+}
+
+type jsonStepResult struct {
+	Name              string     `json:"name"`
+	Tool              string     `json:"tool"`
+	Status            StepStatus `json:"status"`
+	DurationSeconds   float64    `json:"durationSeconds"`
+	Output            string     `json:"output,omitempty"`
+	Failure           string     `json:"failure,omitempty"`
+	StructuredContent any        `json:"structuredContent,omitempty"`
+	Figures           []string   `json:"figures,omitempty"`
+}
+
+// EncodeJSON encodes a report as JSON, with the output and structured content of every step.
+func EncodeJSON(report Report) ([]byte, error) {
+	steps := make([]jsonStepResult, 0, len(report.Steps))
+	for _, step := range report.Steps {
+		steps = append(steps, jsonStepResult{
+			Name:              step.Name,
+			Tool:              step.Tool,
+			Status:            step.Status,
+			DurationSeconds:   step.Duration.Seconds(),
+			Output:            step.Output,
+			Failure:           step.Failure,
+			StructuredContent: step.StructuredContent,
+			Figures:           step.Figures,
+		})
+	}
+
+	data, err := json.MarshalIndent(jsonReport{
+		Name:            report.Name,
+		Passed:          report.Failures == 0,
+		DurationSeconds: report.Duration.Seconds(),
+		Steps:           steps,
+	}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Time      string          `xml:"time,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Skipped   *struct{}     `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// EncodeJUnit encodes a report as JUnit XML, with a test suite for the script and a test case for every step, as CI
+// systems show them.
+func EncodeJUnit(report Report) ([]byte, error) {
+	testCases := make([]junitTestCase, 0, len(report.Steps))
+	for _, step := range report.Steps {
+		testCase := junitTestCase{
+			Name:      step.Name,
+			ClassName: step.Tool,
+			Time:      formatSeconds(step.Duration),
+			SystemOut: step.Output,
+		}
+		switch step.Status {
+		case StepStatusFailed:
+			testCase.Failure = &junitFailure{Message: step.Failure, Text: step.Output}
+			testCase.SystemOut = ""
+		case StepStatusSkipped:
+			testCase.Skipped = &struct{}{}
+		}
+		testCases = append(testCases, testCase)
+	}
+
+	duration := formatSeconds(report.Duration)
+	data, err := xml.MarshalIndent(junitTestSuites{
+		Name:     report.Name,
+		Tests:    len(report.Steps),
+		Failures: report.Failures,
+		Skipped:  report.Skipped,
+		Time:     duration,
+		Suites: []junitTestSuite{{
+			Name:      report.Name,
+			Tests:     len(report.Steps),
+			Failures:  report.Failures,
+			Skipped:   report.Skipped,
+			Time:      duration,
+			TestCases: testCases,
+		}},
+	}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), append(data, '\n')...), nil
+}
+
+func formatSeconds(duration time.Duration) string {
+	return strconv.FormatFloat(duration.Seconds(), 'f', 3, 64)
+}
@@ -0,0 +1,75 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package pipeline
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Script is a declared sequence of tool calls, read from a YAML file, or a JSON file as JSON is valid YAML.
+type Script struct {
+	Name    string  `yaml:"name"`
+	Reports Reports `yaml:"reports"`
+	Steps   []Step  `yaml:"steps"`
+}
+
+// Reports are the paths the reports of a run are written to, relative to the folder of the script unless absolute.
+// No report is written for an empty path.
+type Reports struct {
+	JUnit   string `yaml:"junit"`
+	JSON    string `yaml:"json"`
+	Figures string `yaml:"figures"`
+}
+
+// Step is a tool call of a script.
+type Step struct {
+	Name      string         `yaml:"name"`
+	Tool      string         `yaml:"tool"`
+	Arguments map[string]any `yaml:"arguments"`
+	// ExpectOutput is a text the output of the call must contain for the step to pass.
+	ExpectOutput string `yaml:"expect_output"`
+	// ExpectError makes the step pass when the call fails, and fail when it succeeds.
+	ExpectError bool `yaml:"expect_error"`
+	// ContinueOnFailure runs the next steps when this step fails, rather than skipping them.
+	ContinueOnFailure bool          `yaml:"continue_on_failure"`
+	Timeout           time.Duration `yaml:"timeout"`
+}
+
+// ParseScript parses a script, and rejects the fields it does not know, so that a misspelled field does not go unnoticed.
+func ParseScript(data []byte) (Script, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	var script Script
+	if err := decoder.Decode(&script); err != nil {
+		if errors.Is(err, io.EOF) {
+			return Script{}, errors.New("the script is empty")
+		}
+		return Script{}, err
+	}
+
+	if len(script.Steps) == 0 {
+		return Script{}, errors.New("the script has no steps")
+	}
+
+	for i := range script.Steps {
+		step := &script.Steps[i]
+		if step.Tool == "" {
+			return Script{}, fmt.Errorf("step %d has no tool", i+1)
+		}
+		if step.Timeout < 0 {
+			return Script{}, fmt.Errorf("step %d has a negative timeout", i+1)
+		}
+		if step.Name == "" {
+			step.Name = step.Tool
+		}
+	}
+
+	return script, nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package pipeline_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/pipeline"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseScript_HappyPath(t *testing.T) {
+	// Arrange
+	data := []byte(`
+name: nightly
+reports:
+  junit: reports/junit.xml
+steps:
+  - name: Coverage
+    tool: evaluate_matlab_code
+    arguments:
+      code: disp(1)
+      project_path: /home/user/project
+    timeout: 10m
+  - tool: run_matlab_test_file
+`)
+
+	// Act
+	script, err := pipeline.ParseScript(data)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, pipeline.Script{
+		Name:    "nightly",
+		Reports: pipeline.Reports{JUnit: "reports/junit.xml"},
+		Steps: []pipeline.Step{
+			{
+				Name:      "Coverage",
+				Tool:      "evaluate_matlab_code",
+				Arguments: map[string]any{"code": "disp(1)", "project_path": "/home/user/project"},
+				Timeout:   10 * time.Minute,
+			},
+			{
+				Name: "run_matlab_test_file",
+				Tool: "run_matlab_test_file",
+			},
+		},
+	}, script)
+}
+
+func TestParseScript_JSON(t *testing.T) {
+	// Arrange
+	data := []byte(`{"steps": [{"tool": "evaluate_matlab_code", "arguments": {"code": "x = 1"}}]}`)
+
+	// Act
+	script, err := pipeline.ParseScript(data)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, script.Steps, 1)
+	assert.Equal(t, map[string]any{"code": "x = 1"}, script.Steps[0].Arguments)
+}
+
+func TestParseScript_Invalid(t *testing.T) {
+	testConfigs := []struct {
+		name          string
+		data          string
+		expectedError string
+	}{
+		{
+			name:          "empty",
+			data:          "",
+			expectedError: "the script is empty",
+		},
+		{
+			name:          "no steps",
+			data:          "name: nightly\n",
+			expectedError: "the script has no steps",
+		},
+		{
+			name:          "step without tool",
+			data:          "steps:\n  - name: Tests\n",
+			expectedError: "step 1 has no tool",
+		},
+		{
+			name:          "negative timeout",
+			data:          "steps:\n  - tool: evaluate_matlab_code\n    timeout: -1s\n",
+			expectedError: "step 1 has a negative timeout",
+		},
+		{
+			name:          "unknown field",
+			data:          "stpes: []\n",
+			expectedError: "field stpes not found",
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Act
+			_, err := pipeline.ParseScript([]byte(testConfig.data))
+
+			// Assert
+			require.ErrorContains(t, err, testConfig.expectedError)
+		})
+	}
+}
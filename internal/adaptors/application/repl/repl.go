@@ -0,0 +1,299 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package repl
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const prompt = "> "
+
+// maxLineSize is the size of the longest line read, as the arguments of a call are written on a single line.
+const maxLineSize = 1 << 20
+
+const helpText = `Commands:
+  tools                   List the tools of the server.
+  describe <tool>         Print the description and the input schema of a tool.
+  call <tool> [arguments] Call a tool, with its arguments as a JSON object on the same line.
+  <tool> [arguments]      Same as call.
+  last                    Print the last result as JSON, as the server returned it.
+  help                    Print this help.
+  exit                    Stop the server, and exit.
+`
+
+type Config interface {
+	REPLServerArgs() []string
+}
+
+type ServerLauncher interface {
+	Launch(ctx context.Context, args []string) (*mcp.ClientSession, error)
+}
+
+type OSLayer interface {
+	Stdin() io.Reader
+	Stdout() io.Writer
+	Stderr() io.Writer
+}
+
+// REPL starts a new MATLAB MCP Core Server, and reads commands from the standard input to list its tools and call them,
+// so that developers can debug the behavior of the tools without an AI application.
+type REPL struct {
+	config         Config
+	serverLauncher ServerLauncher
+	osLayer        OSLayer
+}
+
+func New(
+	config Config,
+	serverLauncher ServerLauncher,
+	osLayer OSLayer,
+) *REPL {
+	return &REPL{
+		config:         config,
+		serverLauncher: serverLauncher,
+		osLayer:        osLayer,
+	}
+}
+
+// StartAndWaitForCompletion runs commands until the standard input is closed, or the exit command.
+// Failures of the commands are printed, and do not end the session.
+func (r *REPL) StartAndWaitForCompletion(ctx context.Context) error {
+	if err := r.run(ctx); err != nil {
+		_, _ = fmt.Fprintf(r.osLayer.Stderr(), "REPL failed: %v\n", err)
+		return err
+	}
+	return nil
+}
+
+func (r *REPL) run(ctx context.Context) error {
+	stdout := r.osLayer.Stdout()
+
+	session, err := r.serverLauncher.Launch(ctx, r.config.REPLServerArgs())
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = session.Close()
+	}()
+
+	serverInfo := session.InitializeResult().ServerInfo
+	if _, err := fmt.Fprintf(stdout, "Connected to %s %s. Type help for the commands.\n", serverInfo.Name, serverInfo.Version); err != nil {
+		return err
+	}
+
+	state := &sessionState{session: session, stdout: stdout}
+
+	scanner := bufio.NewScanner(r.osLayer.Stdin())
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	for {
+		if _, err := io.WriteString(stdout, prompt); err != nil {
+			return err
+		}
+		if !scanner.Scan() {
+			break
+		}
+
+		exit, err := state.execute(ctx, strings.TrimSpace(scanner.Text()))
+		if err != nil {
+			if _, err := fmt.Fprintf(stdout, "Error: %v\n", err); err != nil {
+				return err
+			}
+		}
+		if exit {
+			return nil
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read the commands: %w", err)
+	}
+	_, err = fmt.Fprintln(stdout)
+	return err
+}
+
+// sessionState is the connection to the server, with the tools it listed and the last result it returned.
+type sessionState struct {
+	session    *mcp.ClientSession
+	stdout     io.Writer
+	tools      map[string]*mcp.Tool
+	lastResult *mcp.CallToolResult
+}
+
+// execute runs a command line, and returns true when the session should end.
+func (s *sessionState) execute(ctx context.Context, line string) (bool, error) {
+	command, rest, _ := strings.Cut(line, " ")
+	rest = strings.TrimSpace(rest)
+
+	switch command {
+	case "":
+		return false, nil
+	case "exit", "quit":
+		return true, nil
+	case "help":
+		_, err := io.WriteString(s.stdout, helpText)
+		return false, err
+	case "tools":
+		return false, s.listTools(ctx)
+	case "describe":
+		return false, s.describe(ctx, rest)
+	case "call":
+		name, arguments, _ := strings.Cut(rest, " ")
+		return false, s.call(ctx, name, strings.TrimSpace(arguments))
+	case "last":
+		if s.lastResult == nil {
+			return false, fmt.Errorf("no tool was called yet")
+		}
+		return false, s.printJSON(s.lastResult)
+	default:
+		tools, err := s.loadTools(ctx)
+		if err != nil {
+			return false, err
+		}
+		if _, ok := tools[command]; !ok {
+			return false, fmt.Errorf("unknown command or tool: %s, type help for the commands", command)
+		}
+		return false, s.call(ctx, command, rest)
+	}
+}
+
+// loadTools lists the tools of the server the first time they are needed.
+func (s *sessionState) loadTools(ctx context.Context) (map[string]*mcp.Tool, error) {
+	if s.tools != nil {
+		return s.tools, nil
+	}
+
+	tools := map[string]*mcp.Tool{}
+	for tool, err := range s.session.Tools(ctx, nil) {
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the tools: %w", err)
+		}
+		tools[tool.Name] = tool
+	}
+	s.tools = tools
+	return tools, nil
+}
+
+func (s *sessionState) listTools(ctx context.Context) error {
+	tools, err := s.loadTools(ctx)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(tools))
+	width := 0
+	for name := range tools {
+		names = append(names, name)
+		width = max(width, len(name))
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		summary, _, _ := strings.Cut(strings.TrimSpace(tools[name].Description), "\n")
+		if _, err := fmt.Fprintf(s.stdout, "%-*s  %s\n", width, name, summary); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *sessionState) describe(ctx context.Context, name string) error {
+	if name == "" {
+		return fmt.Errorf("describe needs the name of a tool")
+	}
+
+	tools, err := s.loadTools(ctx)
+	if err != nil {
+		return err
+	}
+	tool, ok := tools[name]
+	if !ok {
+		return fmt.Errorf("unknown tool: %s", name)
+	}
+
+	if _, err := fmt.Fprintf(s.stdout, "%s\n\n%s\n\nInput schema:\n", tool.Name, strings.TrimSpace(tool.Description)); err != nil {
+		return err
+	}
+	return s.printJSON(tool.InputSchema)
+}
+
+// call calls a tool with arguments, a JSON object, and prints its result. A result flagged as an error is printed as
+// any other result, as the tools report the failures of MATLAB code that way.
+func (s *sessionState) call(ctx context.Context, name string, arguments string) error {
+	if name == "" {
+		return fmt.Errorf("call needs the name of a tool")
+	}
+
+	params := &mcp.CallToolParams{Name: name}
+	if arguments != "" {
+		var parsed map[string]any
+		if err := json.Unmarshal([]byte(arguments), &parsed); err != nil {
+			return fmt.Errorf("the arguments must be a JSON object: %w", err)
+		}
+		params.Arguments = parsed
+	}
+
+	start := time.Now()
+	result, err := s.session.CallTool(ctx, params)
+	if err != nil {
+		return err
+	}
+	elapsed := time.Since(start)
+	s.lastResult = result
+
+	if result.IsError {
+		if _, err := fmt.Fprintln(s.stdout, "The tool reported an error:"); err != nil {
+			return err
+		}
+	}
+
+	for _, content := range result.Content {
+		var err error
+		switch content := content.(type) {
+		case *mcp.TextContent:
+			_, err = fmt.Fprintln(s.stdout, strings.TrimRight(content.Text, "\n"))
+		case *mcp.ImageContent:
+			_, err = fmt.Fprintf(s.stdout, "[image: %s, %d bytes]\n", content.MIMEType, len(content.Data))
+		case *mcp.AudioContent:
+			_, err = fmt.Fprintf(s.stdout, "[audio: %s, %d bytes]\n", content.MIMEType, len(content.Data))
+		case *mcp.ResourceLink:
+			_, err = fmt.Fprintf(s.stdout, "[resource: %s]\n", content.URI)
+		case *mcp.EmbeddedResource:
+			_, err = fmt.Fprintf(s.stdout, "[embedded resource: %s]\n", content.Resource.URI)
+		default:
+			_, err = fmt.Fprintf(s.stdout, "[%T]\n", content)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if result.StructuredContent != nil {
+		if _, err := fmt.Fprintln(s.stdout, "Structured content:"); err != nil {
+			return err
+		}
+		if err := s.printJSON(result.StructuredContent); err != nil {
+			return err
+		}
+	}
+
+	_, err = fmt.Fprintf(s.stdout, "(%s in %s)\n", name, elapsed.Round(time.Millisecond))
+	return err
+}
+
+func (s *sessionState) printJSON(value any) error {
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(s.stdout, string(data))
+	return err
+}
@@ -0,0 +1,197 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package repl_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/repl"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/repl"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newServerSession connects to an MCP server whose evaluate_matlab_code tool echoes the code, and fails when the code is "error".
+func newServerSession(t *testing.T) *mcp.ClientSession {
+	t.Helper()
+
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "v1.2.3"}, nil)
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "evaluate_matlab_code", Description: "Evaluates MATLAB code.\nReturns the output."}, func(ctx context.Context, req *mcp.CallToolRequest, input struct {
+		Code string `json:"code"`
+	}) (*mcp.CallToolResult, any, error) {
+		return &mcp.CallToolResult{
+			IsError: input.Code == "error",
+			Content: []mcp.Content{&mcp.TextContent{Text: input.Code}},
+		}, nil, nil
+	})
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := mcpServer.Connect(t.Context(), serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = serverSession.Close() })
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	clientSession, err := client.Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+
+	return clientSession
+}
+
+func TestREPL_StartAndWaitForCompletion_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockServerLauncher := &mocks.MockServerLauncher{}
+	defer mockServerLauncher.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	ctx := t.Context()
+	serverArgs := []string{"--matlab-root=/home/matlab"}
+	stdin := strings.NewReader(strings.Join([]string{
+		"tools",
+		"describe evaluate_matlab_code",
+		`call evaluate_matlab_code {"code": "x = 1"}`,
+		`evaluate_matlab_code {"code": "error"}`,
+		"last",
+		"evaluate_matlab_code {not json",
+		"plot",
+		"exit",
+		"tools",
+	}, "\n"))
+	stdout := &bytes.Buffer{}
+
+	mockConfig.EXPECT().
+		REPLServerArgs().
+		Return(serverArgs).
+		Once()
+
+	mockServerLauncher.EXPECT().
+		Launch(ctx, serverArgs).
+		Return(newServerSession(t), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdin().
+		Return(stdin).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(stdout).
+		Once()
+
+	replMode := repl.New(mockConfig, mockServerLauncher, mockOSLayer)
+
+	// Act
+	err := replMode.StartAndWaitForCompletion(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	output := stdout.String()
+	assert.Contains(t, output, "Connected to test-server v1.2.3.")
+	assert.Contains(t, output, "> evaluate_matlab_code  Evaluates MATLAB code.\n")
+	assert.Contains(t, output, "evaluate_matlab_code\n\nEvaluates MATLAB code.\nReturns the output.\n\nInput schema:\n")
+	assert.Contains(t, output, "> x = 1\n(evaluate_matlab_code in ")
+	assert.Contains(t, output, "> The tool reported an error:\nerror\n")
+	assert.Contains(t, output, `"isError": true`)
+	assert.Contains(t, output, "Error: the arguments must be a JSON object")
+	assert.Contains(t, output, "Error: unknown command or tool: plot")
+	assert.Equal(t, 1, strings.Count(output, "Evaluates MATLAB code.\n"+"> "), "the commands after exit should not run")
+}
+
+func TestREPL_StartAndWaitForCompletion_EndOfInput(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockServerLauncher := &mocks.MockServerLauncher{}
+	defer mockServerLauncher.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	ctx := t.Context()
+	stdout := &bytes.Buffer{}
+
+	mockConfig.EXPECT().
+		REPLServerArgs().
+		Return([]string{}).
+		Once()
+
+	mockServerLauncher.EXPECT().
+		Launch(ctx, []string{}).
+		Return(newServerSession(t), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdin().
+		Return(strings.NewReader("last\n")).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(stdout).
+		Once()
+
+	replMode := repl.New(mockConfig, mockServerLauncher, mockOSLayer)
+
+	// Act
+	err := replMode.StartAndWaitForCompletion(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "> Error: no tool was called yet\n> \n")
+}
+
+func TestREPL_StartAndWaitForCompletion_LaunchError(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockServerLauncher := &mocks.MockServerLauncher{}
+	defer mockServerLauncher.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	ctx := t.Context()
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+
+	mockConfig.EXPECT().
+		REPLServerArgs().
+		Return([]string{}).
+		Once()
+
+	mockServerLauncher.EXPECT().
+		Launch(ctx, []string{}).
+		Return(nil, assert.AnError).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(stdout).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stderr().
+		Return(stderr).
+		Once()
+
+	replMode := repl.New(mockConfig, mockServerLauncher, mockOSLayer)
+
+	// Act
+	err := replMode.StartAndWaitForCompletion(ctx)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Contains(t, stderr.String(), "REPL failed")
+	assert.Empty(t, stdout.String())
+}
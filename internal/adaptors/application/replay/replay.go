@@ -0,0 +1,213 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package replay
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/sessionrecording"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type Config interface {
+	ReplayRecording() string
+	ReplayServerArgs() []string
+}
+
+type RecordingReader interface {
+	Read(path string) ([]sessionrecording.Entry, error)
+}
+
+type ServerLauncher interface {
+	Launch(ctx context.Context, args []string) (*mcp.ClientSession, error)
+}
+
+type OSLayer interface {
+	Stdout() io.Writer
+	Stderr() io.Writer
+}
+
+// Replay re-runs the tool calls of a recording against a fresh MATLAB MCP Core Server and MATLAB session,
+// and reports the calls whose results differ from the recorded ones, to verify that an analysis is reproducible.
+type Replay struct {
+	config          Config
+	recordingReader RecordingReader
+	serverLauncher  ServerLauncher
+	osLayer         OSLayer
+}
+
+func New(
+	config Config,
+	recordingReader RecordingReader,
+	serverLauncher ServerLauncher,
+	osLayer OSLayer,
+) *Replay {
+	return &Replay{
+		config:          config,
+		recordingReader: recordingReader,
+		serverLauncher:  serverLauncher,
+		osLayer:         osLayer,
+	}
+}
+
+// StartAndWaitForCompletion replays the recording, and fails if it was modified, or if any of its tool calls was not reproduced.
+// Failures are also written to stderr, as this mode has no log file.
+func (r *Replay) StartAndWaitForCompletion(ctx context.Context) error {
+	if err := r.replay(ctx); err != nil {
+		_, _ = fmt.Fprintf(r.osLayer.Stderr(), "Replay failed: %v\n", err)
+		return err
+	}
+	return nil
+}
+
+func (r *Replay) replay(ctx context.Context) error {
+	path := r.config.ReplayRecording()
+	stdout := r.osLayer.Stdout()
+
+	entries, err := r.recordingReader.Read(path)
+	if err != nil {
+		return err
+	}
+
+	var toolCalls []sessionrecording.Entry
+	for _, entry := range entries {
+		if entry.Kind == sessionrecording.EntryKindToolCall {
+			toolCalls = append(toolCalls, entry)
+		}
+	}
+
+	start := entries[0]
+	if _, err := fmt.Fprintf(stdout, "Verified recording %s: %d tool calls recorded by %s on %s.\n", path, len(toolCalls), start.Version, start.Time.Format(time.RFC3339)); err != nil {
+		return err
+	}
+
+	if len(toolCalls) == 0 {
+		return nil
+	}
+
+	session, err := r.serverLauncher.Launch(ctx, r.config.ReplayServerArgs())
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = session.Close()
+	}()
+
+	notReproduced := 0
+	for i, entry := range toolCalls {
+		outcome := replayToolCall(ctx, session, entry)
+		if outcome != "" {
+			notReproduced++
+		} else {
+			outcome = "reproduced"
+		}
+
+		if _, err := fmt.Fprintf(stdout, "[%d/%d] %s: %s\n", i+1, len(toolCalls), entry.Tool, outcome); err != nil {
+			return err
+		}
+	}
+
+	if notReproduced > 0 {
+		return fmt.Errorf("%d of %d tool calls were not reproduced", notReproduced, len(toolCalls))
+	}
+
+	_, err = fmt.Fprintf(stdout, "All %d tool calls were reproduced.\n", len(toolCalls))
+	return err
+}
+
+// replayToolCall runs the recorded call again, and describes how its outcome differs from the recorded one, or returns empty if it does not.
+func replayToolCall(ctx context.Context, session *mcp.ClientSession, entry sessionrecording.Entry) string {
+	params := &mcp.CallToolParams{Name: entry.Tool}
+	if len(entry.Arguments) > 0 {
+		params.Arguments = entry.Arguments
+	}
+
+	result, err := session.CallTool(ctx, params)
+	if entry.Error != "" {
+		if err == nil {
+			return fmt.Sprintf("differs: the recorded call failed with %q, the replayed call succeeded", entry.Error)
+		}
+		return ""
+	}
+	if err != nil {
+		return fmt.Sprintf("failed: %v", err)
+	}
+
+	var recorded mcp.CallToolResult
+	if err := json.Unmarshal(entry.Result, &recorded); err != nil {
+		return fmt.Sprintf("failed: the recorded result cannot be read: %v", err)
+	}
+
+	return compareResults(&recorded, result)
+}
+
+// compareResults compares the text, the structured content and the number of images of two results.
+// Images are not compared pixel by pixel, as the rendering of figures depends on the machine.
+func compareResults(recorded, replayed *mcp.CallToolResult) string {
+	if recorded.IsError != replayed.IsError {
+		return fmt.Sprintf("differs: the recorded call failed: %t, the replayed call failed: %t", recorded.IsError, replayed.IsError)
+	}
+
+	recordedText, recordedImages := summarizeContent(recorded.Content)
+	replayedText, replayedImages := summarizeContent(replayed.Content)
+
+	if recordedText != replayedText {
+		return fmt.Sprintf("differs: the text output changed from %q to %q", truncate(recordedText), truncate(replayedText))
+	}
+
+	if recordedImages != replayedImages {
+		return fmt.Sprintf("differs: the recorded call returned %d images, the replayed call returned %d", recordedImages, replayedImages)
+	}
+
+	if !sameJSON(recorded.StructuredContent, replayed.StructuredContent) {
+		return "differs: the structured content changed"
+	}
+
+	return ""
+}
+
+func summarizeContent(content []mcp.Content) (string, int) {
+	var text strings.Builder
+	images := 0
+	for _, c := range content {
+		switch c := c.(type) {
+		case *mcp.TextContent:
+			text.WriteString(c.Text)
+		case *mcp.ImageContent:
+			images++
+		}
+	}
+	return text.String(), images
+}
+
+func sameJSON(a, b any) bool {
+	aData, aErr := json.Marshal(a)
+	bData, bErr := json.Marshal(b)
+	if aErr != nil || bErr != nil {
+		return false
+	}
+
+	var aValue, bValue any
+	if json.Unmarshal(aData, &aValue) != nil || json.Unmarshal(bData, &bValue) != nil {
+		return bytes.Equal(aData, bData)
+	}
+
+	aNormalized, _ := json.Marshal(aValue)
+	bNormalized, _ := json.Marshal(bValue)
+	return bytes.Equal(aNormalized, bNormalized)
+}
+
+func truncate(text string) string {
+	const maxLength = 200
+	runes := []rune(text)
+	if len(runes) <= maxLength {
+		return text
+	}
+	return string(runes[:maxLength]) + "..."
+}
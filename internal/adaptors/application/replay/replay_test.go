@@ -0,0 +1,406 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package replay_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/replay"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/sessionrecording"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/replay"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const recordingPath = "/home/user/recordings/recording.jsonl"
+
+// newServerSession connects to an MCP server whose evaluate_matlab_code tool echoes the code, and has no other tool.
+func newServerSession(t *testing.T) *mcp.ClientSession {
+	t.Helper()
+
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test-server"}, nil)
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "evaluate_matlab_code"}, func(ctx context.Context, req *mcp.CallToolRequest, input struct {
+		Code string `json:"code"`
+	}) (*mcp.CallToolResult, any, error) {
+		return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: input.Code}}}, nil, nil
+	})
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := mcpServer.Connect(t.Context(), serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = serverSession.Close() })
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	clientSession, err := client.Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+
+	return clientSession
+}
+
+func toolCallEntry(t *testing.T, code string, output string) sessionrecording.Entry {
+	t.Helper()
+
+	arguments, err := json.Marshal(map[string]string{"code": code})
+	require.NoError(t, err)
+
+	result, err := json.Marshal(&mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: output}}})
+	require.NoError(t, err)
+
+	return sessionrecording.Entry{
+		Kind:      sessionrecording.EntryKindToolCall,
+		Tool:      "evaluate_matlab_code",
+		Arguments: arguments,
+		Result:    result,
+	}
+}
+
+func startEntry() sessionrecording.Entry {
+	return sessionrecording.Entry{
+		Kind:    sessionrecording.EntryKindStart,
+		Version: "matlab-mcp-core-server v0.1.0",
+		Time:    time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockRecordingReader := &mocks.MockRecordingReader{}
+	defer mockRecordingReader.AssertExpectations(t)
+
+	mockServerLauncher := &mocks.MockServerLauncher{}
+	defer mockServerLauncher.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	// Act
+	r := replay.New(mockConfig, mockRecordingReader, mockServerLauncher, mockOSLayer)
+
+	// Assert
+	assert.NotNil(t, r)
+}
+
+func TestReplay_StartAndWaitForCompletion_Reproduced(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockRecordingReader := &mocks.MockRecordingReader{}
+	defer mockRecordingReader.AssertExpectations(t)
+
+	mockServerLauncher := &mocks.MockServerLauncher{}
+	defer mockServerLauncher.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	stdout := &bytes.Buffer{}
+	entries := []sessionrecording.Entry{
+		startEntry(),
+		toolCallEntry(t, "x = 1", "x = 1"),
+		toolCallEntry(t, "y = 2", "y = 2"),
+	}
+
+	mockConfig.EXPECT().
+		ReplayRecording().
+		Return(recordingPath).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(stdout).
+		Once()
+
+	mockRecordingReader.EXPECT().
+		Read(recordingPath).
+		Return(entries, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ReplayServerArgs().
+		Return([]string{"--matlab-root=/home/matlab"}).
+		Once()
+
+	mockServerLauncher.EXPECT().
+		Launch(t.Context(), []string{"--matlab-root=/home/matlab"}).
+		Return(newServerSession(t), nil).
+		Once()
+
+	r := replay.New(mockConfig, mockRecordingReader, mockServerLauncher, mockOSLayer)
+
+	// Act
+	err := r.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "Verified recording /home/user/recordings/recording.jsonl: 2 tool calls recorded by matlab-mcp-core-server v0.1.0 on 2025-06-01T12:00:00Z.\n"+
+		"[1/2] evaluate_matlab_code: reproduced\n"+
+		"[2/2] evaluate_matlab_code: reproduced\n"+
+		"All 2 tool calls were reproduced.\n", stdout.String())
+}
+
+func TestReplay_StartAndWaitForCompletion_NotReproduced(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockRecordingReader := &mocks.MockRecordingReader{}
+	defer mockRecordingReader.AssertExpectations(t)
+
+	mockServerLauncher := &mocks.MockServerLauncher{}
+	defer mockServerLauncher.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	entries := []sessionrecording.Entry{
+		startEntry(),
+		toolCallEntry(t, "x = 1", "x = 2"),
+		toolCallEntry(t, "y = 2", "y = 2"),
+	}
+
+	mockConfig.EXPECT().
+		ReplayRecording().
+		Return(recordingPath).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(stdout).
+		Once()
+
+	mockRecordingReader.EXPECT().
+		Read(recordingPath).
+		Return(entries, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ReplayServerArgs().
+		Return([]string{"--matlab-root=/home/matlab"}).
+		Once()
+
+	mockServerLauncher.EXPECT().
+		Launch(t.Context(), []string{"--matlab-root=/home/matlab"}).
+		Return(newServerSession(t), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stderr().
+		Return(stderr).
+		Once()
+
+	r := replay.New(mockConfig, mockRecordingReader, mockServerLauncher, mockOSLayer)
+
+	// Act
+	err := r.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.EqualError(t, err, "1 of 2 tool calls were not reproduced")
+	assert.Equal(t, "Replay failed: 1 of 2 tool calls were not reproduced\n", stderr.String())
+	assert.Contains(t, stdout.String(), "[1/2] evaluate_matlab_code: differs: the text output changed from \"x = 2\" to \"x = 1\"\n")
+	assert.Contains(t, stdout.String(), "[2/2] evaluate_matlab_code: reproduced\n")
+}
+
+func TestReplay_StartAndWaitForCompletion_NoToolCalls(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockRecordingReader := &mocks.MockRecordingReader{}
+	defer mockRecordingReader.AssertExpectations(t)
+
+	mockServerLauncher := &mocks.MockServerLauncher{}
+	defer mockServerLauncher.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	stdout := &bytes.Buffer{}
+
+	mockConfig.EXPECT().
+		ReplayRecording().
+		Return(recordingPath).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(stdout).
+		Once()
+
+	mockRecordingReader.EXPECT().
+		Read(recordingPath).
+		Return([]sessionrecording.Entry{startEntry()}, nil).
+		Once()
+
+	r := replay.New(mockConfig, mockRecordingReader, mockServerLauncher, mockOSLayer)
+
+	// Act
+	err := r.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "0 tool calls recorded")
+}
+
+func TestReplay_StartAndWaitForCompletion_ReadError(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockRecordingReader := &mocks.MockRecordingReader{}
+	defer mockRecordingReader.AssertExpectations(t)
+
+	mockServerLauncher := &mocks.MockServerLauncher{}
+	defer mockServerLauncher.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		ReplayRecording().
+		Return(recordingPath).
+		Once()
+
+	stderr := &bytes.Buffer{}
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(&bytes.Buffer{}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stderr().
+		Return(stderr).
+		Once()
+
+	mockRecordingReader.EXPECT().
+		Read(recordingPath).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	err := replay.New(mockConfig, mockRecordingReader, mockServerLauncher, mockOSLayer).StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Contains(t, stderr.String(), "Replay failed: ")
+}
+
+func TestReplay_StartAndWaitForCompletion_LaunchError(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockRecordingReader := &mocks.MockRecordingReader{}
+	defer mockRecordingReader.AssertExpectations(t)
+
+	mockServerLauncher := &mocks.MockServerLauncher{}
+	defer mockServerLauncher.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		ReplayRecording().
+		Return(recordingPath).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(&bytes.Buffer{}).
+		Once()
+
+	mockRecordingReader.EXPECT().
+		Read(recordingPath).
+		Return([]sessionrecording.Entry{startEntry(), toolCallEntry(t, "x = 1", "x = 1")}, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ReplayServerArgs().
+		Return([]string{}).
+		Once()
+
+	mockServerLauncher.EXPECT().
+		Launch(t.Context(), []string{}).
+		Return(nil, assert.AnError).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stderr().
+		Return(&bytes.Buffer{}).
+		Once()
+
+	r := replay.New(mockConfig, mockRecordingReader, mockServerLauncher, mockOSLayer)
+
+	// Act
+	err := r.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+func TestReplay_StartAndWaitForCompletion_RecordedFailures(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockRecordingReader := &mocks.MockRecordingReader{}
+	defer mockRecordingReader.AssertExpectations(t)
+
+	mockServerLauncher := &mocks.MockServerLauncher{}
+	defer mockServerLauncher.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	stdout := &bytes.Buffer{}
+	failedCall := sessionrecording.Entry{
+		Kind:  sessionrecording.EntryKindToolCall,
+		Tool:  "unknown_tool",
+		Error: "unknown tool",
+	}
+
+	mockConfig.EXPECT().
+		ReplayRecording().
+		Return(recordingPath).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(stdout).
+		Once()
+
+	mockRecordingReader.EXPECT().
+		Read(recordingPath).
+		Return([]sessionrecording.Entry{startEntry(), failedCall}, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ReplayServerArgs().
+		Return([]string{"--matlab-root=/home/matlab"}).
+		Once()
+
+	mockServerLauncher.EXPECT().
+		Launch(t.Context(), []string{"--matlab-root=/home/matlab"}).
+		Return(newServerSession(t), nil).
+		Once()
+
+	r := replay.New(mockConfig, mockRecordingReader, mockServerLauncher, mockOSLayer)
+
+	// Act
+	err := r.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err, "A call that failed when recorded should fail again when replayed")
+	assert.Contains(t, stdout.String(), "[1/1] unknown_tool: reproduced\n")
+}
@@ -0,0 +1,257 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package selftest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	evalToolName = "evaluate_matlab_code"
+
+	// figureURIPrefix is the prefix of the URIs of the figures of the MATLAB session.
+	figureURIPrefix = "matlab://figures/"
+
+	// evalCode prints a result that the code cannot print by chance.
+	evalCode           = "disp(6*7)"
+	evalExpectedOutput = "42"
+
+	// figureCode creates an invisible figure, so that the self-test does not show windows.
+	figureCode        = "selfTestFigure = figure('Visible', 'off'); plot(1:10);"
+	figureCleanupCode = "close(selfTestFigure); clear selfTestFigure"
+)
+
+// pngSignature starts every PNG image.
+var pngSignature = []byte("\x89PNG\r\n\x1a\n")
+
+// errSelfTestFailed is returned when a step of the self-test failed, after the steps were reported.
+var errSelfTestFailed = errors.New("the self-test failed")
+
+type Config interface {
+	SelfTestServerArgs() []string
+	ServeTransport() entities.Transport
+	ListenAddress() string
+}
+
+type ServerLauncher interface {
+	Launch(ctx context.Context, args []string) (*mcp.ClientSession, error)
+	LaunchServing(ctx context.Context, args []string, transport entities.Transport, address string) (*mcp.ClientSession, func(), error)
+}
+
+type OSLayer interface {
+	Stdout() io.Writer
+	Stderr() io.Writer
+	TempDir() string
+}
+
+// SelfTest starts a new MATLAB MCP Core Server, connects to it as a minimal MCP client over the configured transport,
+// and checks that it lists its tools, evaluates code and captures figures, to verify an installation or an upgrade
+// end to end with a single command.
+type SelfTest struct {
+	config         Config
+	serverLauncher ServerLauncher
+	osLayer        OSLayer
+}
+
+func New(
+	config Config,
+	serverLauncher ServerLauncher,
+	osLayer OSLayer,
+) *SelfTest {
+	return &SelfTest{
+		config:         config,
+		serverLauncher: serverLauncher,
+		osLayer:        osLayer,
+	}
+}
+
+// outcome is the result of a step of the self-test.
+type outcome string
+
+const (
+	outcomePass outcome = "PASS"
+	outcomeFail outcome = "FAIL"
+	outcomeSkip outcome = "SKIP"
+)
+
+// StartAndWaitForCompletion runs the steps of the self-test, and fails if any step failed.
+// Steps which cannot run with the arguments of the server, such as evaluating code in read-only mode, are skipped.
+func (s *SelfTest) StartAndWaitForCompletion(ctx context.Context) error {
+	if err := s.run(ctx); err != nil {
+		_, _ = fmt.Fprintf(s.osLayer.Stderr(), "Self-test failed: %v\n", err)
+		return err
+	}
+	return nil
+}
+
+func (s *SelfTest) run(ctx context.Context) error {
+	stdout := s.osLayer.Stdout()
+	transport := s.config.ServeTransport()
+
+	report := func(result outcome, step string, start time.Time, message string) error {
+		_, err := fmt.Fprintf(stdout, "%s  %-10s %s (%s)\n", result, step, message, time.Since(start).Round(time.Millisecond))
+		return err
+	}
+
+	start := time.Now()
+	session, stop, err := s.launch(ctx, transport)
+	if err != nil {
+		if err := report(outcomeFail, "initialize", start, err.Error()); err != nil {
+			return err
+		}
+		return errSelfTestFailed
+	}
+	defer func() {
+		_ = session.Close()
+		stop()
+	}()
+
+	serverInfo := session.InitializeResult().ServerInfo
+	if err := report(outcomePass, "initialize", start, fmt.Sprintf("connected to %s %s over %s", serverInfo.Name, serverInfo.Version, transport)); err != nil {
+		return err
+	}
+
+	state := &selfTestState{session: session, projectPath: s.osLayer.TempDir()}
+	failed := 0
+	for _, step := range []struct {
+		name string
+		run  func(ctx context.Context) (outcome, string)
+	}{
+		{"tools", state.listTools},
+		{"evaluate", state.evaluate},
+		{"figure", state.captureFigure},
+	} {
+		start := time.Now()
+		result, message := step.run(ctx)
+		if result == outcomeFail {
+			failed++
+		}
+		if err := report(result, step.name, start, message); err != nil {
+			return err
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%w: %d of its steps failed", errSelfTestFailed, failed)
+	}
+	_, err = fmt.Fprintln(stdout, "Self-test passed.")
+	return err
+}
+
+// launch starts the server, with the serve command when it serves on an address.
+// The returned function stops the server, once the session is closed.
+func (s *SelfTest) launch(ctx context.Context, transport entities.Transport) (*mcp.ClientSession, func(), error) {
+	args := s.config.SelfTestServerArgs()
+	if transport == entities.TransportStdio {
+		session, err := s.serverLauncher.Launch(ctx, args)
+		return session, func() {}, err
+	}
+	return s.serverLauncher.LaunchServing(ctx, args, transport, s.config.ListenAddress())
+}
+
+// selfTestState is what the steps learn about the server, for the next steps.
+type selfTestState struct {
+	session     *mcp.ClientSession
+	projectPath string
+	tools       []string
+	evaluated   bool
+}
+
+func (s *selfTestState) listTools(ctx context.Context) (outcome, string) {
+	for tool, err := range s.session.Tools(ctx, nil) {
+		if err != nil {
+			return outcomeFail, fmt.Sprintf("failed to list the tools: %v", err)
+		}
+		s.tools = append(s.tools, tool.Name)
+	}
+	if len(s.tools) == 0 {
+		return outcomeFail, "the server has no tools"
+	}
+	slices.Sort(s.tools)
+	return outcomePass, fmt.Sprintf("%d tools: %s", len(s.tools), strings.Join(s.tools, ", "))
+}
+
+func (s *selfTestState) evaluate(ctx context.Context) (outcome, string) {
+	if !slices.Contains(s.tools, evalToolName) {
+		return outcomeSkip, fmt.Sprintf("the server has no %s tool, as in read-only mode or with several MATLAB sessions", evalToolName)
+	}
+
+	// The first evaluation starts MATLAB, which takes most of the time of the step.
+	output, _, err := s.callEval(ctx, evalCode)
+	if err != nil {
+		return outcomeFail, err.Error()
+	}
+	if !strings.Contains(output, evalExpectedOutput) {
+		return outcomeFail, fmt.Sprintf("%s printed %q instead of %s", evalCode, output, evalExpectedOutput)
+	}
+
+	s.evaluated = true
+	return outcomePass, fmt.Sprintf("%s printed %s", evalCode, evalExpectedOutput)
+}
+
+func (s *selfTestState) captureFigure(ctx context.Context) (outcome, string) {
+	if !s.evaluated {
+		return outcomeSkip, "figures are captured from evaluated code"
+	}
+
+	_, links, err := s.callEval(ctx, figureCode)
+	if err != nil {
+		return outcomeFail, err.Error()
+	}
+	defer func() {
+		_, _, _ = s.callEval(ctx, figureCleanupCode)
+	}()
+
+	if len(links) == 0 {
+		return outcomeFail, "the evaluation returned no figure, check that --figure-resolution is not 0"
+	}
+
+	result, err := s.session.ReadResource(ctx, &mcp.ReadResourceParams{URI: links[0]})
+	if err != nil {
+		return outcomeFail, fmt.Sprintf("failed to read %s: %v", links[0], err)
+	}
+	if len(result.Contents) == 0 || !bytes.HasPrefix(result.Contents[0].Blob, pngSignature) {
+		return outcomeFail, fmt.Sprintf("%s is not a PNG image", links[0])
+	}
+
+	return outcomePass, fmt.Sprintf("read %s, a PNG image of %d bytes", links[0], len(result.Contents[0].Blob))
+}
+
+// callEval evaluates code, and returns its text output and the links to the figures of the MATLAB session.
+func (s *selfTestState) callEval(ctx context.Context, code string) (string, []string, error) {
+	result, err := s.session.CallTool(ctx, &mcp.CallToolParams{
+		Name:      evalToolName,
+		Arguments: map[string]any{"project_path": s.projectPath, "code": code},
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to call %s: %w", evalToolName, err)
+	}
+
+	var output strings.Builder
+	var links []string
+	for _, content := range result.Content {
+		switch content := content.(type) {
+		case *mcp.TextContent:
+			output.WriteString(content.Text)
+		case *mcp.ResourceLink:
+			if strings.HasPrefix(content.URI, figureURIPrefix) {
+				links = append(links, content.URI)
+			}
+		}
+	}
+
+	if result.IsError {
+		return "", nil, fmt.Errorf("%s failed: %s", evalToolName, strings.TrimSpace(output.String()))
+	}
+	return strings.TrimSpace(output.String()), links, nil
+}
@@ -0,0 +1,285 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package selftest_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/selftest"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/selftest"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const figureURI = "matlab://figures/1"
+
+// newServerSession connects to an MCP server whose evaluate_matlab_code tool prints 42 for disp(6*7), and links to a
+// figure for the code creating one. Without withEval, the server only has a read-only tool.
+func newServerSession(t *testing.T, withEval bool, figure []byte) *mcp.ClientSession {
+	t.Helper()
+
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "v1.2.3"}, nil)
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "check_matlab_code"}, func(ctx context.Context, req *mcp.CallToolRequest, input struct{}) (*mcp.CallToolResult, any, error) {
+		return &mcp.CallToolResult{}, nil, nil
+	})
+	if withEval {
+		mcp.AddTool(mcpServer, &mcp.Tool{Name: "evaluate_matlab_code"}, func(ctx context.Context, req *mcp.CallToolRequest, input struct {
+			ProjectPath string `json:"project_path"`
+			Code        string `json:"code"`
+		}) (*mcp.CallToolResult, any, error) {
+			switch {
+			case input.Code == "disp(6*7)":
+				return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: "42\n"}}}, nil, nil
+			case strings.Contains(input.Code, "figure("):
+				return &mcp.CallToolResult{Content: []mcp.Content{&mcp.ResourceLink{URI: figureURI, Name: "Figure 1"}}}, nil, nil
+			default:
+				return &mcp.CallToolResult{}, nil, nil
+			}
+		})
+	}
+	mcpServer.AddResource(&mcp.Resource{URI: figureURI, Name: "Figure 1", MIMEType: "image/png"}, func(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+		return &mcp.ReadResourceResult{Contents: []*mcp.ResourceContents{{URI: figureURI, MIMEType: "image/png", Blob: figure}}}, nil
+	})
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := mcpServer.Connect(t.Context(), serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = serverSession.Close() })
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	clientSession, err := client.Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+
+	return clientSession
+}
+
+func TestSelfTest_StartAndWaitForCompletion_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockServerLauncher := &mocks.MockServerLauncher{}
+	defer mockServerLauncher.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	ctx := t.Context()
+	serverArgs := []string{"--matlab-root=/home/matlab", "--figure-resolution=72"}
+	stdout := &bytes.Buffer{}
+
+	mockConfig.EXPECT().
+		SelfTestServerArgs().
+		Return(serverArgs).
+		Once()
+
+	mockConfig.EXPECT().
+		ServeTransport().
+		Return(entities.TransportStdio).
+		Once()
+
+	mockServerLauncher.EXPECT().
+		Launch(ctx, serverArgs).
+		Return(newServerSession(t, true, []byte("\x89PNG\r\n\x1a\nimage")), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(stdout).
+		Once()
+
+	mockOSLayer.EXPECT().
+		TempDir().
+		Return("/tmp").
+		Once()
+
+	selfTest := selftest.New(mockConfig, mockServerLauncher, mockOSLayer)
+
+	// Act
+	err := selfTest.StartAndWaitForCompletion(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	output := stdout.String()
+	assert.Contains(t, output, "PASS  initialize connected to test-server v1.2.3 over stdio")
+	assert.Contains(t, output, "PASS  tools      2 tools: check_matlab_code, evaluate_matlab_code")
+	assert.Contains(t, output, "PASS  evaluate   disp(6*7) printed 42")
+	assert.Contains(t, output, "PASS  figure     read "+figureURI+", a PNG image of 13 bytes")
+	assert.True(t, strings.HasSuffix(output, "Self-test passed.\n"))
+}
+
+func TestSelfTest_StartAndWaitForCompletion_ServingTransport(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockServerLauncher := &mocks.MockServerLauncher{}
+	defer mockServerLauncher.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	ctx := t.Context()
+	serverArgs := []string{"--transport=http", "--listen=localhost:9911"}
+	stdout := &bytes.Buffer{}
+	stopped := false
+
+	mockConfig.EXPECT().
+		SelfTestServerArgs().
+		Return(serverArgs).
+		Once()
+
+	mockConfig.EXPECT().
+		ServeTransport().
+		Return(entities.TransportHTTP).
+		Once()
+
+	mockConfig.EXPECT().
+		ListenAddress().
+		Return("localhost:9911").
+		Once()
+
+	mockServerLauncher.EXPECT().
+		LaunchServing(ctx, serverArgs, entities.TransportHTTP, "localhost:9911").
+		Return(newServerSession(t, false, nil), func() { stopped = true }, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(stdout).
+		Once()
+
+	mockOSLayer.EXPECT().
+		TempDir().
+		Return("/tmp").
+		Once()
+
+	selfTest := selftest.New(mockConfig, mockServerLauncher, mockOSLayer)
+
+	// Act
+	err := selfTest.StartAndWaitForCompletion(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	output := stdout.String()
+	assert.Contains(t, output, "over http")
+	assert.Contains(t, output, "SKIP  evaluate   the server has no evaluate_matlab_code tool")
+	assert.Contains(t, output, "SKIP  figure")
+	assert.True(t, stopped, "The server should be stopped")
+}
+
+func TestSelfTest_StartAndWaitForCompletion_StepFailed(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockServerLauncher := &mocks.MockServerLauncher{}
+	defer mockServerLauncher.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	ctx := t.Context()
+	serverArgs := []string{}
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+
+	mockConfig.EXPECT().
+		SelfTestServerArgs().
+		Return(serverArgs).
+		Once()
+
+	mockConfig.EXPECT().
+		ServeTransport().
+		Return(entities.TransportStdio).
+		Once()
+
+	mockServerLauncher.EXPECT().
+		Launch(ctx, serverArgs).
+		Return(newServerSession(t, true, []byte("not an image")), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(stdout).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stderr().
+		Return(stderr).
+		Once()
+
+	mockOSLayer.EXPECT().
+		TempDir().
+		Return("/tmp").
+		Once()
+
+	selfTest := selftest.New(mockConfig, mockServerLauncher, mockOSLayer)
+
+	// Act
+	err := selfTest.StartAndWaitForCompletion(ctx)
+
+	// Assert
+	require.Error(t, err)
+	assert.Contains(t, stdout.String(), "PASS  evaluate")
+	assert.Contains(t, stdout.String(), "FAIL  figure     "+figureURI+" is not a PNG image")
+	assert.NotContains(t, stdout.String(), "Self-test passed.")
+	assert.Contains(t, stderr.String(), "Self-test failed: the self-test failed: 1 of its steps failed")
+}
+
+func TestSelfTest_StartAndWaitForCompletion_LaunchError(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockServerLauncher := &mocks.MockServerLauncher{}
+	defer mockServerLauncher.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	ctx := t.Context()
+	serverArgs := []string{}
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+
+	mockConfig.EXPECT().
+		SelfTestServerArgs().
+		Return(serverArgs).
+		Once()
+
+	mockConfig.EXPECT().
+		ServeTransport().
+		Return(entities.TransportStdio).
+		Once()
+
+	mockServerLauncher.EXPECT().
+		Launch(ctx, serverArgs).
+		Return(nil, assert.AnError).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(stdout).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stderr().
+		Return(stderr).
+		Once()
+
+	selfTest := selftest.New(mockConfig, mockServerLauncher, mockOSLayer)
+
+	// Act
+	err := selfTest.StartAndWaitForCompletion(ctx)
+
+	// Assert
+	require.Error(t, err)
+	assert.Contains(t, stdout.String(), "FAIL  initialize "+assert.AnError.Error())
+	assert.Contains(t, stderr.String(), "Self-test failed: ")
+}
@@ -0,0 +1,297 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package service
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/xml"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"unicode/utf16"
+)
+
+const (
+	// unitName is the name of the systemd user unit.
+	unitName = "matlab-mcp-core-server"
+
+	// agentLabel is the label of the launchd agent.
+	agentLabel = "com.mathworks.matlab-mcp-core-server"
+
+	// taskName is the name of the Task Scheduler task.
+	taskName = "MATLAB MCP Core Server"
+)
+
+// serverCommand is how the service runs the server.
+type serverCommand struct {
+	binary     string
+	args       []string
+	workingDir string
+	path       string
+}
+
+// managerCommand is a command of the service manager. The optional commands may fail, for example when they stop a
+// service which is not running.
+type managerCommand struct {
+	args     []string
+	optional bool
+}
+
+// serviceManager is how the service manager of a platform defines the service, and the commands of each action.
+type serviceManager struct {
+	definitionPath string
+	// extraDirs are the folders to create with the definition, such as the folder of its log file.
+	extraDirs []string
+	define    func(server serverCommand) ([]byte, error)
+
+	register    []managerCommand
+	unregister  []managerCommand
+	afterRemove []managerCommand
+	start       []managerCommand
+	stop        []managerCommand
+	status      managerCommand
+}
+
+// systemdManager runs the server as a systemd user unit, enabled to start with the user manager, which is when the
+// user logs in, or at boot when lingering is enabled. The output of the server goes to the journal.
+func systemdManager(configDir string) serviceManager {
+	systemctl := func(args ...string) managerCommand {
+		return managerCommand{args: append([]string{"systemctl", "--user"}, args...)}
+	}
+
+	return serviceManager{
+		definitionPath: filepath.Join(configDir, "systemd", "user", unitName+".service"),
+		define:         systemdUnit,
+		register:       []managerCommand{systemctl("daemon-reload"), systemctl("enable", unitName)},
+		unregister:     []managerCommand{systemctl("disable", "--now", unitName)},
+		afterRemove:    []managerCommand{systemctl("daemon-reload")},
+		start:          []managerCommand{systemctl("start", unitName)},
+		stop:           []managerCommand{systemctl("stop", unitName)},
+		status:         systemctl("status", "--no-pager", unitName),
+	}
+}
+
+func systemdUnit(server serverCommand) ([]byte, error) {
+	execStart := make([]string, 0, len(server.args)+1)
+	for _, arg := range append([]string{server.binary}, server.args...) {
+		// systemd expands specifiers and variables in the command line, so they are escaped.
+		execStart = append(execStart, quoteSystemd(strings.ReplaceAll(arg, "$", "$$")))
+	}
+
+	var unit strings.Builder
+	unit.WriteString("[Unit]\n")
+	unit.WriteString("Description=MATLAB MCP Core Server\n")
+	unit.WriteString("\n[Service]\n")
+	fmt.Fprintf(&unit, "ExecStart=%s\n", strings.Join(execStart, " "))
+	fmt.Fprintf(&unit, "WorkingDirectory=%s\n", strings.ReplaceAll(server.workingDir, "%", "%%"))
+	fmt.Fprintf(&unit, "Environment=%s\n", quoteSystemd("PATH="+server.path))
+	unit.WriteString("Restart=on-failure\n")
+	unit.WriteString("RestartSec=5\n")
+	unit.WriteString("StandardOutput=journal\n")
+	unit.WriteString("StandardError=journal\n")
+	unit.WriteString("\n[Install]\n")
+	unit.WriteString("WantedBy=default.target\n")
+	return []byte(unit.String()), nil
+}
+
+// quoteSystemd quotes a value of a unit file, escaping the specifiers.
+func quoteSystemd(value string) string {
+	value = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "%", "%%").Replace(value)
+	return `"` + value + `"`
+}
+
+// launchdManager runs the server as a launchd agent of the user, loaded when the user logs in.
+// The output of the server goes to a log file in the logs folder of the user.
+func launchdManager(home string, uid string) serviceManager {
+	domain := "gui/" + uid
+	definitionPath := filepath.Join(home, "Library", "LaunchAgents", agentLabel+".plist")
+	logDir := filepath.Join(home, "Library", "Logs", unitName)
+
+	return serviceManager{
+		definitionPath: definitionPath,
+		extraDirs:      []string{logDir},
+		define: func(server serverCommand) ([]byte, error) {
+			return launchdAgent(server, filepath.Join(logDir, "service.log"))
+		},
+		unregister: []managerCommand{{args: []string{"launchctl", "bootout", domain + "/" + agentLabel}, optional: true}},
+		start: []managerCommand{
+			// The agent is already loaded when the user logged in after it was installed.
+			{args: []string{"launchctl", "bootstrap", domain, definitionPath}, optional: true},
+			{args: []string{"launchctl", "kickstart", domain + "/" + agentLabel}},
+		},
+		stop:   []managerCommand{{args: []string{"launchctl", "bootout", domain + "/" + agentLabel}}},
+		status: managerCommand{args: []string{"launchctl", "print", domain + "/" + agentLabel}},
+	}
+}
+
+func launchdAgent(server serverCommand, logFile string) ([]byte, error) {
+	var plist bytes.Buffer
+	plist.WriteString(xml.Header)
+	plist.WriteString(`<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">` + "\n")
+	plist.WriteString(`<plist version="1.0">` + "\n<dict>\n")
+
+	writeKey := func(key string, value string) error {
+		plist.WriteString("\t<key>" + key + "</key>\n\t<string>")
+		if err := xml.EscapeText(&plist, []byte(value)); err != nil {
+			return err
+		}
+		plist.WriteString("</string>\n")
+		return nil
+	}
+
+	if err := writeKey("Label", agentLabel); err != nil {
+		return nil, err
+	}
+
+	plist.WriteString("\t<key>ProgramArguments</key>\n\t<array>\n")
+	for _, arg := range append([]string{server.binary}, server.args...) {
+		plist.WriteString("\t\t<string>")
+		if err := xml.EscapeText(&plist, []byte(arg)); err != nil {
+			return nil, err
+		}
+		plist.WriteString("</string>\n")
+	}
+	plist.WriteString("\t</array>\n")
+
+	plist.WriteString("\t<key>EnvironmentVariables</key>\n\t<dict>\n\t\t<key>PATH</key>\n\t\t<string>")
+	if err := xml.EscapeText(&plist, []byte(server.path)); err != nil {
+		return nil, err
+	}
+	plist.WriteString("</string>\n\t</dict>\n")
+
+	for _, entry := range []struct{ key, value string }{
+		{"WorkingDirectory", server.workingDir},
+		{"StandardOutPath", logFile},
+		{"StandardErrorPath", logFile},
+	} {
+		if err := writeKey(entry.key, entry.value); err != nil {
+			return nil, err
+		}
+	}
+
+	plist.WriteString("\t<key>RunAtLoad</key>\n\t<true/>\n")
+	// The agent is restarted when the server fails, but not when it is stopped.
+	plist.WriteString("\t<key>KeepAlive</key>\n\t<dict>\n\t\t<key>SuccessfulExit</key>\n\t\t<false/>\n\t</dict>\n")
+	plist.WriteString("</dict>\n</plist>\n")
+	return plist.Bytes(), nil
+}
+
+// taskSchedulerManager runs the server as a Task Scheduler task started when the user logs in.
+// A Windows service would need administrator rights to be installed, and the password of the user to run as the user,
+// where MATLAB is licensed and set up. The server writes its output to its own log file.
+func taskSchedulerManager(configDir string, username string) serviceManager {
+	definitionPath := filepath.Join(configDir, unitName, "service.xml")
+
+	return serviceManager{
+		definitionPath: definitionPath,
+		define: func(server serverCommand) ([]byte, error) {
+			return scheduledTask(server, username)
+		},
+		register: []managerCommand{{args: []string{"schtasks", "/Create", "/TN", taskName, "/XML", definitionPath, "/F"}}},
+		unregister: []managerCommand{
+			{args: []string{"schtasks", "/End", "/TN", taskName}, optional: true},
+			{args: []string{"schtasks", "/Delete", "/TN", taskName, "/F"}},
+		},
+		start:  []managerCommand{{args: []string{"schtasks", "/Run", "/TN", taskName}}},
+		stop:   []managerCommand{{args: []string{"schtasks", "/End", "/TN", taskName}}},
+		status: managerCommand{args: []string{"schtasks", "/Query", "/TN", taskName, "/V", "/FO", "LIST"}},
+	}
+}
+
+// scheduledTask is the definition of the task, which Task Scheduler reads in UTF-16.
+func scheduledTask(server serverCommand, username string) ([]byte, error) {
+	args := make([]string, 0, len(server.args))
+	for _, arg := range server.args {
+		args = append(args, quoteWindows(arg))
+	}
+
+	escape := func(value string) (string, error) {
+		var escaped strings.Builder
+		err := xml.EscapeText(&escaped, []byte(value))
+		return escaped.String(), err
+	}
+
+	values := []string{username, server.binary, strings.Join(args, " "), server.workingDir}
+	for i, value := range values {
+		escaped, err := escape(value)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = escaped
+	}
+
+	task := fmt.Sprintf(`<?xml version="1.0" encoding="UTF-16"?>
+<Task version="1.2" xmlns="http://schemas.microsoft.com/windows/2004/02/mit/task">
+  <RegistrationInfo>
+    <Description>MATLAB MCP Core Server</Description>
+  </RegistrationInfo>
+  <Triggers>
+    <LogonTrigger>
+      <Enabled>true</Enabled>
+      <UserId>%[1]s</UserId>
+    </LogonTrigger>
+  </Triggers>
+  <Principals>
+    <Principal id="Author">
+      <UserId>%[1]s</UserId>
+      <LogonType>InteractiveToken</LogonType>
+      <RunLevel>LeastPrivilege</RunLevel>
+    </Principal>
+  </Principals>
+  <Settings>
+    <MultipleInstancesPolicy>IgnoreNew</MultipleInstancesPolicy>
+    <DisallowStartIfOnBatteries>false</DisallowStartIfOnBatteries>
+    <StopIfGoingOnBatteries>false</StopIfGoingOnBatteries>
+    <ExecutionTimeLimit>PT0S</ExecutionTimeLimit>
+    <RestartOnFailure>
+      <Interval>PT1M</Interval>
+      <Count>999</Count>
+    </RestartOnFailure>
+    <Enabled>true</Enabled>
+  </Settings>
+  <Actions Context="Author">
+    <Exec>
+      <Command>%[2]s</Command>
+      <Arguments>%[3]s</Arguments>
+      <WorkingDirectory>%[4]s</WorkingDirectory>
+    </Exec>
+  </Actions>
+</Task>
+`, values[0], values[1], values[2], values[3])
+
+	encoded := utf16.Encode([]rune(strings.ReplaceAll(task, "\n", "\r\n")))
+	data := make([]byte, 2, 2+2*len(encoded))
+	data[0], data[1] = 0xFF, 0xFE
+	for _, unit := range encoded {
+		data = binary.LittleEndian.AppendUint16(data, unit)
+	}
+	return data, nil
+}
+
+// quoteWindows quotes an argument of a Windows command line, as parsed by the Go runtime.
+func quoteWindows(arg string) string {
+	if arg != "" && !strings.ContainsAny(arg, " \t\"") {
+		return arg
+	}
+
+	var quoted strings.Builder
+	quoted.WriteByte('"')
+	backslashes := 0
+	for _, r := range arg {
+		switch r {
+		case '\\':
+			backslashes++
+			continue
+		case '"':
+			quoted.WriteString(strings.Repeat(`\`, 2*backslashes+1))
+		default:
+			quoted.WriteString(strings.Repeat(`\`, backslashes))
+		}
+		backslashes = 0
+		quoted.WriteRune(r)
+	}
+	quoted.WriteString(strings.Repeat(`\`, 2*backslashes))
+	quoted.WriteByte('"')
+	return quoted.String()
+}
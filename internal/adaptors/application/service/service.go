@@ -0,0 +1,232 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+)
+
+const (
+	definitionDirPermissions  = 0o700
+	definitionFilePermissions = 0o600
+)
+
+type Config interface {
+	ServiceAction() entities.ServiceAction
+	ServiceServerArgs() []string
+}
+
+type OSLayer interface {
+	GOOS() string
+	Stdout() io.Writer
+	Stderr() io.Writer
+	Getenv(key string) string
+	Getwd() (string, error)
+	Executable() (string, error)
+	EvalSymlinks(path string) (string, error)
+	CurrentUser() (*user.User, error)
+	UserHomeDir() (string, error)
+	UserConfigDir() (string, error)
+	Stat(name string) (osfacade.FileInfo, error)
+	MkdirAll(path string, perm os.FileMode) error
+	WriteFile(name string, data []byte, perm os.FileMode) error
+	RemoveAll(path string) error
+	Command(name string, arg ...string) osfacade.Cmd
+}
+
+// Service runs the server in the background as a service of the user, managed by the service manager of the platform:
+// a systemd user unit on Linux, a launchd agent on macOS, and a Task Scheduler task started at logon on Windows.
+// The service starts when the user logs in, and is restarted when the server fails.
+type Service struct {
+	config  Config
+	osLayer OSLayer
+}
+
+func New(
+	config Config,
+	osLayer OSLayer,
+) *Service {
+	return &Service{
+		config:  config,
+		osLayer: osLayer,
+	}
+}
+
+// StartAndWaitForCompletion applies the action of the service command.
+// Failures are also written to stderr, as this mode has no log file.
+func (s *Service) StartAndWaitForCompletion(_ context.Context) error {
+	action := s.config.ServiceAction()
+	if err := s.run(action); err != nil {
+		_, _ = fmt.Fprintf(s.osLayer.Stderr(), "Service %s failed: %v\n", action, err)
+		return err
+	}
+	return nil
+}
+
+func (s *Service) run(action entities.ServiceAction) error {
+	manager, err := s.serviceManager()
+	if err != nil {
+		return err
+	}
+
+	stdout := s.osLayer.Stdout()
+
+	if action == entities.ServiceActionInstall {
+		return s.install(manager, stdout)
+	}
+
+	if _, err := s.osLayer.Stat(manager.definitionPath); err != nil {
+		return fmt.Errorf("the service is not installed, install it with the `service %s` command", entities.ServiceActionInstall)
+	}
+
+	switch action {
+	case entities.ServiceActionUninstall:
+		if err := s.runCommands(manager.unregister); err != nil {
+			return err
+		}
+		if err := s.osLayer.RemoveAll(manager.definitionPath); err != nil {
+			return fmt.Errorf("failed to remove %s: %w", manager.definitionPath, err)
+		}
+		if err := s.runCommands(manager.afterRemove); err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(stdout, "Uninstalled the service, and removed %s\n", manager.definitionPath)
+	case entities.ServiceActionStart:
+		if err := s.runCommands(manager.start); err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(stdout, "Started the service.")
+	case entities.ServiceActionStop:
+		if err := s.runCommands(manager.stop); err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(stdout, "Stopped the service.")
+	case entities.ServiceActionStatus:
+		if _, err := fmt.Fprintf(stdout, "The service is installed in %s\n", manager.definitionPath); err != nil {
+			return err
+		}
+		// The status commands report a stopped service with a failure exit code, so their output is printed as is.
+		output, _ := s.osLayer.Command(manager.status.args[0], manager.status.args[1:]...).CombinedOutput()
+		_, err = stdout.Write(output)
+	default:
+		err = fmt.Errorf("unknown service action: %s", action)
+	}
+	return err
+}
+
+func (s *Service) install(manager serviceManager, stdout io.Writer) error {
+	server, err := s.serverCommand()
+	if err != nil {
+		return err
+	}
+
+	definition, err := manager.define(server)
+	if err != nil {
+		return err
+	}
+
+	for _, dir := range append([]string{filepath.Dir(manager.definitionPath)}, manager.extraDirs...) {
+		if err := s.osLayer.MkdirAll(dir, definitionDirPermissions); err != nil {
+			return fmt.Errorf("failed to create %s: %w", dir, err)
+		}
+	}
+
+	if err := s.osLayer.WriteFile(manager.definitionPath, definition, definitionFilePermissions); err != nil {
+		return fmt.Errorf("failed to write %s: %w", manager.definitionPath, err)
+	}
+
+	if err := s.runCommands(manager.register); err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(stdout, "Installed the service in %s\nIt starts when you log in. To start it now, use the `service %s` command.\n", manager.definitionPath, entities.ServiceActionStart)
+	return err
+}
+
+// serverCommand is how the service runs the server: the running binary, with symbolic links resolved so that the
+// service keeps working if the link is moved, and the arguments of the service command.
+// The working folder and the PATH are kept, as the service manager starts the server without the environment of the
+// shell, where MATLAB may be found.
+func (s *Service) serverCommand() (serverCommand, error) {
+	executable, err := s.osLayer.Executable()
+	if err != nil {
+		return serverCommand{}, fmt.Errorf("failed to find the path of the server binary: %w", err)
+	}
+
+	binary, err := s.osLayer.EvalSymlinks(executable)
+	if err != nil {
+		return serverCommand{}, fmt.Errorf("failed to find the path of the server binary: %w", err)
+	}
+
+	workingDir, err := s.osLayer.Getwd()
+	if err != nil {
+		return serverCommand{}, fmt.Errorf("failed to find the working folder: %w", err)
+	}
+
+	return serverCommand{
+		binary:     binary,
+		args:       s.config.ServiceServerArgs(),
+		workingDir: workingDir,
+		path:       s.osLayer.Getenv("PATH"),
+	}, nil
+}
+
+// runCommands runs the commands of the service manager, and fails with the output of the first required command failing.
+func (s *Service) runCommands(commands []managerCommand) error {
+	for _, command := range commands {
+		output, err := s.osLayer.Command(command.args[0], command.args[1:]...).CombinedOutput()
+		if err == nil || command.optional {
+			continue
+		}
+
+		message := strings.TrimSpace(string(output))
+		if message == "" {
+			return fmt.Errorf("%s failed: %w", strings.Join(command.args, " "), err)
+		}
+		return fmt.Errorf("%s failed: %w: %s", strings.Join(command.args, " "), err, message)
+	}
+	return nil
+}
+
+func (s *Service) serviceManager() (serviceManager, error) {
+	switch goos := s.osLayer.GOOS(); goos {
+	case "linux":
+		configDir, err := s.osLayer.UserConfigDir()
+		if err != nil {
+			return serviceManager{}, fmt.Errorf("failed to find the configuration folder: %w", err)
+		}
+		return systemdManager(configDir), nil
+	case "darwin":
+		home, err := s.osLayer.UserHomeDir()
+		if err != nil {
+			return serviceManager{}, fmt.Errorf("failed to find the home folder: %w", err)
+		}
+		currentUser, err := s.osLayer.CurrentUser()
+		if err != nil {
+			return serviceManager{}, fmt.Errorf("failed to find the current user: %w", err)
+		}
+		return launchdManager(home, currentUser.Uid), nil
+	case "windows":
+		configDir, err := s.osLayer.UserConfigDir()
+		if err != nil {
+			return serviceManager{}, fmt.Errorf("failed to find the configuration folder: %w", err)
+		}
+		currentUser, err := s.osLayer.CurrentUser()
+		if err != nil {
+			return serviceManager{}, fmt.Errorf("failed to find the current user: %w", err)
+		}
+		return taskSchedulerManager(configDir, currentUser.Username), nil
+	default:
+		return serviceManager{}, errors.New("services are not supported on " + goos)
+	}
+}
@@ -0,0 +1,408 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package service_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"os/user"
+	"path/filepath"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/service"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/service"
+	osfacademocks "github.com/matlab/matlab-mcp-core-server/mocks/facades/osfacade"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	executablePath = "/usr/local/bin/matlab-mcp-core-server"
+	workingDir     = "/home/user/projects"
+	pathVariable   = "/usr/bin:/opt/matlab/bin"
+)
+
+// setUpInstall sets up the calls made to find how the service runs the server, and captures the written definition.
+func setUpInstall(mockConfig *mocks.MockConfig, mockOSLayer *mocks.MockOSLayer, serverArgs []string, definition *[]byte) {
+	mockConfig.EXPECT().
+		ServiceServerArgs().
+		Return(serverArgs).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Executable().
+		Return(executablePath, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		EvalSymlinks(executablePath).
+		Return(executablePath, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Getwd().
+		Return(workingDir, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Getenv("PATH").
+		Return(pathVariable).
+		Once()
+
+	mockOSLayer.EXPECT().
+		MkdirAll(mock.Anything, os.FileMode(0o700)).
+		Return(nil)
+
+	mockOSLayer.EXPECT().
+		WriteFile(mock.Anything, mock.Anything, os.FileMode(0o600)).
+		RunAndReturn(func(_ string, data []byte, _ os.FileMode) error {
+			*definition = data
+			return nil
+		}).
+		Once()
+}
+
+// expectCommand expects a command of the service manager, which outputs output and fails with err.
+func expectCommand(t *testing.T, mockOSLayer *mocks.MockOSLayer, output string, err error, name string, args ...string) {
+	mockCmd := &osfacademocks.MockCmd{}
+	t.Cleanup(func() { mockCmd.AssertExpectations(t) })
+
+	mockCmd.EXPECT().
+		CombinedOutput().
+		Return([]byte(output), err).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Command(name, args).
+		Return(mockCmd).
+		Once()
+}
+
+func TestService_StartAndWaitForCompletion_InstallSystemdUnit(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	stdout := &bytes.Buffer{}
+	unitPath := filepath.Join("/home/user/.config", "systemd", "user", "matlab-mcp-core-server.service")
+	var unit []byte
+
+	mockConfig.EXPECT().
+		ServiceAction().
+		Return(entities.ServiceActionInstall).
+		Once()
+
+	mockOSLayer.EXPECT().
+		GOOS().
+		Return("linux").
+		Once()
+
+	mockOSLayer.EXPECT().
+		UserConfigDir().
+		Return("/home/user/.config", nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(stdout).
+		Once()
+
+	setUpInstall(mockConfig, mockOSLayer, []string{"--daemon", "--matlab-display-mode=nodesktop", `--initial-working-folder=/home/user/100% "$HOME"`}, &unit)
+	expectCommand(t, mockOSLayer, "", nil, "systemctl", "--user", "daemon-reload")
+	expectCommand(t, mockOSLayer, "", nil, "systemctl", "--user", "enable", "matlab-mcp-core-server")
+
+	serviceMode := service.New(mockConfig, mockOSLayer)
+
+	// Act
+	err := serviceMode.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, `[Unit]
+Description=MATLAB MCP Core Server
+
+[Service]
+ExecStart="/usr/local/bin/matlab-mcp-core-server" "--daemon" "--matlab-display-mode=nodesktop" "--initial-working-folder=/home/user/100%% \"$$HOME\""
+WorkingDirectory=/home/user/projects
+Environment="PATH=/usr/bin:/opt/matlab/bin"
+Restart=on-failure
+RestartSec=5
+StandardOutput=journal
+StandardError=journal
+
+[Install]
+WantedBy=default.target
+`, string(unit))
+	assert.Contains(t, stdout.String(), "Installed the service in "+unitPath)
+}
+
+func TestService_StartAndWaitForCompletion_InstallLaunchdAgent(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	stdout := &bytes.Buffer{}
+	var plist []byte
+
+	mockConfig.EXPECT().
+		ServiceAction().
+		Return(entities.ServiceActionInstall).
+		Once()
+
+	mockOSLayer.EXPECT().
+		GOOS().
+		Return("darwin").
+		Once()
+
+	mockOSLayer.EXPECT().
+		UserHomeDir().
+		Return("/Users/user", nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		CurrentUser().
+		Return(&user.User{Uid: "501", Username: "user"}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(stdout).
+		Once()
+
+	setUpInstall(mockConfig, mockOSLayer, []string{"serve", "--transport=http", "--listen=127.0.0.1:8080"}, &plist)
+
+	serviceMode := service.New(mockConfig, mockOSLayer)
+
+	// Act
+	err := serviceMode.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>Label</key>
+	<string>com.mathworks.matlab-mcp-core-server</string>
+	<key>ProgramArguments</key>
+	<array>
+		<string>/usr/local/bin/matlab-mcp-core-server</string>
+		<string>serve</string>
+		<string>--transport=http</string>
+		<string>--listen=127.0.0.1:8080</string>
+	</array>
+	<key>EnvironmentVariables</key>
+	<dict>
+		<key>PATH</key>
+		<string>/usr/bin:/opt/matlab/bin</string>
+	</dict>
+	<key>WorkingDirectory</key>
+	<string>/home/user/projects</string>
+	<key>StandardOutPath</key>
+	<string>/Users/user/Library/Logs/matlab-mcp-core-server/service.log</string>
+	<key>StandardErrorPath</key>
+	<string>/Users/user/Library/Logs/matlab-mcp-core-server/service.log</string>
+	<key>RunAtLoad</key>
+	<true/>
+	<key>KeepAlive</key>
+	<dict>
+		<key>SuccessfulExit</key>
+		<false/>
+	</dict>
+</dict>
+</plist>
+`, string(plist))
+	assert.Contains(t, stdout.String(), "Installed the service in /Users/user/Library/LaunchAgents/com.mathworks.matlab-mcp-core-server.plist")
+}
+
+func TestService_StartAndWaitForCompletion_InstallScheduledTask(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	stdout := &bytes.Buffer{}
+	taskPath := filepath.Join("/AppData", "matlab-mcp-core-server", "service.xml")
+	var task []byte
+
+	mockConfig.EXPECT().
+		ServiceAction().
+		Return(entities.ServiceActionInstall).
+		Once()
+
+	mockOSLayer.EXPECT().
+		GOOS().
+		Return("windows").
+		Once()
+
+	mockOSLayer.EXPECT().
+		UserConfigDir().
+		Return("/AppData", nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		CurrentUser().
+		Return(&user.User{Uid: "S-1-5-21", Username: `DOMAIN\user`}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(stdout).
+		Once()
+
+	setUpInstall(mockConfig, mockOSLayer, []string{"--daemon", `--matlab-root=C:\Program Files\MATLAB\R2025a\`}, &task)
+	expectCommand(t, mockOSLayer, "SUCCESS", nil, "schtasks", "/Create", "/TN", "MATLAB MCP Core Server", "/XML", taskPath, "/F")
+
+	serviceMode := service.New(mockConfig, mockOSLayer)
+
+	// Act
+	err := serviceMode.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, []byte{0xFF, 0xFE}, task[:2])
+	units := make([]uint16, 0, len(task)/2-1)
+	for i := 2; i < len(task); i += 2 {
+		units = append(units, binary.LittleEndian.Uint16(task[i:]))
+	}
+	definition := string(utf16.Decode(units))
+
+	assert.Contains(t, definition, "<UserId>DOMAIN\\user</UserId>\r\n")
+	assert.Contains(t, definition, "<LogonType>InteractiveToken</LogonType>")
+	assert.Contains(t, definition, "<ExecutionTimeLimit>PT0S</ExecutionTimeLimit>")
+	assert.Contains(t, definition, "<Command>/usr/local/bin/matlab-mcp-core-server</Command>")
+	assert.Contains(t, definition, `<Arguments>--daemon &#34;--matlab-root=C:\Program Files\MATLAB\R2025a\\&#34;</Arguments>`)
+	assert.Contains(t, definition, "<WorkingDirectory>/home/user/projects</WorkingDirectory>")
+	assert.Contains(t, stdout.String(), "Installed the service in "+taskPath)
+}
+
+func TestService_StartAndWaitForCompletion_NotInstalled(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	unitPath := filepath.Join("/home/user/.config", "systemd", "user", "matlab-mcp-core-server.service")
+
+	mockConfig.EXPECT().
+		ServiceAction().
+		Return(entities.ServiceActionStart).
+		Once()
+
+	mockOSLayer.EXPECT().
+		GOOS().
+		Return("linux").
+		Once()
+
+	mockOSLayer.EXPECT().
+		UserConfigDir().
+		Return("/home/user/.config", nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(stdout).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(unitPath).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stderr().
+		Return(stderr).
+		Once()
+
+	serviceMode := service.New(mockConfig, mockOSLayer)
+
+	// Act
+	err := serviceMode.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.ErrorContains(t, err, "the service is not installed")
+	assert.Contains(t, stderr.String(), "Service start failed: the service is not installed")
+	assert.Empty(t, stdout.String())
+}
+
+func TestService_StartAndWaitForCompletion_CommandError(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFileInfo.AssertExpectations(t)
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	plistPath := "/Users/user/Library/LaunchAgents/com.mathworks.matlab-mcp-core-server.plist"
+
+	mockConfig.EXPECT().
+		ServiceAction().
+		Return(entities.ServiceActionStart).
+		Once()
+
+	mockOSLayer.EXPECT().
+		GOOS().
+		Return("darwin").
+		Once()
+
+	mockOSLayer.EXPECT().
+		UserHomeDir().
+		Return("/Users/user", nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		CurrentUser().
+		Return(&user.User{Uid: "501", Username: "user"}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(stdout).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(plistPath).
+		Return(mockFileInfo, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stderr().
+		Return(stderr).
+		Once()
+
+	// The agent is already loaded, which is not a failure.
+	expectCommand(t, mockOSLayer, "Bootstrap failed: 5: Input/output error", assert.AnError, "launchctl", "bootstrap", "gui/501", plistPath)
+	expectCommand(t, mockOSLayer, "Could not find service", assert.AnError, "launchctl", "kickstart", "gui/501/com.mathworks.matlab-mcp-core-server")
+
+	serviceMode := service.New(mockConfig, mockOSLayer)
+
+	// Act
+	err := serviceMode.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Contains(t, stderr.String(), "launchctl kickstart gui/501/com.mathworks.matlab-mcp-core-server failed")
+	assert.Contains(t, stderr.String(), "Could not find service")
+	assert.Empty(t, stdout.String())
+}
@@ -0,0 +1,114 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+type Config interface {
+	StatusEvents() bool
+	BuildInfo() entities.BuildInfo
+}
+
+type EventReader interface {
+	Read() ([]entities.Event, error)
+}
+
+type OSLayer interface {
+	Stdout() io.Writer
+}
+
+// Status reports on the most recent events recorded by a MATLAB MCP Core Server,
+// so users can find out what just happened without looking for log files.
+type Status struct {
+	config      Config
+	eventReader EventReader
+	osLayer     OSLayer
+}
+
+func New(
+	config Config,
+	eventReader EventReader,
+	osLayer OSLayer,
+) *Status {
+	return &Status{
+		config:      config,
+		eventReader: eventReader,
+		osLayer:     osLayer,
+	}
+}
+
+func (s *Status) StartAndWaitForCompletion(_ context.Context) error {
+	events, err := s.eventReader.Read()
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to read server events: %w", err)
+	}
+
+	stdout := s.osLayer.Stdout()
+
+	if len(events) == 0 {
+		_, err := fmt.Fprintf(stdout, "Installed version: %s\nNo events recorded by the MATLAB MCP Core Server yet.\n", s.config.BuildInfo())
+		return err
+	}
+
+	if s.config.StatusEvents() {
+		for _, event := range events {
+			if _, err := fmt.Fprintln(stdout, formatEvent(event)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	_, err = fmt.Fprint(stdout, summarize(s.config.BuildInfo(), events))
+	return err
+}
+
+// summarize describes the installed build, which may differ from the build of the server that recorded the events,
+// as the version of that server is in the details of its start event.
+func summarize(buildInfo entities.BuildInfo, events []entities.Event) string {
+	var lastStarted, lastStopping *entities.Event
+	failures := 0
+	for i := range events {
+		switch events[i].Kind {
+		case entities.EventKindServerStarted:
+			lastStarted = &events[i]
+		case entities.EventKindServerStopping:
+			lastStopping = &events[i]
+		case entities.EventKindMATLABSessionStartFailed, entities.EventKindMATLABSessionCrashed, entities.EventKindMATLABRestartFailed, entities.EventKindToolCallFailed, entities.EventKindPolicyViolation:
+			failures++
+		}
+	}
+
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "Installed version: %s\n", buildInfo)
+	if lastStarted != nil {
+		fmt.Fprintf(&builder, "Last server start: %s\n", formatEvent(*lastStarted))
+	}
+	if lastStopping != nil && (lastStarted == nil || !lastStopping.Time.Before(lastStarted.Time)) {
+		fmt.Fprintf(&builder, "Last server stop:  %s\n", formatEvent(*lastStopping))
+	}
+	fmt.Fprintf(&builder, "Last event:        %s\n", formatEvent(events[len(events)-1]))
+	fmt.Fprintf(&builder, "Recorded events: %d, of which failures: %d\n", len(events), failures)
+	fmt.Fprintln(&builder, "Use `status --events` to list all the recorded events.")
+	return builder.String()
+}
+
+func formatEvent(event entities.Event) string {
+	line := fmt.Sprintf("%s %s %s", event.Time.Format(time.RFC3339), event.Kind, event.Message)
+	for _, key := range slices.Sorted(maps.Keys(event.Details)) {
+		line += fmt.Sprintf(" %s=%v", key, event.Details[key])
+	}
+	return line
+}
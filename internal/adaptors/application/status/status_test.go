@@ -0,0 +1,221 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package status_test
+
+import (
+	"bytes"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/status"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testEvents() []entities.Event {
+	return []entities.Event{
+		{
+			Time:    time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+			Kind:    entities.EventKindServerStarted,
+			Message: "Server started",
+			Details: map[string]any{"pid": float64(1234), "version": "v1.2.2", "commit": "4567cdef"},
+		},
+		{
+			Time:    time.Date(2025, 1, 2, 3, 5, 0, 0, time.UTC),
+			Kind:    entities.EventKindToolCallFailed,
+			Message: "Tool call failed",
+			Details: map[string]any{"tool-name": "evaluate_matlab_code", "error": "boom"},
+		},
+	}
+}
+
+func testBuildInfo() entities.BuildInfo {
+	return entities.BuildInfo{
+		Version:   "v1.2.3",
+		Commit:    "0123abcd",
+		BuildDate: "2025-06-01T09:00:00Z",
+		GoVersion: "go1.24.4",
+		OS:        "linux",
+		Arch:      "amd64",
+	}
+}
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockEventReader := &mocks.MockEventReader{}
+	defer mockEventReader.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	// Act
+	statusInstance := status.New(mockConfig, mockEventReader, mockOSLayer)
+
+	// Assert
+	assert.NotNil(t, statusInstance, "Status instance should not be nil")
+}
+
+func TestStatus_StartAndWaitForCompletion_Summary(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockEventReader := &mocks.MockEventReader{}
+	defer mockEventReader.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	stdout := &bytes.Buffer{}
+
+	mockEventReader.EXPECT().
+		Read().
+		Return(testEvents(), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(stdout).
+		Once()
+
+	mockConfig.EXPECT().
+		StatusEvents().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		BuildInfo().
+		Return(testBuildInfo()).
+		Once()
+
+	statusInstance := status.New(mockConfig, mockEventReader, mockOSLayer)
+
+	// Act
+	err := statusInstance.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t,
+		"Installed version: v1.2.3 (commit 0123abcd, built 2025-06-01T09:00:00Z, go1.24.4 linux/amd64)\n"+
+			"Last server start: 2025-01-02T03:04:05Z server-started Server started commit=4567cdef pid=1234 version=v1.2.2\n"+
+			"Last event:        2025-01-02T03:05:00Z tool-call-failed Tool call failed error=boom tool-name=evaluate_matlab_code\n"+
+			"Recorded events: 2, of which failures: 1\n"+
+			"Use `status --events` to list all the recorded events.\n",
+		stdout.String(),
+	)
+}
+
+func TestStatus_StartAndWaitForCompletion_Events(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockEventReader := &mocks.MockEventReader{}
+	defer mockEventReader.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	stdout := &bytes.Buffer{}
+
+	mockEventReader.EXPECT().
+		Read().
+		Return(testEvents(), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(stdout).
+		Once()
+
+	mockConfig.EXPECT().
+		StatusEvents().
+		Return(true).
+		Once()
+
+	statusInstance := status.New(mockConfig, mockEventReader, mockOSLayer)
+
+	// Act
+	err := statusInstance.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t,
+		"2025-01-02T03:04:05Z server-started Server started commit=4567cdef pid=1234 version=v1.2.2\n"+
+			"2025-01-02T03:05:00Z tool-call-failed Tool call failed error=boom tool-name=evaluate_matlab_code\n",
+		stdout.String(),
+	)
+}
+
+func TestStatus_StartAndWaitForCompletion_NoEventsRecorded(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockEventReader := &mocks.MockEventReader{}
+	defer mockEventReader.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	stdout := &bytes.Buffer{}
+
+	mockEventReader.EXPECT().
+		Read().
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(stdout).
+		Once()
+
+	mockConfig.EXPECT().
+		BuildInfo().
+		Return(testBuildInfo()).
+		Once()
+
+	statusInstance := status.New(mockConfig, mockEventReader, mockOSLayer)
+
+	// Act
+	err := statusInstance.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t,
+		"Installed version: v1.2.3 (commit 0123abcd, built 2025-06-01T09:00:00Z, go1.24.4 linux/amd64)\n"+
+			"No events recorded by the MATLAB MCP Core Server yet.\n",
+		stdout.String(),
+	)
+}
+
+func TestStatus_StartAndWaitForCompletion_ReadError(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockEventReader := &mocks.MockEventReader{}
+	defer mockEventReader.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockEventReader.EXPECT().
+		Read().
+		Return(nil, assert.AnError).
+		Once()
+
+	statusInstance := status.New(mockConfig, mockEventReader, mockOSLayer)
+
+	// Act
+	err := statusInstance.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
@@ -0,0 +1,85 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package stop
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"time"
+)
+
+var (
+	// stopTimeout is how long the server is given to shut down, which includes stopping its MATLAB sessions.
+	stopTimeout = time.Minute
+
+	pollInterval = 100 * time.Millisecond
+)
+
+type InstanceLock interface {
+	Path() string
+	Holder() (int, bool, error)
+	IsProcessRunning(pid int) bool
+	Terminate(pid int) error
+}
+
+type OSLayer interface {
+	Stdout() io.Writer
+}
+
+// Stop stops the running server, found from the PID in its lock file, and waits for it to shut down,
+// so that scripts do not have to find and signal the server process themselves.
+type Stop struct {
+	instanceLock InstanceLock
+	osLayer      OSLayer
+}
+
+func New(
+	instanceLock InstanceLock,
+	osLayer OSLayer,
+) *Stop {
+	return &Stop{
+		instanceLock: instanceLock,
+		osLayer:      osLayer,
+	}
+}
+
+func (s *Stop) StartAndWaitForCompletion(ctx context.Context) error {
+	stdout := s.osLayer.Stdout()
+
+	pid, running, err := s.instanceLock.Holder()
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to read the lock file %s: %w", s.instanceLock.Path(), err)
+	}
+	if err != nil || !running {
+		_, err := fmt.Fprintln(stdout, "No MATLAB MCP Core Server is running.")
+		return err
+	}
+
+	if err := s.instanceLock.Terminate(pid); err != nil {
+		return fmt.Errorf("failed to stop the server (PID %d): %w", pid, err)
+	}
+
+	if _, err := fmt.Fprintf(stdout, "Stopping the MATLAB MCP Core Server (PID %d)...\n", pid); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, stopTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for s.instanceLock.IsProcessRunning(pid) {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("the server (PID %d) did not stop within %s", pid, stopTimeout)
+		case <-ticker.C:
+		}
+	}
+
+	_, err = fmt.Fprintf(stdout, "Stopped the MATLAB MCP Core Server (PID %d).\n", pid)
+	return err
+}
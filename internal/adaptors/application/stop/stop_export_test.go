@@ -0,0 +1,16 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package stop
+
+import (
+	"testing"
+	"time"
+)
+
+func SetStopTimeout(t *testing.T, newStopTimeout time.Duration, newPollInterval time.Duration) {
+	originalStopTimeout, originalPollInterval := stopTimeout, pollInterval
+	t.Cleanup(func() {
+		stopTimeout, pollInterval = originalStopTimeout, originalPollInterval
+	})
+	stopTimeout, pollInterval = newStopTimeout, newPollInterval
+}
@@ -0,0 +1,189 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package stop_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/stop"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/stop"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	serverPID    = 1234
+	lockFilePath = "/tmp/matlab-mcp-core-server.lock"
+)
+
+func TestStop_StartAndWaitForCompletion_HappyPath(t *testing.T) {
+	// Arrange
+	mockInstanceLock := &mocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	stop.SetStopTimeout(t, time.Second, time.Millisecond)
+
+	stdout := &bytes.Buffer{}
+	mockOSLayer.EXPECT().Stdout().Return(stdout).Once()
+
+	mockInstanceLock.EXPECT().
+		Holder().
+		Return(serverPID, true, nil).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		Terminate(serverPID).
+		Return(nil).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		IsProcessRunning(serverPID).
+		Return(true).
+		Twice()
+
+	mockInstanceLock.EXPECT().
+		IsProcessRunning(serverPID).
+		Return(false).
+		Once()
+
+	// Act
+	err := stop.New(mockInstanceLock, mockOSLayer).StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "Stopping the MATLAB MCP Core Server (PID 1234)...\nStopped the MATLAB MCP Core Server (PID 1234).\n", stdout.String())
+}
+
+func TestStop_StartAndWaitForCompletion_NotRunning(t *testing.T) {
+	testConfigs := []struct {
+		name    string
+		pid     int
+		running bool
+		err     error
+	}{
+		{name: "no lock file", err: fmt.Errorf("open %s: %w", lockFilePath, fs.ErrNotExist)},
+		{name: "stale lock file", pid: serverPID},
+		{name: "lock file without PID"},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockInstanceLock := &mocks.MockInstanceLock{}
+			defer mockInstanceLock.AssertExpectations(t)
+
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			stdout := &bytes.Buffer{}
+			mockOSLayer.EXPECT().Stdout().Return(stdout).Once()
+
+			mockInstanceLock.EXPECT().
+				Holder().
+				Return(testConfig.pid, testConfig.running, testConfig.err).
+				Once()
+
+			// Act
+			err := stop.New(mockInstanceLock, mockOSLayer).StartAndWaitForCompletion(t.Context())
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, "No MATLAB MCP Core Server is running.\n", stdout.String())
+		})
+	}
+}
+
+func TestStop_StartAndWaitForCompletion_LockFileReadError(t *testing.T) {
+	// Arrange
+	mockInstanceLock := &mocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().Stdout().Return(&bytes.Buffer{}).Once()
+
+	mockInstanceLock.EXPECT().
+		Holder().
+		Return(0, false, assert.AnError).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		Path().
+		Return(lockFilePath).
+		Once()
+
+	// Act
+	err := stop.New(mockInstanceLock, mockOSLayer).StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Contains(t, err.Error(), lockFilePath)
+}
+
+func TestStop_StartAndWaitForCompletion_TerminateError(t *testing.T) {
+	// Arrange
+	mockInstanceLock := &mocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().Stdout().Return(&bytes.Buffer{}).Once()
+
+	mockInstanceLock.EXPECT().
+		Holder().
+		Return(serverPID, true, nil).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		Terminate(serverPID).
+		Return(assert.AnError).
+		Once()
+
+	// Act
+	err := stop.New(mockInstanceLock, mockOSLayer).StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+func TestStop_StartAndWaitForCompletion_Timeout(t *testing.T) {
+	// Arrange
+	mockInstanceLock := &mocks.MockInstanceLock{}
+	defer mockInstanceLock.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	stop.SetStopTimeout(t, 20*time.Millisecond, time.Millisecond)
+
+	mockOSLayer.EXPECT().Stdout().Return(&bytes.Buffer{}).Once()
+
+	mockInstanceLock.EXPECT().
+		Holder().
+		Return(serverPID, true, nil).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		Terminate(serverPID).
+		Return(nil).
+		Once()
+
+	mockInstanceLock.EXPECT().
+		IsProcessRunning(serverPID).
+		Return(true)
+
+	// Act
+	err := stop.New(mockInstanceLock, mockOSLayer).StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.EqualError(t, err, "the server (PID 1234) did not stop within 20ms")
+}
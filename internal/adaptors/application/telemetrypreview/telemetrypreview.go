@@ -0,0 +1,58 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package telemetrypreview
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/telemetry"
+)
+
+type ReportReader interface {
+	Read() (telemetry.Report, error)
+}
+
+type OSLayer interface {
+	Stdout() io.Writer
+}
+
+// TelemetryPreview prints the usage report pending to be sent by the MATLAB MCP Core Server,
+// so users can check exactly what is reported before, or after, opting in.
+type TelemetryPreview struct {
+	reportReader ReportReader
+	osLayer      OSLayer
+}
+
+func New(
+	reportReader ReportReader,
+	osLayer OSLayer,
+) *TelemetryPreview {
+	return &TelemetryPreview{
+		reportReader: reportReader,
+		osLayer:      osLayer,
+	}
+}
+
+func (p *TelemetryPreview) StartAndWaitForCompletion(_ context.Context) error {
+	report, err := p.reportReader.Read()
+	if errors.Is(err, fs.ErrNotExist) {
+		_, err := fmt.Fprintln(p.osLayer.Stdout(), "No usage data is pending. Usage data is only collected when the server is started with --enable-telemetry.")
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read pending usage report: %w", err)
+	}
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(p.osLayer.Stdout(), "The following usage report is pending, and will be sent to the telemetry endpoint:\n%s\n", data)
+	return err
+}
@@ -0,0 +1,101 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package telemetrypreview_test
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/telemetrypreview"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/telemetry"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/telemetrypreview"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTelemetryPreview_StartAndWaitForCompletion_HappyPath(t *testing.T) {
+	// Arrange
+	mockReportReader := &mocks.MockReportReader{}
+	defer mockReportReader.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	stdout := &bytes.Buffer{}
+
+	mockReportReader.EXPECT().
+		Read().
+		Return(telemetry.Report{
+			SchemaVersion: telemetry.ReportSchemaVersion,
+			ToolCalls:     map[string]int{"evaluate_matlab_code": 4},
+		}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(stdout).
+		Once()
+
+	preview := telemetrypreview.New(mockReportReader, mockOSLayer)
+
+	// Act
+	err := preview.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "The following usage report is pending")
+	assert.Contains(t, stdout.String(), `"evaluate_matlab_code": 4`)
+}
+
+func TestTelemetryPreview_StartAndWaitForCompletion_NothingPending(t *testing.T) {
+	// Arrange
+	mockReportReader := &mocks.MockReportReader{}
+	defer mockReportReader.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	stdout := &bytes.Buffer{}
+
+	mockReportReader.EXPECT().
+		Read().
+		Return(telemetry.Report{}, os.ErrNotExist).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(stdout).
+		Once()
+
+	preview := telemetrypreview.New(mockReportReader, mockOSLayer)
+
+	// Act
+	err := preview.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "No usage data is pending")
+}
+
+func TestTelemetryPreview_StartAndWaitForCompletion_ReadError(t *testing.T) {
+	// Arrange
+	mockReportReader := &mocks.MockReportReader{}
+	defer mockReportReader.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockReportReader.EXPECT().
+		Read().
+		Return(telemetry.Report{}, assert.AnError).
+		Once()
+
+	preview := telemetrypreview.New(mockReportReader, mockOSLayer)
+
+	// Act
+	err := preview.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
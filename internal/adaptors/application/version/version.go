@@ -0,0 +1,119 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package version
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// labelWidth aligns the values of the lines printed after the version.
+const labelWidth = 12
+
+type Config interface {
+	Version() string
+	BuildInfo() entities.BuildInfo
+	ToolSets() []entities.ToolSet
+}
+
+type LoggerFactory interface {
+	GetGlobalLogger() entities.Logger
+}
+
+type MATLABRootGetter interface {
+	GetAll(logger entities.Logger) []string
+}
+
+type MATLABVersionGetter interface {
+	Get(matlabRootLocation string) (datatypes.MatlabVersionInfo, error)
+}
+
+type OSLayer interface {
+	Stdout() io.Writer
+}
+
+// Version prints the version of the server and how it was built, the MCP protocol versions it supports, the MATLAB
+// installations it finds, and the tool sets it would expose with the same arguments, so that they can be pinned in a
+// bug report.
+type Version struct {
+	config              Config
+	loggerFactory       LoggerFactory
+	matlabRootGetter    MATLABRootGetter
+	matlabVersionGetter MATLABVersionGetter
+	osLayer             OSLayer
+}
+
+func New(
+	config Config,
+	loggerFactory LoggerFactory,
+	matlabRootGetter MATLABRootGetter,
+	matlabVersionGetter MATLABVersionGetter,
+	osLayer OSLayer,
+) *Version {
+	return &Version{
+		config:              config,
+		loggerFactory:       loggerFactory,
+		matlabRootGetter:    matlabRootGetter,
+		matlabVersionGetter: matlabVersionGetter,
+		osLayer:             osLayer,
+	}
+}
+
+func (v *Version) StartAndWaitForCompletion(_ context.Context) error {
+	buildInfo := v.config.BuildInfo()
+
+	var output strings.Builder
+	output.WriteString(v.config.Version() + "\n")
+	writeLine(&output, "Commit:", buildInfo.Commit)
+	writeLine(&output, "Build date:", buildInfo.BuildDate)
+	writeLine(&output, "Go:", buildInfo.GoVersion)
+	writeLine(&output, "OS/Arch:", buildInfo.OS+"/"+buildInfo.Arch)
+	writeLine(&output, "MCP:", strings.Join(entities.MCPProtocolVersions, ", "))
+
+	matlabs := v.findMATLABs()
+	if len(matlabs) == 0 {
+		writeLine(&output, "MATLAB:", "none found")
+	}
+	for i, matlab := range matlabs {
+		label := ""
+		if i == 0 {
+			label = "MATLAB:"
+		}
+		writeLine(&output, label, matlab)
+	}
+
+	var toolSets []string
+	for _, toolSet := range v.config.ToolSets() {
+		toolSets = append(toolSets, string(toolSet))
+	}
+	writeLine(&output, "Tool sets:", strings.Join(toolSets, ", "))
+
+	_, err := fmt.Fprint(v.osLayer.Stdout(), output.String())
+	return err
+}
+
+// findMATLABs describes the MATLAB installations found on the system PATH and in the standard installation folders,
+// the same way the server finds them. The folders which are not a valid MATLAB installation are left out.
+func (v *Version) findMATLABs() []string {
+	logger := v.loggerFactory.GetGlobalLogger()
+
+	var matlabs []string
+	for _, root := range v.matlabRootGetter.GetAll(logger) {
+		version, err := v.matlabVersionGetter.Get(root)
+		if err != nil {
+			logger.WithError(err).With("matlab-root", root).Debug("Skipping invalid MATLAB installation")
+			continue
+		}
+		matlabs = append(matlabs, fmt.Sprintf("%s (%s)", version.ReleaseFamily, root))
+	}
+	return matlabs
+}
+
+func writeLine(output *strings.Builder, label string, value string) {
+	_, _ = fmt.Fprintf(output, "%-*s%s\n", labelWidth, label, value)
+}
@@ -0,0 +1,177 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package version_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/version"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	versionmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/application/version"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var buildInfo = entities.BuildInfo{
+	Version:   "v25.6.68",
+	Commit:    "0123abcd",
+	BuildDate: "2025-06-01T09:00:00Z",
+	GoVersion: "go1.24.4",
+	OS:        "linux",
+	Arch:      "amd64",
+}
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &versionmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &versionmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABRootGetter := &versionmocks.MockMATLABRootGetter{}
+	defer mockMATLABRootGetter.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &versionmocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockOSLayer := &versionmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	// Act
+	versionInstance := version.New(mockConfig, mockLoggerFactory, mockMATLABRootGetter, mockMATLABVersionGetter, mockOSLayer)
+
+	// Assert
+	assert.NotNil(t, versionInstance, "Version instance should not be nil")
+}
+
+func TestVersion_StartAndWaitForCompletion_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &versionmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &versionmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABRootGetter := &versionmocks.MockMATLABRootGetter{}
+	defer mockMATLABRootGetter.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &versionmocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockOSLayer := &versionmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	stdout := &bytes.Buffer{}
+
+	mockConfig.EXPECT().Version().Return("github.com/matlab/matlab-mcp-core-server v25.6.68").Once()
+	mockConfig.EXPECT().BuildInfo().Return(buildInfo).Once()
+	mockConfig.EXPECT().ToolSets().Return([]entities.ToolSet{entities.ToolSetMATLAB, entities.ToolSetCodeAnalysis}).Once()
+	mockLoggerFactory.EXPECT().GetGlobalLogger().Return(mockLogger).Once()
+	mockMATLABRootGetter.EXPECT().GetAll(mockLogger.AsMockArg()).Return([]string{"/usr/local/MATLAB/R2024a", "/opt/broken", "/usr/local/MATLAB/R2023b"}).Once()
+	mockMATLABVersionGetter.EXPECT().Get("/usr/local/MATLAB/R2024a").Return(datatypes.MatlabVersionInfo{ReleaseFamily: "R2024a"}, nil).Once()
+	mockMATLABVersionGetter.EXPECT().Get("/opt/broken").Return(datatypes.MatlabVersionInfo{}, errors.New("no VersionInfo.xml")).Once()
+	mockMATLABVersionGetter.EXPECT().Get("/usr/local/MATLAB/R2023b").Return(datatypes.MatlabVersionInfo{ReleaseFamily: "R2023b"}, nil).Once()
+	mockOSLayer.EXPECT().Stdout().Return(stdout).Once()
+
+	versionInstance := version.New(mockConfig, mockLoggerFactory, mockMATLABRootGetter, mockMATLABVersionGetter, mockOSLayer)
+
+	// Act
+	err := versionInstance.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "github.com/matlab/matlab-mcp-core-server v25.6.68\n"+
+		"Commit:     0123abcd\n"+
+		"Build date: 2025-06-01T09:00:00Z\n"+
+		"Go:         go1.24.4\n"+
+		"OS/Arch:    linux/amd64\n"+
+		"MCP:        2025-06-18, 2025-03-26, 2024-11-05\n"+
+		"MATLAB:     R2024a (/usr/local/MATLAB/R2024a)\n"+
+		"            R2023b (/usr/local/MATLAB/R2023b)\n"+
+		"Tool sets:  matlab, code-analysis\n", stdout.String())
+}
+
+func TestVersion_StartAndWaitForCompletion_NoMATLAB(t *testing.T) {
+	// Arrange
+	mockConfig := &versionmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &versionmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABRootGetter := &versionmocks.MockMATLABRootGetter{}
+	defer mockMATLABRootGetter.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &versionmocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockOSLayer := &versionmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	stdout := &bytes.Buffer{}
+
+	mockConfig.EXPECT().Version().Return("github.com/matlab/matlab-mcp-core-server v25.6.68").Once()
+	mockConfig.EXPECT().BuildInfo().Return(buildInfo).Once()
+	mockConfig.EXPECT().ToolSets().Return([]entities.ToolSet{entities.ToolSetMATLABSessions}).Once()
+	mockLoggerFactory.EXPECT().GetGlobalLogger().Return(mockLogger).Once()
+	mockMATLABRootGetter.EXPECT().GetAll(mockLogger.AsMockArg()).Return(nil).Once()
+	mockOSLayer.EXPECT().Stdout().Return(stdout).Once()
+
+	versionInstance := version.New(mockConfig, mockLoggerFactory, mockMATLABRootGetter, mockMATLABVersionGetter, mockOSLayer)
+
+	// Act
+	err := versionInstance.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "MATLAB:     none found\nTool sets:  matlab-sessions\n")
+}
+
+func TestVersion_StartAndWaitForCompletion_WriteError(t *testing.T) {
+	// Arrange
+	mockConfig := &versionmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &versionmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockMATLABRootGetter := &versionmocks.MockMATLABRootGetter{}
+	defer mockMATLABRootGetter.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &versionmocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockOSLayer := &versionmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockStdout := &entitiesmocks.MockWriter{}
+	defer mockStdout.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	expectedError := assert.AnError
+
+	mockConfig.EXPECT().Version().Return("github.com/matlab/matlab-mcp-core-server v25.6.68").Once()
+	mockConfig.EXPECT().BuildInfo().Return(buildInfo).Once()
+	mockConfig.EXPECT().ToolSets().Return(nil).Once()
+	mockLoggerFactory.EXPECT().GetGlobalLogger().Return(mockLogger).Once()
+	mockMATLABRootGetter.EXPECT().GetAll(mockLogger.AsMockArg()).Return(nil).Once()
+	mockOSLayer.EXPECT().Stdout().Return(mockStdout).Once()
+	mockStdout.EXPECT().Write(mock.Anything).Return(0, expectedError).Once()
+
+	versionInstance := version.New(mockConfig, mockLoggerFactory, mockMATLABRootGetter, mockMATLABVersionGetter, mockOSLayer)
+
+	// Act
+	err := versionInstance.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+}
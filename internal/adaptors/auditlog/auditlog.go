@@ -0,0 +1,249 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package auditlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/clientidentity"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// maxErrorLength is the number of bytes of the error of a failed tool call kept in its entry.
+const maxErrorLength = 1024
+
+// fileMode only lets the owner of the server read the audit log, as it holds the code run by the clients.
+const fileMode = 0o600
+
+type Status string
+
+const (
+	StatusSuccess Status = "success"
+	StatusError   Status = "error"
+)
+
+// Entry is a line of the audit log.
+type Entry struct {
+	Time          time.Time       `json:"time"`
+	StartedAt     time.Time       `json:"started_at"`
+	DurationMS    int64           `json:"duration_ms"`
+	CorrelationID string          `json:"correlation_id,omitempty"`
+	SessionID     string          `json:"session_id,omitempty"`
+	User          string          `json:"user,omitempty"`
+	Client        string          `json:"client,omitempty"`
+	Tool          string          `json:"tool"`
+	Arguments     json.RawMessage `json:"arguments,omitempty"`
+	Status        Status          `json:"status"`
+	Error         string          `json:"error,omitempty"`
+}
+
+// ToolCall is a tool call to write to the audit log, once it returned.
+type ToolCall struct {
+	StartedAt     time.Time
+	CorrelationID string
+	SessionID     string
+	Identity      clientidentity.Identity
+	Tool          string
+	Arguments     json.RawMessage
+	Result        *mcp.CallToolResult
+	Err           error
+}
+
+type Config interface {
+	AuditLogFile() string
+	AuditLogMaxMB() int
+}
+
+type OSLayer interface {
+	OpenFile(name string, flag int, perm os.FileMode) (osfacade.File, error)
+	Stat(name string) (osfacade.FileInfo, error)
+	Rename(oldPath string, newPath string) error
+}
+
+type LoggerFactory interface {
+	GetGlobalLogger() entities.Logger
+}
+
+type LifecycleSignaler interface {
+	AddShutdownFunction(shutdownFcn func() error)
+}
+
+// AuditLog appends every tool call of the server to a file, one line of JSON per call, for the review of what the
+// clients did. The file is only ever appended to. Once it grows above the maximum size, it is renamed after the time
+// of the rotation, and a new file is started. Rotated files are never deleted by the server.
+type AuditLog struct {
+	logger   entities.Logger
+	osLayer  OSLayer
+	path     string
+	maxBytes int64
+
+	lock *sync.Mutex
+	file osfacade.File
+	size int64
+}
+
+func New(
+	config Config,
+	osLayer OSLayer,
+	loggerFactory LoggerFactory,
+	lifecycleSignaler LifecycleSignaler,
+) (*AuditLog, error) {
+	auditLog := &AuditLog{
+		logger:   loggerFactory.GetGlobalLogger().With("component", "audit-log"),
+		osLayer:  osLayer,
+		path:     config.AuditLogFile(),
+		maxBytes: int64(config.AuditLogMaxMB()) * 1024 * 1024,
+		lock:     new(sync.Mutex),
+	}
+
+	if auditLog.path == "" {
+		return auditLog, nil
+	}
+
+	if err := auditLog.open(); err != nil {
+		return nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+
+	lifecycleSignaler.AddShutdownFunction(auditLog.close)
+
+	auditLog.logger.With("path", auditLog.path).Info("Writing tool calls to the audit log")
+
+	return auditLog, nil
+}
+
+// Enabled is true when tool calls are written to the audit log.
+func (a *AuditLog) Enabled() bool {
+	return a.path != ""
+}
+
+// RecordToolCall appends a tool call to the audit log.
+// Failing to write the audit log is logged, and does not fail the tool call.
+func (a *AuditLog) RecordToolCall(call ToolCall) {
+	now := time.Now().UTC()
+
+	entry := Entry{
+		Time:          now,
+		StartedAt:     call.StartedAt.UTC(),
+		DurationMS:    now.Sub(call.StartedAt).Milliseconds(),
+		CorrelationID: call.CorrelationID,
+		SessionID:     call.SessionID,
+		User:          call.Identity.User,
+		Client:        call.Identity.Client,
+		Tool:          call.Tool,
+		Arguments:     call.Arguments,
+		Status:        StatusSuccess,
+	}
+
+	switch {
+	case call.Err != nil:
+		entry.Status = StatusError
+		entry.Error = truncate(call.Err.Error())
+	case call.Result != nil && call.Result.IsError:
+		entry.Status = StatusError
+		entry.Error = truncate(resultText(call.Result))
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		a.logger.WithError(err).Error("Failed to serialize audit log entry")
+		return
+	}
+
+	if err := a.append(append(line, '\n')); err != nil {
+		a.logger.WithError(err).Error("Failed to write audit log")
+	}
+}
+
+func (a *AuditLog) append(line []byte) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if a.file == nil {
+		return nil
+	}
+
+	if a.maxBytes > 0 && a.size > 0 && a.size+int64(len(line)) > a.maxBytes {
+		if err := a.rotate(); err != nil {
+			return fmt.Errorf("failed to rotate audit log: %w", err)
+		}
+	}
+
+	written, err := a.file.Write(line)
+	a.size += int64(written)
+	return err
+}
+
+// rotate renames the current file after the time of the rotation, such as audit-20250102T150405.000000000Z.jsonl,
+// and starts a new one.
+func (a *AuditLog) rotate() error {
+	if err := a.file.Close(); err != nil {
+		return err
+	}
+	a.file = nil
+
+	extension := filepath.Ext(a.path)
+	rotatedPath := fmt.Sprintf("%s-%s%s", strings.TrimSuffix(a.path, extension), time.Now().UTC().Format("20060102T150405.000000000Z"), extension)
+	if err := a.osLayer.Rename(a.path, rotatedPath); err != nil {
+		return err
+	}
+
+	a.logger.With("path", rotatedPath).Info("Rotated the audit log")
+
+	return a.open()
+}
+
+// open opens the file for appending, creating it when needed, and picks up the size it already has.
+func (a *AuditLog) open() error {
+	file, err := a.osLayer.OpenFile(a.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, fileMode)
+	if err != nil {
+		return err
+	}
+
+	info, err := a.osLayer.Stat(a.path)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	a.file = file
+	a.size = info.Size()
+	return nil
+}
+
+func (a *AuditLog) close() error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if a.file == nil {
+		return nil
+	}
+
+	err := a.file.Close()
+	a.file = nil
+	return err
+}
+
+// resultText is the text of a failed tool call result, which holds the reason of the failure.
+func resultText(result *mcp.CallToolResult) string {
+	for _, content := range result.Content {
+		if textContent, ok := content.(*mcp.TextContent); ok {
+			return textContent.Text
+		}
+	}
+	return ""
+}
+
+func truncate(text string) string {
+	if len(text) <= maxErrorLength {
+		return text
+	}
+	return strings.ToValidUTF8(text[:maxErrorLength], "") + "…"
+}
@@ -0,0 +1,321 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package auditlog_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/auditlog"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/clientidentity"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/auditlog"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newAuditLog returns an audit log writing to path through the real file system, and the function closing it on shutdown.
+func newAuditLog(t *testing.T, path string, maxMB int) (*auditlog.AuditLog, func() error) {
+	t.Helper()
+
+	mockConfig := &mocks.MockConfig{}
+	t.Cleanup(func() { mockConfig.AssertExpectations(t) })
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	t.Cleanup(func() { mockOSLayer.AssertExpectations(t) })
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	t.Cleanup(func() { mockLoggerFactory.AssertExpectations(t) })
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	t.Cleanup(func() { mockLifecycleSignaler.AssertExpectations(t) })
+
+	osFacade := osfacade.New()
+
+	mockConfig.EXPECT().
+		AuditLogFile().
+		Return(path).
+		Once()
+
+	mockConfig.EXPECT().
+		AuditLogMaxMB().
+		Return(maxMB).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	mockOSLayer.EXPECT().
+		OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, os.FileMode(0o600)).
+		RunAndReturn(osFacade.OpenFile)
+
+	mockOSLayer.EXPECT().
+		Stat(path).
+		RunAndReturn(osFacade.Stat)
+
+	mockOSLayer.EXPECT().
+		Rename(path, mock.Anything).
+		RunAndReturn(osFacade.Rename).
+		Maybe()
+
+	var shutdown func() error
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.Anything).
+		Run(func(shutdownFcn func() error) {
+			shutdown = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	auditLog, err := auditlog.New(mockConfig, mockOSLayer, mockLoggerFactory, mockLifecycleSignaler)
+	require.NoError(t, err)
+
+	return auditLog, shutdown
+}
+
+func readEntries(t *testing.T, path string) []auditlog.Entry {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var entries []auditlog.Entry
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		var entry auditlog.Entry
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		entries = append(entries, entry)
+	}
+	require.NoError(t, scanner.Err())
+	return entries
+}
+
+func TestNew_Disabled(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		AuditLogFile().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		AuditLogMaxMB().
+		Return(100).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	// Act
+	auditLog, err := auditlog.New(mockConfig, &mocks.MockOSLayer{}, mockLoggerFactory, &mocks.MockLifecycleSignaler{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, auditLog.Enabled())
+	auditLog.RecordToolCall(auditlog.ToolCall{Tool: "evaluate_matlab_code"})
+}
+
+func TestNew_OpenFileError(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		AuditLogFile().
+		Return("/var/log/audit.jsonl").
+		Once()
+
+	mockConfig.EXPECT().
+		AuditLogMaxMB().
+		Return(100).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	mockOSLayer.EXPECT().
+		OpenFile("/var/log/audit.jsonl", os.O_APPEND|os.O_CREATE|os.O_WRONLY, os.FileMode(0o600)).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	auditLog, err := auditlog.New(mockConfig, mockOSLayer, mockLoggerFactory, &mocks.MockLifecycleSignaler{})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, auditLog)
+}
+
+func TestAuditLog_RecordToolCall_HappyPath(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	auditLog, shutdown := newAuditLog(t, path, 100)
+	require.True(t, auditLog.Enabled())
+
+	startedAt := time.Now().Add(-2 * time.Second)
+
+	// Act
+	auditLog.RecordToolCall(auditlog.ToolCall{
+		StartedAt:     startedAt,
+		CorrelationID: "c0ffee",
+		SessionID:     "session-1",
+		Identity:      clientidentity.Identity{User: "alice", Client: "claude-code 1.0.0"},
+		Tool:          "evaluate_matlab_code",
+		Arguments:     json.RawMessage(`{"code":"x = 1"}`),
+		Result:        &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: "x = 1"}}},
+	})
+	auditLog.RecordToolCall(auditlog.ToolCall{
+		StartedAt: startedAt,
+		Tool:      "evaluate_matlab_code",
+		Arguments: json.RawMessage(`{"code":"error('boom')"}`),
+		Result:    &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "boom"}}},
+	})
+	auditLog.RecordToolCall(auditlog.ToolCall{
+		StartedAt: startedAt,
+		Tool:      "unknown_tool",
+		Err:       assert.AnError,
+	})
+	require.NoError(t, shutdown())
+
+	// Assert
+	entries := readEntries(t, path)
+	require.Len(t, entries, 3)
+
+	assert.Equal(t, "c0ffee", entries[0].CorrelationID)
+	assert.Equal(t, "session-1", entries[0].SessionID)
+	assert.Equal(t, "alice", entries[0].User)
+	assert.Equal(t, "claude-code 1.0.0", entries[0].Client)
+	assert.Equal(t, "evaluate_matlab_code", entries[0].Tool)
+	assert.JSONEq(t, `{"code":"x = 1"}`, string(entries[0].Arguments))
+	assert.Equal(t, auditlog.StatusSuccess, entries[0].Status)
+	assert.Empty(t, entries[0].Error)
+	assert.True(t, entries[0].StartedAt.Equal(startedAt.UTC()))
+	assert.GreaterOrEqual(t, entries[0].DurationMS, int64(2000))
+
+	assert.Equal(t, auditlog.StatusError, entries[1].Status)
+	assert.Equal(t, "boom", entries[1].Error)
+
+	assert.Equal(t, auditlog.StatusError, entries[2].Status)
+	assert.Equal(t, assert.AnError.Error(), entries[2].Error)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+}
+
+func TestAuditLog_RecordToolCall_AppendsToExistingFile(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	existing := `{"tool":"earlier_call","status":"success"}` + "\n"
+	require.NoError(t, os.WriteFile(path, []byte(existing), 0o600))
+
+	auditLog, shutdown := newAuditLog(t, path, 100)
+
+	// Act
+	auditLog.RecordToolCall(auditlog.ToolCall{StartedAt: time.Now(), Tool: "evaluate_matlab_code"})
+	require.NoError(t, shutdown())
+
+	// Assert
+	entries := readEntries(t, path)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "earlier_call", entries[0].Tool)
+	assert.Equal(t, "evaluate_matlab_code", entries[1].Tool)
+}
+
+func TestAuditLog_RecordToolCall_Rotates(t *testing.T) {
+	// Arrange
+	folder := t.TempDir()
+	path := filepath.Join(folder, "audit.jsonl")
+	require.NoError(t, os.WriteFile(path, bytes.Repeat([]byte("x"), 1024*1024), 0o600))
+
+	auditLog, shutdown := newAuditLog(t, path, 1)
+
+	// Act
+	auditLog.RecordToolCall(auditlog.ToolCall{StartedAt: time.Now(), Tool: "evaluate_matlab_code"})
+	require.NoError(t, shutdown())
+
+	// Assert
+	entries := readEntries(t, path)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "evaluate_matlab_code", entries[0].Tool)
+
+	files, err := os.ReadDir(folder)
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+
+	var rotated string
+	for _, file := range files {
+		if file.Name() != "audit.jsonl" {
+			rotated = file.Name()
+		}
+	}
+	assert.True(t, strings.HasPrefix(rotated, "audit-"), rotated)
+	assert.True(t, strings.HasSuffix(rotated, ".jsonl"), rotated)
+
+	rotatedInfo, err := os.Stat(filepath.Join(folder, rotated))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1024*1024), rotatedInfo.Size())
+}
+
+func TestAuditLog_RecordToolCall_NeverRotatesWithoutMaxSize(t *testing.T) {
+	// Arrange
+	folder := t.TempDir()
+	path := filepath.Join(folder, "audit.jsonl")
+	require.NoError(t, os.WriteFile(path, bytes.Repeat([]byte("x"), 1024*1024), 0o600))
+
+	auditLog, shutdown := newAuditLog(t, path, 0)
+
+	// Act
+	auditLog.RecordToolCall(auditlog.ToolCall{StartedAt: time.Now(), Tool: "evaluate_matlab_code"})
+	require.NoError(t, shutdown())
+
+	// Assert
+	files, err := os.ReadDir(folder)
+	require.NoError(t, err)
+	assert.Len(t, files, 1)
+}
+
+func TestAuditLog_RecordToolCall_TruncatesLongErrors(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	auditLog, shutdown := newAuditLog(t, path, 100)
+
+	// Act
+	auditLog.RecordToolCall(auditlog.ToolCall{
+		StartedAt: time.Now(),
+		Tool:      "evaluate_matlab_code",
+		Result:    &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: strings.Repeat("e", 4096)}}},
+	})
+	require.NoError(t, shutdown())
+
+	// Assert
+	entries := readEntries(t, path)
+	require.Len(t, entries, 1)
+	assert.Equal(t, strings.Repeat("e", 1024)+"…", entries[0].Error)
+}
@@ -0,0 +1,103 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package configfile
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	folderName = "matlab-mcp"
+	fileName   = "config.yaml"
+)
+
+type OSLayer interface {
+	ReadFile(name string) ([]byte, error)
+	UserConfigDir() (string, error)
+}
+
+// ConfigFile reads the settings of the server from a YAML file, so that long command lines need not be repeated in the
+// configuration of every AI application. The keys of the file are the names of the command line flags.
+type ConfigFile struct {
+	osLayer OSLayer
+}
+
+func New(
+	osLayer OSLayer,
+) *ConfigFile {
+	return &ConfigFile{
+		osLayer: osLayer,
+	}
+}
+
+// DefaultPath is the config file read when none is given: config.yaml in the matlab-mcp folder of the configuration
+// folder of the user, such as ~/.config/matlab-mcp/config.yaml on Linux. It is empty when the user has no configuration
+// folder.
+func (f *ConfigFile) DefaultPath() string {
+	folder, err := f.osLayer.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(folder, folderName, fileName)
+}
+
+// Read returns the settings of the config file at path, as the values of the command line flags by flag name, and false
+// when the file does not exist. A list sets a flag that can be repeated once for each of its items.
+func (f *ConfigFile) Read(path string) (map[string][]string, bool, error) {
+	data, err := f.osLayer.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read config file %s: %w", path, err)
+	}
+
+	var document map[string]any
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, false, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	settings := make(map[string][]string, len(document))
+	for name, value := range document {
+		values, err := flagValues(value)
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid setting %s in config file %s: %w", name, path, err)
+		}
+		settings[name] = values
+	}
+
+	return settings, true, nil
+}
+
+// flagValues converts the value of a setting to the values of its flag, as they would be written on the command line.
+func flagValues(value any) ([]string, error) {
+	items, isList := value.([]any)
+	if !isList {
+		items = []any{value}
+	}
+
+	values := make([]string, 0, len(items))
+	for _, item := range items {
+		switch item := item.(type) {
+		case string:
+			values = append(values, item)
+		case bool:
+			values = append(values, strconv.FormatBool(item))
+		case int:
+			values = append(values, strconv.Itoa(item))
+		case float64:
+			values = append(values, strconv.FormatFloat(item, 'f', -1, 64))
+		case nil:
+			values = append(values, "")
+		default:
+			return nil, fmt.Errorf("use a string, a number, a boolean, or a list of them")
+		}
+	}
+	return values, nil
+}
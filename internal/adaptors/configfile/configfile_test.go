@@ -0,0 +1,197 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package configfile_test
+
+import (
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/configfile"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/configfile"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const configFilePath = "/home/user/.config/matlab-mcp/config.yaml"
+
+func TestConfigFile_DefaultPath_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		UserConfigDir().
+		Return("/home/user/.config", nil).
+		Once()
+
+	configFile := configfile.New(mockOSLayer)
+
+	// Act
+	path := configFile.DefaultPath()
+
+	// Assert
+	assert.Equal(t, filepath.Join("/home/user/.config", "matlab-mcp", "config.yaml"), path)
+}
+
+func TestConfigFile_DefaultPath_NoConfigFolder(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		UserConfigDir().
+		Return("", assert.AnError).
+		Once()
+
+	configFile := configfile.New(mockOSLayer)
+
+	// Act
+	path := configFile.DefaultPath()
+
+	// Assert
+	assert.Empty(t, path)
+}
+
+func TestConfigFile_Read_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	data := `
+matlab-root: /opt/matlab/R2025a
+log-level: debug
+initialize-matlab-on-startup: true
+matlab-session-timeout: 30m
+max-concurrent-evals: 4
+figure-resolution: 1.5
+allowed-path:
+  - /home/user/project
+  - /home/user/data
+`
+
+	mockOSLayer.EXPECT().
+		ReadFile(configFilePath).
+		Return([]byte(data), nil).
+		Once()
+
+	configFile := configfile.New(mockOSLayer)
+
+	// Act
+	settings, found, err := configFile.Read(configFilePath)
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, map[string][]string{
+		"matlab-root":                  {"/opt/matlab/R2025a"},
+		"log-level":                    {"debug"},
+		"initialize-matlab-on-startup": {"true"},
+		"matlab-session-timeout":       {"30m"},
+		"max-concurrent-evals":         {"4"},
+		"figure-resolution":            {"1.5"},
+		"allowed-path":                 {"/home/user/project", "/home/user/data"},
+	}, settings)
+}
+
+func TestConfigFile_Read_EmptyFile(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		ReadFile(configFilePath).
+		Return([]byte{}, nil).
+		Once()
+
+	configFile := configfile.New(mockOSLayer)
+
+	// Act
+	settings, found, err := configFile.Read(configFilePath)
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Empty(t, settings)
+}
+
+func TestConfigFile_Read_NotFound(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		ReadFile(configFilePath).
+		Return(nil, fs.ErrNotExist).
+		Once()
+
+	configFile := configfile.New(mockOSLayer)
+
+	// Act
+	settings, found, err := configFile.Read(configFilePath)
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, found)
+	assert.Nil(t, settings)
+}
+
+func TestConfigFile_Read_ReadError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		ReadFile(configFilePath).
+		Return(nil, assert.AnError).
+		Once()
+
+	configFile := configfile.New(mockOSLayer)
+
+	// Act
+	_, found, err := configFile.Read(configFilePath)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.False(t, found)
+}
+
+func TestConfigFile_Read_InvalidYAML(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		ReadFile(configFilePath).
+		Return([]byte("- not\n- a mapping\n"), nil).
+		Once()
+
+	configFile := configfile.New(mockOSLayer)
+
+	// Act
+	_, found, err := configFile.Read(configFilePath)
+
+	// Assert
+	require.ErrorContains(t, err, "failed to parse config file")
+	assert.False(t, found)
+}
+
+func TestConfigFile_Read_NestedSetting(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		ReadFile(configFilePath).
+		Return([]byte("log-level:\n  value: debug\n"), nil).
+		Once()
+
+	configFile := configfile.New(mockOSLayer)
+
+	// Act
+	_, found, err := configFile.Read(configFilePath)
+
+	// Assert
+	require.ErrorContains(t, err, "invalid setting log-level")
+	assert.False(t, found)
+}
@@ -0,0 +1,213 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package configreloader
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+)
+
+const (
+	// pollInterval is how often the config file and the policy file are checked for changes.
+	pollInterval = 2 * time.Second
+
+	logLevelSetting          = "log-level"
+	logComponentLevelSetting = "log-component-level"
+	policyFileSetting        = "policy-file"
+	disableToolSetting       = "disable-tool"
+)
+
+type Config interface {
+	ConfigFile() string
+	PolicyFile() string
+	LogLevel() entities.LogLevel
+	LogComponentLevels() map[string]entities.LogLevel
+	Reload() (entities.ConfigChanges, error)
+}
+
+type LoggerFactory interface {
+	GetGlobalLogger() entities.Logger
+	SetLogLevels(logLevel entities.LogLevel, componentLogLevels map[string]entities.LogLevel) error
+}
+
+type LifecycleSignaler interface {
+	AddShutdownFunction(shutdownFcn func() error)
+}
+
+type ToolPolicy interface {
+	Reload() error
+}
+
+type ToolsReloader interface {
+	ReloadTools() error
+}
+
+type OSLayer interface {
+	Stat(name string) (osfacade.FileInfo, error)
+}
+
+// fileVersion identifies the content of a file without reading it. The zero value is a file which does not exist.
+type fileVersion struct {
+	modTime time.Time
+	size    int64
+}
+
+// Reloader watches the config file and the policy file, and applies the settings which can change while the server
+// runs when they change, without restarting the server or the MATLAB session it manages: the log levels, the limits
+// of the MATLAB calls, the disabled tools, and the rules of the policy file. A change of another setting is logged as
+// requiring a restart.
+//
+// The files are polled, so that a file replaced by an editor, rather than written in place, is still seen to change.
+// When the changed configuration is not valid, the settings in effect are kept.
+type Reloader struct {
+	config            Config
+	loggerFactory     LoggerFactory
+	logger            entities.Logger
+	lifecycleSignaler LifecycleSignaler
+	toolPolicy        ToolPolicy
+	toolsReloader     ToolsReloader
+	osLayer           OSLayer
+
+	// The versions are only accessed by the goroutine watching the files.
+	versions map[string]fileVersion
+}
+
+func New(
+	config Config,
+	loggerFactory LoggerFactory,
+	lifecycleSignaler LifecycleSignaler,
+	toolPolicy ToolPolicy,
+	toolsReloader ToolsReloader,
+	osLayer OSLayer,
+) *Reloader {
+	return &Reloader{
+		config:            config,
+		loggerFactory:     loggerFactory,
+		logger:            loggerFactory.GetGlobalLogger().With("component", "config-reloader"),
+		lifecycleSignaler: lifecycleSignaler,
+		toolPolicy:        toolPolicy,
+		toolsReloader:     toolsReloader,
+		osLayer:           osLayer,
+		versions:          make(map[string]fileVersion),
+	}
+}
+
+// Start watches the config file and the policy file until the server shuts down.
+// It does nothing when the server was started without either file.
+func (r *Reloader) Start() {
+	if r.config.ConfigFile() == "" && r.config.PolicyFile() == "" {
+		r.logger.Debug("No config file or policy file to watch")
+		return
+	}
+
+	r.recordVersions()
+
+	ctx, stop := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		r.run(ctx)
+	}()
+
+	r.lifecycleSignaler.AddShutdownFunction(func() error {
+		r.logger.Debug("Stopping config reloader")
+		stop()
+		<-done
+		return nil
+	})
+
+	r.logger.
+		With("config-file", r.config.ConfigFile()).
+		With("policy-file", r.config.PolicyFile()).
+		Info("Watching the configuration for changes")
+}
+
+func (r *Reloader) run(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.check()
+		}
+	}
+}
+
+func (r *Reloader) recordVersions() {
+	r.changed(r.config.ConfigFile())
+	r.changed(r.config.PolicyFile())
+}
+
+func (r *Reloader) check() {
+	configChanged := r.changed(r.config.ConfigFile())
+	policyChanged := r.changed(r.config.PolicyFile())
+
+	if configChanged {
+		changes, err := r.config.Reload()
+		if err != nil {
+			r.logger.WithError(err).Warn("Failed to reload the config file, keeping the settings in effect")
+		} else {
+			r.apply(changes)
+			if slices.Contains(changes.Applied, policyFileSetting) {
+				// The new policy file is read below, and watched from now on.
+				r.changed(r.config.PolicyFile())
+				policyChanged = true
+			}
+		}
+	}
+
+	if policyChanged {
+		if err := r.toolPolicy.Reload(); err != nil {
+			r.logger.WithError(err).Warn("Failed to reload the policy file, keeping the policy in effect")
+		} else {
+			r.logger.With("policy-file", r.config.PolicyFile()).Info("Policy reloaded")
+		}
+	}
+}
+
+func (r *Reloader) apply(changes entities.ConfigChanges) {
+	if slices.Contains(changes.Applied, logLevelSetting) || slices.Contains(changes.Applied, logComponentLevelSetting) {
+		if err := r.loggerFactory.SetLogLevels(r.config.LogLevel(), r.config.LogComponentLevels()); err != nil {
+			r.logger.WithError(err).Warn("Failed to change the log levels")
+		}
+	}
+
+	if slices.Contains(changes.Applied, disableToolSetting) {
+		if err := r.toolsReloader.ReloadTools(); err != nil {
+			r.logger.WithError(err).Warn("Failed to change the tools exposed to the clients")
+		}
+	}
+
+	if len(changes.Applied) > 0 {
+		r.logger.With("settings", changes.Applied).Info("Configuration reloaded")
+	}
+
+	if len(changes.RequiringRestart) > 0 {
+		r.logger.With("settings", changes.RequiringRestart).Warn("Settings changed in the config file only take effect once the server restarts")
+	}
+}
+
+// changed records the version of the file at path, and reports whether it differs from the version recorded before.
+// A file created or deleted is changed, and an empty path never changes.
+func (r *Reloader) changed(path string) bool {
+	if path == "" {
+		return false
+	}
+
+	var version fileVersion
+	if info, err := r.osLayer.Stat(path); err == nil {
+		version = fileVersion{modTime: info.ModTime(), size: info.Size()}
+	}
+
+	previous, seen := r.versions[path]
+	r.versions[path] = version
+	return seen && previous != version
+}
@@ -0,0 +1,11 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package configreloader
+
+func (r *Reloader) RecordVersions() {
+	r.recordVersions()
+}
+
+func (r *Reloader) Check() {
+	r.check()
+}
@@ -0,0 +1,331 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package configreloader_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/configreloader"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/configreloader"
+	osfacademocks "github.com/matlab/matlab-mcp-core-server/mocks/facades/osfacade"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	configFilePath    = "/home/user/.matlab-mcp/config.yaml"
+	policyFilePath    = "/home/user/policy.json"
+	newPolicyFilePath = "/home/user/new-policy.json"
+)
+
+var (
+	startTime  = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	changeTime = startTime.Add(time.Minute)
+)
+
+type reloaderMocks struct {
+	config            *mocks.MockConfig
+	loggerFactory     *mocks.MockLoggerFactory
+	lifecycleSignaler *mocks.MockLifecycleSignaler
+	toolPolicy        *mocks.MockToolPolicy
+	toolsReloader     *mocks.MockToolsReloader
+	osLayer           *mocks.MockOSLayer
+	logger            *testutils.InspectableLogger
+}
+
+func newReloader(t *testing.T) (*configreloader.Reloader, *reloaderMocks) {
+	t.Helper()
+
+	m := &reloaderMocks{
+		config:            &mocks.MockConfig{},
+		loggerFactory:     &mocks.MockLoggerFactory{},
+		lifecycleSignaler: &mocks.MockLifecycleSignaler{},
+		toolPolicy:        &mocks.MockToolPolicy{},
+		toolsReloader:     &mocks.MockToolsReloader{},
+		osLayer:           &mocks.MockOSLayer{},
+		logger:            testutils.NewInspectableLogger(),
+	}
+	t.Cleanup(func() {
+		m.config.AssertExpectations(t)
+		m.loggerFactory.AssertExpectations(t)
+		m.lifecycleSignaler.AssertExpectations(t)
+		m.toolPolicy.AssertExpectations(t)
+		m.toolsReloader.AssertExpectations(t)
+		m.osLayer.AssertExpectations(t)
+	})
+
+	m.loggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(m.logger).
+		Once()
+
+	reloader := configreloader.New(
+		m.config,
+		m.loggerFactory,
+		m.lifecycleSignaler,
+		m.toolPolicy,
+		m.toolsReloader,
+		m.osLayer,
+	)
+
+	return reloader, m
+}
+
+func (m *reloaderMocks) expectFiles(configFile string, policyFile string) {
+	m.config.EXPECT().
+		ConfigFile().
+		Return(configFile)
+
+	m.config.EXPECT().
+		PolicyFile().
+		Return(policyFile)
+}
+
+func (m *reloaderMocks) expectStat(t *testing.T, path string, modTime time.Time) {
+	t.Helper()
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	t.Cleanup(func() { mockFileInfo.AssertExpectations(t) })
+
+	mockFileInfo.EXPECT().
+		ModTime().
+		Return(modTime).
+		Once()
+
+	mockFileInfo.EXPECT().
+		Size().
+		Return(int64(42)).
+		Once()
+
+	m.osLayer.EXPECT().
+		Stat(path).
+		Return(mockFileInfo, nil).
+		Once()
+}
+
+func TestReloader_Check_HappyPath(t *testing.T) {
+	// Arrange
+	reloader, m := newReloader(t)
+	m.expectFiles(configFilePath, "")
+
+	m.expectStat(t, configFilePath, startTime)
+	reloader.RecordVersions()
+
+	m.expectStat(t, configFilePath, changeTime)
+
+	m.config.EXPECT().
+		Reload().
+		Return(entities.ConfigChanges{
+			Applied:          []string{"log-level", "max-eval-time", "disable-tool"},
+			RequiringRestart: []string{"read-only"},
+		}, nil).
+		Once()
+
+	m.config.EXPECT().
+		LogLevel().
+		Return(entities.LogLevelDebug).
+		Once()
+
+	m.config.EXPECT().
+		LogComponentLevels().
+		Return(nil).
+		Once()
+
+	m.loggerFactory.EXPECT().
+		SetLogLevels(entities.LogLevelDebug, map[string]entities.LogLevel(nil)).
+		Return(nil).
+		Once()
+
+	m.toolsReloader.EXPECT().
+		ReloadTools().
+		Return(nil).
+		Once()
+
+	// Act
+	reloader.Check()
+
+	// Assert
+	logs := m.logger.InfoLogs()
+	fields, found := logs["Configuration reloaded"]
+	assert.True(t, found, "The applied settings should be logged")
+	assert.Equal(t, []string{"log-level", "max-eval-time", "disable-tool"}, fields["settings"])
+
+	warnLogs := m.logger.WarnLogs()
+	fields, found = warnLogs["Settings changed in the config file only take effect once the server restarts"]
+	assert.True(t, found, "The settings requiring a restart should be logged")
+	assert.Equal(t, []string{"read-only"}, fields["settings"])
+}
+
+func TestReloader_Check_NoChange(t *testing.T) {
+	// Arrange
+	reloader, m := newReloader(t)
+	m.expectFiles(configFilePath, policyFilePath)
+
+	m.expectStat(t, configFilePath, startTime)
+	m.expectStat(t, policyFilePath, startTime)
+	reloader.RecordVersions()
+
+	m.expectStat(t, configFilePath, startTime)
+	m.expectStat(t, policyFilePath, startTime)
+
+	// Act
+	reloader.Check()
+
+	// Assert
+	m.config.AssertNotCalled(t, "Reload")
+	m.toolPolicy.AssertNotCalled(t, "Reload")
+}
+
+func TestReloader_Check_ReloadError(t *testing.T) {
+	// Arrange
+	reloader, m := newReloader(t)
+	m.expectFiles(configFilePath, "")
+
+	m.expectStat(t, configFilePath, startTime)
+	reloader.RecordVersions()
+
+	m.expectStat(t, configFilePath, changeTime)
+
+	m.config.EXPECT().
+		Reload().
+		Return(entities.ConfigChanges{}, assert.AnError).
+		Once()
+
+	// Act
+	reloader.Check()
+
+	// Assert
+	_, found := m.logger.WarnLogs()["Failed to reload the config file, keeping the settings in effect"]
+	assert.True(t, found, "The invalid configuration should be logged")
+}
+
+func TestReloader_Check_PolicyFileChanged(t *testing.T) {
+	// Arrange
+	reloader, m := newReloader(t)
+	m.expectFiles("", policyFilePath)
+
+	m.expectStat(t, policyFilePath, startTime)
+	reloader.RecordVersions()
+
+	m.expectStat(t, policyFilePath, changeTime)
+
+	m.toolPolicy.EXPECT().
+		Reload().
+		Return(nil).
+		Once()
+
+	// Act
+	reloader.Check()
+
+	// Assert
+	_, found := m.logger.InfoLogs()["Policy reloaded"]
+	assert.True(t, found, "The reloaded policy should be logged")
+}
+
+func TestReloader_Check_PolicyFileDeleted(t *testing.T) {
+	// Arrange
+	reloader, m := newReloader(t)
+	m.expectFiles("", policyFilePath)
+
+	m.expectStat(t, policyFilePath, startTime)
+	reloader.RecordVersions()
+
+	m.osLayer.EXPECT().
+		Stat(policyFilePath).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	m.toolPolicy.EXPECT().
+		Reload().
+		Return(assert.AnError).
+		Once()
+
+	// Act
+	reloader.Check()
+
+	// Assert
+	_, found := m.logger.WarnLogs()["Failed to reload the policy file, keeping the policy in effect"]
+	assert.True(t, found, "The failed policy reload should be logged")
+}
+
+func TestReloader_Check_PolicyFileSettingChanged(t *testing.T) {
+	// Arrange
+	reloader, m := newReloader(t)
+
+	m.config.EXPECT().
+		ConfigFile().
+		Return(configFilePath)
+
+	m.config.EXPECT().
+		PolicyFile().
+		Return("").
+		Twice()
+
+	m.expectStat(t, configFilePath, startTime)
+	reloader.RecordVersions()
+
+	m.expectStat(t, configFilePath, changeTime)
+
+	m.config.EXPECT().
+		Reload().
+		Return(entities.ConfigChanges{Applied: []string{"policy-file"}}, nil).
+		Once()
+
+	m.config.EXPECT().
+		PolicyFile().
+		Return(newPolicyFilePath)
+
+	m.expectStat(t, newPolicyFilePath, startTime)
+
+	m.toolPolicy.EXPECT().
+		Reload().
+		Return(nil).
+		Once()
+
+	// Act
+	reloader.Check()
+
+	// Assert
+	m.loggerFactory.AssertNotCalled(t, "SetLogLevels", mock.Anything, mock.Anything)
+	m.toolsReloader.AssertNotCalled(t, "ReloadTools")
+}
+
+func TestReloader_Start_NoFiles(t *testing.T) {
+	// Arrange
+	reloader, m := newReloader(t)
+	m.expectFiles("", "")
+
+	// Act
+	reloader.Start()
+
+	// Assert
+	m.lifecycleSignaler.AssertNotCalled(t, "AddShutdownFunction", mock.Anything)
+}
+
+func TestReloader_Start_StopsOnShutdown(t *testing.T) {
+	// Arrange
+	reloader, m := newReloader(t)
+	m.expectFiles(configFilePath, "")
+
+	m.expectStat(t, configFilePath, startTime)
+
+	var shutdownFcn func() error
+	m.lifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.Anything).
+		Run(func(fcn func() error) {
+			shutdownFcn = fcn
+		}).
+		Once()
+
+	// Act
+	reloader.Start()
+
+	// Assert
+	_, found := m.logger.InfoLogs()["Watching the configuration for changes"]
+	assert.True(t, found, "The watched files should be logged")
+	assert.NoError(t, shutdownFcn())
+}
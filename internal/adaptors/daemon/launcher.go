@@ -0,0 +1,61 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package daemon
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+)
+
+const (
+	daemonFlag = "--daemon"
+	attachFlag = "attach"
+)
+
+type LauncherOSLayer interface {
+	Args() []string
+	Command(name string, arg ...string) osfacade.Cmd
+}
+
+// Launcher starts the daemon in a detached process, so that it outlives the client that started it.
+type Launcher struct {
+	osLayer LauncherOSLayer
+}
+
+func NewLauncher(
+	osLayer LauncherOSLayer,
+) *Launcher {
+	return &Launcher{
+		osLayer: osLayer,
+	}
+}
+
+// Launch starts the daemon with the arguments of the current process, so that it uses the same configuration and socket,
+// and returns without waiting for it to listen.
+func (l *Launcher) Launch() error {
+	args := l.osLayer.Args()
+
+	cmd := l.osLayer.Command(args[0], daemonArgs(args[1:])...)
+	cmd.SetSysProcAttr(getSysProcAttrForDetachingAProcess())
+
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start the daemon: %w", err)
+	}
+
+	return nil
+}
+
+// daemonArgs replaces the attach flag of args with the daemon flag.
+func daemonArgs(args []string) []string {
+	result := []string{}
+	for _, arg := range args {
+		name, _, _ := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		if strings.HasPrefix(arg, "-") && name == attachFlag {
+			continue
+		}
+		result = append(result, arg)
+	}
+	return append(result, daemonFlag)
+}
@@ -0,0 +1,113 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package daemon_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/daemon"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/daemon"
+	osfacademocks "github.com/matlab/matlab-mcp-core-server/mocks/facades/osfacade"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLauncher_Launch_HappyPath(t *testing.T) {
+	testCases := []struct {
+		name         string
+		args         []string
+		expectedArgs []string
+	}{
+		{
+			name:         "attach flag",
+			args:         []string{"--attach", "--log-level=debug"},
+			expectedArgs: []string{"--log-level=debug", "--daemon"},
+		},
+		{
+			name:         "attach flag with value",
+			args:         []string{"--daemon-socket=/home/user/mcp.sock", "--attach=true"},
+			expectedArgs: []string{"--daemon-socket=/home/user/mcp.sock", "--daemon"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &mocks.MockLauncherOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockCmd := &osfacademocks.MockCmd{}
+			defer mockCmd.AssertExpectations(t)
+
+			const programPath = "/path/to/program"
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(append([]string{programPath}, testCase.args...)).
+				Once()
+
+			mockOSLayer.EXPECT().
+				Command(programPath, testCase.expectedArgs).
+				Return(mockCmd).
+				Once()
+
+			mockCmd.EXPECT().
+				SetSysProcAttr(mock.Anything).
+				Return().
+				Once()
+
+			mockCmd.EXPECT().
+				Start().
+				Return(nil).
+				Once()
+
+			launcher := daemon.NewLauncher(mockOSLayer)
+
+			// Act
+			err := launcher.Launch()
+
+			// Assert
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestLauncher_Launch_StartError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockLauncherOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockCmd := &osfacademocks.MockCmd{}
+	defer mockCmd.AssertExpectations(t)
+
+	const programPath = "/path/to/program"
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return([]string{programPath, "--attach"}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Command(programPath, []string{"--daemon"}).
+		Return(mockCmd).
+		Once()
+
+	mockCmd.EXPECT().
+		SetSysProcAttr(mock.Anything).
+		Return().
+		Once()
+
+	mockCmd.EXPECT().
+		Start().
+		Return(assert.AnError).
+		Once()
+
+	launcher := daemon.NewLauncher(mockOSLayer)
+
+	// Act
+	err := launcher.Launch()
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
@@ -0,0 +1,12 @@
+// Copyright 2025 The MathWorks, Inc.
+//go:build !windows
+
+package daemon
+
+import "syscall"
+
+func getSysProcAttrForDetachingAProcess() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{
+		Setsid: true, // Do not receive the signals of the terminal or of the process group of the client
+	}
+}
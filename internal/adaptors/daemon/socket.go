@@ -0,0 +1,97 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package daemon
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	defaultSocketName   = "matlab-mcp-core-server.sock"
+	socketNamePrefix    = "matlab-mcp-core-server-"
+	socketNameExtension = ".sock"
+)
+
+// dialTimeout bounds the time to connect to the socket. A daemon listening on it accepts connections immediately.
+const dialTimeout = time.Second
+
+type Config interface {
+	DaemonMode() bool
+	DaemonSocket() string
+	InstanceID() string
+}
+
+type OSLayer interface {
+	TempDir() string
+}
+
+// Socket is the local socket the daemon serves MCP clients on.
+type Socket struct {
+	config  Config
+	osLayer OSLayer
+}
+
+func NewSocket(
+	config Config,
+	osLayer OSLayer,
+) *Socket {
+	return &Socket{
+		config:  config,
+		osLayer: osLayer,
+	}
+}
+
+// Enabled reports whether the server runs as a daemon, serving MCP clients on the socket.
+func (s *Socket) Enabled() bool {
+	return s.config.DaemonMode()
+}
+
+// Path returns the path of the socket: the configured one, or a socket in the temporary folder, next to the instance lock.
+// Named instances have their own socket, so that their daemons run side by side.
+func (s *Socket) Path() string {
+	if path := s.config.DaemonSocket(); path != "" {
+		return path
+	}
+	if instanceID := s.config.InstanceID(); instanceID != "" {
+		return filepath.Join(s.osLayer.TempDir(), socketNamePrefix+instanceID+socketNameExtension)
+	}
+	return filepath.Join(s.osLayer.TempDir(), defaultSocketName)
+}
+
+// Listen listens on the socket. A socket file left behind by a daemon that did not shut down cleanly is replaced,
+// but listening fails if another daemon is listening on it. Only the current user can connect to the socket.
+func (s *Socket) Listen() (net.Listener, error) {
+	path := s.Path()
+
+	if conn, err := net.DialTimeout("unix", path, dialTimeout); err == nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("a daemon is already listening on %s", path)
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to remove stale daemon socket: %w", err)
+	}
+
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on daemon socket: %w", err)
+	}
+
+	if err := os.Chmod(path, 0o600); err != nil {
+		_ = listener.Close()
+		return nil, fmt.Errorf("failed to restrict access to daemon socket: %w", err)
+	}
+
+	return listener, nil
+}
+
+// Dial connects to the daemon listening on the socket.
+func (s *Socket) Dial() (net.Conn, error) {
+	return net.DialTimeout("unix", s.Path(), dialTimeout)
+}
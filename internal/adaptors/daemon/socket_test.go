@@ -0,0 +1,251 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package daemon_test
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/daemon"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/daemon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSocket_Enabled_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		DaemonMode().
+		Return(true).
+		Once()
+
+	socket := daemon.NewSocket(mockConfig, mockOSLayer)
+
+	// Act
+	enabled := socket.Enabled()
+
+	// Assert
+	assert.True(t, enabled)
+}
+
+func TestSocket_Path_Default(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	tempDir := filepath.Join("some", "temp")
+
+	mockConfig.EXPECT().
+		DaemonSocket().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		InstanceID().
+		Return("").
+		Once()
+
+	mockOSLayer.EXPECT().
+		TempDir().
+		Return(tempDir).
+		Once()
+
+	socket := daemon.NewSocket(mockConfig, mockOSLayer)
+
+	// Act
+	path := socket.Path()
+
+	// Assert
+	assert.Equal(t, filepath.Join(tempDir, "matlab-mcp-core-server.sock"), path)
+}
+
+func TestSocket_Path_NamedInstance(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	tempDir := filepath.Join("some", "temp")
+
+	mockConfig.EXPECT().
+		DaemonSocket().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		InstanceID().
+		Return("project").
+		Once()
+
+	mockOSLayer.EXPECT().
+		TempDir().
+		Return(tempDir).
+		Once()
+
+	socket := daemon.NewSocket(mockConfig, mockOSLayer)
+
+	// Act
+	path := socket.Path()
+
+	// Assert
+	assert.Equal(t, filepath.Join(tempDir, "matlab-mcp-core-server-project.sock"), path)
+}
+
+func TestSocket_Path_Configured(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	configuredPath := filepath.Join("home", "user", "mcp.sock")
+
+	mockConfig.EXPECT().
+		DaemonSocket().
+		Return(configuredPath).
+		Once()
+
+	socket := daemon.NewSocket(mockConfig, mockOSLayer)
+
+	// Act
+	path := socket.Path()
+
+	// Assert
+	assert.Equal(t, configuredPath, path)
+}
+
+func TestSocket_ListenAndDial_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	socketPath := filepath.Join(t.TempDir(), "daemon.sock")
+
+	mockConfig.EXPECT().
+		DaemonSocket().
+		Return(socketPath)
+
+	socket := daemon.NewSocket(mockConfig, mockOSLayer)
+
+	// Act
+	listener, err := socket.Listen()
+	require.NoError(t, err)
+	defer listener.Close() //nolint:errcheck // Test listener
+
+	acceptedC := make(chan net.Conn, 1)
+	go func() {
+		conn, acceptErr := listener.Accept()
+		if acceptErr == nil {
+			acceptedC <- conn
+		}
+		close(acceptedC)
+	}()
+
+	conn, err := socket.Dial()
+
+	// Assert
+	require.NoError(t, err)
+	defer conn.Close() //nolint:errcheck // Test connection
+
+	accepted := <-acceptedC
+	require.NotNil(t, accepted)
+	defer accepted.Close() //nolint:errcheck // Test connection
+
+	info, err := os.Stat(socketPath)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm(), "Only the current user should be able to connect")
+}
+
+func TestSocket_Listen_ReplacesStaleSocket(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	socketPath := filepath.Join(t.TempDir(), "daemon.sock")
+	require.NoError(t, os.WriteFile(socketPath, nil, 0o600))
+
+	mockConfig.EXPECT().
+		DaemonSocket().
+		Return(socketPath).
+		Once()
+
+	socket := daemon.NewSocket(mockConfig, mockOSLayer)
+
+	// Act
+	listener, err := socket.Listen()
+
+	// Assert
+	require.NoError(t, err)
+	assert.NoError(t, listener.Close())
+}
+
+func TestSocket_Listen_AnotherDaemonListening(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	socketPath := filepath.Join(t.TempDir(), "daemon.sock")
+	otherDaemon, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+	defer otherDaemon.Close() //nolint:errcheck // Test listener
+
+	mockConfig.EXPECT().
+		DaemonSocket().
+		Return(socketPath).
+		Once()
+
+	socket := daemon.NewSocket(mockConfig, mockOSLayer)
+
+	// Act
+	listener, err := socket.Listen()
+
+	// Assert
+	require.ErrorContains(t, err, "a daemon is already listening")
+	assert.Nil(t, listener)
+}
+
+func TestSocket_Dial_NoDaemon(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		DaemonSocket().
+		Return(filepath.Join(t.TempDir(), "daemon.sock")).
+		Once()
+
+	socket := daemon.NewSocket(mockConfig, mockOSLayer)
+
+	// Act
+	conn, err := socket.Dial()
+
+	// Assert
+	require.Error(t, err)
+	assert.Nil(t, conn)
+}
@@ -0,0 +1,139 @@
+// Copyright 2025 The MathWorks, Inc.
+
+// Package downstream proxies the tools of downstream MCP servers, such as a filesystem or an internal documentation
+// server, so that the AI application reaches them through this server, under its policies, with one configuration.
+package downstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const clientName = "matlab-mcp-core-server"
+
+// connectTimeout bounds the time to start or reach a downstream server and list its tools.
+const connectTimeout = 30 * time.Second
+
+// toolNameSeparator separates the name of a downstream server from the name of its tool in the name of a proxied tool.
+const toolNameSeparator = "__"
+
+// toolNamePattern are the names a proxied tool can have, so that they are valid MCP tool names.
+var toolNamePattern = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
+type Config interface {
+	DownstreamServersFile() string
+	Version() string
+}
+
+type OSLayer interface {
+	ReadFile(name string) ([]byte, error)
+}
+
+type LifecycleSignaler interface {
+	AddShutdownFunction(shutdownFcn func() error)
+}
+
+// Proxy connects to the downstream servers of the configuration, the first time their tools are needed, and provides
+// their tools, named after their server.
+type Proxy struct {
+	loggerFactory basetool.LoggerFactory
+	servers       []*server
+
+	once  *sync.Once
+	tools []tools.ProvidedTool
+}
+
+func New(
+	config Config,
+	osLayer OSLayer,
+	loggerFactory basetool.LoggerFactory,
+	lifecycleSignaler LifecycleSignaler,
+) (*Proxy, error) {
+	proxy := &Proxy{
+		loggerFactory: loggerFactory,
+
+		once: new(sync.Once),
+	}
+
+	serversFile := config.DownstreamServersFile()
+	if serversFile == "" {
+		return proxy, nil
+	}
+
+	data, err := osLayer.ReadFile(serversFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read downstream servers file: %w", err)
+	}
+
+	serverConfigs, err := parseServersFile(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid downstream servers file %s: %w", serversFile, err)
+	}
+
+	client := mcp.NewClient(&mcp.Implementation{Name: clientName, Version: config.Version()}, nil)
+	for _, serverConfig := range serverConfigs {
+		proxy.servers = append(proxy.servers, newServer(serverConfig, client))
+	}
+
+	lifecycleSignaler.AddShutdownFunction(proxy.close)
+
+	return proxy, nil
+}
+
+// Tools returns the tools of all the downstream servers. A server that cannot be reached is logged and skipped, so
+// that the server still starts, as are tools whose name, once prefixed, is not a valid tool name, and tools whose input
+// is not an object.
+func (p *Proxy) Tools() []tools.ProvidedTool {
+	p.once.Do(func() {
+		logger := p.loggerFactory.GetGlobalLogger()
+
+		for _, server := range p.servers {
+			serverLogger := logger.With("downstream-server", server.name)
+
+			ctx, cancel := context.WithTimeout(context.Background(), connectTimeout)
+			serverTools, err := server.listTools(ctx)
+			cancel()
+			if err != nil {
+				serverLogger.WithError(err).Warn("Failed to connect to downstream server")
+				continue
+			}
+
+			for _, serverTool := range serverTools {
+				name := server.name + toolNameSeparator + serverTool.Name
+				if !toolNamePattern.MatchString(name) {
+					serverLogger.With("tool-name", name).Warn("Ignoring downstream tool with an invalid name")
+					continue
+				}
+
+				if serverTool.InputSchema != nil && !isObjectSchema(serverTool.InputSchema) {
+					serverLogger.With("tool-name", name).Warn("Ignoring downstream tool with an input schema that is not an object")
+					continue
+				}
+
+				p.tools = append(p.tools, newTool(p.loggerFactory, server, name, serverTool))
+			}
+
+			serverLogger.With("tools", len(serverTools)).Info("Connected to downstream server")
+		}
+	})
+
+	return p.tools
+}
+
+func (p *Proxy) close() error {
+	var errs []error
+	for _, server := range p.servers {
+		if err := server.close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to disconnect from downstream server %s: %w", server.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
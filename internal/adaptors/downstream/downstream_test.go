@@ -0,0 +1,411 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package downstream_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/downstream"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/downstream"
+	basetoolmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const serversFilePath = "/home/user/downstream.json"
+
+type searchArgs struct {
+	Query string `json:"query"`
+}
+
+func TestNew_NoServersFile(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLoggerFactory := &basetoolmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		DownstreamServersFile().
+		Return("").
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	// Act
+	proxy, err := downstream.New(mockConfig, mockOSLayer, mockLoggerFactory, mockLifecycleSignaler)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, proxy.Tools())
+}
+
+func TestNew_InvalidServersFile(t *testing.T) {
+	testConfigs := []struct {
+		name          string
+		data          string
+		expectedError string
+	}{
+		{
+			name:          "not JSON",
+			data:          "mcpServers: {}",
+			expectedError: "invalid downstream servers file",
+		},
+		{
+			name:          "unknown field",
+			data:          `{"mcpServers": {"docs": {"uri": "https://docs.example.com/mcp"}}}`,
+			expectedError: "unknown field",
+		},
+		{
+			name:          "invalid name",
+			data:          `{"mcpServers": {"internal_docs": {"url": "https://docs.example.com/mcp"}}}`,
+			expectedError: `invalid server name "internal_docs"`,
+		},
+		{
+			name:          "neither command nor url",
+			data:          `{"mcpServers": {"docs": {}}}`,
+			expectedError: "either command or url must be set",
+		},
+		{
+			name:          "command and url",
+			data:          `{"mcpServers": {"docs": {"command": "docs-server", "url": "https://docs.example.com/mcp"}}}`,
+			expectedError: "command and url cannot both be set",
+		},
+		{
+			name:          "headers with command",
+			data:          `{"mcpServers": {"docs": {"command": "docs-server", "headers": {"Authorization": "Bearer token"}}}}`,
+			expectedError: "headers can only be set with url",
+		},
+		{
+			name:          "args with url",
+			data:          `{"mcpServers": {"docs": {"url": "https://docs.example.com/mcp", "args": ["--verbose"]}}}`,
+			expectedError: "args and env can only be set with command",
+		},
+		{
+			name:          "unsupported url",
+			data:          `{"mcpServers": {"docs": {"url": "ftp://docs.example.com/mcp"}}}`,
+			expectedError: "is not an HTTP or HTTPS URL",
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockLoggerFactory := &basetoolmocks.MockLoggerFactory{}
+			defer mockLoggerFactory.AssertExpectations(t)
+
+			mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+			defer mockLifecycleSignaler.AssertExpectations(t)
+
+			mockConfig.EXPECT().
+				DownstreamServersFile().
+				Return(serversFilePath).
+				Once()
+
+			mockOSLayer.EXPECT().
+				ReadFile(serversFilePath).
+				Return([]byte(testConfig.data), nil).
+				Once()
+
+			// Act
+			proxy, err := downstream.New(mockConfig, mockOSLayer, mockLoggerFactory, mockLifecycleSignaler)
+
+			// Assert
+			require.ErrorContains(t, err, testConfig.expectedError)
+			assert.Nil(t, proxy)
+		})
+	}
+}
+
+func TestProxy_Tools_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLoggerFactory := &basetoolmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	t.Setenv("DOCS_TOKEN", "secret-token")
+	docsServer := newDocsServer(t, "Bearer secret-token")
+
+	mockConfig.EXPECT().
+		DownstreamServersFile().
+		Return(serversFilePath).
+		Once()
+
+	mockConfig.EXPECT().
+		Version().
+		Return("1.2.3").
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(serversFilePath).
+		Return([]byte(`{"mcpServers": {"docs": {"url": "`+docsServer.URL+`", "headers": {"Authorization": "Bearer ${DOCS_TOKEN}"}}}}`), nil).
+		Once()
+
+	var shutdown func() error
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.Anything).
+		Run(func(shutdownFcn func() error) {
+			shutdown = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	logger := testutils.NewInspectableLogger()
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(logger).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(logger).
+		Once()
+
+	proxy, err := downstream.New(mockConfig, mockOSLayer, mockLoggerFactory, mockLifecycleSignaler)
+	require.NoError(t, err)
+
+	// Act
+	providedTools := proxy.Tools()
+
+	// Assert
+	require.Len(t, providedTools, 2)
+	assert.Equal(t, "docs__delete_page", providedTools[0].Name())
+	assert.False(t, providedTools[0].ReadOnly())
+	assert.Equal(t, "docs__search", providedTools[1].Name())
+	assert.True(t, providedTools[1].ReadOnly())
+	assert.Contains(t, logger.WarnLogs(), "Ignoring downstream tool with an invalid name")
+
+	session := connectToTools(t, providedTools)
+
+	listResult, err := session.ListTools(t.Context(), nil)
+	require.NoError(t, err)
+	require.Len(t, listResult.Tools, 2)
+	assert.Contains(t, listResult.Tools[1].Description, "This tool is provided by the docs MCP server.")
+
+	callResult, err := session.CallTool(t.Context(), &mcp.CallToolParams{
+		Name:      "docs__search",
+		Arguments: map[string]any{"query": "fft"},
+	})
+	require.NoError(t, err)
+	assert.False(t, callResult.IsError)
+	require.Len(t, callResult.Content, 1)
+	assert.Equal(t, "Results for fft", callResult.Content[0].(*mcp.TextContent).Text)
+
+	require.NoError(t, shutdown())
+}
+
+func TestProxy_Tools_UnreachableServer(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLoggerFactory := &basetoolmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	unreachableServer := httptest.NewServer(http.NotFoundHandler())
+	unreachableServer.Close()
+
+	mockConfig.EXPECT().
+		DownstreamServersFile().
+		Return(serversFilePath).
+		Once()
+
+	mockConfig.EXPECT().
+		Version().
+		Return("1.2.3").
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(serversFilePath).
+		Return([]byte(`{"mcpServers": {"docs": {"url": "`+unreachableServer.URL+`"}}}`), nil).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.Anything).
+		Return().
+		Once()
+
+	logger := testutils.NewInspectableLogger()
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(logger).
+		Once()
+
+	proxy, err := downstream.New(mockConfig, mockOSLayer, mockLoggerFactory, mockLifecycleSignaler)
+	require.NoError(t, err)
+
+	// Act
+	providedTools := proxy.Tools()
+
+	// Assert
+	assert.Empty(t, providedTools)
+	assert.Contains(t, logger.WarnLogs(), "Failed to connect to downstream server")
+}
+
+func TestTool_Handler_DownstreamFailure(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLoggerFactory := &basetoolmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	docsServer := newDocsServer(t, "")
+
+	mockConfig.EXPECT().
+		DownstreamServersFile().
+		Return(serversFilePath).
+		Once()
+
+	mockConfig.EXPECT().
+		Version().
+		Return("1.2.3").
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(serversFilePath).
+		Return([]byte(`{"mcpServers": {"docs": {"url": "`+docsServer.URL+`"}}}`), nil).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.Anything).
+		Return().
+		Once()
+
+	logger := testutils.NewInspectableLogger()
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(logger).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(logger).
+		Once()
+
+	proxy, err := downstream.New(mockConfig, mockOSLayer, mockLoggerFactory, mockLifecycleSignaler)
+	require.NoError(t, err)
+
+	session := connectToTools(t, proxy.Tools())
+	docsServer.CloseClientConnections()
+	docsServer.Close()
+
+	// Act
+	callResult, err := session.CallTool(t.Context(), &mcp.CallToolParams{
+		Name:      "docs__search",
+		Arguments: map[string]any{"query": "fft"},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, callResult.IsError)
+	require.Len(t, callResult.Content, 1)
+	assert.True(t, strings.HasPrefix(callResult.Content[0].(*mcp.TextContent).Text, "DOWNSTREAM_ERROR: downstream server docs failed"))
+	assert.Contains(t, logger.WarnLogs(), "Downstream tool call failed")
+}
+
+// newDocsServer serves a downstream MCP server over HTTP, which only accepts requests with the authorization header.
+func newDocsServer(t *testing.T, expectedAuthorization string) *httptest.Server {
+	t.Helper()
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "docs"}, nil)
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "search",
+		Description: "Searches the documentation.",
+		Annotations: &mcp.ToolAnnotations{ReadOnlyHint: true},
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args searchArgs) (*mcp.CallToolResult, any, error) {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{&mcp.TextContent{Text: "Results for " + args.Query}},
+		}, nil, nil
+	})
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "delete_page",
+		Description: "Deletes a page.",
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args searchArgs) (*mcp.CallToolResult, any, error) {
+		return nil, nil, nil
+	})
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        strings.Repeat("x", 64),
+		Description: "A tool whose name is too long once prefixed.",
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args searchArgs) (*mcp.CallToolResult, any, error) {
+		return nil, nil, nil
+	})
+
+	handler := mcp.NewStreamableHTTPHandler(func(*http.Request) *mcp.Server { return server }, nil)
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != expectedAuthorization {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(httpServer.Close)
+
+	return httpServer
+}
+
+// connectToTools serves the tools from an MCP server, and returns a client session connected to it.
+func connectToTools(t *testing.T, providedTools []tools.ProvidedTool) *mcp.ClientSession {
+	t.Helper()
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "matlab-mcp-core-server"}, nil)
+	for _, tool := range providedTools {
+		require.NoError(t, tool.AddToServer(server))
+	}
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(t.Context(), serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = serverSession.Close() })
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	session, err := client.Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = session.Close() })
+
+	return session
+}
@@ -0,0 +1,165 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package downstream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"os/exec"
+	"sync"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// server is the connection to a downstream server. It connects on first use, and again on the next use once the
+// connection was lost, for example because its process exited.
+type server struct {
+	namedServerConfig
+	client *mcp.Client
+
+	lock    *sync.Mutex
+	session *mcp.ClientSession
+	cancel  context.CancelFunc
+}
+
+func newServer(config namedServerConfig, client *mcp.Client) *server {
+	return &server{
+		namedServerConfig: config,
+		client:            client,
+
+		lock: new(sync.Mutex),
+	}
+}
+
+func (s *server) listTools(ctx context.Context) ([]*mcp.Tool, error) {
+	session, err := s.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tools []*mcp.Tool
+	for tool, err := range session.Tools(ctx, nil) {
+		if err != nil {
+			s.disconnect(session, err)
+			return nil, err
+		}
+		tools = append(tools, tool)
+	}
+
+	return tools, nil
+}
+
+// callTool calls a tool of the downstream server. A call is never retried, even when the connection was lost, as the
+// server may have run it.
+func (s *server) callTool(ctx context.Context, name string, arguments json.RawMessage) (*mcp.CallToolResult, error) {
+	session, err := s.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &mcp.CallToolParams{Name: name}
+	if len(arguments) > 0 {
+		params.Arguments = arguments
+	}
+
+	result, err := session.CallTool(ctx, params)
+	if err != nil {
+		s.disconnect(session, err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *server) connect(ctx context.Context) (*mcp.ClientSession, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.session != nil {
+		return s.session, nil
+	}
+
+	// The connection outlives the call that made it, which can only cancel it while connecting.
+	connectionCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	stop := context.AfterFunc(ctx, cancel)
+	session, err := s.client.Connect(connectionCtx, s.transport(), nil)
+	stop()
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	s.session = session
+	s.cancel = cancel
+	return session, nil
+}
+
+// disconnect drops the session after an error that closed its connection, so that the next use reconnects.
+func (s *server) disconnect(session *mcp.ClientSession, err error) {
+	if !errors.Is(err, mcp.ErrConnectionClosed) {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.session == session {
+		_ = session.Close()
+		s.cancel()
+		s.session = nil
+	}
+}
+
+func (s *server) close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.session == nil {
+		return nil
+	}
+
+	err := s.session.Close()
+	s.cancel()
+	s.session = nil
+	return err
+}
+
+func (s *server) transport() mcp.Transport {
+	if s.Command != "" {
+		cmd := exec.Command(s.Command, s.Args...) //nolint:gosec // The command is set by the user in the downstream servers file
+		cmd.Env = os.Environ()
+		for name, value := range s.Env {
+			cmd.Env = append(cmd.Env, name+"="+os.ExpandEnv(value))
+		}
+		return &mcp.CommandTransport{Command: cmd}
+	}
+
+	headers := http.Header{}
+	for name, value := range s.Headers {
+		headers.Set(name, os.ExpandEnv(value))
+	}
+
+	return &mcp.StreamableClientTransport{
+		Endpoint: s.URL,
+		HTTPClient: &http.Client{
+			Transport: &headerTransport{headers: headers, next: http.DefaultTransport},
+		},
+	}
+}
+
+// headerTransport adds the headers of a downstream server, such as its credentials, to the requests sent to it.
+type headerTransport struct {
+	headers http.Header
+	next    http.RoundTripper
+}
+
+func (t *headerTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	request = request.Clone(request.Context())
+	for name, values := range t.headers {
+		request.Header[name] = values
+	}
+	return t.next.RoundTrip(request)
+}
@@ -0,0 +1,99 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package downstream
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+)
+
+// serverNamePattern are the names a downstream server can have. Underscores are not allowed, so that the separator of
+// the names of its tools is not ambiguous.
+var serverNamePattern = regexp.MustCompile(`^[a-zA-Z0-9-]{1,32}$`)
+
+// ServersFile is the file of the downstream servers, in the format that AI applications use for their MCP servers, so
+// that their entries can be copied into it.
+type ServersFile struct {
+	MCPServers map[string]ServerConfig `json:"mcpServers"`
+}
+
+// ServerConfig is how to connect to a downstream server: either by running its command, which serves MCP over its
+// standard input and output, or at the URL of its streamable HTTP endpoint. Environment variables in the values of
+// env and headers, such as ${DOCS_TOKEN}, are expanded, so that secrets can stay out of the file.
+type ServerConfig struct {
+	Command string            `json:"command"`
+	Args    []string          `json:"args"`
+	Env     map[string]string `json:"env"`
+
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers"`
+}
+
+type namedServerConfig struct {
+	name string
+	ServerConfig
+}
+
+// parseServersFile returns the downstream servers of the file, sorted by name, so that their tools are always listed
+// in the same order.
+func parseServersFile(data []byte) ([]namedServerConfig, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	var file ServersFile
+	if err := decoder.Decode(&file); err != nil {
+		return nil, err
+	}
+
+	servers := make([]namedServerConfig, 0, len(file.MCPServers))
+	for name, server := range file.MCPServers {
+		if !serverNamePattern.MatchString(name) {
+			return nil, fmt.Errorf("invalid server name %q: only letters, digits and hyphens are allowed", name)
+		}
+
+		if err := validateServer(server); err != nil {
+			return nil, fmt.Errorf("invalid server %s: %w", name, err)
+		}
+
+		servers = append(servers, namedServerConfig{name: name, ServerConfig: server})
+	}
+
+	sort.Slice(servers, func(i, j int) bool {
+		return servers[i].name < servers[j].name
+	})
+
+	return servers, nil
+}
+
+func validateServer(server ServerConfig) error {
+	switch {
+	case server.Command == "" && server.URL == "":
+		return errors.New("either command or url must be set")
+	case server.Command != "" && server.URL != "":
+		return errors.New("command and url cannot both be set")
+	case server.Command != "":
+		if len(server.Headers) > 0 {
+			return errors.New("headers can only be set with url")
+		}
+		return nil
+	}
+
+	if len(server.Args) > 0 || len(server.Env) > 0 {
+		return errors.New("args and env can only be set with command")
+	}
+
+	parsed, err := url.Parse(server.URL)
+	if err != nil {
+		return err
+	}
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("%s is not an HTTP or HTTPS URL", server.URL)
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package downstream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/clientidentity"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/correlationid"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Tool is a tool of a downstream server. Its results are returned as the downstream server sent them, so that content
+// of any type, and structured content, reach the client unchanged.
+type Tool struct {
+	loggerFactory basetool.LoggerFactory
+	server        *server
+	name          string
+	definition    *mcp.Tool
+}
+
+func newTool(loggerFactory basetool.LoggerFactory, server *server, name string, definition *mcp.Tool) *Tool {
+	return &Tool{
+		loggerFactory: loggerFactory,
+		server:        server,
+		name:          name,
+		definition:    definition,
+	}
+}
+
+func (t *Tool) Name() string {
+	return t.name
+}
+
+// ReadOnly is true when the downstream server annotated the tool as read-only.
+func (t *Tool) ReadOnly() bool {
+	return t.definition.Annotations != nil && t.definition.Annotations.ReadOnlyHint
+}
+
+func (t *Tool) AddToServer(server *mcp.Server) error {
+	definition := *t.definition
+	definition.Name = t.name
+	definition.Description = fmt.Sprintf("%s\n\nThis tool is provided by the %s MCP server.", t.definition.Description, t.server.name)
+
+	if definition.InputSchema == nil {
+		definition.InputSchema = map[string]any{"type": "object"}
+	}
+	if definition.OutputSchema != nil && !isObjectSchema(definition.OutputSchema) {
+		definition.OutputSchema = nil
+	}
+
+	server.AddTool(&definition, t.Handler())
+	return nil
+}
+
+func (t *Tool) Handler() mcp.ToolHandler {
+	return func(ctx context.Context, req *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		logger := t.loggerFactory.NewMCPSessionLogger(req.Session).
+			With("tool-name", t.name).
+			With("downstream-server", t.server.name)
+		if correlationID, ok := correlationid.FromContext(ctx); ok {
+			logger = logger.With(correlationid.LogKey, correlationID)
+		}
+		if identity, ok := clientidentity.FromContext(ctx); ok {
+			logger = logger.With(clientidentity.UserLogKey, identity.User).With(clientidentity.ClientLogKey, identity.Client)
+		}
+		logger.Debug("Handling tool call request")
+		defer logger.Debug("Handled tool call request")
+
+		var arguments json.RawMessage
+		if req.Params != nil {
+			arguments = req.Params.Arguments
+		}
+
+		result, err := t.server.callTool(ctx, t.definition.Name, arguments)
+		if err != nil {
+			if ctx.Err() == nil {
+				err = entities.NewCodedError(entities.ErrorCodeDownstreamError, fmt.Errorf("downstream server %s failed: %w", t.server.name, err))
+			}
+			err = basetool.ToolCallFailed(ctx, logger, "Downstream tool call failed", err)
+			return &mcp.CallToolResult{
+				IsError: true,
+				Content: []mcp.Content{&mcp.TextContent{Text: err.Error()}},
+			}, nil
+		}
+
+		return result, nil
+	}
+}
+
+func isObjectSchema(schema any) bool {
+	data, err := json.Marshal(schema)
+	if err != nil {
+		return false
+	}
+
+	var fields struct {
+		Type any `json:"type"`
+	}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return false
+	}
+
+	return fields.Type == "object"
+}
@@ -0,0 +1,412 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package dryrun
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+type Config interface {
+	DryRun() bool
+	RequireApproval() bool
+	RealTimeTargets() []string
+}
+
+type CodePolicy interface {
+	CheckCode(code string) error
+	CheckFile(filePath string) error
+	CheckPython() error
+	Effects(code string) []entities.CodeEffect
+}
+
+type OSLayer interface {
+	ReadFile(name string) ([]byte, error)
+}
+
+// mutatingTools are the tools that run MATLAB or Python code, which may change files, the MATLAB path, add-ons or
+// Simulink models, the tools that stop what MATLAB runs, the tool that changes the Python of MATLAB, the tools that
+// load, simulate and change Simulink models, as models run callbacks, the tools that set workspace variables or load them from MAT-files, the tools that build for and drive real-time
+// targets, and the tools that package, deploy and call MATLAB Production Server archives, as the functions of the
+// archives may have side effects.
+var mutatingTools = map[string]bool{
+	"evaluate_matlab_code":   true,
+	"eval_in_matlab_session": true,
+	"run_matlab_file":        true,
+	"run_matlab_test_file":   true,
+	"run_matlab_tests":       true,
+	"start_job":              true,
+	"cancel_job":             true,
+	"stop_matlab_session":    true,
+	"run_python_code":        true,
+	"set_python_environment": true,
+
+	"set_matlab_variable":     true,
+	"load_mat_file_variables": true,
+
+	"load_simulink_model":     true,
+	"simulate_simulink_model": true,
+	"set_simulink_parameter":  true,
+
+	"build_realtime_application":   true,
+	"deploy_realtime_application":  true,
+	"control_realtime_application": true,
+
+	"package_production_archive": true,
+	"deploy_production_archive":  true,
+	"invoke_production_function": true,
+}
+
+// callArguments are the arguments of the mutating tools that the plans describe.
+type callArguments struct {
+	DryRun      bool   `json:"dry_run"`
+	Code        string `json:"code"`
+	ProjectPath string `json:"project_path"`
+	ScriptPath  string `json:"script_path"`
+	Parallel    bool   `json:"parallel"`
+	TestPath    string `json:"test_path"`
+	TestClass   string `json:"test_class"`
+	Tag         string `json:"tag"`
+	NamePattern string `json:"name_pattern"`
+	Mode        string `json:"mode"`
+	JobID       string `json:"job_id"`
+	SessionID   int    `json:"session_id"`
+	Session     string `json:"session"`
+
+	Version       string `json:"version"`
+	ExecutionMode string `json:"execution_mode"`
+
+	ModelPath       string  `json:"model_path"`
+	Target          string  `json:"target"`
+	ApplicationPath string  `json:"application_path"`
+	Action          string  `json:"action"`
+	StopTime        float64 `json:"stop_time"`
+	BlockPath       string  `json:"block_path"`
+	Parameter       string  `json:"parameter"`
+
+	Name      string   `json:"name"`
+	FilePath  string   `json:"file_path"`
+	Variables []string `json:"variables"`
+	Overwrite bool     `json:"overwrite"`
+	// Value is text for Simulink parameters, and any JSON for workspace variables.
+	Value json.RawMessage `json:"value"`
+
+	ArchiveName   string   `json:"archive_name"`
+	FunctionPaths []string `json:"function_paths"`
+	ArchivePath   string   `json:"archive_path"`
+	Archive       string   `json:"archive"`
+	Function      string   `json:"function"`
+}
+
+// session is the MATLAB session a call is for: its name or ID, as given in session, or else its session ID.
+func (a callArguments) session() string {
+	if a.Session != "" {
+		return a.Session
+	}
+	return strconv.Itoa(a.SessionID)
+}
+
+// valueText is the value, when it is a JSON string, or else its JSON text.
+func (a callArguments) valueText() string {
+	var text string
+	if err := json.Unmarshal(a.Value, &text); err == nil {
+		return text
+	}
+	return string(a.Value)
+}
+
+// Planner describes what the calls to the mutating tools would do, instead of running them, so that AI applications
+// can propose their plans for review. There is no tool that writes files or installs add-ons directly: the tools run
+// MATLAB code, so the plan shows the code, and the statements of the code with effects outside of its workspace.
+type Planner struct {
+	config     Config
+	codePolicy CodePolicy
+	osLayer    OSLayer
+}
+
+func New(
+	config Config,
+	codePolicy CodePolicy,
+	osLayer OSLayer,
+) *Planner {
+	return &Planner{
+		config:     config,
+		codePolicy: codePolicy,
+		osLayer:    osLayer,
+	}
+}
+
+// Applies is true when the call to tool must be described, and not run: the tool is a mutating tool, and the server
+// runs in dry-run mode, or the call asks for a dry run. A call cannot opt out of the dry-run mode of the server.
+func (p *Planner) Applies(tool string, arguments json.RawMessage) bool {
+	if !mutatingTools[tool] {
+		return false
+	}
+	if p.config.DryRun() {
+		return true
+	}
+
+	var args callArguments
+	if err := json.Unmarshal(arguments, &args); err != nil {
+		return false
+	}
+	return args.DryRun
+}
+
+// Plan describes what the call to tool would do: the code it would run, where, the effects found in the code,
+// and the checks the call would go through.
+func (p *Planner) Plan(tool string, arguments json.RawMessage) string {
+	var plan strings.Builder
+	if p.config.DryRun() {
+		fmt.Fprintf(&plan, "Dry run: the server runs in dry-run mode, so the call to %s was not run.\n\n", tool)
+	} else {
+		fmt.Fprintf(&plan, "Dry run: the call to %s was not run.\n\n", tool)
+	}
+
+	var args callArguments
+	if len(arguments) > 0 {
+		if err := json.Unmarshal(arguments, &args); err != nil {
+			fmt.Fprintf(&plan, "The call would fail, as its arguments are not valid: %v\n", err)
+			return plan.String()
+		}
+	}
+
+	switch tool {
+	case "evaluate_matlab_code":
+		fmt.Fprintf(&plan, "It would run this MATLAB code in %s:\n", args.ProjectPath)
+		p.describeCode(&plan, args.Code, args.ProjectPath)
+	case "eval_in_matlab_session":
+		fmt.Fprintf(&plan, "It would run this MATLAB code in the MATLAB session %s, in %s:\n", args.session(), args.ProjectPath)
+		p.describeCode(&plan, args.Code, args.ProjectPath)
+	case "start_job":
+		mode := args.Mode
+		if mode == "" {
+			mode = "session"
+		}
+		fmt.Fprintf(&plan, "It would start this MATLAB code as a background job in the %s mode, in %s:\n", mode, args.ProjectPath)
+		p.describeCode(&plan, args.Code, args.ProjectPath)
+	case "run_matlab_file":
+		fmt.Fprintf(&plan, "It would run the MATLAB file %s in %s:\n", args.ScriptPath, filepath.Dir(args.ScriptPath))
+		p.describeFile(&plan, args.ScriptPath, filepath.Dir(args.ScriptPath))
+	case "run_matlab_test_file":
+		where := "one after the other"
+		if args.Parallel {
+			where = "in parallel, on the workers of the parallel pool"
+		}
+		fmt.Fprintf(&plan, "It would run the tests of the MATLAB file %s %s:\n", args.ScriptPath, where)
+		p.describeFile(&plan, args.ScriptPath, "")
+	case "run_matlab_tests":
+		p.describeTests(&plan, args)
+	case "cancel_job":
+		fmt.Fprintf(&plan, "It would cancel the background job %s, and interrupt its code if it is running.\n", args.JobID)
+	case "stop_matlab_session":
+		fmt.Fprintf(&plan, "It would stop the MATLAB session %s. Its workspace, and the figures it shows, would be lost.\n", args.session())
+	case "run_python_code":
+		fmt.Fprintf(&plan, "It would run this Python code with pyrun in the MATLAB session, in %s:\n", args.ProjectPath)
+		p.describePythonCode(&plan, args.Code)
+	case "set_python_environment":
+		p.describePythonEnvironment(&plan, args.Version, args.ExecutionMode)
+	case "set_matlab_variable":
+		fmt.Fprintf(&plan, "It would set the variable %s of the workspace of the MATLAB session, replacing its value, to:\n", args.Name)
+		writeFencedCode(&plan, "json", string(args.Value))
+	case "load_mat_file_variables":
+		fmt.Fprintf(&plan, "It would load the variables %s of the MAT-file %s into the workspace of the MATLAB session.", strings.Join(args.Variables, ", "), args.FilePath)
+		if args.Overwrite {
+			plan.WriteString(" Variables of the workspace with the same names would be replaced.\n")
+		} else {
+			plan.WriteString(" The call would fail if a variable with the same name exists in the workspace.\n")
+		}
+	case "load_simulink_model":
+		fmt.Fprintf(&plan, "It would load the Simulink model %s in the MATLAB session, without opening it. Loading runs the load callbacks of the model.\n", args.ModelPath)
+	case "simulate_simulink_model":
+		stopTime := "the stop time of the model"
+		if args.StopTime > 0 {
+			stopTime = fmt.Sprintf("%g seconds", args.StopTime)
+		}
+		fmt.Fprintf(&plan, "It would simulate the Simulink model %s in the MATLAB session until %s, loading it if needed. Simulating runs the callbacks of the model, and may write files, such as those of To File blocks. The model would not change.\n", args.ModelPath, stopTime)
+	case "set_simulink_parameter":
+		p.describeSimulinkParameter(&plan, args)
+	case "build_realtime_application":
+		fmt.Fprintf(&plan, "It would build the Simulink model %s into a real-time application, in the folder of the model. Building loads the model in the MATLAB session, and runs its callbacks.\n", args.ModelPath)
+	case "deploy_realtime_application":
+		fmt.Fprintf(&plan, "It would load the real-time application %s on the target %s, replacing the application loaded on it. The application would not start.\n", args.ApplicationPath, args.Target)
+		p.describeTargetChecks(&plan, args.Target)
+	case "control_realtime_application":
+		switch {
+		case strings.EqualFold(args.Action, "stop"):
+			fmt.Fprintf(&plan, "It would stop the real-time application running on the target %s.\n", args.Target)
+		case args.StopTime > 0:
+			fmt.Fprintf(&plan, "It would start the real-time application loaded on the target %s, and stop it after %g seconds.\n", args.Target, args.StopTime)
+		default:
+			fmt.Fprintf(&plan, "It would start the real-time application loaded on the target %s, until the stop time of the application.\n", args.Target)
+		}
+		p.describeTargetChecks(&plan, args.Target)
+	case "package_production_archive":
+		fmt.Fprintf(&plan, "It would package these functions into the deployable archive %s with MATLAB Compiler SDK, next to the first function, replacing the archive built there before:\n", args.ArchiveName)
+		for _, functionPath := range args.FunctionPaths {
+			fmt.Fprintf(&plan, "- %s\n", functionPath)
+		}
+	case "deploy_production_archive":
+		fmt.Fprintf(&plan, "It would copy the deployable archive %s to the auto_deploy folder of the MATLAB Production Server instance, replacing the archive with the same name. The instance would serve its functions to all its clients.\n", args.ArchivePath)
+		if p.config.RequireApproval() {
+			plan.WriteString("\nChecks:\n- The user would be asked to approve the deployment.\n")
+		}
+	case "invoke_production_function":
+		fmt.Fprintf(&plan, "It would call the function %s of the archive %s deployed to the MATLAB Production Server instance. The function may have side effects, such as writing to a database.\n", args.Function, args.Archive)
+	}
+
+	return plan.String()
+}
+
+// describeCode writes the code, the effects found in it, and the checks of the code.
+// The working folder is an effect of the call, when it is set.
+func (p *Planner) describeCode(plan *strings.Builder, code string, workingFolder string) {
+	writeCode(plan, code)
+	p.describeEffects(plan, code, workingFolder)
+
+	plan.WriteString("\nChecks:\n")
+	describeCheck(plan, p.codePolicy.CheckCode(code))
+	p.describeApproval(plan)
+}
+
+func (p *Planner) describeFile(plan *strings.Builder, filePath string, workingFolder string) {
+	content, err := p.osLayer.ReadFile(filePath)
+	if err != nil {
+		fmt.Fprintf(plan, "The file cannot be read, so the call would fail: %v\n", err)
+		return
+	}
+
+	writeCode(plan, string(content))
+	p.describeEffects(plan, string(content), workingFolder)
+
+	plan.WriteString("\nChecks:\n")
+	describeCheck(plan, p.codePolicy.CheckFile(filePath))
+	p.describeApproval(plan)
+}
+
+// describeTests writes the tests that would run, and the checks of their files. The files of a folder are only checked
+// when the call runs, as a folder may hold many of them.
+func (p *Planner) describeTests(plan *strings.Builder, args callArguments) {
+	var selection []string
+	if args.TestClass != "" {
+		selection = append(selection, "of the test class "+args.TestClass)
+	}
+	if args.Tag != "" {
+		selection = append(selection, "with the tag "+args.Tag)
+	}
+	if args.NamePattern != "" {
+		selection = append(selection, "whose name matches "+args.NamePattern)
+	}
+	tests := strings.Join(append([]string{"the tests"}, selection...), " ")
+
+	if strings.EqualFold(filepath.Ext(args.TestPath), ".m") {
+		fmt.Fprintf(plan, "It would run %s of the MATLAB file %s:\n", tests, args.TestPath)
+		p.describeFile(plan, args.TestPath, "")
+		return
+	}
+
+	fmt.Fprintf(plan, "It would run %s of the MATLAB files of the folder %s and its subfolders.\n", tests, args.TestPath)
+	plan.WriteString("\nChecks:\n- The code policy would check every MATLAB file of the folder.\n")
+	if p.config.RequireApproval() {
+		plan.WriteString("- The user would be asked to approve every MATLAB file of the folder.\n")
+	}
+}
+
+// describeSimulinkParameter writes the parameter that would be set, and the check of its value, as Simulink evaluates
+// the values of most block parameters as MATLAB expressions.
+func (p *Planner) describeSimulinkParameter(plan *strings.Builder, args callArguments) {
+	target := "the Simulink model " + args.ModelPath
+	if args.BlockPath != "" {
+		target = fmt.Sprintf("the block %s of the Simulink model %s", args.BlockPath, args.ModelPath)
+	}
+	fmt.Fprintf(plan, "It would set the parameter %s of %s, loaded in the MATLAB session, to:\n", args.Parameter, target)
+	writeCode(plan, args.valueText())
+	plan.WriteString("The model would not be saved.\n")
+
+	plan.WriteString("\nChecks:\n")
+	describeCheck(plan, p.codePolicy.CheckCode(args.valueText()))
+}
+
+// describePythonCode writes the Python code and its checks. Effects are only found in MATLAB code.
+func (p *Planner) describePythonCode(plan *strings.Builder, code string) {
+	writeFencedCode(plan, "python", code)
+	plan.WriteString("\nEffects:\n- The effects of Python code are not listed: only the statements of MATLAB code are scanned.\n")
+
+	plan.WriteString("\nChecks:\n")
+	describeCheck(plan, p.codePolicy.CheckPython())
+	p.describeApproval(plan)
+}
+
+func (p *Planner) describePythonEnvironment(plan *strings.Builder, version string, executionMode string) {
+	plan.WriteString("It would set the Python environment of the MATLAB session with pyenv:\n")
+	if version != "" {
+		fmt.Fprintf(plan, "- Python version: %s\n", version)
+	}
+	if executionMode != "" {
+		fmt.Fprintf(plan, "- Execution mode: %s\n", executionMode)
+	}
+	plan.WriteString("A Python running out of process would be stopped, and its variables lost.\n")
+
+	plan.WriteString("\nChecks:\n")
+	describeCheck(plan, p.codePolicy.CheckPython())
+}
+
+func writeCode(plan *strings.Builder, code string) {
+	writeFencedCode(plan, "matlab", code)
+}
+
+func writeFencedCode(plan *strings.Builder, language string, code string) {
+	plan.WriteString("```" + language + "\n")
+	plan.WriteString(strings.TrimRight(code, "\n"))
+	plan.WriteString("\n```\n")
+}
+
+func (p *Planner) describeEffects(plan *strings.Builder, code string, workingFolder string) {
+	plan.WriteString("\nEffects:\n")
+	if workingFolder != "" {
+		fmt.Fprintf(plan, "- The working folder of MATLAB would change to %s.\n", workingFolder)
+	}
+
+	effects := p.codePolicy.Effects(code)
+	for _, effect := range effects {
+		fmt.Fprintf(plan, "- Line %d %s: %s\n", effect.Line, effect.Kind, effect.Statement)
+	}
+	if len(effects) == 0 {
+		plan.WriteString("- No statement of the code writes or deletes files, changes the working folder or the MATLAB path, installs add-ons or edits Simulink models.\n")
+	}
+	plan.WriteString("The scan cannot find the effects of the functions and scripts the code calls, nor of the code it evaluates from strings.\n")
+}
+
+func describeCheck(plan *strings.Builder, err error) {
+	if err != nil {
+		fmt.Fprintf(plan, "- The code policy would reject the call: %v\n", err)
+		return
+	}
+	plan.WriteString("- The code policy would accept the call.\n")
+}
+
+// describeTargetChecks writes whether the server may use the real-time target, and whether the user would approve the
+// action, as the action drives hardware.
+func (p *Planner) describeTargetChecks(plan *strings.Builder, target string) {
+	plan.WriteString("\nChecks:\n")
+	if !slices.Contains(p.config.RealTimeTargets(), target) {
+		fmt.Fprintf(plan, "- The target %s is not one of the real-time targets the server may use, so the call would fail.\n", target)
+		return
+	}
+	plan.WriteString("- The server may use the target.\n")
+	if p.config.RequireApproval() {
+		plan.WriteString("- The user would be asked to approve the action.\n")
+	}
+}
+
+func (p *Planner) describeApproval(plan *strings.Builder) {
+	if p.config.RequireApproval() {
+		plan.WriteString("- The user would be asked to approve the code.\n")
+	}
+}
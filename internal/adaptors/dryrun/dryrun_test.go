@@ -0,0 +1,694 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package dryrun_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/dryrun"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/dryrun"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanner_Applies(t *testing.T) {
+	testCases := []struct {
+		name      string
+		tool      string
+		arguments string
+		dryRun    bool
+		expected  bool
+	}{
+		{
+			name:      "no dry run",
+			tool:      "evaluate_matlab_code",
+			arguments: `{"code":"x = 1"}`,
+			expected:  false,
+		},
+		{
+			name:      "dry run asked by the call",
+			tool:      "evaluate_matlab_code",
+			arguments: `{"code":"x = 1","dry_run":true}`,
+			expected:  true,
+		},
+		{
+			name:      "dry-run mode of the server",
+			tool:      "cancel_job",
+			arguments: `{"job_id":"job-1","dry_run":false}`,
+			dryRun:    true,
+			expected:  true,
+		},
+		{
+			name:      "dry run asked with a JSON value",
+			tool:      "set_matlab_variable",
+			arguments: `{"name":"gains","value":[0.5,1,2],"dry_run":true}`,
+			expected:  true,
+		},
+		{
+			name:      "invalid arguments",
+			tool:      "run_matlab_file",
+			arguments: `{not json`,
+			expected:  false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockCodePolicy := &mocks.MockCodePolicy{}
+			defer mockCodePolicy.AssertExpectations(t)
+
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockConfig.EXPECT().
+				DryRun().
+				Return(testCase.dryRun).
+				Once()
+
+			planner := dryrun.New(mockConfig, mockCodePolicy, mockOSLayer)
+
+			// Act
+			result := planner.Applies(testCase.tool, json.RawMessage(testCase.arguments))
+
+			// Assert
+			assert.Equal(t, testCase.expected, result)
+		})
+	}
+}
+
+func TestPlanner_Applies_ReadOnlyTool(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	planner := dryrun.New(mockConfig, mockCodePolicy, mockOSLayer)
+
+	// Act
+	result := planner.Applies("check_matlab_code", json.RawMessage(`{"script_path":"/home/user/script.m","dry_run":true}`))
+
+	// Assert
+	assert.False(t, result, "Tools that do not run code should always run")
+}
+
+func TestPlanner_Plan_Code(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	code := "x = magic(3);\nsave('x.mat', 'x')"
+
+	mockConfig.EXPECT().
+		DryRun().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		RequireApproval().
+		Return(true).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		Effects(code).
+		Return([]entities.CodeEffect{{Kind: entities.CodeEffectWritesFiles, Line: 2, Statement: "save('x.mat', 'x')"}}).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		CheckCode(code).
+		Return(nil).
+		Once()
+
+	planner := dryrun.New(mockConfig, mockCodePolicy, mockOSLayer)
+
+	// Act
+	plan := planner.Plan("evaluate_matlab_code", json.RawMessage(`{"project_path":"/home/user/project","code":"x = magic(3);\nsave('x.mat', 'x')","dry_run":true}`))
+
+	// Assert
+	assert.Equal(t, "Dry run: the call to evaluate_matlab_code was not run.\n\n"+
+		"It would run this MATLAB code in /home/user/project:\n"+
+		"```matlab\nx = magic(3);\nsave('x.mat', 'x')\n```\n"+
+		"\nEffects:\n"+
+		"- The working folder of MATLAB would change to /home/user/project.\n"+
+		"- Line 2 writes files: save('x.mat', 'x')\n"+
+		"The scan cannot find the effects of the functions and scripts the code calls, nor of the code it evaluates from strings.\n"+
+		"\nChecks:\n"+
+		"- The code policy would accept the call.\n"+
+		"- The user would be asked to approve the code.\n", plan)
+}
+
+func TestPlanner_Plan_FileRejectedByCodePolicy(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	scriptPath := "/home/user/tests/testSolver.m"
+	content := "system('make')"
+
+	mockConfig.EXPECT().
+		DryRun().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		RequireApproval().
+		Return(false).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(scriptPath).
+		Return([]byte(content), nil).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		Effects(content).
+		Return(nil).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		CheckFile(scriptPath).
+		Return(assert.AnError).
+		Once()
+
+	planner := dryrun.New(mockConfig, mockCodePolicy, mockOSLayer)
+
+	// Act
+	plan := planner.Plan("run_matlab_test_file", json.RawMessage(`{"script_path":"/home/user/tests/testSolver.m","parallel":true}`))
+
+	// Assert
+	assert.Contains(t, plan, "Dry run: the server runs in dry-run mode, so the call to run_matlab_test_file was not run.\n")
+	assert.Contains(t, plan, "It would run the tests of the MATLAB file /home/user/tests/testSolver.m in parallel, on the workers of the parallel pool:\n```matlab\nsystem('make')\n```\n")
+	assert.Contains(t, plan, "- No statement of the code writes or deletes files")
+	assert.NotContains(t, plan, "working folder of MATLAB would change")
+	assert.Contains(t, plan, "- The code policy would reject the call: "+assert.AnError.Error()+"\n")
+	assert.NotContains(t, plan, "approve")
+}
+
+func TestPlanner_Plan_RunMATLABTests(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	testPath := "/home/user/tests/SolverTest.m"
+	content := "classdef SolverTest < matlab.unittest.TestCase\nend"
+
+	mockConfig.EXPECT().
+		DryRun().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		RequireApproval().
+		Return(true).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(testPath).
+		Return([]byte(content), nil).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		Effects(content).
+		Return(nil).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		CheckFile(testPath).
+		Return(nil).
+		Once()
+
+	planner := dryrun.New(mockConfig, mockCodePolicy, mockOSLayer)
+
+	// Act
+	plan := planner.Plan("run_matlab_tests", json.RawMessage(`{"test_path":"/home/user/tests/SolverTest.m","tag":"Unit","name_pattern":"SolverTest/testConverge*","dry_run":true}`))
+
+	// Assert
+	assert.Contains(t, plan, "It would run the tests with the tag Unit whose name matches SolverTest/testConverge* of the MATLAB file /home/user/tests/SolverTest.m:\n```matlab\n"+content+"\n```\n")
+	assert.Contains(t, plan, "- The code policy would accept the call.\n")
+	assert.Contains(t, plan, "- The user would be asked to approve the code.\n")
+}
+
+func TestPlanner_Plan_RunMATLABTests_Folder(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		DryRun().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		RequireApproval().
+		Return(true).
+		Once()
+
+	planner := dryrun.New(mockConfig, mockCodePolicy, mockOSLayer)
+
+	// Act
+	plan := planner.Plan("run_matlab_tests", json.RawMessage(`{"test_path":"/home/user/tests","test_class":"SolverTest","dry_run":true}`))
+
+	// Assert
+	assert.Contains(t, plan, "It would run the tests of the test class SolverTest of the MATLAB files of the folder /home/user/tests and its subfolders.\n")
+	assert.Contains(t, plan, "- The code policy would check every MATLAB file of the folder.\n")
+	assert.Contains(t, plan, "- The user would be asked to approve every MATLAB file of the folder.\n")
+}
+
+func TestPlanner_Plan_FileReadError(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		DryRun().
+		Return(false).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile("/home/user/missing.m").
+		Return(nil, assert.AnError).
+		Once()
+
+	planner := dryrun.New(mockConfig, mockCodePolicy, mockOSLayer)
+
+	// Act
+	plan := planner.Plan("run_matlab_file", json.RawMessage(`{"script_path":"/home/user/missing.m","dry_run":true}`))
+
+	// Assert
+	assert.Contains(t, plan, "It would run the MATLAB file /home/user/missing.m in /home/user:\n")
+	assert.Contains(t, plan, "The file cannot be read, so the call would fail: "+assert.AnError.Error())
+}
+
+func TestPlanner_Plan_StopMATLABSession(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		DryRun().
+		Return(false).
+		Once()
+
+	planner := dryrun.New(mockConfig, mockCodePolicy, mockOSLayer)
+
+	// Act
+	plan := planner.Plan("stop_matlab_session", json.RawMessage(`{"session_id":2,"dry_run":true}`))
+
+	// Assert
+	assert.Equal(t, "Dry run: the call to stop_matlab_session was not run.\n\nIt would stop the MATLAB session 2. Its workspace, and the figures it shows, would be lost.\n", plan)
+}
+
+func TestPlanner_Plan_StopNamedMATLABSession(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		DryRun().
+		Return(false).
+		Once()
+
+	planner := dryrun.New(mockConfig, mockCodePolicy, mockOSLayer)
+
+	// Act
+	plan := planner.Plan("stop_matlab_session", json.RawMessage(`{"session":"analysis","session_id":2,"dry_run":true}`))
+
+	// Assert
+	assert.Equal(t, "Dry run: the call to stop_matlab_session was not run.\n\nIt would stop the MATLAB session analysis. Its workspace, and the figures it shows, would be lost.\n", plan)
+}
+
+func TestPlanner_Plan_PythonCode(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		DryRun().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		RequireApproval().
+		Return(true).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		CheckPython().
+		Return(nil).
+		Once()
+
+	planner := dryrun.New(mockConfig, mockCodePolicy, mockOSLayer)
+
+	// Act
+	plan := planner.Plan("run_python_code", json.RawMessage(`{"project_path":"/home/user/project","code":"import os\nos.remove('data.csv')\n","dry_run":true}`))
+
+	// Assert
+	assert.Equal(t, "Dry run: the call to run_python_code was not run.\n\n"+
+		"It would run this Python code with pyrun in the MATLAB session, in /home/user/project:\n"+
+		"```python\nimport os\nos.remove('data.csv')\n```\n"+
+		"\nEffects:\n"+
+		"- The effects of Python code are not listed: only the statements of MATLAB code are scanned.\n"+
+		"\nChecks:\n"+
+		"- The code policy would accept the call.\n"+
+		"- The user would be asked to approve the code.\n", plan)
+}
+
+func TestPlanner_Plan_PythonEnvironmentRejectedByCodePolicy(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		DryRun().
+		Return(false).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		CheckPython().
+		Return(assert.AnError).
+		Once()
+
+	planner := dryrun.New(mockConfig, mockCodePolicy, mockOSLayer)
+
+	// Act
+	plan := planner.Plan("set_python_environment", json.RawMessage(`{"version":"/home/user/venv/bin/python","execution_mode":"OutOfProcess","dry_run":true}`))
+
+	// Assert
+	assert.Equal(t, "Dry run: the call to set_python_environment was not run.\n\n"+
+		"It would set the Python environment of the MATLAB session with pyenv:\n"+
+		"- Python version: /home/user/venv/bin/python\n"+
+		"- Execution mode: OutOfProcess\n"+
+		"A Python running out of process would be stopped, and its variables lost.\n"+
+		"\nChecks:\n"+
+		"- The code policy would reject the call: "+assert.AnError.Error()+"\n", plan)
+}
+
+func TestPlanner_Plan_SimulateSimulinkModel(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		DryRun().
+		Return(false).
+		Once()
+
+	planner := dryrun.New(mockConfig, mockCodePolicy, mockOSLayer)
+
+	// Act
+	plan := planner.Plan("simulate_simulink_model", json.RawMessage(`{"model_path":"/home/user/models/plant.slx","stop_time":2.5,"dry_run":true}`))
+
+	// Assert
+	assert.Equal(t, "Dry run: the call to simulate_simulink_model was not run.\n\n"+
+		"It would simulate the Simulink model /home/user/models/plant.slx in the MATLAB session until 2.5 seconds, loading it if needed. "+
+		"Simulating runs the callbacks of the model, and may write files, such as those of To File blocks. The model would not change.\n", plan)
+}
+
+func TestPlanner_Plan_SetSimulinkParameter(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		DryRun().
+		Return(false).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		CheckCode("2*K").
+		Return(nil).
+		Once()
+
+	planner := dryrun.New(mockConfig, mockCodePolicy, mockOSLayer)
+
+	// Act
+	plan := planner.Plan("set_simulink_parameter", json.RawMessage(`{"model_path":"/home/user/models/plant.slx","block_path":"Controller/Gain","parameter":"Gain","value":"2*K","dry_run":true}`))
+
+	// Assert
+	assert.Equal(t, "Dry run: the call to set_simulink_parameter was not run.\n\n"+
+		"It would set the parameter Gain of the block Controller/Gain of the Simulink model /home/user/models/plant.slx, loaded in the MATLAB session, to:\n"+
+		"```matlab\n2*K\n```\n"+
+		"The model would not be saved.\n"+
+		"\nChecks:\n"+
+		"- The code policy would accept the call.\n", plan)
+}
+
+func TestPlanner_Plan_SetMATLABVariable(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		DryRun().
+		Return(false).
+		Once()
+
+	planner := dryrun.New(mockConfig, mockCodePolicy, mockOSLayer)
+
+	// Act
+	plan := planner.Plan("set_matlab_variable", json.RawMessage(`{"name":"gains","value":{"class":"int32","size":[1,2],"data":[1,2]},"dry_run":true}`))
+
+	// Assert
+	assert.Equal(t, "Dry run: the call to set_matlab_variable was not run.\n\n"+
+		"It would set the variable gains of the workspace of the MATLAB session, replacing its value, to:\n"+
+		"```json\n{\"class\":\"int32\",\"size\":[1,2],\"data\":[1,2]}\n```\n", plan)
+}
+
+func TestPlanner_Plan_LoadMATFileVariables(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		DryRun().
+		Return(false).
+		Once()
+
+	planner := dryrun.New(mockConfig, mockCodePolicy, mockOSLayer)
+
+	// Act
+	plan := planner.Plan("load_mat_file_variables", json.RawMessage(`{"file_path":"/home/user/data/measurements.mat","variables":["t","y"],"overwrite":true,"dry_run":true}`))
+
+	// Assert
+	assert.Equal(t, "Dry run: the call to load_mat_file_variables was not run.\n\n"+
+		"It would load the variables t, y of the MAT-file /home/user/data/measurements.mat into the workspace of the MATLAB session. "+
+		"Variables of the workspace with the same names would be replaced.\n", plan)
+}
+
+func TestPlanner_Plan_ControlRealTimeApplication(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		DryRun().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		RealTimeTargets().
+		Return([]string{"rig1"}).
+		Once()
+
+	mockConfig.EXPECT().
+		RequireApproval().
+		Return(true).
+		Once()
+
+	planner := dryrun.New(mockConfig, mockCodePolicy, mockOSLayer)
+
+	// Act
+	plan := planner.Plan("control_realtime_application", json.RawMessage(`{"target":"rig1","action":"start","stop_time":30,"dry_run":true}`))
+
+	// Assert
+	assert.Equal(t, "Dry run: the call to control_realtime_application was not run.\n\n"+
+		"It would start the real-time application loaded on the target rig1, and stop it after 30 seconds.\n"+
+		"\nChecks:\n"+
+		"- The server may use the target.\n"+
+		"- The user would be asked to approve the action.\n", plan)
+}
+
+func TestPlanner_Plan_DeployRealTimeApplicationToUnknownTarget(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		DryRun().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		RealTimeTargets().
+		Return([]string{"rig1"}).
+		Once()
+
+	planner := dryrun.New(mockConfig, mockCodePolicy, mockOSLayer)
+
+	// Act
+	plan := planner.Plan("deploy_realtime_application", json.RawMessage(`{"target":"production","application_path":"/home/user/rig/controller.mldatx","dry_run":true}`))
+
+	// Assert
+	assert.Equal(t, "Dry run: the call to deploy_realtime_application was not run.\n\n"+
+		"It would load the real-time application /home/user/rig/controller.mldatx on the target production, replacing the application loaded on it. The application would not start.\n"+
+		"\nChecks:\n"+
+		"- The target production is not one of the real-time targets the server may use, so the call would fail.\n", plan)
+}
+
+func TestPlanner_Plan_DeployProductionArchive(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		DryRun().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		RequireApproval().
+		Return(true).
+		Once()
+
+	planner := dryrun.New(mockConfig, mockCodePolicy, mockOSLayer)
+
+	// Act
+	plan := planner.Plan("deploy_production_archive", json.RawMessage(`{"archive_path":"/home/user/pricing/pricing.ctf"}`))
+
+	// Assert
+	assert.Equal(t, "Dry run: the server runs in dry-run mode, so the call to deploy_production_archive was not run.\n\n"+
+		"It would copy the deployable archive /home/user/pricing/pricing.ctf to the auto_deploy folder of the MATLAB Production Server instance, replacing the archive with the same name. The instance would serve its functions to all its clients.\n"+
+		"\nChecks:\n"+
+		"- The user would be asked to approve the deployment.\n", plan)
+}
+
+func TestPlanner_Plan_InvokeProductionFunction(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		DryRun().
+		Return(false).
+		Once()
+
+	planner := dryrun.New(mockConfig, mockCodePolicy, mockOSLayer)
+
+	// Act
+	plan := planner.Plan("invoke_production_function", json.RawMessage(`{"archive":"pricing","function":"price","inputs":[100],"dry_run":true}`))
+
+	// Assert
+	assert.Equal(t, "Dry run: the call to invoke_production_function was not run.\n\n"+
+		"It would call the function price of the archive pricing deployed to the MATLAB Production Server instance. The function may have side effects, such as writing to a database.\n", plan)
+}
@@ -0,0 +1,211 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package eventbuffer
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/directory"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// Capacity is the number of most recent events kept in the buffer.
+const Capacity = 100
+
+const snapshotFileName = "events.json"
+
+type OSLayer interface {
+	TempDir() string
+	ReadFile(filePath string) ([]byte, error)
+	WriteFile(name string, data []byte, perm os.FileMode) error
+	Rename(oldPath string, newPath string) error
+}
+
+type FileLayer interface {
+	Glob(pattern string) ([]string, error)
+}
+
+type Directory interface {
+	BaseDir() string
+}
+
+type LoggerFactory interface {
+	GetGlobalLogger() entities.Logger
+}
+
+type Encryptor interface {
+	Encrypt(data []byte) ([]byte, error)
+	Decrypt(data []byte) ([]byte, error)
+}
+
+type Sink interface {
+	Publish(event entities.Event)
+}
+
+type Metrics interface {
+	RecordEvent(kind entities.EventKind)
+}
+
+type Decryptor interface {
+	Decrypt(data []byte) ([]byte, error)
+}
+
+// Buffer keeps the last Capacity events in memory.
+// Every change is also written to a snapshot file in the folder of the server instance, so that the events can be
+// read by the status and logs commands, which run in another process. The snapshot is written to a temporary file
+// first, and then renamed, so that a server crashing while writing it never leaves a torn snapshot behind.
+// Every event is also published to the event sink, which delivers it to the configured webhooks and socket, and
+// counted in the metrics.
+type Buffer struct {
+	osLayer   OSLayer
+	directory Directory
+	logger    entities.Logger
+	encryptor Encryptor
+	sink      Sink
+	metrics   Metrics
+
+	lock   *sync.Mutex
+	events []entities.Event
+}
+
+func New(
+	osLayer OSLayer,
+	directory Directory,
+	loggerFactory LoggerFactory,
+	encryptor Encryptor,
+	sink Sink,
+	metrics Metrics,
+) *Buffer {
+	return &Buffer{
+		osLayer:   osLayer,
+		directory: directory,
+		logger:    loggerFactory.GetGlobalLogger().With("component", "event-buffer"),
+		encryptor: encryptor,
+		sink:      sink,
+		metrics:   metrics,
+
+		lock: new(sync.Mutex),
+	}
+}
+
+func (b *Buffer) Record(kind entities.EventKind, message string, details map[string]any) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	event := entities.Event{
+		Time:    time.Now(),
+		Kind:    kind,
+		Message: message,
+		Details: details,
+	}
+	b.events = trim(append(b.events, event))
+	b.sink.Publish(event)
+	b.metrics.RecordEvent(kind)
+
+	if err := b.writeSnapshot(); err != nil {
+		b.logger.WithError(err).Warn("Failed to write events snapshot")
+	}
+}
+
+// Events returns the events recorded by this server, oldest first.
+func (b *Buffer) Events() []entities.Event {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	events := make([]entities.Event, len(b.events))
+	copy(events, b.events)
+	return events
+}
+
+func (b *Buffer) writeSnapshot() error {
+	data, err := json.Marshal(b.events)
+	if err != nil {
+		return err
+	}
+
+	data, err = b.encryptor.Encrypt(data)
+	if err != nil {
+		return err
+	}
+
+	snapshotPath := filepath.Join(b.directory.BaseDir(), snapshotFileName)
+	partialPath := snapshotPath + ".partial"
+	if err := b.osLayer.WriteFile(partialPath, data, 0o600); err != nil {
+		return err
+	}
+	return b.osLayer.Rename(partialPath, snapshotPath)
+}
+
+// Reader reads the events recorded by the servers, possibly running in other processes, from the snapshots in the
+// folders of the server instances.
+type Reader struct {
+	osLayer   OSLayer
+	fileLayer FileLayer
+	decryptor Decryptor
+}
+
+func NewReader(osLayer OSLayer, fileLayer FileLayer, decryptor Decryptor) *Reader {
+	return &Reader{
+		osLayer:   osLayer,
+		fileLayer: fileLayer,
+		decryptor: decryptor,
+	}
+}
+
+// Read returns the last Capacity events recorded by all the server instances, oldest first.
+// The snapshots of the instances whose folder was removed while reading them are skipped.
+func (r *Reader) Read() ([]entities.Event, error) {
+	snapshotPaths, err := r.fileLayer.Glob(filepath.Join(r.osLayer.TempDir(), directory.InstanceDirPattern+"*", snapshotFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	var events []entities.Event
+	for _, snapshotPath := range snapshotPaths {
+		snapshotEvents, err := r.readSnapshot(snapshotPath)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, snapshotEvents...)
+	}
+
+	slices.SortStableFunc(events, func(a, b entities.Event) int {
+		return a.Time.Compare(b.Time)
+	})
+	return trim(events), nil
+}
+
+func (r *Reader) readSnapshot(snapshotPath string) ([]entities.Event, error) {
+	data, err := r.osLayer.ReadFile(snapshotPath)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err = r.decryptor.Decrypt(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []entities.Event
+	if err := json.Unmarshal(data, &events); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func trim(events []entities.Event) []entities.Event {
+	if len(events) <= Capacity {
+		return events
+	}
+	return append([]entities.Event(nil), events[len(events)-Capacity:]...)
+}
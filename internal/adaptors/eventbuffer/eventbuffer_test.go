@@ -0,0 +1,514 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package eventbuffer_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/eventbuffer"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/eventbuffer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	snapshotFileName = "events.json"
+	partialFileName  = "events.json.partial"
+)
+
+// newPassthroughEncryptor returns an encryptor that leaves the data unchanged, as when encryption is turned off.
+func newPassthroughEncryptor(t *testing.T) *mocks.MockEncryptor {
+	t.Helper()
+
+	mockEncryptor := &mocks.MockEncryptor{}
+	t.Cleanup(func() { mockEncryptor.AssertExpectations(t) })
+
+	mockEncryptor.EXPECT().
+		Encrypt(mock.Anything).
+		RunAndReturn(func(data []byte) ([]byte, error) { return data, nil }).
+		Maybe()
+
+	mockEncryptor.EXPECT().
+		Decrypt(mock.Anything).
+		RunAndReturn(func(data []byte) ([]byte, error) { return data, nil }).
+		Maybe()
+
+	return mockEncryptor
+}
+
+// newDiscardingSink returns an event sink that drops the events, as when no webhook or socket is set.
+func newDiscardingSink(t *testing.T) *mocks.MockSink {
+	t.Helper()
+
+	mockSink := &mocks.MockSink{}
+	t.Cleanup(func() { mockSink.AssertExpectations(t) })
+
+	mockSink.EXPECT().
+		Publish(mock.Anything).
+		Return().
+		Maybe()
+
+	return mockSink
+}
+
+// newUncountedMetrics returns metrics that ignore the events, as when no metrics listen address is set.
+func newUncountedMetrics(t *testing.T) *mocks.MockMetrics {
+	t.Helper()
+
+	mockMetrics := &mocks.MockMetrics{}
+	t.Cleanup(func() { mockMetrics.AssertExpectations(t) })
+
+	mockMetrics.EXPECT().
+		RecordEvent(mock.Anything).
+		Return().
+		Maybe()
+
+	return mockMetrics
+}
+
+func TestNew_StartsEmpty(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockDirectory := &mocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	// Act
+	buffer := eventbuffer.New(mockOSLayer, mockDirectory, mockLoggerFactory, newPassthroughEncryptor(t), newDiscardingSink(t), newUncountedMetrics(t))
+
+	// Assert
+	assert.Empty(t, buffer.Events(), "The events of previous servers should not be read into the buffer")
+}
+
+func TestBuffer_Record_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockDirectory := &mocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	instanceDir := t.TempDir()
+	expectedPartialPath := filepath.Join(instanceDir, partialFileName)
+	expectedPath := filepath.Join(instanceDir, snapshotFileName)
+	expectedDetails := map[string]any{"pid": 1234}
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	mockDirectory.EXPECT().
+		BaseDir().
+		Return(instanceDir).
+		Once()
+
+	var writtenSnapshot []byte
+	mockOSLayer.EXPECT().
+		WriteFile(expectedPartialPath, mock.Anything, os.FileMode(0o600)).
+		Run(func(_ string, data []byte, _ os.FileMode) {
+			writtenSnapshot = data
+		}).
+		Return(nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Rename(expectedPartialPath, expectedPath).
+		Return(nil).
+		Once()
+
+	buffer := eventbuffer.New(mockOSLayer, mockDirectory, mockLoggerFactory, newPassthroughEncryptor(t), newDiscardingSink(t), newUncountedMetrics(t))
+
+	// Act
+	buffer.Record(entities.EventKindServerStarted, "Server started", expectedDetails)
+
+	// Assert
+	events := buffer.Events()
+	require.Len(t, events, 1)
+	assert.Equal(t, entities.EventKindServerStarted, events[0].Kind)
+	assert.Equal(t, "Server started", events[0].Message)
+	assert.Equal(t, expectedDetails, events[0].Details)
+	assert.False(t, events[0].Time.IsZero(), "Event time should be set")
+
+	var snapshotEvents []entities.Event
+	require.NoError(t, json.Unmarshal(writtenSnapshot, &snapshotEvents))
+	require.Len(t, snapshotEvents, 1)
+	assert.Equal(t, entities.EventKindServerStarted, snapshotEvents[0].Kind)
+}
+
+func TestBuffer_Record_PublishesToSink(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockDirectory := &mocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSink := &mocks.MockSink{}
+	defer mockSink.AssertExpectations(t)
+
+	mockMetrics := &mocks.MockMetrics{}
+	defer mockMetrics.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	mockDirectory.EXPECT().
+		BaseDir().
+		Return(t.TempDir()).
+		Once()
+
+	mockOSLayer.EXPECT().
+		WriteFile(mock.Anything, mock.Anything, os.FileMode(0o600)).
+		Return(nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Rename(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+
+	var publishedEvent entities.Event
+	mockSink.EXPECT().
+		Publish(mock.Anything).
+		Run(func(event entities.Event) {
+			publishedEvent = event
+		}).
+		Return().
+		Once()
+
+	mockMetrics.EXPECT().
+		RecordEvent(entities.EventKindMATLABSessionStarted).
+		Return().
+		Once()
+
+	buffer := eventbuffer.New(mockOSLayer, mockDirectory, mockLoggerFactory, newPassthroughEncryptor(t), mockSink, mockMetrics)
+
+	// Act
+	buffer.Record(entities.EventKindMATLABSessionStarted, "MATLAB session started", nil)
+
+	// Assert
+	assert.Equal(t, buffer.Events(), []entities.Event{publishedEvent})
+}
+
+func TestBuffer_Record_KeepsOnlyMostRecentEvents(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockDirectory := &mocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	mockDirectory.EXPECT().
+		BaseDir().
+		Return(t.TempDir())
+
+	mockOSLayer.EXPECT().
+		WriteFile(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil)
+
+	mockOSLayer.EXPECT().
+		Rename(mock.Anything, mock.Anything).
+		Return(nil)
+
+	buffer := eventbuffer.New(mockOSLayer, mockDirectory, mockLoggerFactory, newPassthroughEncryptor(t), newDiscardingSink(t), newUncountedMetrics(t))
+
+	// Act
+	for i := range eventbuffer.Capacity + 5 {
+		buffer.Record(entities.EventKindToolCallFailed, fmt.Sprintf("event %d", i), nil)
+	}
+
+	// Assert
+	events := buffer.Events()
+	require.Len(t, events, eventbuffer.Capacity)
+	assert.Equal(t, "event 5", events[0].Message, "Oldest events should be dropped")
+	assert.Equal(t, fmt.Sprintf("event %d", eventbuffer.Capacity+4), events[len(events)-1].Message)
+}
+
+func TestBuffer_Record_WriteErrorIsLogged(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockDirectory := &mocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockDirectory.EXPECT().
+		BaseDir().
+		Return(t.TempDir()).
+		Once()
+
+	mockOSLayer.EXPECT().
+		WriteFile(mock.Anything, mock.Anything, mock.Anything).
+		Return(assert.AnError).
+		Once()
+
+	buffer := eventbuffer.New(mockOSLayer, mockDirectory, mockLoggerFactory, newPassthroughEncryptor(t), newDiscardingSink(t), newUncountedMetrics(t))
+
+	// Act
+	buffer.Record(entities.EventKindServerStarted, "Server started", nil)
+
+	// Assert
+	mockOSLayer.AssertNotCalled(t, "Rename", mock.Anything, mock.Anything)
+	assert.Len(t, buffer.Events(), 1, "Event should be kept in memory even if the snapshot fails")
+	warnLogs := mockLogger.WarnLogs()
+	require.Contains(t, warnLogs, "Failed to write events snapshot")
+	assert.Equal(t, assert.AnError, warnLogs["Failed to write events snapshot"]["error"])
+}
+
+func TestBuffer_Record_EncryptsSnapshot(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockDirectory := &mocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockEncryptor := &mocks.MockEncryptor{}
+	defer mockEncryptor.AssertExpectations(t)
+
+	instanceDir := t.TempDir()
+	expectedPartialPath := filepath.Join(instanceDir, partialFileName)
+	encryptedSnapshot := []byte("matlab-mcp-encrypted:v1:c2VjcmV0")
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	mockDirectory.EXPECT().
+		BaseDir().
+		Return(instanceDir).
+		Once()
+
+	mockEncryptor.EXPECT().
+		Encrypt(mock.Anything).
+		Return(encryptedSnapshot, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		WriteFile(expectedPartialPath, encryptedSnapshot, os.FileMode(0o600)).
+		Return(nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Rename(expectedPartialPath, filepath.Join(instanceDir, snapshotFileName)).
+		Return(nil).
+		Once()
+
+	buffer := eventbuffer.New(mockOSLayer, mockDirectory, mockLoggerFactory, mockEncryptor, newDiscardingSink(t), newUncountedMetrics(t))
+
+	// Act
+	buffer.Record(entities.EventKindServerStarted, "Server started", nil)
+
+	// Assert
+	assert.Len(t, buffer.Events(), 1)
+}
+
+func TestReader_Read_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	tempDir := t.TempDir()
+	previousSnapshotPath := filepath.Join(tempDir, "matlab-mcp-core-server-111", snapshotFileName)
+	currentSnapshotPath := filepath.Join(tempDir, "matlab-mcp-core-server-222", snapshotFileName)
+
+	takeoverEvent := entities.Event{
+		Time:    time.Date(2025, 1, 2, 3, 4, 6, 0, time.UTC),
+		Kind:    entities.EventKindInstanceTakeover,
+		Message: "Took over from an existing server instance",
+		Details: map[string]any{"previous-pid": float64(4242)},
+	}
+	stoppingEvent := entities.Event{
+		Time:    time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		Kind:    entities.EventKindServerStopping,
+		Message: "Server shutting down",
+	}
+	currentSnapshot, err := json.Marshal([]entities.Event{takeoverEvent})
+	require.NoError(t, err)
+	previousSnapshot, err := json.Marshal([]entities.Event{stoppingEvent})
+	require.NoError(t, err)
+
+	mockOSLayer.EXPECT().
+		TempDir().
+		Return(tempDir).
+		Once()
+
+	mockFileLayer.EXPECT().
+		Glob(filepath.Join(tempDir, "matlab-mcp-core-server-*", snapshotFileName)).
+		Return([]string{currentSnapshotPath, previousSnapshotPath}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(currentSnapshotPath).
+		Return(currentSnapshot, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(previousSnapshotPath).
+		Return(previousSnapshot, nil).
+		Once()
+
+	reader := eventbuffer.NewReader(mockOSLayer, mockFileLayer, newPassthroughEncryptor(t))
+
+	// Act
+	events, err := reader.Read()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []entities.Event{stoppingEvent, takeoverEvent}, events, "The events of all the instances should be merged, oldest first")
+}
+
+func TestReader_Read_NoSnapshot(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		TempDir().
+		Return(t.TempDir()).
+		Once()
+
+	mockFileLayer.EXPECT().
+		Glob(mock.Anything).
+		Return(nil, nil).
+		Once()
+
+	reader := eventbuffer.NewReader(mockOSLayer, mockFileLayer, newPassthroughEncryptor(t))
+
+	// Act
+	events, err := reader.Read()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
+
+func TestReader_Read_SkipsRemovedSnapshot(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		TempDir().
+		Return(t.TempDir()).
+		Once()
+
+	mockFileLayer.EXPECT().
+		Glob(mock.Anything).
+		Return([]string{"/tmp/matlab-mcp-core-server-111/events.json"}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile("/tmp/matlab-mcp-core-server-111/events.json").
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	reader := eventbuffer.NewReader(mockOSLayer, mockFileLayer, newPassthroughEncryptor(t))
+
+	// Act
+	events, err := reader.Read()
+
+	// Assert
+	require.NoError(t, err, "A folder removed while reading, such as by the cleanup command, should be skipped")
+	assert.Empty(t, events)
+}
+
+func TestReader_Read_DecryptError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockDecryptor := &mocks.MockDecryptor{}
+	defer mockDecryptor.AssertExpectations(t)
+
+	encryptedSnapshot := []byte("matlab-mcp-encrypted:v1:c2VjcmV0")
+
+	mockOSLayer.EXPECT().
+		TempDir().
+		Return(t.TempDir()).
+		Once()
+
+	mockFileLayer.EXPECT().
+		Glob(mock.Anything).
+		Return([]string{"/tmp/matlab-mcp-core-server-111/events.json"}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(mock.Anything).
+		Return(encryptedSnapshot, nil).
+		Once()
+
+	mockDecryptor.EXPECT().
+		Decrypt(encryptedSnapshot).
+		Return(nil, assert.AnError).
+		Once()
+
+	reader := eventbuffer.NewReader(mockOSLayer, mockFileLayer, mockDecryptor)
+
+	// Act
+	events, err := reader.Read()
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, events)
+}
@@ -0,0 +1,207 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package eventsink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+const (
+	// queueSize is the number of events waiting to be delivered. Events published when the queue is full are dropped.
+	queueSize   = 1000
+	sendTimeout = 5 * time.Second
+)
+
+type Config interface {
+	EventWebhooks() []string
+	EventSocket() string
+}
+
+type LoggerFactory interface {
+	GetGlobalLogger() entities.Logger
+}
+
+type LifecycleSignaler interface {
+	AddShutdownFunction(shutdownFcn func() error)
+}
+
+type HTTPClientFactory interface {
+	NewClient(timeout time.Duration) *http.Client
+}
+
+// Sink delivers the events of the server, as JSON, to the webhooks and to the event socket set in the configuration,
+// so that they can be piped into monitoring and approval systems.
+// Events are queued and delivered in order by a single goroutine, so that a slow or unreachable receiver never delays
+// a tool call. Each event is delivered at most once: an event that a receiver fails to take is dropped.
+type Sink struct {
+	config     Config
+	logger     entities.Logger
+	httpClient *http.Client
+
+	lock   *sync.RWMutex
+	queue  chan entities.Event
+	closed bool
+	done   chan struct{}
+
+	// conn is the connection to the event socket, only used by the delivery goroutine.
+	conn net.Conn
+}
+
+func New(
+	config Config,
+	loggerFactory LoggerFactory,
+	lifecycleSignaler LifecycleSignaler,
+	httpClientFactory HTTPClientFactory,
+) *Sink {
+	sink := &Sink{
+		config:     config,
+		logger:     loggerFactory.GetGlobalLogger().With("component", "event-sink"),
+		httpClient: httpClientFactory.NewClient(sendTimeout),
+
+		lock: new(sync.RWMutex),
+	}
+
+	if !sink.Enabled() {
+		return sink
+	}
+
+	sink.logger.
+		With("webhooks", len(config.EventWebhooks())).
+		With("socket", config.EventSocket()).
+		Info("Server events are delivered to the event sink")
+
+	sink.queue = make(chan entities.Event, queueSize)
+	sink.done = make(chan struct{})
+	go sink.deliver()
+
+	lifecycleSignaler.AddShutdownFunction(func() error {
+		sink.close()
+		return nil
+	})
+
+	return sink
+}
+
+// Enabled is true when events are delivered to at least one webhook or socket.
+func (s *Sink) Enabled() bool {
+	return len(s.config.EventWebhooks()) > 0 || s.config.EventSocket() != ""
+}
+
+// Publish queues an event for delivery. It never blocks: when the queue is full, the event is dropped.
+func (s *Sink) Publish(event entities.Event) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if s.queue == nil || s.closed {
+		return
+	}
+
+	select {
+	case s.queue <- event:
+	default:
+		s.logger.With("kind", string(event.Kind)).Warn("Event sink queue is full, dropping event")
+	}
+}
+
+// close stops accepting events, and waits for the queued events to be delivered, for at most the time to deliver one.
+func (s *Sink) close() {
+	s.lock.Lock()
+	if s.closed {
+		s.lock.Unlock()
+		return
+	}
+	s.closed = true
+	close(s.queue)
+	s.lock.Unlock()
+
+	select {
+	case <-s.done:
+	case <-time.After(sendTimeout):
+		s.logger.Warn("Timed out delivering the queued events")
+	}
+}
+
+func (s *Sink) deliver() {
+	defer close(s.done)
+	defer func() {
+		if s.conn != nil {
+			_ = s.conn.Close()
+		}
+	}()
+
+	for event := range s.queue {
+		data, err := json.Marshal(event)
+		if err != nil {
+			s.logger.WithError(err).Warn("Failed to encode event")
+			continue
+		}
+
+		for _, webhook := range s.config.EventWebhooks() {
+			if err := s.post(webhook, data); err != nil {
+				s.logger.WithError(err).With("kind", string(event.Kind)).Warn("Failed to deliver event to webhook")
+			}
+		}
+
+		if socket := s.config.EventSocket(); socket != "" {
+			if err := s.write(socket, data); err != nil {
+				s.logger.WithError(err).With("kind", string(event.Kind)).Warn("Failed to deliver event to event socket")
+			}
+		}
+	}
+}
+
+func (s *Sink) post(webhook string, data []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), sendTimeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := s.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close() //nolint:errcheck // Nothing is read from the response
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code: %d", response.StatusCode)
+	}
+
+	return nil
+}
+
+// write writes an event as a line to the event socket, connecting to it first if needed. The connection is dropped
+// when a write fails, so that the next event reconnects, for example once the collector restarted.
+func (s *Sink) write(socket string, data []byte) error {
+	if s.conn == nil {
+		conn, err := net.DialTimeout("unix", socket, sendTimeout)
+		if err != nil {
+			return err
+		}
+		s.conn = conn
+	}
+
+	if err := s.conn.SetWriteDeadline(time.Now().Add(sendTimeout)); err != nil {
+		return err
+	}
+
+	if _, err := s.conn.Write(append(data, '\n')); err != nil {
+		_ = s.conn.Close()
+		s.conn = nil
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,286 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package eventsink_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/eventsink"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/eventsink"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_Disabled(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	mockConfig.EXPECT().
+		EventWebhooks().
+		Return(nil)
+
+	mockConfig.EXPECT().
+		EventSocket().
+		Return("")
+
+	mockHTTPClientFactory := &mocks.MockHTTPClientFactory{}
+	defer mockHTTPClientFactory.AssertExpectations(t)
+
+	mockHTTPClientFactory.EXPECT().
+		NewClient(mock.Anything).
+		Return(&http.Client{}).
+		Once()
+
+	// Act
+	sink := eventsink.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler, mockHTTPClientFactory)
+	sink.Publish(entities.Event{Kind: entities.EventKindToolCalled})
+
+	// Assert
+	assert.False(t, sink.Enabled())
+}
+
+func TestSink_Publish_DeliversToWebhook(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	received := make(chan entities.Event, 1)
+	var contentType string
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		var event entities.Event
+		assert.NoError(t, json.Unmarshal(body, &event))
+		received <- event
+	}))
+	defer webhook.Close()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	mockConfig.EXPECT().
+		EventWebhooks().
+		Return([]string{webhook.URL})
+
+	mockConfig.EXPECT().
+		EventSocket().
+		Return("")
+
+	var shutdown func() error
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.Anything).
+		Run(func(shutdownFcn func() error) {
+			shutdown = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockHTTPClientFactory := &mocks.MockHTTPClientFactory{}
+	defer mockHTTPClientFactory.AssertExpectations(t)
+
+	mockHTTPClientFactory.EXPECT().
+		NewClient(mock.Anything).
+		Return(&http.Client{}).
+		Once()
+
+	sink := eventsink.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler, mockHTTPClientFactory)
+
+	// Act
+	sink.Publish(entities.Event{
+		Kind:    entities.EventKindToolCalled,
+		Message: "Tool called",
+		Details: map[string]any{"tool-name": "evaluate_matlab_code"},
+	})
+
+	// Assert
+	require.True(t, sink.Enabled())
+	select {
+	case event := <-received:
+		assert.Equal(t, entities.EventKindToolCalled, event.Kind)
+		assert.Equal(t, "Tool called", event.Message)
+		assert.Equal(t, "evaluate_matlab_code", event.Details["tool-name"])
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "The webhook did not receive the event")
+	}
+	assert.Equal(t, "application/json", contentType)
+
+	require.NoError(t, shutdown())
+}
+
+func TestSink_Publish_DeliversToSocket(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	// Unix socket paths are limited in length, so the socket is not created in the longer test folder.
+	folder, err := os.MkdirTemp("", "eventsink")
+	require.NoError(t, err)
+	defer os.RemoveAll(folder) //nolint:errcheck // Best effort cleanup
+	socket := filepath.Join(folder, "events.sock")
+
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	defer listener.Close() //nolint:errcheck // Best effort cleanup
+
+	lines := make(chan string, 2)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close() //nolint:errcheck // Best effort cleanup
+
+		scanner := bufio.NewScanner(conn)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+	}()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	mockConfig.EXPECT().
+		EventWebhooks().
+		Return(nil)
+
+	mockConfig.EXPECT().
+		EventSocket().
+		Return(socket)
+
+	var shutdown func() error
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.Anything).
+		Run(func(shutdownFcn func() error) {
+			shutdown = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockHTTPClientFactory := &mocks.MockHTTPClientFactory{}
+	defer mockHTTPClientFactory.AssertExpectations(t)
+
+	mockHTTPClientFactory.EXPECT().
+		NewClient(mock.Anything).
+		Return(&http.Client{}).
+		Once()
+
+	sink := eventsink.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler, mockHTTPClientFactory)
+
+	// Act
+	sink.Publish(entities.Event{Kind: entities.EventKindToolCalled, Message: "Tool called"})
+	sink.Publish(entities.Event{Kind: entities.EventKindPolicyViolation, Message: "Tool call rejected by the policy"})
+	require.NoError(t, shutdown())
+
+	// Assert
+	var kinds []entities.EventKind
+	for range 2 {
+		select {
+		case line := <-lines:
+			var event entities.Event
+			require.NoError(t, json.Unmarshal([]byte(line), &event))
+			kinds = append(kinds, event.Kind)
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "The socket did not receive the events")
+		}
+	}
+	assert.Equal(t, []entities.EventKind{entities.EventKindToolCalled, entities.EventKindPolicyViolation}, kinds)
+}
+
+func TestSink_Publish_AfterShutdownIsDropped(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	requests := make(chan struct{}, 1)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests <- struct{}{}
+	}))
+	defer webhook.Close()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	mockConfig.EXPECT().
+		EventWebhooks().
+		Return([]string{webhook.URL})
+
+	mockConfig.EXPECT().
+		EventSocket().
+		Return("")
+
+	var shutdown func() error
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.Anything).
+		Run(func(shutdownFcn func() error) {
+			shutdown = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockHTTPClientFactory := &mocks.MockHTTPClientFactory{}
+	defer mockHTTPClientFactory.AssertExpectations(t)
+
+	mockHTTPClientFactory.EXPECT().
+		NewClient(mock.Anything).
+		Return(&http.Client{}).
+		Once()
+
+	sink := eventsink.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler, mockHTTPClientFactory)
+	require.NoError(t, shutdown())
+
+	// Act
+	sink.Publish(entities.Event{Kind: entities.EventKindToolCalled})
+
+	// Assert
+	assert.Empty(t, requests)
+}
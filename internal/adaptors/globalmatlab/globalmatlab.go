@@ -12,9 +12,15 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 )
 
+type Config interface {
+	SharedMATLABSessionFile() string
+	ShowMATLABDesktop() bool
+}
+
 type MATLABManager interface {
 	StartMATLABSession(ctx context.Context, sessionLogger entities.Logger, startRequest entities.SessionDetails) (entities.SessionID, error)
 	GetMATLABSessionClient(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) (entities.MATLABSessionClient, error)
+	StopMATLABSession(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) error
 }
 
 type MATLABRootSelector interface {
@@ -26,6 +32,7 @@ type MATLABStartingDirSelector interface {
 }
 
 type GlobalMATLAB struct {
+	config                    Config
 	matlabManager             MATLABManager
 	matlabRootSelector        MATLABRootSelector
 	matlabStartingDirSelector MATLABStartingDirSelector
@@ -39,11 +46,13 @@ type GlobalMATLAB struct {
 }
 
 func New(
+	config Config,
 	matlabManager MATLABManager,
 	matlabRootSelector MATLABRootSelector,
 	matlabStartingDirSelector MATLABStartingDirSelector,
 ) *GlobalMATLAB {
 	return &GlobalMATLAB{
+		config:                    config,
 		matlabManager:             matlabManager,
 		matlabRootSelector:        matlabRootSelector,
 		matlabStartingDirSelector: matlabStartingDirSelector,
@@ -56,19 +65,21 @@ func (g *GlobalMATLAB) Initialize(ctx context.Context, logger entities.Logger) e
 	logger = logger.With("mcp_server_pid", os.Getpid())
 	logger.Debug("GlobalMATLAB.Initialize called")
 
-	var err error
-	g.matlabRoot, err = g.matlabRootSelector.SelectFirstMATLABVersionOnPath(ctx, logger)
-	if err != nil {
-		return err
-	}
+	// A shared MATLAB is already running, in the folder the user chose.
+	if g.config.SharedMATLABSessionFile() == "" {
+		var err error
+		g.matlabRoot, err = g.matlabRootSelector.SelectFirstMATLABVersionOnPath(ctx, logger)
+		if err != nil {
+			return err
+		}
 
-	g.matlabStartingDir, err = g.matlabStartingDirSelector.SelectMatlabStartingDir()
-	if err != nil {
-		logger.WithError(err).Warn("failed to determine MATLAB starting directory, proceeding without one")
+		g.matlabStartingDir, err = g.matlabStartingDirSelector.SelectMatlabStartingDir()
+		if err != nil {
+			logger.WithError(err).Warn("failed to determine MATLAB starting directory, proceeding without one")
+		}
 	}
 
-	err = g.ensureMATLABClientIsValid(ctx, logger)
-	if err != nil {
+	if err := g.ensureMATLABClientIsValid(ctx, logger); err != nil {
 		return err
 	}
 
@@ -128,6 +139,28 @@ func (g *GlobalMATLAB) Client(ctx context.Context, logger entities.Logger) (enti
 	return nil, fmt.Errorf("failed to get MATLAB client after %d attempts: %w", maxRetries, lastErr)
 }
 
+// Restart stops the MATLAB session and starts a new one, with the same MATLAB and starting directory.
+// The calls running in the stopped session fail.
+func (g *GlobalMATLAB) Restart(ctx context.Context, logger entities.Logger) error {
+	var sessionIDZeroValue entities.SessionID
+
+	g.lock.Lock()
+	sessionID := g.sessionID
+	g.sessionID = sessionIDZeroValue
+	g.isReady = false
+	g.cachedStartErr = nil
+	g.lock.Unlock()
+
+	if sessionID != sessionIDZeroValue {
+		logger.With("session_id", sessionID).Info("Stopping MATLAB session to restart it")
+		if err := g.matlabManager.StopMATLABSession(ctx, logger, sessionID); err != nil {
+			logger.WithError(err).Warn("Failed to stop MATLAB session, starting a new one anyway")
+		}
+	}
+
+	return g.ensureMATLABClientIsValid(ctx, logger)
+}
+
 func (g *GlobalMATLAB) ensureMATLABClientIsValid(ctx context.Context, logger entities.Logger) error {
 	g.lock.Lock()
 	defer g.lock.Unlock()
@@ -140,12 +173,11 @@ func (g *GlobalMATLAB) ensureMATLABClientIsValid(ctx context.Context, logger ent
 	var sessionIDZeroValue entities.SessionID
 	if g.sessionID == sessionIDZeroValue {
 		logger.With("matlab_root", g.matlabRoot).Debug("ensureMATLABClientIsValid: starting new MATLAB session")
-		sessionID, err := g.matlabManager.StartMATLABSession(ctx, logger, entities.LocalSessionDetails{
-			MATLABRoot:        g.matlabRoot,
-			StartingDirectory: g.matlabStartingDir,
-			ShowMATLABDesktop: true,
-		})
+		sessionID, err := g.matlabManager.StartMATLABSession(ctx, logger, g.sessionDetails())
 		if err != nil {
+			if entities.ErrorCodeOf(err) == entities.ErrorCodeInternal {
+				err = entities.NewCodedError(entities.ErrorCodeMATLABStartFailed, err)
+			}
 			g.cachedStartErr = err
 			logger.WithError(err).Error("ensureMATLABClientIsValid: failed to start MATLAB session")
 			return err
@@ -161,6 +193,21 @@ func (g *GlobalMATLAB) ensureMATLABClientIsValid(ctx context.Context, logger ent
 	return nil
 }
 
+// sessionDetails describe the MATLAB session to start: the MATLAB shared by the user when set, or a new MATLAB.
+func (g *GlobalMATLAB) sessionDetails() entities.SessionDetails {
+	if sessionFile := g.config.SharedMATLABSessionFile(); sessionFile != "" {
+		return entities.SharedSessionDetails{
+			SessionFile: sessionFile,
+		}
+	}
+
+	return entities.LocalSessionDetails{
+		MATLABRoot:        g.matlabRoot,
+		StartingDirectory: g.matlabStartingDir,
+		ShowMATLABDesktop: g.config.ShowMATLABDesktop(),
+	}
+}
+
 // waitForMATLABReady tests the MATLAB connection with a simple eval to ensure it's ready
 // This gives MATLAB time to fully initialize the Embedded Connector before accepting requests
 func (g *GlobalMATLAB) waitForMATLABReady(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) error {
@@ -200,5 +247,5 @@ func (g *GlobalMATLAB) waitForMATLABReady(ctx context.Context, logger entities.L
 		logger.WithError(err).With("attempt", attempt+1).Debug("MATLAB connection test failed, will retry")
 	}
 
-	return fmt.Errorf("MATLAB connection not ready after %d attempts", maxAttempts)
+	return entities.NewCodedError(entities.ErrorCodeMATLABStartFailed, fmt.Errorf("MATLAB connection not ready after %d attempts", maxAttempts))
 }
@@ -21,6 +21,19 @@ func TestGlobalMATLAB_Client_HappyPath(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		SharedMATLABSessionFile().
+		Return("").
+		Maybe()
+
+	mockConfig.EXPECT().
+		ShowMATLABDesktop().
+		Return(true).
+		Maybe()
+
 	mockMATLABManager := &mocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
@@ -54,6 +67,7 @@ func TestGlobalMATLAB_Client_HappyPath(t *testing.T) {
 		Once()
 
 	globalMATLABSession := globalmatlab.New(
+		mockConfig,
 		mockMATLABManager,
 		mockMATLABRootSelector,
 		mockMATLABStartingDirSelector,
@@ -73,6 +87,19 @@ func TestGlobalMATLAB_Client_StartMATLABSessionError(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		SharedMATLABSessionFile().
+		Return("").
+		Maybe()
+
+	mockConfig.EXPECT().
+		ShowMATLABDesktop().
+		Return(true).
+		Maybe()
+
 	mockMATLABManager := &mocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
@@ -101,6 +128,7 @@ func TestGlobalMATLAB_Client_StartMATLABSessionError(t *testing.T) {
 		Once()
 
 	globalMATLABSession := globalmatlab.New(
+		mockConfig,
 		mockMATLABManager,
 		mockMATLABRootSelector,
 		mockMATLABStartingDirSelector,
@@ -118,6 +146,19 @@ func TestGlobalMATLAB_Client_GetMATLABSessionClientError(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		SharedMATLABSessionFile().
+		Return("").
+		Maybe()
+
+	mockConfig.EXPECT().
+		ShowMATLABDesktop().
+		Return(true).
+		Maybe()
+
 	mockMATLABManager := &mocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
@@ -150,6 +191,7 @@ func TestGlobalMATLAB_Client_GetMATLABSessionClientError(t *testing.T) {
 		Once()
 
 	globalMATLABSession := globalmatlab.New(
+		mockConfig,
 		mockMATLABManager,
 		mockMATLABRootSelector,
 		mockMATLABStartingDirSelector,
@@ -167,6 +209,19 @@ func TestGlobalMATLAB_Client_ReturnsStartMATLABCachedErrorFromInitialize(t *test
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		SharedMATLABSessionFile().
+		Return("").
+		Maybe()
+
+	mockConfig.EXPECT().
+		ShowMATLABDesktop().
+		Return(true).
+		Maybe()
+
 	mockMATLABManager := &mocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
@@ -201,6 +256,7 @@ func TestGlobalMATLAB_Client_ReturnsStartMATLABCachedErrorFromInitialize(t *test
 		Once()
 
 	globalMATLABSession := globalmatlab.New(
+		mockConfig,
 		mockMATLABManager,
 		mockMATLABRootSelector,
 		mockMATLABStartingDirSelector,
@@ -221,6 +277,19 @@ func TestGlobalMATLAB_Client_ReturnsCachedErrorOnSubsequentClientCalls(t *testin
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		SharedMATLABSessionFile().
+		Return("").
+		Maybe()
+
+	mockConfig.EXPECT().
+		ShowMATLABDesktop().
+		Return(true).
+		Maybe()
+
 	mockMATLABManager := &mocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
@@ -236,6 +305,7 @@ func TestGlobalMATLAB_Client_ReturnsCachedErrorOnSubsequentClientCalls(t *testin
 	expectedError := assert.AnError
 
 	globalMATLABSession := globalmatlab.New(
+		mockConfig,
 		mockMATLABManager,
 		mockMATLABRootSelector,
 		mockMATLABStartingDirSelector,
@@ -266,6 +336,19 @@ func TestGlobalMATLAB_Client_ConcurrentCallsWaitForCompletion(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		SharedMATLABSessionFile().
+		Return("").
+		Maybe()
+
+	mockConfig.EXPECT().
+		ShowMATLABDesktop().
+		Return(true).
+		Maybe()
+
 	mockMATLABManager := &mocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
@@ -319,6 +402,7 @@ func TestGlobalMATLAB_Client_ConcurrentCallsWaitForCompletion(t *testing.T) {
 		Once()
 
 	globalMATLABSession := globalmatlab.New(
+		mockConfig,
 		mockMATLABManager,
 		mockMATLABRootSelector,
 		mockMATLABStartingDirSelector,
@@ -360,6 +444,19 @@ func TestGlobalMATLAB_Client_WaitUntilInitializeFinish(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		SharedMATLABSessionFile().
+		Return("").
+		Maybe()
+
+	mockConfig.EXPECT().
+		ShowMATLABDesktop().
+		Return(true).
+		Maybe()
+
 	mockMATLABManager := &mocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
@@ -418,6 +515,7 @@ func TestGlobalMATLAB_Client_WaitUntilInitializeFinish(t *testing.T) {
 		Once()
 
 	globalMATLABSession := globalmatlab.New(
+		mockConfig,
 		mockMATLABManager,
 		mockMATLABRootSelector,
 		mockMATLABStartingDirSelector,
@@ -20,6 +20,19 @@ func TestGlobalMATLAB_Initialize_HappyPath(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		SharedMATLABSessionFile().
+		Return("").
+		Maybe()
+
+	mockConfig.EXPECT().
+		ShowMATLABDesktop().
+		Return(true).
+		Maybe()
+
 	mockMATLABManager := &mocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
@@ -57,6 +70,49 @@ func TestGlobalMATLAB_Initialize_HappyPath(t *testing.T) {
 		Once()
 
 	globalMATLABSession := globalmatlab.New(
+		mockConfig,
+		mockMATLABManager,
+		mockMATLABRootSelector,
+		mockMATLABStartingDirSelector,
+	)
+
+	// Act
+	err := globalMATLABSession.Initialize(ctx, mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestGlobalMATLAB_Initialize_SharedMATLABSession(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
+	defer mockMATLABStartingDirSelector.AssertExpectations(t)
+
+	ctx := t.Context()
+	sessionFile := "/home/user/.matlab/matlab-mcp-session.json"
+
+	mockConfig.EXPECT().
+		SharedMATLABSessionFile().
+		Return(sessionFile)
+
+	mockMATLABManager.EXPECT().
+		StartMATLABSession(ctx, mockLogger.AsMockArg(), entities.SharedSessionDetails{SessionFile: sessionFile}).
+		Return(entities.SessionID(123), nil).
+		Once()
+
+	globalMATLABSession := globalmatlab.New(
+		mockConfig,
 		mockMATLABManager,
 		mockMATLABRootSelector,
 		mockMATLABStartingDirSelector,
@@ -73,6 +129,19 @@ func TestGlobalMATLAB_Initialize_SelectFirstMATLABVersionOnPathError(t *testing.
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		SharedMATLABSessionFile().
+		Return("").
+		Maybe()
+
+	mockConfig.EXPECT().
+		ShowMATLABDesktop().
+		Return(true).
+		Maybe()
+
 	mockMATLABManager := &mocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
@@ -91,6 +160,7 @@ func TestGlobalMATLAB_Initialize_SelectFirstMATLABVersionOnPathError(t *testing.
 		Once()
 
 	globalMATLABSession := globalmatlab.New(
+		mockConfig,
 		mockMATLABManager,
 		mockMATLABRootSelector,
 		mockMATLABStartingDirSelector,
@@ -107,6 +177,19 @@ func TestGlobalMATLAB_Initialize_SelectMatlabStartingDirError(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		SharedMATLABSessionFile().
+		Return("").
+		Maybe()
+
+	mockConfig.EXPECT().
+		ShowMATLABDesktop().
+		Return(true).
+		Maybe()
+
 	mockMATLABManager := &mocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
@@ -143,6 +226,7 @@ func TestGlobalMATLAB_Initialize_SelectMatlabStartingDirError(t *testing.T) {
 		Once()
 
 	globalMATLABSession := globalmatlab.New(
+		mockConfig,
 		mockMATLABManager,
 		mockMATLABRootSelector,
 		mockMATLABStartingDirSelector,
@@ -159,6 +243,19 @@ func TestGlobalMATLAB_Initialize_StartMATLABSessionError(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		SharedMATLABSessionFile().
+		Return("").
+		Maybe()
+
+	mockConfig.EXPECT().
+		ShowMATLABDesktop().
+		Return(true).
+		Maybe()
+
 	mockMATLABManager := &mocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
@@ -197,6 +294,7 @@ func TestGlobalMATLAB_Initialize_StartMATLABSessionError(t *testing.T) {
 		Once()
 
 	globalMATLABSession := globalmatlab.New(
+		mockConfig,
 		mockMATLABManager,
 		mockMATLABRootSelector,
 		mockMATLABStartingDirSelector,
@@ -213,6 +311,19 @@ func TestGlobalMATLAB_Initialize_ReturnsCachedErrorOnSubsequentInitializeCalls(t
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		SharedMATLABSessionFile().
+		Return("").
+		Maybe()
+
+	mockConfig.EXPECT().
+		ShowMATLABDesktop().
+		Return(true).
+		Maybe()
+
 	mockMATLABManager := &mocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
@@ -261,6 +372,7 @@ func TestGlobalMATLAB_Initialize_ReturnsCachedErrorOnSubsequentInitializeCalls(t
 		Once()
 
 	globalMATLABSession := globalmatlab.New(
+		mockConfig,
 		mockMATLABManager,
 		mockMATLABRootSelector,
 		mockMATLABStartingDirSelector,
@@ -279,6 +391,19 @@ func TestGlobalMATLAB_Initialize_ConcurrentCallsWaitForCompletion(t *testing.T)
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		SharedMATLABSessionFile().
+		Return("").
+		Maybe()
+
+	mockConfig.EXPECT().
+		ShowMATLABDesktop().
+		Return(true).
+		Maybe()
+
 	mockMATLABManager := &mocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
@@ -335,6 +460,7 @@ func TestGlobalMATLAB_Initialize_ConcurrentCallsWaitForCompletion(t *testing.T)
 		Once()
 
 	globalMATLABSession := globalmatlab.New(
+		mockConfig,
 		mockMATLABManager,
 		mockMATLABRootSelector,
 		mockMATLABStartingDirSelector,
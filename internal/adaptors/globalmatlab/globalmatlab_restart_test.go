@@ -0,0 +1,161 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package globalmatlab_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/globalmatlab"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/globalmatlab"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGlobalMATLAB_Restart_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		SharedMATLABSessionFile().
+		Return("").
+		Maybe()
+
+	mockConfig.EXPECT().
+		ShowMATLABDesktop().
+		Return(true).
+		Maybe()
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
+	defer mockMATLABStartingDirSelector.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedLocalSessionDetails := entities.LocalSessionDetails{
+		MATLABRoot:        "/mock/matlab/path",
+		StartingDirectory: "/home/myuser",
+		ShowMATLABDesktop: true,
+	}
+
+	firstSessionID := entities.SessionID(123)
+	secondSessionID := entities.SessionID(456)
+
+	mockMATLABRootSelector.EXPECT().
+		SelectFirstMATLABVersionOnPath(ctx, mockLogger.AsMockArg()).
+		Return(expectedLocalSessionDetails.MATLABRoot, nil).
+		Once()
+
+	mockMATLABStartingDirSelector.EXPECT().
+		SelectMatlabStartingDir().
+		Return(expectedLocalSessionDetails.StartingDirectory, nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		StartMATLABSession(ctx, mockLogger.AsMockArg(), expectedLocalSessionDetails).
+		Return(firstSessionID, nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		StopMATLABSession(ctx, mockLogger.AsMockArg(), firstSessionID).
+		Return(nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		StartMATLABSession(ctx, mockLogger.AsMockArg(), expectedLocalSessionDetails).
+		Return(secondSessionID, nil).
+		Once()
+
+	globalMATLABSession := globalmatlab.New(
+		mockConfig,
+		mockMATLABManager,
+		mockMATLABRootSelector,
+		mockMATLABStartingDirSelector,
+	)
+	require.NoError(t, globalMATLABSession.Initialize(ctx, mockLogger))
+
+	// Act
+	err := globalMATLABSession.Restart(ctx, mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestGlobalMATLAB_Restart_StopErrorStillStartsNewSession(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		SharedMATLABSessionFile().
+		Return("").
+		Maybe()
+
+	mockConfig.EXPECT().
+		ShowMATLABDesktop().
+		Return(true).
+		Maybe()
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
+	defer mockMATLABStartingDirSelector.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedLocalSessionDetails := entities.LocalSessionDetails{
+		MATLABRoot:        "/mock/matlab/path",
+		StartingDirectory: "/home/myuser",
+		ShowMATLABDesktop: true,
+	}
+
+	firstSessionID := entities.SessionID(123)
+
+	mockMATLABRootSelector.EXPECT().
+		SelectFirstMATLABVersionOnPath(ctx, mockLogger.AsMockArg()).
+		Return(expectedLocalSessionDetails.MATLABRoot, nil).
+		Once()
+
+	mockMATLABStartingDirSelector.EXPECT().
+		SelectMatlabStartingDir().
+		Return(expectedLocalSessionDetails.StartingDirectory, nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		StartMATLABSession(ctx, mockLogger.AsMockArg(), expectedLocalSessionDetails).
+		Return(firstSessionID, nil).
+		Twice()
+
+	mockMATLABManager.EXPECT().
+		StopMATLABSession(ctx, mockLogger.AsMockArg(), firstSessionID).
+		Return(errors.New("session already gone")).
+		Once()
+
+	globalMATLABSession := globalmatlab.New(
+		mockConfig,
+		mockMATLABManager,
+		mockMATLABRootSelector,
+		mockMATLABStartingDirSelector,
+	)
+	require.NoError(t, globalMATLABSession.Initialize(ctx, mockLogger))
+
+	// Act
+	err := globalMATLABSession.Restart(ctx, mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	require.NotEmpty(t, mockLogger.WarnLogs())
+}
@@ -11,6 +11,9 @@ import (
 )
 
 func TestNew_HappyPath(t *testing.T) {
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockMATLABManager := &mocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
@@ -22,6 +25,7 @@ func TestNew_HappyPath(t *testing.T) {
 
 	// Act
 	globalMATLABSession := globalmatlab.New(
+		mockConfig,
 		mockMATLABManager,
 		mockMATLABRootSelector,
 		mockMATLABStartingDirSelector,
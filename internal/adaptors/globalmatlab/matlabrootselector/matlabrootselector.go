@@ -11,6 +11,7 @@ import (
 
 type Config interface {
 	PreferredLocalMATLABRoot() string
+	MATLABRelease() string
 }
 
 type MATLABManager interface {
@@ -39,8 +40,19 @@ func (m *MATLABRootSelector) SelectFirstMATLABVersionOnPath(ctx context.Context,
 
 	environments := m.matlabManager.ListEnvironments(ctx, logger)
 	if len(environments) == 0 {
-		return "", fmt.Errorf("no valid MATLAB environments found")
+		return "", entities.NewCodedError(entities.ErrorCodeMATLABNotFound, fmt.Errorf("no valid MATLAB environments found"))
 	}
 
-	return environments[0].MATLABRoot, nil
+	release := m.config.MATLABRelease()
+	if release == "" {
+		return environments[0].MATLABRoot, nil
+	}
+
+	for _, environment := range environments {
+		if string(environment.Version) == release {
+			return environment.MATLABRoot, nil
+		}
+	}
+
+	return "", entities.NewCodedError(entities.ErrorCodeMATLABNotFound, fmt.Errorf("no MATLAB %s installation found", release))
 }
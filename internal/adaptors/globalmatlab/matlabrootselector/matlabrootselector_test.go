@@ -97,6 +97,11 @@ func TestMATLABRootSelector_SelectFirstMATLABVersionOnPath_HappyPath(t *testing.
 				Return("").
 				Once()
 
+			mockConfig.EXPECT().
+				MATLABRelease().
+				Return("").
+				Once()
+
 			mockMATLABManager.EXPECT().
 				ListEnvironments(ctx, mockLogger).
 				Return(tc.environments).
@@ -192,3 +197,82 @@ func TestMATLABRootSelector_SelectFirstMATLABVersionOnPath_ListEnvironmentsEmpty
 		})
 	}
 }
+
+func TestMATLABRootSelector_SelectFirstMATLABVersionOnPath_MATLABRelease_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		PreferredLocalMATLABRoot().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABRelease().
+		Return("R2023b").
+		Once()
+
+	mockMATLABManager.EXPECT().
+		ListEnvironments(ctx, mockLogger).
+		Return([]entities.EnvironmentInfo{
+			{MATLABRoot: "/usr/local/MATLAB/R2025a", Version: "R2025a"},
+			{MATLABRoot: "/usr/local/MATLAB/R2023b", Version: "R2023b"},
+		}).
+		Once()
+
+	selector := matlabrootselector.New(mockConfig, mockMATLABManager)
+
+	// Act
+	result, err := selector.SelectFirstMATLABVersionOnPath(ctx, mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "/usr/local/MATLAB/R2023b", result)
+}
+
+func TestMATLABRootSelector_SelectFirstMATLABVersionOnPath_MATLABReleaseNotFound(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		PreferredLocalMATLABRoot().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABRelease().
+		Return("R2022a").
+		Once()
+
+	mockMATLABManager.EXPECT().
+		ListEnvironments(ctx, mockLogger).
+		Return([]entities.EnvironmentInfo{
+			{MATLABRoot: "/usr/local/MATLAB/R2025a", Version: "R2025a"},
+		}).
+		Once()
+
+	selector := matlabrootselector.New(mockConfig, mockMATLABManager)
+
+	// Act
+	result, err := selector.SelectFirstMATLABVersionOnPath(ctx, mockLogger)
+
+	// Assert
+	require.ErrorContains(t, err, "no MATLAB R2022a installation found")
+	assert.Empty(t, result)
+}
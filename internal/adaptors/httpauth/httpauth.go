@@ -0,0 +1,191 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package httpauth
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+)
+
+// minTokenLength is the number of characters below which a token is too easy to guess.
+const minTokenLength = 16
+
+var (
+	ErrMissingToken = errors.New("the request has no bearer token")
+	ErrInvalidToken = errors.New("the bearer token of the request is not a valid API key")
+)
+
+type Config interface {
+	AuthTokensFile() string
+}
+
+type OSLayer interface {
+	Stat(name string) (osfacade.FileInfo, error)
+	ReadFile(name string) ([]byte, error)
+}
+
+type apiKey struct {
+	client string
+	digest [sha256.Size]byte
+}
+
+// Authenticator checks the bearer token of the requests to the HTTP and WebSocket transports against the API keys of
+// a tokens file. The file has one key per line, as NAME:TOKEN, or as a bare TOKEN named after its line. Blank lines
+// and lines starting with # are ignored.
+//
+// The file is read again when its size or modification time changes, so that keys are rotated without restarting
+// the server: add the new key, move the client to it, then remove the old key. While the file cannot be read or is
+// invalid, every request is rejected.
+type Authenticator struct {
+	tokensFile string
+	osLayer    OSLayer
+
+	lock    sync.Mutex
+	loaded  bool
+	modTime time.Time
+	size    int64
+	keys    []apiKey
+}
+
+func New(
+	config Config,
+	osLayer OSLayer,
+) *Authenticator {
+	return &Authenticator{
+		tokensFile: config.AuthTokensFile(),
+		osLayer:    osLayer,
+	}
+}
+
+// Enabled is true when a tokens file is set, and requests must send one of its tokens.
+func (a *Authenticator) Enabled() bool {
+	return a.tokensFile != ""
+}
+
+// Load reads the tokens file, so that an invalid file is reported when the server starts rather than on the first
+// request.
+func (a *Authenticator) Load() error {
+	_, err := a.currentKeys()
+	return err
+}
+
+// Authenticate returns the name of the API key whose token is the bearer token of the request. The token is compared
+// with every key in constant time, so that the time taken reveals neither the keys nor how much of them matched.
+func (a *Authenticator) Authenticate(r *http.Request) (string, error) {
+	token, found := bearerToken(r)
+	if !found {
+		return "", ErrMissingToken
+	}
+
+	keys, err := a.currentKeys()
+	if err != nil {
+		return "", err
+	}
+
+	digest := sha256.Sum256([]byte(token))
+	client := ""
+	for _, key := range keys {
+		if subtle.ConstantTimeCompare(digest[:], key.digest[:]) == 1 && client == "" {
+			client = key.client
+		}
+	}
+	if client == "" {
+		return "", ErrInvalidToken
+	}
+	return client, nil
+}
+
+// currentKeys returns the keys of the tokens file, reading it again when it changed since it was last read.
+func (a *Authenticator) currentKeys() ([]apiKey, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	info, err := a.osLayer.Stat(a.tokensFile)
+	if err != nil {
+		a.loaded = false
+		return nil, fmt.Errorf("failed to read tokens file %s: %w", a.tokensFile, err)
+	}
+	if a.loaded && info.ModTime().Equal(a.modTime) && info.Size() == a.size {
+		return a.keys, nil
+	}
+
+	data, err := a.osLayer.ReadFile(a.tokensFile)
+	if err != nil {
+		a.loaded = false
+		return nil, fmt.Errorf("failed to read tokens file %s: %w", a.tokensFile, err)
+	}
+
+	keys, err := parseKeys(data)
+	if err != nil {
+		a.loaded = false
+		return nil, fmt.Errorf("invalid tokens file %s: %w", a.tokensFile, err)
+	}
+
+	a.loaded = true
+	a.modTime = info.ModTime()
+	a.size = info.Size()
+	a.keys = keys
+	return keys, nil
+}
+
+func parseKeys(data []byte) ([]apiKey, error) {
+	var keys []apiKey
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		client, token, named := strings.Cut(line, ":")
+		if !named {
+			client, token = "line-"+strconv.Itoa(lineNumber), line
+		}
+		client = strings.TrimSpace(client)
+		token = strings.TrimSpace(token)
+
+		if client == "" {
+			return nil, fmt.Errorf("the key of line %d has no name", lineNumber)
+		}
+		if len(token) < minTokenLength {
+			return nil, fmt.Errorf("the token of key %s is shorter than %d characters", client, minTokenLength)
+		}
+
+		keys = append(keys, apiKey{
+			client: client,
+			digest: sha256.Sum256([]byte(token)),
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.New("the file has no keys")
+	}
+	return keys, nil
+}
+
+// bearerToken returns the token of the Authorization header of the request, whose scheme is case-insensitive.
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, found := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
@@ -0,0 +1,40 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package localizer
+
+import (
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/i18n"
+)
+
+type Config interface {
+	Locale() entities.Locale
+}
+
+type OSLayer interface {
+	Getenv(key string) string
+}
+
+// Localizer decides the language of the messages shown to users: the locale set with --locale,
+// or else the language of the system locale.
+type Localizer struct {
+	locale entities.Locale
+}
+
+func New(
+	config Config,
+	osLayer OSLayer,
+) *Localizer {
+	locale := config.Locale()
+	if locale == "" {
+		locale = i18n.DetectLocale(osLayer.Getenv)
+	}
+
+	return &Localizer{
+		locale: locale,
+	}
+}
+
+func (l *Localizer) Locale() entities.Locale {
+	return l.locale
+}
@@ -0,0 +1,67 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package localizer_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/localizer"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	localizermocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/localizer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew_ConfiguredLocale(t *testing.T) {
+	// Arrange
+	mockConfig := &localizermocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &localizermocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		Locale().
+		Return(entities.LocaleGerman).
+		Once()
+
+	// Act
+	l := localizer.New(mockConfig, mockOSLayer)
+
+	// Assert
+	assert.Equal(t, entities.LocaleGerman, l.Locale())
+}
+
+func TestNew_SystemLocale(t *testing.T) {
+	// Arrange
+	mockConfig := &localizermocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &localizermocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		Locale().
+		Return("").
+		Once()
+
+	mockOSLayer.EXPECT().
+		Getenv("LC_ALL").
+		Return("").
+		Once()
+
+	mockOSLayer.EXPECT().
+		Getenv("LC_MESSAGES").
+		Return("").
+		Once()
+
+	mockOSLayer.EXPECT().
+		Getenv("LANG").
+		Return("ja_JP.eucJP").
+		Once()
+
+	// Act
+	l := localizer.New(mockConfig, mockOSLayer)
+
+	// Assert
+	assert.Equal(t, entities.LocaleJapanese, l.Locale())
+}
@@ -0,0 +1,54 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package localuser
+
+import (
+	"os/user"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+type OSLayer interface {
+	CurrentUser() (*user.User, error)
+	Getenv(key string) string
+}
+
+type LoggerFactory interface {
+	GetGlobalLogger() entities.Logger
+}
+
+// LocalUser is the user the server runs for. Over stdio, the MCP client starts the server as this user,
+// so it is the human on whose behalf the AI application acts.
+type LocalUser struct {
+	name string
+}
+
+func New(
+	osLayer OSLayer,
+	loggerFactory LoggerFactory,
+) *LocalUser {
+	return &LocalUser{
+		name: currentUserName(osLayer, loggerFactory.GetGlobalLogger()),
+	}
+}
+
+// User is the login name of the user, or empty when it is unknown.
+func (u *LocalUser) User() string {
+	return u.name
+}
+
+func currentUserName(osLayer OSLayer, logger entities.Logger) string {
+	currentUser, err := osLayer.CurrentUser()
+	if err == nil && currentUser.Username != "" {
+		return currentUser.Username
+	}
+
+	for _, variable := range []string{"USER", "USERNAME"} {
+		if name := osLayer.Getenv(variable); name != "" {
+			return name
+		}
+	}
+
+	logger.WithError(err).Warn("Failed to identify the user running the server")
+	return ""
+}
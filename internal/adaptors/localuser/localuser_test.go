@@ -0,0 +1,111 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package localuser_test
+
+import (
+	"os/user"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/localuser"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/localuser"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalUser_User_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	mockOSLayer.EXPECT().
+		CurrentUser().
+		Return(&user.User{Username: "alice"}, nil).
+		Once()
+
+	// Act
+	name := localuser.New(mockOSLayer, mockLoggerFactory).User()
+
+	// Assert
+	assert.Equal(t, "alice", name)
+}
+
+func TestLocalUser_User_FallsBackToEnvironment(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	mockOSLayer.EXPECT().
+		CurrentUser().
+		Return(nil, assert.AnError).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Getenv("USER").
+		Return("").
+		Once()
+
+	mockOSLayer.EXPECT().
+		Getenv("USERNAME").
+		Return("bob").
+		Once()
+
+	// Act
+	name := localuser.New(mockOSLayer, mockLoggerFactory).User()
+
+	// Assert
+	assert.Equal(t, "bob", name)
+}
+
+func TestLocalUser_User_Unknown(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	logger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(logger).
+		Once()
+
+	mockOSLayer.EXPECT().
+		CurrentUser().
+		Return(nil, assert.AnError).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Getenv("USER").
+		Return("").
+		Once()
+
+	mockOSLayer.EXPECT().
+		Getenv("USERNAME").
+		Return("").
+		Once()
+
+	// Act
+	name := localuser.New(mockOSLayer, mockLoggerFactory).User()
+
+	// Assert
+	assert.Empty(t, name)
+	assert.NotEmpty(t, logger.WarnLogs())
+}
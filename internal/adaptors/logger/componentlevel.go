@@ -0,0 +1,99 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+)
+
+// componentKey is the key of the attribute naming the component of the server a logger belongs to.
+const componentKey = "component"
+
+// logLevels are the default log level of the entries of a handler, and the levels of the components logging at
+// another level. They are shared by the loggers derived from the handler, so that a change applies to all of them.
+type logLevels struct {
+	levels atomic.Pointer[levelsByComponent]
+}
+
+type levelsByComponent struct {
+	level           slog.Level
+	componentLevels map[string]slog.Level
+}
+
+func newLogLevels(level slog.Level, componentLevels map[string]slog.Level) *logLevels {
+	l := new(logLevels)
+	l.set(level, componentLevels)
+	return l
+}
+
+func (l *logLevels) set(level slog.Level, componentLevels map[string]slog.Level) {
+	l.levels.Store(&levelsByComponent{
+		level:           level,
+		componentLevels: componentLevels,
+	})
+}
+
+// Level is the most detailed of the default level and the levels of the components, for the handler they filter.
+func (l *logLevels) Level() slog.Level {
+	levels := l.levels.Load()
+	return mostDetailedLevel(levels.level, levels.componentLevels)
+}
+
+// of is the log level of component, or the default level when the component has none, or when component is empty.
+func (l *logLevels) of(component string) slog.Level {
+	levels := l.levels.Load()
+	if componentLevel, ok := levels.componentLevels[component]; ok && component != "" {
+		return componentLevel
+	}
+	return levels.level
+}
+
+// componentLevelHandler filters the log entries below the log level of the component of the logger, as set by the
+// "component" attribute, or below the default level for the loggers of other components. The level of the wrapped
+// handler must be the most detailed of those levels.
+type componentLevelHandler struct {
+	handler   slog.Handler
+	levels    *logLevels
+	component string
+}
+
+func newComponentLevelHandler(handler slog.Handler, levels *logLevels, component string) *componentLevelHandler {
+	return &componentLevelHandler{
+		handler:   handler,
+		levels:    levels,
+		component: component,
+	}
+}
+
+func (h *componentLevelHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= h.levels.of(h.component) && h.handler.Enabled(ctx, level)
+}
+
+func (h *componentLevelHandler) Handle(ctx context.Context, record slog.Record) error {
+	return h.handler.Handle(ctx, record)
+}
+
+func (h *componentLevelHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	component := h.component
+	for _, attr := range attrs {
+		if attr.Key == componentKey {
+			component = attr.Value.String()
+		}
+	}
+
+	return newComponentLevelHandler(h.handler.WithAttrs(attrs), h.levels, component)
+}
+
+func (h *componentLevelHandler) WithGroup(name string) slog.Handler {
+	return newComponentLevelHandler(h.handler.WithGroup(name), h.levels, h.component)
+}
+
+// mostDetailedLevel is the most detailed of level and the levels of the components.
+func mostDetailedLevel(level slog.Level, componentLevels map[string]slog.Level) slog.Level {
+	for _, componentLevel := range componentLevels {
+		level = min(level, componentLevel)
+	}
+	return level
+}
@@ -0,0 +1,23 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package logger
+
+import (
+	"log/slog"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// DiscardFactory creates loggers which discard every message, for the commands run by other programs, such as the
+// shell completions, which must neither write to stderr nor create log files.
+type DiscardFactory struct{}
+
+func NewDiscardFactory() *DiscardFactory {
+	return &DiscardFactory{}
+}
+
+func (f *DiscardFactory) GetGlobalLogger() entities.Logger {
+	return &slogLogger{
+		logger: slog.New(slog.DiscardHandler),
+	}
+}
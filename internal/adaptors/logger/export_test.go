@@ -0,0 +1,28 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package logger
+
+import (
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+)
+
+// RotatingFile exposes the rotating log file to the tests, with Close to release the file it writes to.
+type RotatingFile struct {
+	*rotatingFile
+}
+
+func NewRotatingFile(osLayer OSLayer, file osfacade.File, path string, maxBytes int64, maxAge time.Duration, maxFiles int) RotatingFile {
+	return RotatingFile{newRotatingFile(osLayer, file, path, maxBytes, maxAge, maxFiles)}
+}
+
+func (r RotatingFile) Close() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.file == nil {
+		return nil
+	}
+	return r.file.Close()
+}
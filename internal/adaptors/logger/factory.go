@@ -4,11 +4,13 @@ package logger
 
 import (
 	"fmt"
-	"io"
+	"io/fs"
+	"iter"
 	"log/slog"
 	"os"
 	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
@@ -24,6 +26,13 @@ const (
 
 type Config interface {
 	LogLevel() entities.LogLevel
+	LogFile() string
+	LogComponentLevels() map[string]entities.LogLevel
+	LogMaxSizeMB() int
+	LogMaxAge() time.Duration
+	LogMaxFiles() int
+	Quiet() bool
+	Verbose() bool
 }
 
 type Directory interface {
@@ -32,13 +41,28 @@ type Directory interface {
 
 type OSLayer interface {
 	Create(name string) (osfacade.File, error)
+	OpenFile(name string, flag int, perm os.FileMode) (osfacade.File, error)
+	Rename(oldPath string, newPath string) error
+	RemoveAll(path string) error
+	DirFS(dir string) fs.FS
 }
 
 type Factory struct {
-	globalLoggerOnce     *sync.Once
-	globalLogger         *slogLogger
-	globalLoggerLogLevel slog.Level
-	globalLoggerFile     entities.Writer
+	globalLoggerOnce *sync.Once
+	globalLogger     *slogLogger
+	globalLoggerFile entities.Writer
+
+	// logLevels are the level of the entries of the log file, and the levels of the components logging at another
+	// level than the global one. They can be changed while the server runs.
+	logLevels *logLevels
+
+	// stderrLogLevels are the levels of the entries of the global logger written to stderr, unless quiet. They are
+	// the levels of the log file, except with --verbose.
+	stderrLogLevels *logLevels
+	quiet           bool
+
+	// sessionForwarding forwards the entries of the global logger to the MCP sessions, once the server is created.
+	sessionForwarding *sessionForwardingHandler
 
 	watchdogLoggerOnce     *sync.Once
 	watchdogLogger         *slogLogger
@@ -58,7 +82,12 @@ func NewFactory(
 
 	baseDir := directory.BaseDir()
 
-	logFile, err := osLayer.Create(filepath.Join(baseDir, logFileName))
+	logFilePath := config.LogFile()
+	if logFilePath == "" {
+		logFilePath = LogFilePath(baseDir)
+	}
+
+	logFile, err := osLayer.Create(logFilePath)
 	if err != nil {
 		return nil, err
 	}
@@ -68,10 +97,30 @@ func NewFactory(
 		return nil, err
 	}
 
+	componentLevels, err := parseLogComponentLevels(config.LogComponentLevels())
+	if err != nil {
+		return nil, err
+	}
+
+	rotatingLogFile := newRotatingFile(osLayer, logFile, logFilePath, int64(config.LogMaxSizeMB())*1024*1024, config.LogMaxAge(), config.LogMaxFiles())
+
+	// With --verbose, the entries of every component are written to stderr, whatever their level.
+	logFileLevels := newLogLevels(logLevel, componentLevels)
+	stderrLogLevels := logFileLevels
+	if config.Verbose() {
+		stderrLogLevels = newLogLevels(slog.LevelDebug, nil)
+	}
+
 	return &Factory{
-		globalLoggerOnce:     new(sync.Once),
-		globalLoggerLogLevel: logLevel,
-		globalLoggerFile:     logFile,
+		globalLoggerOnce: new(sync.Once),
+		globalLoggerFile: rotatingLogFile,
+
+		logLevels: logFileLevels,
+
+		stderrLogLevels: stderrLogLevels,
+		quiet:           config.Quiet(),
+
+		sessionForwarding: newSessionForwardingHandler(),
 
 		watchdogLoggerOnce:     new(sync.Once),
 		watchdogLoggerLogLevel: logLevel,
@@ -79,6 +128,11 @@ func NewFactory(
 	}, nil
 }
 
+// LogFilePath is the path of the log file written by the server whose base folder is baseDir.
+func LogFilePath(baseDir string) string {
+	return filepath.Join(baseDir, logFileName)
+}
+
 func (f *Factory) NewMCPSessionLogger(session *mcp.ServerSession) entities.Logger {
 	// In MCP Server development, special care should be given to logging:
 	//
@@ -89,12 +143,8 @@ func (f *Factory) NewMCPSessionLogger(session *mcp.ServerSession) entities.Logge
 	// https://modelcontextprotocol.io/specification/2025-06-18/server/utilities/logging
 	sessionHandler := mcp.NewLoggingHandler(session, &mcp.LoggingHandlerOptions{})
 
-	handler := slog.NewJSONHandler(f.globalLoggerFile, &slog.HandlerOptions{
-		Level: f.globalLoggerLogLevel,
-	})
-
 	return &slogLogger{
-		logger: slog.New(NewMultiHandler(sessionHandler, handler)),
+		logger: slog.New(NewMultiHandler(sessionHandler, newJSONHandler(f.globalLoggerFile, f.logLevels))),
 	}
 }
 
@@ -103,19 +153,48 @@ func (f *Factory) GetGlobalLogger() entities.Logger {
 	// In those cases, we must log to stderr, to not affect the stdio transport:
 	//
 	// https://modelcontextprotocol.io/docs/develop/build-server#best-practices
+	//
+	// With --quiet, nothing is written to stderr, and with --verbose, the entries of every level are.
 	f.globalLoggerOnce.Do(func() {
-		multiWriter := io.MultiWriter(os.Stderr, f.globalLoggerFile)
-
-		handler := slog.NewJSONHandler(multiWriter, &slog.HandlerOptions{
-			Level: f.globalLoggerLogLevel,
-		})
+		handlers := []Handler{
+			newJSONHandler(f.globalLoggerFile, f.logLevels),
+		}
+		if !f.quiet {
+			handlers = append(handlers, newJSONHandler(os.Stderr, f.stderrLogLevels))
+		}
+		handlers = append(handlers, f.sessionForwarding)
 		f.globalLogger = &slogLogger{
-			logger: slog.New(handler),
+			logger: slog.New(NewMultiHandler(handlers...)),
 		}
 	})
 	return f.globalLogger
 }
 
+// SetLogLevels changes the log level of the loggers created by the factory, and the levels of the components logging
+// at another level, such as when the configuration is reloaded. The level of the entries written to stderr with
+// --verbose, and of the watchdog logger, do not change.
+func (f *Factory) SetLogLevels(logLevel entities.LogLevel, componentLogLevels map[string]entities.LogLevel) error {
+	level, err := parseLogLevel(logLevel)
+	if err != nil {
+		return err
+	}
+
+	componentLevels, err := parseLogComponentLevels(componentLogLevels)
+	if err != nil {
+		return err
+	}
+
+	f.logLevels.set(level, componentLevels)
+	return nil
+}
+
+// ForwardToSessions forwards the entries of the global logger to the MCP sessions listed by sessions, such as the
+// sessions of the MCP server, at the log level each client set. The global logger writes them before the MCP server
+// is created.
+func (f *Factory) ForwardToSessions(sessions func() iter.Seq[*mcp.ServerSession]) {
+	f.sessionForwarding.forwardTo(sessions)
+}
+
 func (f *Factory) GetWatchdogLogger() entities.Logger {
 	f.watchdogLoggerOnce.Do(func() {
 		handler := slog.NewJSONHandler(f.watchdogLoggerFile, &slog.HandlerOptions{
@@ -128,6 +207,26 @@ func (f *Factory) GetWatchdogLogger() entities.Logger {
 	return f.watchdogLogger
 }
 
+// newJSONHandler writes the entries at the log level or above to writer, as JSON, or at the level of their component.
+func newJSONHandler(writer entities.Writer, levels *logLevels) Handler {
+	handler := slog.NewJSONHandler(writer, &slog.HandlerOptions{
+		Level: levels,
+	})
+	return newComponentLevelHandler(handler, levels, "")
+}
+
+func parseLogComponentLevels(componentLogLevels map[string]entities.LogLevel) (map[string]slog.Level, error) {
+	componentLevels := make(map[string]slog.Level)
+	for component, componentLogLevel := range componentLogLevels {
+		level, err := parseLogLevel(componentLogLevel)
+		if err != nil {
+			return nil, err
+		}
+		componentLevels[component] = level
+	}
+	return componentLevels, nil
+}
+
 func parseLogLevel(logLevel entities.LogLevel) (slog.Level, error) {
 	switch logLevel {
 	case entities.LogLevelDebug:
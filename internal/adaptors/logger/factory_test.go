@@ -3,8 +3,10 @@
 package logger_test
 
 import (
+	"context"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/logger"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
@@ -12,6 +14,7 @@ import (
 	osfacademocks "github.com/matlab/matlab-mcp-core-server/mocks/facades/osfacade"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -44,6 +47,11 @@ func TestNewFactory_HappyPath(t *testing.T) {
 				Return(expectedBaseDir).
 				Once()
 
+			mockConfig.EXPECT().
+				LogFile().
+				Return("").
+				Once()
+
 			mockLogFile := &osfacademocks.MockFile{}
 			mockOSLayer.EXPECT().
 				Create(filepath.Join(expectedBaseDir, "server.log")).
@@ -56,6 +64,36 @@ func TestNewFactory_HappyPath(t *testing.T) {
 				Return(mockWatchdogLogFile, nil).
 				Once()
 
+			mockConfig.EXPECT().
+				LogComponentLevels().
+				Return(map[string]entities.LogLevel{}).
+				Once()
+
+			mockConfig.EXPECT().
+				LogMaxSizeMB().
+				Return(100).
+				Once()
+
+			mockConfig.EXPECT().
+				LogMaxAge().
+				Return(time.Duration(0)).
+				Once()
+
+			mockConfig.EXPECT().
+				LogMaxFiles().
+				Return(5).
+				Once()
+
+			mockConfig.EXPECT().
+				Verbose().
+				Return(false).
+				Once()
+
+			mockConfig.EXPECT().
+				Quiet().
+				Return(false).
+				Once()
+
 			// Act
 			factory, err := logger.NewFactory(mockConfig, mockDirectory, mockOSLayer)
 
@@ -66,6 +104,82 @@ func TestNewFactory_HappyPath(t *testing.T) {
 	}
 }
 
+func TestNewFactory_CustomLogFile(t *testing.T) {
+	// Arrange
+	mockConfig := &loggermocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockDirectory := &loggermocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockOSLayer := &loggermocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		LogLevel().
+		Return("info").
+		Once()
+
+	expectedBaseDir := "/some/directory"
+	mockDirectory.EXPECT().
+		BaseDir().
+		Return(expectedBaseDir).
+		Once()
+
+	expectedLogFile := "/home/user/logs/matlab-mcp.log"
+	mockConfig.EXPECT().
+		LogFile().
+		Return(expectedLogFile).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Create(expectedLogFile).
+		Return(&osfacademocks.MockFile{}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Create(filepath.Join(expectedBaseDir, "watchdog.log")).
+		Return(&osfacademocks.MockFile{}, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		LogComponentLevels().
+		Return(map[string]entities.LogLevel{}).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxSizeMB().
+		Return(100).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxAge().
+		Return(time.Duration(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxFiles().
+		Return(5).
+		Once()
+
+	mockConfig.EXPECT().
+		Verbose().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		Quiet().
+		Return(false).
+		Once()
+
+	// Act
+	factory, err := logger.NewFactory(mockConfig, mockDirectory, mockOSLayer)
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotNil(t, factory)
+}
+
 func TestNewFactory_LogFileCreateError(t *testing.T) {
 	// Arrange
 	mockConfig := &loggermocks.MockConfig{}
@@ -88,6 +202,11 @@ func TestNewFactory_LogFileCreateError(t *testing.T) {
 		Return(expectedBaseDir).
 		Once()
 
+	mockConfig.EXPECT().
+		LogFile().
+		Return("").
+		Once()
+
 	expectedError := assert.AnError
 	mockOSLayer.EXPECT().
 		Create(filepath.Join(expectedBaseDir, "server.log")).
@@ -124,6 +243,11 @@ func TestNewFactory_WatchdogLogFileCreateError(t *testing.T) {
 		Return(expectedBaseDir).
 		Once()
 
+	mockConfig.EXPECT().
+		LogFile().
+		Return("").
+		Once()
+
 	mockLogFile := &osfacademocks.MockFile{}
 	mockOSLayer.EXPECT().
 		Create(filepath.Join(expectedBaseDir, "server.log")).
@@ -191,6 +315,11 @@ func TestFactory_NewMCPSessionLogger_HappyPath(t *testing.T) {
 		Return(expectedBaseDir).
 		Once()
 
+	mockConfig.EXPECT().
+		LogFile().
+		Return("").
+		Once()
+
 	mockLogFile := &osfacademocks.MockFile{}
 	mockOSLayer.EXPECT().
 		Create(filepath.Join(expectedBaseDir, "server.log")).
@@ -203,6 +332,36 @@ func TestFactory_NewMCPSessionLogger_HappyPath(t *testing.T) {
 		Return(mockWatchdogLogFile, nil).
 		Once()
 
+	mockConfig.EXPECT().
+		LogComponentLevels().
+		Return(map[string]entities.LogLevel{}).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxSizeMB().
+		Return(100).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxAge().
+		Return(time.Duration(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxFiles().
+		Return(5).
+		Once()
+
+	mockConfig.EXPECT().
+		Verbose().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		Quiet().
+		Return(false).
+		Once()
+
 	factory, err := logger.NewFactory(mockConfig, mockDirectory, mockOSLayer)
 	require.NoError(t, err, "Factory creation should not fail")
 
@@ -235,6 +394,11 @@ func TestFactory_GetGlobalLogger_HappyPath(t *testing.T) {
 		Return(expectedBaseDir).
 		Once()
 
+	mockConfig.EXPECT().
+		LogFile().
+		Return("").
+		Once()
+
 	mockLogFile := &osfacademocks.MockFile{}
 	mockOSLayer.EXPECT().
 		Create(filepath.Join(expectedBaseDir, "server.log")).
@@ -247,6 +411,36 @@ func TestFactory_GetGlobalLogger_HappyPath(t *testing.T) {
 		Return(mockWatchdogLogFile, nil).
 		Once()
 
+	mockConfig.EXPECT().
+		LogComponentLevels().
+		Return(map[string]entities.LogLevel{}).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxSizeMB().
+		Return(100).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxAge().
+		Return(time.Duration(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxFiles().
+		Return(5).
+		Once()
+
+	mockConfig.EXPECT().
+		Verbose().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		Quiet().
+		Return(false).
+		Once()
+
 	factory, err := logger.NewFactory(mockConfig, mockDirectory, mockOSLayer)
 	require.NoError(t, err, "Factory creation should not fail")
 
@@ -279,6 +473,11 @@ func TestFactory_GetGlobalLogger_IsSingleton(t *testing.T) {
 		Return(expectedBaseDir).
 		Once()
 
+	mockConfig.EXPECT().
+		LogFile().
+		Return("").
+		Once()
+
 	mockLogFile := &osfacademocks.MockFile{}
 	mockOSLayer.EXPECT().
 		Create(filepath.Join(expectedBaseDir, "server.log")).
@@ -291,6 +490,36 @@ func TestFactory_GetGlobalLogger_IsSingleton(t *testing.T) {
 		Return(mockWatchdogLogFile, nil).
 		Once()
 
+	mockConfig.EXPECT().
+		LogComponentLevels().
+		Return(map[string]entities.LogLevel{}).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxSizeMB().
+		Return(100).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxAge().
+		Return(time.Duration(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxFiles().
+		Return(5).
+		Once()
+
+	mockConfig.EXPECT().
+		Verbose().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		Quiet().
+		Return(false).
+		Once()
+
 	factory, err := logger.NewFactory(mockConfig, mockDirectory, mockOSLayer)
 	require.NoError(t, err, "Factory creation should not fail")
 
@@ -326,6 +555,11 @@ func TestFactory_GetWatchdogLogger_HappyPath(t *testing.T) {
 		Return(expectedBaseDir).
 		Once()
 
+	mockConfig.EXPECT().
+		LogFile().
+		Return("").
+		Once()
+
 	mockLogFile := &osfacademocks.MockFile{}
 	mockOSLayer.EXPECT().
 		Create(filepath.Join(expectedBaseDir, "server.log")).
@@ -338,6 +572,36 @@ func TestFactory_GetWatchdogLogger_HappyPath(t *testing.T) {
 		Return(mockWatchdogLogFile, nil).
 		Once()
 
+	mockConfig.EXPECT().
+		LogComponentLevels().
+		Return(map[string]entities.LogLevel{}).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxSizeMB().
+		Return(100).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxAge().
+		Return(time.Duration(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxFiles().
+		Return(5).
+		Once()
+
+	mockConfig.EXPECT().
+		Verbose().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		Quiet().
+		Return(false).
+		Once()
+
 	factory, err := logger.NewFactory(mockConfig, mockDirectory, mockOSLayer)
 	require.NoError(t, err, "Factory creation should not fail")
 
@@ -370,6 +634,11 @@ func TestFactory_GetWatchdogLogger_IsSingleton(t *testing.T) {
 		Return(expectedBaseDir).
 		Once()
 
+	mockConfig.EXPECT().
+		LogFile().
+		Return("").
+		Once()
+
 	mockLogFile := &osfacademocks.MockFile{}
 	mockOSLayer.EXPECT().
 		Create(filepath.Join(expectedBaseDir, "server.log")).
@@ -382,6 +651,36 @@ func TestFactory_GetWatchdogLogger_IsSingleton(t *testing.T) {
 		Return(mockWatchdogLogFile, nil).
 		Once()
 
+	mockConfig.EXPECT().
+		LogComponentLevels().
+		Return(map[string]entities.LogLevel{}).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxSizeMB().
+		Return(100).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxAge().
+		Return(time.Duration(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxFiles().
+		Return(5).
+		Once()
+
+	mockConfig.EXPECT().
+		Verbose().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		Quiet().
+		Return(false).
+		Once()
+
 	factory, err := logger.NewFactory(mockConfig, mockDirectory, mockOSLayer)
 	require.NoError(t, err, "Factory creation should not fail")
 
@@ -394,3 +693,421 @@ func TestFactory_GetWatchdogLogger_IsSingleton(t *testing.T) {
 	assert.NotNil(t, logger2, "Second watchdog logger should not be nil")
 	assert.Same(t, logger1, logger2, "Watchdog logger should be a singleton")
 }
+
+func TestFactory_GetGlobalLogger_QuietWritesOnlyToLogFile(t *testing.T) {
+	// Arrange
+	mockConfig := &loggermocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockDirectory := &loggermocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockOSLayer := &loggermocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLogFile := &osfacademocks.MockFile{}
+	defer mockLogFile.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		LogLevel().
+		Return("info").
+		Once()
+
+	expectedBaseDir := "/some/directory"
+	mockDirectory.EXPECT().
+		BaseDir().
+		Return(expectedBaseDir).
+		Once()
+
+	mockConfig.EXPECT().
+		LogFile().
+		Return("").
+		Once()
+
+	mockOSLayer.EXPECT().
+		Create(filepath.Join(expectedBaseDir, "server.log")).
+		Return(mockLogFile, nil).
+		Once()
+
+	mockWatchdogLogFile := &osfacademocks.MockFile{}
+	mockOSLayer.EXPECT().
+		Create(filepath.Join(expectedBaseDir, "watchdog.log")).
+		Return(mockWatchdogLogFile, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		LogComponentLevels().
+		Return(map[string]entities.LogLevel{}).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxSizeMB().
+		Return(100).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxAge().
+		Return(time.Duration(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxFiles().
+		Return(5).
+		Once()
+
+	mockConfig.EXPECT().
+		Verbose().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		Quiet().
+		Return(true).
+		Once()
+
+	mockLogFile.EXPECT().
+		Write(mock.Anything).
+		RunAndReturn(func(b []byte) (int, error) {
+			return len(b), nil
+		}).
+		Once()
+
+	factory, err := logger.NewFactory(mockConfig, mockDirectory, mockOSLayer)
+	require.NoError(t, err, "Factory creation should not fail")
+
+	// Act
+	globalLogger := factory.GetGlobalLogger()
+	globalLogger.Debug("Below the log level")
+	globalLogger.Info("At the log level")
+
+	// Assert
+	mockLogFile.AssertNumberOfCalls(t, "Write", 1)
+}
+
+func TestFactory_GetGlobalLogger_ComponentLevels(t *testing.T) {
+	// Arrange
+	mockConfig := &loggermocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockDirectory := &loggermocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockOSLayer := &loggermocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLogFile := &osfacademocks.MockFile{}
+	defer mockLogFile.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		LogLevel().
+		Return("info").
+		Once()
+
+	expectedBaseDir := "/some/directory"
+	mockDirectory.EXPECT().
+		BaseDir().
+		Return(expectedBaseDir).
+		Once()
+
+	mockConfig.EXPECT().
+		LogFile().
+		Return("").
+		Once()
+
+	mockOSLayer.EXPECT().
+		Create(filepath.Join(expectedBaseDir, "server.log")).
+		Return(mockLogFile, nil).
+		Once()
+
+	mockWatchdogLogFile := &osfacademocks.MockFile{}
+	mockOSLayer.EXPECT().
+		Create(filepath.Join(expectedBaseDir, "watchdog.log")).
+		Return(mockWatchdogLogFile, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		LogComponentLevels().
+		Return(map[string]entities.LogLevel{
+			"matlab-supervisor": entities.LogLevelDebug,
+			"metrics":           entities.LogLevelError,
+		}).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxSizeMB().
+		Return(100).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxAge().
+		Return(time.Duration(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxFiles().
+		Return(5).
+		Once()
+
+	mockConfig.EXPECT().
+		Verbose().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		Quiet().
+		Return(true).
+		Once()
+
+	var written []string
+	mockLogFile.EXPECT().
+		Write(mock.Anything).
+		RunAndReturn(func(b []byte) (int, error) {
+			written = append(written, string(b))
+			return len(b), nil
+		}).
+		Times(2)
+
+	factory, err := logger.NewFactory(mockConfig, mockDirectory, mockOSLayer)
+	require.NoError(t, err, "Factory creation should not fail")
+
+	// Act
+	globalLogger := factory.GetGlobalLogger()
+	globalLogger.With("component", "matlab-supervisor").Debug("Debug entry of a detailed component")
+	globalLogger.With("component", "metrics").Warn("Warning of a quiet component")
+	globalLogger.Debug("Below the log level")
+	globalLogger.Info("At the log level")
+
+	// Assert
+	require.Len(t, written, 2)
+	assert.Contains(t, written[0], "Debug entry of a detailed component")
+	assert.Contains(t, written[1], "At the log level")
+	assert.NotContains(t, written[1], "matlab-supervisor", "The component of a derived logger should not leak into the global logger")
+}
+
+func TestFactory_SetLogLevels_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &loggermocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockDirectory := &loggermocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockOSLayer := &loggermocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLogFile := &osfacademocks.MockFile{}
+	defer mockLogFile.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		LogLevel().
+		Return("info").
+		Once()
+
+	expectedBaseDir := "/some/directory"
+	mockDirectory.EXPECT().
+		BaseDir().
+		Return(expectedBaseDir).
+		Once()
+
+	mockConfig.EXPECT().
+		LogFile().
+		Return("").
+		Once()
+
+	mockOSLayer.EXPECT().
+		Create(filepath.Join(expectedBaseDir, "server.log")).
+		Return(mockLogFile, nil).
+		Once()
+
+	mockWatchdogLogFile := &osfacademocks.MockFile{}
+	mockOSLayer.EXPECT().
+		Create(filepath.Join(expectedBaseDir, "watchdog.log")).
+		Return(mockWatchdogLogFile, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		LogComponentLevels().
+		Return(map[string]entities.LogLevel{
+			"matlab-supervisor": entities.LogLevelDebug,
+			"metrics":           entities.LogLevelError,
+		}).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxSizeMB().
+		Return(100).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxAge().
+		Return(time.Duration(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxFiles().
+		Return(5).
+		Once()
+
+	mockConfig.EXPECT().
+		Verbose().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		Quiet().
+		Return(true).
+		Once()
+
+	var written []string
+	mockLogFile.EXPECT().
+		Write(mock.Anything).
+		RunAndReturn(func(b []byte) (int, error) {
+			written = append(written, string(b))
+			return len(b), nil
+		}).
+		Times(3)
+
+	factory, err := logger.NewFactory(mockConfig, mockDirectory, mockOSLayer)
+	require.NoError(t, err, "Factory creation should not fail")
+
+	globalLogger := factory.GetGlobalLogger()
+	supervisorLogger := globalLogger.With("component", "matlab-supervisor")
+
+	// Act
+	err = factory.SetLogLevels(entities.LogLevelDebug, map[string]entities.LogLevel{
+		"matlab-supervisor": entities.LogLevelError,
+	})
+	require.NoError(t, err)
+
+	supervisorLogger.Info("Below the new level of the component")
+	supervisorLogger.Error("At the new level of the component")
+	globalLogger.With("component", "metrics").Debug("Debug entry of a component back to the log level")
+	globalLogger.Debug("At the new log level")
+
+	// Assert
+	require.Len(t, written, 3)
+	assert.Contains(t, written[0], "At the new level of the component", "Loggers created before the change should follow the new levels")
+	assert.Contains(t, written[1], "Debug entry of a component back to the log level")
+	assert.Contains(t, written[2], "At the new log level")
+}
+
+func TestFactory_GetGlobalLogger_ForwardsToSessions(t *testing.T) {
+	// Arrange
+	mockConfig := &loggermocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockDirectory := &loggermocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockOSLayer := &loggermocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLogFile := &osfacademocks.MockFile{}
+	defer mockLogFile.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		LogLevel().
+		Return("info").
+		Once()
+
+	expectedBaseDir := "/some/directory"
+	mockDirectory.EXPECT().
+		BaseDir().
+		Return(expectedBaseDir).
+		Once()
+
+	mockConfig.EXPECT().
+		LogFile().
+		Return("").
+		Once()
+
+	mockOSLayer.EXPECT().
+		Create(filepath.Join(expectedBaseDir, "server.log")).
+		Return(mockLogFile, nil).
+		Once()
+
+	mockWatchdogLogFile := &osfacademocks.MockFile{}
+	mockOSLayer.EXPECT().
+		Create(filepath.Join(expectedBaseDir, "watchdog.log")).
+		Return(mockWatchdogLogFile, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		LogComponentLevels().
+		Return(map[string]entities.LogLevel{}).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxSizeMB().
+		Return(100).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxAge().
+		Return(time.Duration(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxFiles().
+		Return(5).
+		Once()
+
+	mockConfig.EXPECT().
+		Verbose().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		Quiet().
+		Return(true).
+		Once()
+
+	mockLogFile.EXPECT().
+		Write(mock.Anything).
+		RunAndReturn(func(b []byte) (int, error) {
+			return len(b), nil
+		}).
+		Times(3)
+
+	factory, err := logger.NewFactory(mockConfig, mockDirectory, mockOSLayer)
+	require.NoError(t, err, "Factory creation should not fail")
+
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test-server"}, nil)
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := mcpServer.Connect(t.Context(), serverTransport, nil)
+	require.NoError(t, err)
+	defer func() { _ = serverSession.Close() }()
+
+	received := make(chan *mcp.LoggingMessageParams, 10)
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, &mcp.ClientOptions{
+		LoggingMessageHandler: func(_ context.Context, req *mcp.LoggingMessageRequest) {
+			received <- req.Params
+		},
+	})
+	clientSession, err := client.Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+	defer func() { _ = clientSession.Close() }()
+
+	globalLogger := factory.GetGlobalLogger()
+	globalLogger.Info("Before the server forwards the log entries")
+
+	factory.ForwardToSessions(mcpServer.Sessions)
+	globalLogger.Info("Before the client sets a log level")
+
+	require.NoError(t, clientSession.SetLoggingLevel(t.Context(), &mcp.SetLoggingLevelParams{Level: "info"}))
+
+	// Act
+	globalLogger.Debug("Below the log level of the client")
+	globalLogger.With("component", "metrics").Info("Serving metrics")
+
+	// Assert
+	params := <-received
+	assert.Equal(t, mcp.LoggingLevel("info"), params.Level)
+	assert.Equal(t, "matlab-mcp-core-server", params.Logger)
+
+	data, ok := params.Data.(map[string]any)
+	require.True(t, ok, "The log entry should be sent as a JSON object")
+	assert.Equal(t, "Serving metrics", data["msg"])
+	assert.Equal(t, "metrics", data["component"])
+	assert.Empty(t, received, "Only the entries at the level of the client should be forwarded, once it set one")
+}
@@ -0,0 +1,144 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package logger
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+)
+
+const rotatedFileTimeFormat = "20060102T150405.000000000Z"
+
+// rotatingFile writes the log entries to a file, which is rotated once it grows above the maximum size, or once it is
+// older than the maximum age. The rotated file is renamed after the time of the rotation, such as
+// server-20250102T150405.000000000Z.log, and only the most recent rotated files are kept.
+//
+// Failing to rotate does not lose the log entries: they keep being written to the current file. The failures are not
+// logged, as the log entries are written to this very file.
+type rotatingFile struct {
+	osLayer  OSLayer
+	path     string
+	maxBytes int64
+	maxAge   time.Duration
+	maxFiles int
+
+	lock     *sync.Mutex
+	file     osfacade.File
+	size     int64
+	openedAt time.Time
+}
+
+// newRotatingFile rotates file, freshly created at path. A maxBytes or maxAge of 0 never rotates the file for its size
+// or age, and a maxFiles of 0 keeps every rotated file.
+func newRotatingFile(osLayer OSLayer, file osfacade.File, path string, maxBytes int64, maxAge time.Duration, maxFiles int) *rotatingFile {
+	return &rotatingFile{
+		osLayer:  osLayer,
+		path:     path,
+		maxBytes: maxBytes,
+		maxAge:   maxAge,
+		maxFiles: maxFiles,
+
+		lock:     new(sync.Mutex),
+		file:     file,
+		openedAt: time.Now(),
+	}
+}
+
+func (r *rotatingFile) Write(p []byte) (int, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.shouldRotate(len(p)) {
+		r.rotate()
+	}
+
+	if r.file == nil {
+		return 0, fmt.Errorf("log file %s is not open", r.path)
+	}
+
+	written, err := r.file.Write(p)
+	r.size += int64(written)
+	return written, err
+}
+
+// shouldRotate is true when writing length more bytes would exceed the maximum size, or when the file is too old.
+// An empty file is never rotated, as an entry larger than the maximum size would otherwise rotate it forever.
+func (r *rotatingFile) shouldRotate(length int) bool {
+	if r.size == 0 {
+		return false
+	}
+	if r.maxBytes > 0 && r.size+int64(length) > r.maxBytes {
+		return true
+	}
+	return r.maxAge > 0 && time.Since(r.openedAt) > r.maxAge
+}
+
+// rotate renames the current file after the time of the rotation, starts a new one, and deletes the oldest rotated
+// files. When the file cannot be renamed, the entries are appended to it, until the next rotation is due.
+func (r *rotatingFile) rotate() {
+	if r.file != nil {
+		_ = r.file.Close()
+		r.file = nil
+	}
+
+	r.size = 0
+	r.openedAt = time.Now()
+
+	extension := filepath.Ext(r.path)
+	rotatedPath := fmt.Sprintf("%s-%s%s", strings.TrimSuffix(r.path, extension), r.openedAt.UTC().Format(rotatedFileTimeFormat), extension)
+	if err := r.osLayer.Rename(r.path, rotatedPath); err != nil {
+		if file, err := r.osLayer.OpenFile(r.path, os.O_APPEND|os.O_WRONLY, 0); err == nil {
+			r.file = file
+		}
+		return
+	}
+
+	if file, err := r.osLayer.Create(r.path); err == nil {
+		r.file = file
+	}
+
+	r.prune()
+}
+
+// prune deletes the oldest rotated files, beyond the maximum number of files.
+func (r *rotatingFile) prune() {
+	if r.maxFiles == 0 {
+		return
+	}
+
+	directory := filepath.Dir(r.path)
+	entries, err := fs.ReadDir(r.osLayer.DirFS(directory), ".")
+	if err != nil {
+		return
+	}
+
+	extension := filepath.Ext(r.path)
+	prefix := strings.TrimSuffix(filepath.Base(r.path), extension) + "-"
+
+	rotatedFiles := []string{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, extension) {
+			continue
+		}
+		if _, err := time.Parse(rotatedFileTimeFormat, strings.TrimSuffix(strings.TrimPrefix(name, prefix), extension)); err != nil {
+			continue
+		}
+		rotatedFiles = append(rotatedFiles, name)
+	}
+
+	// The time of the rotation in the names sorts the files from the oldest to the most recent.
+	slices.Sort(rotatedFiles)
+	for len(rotatedFiles) > r.maxFiles {
+		_ = r.osLayer.RemoveAll(filepath.Join(directory, rotatedFiles[0]))
+		rotatedFiles = rotatedFiles[1:]
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package logger_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/logger"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+	loggermocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// rotatedFiles returns the names of the rotated log files of path.
+func rotatedFiles(t *testing.T, path string) []string {
+	t.Helper()
+
+	matches, err := filepath.Glob(strings.TrimSuffix(path, ".log") + "-*.log")
+	require.NoError(t, err)
+	return matches
+}
+
+func TestRotatingFile_Write_RotatesOnSize(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "server.log")
+	osFacade := osfacade.New()
+
+	mockOSLayer := &loggermocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		Rename(path, mock.Anything).
+		RunAndReturn(osFacade.Rename).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Create(path).
+		RunAndReturn(osFacade.Create).
+		Once()
+
+	file, err := osFacade.Create(path)
+	require.NoError(t, err)
+
+	rotatingFile := logger.NewRotatingFile(mockOSLayer, file, path, 10, 0, 0)
+	defer func() { _ = rotatingFile.Close() }()
+
+	// Act
+	_, err = rotatingFile.Write([]byte("first entry\n"))
+	require.NoError(t, err)
+	_, err = rotatingFile.Write([]byte("second\n"))
+	require.NoError(t, err)
+
+	// Assert
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "second\n", string(content))
+
+	rotated := rotatedFiles(t, path)
+	require.Len(t, rotated, 1)
+	rotatedContent, err := os.ReadFile(rotated[0])
+	require.NoError(t, err)
+	assert.Equal(t, "first entry\n", string(rotatedContent), "An entry larger than the maximum size should still be written")
+}
+
+func TestRotatingFile_Write_RotatesOnAge(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "server.log")
+	osFacade := osfacade.New()
+
+	mockOSLayer := &loggermocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		Rename(path, mock.Anything).
+		RunAndReturn(osFacade.Rename).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Create(path).
+		RunAndReturn(osFacade.Create).
+		Once()
+
+	file, err := osFacade.Create(path)
+	require.NoError(t, err)
+
+	rotatingFile := logger.NewRotatingFile(mockOSLayer, file, path, 0, time.Millisecond, 0)
+	defer func() { _ = rotatingFile.Close() }()
+
+	_, err = rotatingFile.Write([]byte("old entry\n"))
+	require.NoError(t, err)
+	time.Sleep(5 * time.Millisecond)
+
+	// Act
+	_, err = rotatingFile.Write([]byte("new entry\n"))
+	require.NoError(t, err)
+
+	// Assert
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "new entry\n", string(content))
+	assert.Len(t, rotatedFiles(t, path), 1)
+}
+
+func TestRotatingFile_Write_DeletesOldestRotatedFiles(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "server.log")
+	osFacade := osfacade.New()
+
+	mockOSLayer := &loggermocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		Rename(path, mock.Anything).
+		RunAndReturn(osFacade.Rename).
+		Times(4)
+
+	mockOSLayer.EXPECT().
+		Create(path).
+		RunAndReturn(osFacade.Create).
+		Times(4)
+
+	mockOSLayer.EXPECT().
+		DirFS(filepath.Dir(path)).
+		RunAndReturn(osFacade.DirFS).
+		Times(4)
+
+	mockOSLayer.EXPECT().
+		RemoveAll(mock.Anything).
+		RunAndReturn(osFacade.RemoveAll).
+		Times(2)
+
+	unrelatedPath := filepath.Join(filepath.Dir(path), "server-notes.log")
+	require.NoError(t, os.WriteFile(unrelatedPath, []byte("notes"), 0o600))
+
+	file, err := osFacade.Create(path)
+	require.NoError(t, err)
+
+	rotatingFile := logger.NewRotatingFile(mockOSLayer, file, path, 1, 0, 2)
+	defer func() { _ = rotatingFile.Close() }()
+
+	// Act
+	for _, entry := range []string{"1\n", "2\n", "3\n", "4\n", "5\n"} {
+		_, err = rotatingFile.Write([]byte(entry))
+		require.NoError(t, err)
+	}
+
+	// Assert
+	rotated := rotatedFiles(t, path)
+	require.Len(t, rotated, 3, "The two most recent rotated files should be kept, next to the unrelated file")
+
+	var contents []string
+	for _, name := range rotated {
+		if name == unrelatedPath {
+			continue
+		}
+		content, err := os.ReadFile(name)
+		require.NoError(t, err)
+		contents = append(contents, string(content))
+	}
+	assert.Equal(t, []string{"3\n", "4\n"}, contents)
+	assert.FileExists(t, unrelatedPath)
+}
@@ -0,0 +1,97 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package logger
+
+import (
+	"context"
+	"iter"
+	"log/slog"
+	"sync/atomic"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// forwardedLoggerName is the "logger" field of the log entries of the server forwarded to the clients, the same as
+// the one of the notifications sent to every client.
+const forwardedLoggerName = "matlab-mcp-core-server"
+
+// sessions lists the MCP sessions connected to the server.
+type sessions func() iter.Seq[*mcp.ServerSession]
+
+// sessionForwardingHandler forwards the log entries of the global logger to every MCP session connected to the
+// server, as log message notifications. As required by the specification, a client only receives the entries at or
+// above the level it set, and none before it sets one:
+//
+// https://modelcontextprotocol.io/specification/2025-06-18/server/utilities/logging
+//
+// Failing to notify a client is not logged, as that log entry would be forwarded as well.
+type sessionForwardingHandler struct {
+	sessions *atomic.Pointer[sessions]
+
+	// derive are the attributes and groups of the logger, applied to the handler of each session in turn.
+	derive []func(slog.Handler) slog.Handler
+}
+
+func newSessionForwardingHandler() *sessionForwardingHandler {
+	return &sessionForwardingHandler{
+		sessions: new(atomic.Pointer[sessions]),
+	}
+}
+
+// forwardTo starts forwarding the log entries to the sessions listed by listSessions.
+func (h *sessionForwardingHandler) forwardTo(listSessions sessions) {
+	h.sessions.Store(&listSessions)
+}
+
+func (h *sessionForwardingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	for session := range h.listSessions() {
+		if mcp.NewLoggingHandler(session, nil).Enabled(ctx, level) {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *sessionForwardingHandler) Handle(ctx context.Context, record slog.Record) error {
+	for session := range h.listSessions() {
+		var handler slog.Handler = mcp.NewLoggingHandler(session, &mcp.LoggingHandlerOptions{
+			LoggerName: forwardedLoggerName,
+		})
+		if !handler.Enabled(ctx, record.Level) {
+			continue
+		}
+		for _, derive := range h.derive {
+			handler = derive(handler)
+		}
+		_ = handler.Handle(ctx, record)
+	}
+	return nil
+}
+
+func (h *sessionForwardingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return h.with(func(handler slog.Handler) slog.Handler {
+		return handler.WithAttrs(attrs)
+	})
+}
+
+func (h *sessionForwardingHandler) WithGroup(name string) slog.Handler {
+	return h.with(func(handler slog.Handler) slog.Handler {
+		return handler.WithGroup(name)
+	})
+}
+
+func (h *sessionForwardingHandler) with(derive func(slog.Handler) slog.Handler) *sessionForwardingHandler {
+	return &sessionForwardingHandler{
+		sessions: h.sessions,
+		derive:   append(h.derive[:len(h.derive):len(h.derive)], derive),
+	}
+}
+
+// listSessions lists the sessions connected to the server, none until the server forwards the log entries to them.
+func (h *sessionForwardingHandler) listSessions() iter.Seq[*mcp.ServerSession] {
+	listSessions := h.sessions.Load()
+	if listSessions == nil {
+		return func(func(*mcp.ServerSession) bool) {}
+	}
+	return (*listSessions)()
+}
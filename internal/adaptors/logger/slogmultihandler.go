@@ -46,18 +46,22 @@ func (h *SlogMultiHandler) Handle(ctx context.Context, record slog.Record) error
 	return err
 }
 
+// WithAttrs returns a new handler, leaving h untouched, as the loggers derived from one another share their handlers.
 func (h *SlogMultiHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
-	for i, handler := range h.handlers {
-		h.handlers[i] = handler.WithAttrs(attrs)
+	var handlers []Handler
+	for _, handler := range h.handlers {
+		handlers = append(handlers, handler.WithAttrs(attrs))
 	}
 
-	return h
+	return NewMultiHandler(handlers...)
 }
 
+// WithGroup returns a new handler, leaving h untouched.
 func (h *SlogMultiHandler) WithGroup(name string) slog.Handler {
-	for i, handler := range h.handlers {
-		h.handlers[i] = handler.WithGroup(name)
+	var handlers []Handler
+	for _, handler := range h.handlers {
+		handlers = append(handlers, handler.WithGroup(name))
 	}
 
-	return h
+	return NewMultiHandler(handlers...)
 }
@@ -3,6 +3,7 @@
 package logger_test
 
 import (
+	"bytes"
 	"log/slog"
 	"testing"
 
@@ -291,6 +292,20 @@ func TestSlogMultiHandler_WithAttrs_NoHandlers_ReturnsItself(t *testing.T) {
 	assert.Equal(t, multiHandler, result, "WithAttrs should return the same multiHandler instance")
 }
 
+func TestSlogMultiHandler_WithAttrs_LeavesOriginalUntouched(t *testing.T) {
+	// Arrange
+	buffer := &bytes.Buffer{}
+	multiHandler := logger.NewMultiHandler(slog.NewJSONHandler(buffer, nil))
+	original := slog.New(multiHandler)
+
+	// Act
+	_ = original.With("component", "metrics")
+	original.Info("message")
+
+	// Assert
+	assert.NotContains(t, buffer.String(), "metrics", "Deriving a logger should not add attributes to the original one")
+}
+
 func TestSlogMultiHandler_WithGroup_CallsAllHandlers(t *testing.T) {
 	// Arrange
 	groupName := "test-group"
@@ -0,0 +1,267 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package managedpolicy
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// publicKey is the base64 encoded ed25519 key verifying managed policy bundles.
+// It is empty by default, and set using ldflags when building for a managed deployment.
+var publicKey = ""
+
+// functionNamePattern matches the names of MATLAB functions, with their package.
+var functionNamePattern = regexp.MustCompile(`^[A-Za-z]\w*(\.[A-Za-z]\w*)*$`)
+
+const bundleFileName = "managed-policy.json"
+
+type OSLayer interface {
+	ReadFile(name string) ([]byte, error)
+	Getenv(key string) string
+	GOOS() string
+}
+
+// Settings are the restrictions of a managed policy.
+// Local configuration can make them stricter, but never weaker.
+type Settings struct {
+	DisableTelemetry   bool
+	Sandbox            bool
+	AllowedFunctions   []string
+	DeniedFunctions    []string
+	ReadOnly           bool
+	RequireApproval    bool
+	RedactOutput       bool
+	RedactionPatterns  []string
+	RestrictFileAccess bool
+	AllowedFolders     []string
+	BlockNetwork       bool
+	AllowedHosts       []string
+	EncryptAtRest      bool
+	StrictTLS          bool
+	MaxEvalTime        time.Duration
+	MaxOutputBytes     int
+	MaxFigures         int
+	RateLimit          float64
+	RateLimitBurst     int
+	MaxConcurrentCalls int
+	// LogLevel is the least detailed log level allowed, so that the logs keep the records required by audits.
+	LogLevel entities.LogLevel
+	// ToolPolicy is a tool policy document, in the format of the policy file.
+	ToolPolicy json.RawMessage
+}
+
+type bundle struct {
+	Policy    string `json:"policy"`
+	Signature string `json:"signature"`
+}
+
+type document struct {
+	DisableTelemetry   bool            `json:"disable-telemetry"`
+	Sandbox            bool            `json:"sandbox"`
+	AllowedFunctions   []string        `json:"allowed-function"`
+	DeniedFunctions    []string        `json:"denied-function"`
+	ReadOnly           bool            `json:"read-only"`
+	RequireApproval    bool            `json:"require-approval"`
+	RedactOutput       bool            `json:"redact-output"`
+	RedactionPatterns  []string        `json:"redact-pattern"`
+	RestrictFileAccess bool            `json:"restrict-file-access"`
+	AllowedFolders     []string        `json:"allowed-folder"`
+	BlockNetwork       bool            `json:"block-network"`
+	AllowedHosts       []string        `json:"allowed-host"`
+	EncryptAtRest      bool            `json:"encrypt-at-rest"`
+	StrictTLS          bool            `json:"strict-tls"`
+	MaxEvalTime        string          `json:"max-eval-time"`
+	MaxOutputBytes     int             `json:"max-output-bytes"`
+	MaxFigures         int             `json:"max-figures"`
+	RateLimit          float64         `json:"rate-limit"`
+	RateLimitBurst     int             `json:"rate-limit-burst"`
+	MaxConcurrentCalls int             `json:"max-concurrent-calls"`
+	LogLevel           string          `json:"log-level"`
+	ToolPolicy         json.RawMessage `json:"tool-policy"`
+}
+
+// ManagedPolicy is the policy installed by the administrators of a managed workstation, in a location only they can write to.
+// The bundle is signed, and its signature is checked against the public key embedded in the server at build time.
+type ManagedPolicy struct {
+	path     string
+	settings Settings
+	present  bool
+}
+
+func New(
+	osLayer OSLayer,
+) (*ManagedPolicy, error) {
+	path := bundlePath(osLayer)
+	policy := &ManagedPolicy{
+		path: path,
+	}
+
+	data, err := osLayer.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return policy, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read managed policy %s: %w", path, err)
+	}
+
+	settings, err := verify(data, strings.TrimSpace(publicKey))
+	if err != nil {
+		return nil, fmt.Errorf("refusing to start with managed policy %s: %w", path, err)
+	}
+
+	policy.settings = settings
+	policy.present = true
+
+	return policy, nil
+}
+
+// Path is the location of the managed policy bundle, whether or not it exists.
+func (p *ManagedPolicy) Path() string {
+	return p.path
+}
+
+// Settings returns the restrictions of the managed policy, and false when there is no managed policy.
+func (p *ManagedPolicy) Settings() (Settings, bool) {
+	return p.settings, p.present
+}
+
+// bundlePath is the machine wide location of the bundle, which depends on the OS only, so that users cannot point the server elsewhere.
+func bundlePath(osLayer OSLayer) string {
+	switch osLayer.GOOS() {
+	case "windows":
+		programData := osLayer.Getenv("ProgramData")
+		if programData == "" {
+			programData = `C:\ProgramData`
+		}
+		return programData + `\MathWorks\MATLAB MCP Core Server\` + bundleFileName
+	case "darwin":
+		return "/Library/Application Support/MathWorks/MATLAB MCP Core Server/" + bundleFileName
+	default:
+		return "/etc/matlab-mcp-core-server/" + bundleFileName
+	}
+}
+
+func verify(data []byte, encodedPublicKey string) (Settings, error) {
+	if encodedPublicKey == "" {
+		return Settings{}, fmt.Errorf("this build of the server has no public key to verify managed policies")
+	}
+
+	key, err := base64.StdEncoding.DecodeString(encodedPublicKey)
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		return Settings{}, fmt.Errorf("the embedded public key is not a base64 encoded ed25519 public key")
+	}
+
+	var b bundle
+	if err := json.Unmarshal(data, &b); err != nil {
+		return Settings{}, fmt.Errorf("failed to parse bundle: %w", err)
+	}
+
+	policy, err := base64.StdEncoding.DecodeString(b.Policy)
+	if err != nil {
+		return Settings{}, fmt.Errorf("policy is not base64 encoded: %w", err)
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(b.Signature)
+	if err != nil {
+		return Settings{}, fmt.Errorf("signature is not base64 encoded: %w", err)
+	}
+
+	if !ed25519.Verify(ed25519.PublicKey(key), policy, signature) {
+		return Settings{}, fmt.Errorf("invalid signature")
+	}
+
+	return parse(policy)
+}
+
+func parse(policy []byte) (Settings, error) {
+	decoder := json.NewDecoder(bytes.NewReader(policy))
+	decoder.DisallowUnknownFields()
+
+	var doc document
+	if err := decoder.Decode(&doc); err != nil {
+		return Settings{}, fmt.Errorf("failed to parse policy: %w", err)
+	}
+
+	settings := Settings{
+		DisableTelemetry:   doc.DisableTelemetry,
+		Sandbox:            doc.Sandbox,
+		AllowedFunctions:   doc.AllowedFunctions,
+		DeniedFunctions:    doc.DeniedFunctions,
+		ReadOnly:           doc.ReadOnly,
+		RequireApproval:    doc.RequireApproval,
+		RedactOutput:       doc.RedactOutput,
+		RedactionPatterns:  doc.RedactionPatterns,
+		RestrictFileAccess: doc.RestrictFileAccess,
+		AllowedFolders:     doc.AllowedFolders,
+		BlockNetwork:       doc.BlockNetwork,
+		AllowedHosts:       doc.AllowedHosts,
+		EncryptAtRest:      doc.EncryptAtRest,
+		StrictTLS:          doc.StrictTLS,
+		MaxOutputBytes:     doc.MaxOutputBytes,
+		MaxFigures:         doc.MaxFigures,
+		RateLimit:          doc.RateLimit,
+		RateLimitBurst:     doc.RateLimitBurst,
+		MaxConcurrentCalls: doc.MaxConcurrentCalls,
+		LogLevel:           entities.LogLevel(doc.LogLevel),
+		ToolPolicy:         doc.ToolPolicy,
+	}
+
+	switch settings.LogLevel {
+	case "", entities.LogLevelDebug, entities.LogLevelInfo, entities.LogLevelWarn, entities.LogLevelError:
+	default:
+		return Settings{}, fmt.Errorf("invalid log level: %s", doc.LogLevel)
+	}
+
+	if doc.MaxEvalTime != "" {
+		maxEvalTime, err := time.ParseDuration(doc.MaxEvalTime)
+		if err != nil {
+			return Settings{}, fmt.Errorf("invalid max-eval-time: %w", err)
+		}
+		settings.MaxEvalTime = maxEvalTime
+	}
+
+	if settings.MaxEvalTime < 0 || settings.MaxOutputBytes < 0 || settings.MaxFigures < 0 ||
+		settings.RateLimit < 0 || settings.RateLimitBurst < 0 || settings.MaxConcurrentCalls < 0 {
+		return Settings{}, fmt.Errorf("limits cannot be negative")
+	}
+
+	for _, pattern := range settings.RedactionPatterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return Settings{}, fmt.Errorf("invalid redact pattern: %w", err)
+		}
+	}
+
+	for _, folder := range settings.AllowedFolders {
+		if !filepath.IsAbs(folder) {
+			return Settings{}, fmt.Errorf("invalid allowed folder: %s is not an absolute path", folder)
+		}
+	}
+
+	for i, host := range settings.AllowedHosts {
+		if host == "" || strings.ContainsAny(host, "/:@ ") {
+			return Settings{}, fmt.Errorf("invalid allowed host: %s is not a host name", host)
+		}
+		settings.AllowedHosts[i] = strings.ToLower(host)
+	}
+
+	for _, function := range slices.Concat(settings.AllowedFunctions, settings.DeniedFunctions) {
+		if !functionNamePattern.MatchString(function) {
+			return Settings{}, fmt.Errorf("invalid function: %s is not a MATLAB function name", function)
+		}
+	}
+
+	return settings, nil
+}
@@ -0,0 +1,12 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package managedpolicy
+
+import "testing"
+
+func SetPublicKeyLikeLDFLAGSWould(t *testing.T, newPublicKey string) {
+	t.Cleanup(func() {
+		publicKey = ""
+	})
+	publicKey = newPublicKey
+}
@@ -0,0 +1,330 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package managedpolicy_test
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"io/fs"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/managedpolicy"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/managedpolicy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const linuxBundlePath = "/etc/matlab-mcp-core-server/managed-policy.json"
+
+type signer struct {
+	publicKey  string
+	privateKey ed25519.PrivateKey
+}
+
+func newSigner(t *testing.T) *signer {
+	t.Helper()
+
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	return &signer{
+		publicKey:  base64.StdEncoding.EncodeToString(publicKey),
+		privateKey: privateKey,
+	}
+}
+
+func (s *signer) bundle(t *testing.T, policy string) []byte {
+	t.Helper()
+
+	data, err := json.Marshal(map[string]string{
+		"policy":    base64.StdEncoding.EncodeToString([]byte(policy)),
+		"signature": base64.StdEncoding.EncodeToString(ed25519.Sign(s.privateKey, []byte(policy))),
+	})
+	require.NoError(t, err)
+	return data
+}
+
+func newLinuxOSLayer(t *testing.T, data []byte, err error) *mocks.MockOSLayer {
+	t.Helper()
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	t.Cleanup(func() { mockOSLayer.AssertExpectations(t) })
+
+	mockOSLayer.EXPECT().
+		GOOS().
+		Return("linux").
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(linuxBundlePath).
+		Return(data, err).
+		Once()
+
+	return mockOSLayer
+}
+
+func TestNew_NoBundle(t *testing.T) {
+	// Arrange
+	mockOSLayer := newLinuxOSLayer(t, nil, fs.ErrNotExist)
+
+	// Act
+	policy, err := managedpolicy.New(mockOSLayer)
+
+	// Assert
+	require.NoError(t, err)
+	_, present := policy.Settings()
+	assert.False(t, present)
+	assert.Equal(t, linuxBundlePath, policy.Path())
+}
+
+func TestNew_ReadFileError(t *testing.T) {
+	// Arrange
+	mockOSLayer := newLinuxOSLayer(t, nil, assert.AnError)
+
+	// Act
+	policy, err := managedpolicy.New(mockOSLayer)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, policy)
+}
+
+func TestNew_ValidBundle(t *testing.T) {
+	// Arrange
+	signer := newSigner(t)
+	managedpolicy.SetPublicKeyLikeLDFLAGSWould(t, signer.publicKey)
+
+	mockOSLayer := newLinuxOSLayer(t, signer.bundle(t, `{
+		"sandbox": true,
+		"allowed-function": ["delete"],
+		"denied-function": ["rmpath"],
+		"restrict-file-access": true,
+		"allowed-folder": ["/data"],
+		"block-network": true,
+		"allowed-host": ["Data.Example.com"],
+		"encrypt-at-rest": true,
+		"strict-tls": true,
+		"max-eval-time": "5m",
+		"max-figures": 10,
+		"log-level": "info",
+		"tool-policy": {"rules": [{"tool": "run_matlab_file", "action": "deny"}]}
+	}`), nil)
+
+	// Act
+	policy, err := managedpolicy.New(mockOSLayer)
+
+	// Assert
+	require.NoError(t, err)
+	settings, present := policy.Settings()
+	require.True(t, present)
+	assert.True(t, settings.Sandbox)
+	assert.Equal(t, []string{"delete"}, settings.AllowedFunctions)
+	assert.Equal(t, []string{"rmpath"}, settings.DeniedFunctions)
+	assert.False(t, settings.ReadOnly)
+	assert.True(t, settings.RestrictFileAccess)
+	assert.Equal(t, []string{"/data"}, settings.AllowedFolders)
+	assert.True(t, settings.BlockNetwork)
+	assert.Equal(t, []string{"data.example.com"}, settings.AllowedHosts)
+	assert.True(t, settings.EncryptAtRest)
+	assert.True(t, settings.StrictTLS)
+	assert.Equal(t, 5*time.Minute, settings.MaxEvalTime)
+	assert.Equal(t, 10, settings.MaxFigures)
+	assert.Equal(t, entities.LogLevelInfo, settings.LogLevel)
+	assert.JSONEq(t, `{"rules": [{"tool": "run_matlab_file", "action": "deny"}]}`, string(settings.ToolPolicy))
+}
+
+func TestNew_RefusesUnverifiedBundles(t *testing.T) {
+	signer := newSigner(t)
+	otherSigner := newSigner(t)
+
+	tamperedBundle := map[string]string{}
+	require.NoError(t, json.Unmarshal(signer.bundle(t, `{"sandbox": true}`), &tamperedBundle))
+	tamperedBundle["policy"] = base64.StdEncoding.EncodeToString([]byte(`{"sandbox": false}`))
+	tampered, err := json.Marshal(tamperedBundle)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name          string
+		publicKey     string
+		data          []byte
+		expectedError string
+	}{
+		{
+			name:          "no embedded public key",
+			publicKey:     "",
+			data:          signer.bundle(t, `{"sandbox": true}`),
+			expectedError: "this build of the server has no public key to verify managed policies",
+		},
+		{
+			name:          "invalid embedded public key",
+			publicKey:     "not a key",
+			data:          signer.bundle(t, `{"sandbox": true}`),
+			expectedError: "the embedded public key is not a base64 encoded ed25519 public key",
+		},
+		{
+			name:          "signed with another key",
+			publicKey:     signer.publicKey,
+			data:          otherSigner.bundle(t, `{"sandbox": true}`),
+			expectedError: "invalid signature",
+		},
+		{
+			name:          "tampered policy",
+			publicKey:     signer.publicKey,
+			data:          tampered,
+			expectedError: "invalid signature",
+		},
+		{
+			name:          "not a bundle",
+			publicKey:     signer.publicKey,
+			data:          []byte("sandbox: true"),
+			expectedError: "failed to parse bundle",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			managedpolicy.SetPublicKeyLikeLDFLAGSWould(t, testCase.publicKey)
+			mockOSLayer := newLinuxOSLayer(t, testCase.data, nil)
+
+			// Act
+			policy, err := managedpolicy.New(mockOSLayer)
+
+			// Assert
+			require.ErrorContains(t, err, "refusing to start with managed policy "+linuxBundlePath)
+			require.ErrorContains(t, err, testCase.expectedError)
+			assert.Nil(t, policy)
+		})
+	}
+}
+
+func TestNew_InvalidPolicy(t *testing.T) {
+	testCases := []struct {
+		name          string
+		policy        string
+		expectedError string
+	}{
+		{
+			name:          "unknown setting",
+			policy:        `{"sandboxed": true}`,
+			expectedError: `unknown field "sandboxed"`,
+		},
+		{
+			name:          "invalid duration",
+			policy:        `{"max-eval-time": "soon"}`,
+			expectedError: "invalid max-eval-time",
+		},
+		{
+			name:          "negative limit",
+			policy:        `{"max-figures": -1}`,
+			expectedError: "limits cannot be negative",
+		},
+		{
+			name:          "invalid redact pattern",
+			policy:        `{"redact-pattern": ["PAT-("]}`,
+			expectedError: "invalid redact pattern",
+		},
+		{
+			name:          "relative allowed folder",
+			policy:        `{"allowed-folder": ["data"]}`,
+			expectedError: "invalid allowed folder: data is not an absolute path",
+		},
+		{
+			name:          "allowed host with a scheme",
+			policy:        `{"allowed-host": ["https://example.com"]}`,
+			expectedError: "invalid allowed host: https://example.com is not a host name",
+		},
+		{
+			name:          "denied shell escape",
+			policy:        `{"denied-function": ["!"]}`,
+			expectedError: "invalid function: ! is not a MATLAB function name",
+		},
+		{
+			name:          "invalid log level",
+			policy:        `{"log-level": "verbose"}`,
+			expectedError: "invalid log level: verbose",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			signer := newSigner(t)
+			managedpolicy.SetPublicKeyLikeLDFLAGSWould(t, signer.publicKey)
+			mockOSLayer := newLinuxOSLayer(t, signer.bundle(t, testCase.policy), nil)
+
+			// Act
+			policy, err := managedpolicy.New(mockOSLayer)
+
+			// Assert
+			require.ErrorContains(t, err, testCase.expectedError)
+			assert.Nil(t, policy)
+		})
+	}
+}
+
+func TestNew_BundlePath(t *testing.T) {
+	testCases := []struct {
+		name         string
+		goos         string
+		programData  string
+		expectedPath string
+	}{
+		{
+			name:         "windows",
+			goos:         "windows",
+			programData:  `D:\ProgramData`,
+			expectedPath: `D:\ProgramData\MathWorks\MATLAB MCP Core Server\managed-policy.json`,
+		},
+		{
+			name:         "windows without ProgramData",
+			goos:         "windows",
+			expectedPath: `C:\ProgramData\MathWorks\MATLAB MCP Core Server\managed-policy.json`,
+		},
+		{
+			name:         "macOS",
+			goos:         "darwin",
+			expectedPath: "/Library/Application Support/MathWorks/MATLAB MCP Core Server/managed-policy.json",
+		},
+		{
+			name:         "linux",
+			goos:         "linux",
+			expectedPath: linuxBundlePath,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockOSLayer.EXPECT().
+				GOOS().
+				Return(testCase.goos).
+				Once()
+
+			if testCase.goos == "windows" {
+				mockOSLayer.EXPECT().
+					Getenv("ProgramData").
+					Return(testCase.programData).
+					Once()
+			}
+
+			mockOSLayer.EXPECT().
+				ReadFile(testCase.expectedPath).
+				Return(nil, fs.ErrNotExist).
+				Once()
+
+			// Act
+			policy, err := managedpolicy.New(mockOSLayer)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedPath, policy.Path())
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabfixture
+
+import (
+	"encoding/json"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// maxInteractionSize is the largest recorded interaction the player accepts, as responses can hold figures.
+const maxInteractionSize = 256 * 1024 * 1024
+
+type Kind string
+
+const (
+	KindEnvironments    Kind = "environments"
+	KindEval            Kind = "eval"
+	KindEvalWithCapture Kind = "eval_with_capture"
+	KindFEval           Kind = "feval"
+	KindInterrupt       Kind = "interrupt"
+)
+
+// Interaction is a line of a fixture: a request to a MATLAB session with the response or error of the session, or the
+// MATLAB environments found on the machine.
+type Interaction struct {
+	Kind         Kind            `json:"kind"`
+	Environments []Environment   `json:"environments,omitempty"`
+	Request      json.RawMessage `json:"request,omitempty"`
+	Response     json.RawMessage `json:"response,omitempty"`
+	Error        *Error          `json:"error,omitempty"`
+}
+
+type Environment struct {
+	MATLABRoot string `json:"matlab_root"`
+	Version    string `json:"version"`
+}
+
+// Error is a recorded error, with its error code, so that the replayed error is classified as the recorded one.
+type Error struct {
+	Code    entities.ErrorCode `json:"code"`
+	Message string             `json:"message"`
+}
+
+type evalRequest struct {
+	Code string `json:"code"`
+}
+
+type evalResponse struct {
+	ConsoleOutput string   `json:"console_output"`
+	Images        [][]byte `json:"images,omitempty"`
+}
+
+type fevalRequest struct {
+	Function   string   `json:"function"`
+	Arguments  []string `json:"arguments"`
+	NumOutputs int      `json:"num_outputs"`
+}
+
+type fevalResponse struct {
+	Outputs []any `json:"outputs"`
+}
+
+func newEvalRequest(request entities.EvalRequest) evalRequest {
+	return evalRequest{Code: request.Code}
+}
+
+func newFEvalRequest(request entities.FEvalRequest) fevalRequest {
+	arguments := request.Arguments
+	if arguments == nil {
+		arguments = []string{}
+	}
+	return fevalRequest{
+		Function:   request.Function,
+		Arguments:  arguments,
+		NumOutputs: request.NumOutputs,
+	}
+}
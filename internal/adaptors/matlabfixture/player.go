@@ -0,0 +1,221 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabfixture
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+type PlayerConfig interface {
+	ReplayMATLABFile() string
+}
+
+type PlayerOSLayer interface {
+	ReadFile(name string) ([]byte, error)
+}
+
+// Player serves the responses of a fixture instead of MATLAB, so that the tools run without a MATLAB installation.
+// A request is answered with the first response recorded for the same request that was not served yet, so the
+// responses to repeated requests are served in the order they were recorded, whatever the order of other requests.
+type Player struct {
+	path         string
+	environments []entities.EnvironmentInfo
+
+	lock         *sync.Mutex
+	interactions map[string][]Interaction
+}
+
+func NewPlayer(
+	config PlayerConfig,
+	osLayer PlayerOSLayer,
+) (*Player, error) {
+	player := &Player{
+		path:         config.ReplayMATLABFile(),
+		lock:         new(sync.Mutex),
+		interactions: map[string][]Interaction{},
+	}
+
+	if player.path == "" {
+		return player, nil
+	}
+
+	data, err := osLayer.ReadFile(player.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read MATLAB fixture: %w", err)
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, maxInteractionSize)
+
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		var interaction Interaction
+		if err := json.Unmarshal(scanner.Bytes(), &interaction); err != nil {
+			return nil, fmt.Errorf("invalid line %d of MATLAB fixture %s: %w", lineNumber, player.path, err)
+		}
+
+		if interaction.Kind == KindEnvironments {
+			for _, environment := range interaction.Environments {
+				player.environments = append(player.environments, entities.EnvironmentInfo{
+					MATLABRoot: environment.MATLABRoot,
+					Version:    environment.Version,
+				})
+			}
+			continue
+		}
+
+		key, err := keyOf(interaction.Kind, interaction.Request)
+		if err != nil {
+			return nil, fmt.Errorf("invalid request on line %d of MATLAB fixture %s: %w", lineNumber, player.path, err)
+		}
+		player.interactions[key] = append(player.interactions[key], interaction)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read MATLAB fixture: %w", err)
+	}
+
+	return player, nil
+}
+
+// Enabled is true when MATLAB is replaced by a fixture.
+func (p *Player) Enabled() bool {
+	return p.path != ""
+}
+
+// Environments returns the MATLAB environments recorded in the fixture, or a single environment named after the
+// fixture when none were recorded, so that a MATLAB session can always be started.
+func (p *Player) Environments() []entities.EnvironmentInfo {
+	if len(p.environments) == 0 {
+		return []entities.EnvironmentInfo{{MATLABRoot: p.path, Version: "fixture"}}
+	}
+	return p.environments
+}
+
+// NewClient returns a client answering from the fixture. All the clients share the responses of the fixture.
+func (p *Player) NewClient() entities.MATLABSessionClient {
+	return &replayingClient{
+		player: p,
+	}
+}
+
+// next returns the next recorded interaction for the request, and removes it from the fixture.
+func (p *Player) next(kind Kind, request any) (Interaction, bool, error) {
+	var data []byte
+	if request != nil {
+		var err error
+		data, err = json.Marshal(request)
+		if err != nil {
+			return Interaction{}, false, err
+		}
+	}
+
+	key, err := keyOf(kind, data)
+	if err != nil {
+		return Interaction{}, false, err
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	interactions := p.interactions[key]
+	if len(interactions) == 0 {
+		return Interaction{}, false, nil
+	}
+
+	p.interactions[key] = interactions[1:]
+	return interactions[0], true, nil
+}
+
+// play decodes the recorded response of the request into response, or returns the recorded error.
+func (p *Player) play(kind Kind, request any, response any) error {
+	interaction, found, err := p.next(kind, request)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		data, _ := json.Marshal(request)
+		return fmt.Errorf("no response to this %s request is left in the MATLAB fixture %s: %s", kind, p.path, data)
+	}
+
+	if interaction.Error != nil {
+		return entities.NewCodedError(interaction.Error.Code, errors.New(interaction.Error.Message))
+	}
+
+	if len(interaction.Response) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(interaction.Response, response); err != nil {
+		return fmt.Errorf("invalid %s response in the MATLAB fixture %s: %w", kind, p.path, err)
+	}
+	return nil
+}
+
+// keyOf identifies a request by its kind and its compacted JSON, so that the formatting of a fixture edited by hand
+// does not matter.
+func keyOf(kind Kind, request json.RawMessage) (string, error) {
+	var compacted bytes.Buffer
+	if len(request) > 0 {
+		if err := json.Compact(&compacted, request); err != nil {
+			return "", err
+		}
+	}
+	return string(kind) + "\x00" + compacted.String(), nil
+}
+
+type replayingClient struct {
+	player *Player
+}
+
+func (c *replayingClient) Eval(_ context.Context, _ entities.Logger, request entities.EvalRequest) (entities.EvalResponse, error) {
+	return c.eval(KindEval, request)
+}
+
+func (c *replayingClient) EvalWithCapture(_ context.Context, _ entities.Logger, request entities.EvalRequest) (entities.EvalResponse, error) {
+	return c.eval(KindEvalWithCapture, request)
+}
+
+func (c *replayingClient) eval(kind Kind, request entities.EvalRequest) (entities.EvalResponse, error) {
+	var response evalResponse
+	if err := c.player.play(kind, newEvalRequest(request), &response); err != nil {
+		return entities.EvalResponse{}, err
+	}
+
+	return entities.EvalResponse{
+		ConsoleOutput: response.ConsoleOutput,
+		Images:        response.Images,
+	}, nil
+}
+
+func (c *replayingClient) FEval(_ context.Context, _ entities.Logger, request entities.FEvalRequest) (entities.FEvalResponse, error) {
+	var response fevalResponse
+	if err := c.player.play(KindFEval, newFEvalRequest(request), &response); err != nil {
+		return entities.FEvalResponse{}, err
+	}
+
+	return entities.FEvalResponse{
+		Outputs: response.Outputs,
+	}, nil
+}
+
+// Interrupt replays the recorded interrupts. Interrupts depend on the timing of the calls, so an interrupt that was
+// not recorded succeeds.
+func (c *replayingClient) Interrupt(_ context.Context, _ entities.Logger) error {
+	interaction, found, err := c.player.next(KindInterrupt, nil)
+	if err != nil || !found || interaction.Error == nil {
+		return err
+	}
+	return entities.NewCodedError(interaction.Error.Code, errors.New(interaction.Error.Message))
+}
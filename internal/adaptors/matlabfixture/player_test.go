@@ -0,0 +1,171 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabfixture_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabfixture"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabfixture"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const fixturePath = "/home/user/fixtures/session.jsonl"
+
+const fixture = `{"kind":"environments","environments":[{"matlab_root":"/opt/matlab/R2025a","version":"R2025a"}]}
+{"kind":"eval","request":{"code":"x = x + 1"},"response":{"console_output":"x = 2"}}
+{"kind":"eval","request":{"code":"x = x + 1"},"response":{"console_output":"x = 3"}}
+
+{"kind":"feval","request":{"function":"plus","arguments":["1", "2"],"num_outputs":1},"response":{"outputs":[3]}}
+{"kind":"eval_with_capture","request":{"code":"error('boom')"},"error":{"code":"MATLAB_ERROR","message":"boom"}}
+`
+
+func newPlayer(t *testing.T, data string) (*matlabfixture.Player, error) {
+	t.Helper()
+
+	mockConfig := &mocks.MockPlayerConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockPlayerOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		ReplayMATLABFile().
+		Return(fixturePath).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(fixturePath).
+		Return([]byte(data), nil).
+		Once()
+
+	return matlabfixture.NewPlayer(mockConfig, mockOSLayer)
+}
+
+func TestPlayer_NewClient_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	player, err := newPlayer(t, fixture)
+	require.NoError(t, err)
+
+	client := player.NewClient()
+
+	// Act
+	first, firstErr := client.Eval(t.Context(), mockLogger, entities.EvalRequest{Code: "x = x + 1"})
+	feval, fevalErr := client.FEval(t.Context(), mockLogger, entities.FEvalRequest{Function: "plus", Arguments: []string{"1", "2"}, NumOutputs: 1})
+	second, secondErr := player.NewClient().Eval(t.Context(), mockLogger, entities.EvalRequest{Code: "x = x + 1"})
+	_, exhaustedErr := client.Eval(t.Context(), mockLogger, entities.EvalRequest{Code: "x = x + 1"})
+	_, recordedErr := client.EvalWithCapture(t.Context(), mockLogger, entities.EvalRequest{Code: "error('boom')"})
+	interruptErr := client.Interrupt(t.Context(), mockLogger)
+
+	// Assert
+	require.True(t, player.Enabled())
+
+	require.NoError(t, firstErr)
+	assert.Equal(t, "x = 2", first.ConsoleOutput)
+
+	require.NoError(t, fevalErr)
+	assert.Equal(t, []any{float64(3)}, feval.Outputs)
+
+	require.NoError(t, secondErr)
+	assert.Equal(t, "x = 3", second.ConsoleOutput, "Repeated requests should be answered in the recorded order")
+
+	require.Error(t, exhaustedErr)
+	assert.Contains(t, exhaustedErr.Error(), "no response to this eval request is left")
+
+	require.EqualError(t, recordedErr, "boom")
+	assert.Equal(t, entities.ErrorCodeMATLABError, entities.ErrorCodeOf(recordedErr))
+
+	require.NoError(t, interruptErr, "An interrupt that was not recorded should succeed")
+}
+
+func TestPlayer_Environments_HappyPath(t *testing.T) {
+	// Arrange
+	player, err := newPlayer(t, fixture)
+	require.NoError(t, err)
+
+	// Act
+	environments := player.Environments()
+
+	// Assert
+	assert.Equal(t, []entities.EnvironmentInfo{{MATLABRoot: "/opt/matlab/R2025a", Version: "R2025a"}}, environments)
+}
+
+func TestPlayer_Environments_NotRecorded(t *testing.T) {
+	// Arrange
+	player, err := newPlayer(t, `{"kind":"eval","request":{"code":"1"},"response":{"console_output":"ans = 1"}}`)
+	require.NoError(t, err)
+
+	// Act
+	environments := player.Environments()
+
+	// Assert
+	assert.Equal(t, []entities.EnvironmentInfo{{MATLABRoot: fixturePath, Version: "fixture"}}, environments)
+}
+
+func TestPlayer_New_InvalidLine(t *testing.T) {
+	// Arrange
+	data := `{"kind":"eval","request":{"code":"1"},"response":{"console_output":"ans = 1"}}
+not json
+`
+
+	// Act
+	player, err := newPlayer(t, data)
+
+	// Assert
+	require.ErrorContains(t, err, "invalid line 2 of MATLAB fixture")
+	assert.Nil(t, player)
+}
+
+func TestPlayer_New_ReadError(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockPlayerConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockPlayerOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		ReplayMATLABFile().
+		Return(fixturePath).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(fixturePath).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	player, err := matlabfixture.NewPlayer(mockConfig, mockOSLayer)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, player)
+}
+
+func TestPlayer_Enabled_Disabled(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockPlayerConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockPlayerOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		ReplayMATLABFile().
+		Return("").
+		Once()
+
+	player, err := matlabfixture.NewPlayer(mockConfig, mockOSLayer)
+	require.NoError(t, err)
+
+	// Act
+	enabled := player.Enabled()
+
+	// Assert
+	assert.False(t, enabled)
+}
@@ -0,0 +1,231 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabfixture
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+)
+
+type RecorderConfig interface {
+	RecordMATLABFile() string
+}
+
+type RecorderOSLayer interface {
+	Create(name string) (osfacade.File, error)
+}
+
+type LoggerFactory interface {
+	GetGlobalLogger() entities.Logger
+}
+
+type LifecycleSignaler interface {
+	AddShutdownFunction(shutdownFcn func() error)
+}
+
+type Redactor interface {
+	Enabled() bool
+	Redact(text string) (string, int)
+}
+
+// Recorder writes the requests to the MATLAB sessions, with the responses of MATLAB, to a fixture that the player
+// serves instead of MATLAB.
+type Recorder struct {
+	logger   entities.Logger
+	redactor Redactor
+
+	lock *sync.Mutex
+	file osfacade.File
+}
+
+func NewRecorder(
+	config RecorderConfig,
+	osLayer RecorderOSLayer,
+	loggerFactory LoggerFactory,
+	lifecycleSignaler LifecycleSignaler,
+	redactor Redactor,
+) (*Recorder, error) {
+	recorder := &Recorder{
+		logger:   loggerFactory.GetGlobalLogger().With("component", "matlab-recorder"),
+		redactor: redactor,
+		lock:     new(sync.Mutex),
+	}
+
+	path := config.RecordMATLABFile()
+	if path == "" {
+		return recorder, nil
+	}
+
+	file, err := osLayer.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create MATLAB fixture: %w", err)
+	}
+	recorder.file = file
+
+	lifecycleSignaler.AddShutdownFunction(recorder.close)
+
+	recorder.logger.With("path", path).Info("Recording the MATLAB sessions")
+
+	return recorder, nil
+}
+
+// Record returns a client recording the requests to client, and its responses. The client is returned as is when
+// the MATLAB sessions are not recorded.
+func (r *Recorder) Record(client entities.MATLABSessionClient) entities.MATLABSessionClient {
+	if r.file == nil {
+		return client
+	}
+
+	return &recordingClient{
+		client:   client,
+		recorder: r,
+	}
+}
+
+// RecordEnvironments records the MATLAB environments found on the machine, so that the player lists them.
+func (r *Recorder) RecordEnvironments(environments []entities.EnvironmentInfo) {
+	if r.file == nil {
+		return
+	}
+
+	recorded := make([]Environment, 0, len(environments))
+	for _, environment := range environments {
+		recorded = append(recorded, Environment{
+			MATLABRoot: environment.MATLABRoot,
+			Version:    environment.Version,
+		})
+	}
+
+	r.append(Interaction{Kind: KindEnvironments, Environments: recorded})
+}
+
+func (r *Recorder) recordCall(kind Kind, request any, response any, callErr error) {
+	interaction := Interaction{
+		Kind: kind,
+	}
+
+	if request != nil {
+		data, err := json.Marshal(request)
+		if err != nil {
+			r.logger.WithError(err).Warn("Failed to serialize MATLAB request for the fixture")
+			return
+		}
+		interaction.Request = data
+	}
+
+	if callErr != nil {
+		interaction.Error = &Error{
+			Code:    entities.ErrorCodeOf(callErr),
+			Message: r.redact(callErr.Error()),
+		}
+	} else if response != nil {
+		data, err := json.Marshal(response)
+		if err != nil {
+			r.logger.WithError(err).Warn("Failed to serialize MATLAB response for the fixture")
+			return
+		}
+		interaction.Response = data
+	}
+
+	r.append(interaction)
+}
+
+// append writes an interaction to the fixture. Failing to record is logged, and does not fail the request.
+func (r *Recorder) append(interaction Interaction) {
+	line, err := json.Marshal(interaction)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to write MATLAB fixture")
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.file == nil {
+		return
+	}
+
+	if _, err := r.file.Write(append(line, '\n')); err != nil {
+		r.logger.WithError(err).Error("Failed to write MATLAB fixture")
+	}
+}
+
+func (r *Recorder) redact(text string) string {
+	if !r.redactor.Enabled() {
+		return text
+	}
+	redacted, _ := r.redactor.Redact(text)
+	return redacted
+}
+
+// redactOutputs redacts the text outputs of a function, so that fixtures shipped with bug reports do not hold the
+// secrets that --redact-output removes from tool results.
+func (r *Recorder) redactOutputs(outputs []any) []any {
+	if !r.redactor.Enabled() {
+		return outputs
+	}
+
+	redacted := make([]any, 0, len(outputs))
+	for _, output := range outputs {
+		if text, ok := output.(string); ok {
+			output = r.redact(text)
+		}
+		redacted = append(redacted, output)
+	}
+	return redacted
+}
+
+func (r *Recorder) close() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.file == nil {
+		return nil
+	}
+
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+type recordingClient struct {
+	client   entities.MATLABSessionClient
+	recorder *Recorder
+}
+
+func (c *recordingClient) Eval(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) (entities.EvalResponse, error) {
+	response, err := c.client.Eval(ctx, sessionLogger, request)
+	c.recorder.recordCall(KindEval, newEvalRequest(request), evalResponse{
+		ConsoleOutput: c.recorder.redact(response.ConsoleOutput),
+		Images:        response.Images,
+	}, err)
+	return response, err
+}
+
+func (c *recordingClient) EvalWithCapture(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) (entities.EvalResponse, error) {
+	response, err := c.client.EvalWithCapture(ctx, sessionLogger, request)
+	c.recorder.recordCall(KindEvalWithCapture, newEvalRequest(request), evalResponse{
+		ConsoleOutput: c.recorder.redact(response.ConsoleOutput),
+		Images:        response.Images,
+	}, err)
+	return response, err
+}
+
+func (c *recordingClient) FEval(ctx context.Context, sessionLogger entities.Logger, request entities.FEvalRequest) (entities.FEvalResponse, error) {
+	response, err := c.client.FEval(ctx, sessionLogger, request)
+	c.recorder.recordCall(KindFEval, newFEvalRequest(request), fevalResponse{
+		Outputs: c.recorder.redactOutputs(response.Outputs),
+	}, err)
+	return response, err
+}
+
+func (c *recordingClient) Interrupt(ctx context.Context, sessionLogger entities.Logger) error {
+	err := c.client.Interrupt(ctx, sessionLogger)
+	c.recorder.recordCall(KindInterrupt, nil, nil, err)
+	return err
+}
@@ -0,0 +1,287 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabfixture_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabfixture"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabfixture"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newRecorder returns a recorder writing to a file in a temporary folder, the path of that file, and the shutdown
+// function closing it.
+func newRecorder(t *testing.T, redactor *mocks.MockRedactor) (*matlabfixture.Recorder, string, func() error) {
+	t.Helper()
+
+	mockConfig := &mocks.MockRecorderConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockRecorderOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	path := filepath.Join(t.TempDir(), "fixture.jsonl")
+	file, err := os.Create(path)
+	require.NoError(t, err)
+
+	mockConfig.EXPECT().
+		RecordMATLABFile().
+		Return(path).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Create(path).
+		Return(&osfacade.FileWrapper{File: file}, nil).
+		Once()
+
+	var shutdown func() error
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.Anything).
+		Run(func(shutdownFcn func() error) {
+			shutdown = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	recorder, err := matlabfixture.NewRecorder(mockConfig, mockOSLayer, mockLoggerFactory, mockLifecycleSignaler, redactor)
+	require.NoError(t, err)
+
+	return recorder, path, shutdown
+}
+
+func TestRecorder_Record_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockRedactor := &mocks.MockRedactor{}
+	defer mockRedactor.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockRedactor.EXPECT().
+		Enabled().
+		Return(false)
+
+	evalRequest := entities.EvalRequest{Code: "x = 1"}
+	evalResponse := entities.EvalResponse{ConsoleOutput: "x = 1"}
+	fevalRequest := entities.FEvalRequest{Function: "plus", Arguments: []string{"1", "2"}, NumOutputs: 1}
+	fevalResponse := entities.FEvalResponse{Outputs: []any{float64(3)}}
+
+	mockClient.EXPECT().
+		Eval(t.Context(), mockLogger.AsMockArg(), evalRequest).
+		Return(evalResponse, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(t.Context(), mockLogger.AsMockArg(), fevalRequest).
+		Return(fevalResponse, nil).
+		Once()
+
+	mockClient.EXPECT().
+		EvalWithCapture(t.Context(), mockLogger.AsMockArg(), entities.EvalRequest{Code: "error('boom')"}).
+		Return(entities.EvalResponse{}, entities.NewCodedError(entities.ErrorCodeMATLABError, assert.AnError)).
+		Once()
+
+	recorder, path, shutdown := newRecorder(t, mockRedactor)
+	recorder.RecordEnvironments([]entities.EnvironmentInfo{{MATLABRoot: "/opt/matlab/R2025a", Version: "R2025a"}})
+	client := recorder.Record(mockClient)
+
+	// Act
+	gotEval, evalErr := client.Eval(t.Context(), mockLogger, evalRequest)
+	gotFEval, fevalErr := client.FEval(t.Context(), mockLogger, fevalRequest)
+	_, captureErr := client.EvalWithCapture(t.Context(), mockLogger, entities.EvalRequest{Code: "error('boom')"})
+	require.NoError(t, shutdown())
+
+	// Assert
+	require.NoError(t, evalErr)
+	require.NoError(t, fevalErr)
+	require.Error(t, captureErr)
+	assert.Equal(t, evalResponse, gotEval)
+	assert.Equal(t, fevalResponse, gotFEval)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 4)
+
+	var interactions []matlabfixture.Interaction
+	for _, line := range lines {
+		var interaction matlabfixture.Interaction
+		require.NoError(t, json.Unmarshal([]byte(line), &interaction))
+		interactions = append(interactions, interaction)
+	}
+
+	assert.Equal(t, matlabfixture.KindEnvironments, interactions[0].Kind)
+	assert.Equal(t, []matlabfixture.Environment{{MATLABRoot: "/opt/matlab/R2025a", Version: "R2025a"}}, interactions[0].Environments)
+
+	assert.Equal(t, matlabfixture.KindEval, interactions[1].Kind)
+	assert.JSONEq(t, `{"code":"x = 1"}`, string(interactions[1].Request))
+	assert.JSONEq(t, `{"console_output":"x = 1"}`, string(interactions[1].Response))
+
+	assert.Equal(t, matlabfixture.KindFEval, interactions[2].Kind)
+	assert.JSONEq(t, `{"function":"plus","arguments":["1","2"],"num_outputs":1}`, string(interactions[2].Request))
+	assert.JSONEq(t, `{"outputs":[3]}`, string(interactions[2].Response))
+
+	assert.Equal(t, matlabfixture.KindEvalWithCapture, interactions[3].Kind)
+	require.NotNil(t, interactions[3].Error)
+	assert.Equal(t, entities.ErrorCodeMATLABError, interactions[3].Error.Code)
+	assert.Empty(t, interactions[3].Response)
+}
+
+func TestRecorder_Record_RedactsResponses(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockRedactor := &mocks.MockRedactor{}
+	defer mockRedactor.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockRedactor.EXPECT().
+		Enabled().
+		Return(true)
+
+	mockRedactor.EXPECT().
+		Redact("token = abc123").
+		Return("token = [REDACTED]", 1).
+		Twice()
+
+	evalRequest := entities.EvalRequest{Code: "token"}
+	fevalRequest := entities.FEvalRequest{Function: "getenv", Arguments: []string{"'TOKEN'"}, NumOutputs: 1}
+
+	mockClient.EXPECT().
+		Eval(t.Context(), mockLogger.AsMockArg(), evalRequest).
+		Return(entities.EvalResponse{ConsoleOutput: "token = abc123"}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(t.Context(), mockLogger.AsMockArg(), fevalRequest).
+		Return(entities.FEvalResponse{Outputs: []any{"token = abc123"}}, nil).
+		Once()
+
+	recorder, path, shutdown := newRecorder(t, mockRedactor)
+	client := recorder.Record(mockClient)
+
+	// Act
+	gotEval, evalErr := client.Eval(t.Context(), mockLogger, evalRequest)
+	_, fevalErr := client.FEval(t.Context(), mockLogger, fevalRequest)
+	require.NoError(t, shutdown())
+
+	// Assert
+	require.NoError(t, evalErr)
+	require.NoError(t, fevalErr)
+	assert.Equal(t, "token = abc123", gotEval.ConsoleOutput, "The caller should get the response of MATLAB")
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "abc123")
+	assert.Contains(t, string(data), "token = [REDACTED]")
+}
+
+func TestRecorder_Record_Disabled(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockRecorderConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockRecorderOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockRedactor := &mocks.MockRedactor{}
+	defer mockRedactor.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		RecordMATLABFile().
+		Return("").
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	recorder, err := matlabfixture.NewRecorder(mockConfig, mockOSLayer, mockLoggerFactory, mockLifecycleSignaler, mockRedactor)
+	require.NoError(t, err)
+
+	// Act
+	client := recorder.Record(mockClient)
+	recorder.RecordEnvironments([]entities.EnvironmentInfo{{MATLABRoot: "/opt/matlab/R2025a", Version: "R2025a"}})
+
+	// Assert
+	assert.Same(t, mockClient, client)
+}
+
+func TestRecorder_New_CreateError(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockRecorderConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockRecorderOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockRedactor := &mocks.MockRedactor{}
+	defer mockRedactor.AssertExpectations(t)
+
+	path := "/home/user/fixtures/session.jsonl"
+
+	mockConfig.EXPECT().
+		RecordMATLABFile().
+		Return(path).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Create(path).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	recorder, err := matlabfixture.NewRecorder(mockConfig, mockOSLayer, mockLoggerFactory, mockLifecycleSignaler, mockRedactor)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, recorder)
+}
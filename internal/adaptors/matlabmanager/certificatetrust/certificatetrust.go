@@ -0,0 +1,141 @@
+// Copyright 2025 The MathWorks, Inc.
+
+// Package certificatetrust decides whether the certificate of the embedded connector of a new MATLAB session is trusted,
+// when strict TLS is enabled.
+package certificatetrust
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/elicitation"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/i18n"
+)
+
+// connectorHost is the host the MATLAB sessions are reached on, so the host their certificates must be issued for.
+const connectorHost = "localhost"
+
+type Config interface {
+	StrictTLS() bool
+}
+
+// CertificateTrust validates the fresh certificate the embedded connector creates each time MATLAB starts.
+// The first certificate is only trusted once the user confirmed its fingerprint. MATLAB creates a new certificate on
+// every restart, and the later certificates replace the trusted one once they are validated.
+type CertificateTrust struct {
+	strict bool
+	now    func() time.Time
+
+	lock               sync.Mutex
+	trustedFingerprint string
+}
+
+func New(
+	config Config,
+) *CertificateTrust {
+	return &CertificateTrust{
+		strict: config.StrictTLS(),
+		now:    time.Now,
+	}
+}
+
+// Trust returns an error if the certificate must not be trusted for the connection to a new MATLAB session.
+// It always trusts the certificate when strict TLS is disabled.
+func (t *CertificateTrust) Trust(ctx context.Context, logger entities.Logger, certificatePEM []byte) error {
+	if !t.strict {
+		return nil
+	}
+
+	certificate, err := t.validate(certificatePEM)
+	if err != nil {
+		return entities.NewCodedError(entities.ErrorCodePermissionDenied, fmt.Errorf("the certificate of the MATLAB session is not valid: %w", err))
+	}
+
+	fingerprint := fingerprint(certificate)
+	logger = logger.With("certificate_fingerprint", fingerprint)
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	switch t.trustedFingerprint {
+	case fingerprint:
+		logger.Debug("MATLAB session certificate already trusted")
+		return nil
+	case "":
+		if err := confirm(ctx, logger, fingerprint); err != nil {
+			return err
+		}
+		logger.Info("Trusted MATLAB session certificate")
+	default:
+		logger.With("previous_certificate_fingerprint", t.trustedFingerprint).Info("Rotated MATLAB session certificate")
+	}
+
+	t.trustedFingerprint = fingerprint
+	return nil
+}
+
+func (t *CertificateTrust) validate(certificatePEM []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(certificatePEM)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("no PEM encoded certificate found")
+	}
+
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse certificate: %w", err)
+	}
+
+	now := t.now()
+	if now.Before(certificate.NotBefore) {
+		return nil, fmt.Errorf("certificate not valid before %v", certificate.NotBefore)
+	}
+	if now.After(certificate.NotAfter) {
+		return nil, fmt.Errorf("certificate expired on %v", certificate.NotAfter)
+	}
+
+	if err := certificate.VerifyHostname(connectorHost); err != nil {
+		return nil, err
+	}
+
+	return certificate, nil
+}
+
+// confirm asks the user to confirm the fingerprint of the first certificate trusted.
+// When the client cannot ask its user, such as when MATLAB starts with the server, the fingerprint is logged instead.
+func confirm(ctx context.Context, logger entities.Logger, fingerprint string) error {
+	locale := i18n.FromContext(ctx)
+	approved, err := elicitation.Confirm(ctx, i18n.Translate(locale, i18n.MessageTrustCertificate, fingerprint))
+	if errors.Is(err, elicitation.ErrNotSupported) {
+		logger.Warn("Trusting MATLAB session certificate without confirmation, as the MCP client cannot ask for it")
+		return nil
+	}
+	if err != nil {
+		return entities.NewCodedError(entities.ErrorCodePermissionDenied, fmt.Errorf("failed to request the confirmation of the MATLAB session certificate: %w", err))
+	}
+
+	if !approved {
+		return entities.NewCodedError(entities.ErrorCodePermissionDenied, errors.New(i18n.Translate(locale, i18n.MessageCertificateNotTrusted)))
+	}
+
+	return nil
+}
+
+// fingerprint returns the SHA-256 fingerprint of certificate, as colon separated hexadecimal bytes.
+func fingerprint(certificate *x509.Certificate) string {
+	sum := sha256.Sum256(certificate.Raw)
+
+	parts := make([]string, len(sum))
+	for i, b := range sum {
+		parts[i] = fmt.Sprintf("%02X", b)
+	}
+
+	return strings.Join(parts, ":")
+}
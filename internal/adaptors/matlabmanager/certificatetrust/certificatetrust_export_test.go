@@ -0,0 +1,9 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package certificatetrust
+
+import "time"
+
+func (t *CertificateTrust) SetNow(now func() time.Time) {
+	t.now = now
+}
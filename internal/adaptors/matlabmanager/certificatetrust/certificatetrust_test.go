@@ -0,0 +1,245 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package certificatetrust_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/certificatetrust"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/elicitation"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/certificatetrust"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var now = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		StrictTLS().
+		Return(true).
+		Once()
+
+	// Act
+	trust := certificatetrust.New(mockConfig)
+
+	// Assert
+	assert.NotNil(t, trust)
+}
+
+func TestCertificateTrust_Trust_NotStrict(t *testing.T) {
+	// Arrange
+	trust := newCertificateTrust(t, false)
+
+	// Act
+	err := trust.Trust(t.Context(), testutils.NewInspectableLogger(), []byte("not a certificate"))
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestCertificateTrust_Trust_FirstTrustConfirmed(t *testing.T) {
+	// Arrange
+	trust := newCertificateTrust(t, true)
+	certificatePEM := newCertificate(t, "localhost", now.Add(-time.Hour), now.Add(time.Hour))
+
+	var shownMessage string
+	ctx := elicitation.NewContext(t.Context(), func(ctx context.Context, message string) (bool, error) {
+		shownMessage = message
+		return true, nil
+	})
+
+	// Act
+	err := trust.Trust(ctx, testutils.NewInspectableLogger(), certificatePEM)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, shownMessage, fingerprintOf(t, certificatePEM), "Fingerprint should be shown to the user")
+}
+
+func TestCertificateTrust_Trust_FirstTrustRejected(t *testing.T) {
+	// Arrange
+	trust := newCertificateTrust(t, true)
+	certificatePEM := newCertificate(t, "localhost", now.Add(-time.Hour), now.Add(time.Hour))
+
+	ctx := elicitation.NewContext(t.Context(), func(ctx context.Context, message string) (bool, error) {
+		return false, nil
+	})
+
+	// Act
+	err := trust.Trust(ctx, testutils.NewInspectableLogger(), certificatePEM)
+
+	// Assert
+	require.Error(t, err)
+	assert.Equal(t, entities.ErrorCodePermissionDenied, entities.ErrorCodeOf(err))
+
+	// Act + Assert to check the rejected certificate was not trusted
+	confirmed := false
+	ctx = elicitation.NewContext(t.Context(), func(ctx context.Context, message string) (bool, error) {
+		confirmed = true
+		return true, nil
+	})
+	require.NoError(t, trust.Trust(ctx, testutils.NewInspectableLogger(), certificatePEM))
+	assert.True(t, confirmed, "Confirmation should be requested again")
+}
+
+func TestCertificateTrust_Trust_ElicitationNotSupported(t *testing.T) {
+	// Arrange
+	trust := newCertificateTrust(t, true)
+	certificatePEM := newCertificate(t, "localhost", now.Add(-time.Hour), now.Add(time.Hour))
+	logger := testutils.NewInspectableLogger()
+
+	// Act
+	err := trust.Trust(t.Context(), logger, certificatePEM)
+
+	// Assert
+	require.NoError(t, err)
+	fields, found := logger.WarnLogs()["Trusting MATLAB session certificate without confirmation, as the MCP client cannot ask for it"]
+	require.True(t, found, "Fingerprint should be logged when it cannot be confirmed")
+	assert.Equal(t, fingerprintOf(t, certificatePEM), fields["certificate_fingerprint"])
+}
+
+func TestCertificateTrust_Trust_RotatedCertificate(t *testing.T) {
+	// Arrange
+	trust := newCertificateTrust(t, true)
+	firstCertificatePEM := newCertificate(t, "localhost", now.Add(-time.Hour), now.Add(time.Hour))
+	rotatedCertificatePEM := newCertificate(t, "localhost", now.Add(-time.Minute), now.Add(time.Hour))
+
+	confirmations := 0
+	ctx := elicitation.NewContext(t.Context(), func(ctx context.Context, message string) (bool, error) {
+		confirmations++
+		return true, nil
+	})
+	require.NoError(t, trust.Trust(ctx, testutils.NewInspectableLogger(), firstCertificatePEM))
+
+	logger := testutils.NewInspectableLogger()
+
+	// Act
+	err := trust.Trust(ctx, logger, rotatedCertificatePEM)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, confirmations, "Only the first certificate should be confirmed")
+	fields, found := logger.InfoLogs()["Rotated MATLAB session certificate"]
+	require.True(t, found)
+	assert.Equal(t, fingerprintOf(t, rotatedCertificatePEM), fields["certificate_fingerprint"])
+	assert.Equal(t, fingerprintOf(t, firstCertificatePEM), fields["previous_certificate_fingerprint"])
+}
+
+func TestCertificateTrust_Trust_InvalidCertificate(t *testing.T) {
+	testCases := []struct {
+		name           string
+		certificatePEM func(t *testing.T) []byte
+	}{
+		{
+			name:           "not PEM",
+			certificatePEM: func(t *testing.T) []byte { return []byte("not a certificate") },
+		},
+		{
+			name: "expired",
+			certificatePEM: func(t *testing.T) []byte {
+				return newCertificate(t, "localhost", now.Add(-2*time.Hour), now.Add(-time.Minute))
+			},
+		},
+		{
+			name: "not valid yet",
+			certificatePEM: func(t *testing.T) []byte {
+				return newCertificate(t, "localhost", now.Add(time.Minute), now.Add(time.Hour))
+			},
+		},
+		{
+			name: "other host",
+			certificatePEM: func(t *testing.T) []byte {
+				return newCertificate(t, "example.com", now.Add(-time.Hour), now.Add(time.Hour))
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			trust := newCertificateTrust(t, true)
+			ctx := elicitation.NewContext(t.Context(), func(ctx context.Context, message string) (bool, error) {
+				t.Fatal("Invalid certificate should not be shown to the user")
+				return false, nil
+			})
+
+			// Act
+			err := trust.Trust(ctx, testutils.NewInspectableLogger(), testCase.certificatePEM(t))
+
+			// Assert
+			require.Error(t, err)
+			assert.Equal(t, entities.ErrorCodePermissionDenied, entities.ErrorCodeOf(err))
+		})
+	}
+}
+
+func newCertificateTrust(t *testing.T, strict bool) *certificatetrust.CertificateTrust {
+	t.Helper()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		StrictTLS().
+		Return(strict).
+		Once()
+
+	trust := certificatetrust.New(mockConfig)
+	trust.SetNow(func() time.Time { return now })
+	return trust
+}
+
+func newCertificate(t *testing.T, host string, notBefore time.Time, notAfter time.Time) []byte {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: host},
+		DNSNames:     []string{host},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func fingerprintOf(t *testing.T, certificatePEM []byte) string {
+	t.Helper()
+
+	block, _ := pem.Decode(certificatePEM)
+	require.NotNil(t, block)
+
+	sum := sha256.Sum256(block.Bytes)
+	fingerprint := ""
+	for i, b := range sum {
+		if i > 0 {
+			fingerprint += ":"
+		}
+		fingerprint += fmt.Sprintf("%02X", b)
+	}
+	return fingerprint
+}
@@ -0,0 +1,24 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabmanager
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// FindMATLABSession returns the ID of the session named session, or, when session is a number, the ID of the session
+// with this ID. Names start with a letter, so a session cannot be both.
+func (m *MATLABManager) FindMATLABSession(_ context.Context, _ entities.Logger, session string) (entities.SessionID, error) {
+	if id, err := strconv.Atoi(session); err == nil {
+		sessionID := entities.SessionID(id)
+		if _, err := m.sessionStore.Get(sessionID); err != nil {
+			return 0, err
+		}
+		return sessionID, nil
+	}
+
+	return m.sessionStore.Find(session)
+}
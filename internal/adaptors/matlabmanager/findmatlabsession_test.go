@@ -0,0 +1,139 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabmanager_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager"
+	sessionstoremocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMATLABManager_FindMATLABSession_ByID(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockCertificateTrust := &mocks.MockCertificateTrust{}
+	defer mockCertificateTrust.AssertExpectations(t)
+
+	mockUsageRecorder := &mocks.MockUsageRecorder{}
+	defer mockUsageRecorder.AssertExpectations(t)
+
+	mockMATLABRecorder := &mocks.MockMATLABRecorder{}
+	defer mockMATLABRecorder.AssertExpectations(t)
+
+	mockMATLABPlayer := &mocks.MockMATLABPlayer{}
+	defer mockMATLABPlayer.AssertExpectations(t)
+
+	mockSessionClient := &sessionstoremocks.MockMATLABSessionClientWithCleanup{}
+
+	mockSessionStore.EXPECT().
+		Get(entities.SessionID(2)).
+		Return(mockSessionClient, nil).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockCertificateTrust, mockUsageRecorder, mockMATLABRecorder, mockMATLABPlayer)
+
+	// Act
+	sessionID, err := manager.FindMATLABSession(t.Context(), mockLogger, "2")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, entities.SessionID(2), sessionID)
+}
+
+func TestMATLABManager_FindMATLABSession_ByName(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockCertificateTrust := &mocks.MockCertificateTrust{}
+	defer mockCertificateTrust.AssertExpectations(t)
+
+	mockUsageRecorder := &mocks.MockUsageRecorder{}
+	defer mockUsageRecorder.AssertExpectations(t)
+
+	mockMATLABRecorder := &mocks.MockMATLABRecorder{}
+	defer mockMATLABRecorder.AssertExpectations(t)
+
+	mockMATLABPlayer := &mocks.MockMATLABPlayer{}
+	defer mockMATLABPlayer.AssertExpectations(t)
+
+	mockSessionStore.EXPECT().
+		Find("analysis").
+		Return(entities.SessionID(3), nil).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockCertificateTrust, mockUsageRecorder, mockMATLABRecorder, mockMATLABPlayer)
+
+	// Act
+	sessionID, err := manager.FindMATLABSession(t.Context(), mockLogger, "analysis")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, entities.SessionID(3), sessionID)
+}
+
+func TestMATLABManager_FindMATLABSession_UnknownID(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockCertificateTrust := &mocks.MockCertificateTrust{}
+	defer mockCertificateTrust.AssertExpectations(t)
+
+	mockUsageRecorder := &mocks.MockUsageRecorder{}
+	defer mockUsageRecorder.AssertExpectations(t)
+
+	mockMATLABRecorder := &mocks.MockMATLABRecorder{}
+	defer mockMATLABRecorder.AssertExpectations(t)
+
+	mockMATLABPlayer := &mocks.MockMATLABPlayer{}
+	defer mockMATLABPlayer.AssertExpectations(t)
+
+	expectedError := entities.NewCodedError(entities.ErrorCodeSessionNotFound, assert.AnError)
+
+	mockSessionStore.EXPECT().
+		Get(entities.SessionID(7)).
+		Return(nil, expectedError).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockCertificateTrust, mockUsageRecorder, mockMATLABRecorder, mockMATLABPlayer)
+
+	// Act
+	sessionID, err := manager.FindMATLABSession(t.Context(), mockLogger, "7")
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, sessionID)
+}
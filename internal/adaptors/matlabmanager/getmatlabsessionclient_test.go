@@ -27,6 +27,18 @@ func TestMATLABManager_GetMATLABSessionClient_HappyPath(t *testing.T) {
 	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
 	defer mockClientFactory.AssertExpectations(t)
 
+	mockCertificateTrust := &mocks.MockCertificateTrust{}
+	defer mockCertificateTrust.AssertExpectations(t)
+
+	mockUsageRecorder := &mocks.MockUsageRecorder{}
+	defer mockUsageRecorder.AssertExpectations(t)
+
+	mockMATLABRecorder := &mocks.MockMATLABRecorder{}
+	defer mockMATLABRecorder.AssertExpectations(t)
+
+	mockMATLABPlayer := &mocks.MockMATLABPlayer{}
+	defer mockMATLABPlayer.AssertExpectations(t)
+
 	mockSessionClient := &sessionstoremocks.MockMATLABSessionClientWithCleanup{}
 
 	sessionID := entities.SessionID(123)
@@ -37,7 +49,7 @@ func TestMATLABManager_GetMATLABSessionClient_HappyPath(t *testing.T) {
 		Return(mockSessionClient, nil).
 		Once()
 
-	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory)
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockCertificateTrust, mockUsageRecorder, mockMATLABRecorder, mockMATLABPlayer)
 
 	// Act
 	client, err := manager.GetMATLABSessionClient(ctx, mockLogger, sessionID)
@@ -60,6 +72,18 @@ func TestMATLABManager_GetMATLABSessionClient_SessionStoreError(t *testing.T) {
 	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
 	defer mockClientFactory.AssertExpectations(t)
 
+	mockCertificateTrust := &mocks.MockCertificateTrust{}
+	defer mockCertificateTrust.AssertExpectations(t)
+
+	mockUsageRecorder := &mocks.MockUsageRecorder{}
+	defer mockUsageRecorder.AssertExpectations(t)
+
+	mockMATLABRecorder := &mocks.MockMATLABRecorder{}
+	defer mockMATLABRecorder.AssertExpectations(t)
+
+	mockMATLABPlayer := &mocks.MockMATLABPlayer{}
+	defer mockMATLABPlayer.AssertExpectations(t)
+
 	sessionID := entities.SessionID(123)
 	ctx := t.Context()
 	expectedError := assert.AnError
@@ -69,7 +93,7 @@ func TestMATLABManager_GetMATLABSessionClient_SessionStoreError(t *testing.T) {
 		Return(nil, expectedError).
 		Once()
 
-	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory)
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockCertificateTrust, mockUsageRecorder, mockMATLABRecorder, mockMATLABPlayer)
 
 	// Act
 	client, err := manager.GetMATLABSessionClient(ctx, mockLogger, sessionID)
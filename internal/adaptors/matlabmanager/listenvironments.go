@@ -9,6 +9,10 @@ import (
 )
 
 func (m *MATLABManager) ListEnvironments(_ context.Context, sessionLogger entities.Logger) []entities.EnvironmentInfo {
+	if m.matlabPlayer.Enabled() {
+		return m.matlabPlayer.Environments()
+	}
+
 	sessionLogger.Debug("Calling ListDiscoveredMatlabInfo on MATLAB Manager")
 
 	matlabInfos := m.matlabServices.ListDiscoveredMatlabInfo(sessionLogger)
@@ -23,5 +27,7 @@ func (m *MATLABManager) ListEnvironments(_ context.Context, sessionLogger entiti
 		})
 	}
 
+	m.matlabRecorder.RecordEnvironments(info)
+
 	return info
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager"
 	"github.com/stretchr/testify/assert"
@@ -26,6 +27,18 @@ func TestMATLABManager_ListEnvironments_HappyPath(t *testing.T) {
 	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
 	defer mockClientFactory.AssertExpectations(t)
 
+	mockCertificateTrust := &mocks.MockCertificateTrust{}
+	defer mockCertificateTrust.AssertExpectations(t)
+
+	mockUsageRecorder := &mocks.MockUsageRecorder{}
+	defer mockUsageRecorder.AssertExpectations(t)
+
+	mockMATLABRecorder := &mocks.MockMATLABRecorder{}
+	defer mockMATLABRecorder.AssertExpectations(t)
+
+	mockMATLABPlayer := &mocks.MockMATLABPlayer{}
+	defer mockMATLABPlayer.AssertExpectations(t)
+
 	dummyMatlabInfos := []datatypes.MatlabInfo{{
 		Location: "/path/to/matlab/R2023a",
 		Version: datatypes.MatlabVersionInfo{
@@ -51,7 +64,20 @@ func TestMATLABManager_ListEnvironments_HappyPath(t *testing.T) {
 		Return(mockResponse).
 		Once()
 
-	manager := matlabmanager.New(mockMATLABManager, mockSessionStore, mockClientFactory)
+	mockMATLABPlayer.EXPECT().
+		Enabled().
+		Return(false).
+		Once()
+
+	mockMATLABRecorder.EXPECT().
+		RecordEnvironments([]entities.EnvironmentInfo{
+			{MATLABRoot: "/path/to/matlab/R2023a", Version: "R2023a"},
+			{MATLABRoot: "/path/to/matlab/R2022b", Version: "R2022b"},
+		}).
+		Return().
+		Once()
+
+	manager := matlabmanager.New(mockMATLABManager, mockSessionStore, mockClientFactory, mockCertificateTrust, mockUsageRecorder, mockMATLABRecorder, mockMATLABPlayer)
 	ctx := t.Context()
 
 	// Act
@@ -80,6 +106,18 @@ func TestMATLABManager_ListEnvironments_EmptyList(t *testing.T) {
 	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
 	defer mockClientFactory.AssertExpectations(t)
 
+	mockCertificateTrust := &mocks.MockCertificateTrust{}
+	defer mockCertificateTrust.AssertExpectations(t)
+
+	mockUsageRecorder := &mocks.MockUsageRecorder{}
+	defer mockUsageRecorder.AssertExpectations(t)
+
+	mockMATLABRecorder := &mocks.MockMATLABRecorder{}
+	defer mockMATLABRecorder.AssertExpectations(t)
+
+	mockMATLABPlayer := &mocks.MockMATLABPlayer{}
+	defer mockMATLABPlayer.AssertExpectations(t)
+
 	mockResponse := datatypes.ListMatlabInfo{
 		MatlabInfo: []datatypes.MatlabInfo{},
 	}
@@ -88,7 +126,17 @@ func TestMATLABManager_ListEnvironments_EmptyList(t *testing.T) {
 		Return(mockResponse).
 		Once()
 
-	manager := matlabmanager.New(mockMATLABManager, mockSessionStore, mockClientFactory)
+	mockMATLABPlayer.EXPECT().
+		Enabled().
+		Return(false).
+		Once()
+
+	mockMATLABRecorder.EXPECT().
+		RecordEnvironments([]entities.EnvironmentInfo{}).
+		Return().
+		Once()
+
+	manager := matlabmanager.New(mockMATLABManager, mockSessionStore, mockClientFactory, mockCertificateTrust, mockUsageRecorder, mockMATLABRecorder, mockMATLABPlayer)
 	ctx := t.Context()
 
 	// Act
@@ -98,3 +146,52 @@ func TestMATLABManager_ListEnvironments_EmptyList(t *testing.T) {
 	assert.NotNil(t, result)
 	assert.Empty(t, result)
 }
+
+func TestMATLABManager_ListEnvironments_ReplayedFromFixture(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManager := &mocks.MockMATLABServices{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockCertificateTrust := &mocks.MockCertificateTrust{}
+	defer mockCertificateTrust.AssertExpectations(t)
+
+	mockUsageRecorder := &mocks.MockUsageRecorder{}
+	defer mockUsageRecorder.AssertExpectations(t)
+
+	mockMATLABRecorder := &mocks.MockMATLABRecorder{}
+	defer mockMATLABRecorder.AssertExpectations(t)
+
+	mockMATLABPlayer := &mocks.MockMATLABPlayer{}
+	defer mockMATLABPlayer.AssertExpectations(t)
+
+	expectedEnvironments := []entities.EnvironmentInfo{
+		{MATLABRoot: "/path/to/matlab/R2023a", Version: "R2023a"},
+	}
+
+	mockMATLABPlayer.EXPECT().
+		Enabled().
+		Return(true).
+		Once()
+
+	mockMATLABPlayer.EXPECT().
+		Environments().
+		Return(expectedEnvironments).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABManager, mockSessionStore, mockClientFactory, mockCertificateTrust, mockUsageRecorder, mockMATLABRecorder, mockMATLABPlayer)
+	ctx := t.Context()
+
+	// Act
+	result := manager.ListEnvironments(ctx, mockLogger)
+
+	// Assert
+	assert.Equal(t, expectedEnvironments, result)
+}
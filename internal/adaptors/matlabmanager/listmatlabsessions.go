@@ -0,0 +1,13 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabmanager
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+func (m *MATLABManager) ListMATLABSessions(_ context.Context, _ entities.Logger) []entities.SessionInfo {
+	return m.sessionStore.List()
+}
@@ -0,0 +1,57 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabmanager_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMATLABManager_ListMATLABSessions_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockCertificateTrust := &mocks.MockCertificateTrust{}
+	defer mockCertificateTrust.AssertExpectations(t)
+
+	mockUsageRecorder := &mocks.MockUsageRecorder{}
+	defer mockUsageRecorder.AssertExpectations(t)
+
+	mockMATLABRecorder := &mocks.MockMATLABRecorder{}
+	defer mockMATLABRecorder.AssertExpectations(t)
+
+	mockMATLABPlayer := &mocks.MockMATLABPlayer{}
+	defer mockMATLABPlayer.AssertExpectations(t)
+
+	expectedSessions := []entities.SessionInfo{
+		{ID: 1, Name: "analysis", MATLABRoot: "/path/to/matlab/R2023a"},
+		{ID: 2, MATLABRoot: "/path/to/matlab/R2022b"},
+	}
+
+	mockSessionStore.EXPECT().
+		List().
+		Return(expectedSessions).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockCertificateTrust, mockUsageRecorder, mockMATLABRecorder, mockMATLABPlayer)
+
+	// Act
+	sessions := manager.ListMATLABSessions(t.Context(), mockLogger)
+
+	// Assert
+	assert.Equal(t, expectedSessions, sessions)
+}
@@ -3,6 +3,8 @@
 package matlabmanager
 
 import (
+	"context"
+
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
@@ -12,11 +14,14 @@ import (
 type MATLABServices interface {
 	ListDiscoveredMatlabInfo(logger entities.Logger) datatypes.ListMatlabInfo
 	StartLocalMATLABSession(logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, error)
+	ConnectToSharedMATLABSession(logger entities.Logger, request datatypes.SharedSessionDetails) (datatypes.SharedSession, embeddedconnector.ConnectionDetails, func() error, error)
 }
 
 type MATLABSessionStore interface {
-	Add(client matlabsessionstore.MATLABSessionClientWithCleanup) entities.SessionID
+	Add(name string, matlabRoot string, client matlabsessionstore.MATLABSessionClientWithCleanup) (entities.SessionID, error)
 	Get(sessionID entities.SessionID) (matlabsessionstore.MATLABSessionClientWithCleanup, error)
+	Find(name string) (entities.SessionID, error)
+	List() []entities.SessionInfo
 	Remove(sessionID entities.SessionID)
 }
 
@@ -24,10 +29,33 @@ type MATLABSessionClientFactory interface {
 	New(endpoint embeddedconnector.ConnectionDetails) (entities.MATLABSessionClient, error)
 }
 
+type CertificateTrust interface {
+	Trust(ctx context.Context, logger entities.Logger, certificatePEM []byte) error
+}
+
+type UsageRecorder interface {
+	RecordMATLABSessionStarted(matlabRoot string)
+}
+
+type MATLABRecorder interface {
+	Record(client entities.MATLABSessionClient) entities.MATLABSessionClient
+	RecordEnvironments(environments []entities.EnvironmentInfo)
+}
+
+type MATLABPlayer interface {
+	Enabled() bool
+	Environments() []entities.EnvironmentInfo
+	NewClient() entities.MATLABSessionClient
+}
+
 type MATLABManager struct {
-	matlabServices MATLABServices
-	sessionStore   MATLABSessionStore
-	clientFactory  MATLABSessionClientFactory
+	matlabServices   MATLABServices
+	sessionStore     MATLABSessionStore
+	clientFactory    MATLABSessionClientFactory
+	certificateTrust CertificateTrust
+	usageRecorder    UsageRecorder
+	matlabRecorder   MATLABRecorder
+	matlabPlayer     MATLABPlayer
 }
 
 var _ entities.MATLABManager = (*MATLABManager)(nil)
@@ -36,10 +64,18 @@ func New(
 	matlabServices MATLABServices,
 	sessionStore MATLABSessionStore,
 	clientFactory MATLABSessionClientFactory,
+	certificateTrust CertificateTrust,
+	usageRecorder UsageRecorder,
+	matlabRecorder MATLABRecorder,
+	matlabPlayer MATLABPlayer,
 ) *MATLABManager {
 	return &MATLABManager{
-		matlabServices: matlabServices,
-		sessionStore:   sessionStore,
-		clientFactory:  clientFactory,
+		matlabServices:   matlabServices,
+		sessionStore:     sessionStore,
+		clientFactory:    clientFactory,
+		certificateTrust: certificateTrust,
+		usageRecorder:    usageRecorder,
+		matlabRecorder:   matlabRecorder,
+		matlabPlayer:     matlabPlayer,
 	}
 }
@@ -21,8 +21,20 @@ func TestNew_HappyPath(t *testing.T) {
 	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
 	defer mockClientFactory.AssertExpectations(t)
 
+	mockCertificateTrust := &mocks.MockCertificateTrust{}
+	defer mockCertificateTrust.AssertExpectations(t)
+
+	mockUsageRecorder := &mocks.MockUsageRecorder{}
+	defer mockUsageRecorder.AssertExpectations(t)
+
+	mockMATLABRecorder := &mocks.MockMATLABRecorder{}
+	defer mockMATLABRecorder.AssertExpectations(t)
+
+	mockMATLABPlayer := &mocks.MockMATLABPlayer{}
+	defer mockMATLABPlayer.AssertExpectations(t)
+
 	// Act
-	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory)
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockCertificateTrust, mockUsageRecorder, mockMATLABRecorder, mockMATLABPlayer)
 
 	// Assert
 	assert.NotNil(t, manager, "MATLABManager should not be nil")
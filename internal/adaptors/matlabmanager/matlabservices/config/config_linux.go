@@ -5,3 +5,8 @@ package config
 const (
 	MATLABExeName = "matlab"
 )
+
+// StandardInstallationPatterns match the folders where the MATLAB installer installs MATLAB by default.
+var StandardInstallationPatterns = []string{
+	"/usr/local/MATLAB/R*",
+}
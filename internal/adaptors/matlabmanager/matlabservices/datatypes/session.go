@@ -9,3 +9,14 @@ type LocalSessionDetails struct {
 	StartingDirectory string
 	ShowMATLABDesktop bool
 }
+
+type SharedSessionDetails struct {
+	SessionFile string
+}
+
+// SharedSession is a MATLAB session shared by the user, which the server connected to.
+type SharedSession struct {
+	MATLABRoot string
+	// SessionDir is the folder of the MATLAB files of the server, to add to the path of the shared MATLAB.
+	SessionDir string
+}
@@ -16,17 +16,24 @@ type LocalMATLABSessionLauncher interface {
 	StartLocalMATLABSession(logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, error)
 }
 
+type SharedMATLABSessionConnector interface {
+	ConnectToSharedMATLABSession(logger entities.Logger, request datatypes.SharedSessionDetails) (datatypes.SharedSession, embeddedconnector.ConnectionDetails, func() error, error)
+}
+
 type MATLABServices struct {
 	MATLABLocator
 	LocalMATLABSessionLauncher
+	SharedMATLABSessionConnector
 }
 
 func New(
 	matlabLocator MATLABLocator,
 	localMATLABSessionLauncher LocalMATLABSessionLauncher,
+	sharedMATLABSessionConnector SharedMATLABSessionConnector,
 ) *MATLABServices {
 	return &MATLABServices{
-		MATLABLocator:              matlabLocator,
-		LocalMATLABSessionLauncher: localMATLABSessionLauncher,
+		MATLABLocator:                matlabLocator,
+		LocalMATLABSessionLauncher:   localMATLABSessionLauncher,
+		SharedMATLABSessionConnector: sharedMATLABSessionConnector,
 	}
 }
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
@@ -26,6 +28,15 @@ type OSLayer interface {
 
 type MATLABFiles interface {
 	GetAll() map[string][]byte
+	GetSandbox() map[string][]byte
+	GetNetworkBlock() map[string][]byte
+}
+
+type Config interface {
+	SandboxEnabled() bool
+	AllowedFunctions() []string
+	BlockNetwork() bool
+	AllowedHosts() []string
 }
 
 type Directory interface {
@@ -40,17 +51,20 @@ type DirectoryFactory struct {
 	osLayer              OSLayer
 	applicationDirectory ApplicationDirectory
 	matlabFiles          MATLABFiles
+	config               Config
 }
 
 func NewFactory(
 	osLayer OSLayer,
 	applicationDirectory ApplicationDirectory,
 	matlabFiles MATLABFiles,
+	config Config,
 ) *DirectoryFactory {
 	return &DirectoryFactory{
 		osLayer:              osLayer,
 		applicationDirectory: applicationDirectory,
 		matlabFiles:          matlabFiles,
+		config:               config,
 	}
 }
 
@@ -74,5 +88,33 @@ func (f *DirectoryFactory) Create(logger entities.Logger) (Directory, error) {
 		}
 	}
 
+	// The session directory is added to the MATLAB path, so these files shadow the MATLAB functions they block.
+	// The allowed functions are not shadowed, so that the calls the sandbox check accepts also run in MATLAB.
+	if f.config.SandboxEnabled() {
+		logger.Debug("Blocking shell commands in the MATLAB session")
+		allowedFunctions := f.config.AllowedFunctions()
+		for fileName, fileContent := range f.matlabFiles.GetSandbox() {
+			if slices.Contains(allowedFunctions, strings.TrimSuffix(fileName, ".m")) {
+				continue
+			}
+
+			filePath := filepath.Join(sessionDir, fileName)
+			if err := f.osLayer.WriteFile(filePath, fileContent, 0o600); err != nil {
+				return nil, fmt.Errorf("failed to create %s file: %w", fileName, err)
+			}
+		}
+	}
+
+	// When some hosts are allowed, the network functions stay available, and calls are only checked before they run.
+	if f.config.BlockNetwork() && len(f.config.AllowedHosts()) == 0 {
+		logger.Debug("Blocking network access in the MATLAB session")
+		for fileName, fileContent := range f.matlabFiles.GetNetworkBlock() {
+			filePath := filepath.Join(sessionDir, fileName)
+			if err := f.osLayer.WriteFile(filePath, fileContent, 0o600); err != nil {
+				return nil, fmt.Errorf("failed to create %s file: %w", fileName, err)
+			}
+		}
+	}
+
 	return newDirectoryManager(sessionDir, f.osLayer), nil
 }
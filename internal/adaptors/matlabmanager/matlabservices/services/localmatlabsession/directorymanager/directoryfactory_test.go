@@ -26,8 +26,11 @@ func TestNewFactory_HappyPath(t *testing.T) {
 	mockMATLABFiles := &mocks.MockMATLABFiles{}
 	defer mockMATLABFiles.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	// Act
-	factory := directorymanager.NewFactory(mockOSLayer, mockApplicationDirectory, mockMATLABFiles)
+	factory := directorymanager.NewFactory(mockOSLayer, mockApplicationDirectory, mockMATLABFiles, mockConfig)
 
 	// Assert
 	assert.NotNil(t, factory)
@@ -44,6 +47,9 @@ func TestDirectoryFactory_Create_HappyPath(t *testing.T) {
 	mockMATLABFiles := &mocks.MockMATLABFiles{}
 	defer mockMATLABFiles.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	sessionDir := "/tmp/matlab-session-12345"
@@ -77,7 +83,17 @@ func TestDirectoryFactory_Create_HappyPath(t *testing.T) {
 			Once()
 	}
 
-	factory := directorymanager.NewFactory(mockOSLayer, mockApplicationDirectory, mockMATLABFiles)
+	mockConfig.EXPECT().
+		SandboxEnabled().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		BlockNetwork().
+		Return(false).
+		Once()
+
+	factory := directorymanager.NewFactory(mockOSLayer, mockApplicationDirectory, mockMATLABFiles, mockConfig)
 
 	// Act
 	directory, err := factory.Create(mockLogger)
@@ -101,6 +117,9 @@ func TestDirectoryFactory_Create_MkdirTempError(t *testing.T) {
 	mockMATLABFiles := &mocks.MockMATLABFiles{}
 	defer mockMATLABFiles.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	expectedError := assert.AnError
@@ -110,7 +129,7 @@ func TestDirectoryFactory_Create_MkdirTempError(t *testing.T) {
 		Return("", expectedError).
 		Once()
 
-	factory := directorymanager.NewFactory(mockOSLayer, mockApplicationDirectory, mockMATLABFiles)
+	factory := directorymanager.NewFactory(mockOSLayer, mockApplicationDirectory, mockMATLABFiles, mockConfig)
 
 	// Act
 	directory, err := factory.Create(mockLogger)
@@ -131,6 +150,9 @@ func TestDirectoryFactory_Create_PackageDirectoryMkdirError(t *testing.T) {
 	mockMATLABFiles := &mocks.MockMATLABFiles{}
 	defer mockMATLABFiles.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	sessionDir := "/tmp/matlab-session-12345"
@@ -147,7 +169,7 @@ func TestDirectoryFactory_Create_PackageDirectoryMkdirError(t *testing.T) {
 		Return(expectedError).
 		Once()
 
-	factory := directorymanager.NewFactory(mockOSLayer, mockApplicationDirectory, mockMATLABFiles)
+	factory := directorymanager.NewFactory(mockOSLayer, mockApplicationDirectory, mockMATLABFiles, mockConfig)
 
 	// Act
 	directory, err := factory.Create(mockLogger)
@@ -168,6 +190,9 @@ func TestDirectoryFactory_Create_WriteFileError(t *testing.T) {
 	mockMATLABFiles := &mocks.MockMATLABFiles{}
 	defer mockMATLABFiles.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	sessionDir := "/tmp/matlab-session-12345"
@@ -200,7 +225,7 @@ func TestDirectoryFactory_Create_WriteFileError(t *testing.T) {
 		Return(expectedError).
 		Once()
 
-	factory := directorymanager.NewFactory(mockOSLayer, mockApplicationDirectory, mockMATLABFiles)
+	factory := directorymanager.NewFactory(mockOSLayer, mockApplicationDirectory, mockMATLABFiles, mockConfig)
 
 	// Act
 	directory, err := factory.Create(mockLogger)
@@ -209,3 +234,285 @@ func TestDirectoryFactory_Create_WriteFileError(t *testing.T) {
 	require.ErrorIs(t, err, expectedError)
 	assert.Nil(t, directory)
 }
+
+func TestDirectoryFactory_Create_SandboxEnabled(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockApplicationDirectory := &mocks.MockApplicationDirectory{}
+	defer mockApplicationDirectory.AssertExpectations(t)
+
+	mockMATLABFiles := &mocks.MockMATLABFiles{}
+	defer mockMATLABFiles.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	sessionDir := "/tmp/matlab-session-12345"
+	packageDir := filepath.Join(sessionDir, "+matlab_mcp")
+
+	mockApplicationDirectory.EXPECT().
+		MkdirTemp(mock.AnythingOfType("string")).
+		Return(sessionDir, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Mkdir(packageDir, os.FileMode(0o700)).
+		Return(nil).
+		Once()
+
+	mockMATLABFiles.EXPECT().
+		GetAll().
+		Return(map[string][]byte{}).
+		Once()
+
+	mockConfig.EXPECT().
+		SandboxEnabled().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		AllowedFunctions().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		BlockNetwork().
+		Return(false).
+		Once()
+
+	expectedSandboxFiles := map[string][]byte{
+		"system.m": []byte("some content"),
+		"dos.m":    []byte("some other content"),
+	}
+
+	mockMATLABFiles.EXPECT().
+		GetSandbox().
+		Return(expectedSandboxFiles).
+		Once()
+
+	for fileName, fileContent := range expectedSandboxFiles {
+		mockOSLayer.EXPECT().
+			WriteFile(filepath.Join(sessionDir, fileName), fileContent, os.FileMode(0o600)).
+			Return(nil).
+			Once()
+	}
+
+	factory := directorymanager.NewFactory(mockOSLayer, mockApplicationDirectory, mockMATLABFiles, mockConfig)
+
+	// Act
+	directory, err := factory.Create(mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, sessionDir, directory.Path())
+}
+
+func TestDirectoryFactory_Create_SandboxAllowedFunctionsAreNotShadowed(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockApplicationDirectory := &mocks.MockApplicationDirectory{}
+	defer mockApplicationDirectory.AssertExpectations(t)
+
+	mockMATLABFiles := &mocks.MockMATLABFiles{}
+	defer mockMATLABFiles.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	sessionDir := "/tmp/matlab-session-12345"
+	packageDir := filepath.Join(sessionDir, "+matlab_mcp")
+
+	mockApplicationDirectory.EXPECT().
+		MkdirTemp(mock.AnythingOfType("string")).
+		Return(sessionDir, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Mkdir(packageDir, os.FileMode(0o700)).
+		Return(nil).
+		Once()
+
+	mockMATLABFiles.EXPECT().
+		GetAll().
+		Return(map[string][]byte{}).
+		Once()
+
+	mockConfig.EXPECT().
+		SandboxEnabled().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		AllowedFunctions().
+		Return([]string{"dos", "delete"}).
+		Once()
+
+	mockConfig.EXPECT().
+		BlockNetwork().
+		Return(false).
+		Once()
+
+	mockMATLABFiles.EXPECT().
+		GetSandbox().
+		Return(map[string][]byte{
+			"system.m": []byte("some content"),
+			"dos.m":    []byte("some other content"),
+		}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		WriteFile(filepath.Join(sessionDir, "system.m"), []byte("some content"), os.FileMode(0o600)).
+		Return(nil).
+		Once()
+
+	factory := directorymanager.NewFactory(mockOSLayer, mockApplicationDirectory, mockMATLABFiles, mockConfig)
+
+	// Act
+	directory, err := factory.Create(mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, sessionDir, directory.Path())
+}
+
+func TestDirectoryFactory_Create_NetworkBlocked(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockApplicationDirectory := &mocks.MockApplicationDirectory{}
+	defer mockApplicationDirectory.AssertExpectations(t)
+
+	mockMATLABFiles := &mocks.MockMATLABFiles{}
+	defer mockMATLABFiles.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	sessionDir := "/tmp/matlab-session-12345"
+	packageDir := filepath.Join(sessionDir, "+matlab_mcp")
+
+	mockApplicationDirectory.EXPECT().
+		MkdirTemp(mock.AnythingOfType("string")).
+		Return(sessionDir, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Mkdir(packageDir, os.FileMode(0o700)).
+		Return(nil).
+		Once()
+
+	mockMATLABFiles.EXPECT().
+		GetAll().
+		Return(map[string][]byte{}).
+		Once()
+
+	mockConfig.EXPECT().
+		SandboxEnabled().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		BlockNetwork().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		AllowedHosts().
+		Return(nil).
+		Once()
+
+	expectedNetworkFiles := map[string][]byte{
+		"webread.m":   []byte("some content"),
+		"tcpclient.m": []byte("some other content"),
+	}
+
+	mockMATLABFiles.EXPECT().
+		GetNetworkBlock().
+		Return(expectedNetworkFiles).
+		Once()
+
+	for fileName, fileContent := range expectedNetworkFiles {
+		mockOSLayer.EXPECT().
+			WriteFile(filepath.Join(sessionDir, fileName), fileContent, os.FileMode(0o600)).
+			Return(nil).
+			Once()
+	}
+
+	factory := directorymanager.NewFactory(mockOSLayer, mockApplicationDirectory, mockMATLABFiles, mockConfig)
+
+	// Act
+	directory, err := factory.Create(mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, sessionDir, directory.Path())
+}
+
+func TestDirectoryFactory_Create_NetworkAllowedHostsDoNotShadowFunctions(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockApplicationDirectory := &mocks.MockApplicationDirectory{}
+	defer mockApplicationDirectory.AssertExpectations(t)
+
+	mockMATLABFiles := &mocks.MockMATLABFiles{}
+	defer mockMATLABFiles.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	sessionDir := "/tmp/matlab-session-12345"
+
+	mockApplicationDirectory.EXPECT().
+		MkdirTemp(mock.AnythingOfType("string")).
+		Return(sessionDir, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Mkdir(filepath.Join(sessionDir, "+matlab_mcp"), os.FileMode(0o700)).
+		Return(nil).
+		Once()
+
+	mockMATLABFiles.EXPECT().
+		GetAll().
+		Return(map[string][]byte{}).
+		Once()
+
+	mockConfig.EXPECT().
+		SandboxEnabled().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		BlockNetwork().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		AllowedHosts().
+		Return([]string{"data.example.com"}).
+		Once()
+
+	factory := directorymanager.NewFactory(mockOSLayer, mockApplicationDirectory, mockMATLABFiles, mockConfig)
+
+	// Act
+	directory, err := factory.Create(mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, sessionDir, directory.Path())
+}
@@ -0,0 +1,22 @@
+function output = cancelJob(id)
+    % cancelJob cancels a background job started with startJob, removes it, and returns the
+    % command window output it produced before it was cancelled.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    job = matlab_mcp.jobStore("get", id);
+    cancel(job);
+
+    if isa(job, 'parallel.Future')
+        output = job.Diary;
+    else
+        output = '';
+        if ~isempty(job.Tasks)
+            output = job.Tasks(1).Diary;
+        end
+        delete(job);
+    end
+
+    matlab_mcp.jobStore("remove", id);
+    output = char(output);
+end
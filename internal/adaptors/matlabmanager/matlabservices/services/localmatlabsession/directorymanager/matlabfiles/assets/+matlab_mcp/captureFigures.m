@@ -0,0 +1,48 @@
+function result = captureFigures(numbers, format, resolution)
+    % captureFigures exports the figures with the given numbers, or every open figure with a
+    % number when no number is given, to temporary PNG or SVG files, and returns their numbers,
+    % names and paths as JSON text. The MATLAB MCP Core Server reads and deletes the files.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    % The server passes every argument as text, with the numbers as a JSON array.
+    if ischar(numbers) || isstring(numbers)
+        numbers = jsondecode(char(numbers));
+    end
+    if ischar(resolution) || isstring(resolution)
+        resolution = str2double(resolution);
+    end
+    format = char(format);
+
+    if isempty(numbers)
+        figures = findall(groot, 'Type', 'figure');
+        figures = figures(arrayfun(@(fig) ~isempty(fig.Number), figures));
+        [~, order] = sort([figures.Number]);
+        figures = figures(order);
+    else
+        figures = gobjects(0);
+        for number = reshape(numbers, 1, [])
+            fig = findall(groot, 'Type', 'figure', 'Number', number);
+            if isempty(fig)
+                error("matlab_mcp:captureFigures:notFound", "Figure %d is not open.", number);
+            end
+            figures(end+1) = fig(1); %#ok<AGROW>
+        end
+    end
+
+    % Use a cell array, so that a single figure is still encoded as a JSON array.
+    result = cell(1, numel(figures));
+    for ii = 1:numel(figures)
+        fig = figures(ii);
+        file = [tempname '.' format];
+        if strcmp(format, 'svg')
+            % exportgraphics does not write SVG files.
+            print(fig, file, '-dsvg');
+        else
+            exportgraphics(fig, file, 'Resolution', resolution);
+        end
+        result{ii} = struct('number', fig.Number, 'name', fig.Name, 'file', file);
+    end
+
+    result = jsonencode(result);
+end
@@ -0,0 +1,75 @@
+function result = checkCode(target)
+    % checkCode runs Code Analyzer on a MATLAB file, or on the MATLAB files of a folder and its
+    % subfolders, and returns the issues it found as JSON text.
+    %
+    % Each issue holds the file, its line and columns, its severity, the identifier of the
+    % check, its message, and whether Code Analyzer can fix it automatically. Releases without
+    % codeIssues only report warnings, and do not report which issues can be fixed.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    target = char(target);
+    if isfolder(target)
+        files = dir(fullfile(target, '**', '*.m'));
+        files = fullfile({files.folder}, {files.name});
+    else
+        files = {target};
+    end
+
+    % Use a cell array, so that a single issue is still encoded as a JSON array.
+    result = {};
+    if ~isempty(files)
+        if exist('codeIssues', 'file')
+            result = issuesOf(files);
+        else
+            result = checkcodeIssuesOf(files);
+        end
+    end
+
+    result = jsonencode(result);
+end
+
+% Helper function listing the issues found by codeIssues, available from R2022b.
+function result = issuesOf(files)
+    issues = codeIssues(files).Issues;
+    result = cell(1, height(issues));
+    for ii = 1:height(issues)
+        fix = "manual";
+        if issues.Fixability(ii) == "auto"
+            fix = "automatic";
+        end
+        result{ii} = issue( ...
+            issues.FullFilename(ii), issues.LineStart(ii), issues.ColumnStart(ii), issues.ColumnEnd(ii), ...
+            lower(string(issues.Severity(ii))), issues.CheckID(ii), issues.Description(ii), fix);
+    end
+end
+
+% Helper function listing the issues found by checkcode, in releases without codeIssues.
+function result = checkcodeIssuesOf(files)
+    info = checkcode(files, '-id', '-struct');
+    if ~iscell(info)
+        info = {info};
+    end
+
+    result = {};
+    for ii = 1:numel(files)
+        for found = info{ii}(:)'
+            result{end + 1} = issue( ...
+                files{ii}, found.line, found.column(1), found.column(end), ...
+                "warning", found.id, found.message, ""); %#ok<AGROW>
+        end
+    end
+end
+
+% Helper function building the struct encoding an issue.
+function node = issue(file, line, startColumn, endColumn, severity, id, message, fix)
+    node = struct( ...
+        'file', string(file), ...
+        'line', double(line), ...
+        'column', double(startColumn), ...
+        'endColumn', double(endColumn), ...
+        'severity', string(severity), ...
+        'id', string(id), ...
+        'message', string(message), ...
+        'fix', string(fix));
+end
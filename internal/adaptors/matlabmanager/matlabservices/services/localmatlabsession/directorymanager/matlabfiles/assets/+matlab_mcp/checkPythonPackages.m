@@ -0,0 +1,41 @@
+function result = checkPythonPackages(varargin)
+    % checkPythonPackages imports the Python modules named by the arguments, and returns for
+    % each of them whether it could be imported, its version, and the error raised by the
+    % import, as JSON text.
+    %
+    % The version is the __version__ attribute of the module, or the version of the installed
+    % distribution of the same name, and is empty when neither is found.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    % Use a cell array, so that a single package is still encoded as a JSON array.
+    result = cell(1, numel(varargin));
+    for ii = 1:numel(varargin)
+        name = string(varargin{ii});
+        package = struct('name', name, 'importable', false, 'version', "", 'error', "");
+        try
+            module = py.importlib.import_module(name);
+            package.importable = true;
+            package.version = moduleVersion(module, name);
+        catch exception
+            package.error = string(exception.message);
+        end
+        result{ii} = package;
+    end
+
+    result = jsonencode(result);
+end
+
+function version = moduleVersion(module, name)
+    version = "";
+    try
+        if py.hasattr(module, '__version__')
+            version = string(py.str(py.getattr(module, '__version__')));
+            return
+        end
+        metadata = py.importlib.import_module('importlib.metadata');
+        version = string(metadata.version(extractBefore(name + ".", ".")));
+    catch
+        % The module has no version, or its distribution has another name.
+    end
+end
@@ -0,0 +1,13 @@
+function file = checkpointWorkspace(folder)
+    % checkpointWorkspace saves the variables of the base workspace to a new MAT-file of folder,
+    % the artifact directory shared with the server, and returns the path of the file, so that
+    % the workspace can be restored with load after the MATLAB session is restarted.
+    % The MAT-file is not encrypted, so the server does not call it with encryption at rest.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    file = fullfile(folder, ['workspace-checkpoint-' char(datetime('now', 'Format', 'yyyyMMdd-HHmmss')) '.mat']);
+
+    % Version 7.3 MAT-files hold variables larger than 2 GB, which a session running out of memory is likely to have.
+    evalin("base", sprintf("save('%s', '-v7.3')", strrep(file, "'", "''")));
+end
@@ -0,0 +1,110 @@
+function result = exportVariable(name, binaryThreshold, previewThreshold, folder)
+    % exportVariable returns a variable of the base workspace to the MATLAB MCP Core Server,
+    % with its class, size and number of bytes.
+    %
+    % Variables of at most binaryThreshold bytes are encoded as typed JSON text, which keeps
+    % their class and size, by typedValue. Larger variables, and variables without a typed
+    % JSON representation, such as objects, are saved to a MAT-file of folder instead,
+    % the artifact directory shared with the server, which hands the file to the AI application
+    % without reading it. MAT-files keep the exact values of every class, such as 64-bit
+    % integers, which JSON numbers do not.
+    %
+    % Variables larger than previewThreshold bytes are not serialized at all. A preview is
+    % returned instead, with statistics and a deterministic sample of the elements, so that
+    % huge arrays cost neither the time to save them nor the context of the AI application.
+    % A previewThreshold of 0 disables previews.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    % The server passes every argument as text.
+    if ischar(binaryThreshold) || isstring(binaryThreshold)
+        binaryThreshold = str2double(binaryThreshold);
+    end
+    if nargin < 3
+        previewThreshold = 0;
+    elseif ischar(previewThreshold) || isstring(previewThreshold)
+        previewThreshold = str2double(previewThreshold);
+    end
+    if nargin < 4
+        folder = tempdir;
+    end
+
+    if ~isvarname(name)
+        error("matlab_mcp:exportVariable:invalidName", "'%s' is not a valid variable name.", name);
+    end
+
+    if ~evalin("base", sprintf("exist('%s', 'var')", name))
+        error("matlab_mcp:exportVariable:notFound", "Variable '%s' not found in the workspace.", name);
+    end
+
+    info = evalin("base", sprintf("whos('%s')", name));
+    value = evalin("base", name);
+
+    result = struct( ...
+        'name', name, ...
+        'class', info.class, ...
+        'size', info.size, ...
+        'bytes', info.bytes, ...
+        'encoding', 'typed', ...
+        'data', '', ...
+        'file', '');
+
+    if previewThreshold > 0 && info.bytes > previewThreshold
+        result.encoding = 'preview';
+        result.data = jsonencode(previewOf(value));
+        result = jsonencode(result);
+        return
+    end
+
+    if info.bytes <= binaryThreshold
+        try
+            result.data = jsonencode(matlab_mcp.typedValue(value));
+            result = jsonencode(result);
+            return
+        catch
+            % Values without a typed JSON representation, such as objects, are saved to a MAT-file.
+        end
+    end
+
+    % Version 7 MAT-files are compressed, and readable without HDF5, but limited to 2 GB per variable.
+    version = '-v7';
+    if info.bytes >= 2^31
+        version = '-v7.3';
+    end
+
+    variables.(name) = value;
+    result.file = [tempname(folder) '.mat'];
+    save(result.file, '-struct', 'variables', version);
+
+    result.encoding = 'mat';
+    result = jsonencode(result);
+end
+
+% Helper function returning the statistics of a numeric array, and a sample of its elements.
+% The sample is evenly spaced over the elements, in column-major order, so that reading the
+% same variable twice returns the same sample.
+function preview = previewOf(value)
+    maxSampleSize = 100;
+
+    preview = struct('sampleIndices', [], 'sample', []);
+
+    n = numel(value);
+    if n == 0 || ~(isnumeric(value) || islogical(value) || ischar(value) || isstring(value))
+        return
+    end
+
+    indices = unique(round(linspace(1, n, min(n, maxSampleSize))));
+    preview.sampleIndices = indices;
+    preview.sample = value(indices);
+
+    if (isnumeric(value) || islogical(value)) && isreal(value)
+        % Reshaping does not copy the data, unlike converting it to double.
+        values = value(:);
+        preview.min = double(min(values, [], 'omitnan'));
+        preview.max = double(max(values, [], 'omitnan'));
+        preview.mean = mean(values, 'omitnan');
+        preview.nanCount = nnz(isnan(values));
+        preview.infCount = nnz(isinf(values));
+        preview.nonzeroCount = nnz(values);
+    end
+end
@@ -0,0 +1,61 @@
+function result = jobStatus(id)
+    % jobStatus returns the state, the output and the error message of a background job started
+    % with startJob, as JSON text. The state is one of "queued", "running", "completed" and
+    % "failed".
+    %
+    % Once the job is finished, it is removed, and its batch job is deleted, as the MATLAB MCP
+    % Core Server keeps its result.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    job = matlab_mcp.jobStore("get", id);
+    [output, err] = jobOutput(job);
+
+    switch job.State
+        case {'pending', 'queued'}
+            state = 'queued';
+        case 'running'
+            state = 'running';
+        case 'finished'
+            state = 'completed';
+        otherwise
+            state = 'failed';
+    end
+
+    message = '';
+    if ~isempty(err)
+        state = 'failed';
+        message = err.message;
+    elseif strcmp(state, 'failed')
+        message = sprintf("The job is %s.", job.State);
+    end
+
+    if any(strcmp(state, {'completed', 'failed'}))
+        removeJob(id, job);
+    end
+
+    result = jsonencode(struct('state', state, 'output', char(output), 'error', message));
+end
+
+function [output, err] = jobOutput(job)
+    % jobOutput returns the command window output of a job, and its error, if any.
+    if isa(job, 'parallel.Future')
+        output = job.Diary;
+        err = job.Error;
+        return
+    end
+
+    output = '';
+    err = [];
+    if ~isempty(job.Tasks)
+        output = job.Tasks(1).Diary;
+        err = job.Tasks(1).Error;
+    end
+end
+
+function removeJob(id, job)
+    matlab_mcp.jobStore("remove", id);
+    if ~isa(job, 'parallel.Future')
+        delete(job);
+    end
+end
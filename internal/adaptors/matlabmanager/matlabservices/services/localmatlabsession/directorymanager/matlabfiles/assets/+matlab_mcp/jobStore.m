@@ -0,0 +1,30 @@
+function job = jobStore(action, id, job)
+    % jobStore keeps the batch jobs and parfeval futures of the background jobs started by
+    % the MATLAB MCP Core Server, by job ID.
+    %
+    % The function is locked, so that clearing functions in the session does not lose the jobs.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    persistent jobs
+    mlock
+
+    if isempty(jobs)
+        jobs = containers.Map('KeyType', 'char', 'ValueType', 'any');
+    end
+
+    id = char(id);
+    switch action
+        case "set"
+            jobs(id) = job;
+        case "get"
+            if ~isKey(jobs, id)
+                error("matlab_mcp:jobStore:notFound", "Background job '%s' does not exist in this MATLAB session.", id);
+            end
+            job = jobs(id);
+        case "remove"
+            if isKey(jobs, id)
+                remove(jobs, id);
+            end
+    end
+end
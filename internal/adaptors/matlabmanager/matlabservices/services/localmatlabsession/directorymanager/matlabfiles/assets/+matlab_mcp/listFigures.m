@@ -0,0 +1,22 @@
+function result = listFigures()
+    % listFigures returns the numbers and names of the figures open in the MATLAB session,
+    % as JSON text, so that the MATLAB MCP Core Server can render them in the background.
+    %
+    % Figures without a number, such as the figures created with uifigure, are not listed.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    figures = findall(groot, 'Type', 'figure');
+
+    % Use a cell array, so that a single figure is still encoded as a JSON array.
+    result = {};
+    for ii = 1:numel(figures)
+        fig = figures(ii);
+        if isempty(fig.Number)
+            continue
+        end
+        result{end+1} = struct('number', fig.Number, 'name', fig.Name); %#ok<AGROW>
+    end
+
+    result = jsonencode(result);
+end
@@ -0,0 +1,22 @@
+function result = listVariables()
+    % listVariables returns the names, classes, sizes and numbers of bytes of the variables of
+    % the base workspace, as JSON text, so that the MATLAB MCP Core Server can list them as
+    % resources without reading their values.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    infos = evalin("base", "whos");
+
+    % Use a cell array, so that a single variable is still encoded as a JSON array.
+    result = cell(1, numel(infos));
+    for ii = 1:numel(infos)
+        info = infos(ii);
+        result{ii} = struct( ...
+            'name', info.name, ...
+            'class', info.class, ...
+            'size', {info.size}, ...
+            'bytes', info.bytes);
+    end
+
+    result = jsonencode(result);
+end
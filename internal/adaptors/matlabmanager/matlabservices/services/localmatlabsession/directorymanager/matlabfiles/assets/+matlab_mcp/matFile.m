@@ -0,0 +1,78 @@
+function result = matFile(action, filePath, varargin)
+    % matFile lists and loads the variables of MAT-files for the MCP server. It returns JSON
+    % text.
+    %
+    %   matFile("list", filePath, maxVariables)
+    %   matFile("load", filePath, overwrite, name1, name2, ...)
+    %
+    % Numbers and flags are passed as text. Listing reads the headers of the variables with
+    % whos, without loading their values. Loading assigns the named variables in the base
+    % workspace, and raises an error, before loading anything, if one of them is not in the
+    % file, or already exists in the workspace and overwrite is not "true".
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    filePath = char(filePath);
+    switch action
+        case "list"
+            result = jsonencode(listVariables(filePath, str2double(varargin{1})));
+        case "load"
+            result = jsonencode(loadVariables(filePath, varargin{1} == "true", cellstr(varargin(2:end))));
+        otherwise
+            error("matlab_mcp:matFile:unknownAction", "Unknown action %s.", action);
+    end
+end
+
+function listed = listVariables(filePath, maxVariables)
+    info = whos('-file', filePath);
+
+    % Cells are encoded as JSON arrays whatever their size.
+    variables = cell(1, min(numel(info), maxVariables));
+    for ii = 1:numel(variables)
+        variables{ii} = describeVariable(info(ii));
+    end
+
+    listed = struct( ...
+        'file', string(filePath), ...
+        'variables', {variables}, ...
+        'variableCount', numel(info));
+end
+
+function loaded = loadVariables(filePath, overwrite, names)
+    info = whos('-file', filePath);
+
+    missingNames = setdiff(names, {info.name}, 'stable');
+    if ~isempty(missingNames)
+        error("matlab_mcp:matFile:notFound", "The MAT-file %s has no variable named %s.", ...
+            filePath, strjoin(missingNames, ', '));
+    end
+
+    existingNames = names(cellfun(@(name) evalin('base', sprintf("exist('%s', 'var')", name)) == 1, names));
+    if ~overwrite && ~isempty(existingNames)
+        error("matlab_mcp:matFile:exists", ...
+            "The workspace already has variables named %s: set overwrite to replace them.", ...
+            strjoin(existingNames, ', '));
+    end
+
+    values = load(filePath, names{:});
+
+    variables = cell(1, numel(names));
+    for ii = 1:numel(names)
+        assignin('base', names{ii}, values.(names{ii}));
+        variables{ii} = describeVariable(info(strcmp({info.name}, names{ii})));
+    end
+
+    loaded = struct( ...
+        'file', string(filePath), ...
+        'variables', {variables}, ...
+        'replaced', {existingNames});
+end
+
+function described = describeVariable(info)
+    described = struct( ...
+        'name', string(info.name), ...
+        'class', string(info.class), ...
+        'size', {num2cell(info.size)}, ...
+        'bytes', info.bytes, ...
+        'complex', info.complex);
+end
@@ -3,7 +3,7 @@
 % change without any prior notice. Usage of these undocumented APIs outside of
 % these files is not supported.
 
-function results = mcpEval(code)
+function results = mcpEval(code, outputFile)
     % mcpEval A helper function for handling execution of MATLAB code and post-processing
     % the outputs. The MATLAB MCP Core Server will then convert those to the appropriate MCP Server Tool Content, see:
     % 
@@ -14,6 +14,9 @@ function results = mcpEval(code)
     % The entire MATLAB code given by user is treated as code within a single cell
     % of a unique Live Script. Hence, each execution request can be considered as
     % creating and running a new Live Script file.
+    %
+    % When outputFile is given, the command window output of the code is also written to it
+    % while the code runs, so that the server can interrupt code writing more output than its limit.
         
     % This is largely a re-use of:
     % https://github.com/mathworks/jupyter-matlab-proxy/blob/057564dccb7de37f052e709f5380e3ece0b2c4a1/src/jupyter_matlab_kernel/matlab/%2Bjupyter/execute.m#L1
@@ -45,6 +48,11 @@ function results = mcpEval(code)
     hotlinksPreviousState = feature('hotlinks','off');
     hotlinksCleanupObj = onCleanup(@() feature('hotlinks', hotlinksPreviousState));
 
+    if nargin > 1 && strlength(outputFile) > 0
+        diary(outputFile);
+        diaryCleanupObj = onCleanup(@() diary('off'));
+    end
+
     resp = jsondecode(matlab.internal.editor.evaluateSynchronousRequest(request));
 
     results = jsonencode(processOutputs(resp.outputs));
@@ -118,9 +126,9 @@ function result = processOutputs(outputs)
             case 'symbolic'
                 result{ii} = processSymbolic(outputData);
             case 'error'
-                result{ii} = processStream('stderr', outputData.text);
+                result{ii} = processDiagnostic('error', outputData);
             case 'warning'
-                result{ii} = processStream('stderr', outputData.text);
+                result{ii} = processDiagnostic('warning', outputData);
             case 'text'
                 result{ii} = processStream('stdout', outputData.text);
             case 'stderr'
@@ -152,7 +160,10 @@ function result = processOutputs(outputs)
 
     ME = matlab_mcp.getOrStashExceptions([], true);
     if ~isempty(ME)
-        result{end+1} = processStream('stderr', ME.message);
+        result{end+1} = processDiagnostic('error', struct( ...
+            'text', ME.message, ...
+            'identifier', ME.identifier, ...
+            'stack', ME.stack));
     end
 
     % Helper functions to post process output of type 'matrix', 'variable' and
@@ -250,6 +261,26 @@ function result = processOutputs(outputs)
         result.content.text = text;
     end
 
+    % Helper function for processing warnings and errors. These are still reported
+    % on the 'stderr' stream, with the identifier and stack, when available, attached
+    % so the MCP server can log them as structured records.
+    function result = processDiagnostic(kind, output)
+        result = processStream('stderr', output.text);
+        result.diagnostic.kind = kind;
+        result.diagnostic.identifier = '';
+        result.diagnostic.stack = {};
+        if isfield(output, 'identifier')
+            result.diagnostic.identifier = output.identifier;
+        end
+        if isfield(output, 'stack') && isstruct(output.stack)
+            % Use a cell array, so that a single frame is still encoded as a JSON array.
+            result.diagnostic.stack = arrayfun(@(frame) struct( ...
+                'file', frame.file, ...
+                'name', frame.name, ...
+                'line', frame.line), output.stack(:)', 'UniformOutput', false);
+        end
+    end
+
     % Helper function for processing figure outputs.
     % base64Data will be 'data:image/png;base64,<base64_value>'
     function result = processFigure(base64Data)
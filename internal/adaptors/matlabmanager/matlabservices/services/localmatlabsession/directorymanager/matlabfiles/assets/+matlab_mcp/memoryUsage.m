@@ -0,0 +1,24 @@
+function result = memoryUsage()
+    % memoryUsage returns the process ID of the MATLAB session, so that the server can read the
+    % memory it uses while it is busy, and the number of bytes held by the base workspace, with
+    % its largest variables, as JSON text.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    maxLargestVariables = 3;
+
+    infos = evalin("base", "whos");
+    [~, order] = sort([infos.bytes], 'descend');
+
+    % Use a cell array, so that a single variable is still encoded as a JSON array.
+    largest = cell(1, min(maxLargestVariables, numel(infos)));
+    for ii = 1:numel(largest)
+        info = infos(order(ii));
+        largest{ii} = struct('name', info.name, 'bytes', info.bytes);
+    end
+
+    result = jsonencode(struct( ...
+        'pid', feature('getpid'), ...
+        'workspaceBytes', sum([infos.bytes]), ...
+        'largestVariables', {largest}));
+end
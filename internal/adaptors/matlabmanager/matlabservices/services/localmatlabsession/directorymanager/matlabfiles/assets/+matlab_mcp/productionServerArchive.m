@@ -0,0 +1,33 @@
+function result = productionServerArchive(archiveName, varargin)
+    % productionServerArchive packages MATLAB functions into a deployable archive for
+    % MATLAB Production Server, for the MCP server. It returns JSON text.
+    %
+    %   productionServerArchive(archiveName, functionPath1, functionPath2, ...)
+    %
+    % The archive is written to the folder archiveName + "_archive", next to the first
+    % function, and the result holds its path and the log of the build.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    if ~license('test', 'Compiler') || isempty(which('compiler.build.productionServerArchive'))
+        error("matlab_mcp:productionServerArchive:noCompiler", ...
+            "MATLAB Compiler SDK is required to package functions for MATLAB Production Server.");
+    end
+
+    functionPaths = string(varargin);
+    outputFolder = fullfile(fileparts(functionPaths(1)), archiveName + "_archive");
+
+    log = evalc(['results = compiler.build.productionServerArchive(functionPaths, ', ...
+        '''ArchiveName'', archiveName, ''OutputDir'', outputFolder);']);
+
+    archive = results.Files(endsWith(results.Files, ".ctf"));
+    if isempty(archive)
+        error("matlab_mcp:productionServerArchive:noArchive", ...
+            "The build did not produce a deployable archive:\n%s", log);
+    end
+
+    result = jsonencode(struct( ...
+        'archive', string(archive{1}), ...
+        'functions', {cellstr(functionPaths)}, ...
+        'log', string(log)));
+end
@@ -0,0 +1,45 @@
+function result = pythonEnvironment(version, executionMode)
+    % pythonEnvironment returns the Python environment of the MATLAB session, as pyenv shows
+    % it, as JSON text.
+    %
+    % With arguments, it first sets the Python version, the path of a Python executable or a
+    % version number, and the execution mode, InProcess or OutOfProcess, with pyenv. Empty
+    % arguments keep the current setting. A Python loaded out of process is terminated first,
+    % and loaded again with the new settings when it is next used. A Python loaded in the
+    % MATLAB process cannot be changed until MATLAB restarts, so pyenv raises an error.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    if nargin < 1
+        version = "";
+    end
+    if nargin < 2
+        executionMode = "";
+    end
+
+    options = {};
+    if strlength(version) > 0
+        options = [options, {'Version', char(version)}];
+    end
+    if strlength(executionMode) > 0
+        options = [options, {'ExecutionMode', char(executionMode)}];
+    end
+
+    if ~isempty(options)
+        environment = pyenv;
+        if environment.Status == "Loaded" && environment.ExecutionMode == "OutOfProcess"
+            terminate(environment);
+        end
+        pyenv(options{:});
+    end
+
+    environment = pyenv;
+    result = jsonencode(struct( ...
+        'version', string(environment.Version), ...
+        'executable', string(environment.Executable), ...
+        'library', string(environment.Library), ...
+        'home', string(environment.Home), ...
+        'status', string(environment.Status), ...
+        'executionMode', string(environment.ExecutionMode), ...
+        'processID', string(environment.ProcessID)));
+end
@@ -0,0 +1,126 @@
+function result = realTimeTarget(action, varargin)
+    % realTimeTarget builds real-time applications, and drives Simulink Real-Time target
+    % computers, for the MCP server. It returns JSON text.
+    %
+    %   realTimeTarget("build", modelPath)
+    %   realTimeTarget("deploy", target, applicationPath)
+    %   realTimeTarget("start", target, stopTime)
+    %   realTimeTarget("stop", target)
+    %   realTimeTarget("signals", target, duration, interval, blockPath1, portIndex1, ...)
+    %
+    % Numbers are passed as text. An empty stopTime keeps the stop time of the application.
+    % All actions but build return the status of the target, and signals adds the values of
+    % the signals, sampled with getsignal every interval seconds for duration seconds.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    switch action
+        case "build"
+            result = jsonencode(build(varargin{1}));
+        case "deploy"
+            tg = connectedTarget(varargin{1});
+            load(tg, char(varargin{2}));
+            result = jsonencode(struct('status', targetStatus(tg)));
+        case "start"
+            tg = connectedTarget(varargin{1});
+            if strlength(varargin{2}) > 0
+                setStopTime(tg, str2double(varargin{2}));
+            end
+            start(tg);
+            result = jsonencode(struct('status', targetStatus(tg)));
+        case "stop"
+            tg = connectedTarget(varargin{1});
+            stop(tg);
+            result = jsonencode(struct('status', targetStatus(tg)));
+        case "signals"
+            tg = connectedTarget(varargin{1});
+            result = jsonencode(sampleSignals(tg, str2double(varargin{2}), ...
+                str2double(varargin{3}), varargin(4:end)));
+        otherwise
+            error("matlab_mcp:realTimeTarget:unknownAction", "Unknown action %s.", action);
+    end
+end
+
+function built = build(modelPath)
+    [folder, model] = fileparts(char(modelPath));
+    load_system(modelPath);
+    if get_param(model, 'SystemTargetFile') ~= "slrealtime.tlc"
+        error("matlab_mcp:realTimeTarget:notRealTime", ...
+            "The model %s is not configured for Simulink Real-Time: set its system target file to slrealtime.tlc.", model);
+    end
+
+    % slbuild writes the application to the current folder.
+    previousFolder = cd(folder);
+    restoreFolder = onCleanup(@() cd(previousFolder));
+    log = evalc('slbuild(model)');
+
+    built = struct( ...
+        'application', string(fullfile(folder, model + ".mldatx")), ...
+        'log', string(log));
+end
+
+function tg = connectedTarget(name)
+    tg = slrealtime(char(name));
+    if ~isConnected(tg)
+        connect(tg);
+    end
+end
+
+function status = targetStatus(tg)
+    status = struct( ...
+        'target', string(tg.TargetSettings.name), ...
+        'connected', isConnected(tg), ...
+        'loaded', false, ...
+        'running', false, ...
+        'application', "");
+    if ~status.connected
+        return
+    end
+
+    status.loaded = isLoaded(tg);
+    status.running = isRunning(tg);
+    if status.loaded
+        try
+            status.application = string(tg.tc.ModelProperties.Application);
+        catch
+            % The loaded application is not reported by every release.
+        end
+    end
+end
+
+function sampled = sampleSignals(tg, duration, interval, signalArguments)
+    blockPaths = string(signalArguments(1:2:end));
+    portIndices = str2double(string(signalArguments(2:2:end)));
+
+    sampleCount = max(1, floor(duration / interval) + 1);
+    time = zeros(1, sampleCount);
+    values = zeros(numel(blockPaths), sampleCount);
+
+    timer = tic;
+    for sample = 1:sampleCount
+        time(sample) = toc(timer);
+        for signal = 1:numel(blockPaths)
+            value = getsignal(tg, char(blockPaths(signal)), portIndices(signal));
+            if ~isscalar(value)
+                error("matlab_mcp:realTimeTarget:notScalar", ...
+                    "The signal at port %d of %s is not scalar.", portIndices(signal), blockPaths(signal));
+            end
+            values(signal, sample) = double(value);
+        end
+        pause(max(0, sample * interval - toc(timer)));
+    end
+
+    % Cells are encoded as JSON arrays whatever their size, unlike a single signal or sample.
+    signals = cell(1, numel(blockPaths));
+    for signal = 1:numel(blockPaths)
+        signals{signal} = struct( ...
+            'blockPath', blockPaths(signal), ...
+            'portIndex', portIndices(signal), ...
+            'values', {num2cell(values(signal, :))});
+    end
+
+    sampled = struct( ...
+        'status', targetStatus(tg), ...
+        'time', {num2cell(time)}, ...
+        'signals', {signals});
+end
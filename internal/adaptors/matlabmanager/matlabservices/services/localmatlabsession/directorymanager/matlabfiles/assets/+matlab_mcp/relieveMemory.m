@@ -0,0 +1,21 @@
+function relieveMemory()
+    % relieveMemory releases the memory that the MATLAB session can free without changing the
+    % results of the code run in it: the functions loaded in memory are cleared, except those of
+    % the matlab_mcp package, which keep the state of the server, and the Java heap is garbage
+    % collected. The workspace and the figures are kept.
+    %
+    % pack is not run, as it can only be called from the command line.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    loaded = inmem;
+    for ii = 1:numel(loaded)
+        if ~startsWith(loaded{ii}, 'matlab_mcp.')
+            clear(loaded{ii});
+        end
+    end
+
+    if usejava('jvm')
+        java.lang.System.gc();
+    end
+end
@@ -0,0 +1,23 @@
+function file = renderFigure(number, resolution)
+    % renderFigure exports the figure with the given number to a temporary PNG file, at the
+    % given resolution in dots per inch, and returns the path of the file. The MATLAB MCP Core
+    % Server reads and deletes the file.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    % The server passes every argument as text.
+    if ischar(number) || isstring(number)
+        number = str2double(number);
+    end
+    if ischar(resolution) || isstring(resolution)
+        resolution = str2double(resolution);
+    end
+
+    fig = findall(groot, 'Type', 'figure', 'Number', number);
+    if isempty(fig)
+        error("matlab_mcp:renderFigure:notFound", "Figure %d is not open.", number);
+    end
+
+    file = [tempname '.png'];
+    exportgraphics(fig(1), file, 'Resolution', resolution);
+end
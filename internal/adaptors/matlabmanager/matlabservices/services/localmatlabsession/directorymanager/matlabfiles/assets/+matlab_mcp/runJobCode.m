@@ -0,0 +1,9 @@
+function runJobCode(code, folder)
+    % runJobCode runs the code of a background job on a Parallel Computing Toolbox worker,
+    % in the given folder.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    cd(folder);
+    eval(code);
+end
@@ -0,0 +1,35 @@
+function result = runPython(code, projectPath, varargin)
+    % runPython runs Python code with pyrun, in projectPath, and returns what the code printed,
+    % the traceback of the exception it raised, if any, and the representation of the Python
+    % variables named by the other arguments, as JSON text.
+    %
+    % The code runs in the __main__ module, as all code run with pyrun, so its variables are
+    % kept between calls. What it prints is captured in Python, as the output of Python does
+    % not reach the command window of MATLAB when Python runs out of process. projectPath is
+    % added to the Python module search path, so that the code can import the modules of the
+    % project.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    cd(projectPath);
+
+    runner = strjoin([ ...
+        "import contextlib as _mcp_contextlib, io as _mcp_io, json as _mcp_json, os as _mcp_os, sys as _mcp_sys, traceback as _mcp_traceback"
+        "_mcp_os.chdir(mcp_project_path)"
+        "if mcp_project_path not in _mcp_sys.path:"
+        "    _mcp_sys.path.insert(0, mcp_project_path)"
+        "_mcp_buffer = _mcp_io.StringIO()"
+        "_mcp_error = ''"
+        "with _mcp_contextlib.redirect_stdout(_mcp_buffer), _mcp_contextlib.redirect_stderr(_mcp_buffer):"
+        "    try:"
+        "        exec(compile(mcp_code, '<python>', 'exec'), globals())"
+        "    except BaseException:"
+        "        _mcp_error = _mcp_traceback.format_exc()"
+        "mcp_result = _mcp_json.dumps({'output': _mcp_buffer.getvalue(), 'error': _mcp_error, 'variables': {name: repr(globals()[name]) for name in mcp_variables if name in globals()}})"
+        ], newline);
+
+    result = char(string(pyrun(runner, "mcp_result", ...
+        "mcp_code", string(code), ...
+        "mcp_project_path", string(projectPath), ...
+        "mcp_variables", py.list(cellfun(@string, varargin, 'UniformOutput', false)))));
+end
@@ -0,0 +1,107 @@
+function result = runTests(testPath, testClass, tag, namePattern)
+    % runTests runs the tests of a test file, or of the test files of a folder and its
+    % subfolders, and returns the number of passed, failed and incomplete tests, their total
+    % duration, and the failures of the tests that did not pass, as JSON text.
+    %
+    % The tests are selected by the name of their test class, by tag, and by a name pattern
+    % with * wildcards, when these arguments are not empty. Each failure holds the event that
+    % failed the test, such as a failed verification or an uncaught exception, its message, and
+    % the stack of the test code where it happened.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    options = {};
+    if isfolder(testPath)
+        options = [options, {'IncludeSubfolders', true}];
+    end
+    if strlength(tag) > 0
+        options = [options, {'Tag', char(tag)}];
+    end
+    if strlength(namePattern) > 0
+        options = [options, {'Name', char(namePattern)}];
+    end
+
+    suite = testsuite(char(testPath), options{:});
+    if strlength(testClass) > 0
+        % The names of the tests of a class start with the name of the class, qualified with its package.
+        suite = suite(startsWith(string({suite.Name}), string(testClass) + "/"));
+    end
+
+    runner = matlab.unittest.TestRunner.withNoPlugins;
+    runner.addPlugin(matlab.unittest.plugins.DiagnosticsRecordingPlugin);
+    results = runner.run(suite);
+
+    % Use a cell array, so that a single test is still encoded as a JSON array.
+    failures = {};
+    for testResult = results(:)'
+        if testResult.Failed || testResult.Incomplete
+            failures{end + 1} = describe(testResult); %#ok<AGROW>
+        end
+    end
+
+    result = jsonencode(struct( ...
+        'total', numel(results), ...
+        'passed', nnz([results.Passed]), ...
+        'failed', nnz([results.Failed]), ...
+        'incomplete', nnz([results.Incomplete]), ...
+        'duration', sum([results.Duration]), ...
+        'failures', {failures}));
+end
+
+% Helper function returning the name, outcome, duration and failure events of a test.
+function description = describe(testResult)
+    status = "failed";
+    if ~testResult.Failed
+        status = "incomplete";
+    end
+
+    events = {};
+    for record = testResult.Details.DiagnosticRecord(:)'
+        % Diagnostics logged by the test are not failures.
+        if ~isa(record, 'matlab.unittest.plugins.diagnosticrecord.LoggedDiagnosticRecord')
+            events{end + 1} = struct( ...
+                'event', string(record.Event), ...
+                'message', messageOf(record), ...
+                'stack', {stackOf(record.Stack)}); %#ok<AGROW>
+        end
+    end
+
+    description = struct( ...
+        'name', testResult.Name, ...
+        'status', status, ...
+        'duration', testResult.Duration, ...
+        'events', {events});
+end
+
+% Helper function returning the message of a failure: the message of the uncaught exception,
+% or the diagnostics of the failed qualification.
+function message = messageOf(record)
+    if isa(record, 'matlab.unittest.plugins.diagnosticrecord.ExceptionDiagnosticRecord')
+        message = string(record.Exception.message);
+        return
+    end
+
+    texts = string.empty;
+    for diagnosticResult = [record.TestDiagnosticResults(:); record.FrameworkDiagnosticResults(:)]'
+        texts(end + 1) = string(diagnosticResult.DiagnosticText); %#ok<AGROW>
+    end
+    texts = texts(strlength(texts) > 0);
+
+    if isempty(texts)
+        message = string(record.Report);
+    else
+        message = strjoin(texts, newline);
+    end
+end
+
+% Helper function listing the frames of a stack, as a cell array so that a single frame is
+% still encoded as a JSON array.
+function frames = stackOf(stack)
+    frames = cell(1, numel(stack));
+    for ii = 1:numel(stack)
+        frames{ii} = struct( ...
+            'file', string(stack(ii).file), ...
+            'name', string(stack(ii).name), ...
+            'line', stack(ii).line);
+    end
+end
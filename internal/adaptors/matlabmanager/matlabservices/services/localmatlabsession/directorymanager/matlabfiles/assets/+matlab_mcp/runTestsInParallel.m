@@ -0,0 +1,59 @@
+function result = runTestsInParallel(testPath)
+    % runTestsInParallel runs the tests of testPath with runInParallel, which shards the suite
+    % across the workers of the current parallel pool, starting it if needed. It returns the
+    % command window output of the run, the number of workers, the wall time of the run, and
+    % the result of every test, as JSON text.
+    %
+    % Without the Parallel Computing Toolbox, or if no pool can be started, the tests run one
+    % after the other in the session, and the number of workers is 0.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    suite = testsuite(char(testPath));
+    runner = matlab.unittest.TestRunner.withTextOutput;
+
+    startTime = tic;
+    [output, results] = evalc('runInParallel(runner, suite)');
+    wallTime = toc(startTime);
+
+    workers = 0;
+    if exist('gcp', 'file') == 2
+        pool = gcp('nocreate');
+        if ~isempty(pool)
+            workers = pool.NumWorkers;
+        end
+    end
+
+    % Use a cell array, so that a single test is still encoded as a JSON array.
+    tests = cell(1, numel(results));
+    for ii = 1:numel(results)
+        tests{ii} = describe(results(ii));
+    end
+
+    result = jsonencode(struct( ...
+        'output', output, ...
+        'workers', workers, ...
+        'wallTime', wallTime, ...
+        'tests', {tests}));
+end
+
+% Helper function returning the name, outcome, duration and failure diagnostics of a test.
+function description = describe(testResult)
+    details = '';
+    % The diagnostics are only recorded by the DiagnosticsRecordingPlugin of the runner.
+    if (testResult.Failed || testResult.Incomplete) && isfield(testResult.Details, 'DiagnosticRecord')
+        details = strjoin(arrayfun(@diagnosticText, testResult.Details.DiagnosticRecord, 'UniformOutput', false), newline);
+    end
+
+    description = struct( ...
+        'name', testResult.Name, ...
+        'passed', testResult.Passed, ...
+        'failed', testResult.Failed, ...
+        'incomplete', testResult.Incomplete, ...
+        'duration', testResult.Duration, ...
+        'details', details);
+end
+
+function text = diagnosticText(record)
+    text = char(record.Report);
+end
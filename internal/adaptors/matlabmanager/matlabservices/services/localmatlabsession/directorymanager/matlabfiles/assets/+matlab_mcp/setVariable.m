@@ -0,0 +1,148 @@
+function result = setVariable(name, jsonText)
+    % setVariable sets a variable of the base workspace to a value sent by the MATLAB MCP Core
+    % Server as JSON text, and returns the class, size and number of bytes of the variable.
+    %
+    % Values in the typed JSON of typedValue, with their class and size, are rebuilt as the
+    % value they describe, so that a value read from the workspace can be set back unchanged.
+    % Any other JSON text is decoded by jsondecode.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    if ~isvarname(name)
+        error("matlab_mcp:setVariable:invalidName", "'%s' is not a valid variable name.", name);
+    end
+
+    decoded = jsondecode(jsonText);
+    if isTypedNode(decoded)
+        value = fromNode(decoded);
+    else
+        value = decoded;
+    end
+
+    assignin("base", name, value);
+    info = evalin("base", sprintf("whos('%s')", name));
+
+    result = jsonencode(struct( ...
+        'name', name, ...
+        'class', info.class, ...
+        'size', {num2cell(info.size)}, ...
+        'bytes', info.bytes));
+end
+
+function typed = isTypedNode(decoded)
+    typed = isstruct(decoded) && isscalar(decoded) ...
+        && isfield(decoded, 'class') && isfield(decoded, 'size');
+end
+
+% Helper function rebuilding the value described by a node of typed JSON.
+function value = fromNode(node)
+    sz = reshape(double(node.size), 1, []);
+    switch node.class
+        case {'double', 'single', 'int8', 'uint8', 'int16', 'uint16', 'int32', 'uint32', 'int64', 'uint64'}
+            if isfield(node, 'real')
+                value = complex(numbersOf(node.real), numbersOf(node.imag));
+            else
+                value = numbersOf(node.data);
+            end
+            value = reshape(cast(value, node.class), sz);
+        case 'logical'
+            value = reshape(logical(cell2mat(elementsOf(node.data))), sz);
+        case 'char'
+            if ischar(node.data)
+                value = reshape(node.data, sz);
+            else
+                rows = elementsOf(node.data);
+                value = char(rows{:});
+            end
+        case 'string'
+            value = reshape(textOf(node.data), sz);
+        case 'cell'
+            value = reshape(cellfun(@fromNode, elementsOf(node.data), 'UniformOutput', false), sz);
+        case 'struct'
+            value = structOf(node, sz);
+        case {'table', 'timetable'}
+            value = tableOf(node);
+        case 'datetime'
+            format = 'yyyy-MM-dd''T''HH:mm:ss.SSSXXX';
+            timeZone = '';
+            if isfield(node, 'timeZone') && ~isempty(node.timeZone)
+                timeZone = node.timeZone;
+            else
+                format = 'yyyy-MM-dd''T''HH:mm:ss.SSS';
+            end
+            value = reshape(datetime(textOf(node.data), 'InputFormat', format, 'TimeZone', timeZone), sz);
+        case 'duration'
+            value = reshape(seconds(numbersOf(node.data)), sz);
+        case 'categorical'
+            value = reshape(categorical(textOf(node.data), textOf(node.categories)), sz);
+        otherwise
+            error("matlab_mcp:setVariable:unsupportedClass", "Values of class %s cannot be set from JSON.", node.class);
+    end
+end
+
+function value = structOf(node, sz)
+    fields = elementsOf(node.fields);
+    elements = elementsOf(node.data);
+
+    value = repmat(cell2struct(cell(numel(fields), 1), fields, 1), 1, numel(elements));
+    for ii = 1:numel(elements)
+        for jj = 1:numel(fields)
+            value(ii).(fields{jj}) = fromNode(elements{ii}.(fields{jj}));
+        end
+    end
+    value = reshape(value, sz);
+end
+
+function value = tableOf(node)
+    columns = elementsOf(node.columns);
+    names = cellfun(@(column) column.name, columns, 'UniformOutput', false);
+    variables = cellfun(@(column) fromNode(column.value), columns, 'UniformOutput', false);
+
+    if strcmp(node.class, 'timetable')
+        value = timetable(fromNode(node.rowTimes), variables{:}, 'VariableNames', names);
+    else
+        value = table(variables{:}, 'VariableNames', names);
+        rowNames = elementsOf(node.rowNames);
+        if ~isempty(rowNames)
+            value.Properties.RowNames = rowNames;
+        end
+    end
+end
+
+% Helper function listing the elements of a decoded JSON array as a cell row. jsondecode turns
+% arrays into numeric or logical columns, struct arrays or cells, depending on their elements.
+function elements = elementsOf(decoded)
+    if iscell(decoded)
+        elements = decoded(:)';
+    elseif ischar(decoded)
+        elements = {decoded};
+    else
+        elements = num2cell(decoded(:)');
+    end
+end
+
+% Helper function listing numbers as a row, reading "NaN", "Inf" and "-Inf" from their text.
+function value = numbersOf(decoded)
+    elements = elementsOf(decoded);
+    value = zeros(1, numel(elements));
+    for ii = 1:numel(elements)
+        if ischar(elements{ii})
+            value(ii) = str2double(elements{ii});
+        else
+            value(ii) = double(elements{ii});
+        end
+    end
+end
+
+% Helper function listing text as a string row, with missing strings for null.
+function value = textOf(decoded)
+    elements = elementsOf(decoded);
+    value = strings(1, numel(elements));
+    for ii = 1:numel(elements)
+        if ischar(elements{ii})
+            value(ii) = string(elements{ii});
+        else
+            value(ii) = missing;
+        end
+    end
+end
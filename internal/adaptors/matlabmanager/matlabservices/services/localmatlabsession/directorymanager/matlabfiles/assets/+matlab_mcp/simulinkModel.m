@@ -0,0 +1,232 @@
+function result = simulinkModel(action, modelPath, varargin)
+    % simulinkModel loads, simulates and queries Simulink models for the MCP server. It
+    % returns JSON text.
+    %
+    %   simulinkModel("load", modelPath, maxBlocks)
+    %   simulinkModel("simulate", modelPath, stopTime, maxSamples, maxSignals)
+    %   simulinkModel("blockParameters", modelPath, blockPath)
+    %   simulinkModel("setParameter", modelPath, blockPath, parameter, value)
+    %
+    % Numbers are passed as text. An empty stopTime keeps the stop time of the model, and an
+    % empty blockPath sets a parameter of the model itself. The blockParameters and
+    % setParameter actions only use models that are already loaded, so that they do not run
+    % the callbacks of the model.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    modelPath = char(modelPath);
+    switch action
+        case "load"
+            load_system(modelPath);
+            result = jsonencode(describeModel(modelName(modelPath), str2double(varargin{1})));
+        case "simulate"
+            load_system(modelPath);
+            result = jsonencode(simulate(modelName(modelPath), varargin{1}, ...
+                str2double(varargin{2}), str2double(varargin{3})));
+        case "blockParameters"
+            model = loadedModel(modelPath);
+            result = jsonencode(blockParameters(model, blockIn(model, varargin{1})));
+        case "setParameter"
+            model = loadedModel(modelPath);
+            result = jsonencode(setParameter(model, varargin{1}, char(varargin{2}), char(varargin{3})));
+        otherwise
+            error("matlab_mcp:simulinkModel:unknownAction", "Unknown action %s.", action);
+    end
+end
+
+function model = modelName(modelPath)
+    [~, model] = fileparts(modelPath);
+end
+
+% Helper function returning the name of a model loaded from modelPath, and raising an error if
+% it is not loaded, or if another model of the same name is.
+function model = loadedModel(modelPath)
+    model = modelName(modelPath);
+    if ~bdIsLoaded(model)
+        error("matlab_mcp:simulinkModel:notLoaded", ...
+            "The model %s is not loaded: load it with load_simulink_model first.", model);
+    end
+    if ~strcmp(get_param(model, 'FileName'), modelPath)
+        error("matlab_mcp:simulinkModel:otherModel", ...
+            "Another model named %s is loaded, from %s.", model, get_param(model, 'FileName'));
+    end
+end
+
+% Helper function returning the full path of a block of the model, given its path relative to
+% the model, or its full path.
+function block = blockIn(model, blockPath)
+    block = char(blockPath);
+    if ~startsWith(block, [model '/'])
+        block = [model '/' block];
+    end
+    % Raises an error if the block does not exist.
+    get_param(block, 'Handle');
+end
+
+function described = describeModel(model, maxBlocks)
+    blocks = find_system(model, 'LookUnderMasks', 'all', 'FollowLinks', 'on', 'Type', 'Block');
+
+    % Cells are encoded as JSON arrays whatever their size.
+    listed = cell(1, min(numel(blocks), maxBlocks));
+    for ii = 1:numel(listed)
+        listed{ii} = struct( ...
+            'path', string(blocks{ii}), ...
+            'type', string(get_param(blocks{ii}, 'BlockType')));
+    end
+
+    described = struct( ...
+        'name', string(model), ...
+        'file', string(get_param(model, 'FileName')), ...
+        'solver', string(get_param(model, 'Solver')), ...
+        'stopTime', string(get_param(model, 'StopTime')), ...
+        'dirty', strcmp(get_param(model, 'Dirty'), 'on'), ...
+        'blockCount', numel(blocks), ...
+        'blocks', {listed});
+end
+
+function simulated = simulate(model, stopTime, maxSamples, maxSignals)
+    options = {'ReturnWorkspaceOutputs', 'on', 'CaptureErrors', 'on'};
+    if strlength(stopTime) > 0
+        options = [options, {'StopTime', char(stopTime)}];
+    end
+
+    timer = tic;
+    out = sim(model, options{:});
+    elapsed = toc(timer);
+
+    signals = {};
+    omitted = 0;
+    for name = string(out.who)'
+        dataset = out.get(name);
+        if ~isa(dataset, 'Simulink.SimulationData.Dataset')
+            continue
+        end
+        for ii = 1:dataset.numElements
+            element = dataset.getElement(ii);
+            if ~isa(element.Values, 'timeseries')
+                % Buses are logged as structures of timeseries.
+                omitted = omitted + 1;
+            elseif numel(signals) == maxSignals
+                omitted = omitted + 1;
+            else
+                signals{end + 1} = describeSignal(name, element, maxSamples); %#ok<AGROW>
+            end
+        end
+    end
+
+    errorMessage = "";
+    if ~isempty(out.ErrorMessage)
+        errorMessage = string(out.ErrorMessage);
+    end
+
+    simulated = struct( ...
+        'model', string(model), ...
+        'stopTime', string(get_param(model, 'StopTime')), ...
+        'elapsed', elapsed, ...
+        'error', errorMessage, ...
+        'warnings', {warningsOf(out)}, ...
+        'signals', {signals}, ...
+        'omittedSignals', omitted);
+end
+
+% Helper function returning a logged signal, with at most maxSamples samples taken evenly
+% across the simulation. Each sample lists the elements of the signal at that time.
+function signal = describeSignal(dataset, element, maxSamples)
+    values = element.Values;
+    time = values.Time(:);
+    data = double(values.Data);
+    if ~values.IsTimeFirst
+        data = permute(data, [ndims(data), 1:ndims(data) - 1]);
+    end
+    data = reshape(data, numel(time), []);
+
+    samples = 1:numel(time);
+    if numel(samples) > maxSamples
+        samples = unique(round(linspace(1, numel(time), maxSamples)));
+    end
+
+    blockPath = "";
+    portIndex = 0;
+    if isprop(element, 'BlockPath') && element.BlockPath.getLength > 0
+        blockPath = string(element.BlockPath.getBlock(element.BlockPath.getLength));
+        portIndex = element.PortIndex;
+    end
+
+    % Cells keep each sample a JSON array, even for scalar signals.
+    sampled = cell(1, numel(samples));
+    for ii = 1:numel(samples)
+        sampled{ii} = num2cell(data(samples(ii), :));
+    end
+
+    signal = struct( ...
+        'name', string(element.Name), ...
+        'dataset', string(dataset), ...
+        'blockPath', blockPath, ...
+        'portIndex', portIndex, ...
+        'sampleCount', numel(time), ...
+        'time', {num2cell(time(samples)')}, ...
+        'values', {sampled});
+end
+
+function warnings = warningsOf(out)
+    warnings = {};
+    try
+        diagnostics = out.SimulationMetadata.ExecutionInfo.WarningDiagnostics;
+        for ii = 1:numel(diagnostics)
+            warnings{end + 1} = string(diagnostics(ii).Diagnostic.message); %#ok<AGROW>
+        end
+    catch
+        % The warnings are not reported by every release.
+    end
+end
+
+function parameters = blockParameters(model, block)
+    dialogParameters = get_param(block, 'DialogParameters');
+    names = {};
+    if isstruct(dialogParameters)
+        names = fieldnames(dialogParameters);
+    end
+
+    listed = cell(1, numel(names));
+    for ii = 1:numel(names)
+        listed{ii} = struct( ...
+            'name', string(names{ii}), ...
+            'value', valueText(get_param(block, names{ii})));
+    end
+
+    parameters = struct( ...
+        'model', string(model), ...
+        'block', string(block), ...
+        'type', string(get_param(block, 'BlockType')), ...
+        'parameters', {listed});
+end
+
+function changed = setParameter(model, blockPath, parameter, value)
+    target = model;
+    if strlength(blockPath) > 0
+        target = blockIn(model, blockPath);
+    end
+
+    previous = valueText(get_param(target, parameter));
+    set_param(target, parameter, value);
+
+    changed = struct( ...
+        'model', string(model), ...
+        'target', string(target), ...
+        'parameter', string(parameter), ...
+        'previousValue', previous, ...
+        'value', valueText(get_param(target, parameter)), ...
+        'dirty', strcmp(get_param(model, 'Dirty'), 'on'));
+end
+
+% Helper function returning a parameter value as text. Most parameters are text, such as the
+% expressions of dialog parameters; the others are displayed.
+function text = valueText(value)
+    if ischar(value) || isstring(value)
+        text = string(value);
+    elseif isnumeric(value) || islogical(value)
+        text = string(mat2str(value));
+    else
+        text = string(strtrim(evalc('disp(value)')));
+    end
+end
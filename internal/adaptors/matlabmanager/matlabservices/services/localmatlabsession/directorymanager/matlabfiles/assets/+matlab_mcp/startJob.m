@@ -0,0 +1,22 @@
+function startJob(id, mode, code, folder)
+    % startJob runs code in the background on a Parallel Computing Toolbox worker, in the given
+    % folder, and keeps the job under id, so that the MATLAB MCP Core Server can query it with
+    % jobStatus and cancel it with cancelJob.
+    %
+    % With the "batch" mode, the code runs as a batch job of the default cluster profile. With
+    % the "parfeval" mode, it runs on a worker of the current parallel pool, which is started
+    % if needed.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    switch mode
+        case "batch"
+            job = batch(@matlab_mcp.runJobCode, 0, {code, folder}, 'CaptureDiary', true, 'AutoAddClientPath', true);
+        case "parfeval"
+            job = parfeval(@matlab_mcp.runJobCode, 0, code, folder);
+        otherwise
+            error("matlab_mcp:startJob:invalidMode", "'%s' is not a valid job mode.", mode);
+    end
+
+    matlab_mcp.jobStore("set", id, job);
+end
@@ -0,0 +1,108 @@
+function node = typedValue(value)
+    % typedValue converts a MATLAB value to a struct that jsonencode turns into typed JSON, so
+    % that the MATLAB MCP Core Server returns values that AI applications can use without
+    % parsing their display.
+    %
+    % Every node holds the class and the size of the value. The elements of arrays are listed
+    % in column-major order, as a JSON array even when there is a single element, so that the
+    % value is rebuilt by reshaping them to the size:
+    %
+    %   - Numeric arrays list their elements in data, with NaN, Inf and -Inf as strings, as
+    %     JSON has no number for them. Complex arrays list the real and imaginary parts instead.
+    %   - Logical arrays list their elements in data, as booleans.
+    %   - Character arrays hold their text in data, or the text of each row for matrices.
+    %   - String arrays list their elements in data, with null for missing strings.
+    %   - Cell arrays list the node of each element in data.
+    %   - Structures list their field names in fields, and, for each element, the nodes of its
+    %     fields in data.
+    %   - Tables list their columns, each with its name and node, and their row names.
+    %     Timetables also hold the node of their row times.
+    %   - Datetimes list their elements as ISO 8601 text in data, with null for NaT, and hold
+    %     their time zone. Durations list their elements in seconds.
+    %   - Categorical arrays list their categories, and their elements in data, with null for
+    %     undefined elements.
+    %
+    % Other values, such as objects, raise an error, so that the server saves them to a
+    % MAT-file instead.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    node = struct('class', class(value), 'size', {num2cell(size(value))});
+
+    if isnumeric(value)
+        if isreal(value)
+            node.data = numbersOf(value);
+        else
+            node.real = numbersOf(real(value));
+            node.imag = numbersOf(imag(value));
+        end
+    elseif islogical(value)
+        node.data = num2cell(value(:)');
+    elseif ischar(value)
+        if isrow(value) || isempty(value)
+            node.data = value;
+        else
+            node.data = cellstr(value)';
+        end
+    elseif isstring(value)
+        node.data = textOf(value);
+    elseif iscell(value)
+        node.data = cellfun(@matlab_mcp.typedValue, value(:)', 'UniformOutput', false);
+    elseif isstruct(value)
+        node.fields = fieldnames(value)';
+        node.data = cell(1, numel(value));
+        for ii = 1:numel(value)
+            element = struct();
+            for field = node.fields
+                element.(field{1}) = matlab_mcp.typedValue(value(ii).(field{1}));
+            end
+            node.data{ii} = element;
+        end
+    elseif istable(value) || istimetable(value)
+        names = value.Properties.VariableNames;
+        node.columns = cell(1, numel(names));
+        for ii = 1:numel(names)
+            node.columns{ii} = struct( ...
+                'name', names{ii}, ...
+                'value', matlab_mcp.typedValue(value.(names{ii})));
+        end
+        if istimetable(value)
+            node.rowTimes = matlab_mcp.typedValue(value.Properties.RowTimes);
+        else
+            node.rowNames = value.Properties.RowNames(:)';
+        end
+    elseif isdatetime(value)
+        format = 'yyyy-MM-dd''T''HH:mm:ss.SSSXXX';
+        if isempty(value.TimeZone)
+            % Unzoned datetimes have no offset.
+            format = 'yyyy-MM-dd''T''HH:mm:ss.SSS';
+        end
+        node.data = textOf(string(value, format));
+        node.timeZone = value.TimeZone;
+    elseif isduration(value)
+        node.data = numbersOf(seconds(value));
+        node.unit = 'seconds';
+    elseif iscategorical(value)
+        node.categories = categories(value)';
+        node.data = textOf(string(value));
+    else
+        error("matlab_mcp:typedValue:unsupportedClass", "Values of class %s have no typed JSON representation.", class(value));
+    end
+end
+
+% Helper function listing the elements of a real numeric array, with the values JSON has no
+% number for as text.
+function data = numbersOf(value)
+    value = double(value(:)');
+    data = num2cell(value);
+    data(isnan(value)) = {'NaN'};
+    data(value == Inf) = {'Inf'};
+    data(value == -Inf) = {'-Inf'};
+end
+
+% Helper function listing the elements of a string array, with null for missing strings.
+function data = textOf(value)
+    value = value(:)';
+    data = cellstr(value);
+    data(ismissing(value)) = {[]};
+end
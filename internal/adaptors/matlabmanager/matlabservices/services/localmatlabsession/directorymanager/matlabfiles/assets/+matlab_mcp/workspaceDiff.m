@@ -0,0 +1,100 @@
+function result = workspaceDiff(compareThreshold)
+    % workspaceDiff returns the variables of the base workspace that were added, modified or
+    % removed since the previous call, as JSON text, with a short preview of the values of the
+    % added and modified variables. The first call reports every variable as added.
+    %
+    % Variables of at most compareThreshold bytes are compared by value. Larger variables are
+    % only compared by class, size and number of bytes, so that the snapshot does not keep a
+    % copy of them. A compareThreshold of 0 compares every variable by value.
+    %
+    % The function is locked, so that clearing functions in the session does not lose the snapshot.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    persistent snapshot
+    mlock
+
+    % The server passes every argument as text.
+    if ischar(compareThreshold) || isstring(compareThreshold)
+        compareThreshold = str2double(compareThreshold);
+    end
+
+    if isempty(snapshot)
+        snapshot = containers.Map('KeyType', 'char', 'ValueType', 'any');
+    end
+
+    infos = evalin("base", "whos");
+    current = containers.Map('KeyType', 'char', 'ValueType', 'any');
+
+    % Use cell arrays, so that a single variable is still encoded as a JSON array.
+    added = {};
+    modified = {};
+    for ii = 1:numel(infos)
+        info = infos(ii);
+
+        entry = struct('class', info.class, 'size', info.size, 'bytes', info.bytes, 'hasValue', false, 'value', []);
+        value = [];
+        if compareThreshold == 0 || info.bytes <= compareThreshold
+            value = evalin("base", info.name);
+            entry.hasValue = true;
+            entry.value = value;
+        end
+        current(info.name) = entry;
+
+        if ~isKey(snapshot, info.name)
+            added{end+1} = describe(info, entry); %#ok<AGROW>
+        elseif hasChanged(snapshot(info.name), entry)
+            modified{end+1} = describe(info, entry); %#ok<AGROW>
+        end
+    end
+
+    removed = setdiff(keys(snapshot), keys(current));
+
+    snapshot = current;
+
+    result = jsonencode(struct( ...
+        'added', {added}, ...
+        'modified', {modified}, ...
+        'removed', {removed}));
+end
+
+% Helper function reporting whether a variable changed since the previous snapshot.
+function changed = hasChanged(previous, entry)
+    if ~strcmp(previous.class, entry.class) || ~isequal(previous.size, entry.size) || previous.bytes ~= entry.bytes
+        changed = true;
+    elseif previous.hasValue && entry.hasValue
+        changed = ~isequaln(previous.value, entry.value);
+    else
+        % Without both values, a variable of the same class, size and number of bytes is assumed unchanged.
+        changed = false;
+    end
+end
+
+% Helper function returning the name, class, size and number of bytes of a variable, with a
+% preview of its value, truncated so that large values do not flood the result.
+function description = describe(info, entry)
+    maxPreviewLength = 200;
+
+    description = struct( ...
+        'name', info.name, ...
+        'class', info.class, ...
+        'size', info.size, ...
+        'bytes', info.bytes, ...
+        'preview', '');
+
+    if ~entry.hasValue
+        return
+    end
+
+    try
+        preview = strtrim(evalc('disp(entry.value)'));
+    catch
+        % Values that cannot be displayed have no preview.
+        return
+    end
+
+    if strlength(preview) > maxPreviewLength
+        preview = [preview(1:maxPreviewLength) '...'];
+    end
+    description.preview = preview;
+end
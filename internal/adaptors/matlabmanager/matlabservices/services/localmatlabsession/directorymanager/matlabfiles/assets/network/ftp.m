@@ -0,0 +1,9 @@
+function varargout = ftp(varargin) %#ok<STOUT>
+    % ftp is disabled when the MATLAB MCP Core Server blocks network access.
+    % This file shadows the MATLAB function of the same name, so that code submitted to
+    % the server cannot send data to, or download data from, the network.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    error("matlab_mcp:network:blocked", "ftp is disabled: the MATLAB MCP Core Server is blocking network access.");
+end
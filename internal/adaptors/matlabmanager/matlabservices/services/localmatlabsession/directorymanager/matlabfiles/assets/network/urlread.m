@@ -0,0 +1,9 @@
+function varargout = urlread(varargin) %#ok<STOUT>
+    % urlread is disabled when the MATLAB MCP Core Server blocks network access.
+    % This file shadows the MATLAB function of the same name, so that code submitted to
+    % the server cannot send data to, or download data from, the network.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    error("matlab_mcp:network:blocked", "urlread is disabled: the MATLAB MCP Core Server is blocking network access.");
+end
@@ -0,0 +1,9 @@
+function varargout = system(varargin) %#ok<STOUT>
+    % system is disabled when the MATLAB MCP Core Server runs in sandbox mode.
+    % This file shadows the MATLAB function of the same name, so that code submitted to
+    % the server cannot run shell commands or external programs.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    error("matlab_mcp:sandbox:blocked", "system is disabled: the MATLAB MCP Core Server is running in sandbox mode.");
+end
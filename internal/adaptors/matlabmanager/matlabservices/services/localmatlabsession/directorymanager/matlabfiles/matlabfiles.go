@@ -2,7 +2,10 @@
 
 package matlabfiles
 
-import _ "embed"
+import (
+	"embed"
+	"io/fs"
+)
 
 //go:embed assets/+matlab_mcp/initializeMCP.m
 var initializeMCP []byte
@@ -13,6 +16,99 @@ var mcpEval []byte
 //go:embed assets/+matlab_mcp/getOrStashExceptions.m
 var getOrStashExceptions []byte
 
+//go:embed assets/+matlab_mcp/exportVariable.m
+var exportVariable []byte
+
+//go:embed assets/+matlab_mcp/typedValue.m
+var typedValue []byte
+
+//go:embed assets/+matlab_mcp/setVariable.m
+var setVariable []byte
+
+//go:embed assets/+matlab_mcp/matFile.m
+var matFile []byte
+
+//go:embed assets/+matlab_mcp/listFigures.m
+var listFigures []byte
+
+//go:embed assets/+matlab_mcp/listVariables.m
+var listVariables []byte
+
+//go:embed assets/+matlab_mcp/renderFigure.m
+var renderFigure []byte
+
+//go:embed assets/+matlab_mcp/captureFigures.m
+var captureFigures []byte
+
+//go:embed assets/+matlab_mcp/startJob.m
+var startJob []byte
+
+//go:embed assets/+matlab_mcp/runJobCode.m
+var runJobCode []byte
+
+//go:embed assets/+matlab_mcp/jobStore.m
+var jobStore []byte
+
+//go:embed assets/+matlab_mcp/jobStatus.m
+var jobStatus []byte
+
+//go:embed assets/+matlab_mcp/cancelJob.m
+var cancelJob []byte
+
+//go:embed assets/+matlab_mcp/workspaceDiff.m
+var workspaceDiff []byte
+
+//go:embed assets/+matlab_mcp/runTestsInParallel.m
+var runTestsInParallel []byte
+
+//go:embed assets/+matlab_mcp/runTests.m
+var runTests []byte
+
+//go:embed assets/+matlab_mcp/memoryUsage.m
+var memoryUsage []byte
+
+//go:embed assets/+matlab_mcp/relieveMemory.m
+var relieveMemory []byte
+
+//go:embed assets/+matlab_mcp/checkpointWorkspace.m
+var checkpointWorkspace []byte
+
+//go:embed assets/+matlab_mcp/pythonEnvironment.m
+var pythonEnvironment []byte
+
+//go:embed assets/+matlab_mcp/checkPythonPackages.m
+var checkPythonPackages []byte
+
+//go:embed assets/+matlab_mcp/checkCode.m
+var checkCode []byte
+
+//go:embed assets/+matlab_mcp/runPython.m
+var runPython []byte
+
+//go:embed assets/+matlab_mcp/realTimeTarget.m
+var realTimeTarget []byte
+
+//go:embed assets/+matlab_mcp/productionServerArchive.m
+var productionServerArchive []byte
+
+//go:embed assets/+matlab_mcp/simulinkModel.m
+var simulinkModel []byte
+
+//go:embed assets/sandbox/system.m
+var sandboxSystem []byte
+
+//go:embed assets/sandbox/dos.m
+var sandboxDos []byte
+
+//go:embed assets/sandbox/unix.m
+var sandboxUnix []byte
+
+//go:embed assets/sandbox/perl.m
+var sandboxPerl []byte
+
+//go:embed assets/network/*.m
+var networkFiles embed.FS
+
 type MATLABFiles struct{}
 
 func New() MATLABFiles {
@@ -21,8 +117,56 @@ func New() MATLABFiles {
 
 func (g MATLABFiles) GetAll() map[string][]byte {
 	return map[string][]byte{
-		"initializeMCP.m":        initializeMCP,
-		"mcpEval.m":              mcpEval,
-		"getOrStashExceptions.m": getOrStashExceptions,
+		"initializeMCP.m":           initializeMCP,
+		"mcpEval.m":                 mcpEval,
+		"getOrStashExceptions.m":    getOrStashExceptions,
+		"exportVariable.m":          exportVariable,
+		"typedValue.m":              typedValue,
+		"setVariable.m":             setVariable,
+		"matFile.m":                 matFile,
+		"listFigures.m":             listFigures,
+		"listVariables.m":           listVariables,
+		"renderFigure.m":            renderFigure,
+		"captureFigures.m":          captureFigures,
+		"startJob.m":                startJob,
+		"runJobCode.m":              runJobCode,
+		"jobStore.m":                jobStore,
+		"jobStatus.m":               jobStatus,
+		"cancelJob.m":               cancelJob,
+		"workspaceDiff.m":           workspaceDiff,
+		"runTestsInParallel.m":      runTestsInParallel,
+		"runTests.m":                runTests,
+		"memoryUsage.m":             memoryUsage,
+		"relieveMemory.m":           relieveMemory,
+		"checkpointWorkspace.m":     checkpointWorkspace,
+		"pythonEnvironment.m":       pythonEnvironment,
+		"checkPythonPackages.m":     checkPythonPackages,
+		"checkCode.m":               checkCode,
+		"runPython.m":               runPython,
+		"realTimeTarget.m":          realTimeTarget,
+		"productionServerArchive.m": productionServerArchive,
+		"simulinkModel.m":           simulinkModel,
+	}
+}
+
+// GetSandbox returns the files shadowing the MATLAB functions that are blocked in sandbox mode.
+func (g MATLABFiles) GetSandbox() map[string][]byte {
+	return map[string][]byte{
+		"system.m": sandboxSystem,
+		"dos.m":    sandboxDos,
+		"unix.m":   sandboxUnix,
+		"perl.m":   sandboxPerl,
+	}
+}
+
+// GetNetworkBlock returns the files shadowing the MATLAB functions that are blocked when network access is blocked.
+func (g MATLABFiles) GetNetworkBlock() map[string][]byte {
+	entries, _ := fs.ReadDir(networkFiles, "assets/network")
+
+	files := make(map[string][]byte, len(entries))
+	for _, entry := range entries {
+		content, _ := fs.ReadFile(networkFiles, "assets/network/"+entry.Name())
+		files[entry.Name()] = content
 	}
+	return files
 }
@@ -15,6 +15,12 @@ import (
 //go:embed assets/+matlab_mcp
 var expectedMATLABFiles embed.FS
 
+//go:embed assets/sandbox
+var expectedSandboxFiles embed.FS
+
+//go:embed assets/network
+var expectedNetworkFiles embed.FS
+
 func TestMATLABFiles_GetAll_HappyPath(t *testing.T) {
 	// Arrange
 	matlabFiles := matlabfiles.New()
@@ -48,3 +54,44 @@ func TestMATLABFiles_GetAll_ReturnAllFiles(t *testing.T) {
 		assert.Contains(t, files, entry.Name())
 	}
 }
+
+func TestMATLABFiles_GetSandbox_ReturnAllFiles(t *testing.T) {
+	// Arrange
+	matlabFiles := matlabfiles.New()
+	subFS, err := fs.Sub(expectedSandboxFiles, "assets/sandbox")
+	require.NoError(t, err)
+
+	// Act
+	files := matlabFiles.GetSandbox()
+
+	// Assert
+	entries, err := fs.ReadDir(subFS, ".")
+	require.NoError(t, err)
+	require.Len(t, files, len(entries))
+	for _, entry := range entries {
+		expectedFileContent, err := fs.ReadFile(subFS, entry.Name())
+		require.NoError(t, err)
+		assert.Equal(t, expectedFileContent, files[entry.Name()])
+	}
+}
+
+func TestMATLABFiles_GetNetworkBlock_ReturnAllFiles(t *testing.T) {
+	// Arrange
+	matlabFiles := matlabfiles.New()
+	subFS, err := fs.Sub(expectedNetworkFiles, "assets/network")
+	require.NoError(t, err)
+
+	// Act
+	files := matlabFiles.GetNetworkBlock()
+
+	// Assert
+	entries, err := fs.ReadDir(subFS, ".")
+	require.NoError(t, err)
+	require.NotEmpty(t, entries)
+	require.Len(t, files, len(entries))
+	for _, entry := range entries {
+		expectedFileContent, err := fs.ReadFile(subFS, entry.Name())
+		require.NoError(t, err)
+		assert.Equal(t, expectedFileContent, files[entry.Name()])
+	}
+}
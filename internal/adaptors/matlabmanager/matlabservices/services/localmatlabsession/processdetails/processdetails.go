@@ -4,6 +4,7 @@ package processdetails
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/google/uuid"
@@ -18,6 +19,9 @@ const (
 	MWContextTagsValue  = "MATLAB:MATLAB_MCP_CORE_SERVER:V1"
 )
 
+// localeEnvVars are the environment variables that set the character encoding MATLAB uses on Linux and macOS.
+var localeEnvVars = []string{"LC_ALL", "LC_CTYPE", "LC_MESSAGES", "LANG"}
+
 type OSLayer interface {
 	Environ() []string
 }
@@ -49,6 +53,8 @@ func (g *ProcessDetails) EnvironmentVariables(sessionDirPath string, apiKey stri
 		"MW_PKEYFILE="+certificateKey,
 	)
 
+	utf8Locales(processEnvVars)
+
 	// Add or update the MW_CONTEXT_TAGS environment variable
 	// If MW_CONTEXT_TAGS already exists, append a comma and the new value.
 	processEnvVars = func(processEnvVars []string) []string {
@@ -77,6 +83,35 @@ func (g *ProcessDetails) EnvironmentVariables(sessionDirPath string, apiKey stri
 	return processEnvVars
 }
 
+// utf8Locales switches the locales of MATLAB to the UTF-8 encoding of the same language, such as ja_JP.eucJP to ja_JP.UTF-8,
+// so that MATLAB keeps showing its messages in the language of the user, without mangling the characters outside of ASCII.
+// The C and POSIX locales are left as they are, as their UTF-8 variants are not installed on every system.
+func utf8Locales(processEnvVars []string) {
+	for i, envVar := range processEnvVars {
+		name, value, ok := strings.Cut(envVar, "=")
+		if !ok || !slices.Contains(localeEnvVars, name) {
+			continue
+		}
+
+		language, modifier, _ := strings.Cut(value, "@")
+		language, codeset, _ := strings.Cut(language, ".")
+		if language == "" || language == "C" || language == "POSIX" {
+			continue
+		}
+
+		normalizedCodeset := strings.ToLower(strings.ReplaceAll(codeset, "-", ""))
+		if normalizedCodeset == "utf8" {
+			continue
+		}
+
+		value = language + ".UTF-8"
+		if modifier != "" {
+			value += "@" + modifier
+		}
+		processEnvVars[i] = name + "=" + value
+	}
+}
+
 func (*ProcessDetails) StartupFlag(os string, showMATLAB bool, startupCode string) []string {
 	startupFlags := []string{}
 	if showMATLAB {
@@ -170,6 +170,45 @@ func TestProcessDetails_EnvironmentVariables_MWContextTagPropagation(t *testing.
 	assert.ElementsMatch(t, expectedEnv, env)
 }
 
+func TestProcessDetails_EnvironmentVariables_UTF8Locales(t *testing.T) {
+	testCases := []struct {
+		name     string
+		envVar   string
+		expected string
+	}{
+		{name: "Japanese EUC", envVar: "LANG=ja_JP.eucJP", expected: "LANG=ja_JP.UTF-8"},
+		{name: "Chinese GB18030", envVar: "LC_ALL=zh_CN.GB18030", expected: "LC_ALL=zh_CN.UTF-8"},
+		{name: "No codeset with modifier", envVar: "LC_MESSAGES=de_DE@euro", expected: "LC_MESSAGES=de_DE.UTF-8@euro"},
+		{name: "Already UTF-8", envVar: "LC_CTYPE=ja_JP.utf8", expected: "LC_CTYPE=ja_JP.utf8"},
+		{name: "C locale", envVar: "LANG=C", expected: "LANG=C"},
+		{name: "Other variable", envVar: "LANGUAGE=ja_JP.eucJP", expected: "LANGUAGE=ja_JP.eucJP"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockOSLayer.EXPECT().
+				Environ().
+				Return([]string{testCase.envVar}).
+				Once()
+
+			details := processdetails.New(mockOSLayer)
+
+			// Act
+			env := details.EnvironmentVariables("/tmp/matlab-session-12345", "test-api-key-12345", "cert.pem", "cert.key")
+
+			// Assert
+			assert.Contains(t, env, testCase.expected)
+			if testCase.expected != testCase.envVar {
+				assert.NotContains(t, env, testCase.envVar)
+			}
+		})
+	}
+}
+
 func TestProcessDetails_StartupFlag_HappyPath(t *testing.T) {
 	for _, testConfig := range []struct {
 		os            string
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/config"
@@ -20,11 +21,13 @@ type OSLayer interface {
 
 type FileLayer interface {
 	EvalSymlinks(path string) (string, error)
+	Glob(pattern string) ([]string, error)
 }
 
 type Getter struct {
-	osLayer   OSLayer
-	fileLayer FileLayer
+	osLayer              OSLayer
+	fileLayer            FileLayer
+	installationPatterns []string
 }
 
 func New(
@@ -32,11 +35,15 @@ func New(
 	fileLayer FileLayer,
 ) *Getter {
 	return &Getter{
-		osLayer:   osLayer,
-		fileLayer: fileLayer,
+		osLayer:              osLayer,
+		fileLayer:            fileLayer,
+		installationPatterns: config.StandardInstallationPatterns,
 	}
 }
 
+// GetAll returns the MATLAB roots of the MATLAB executables on the system PATH, in the order of the PATH, followed by
+// the MATLAB installations in the standard installation folders that are not on the PATH.
+
 func (s *Getter) GetAll(logger entities.Logger) []string {
 	pathList := strings.Split(s.osLayer.Getenv("PATH"), string(os.PathListSeparator))
 
@@ -84,9 +91,47 @@ func (s *Getter) GetAll(logger entities.Logger) []string {
 		matlabLocations = append(matlabLocations, matlabRoot)
 	}
 
+	matlabLocations = s.appendStandardInstallations(logger, matlabLocations)
+
 	if len(matlabLocations) == 0 {
 		return nil
 	}
 
 	return matlabLocations
 }
+
+// appendStandardInstallations appends the MATLAB installations found in the standard installation folders to
+// matlabLocations, newest release first, so that MATLAB is found even when it was not added to the system PATH.
+func (s *Getter) appendStandardInstallations(logger entities.Logger, matlabLocations []string) []string {
+	for _, pattern := range s.installationPatterns {
+		candidates, err := s.fileLayer.Glob(pattern)
+		if err != nil {
+			logger.With("pattern", pattern).WithError(err).Warn("Unable to search for MATLAB installations")
+			continue
+		}
+
+		// The folders are named after the release, so the newest release sorts last.
+		slices.Sort(candidates)
+		slices.Reverse(candidates)
+
+		for _, candidate := range candidates {
+			fileInfo, err := s.osLayer.Stat(filepath.Join(candidate, "bin", config.MATLABExeName))
+			if err != nil || fileInfo.IsDir() {
+				continue
+			}
+
+			matlabRoot, err := s.fileLayer.EvalSymlinks(candidate)
+			if err != nil {
+				logger.With("path", candidate).WithError(err).Warn("Error evaluating if the MATLAB installation was a symbolic link")
+				continue
+			}
+
+			if slices.Contains(matlabLocations, matlabRoot) {
+				continue
+			}
+			matlabLocations = append(matlabLocations, matlabRoot)
+		}
+	}
+
+	return matlabLocations
+}
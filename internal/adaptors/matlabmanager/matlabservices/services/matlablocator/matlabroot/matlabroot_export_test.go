@@ -0,0 +1,11 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabroot
+
+// NewWithInstallationPatterns returns a getter searching the MATLAB installations matching installationPatterns
+// instead of the standard installation folders.
+func NewWithInstallationPatterns(osLayer OSLayer, fileLayer FileLayer, installationPatterns []string) *Getter {
+	getter := New(osLayer, fileLayer)
+	getter.installationPatterns = installationPatterns
+	return getter
+}
@@ -53,7 +53,7 @@ func TestMATLABRootGetter_GetAll_Success(t *testing.T) {
 		addSuccessfulPathCheck(path, mockOSLayer, mockFileLayer, mockFileInfo)
 	}
 
-	service := matlabroot.New(mockOSLayer, mockFileLayer)
+	service := matlabroot.NewWithInstallationPatterns(mockOSLayer, mockFileLayer, nil)
 
 	// Act
 	results := service.GetAll(mockLogger)
@@ -104,7 +104,7 @@ func TestMATLABRootGetter_GetAll_FirstEvalSymlinksError(t *testing.T) {
 		Return(pathEnv).
 		Once()
 
-	service := matlabroot.New(mockOSLayer, mockFileLayer)
+	service := matlabroot.NewWithInstallationPatterns(mockOSLayer, mockFileLayer, nil)
 
 	// Act
 	result := service.GetAll(mockLogger)
@@ -155,7 +155,7 @@ func TestMATLABRootGetter_GetAll_StatError(t *testing.T) {
 		Return(pathEnv).
 		Once()
 
-	service := matlabroot.New(mockOSLayer, mockFileLayer)
+	service := matlabroot.NewWithInstallationPatterns(mockOSLayer, mockFileLayer, nil)
 
 	// Act
 	result := service.GetAll(mockLogger)
@@ -211,7 +211,7 @@ func TestMATLABRootGetter_GetAll_FindsDirectoryInsteadOfBinary(t *testing.T) {
 		Return(pathEnv).
 		Once()
 
-	service := matlabroot.New(mockOSLayer, mockFileLayer)
+	service := matlabroot.NewWithInstallationPatterns(mockOSLayer, mockFileLayer, nil)
 
 	// Act
 	result := service.GetAll(mockLogger)
@@ -273,7 +273,7 @@ func TestMATLABRootGetter_GetAll_SecondEvalSymlinksError(t *testing.T) {
 		Return(pathEnv).
 		Once()
 
-	service := matlabroot.New(mockOSLayer, mockFileLayer)
+	service := matlabroot.NewWithInstallationPatterns(mockOSLayer, mockFileLayer, nil)
 
 	// Act
 	result := service.GetAll(mockLogger)
@@ -300,7 +300,7 @@ func TestMATLABRootGetter_GetAll_EmptyPath(t *testing.T) {
 		Return("").
 		Once()
 
-	service := matlabroot.New(mockOSLayer, mockFileLayer)
+	service := matlabroot.NewWithInstallationPatterns(mockOSLayer, mockFileLayer, nil)
 
 	// Act
 	result := service.GetAll(mockLogger)
@@ -339,7 +339,7 @@ func TestMATLABRootGetter_GetAll_FileNotExist(t *testing.T) {
 		Return(nil, os.ErrNotExist).
 		Once()
 
-	service := matlabroot.New(mockOSLayer, mockFileLayer)
+	service := matlabroot.NewWithInstallationPatterns(mockOSLayer, mockFileLayer, nil)
 
 	// Act
 	result := service.GetAll(mockLogger)
@@ -350,6 +350,101 @@ func TestMATLABRootGetter_GetAll_FileNotExist(t *testing.T) {
 	assert.Len(t, mockLogger.WarnLogs(), 0, "No warning logs should be generated for files not existing") //nolint:testifylint // Len check is consistent with other logger checks
 }
 
+func TestMATLABRootGetter_GetAll_StandardInstallations(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFileInfo.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	pattern := filepath.Join("/standard", "R*")
+	onPath := filepath.Join("/standard", "R2024b")
+	newest := filepath.Join("/standard", "R2025a")
+	oldest := filepath.Join("/standard", "R2023b")
+	uninstalled := filepath.Join("/standard", "R2022a")
+
+	mockOSLayer.EXPECT().
+		Getenv("PATH").
+		Return(filepath.Join(onPath, "bin")).
+		Once()
+
+	addSuccessfulPathCheck(filepath.Join(onPath, "bin"), mockOSLayer, mockFileLayer, mockFileInfo)
+
+	mockFileLayer.EXPECT().
+		Glob(pattern).
+		Return([]string{uninstalled, oldest, onPath, newest}, nil).
+		Once()
+
+	for _, installation := range []string{newest, onPath, oldest} {
+		mockOSLayer.EXPECT().
+			Stat(filepath.Join(installation, "bin", config.MATLABExeName)).
+			Return(mockFileInfo, nil).
+			Once()
+
+		mockFileInfo.EXPECT().
+			IsDir().
+			Return(false).
+			Once()
+
+		mockFileLayer.EXPECT().
+			EvalSymlinks(installation).
+			Return(installation, nil).
+			Once()
+	}
+
+	mockOSLayer.EXPECT().
+		Stat(filepath.Join(uninstalled, "bin", config.MATLABExeName)).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	service := matlabroot.NewWithInstallationPatterns(mockOSLayer, mockFileLayer, []string{pattern})
+
+	// Act
+	results := service.GetAll(mockLogger)
+
+	// Assert
+	assert.Equal(t, []string{onPath, newest, oldest}, results, "MATLAB on the PATH should come first, followed by the other installations, newest first")
+	assert.Empty(t, mockLogger.WarnLogs())
+}
+
+func TestMATLABRootGetter_GetAll_GlobError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	pattern := filepath.Join("/standard", "R*")
+
+	mockOSLayer.EXPECT().
+		Getenv("PATH").
+		Return("").
+		Once()
+
+	mockFileLayer.EXPECT().
+		Glob(pattern).
+		Return(nil, assert.AnError).
+		Once()
+
+	service := matlabroot.NewWithInstallationPatterns(mockOSLayer, mockFileLayer, []string{pattern})
+
+	// Act
+	results := service.GetAll(mockLogger)
+
+	// Assert
+	assert.Nil(t, results)
+	assert.Contains(t, mockLogger.WarnLogs(), "Unable to search for MATLAB installations")
+}
+
 func addSuccessfulPathCheck(
 	path string,
 	mockOSLayer *mocks.MockOSLayer,
@@ -0,0 +1,88 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package sharedmatlabsession
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"strconv"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directorymanager"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+type SessionDirectoryFactory interface {
+	Create(logger entities.Logger) (directorymanager.Directory, error)
+}
+
+type OSLayer interface {
+	ReadFile(filePath string) ([]byte, error)
+}
+
+// sessionFile is the content of the session file written by the MATLAB sharing its session.
+type sessionFile struct {
+	MATLABRoot  string `json:"matlabRoot"`
+	SecurePort  int    `json:"securePort"`
+	APIKey      string `json:"apiKey"`
+	Certificate string `json:"certificate"`
+}
+
+type Connector struct {
+	directoryFactory SessionDirectoryFactory
+	osLayer          OSLayer
+}
+
+func NewConnector(
+	directoryFactory SessionDirectoryFactory,
+	osLayer OSLayer,
+) *Connector {
+	return &Connector{
+		directoryFactory: directoryFactory,
+		osLayer:          osLayer,
+	}
+}
+
+// ConnectToSharedMATLABSession reads the session file of a MATLAB shared by the user, and returns how to connect to its
+// embedded connector. The MATLAB files of the server are written to a session directory, to add to the path of MATLAB
+// once connected. The cleanup function removes the session directory, and leaves MATLAB running.
+func (c *Connector) ConnectToSharedMATLABSession(logger entities.Logger, request datatypes.SharedSessionDetails) (datatypes.SharedSession, embeddedconnector.ConnectionDetails, func() error, error) {
+	logger.With("session_file", request.SessionFile).Debug("ConnectToSharedMATLABSession called")
+
+	content, err := c.osLayer.ReadFile(request.SessionFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		return datatypes.SharedSession{}, embeddedconnector.ConnectionDetails{}, nil, entities.NewCodedError(entities.ErrorCodeMATLABNotFound, fmt.Errorf("no MATLAB session was shared in %s", request.SessionFile))
+	}
+	if err != nil {
+		return datatypes.SharedSession{}, embeddedconnector.ConnectionDetails{}, nil, fmt.Errorf("failed to read MATLAB session file: %w", err)
+	}
+
+	var session sessionFile
+	if err := json.Unmarshal(content, &session); err != nil {
+		return datatypes.SharedSession{}, embeddedconnector.ConnectionDetails{}, nil, fmt.Errorf("invalid MATLAB session file %s: %w", request.SessionFile, err)
+	}
+
+	if session.SecurePort <= 0 || session.Certificate == "" {
+		return datatypes.SharedSession{}, embeddedconnector.ConnectionDetails{}, nil, fmt.Errorf("invalid MATLAB session file %s: the secure port and the certificate of the embedded connector are required", request.SessionFile)
+	}
+
+	sessionDir, err := c.directoryFactory.Create(logger)
+	if err != nil {
+		return datatypes.SharedSession{}, embeddedconnector.ConnectionDetails{}, nil, err
+	}
+
+	logger.With("session_dir", sessionDir.Path()).With("matlab_root", session.MATLABRoot).Debug("Created session directory for shared MATLAB session")
+
+	return datatypes.SharedSession{
+		MATLABRoot: session.MATLABRoot,
+		SessionDir: sessionDir.Path(),
+	}, embeddedconnector.ConnectionDetails{
+		Host:           "localhost",
+		Port:           strconv.Itoa(session.SecurePort),
+		APIKey:         session.APIKey,
+		CertificatePEM: []byte(session.Certificate),
+	}, sessionDir.Cleanup, nil
+}
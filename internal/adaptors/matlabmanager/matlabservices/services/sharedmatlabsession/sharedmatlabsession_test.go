@@ -0,0 +1,140 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package sharedmatlabsession_test
+
+import (
+	"io/fs"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/sharedmatlabsession"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	directorymocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directorymanager"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabservices/services/sharedmatlabsession"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sessionFile = "/home/user/.matlab/matlab-mcp-session.json"
+
+func TestConnector_ConnectToSharedMATLABSession_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockDirectoryFactory := &mocks.MockSessionDirectoryFactory{}
+	defer mockDirectoryFactory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockDirectory := &directorymocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		ReadFile(sessionFile).
+		Return([]byte(`{"matlabRoot":"/usr/local/MATLAB/R2025a","securePort":31515,"apiKey":"key","certificate":"-----BEGIN CERTIFICATE-----"}`), nil).
+		Once()
+
+	mockDirectoryFactory.EXPECT().
+		Create(mockLogger.AsMockArg()).
+		Return(mockDirectory, nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		Path().
+		Return("/tmp/matlab-session-1")
+
+	mockDirectory.EXPECT().
+		Cleanup().
+		Return(nil).
+		Once()
+
+	connector := sharedmatlabsession.NewConnector(mockDirectoryFactory, mockOSLayer)
+
+	// Act
+	session, connectionDetails, cleanup, err := connector.ConnectToSharedMATLABSession(mockLogger, datatypes.SharedSessionDetails{SessionFile: sessionFile})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, datatypes.SharedSession{MATLABRoot: "/usr/local/MATLAB/R2025a", SessionDir: "/tmp/matlab-session-1"}, session)
+	assert.Equal(t, embeddedconnector.ConnectionDetails{
+		Host:           "localhost",
+		Port:           "31515",
+		APIKey:         "key",
+		CertificatePEM: []byte("-----BEGIN CERTIFICATE-----"),
+	}, connectionDetails)
+	require.NoError(t, cleanup())
+}
+
+func TestConnector_ConnectToSharedMATLABSession_NotShared(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockDirectoryFactory := &mocks.MockSessionDirectoryFactory{}
+	defer mockDirectoryFactory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		ReadFile(sessionFile).
+		Return(nil, fs.ErrNotExist).
+		Once()
+
+	connector := sharedmatlabsession.NewConnector(mockDirectoryFactory, mockOSLayer)
+
+	// Act
+	_, _, _, err := connector.ConnectToSharedMATLABSession(mockLogger, datatypes.SharedSessionDetails{SessionFile: sessionFile})
+
+	// Assert
+	require.ErrorContains(t, err, "no MATLAB session was shared in "+sessionFile)
+	assert.Equal(t, entities.ErrorCodeMATLABNotFound, entities.ErrorCodeOf(err))
+}
+
+func TestConnector_ConnectToSharedMATLABSession_InvalidSessionFile(t *testing.T) {
+	testCases := []struct {
+		name    string
+		content string
+	}{
+		{
+			name:    "not JSON",
+			content: "31515",
+		},
+		{
+			name:    "no port",
+			content: `{"certificate":"-----BEGIN CERTIFICATE-----"}`,
+		},
+		{
+			name:    "no certificate",
+			content: `{"securePort":31515}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockDirectoryFactory := &mocks.MockSessionDirectoryFactory{}
+			defer mockDirectoryFactory.AssertExpectations(t)
+
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockOSLayer.EXPECT().
+				ReadFile(sessionFile).
+				Return([]byte(tc.content), nil).
+				Once()
+
+			connector := sharedmatlabsession.NewConnector(mockDirectoryFactory, mockOSLayer)
+
+			// Act
+			_, _, _, err := connector.ConnectToSharedMATLABSession(mockLogger, datatypes.SharedSessionDetails{SessionFile: sessionFile})
+
+			// Assert
+			require.ErrorContains(t, err, "invalid MATLAB session file "+sessionFile)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsessionclient
+
+import (
+	"context"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// defaultCancelGracePeriod is how long a cancelled call is given to stop once MATLAB was interrupted, before it is abandoned.
+// It is shorter than the time the server gives cancelled tool calls to stop on shutdown.
+const defaultCancelGracePeriod = 5 * time.Second
+
+// cancellingClient interrupts the code running in MATLAB, as Ctrl+C does, when a call is cancelled, such as when the
+// client cancels the tool call. Otherwise, the request would be abandoned, and MATLAB would keep running the code,
+// holding up every later call to the session. The call is only abandoned if MATLAB does not stop within the grace
+// period, and returns the error of the context.
+type cancellingClient struct {
+	client      entities.MATLABSessionClient
+	interrupter Interrupter
+	gracePeriod time.Duration
+}
+
+func newCancellingClient(client entities.MATLABSessionClient, interrupter Interrupter) *cancellingClient {
+	return &cancellingClient{
+		client:      client,
+		interrupter: interrupter,
+		gracePeriod: defaultCancelGracePeriod,
+	}
+}
+
+func (c *cancellingClient) Eval(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) (entities.EvalResponse, error) {
+	var response entities.EvalResponse
+	err := c.runUntilCancelled(ctx, sessionLogger, func(ctx context.Context) error {
+		var err error
+		response, err = c.client.Eval(ctx, sessionLogger, request)
+		return err
+	})
+	return response, err
+}
+
+func (c *cancellingClient) EvalWithCapture(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) (entities.EvalResponse, error) {
+	var response entities.EvalResponse
+	err := c.runUntilCancelled(ctx, sessionLogger, func(ctx context.Context) error {
+		var err error
+		response, err = c.client.EvalWithCapture(ctx, sessionLogger, request)
+		return err
+	})
+	return response, err
+}
+
+func (c *cancellingClient) FEval(ctx context.Context, sessionLogger entities.Logger, request entities.FEvalRequest) (entities.FEvalResponse, error) {
+	var response entities.FEvalResponse
+	err := c.runUntilCancelled(ctx, sessionLogger, func(ctx context.Context) error {
+		var err error
+		response, err = c.client.FEval(ctx, sessionLogger, request)
+		return err
+	})
+	return response, err
+}
+
+func (c *cancellingClient) Interrupt(ctx context.Context, sessionLogger entities.Logger) error {
+	return c.client.Interrupt(ctx, sessionLogger)
+}
+
+// runUntilCancelled runs call with a context that is not cancelled with ctx, and interrupts MATLAB once ctx is cancelled.
+// If MATLAB does not return within the grace period after the interrupt, the call is abandoned.
+func (c *cancellingClient) runUntilCancelled(ctx context.Context, sessionLogger entities.Logger, call func(ctx context.Context) error) error {
+	if ctx.Done() == nil {
+		return call(ctx)
+	}
+
+	callCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- call(callCtx)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+	}
+
+	sessionLogger.Info("MATLAB call was cancelled, interrupting it")
+	if err := c.interrupter.Interrupt(callCtx, sessionLogger); err != nil {
+		sessionLogger.WithError(err).Warn("Failed to interrupt MATLAB call")
+	}
+
+	graceTimer := time.NewTimer(c.gracePeriod)
+	defer graceTimer.Stop()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			sessionLogger.WithError(err).Debug("Interrupted MATLAB call returned an error")
+		}
+	case <-graceTimer.C:
+		sessionLogger.Warn("Interrupted MATLAB call did not return, abandoning it")
+		cancel()
+		<-done
+	}
+
+	return ctx.Err()
+}
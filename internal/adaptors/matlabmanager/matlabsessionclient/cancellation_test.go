@@ -0,0 +1,148 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsessionclient_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabsessionclient"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancellingClient_Eval_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockInterrupter := &mocks.MockInterrupter{}
+	defer mockInterrupter.AssertExpectations(t)
+
+	request := entities.EvalRequest{Code: "x = 1"}
+	expectedResponse := entities.EvalResponse{ConsoleOutput: "x = 1"}
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), request).
+		Return(expectedResponse, nil).
+		Once()
+
+	client := matlabsessionclient.NewCancellingClient(mockClient, mockInterrupter, time.Second)
+
+	// Act
+	response, err := client.Eval(t.Context(), mockLogger, request)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedResponse, response)
+}
+
+func TestCancellingClient_Eval_CancelledCallIsInterrupted(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockInterrupter := &mocks.MockInterrupter{}
+	defer mockInterrupter.AssertExpectations(t)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	request := entities.EvalRequest{Code: "while true; end"}
+	started := make(chan struct{})
+	interrupted := make(chan struct{})
+	var callErr error
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), request).
+		Run(func(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) {
+			close(started)
+			<-interrupted
+			callErr = ctx.Err()
+		}).
+		Return(entities.EvalResponse{}, assert.AnError).
+		Once()
+
+	mockInterrupter.EXPECT().
+		Interrupt(mock.Anything, mockLogger.AsMockArg()).
+		Run(func(ctx context.Context, logger entities.Logger) {
+			close(interrupted)
+		}).
+		Return(nil).
+		Once()
+
+	client := matlabsessionclient.NewCancellingClient(mockClient, mockInterrupter, time.Second)
+
+	go func() {
+		<-started
+		cancel()
+	}()
+
+	// Act
+	_, err := client.Eval(ctx, mockLogger, request)
+
+	// Assert
+	require.ErrorIs(t, err, context.Canceled)
+	require.NoError(t, callErr, "The call should not be abandoned while MATLAB stops")
+
+	logs := mockLogger.InfoLogs()
+	assert.Contains(t, logs, "MATLAB call was cancelled, interrupting it")
+}
+
+func TestCancellingClient_FEval_InterruptedCallIsAbandoned(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockInterrupter := &mocks.MockInterrupter{}
+	defer mockInterrupter.AssertExpectations(t)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	request := entities.FEvalRequest{Function: "pause", Arguments: []string{"Inf"}}
+	started := make(chan struct{})
+
+	mockClient.EXPECT().
+		FEval(mock.Anything, mockLogger.AsMockArg(), request).
+		Run(func(ctx context.Context, sessionLogger entities.Logger, request entities.FEvalRequest) {
+			close(started)
+			<-ctx.Done()
+		}).
+		Return(entities.FEvalResponse{}, context.Canceled).
+		Once()
+
+	mockInterrupter.EXPECT().
+		Interrupt(mock.Anything, mockLogger.AsMockArg()).
+		Return(assert.AnError).
+		Once()
+
+	client := matlabsessionclient.NewCancellingClient(mockClient, mockInterrupter, 10*time.Millisecond)
+
+	go func() {
+		<-started
+		cancel()
+	}()
+
+	// Act
+	_, err := client.FEval(ctx, mockLogger, request)
+
+	// Assert
+	require.ErrorIs(t, err, context.Canceled)
+
+	logs := mockLogger.WarnLogs()
+	assert.Contains(t, logs, "Failed to interrupt MATLAB call")
+	assert.Contains(t, logs, "Interrupted MATLAB call did not return, abandoning it")
+}
@@ -9,13 +9,17 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 
+	"github.com/google/uuid"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/correlationid"
 	"github.com/matlab/matlab-mcp-core-server/internal/utils/httpclientfactory"
 )
 
 type HttpClientFactory interface {
 	NewClientForSelfSignedTLSServer(certificatePEM []byte) (httpclientfactory.HttpClient, error)
+	NewClientForMutualTLS(clientCertPEM []byte, clientKeyPEM []byte, caPEM []byte) (httpclientfactory.HttpClient, error)
 }
 
 type ConnectionDetails struct {
@@ -23,6 +27,11 @@ type ConnectionDetails struct {
 	Port           string
 	APIKey         string
 	CertificatePEM []byte
+
+	// ClientCertificatePEM and ClientKeyPEM are the certificate and private key the client authenticates with, when the
+	// embedded connector requires client certificates.
+	ClientCertificatePEM []byte
+	ClientKeyPEM         []byte
 }
 
 type Client struct {
@@ -36,7 +45,13 @@ func NewClient(
 	endpoint ConnectionDetails,
 	httpClientFactory HttpClientFactory,
 ) (*Client, error) {
-	httpClient, err := httpClientFactory.NewClientForSelfSignedTLSServer(endpoint.CertificatePEM)
+	var httpClient httpclientfactory.HttpClient
+	var err error
+	if len(endpoint.ClientCertificatePEM) > 0 {
+		httpClient, err = httpClientFactory.NewClientForMutualTLS(endpoint.ClientCertificatePEM, endpoint.ClientKeyPEM, endpoint.CertificatePEM)
+	} else {
+		httpClient, err = httpClientFactory.NewClientForSelfSignedTLSServer(endpoint.CertificatePEM)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -54,7 +69,7 @@ func (c *Client) Eval(ctx context.Context, logger entities.Logger, input entitie
 		Messages: ConnectorMessage{
 			Eval: []EvalMessage{
 				{
-					Code: input.Code,
+					Code: evalCode(input),
 				},
 			},
 		},
@@ -80,23 +95,39 @@ func (c *Client) Eval(ctx context.Context, logger entities.Logger, input entitie
 	}, nil
 }
 
+// evalCode is the code evaluated for input. When input has an output file, the command window output of the code is
+// also written to it with diary while the code runs, and diary is turned off again whether the code fails or not.
+func evalCode(input entities.EvalRequest) string {
+	if input.OutputFile == "" {
+		return input.Code
+	}
+
+	return fmt.Sprintf("diary('%s');\ntry\n%s\ncatch matlabMCPException\ndiary('off');\nrethrow(matlabMCPException);\nend\ndiary('off');",
+		strings.ReplaceAll(input.OutputFile, "'", "''"), input.Code)
+}
+
 func (c *Client) EvalWithCapture(ctx context.Context, logger entities.Logger, input entities.EvalRequest) (entities.EvalResponse, error) {
 	fevalRequest := entities.FEvalRequest{
 		Function:   "matlab_mcp.mcpEval",
 		Arguments:  []string{input.Code},
 		NumOutputs: 1,
 	}
+	if input.OutputFile != "" {
+		fevalRequest.Arguments = append(fevalRequest.Arguments, input.OutputFile)
+	}
 
 	response, err := c.FEval(ctx, logger, fevalRequest)
 	if err != nil {
 		return entities.EvalResponse{}, err
 	}
 
-	outputs, err := parseEvalWithCaptureResponse(response)
+	outputs, records, err := parseEvalWithCaptureResponse(response)
 	if err != nil {
 		return entities.EvalResponse{}, err
 	}
 
+	logOutputRecords(logger, records)
+
 	return outputs, nil
 }
 
@@ -145,6 +176,24 @@ func (c *Client) FEval(ctx context.Context, logger entities.Logger, input entiti
 	}, nil
 }
 
+// Interrupt stops the evaluation currently running in the MATLAB session.
+// The interrupted call then returns with the output produced so far, or with an error.
+func (c *Client) Interrupt(ctx context.Context, logger entities.Logger) error {
+	payload := ConnectorPayload{
+		Messages: ConnectorMessage{
+			Interrupt: []InterruptMessage{
+				{
+					MWType: "Interrupt",
+					UUID:   uuid.NewString(),
+				},
+			},
+		},
+	}
+
+	_, err := c.sendRequestToEvaluationEndpoint(ctx, logger, payload)
+	return err
+}
+
 func (c *Client) sendRequestToEvaluationEndpoint(ctx context.Context, logger entities.Logger, payload ConnectorPayload) (ConnectorPayload, error) {
 	payloadBytes, err := json.Marshal(payload)
 	if err != nil {
@@ -160,11 +209,14 @@ func (c *Client) sendRequestToEvaluationEndpoint(ctx context.Context, logger ent
 
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("mwapikey", c.apiKey)
+	if correlationID, ok := correlationid.FromContext(ctx); ok {
+		req.Header.Set(correlationid.HTTPHeader, correlationID)
+	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		logger.WithError(err).Error("Failed to send HTTP request")
-		return ConnectorPayload{}, fmt.Errorf("failed to send request: %w", err)
+		return ConnectorPayload{}, newConnectionError(ctx, fmt.Errorf("failed to send request: %w", err))
 	}
 	defer func() {
 		if err := resp.Body.Close(); err != nil {
@@ -174,15 +226,17 @@ func (c *Client) sendRequestToEvaluationEndpoint(ctx context.Context, logger ent
 
 	if resp.StatusCode != http.StatusOK {
 		logger.With("status", resp.Status).With("status-code", resp.StatusCode).Error("Request failed")
-		return ConnectorPayload{}, fmt.Errorf("request failed with status code %d: %s", resp.StatusCode, resp.Status)
+		return ConnectorPayload{}, entities.NewCodedError(entities.ErrorCodeSessionCrashed, fmt.Errorf("request failed with status code %d: %s", resp.StatusCode, resp.Status))
 	}
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		logger.WithError(err).Error("Failed to read response body")
-		return ConnectorPayload{}, fmt.Errorf("failed to read response body: %w", err)
+		return ConnectorPayload{}, newConnectionError(ctx, fmt.Errorf("failed to read response body: %w", err))
 	}
 
+	body = toUTF8(logger, body, resp.Header.Get("Content-Type"))
+
 	var response ConnectorPayload
 	if err := json.Unmarshal(body, &response); err != nil {
 		logger.WithError(err).Error("Failed to unmarshal response")
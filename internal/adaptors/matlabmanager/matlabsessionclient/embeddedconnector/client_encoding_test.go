@@ -0,0 +1,92 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package embeddedconnector_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	httpclientfactorymocks "github.com/matlab/matlab-mcp-core-server/mocks/utils/httpclientfactory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Eval_ResponseEncodings(t *testing.T) {
+	testCases := []struct {
+		name           string
+		contentType    string
+		responseStr    []byte
+		expectedOutput string
+		expectWarning  bool
+	}{
+		{
+			name:           "UTF-8",
+			contentType:    "application/json",
+			responseStr:    []byte("エラー: 未定義の関数\n"),
+			expectedOutput: "エラー: 未定義の関数\n",
+		},
+		{
+			name:           "ISO-8859-1 charset",
+			contentType:    "application/json; charset=ISO-8859-1",
+			responseStr:    []byte("Gr\xf6\xdfe: 3\n"),
+			expectedOutput: "Größe: 3\n",
+		},
+		{
+			name:           "Windows-1252 without charset",
+			contentType:    "application/json",
+			responseStr:    []byte("Kosten: 5 \x80 \x96 gepr\xfcft\n"),
+			expectedOutput: "Kosten: 5 € – geprüft\n",
+		},
+		{
+			name:           "Shift_JIS",
+			contentType:    "application/json; charset=Shift_JIS",
+			responseStr:    []byte("\x83G\x83\x89\x81[\n"),
+			expectedOutput: "�G���[\n",
+			expectWarning:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockHttpClient := &httpclientfactorymocks.MockHttpClient{}
+			defer mockHttpClient.AssertExpectations(t)
+
+			body := append([]byte(`{"messages":{"EvalResponse":[{"isError":false,"responseStr":"`), testCase.responseStr...)
+			body = append(body, []byte(`"}]}}`)...)
+			body = bytes.ReplaceAll(body, []byte("\n\""), []byte(`\n"`))
+
+			mockHttpClient.EXPECT().
+				Do(mock.AnythingOfType("*http.Request")).
+				Return(&http.Response{
+					StatusCode: http.StatusOK,
+					Header:     http.Header{"Content-Type": []string{testCase.contentType}},
+					Body:       io.NopCloser(bytes.NewReader(body)),
+				}, nil).
+				Once()
+
+			client := embeddedconnector.Client{}
+			client.SetHttpClient(mockHttpClient)
+
+			// Act
+			response, err := client.Eval(t.Context(), mockLogger, entities.EvalRequest{Code: "disp(x)"})
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedOutput, response.ConsoleOutput)
+			if testCase.expectWarning {
+				assert.Contains(t, mockLogger.WarnLogs(), "MATLAB response is not in UTF-8, its characters outside of ASCII are replaced")
+			} else {
+				assert.Empty(t, mockLogger.WarnLogs())
+			}
+		})
+	}
+}
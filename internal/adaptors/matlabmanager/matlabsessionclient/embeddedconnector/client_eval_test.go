@@ -4,7 +4,10 @@ package embeddedconnector_test
 
 import (
 	"context"
+	"encoding/json"
+	"io"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
@@ -42,6 +45,7 @@ func TestClient_Eval_DoErrors(t *testing.T) {
 	// Assert
 	require.Error(t, err)
 	assert.Empty(t, response)
+	assert.Equal(t, entities.ErrorCodeSessionCrashed, entities.ErrorCodeOf(err))
 }
 
 func TestClient_Eval_ContextPropagation(t *testing.T) {
@@ -78,3 +82,99 @@ func TestClient_Eval_ContextPropagation(t *testing.T) {
 	require.Error(t, err)
 	assert.Empty(t, response)
 }
+
+func TestClient_Eval_MATLABErrorCodes(t *testing.T) {
+	testCases := []struct {
+		name         string
+		responseStr  string
+		expectedCode entities.ErrorCode
+	}{
+		{
+			name:         "syntax error",
+			responseStr:  "Invalid expression. Check for missing multiplication operator.",
+			expectedCode: entities.ErrorCodeSyntaxError,
+		},
+		{
+			name:         "license error",
+			responseStr:  "License checkout failed. License Manager Error -4",
+			expectedCode: entities.ErrorCodeLicenseUnavailable,
+		},
+		{
+			name:         "runtime error",
+			responseStr:  "Undefined function or variable 'x'.",
+			expectedCode: entities.ErrorCodeMATLABError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockHttpClient := &httpclientfactorymocks.MockHttpClient{}
+			defer mockHttpClient.AssertExpectations(t)
+
+			body, err := json.Marshal(embeddedconnector.ConnectorPayload{
+				Messages: embeddedconnector.ConnectorMessage{
+					EvalResponse: []embeddedconnector.EvalResponseMessage{
+						{IsError: true, ResponseStr: testCase.responseStr},
+					},
+				},
+			})
+			require.NoError(t, err)
+
+			mockHttpClient.EXPECT().
+				Do(mock.AnythingOfType("*http.Request")).
+				Return(&http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(string(body))),
+				}, nil).
+				Once()
+
+			client := embeddedconnector.Client{}
+			client.SetHttpClient(mockHttpClient)
+
+			// Act
+			_, err = client.Eval(t.Context(), mockLogger, entities.EvalRequest{Code: "x"})
+
+			// Assert
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), testCase.responseStr)
+			assert.Equal(t, testCase.expectedCode, entities.ErrorCodeOf(err))
+		})
+	}
+}
+
+func TestClient_Eval_OutputFile(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientfactorymocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	var body string
+	mockHttpClient.EXPECT().
+		Do(mock.AnythingOfType("*http.Request")).
+		RunAndReturn(func(request *http.Request) (*http.Response, error) {
+			payload, err := io.ReadAll(request.Body)
+			require.NoError(t, err)
+			body = string(payload)
+			return nil, assert.AnError
+		}).
+		Once()
+
+	client := embeddedconnector.Client{}
+	client.SetHttpClient(mockHttpClient)
+
+	evalRequest := entities.EvalRequest{
+		Code:       "ver",
+		OutputFile: "/tmp/matlab-mcp-output-1/output.txt",
+	}
+
+	// Act
+	_, err := client.Eval(t.Context(), mockLogger, evalRequest)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Contains(t, body, `"mcode":"diary('/tmp/matlab-mcp-output-1/output.txt');\ntry\nver\ncatch matlabMCPException\ndiary('off');\nrethrow(matlabMCPException);\nend\ndiary('off');"`)
+}
@@ -4,6 +4,7 @@ package embeddedconnector_test
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"testing"
 
@@ -79,3 +80,37 @@ func TestClient_EvalWithCapture_ContextPropagation(t *testing.T) {
 	require.ErrorIs(t, err, expectedError)
 	assert.Empty(t, response)
 }
+
+func TestClient_EvalWithCapture_OutputFile(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientfactorymocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	var body string
+	mockHttpClient.EXPECT().
+		Do(mock.AnythingOfType("*http.Request")).
+		RunAndReturn(func(request *http.Request) (*http.Response, error) {
+			payload, err := io.ReadAll(request.Body)
+			require.NoError(t, err)
+			body = string(payload)
+			return nil, assert.AnError
+		}).
+		Once()
+
+	client := embeddedconnector.Client{}
+	client.SetHttpClient(mockHttpClient)
+
+	evalRequest := entities.EvalRequest{
+		Code:       "ver",
+		OutputFile: "/tmp/matlab-mcp-output-1/output.txt",
+	}
+
+	// Act
+	_, err := client.EvalWithCapture(t.Context(), mockLogger, evalRequest)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Contains(t, body, `"arguments":["ver","/tmp/matlab-mcp-output-1/output.txt"]`)
+}
@@ -0,0 +1,77 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package embeddedconnector_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	httpclientfactorymocks "github.com/matlab/matlab-mcp-core-server/mocks/utils/httpclientfactory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Interrupt_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientfactorymocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	var sentPayload embeddedconnector.ConnectorPayload
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(func(request *http.Request) bool {
+			body, err := io.ReadAll(request.Body)
+			if err != nil {
+				return false
+			}
+			return json.Unmarshal(body, &sentPayload) == nil
+		})).
+		Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"messages":{}}`)),
+		}, nil).
+		Once()
+
+	client := embeddedconnector.Client{}
+	client.SetHttpClient(mockHttpClient)
+
+	// Act
+	err := client.Interrupt(t.Context(), mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, sentPayload.Messages.Interrupt, 1)
+	assert.Equal(t, "Interrupt", sentPayload.Messages.Interrupt[0].MWType)
+	assert.NotEmpty(t, sentPayload.Messages.Interrupt[0].UUID)
+	assert.Empty(t, sentPayload.Messages.Eval)
+	assert.Empty(t, sentPayload.Messages.FEval)
+}
+
+func TestClient_Interrupt_DoErrors(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientfactorymocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	mockHttpClient.EXPECT().
+		Do(mock.AnythingOfType("*http.Request")).
+		Return(nil, assert.AnError).
+		Once()
+
+	client := embeddedconnector.Client{}
+	client.SetHttpClient(mockHttpClient)
+
+	// Act
+	err := client.Interrupt(t.Context(), mockLogger)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
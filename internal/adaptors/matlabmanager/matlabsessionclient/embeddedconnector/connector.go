@@ -19,6 +19,7 @@ type ConnectorMessage struct {
 	FEval         []FevalMessage         `json:"FEval,omitempty"`
 	EvalResponse  []EvalResponseMessage  `json:"EvalResponse,omitempty"`
 	FevalResponse []FevalResponseMessage `json:"FEvalResponse,omitempty"`
+	Interrupt     []InterruptMessage     `json:"Interrupt,omitempty"`
 }
 
 type EvalMessage struct {
@@ -37,6 +38,12 @@ type FevalMessage struct {
 	DequeMode string   `json:"dequeMode"`
 }
 
+// InterruptMessage stops the evaluation running in MATLAB, as with Ctrl+C in the MATLAB command window.
+type InterruptMessage struct {
+	MWType string `json:"mwtype"`
+	UUID   string `json:"uuid"`
+}
+
 type FevalResponseMessage struct {
 	IsError       bool              `json:"isError"`
 	MessageFaults []json.RawMessage `json:"messageFaults"`
@@ -55,11 +62,26 @@ type LiveEditorResponseEntry struct {
 		Text string `json:"text"`
 		Name string `json:"name"`
 	} `json:"content"`
+	Diagnostic *Diagnostic `json:"diagnostic,omitempty"`
+}
+
+// Diagnostic is attached by mcpEval.m to stream entries that originate from a MATLAB warning or error.
+type Diagnostic struct {
+	Kind       string       `json:"kind"`
+	Identifier string       `json:"identifier"`
+	Stack      []StackFrame `json:"stack"`
+}
+
+type StackFrame struct {
+	File string `json:"file"`
+	Name string `json:"name"`
+	Line int    `json:"line"`
 }
 
 type responseProcessor struct {
 	consoleOutput        []string
 	images               [][]byte
+	records              []OutputRecord
 	pendingStreamName    string
 	pendingStreamContent string
 }
@@ -91,6 +113,10 @@ func (p *responseProcessor) processExecuteResult(entry LiveEditorResponseEntry)
 				return err
 			}
 			p.consoleOutput = append(p.consoleOutput, value)
+			p.records = append(p.records, OutputRecord{
+				Kind: OutputRecordKindOutput,
+				Text: value,
+			})
 		case "image/png":
 			var value []byte
 			err := json.Unmarshal(entry.Value[i], &value)
@@ -111,6 +137,7 @@ func (p *responseProcessor) processStream(entry LiveEditorResponseEntry) {
 
 	p.pendingStreamName = entry.Content.Name
 	p.pendingStreamContent += entry.Content.Text
+	p.records = append(p.records, newStreamOutputRecord(entry))
 }
 
 func (p *responseProcessor) flushPendingStream() {
@@ -121,20 +148,20 @@ func (p *responseProcessor) flushPendingStream() {
 	}
 }
 
-func parseEvalWithCaptureResponse(response entities.FEvalResponse) (entities.EvalResponse, error) {
+func parseEvalWithCaptureResponse(response entities.FEvalResponse) (entities.EvalResponse, []OutputRecord, error) {
 	if len(response.Outputs) != 1 {
-		return entities.EvalResponse{}, fmt.Errorf("unexpected number of outputs from MATLAB session")
+		return entities.EvalResponse{}, nil, fmt.Errorf("unexpected number of outputs from MATLAB session")
 	}
 
 	consoleData, ok := response.Outputs[0].(string)
 	if !ok {
-		return entities.EvalResponse{}, fmt.Errorf("failed to cast output to string")
+		return entities.EvalResponse{}, nil, fmt.Errorf("failed to cast output to string")
 	}
 
 	var parsedResponse []json.RawMessage
 	err := json.Unmarshal([]byte(consoleData), &parsedResponse)
 	if err != nil {
-		return entities.EvalResponse{}, fmt.Errorf("failed to parse JSON response: %w", err)
+		return entities.EvalResponse{}, nil, fmt.Errorf("failed to parse JSON response: %w", err)
 	}
 
 	processor := &responseProcessor{}
@@ -145,7 +172,7 @@ func parseEvalWithCaptureResponse(response entities.FEvalResponse) (entities.Eva
 			continue // Ignore invalid entries
 		}
 		if err := processor.processEntry(entry); err != nil {
-			return entities.EvalResponse{}, err
+			return entities.EvalResponse{}, nil, err
 		}
 	}
 
@@ -154,5 +181,5 @@ func parseEvalWithCaptureResponse(response entities.FEvalResponse) (entities.Eva
 	return entities.EvalResponse{
 		ConsoleOutput: strings.Join(processor.consoleOutput, "\n"),
 		Images:        processor.images,
-	}, nil
+	}, processor.records, nil
 }
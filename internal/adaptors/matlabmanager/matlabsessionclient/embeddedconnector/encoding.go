@@ -0,0 +1,72 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package embeddedconnector
+
+import (
+	"mime"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// windows1252 maps the bytes 0x80 to 0x9F of Windows-1252 to their characters.
+// The other bytes above 0x7F are the characters of the same code point, as in ISO-8859-1.
+var windows1252 = [32]rune{
+	'€', '\u0081', '‚', 'ƒ', '„', '…', '†', '‡', 'ˆ', '‰', 'Š', '‹', 'Œ', '\u008D', 'Ž', '\u008F',
+	'\u0090', '‘', '’', '“', '”', '•', '–', '—', '˜', '™', 'š', '›', 'œ', '\u009D', 'ž', 'Ÿ',
+}
+
+// toUTF8 returns the body of a response of MATLAB in UTF-8, so that the command window output of a MATLAB which does not
+// use UTF-8, such as MATLAB on Windows with a German locale, reaches the client intact instead of as replacement characters.
+//
+// Bodies in Windows-1252 or ISO-8859-1 are converted, whether they state their charset or not. The bodies in multibyte
+// encodings, such as Shift_JIS or GBK, cannot be converted, so they are returned unchanged, and a warning is logged.
+// MATLAB is started with a UTF-8 locale on Linux and macOS, so such bodies only come from MATLAB on Windows.
+func toUTF8(logger entities.Logger, body []byte, contentType string) []byte {
+	if utf8.Valid(body) {
+		return body
+	}
+
+	charset := ""
+	if _, params, err := mime.ParseMediaType(contentType); err == nil {
+		charset = strings.ToLower(params["charset"])
+	}
+
+	switch charset {
+	case "iso-8859-1", "latin1":
+		return decodeSingleByte(body, false)
+	case "windows-1252", "cp1252":
+		return decodeSingleByte(body, true)
+	case "":
+		if isSingleByte(body) {
+			return decodeSingleByte(body, true)
+		}
+	}
+
+	logger.With("charset", charset).Warn("MATLAB response is not in UTF-8, its characters outside of ASCII are replaced")
+	return body
+}
+
+// isSingleByte returns true if the bytes above 0x7F of body are isolated, as in the text of a single byte encoding.
+// The text of multibyte encodings has runs of bytes above 0x7F, for every character.
+func isSingleByte(body []byte) bool {
+	for i := 1; i < len(body); i++ {
+		if body[i-1] >= utf8.RuneSelf && body[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+func decodeSingleByte(body []byte, isWindows1252 bool) []byte {
+	decoded := make([]byte, 0, len(body)+len(body)/4)
+	for _, b := range body {
+		r := rune(b)
+		if isWindows1252 && b >= 0x80 && b <= 0x9F {
+			r = windows1252[b-0x80]
+		}
+		decoded = utf8.AppendRune(decoded, r)
+	}
+	return decoded
+}
@@ -2,7 +2,31 @@
 
 package embeddedconnector
 
-import "fmt"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// Fragments of MATLAB error messages that identify the class of the error raised by MATLAB.
+var (
+	licenseErrorFragments = []string{
+		"license checkout failed",
+		"unable to check out a license",
+		"licensing error",
+	}
+	syntaxErrorFragments = []string{
+		"parse error",
+		"invalid expression",
+		"invalid use of operator",
+		"incorrect use of '='",
+		"unbalanced or unexpected parenthesis or bracket",
+		"invalid syntax",
+	}
+)
 
 type matlabError struct {
 	message string
@@ -17,3 +41,36 @@ func newMATLABError(message string) matlabError {
 func (e matlabError) Error() string {
 	return fmt.Sprintf("matlab error: %v", e.message)
 }
+
+func (e matlabError) ErrorCode() entities.ErrorCode {
+	message := strings.ToLower(e.message)
+	switch {
+	case containsAny(message, licenseErrorFragments):
+		return entities.ErrorCodeLicenseUnavailable
+	case containsAny(message, syntaxErrorFragments):
+		return entities.ErrorCodeSyntaxError
+	default:
+		return entities.ErrorCodeMATLABError
+	}
+}
+
+// newConnectionError classifies a failure to communicate with the MATLAB session.
+func newConnectionError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return entities.NewCodedError(entities.ErrorCodeEvalTimeout, err)
+	case errors.Is(ctx.Err(), context.Canceled):
+		return entities.NewCodedError(entities.ErrorCodeCancelled, err)
+	default:
+		return entities.NewCodedError(entities.ErrorCodeSessionCrashed, err)
+	}
+}
+
+func containsAny(s string, fragments []string) bool {
+	for _, fragment := range fragments {
+		if strings.Contains(s, fragment) {
+			return true
+		}
+	}
+	return false
+}
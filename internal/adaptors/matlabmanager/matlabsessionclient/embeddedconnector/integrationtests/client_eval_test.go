@@ -11,14 +11,14 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
-	"github.com/matlab/matlab-mcp-core-server/internal/utils/httpclientfactory"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/correlationid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func TestClient_Eval_HappyPath(t *testing.T) {
 	// Arrange
-	httpClientFactory := httpclientfactory.New()
+	httpClientFactory := newHTTPClientFactory(t)
 	mockLogger := testutils.NewInspectableLogger()
 
 	const expectedCode = "disp('Hello World')"
@@ -62,7 +62,7 @@ func TestClient_Eval_HappyPath(t *testing.T) {
 
 func TestClient_Eval_MATLABError(t *testing.T) {
 	// Arrange
-	httpClientFactory := httpclientfactory.New()
+	httpClientFactory := newHTTPClientFactory(t)
 	mockLogger := testutils.NewInspectableLogger()
 
 	expectedCode := "invalid_function()"
@@ -107,7 +107,7 @@ func TestClient_Eval_MATLABError(t *testing.T) {
 
 func TestClient_Eval_HTTPError(t *testing.T) {
 	// Arrange
-	httpClientFactory := httpclientfactory.New()
+	httpClientFactory := newHTTPClientFactory(t)
 	mockLogger := testutils.NewInspectableLogger()
 
 	connectionDetails := startTestServer(t, func(responseWriter http.ResponseWriter, request *http.Request) {
@@ -134,7 +134,7 @@ func TestClient_Eval_HTTPError(t *testing.T) {
 
 func TestClient_Eval_NoResponseMessages(t *testing.T) {
 	// Arrange
-	httpClientFactory := httpclientfactory.New()
+	httpClientFactory := newHTTPClientFactory(t)
 	mockLogger := testutils.NewInspectableLogger()
 
 	connectionDetails := startTestServer(t, func(responseWriter http.ResponseWriter, request *http.Request) {
@@ -170,7 +170,7 @@ func TestClient_Eval_NoResponseMessages(t *testing.T) {
 
 func TestClient_Eval_InvalidJSONResponse(t *testing.T) {
 	// Arrange
-	httpClientFactory := httpclientfactory.New()
+	httpClientFactory := newHTTPClientFactory(t)
 	mockLogger := testutils.NewInspectableLogger()
 
 	connectionDetails := startTestServer(t, func(responseWriter http.ResponseWriter, request *http.Request) {
@@ -200,7 +200,7 @@ func TestClient_Eval_InvalidJSONResponse(t *testing.T) {
 
 func TestClient_Eval_ContextCancellation(t *testing.T) {
 	// Arrange
-	httpClientFactory := httpclientfactory.New()
+	httpClientFactory := newHTTPClientFactory(t)
 	mockLogger := testutils.NewInspectableLogger()
 
 	connectionDetails := startTestServer(t, func(responseWriter http.ResponseWriter, request *http.Request) {
@@ -236,3 +236,45 @@ func assertEvalMessage(t *testing.T, request *http.Request, expectedCode string)
 	require.Len(t, requestPayload.Messages.Eval, 1, "expected exactly one Eval message")
 	assert.Equal(t, expectedCode, requestPayload.Messages.Eval[0].Code, "eval code does not match expected code")
 }
+
+func TestClient_Eval_ForwardsCorrelationID(t *testing.T) {
+	// Arrange
+	httpClientFactory := newHTTPClientFactory(t)
+	mockLogger := testutils.NewInspectableLogger()
+
+	const expectedCode = "disp('Hello World')"
+	const expectedCorrelationID = "some-correlation-id"
+
+	connectionDetails := startTestServer(t, func(responseWriter http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, expectedCorrelationID, request.Header.Get(correlationid.HTTPHeader))
+
+		response := embeddedconnector.ConnectorPayload{
+			Messages: embeddedconnector.ConnectorMessage{
+				EvalResponse: []embeddedconnector.EvalResponseMessage{
+					{
+						IsError:     false,
+						ResponseStr: "Hello World\n",
+					},
+				},
+			},
+		}
+
+		responseWriter.Header().Set("Content-Type", "application/json")
+		responseWriter.WriteHeader(http.StatusOK)
+		assert.NoError(t, json.NewEncoder(responseWriter).Encode(response))
+	})
+
+	client, err := embeddedconnector.NewClient(connectionDetails, httpClientFactory)
+	require.NoError(t, err)
+
+	ctx := correlationid.NewContext(t.Context(), expectedCorrelationID)
+	evalRequest := entities.EvalRequest{
+		Code: expectedCode,
+	}
+
+	// Act
+	_, err = client.Eval(ctx, mockLogger, evalRequest)
+
+	// Assert
+	require.NoError(t, err)
+}
@@ -11,14 +11,13 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
-	"github.com/matlab/matlab-mcp-core-server/internal/utils/httpclientfactory"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func TestClient_EvalWithCapture_HappyPath(t *testing.T) {
 	// Arrange
-	httpClientFactory := httpclientfactory.New()
+	httpClientFactory := newHTTPClientFactory(t)
 	mockLogger := testutils.NewInspectableLogger()
 
 	const expectedCode = "disp('Hello World')"
@@ -74,7 +73,7 @@ func TestClient_EvalWithCapture_HappyPath(t *testing.T) {
 
 func TestClient_EvalWithCapture_ReturnImages(t *testing.T) {
 	// Arrange
-	httpClientFactory := httpclientfactory.New()
+	httpClientFactory := newHTTPClientFactory(t)
 	mockLogger := testutils.NewInspectableLogger()
 
 	const expectedCode = "plot(1:10)"
@@ -132,7 +131,7 @@ func TestClient_EvalWithCapture_ReturnImages(t *testing.T) {
 
 func TestClient_EvalWithCapture_ReturnStreams(t *testing.T) {
 	// Arrange
-	httpClientFactory := httpclientfactory.New()
+	httpClientFactory := newHTTPClientFactory(t)
 	mockLogger := testutils.NewInspectableLogger()
 
 	const expectedCode = "undefined_function"
@@ -194,7 +193,7 @@ func TestClient_EvalWithCapture_ReturnStreams(t *testing.T) {
 
 func TestClient_EvalWithCapture_MultipleStreams_SameName(t *testing.T) {
 	// Arrange
-	httpClientFactory := httpclientfactory.New()
+	httpClientFactory := newHTTPClientFactory(t)
 	mockLogger := testutils.NewInspectableLogger()
 
 	const expectedCode = "disp('line1'); disp('line2')"
@@ -264,7 +263,7 @@ func TestClient_EvalWithCapture_MultipleStreams_SameName(t *testing.T) {
 
 func TestClient_EvalWithCapture_MultipleStreams_DifferentNames(t *testing.T) {
 	// Arrange
-	httpClientFactory := httpclientfactory.New()
+	httpClientFactory := newHTTPClientFactory(t)
 	mockLogger := testutils.NewInspectableLogger()
 
 	const expectedCode = "fprintf('output'); warning('warning message')"
@@ -344,7 +343,7 @@ func TestClient_EvalWithCapture_MultipleStreams_DifferentNames(t *testing.T) {
 
 func TestClient_EvalWithCapture_MixedStreamsAndResults(t *testing.T) {
 	// Arrange
-	httpClientFactory := httpclientfactory.New()
+	httpClientFactory := newHTTPClientFactory(t)
 	mockLogger := testutils.NewInspectableLogger()
 
 	const expectedCode = "x = 5; disp('calculating'); y = x * 2; plot(1:y)"
@@ -426,7 +425,7 @@ func TestClient_EvalWithCapture_MixedStreamsAndResults(t *testing.T) {
 }
 
 func TestClient_EvalWithCapture_StreamsWithInterruptionByExecuteResult(t *testing.T) {
-	httpClientFactory := httpclientfactory.New()
+	httpClientFactory := newHTTPClientFactory(t)
 	mockLogger := testutils.NewInspectableLogger()
 
 	const expectedCode = "warning('first'); x = 1; warning('second')"
@@ -508,3 +507,160 @@ func TestClient_EvalWithCapture_StreamsWithInterruptionByExecuteResult(t *testin
 	assert.Equal(t, "Warning: first warning message\nx = 1\nWarning: second warning", response.ConsoleOutput)
 	assert.Nil(t, response.Images)
 }
+
+func TestClient_EvalWithCapture_LogsStructuredDiagnostics(t *testing.T) {
+	// Arrange
+	httpClientFactory := newHTTPClientFactory(t)
+	mockLogger := testutils.NewInspectableLogger()
+
+	const expectedCode = "warning('my:id', 'careful'); myFunction()"
+
+	connectionDetails := startTestServer(t, func(responseWriter http.ResponseWriter, request *http.Request) {
+		assertFevalMessage(t, request, "matlab_mcp.mcpEval", []string{expectedCode}, 1)
+
+		liveEditorResponseEntries := []embeddedconnector.LiveEditorResponseEntry{
+			{
+				Type: "stream",
+				Content: struct {
+					Text string `json:"text"`
+					Name string `json:"name"`
+				}{
+					Text: "Warning: careful",
+					Name: "stderr",
+				},
+				Diagnostic: &embeddedconnector.Diagnostic{
+					Kind:       "warning",
+					Identifier: "my:id",
+				},
+			},
+			{
+				Type: "stream",
+				Content: struct {
+					Text string `json:"text"`
+					Name string `json:"name"`
+				}{
+					Text: "Index exceeds array bounds.",
+					Name: "stderr",
+				},
+				Diagnostic: &embeddedconnector.Diagnostic{
+					Kind:       "error",
+					Identifier: "MATLAB:badsubscript",
+					Stack: []embeddedconnector.StackFrame{
+						{File: "/home/user/myFunction.m", Name: "myFunction", Line: 3},
+						{File: "/home/user/caller.m", Name: "caller", Line: 10},
+					},
+				},
+			},
+		}
+		data, err := json.Marshal(liveEditorResponseEntries)
+		assert.NoError(t, err)
+
+		response := embeddedconnector.ConnectorPayload{
+			Messages: embeddedconnector.ConnectorMessage{
+				FevalResponse: []embeddedconnector.FevalResponseMessage{
+					{
+						IsError: false,
+						Results: []interface{}{
+							string(data),
+						},
+					},
+				},
+			},
+		}
+
+		responseWriter.Header().Set("Content-Type", "application/json")
+		responseWriter.WriteHeader(http.StatusOK)
+		assert.NoError(t, json.NewEncoder(responseWriter).Encode(response))
+	})
+
+	client, err := embeddedconnector.NewClient(connectionDetails, httpClientFactory)
+	require.NoError(t, err)
+
+	ctx := t.Context()
+	evalRequest := entities.EvalRequest{
+		Code: expectedCode,
+	}
+
+	// Act
+	response, err := client.EvalWithCapture(ctx, mockLogger, evalRequest)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "Warning: carefulIndex exceeds array bounds.", response.ConsoleOutput)
+
+	infoLogs := mockLogger.InfoLogs()
+
+	warningFields, found := infoLogs["MATLAB warning"]
+	require.True(t, found, "Expected MATLAB warning log not found")
+	assert.Equal(t, "my:id", warningFields["identifier"])
+	assert.Equal(t, "Warning: careful", warningFields["text"])
+	assert.Equal(t, "stderr", warningFields["stream"])
+	assert.Equal(t, 0, warningFields["output-index"])
+
+	errorFields, found := infoLogs["MATLAB error"]
+	require.True(t, found, "Expected MATLAB error log not found")
+	assert.Equal(t, "MATLAB:badsubscript", errorFields["identifier"])
+	assert.Equal(t, "Index exceeds array bounds.", errorFields["text"])
+	assert.Equal(t, "myFunction (/home/user/myFunction.m:3) <- caller (/home/user/caller.m:10)", errorFields["stack"])
+	assert.Equal(t, 1, errorFields["output-index"])
+}
+
+func TestClient_EvalWithCapture_LogsPlainOutputAtDebug(t *testing.T) {
+	// Arrange
+	httpClientFactory := newHTTPClientFactory(t)
+	mockLogger := testutils.NewInspectableLogger()
+
+	const expectedCode = "x = 1"
+
+	connectionDetails := startTestServer(t, func(responseWriter http.ResponseWriter, request *http.Request) {
+		assertFevalMessage(t, request, "matlab_mcp.mcpEval", []string{expectedCode}, 1)
+
+		liveEditorResponseEntries := []embeddedconnector.LiveEditorResponseEntry{
+			{
+				Type:     "execute_result",
+				MimeType: []string{"text/plain"},
+				Value:    []json.RawMessage{json.RawMessage(`"x = 1"`)},
+			},
+		}
+		data, err := json.Marshal(liveEditorResponseEntries)
+		assert.NoError(t, err)
+
+		response := embeddedconnector.ConnectorPayload{
+			Messages: embeddedconnector.ConnectorMessage{
+				FevalResponse: []embeddedconnector.FevalResponseMessage{
+					{
+						IsError: false,
+						Results: []interface{}{
+							string(data),
+						},
+					},
+				},
+			},
+		}
+
+		responseWriter.Header().Set("Content-Type", "application/json")
+		responseWriter.WriteHeader(http.StatusOK)
+		assert.NoError(t, json.NewEncoder(responseWriter).Encode(response))
+	})
+
+	client, err := embeddedconnector.NewClient(connectionDetails, httpClientFactory)
+	require.NoError(t, err)
+
+	ctx := t.Context()
+	evalRequest := entities.EvalRequest{
+		Code: expectedCode,
+	}
+
+	// Act
+	_, err = client.EvalWithCapture(ctx, mockLogger, evalRequest)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, mockLogger.InfoLogs())
+
+	outputFields, found := mockLogger.DebugLogs()["MATLAB output"]
+	require.True(t, found, "Expected MATLAB output log not found")
+	assert.Equal(t, "output", outputFields["output-kind"])
+	assert.Equal(t, "x = 1", outputFields["text"])
+	assert.NotContains(t, outputFields, "stream")
+}
@@ -11,14 +11,13 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
-	"github.com/matlab/matlab-mcp-core-server/internal/utils/httpclientfactory"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func TestClient_FEval_HappyPath(t *testing.T) {
 	// Arrange
-	httpClientFactory := httpclientfactory.New()
+	httpClientFactory := newHTTPClientFactory(t)
 	mockLogger := testutils.NewInspectableLogger()
 
 	expectedFunction := "sum"
@@ -65,7 +64,7 @@ func TestClient_FEval_HappyPath(t *testing.T) {
 
 func TestClient_FEval_MultipleOutputs(t *testing.T) {
 	// Arrange
-	httpClientFactory := httpclientfactory.New()
+	httpClientFactory := newHTTPClientFactory(t)
 	mockLogger := testutils.NewInspectableLogger()
 
 	expectedFunction := "size"
@@ -112,7 +111,7 @@ func TestClient_FEval_MultipleOutputs(t *testing.T) {
 
 func TestClient_FEval_NoArguments(t *testing.T) {
 	// Arrange
-	httpClientFactory := httpclientfactory.New()
+	httpClientFactory := newHTTPClientFactory(t)
 	mockLogger := testutils.NewInspectableLogger()
 
 	expectedFunction := "rand"
@@ -159,7 +158,7 @@ func TestClient_FEval_NoArguments(t *testing.T) {
 
 func TestClient_FEval_MATLABError(t *testing.T) {
 	// Arrange
-	httpClientFactory := httpclientfactory.New()
+	httpClientFactory := newHTTPClientFactory(t)
 	mockLogger := testutils.NewInspectableLogger()
 
 	expectedFunction := "invalid_function"
@@ -213,7 +212,7 @@ func TestClient_FEval_MATLABError(t *testing.T) {
 
 func TestClient_FEval_MATLABErrorWithMultipleFaults(t *testing.T) {
 	// Arrange
-	httpClientFactory := httpclientfactory.New()
+	httpClientFactory := newHTTPClientFactory(t)
 	mockLogger := testutils.NewInspectableLogger()
 
 	expectedFunction := "invalid_function"
@@ -275,7 +274,7 @@ func TestClient_FEval_MATLABErrorWithMultipleFaults(t *testing.T) {
 
 func TestClient_FEval_MATLABErrorWithNoFaults(t *testing.T) {
 	// Arrange
-	httpClientFactory := httpclientfactory.New()
+	httpClientFactory := newHTTPClientFactory(t)
 	mockLogger := testutils.NewInspectableLogger()
 
 	expectedFunction := "invalid_function"
@@ -322,7 +321,7 @@ func TestClient_FEval_MATLABErrorWithNoFaults(t *testing.T) {
 
 func TestClient_FEval_HTTPError(t *testing.T) {
 	// Arrange
-	httpClientFactory := httpclientfactory.New()
+	httpClientFactory := newHTTPClientFactory(t)
 	mockLogger := testutils.NewInspectableLogger()
 
 	connectionDetails := startTestServer(t, func(responseWriter http.ResponseWriter, request *http.Request) {
@@ -350,7 +349,7 @@ func TestClient_FEval_HTTPError(t *testing.T) {
 
 func TestClient_FEval_NoResponseMessages(t *testing.T) {
 	// Arrange
-	httpClientFactory := httpclientfactory.New()
+	httpClientFactory := newHTTPClientFactory(t)
 	mockLogger := testutils.NewInspectableLogger()
 
 	connectionDetails := startTestServer(t, func(responseWriter http.ResponseWriter, request *http.Request) {
@@ -387,7 +386,7 @@ func TestClient_FEval_NoResponseMessages(t *testing.T) {
 
 func TestClient_FEval_InvalidJSONResponse(t *testing.T) {
 	// Arrange
-	httpClientFactory := httpclientfactory.New()
+	httpClientFactory := newHTTPClientFactory(t)
 	mockLogger := testutils.NewInspectableLogger()
 
 	connectionDetails := startTestServer(t, func(responseWriter http.ResponseWriter, request *http.Request) {
@@ -418,7 +417,7 @@ func TestClient_FEval_InvalidJSONResponse(t *testing.T) {
 
 func TestClient_FEval_ContextCancellation(t *testing.T) {
 	// Arrange
-	httpClientFactory := httpclientfactory.New()
+	httpClientFactory := newHTTPClientFactory(t)
 	mockLogger := testutils.NewInspectableLogger()
 
 	connectionDetails := startTestServer(t, func(responseWriter http.ResponseWriter, request *http.Request) {
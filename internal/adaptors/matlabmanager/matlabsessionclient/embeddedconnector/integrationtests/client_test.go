@@ -11,9 +11,27 @@ import (
 	"testing"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/httpclientfactory"
+	httpclientfactorymocks "github.com/matlab/matlab-mcp-core-server/mocks/utils/httpclientfactory"
 	"github.com/stretchr/testify/assert"
 )
 
+func newHTTPClientFactory(t *testing.T) *httpclientfactory.HTTPClientFactory {
+	t.Helper()
+
+	mockConfig := httpclientfactorymocks.NewMockConfig(t)
+	mockConfig.EXPECT().
+		StrictTLS().
+		Return(false).
+		Maybe()
+	mockConfig.EXPECT().
+		Proxy().
+		Return("").
+		Maybe()
+
+	return httpclientfactory.New(mockConfig)
+}
+
 func startTestServer(t *testing.T, handler func(responseWriter http.ResponseWriter, request *http.Request)) embeddedconnector.ConnectionDetails {
 	t.Helper()
 
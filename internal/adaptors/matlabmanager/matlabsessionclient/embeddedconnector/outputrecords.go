@@ -0,0 +1,84 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package embeddedconnector
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+type OutputRecordKind string
+
+const (
+	OutputRecordKindOutput  OutputRecordKind = "output"
+	OutputRecordKindWarning OutputRecordKind = "warning"
+	OutputRecordKindError   OutputRecordKind = "error"
+)
+
+// OutputRecord is a single structured piece of the MATLAB command window output,
+// so that warnings and errors can be told apart from plain output in the server logs.
+type OutputRecord struct {
+	Kind       OutputRecordKind
+	Stream     string
+	Text       string
+	Identifier string
+	Stack      []StackFrame
+}
+
+func newStreamOutputRecord(entry LiveEditorResponseEntry) OutputRecord {
+	record := OutputRecord{
+		Kind:   OutputRecordKindOutput,
+		Stream: entry.Content.Name,
+		Text:   entry.Content.Text,
+	}
+
+	if entry.Diagnostic == nil {
+		return record
+	}
+
+	switch OutputRecordKind(entry.Diagnostic.Kind) {
+	case OutputRecordKindWarning:
+		record.Kind = OutputRecordKindWarning
+	case OutputRecordKindError:
+		record.Kind = OutputRecordKindError
+	}
+	record.Identifier = entry.Diagnostic.Identifier
+	record.Stack = entry.Diagnostic.Stack
+
+	return record
+}
+
+func logOutputRecords(logger entities.Logger, records []OutputRecord) {
+	for index, record := range records {
+		recordLogger := logger.
+			With("output-index", index).
+			With("output-kind", string(record.Kind)).
+			With("text", strings.TrimSpace(record.Text))
+
+		if record.Stream != "" {
+			recordLogger = recordLogger.With("stream", record.Stream)
+		}
+
+		switch record.Kind {
+		case OutputRecordKindWarning:
+			recordLogger.With("identifier", record.Identifier).Info("MATLAB warning")
+		case OutputRecordKindError:
+			recordLogger.
+				With("identifier", record.Identifier).
+				With("stack", formatStack(record.Stack)).
+				Info("MATLAB error")
+		default:
+			recordLogger.Debug("MATLAB output")
+		}
+	}
+}
+
+func formatStack(stack []StackFrame) string {
+	frames := make([]string, 0, len(stack))
+	for _, frame := range stack {
+		frames = append(frames, fmt.Sprintf("%s (%s:%d)", frame.Name, frame.File, frame.Line))
+	}
+	return strings.Join(frames, " <- ")
+}
@@ -0,0 +1,50 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsessionclient
+
+import (
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+func NewSlowCallLoggingClient(client entities.MATLABSessionClient, threshold time.Duration) entities.MATLABSessionClient {
+	return newSlowCallLoggingClient(client, func() time.Duration { return threshold })
+}
+
+type IdentityClient = identityClient
+
+func NewTracingClient(client entities.MATLABSessionClient) entities.MATLABSessionClient {
+	return newTracingClient(client)
+}
+
+func NewMeasuringClient(client entities.MATLABSessionClient, metrics Metrics) entities.MATLABSessionClient {
+	return newMeasuringClient(client, metrics)
+}
+
+func NewIdentityClient(client entities.MATLABSessionClient) entities.MATLABSessionClient {
+	return newIdentityClient(client)
+}
+
+func NewRedactingClient(client entities.MATLABSessionClient, redactor Redactor) entities.MATLABSessionClient {
+	return newRedactingClient(client, redactor)
+}
+
+func NewLimitingClient(client entities.MATLABSessionClient, interrupter Interrupter, osLayer OSLayer, maxEvalTime time.Duration, maxOutputBytes int, maxFigures int, interruptGracePeriod time.Duration, outputPollInterval time.Duration) entities.MATLABSessionClient {
+	limitingClient := newLimitingClient(client, interrupter, osLayer, resourceLimits{
+		maxEvalTime:    func() time.Duration { return maxEvalTime },
+		maxOutputBytes: maxOutputBytes,
+		maxFigures:     maxFigures,
+	})
+	limitingClient.interruptGracePeriod = interruptGracePeriod
+	limitingClient.outputPollInterval = outputPollInterval
+	return limitingClient
+}
+
+type CancellingClient = cancellingClient
+
+func NewCancellingClient(client entities.MATLABSessionClient, interrupter Interrupter, gracePeriod time.Duration) entities.MATLABSessionClient {
+	cancellingClient := newCancellingClient(client, interrupter)
+	cancellingClient.gracePeriod = gracePeriod
+	return cancellingClient
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsessionclient
+
+import (
+	"context"
+	"sync"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/clientidentity"
+)
+
+// identityClient sets the identity of the user and client making each call in the environment of the MATLAB session,
+// so that MATLAB code, and the logs it writes, can tell who performed an action, for example with getenv("MATLAB_MCP_USER").
+// The environment is only updated when the identity changes.
+type identityClient struct {
+	client entities.MATLABSessionClient
+
+	lock    *sync.Mutex
+	current *clientidentity.Identity
+}
+
+func newIdentityClient(client entities.MATLABSessionClient) *identityClient {
+	return &identityClient{
+		client: client,
+		lock:   new(sync.Mutex),
+	}
+}
+
+func (c *identityClient) Eval(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) (entities.EvalResponse, error) {
+	c.setIdentity(ctx, sessionLogger)
+	return c.client.Eval(ctx, sessionLogger, request)
+}
+
+func (c *identityClient) EvalWithCapture(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) (entities.EvalResponse, error) {
+	c.setIdentity(ctx, sessionLogger)
+	return c.client.EvalWithCapture(ctx, sessionLogger, request)
+}
+
+func (c *identityClient) FEval(ctx context.Context, sessionLogger entities.Logger, request entities.FEvalRequest) (entities.FEvalResponse, error) {
+	c.setIdentity(ctx, sessionLogger)
+	return c.client.FEval(ctx, sessionLogger, request)
+}
+
+func (c *identityClient) Interrupt(ctx context.Context, sessionLogger entities.Logger) error {
+	return c.client.Interrupt(ctx, sessionLogger)
+}
+
+// setIdentity does not fail the call when the environment cannot be updated, as the identity is also in the server logs.
+func (c *identityClient) setIdentity(ctx context.Context, sessionLogger entities.Logger) {
+	identity, ok := clientidentity.FromContext(ctx)
+	if !ok {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.current != nil && *c.current == identity {
+		return
+	}
+
+	variables := [][]string{
+		{clientidentity.UserEnvironmentVariable, identity.User},
+		{clientidentity.ClientEnvironmentVariable, identity.Client},
+	}
+	for _, variable := range variables {
+		_, err := c.client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+			Function:   "setenv",
+			Arguments:  variable,
+			NumOutputs: 0,
+		})
+		if err != nil {
+			sessionLogger.WithError(err).Warn("Failed to set the client identity in the MATLAB session")
+			return
+		}
+	}
+
+	c.current = &identity
+}
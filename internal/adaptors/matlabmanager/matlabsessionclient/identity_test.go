@@ -0,0 +1,152 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsessionclient_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/clientidentity"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdentityClient_EvalWithCapture_SetsIdentityOnce(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := clientidentity.NewContext(t.Context(), clientidentity.Identity{User: "alice", Client: "claude-code 1.0.0"})
+	request := entities.EvalRequest{Code: "x = 1;"}
+	expectedResponse := entities.EvalResponse{ConsoleOutput: "x = 1"}
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{Function: "setenv", Arguments: []string{"MATLAB_MCP_USER", "alice"}}).
+		Return(entities.FEvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{Function: "setenv", Arguments: []string{"MATLAB_MCP_CLIENT", "claude-code 1.0.0"}}).
+		Return(entities.FEvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		EvalWithCapture(ctx, mockLogger.AsMockArg(), request).
+		Return(expectedResponse, nil).
+		Twice()
+
+	client := matlabsessionclient.NewIdentityClient(mockClient)
+
+	// Act
+	_, firstErr := client.EvalWithCapture(ctx, mockLogger, request)
+	response, secondErr := client.EvalWithCapture(ctx, mockLogger, request)
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, secondErr)
+	assert.Equal(t, expectedResponse, response)
+}
+
+func TestIdentityClient_Eval_UpdatesChangedIdentity(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	aliceCtx := clientidentity.NewContext(t.Context(), clientidentity.Identity{User: "alice", Client: "claude-code 1.0.0"})
+	bobCtx := clientidentity.NewContext(t.Context(), clientidentity.Identity{User: "bob", Client: "claude-code 1.0.0"})
+	request := entities.EvalRequest{Code: "x = 1;"}
+
+	for _, ctx := range []any{aliceCtx, bobCtx} {
+		mockClient.EXPECT().
+			FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{Function: "setenv", Arguments: []string{"MATLAB_MCP_CLIENT", "claude-code 1.0.0"}}).
+			Return(entities.FEvalResponse{}, nil).
+			Once()
+
+		mockClient.EXPECT().
+			Eval(ctx, mockLogger.AsMockArg(), request).
+			Return(entities.EvalResponse{}, nil).
+			Once()
+	}
+
+	mockClient.EXPECT().
+		FEval(aliceCtx, mockLogger.AsMockArg(), entities.FEvalRequest{Function: "setenv", Arguments: []string{"MATLAB_MCP_USER", "alice"}}).
+		Return(entities.FEvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(bobCtx, mockLogger.AsMockArg(), entities.FEvalRequest{Function: "setenv", Arguments: []string{"MATLAB_MCP_USER", "bob"}}).
+		Return(entities.FEvalResponse{}, nil).
+		Once()
+
+	client := matlabsessionclient.NewIdentityClient(mockClient)
+
+	// Act
+	_, aliceErr := client.Eval(aliceCtx, mockLogger, request)
+	_, bobErr := client.Eval(bobCtx, mockLogger, request)
+
+	// Assert
+	require.NoError(t, aliceErr)
+	require.NoError(t, bobErr)
+}
+
+func TestIdentityClient_Eval_SetenvErrorDoesNotFailTheCall(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := clientidentity.NewContext(t.Context(), clientidentity.Identity{User: "alice"})
+	request := entities.EvalRequest{Code: "x = 1;"}
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{Function: "setenv", Arguments: []string{"MATLAB_MCP_USER", "alice"}}).
+		Return(entities.FEvalResponse{}, assert.AnError).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), request).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	client := matlabsessionclient.NewIdentityClient(mockClient)
+
+	// Act
+	_, err := client.Eval(ctx, mockLogger, request)
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotEmpty(t, mockLogger.WarnLogs())
+}
+
+func TestIdentityClient_FEval_WithoutIdentity(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	request := entities.FEvalRequest{Function: "version", NumOutputs: 1}
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), request).
+		Return(entities.FEvalResponse{Outputs: []any{"25.1"}}, nil).
+		Once()
+
+	client := matlabsessionclient.NewIdentityClient(mockClient)
+
+	// Act
+	response, err := client.FEval(ctx, mockLogger, request)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []any{"25.1"}, response.Outputs)
+}
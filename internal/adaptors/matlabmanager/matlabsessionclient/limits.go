@@ -0,0 +1,256 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsessionclient
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// defaultInterruptGracePeriod is how long an interrupted call is given to return its partial output, before it is abandoned.
+const defaultInterruptGracePeriod = 10 * time.Second
+
+// defaultOutputPollInterval is how often the output written by a running call is checked against the output limit.
+const defaultOutputPollInterval = 100 * time.Millisecond
+
+// outputFileName is the name of the file the output of a call is written to while it runs, in a temporary folder of its own.
+const outputFileName = "output.txt"
+
+type Interrupter interface {
+	Interrupt(ctx context.Context, logger entities.Logger) error
+}
+
+type resourceLimits struct {
+	// maxEvalTime is read at each call, as it can change while the server runs.
+	maxEvalTime    func() time.Duration
+	maxOutputBytes int
+	maxFigures     int
+}
+
+// limitingClient enforces per-call resource ceilings: calls running longer than the wall time limit, or writing more
+// output than the output limit, are interrupted, and outputs larger than the output limits are truncated. In all cases,
+// the call fails with an entities.LimitExceededError holding the partial output. The output of evaluations is written
+// to a file while they run, so that the output limit is enforced before they return, and so that the output produced
+// before an interrupt is kept when the interrupted call fails. It must wrap the client of the session directly, so that
+// the wall time only counts the time spent executing, and not waiting behind other calls.
+type limitingClient struct {
+	client               entities.MATLABSessionClient
+	interrupter          Interrupter
+	osLayer              OSLayer
+	limits               resourceLimits
+	interruptGracePeriod time.Duration
+	outputPollInterval   time.Duration
+}
+
+func newLimitingClient(client entities.MATLABSessionClient, interrupter Interrupter, osLayer OSLayer, limits resourceLimits) *limitingClient {
+	return &limitingClient{
+		client:               client,
+		interrupter:          interrupter,
+		osLayer:              osLayer,
+		limits:               limits,
+		interruptGracePeriod: defaultInterruptGracePeriod,
+		outputPollInterval:   defaultOutputPollInterval,
+	}
+}
+
+func (c *limitingClient) Eval(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) (entities.EvalResponse, error) {
+	return c.limitEval(ctx, sessionLogger, request, c.client.Eval)
+}
+
+func (c *limitingClient) EvalWithCapture(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) (entities.EvalResponse, error) {
+	return c.limitEval(ctx, sessionLogger, request, c.client.EvalWithCapture)
+}
+
+func (c *limitingClient) FEval(ctx context.Context, sessionLogger entities.Logger, request entities.FEvalRequest) (entities.FEvalResponse, error) {
+	var response entities.FEvalResponse
+	limitErr, err := c.runWithLimits(ctx, sessionLogger, c.limits.maxEvalTime(), "", func(ctx context.Context) error {
+		var err error
+		response, err = c.client.FEval(ctx, sessionLogger, request)
+		return err
+	})
+	if limitErr != nil {
+		if err != nil {
+			sessionLogger.WithError(err).Debug("Interrupted MATLAB call returned an error")
+		}
+		return entities.FEvalResponse{}, limitErr
+	}
+	return response, err
+}
+
+func (c *limitingClient) Interrupt(ctx context.Context, sessionLogger entities.Logger) error {
+	return c.client.Interrupt(ctx, sessionLogger)
+}
+
+type evalFunc func(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) (entities.EvalResponse, error)
+
+func (c *limitingClient) limitEval(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest, eval evalFunc) (entities.EvalResponse, error) {
+	maxEvalTime := c.limits.maxEvalTime()
+	if maxEvalTime > 0 || c.limits.maxOutputBytes > 0 {
+		outputDir, err := c.osLayer.MkdirTemp("", "matlab-mcp-output-")
+		if err != nil {
+			sessionLogger.WithError(err).Warn("Failed to create the output file of a MATLAB call, its output is only checked once it returns")
+		} else {
+			defer func() {
+				if err := c.osLayer.RemoveAll(outputDir); err != nil {
+					sessionLogger.WithError(err).Warn("Failed to remove the output file of a MATLAB call")
+				}
+			}()
+			request.OutputFile = filepath.Join(outputDir, outputFileName)
+		}
+	}
+
+	var response entities.EvalResponse
+	limitErr, err := c.runWithLimits(ctx, sessionLogger, maxEvalTime, request.OutputFile, func(ctx context.Context) error {
+		var err error
+		response, err = eval(ctx, sessionLogger, request)
+		return err
+	})
+	if limitErr != nil {
+		// The interrupted call usually fails, and then only reports the interruption: its output is read from the output file.
+		if err != nil {
+			sessionLogger.WithError(err).Debug("Interrupted MATLAB call returned an error")
+			if response.ConsoleOutput == "" {
+				response.ConsoleOutput = c.writtenOutput(sessionLogger, request.OutputFile)
+			}
+		}
+		limitErr.Partial, _ = c.truncateOutput(response)
+		return entities.EvalResponse{}, limitErr
+	}
+	if err != nil {
+		return entities.EvalResponse{}, err
+	}
+
+	response, truncateErr := c.truncateOutput(response)
+	if truncateErr != nil {
+		sessionLogger.With("limit", string(truncateErr.Limit)).With("value", truncateErr.Value).Warn("MATLAB call output exceeded a resource limit")
+		return entities.EvalResponse{}, truncateErr
+	}
+
+	return response, nil
+}
+
+// runWithLimits runs call, and interrupts it once it runs longer than maxEvalTime, or once the output it wrote to
+// outputFile exceeds the output limit. It returns the error of the limit exceeded then, without partial output, or nil
+// when the call was not interrupted. maxEvalTime is 0, and outputFile empty, for no limit.
+// If MATLAB does not return within the grace period after the interrupt, the call is abandoned.
+func (c *limitingClient) runWithLimits(ctx context.Context, sessionLogger entities.Logger, maxEvalTime time.Duration, outputFile string, call func(ctx context.Context) error) (*entities.LimitExceededError, error) {
+	checkOutput := outputFile != "" && c.limits.maxOutputBytes > 0
+	if maxEvalTime == 0 && !checkOutput {
+		return nil, call(ctx)
+	}
+
+	callCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- call(callCtx)
+	}()
+
+	var limitTimeout <-chan time.Time
+	if maxEvalTime > 0 {
+		limitTimer := time.NewTimer(maxEvalTime)
+		defer limitTimer.Stop()
+		limitTimeout = limitTimer.C
+	}
+
+	var outputPoll <-chan time.Time
+	if checkOutput {
+		outputTicker := time.NewTicker(c.outputPollInterval)
+		defer outputTicker.Stop()
+		outputPoll = outputTicker.C
+	}
+
+	var limitErr *entities.LimitExceededError
+	for limitErr == nil {
+		select {
+		case err := <-done:
+			return nil, err
+		case <-limitTimeout:
+			sessionLogger.With("max-eval-time", maxEvalTime.String()).Warn("MATLAB call exceeded the wall time limit, interrupting it")
+			limitErr = entities.NewLimitExceededError(entities.ResourceLimitWallTime, maxEvalTime.String(), entities.EvalResponse{})
+		case <-outputPoll:
+			if c.writtenBytes(outputFile) > int64(c.limits.maxOutputBytes) {
+				sessionLogger.With("max-output-bytes", c.limits.maxOutputBytes).Warn("MATLAB call exceeded the output limit, interrupting it")
+				limitErr = entities.NewLimitExceededError(entities.ResourceLimitOutputBytes, fmt.Sprintf("%d bytes", c.limits.maxOutputBytes), entities.EvalResponse{})
+			}
+		}
+	}
+	limitErr.Interrupted = true
+
+	if err := c.interrupter.Interrupt(ctx, sessionLogger); err != nil {
+		sessionLogger.WithError(err).Warn("Failed to interrupt MATLAB call")
+	}
+
+	graceTimer := time.NewTimer(c.interruptGracePeriod)
+	defer graceTimer.Stop()
+
+	select {
+	case err := <-done:
+		return limitErr, err
+	case <-graceTimer.C:
+		sessionLogger.Warn("Interrupted MATLAB call did not return, abandoning it")
+		cancel()
+		return limitErr, <-done
+	}
+}
+
+// writtenBytes is the size of the output written to outputFile so far, 0 before MATLAB creates the file.
+func (c *limitingClient) writtenBytes(outputFile string) int64 {
+	info, err := c.osLayer.Stat(outputFile)
+	if err != nil {
+		return 0
+	}
+	return info.Size()
+}
+
+// writtenOutput is the output written to outputFile, empty when there is none.
+func (c *limitingClient) writtenOutput(sessionLogger entities.Logger, outputFile string) string {
+	if outputFile == "" {
+		return ""
+	}
+	output, err := c.osLayer.ReadFile(outputFile)
+	if err != nil {
+		sessionLogger.WithError(err).Debug("Failed to read the output file of an interrupted MATLAB call")
+		return ""
+	}
+	return string(output)
+}
+
+// truncateOutput applies the output limits to response, and returns the error of the first limit exceeded, if any.
+func (c *limitingClient) truncateOutput(response entities.EvalResponse) (entities.EvalResponse, *entities.LimitExceededError) {
+	var limit entities.ResourceLimit
+	var value string
+
+	if c.limits.maxOutputBytes > 0 && len(response.ConsoleOutput) > c.limits.maxOutputBytes {
+		response.ConsoleOutput = truncateUTF8(response.ConsoleOutput, c.limits.maxOutputBytes)
+		limit, value = entities.ResourceLimitOutputBytes, fmt.Sprintf("%d bytes", c.limits.maxOutputBytes)
+	}
+
+	if c.limits.maxFigures > 0 && len(response.Images) > c.limits.maxFigures {
+		response.Images = response.Images[:c.limits.maxFigures]
+		if limit == "" {
+			limit, value = entities.ResourceLimitFigures, strconv.Itoa(c.limits.maxFigures)
+		}
+	}
+
+	if limit == "" {
+		return response, nil
+	}
+
+	return response, entities.NewLimitExceededError(limit, value, response)
+}
+
+// truncateUTF8 truncates s to at most n bytes, without splitting a multi-byte character.
+func truncateUTF8(s string, n int) string {
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
@@ -0,0 +1,447 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsessionclient_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabsessionclient"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	osfacademocks "github.com/matlab/matlab-mcp-core-server/mocks/facades/osfacade"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimitingClient_Eval_WithinLimits(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockInterrupter := &mocks.MockInterrupter{}
+	defer mockInterrupter.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	outputDir := "/tmp/matlab-mcp-output-1"
+	request := entities.EvalRequest{Code: "x = 1"}
+	expectedRequest := entities.EvalRequest{Code: "x = 1", OutputFile: outputDir + "/output.txt"}
+	expectedResponse := entities.EvalResponse{ConsoleOutput: "x = 1"}
+
+	mockOSLayer.EXPECT().
+		MkdirTemp("", "matlab-mcp-output-").
+		Return(outputDir, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		RemoveAll(outputDir).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), expectedRequest).
+		Return(expectedResponse, nil).
+		Once()
+
+	client := matlabsessionclient.NewLimitingClient(mockClient, mockInterrupter, mockOSLayer, time.Hour, 1024, 1, time.Second, time.Hour)
+
+	// Act
+	response, err := client.Eval(t.Context(), mockLogger, request)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedResponse, response)
+}
+
+func TestLimitingClient_Eval_WallTimeExceeded(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockInterrupter := &mocks.MockInterrupter{}
+	defer mockInterrupter.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	outputDir := "/tmp/matlab-mcp-output-1"
+	outputFile := outputDir + "/output.txt"
+	request := entities.EvalRequest{Code: "while true; fprintf('x'); end"}
+	expectedRequest := entities.EvalRequest{Code: request.Code, OutputFile: outputFile}
+	interrupted := make(chan struct{})
+
+	mockOSLayer.EXPECT().
+		MkdirTemp("", "matlab-mcp-output-").
+		Return(outputDir, nil).
+		Once()
+
+	mockInterrupter.EXPECT().
+		Interrupt(mock.Anything, mockLogger.AsMockArg()).
+		Run(func(ctx context.Context, logger entities.Logger) {
+			close(interrupted)
+		}).
+		Return(nil).
+		Once()
+
+	// The embedded connector fails the interrupted call, without its output.
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), expectedRequest).
+		Run(func(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) {
+			<-interrupted
+		}).
+		Return(entities.EvalResponse{}, assert.AnError).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(outputFile).
+		Return([]byte("xxxxxxxxxx"), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		RemoveAll(outputDir).
+		Return(nil).
+		Once()
+
+	client := matlabsessionclient.NewLimitingClient(mockClient, mockInterrupter, mockOSLayer, 5*time.Millisecond, 4, 0, time.Second, time.Hour)
+
+	// Act
+	response, err := client.Eval(t.Context(), mockLogger, request)
+
+	// Assert
+	assert.Empty(t, response)
+	var limitErr *entities.LimitExceededError
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, entities.ErrorCodeLimitExceeded, entities.ErrorCodeOf(err))
+	assert.Equal(t, entities.ResourceLimitWallTime, limitErr.Limit)
+	assert.Equal(t, "5ms", limitErr.Value)
+	assert.Equal(t, "xxxx", limitErr.Partial.ConsoleOutput, "The partial output should be truncated to the output limit")
+	assert.True(t, limitErr.Interrupted)
+
+	_, found := mockLogger.WarnLogs()["MATLAB call exceeded the wall time limit, interrupting it"]
+	assert.True(t, found)
+}
+
+func TestLimitingClient_Eval_OutputLimitExceededWhileRunning(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockInterrupter := &mocks.MockInterrupter{}
+	defer mockInterrupter.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFileInfo.AssertExpectations(t)
+
+	outputDir := "/tmp/matlab-mcp-output-1"
+	outputFile := outputDir + "/output.txt"
+	request := entities.EvalRequest{Code: "while true; fprintf('x'); end"}
+	expectedRequest := entities.EvalRequest{Code: request.Code, OutputFile: outputFile}
+	interrupted := make(chan struct{})
+
+	mockOSLayer.EXPECT().
+		MkdirTemp("", "matlab-mcp-output-").
+		Return(outputDir, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(outputFile).
+		Return(mockFileInfo, nil).
+		Once()
+
+	mockFileInfo.EXPECT().
+		Size().
+		Return(int64(10)).
+		Once()
+
+	mockInterrupter.EXPECT().
+		Interrupt(mock.Anything, mockLogger.AsMockArg()).
+		Run(func(ctx context.Context, logger entities.Logger) {
+			close(interrupted)
+		}).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		EvalWithCapture(mock.Anything, mockLogger.AsMockArg(), expectedRequest).
+		Run(func(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) {
+			<-interrupted
+		}).
+		Return(entities.EvalResponse{}, assert.AnError).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(outputFile).
+		Return([]byte("xxxxxxxxxx"), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		RemoveAll(outputDir).
+		Return(nil).
+		Once()
+
+	client := matlabsessionclient.NewLimitingClient(mockClient, mockInterrupter, mockOSLayer, 0, 4, 0, time.Second, time.Millisecond)
+
+	// Act
+	response, err := client.EvalWithCapture(t.Context(), mockLogger, request)
+
+	// Assert
+	assert.Empty(t, response)
+	var limitErr *entities.LimitExceededError
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, entities.ResourceLimitOutputBytes, limitErr.Limit)
+	assert.Equal(t, "4 bytes", limitErr.Value)
+	assert.Equal(t, "xxxx", limitErr.Partial.ConsoleOutput)
+	assert.True(t, limitErr.Interrupted)
+	assert.Equal(t, "the MATLAB call exceeded the output size limit of 4 bytes and was interrupted", err.Error())
+
+	_, found := mockLogger.WarnLogs()["MATLAB call exceeded the output limit, interrupting it"]
+	assert.True(t, found)
+}
+
+func TestLimitingClient_Eval_InterruptedCallIsAbandoned(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockInterrupter := &mocks.MockInterrupter{}
+	defer mockInterrupter.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	outputDir := "/tmp/matlab-mcp-output-1"
+	outputFile := outputDir + "/output.txt"
+	request := entities.EvalRequest{Code: "pause(inf)"}
+	expectedRequest := entities.EvalRequest{Code: request.Code, OutputFile: outputFile}
+
+	mockOSLayer.EXPECT().
+		MkdirTemp("", "matlab-mcp-output-").
+		Return(outputDir, nil).
+		Once()
+
+	mockInterrupter.EXPECT().
+		Interrupt(mock.Anything, mockLogger.AsMockArg()).
+		Return(assert.AnError).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), expectedRequest).
+		RunAndReturn(func(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) (entities.EvalResponse, error) {
+			<-ctx.Done()
+			return entities.EvalResponse{}, ctx.Err()
+		}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(outputFile).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	mockOSLayer.EXPECT().
+		RemoveAll(outputDir).
+		Return(nil).
+		Once()
+
+	client := matlabsessionclient.NewLimitingClient(mockClient, mockInterrupter, mockOSLayer, 5*time.Millisecond, 0, 0, 5*time.Millisecond, time.Hour)
+
+	// Act
+	_, err := client.Eval(t.Context(), mockLogger, request)
+
+	// Assert
+	var limitErr *entities.LimitExceededError
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, entities.ResourceLimitWallTime, limitErr.Limit)
+	assert.Empty(t, limitErr.Partial)
+	assert.False(t, errors.Is(err, context.Canceled), "The cancellation of the abandoned call should not be reported")
+
+	_, found := mockLogger.WarnLogs()["Interrupted MATLAB call did not return, abandoning it"]
+	assert.True(t, found)
+}
+
+func TestLimitingClient_EvalWithCapture_OutputLimits(t *testing.T) {
+	testCases := []struct {
+		name            string
+		maxOutputBytes  int
+		maxFigures      int
+		response        entities.EvalResponse
+		expectedLimit   entities.ResourceLimit
+		expectedValue   string
+		expectedPartial entities.EvalResponse
+	}{
+		{
+			name:            "output bytes",
+			maxOutputBytes:  5,
+			response:        entities.EvalResponse{ConsoleOutput: "abcdefgh"},
+			expectedLimit:   entities.ResourceLimitOutputBytes,
+			expectedValue:   "5 bytes",
+			expectedPartial: entities.EvalResponse{ConsoleOutput: "abcde"},
+		},
+		{
+			name:            "output bytes within a multi-byte character",
+			maxOutputBytes:  2,
+			response:        entities.EvalResponse{ConsoleOutput: "aéb"},
+			expectedLimit:   entities.ResourceLimitOutputBytes,
+			expectedValue:   "2 bytes",
+			expectedPartial: entities.EvalResponse{ConsoleOutput: "a"},
+		},
+		{
+			name:            "figure count",
+			maxFigures:      1,
+			response:        entities.EvalResponse{ConsoleOutput: "done", Images: [][]byte{[]byte("figure 1"), []byte("figure 2")}},
+			expectedLimit:   entities.ResourceLimitFigures,
+			expectedValue:   "1",
+			expectedPartial: entities.EvalResponse{ConsoleOutput: "done", Images: [][]byte{[]byte("figure 1")}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			mockInterrupter := &mocks.MockInterrupter{}
+			defer mockInterrupter.AssertExpectations(t)
+
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			ctx := t.Context()
+			request := entities.EvalRequest{Code: "run"}
+			expectedRequest := request
+
+			if testCase.maxOutputBytes > 0 {
+				outputDir := "/tmp/matlab-mcp-output-1"
+				expectedRequest.OutputFile = outputDir + "/output.txt"
+
+				mockOSLayer.EXPECT().
+					MkdirTemp("", "matlab-mcp-output-").
+					Return(outputDir, nil).
+					Once()
+
+				mockOSLayer.EXPECT().
+					RemoveAll(outputDir).
+					Return(nil).
+					Once()
+			}
+
+			mockClient.EXPECT().
+				EvalWithCapture(mock.Anything, mockLogger.AsMockArg(), expectedRequest).
+				Return(testCase.response, nil).
+				Once()
+
+			client := matlabsessionclient.NewLimitingClient(mockClient, mockInterrupter, mockOSLayer, 0, testCase.maxOutputBytes, testCase.maxFigures, time.Second, time.Hour)
+
+			// Act
+			response, err := client.EvalWithCapture(ctx, mockLogger, request)
+
+			// Assert
+			assert.Empty(t, response)
+			var limitErr *entities.LimitExceededError
+			require.ErrorAs(t, err, &limitErr)
+			assert.Equal(t, testCase.expectedLimit, limitErr.Limit)
+			assert.Equal(t, testCase.expectedValue, limitErr.Value)
+			assert.Equal(t, testCase.expectedPartial, limitErr.Partial)
+		})
+	}
+}
+
+func TestLimitingClient_EvalWithCapture_ErrorIsReturned(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockInterrupter := &mocks.MockInterrupter{}
+	defer mockInterrupter.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	ctx := t.Context()
+	request := entities.EvalRequest{Code: "error('boom')"}
+
+	mockOSLayer.EXPECT().
+		MkdirTemp("", "matlab-mcp-output-").
+		Return("", assert.AnError).
+		Once()
+
+	mockClient.EXPECT().
+		EvalWithCapture(ctx, mockLogger.AsMockArg(), request).
+		Return(entities.EvalResponse{}, assert.AnError).
+		Once()
+
+	client := matlabsessionclient.NewLimitingClient(mockClient, mockInterrupter, mockOSLayer, 0, 10, 0, time.Second, time.Hour)
+
+	// Act
+	_, err := client.EvalWithCapture(ctx, mockLogger, request)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+func TestLimitingClient_FEval_WallTimeExceeded(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockInterrupter := &mocks.MockInterrupter{}
+	defer mockInterrupter.AssertExpectations(t)
+
+	request := entities.FEvalRequest{Function: "pause", Arguments: []string{"inf"}}
+	interrupted := make(chan struct{})
+
+	mockInterrupter.EXPECT().
+		Interrupt(mock.Anything, mockLogger.AsMockArg()).
+		Run(func(ctx context.Context, logger entities.Logger) {
+			close(interrupted)
+		}).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(mock.Anything, mockLogger.AsMockArg(), request).
+		Run(func(ctx context.Context, sessionLogger entities.Logger, request entities.FEvalRequest) {
+			<-interrupted
+		}).
+		Return(entities.FEvalResponse{}, assert.AnError).
+		Once()
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	client := matlabsessionclient.NewLimitingClient(mockClient, mockInterrupter, mockOSLayer, 5*time.Millisecond, 0, 0, time.Second, time.Hour)
+
+	// Act
+	response, err := client.FEval(t.Context(), mockLogger, request)
+
+	// Assert
+	assert.Empty(t, response)
+	var limitErr *entities.LimitExceededError
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, entities.ResourceLimitWallTime, limitErr.Limit)
+}
@@ -3,27 +3,106 @@
 package matlabsessionclient
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
 	"github.com/matlab/matlab-mcp-core-server/internal/utils/httpclientfactory"
 )
 
 type HttpClientFactory interface {
 	NewClientForSelfSignedTLSServer(certificatePEM []byte) (httpclientfactory.HttpClient, error)
+	NewClientForMutualTLS(clientCertPEM []byte, clientKeyPEM []byte, caPEM []byte) (httpclientfactory.HttpClient, error)
+}
+
+type Config interface {
+	SlowCallThreshold() time.Duration
+	MaxEvalTime() time.Duration
+	MaxOutputBytes() int
+	MaxFigures() int
+	MATLABClientCertificate() string
+	MATLABClientKey() string
+}
+
+type OSLayer interface {
+	ReadFile(name string) ([]byte, error)
+	MkdirTemp(dir string, pattern string) (string, error)
+	Stat(name string) (osfacade.FileInfo, error)
+	RemoveAll(path string) error
+}
+
+type Redactor interface {
+	Enabled() bool
+	Redact(text string) (string, int)
+}
+
+type Metrics interface {
+	RecordEvaluation(operation string, duration time.Duration, failed bool)
 }
 
 type Factory struct {
 	httpClientFactory HttpClientFactory
+	config            Config
+	redactor          Redactor
+	osLayer           OSLayer
+	metrics           Metrics
 }
 
 func NewFactory(
 	httpClientFactory HttpClientFactory,
+	config Config,
+	redactor Redactor,
+	osLayer OSLayer,
+	metrics Metrics,
 ) *Factory {
 	return &Factory{
 		httpClientFactory: httpClientFactory,
+		config:            config,
+		redactor:          redactor,
+		osLayer:           osLayer,
+		metrics:           metrics,
 	}
 }
 
 func (f *Factory) New(endpoint embeddedconnector.ConnectionDetails) (entities.MATLABSessionClient, error) {
-	return embeddedconnector.NewClient(endpoint, f.httpClientFactory)
+	// The client certificate is read for each session, so that a renewed certificate is used by the next session.
+	if certificateFile := f.config.MATLABClientCertificate(); certificateFile != "" {
+		certificatePEM, err := f.osLayer.ReadFile(certificateFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read MATLAB client certificate: %w", err)
+		}
+
+		keyPEM, err := f.osLayer.ReadFile(f.config.MATLABClientKey())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read MATLAB client key: %w", err)
+		}
+
+		endpoint.ClientCertificatePEM = certificatePEM
+		endpoint.ClientKeyPEM = keyPEM
+	}
+
+	connectorClient, err := embeddedconnector.NewClient(endpoint, f.httpClientFactory)
+	if err != nil {
+		return nil, err
+	}
+
+	var client entities.MATLABSessionClient = newIdentityClient(newTracingClient(newMeasuringClient(connectorClient, f.metrics)))
+
+	// The wall time limit and the slow call threshold are read at each call, so that a reload of the configuration
+	// applies to the sessions already running.
+	client = newLimitingClient(client, connectorClient, f.osLayer, resourceLimits{
+		maxEvalTime:    f.config.MaxEvalTime,
+		maxOutputBytes: f.config.MaxOutputBytes(),
+		maxFigures:     f.config.MaxFigures(),
+	})
+
+	client = newCancellingClient(client, connectorClient)
+
+	if f.redactor.Enabled() {
+		client = newRedactingClient(client, f.redactor)
+	}
+
+	return newSlowCallLoggingClient(client, f.config.SlowCallThreshold), nil
 }
@@ -3,6 +3,7 @@
 package matlabsessionclient_test
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient"
@@ -18,8 +19,20 @@ func TestNew_HappyPath(t *testing.T) {
 	mockHTTPClientFactory := &mocks.MockHttpClientFactory{}
 	defer mockHTTPClientFactory.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockRedactor := &mocks.MockRedactor{}
+	defer mockRedactor.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockMetrics := &mocks.MockMetrics{}
+	defer mockMetrics.AssertExpectations(t)
+
 	// Act
-	factory := matlabsessionclient.NewFactory(mockHTTPClientFactory)
+	factory := matlabsessionclient.NewFactory(mockHTTPClientFactory, mockConfig, mockRedactor, mockOSLayer, mockMetrics)
 
 	// Assert
 	assert.NotNil(t, factory)
@@ -39,7 +52,39 @@ func TestFactory_New_HappyPath(t *testing.T) {
 		Return(mockHTTPClient, nil).
 		Once()
 
-	factory := matlabsessionclient.NewFactory(mockHTTPClientFactory)
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockRedactor := &mocks.MockRedactor{}
+	defer mockRedactor.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		MaxOutputBytes().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxFigures().
+		Return(0).
+		Once()
+
+	mockRedactor.EXPECT().
+		Enabled().
+		Return(false).
+		Once()
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockMetrics := &mocks.MockMetrics{}
+	defer mockMetrics.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		MATLABClientCertificate().
+		Return("").
+		Once()
+
+	factory := matlabsessionclient.NewFactory(mockHTTPClientFactory, mockConfig, mockRedactor, mockOSLayer, mockMetrics)
 
 	connectionDetails := embeddedconnector.ConnectionDetails{
 		Host:           "localhost",
@@ -69,7 +114,24 @@ func TestFactory_New_HttpClientCreationErrors(t *testing.T) {
 		Return(nil, expectedError).
 		Once()
 
-	factory := matlabsessionclient.NewFactory(mockHTTPClientFactory)
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockRedactor := &mocks.MockRedactor{}
+	defer mockRedactor.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockMetrics := &mocks.MockMetrics{}
+	defer mockMetrics.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		MATLABClientCertificate().
+		Return("").
+		Once()
+
+	factory := matlabsessionclient.NewFactory(mockHTTPClientFactory, mockConfig, mockRedactor, mockOSLayer, mockMetrics)
 
 	connectionDetails := embeddedconnector.ConnectionDetails{
 		Host:           "localhost",
@@ -85,3 +147,249 @@ func TestFactory_New_HttpClientCreationErrors(t *testing.T) {
 	require.ErrorIs(t, err, expectedError)
 	assert.Nil(t, client)
 }
+
+func TestFactory_New_RedactionEnabled(t *testing.T) {
+	// Arrange
+	mockHTTPClientFactory := &mocks.MockHttpClientFactory{}
+	defer mockHTTPClientFactory.AssertExpectations(t)
+
+	mockHTTPClient := &httpclientfactorymocks.MockHttpClient{}
+	defer mockHTTPClient.AssertExpectations(t)
+
+	expectedCertificatePEM := []byte("some cert")
+	mockHTTPClientFactory.EXPECT().
+		NewClientForSelfSignedTLSServer(expectedCertificatePEM).
+		Return(mockHTTPClient, nil).
+		Once()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockRedactor := &mocks.MockRedactor{}
+	defer mockRedactor.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		MaxOutputBytes().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxFigures().
+		Return(0).
+		Once()
+
+	mockRedactor.EXPECT().
+		Enabled().
+		Return(true).
+		Once()
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockMetrics := &mocks.MockMetrics{}
+	defer mockMetrics.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		MATLABClientCertificate().
+		Return("").
+		Once()
+
+	factory := matlabsessionclient.NewFactory(mockHTTPClientFactory, mockConfig, mockRedactor, mockOSLayer, mockMetrics)
+
+	connectionDetails := embeddedconnector.ConnectionDetails{
+		Host:           "localhost",
+		Port:           "9910",
+		APIKey:         "test-api-key",
+		CertificatePEM: expectedCertificatePEM,
+	}
+
+	// Act
+	client, err := factory.New(connectionDetails)
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotNil(t, client)
+	assert.NotEqual(t, reflect.TypeOf(&matlabsessionclient.CancellingClient{}), reflect.TypeOf(client), "The client should be wrapped to redact its logs")
+}
+
+func TestFactory_New_ResourceLimitsEnabled(t *testing.T) {
+	// Arrange
+	mockHTTPClientFactory := &mocks.MockHttpClientFactory{}
+	defer mockHTTPClientFactory.AssertExpectations(t)
+
+	mockHTTPClient := &httpclientfactorymocks.MockHttpClient{}
+	defer mockHTTPClient.AssertExpectations(t)
+
+	expectedCertificatePEM := []byte("some cert")
+	mockHTTPClientFactory.EXPECT().
+		NewClientForSelfSignedTLSServer(expectedCertificatePEM).
+		Return(mockHTTPClient, nil).
+		Once()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockRedactor := &mocks.MockRedactor{}
+	defer mockRedactor.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		MaxOutputBytes().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxFigures().
+		Return(0).
+		Once()
+
+	mockRedactor.EXPECT().
+		Enabled().
+		Return(false).
+		Once()
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockMetrics := &mocks.MockMetrics{}
+	defer mockMetrics.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		MATLABClientCertificate().
+		Return("").
+		Once()
+
+	factory := matlabsessionclient.NewFactory(mockHTTPClientFactory, mockConfig, mockRedactor, mockOSLayer, mockMetrics)
+
+	connectionDetails := embeddedconnector.ConnectionDetails{
+		Host:           "localhost",
+		Port:           "9910",
+		APIKey:         "test-api-key",
+		CertificatePEM: expectedCertificatePEM,
+	}
+
+	// Act
+	client, err := factory.New(connectionDetails)
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotNil(t, client)
+	assert.NotEqual(t, reflect.TypeOf(&matlabsessionclient.IdentityClient{}), reflect.TypeOf(client), "The client should be wrapped to enforce the resource limits")
+}
+
+func TestFactory_New_ClientCertificate(t *testing.T) {
+	// Arrange
+	mockHTTPClientFactory := &mocks.MockHttpClientFactory{}
+	defer mockHTTPClientFactory.AssertExpectations(t)
+
+	mockHTTPClient := &httpclientfactorymocks.MockHttpClient{}
+	defer mockHTTPClient.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockRedactor := &mocks.MockRedactor{}
+	defer mockRedactor.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockMetrics := &mocks.MockMetrics{}
+	defer mockMetrics.AssertExpectations(t)
+
+	certificatePEM := []byte("some cert")
+	clientCertificatePEM := []byte("client cert")
+	clientKeyPEM := []byte("client key")
+
+	mockConfig.EXPECT().
+		MATLABClientCertificate().
+		Return("/home/user/certs/client.pem").
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABClientKey().
+		Return("/home/user/certs/client-key.pem").
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile("/home/user/certs/client.pem").
+		Return(clientCertificatePEM, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile("/home/user/certs/client-key.pem").
+		Return(clientKeyPEM, nil).
+		Once()
+
+	mockHTTPClientFactory.EXPECT().
+		NewClientForMutualTLS(clientCertificatePEM, clientKeyPEM, certificatePEM).
+		Return(mockHTTPClient, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxOutputBytes().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxFigures().
+		Return(0).
+		Once()
+
+	mockRedactor.EXPECT().
+		Enabled().
+		Return(false).
+		Once()
+
+	factory := matlabsessionclient.NewFactory(mockHTTPClientFactory, mockConfig, mockRedactor, mockOSLayer, mockMetrics)
+
+	connectionDetails := embeddedconnector.ConnectionDetails{
+		Host:           "localhost",
+		Port:           "9910",
+		APIKey:         "test-api-key",
+		CertificatePEM: certificatePEM,
+	}
+
+	// Act
+	client, err := factory.New(connectionDetails)
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotNil(t, client)
+}
+
+func TestFactory_New_ClientCertificateReadError(t *testing.T) {
+	// Arrange
+	mockHTTPClientFactory := &mocks.MockHttpClientFactory{}
+	defer mockHTTPClientFactory.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockRedactor := &mocks.MockRedactor{}
+	defer mockRedactor.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockMetrics := &mocks.MockMetrics{}
+	defer mockMetrics.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		MATLABClientCertificate().
+		Return("/home/user/certs/client.pem").
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile("/home/user/certs/client.pem").
+		Return(nil, assert.AnError).
+		Once()
+
+	factory := matlabsessionclient.NewFactory(mockHTTPClientFactory, mockConfig, mockRedactor, mockOSLayer, mockMetrics)
+
+	// Act
+	client, err := factory.New(embeddedconnector.ConnectionDetails{CertificatePEM: []byte("some cert")})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, client)
+}
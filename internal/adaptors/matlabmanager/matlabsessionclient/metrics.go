@@ -0,0 +1,49 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsessionclient
+
+import (
+	"context"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// measuringClient records the duration of every request evaluating code in the MATLAB session. The interrupts are not
+// recorded, as they do not evaluate code.
+type measuringClient struct {
+	client  entities.MATLABSessionClient
+	metrics Metrics
+}
+
+func newMeasuringClient(client entities.MATLABSessionClient, metrics Metrics) *measuringClient {
+	return &measuringClient{
+		client:  client,
+		metrics: metrics,
+	}
+}
+
+func (c *measuringClient) Eval(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) (entities.EvalResponse, error) {
+	startedAt := time.Now()
+	response, err := c.client.Eval(ctx, sessionLogger, request)
+	c.metrics.RecordEvaluation("eval", time.Since(startedAt), err != nil)
+	return response, err
+}
+
+func (c *measuringClient) EvalWithCapture(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) (entities.EvalResponse, error) {
+	startedAt := time.Now()
+	response, err := c.client.EvalWithCapture(ctx, sessionLogger, request)
+	c.metrics.RecordEvaluation("eval_with_capture", time.Since(startedAt), err != nil)
+	return response, err
+}
+
+func (c *measuringClient) FEval(ctx context.Context, sessionLogger entities.Logger, request entities.FEvalRequest) (entities.FEvalResponse, error) {
+	startedAt := time.Now()
+	response, err := c.client.FEval(ctx, sessionLogger, request)
+	c.metrics.RecordEvaluation("feval", time.Since(startedAt), err != nil)
+	return response, err
+}
+
+func (c *measuringClient) Interrupt(ctx context.Context, sessionLogger entities.Logger) error {
+	return c.client.Interrupt(ctx, sessionLogger)
+}
@@ -0,0 +1,104 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsessionclient_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabsessionclient"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMeasuringClient_EvalWithCapture_RecordsEvaluation(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockMetrics := &mocks.MockMetrics{}
+	defer mockMetrics.AssertExpectations(t)
+
+	request := entities.EvalRequest{Code: "x = 1;"}
+	expectedResponse := entities.EvalResponse{ConsoleOutput: "x = 1"}
+
+	mockClient.EXPECT().
+		EvalWithCapture(t.Context(), mockLogger.AsMockArg(), request).
+		Return(expectedResponse, nil).
+		Once()
+
+	mockMetrics.EXPECT().
+		RecordEvaluation("eval_with_capture", mock.Anything, false).
+		Return().
+		Once()
+
+	client := matlabsessionclient.NewMeasuringClient(mockClient, mockMetrics)
+
+	// Act
+	response, err := client.EvalWithCapture(t.Context(), mockLogger, request)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedResponse, response)
+}
+
+func TestMeasuringClient_FEval_RecordsFailure(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockMetrics := &mocks.MockMetrics{}
+	defer mockMetrics.AssertExpectations(t)
+
+	request := entities.FEvalRequest{Function: "pwd"}
+
+	mockClient.EXPECT().
+		FEval(t.Context(), mockLogger.AsMockArg(), request).
+		Return(entities.FEvalResponse{}, assert.AnError).
+		Once()
+
+	mockMetrics.EXPECT().
+		RecordEvaluation("feval", mock.Anything, true).
+		Return().
+		Once()
+
+	client := matlabsessionclient.NewMeasuringClient(mockClient, mockMetrics)
+
+	// Act
+	_, err := client.FEval(t.Context(), mockLogger, request)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+func TestMeasuringClient_Interrupt_NotRecorded(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockMetrics := &mocks.MockMetrics{}
+	defer mockMetrics.AssertExpectations(t)
+
+	mockClient.EXPECT().
+		Interrupt(t.Context(), mockLogger.AsMockArg()).
+		Return(nil).
+		Once()
+
+	client := matlabsessionclient.NewMeasuringClient(mockClient, mockMetrics)
+
+	// Act
+	err := client.Interrupt(t.Context(), mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+}
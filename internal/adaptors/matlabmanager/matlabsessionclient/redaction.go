@@ -0,0 +1,101 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsessionclient
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// redactingClient redacts secrets from everything the wrapped client logs, such as the MATLAB output of evaluations.
+// The responses are returned unchanged: the tool results are redacted by the MCP server, which also counts the redactions.
+type redactingClient struct {
+	client   entities.MATLABSessionClient
+	redactor Redactor
+}
+
+func newRedactingClient(client entities.MATLABSessionClient, redactor Redactor) *redactingClient {
+	return &redactingClient{
+		client:   client,
+		redactor: redactor,
+	}
+}
+
+func (c *redactingClient) Eval(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) (entities.EvalResponse, error) {
+	return c.client.Eval(ctx, newRedactingLogger(sessionLogger, c.redactor), request)
+}
+
+func (c *redactingClient) EvalWithCapture(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) (entities.EvalResponse, error) {
+	return c.client.EvalWithCapture(ctx, newRedactingLogger(sessionLogger, c.redactor), request)
+}
+
+func (c *redactingClient) FEval(ctx context.Context, sessionLogger entities.Logger, request entities.FEvalRequest) (entities.FEvalResponse, error) {
+	return c.client.FEval(ctx, newRedactingLogger(sessionLogger, c.redactor), request)
+}
+
+func (c *redactingClient) Interrupt(ctx context.Context, sessionLogger entities.Logger) error {
+	return c.client.Interrupt(ctx, newRedactingLogger(sessionLogger, c.redactor))
+}
+
+// redactingLogger redacts the messages, string fields and errors it logs.
+type redactingLogger struct {
+	logger   entities.Logger
+	redactor Redactor
+}
+
+func newRedactingLogger(logger entities.Logger, redactor Redactor) *redactingLogger {
+	return &redactingLogger{
+		logger:   logger,
+		redactor: redactor,
+	}
+}
+
+func (l *redactingLogger) Debug(msg string) {
+	l.logger.Debug(l.redact(msg))
+}
+
+func (l *redactingLogger) Info(msg string) {
+	l.logger.Info(l.redact(msg))
+}
+
+func (l *redactingLogger) Warn(msg string) {
+	l.logger.Warn(l.redact(msg))
+}
+
+func (l *redactingLogger) Error(msg string) {
+	l.logger.Error(l.redact(msg))
+}
+
+func (l *redactingLogger) With(key string, value any) entities.Logger {
+	if text, ok := value.(string); ok {
+		value = l.redact(text)
+	}
+	return newRedactingLogger(l.logger.With(key, value), l.redactor)
+}
+
+func (l *redactingLogger) WithError(err error) entities.Logger {
+	if err == nil {
+		return newRedactingLogger(l.logger.WithError(err), l.redactor)
+	}
+	return newRedactingLogger(l.logger.WithError(&redactedError{err: err, message: l.redact(err.Error())}), l.redactor)
+}
+
+func (l *redactingLogger) redact(text string) string {
+	redacted, _ := l.redactor.Redact(text)
+	return redacted
+}
+
+// redactedError keeps the wrapped error available to errors.Is and errors.As, with a redacted message.
+type redactedError struct {
+	err     error
+	message string
+}
+
+func (e *redactedError) Error() string {
+	return e.message
+}
+
+func (e *redactedError) Unwrap() error {
+	return e.err
+}
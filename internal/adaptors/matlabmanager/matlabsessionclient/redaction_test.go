@@ -0,0 +1,96 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsessionclient_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type replacingRedactor struct {
+	secret string
+}
+
+func (r replacingRedactor) Enabled() bool {
+	return true
+}
+
+func (r replacingRedactor) Redact(text string) (string, int) {
+	return strings.ReplaceAll(text, r.secret, "[REDACTED]"), strings.Count(text, r.secret)
+}
+
+func TestRedactingClient_EvalWithCapture_RedactsLogs(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	request := entities.EvalRequest{Code: "disp(getenv('API_KEY'))"}
+	expectedResponse := entities.EvalResponse{ConsoleOutput: "hunter2"}
+
+	mockClient.EXPECT().
+		EvalWithCapture(ctx, mock.Anything, request).
+		Run(func(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) {
+			sessionLogger.With("text", "hunter2").Debug("MATLAB output")
+			sessionLogger.WithError(fmt.Errorf("failed with hunter2")).Warn("Output hunter2 failed")
+		}).
+		Return(expectedResponse, nil).
+		Once()
+
+	client := matlabsessionclient.NewRedactingClient(mockClient, replacingRedactor{secret: "hunter2"})
+
+	// Act
+	response, err := client.EvalWithCapture(ctx, mockLogger, request)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedResponse, response, "The response should be returned unchanged")
+
+	debugLog, found := mockLogger.DebugLogs()["MATLAB output"]
+	require.True(t, found)
+	assert.Equal(t, "[REDACTED]", debugLog["text"], "String fields should be redacted")
+
+	warnLog, found := mockLogger.WarnLogs()["Output [REDACTED] failed"]
+	require.True(t, found, "Messages should be redacted")
+	loggedErr, ok := warnLog["error"].(error)
+	require.True(t, ok)
+	assert.Equal(t, "failed with [REDACTED]", loggedErr.Error(), "Errors should be redacted")
+}
+
+func TestRedactingClient_FEval_PassesThrough(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	request := entities.FEvalRequest{Function: "ver"}
+	expectedResponse := entities.FEvalResponse{Outputs: []any{"MATLAB"}}
+
+	mockClient.EXPECT().
+		FEval(ctx, mock.Anything, request).
+		Return(expectedResponse, assert.AnError).
+		Once()
+
+	client := matlabsessionclient.NewRedactingClient(mockClient, replacingRedactor{secret: "hunter2"})
+
+	// Act
+	response, err := client.FEval(ctx, mockLogger, request)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Equal(t, expectedResponse, response)
+}
@@ -0,0 +1,115 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsessionclient
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/tracing"
+)
+
+const snippetHashLength = 12
+
+// slowCallLoggingClient serializes calls to a MATLAB session, and emits a warning for every call whose
+// total duration exceeds the configured threshold. MATLAB processes requests one at a time, so the
+// serialization makes the time spent waiting behind other calls measurable, rather than adding a new constraint.
+// The threshold is read at each call, as it can change while the server runs, and 0 disables the warnings.
+type slowCallLoggingClient struct {
+	client    entities.MATLABSessionClient
+	threshold func() time.Duration
+	queue     chan struct{}
+}
+
+func newSlowCallLoggingClient(client entities.MATLABSessionClient, threshold func() time.Duration) *slowCallLoggingClient {
+	return &slowCallLoggingClient{
+		client:    client,
+		threshold: threshold,
+		queue:     make(chan struct{}, 1),
+	}
+}
+
+func (c *slowCallLoggingClient) Eval(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) (entities.EvalResponse, error) {
+	var response entities.EvalResponse
+	err := c.timeCall(ctx, sessionLogger, "eval", request.Code, func() error {
+		var err error
+		response, err = c.client.Eval(ctx, sessionLogger, request)
+		return err
+	})
+	return response, err
+}
+
+func (c *slowCallLoggingClient) EvalWithCapture(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) (entities.EvalResponse, error) {
+	var response entities.EvalResponse
+	err := c.timeCall(ctx, sessionLogger, "eval-with-capture", request.Code, func() error {
+		var err error
+		response, err = c.client.EvalWithCapture(ctx, sessionLogger, request)
+		return err
+	})
+	return response, err
+}
+
+func (c *slowCallLoggingClient) FEval(ctx context.Context, sessionLogger entities.Logger, request entities.FEvalRequest) (entities.FEvalResponse, error) {
+	var response entities.FEvalResponse
+	snippet := request.Function + "(" + strings.Join(request.Arguments, ",") + ")"
+	err := c.timeCall(ctx, sessionLogger, "feval", snippet, func() error {
+		var err error
+		response, err = c.client.FEval(ctx, sessionLogger, request)
+		return err
+	})
+	return response, err
+}
+
+// Interrupt is not queued behind the call it interrupts.
+func (c *slowCallLoggingClient) Interrupt(ctx context.Context, sessionLogger entities.Logger) error {
+	return c.client.Interrupt(ctx, sessionLogger)
+}
+
+func (c *slowCallLoggingClient) timeCall(ctx context.Context, sessionLogger entities.Logger, callType string, snippet string, call func() error) error {
+	queuedAt := time.Now()
+
+	// The wait for the calls ahead in the queue is a span of its own, so that traces tell it apart from the call.
+	_, queueSpan := tracing.Start(ctx, "matlab queue", tracing.SpanKindInternal)
+	select {
+	case c.queue <- struct{}{}:
+		queueSpan.End()
+	case <-ctx.Done():
+		queueSpan.RecordError(ctx.Err())
+		queueSpan.End()
+		return ctx.Err()
+	}
+	defer func() { <-c.queue }()
+
+	startedAt := time.Now()
+	err := call()
+	finishedAt := time.Now()
+
+	duration := finishedAt.Sub(queuedAt)
+	threshold := c.threshold()
+	if threshold == 0 || duration < threshold {
+		return err
+	}
+
+	logger := sessionLogger.
+		With("matlab-call", callType).
+		With("snippet-hash", hashSnippet(snippet)).
+		With("duration-ms", duration.Milliseconds()).
+		With("queue-wait-ms", startedAt.Sub(queuedAt).Milliseconds()).
+		With("exec-ms", finishedAt.Sub(startedAt).Milliseconds()).
+		With("threshold-ms", threshold.Milliseconds())
+	if err != nil {
+		logger = logger.WithError(err)
+	}
+	logger.Warn("Slow MATLAB call")
+
+	return err
+}
+
+func hashSnippet(snippet string) string {
+	sum := sha256.Sum256([]byte(snippet))
+	return hex.EncodeToString(sum[:])[:snippetHashLength]
+}
@@ -0,0 +1,263 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsessionclient_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlowCallLoggingClient_Eval_FastCallIsNotLogged(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	request := entities.EvalRequest{Code: "x = 1;"}
+	expectedResponse := entities.EvalResponse{ConsoleOutput: "x = 1"}
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), request).
+		Return(expectedResponse, nil).
+		Once()
+
+	client := matlabsessionclient.NewSlowCallLoggingClient(mockClient, time.Hour)
+
+	// Act
+	response, err := client.Eval(ctx, mockLogger, request)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedResponse, response)
+	assert.Empty(t, mockLogger.WarnLogs())
+}
+
+func TestSlowCallLoggingClient_Eval_ZeroThresholdIsNotLogged(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	request := entities.EvalRequest{Code: "pause(1)"}
+	expectedResponse := entities.EvalResponse{ConsoleOutput: ""}
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), request).
+		Run(func(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) {
+			time.Sleep(5 * time.Millisecond)
+		}).
+		Return(expectedResponse, nil).
+		Once()
+
+	client := matlabsessionclient.NewSlowCallLoggingClient(mockClient, 0)
+
+	// Act
+	response, err := client.Eval(ctx, mockLogger, request)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedResponse, response)
+	assert.Empty(t, mockLogger.WarnLogs(), "A threshold of 0 should disable the warnings")
+}
+
+func TestSlowCallLoggingClient_Eval_SlowCallIsLogged(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	request := entities.EvalRequest{Code: "pause(1)"}
+	expectedResponse := entities.EvalResponse{ConsoleOutput: ""}
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), request).
+		Run(func(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) {
+			time.Sleep(5 * time.Millisecond)
+		}).
+		Return(expectedResponse, nil).
+		Once()
+
+	client := matlabsessionclient.NewSlowCallLoggingClient(mockClient, time.Millisecond)
+
+	// Act
+	response, err := client.Eval(ctx, mockLogger, request)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedResponse, response)
+
+	warnLogs := mockLogger.WarnLogs()
+	fields, found := warnLogs["Slow MATLAB call"]
+	require.True(t, found, "Expected slow call warning not found")
+	assert.Equal(t, "eval", fields["matlab-call"])
+	assert.Len(t, fields["snippet-hash"], 12)
+	assert.GreaterOrEqual(t, fields["exec-ms"], int64(5))
+	assert.Contains(t, fields, "queue-wait-ms")
+	assert.Contains(t, fields, "duration-ms")
+	assert.Equal(t, int64(1), fields["threshold-ms"])
+}
+
+func TestSlowCallLoggingClient_EvalWithCapture_SlowCallIsLoggedWithError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	request := entities.EvalRequest{Code: "error('boom')"}
+
+	mockClient.EXPECT().
+		EvalWithCapture(ctx, mockLogger.AsMockArg(), request).
+		Run(func(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) {
+			time.Sleep(5 * time.Millisecond)
+		}).
+		Return(entities.EvalResponse{}, assert.AnError).
+		Once()
+
+	client := matlabsessionclient.NewSlowCallLoggingClient(mockClient, time.Millisecond)
+
+	// Act
+	response, err := client.EvalWithCapture(ctx, mockLogger, request)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, response)
+
+	fields, found := mockLogger.WarnLogs()["Slow MATLAB call"]
+	require.True(t, found, "Expected slow call warning not found")
+	assert.Equal(t, "eval-with-capture", fields["matlab-call"])
+	assert.Equal(t, assert.AnError, fields["error"])
+}
+
+func TestSlowCallLoggingClient_FEval_SameSnippetHasSameHash(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	request := entities.FEvalRequest{Function: "disp", Arguments: []string{"hello"}, NumOutputs: 0}
+	expectedResponse := entities.FEvalResponse{Outputs: []any{}}
+
+	mockClient.EXPECT().
+		FEval(ctx, mock.Anything, request).
+		Run(func(ctx context.Context, sessionLogger entities.Logger, request entities.FEvalRequest) {
+			time.Sleep(2 * time.Millisecond)
+		}).
+		Return(expectedResponse, nil).
+		Twice()
+
+	client := matlabsessionclient.NewSlowCallLoggingClient(mockClient, time.Millisecond)
+
+	firstLogger := testutils.NewInspectableLogger()
+	secondLogger := testutils.NewInspectableLogger()
+
+	// Act
+	_, err := client.FEval(ctx, firstLogger, request)
+	require.NoError(t, err)
+	_, err = client.FEval(ctx, secondLogger, request)
+	require.NoError(t, err)
+
+	// Assert
+	firstFields, found := firstLogger.WarnLogs()["Slow MATLAB call"]
+	require.True(t, found)
+	secondFields, found := secondLogger.WarnLogs()["Slow MATLAB call"]
+	require.True(t, found)
+	assert.Equal(t, firstFields["snippet-hash"], secondFields["snippet-hash"])
+	assert.Empty(t, mockLogger.WarnLogs())
+}
+
+func TestSlowCallLoggingClient_Eval_ContextCancelledWhileQueued(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	request := entities.EvalRequest{Code: "pause(10)"}
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), request).
+		Run(func(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) {
+			close(started)
+			<-release
+		}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	client := matlabsessionclient.NewSlowCallLoggingClient(mockClient, time.Hour)
+
+	go func() {
+		_, _ = client.Eval(t.Context(), mockLogger, request)
+	}()
+	<-started
+	defer close(release)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	// Act
+	_, err := client.Eval(ctx, mockLogger, request)
+
+	// Assert
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestSlowCallLoggingClient_Interrupt_IsNotQueued(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	request := entities.EvalRequest{Code: "pause(10)"}
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), request).
+		Run(func(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) {
+			close(started)
+			<-release
+		}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Interrupt(mock.Anything, mockLogger.AsMockArg()).
+		Return(nil).
+		Once()
+
+	client := matlabsessionclient.NewSlowCallLoggingClient(mockClient, time.Hour)
+
+	go func() {
+		_, _ = client.Eval(t.Context(), mockLogger, request)
+	}()
+	<-started
+	defer close(release)
+
+	// Act
+	err := client.Interrupt(t.Context(), mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+}
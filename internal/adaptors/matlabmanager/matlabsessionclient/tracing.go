@@ -0,0 +1,66 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsessionclient
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/tracing"
+)
+
+// tracingClient records a span for every request sent to the MATLAB session, which is the time spent in MATLAB.
+// The code is not recorded, only its size, as spans are sent to a collector outside of the server.
+type tracingClient struct {
+	client entities.MATLABSessionClient
+}
+
+func newTracingClient(client entities.MATLABSessionClient) *tracingClient {
+	return &tracingClient{
+		client: client,
+	}
+}
+
+func (c *tracingClient) Eval(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) (entities.EvalResponse, error) {
+	ctx, span := tracing.Start(ctx, "matlab eval", tracing.SpanKindClient, tracing.Int("matlab.code.bytes", len(request.Code)))
+	defer span.End()
+
+	response, err := c.client.Eval(ctx, sessionLogger, request)
+	endEvalSpan(span, response, err)
+	return response, err
+}
+
+func (c *tracingClient) EvalWithCapture(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) (entities.EvalResponse, error) {
+	ctx, span := tracing.Start(ctx, "matlab eval_with_capture", tracing.SpanKindClient, tracing.Int("matlab.code.bytes", len(request.Code)))
+	defer span.End()
+
+	response, err := c.client.EvalWithCapture(ctx, sessionLogger, request)
+	endEvalSpan(span, response, err)
+	return response, err
+}
+
+func (c *tracingClient) FEval(ctx context.Context, sessionLogger entities.Logger, request entities.FEvalRequest) (entities.FEvalResponse, error) {
+	ctx, span := tracing.Start(ctx, "matlab feval", tracing.SpanKindClient, tracing.String("matlab.function", request.Function))
+	defer span.End()
+
+	response, err := c.client.FEval(ctx, sessionLogger, request)
+	span.RecordError(err)
+	return response, err
+}
+
+func (c *tracingClient) Interrupt(ctx context.Context, sessionLogger entities.Logger) error {
+	ctx, span := tracing.Start(ctx, "matlab interrupt", tracing.SpanKindClient)
+	defer span.End()
+
+	err := c.client.Interrupt(ctx, sessionLogger)
+	span.RecordError(err)
+	return err
+}
+
+func endEvalSpan(span *tracing.Span, response entities.EvalResponse, err error) {
+	span.SetAttributes(
+		tracing.Int("matlab.output.bytes", len(response.ConsoleOutput)),
+		tracing.Int("matlab.figures", len(response.Images)),
+	)
+	span.RecordError(err)
+}
@@ -0,0 +1,140 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsessionclient_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/tracing"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	tracingmocks "github.com/matlab/matlab-mcp-core-server/mocks/utils/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newTracedContext returns the context of a traced tool call, its span, and the spans exported.
+func newTracedContext(t *testing.T) (context.Context, *tracing.Span, *[]*tracing.Span) {
+	t.Helper()
+
+	mockExporter := &tracingmocks.MockExporter{}
+	t.Cleanup(func() { mockExporter.AssertExpectations(t) })
+
+	mockExporter.EXPECT().
+		Enabled().
+		Return(true).
+		Once()
+
+	var exported []*tracing.Span
+	mockExporter.EXPECT().
+		ExportSpan(mock.Anything).
+		Run(func(span *tracing.Span) {
+			exported = append(exported, span)
+		}).
+		Return().
+		Maybe()
+
+	ctx, span := tracing.NewTracer(mockExporter).Start(t.Context(), "tools/call evaluate_matlab_code", tracing.SpanKindServer)
+	return ctx, span, &exported
+}
+
+// inSpan matches the contexts whose span is a child of parent.
+func inSpan(parent *tracing.Span) any {
+	return mock.MatchedBy(func(ctx context.Context) bool {
+		span := tracing.SpanFromContext(ctx)
+		return span != nil && span.ParentSpanID == parent.SpanID
+	})
+}
+
+func TestTracingClient_EvalWithCapture_RecordsSpan(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx, toolCallSpan, exported := newTracedContext(t)
+	request := entities.EvalRequest{Code: "x = 1;"}
+	expectedResponse := entities.EvalResponse{ConsoleOutput: "x = 1", Images: [][]byte{[]byte("png")}}
+
+	mockClient.EXPECT().
+		EvalWithCapture(inSpan(toolCallSpan), mockLogger.AsMockArg(), request).
+		Return(expectedResponse, nil).
+		Once()
+
+	client := matlabsessionclient.NewTracingClient(mockClient)
+
+	// Act
+	response, err := client.EvalWithCapture(ctx, mockLogger, request)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedResponse, response)
+	require.Len(t, *exported, 1)
+
+	span := (*exported)[0]
+	assert.Equal(t, "matlab eval_with_capture", span.Name)
+	assert.Equal(t, tracing.SpanKindClient, span.Kind)
+	assert.Equal(t, toolCallSpan.SpanID, span.ParentSpanID)
+	assert.Equal(t, []tracing.Attribute{
+		tracing.Int("matlab.code.bytes", 6),
+		tracing.Int("matlab.output.bytes", 5),
+		tracing.Int("matlab.figures", 1),
+	}, span.Attributes)
+	assert.False(t, span.Failed)
+}
+
+func TestTracingClient_FEval_RecordsError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx, toolCallSpan, exported := newTracedContext(t)
+	request := entities.FEvalRequest{Function: "setenv", Arguments: []string{"MATLAB_MCP_USER", "alice"}}
+
+	mockClient.EXPECT().
+		FEval(inSpan(toolCallSpan), mockLogger.AsMockArg(), request).
+		Return(entities.FEvalResponse{}, assert.AnError).
+		Once()
+
+	client := matlabsessionclient.NewTracingClient(mockClient)
+
+	// Act
+	_, err := client.FEval(ctx, mockLogger, request)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	require.Len(t, *exported, 1)
+	assert.Equal(t, "matlab feval", (*exported)[0].Name)
+	assert.Contains(t, (*exported)[0].Attributes, tracing.String("matlab.function", "setenv"))
+	assert.True(t, (*exported)[0].Failed)
+}
+
+func TestTracingClient_Eval_NotTraced(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	request := entities.EvalRequest{Code: "x = 1;"}
+
+	mockClient.EXPECT().
+		Eval(t.Context(), mockLogger.AsMockArg(), request).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	client := matlabsessionclient.NewTracingClient(mockClient)
+
+	// Act
+	_, err := client.Eval(t.Context(), mockLogger, request)
+
+	// Assert
+	require.NoError(t, err)
+}
@@ -3,8 +3,10 @@
 package matlabsessionstore
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"slices"
 	"sync"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
@@ -25,9 +27,11 @@ type LifecycleSignaler interface {
 }
 
 type Store struct {
-	l       *sync.RWMutex
-	next    entities.SessionID
-	clients map[entities.SessionID]MATLABSessionClientWithCleanup
+	l        *sync.RWMutex
+	next     entities.SessionID
+	clients  map[entities.SessionID]MATLABSessionClientWithCleanup
+	sessions map[entities.SessionID]entities.SessionInfo
+	names    map[string]entities.SessionID
 }
 
 func New(
@@ -35,9 +39,11 @@ func New(
 	lifecycleSignaler LifecycleSignaler,
 ) *Store {
 	store := &Store{
-		l:       new(sync.RWMutex),
-		next:    1,
-		clients: map[entities.SessionID]MATLABSessionClientWithCleanup{},
+		l:        new(sync.RWMutex),
+		next:     1,
+		clients:  map[entities.SessionID]MATLABSessionClientWithCleanup{},
+		sessions: map[entities.SessionID]entities.SessionInfo{},
+		names:    map[string]entities.SessionID{},
 	}
 
 	lifecycleSignaler.AddShutdownFunction(func() error {
@@ -63,14 +69,59 @@ func New(
 	return store
 }
 
-func (s *Store) Add(client MATLABSessionClientWithCleanup) entities.SessionID {
+// Add stores the client of a new session, and returns the ID of the session. The name is optional, but two sessions
+// cannot have the same name.
+func (s *Store) Add(name string, matlabRoot string, client MATLABSessionClientWithCleanup) (entities.SessionID, error) {
 	s.l.Lock()
 	defer s.l.Unlock()
 
+	if name != "" {
+		if _, exists := s.names[name]; exists {
+			return 0, entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("a MATLAB session named %q is already running", name))
+		}
+	}
+
 	sessionID := s.next
 	s.clients[sessionID] = client
+	s.sessions[sessionID] = entities.SessionInfo{
+		ID:         sessionID,
+		Name:       name,
+		MATLABRoot: matlabRoot,
+	}
+	if name != "" {
+		s.names[name] = sessionID
+	}
 	s.next++
-	return entities.SessionID(sessionID)
+	return entities.SessionID(sessionID), nil
+}
+
+// Find returns the ID of the session with the given name.
+func (s *Store) Find(name string) (entities.SessionID, error) {
+	s.l.RLock()
+	defer s.l.RUnlock()
+
+	sessionID, exists := s.names[name]
+	if !exists {
+		return 0, entities.NewCodedError(entities.ErrorCodeSessionNotFound, fmt.Errorf("session not found: %q", name))
+	}
+
+	return sessionID, nil
+}
+
+// List returns the running sessions, ordered by ID.
+func (s *Store) List() []entities.SessionInfo {
+	s.l.RLock()
+	defer s.l.RUnlock()
+
+	sessions := make([]entities.SessionInfo, 0, len(s.sessions))
+	for _, session := range s.sessions {
+		sessions = append(sessions, session)
+	}
+	slices.SortFunc(sessions, func(a, b entities.SessionInfo) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return sessions
 }
 
 func (s *Store) Get(sessionID entities.SessionID) (MATLABSessionClientWithCleanup, error) {
@@ -79,7 +130,7 @@ func (s *Store) Get(sessionID entities.SessionID) (MATLABSessionClientWithCleanu
 
 	client, exists := s.clients[sessionID]
 	if !exists {
-		return nil, fmt.Errorf("session not found: %v", sessionID)
+		return nil, entities.NewCodedError(entities.ErrorCodeSessionNotFound, fmt.Errorf("session not found: %v", sessionID))
 	}
 
 	return client, nil
@@ -89,5 +140,9 @@ func (s *Store) Remove(sessionID entities.SessionID) {
 	s.l.Lock()
 	defer s.l.Unlock()
 
+	if session, exists := s.sessions[sessionID]; exists && session.Name != "" {
+		delete(s.names, session.Name)
+	}
 	delete(s.clients, sessionID)
+	delete(s.sessions, sessionID)
 }
@@ -14,6 +14,14 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func mustAdd(t *testing.T, store *matlabsessionstore.Store, client matlabsessionstore.MATLABSessionClientWithCleanup) entities.SessionID {
+	t.Helper()
+
+	sessionID, err := store.Add("", "", client)
+	require.NoError(t, err)
+	return sessionID
+}
+
 func TestNew_HappyPath(t *testing.T) {
 	// Arrange
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
@@ -81,8 +89,8 @@ func TestNew_ShutdownFunctionCallsStopSessionOnAllClients(t *testing.T) {
 	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
 	require.NotNil(t, capturedShutdownFunc)
 
-	store.Add(mockClient1)
-	store.Add(mockClient2)
+	mustAdd(t, store, mockClient1)
+	mustAdd(t, store, mockClient2)
 
 	// Act
 	err := capturedShutdownFunc()
@@ -171,8 +179,8 @@ func TestNew_ShutdownFunctionReturnsErrorWhenStopSessionFails(t *testing.T) {
 	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
 	require.NotNil(t, capturedShutdownFunc)
 
-	store.Add(mockClient1)
-	store.Add(mockClient2)
+	mustAdd(t, store, mockClient1)
+	mustAdd(t, store, mockClient2)
 
 	// Act
 	err := capturedShutdownFunc()
@@ -200,9 +208,10 @@ func TestStore_Add_HappyPath(t *testing.T) {
 	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
 
 	// Act
-	sessionID := store.Add(mockClient)
+	sessionID, err := store.Add("", "/path/to/matlab", mockClient)
 
 	// Assert
+	require.NoError(t, err)
 	assert.Equal(t, entities.SessionID(1), sessionID)
 }
 
@@ -231,11 +240,14 @@ func TestStore_Add_MultipleClients_ReturnsIncrementingIDs(t *testing.T) {
 	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
 
 	// Act
-	sessionID1 := store.Add(mockClient1)
-	sessionID2 := store.Add(mockClient2)
-	sessionID3 := store.Add(mockClient3)
+	sessionID1, err1 := store.Add("", "", mockClient1)
+	sessionID2, err2 := store.Add("", "", mockClient2)
+	sessionID3, err3 := store.Add("", "", mockClient3)
 
 	// Assert
+	require.NoError(t, err1)
+	require.NoError(t, err2)
+	require.NoError(t, err3)
 	assert.Equal(t, entities.SessionID(1), sessionID1)
 	assert.Equal(t, entities.SessionID(2), sessionID2)
 	assert.Equal(t, entities.SessionID(3), sessionID3)
@@ -258,7 +270,7 @@ func TestStore_Get_HappyPath(t *testing.T) {
 		Once()
 
 	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
-	sessionID := store.Add(mockClient)
+	sessionID := mustAdd(t, store, mockClient)
 
 	// Act
 	retrievedClient, err := store.Get(sessionID)
@@ -292,6 +304,7 @@ func TestStore_Get_NonExistentSession_ReturnsError(t *testing.T) {
 	assert.Nil(t, retrievedClient)
 	assert.Contains(t, err.Error(), "session not found")
 	assert.Contains(t, err.Error(), "999")
+	assert.Equal(t, entities.ErrorCodeSessionNotFound, entities.ErrorCodeOf(err))
 }
 
 func TestStore_Remove_HappyPath(t *testing.T) {
@@ -311,7 +324,7 @@ func TestStore_Remove_HappyPath(t *testing.T) {
 		Once()
 
 	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
-	sessionID := store.Add(mockClient)
+	sessionID := mustAdd(t, store, mockClient)
 
 	// Verify client exists before removal
 	retrievedClient, err := store.Get(sessionID)
@@ -373,9 +386,9 @@ func TestStore_AddGetRemove_MultipleClients(t *testing.T) {
 	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
 
 	// Act - Add multiple clients
-	sessionID1 := store.Add(mockClient1)
-	sessionID2 := store.Add(mockClient2)
-	sessionID3 := store.Add(mockClient3)
+	sessionID1 := mustAdd(t, store, mockClient1)
+	sessionID2 := mustAdd(t, store, mockClient2)
+	sessionID3 := mustAdd(t, store, mockClient3)
 
 	// Assert - All clients can be retrieved
 	retrievedClient1, err := store.Get(sessionID1)
@@ -406,3 +419,146 @@ func TestStore_AddGetRemove_MultipleClients(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, mockClient3, retrievedClient3)
 }
+
+func TestStore_Add_DuplicateName_ReturnsError(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient1 := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient1.AssertExpectations(t)
+
+	mockClient2 := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient2.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	_, err := store.Add("analysis", "/path/to/matlab", mockClient1)
+	require.NoError(t, err)
+
+	// Act
+	sessionID, err := store.Add("analysis", "/path/to/matlab", mockClient2)
+
+	// Assert
+	require.Error(t, err)
+	assert.Empty(t, sessionID)
+	assert.Equal(t, entities.ErrorCodeInvalidInput, entities.ErrorCodeOf(err))
+	assert.Len(t, store.List(), 1)
+}
+
+func TestStore_Find_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient1 := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient1.AssertExpectations(t)
+
+	mockClient2 := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient2.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	mustAdd(t, store, mockClient1)
+	expectedSessionID, err := store.Add("analysis", "/path/to/matlab", mockClient2)
+	require.NoError(t, err)
+
+	// Act
+	sessionID, err := store.Find("analysis")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedSessionID, sessionID)
+}
+
+func TestStore_Find_RemovedSession_ReturnsError(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	sessionID, err := store.Add("analysis", "/path/to/matlab", mockClient)
+	require.NoError(t, err)
+	store.Remove(sessionID)
+
+	// Act
+	_, err = store.Find("analysis")
+
+	// Assert
+	require.Error(t, err)
+	assert.Equal(t, entities.ErrorCodeSessionNotFound, entities.ErrorCodeOf(err))
+
+	_, err = store.Add("analysis", "/path/to/matlab", mockClient)
+	require.NoError(t, err, "The name of a removed session should be free again")
+}
+
+func TestStore_List_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient1 := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient1.AssertExpectations(t)
+
+	mockClient2 := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient2.AssertExpectations(t)
+
+	mockClient3 := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient3.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	for _, session := range []struct {
+		name   string
+		client *mocks.MockMATLABSessionClientWithCleanup
+	}{
+		{"analysis", mockClient1},
+		{"", mockClient2},
+		{"plots", mockClient3},
+	} {
+		_, err := store.Add(session.name, "/path/to/matlab", session.client)
+		require.NoError(t, err)
+	}
+	store.Remove(entities.SessionID(2))
+
+	// Act
+	sessions := store.List()
+
+	// Assert
+	assert.Equal(t, []entities.SessionInfo{
+		{ID: 1, Name: "analysis", MATLABRoot: "/path/to/matlab"},
+		{ID: 3, Name: "plots", MATLABRoot: "/path/to/matlab"},
+	}, sessions)
+}
@@ -0,0 +1,36 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabmanager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// sharedMATLABSessionClient is the client of a MATLAB shared by the user. Stopping its session disconnects from MATLAB,
+// and leaves it running, with its workspace, for the user.
+type sharedMATLABSessionClient struct {
+	entities.MATLABSessionClient
+	sessionDir     string
+	sessionCleanup func() error
+}
+
+func newSharedMATLABSessionClient(matlabSessionClient entities.MATLABSessionClient, sessionDir string, sessionCleanup func() error) *sharedMATLABSessionClient {
+	return &sharedMATLABSessionClient{
+		MATLABSessionClient: matlabSessionClient,
+		sessionDir:          sessionDir,
+		sessionCleanup:      sessionCleanup,
+	}
+}
+
+func (c *sharedMATLABSessionClient) StopSession(ctx context.Context, sessionLogger entities.Logger) error {
+	// The user may have closed MATLAB already, in which case there is nothing left to remove from its path.
+	if _, err := c.Eval(ctx, sessionLogger, entities.EvalRequest{Code: fmt.Sprintf("rmpath('%s')", strings.ReplaceAll(c.sessionDir, "'", "''"))}); err != nil {
+		sessionLogger.WithError(err).Warn("Failed to remove the MATLAB files of the server from the path of the shared MATLAB session")
+	}
+
+	return c.sessionCleanup()
+}
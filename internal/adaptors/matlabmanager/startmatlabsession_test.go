@@ -30,6 +30,18 @@ func TestMATLABManager_StartMATLABSession_HappyPath(t *testing.T) {
 	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
 	defer mockClientFactory.AssertExpectations(t)
 
+	mockCertificateTrust := &mocks.MockCertificateTrust{}
+	defer mockCertificateTrust.AssertExpectations(t)
+
+	mockUsageRecorder := &mocks.MockUsageRecorder{}
+	defer mockUsageRecorder.AssertExpectations(t)
+
+	mockMATLABRecorder := &mocks.MockMATLABRecorder{}
+	defer mockMATLABRecorder.AssertExpectations(t)
+
+	mockMATLABPlayer := &mocks.MockMATLABPlayer{}
+	defer mockMATLABPlayer.AssertExpectations(t)
+
 	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 
 	matlabRoot := "/path/to/matlab/R2023a"
@@ -47,17 +59,37 @@ func TestMATLABManager_StartMATLABSession_HappyPath(t *testing.T) {
 		Return(connectionDetails, sessionCleanupFunc, nil).
 		Once()
 
+	mockCertificateTrust.EXPECT().
+		Trust(t.Context(), mock.Anything, connectionDetails.CertificatePEM).
+		Return(nil).
+		Once()
+
 	mockClientFactory.EXPECT().
 		New(connectionDetails).
 		Return(mockSessionClient, nil).
 		Once()
 
+	mockMATLABRecorder.EXPECT().
+		Record(mockSessionClient).
+		Return(mockSessionClient).
+		Once()
+
+	mockUsageRecorder.EXPECT().
+		RecordMATLABSessionStarted(matlabRoot).
+		Return().
+		Once()
+
 	mockSessionStore.EXPECT().
-		Add(mock.AnythingOfType("*matlabmanager.matlabSessionClientWithCleanup")).
-		Return(expectedSessionID).
+		Add("", matlabRoot, mock.AnythingOfType("*matlabmanager.matlabSessionClientWithCleanup")).
+		Return(expectedSessionID, nil).
 		Once()
 
-	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory)
+	mockMATLABPlayer.EXPECT().
+		Enabled().
+		Return(false).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockCertificateTrust, mockUsageRecorder, mockMATLABRecorder, mockMATLABPlayer)
 	ctx := t.Context()
 
 	startRequest := entities.LocalSessionDetails{
@@ -85,6 +117,18 @@ func TestMATLABManager_StartMATLABSession_MATLABServicesError(t *testing.T) {
 	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
 	defer mockClientFactory.AssertExpectations(t)
 
+	mockCertificateTrust := &mocks.MockCertificateTrust{}
+	defer mockCertificateTrust.AssertExpectations(t)
+
+	mockUsageRecorder := &mocks.MockUsageRecorder{}
+	defer mockUsageRecorder.AssertExpectations(t)
+
+	mockMATLABRecorder := &mocks.MockMATLABRecorder{}
+	defer mockMATLABRecorder.AssertExpectations(t)
+
+	mockMATLABPlayer := &mocks.MockMATLABPlayer{}
+	defer mockMATLABPlayer.AssertExpectations(t)
+
 	matlabRoot := "/path/to/matlab/R2023a"
 	expectedError := assert.AnError
 
@@ -93,7 +137,12 @@ func TestMATLABManager_StartMATLABSession_MATLABServicesError(t *testing.T) {
 		Return(embeddedconnector.ConnectionDetails{}, nil, expectedError).
 		Once()
 
-	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory)
+	mockMATLABPlayer.EXPECT().
+		Enabled().
+		Return(false).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockCertificateTrust, mockUsageRecorder, mockMATLABRecorder, mockMATLABPlayer)
 	ctx := t.Context()
 
 	startRequest := entities.LocalSessionDetails{
@@ -121,6 +170,18 @@ func TestMATLABManager_StartMATLABSession_ClientFactoryError(t *testing.T) {
 	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
 	defer mockClientFactory.AssertExpectations(t)
 
+	mockCertificateTrust := &mocks.MockCertificateTrust{}
+	defer mockCertificateTrust.AssertExpectations(t)
+
+	mockUsageRecorder := &mocks.MockUsageRecorder{}
+	defer mockUsageRecorder.AssertExpectations(t)
+
+	mockMATLABRecorder := &mocks.MockMATLABRecorder{}
+	defer mockMATLABRecorder.AssertExpectations(t)
+
+	mockMATLABPlayer := &mocks.MockMATLABPlayer{}
+	defer mockMATLABPlayer.AssertExpectations(t)
+
 	matlabRoot := "/path/to/matlab/R2023a"
 	connectionDetails := embeddedconnector.ConnectionDetails{
 		Host: "localhost",
@@ -134,12 +195,90 @@ func TestMATLABManager_StartMATLABSession_ClientFactoryError(t *testing.T) {
 		Return(connectionDetails, sessionCleanupFunc, nil).
 		Once()
 
+	mockCertificateTrust.EXPECT().
+		Trust(t.Context(), mock.Anything, connectionDetails.CertificatePEM).
+		Return(nil).
+		Once()
+
 	mockClientFactory.EXPECT().
 		New(connectionDetails).
 		Return(nil, expectedError).
 		Once()
 
-	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory)
+	mockMATLABPlayer.EXPECT().
+		Enabled().
+		Return(false).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockCertificateTrust, mockUsageRecorder, mockMATLABRecorder, mockMATLABPlayer)
+	ctx := t.Context()
+
+	startRequest := entities.LocalSessionDetails{
+		MATLABRoot: matlabRoot,
+	}
+
+	// Act
+	sessionID, err := manager.StartMATLABSession(ctx, mockLogger, startRequest)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, sessionID)
+}
+
+func TestMATLABManager_StartMATLABSession_CertificateNotTrusted(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockCertificateTrust := &mocks.MockCertificateTrust{}
+	defer mockCertificateTrust.AssertExpectations(t)
+
+	mockUsageRecorder := &mocks.MockUsageRecorder{}
+	defer mockUsageRecorder.AssertExpectations(t)
+
+	mockMATLABRecorder := &mocks.MockMATLABRecorder{}
+	defer mockMATLABRecorder.AssertExpectations(t)
+
+	mockMATLABPlayer := &mocks.MockMATLABPlayer{}
+	defer mockMATLABPlayer.AssertExpectations(t)
+
+	matlabRoot := "/path/to/matlab/R2023a"
+	connectionDetails := embeddedconnector.ConnectionDetails{
+		Host:           "localhost",
+		Port:           "12345",
+		CertificatePEM: []byte("certificate"),
+	}
+	sessionCleanedUp := false
+	sessionCleanupFunc := func() error {
+		sessionCleanedUp = true
+		return nil
+	}
+	expectedError := assert.AnError
+
+	mockMATLABServices.EXPECT().
+		StartLocalMATLABSession(mock.Anything, datatypes.LocalSessionDetails{MATLABRoot: matlabRoot}).
+		Return(connectionDetails, sessionCleanupFunc, nil).
+		Once()
+
+	mockCertificateTrust.EXPECT().
+		Trust(t.Context(), mock.Anything, connectionDetails.CertificatePEM).
+		Return(expectedError).
+		Once()
+
+	mockMATLABPlayer.EXPECT().
+		Enabled().
+		Return(false).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockCertificateTrust, mockUsageRecorder, mockMATLABRecorder, mockMATLABPlayer)
 	ctx := t.Context()
 
 	startRequest := entities.LocalSessionDetails{
@@ -152,4 +291,233 @@ func TestMATLABManager_StartMATLABSession_ClientFactoryError(t *testing.T) {
 	// Assert
 	require.ErrorIs(t, err, expectedError)
 	assert.Empty(t, sessionID)
+	assert.True(t, sessionCleanedUp, "Untrusted session should be cleaned up")
+}
+
+func TestMATLABManager_StartMATLABSession_ReplayedFromFixture(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockCertificateTrust := &mocks.MockCertificateTrust{}
+	defer mockCertificateTrust.AssertExpectations(t)
+
+	mockUsageRecorder := &mocks.MockUsageRecorder{}
+	defer mockUsageRecorder.AssertExpectations(t)
+
+	mockMATLABRecorder := &mocks.MockMATLABRecorder{}
+	defer mockMATLABRecorder.AssertExpectations(t)
+
+	mockMATLABPlayer := &mocks.MockMATLABPlayer{}
+	defer mockMATLABPlayer.AssertExpectations(t)
+
+	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	expectedSessionID := entities.SessionID(123)
+
+	mockMATLABPlayer.EXPECT().
+		Enabled().
+		Return(true).
+		Once()
+
+	mockMATLABPlayer.EXPECT().
+		NewClient().
+		Return(mockSessionClient).
+		Once()
+
+	mockSessionStore.EXPECT().
+		Add("", "/path/to/matlab/R2023a", mock.AnythingOfType("*matlabmanager.matlabSessionClientWithCleanup")).
+		Return(expectedSessionID, nil).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockCertificateTrust, mockUsageRecorder, mockMATLABRecorder, mockMATLABPlayer)
+	ctx := t.Context()
+
+	startRequest := entities.LocalSessionDetails{
+		MATLABRoot: "/path/to/matlab/R2023a",
+	}
+
+	// Act
+	sessionID, err := manager.StartMATLABSession(ctx, mockLogger, startRequest)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedSessionID, sessionID)
+}
+
+func TestMATLABManager_StartMATLABSession_Named(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockCertificateTrust := &mocks.MockCertificateTrust{}
+	defer mockCertificateTrust.AssertExpectations(t)
+
+	mockUsageRecorder := &mocks.MockUsageRecorder{}
+	defer mockUsageRecorder.AssertExpectations(t)
+
+	mockMATLABRecorder := &mocks.MockMATLABRecorder{}
+	defer mockMATLABRecorder.AssertExpectations(t)
+
+	mockMATLABPlayer := &mocks.MockMATLABPlayer{}
+	defer mockMATLABPlayer.AssertExpectations(t)
+
+	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	matlabRoot := "/path/to/matlab/R2023a"
+	expectedSessionID := entities.SessionID(4)
+	connectionDetails := embeddedconnector.ConnectionDetails{
+		Host: "localhost",
+		Port: "1234",
+	}
+
+	mockSessionStore.EXPECT().
+		Find("analysis").
+		Return(0, entities.NewCodedError(entities.ErrorCodeSessionNotFound, assert.AnError)).
+		Once()
+
+	mockMATLABPlayer.EXPECT().
+		Enabled().
+		Return(false).
+		Once()
+
+	mockMATLABServices.EXPECT().
+		StartLocalMATLABSession(mock.Anything, datatypes.LocalSessionDetails{MATLABRoot: matlabRoot}).
+		Return(connectionDetails, func() error { return nil }, nil).
+		Once()
+
+	mockCertificateTrust.EXPECT().
+		Trust(t.Context(), mock.Anything, connectionDetails.CertificatePEM).
+		Return(nil).
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(connectionDetails).
+		Return(mockSessionClient, nil).
+		Once()
+
+	mockMATLABRecorder.EXPECT().
+		Record(mockSessionClient).
+		Return(mockSessionClient).
+		Once()
+
+	mockSessionStore.EXPECT().
+		Add("analysis", matlabRoot, mock.AnythingOfType("*matlabmanager.matlabSessionClientWithCleanup")).
+		Return(expectedSessionID, nil).
+		Once()
+
+	mockUsageRecorder.EXPECT().
+		RecordMATLABSessionStarted(matlabRoot).
+		Return().
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockCertificateTrust, mockUsageRecorder, mockMATLABRecorder, mockMATLABPlayer)
+
+	// Act
+	sessionID, err := manager.StartMATLABSession(t.Context(), mockLogger, entities.LocalSessionDetails{
+		Name:       "analysis",
+		MATLABRoot: matlabRoot,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedSessionID, sessionID)
+}
+
+func TestMATLABManager_StartMATLABSession_NameInUse(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockCertificateTrust := &mocks.MockCertificateTrust{}
+	defer mockCertificateTrust.AssertExpectations(t)
+
+	mockUsageRecorder := &mocks.MockUsageRecorder{}
+	defer mockUsageRecorder.AssertExpectations(t)
+
+	mockMATLABRecorder := &mocks.MockMATLABRecorder{}
+	defer mockMATLABRecorder.AssertExpectations(t)
+
+	mockMATLABPlayer := &mocks.MockMATLABPlayer{}
+	defer mockMATLABPlayer.AssertExpectations(t)
+
+	mockSessionStore.EXPECT().
+		Find("analysis").
+		Return(entities.SessionID(1), nil).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockCertificateTrust, mockUsageRecorder, mockMATLABRecorder, mockMATLABPlayer)
+
+	// Act
+	sessionID, err := manager.StartMATLABSession(t.Context(), mockLogger, entities.LocalSessionDetails{
+		Name:       "analysis",
+		MATLABRoot: "/path/to/matlab/R2023a",
+	})
+
+	// Assert
+	require.Error(t, err)
+	assert.Equal(t, entities.ErrorCodeInvalidInput, entities.ErrorCodeOf(err))
+	assert.Empty(t, sessionID)
+}
+
+func TestMATLABManager_StartMATLABSession_InvalidName(t *testing.T) {
+	testCases := []string{
+		"42",
+		"my session",
+		"_analysis",
+		"a/b",
+	}
+
+	for _, name := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockMATLABServices := &mocks.MockMATLABServices{}
+			defer mockMATLABServices.AssertExpectations(t)
+
+			mockSessionStore := &mocks.MockMATLABSessionStore{}
+			defer mockSessionStore.AssertExpectations(t)
+
+			mockMATLABPlayer := &mocks.MockMATLABPlayer{}
+			defer mockMATLABPlayer.AssertExpectations(t)
+
+			manager := matlabmanager.New(mockMATLABServices, mockSessionStore, &mocks.MockMATLABSessionClientFactory{}, &mocks.MockCertificateTrust{}, &mocks.MockUsageRecorder{}, &mocks.MockMATLABRecorder{}, mockMATLABPlayer)
+
+			// Act
+			sessionID, err := manager.StartMATLABSession(t.Context(), mockLogger, entities.LocalSessionDetails{
+				Name:       name,
+				MATLABRoot: "/path/to/matlab/R2023a",
+			})
+
+			// Assert
+			require.Error(t, err)
+			assert.Equal(t, entities.ErrorCodeInvalidInput, entities.ErrorCodeOf(err))
+			assert.Empty(t, sessionID)
+		})
+	}
 }
@@ -5,38 +5,101 @@ package matlabmanager
 import (
 	"context"
 	"fmt"
+	"regexp"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
-	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/tracing"
 )
 
-func (m *MATLABManager) StartMATLABSession(ctx context.Context, sessionLogger entities.Logger, startRequest entities.SessionDetails) (entities.SessionID, error) {
-	var zeroValue entities.SessionID
-	var client matlabsessionstore.MATLABSessionClientWithCleanup
+// sessionNamePattern is the pattern of session names. Names start with a letter, so that they are never taken for a
+// session ID.
+var sessionNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_.-]{0,63}$`)
+
+func (m *MATLABManager) StartMATLABSession(ctx context.Context, sessionLogger entities.Logger, startRequest entities.SessionDetails) (sessionID entities.SessionID, err error) {
+	ctx, span := tracing.Start(ctx, "matlab start_session", tracing.SpanKindInternal)
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+		}
+		span.End()
+	}()
 
 	switch request := startRequest.(type) {
 	case entities.LocalSessionDetails:
-		sessionLogger := sessionLogger.With("matlab-root", request.MATLABRoot)
-		// For now, we return embedded connector details, to decouple the session start logic from the client creation.
-		embeddedConnectorEndpoint, sessionCleanup, err := m.matlabServices.StartLocalMATLABSession(sessionLogger,
-			datatypes.LocalSessionDetails{
-				MATLABRoot:        request.MATLABRoot,
-				StartingDirectory: request.StartingDirectory,
-				ShowMATLABDesktop: request.ShowMATLABDesktop,
-			},
-		)
-		if err != nil {
-			return zeroValue, err
+		return m.startLocalMATLABSession(ctx, sessionLogger, request)
+	case entities.SharedSessionDetails:
+		return m.startSharedMATLABSession(ctx, sessionLogger, request)
+	default:
+		return sessionID, fmt.Errorf("unknown request type: %T", startRequest)
+	}
+}
+
+func (m *MATLABManager) startLocalMATLABSession(ctx context.Context, sessionLogger entities.Logger, request entities.LocalSessionDetails) (entities.SessionID, error) {
+	var zeroValue entities.SessionID
+
+	if err := m.checkSessionName(request.Name); err != nil {
+		return zeroValue, err
+	}
+
+	// A replayed session answers from the fixture, so no MATLAB is started.
+	if m.matlabPlayer.Enabled() {
+		sessionLogger.Debug("Replaying MATLAB session from fixture")
+		return m.sessionStore.Add(request.Name, request.MATLABRoot, newMATLABSessionClientWithCleanup(m.matlabPlayer.NewClient(), func() error { return nil }))
+	}
+
+	sessionLogger = sessionLogger.With("matlab-root", request.MATLABRoot)
+	// For now, we return embedded connector details, to decouple the session start logic from the client creation.
+	embeddedConnectorEndpoint, sessionCleanup, err := m.matlabServices.StartLocalMATLABSession(sessionLogger,
+		datatypes.LocalSessionDetails{
+			MATLABRoot:        request.MATLABRoot,
+			StartingDirectory: request.StartingDirectory,
+			ShowMATLABDesktop: request.ShowMATLABDesktop,
+		},
+	)
+	if err != nil {
+		return zeroValue, err
+	}
+	if err := m.certificateTrust.Trust(ctx, sessionLogger, embeddedConnectorEndpoint.CertificatePEM); err != nil {
+		if cleanupErr := sessionCleanup(); cleanupErr != nil {
+			sessionLogger.WithError(cleanupErr).Warn("Failed to clean up untrusted MATLAB session")
 		}
-		embeddedConnectorClient, err := m.clientFactory.New(embeddedConnectorEndpoint)
-		if err != nil {
-			return zeroValue, err
+		return zeroValue, err
+	}
+	embeddedConnectorClient, err := m.clientFactory.New(embeddedConnectorEndpoint)
+	if err != nil {
+		return zeroValue, err
+	}
+	client := newMATLABSessionClientWithCleanup(m.matlabRecorder.Record(embeddedConnectorClient), sessionCleanup)
+
+	sessionID, err := m.sessionStore.Add(request.Name, request.MATLABRoot, client)
+	if err != nil {
+		if stopErr := client.StopSession(ctx, sessionLogger); stopErr != nil {
+			sessionLogger.WithError(stopErr).Warn("Failed to stop MATLAB session with a name already in use")
 		}
-		client = newMATLABSessionClientWithCleanup(embeddedConnectorClient, sessionCleanup)
-	default:
-		return zeroValue, fmt.Errorf("unknown request type: %T", request)
+		return zeroValue, err
+	}
+
+	m.usageRecorder.RecordMATLABSessionStarted(request.MATLABRoot)
+
+	return sessionID, nil
+}
+
+// checkSessionName fails if name is not a valid session name, or if a running session already has it.
+func (m *MATLABManager) checkSessionName(name string) error {
+	if name == "" {
+		return nil
+	}
+
+	if !sessionNamePattern.MatchString(name) {
+		return entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("invalid session name %q: use up to 64 letters, digits, '_', '.' and '-', starting with a letter", name))
+	}
+
+	// Fail before starting MATLAB, which takes time. The store checks the name again, as another session with the
+	// same name could be started in the meantime.
+	if _, err := m.sessionStore.Find(name); err == nil {
+		return entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("a MATLAB session named %q is already running", name))
 	}
 
-	return m.sessionStore.Add(client), nil
+	return nil
 }
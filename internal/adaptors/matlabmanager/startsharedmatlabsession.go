@@ -0,0 +1,74 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabmanager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// startSharedMATLABSession connects to a MATLAB shared by the user, such as a MATLAB desktop, instead of starting one.
+// The MATLAB files of the server are added to the path of MATLAB for the time of the session.
+func (m *MATLABManager) startSharedMATLABSession(ctx context.Context, sessionLogger entities.Logger, request entities.SharedSessionDetails) (entities.SessionID, error) {
+	var zeroValue entities.SessionID
+
+	if err := m.checkSessionName(request.Name); err != nil {
+		return zeroValue, err
+	}
+
+	// A replayed session answers from the fixture, so no MATLAB is connected to.
+	if m.matlabPlayer.Enabled() {
+		sessionLogger.Debug("Replaying MATLAB session from fixture")
+		return m.sessionStore.Add(request.Name, "", newMATLABSessionClientWithCleanup(m.matlabPlayer.NewClient(), func() error { return nil }))
+	}
+
+	sessionLogger = sessionLogger.With("session-file", request.SessionFile)
+	session, embeddedConnectorEndpoint, sessionCleanup, err := m.matlabServices.ConnectToSharedMATLABSession(sessionLogger,
+		datatypes.SharedSessionDetails{
+			SessionFile: request.SessionFile,
+		},
+	)
+	if err != nil {
+		return zeroValue, err
+	}
+
+	cleanUp := func() {
+		if cleanupErr := sessionCleanup(); cleanupErr != nil {
+			sessionLogger.WithError(cleanupErr).Warn("Failed to clean up shared MATLAB session")
+		}
+	}
+
+	if err := m.certificateTrust.Trust(ctx, sessionLogger, embeddedConnectorEndpoint.CertificatePEM); err != nil {
+		cleanUp()
+		return zeroValue, err
+	}
+	embeddedConnectorClient, err := m.clientFactory.New(embeddedConnectorEndpoint)
+	if err != nil {
+		cleanUp()
+		return zeroValue, err
+	}
+
+	if _, err := embeddedConnectorClient.Eval(ctx, sessionLogger, entities.EvalRequest{Code: fmt.Sprintf("addpath('%s')", strings.ReplaceAll(session.SessionDir, "'", "''"))}); err != nil {
+		cleanUp()
+		return zeroValue, entities.NewCodedError(entities.ErrorCodeMATLABNotFound, fmt.Errorf("failed to connect to the MATLAB session shared in %s: %w", request.SessionFile, err))
+	}
+
+	client := newSharedMATLABSessionClient(m.matlabRecorder.Record(embeddedConnectorClient), session.SessionDir, sessionCleanup)
+
+	sessionID, err := m.sessionStore.Add(request.Name, session.MATLABRoot, client)
+	if err != nil {
+		if stopErr := client.StopSession(ctx, sessionLogger); stopErr != nil {
+			sessionLogger.WithError(stopErr).Warn("Failed to disconnect from MATLAB session with a name already in use")
+		}
+		return zeroValue, err
+	}
+
+	sessionLogger.With("matlab-root", session.MATLABRoot).Info("Connected to shared MATLAB session")
+	m.usageRecorder.RecordMATLABSessionStarted(session.MATLABRoot)
+
+	return sessionID, nil
+}
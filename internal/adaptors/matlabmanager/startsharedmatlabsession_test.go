@@ -0,0 +1,206 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabmanager_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMATLABManager_StartMATLABSession_Shared_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockCertificateTrust := &mocks.MockCertificateTrust{}
+	defer mockCertificateTrust.AssertExpectations(t)
+
+	mockUsageRecorder := &mocks.MockUsageRecorder{}
+	defer mockUsageRecorder.AssertExpectations(t)
+
+	mockMATLABRecorder := &mocks.MockMATLABRecorder{}
+	defer mockMATLABRecorder.AssertExpectations(t)
+
+	mockMATLABPlayer := &mocks.MockMATLABPlayer{}
+	defer mockMATLABPlayer.AssertExpectations(t)
+
+	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockSessionClient.AssertExpectations(t)
+
+	sessionFile := "/home/user/.matlab/matlab-mcp-session.json"
+	session := datatypes.SharedSession{
+		MATLABRoot: "/usr/local/MATLAB/R2025a",
+		SessionDir: "/tmp/matlab-session-o'brien",
+	}
+	expectedSessionID := entities.SessionID(123)
+
+	connectionDetails := embeddedconnector.ConnectionDetails{
+		Host: "localhost",
+		Port: "31515",
+	}
+
+	cleanedUp := false
+	sessionCleanupFunc := func() error {
+		cleanedUp = true
+		return nil
+	}
+
+	mockMATLABPlayer.EXPECT().
+		Enabled().
+		Return(false).
+		Once()
+
+	mockMATLABServices.EXPECT().
+		ConnectToSharedMATLABSession(mock.Anything, datatypes.SharedSessionDetails{SessionFile: sessionFile}).
+		Return(session, connectionDetails, sessionCleanupFunc, nil).
+		Once()
+
+	mockCertificateTrust.EXPECT().
+		Trust(t.Context(), mock.Anything, connectionDetails.CertificatePEM).
+		Return(nil).
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(connectionDetails).
+		Return(mockSessionClient, nil).
+		Once()
+
+	mockSessionClient.EXPECT().
+		Eval(t.Context(), mock.Anything, entities.EvalRequest{Code: "addpath('/tmp/matlab-session-o''brien')"}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockMATLABRecorder.EXPECT().
+		Record(mockSessionClient).
+		Return(mockSessionClient).
+		Once()
+
+	mockUsageRecorder.EXPECT().
+		RecordMATLABSessionStarted(session.MATLABRoot).
+		Return().
+		Once()
+
+	var storedClient matlabsessionstore.MATLABSessionClientWithCleanup
+	mockSessionStore.EXPECT().
+		Add("", session.MATLABRoot, mock.AnythingOfType("*matlabmanager.sharedMATLABSessionClient")).
+		Run(func(name string, matlabRoot string, client matlabsessionstore.MATLABSessionClientWithCleanup) {
+			storedClient = client
+		}).
+		Return(expectedSessionID, nil).
+		Once()
+
+	mockSessionClient.EXPECT().
+		Eval(t.Context(), mockLogger, entities.EvalRequest{Code: "rmpath('/tmp/matlab-session-o''brien')"}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockCertificateTrust, mockUsageRecorder, mockMATLABRecorder, mockMATLABPlayer)
+
+	// Act
+	sessionID, err := manager.StartMATLABSession(t.Context(), mockLogger, entities.SharedSessionDetails{SessionFile: sessionFile})
+	require.NoError(t, err)
+
+	stopErr := storedClient.StopSession(t.Context(), mockLogger)
+
+	// Assert
+	assert.Equal(t, expectedSessionID, sessionID)
+	require.NoError(t, stopErr)
+	assert.True(t, cleanedUp, "Stopping the session should remove the session directory, and leave MATLAB running")
+}
+
+func TestMATLABManager_StartMATLABSession_Shared_ConnectionFailed(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockCertificateTrust := &mocks.MockCertificateTrust{}
+	defer mockCertificateTrust.AssertExpectations(t)
+
+	mockUsageRecorder := &mocks.MockUsageRecorder{}
+	defer mockUsageRecorder.AssertExpectations(t)
+
+	mockMATLABRecorder := &mocks.MockMATLABRecorder{}
+	defer mockMATLABRecorder.AssertExpectations(t)
+
+	mockMATLABPlayer := &mocks.MockMATLABPlayer{}
+	defer mockMATLABPlayer.AssertExpectations(t)
+
+	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockSessionClient.AssertExpectations(t)
+
+	sessionFile := "/home/user/.matlab/matlab-mcp-session.json"
+	session := datatypes.SharedSession{SessionDir: "/tmp/matlab-session-1"}
+	connectionDetails := embeddedconnector.ConnectionDetails{
+		Host: "localhost",
+		Port: "31515",
+	}
+
+	cleanedUp := false
+	sessionCleanupFunc := func() error {
+		cleanedUp = true
+		return nil
+	}
+
+	mockMATLABPlayer.EXPECT().
+		Enabled().
+		Return(false).
+		Once()
+
+	mockMATLABServices.EXPECT().
+		ConnectToSharedMATLABSession(mock.Anything, datatypes.SharedSessionDetails{SessionFile: sessionFile}).
+		Return(session, connectionDetails, sessionCleanupFunc, nil).
+		Once()
+
+	mockCertificateTrust.EXPECT().
+		Trust(t.Context(), mock.Anything, connectionDetails.CertificatePEM).
+		Return(nil).
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(connectionDetails).
+		Return(mockSessionClient, nil).
+		Once()
+
+	mockSessionClient.EXPECT().
+		Eval(t.Context(), mock.Anything, mock.Anything).
+		Return(entities.EvalResponse{}, assert.AnError).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockCertificateTrust, mockUsageRecorder, mockMATLABRecorder, mockMATLABPlayer)
+
+	// Act
+	sessionID, err := manager.StartMATLABSession(t.Context(), mockLogger, entities.SharedSessionDetails{SessionFile: sessionFile})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Equal(t, entities.ErrorCodeMATLABNotFound, entities.ErrorCodeOf(err))
+	assert.Zero(t, sessionID)
+	assert.True(t, cleanedUp, "The session directory should be removed")
+}
@@ -0,0 +1,264 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsupervisor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+const (
+	// pidTimeout bounds the call finding the process of the MATLAB session, which waits for the code running in the
+	// session to finish.
+	pidTimeout = 10 * time.Second
+
+	// maxRestartDelay caps the delay between the attempts to restart a MATLAB session that keeps failing to start.
+	maxRestartDelay = 5 * time.Minute
+
+	notificationLevelInfo  = "info"
+	notificationLevelError = "error"
+)
+
+type Config interface {
+	HealthCheckInterval() time.Duration
+	SharedMATLABSessionFile() string
+}
+
+type LoggerFactory interface {
+	GetGlobalLogger() entities.Logger
+}
+
+type LifecycleSignaler interface {
+	AddShutdownFunction(shutdownFcn func() error)
+}
+
+type GlobalMATLAB interface {
+	Client(ctx context.Context, logger entities.Logger) (entities.MATLABSessionClient, error)
+	Restart(ctx context.Context, logger entities.Logger) error
+}
+
+type ProcessChecker interface {
+	IsProcessRunning(pid int) bool
+}
+
+type EventRecorder interface {
+	Record(kind entities.EventKind, message string, details map[string]any)
+}
+
+type ClientNotifier interface {
+	NotifyClients(level string, message string)
+}
+
+// Supervisor checks that the process of the MATLAB session started by the server is still running, and restarts the
+// session when it crashed, rather than leaving every later tool call to fail. When the new session fails to start,
+// the restart is attempted again, with a delay doubling at each failure. The MCP clients are notified of each of
+// these transitions.
+//
+// The process is checked through the operating system, so that a session busy evaluating code is not taken for a
+// crashed one.
+type Supervisor struct {
+	config            Config
+	logger            entities.Logger
+	lifecycleSignaler LifecycleSignaler
+	globalMATLAB      GlobalMATLAB
+	processChecker    ProcessChecker
+	eventRecorder     EventRecorder
+	clientNotifier    ClientNotifier
+
+	// The state is only accessed by the goroutine checking the session.
+	pid             int
+	crashed         bool
+	restartFailures int
+	nextRestart     time.Time
+}
+
+func New(
+	config Config,
+	loggerFactory LoggerFactory,
+	lifecycleSignaler LifecycleSignaler,
+	globalMATLAB GlobalMATLAB,
+	processChecker ProcessChecker,
+	eventRecorder EventRecorder,
+	clientNotifier ClientNotifier,
+) *Supervisor {
+	return &Supervisor{
+		config:            config,
+		logger:            loggerFactory.GetGlobalLogger().With("component", "matlab-supervisor"),
+		lifecycleSignaler: lifecycleSignaler,
+		globalMATLAB:      globalMATLAB,
+		processChecker:    processChecker,
+		eventRecorder:     eventRecorder,
+		clientNotifier:    clientNotifier,
+	}
+}
+
+// Start checks the MATLAB session at every health check interval, until the server shuts down or ctx is cancelled.
+// It does nothing when the health checks are disabled, or when the session is shared by the user, as the server
+// did not start it and cannot restart it.
+func (s *Supervisor) Start(ctx context.Context) {
+	if s.config.HealthCheckInterval() == 0 {
+		return
+	}
+
+	if s.config.SharedMATLABSessionFile() != "" {
+		s.logger.Debug("The shared MATLAB session is not started by the server, not supervising it")
+		return
+	}
+
+	ctx, stop := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		s.run(ctx)
+	}()
+
+	s.lifecycleSignaler.AddShutdownFunction(func() error {
+		s.logger.Debug("Stopping MATLAB supervisor")
+		stop()
+		<-done
+		return nil
+	})
+
+	s.logger.
+		With("interval", s.config.HealthCheckInterval().String()).
+		Info("MATLAB supervisor started")
+}
+
+func (s *Supervisor) run(ctx context.Context) {
+	ticker := time.NewTicker(s.config.HealthCheckInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.check(ctx)
+		}
+	}
+}
+
+func (s *Supervisor) check(ctx context.Context) {
+	if s.crashed {
+		if time.Now().Before(s.nextRestart) {
+			return
+		}
+		s.restart(ctx)
+		return
+	}
+
+	if s.pid == 0 {
+		pid, err := s.matlabPID(ctx)
+		if err != nil {
+			s.logger.WithError(err).Debug("Failed to find the MATLAB process, skipping health check")
+			return
+		}
+		s.pid = pid
+	}
+
+	if s.processChecker.IsProcessRunning(s.pid) {
+		return
+	}
+
+	// The session may have been replaced, such as by the memory watchdog, rather than have crashed.
+	// Only a lost connection to the session confirms the crash: a new session may be busy, or still starting.
+	pid, err := s.matlabPID(ctx)
+	if err == nil {
+		s.logger.With("previous-pid", s.pid).With("pid", pid).Debug("MATLAB session was replaced")
+		s.pid = pid
+		return
+	}
+	if entities.ErrorCodeOf(err) != entities.ErrorCodeSessionCrashed {
+		s.logger.WithError(err).Debug("Failed to reach the MATLAB session, looking up its process again at the next check")
+		s.pid = 0
+		return
+	}
+
+	s.logger.WithError(err).With("pid", s.pid).Error("MATLAB process is no longer running, restarting the MATLAB session")
+
+	message := fmt.Sprintf("The MATLAB session (process %d) stopped unexpectedly, so the code running in it failed, and the variables of its workspace were lost. A new MATLAB session is being started.", s.pid)
+	s.notify(entities.EventKindMATLABSessionCrashed, notificationLevelError, message, map[string]any{
+		"pid": s.pid,
+	})
+
+	s.crashed = true
+	s.pid = 0
+	s.restart(ctx)
+}
+
+func (s *Supervisor) restart(ctx context.Context) {
+	if err := s.globalMATLAB.Restart(ctx, s.logger); err != nil {
+		s.restartFailures++
+		delay := s.restartDelay()
+		s.nextRestart = time.Now().Add(delay)
+
+		s.logger.WithError(err).With("retry-in", delay.String()).Error("Failed to restart the MATLAB session")
+
+		message := fmt.Sprintf("The new MATLAB session failed to start: %v. It is started again in %s.", err, delay)
+		s.notify(entities.EventKindMATLABRestartFailed, notificationLevelError, message, map[string]any{
+			"attempt":  s.restartFailures,
+			"retry-in": delay.String(),
+		})
+		return
+	}
+
+	s.logger.With("attempts", s.restartFailures+1).Info("MATLAB session restarted")
+	s.notify(entities.EventKindMATLABSessionRestarted, notificationLevelInfo, "A new MATLAB session was started after the previous one stopped unexpectedly. Run again the code needed to restore its workspace.", map[string]any{
+		"attempts": s.restartFailures + 1,
+	})
+
+	s.crashed = false
+	s.restartFailures = 0
+}
+
+// restartDelay is the delay before the next attempt to restart the MATLAB session: the health check interval, doubled
+// at each failure, up to maxRestartDelay.
+func (s *Supervisor) restartDelay() time.Duration {
+	delay := s.config.HealthCheckInterval()
+	for range s.restartFailures - 1 {
+		delay *= 2
+		if delay >= maxRestartDelay {
+			return maxRestartDelay
+		}
+	}
+	return min(delay, maxRestartDelay)
+}
+
+func (s *Supervisor) matlabPID(ctx context.Context) (int, error) {
+	client, err := s.globalMATLAB.Client(ctx, s.logger)
+	if err != nil {
+		return 0, err
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, pidTimeout)
+	defer cancel()
+
+	response, err := client.FEval(queryCtx, s.logger, entities.FEvalRequest{
+		Function:   "feature",
+		Arguments:  []string{"getpid"},
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if len(response.Outputs) != 1 {
+		return 0, fmt.Errorf("unexpected number of outputs from MATLAB session")
+	}
+
+	pid, ok := response.Outputs[0].(float64)
+	if !ok {
+		return 0, fmt.Errorf("failed to cast output to number")
+	}
+
+	return int(pid), nil
+}
+
+func (s *Supervisor) notify(kind entities.EventKind, level string, message string, details map[string]any) {
+	s.eventRecorder.Record(kind, message, details)
+	s.clientNotifier.NotifyClients(level, message)
+}
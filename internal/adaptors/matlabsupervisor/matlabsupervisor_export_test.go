@@ -0,0 +1,9 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsupervisor
+
+import "context"
+
+func (s *Supervisor) Check(ctx context.Context) {
+	s.check(ctx)
+}
@@ -0,0 +1,347 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsupervisor_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabsupervisor"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabsupervisor"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const matlabPID = 4321
+
+var getPIDRequest = entities.FEvalRequest{
+	Function:   "feature",
+	Arguments:  []string{"getpid"},
+	NumOutputs: 1,
+}
+
+type supervisorMocks struct {
+	config            *mocks.MockConfig
+	lifecycleSignaler *mocks.MockLifecycleSignaler
+	globalMATLAB      *mocks.MockGlobalMATLAB
+	processChecker    *mocks.MockProcessChecker
+	eventRecorder     *mocks.MockEventRecorder
+	clientNotifier    *mocks.MockClientNotifier
+	client            *entitiesmocks.MockMATLABSessionClient
+	logger            *testutils.InspectableLogger
+}
+
+func newSupervisor(t *testing.T) (*matlabsupervisor.Supervisor, *supervisorMocks) {
+	t.Helper()
+
+	m := &supervisorMocks{
+		config:            &mocks.MockConfig{},
+		lifecycleSignaler: &mocks.MockLifecycleSignaler{},
+		globalMATLAB:      &mocks.MockGlobalMATLAB{},
+		processChecker:    &mocks.MockProcessChecker{},
+		eventRecorder:     &mocks.MockEventRecorder{},
+		clientNotifier:    &mocks.MockClientNotifier{},
+		client:            &entitiesmocks.MockMATLABSessionClient{},
+		logger:            testutils.NewInspectableLogger(),
+	}
+	t.Cleanup(func() {
+		m.config.AssertExpectations(t)
+		m.lifecycleSignaler.AssertExpectations(t)
+		m.globalMATLAB.AssertExpectations(t)
+		m.processChecker.AssertExpectations(t)
+		m.eventRecorder.AssertExpectations(t)
+		m.clientNotifier.AssertExpectations(t)
+		m.client.AssertExpectations(t)
+	})
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(m.logger).
+		Once()
+
+	supervisor := matlabsupervisor.New(
+		m.config,
+		mockLoggerFactory,
+		m.lifecycleSignaler,
+		m.globalMATLAB,
+		m.processChecker,
+		m.eventRecorder,
+		m.clientNotifier,
+	)
+
+	return supervisor, m
+}
+
+func (m *supervisorMocks) expectClient() {
+	m.globalMATLAB.EXPECT().
+		Client(mock.Anything, m.logger.AsMockArg()).
+		Return(m.client, nil)
+}
+
+func (m *supervisorMocks) expectPID(pid int) {
+	m.client.EXPECT().
+		FEval(mock.Anything, m.logger.AsMockArg(), getPIDRequest).
+		Return(entities.FEvalResponse{Outputs: []any{float64(pid)}}, nil).
+		Once()
+}
+
+func (m *supervisorMocks) expectPIDError(err error) {
+	m.client.EXPECT().
+		FEval(mock.Anything, m.logger.AsMockArg(), getPIDRequest).
+		Return(entities.FEvalResponse{}, err).
+		Once()
+}
+
+func (m *supervisorMocks) expectProcessRunning(pid int, running bool) {
+	m.processChecker.EXPECT().
+		IsProcessRunning(pid).
+		Return(running).
+		Once()
+}
+
+func (m *supervisorMocks) expectNotification(kind entities.EventKind, level string, messages *[]string) {
+	m.eventRecorder.EXPECT().
+		Record(kind, mock.Anything, mock.Anything).
+		Return().
+		Once()
+
+	m.clientNotifier.EXPECT().
+		NotifyClients(level, mock.Anything).
+		Run(func(_ string, message string) {
+			*messages = append(*messages, message)
+		}).
+		Return().
+		Once()
+}
+
+func TestNew_HappyPath(t *testing.T) {
+	// Act
+	supervisor, _ := newSupervisor(t)
+
+	// Assert
+	assert.NotNil(t, supervisor)
+}
+
+func TestSupervisor_Start_Disabled(t *testing.T) {
+	// Arrange
+	supervisor, m := newSupervisor(t)
+
+	m.config.EXPECT().
+		HealthCheckInterval().
+		Return(0).
+		Once()
+
+	// Act
+	supervisor.Start(t.Context())
+
+	// Assert
+	m.lifecycleSignaler.AssertNotCalled(t, "AddShutdownFunction", mock.Anything)
+}
+
+func TestSupervisor_Start_IgnoredWithSharedMATLABSession(t *testing.T) {
+	// Arrange
+	supervisor, m := newSupervisor(t)
+
+	m.config.EXPECT().
+		HealthCheckInterval().
+		Return(10 * time.Second).
+		Once()
+
+	m.config.EXPECT().
+		SharedMATLABSessionFile().
+		Return("/home/user/matlab-mcp-session.json").
+		Once()
+
+	// Act
+	supervisor.Start(t.Context())
+
+	// Assert
+	m.lifecycleSignaler.AssertNotCalled(t, "AddShutdownFunction", mock.Anything)
+}
+
+func TestSupervisor_Start_StopsOnShutdown(t *testing.T) {
+	// Arrange
+	supervisor, m := newSupervisor(t)
+
+	m.config.EXPECT().
+		HealthCheckInterval().
+		Return(time.Hour)
+
+	m.config.EXPECT().
+		SharedMATLABSessionFile().
+		Return("").
+		Once()
+
+	var shutdownFcn func() error
+	m.lifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.Anything).
+		Run(func(fcn func() error) {
+			shutdownFcn = fcn
+		}).
+		Once()
+
+	// Act
+	supervisor.Start(t.Context())
+
+	// Assert
+	require.NotNil(t, shutdownFcn)
+	require.NoError(t, shutdownFcn())
+}
+
+func TestSupervisor_Check_ProcessRunning(t *testing.T) {
+	// Arrange
+	supervisor, m := newSupervisor(t)
+	m.expectClient()
+	m.expectPID(matlabPID)
+	m.expectProcessRunning(matlabPID, true)
+	m.expectProcessRunning(matlabPID, true)
+
+	// Act
+	supervisor.Check(t.Context())
+	supervisor.Check(t.Context())
+
+	// Assert
+	m.globalMATLAB.AssertNotCalled(t, "Restart", mock.Anything, mock.Anything)
+	m.clientNotifier.AssertNotCalled(t, "NotifyClients", mock.Anything, mock.Anything)
+}
+
+func TestSupervisor_Check_SessionUnavailable(t *testing.T) {
+	// Arrange
+	supervisor, m := newSupervisor(t)
+
+	m.globalMATLAB.EXPECT().
+		Client(mock.Anything, m.logger.AsMockArg()).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	supervisor.Check(t.Context())
+
+	// Assert
+	m.processChecker.AssertNotCalled(t, "IsProcessRunning", mock.Anything)
+	m.globalMATLAB.AssertNotCalled(t, "Restart", mock.Anything, mock.Anything)
+}
+
+func TestSupervisor_Check_RestartsCrashedSession(t *testing.T) {
+	// Arrange
+	supervisor, m := newSupervisor(t)
+	m.expectClient()
+	m.expectPID(matlabPID)
+	m.expectProcessRunning(matlabPID, false)
+	m.expectPIDError(entities.NewCodedError(entities.ErrorCodeSessionCrashed, errors.New("connection refused")))
+
+	m.globalMATLAB.EXPECT().
+		Restart(mock.Anything, m.logger.AsMockArg()).
+		Return(nil).
+		Once()
+
+	var messages []string
+	m.expectNotification(entities.EventKindMATLABSessionCrashed, "error", &messages)
+	m.expectNotification(entities.EventKindMATLABSessionRestarted, "info", &messages)
+
+	// Act
+	supervisor.Check(t.Context())
+
+	// Assert
+	require.Len(t, messages, 2)
+	assert.Contains(t, messages[0], "The MATLAB session (process 4321) stopped unexpectedly")
+	assert.Contains(t, messages[1], "A new MATLAB session was started")
+}
+
+func TestSupervisor_Check_ReplacedSessionIsNotRestarted(t *testing.T) {
+	// Arrange
+	supervisor, m := newSupervisor(t)
+	m.expectClient()
+	m.expectPID(matlabPID)
+	m.expectProcessRunning(matlabPID, false)
+	m.expectPID(matlabPID + 1)
+	m.expectProcessRunning(matlabPID+1, true)
+
+	// Act
+	supervisor.Check(t.Context())
+	supervisor.Check(t.Context())
+
+	// Assert
+	m.globalMATLAB.AssertNotCalled(t, "Restart", mock.Anything, mock.Anything)
+	m.clientNotifier.AssertNotCalled(t, "NotifyClients", mock.Anything, mock.Anything)
+}
+
+func TestSupervisor_Check_BusySessionIsNotRestarted(t *testing.T) {
+	// Arrange
+	supervisor, m := newSupervisor(t)
+	m.expectClient()
+	m.expectPID(matlabPID)
+	m.expectProcessRunning(matlabPID, false)
+	m.expectPIDError(entities.NewCodedError(entities.ErrorCodeEvalTimeout, errors.New("deadline exceeded")))
+
+	// Act
+	supervisor.Check(t.Context())
+
+	// Assert
+	m.globalMATLAB.AssertNotCalled(t, "Restart", mock.Anything, mock.Anything)
+	m.clientNotifier.AssertNotCalled(t, "NotifyClients", mock.Anything, mock.Anything)
+}
+
+func TestSupervisor_Check_RetriesFailedRestartWithBackoff(t *testing.T) {
+	// Arrange
+	supervisor, m := newSupervisor(t)
+	m.expectClient()
+	m.expectPID(matlabPID)
+	m.expectProcessRunning(matlabPID, false)
+	m.expectPIDError(entities.NewCodedError(entities.ErrorCodeSessionCrashed, errors.New("connection refused")))
+
+	interval := 5 * time.Millisecond
+	m.config.EXPECT().
+		HealthCheckInterval().
+		Return(interval)
+
+	m.globalMATLAB.EXPECT().
+		Restart(mock.Anything, m.logger.AsMockArg()).
+		Return(errors.New("license unavailable")).
+		Twice()
+
+	m.globalMATLAB.EXPECT().
+		Restart(mock.Anything, m.logger.AsMockArg()).
+		Return(nil).
+		Once()
+
+	var retryDelays []string
+	m.eventRecorder.EXPECT().
+		Record(entities.EventKindMATLABRestartFailed, mock.Anything, mock.Anything).
+		Run(func(_ entities.EventKind, _ string, details map[string]any) {
+			retryDelays = append(retryDelays, details["retry-in"].(string))
+		}).
+		Return().
+		Twice()
+
+	var messages []string
+	m.expectNotification(entities.EventKindMATLABSessionCrashed, "error", &messages)
+	m.expectNotification(entities.EventKindMATLABSessionRestarted, "info", &messages)
+	m.clientNotifier.EXPECT().
+		NotifyClients("error", mock.Anything).
+		Run(func(_ string, message string) {
+			messages = append(messages, message)
+		}).
+		Return().
+		Twice()
+
+	// Act
+	supervisor.Check(t.Context())
+	supervisor.Check(t.Context()) // Before the delay elapsed, the restart is not attempted again.
+	time.Sleep(interval)
+	supervisor.Check(t.Context())
+	time.Sleep(2 * interval)
+	supervisor.Check(t.Context())
+
+	// Assert
+	assert.Equal(t, []string{"5ms", "10ms"}, retryDelays)
+	require.Len(t, messages, 4)
+	assert.Contains(t, messages[1], "The new MATLAB session failed to start: license unavailable. It is started again in 5ms.")
+	assert.Contains(t, messages[3], "A new MATLAB session was started")
+}
@@ -0,0 +1,10 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabartifact
+
+const (
+	uriTemplate = "matlab://artifacts/{name}"
+	name        = "matlab-artifact"
+	title       = "MATLAB Artifact"
+	description = "A file (`name`) of the artifact directory shared by the server and MATLAB, such as the MAT-file of a large workspace variable. The content is checked against the SHA-256 hash taken when the file was written. The `_meta` field of the contents holds the path, number of bytes, SHA-256 hash and creation time of the file, so that clients with access to the file system can read it directly instead."
+)
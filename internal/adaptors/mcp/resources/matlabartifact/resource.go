@@ -0,0 +1,82 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabartifact
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/artifactstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/clientidentity"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type LoggerFactory interface {
+	NewMCPSessionLogger(session *mcp.ServerSession) entities.Logger
+}
+
+type ArtifactStore interface {
+	Read(logger entities.Logger, name string) (artifactstore.Artifact, []byte, error)
+}
+
+// Resource exposes the files of the shared artifact directory, for clients that cannot read them from the file system.
+type Resource struct {
+	handler mcp.ResourceHandler
+}
+
+func New(
+	loggerFactory LoggerFactory,
+	artifactStore ArtifactStore,
+) *Resource {
+	return &Resource{
+		handler: Handler(loggerFactory, artifactStore),
+	}
+}
+
+func (r *Resource) AddToServer(server *mcp.Server) error {
+	server.AddResourceTemplate(&mcp.ResourceTemplate{
+		URITemplate: uriTemplate,
+		Name:        name,
+		Title:       title,
+		Description: description,
+	}, r.handler)
+
+	return nil
+}
+
+func Handler(loggerFactory LoggerFactory, artifactStore ArtifactStore) mcp.ResourceHandler {
+	return func(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+		uri := req.Params.URI
+		sessionLogger := loggerFactory.NewMCPSessionLogger(req.Session).With("resource-uri", uri)
+		if identity, ok := clientidentity.FromContext(ctx); ok {
+			sessionLogger = sessionLogger.With(clientidentity.UserLogKey, identity.User).With(clientidentity.ClientLogKey, identity.Client)
+		}
+
+		sessionLogger.Info("Reading MATLAB artifact resource")
+		defer sessionLogger.Info("Done - Reading MATLAB artifact resource")
+
+		artifact, content, err := artifactStore.Read(sessionLogger, strings.TrimPrefix(uri, artifactstore.URIPrefix))
+		if err != nil {
+			sessionLogger.WithError(err).Warn("Failed to read MATLAB artifact")
+			return nil, err
+		}
+
+		return &mcp.ReadResourceResult{
+			Contents: []*mcp.ResourceContents{
+				{
+					URI:      uri,
+					MIMEType: artifact.MIMEType,
+					Blob:     content,
+					Meta: mcp.Meta{
+						"path":    artifact.Path,
+						"bytes":   artifact.Bytes,
+						"sha256":  artifact.SHA256,
+						"created": artifact.Created.Format(time.RFC3339),
+					},
+				},
+			},
+		}, nil
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabartifact_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabartifact"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/artifactstore"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/resources/matlabartifact"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockArtifactStore := &mocks.MockArtifactStore{}
+	defer mockArtifactStore.AssertExpectations(t)
+
+	// Act
+	resource := matlabartifact.New(mockLoggerFactory, mockArtifactStore)
+
+	// Assert
+	assert.NotNil(t, resource)
+}
+
+func TestResource_AddToServer_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockArtifactStore := &mocks.MockArtifactStore{}
+	defer mockArtifactStore.AssertExpectations(t)
+
+	resource := matlabartifact.New(mockLoggerFactory, mockArtifactStore)
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-server"}, nil)
+
+	// Act
+	err := resource.AddToServer(server)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestHandler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockArtifactStore := &mocks.MockArtifactStore{}
+	defer mockArtifactStore.AssertExpectations(t)
+
+	ctx := t.Context()
+	const uri = "matlab://artifacts/tp1234.mat"
+	artifact := artifactstore.Artifact{
+		Name:     "tp1234.mat",
+		URI:      uri,
+		Path:     "/tmp/matlab-mcp-core-server-123/artifacts-456/tp1234.mat",
+		MIMEType: "application/x-matlab-data",
+		Bytes:    19,
+		SHA256:   "80fbccf21e8b41709a1790cc6921aedcbbb677bb315070e48a313e941de207b0",
+		Created:  time.Date(2025, 6, 1, 12, 30, 0, 0, time.UTC),
+	}
+	content := []byte("MATLAB 5.0 MAT-file")
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	mockArtifactStore.EXPECT().
+		Read(mockLogger.AsMockArg(), "tp1234.mat").
+		Return(artifact, content, nil).
+		Once()
+
+	handler := matlabartifact.Handler(mockLoggerFactory, mockArtifactStore)
+
+	// Act
+	result, err := handler(ctx, &mcp.ReadResourceRequest{Params: &mcp.ReadResourceParams{URI: uri}})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.Contents, 1)
+	contents := result.Contents[0]
+	assert.Equal(t, uri, contents.URI)
+	assert.Equal(t, "application/x-matlab-data", contents.MIMEType)
+	assert.Equal(t, content, contents.Blob)
+	assert.Equal(t, mcp.Meta{
+		"path":    artifact.Path,
+		"bytes":   int64(19),
+		"sha256":  artifact.SHA256,
+		"created": "2025-06-01T12:30:00Z",
+	}, contents.Meta)
+}
+
+func TestHandler_ArtifactStoreError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockArtifactStore := &mocks.MockArtifactStore{}
+	defer mockArtifactStore.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	mockArtifactStore.EXPECT().
+		Read(mockLogger.AsMockArg(), "missing.mat").
+		Return(artifactstore.Artifact{}, nil, assert.AnError).
+		Once()
+
+	handler := matlabartifact.Handler(mockLoggerFactory, mockArtifactStore)
+
+	// Act
+	result, err := handler(ctx, &mcp.ReadResourceRequest{Params: &mcp.ReadResourceParams{URI: "matlab://artifacts/missing.mat"}})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, result)
+	assert.Contains(t, mockLogger.WarnLogs(), "Failed to read MATLAB artifact")
+}
@@ -0,0 +1,16 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabdrive
+
+const (
+	uriTemplate = "matlab://drive/{+path}"
+	name        = "matlab-drive"
+	title       = "MATLAB Drive"
+	description = "A file or folder (`path`) of MATLAB Drive, the cloud storage of MATLAB, as synced to this computer by MATLAB Drive Connector. A folder is returned as a JSON listing of its files and folders, with their URI, so that clients can browse MATLAB Drive from matlab://drive/. Use pull_from_matlab_drive to copy a file into a project."
+
+	rootURI         = "matlab://drive/"
+	rootName        = "matlab-drive-root"
+	rootDescription = "The top folder of MATLAB Drive, as a JSON listing of its files and folders, with their URI."
+
+	folderMIMEType = "application/json"
+)
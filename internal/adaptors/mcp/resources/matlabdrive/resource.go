@@ -0,0 +1,180 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabdrive
+
+import (
+	"context"
+	"encoding/json"
+	"mime"
+	"path"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/matlabdrive"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/clientidentity"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type LoggerFactory interface {
+	NewMCPSessionLogger(session *mcp.ServerSession) entities.Logger
+}
+
+type Drive interface {
+	Stat(drivePath string) (matlabdrive.Entry, error)
+	List(drivePath string) ([]matlabdrive.Entry, error)
+	Read(drivePath string) ([]byte, error)
+}
+
+// Resource exposes the files and folders of MATLAB Drive.
+type Resource struct {
+	handler mcp.ResourceHandler
+}
+
+func New(
+	loggerFactory LoggerFactory,
+	drive Drive,
+) *Resource {
+	return &Resource{
+		handler: Handler(loggerFactory, drive),
+	}
+}
+
+func (r *Resource) AddToServer(server *mcp.Server) error {
+	server.AddResource(&mcp.Resource{
+		URI:         rootURI,
+		Name:        rootName,
+		Title:       title,
+		Description: rootDescription,
+		MIMEType:    folderMIMEType,
+	}, r.handler)
+
+	server.AddResourceTemplate(&mcp.ResourceTemplate{
+		URITemplate: uriTemplate,
+		Name:        name,
+		Title:       title,
+		Description: description,
+	}, r.handler)
+
+	return nil
+}
+
+type folderEntry struct {
+	Name     string    `json:"name"`
+	URI      string    `json:"uri"`
+	IsFolder bool      `json:"is_folder"`
+	Bytes    int64     `json:"bytes,omitempty"`
+	Modified time.Time `json:"modified"`
+}
+
+type folderListing struct {
+	Path    string        `json:"path"`
+	Entries []folderEntry `json:"entries"`
+}
+
+func Handler(loggerFactory LoggerFactory, drive Drive) mcp.ResourceHandler {
+	return func(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+		uri := req.Params.URI
+		sessionLogger := loggerFactory.NewMCPSessionLogger(req.Session).With("resource-uri", uri)
+		if identity, ok := clientidentity.FromContext(ctx); ok {
+			sessionLogger = sessionLogger.With(clientidentity.UserLogKey, identity.User).With(clientidentity.ClientLogKey, identity.Client)
+		}
+
+		sessionLogger.Info("Reading MATLAB Drive resource")
+		defer sessionLogger.Info("Done - Reading MATLAB Drive resource")
+
+		drivePath, err := matlabdrive.PathFromURI(uri)
+		if err != nil {
+			return nil, err
+		}
+
+		entry, err := drive.Stat(drivePath)
+		if err != nil {
+			sessionLogger.WithError(err).Warn("Failed to read MATLAB Drive")
+			return nil, err
+		}
+
+		if entry.IsDir {
+			return readFolder(drive, uri, entry)
+		}
+
+		content, err := drive.Read(drivePath)
+		if err != nil {
+			sessionLogger.WithError(err).Warn("Failed to read MATLAB Drive")
+			return nil, err
+		}
+
+		contents := &mcp.ResourceContents{
+			URI:      uri,
+			MIMEType: mimeType(entry.Name),
+		}
+		if isText(contents.MIMEType) && utf8.Valid(content) {
+			contents.Text = string(content)
+		} else {
+			contents.Blob = content
+		}
+
+		return &mcp.ReadResourceResult{
+			Contents: []*mcp.ResourceContents{contents},
+		}, nil
+	}
+}
+
+func readFolder(drive Drive, uri string, folder matlabdrive.Entry) (*mcp.ReadResourceResult, error) {
+	entries, err := drive.List(folder.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	listing := folderListing{
+		Path:    folder.Path,
+		Entries: make([]folderEntry, len(entries)),
+	}
+	for i, entry := range entries {
+		listing.Entries[i] = folderEntry{
+			Name:     entry.Name,
+			URI:      entry.URI,
+			IsFolder: entry.IsDir,
+			Bytes:    entry.Bytes,
+			Modified: entry.ModTime.UTC(),
+		}
+		if entry.IsDir {
+			listing.Entries[i].Bytes = 0
+		}
+	}
+
+	data, err := json.Marshal(listing)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mcp.ReadResourceResult{
+		Contents: []*mcp.ResourceContents{
+			{
+				URI:      uri,
+				MIMEType: folderMIMEType,
+				Text:     string(data),
+			},
+		},
+	}, nil
+}
+
+// mimeType is the MIME type of a file of MATLAB Drive, from the extension of its name.
+func mimeType(fileName string) string {
+	switch extension := strings.ToLower(path.Ext(fileName)); extension {
+	case ".m":
+		return "text/x-matlab"
+	case ".mat":
+		return "application/x-matlab-data"
+	default:
+		if mimeType := mime.TypeByExtension(extension); mimeType != "" {
+			return mimeType
+		}
+		return "application/octet-stream"
+	}
+}
+
+func isText(mimeType string) bool {
+	return strings.HasPrefix(mimeType, "text/") || strings.HasPrefix(mimeType, "application/json") || strings.HasPrefix(mimeType, "application/xml")
+}
@@ -0,0 +1,211 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabdrive_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabdrive"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	drive "github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/matlabdrive"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/resources/matlabdrive"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockDrive := &mocks.MockDrive{}
+	defer mockDrive.AssertExpectations(t)
+
+	// Act
+	resource := matlabdrive.New(mockLoggerFactory, mockDrive)
+
+	// Assert
+	assert.NotNil(t, resource)
+}
+
+func TestResource_AddToServer_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockDrive := &mocks.MockDrive{}
+	defer mockDrive.AssertExpectations(t)
+
+	resource := matlabdrive.New(mockLoggerFactory, mockDrive)
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-server"}, nil)
+
+	// Act
+	err := resource.AddToServer(server)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestHandler_Folder(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockDrive := &mocks.MockDrive{}
+	defer mockDrive.AssertExpectations(t)
+
+	ctx := t.Context()
+	const uri = "matlab://drive/data"
+	modified := time.Date(2025, 3, 4, 5, 6, 7, 0, time.UTC)
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	mockDrive.EXPECT().
+		Stat("data").
+		Return(drive.Entry{Name: "data", Path: "data", URI: uri, IsDir: true, ModTime: modified}, nil).
+		Once()
+
+	mockDrive.EXPECT().
+		List("data").
+		Return([]drive.Entry{
+			{Name: "raw", Path: "data/raw", URI: "matlab://drive/data/raw", IsDir: true, Bytes: 4096, ModTime: modified},
+			{Name: "results.csv", Path: "data/results.csv", URI: "matlab://drive/data/results.csv", Bytes: 8, ModTime: modified},
+		}, nil).
+		Once()
+
+	handler := matlabdrive.Handler(mockLoggerFactory, mockDrive)
+
+	// Act
+	result, err := handler(ctx, &mcp.ReadResourceRequest{Params: &mcp.ReadResourceParams{URI: uri}})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.Contents, 1)
+	assert.Equal(t, uri, result.Contents[0].URI)
+	assert.Equal(t, "application/json", result.Contents[0].MIMEType)
+	assert.JSONEq(t, `{
+		"path": "data",
+		"entries": [
+			{"name": "raw", "uri": "matlab://drive/data/raw", "is_folder": true, "modified": "2025-03-04T05:06:07Z"},
+			{"name": "results.csv", "uri": "matlab://drive/data/results.csv", "is_folder": false, "bytes": 8, "modified": "2025-03-04T05:06:07Z"}
+		]
+	}`, result.Contents[0].Text)
+}
+
+func TestHandler_File(t *testing.T) {
+	testConfigs := []struct {
+		name             string
+		fileName         string
+		content          []byte
+		expectedMIMEType string
+		expectedText     string
+		expectedBlob     []byte
+	}{
+		{
+			name:             "MATLAB code",
+			fileName:         "analyze.m",
+			content:          []byte("disp(1)"),
+			expectedMIMEType: "text/x-matlab",
+			expectedText:     "disp(1)",
+		},
+		{
+			name:             "MAT-file",
+			fileName:         "data.mat",
+			content:          []byte("MATLAB 5.0 MAT-file"),
+			expectedMIMEType: "application/x-matlab-data",
+			expectedBlob:     []byte("MATLAB 5.0 MAT-file"),
+		},
+		{
+			name:             "unknown extension",
+			fileName:         "model.slxc",
+			content:          []byte{0x50, 0x4b},
+			expectedMIMEType: "application/octet-stream",
+			expectedBlob:     []byte{0x50, 0x4b},
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockLoggerFactory := &mocks.MockLoggerFactory{}
+			defer mockLoggerFactory.AssertExpectations(t)
+
+			mockDrive := &mocks.MockDrive{}
+			defer mockDrive.AssertExpectations(t)
+
+			ctx := t.Context()
+			uri := "matlab://drive/project/" + testConfig.fileName
+			drivePath := "project/" + testConfig.fileName
+
+			mockLoggerFactory.EXPECT().
+				NewMCPSessionLogger(mock.Anything).
+				Return(mockLogger).
+				Once()
+
+			mockDrive.EXPECT().
+				Stat(drivePath).
+				Return(drive.Entry{Name: testConfig.fileName, Path: drivePath, URI: uri}, nil).
+				Once()
+
+			mockDrive.EXPECT().
+				Read(drivePath).
+				Return(testConfig.content, nil).
+				Once()
+
+			handler := matlabdrive.Handler(mockLoggerFactory, mockDrive)
+
+			// Act
+			result, err := handler(ctx, &mcp.ReadResourceRequest{Params: &mcp.ReadResourceParams{URI: uri}})
+
+			// Assert
+			require.NoError(t, err)
+			require.Len(t, result.Contents, 1)
+			assert.Equal(t, testConfig.expectedMIMEType, result.Contents[0].MIMEType)
+			assert.Equal(t, testConfig.expectedText, result.Contents[0].Text)
+			assert.Equal(t, testConfig.expectedBlob, result.Contents[0].Blob)
+		})
+	}
+}
+
+func TestHandler_DriveError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockDrive := &mocks.MockDrive{}
+	defer mockDrive.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	mockDrive.EXPECT().
+		Stat("missing.m").
+		Return(drive.Entry{}, assert.AnError).
+		Once()
+
+	handler := matlabdrive.Handler(mockLoggerFactory, mockDrive)
+
+	// Act
+	result, err := handler(ctx, &mcp.ReadResourceRequest{Params: &mcp.ReadResourceParams{URI: "matlab://drive/missing.m"}})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, result)
+	assert.Contains(t, mockLogger.WarnLogs(), "Failed to read MATLAB Drive")
+}
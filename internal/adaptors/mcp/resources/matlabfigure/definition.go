@@ -0,0 +1,13 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabfigure
+
+const (
+	uriTemplate = "matlab://figures/{number}"
+	uriPrefix   = "matlab://figures/"
+	name        = "matlab-figure"
+	title       = "MATLAB Figure"
+	description = "A PNG snapshot of the figure (`number`) of the MATLAB session, rendered in the background after evaluate_matlab_code returns. Reading a snapshot that is still being rendered waits for it. Subscribe to the resource to be notified when the snapshot is ready."
+
+	pngMIMEType = "image/png"
+)
@@ -0,0 +1,253 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabfigure
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listmatlabfigures"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/rendermatlabfigure"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/clientidentity"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type LoggerFactory interface {
+	GetGlobalLogger() entities.Logger
+	NewMCPSessionLogger(session *mcp.ServerSession) entities.Logger
+}
+
+type Config interface {
+	FigureResolution() int
+}
+
+type ListUsecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) (listmatlabfigures.ReturnArgs, error)
+}
+
+type RenderUsecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request rendermatlabfigure.Args) (rendermatlabfigure.ReturnArgs, error)
+}
+
+type resourceUpdateNotifier func(ctx context.Context, params *mcp.ResourceUpdatedNotificationParams) error
+
+// snapshot is the rendering of a figure. done is closed once the rendering is complete, successfully or not.
+type snapshot struct {
+	done chan struct{}
+	png  []byte
+	err  error
+}
+
+func (s *snapshot) completed() bool {
+	select {
+	case <-s.done:
+		return true
+	default:
+		return false
+	}
+}
+
+type renderJob struct {
+	number   int
+	snapshot *snapshot
+}
+
+// Resource exposes snapshots of the figures of the global MATLAB session. The figures are rendered in the background,
+// one at a time, so that evaluate_matlab_code returns as soon as the code has run, with links to the snapshots.
+// Subscribed clients are notified when a snapshot is ready.
+type Resource struct {
+	logger        entities.Logger
+	loggerFactory LoggerFactory
+	config        Config
+	listUsecase   ListUsecase
+	renderUsecase RenderUsecase
+	globalMATLAB  entities.GlobalMATLAB
+
+	lock       *sync.Mutex
+	snapshots  map[int]*snapshot
+	renderLock *sync.Mutex
+	notify     resourceUpdateNotifier
+}
+
+func New(
+	loggerFactory LoggerFactory,
+	config Config,
+	listUsecase ListUsecase,
+	renderUsecase RenderUsecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Resource {
+	return &Resource{
+		logger:        loggerFactory.GetGlobalLogger().With("component", "figure-renderer"),
+		loggerFactory: loggerFactory,
+		config:        config,
+		listUsecase:   listUsecase,
+		renderUsecase: renderUsecase,
+		globalMATLAB:  globalMATLAB,
+
+		lock:       new(sync.Mutex),
+		snapshots:  make(map[int]*snapshot),
+		renderLock: new(sync.Mutex),
+		notify: func(ctx context.Context, params *mcp.ResourceUpdatedNotificationParams) error {
+			return nil
+		},
+	}
+}
+
+func (r *Resource) AddToServer(server *mcp.Server) error {
+	server.AddResourceTemplate(&mcp.ResourceTemplate{
+		URITemplate: uriTemplate,
+		Name:        name,
+		Title:       title,
+		Description: description,
+		MIMEType:    pngMIMEType,
+	}, r.Handler())
+
+	r.lock.Lock()
+	r.notify = server.ResourceUpdated
+	r.lock.Unlock()
+
+	return nil
+}
+
+// Submit lists the figures open in the MATLAB session, queues their rendering, and returns links to their snapshots.
+// It returns no links if figure rendering is disabled.
+func (r *Resource) Submit(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) ([]tools.ResourceLink, error) {
+	if r.config.FigureResolution() == 0 {
+		return nil, nil
+	}
+
+	listed, err := r.listUsecase.Execute(ctx, sessionLogger, client)
+	if err != nil {
+		return nil, err
+	}
+
+	links := make([]tools.ResourceLink, 0, len(listed.Figures))
+	jobs := make([]renderJob, 0, len(listed.Figures))
+	open := make(map[int]bool, len(listed.Figures))
+
+	r.lock.Lock()
+	for _, figure := range listed.Figures {
+		open[figure.Number] = true
+
+		// A figure that is still waiting to be rendered is not queued again.
+		current, ok := r.snapshots[figure.Number]
+		if !ok || current.completed() {
+			current = &snapshot{done: make(chan struct{})}
+			r.snapshots[figure.Number] = current
+			jobs = append(jobs, renderJob{number: figure.Number, snapshot: current})
+		}
+
+		links = append(links, tools.ResourceLink{
+			URI:      figureURI(figure.Number),
+			Name:     figureName(figure),
+			MIMEType: pngMIMEType,
+		})
+	}
+
+	// Drop the snapshots of the figures that were closed.
+	for number, current := range r.snapshots {
+		if !open[number] && current.completed() {
+			delete(r.snapshots, number)
+		}
+	}
+	r.lock.Unlock()
+
+	if len(jobs) > 0 {
+		sessionLogger.With("figures", len(jobs)).Debug("Queued figure rendering")
+		go r.render(jobs)
+	}
+
+	return links, nil
+}
+
+func (r *Resource) render(jobs []renderJob) {
+	r.renderLock.Lock()
+	defer r.renderLock.Unlock()
+
+	ctx := context.Background()
+
+	for _, job := range jobs {
+		logger := r.logger.With("figure", job.number)
+
+		client, err := r.globalMATLAB.Client(ctx, logger)
+		if err == nil {
+			var rendered rendermatlabfigure.ReturnArgs
+			rendered, err = r.renderUsecase.Execute(ctx, logger, client, rendermatlabfigure.Args{Number: job.number})
+			job.snapshot.png = rendered.PNG
+		}
+		job.snapshot.err = err
+		close(job.snapshot.done)
+
+		if err != nil {
+			logger.WithError(err).Warn("Failed to render figure")
+		}
+
+		r.lock.Lock()
+		notify := r.notify
+		r.lock.Unlock()
+
+		if err := notify(ctx, &mcp.ResourceUpdatedNotificationParams{URI: figureURI(job.number)}); err != nil {
+			logger.WithError(err).Warn("Failed to notify clients of the rendered figure")
+		}
+	}
+}
+
+func (r *Resource) Handler() mcp.ResourceHandler {
+	return func(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+		uri := req.Params.URI
+		sessionLogger := r.loggerFactory.NewMCPSessionLogger(req.Session).With("resource-uri", uri)
+		if identity, ok := clientidentity.FromContext(ctx); ok {
+			sessionLogger = sessionLogger.With(clientidentity.UserLogKey, identity.User).With(clientidentity.ClientLogKey, identity.Client)
+		}
+
+		sessionLogger.Info("Reading MATLAB figure resource")
+		defer sessionLogger.Info("Done - Reading MATLAB figure resource")
+
+		number, err := strconv.Atoi(strings.TrimPrefix(uri, uriPrefix))
+		if err != nil {
+			return nil, mcp.ResourceNotFoundError(uri)
+		}
+
+		r.lock.Lock()
+		current, ok := r.snapshots[number]
+		r.lock.Unlock()
+
+		if !ok {
+			return nil, mcp.ResourceNotFoundError(uri)
+		}
+
+		select {
+		case <-current.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		if current.err != nil {
+			return nil, fmt.Errorf("failed to render figure %d: %w", number, current.err)
+		}
+
+		return &mcp.ReadResourceResult{
+			Contents: []*mcp.ResourceContents{{
+				URI:      uri,
+				MIMEType: pngMIMEType,
+				Blob:     current.png,
+			}},
+		}, nil
+	}
+}
+
+func figureURI(number int) string {
+	return uriPrefix + strconv.Itoa(number)
+}
+
+func figureName(figure listmatlabfigures.Figure) string {
+	if figure.Name != "" {
+		return fmt.Sprintf("Figure %d: %s", figure.Number, figure.Name)
+	}
+	return fmt.Sprintf("Figure %d", figure.Number)
+}
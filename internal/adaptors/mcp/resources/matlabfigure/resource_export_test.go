@@ -0,0 +1,15 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabfigure
+
+import (
+	"context"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func (r *Resource) SetNotifier(notify func(ctx context.Context, params *mcp.ResourceUpdatedNotificationParams) error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.notify = notify
+}
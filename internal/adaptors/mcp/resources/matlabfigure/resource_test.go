@@ -0,0 +1,479 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabfigure_test
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabfigure"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listmatlabfigures"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/rendermatlabfigure"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/resources/matlabfigure"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type notificationCollector struct {
+	mu   sync.Mutex
+	uris []string
+}
+
+func (c *notificationCollector) notify(ctx context.Context, params *mcp.ResourceUpdatedNotificationParams) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.uris = append(c.uris, params.URI)
+	return nil
+}
+
+func (c *notificationCollector) received() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return slices.Clone(c.uris)
+}
+
+func readFigure(ctx context.Context, resource *matlabfigure.Resource, uri string) (*mcp.ReadResourceResult, error) {
+	return resource.Handler()(ctx, &mcp.ReadResourceRequest{Params: &mcp.ReadResourceParams{URI: uri}})
+}
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockListUsecase := &mocks.MockListUsecase{}
+	defer mockListUsecase.AssertExpectations(t)
+
+	mockRenderUsecase := &mocks.MockRenderUsecase{}
+	defer mockRenderUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	resource := matlabfigure.New(mockLoggerFactory, mockConfig, mockListUsecase, mockRenderUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, resource)
+}
+
+func TestResource_AddToServer_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockListUsecase := &mocks.MockListUsecase{}
+	defer mockListUsecase.AssertExpectations(t)
+
+	mockRenderUsecase := &mocks.MockRenderUsecase{}
+	defer mockRenderUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+	resource := matlabfigure.New(mockLoggerFactory, mockConfig, mockListUsecase, mockRenderUsecase, mockGlobalMATLAB)
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-server"}, nil)
+
+	// Act
+	err := resource.AddToServer(server)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestResource_Submit_Disabled(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockListUsecase := &mocks.MockListUsecase{}
+	defer mockListUsecase.AssertExpectations(t)
+
+	mockRenderUsecase := &mocks.MockRenderUsecase{}
+	defer mockRenderUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockConfig.EXPECT().
+		FigureResolution().
+		Return(0).
+		Once()
+
+	resource := matlabfigure.New(mockLoggerFactory, mockConfig, mockListUsecase, mockRenderUsecase, mockGlobalMATLAB)
+
+	// Act
+	links, err := resource.Submit(t.Context(), mockLogger, mockClient)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, links)
+}
+
+func TestResource_Submit_RendersInBackground(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockListUsecase := &mocks.MockListUsecase{}
+	defer mockListUsecase.AssertExpectations(t)
+
+	mockRenderUsecase := &mocks.MockRenderUsecase{}
+	defer mockRenderUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+	ctx := t.Context()
+	collector := &notificationCollector{}
+
+	mockConfig.EXPECT().
+		FigureResolution().
+		Return(150).
+		Once()
+
+	mockListUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(listmatlabfigures.ReturnArgs{Figures: []listmatlabfigures.Figure{
+			{Number: 1},
+			{Number: 3, Name: "Residuals"},
+		}}, nil).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(mock.Anything, mock.Anything).
+		Return(mockClient, nil).
+		Times(2)
+
+	mockRenderUsecase.EXPECT().
+		Execute(mock.Anything, mock.Anything, mockClient, rendermatlabfigure.Args{Number: 1}).
+		Return(rendermatlabfigure.ReturnArgs{PNG: []byte("figure 1")}, nil).
+		Once()
+
+	mockRenderUsecase.EXPECT().
+		Execute(mock.Anything, mock.Anything, mockClient, rendermatlabfigure.Args{Number: 3}).
+		Return(rendermatlabfigure.ReturnArgs{PNG: []byte("figure 3")}, nil).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	resource := matlabfigure.New(mockLoggerFactory, mockConfig, mockListUsecase, mockRenderUsecase, mockGlobalMATLAB)
+	resource.SetNotifier(collector.notify)
+
+	// Act
+	links, err := resource.Submit(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []tools.ResourceLink{
+		{URI: "matlab://figures/1", Name: "Figure 1", MIMEType: "image/png"},
+		{URI: "matlab://figures/3", Name: "Figure 3: Residuals", MIMEType: "image/png"},
+	}, links)
+
+	require.Eventually(t, func() bool { return len(collector.received()) == 2 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"matlab://figures/1", "matlab://figures/3"}, collector.received(), "Figures should be rendered in order")
+
+	result, err := readFigure(ctx, resource, "matlab://figures/3")
+	require.NoError(t, err)
+	require.Len(t, result.Contents, 1)
+	assert.Equal(t, "image/png", result.Contents[0].MIMEType)
+	assert.Equal(t, []byte("figure 3"), result.Contents[0].Blob)
+}
+
+func TestResource_Submit_ListError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockListUsecase := &mocks.MockListUsecase{}
+	defer mockListUsecase.AssertExpectations(t)
+
+	mockRenderUsecase := &mocks.MockRenderUsecase{}
+	defer mockRenderUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		FigureResolution().
+		Return(150).
+		Once()
+
+	mockListUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(listmatlabfigures.ReturnArgs{}, assert.AnError).
+		Once()
+
+	resource := matlabfigure.New(mockLoggerFactory, mockConfig, mockListUsecase, mockRenderUsecase, mockGlobalMATLAB)
+
+	// Act
+	links, err := resource.Submit(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, links)
+}
+
+func TestResource_Handler_WaitsForRendering(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockListUsecase := &mocks.MockListUsecase{}
+	defer mockListUsecase.AssertExpectations(t)
+
+	mockRenderUsecase := &mocks.MockRenderUsecase{}
+	defer mockRenderUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+	ctx := t.Context()
+	release := make(chan struct{})
+
+	mockConfig.EXPECT().
+		FigureResolution().
+		Return(150).
+		Once()
+
+	mockListUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(listmatlabfigures.ReturnArgs{Figures: []listmatlabfigures.Figure{{Number: 1}}}, nil).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(mock.Anything, mock.Anything).
+		Return(mockClient, nil).
+		Once()
+
+	mockRenderUsecase.EXPECT().
+		Execute(mock.Anything, mock.Anything, mockClient, rendermatlabfigure.Args{Number: 1}).
+		RunAndReturn(func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, args rendermatlabfigure.Args) (rendermatlabfigure.ReturnArgs, error) {
+			<-release
+			return rendermatlabfigure.ReturnArgs{PNG: []byte("figure 1")}, nil
+		}).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Times(2)
+
+	resource := matlabfigure.New(mockLoggerFactory, mockConfig, mockListUsecase, mockRenderUsecase, mockGlobalMATLAB)
+
+	_, err := resource.Submit(ctx, mockLogger, mockClient)
+	require.NoError(t, err)
+
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	// Act
+	_, cancelledErr := readFigure(cancelledCtx, resource, "matlab://figures/1")
+	close(release)
+	result, err := readFigure(ctx, resource, "matlab://figures/1")
+
+	// Assert
+	require.ErrorIs(t, cancelledErr, context.Canceled, "A read should wait for the rendering")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("figure 1"), result.Contents[0].Blob)
+}
+
+func TestResource_Handler_RenderError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockListUsecase := &mocks.MockListUsecase{}
+	defer mockListUsecase.AssertExpectations(t)
+
+	mockRenderUsecase := &mocks.MockRenderUsecase{}
+	defer mockRenderUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		FigureResolution().
+		Return(150).
+		Once()
+
+	mockListUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(listmatlabfigures.ReturnArgs{Figures: []listmatlabfigures.Figure{{Number: 1}}}, nil).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(mock.Anything, mock.Anything).
+		Return(mockClient, nil).
+		Once()
+
+	mockRenderUsecase.EXPECT().
+		Execute(mock.Anything, mock.Anything, mockClient, rendermatlabfigure.Args{Number: 1}).
+		Return(rendermatlabfigure.ReturnArgs{}, assert.AnError).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	resource := matlabfigure.New(mockLoggerFactory, mockConfig, mockListUsecase, mockRenderUsecase, mockGlobalMATLAB)
+
+	_, err := resource.Submit(ctx, mockLogger, mockClient)
+	require.NoError(t, err)
+
+	// Act
+	result, err := readFigure(ctx, resource, "matlab://figures/1")
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, result)
+	assert.Contains(t, mockLogger.WarnLogs(), "Failed to render figure")
+}
+
+func TestResource_Handler_UnknownFigure(t *testing.T) {
+	testCases := []struct {
+		name string
+		uri  string
+	}{
+		{name: "not rendered", uri: "matlab://figures/7"},
+		{name: "not a number", uri: "matlab://figures/abc"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockLoggerFactory := &mocks.MockLoggerFactory{}
+			defer mockLoggerFactory.AssertExpectations(t)
+
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockListUsecase := &mocks.MockListUsecase{}
+			defer mockListUsecase.AssertExpectations(t)
+
+			mockRenderUsecase := &mocks.MockRenderUsecase{}
+			defer mockRenderUsecase.AssertExpectations(t)
+
+			mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+			defer mockGlobalMATLAB.AssertExpectations(t)
+
+			mockLoggerFactory.EXPECT().
+				GetGlobalLogger().
+				Return(mockLogger).
+				Once()
+
+			mockLoggerFactory.EXPECT().
+				NewMCPSessionLogger(mock.Anything).
+				Return(mockLogger).
+				Once()
+
+			resource := matlabfigure.New(mockLoggerFactory, mockConfig, mockListUsecase, mockRenderUsecase, mockGlobalMATLAB)
+
+			// Act
+			result, err := readFigure(t.Context(), resource, testCase.uri)
+
+			// Assert
+			require.Error(t, err)
+			assert.Nil(t, result)
+		})
+	}
+}
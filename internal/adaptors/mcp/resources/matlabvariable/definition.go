@@ -0,0 +1,15 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabvariable
+
+const (
+	uriTemplate = "matlab://workspace/{name}"
+	uriPrefix   = "matlab://workspace/"
+	name        = "matlab-variable"
+	title       = "MATLAB Workspace Variable"
+	description = "A variable (`name`) of the workspace of the MATLAB session. Small variables are returned as typed JSON text, holding the class and size of the value and of its elements, with the elements of arrays in column-major order. Variables larger than the binary threshold, or without a typed JSON representation, are saved to a MAT-file, which keeps their exact values, and a JSON reference to the file is returned instead: its artifact URI, path, MIME type, size and SHA-256 hash. The `_meta` field of the contents holds the class, size, number of bytes and encoding of the variable."
+
+	jsonMIMEType = "application/json"
+
+	methodListResources = "resources/list"
+)
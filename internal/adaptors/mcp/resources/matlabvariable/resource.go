@@ -0,0 +1,208 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabvariable
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getmatlabvariable"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listmatlabvariables"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/clientidentity"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type LoggerFactory interface {
+	NewMCPSessionLogger(session *mcp.ServerSession) entities.Logger
+}
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request getmatlabvariable.Args) (getmatlabvariable.ReturnArgs, error)
+}
+
+type ListUsecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) (listmatlabvariables.ReturnArgs, error)
+}
+
+// artifactReference points to the MAT-file holding a variable, in the shared artifact directory.
+type artifactReference struct {
+	URI      string `json:"uri"`
+	Path     string `json:"path"`
+	MIMEType string `json:"mimeType"`
+	Bytes    int64  `json:"bytes"`
+	SHA256   string `json:"sha256"`
+}
+
+// Resource exposes the variables of the workspace of the global MATLAB session, so that clients can read large arrays
+// without printing them in the output of evaluate_matlab_code. The variables are listed with the other resources.
+type Resource struct {
+	handler    mcp.ResourceHandler
+	middleware mcp.Middleware
+}
+
+func New(
+	loggerFactory LoggerFactory,
+	usecase Usecase,
+	listUsecase ListUsecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Resource {
+	return &Resource{
+		handler:    Handler(loggerFactory, usecase, globalMATLAB),
+		middleware: ListingMiddleware(loggerFactory, listUsecase, globalMATLAB),
+	}
+}
+
+func (r *Resource) AddToServer(server *mcp.Server) error {
+	server.AddResourceTemplate(&mcp.ResourceTemplate{
+		URITemplate: uriTemplate,
+		Name:        name,
+		Title:       title,
+		Description: description,
+	}, r.handler)
+
+	// The SDK only lists the resources added to the server, and a template is not one, so the variables are added to
+	// the resources it lists.
+	server.AddReceivingMiddleware(r.middleware)
+
+	return nil
+}
+
+func Handler(loggerFactory LoggerFactory, usecase Usecase, globalMATLAB entities.GlobalMATLAB) mcp.ResourceHandler {
+	return func(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+		uri := req.Params.URI
+		sessionLogger := loggerFactory.NewMCPSessionLogger(req.Session).With("resource-uri", uri)
+		if identity, ok := clientidentity.FromContext(ctx); ok {
+			sessionLogger = sessionLogger.With(clientidentity.UserLogKey, identity.User).With(clientidentity.ClientLogKey, identity.Client)
+		}
+
+		sessionLogger.Info("Reading MATLAB variable resource")
+		defer sessionLogger.Info("Done - Reading MATLAB variable resource")
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return nil, err
+		}
+
+		variable, err := usecase.Execute(ctx, sessionLogger, client, getmatlabvariable.Args{
+			Name: strings.TrimPrefix(uri, uriPrefix),
+		})
+		if err != nil {
+			sessionLogger.WithError(err).Warn("Failed to read MATLAB variable")
+			return nil, err
+		}
+
+		contents := &mcp.ResourceContents{
+			URI: uri,
+			Meta: mcp.Meta{
+				"name":     variable.Name,
+				"class":    variable.Class,
+				"size":     variable.Size,
+				"bytes":    variable.Bytes,
+				"encoding": string(variable.Encoding),
+			},
+		}
+
+		contents.MIMEType = jsonMIMEType
+		contents.Text = string(variable.Data)
+
+		// MAT-files are not inlined: the client reads them from the artifact directory, or with the artifact resource.
+		if variable.Encoding == getmatlabvariable.EncodingMAT {
+			reference, err := json.Marshal(artifactReference{
+				URI:      variable.Artifact.URI,
+				Path:     variable.Artifact.Path,
+				MIMEType: variable.Artifact.MIMEType,
+				Bytes:    variable.Artifact.Bytes,
+				SHA256:   variable.Artifact.SHA256,
+			})
+			if err != nil {
+				return nil, err
+			}
+			contents.Text = string(reference)
+			contents.Meta["artifact"] = variable.Artifact.URI
+		}
+
+		return &mcp.ReadResourceResult{
+			Contents: []*mcp.ResourceContents{contents},
+		}, nil
+	}
+}
+
+// ListingMiddleware adds a resource for each variable of the workspace to the first page of resources/list, with its
+// class and size, so that clients see the state of the workspace without evaluating code. The other resources are
+// still listed when the variables cannot be.
+func ListingMiddleware(loggerFactory LoggerFactory, listUsecase ListUsecase, globalMATLAB entities.GlobalMATLAB) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			result, err := next(ctx, method, req)
+			if err != nil || method != methodListResources {
+				return result, err
+			}
+
+			listResult, ok := result.(*mcp.ListResourcesResult)
+			if !ok || listResult == nil {
+				return result, err
+			}
+
+			// Variables are only listed once, on the first page.
+			if params, ok := req.GetParams().(*mcp.ListResourcesParams); ok && params != nil && params.Cursor != "" {
+				return result, err
+			}
+
+			sessionLogger := loggerFactory.NewMCPSessionLogger(sessionOf(req))
+			if identity, ok := clientidentity.FromContext(ctx); ok {
+				sessionLogger = sessionLogger.With(clientidentity.UserLogKey, identity.User).With(clientidentity.ClientLogKey, identity.Client)
+			}
+
+			client, clientErr := globalMATLAB.Client(ctx, sessionLogger)
+			if clientErr != nil {
+				sessionLogger.WithError(clientErr).Warn("Failed to list MATLAB variables")
+				return result, err
+			}
+
+			listed, listErr := listUsecase.Execute(ctx, sessionLogger, client)
+			if listErr != nil {
+				sessionLogger.WithError(listErr).Warn("Failed to list MATLAB variables")
+				return result, err
+			}
+
+			for _, variable := range listed.Variables {
+				listResult.Resources = append(listResult.Resources, variableResource(variable))
+			}
+
+			return result, err
+		}
+	}
+}
+
+func sessionOf(req mcp.Request) *mcp.ServerSession {
+	session, _ := req.GetSession().(*mcp.ServerSession)
+	return session
+}
+
+func variableResource(variable listmatlabvariables.Variable) *mcp.Resource {
+	return &mcp.Resource{
+		URI:         uriPrefix + variable.Name,
+		Name:        variable.Name,
+		Title:       fmt.Sprintf("%s (%s %s)", variable.Name, formatSize(variable.Size), variable.Class),
+		Description: fmt.Sprintf("The %s %s variable %s of the workspace of the MATLAB session.", formatSize(variable.Size), variable.Class, variable.Name),
+		MIMEType:    jsonMIMEType,
+		Meta: mcp.Meta{
+			"class": variable.Class,
+			"size":  variable.Size,
+			"bytes": variable.Bytes,
+		},
+	}
+}
+
+// formatSize formats the size of a variable as MATLAB displays it, such as 1x3.
+func formatSize(size []int) string {
+	dimensions := make([]string, 0, len(size))
+	for _, dimension := range size {
+		dimensions = append(dimensions, strconv.Itoa(dimension))
+	}
+	return strings.Join(dimensions, "x")
+}
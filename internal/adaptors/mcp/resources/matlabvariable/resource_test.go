@@ -0,0 +1,432 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabvariable_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabvariable"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getmatlabvariable"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listmatlabvariables"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/artifactstore"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/resources/matlabvariable"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockListUsecase := &mocks.MockListUsecase{}
+	defer mockListUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	// Act
+	resource := matlabvariable.New(mockLoggerFactory, mockUsecase, mockListUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, resource)
+}
+
+func TestResource_AddToServer_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockListUsecase := &mocks.MockListUsecase{}
+	defer mockListUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	resource := matlabvariable.New(mockLoggerFactory, mockUsecase, mockListUsecase, mockGlobalMATLAB)
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-server"}, nil)
+
+	// Act
+	err := resource.AddToServer(server)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestHandler_JSONVariable(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const uri = "matlab://workspace/x"
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockClient, getmatlabvariable.Args{Name: "x"}).
+		Return(getmatlabvariable.ReturnArgs{
+			Name:     "x",
+			Class:    "double",
+			Size:     []int{1, 3},
+			Bytes:    24,
+			Encoding: getmatlabvariable.EncodingTyped,
+			Data:     []byte(`{"class":"double","size":[1,3],"data":[1,2,3]}`),
+		}, nil).
+		Once()
+
+	handler := matlabvariable.Handler(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Act
+	result, err := handler(ctx, &mcp.ReadResourceRequest{Params: &mcp.ReadResourceParams{URI: uri}})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.Contents, 1)
+	contents := result.Contents[0]
+	assert.Equal(t, uri, contents.URI)
+	assert.Equal(t, "application/json", contents.MIMEType)
+	assert.Equal(t, `{"class":"double","size":[1,3],"data":[1,2,3]}`, contents.Text)
+	assert.Nil(t, contents.Blob)
+	assert.Equal(t, mcp.Meta{
+		"name":     "x",
+		"class":    "double",
+		"size":     []int{1, 3},
+		"bytes":    24,
+		"encoding": "typed",
+	}, contents.Meta)
+}
+
+func TestHandler_MATVariable(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const uri = "matlab://workspace/bigArray"
+	artifact := artifactstore.Artifact{
+		Name:     "tp1234.mat",
+		URI:      "matlab://artifacts/tp1234.mat",
+		Path:     "/tmp/matlab-mcp-core-server-123/artifacts-456/tp1234.mat",
+		MIMEType: "application/x-matlab-data",
+		Bytes:    2048,
+		SHA256:   "80fbccf21e8b41709a1790cc6921aedcbbb677bb315070e48a313e941de207b0",
+	}
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockClient, getmatlabvariable.Args{Name: "bigArray"}).
+		Return(getmatlabvariable.ReturnArgs{
+			Name:     "bigArray",
+			Class:    "single",
+			Size:     []int{1000, 1000},
+			Bytes:    4000000,
+			Encoding: getmatlabvariable.EncodingMAT,
+			Artifact: artifact,
+		}, nil).
+		Once()
+
+	handler := matlabvariable.Handler(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Act
+	result, err := handler(ctx, &mcp.ReadResourceRequest{Params: &mcp.ReadResourceParams{URI: uri}})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.Contents, 1)
+	contents := result.Contents[0]
+	assert.Equal(t, "application/json", contents.MIMEType)
+	assert.JSONEq(t, `{"uri":"matlab://artifacts/tp1234.mat","path":"/tmp/matlab-mcp-core-server-123/artifacts-456/tp1234.mat","mimeType":"application/x-matlab-data","bytes":2048,"sha256":"80fbccf21e8b41709a1790cc6921aedcbbb677bb315070e48a313e941de207b0"}`, contents.Text)
+	assert.Nil(t, contents.Blob, "MAT-files should not be inlined")
+	assert.Equal(t, "mat", contents.Meta["encoding"])
+	assert.Equal(t, "matlab://artifacts/tp1234.mat", contents.Meta["artifact"])
+}
+
+func TestHandler_ClientError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, assert.AnError).
+		Once()
+
+	handler := matlabvariable.Handler(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Act
+	result, err := handler(ctx, &mcp.ReadResourceRequest{Params: &mcp.ReadResourceParams{URI: "matlab://workspace/x"}})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, result)
+}
+
+func TestHandler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockClient, getmatlabvariable.Args{Name: "missing"}).
+		Return(getmatlabvariable.ReturnArgs{}, assert.AnError).
+		Once()
+
+	handler := matlabvariable.Handler(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Act
+	result, err := handler(ctx, &mcp.ReadResourceRequest{Params: &mcp.ReadResourceParams{URI: "matlab://workspace/missing"}})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, result)
+	assert.Contains(t, mockLogger.WarnLogs(), "Failed to read MATLAB variable")
+}
+
+// listedResources is the next handler of the listing middleware, listing the resources added to the server.
+func listedResources(context.Context, string, mcp.Request) (mcp.Result, error) {
+	return &mcp.ListResourcesResult{
+		Resources: []*mcp.Resource{{URI: "matlab://server/events", Name: "events"}},
+	}, nil
+}
+
+func TestListingMiddleware_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockListUsecase := &mocks.MockListUsecase{}
+	defer mockListUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockClient, nil).
+		Once()
+
+	mockListUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(listmatlabvariables.ReturnArgs{
+			Variables: []listmatlabvariables.Variable{
+				{Name: "x", Class: "double", Size: []int{1, 3}, Bytes: 24},
+			},
+		}, nil).
+		Once()
+
+	handler := matlabvariable.ListingMiddleware(mockLoggerFactory, mockListUsecase, mockGlobalMATLAB)(listedResources)
+
+	// Act
+	result, err := handler(ctx, "resources/list", &mcp.ListResourcesRequest{Params: &mcp.ListResourcesParams{}})
+
+	// Assert
+	require.NoError(t, err)
+	listResult, ok := result.(*mcp.ListResourcesResult)
+	require.True(t, ok)
+	require.Len(t, listResult.Resources, 2)
+	assert.Equal(t, "matlab://server/events", listResult.Resources[0].URI, "Resources added to the server should still be listed")
+	assert.Equal(t, &mcp.Resource{
+		URI:         "matlab://workspace/x",
+		Name:        "x",
+		Title:       "x (1x3 double)",
+		Description: "The 1x3 double variable x of the workspace of the MATLAB session.",
+		MIMEType:    "application/json",
+		Meta: mcp.Meta{
+			"class": "double",
+			"size":  []int{1, 3},
+			"bytes": int64(24),
+		},
+	}, listResult.Resources[1])
+}
+
+func TestListingMiddleware_NextPage(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockListUsecase := &mocks.MockListUsecase{}
+	defer mockListUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	handler := matlabvariable.ListingMiddleware(mockLoggerFactory, mockListUsecase, mockGlobalMATLAB)(listedResources)
+
+	// Act
+	result, err := handler(t.Context(), "resources/list", &mcp.ListResourcesRequest{Params: &mcp.ListResourcesParams{Cursor: "next"}})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Len(t, result.(*mcp.ListResourcesResult).Resources, 1, "Variables should only be listed on the first page")
+}
+
+func TestListingMiddleware_OtherMethod(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockListUsecase := &mocks.MockListUsecase{}
+	defer mockListUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	expectedResult := &mcp.CallToolResult{}
+	next := func(context.Context, string, mcp.Request) (mcp.Result, error) {
+		return expectedResult, nil
+	}
+
+	handler := matlabvariable.ListingMiddleware(mockLoggerFactory, mockListUsecase, mockGlobalMATLAB)(next)
+
+	// Act
+	result, err := handler(t.Context(), "tools/call", &mcp.CallToolRequest{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Same(t, expectedResult, result)
+}
+
+func TestListingMiddleware_ListError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockListUsecase := &mocks.MockListUsecase{}
+	defer mockListUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockClient, nil).
+		Once()
+
+	mockListUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(listmatlabvariables.ReturnArgs{}, assert.AnError).
+		Once()
+
+	handler := matlabvariable.ListingMiddleware(mockLoggerFactory, mockListUsecase, mockGlobalMATLAB)(listedResources)
+
+	// Act
+	result, err := handler(ctx, "resources/list", &mcp.ListResourcesRequest{Params: &mcp.ListResourcesParams{}})
+
+	// Assert
+	require.NoError(t, err, "Failing to list the variables should not fail the listing")
+	assert.Len(t, result.(*mcp.ListResourcesResult).Resources, 1)
+	assert.Contains(t, mockLogger.WarnLogs(), "Failed to list MATLAB variables")
+}
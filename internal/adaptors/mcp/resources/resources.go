@@ -0,0 +1,11 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package resources
+
+import (
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type Resource interface {
+	AddToServer(server *mcp.Server) error
+}
@@ -0,0 +1,50 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/auditlog"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/clientidentity"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/correlationid"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// auditMiddleware writes every tool call to the audit log, with the code it ran, the session and client that made it,
+// when it started and how it ended. Like the session recording, it sees the calls rejected by the tool policy and the
+// rate limits, and the results after redaction.
+func auditMiddleware(auditLog AuditLog) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			if method != methodCallTool || !auditLog.Enabled() {
+				return next(ctx, method, req)
+			}
+
+			startedAt := time.Now()
+			result, err := next(ctx, method, req)
+
+			params, ok := req.GetParams().(*mcp.CallToolParamsRaw)
+			if !ok {
+				return result, err
+			}
+
+			callToolResult, _ := result.(*mcp.CallToolResult)
+			identity, _ := clientidentity.FromContext(ctx)
+			correlationID, _ := correlationid.FromContext(ctx)
+			auditLog.RecordToolCall(auditlog.ToolCall{
+				StartedAt:     startedAt,
+				CorrelationID: correlationID,
+				SessionID:     sessionID(req),
+				Identity:      identity,
+				Tool:          params.Name,
+				Arguments:     params.Arguments,
+				Result:        callToolResult,
+				Err:           err,
+			})
+
+			return result, err
+		}
+	}
+}
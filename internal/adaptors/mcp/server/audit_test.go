@@ -0,0 +1,144 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/auditlog"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/clientidentity"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/correlationid"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/server"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditMiddleware_RecordsToolCalls(t *testing.T) {
+	// Arrange
+	mockAuditLog := &mocks.MockAuditLog{}
+	defer mockAuditLog.AssertExpectations(t)
+
+	arguments := json.RawMessage(`{"code":"x = 1"}`)
+	identity := clientidentity.Identity{User: "alice", Client: "claude-code 1.0.0"}
+	expectedResult := &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: "x = 1"}}}
+	beforeCall := time.Now()
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return expectedResult, nil
+	}
+
+	mockAuditLog.EXPECT().
+		Enabled().
+		Return(true).
+		Once()
+
+	var recordedCall auditlog.ToolCall
+	mockAuditLog.EXPECT().
+		RecordToolCall(mock.Anything).
+		Run(func(call auditlog.ToolCall) {
+			recordedCall = call
+		}).
+		Return().
+		Once()
+
+	handler := server.AuditMiddleware(mockAuditLog)(next)
+
+	ctx := correlationid.NewContext(clientidentity.NewContext(t.Context(), identity), "c0ffee")
+
+	// Act
+	result, err := handler(ctx, "tools/call", &mcp.CallToolRequest{
+		Params: &mcp.CallToolParamsRaw{Name: "evaluate_matlab_code", Arguments: arguments},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedResult, result)
+	assert.Equal(t, "evaluate_matlab_code", recordedCall.Tool)
+	assert.Equal(t, arguments, recordedCall.Arguments)
+	assert.Equal(t, identity, recordedCall.Identity)
+	assert.Equal(t, "c0ffee", recordedCall.CorrelationID)
+	assert.Equal(t, expectedResult, recordedCall.Result)
+	assert.NoError(t, recordedCall.Err)
+	assert.False(t, recordedCall.StartedAt.Before(beforeCall))
+}
+
+func TestAuditMiddleware_RecordsErrors(t *testing.T) {
+	// Arrange
+	mockAuditLog := &mocks.MockAuditLog{}
+	defer mockAuditLog.AssertExpectations(t)
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return nil, assert.AnError
+	}
+
+	mockAuditLog.EXPECT().
+		Enabled().
+		Return(true).
+		Once()
+
+	mockAuditLog.EXPECT().
+		RecordToolCall(mock.MatchedBy(func(call auditlog.ToolCall) bool {
+			return call.Tool == "unknown_tool" && call.Result == nil && call.Err == assert.AnError
+		})).
+		Return().
+		Once()
+
+	handler := server.AuditMiddleware(mockAuditLog)(next)
+
+	// Act
+	_, err := handler(t.Context(), "tools/call", &mcp.CallToolRequest{
+		Params: &mcp.CallToolParamsRaw{Name: "unknown_tool"},
+	})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+func TestAuditMiddleware_Disabled(t *testing.T) {
+	// Arrange
+	mockAuditLog := &mocks.MockAuditLog{}
+	defer mockAuditLog.AssertExpectations(t)
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return &mcp.CallToolResult{}, nil
+	}
+
+	mockAuditLog.EXPECT().
+		Enabled().
+		Return(false).
+		Once()
+
+	handler := server.AuditMiddleware(mockAuditLog)(next)
+
+	// Act
+	_, err := handler(t.Context(), "tools/call", &mcp.CallToolRequest{
+		Params: &mcp.CallToolParamsRaw{Name: "evaluate_matlab_code"},
+	})
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestAuditMiddleware_IgnoresOtherMethods(t *testing.T) {
+	// Arrange
+	mockAuditLog := &mocks.MockAuditLog{}
+	defer mockAuditLog.AssertExpectations(t)
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return &mcp.ListToolsResult{}, nil
+	}
+
+	handler := server.AuditMiddleware(mockAuditLog)(next)
+
+	// Act
+	_, err := handler(t.Context(), "tools/list", &mcp.ListToolsRequest{Params: &mcp.ListToolsParams{}})
+
+	// Assert
+	require.NoError(t, err)
+}
@@ -0,0 +1,90 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/clientidentity"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// apiKeyTokenExtra is the field of the token info of the authenticated streamable HTTP requests holding the name of
+// their API key.
+const apiKeyTokenExtra = "apiKey"
+
+// apiKeyMetaKey is the `_meta` field of the tool calls of the REST API and the gRPC service holding the name of the API
+// key their HTTP request was authenticated with, as all their calls share one in-process session.
+const apiKeyMetaKey = "apiKey"
+
+// clientIdentityMiddleware binds the user the server runs for, and the MCP client acting for them, to every incoming request,
+// so that tool calls are attributed in the logs, the events, the session recording and the MATLAB session.
+// When the request was authenticated with an API key, the client is the name of the key. An identity already bound to
+// the request is kept.
+func clientIdentityMiddleware(identityProvider IdentityProvider) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			identity, ok := clientidentity.FromContext(ctx)
+			if !ok {
+				identity.Client, identity.Authenticated = apiKeyName(req)
+				if !identity.Authenticated {
+					identity.Client = clientName(req)
+				}
+			}
+			if identity.User == "" {
+				identity.User = identityProvider.User()
+			}
+
+			return next(clientidentity.NewContext(ctx, identity), method, req)
+		}
+	}
+}
+
+// apiKeyName is the name of the API key req was authenticated with, taken from the token info of the streamable HTTP
+// transport, or from the `_meta` of the tool calls of the in-process sessions, which only the server can connect to.
+func apiKeyName(req mcp.Request) (string, bool) {
+	if extra := req.GetExtra(); extra != nil && extra.TokenInfo != nil {
+		name, ok := extra.TokenInfo.Extra[apiKeyTokenExtra].(string)
+		return name, ok && name != ""
+	}
+
+	if id := sessionID(req); id != grpcSessionID && id != restAPISessionID {
+		return "", false
+	}
+	params, ok := req.GetParams().(*mcp.CallToolParamsRaw)
+	if !ok || params == nil {
+		return "", false
+	}
+	name, ok := params.Meta[apiKeyMetaKey].(string)
+	return name, ok && name != ""
+}
+
+// apiKeyMeta adds the name of the API key the HTTP request of ctx was authenticated with to meta, for the calls the
+// REST API and the gRPC service make on behalf of the request.
+func apiKeyMeta(ctx context.Context, meta mcp.Meta) mcp.Meta {
+	identity, ok := clientidentity.FromContext(ctx)
+	if !ok || !identity.Authenticated {
+		return meta
+	}
+	if meta == nil {
+		meta = mcp.Meta{}
+	}
+	meta[apiKeyMetaKey] = identity.Client
+	return meta
+}
+
+// clientName is the name and version the MCP client sent when it initialized the session, or empty before that.
+func clientName(req mcp.Request) string {
+	session, ok := req.GetSession().(*mcp.ServerSession)
+	if !ok || session == nil {
+		return ""
+	}
+
+	params := session.InitializeParams()
+	if params == nil || params.ClientInfo == nil {
+		return ""
+	}
+
+	return strings.TrimSpace(params.ClientInfo.Name + " " + params.ClientInfo.Version)
+}
@@ -0,0 +1,45 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/clientroots"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// clientRootsMiddleware makes the roots of the client available to tool calls.
+// The roots are only requested from the client if the tool needs them.
+func clientRootsMiddleware(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		if method != methodCallTool {
+			return next(ctx, method, req)
+		}
+
+		session, ok := req.GetSession().(*mcp.ServerSession)
+		if !ok || session == nil {
+			return next(ctx, method, req)
+		}
+
+		ctx = clientroots.NewContext(ctx, func(ctx context.Context) ([]string, error) {
+			return listClientRoots(ctx, session)
+		})
+		return next(ctx, method, req)
+	}
+}
+
+func listClientRoots(ctx context.Context, session *mcp.ServerSession) ([]string, error) {
+	result, err := session.ListRoots(ctx, &mcp.ListRootsParams{})
+	if err != nil {
+		return nil, err
+	}
+
+	roots := make([]string, 0, len(result.Roots))
+	for _, root := range result.Roots {
+		if path, ok := clientroots.PathFromURI(root.URI); ok {
+			roots = append(roots, path)
+		}
+	}
+	return roots, nil
+}
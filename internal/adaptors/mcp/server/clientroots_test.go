@@ -0,0 +1,51 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/clientroots"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientRootsMiddleware_ListsClientRoots(t *testing.T) {
+	// Arrange
+	var roots []string
+	var rootsErr error
+
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test-server"}, nil)
+	mcpServer.AddReceivingMiddleware(server.ClientRootsMiddleware)
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "test-tool"}, func(ctx context.Context, req *mcp.CallToolRequest, input struct{}) (*mcp.CallToolResult, any, error) {
+		roots, rootsErr = clientroots.FromContext(ctx)
+		return &mcp.CallToolResult{}, nil, nil
+	})
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	client.AddRoots(
+		&mcp.Root{URI: "file:///home/user/project"},
+		&mcp.Root{URI: "https://example.com/not-a-folder"},
+	)
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := mcpServer.Connect(t.Context(), serverTransport, nil)
+	require.NoError(t, err)
+	defer func() { _ = serverSession.Close() }()
+
+	clientSession, err := client.Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+	defer func() { _ = clientSession.Close() }()
+
+	// Act
+	_, err = clientSession.CallTool(t.Context(), &mcp.CallToolParams{Name: "test-tool", Arguments: map[string]any{}})
+
+	// Assert
+	require.NoError(t, err)
+	require.NoError(t, rootsErr)
+	assert.Equal(t, []string{filepath.FromSlash("/home/user/project")}, roots, "Only the file roots should be listed")
+}
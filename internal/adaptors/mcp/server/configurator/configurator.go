@@ -3,20 +3,67 @@
 package configurator
 
 import (
+	"slices"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabartifact"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabdrive"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabfigure"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabvariable"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
 	evalmatlabcodemultisession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/evalmatlabcode"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/listavailablematlabs"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/listmatlabsessions"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/startmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/stopmatlabsession"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/deployproductionarchive"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/findmatlabdefinition"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/getmatlabdiagnostics"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/getproductionserverstatus"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/getserverinfo"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/invokeproductionfunction"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/pullfrommatlabdrive"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/pushtomatlabdrive"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/buildrealtimeapplication"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/canceljob"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/capturematlabfigures"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/checkmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/checkpythonpackages"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/controlrealtimeapplication"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/deployrealtimeapplication"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/detectmatlabtoolboxes"
 	evalmatlabcodesinglesession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/evalmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/exportlivescript"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/getjoboutput"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/getjobstatus"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/getmatlabvariable"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/getpythonenvironment"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/getsimulinkblockparameters"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/listmatfilevariables"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/loadmatfilevariables"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/loadsimulinkmodel"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/packageproductionarchive"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabfile"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabtestfile"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabtests"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runpythoncode"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/setmatlabvariable"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/setpythonenvironment"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/setsimulinkparameter"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/simulatesimulinkmodel"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/startjob"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/streamrealtimesignals"
 )
 
 type Config interface {
 	UseSingleMATLABSession() bool
+	ReadOnly() bool
+	MATLABDriveFolder() string
+	RealTimeTargets() []string
+	ProductionServerURL() string
+	ProductionServerDeployFolder() string
+	DryRun() bool
+	DisabledTools() []string
 }
 
 type Configurator struct {
@@ -25,15 +72,58 @@ type Configurator struct {
 	// Multi Session
 	listAvailableMATLABsTool tools.Tool
 	startMATLABSessionTool   tools.Tool
+	listMATLABSessionsTool   tools.Tool
 	stopMATLABSessionTool    tools.Tool
 	evalInMATLABSessionTool  tools.Tool
 
 	// Single Session
-	evalInGlobalMATLABSessionTool                  tools.Tool
-	checkMATLABCodeInGlobalMATLABSessionTool       tools.Tool
-	detectMATLABToolboxesInGlobalMATLABSessionTool tools.Tool
-	runMATLABFileInGlobalMATLABSessionTool         tools.Tool
-	runMATLABTestFileInGlobalMATLABSessionTool     tools.Tool
+	evalInGlobalMATLABSessionTool                       tools.Tool
+	checkMATLABCodeInGlobalMATLABSessionTool            tools.Tool
+	detectMATLABToolboxesInGlobalMATLABSessionTool      tools.Tool
+	runMATLABFileInGlobalMATLABSessionTool              tools.Tool
+	runMATLABTestFileInGlobalMATLABSessionTool          tools.Tool
+	runMATLABTestsInGlobalMATLABSessionTool             tools.Tool
+	startJobInGlobalMATLABSessionTool                   tools.Tool
+	getJobStatusInGlobalMATLABSessionTool               tools.Tool
+	getJobOutputInGlobalMATLABSessionTool               tools.Tool
+	cancelJobInGlobalMATLABSessionTool                  tools.Tool
+	getPythonEnvironmentInGlobalMATLABSessionTool       tools.Tool
+	setPythonEnvironmentInGlobalMATLABSessionTool       tools.Tool
+	checkPythonPackagesInGlobalMATLABSessionTool        tools.Tool
+	runPythonCodeInGlobalMATLABSessionTool              tools.Tool
+	exportLiveScriptInGlobalMATLABSessionTool           tools.Tool
+	captureMATLABFiguresInGlobalMATLABSessionTool       tools.Tool
+	getMATLABVariableInGlobalMATLABSessionTool          tools.Tool
+	setMATLABVariableInGlobalMATLABSessionTool          tools.Tool
+	listMATFileVariablesInGlobalMATLABSessionTool       tools.Tool
+	loadMATFileVariablesInGlobalMATLABSessionTool       tools.Tool
+	loadSimulinkModelInGlobalMATLABSessionTool          tools.Tool
+	simulateSimulinkModelInGlobalMATLABSessionTool      tools.Tool
+	getSimulinkBlockParametersInGlobalMATLABSessionTool tools.Tool
+	setSimulinkParameterInGlobalMATLABSessionTool       tools.Tool
+	buildRealTimeApplicationInGlobalMATLABSessionTool   tools.Tool
+	deployRealTimeApplicationInGlobalMATLABSessionTool  tools.Tool
+	controlRealTimeApplicationInGlobalMATLABSessionTool tools.Tool
+	streamRealTimeSignalsInGlobalMATLABSessionTool      tools.Tool
+	packageProductionArchiveInGlobalMATLABSessionTool   tools.Tool
+
+	// Sessionless, as they analyze MATLAB files without MATLAB
+	getMATLABCodeDiagnosticsTool  tools.Tool
+	findMATLABDefinitionTool      tools.Tool
+	pullFromMATLABDriveTool       tools.Tool
+	pushToMATLABDriveTool         tools.Tool
+	deployProductionArchiveTool   tools.Tool
+	invokeProductionFunctionTool  tools.Tool
+	getProductionServerStatusTool tools.Tool
+	getServerInfoTool             tools.Tool
+
+	// Plugins and downstream servers, which provide tools outside of the server
+	toolProviders []tools.ToolProvider
+
+	matlabVariableInGlobalMATLABSessionResource resources.Resource
+	matlabFigureInGlobalMATLABSessionResource   resources.Resource
+	matlabArtifactResource                      resources.Resource
+	matlabDriveResource                         resources.Resource
 }
 
 func New(
@@ -41,6 +131,7 @@ func New(
 
 	listAvailableMATLABsTool *listavailablematlabs.Tool,
 	startMATLABSessionTool *startmatlabsession.Tool,
+	listMATLABSessionsTool *listmatlabsessions.Tool,
 	stopMATLABSessionTool *stopmatlabsession.Tool,
 	evalInMATLABSessionTool *evalmatlabcodemultisession.Tool,
 
@@ -49,40 +140,320 @@ func New(
 	detectMATLABToolboxesInGlobalMATLABSessionTool *detectmatlabtoolboxes.Tool,
 	runMATLABFileInGlobalMATLABSessionTool *runmatlabfile.Tool,
 	runMATLABTestFileInGlobalMATLABSessionTool *runmatlabtestfile.Tool,
+	runMATLABTestsInGlobalMATLABSessionTool *runmatlabtests.Tool,
+	startJobInGlobalMATLABSessionTool *startjob.Tool,
+	getJobStatusInGlobalMATLABSessionTool *getjobstatus.Tool,
+	getJobOutputInGlobalMATLABSessionTool *getjoboutput.Tool,
+	cancelJobInGlobalMATLABSessionTool *canceljob.Tool,
+	getPythonEnvironmentInGlobalMATLABSessionTool *getpythonenvironment.Tool,
+	setPythonEnvironmentInGlobalMATLABSessionTool *setpythonenvironment.Tool,
+	checkPythonPackagesInGlobalMATLABSessionTool *checkpythonpackages.Tool,
+	runPythonCodeInGlobalMATLABSessionTool *runpythoncode.Tool,
+	exportLiveScriptInGlobalMATLABSessionTool *exportlivescript.Tool,
+	captureMATLABFiguresInGlobalMATLABSessionTool *capturematlabfigures.Tool,
+	getMATLABVariableInGlobalMATLABSessionTool *getmatlabvariable.Tool,
+	setMATLABVariableInGlobalMATLABSessionTool *setmatlabvariable.Tool,
+	listMATFileVariablesInGlobalMATLABSessionTool *listmatfilevariables.Tool,
+	loadMATFileVariablesInGlobalMATLABSessionTool *loadmatfilevariables.Tool,
+	loadSimulinkModelInGlobalMATLABSessionTool *loadsimulinkmodel.Tool,
+	simulateSimulinkModelInGlobalMATLABSessionTool *simulatesimulinkmodel.Tool,
+	getSimulinkBlockParametersInGlobalMATLABSessionTool *getsimulinkblockparameters.Tool,
+	setSimulinkParameterInGlobalMATLABSessionTool *setsimulinkparameter.Tool,
+	buildRealTimeApplicationInGlobalMATLABSessionTool *buildrealtimeapplication.Tool,
+	deployRealTimeApplicationInGlobalMATLABSessionTool *deployrealtimeapplication.Tool,
+	controlRealTimeApplicationInGlobalMATLABSessionTool *controlrealtimeapplication.Tool,
+	streamRealTimeSignalsInGlobalMATLABSessionTool *streamrealtimesignals.Tool,
+	packageProductionArchiveInGlobalMATLABSessionTool *packageproductionarchive.Tool,
+
+	getMATLABCodeDiagnosticsTool *getmatlabdiagnostics.Tool,
+	findMATLABDefinitionTool *findmatlabdefinition.Tool,
+	pullFromMATLABDriveTool *pullfrommatlabdrive.Tool,
+	pushToMATLABDriveTool *pushtomatlabdrive.Tool,
+	deployProductionArchiveTool *deployproductionarchive.Tool,
+	invokeProductionFunctionTool *invokeproductionfunction.Tool,
+	getProductionServerStatusTool *getproductionserverstatus.Tool,
+	getServerInfoTool *getserverinfo.Tool,
+
+	toolProviders []tools.ToolProvider,
+
+	matlabVariableInGlobalMATLABSessionResource *matlabvariable.Resource,
+	matlabFigureInGlobalMATLABSessionResource *matlabfigure.Resource,
+	matlabArtifactResource *matlabartifact.Resource,
+	matlabDriveResource *matlabdrive.Resource,
 ) *Configurator {
 	return &Configurator{
 		config: config,
 
 		listAvailableMATLABsTool: listAvailableMATLABsTool,
 		startMATLABSessionTool:   startMATLABSessionTool,
+		listMATLABSessionsTool:   listMATLABSessionsTool,
 		stopMATLABSessionTool:    stopMATLABSessionTool,
 		evalInMATLABSessionTool:  evalInMATLABSessionTool,
 
-		evalInGlobalMATLABSessionTool:                  evalInGlobalMATLABSessionTool,
-		checkMATLABCodeInGlobalMATLABSessionTool:       checkMATLABCodeInGlobalMATLABSession,
-		detectMATLABToolboxesInGlobalMATLABSessionTool: detectMATLABToolboxesInGlobalMATLABSessionTool,
-		runMATLABFileInGlobalMATLABSessionTool:         runMATLABFileInGlobalMATLABSessionTool,
-		runMATLABTestFileInGlobalMATLABSessionTool:     runMATLABTestFileInGlobalMATLABSessionTool,
+		evalInGlobalMATLABSessionTool:                       evalInGlobalMATLABSessionTool,
+		checkMATLABCodeInGlobalMATLABSessionTool:            checkMATLABCodeInGlobalMATLABSession,
+		detectMATLABToolboxesInGlobalMATLABSessionTool:      detectMATLABToolboxesInGlobalMATLABSessionTool,
+		runMATLABFileInGlobalMATLABSessionTool:              runMATLABFileInGlobalMATLABSessionTool,
+		runMATLABTestFileInGlobalMATLABSessionTool:          runMATLABTestFileInGlobalMATLABSessionTool,
+		runMATLABTestsInGlobalMATLABSessionTool:             runMATLABTestsInGlobalMATLABSessionTool,
+		startJobInGlobalMATLABSessionTool:                   startJobInGlobalMATLABSessionTool,
+		getJobStatusInGlobalMATLABSessionTool:               getJobStatusInGlobalMATLABSessionTool,
+		getJobOutputInGlobalMATLABSessionTool:               getJobOutputInGlobalMATLABSessionTool,
+		cancelJobInGlobalMATLABSessionTool:                  cancelJobInGlobalMATLABSessionTool,
+		getPythonEnvironmentInGlobalMATLABSessionTool:       getPythonEnvironmentInGlobalMATLABSessionTool,
+		setPythonEnvironmentInGlobalMATLABSessionTool:       setPythonEnvironmentInGlobalMATLABSessionTool,
+		checkPythonPackagesInGlobalMATLABSessionTool:        checkPythonPackagesInGlobalMATLABSessionTool,
+		runPythonCodeInGlobalMATLABSessionTool:              runPythonCodeInGlobalMATLABSessionTool,
+		exportLiveScriptInGlobalMATLABSessionTool:           exportLiveScriptInGlobalMATLABSessionTool,
+		captureMATLABFiguresInGlobalMATLABSessionTool:       captureMATLABFiguresInGlobalMATLABSessionTool,
+		getMATLABVariableInGlobalMATLABSessionTool:          getMATLABVariableInGlobalMATLABSessionTool,
+		setMATLABVariableInGlobalMATLABSessionTool:          setMATLABVariableInGlobalMATLABSessionTool,
+		listMATFileVariablesInGlobalMATLABSessionTool:       listMATFileVariablesInGlobalMATLABSessionTool,
+		loadMATFileVariablesInGlobalMATLABSessionTool:       loadMATFileVariablesInGlobalMATLABSessionTool,
+		loadSimulinkModelInGlobalMATLABSessionTool:          loadSimulinkModelInGlobalMATLABSessionTool,
+		simulateSimulinkModelInGlobalMATLABSessionTool:      simulateSimulinkModelInGlobalMATLABSessionTool,
+		getSimulinkBlockParametersInGlobalMATLABSessionTool: getSimulinkBlockParametersInGlobalMATLABSessionTool,
+		setSimulinkParameterInGlobalMATLABSessionTool:       setSimulinkParameterInGlobalMATLABSessionTool,
+		buildRealTimeApplicationInGlobalMATLABSessionTool:   buildRealTimeApplicationInGlobalMATLABSessionTool,
+		deployRealTimeApplicationInGlobalMATLABSessionTool:  deployRealTimeApplicationInGlobalMATLABSessionTool,
+		controlRealTimeApplicationInGlobalMATLABSessionTool: controlRealTimeApplicationInGlobalMATLABSessionTool,
+		streamRealTimeSignalsInGlobalMATLABSessionTool:      streamRealTimeSignalsInGlobalMATLABSessionTool,
+		packageProductionArchiveInGlobalMATLABSessionTool:   packageProductionArchiveInGlobalMATLABSessionTool,
+
+		getMATLABCodeDiagnosticsTool:  getMATLABCodeDiagnosticsTool,
+		findMATLABDefinitionTool:      findMATLABDefinitionTool,
+		pullFromMATLABDriveTool:       pullFromMATLABDriveTool,
+		pushToMATLABDriveTool:         pushToMATLABDriveTool,
+		deployProductionArchiveTool:   deployProductionArchiveTool,
+		invokeProductionFunctionTool:  invokeProductionFunctionTool,
+		getProductionServerStatusTool: getProductionServerStatusTool,
+		getServerInfoTool:             getServerInfoTool,
+
+		toolProviders: toolProviders,
+
+		matlabVariableInGlobalMATLABSessionResource: matlabVariableInGlobalMATLABSessionResource,
+		matlabFigureInGlobalMATLABSessionResource:   matlabFigureInGlobalMATLABSessionResource,
+		matlabArtifactResource:                      matlabArtifactResource,
+		matlabDriveResource:                         matlabDriveResource,
 	}
 }
 
+// GetToolsToAdd returns the tools to expose. The server info tool is always exposed, as it only reports on the server,
+// unless it is disabled like any other tool.
 func (c *Configurator) GetToolsToAdd() []tools.Tool {
+	return c.withoutDisabledTools(c.withProvidedTools(append(c.getBuiltInToolsToAdd(), c.getServerInfoTool)))
+}
+
+// withoutDisabledTools removes the tools disabled with --disable-tool. The list can change while the server runs, so
+// it is read each time the tools are chosen.
+func (c *Configurator) withoutDisabledTools(toolsToAdd []tools.Tool) []tools.Tool {
+	disabledTools := c.config.DisabledTools()
+	if len(disabledTools) == 0 {
+		return toolsToAdd
+	}
+
+	return slices.DeleteFunc(toolsToAdd, func(tool tools.Tool) bool {
+		namedTool, ok := tool.(interface{ Name() string })
+		return ok && slices.Contains(disabledTools, namedTool.Name())
+	})
+}
+
+func (c *Configurator) getBuiltInToolsToAdd() []tools.Tool {
 	// Choose which tool to expose
 
+	if c.config.ReadOnly() {
+		return c.getReadOnlyToolsToAdd()
+	}
+
 	if c.config.UseSingleMATLABSession() {
-		return []tools.Tool{
+		toolsToAdd := append([]tools.Tool{
 			c.evalInGlobalMATLABSessionTool,
 			c.checkMATLABCodeInGlobalMATLABSessionTool,
 			c.detectMATLABToolboxesInGlobalMATLABSessionTool,
 			c.runMATLABFileInGlobalMATLABSessionTool,
 			c.runMATLABTestFileInGlobalMATLABSessionTool,
+			c.runMATLABTestsInGlobalMATLABSessionTool,
+			c.startJobInGlobalMATLABSessionTool,
+			c.getJobStatusInGlobalMATLABSessionTool,
+			c.getJobOutputInGlobalMATLABSessionTool,
+			c.cancelJobInGlobalMATLABSessionTool,
+			c.getPythonEnvironmentInGlobalMATLABSessionTool,
+			c.setPythonEnvironmentInGlobalMATLABSessionTool,
+			c.checkPythonPackagesInGlobalMATLABSessionTool,
+			c.runPythonCodeInGlobalMATLABSessionTool,
+			c.exportLiveScriptInGlobalMATLABSessionTool,
+			c.captureMATLABFiguresInGlobalMATLABSessionTool,
+			c.getMATLABVariableInGlobalMATLABSessionTool,
+			c.setMATLABVariableInGlobalMATLABSessionTool,
+			c.listMATFileVariablesInGlobalMATLABSessionTool,
+			c.loadMATFileVariablesInGlobalMATLABSessionTool,
+			c.loadSimulinkModelInGlobalMATLABSessionTool,
+			c.simulateSimulinkModelInGlobalMATLABSessionTool,
+			c.getSimulinkBlockParametersInGlobalMATLABSessionTool,
+			c.setSimulinkParameterInGlobalMATLABSessionTool,
+			c.getMATLABCodeDiagnosticsTool,
+			c.findMATLABDefinitionTool,
+		}, c.getMATLABDriveToolsToAdd()...)
+		toolsToAdd = append(toolsToAdd, c.getRealTimeToolsToAdd()...)
+
+		// Packaging runs MATLAB Compiler SDK in the MATLAB session.
+		productionServerTools := c.getProductionServerToolsToAdd()
+		if len(productionServerTools) > 0 {
+			productionServerTools = append(productionServerTools, c.packageProductionArchiveInGlobalMATLABSessionTool)
 		}
+
+		return append(toolsToAdd, productionServerTools...)
 	}
 
-	return []tools.Tool{
+	toolsToAdd := append([]tools.Tool{
 		c.listAvailableMATLABsTool,
 		c.startMATLABSessionTool,
+		c.listMATLABSessionsTool,
 		c.stopMATLABSessionTool,
 		c.evalInMATLABSessionTool,
+		c.getMATLABCodeDiagnosticsTool,
+		c.findMATLABDefinitionTool,
+	}, c.getMATLABDriveToolsToAdd()...)
+	return append(toolsToAdd, c.getProductionServerToolsToAdd()...)
+}
+
+// withProvidedTools adds the tools of the plugins and of the downstream servers to the tools of the server. The server
+// cannot tell what these tools do, so in read-only mode and in dry runs, only the tools declared read-only are added.
+// Tools with the name of a tool of the server are not added, so that they cannot replace a tool of the server.
+func (c *Configurator) withProvidedTools(toolsToAdd []tools.Tool) []tools.Tool {
+	names := map[string]bool{}
+	for _, tool := range toolsToAdd {
+		if namedTool, ok := tool.(interface{ Name() string }); ok {
+			names[namedTool.Name()] = true
+		}
+	}
+
+	for _, toolProvider := range c.toolProviders {
+		for _, providedTool := range toolProvider.Tools() {
+			if names[providedTool.Name()] {
+				continue
+			}
+
+			if !providedTool.ReadOnly() && (c.config.ReadOnly() || c.config.DryRun()) {
+				continue
+			}
+
+			toolsToAdd = append(toolsToAdd, providedTool)
+		}
 	}
+
+	return toolsToAdd
+}
+
+// getMATLABDriveToolsToAdd returns the tools copying files from and to MATLAB Drive, when it is configured. They write
+// files, so they are not available in read-only mode.
+func (c *Configurator) getMATLABDriveToolsToAdd() []tools.Tool {
+	if c.config.MATLABDriveFolder() == "" {
+		return nil
+	}
+
+	return []tools.Tool{
+		c.pullFromMATLABDriveTool,
+		c.pushToMATLABDriveTool,
+	}
+}
+
+// getRealTimeToolsToAdd returns the tools driving Simulink Real-Time targets, when targets are configured. They connect
+// to the targets from the MATLAB session, so they need a single session.
+func (c *Configurator) getRealTimeToolsToAdd() []tools.Tool {
+	if len(c.config.RealTimeTargets()) == 0 {
+		return nil
+	}
+
+	return []tools.Tool{
+		c.buildRealTimeApplicationInGlobalMATLABSessionTool,
+		c.deployRealTimeApplicationInGlobalMATLABSessionTool,
+		c.controlRealTimeApplicationInGlobalMATLABSessionTool,
+		c.streamRealTimeSignalsInGlobalMATLABSessionTool,
+	}
+}
+
+// getProductionServerToolsToAdd returns the tools reaching the MATLAB Production Server instance, when it is configured.
+// They call the instance without MATLAB. Archives can only be deployed when the auto_deploy folder of the instance is
+// configured.
+func (c *Configurator) getProductionServerToolsToAdd() []tools.Tool {
+	if c.config.ProductionServerURL() == "" {
+		return nil
+	}
+
+	toolsToAdd := []tools.Tool{
+		c.invokeProductionFunctionTool,
+		c.getProductionServerStatusTool,
+	}
+
+	if c.config.ProductionServerDeployFolder() != "" {
+		toolsToAdd = append(toolsToAdd, c.deployProductionArchiveTool)
+	}
+
+	return toolsToAdd
+}
+
+// getReadOnlyToolsToAdd only returns the tools that neither run MATLAB code provided by the client nor modify files.
+func (c *Configurator) getReadOnlyToolsToAdd() []tools.Tool {
+	if c.config.UseSingleMATLABSession() {
+		toolsToAdd := []tools.Tool{
+			c.checkMATLABCodeInGlobalMATLABSessionTool,
+			c.detectMATLABToolboxesInGlobalMATLABSessionTool,
+			c.getPythonEnvironmentInGlobalMATLABSessionTool,
+			c.captureMATLABFiguresInGlobalMATLABSessionTool,
+			c.getMATLABVariableInGlobalMATLABSessionTool,
+			// Listing the variables of a MAT-file reads their headers only.
+			c.listMATFileVariablesInGlobalMATLABSessionTool,
+			// Reading block parameters neither loads a model nor runs its callbacks.
+			c.getSimulinkBlockParametersInGlobalMATLABSessionTool,
+			c.getMATLABCodeDiagnosticsTool,
+			c.findMATLABDefinitionTool,
+		}
+
+		// Sampling signals neither changes the target nor the application running on it.
+		if len(c.config.RealTimeTargets()) > 0 {
+			toolsToAdd = append(toolsToAdd, c.streamRealTimeSignalsInGlobalMATLABSessionTool)
+		}
+
+		return append(toolsToAdd, c.getReadOnlyProductionServerToolsToAdd()...)
+	}
+
+	// Sessions are only useful to evaluate code, so there is no need to start them.
+	return append([]tools.Tool{
+		c.listAvailableMATLABsTool,
+		c.getMATLABCodeDiagnosticsTool,
+		c.findMATLABDefinitionTool,
+	}, c.getReadOnlyProductionServerToolsToAdd()...)
+}
+
+// getReadOnlyProductionServerToolsToAdd only returns the tool reporting the status of the MATLAB Production Server
+// instance, as the functions of its archives may have side effects.
+func (c *Configurator) getReadOnlyProductionServerToolsToAdd() []tools.Tool {
+	if c.config.ProductionServerURL() == "" {
+		return nil
+	}
+
+	return []tools.Tool{
+		c.getProductionServerStatusTool,
+	}
+}
+
+// GetResourcesToAdd returns the resource templates reading the MATLAB session and MATLAB Drive. Reading a resource never
+// modifies the session, so they are also available in read-only mode.
+func (c *Configurator) GetResourcesToAdd() []resources.Resource {
+	var resourcesToAdd []resources.Resource
+	if c.config.UseSingleMATLABSession() {
+		resourcesToAdd = append(resourcesToAdd,
+			c.matlabVariableInGlobalMATLABSessionResource,
+			c.matlabFigureInGlobalMATLABSessionResource,
+			c.matlabArtifactResource,
+		)
+	}
+
+	// MATLAB Drive is read from its local folder, without MATLAB.
+	if c.config.MATLABDriveFolder() != "" {
+		resourcesToAdd = append(resourcesToAdd, c.matlabDriveResource)
+	}
+
+	return resourcesToAdd
 }
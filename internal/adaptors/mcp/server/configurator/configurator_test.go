@@ -3,20 +3,59 @@
 package configurator_test
 
 import (
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/getsimulinkblockparameters"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/listmatfilevariables"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/loadmatfilevariables"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/loadsimulinkmodel"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/setsimulinkparameter"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/simulatesimulinkmodel"
 	"testing"
 
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabartifact"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabdrive"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabfigure"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabvariable"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server/configurator"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
 	evalmatlabmultisession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/evalmatlabcode"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/listavailablematlabs"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/listmatlabsessions"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/startmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/stopmatlabsession"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/deployproductionarchive"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/findmatlabdefinition"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/getmatlabdiagnostics"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/getproductionserverstatus"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/getserverinfo"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/invokeproductionfunction"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/pullfrommatlabdrive"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/pushtomatlabdrive"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/buildrealtimeapplication"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/canceljob"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/capturematlabfigures"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/checkmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/checkpythonpackages"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/controlrealtimeapplication"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/deployrealtimeapplication"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/detectmatlabtoolboxes"
 	evalmatlabsinglesession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/evalmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/exportlivescript"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/getjoboutput"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/getjobstatus"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/getmatlabvariable"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/getpythonenvironment"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/packageproductionarchive"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabfile"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabtestfile"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabtests"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runpythoncode"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/setmatlabvariable"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/setpythonenvironment"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/startjob"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/streamrealtimesignals"
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/server/configurator"
+	toolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -28,6 +67,7 @@ func TestNew_HappyPath(t *testing.T) {
 
 	listAvailableMATLABsTool := &listavailablematlabs.Tool{}
 	startMATLABSessionTool := &startmatlabsession.Tool{}
+	listMATLABSessionsTool := &listmatlabsessions.Tool{}
 	stopMATLABSessionTool := &stopmatlabsession.Tool{}
 	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
 	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
@@ -35,12 +75,49 @@ func TestNew_HappyPath(t *testing.T) {
 	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
 	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
 	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	runMATLABTestsInGlobalMATLABSessionTool := &runmatlabtests.Tool{}
+	startJobInGlobalMATLABSessionTool := &startjob.Tool{}
+	getJobStatusInGlobalMATLABSessionTool := &getjobstatus.Tool{}
+	getJobOutputInGlobalMATLABSessionTool := &getjoboutput.Tool{}
+	cancelJobInGlobalMATLABSessionTool := &canceljob.Tool{}
+	getPythonEnvironmentInGlobalMATLABSessionTool := &getpythonenvironment.Tool{}
+	setPythonEnvironmentInGlobalMATLABSessionTool := &setpythonenvironment.Tool{}
+	checkPythonPackagesInGlobalMATLABSessionTool := &checkpythonpackages.Tool{}
+	runPythonCodeInGlobalMATLABSessionTool := &runpythoncode.Tool{}
+	exportLiveScriptInGlobalMATLABSessionTool := &exportlivescript.Tool{}
+	captureMATLABFiguresInGlobalMATLABSessionTool := &capturematlabfigures.Tool{}
+	getMATLABVariableInGlobalMATLABSessionTool := &getmatlabvariable.Tool{}
+	setMATLABVariableInGlobalMATLABSessionTool := &setmatlabvariable.Tool{}
+	listMATFileVariablesInGlobalMATLABSessionTool := &listmatfilevariables.Tool{}
+	loadMATFileVariablesInGlobalMATLABSessionTool := &loadmatfilevariables.Tool{}
+	loadSimulinkModelInGlobalMATLABSessionTool := &loadsimulinkmodel.Tool{}
+	simulateSimulinkModelInGlobalMATLABSessionTool := &simulatesimulinkmodel.Tool{}
+	getSimulinkBlockParametersInGlobalMATLABSessionTool := &getsimulinkblockparameters.Tool{}
+	setSimulinkParameterInGlobalMATLABSessionTool := &setsimulinkparameter.Tool{}
+	buildRealTimeApplicationInGlobalMATLABSessionTool := &buildrealtimeapplication.Tool{}
+	deployRealTimeApplicationInGlobalMATLABSessionTool := &deployrealtimeapplication.Tool{}
+	controlRealTimeApplicationInGlobalMATLABSessionTool := &controlrealtimeapplication.Tool{}
+	streamRealTimeSignalsInGlobalMATLABSessionTool := &streamrealtimesignals.Tool{}
+	packageProductionArchiveInGlobalMATLABSessionTool := &packageproductionarchive.Tool{}
+	getMATLABCodeDiagnosticsTool := &getmatlabdiagnostics.Tool{}
+	findMATLABDefinitionTool := &findmatlabdefinition.Tool{}
+	pullFromMATLABDriveTool := &pullfrommatlabdrive.Tool{}
+	pushToMATLABDriveTool := &pushtomatlabdrive.Tool{}
+	deployProductionArchiveTool := &deployproductionarchive.Tool{}
+	invokeProductionFunctionTool := &invokeproductionfunction.Tool{}
+	getProductionServerStatusTool := &getproductionserverstatus.Tool{}
+	getServerInfoTool := &getserverinfo.Tool{}
+	matlabVariableInGlobalMATLABSessionResource := &matlabvariable.Resource{}
+	matlabFigureInGlobalMATLABSessionResource := &matlabfigure.Resource{}
+	matlabArtifactResource := &matlabartifact.Resource{}
+	matlabDriveResource := &matlabdrive.Resource{}
 
 	// Act
 	result := configurator.New(
 		mockConfig,
 		listAvailableMATLABsTool,
 		startMATLABSessionTool,
+		listMATLABSessionsTool,
 		stopMATLABSessionTool,
 		evalInMATLABSessionTool,
 		evalInGlobalMATLABSessionTool,
@@ -48,6 +125,43 @@ func TestNew_HappyPath(t *testing.T) {
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		runMATLABTestsInGlobalMATLABSessionTool,
+		startJobInGlobalMATLABSessionTool,
+		getJobStatusInGlobalMATLABSessionTool,
+		getJobOutputInGlobalMATLABSessionTool,
+		cancelJobInGlobalMATLABSessionTool,
+		getPythonEnvironmentInGlobalMATLABSessionTool,
+		setPythonEnvironmentInGlobalMATLABSessionTool,
+		checkPythonPackagesInGlobalMATLABSessionTool,
+		runPythonCodeInGlobalMATLABSessionTool,
+		exportLiveScriptInGlobalMATLABSessionTool,
+		captureMATLABFiguresInGlobalMATLABSessionTool,
+		getMATLABVariableInGlobalMATLABSessionTool,
+		setMATLABVariableInGlobalMATLABSessionTool,
+		listMATFileVariablesInGlobalMATLABSessionTool,
+		loadMATFileVariablesInGlobalMATLABSessionTool,
+		loadSimulinkModelInGlobalMATLABSessionTool,
+		simulateSimulinkModelInGlobalMATLABSessionTool,
+		getSimulinkBlockParametersInGlobalMATLABSessionTool,
+		setSimulinkParameterInGlobalMATLABSessionTool,
+		buildRealTimeApplicationInGlobalMATLABSessionTool,
+		deployRealTimeApplicationInGlobalMATLABSessionTool,
+		controlRealTimeApplicationInGlobalMATLABSessionTool,
+		streamRealTimeSignalsInGlobalMATLABSessionTool,
+		packageProductionArchiveInGlobalMATLABSessionTool,
+		getMATLABCodeDiagnosticsTool,
+		findMATLABDefinitionTool,
+		pullFromMATLABDriveTool,
+		pushToMATLABDriveTool,
+		deployProductionArchiveTool,
+		invokeProductionFunctionTool,
+		getProductionServerStatusTool,
+		getServerInfoTool,
+		nil,
+		matlabVariableInGlobalMATLABSessionResource,
+		matlabFigureInGlobalMATLABSessionResource,
+		matlabArtifactResource,
+		matlabDriveResource,
 	)
 
 	// Assert
@@ -61,6 +175,7 @@ func TestConfigurator_GetToolsToAdd_MultipleMATLABSession_HappyPath(t *testing.T
 
 	listAvailableMATLABsTool := &listavailablematlabs.Tool{}
 	startMATLABSessionTool := &startmatlabsession.Tool{}
+	listMATLABSessionsTool := &listmatlabsessions.Tool{}
 	stopMATLABSessionTool := &stopmatlabsession.Tool{}
 	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
 	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
@@ -68,16 +183,73 @@ func TestConfigurator_GetToolsToAdd_MultipleMATLABSession_HappyPath(t *testing.T
 	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
 	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
 	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	runMATLABTestsInGlobalMATLABSessionTool := &runmatlabtests.Tool{}
+	startJobInGlobalMATLABSessionTool := &startjob.Tool{}
+	getJobStatusInGlobalMATLABSessionTool := &getjobstatus.Tool{}
+	getJobOutputInGlobalMATLABSessionTool := &getjoboutput.Tool{}
+	cancelJobInGlobalMATLABSessionTool := &canceljob.Tool{}
+	getPythonEnvironmentInGlobalMATLABSessionTool := &getpythonenvironment.Tool{}
+	setPythonEnvironmentInGlobalMATLABSessionTool := &setpythonenvironment.Tool{}
+	checkPythonPackagesInGlobalMATLABSessionTool := &checkpythonpackages.Tool{}
+	runPythonCodeInGlobalMATLABSessionTool := &runpythoncode.Tool{}
+	exportLiveScriptInGlobalMATLABSessionTool := &exportlivescript.Tool{}
+	captureMATLABFiguresInGlobalMATLABSessionTool := &capturematlabfigures.Tool{}
+	getMATLABVariableInGlobalMATLABSessionTool := &getmatlabvariable.Tool{}
+	setMATLABVariableInGlobalMATLABSessionTool := &setmatlabvariable.Tool{}
+	listMATFileVariablesInGlobalMATLABSessionTool := &listmatfilevariables.Tool{}
+	loadMATFileVariablesInGlobalMATLABSessionTool := &loadmatfilevariables.Tool{}
+	loadSimulinkModelInGlobalMATLABSessionTool := &loadsimulinkmodel.Tool{}
+	simulateSimulinkModelInGlobalMATLABSessionTool := &simulatesimulinkmodel.Tool{}
+	getSimulinkBlockParametersInGlobalMATLABSessionTool := &getsimulinkblockparameters.Tool{}
+	setSimulinkParameterInGlobalMATLABSessionTool := &setsimulinkparameter.Tool{}
+	buildRealTimeApplicationInGlobalMATLABSessionTool := &buildrealtimeapplication.Tool{}
+	deployRealTimeApplicationInGlobalMATLABSessionTool := &deployrealtimeapplication.Tool{}
+	controlRealTimeApplicationInGlobalMATLABSessionTool := &controlrealtimeapplication.Tool{}
+	streamRealTimeSignalsInGlobalMATLABSessionTool := &streamrealtimesignals.Tool{}
+	packageProductionArchiveInGlobalMATLABSessionTool := &packageproductionarchive.Tool{}
+	getMATLABCodeDiagnosticsTool := &getmatlabdiagnostics.Tool{}
+	findMATLABDefinitionTool := &findmatlabdefinition.Tool{}
+	pullFromMATLABDriveTool := &pullfrommatlabdrive.Tool{}
+	pushToMATLABDriveTool := &pushtomatlabdrive.Tool{}
+	deployProductionArchiveTool := &deployproductionarchive.Tool{}
+	invokeProductionFunctionTool := &invokeproductionfunction.Tool{}
+	getProductionServerStatusTool := &getproductionserverstatus.Tool{}
+	getServerInfoTool := &getserverinfo.Tool{}
+	matlabVariableInGlobalMATLABSessionResource := &matlabvariable.Resource{}
+	matlabFigureInGlobalMATLABSessionResource := &matlabfigure.Resource{}
+	matlabArtifactResource := &matlabartifact.Resource{}
+	matlabDriveResource := &matlabdrive.Resource{}
+
+	mockConfig.EXPECT().
+		DisabledTools().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ReadOnly().
+		Return(false).
+		Once()
 
 	mockConfig.EXPECT().
 		UseSingleMATLABSession().
 		Return(false).
 		Once()
 
+	mockConfig.EXPECT().
+		MATLABDriveFolder().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		ProductionServerURL().
+		Return("").
+		Once()
+
 	c := configurator.New(
 		mockConfig,
 		listAvailableMATLABsTool,
 		startMATLABSessionTool,
+		listMATLABSessionsTool,
 		stopMATLABSessionTool,
 		evalInMATLABSessionTool,
 		evalInGlobalMATLABSessionTool,
@@ -85,6 +257,43 @@ func TestConfigurator_GetToolsToAdd_MultipleMATLABSession_HappyPath(t *testing.T
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		runMATLABTestsInGlobalMATLABSessionTool,
+		startJobInGlobalMATLABSessionTool,
+		getJobStatusInGlobalMATLABSessionTool,
+		getJobOutputInGlobalMATLABSessionTool,
+		cancelJobInGlobalMATLABSessionTool,
+		getPythonEnvironmentInGlobalMATLABSessionTool,
+		setPythonEnvironmentInGlobalMATLABSessionTool,
+		checkPythonPackagesInGlobalMATLABSessionTool,
+		runPythonCodeInGlobalMATLABSessionTool,
+		exportLiveScriptInGlobalMATLABSessionTool,
+		captureMATLABFiguresInGlobalMATLABSessionTool,
+		getMATLABVariableInGlobalMATLABSessionTool,
+		setMATLABVariableInGlobalMATLABSessionTool,
+		listMATFileVariablesInGlobalMATLABSessionTool,
+		loadMATFileVariablesInGlobalMATLABSessionTool,
+		loadSimulinkModelInGlobalMATLABSessionTool,
+		simulateSimulinkModelInGlobalMATLABSessionTool,
+		getSimulinkBlockParametersInGlobalMATLABSessionTool,
+		setSimulinkParameterInGlobalMATLABSessionTool,
+		buildRealTimeApplicationInGlobalMATLABSessionTool,
+		deployRealTimeApplicationInGlobalMATLABSessionTool,
+		controlRealTimeApplicationInGlobalMATLABSessionTool,
+		streamRealTimeSignalsInGlobalMATLABSessionTool,
+		packageProductionArchiveInGlobalMATLABSessionTool,
+		getMATLABCodeDiagnosticsTool,
+		findMATLABDefinitionTool,
+		pullFromMATLABDriveTool,
+		pushToMATLABDriveTool,
+		deployProductionArchiveTool,
+		invokeProductionFunctionTool,
+		getProductionServerStatusTool,
+		getServerInfoTool,
+		nil,
+		matlabVariableInGlobalMATLABSessionResource,
+		matlabFigureInGlobalMATLABSessionResource,
+		matlabArtifactResource,
+		matlabDriveResource,
 	)
 
 	// Act
@@ -94,8 +303,12 @@ func TestConfigurator_GetToolsToAdd_MultipleMATLABSession_HappyPath(t *testing.T
 	assert.ElementsMatch(t, toolsToAdd, []tools.Tool{
 		listAvailableMATLABsTool,
 		startMATLABSessionTool,
+		listMATLABSessionsTool,
 		stopMATLABSessionTool,
 		evalInMATLABSessionTool,
+		getMATLABCodeDiagnosticsTool,
+		findMATLABDefinitionTool,
+		getServerInfoTool,
 	}, "GetToolsToAdd should return all the injected tools for multi session")
 }
 
@@ -106,6 +319,7 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_HappyPath(t *testing.T)
 
 	listAvailableMATLABsTool := &listavailablematlabs.Tool{}
 	startMATLABSessionTool := &startmatlabsession.Tool{}
+	listMATLABSessionsTool := &listmatlabsessions.Tool{}
 	stopMATLABSessionTool := &stopmatlabsession.Tool{}
 	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
 	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
@@ -113,16 +327,78 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_HappyPath(t *testing.T)
 	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
 	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
 	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	runMATLABTestsInGlobalMATLABSessionTool := &runmatlabtests.Tool{}
+	startJobInGlobalMATLABSessionTool := &startjob.Tool{}
+	getJobStatusInGlobalMATLABSessionTool := &getjobstatus.Tool{}
+	getJobOutputInGlobalMATLABSessionTool := &getjoboutput.Tool{}
+	cancelJobInGlobalMATLABSessionTool := &canceljob.Tool{}
+	getPythonEnvironmentInGlobalMATLABSessionTool := &getpythonenvironment.Tool{}
+	setPythonEnvironmentInGlobalMATLABSessionTool := &setpythonenvironment.Tool{}
+	checkPythonPackagesInGlobalMATLABSessionTool := &checkpythonpackages.Tool{}
+	runPythonCodeInGlobalMATLABSessionTool := &runpythoncode.Tool{}
+	exportLiveScriptInGlobalMATLABSessionTool := &exportlivescript.Tool{}
+	captureMATLABFiguresInGlobalMATLABSessionTool := &capturematlabfigures.Tool{}
+	getMATLABVariableInGlobalMATLABSessionTool := &getmatlabvariable.Tool{}
+	setMATLABVariableInGlobalMATLABSessionTool := &setmatlabvariable.Tool{}
+	listMATFileVariablesInGlobalMATLABSessionTool := &listmatfilevariables.Tool{}
+	loadMATFileVariablesInGlobalMATLABSessionTool := &loadmatfilevariables.Tool{}
+	loadSimulinkModelInGlobalMATLABSessionTool := &loadsimulinkmodel.Tool{}
+	simulateSimulinkModelInGlobalMATLABSessionTool := &simulatesimulinkmodel.Tool{}
+	getSimulinkBlockParametersInGlobalMATLABSessionTool := &getsimulinkblockparameters.Tool{}
+	setSimulinkParameterInGlobalMATLABSessionTool := &setsimulinkparameter.Tool{}
+	buildRealTimeApplicationInGlobalMATLABSessionTool := &buildrealtimeapplication.Tool{}
+	deployRealTimeApplicationInGlobalMATLABSessionTool := &deployrealtimeapplication.Tool{}
+	controlRealTimeApplicationInGlobalMATLABSessionTool := &controlrealtimeapplication.Tool{}
+	streamRealTimeSignalsInGlobalMATLABSessionTool := &streamrealtimesignals.Tool{}
+	packageProductionArchiveInGlobalMATLABSessionTool := &packageproductionarchive.Tool{}
+	getMATLABCodeDiagnosticsTool := &getmatlabdiagnostics.Tool{}
+	findMATLABDefinitionTool := &findmatlabdefinition.Tool{}
+	pullFromMATLABDriveTool := &pullfrommatlabdrive.Tool{}
+	pushToMATLABDriveTool := &pushtomatlabdrive.Tool{}
+	deployProductionArchiveTool := &deployproductionarchive.Tool{}
+	invokeProductionFunctionTool := &invokeproductionfunction.Tool{}
+	getProductionServerStatusTool := &getproductionserverstatus.Tool{}
+	getServerInfoTool := &getserverinfo.Tool{}
+	matlabVariableInGlobalMATLABSessionResource := &matlabvariable.Resource{}
+	matlabFigureInGlobalMATLABSessionResource := &matlabfigure.Resource{}
+	matlabArtifactResource := &matlabartifact.Resource{}
+	matlabDriveResource := &matlabdrive.Resource{}
+
+	mockConfig.EXPECT().
+		DisabledTools().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ReadOnly().
+		Return(false).
+		Once()
 
 	mockConfig.EXPECT().
 		UseSingleMATLABSession().
 		Return(true).
 		Once()
 
+	mockConfig.EXPECT().
+		MATLABDriveFolder().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		RealTimeTargets().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ProductionServerURL().
+		Return("").
+		Once()
+
 	c := configurator.New(
 		mockConfig,
 		listAvailableMATLABsTool,
 		startMATLABSessionTool,
+		listMATLABSessionsTool,
 		stopMATLABSessionTool,
 		evalInMATLABSessionTool,
 		evalInGlobalMATLABSessionTool,
@@ -130,6 +406,43 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_HappyPath(t *testing.T)
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		runMATLABTestsInGlobalMATLABSessionTool,
+		startJobInGlobalMATLABSessionTool,
+		getJobStatusInGlobalMATLABSessionTool,
+		getJobOutputInGlobalMATLABSessionTool,
+		cancelJobInGlobalMATLABSessionTool,
+		getPythonEnvironmentInGlobalMATLABSessionTool,
+		setPythonEnvironmentInGlobalMATLABSessionTool,
+		checkPythonPackagesInGlobalMATLABSessionTool,
+		runPythonCodeInGlobalMATLABSessionTool,
+		exportLiveScriptInGlobalMATLABSessionTool,
+		captureMATLABFiguresInGlobalMATLABSessionTool,
+		getMATLABVariableInGlobalMATLABSessionTool,
+		setMATLABVariableInGlobalMATLABSessionTool,
+		listMATFileVariablesInGlobalMATLABSessionTool,
+		loadMATFileVariablesInGlobalMATLABSessionTool,
+		loadSimulinkModelInGlobalMATLABSessionTool,
+		simulateSimulinkModelInGlobalMATLABSessionTool,
+		getSimulinkBlockParametersInGlobalMATLABSessionTool,
+		setSimulinkParameterInGlobalMATLABSessionTool,
+		buildRealTimeApplicationInGlobalMATLABSessionTool,
+		deployRealTimeApplicationInGlobalMATLABSessionTool,
+		controlRealTimeApplicationInGlobalMATLABSessionTool,
+		streamRealTimeSignalsInGlobalMATLABSessionTool,
+		packageProductionArchiveInGlobalMATLABSessionTool,
+		getMATLABCodeDiagnosticsTool,
+		findMATLABDefinitionTool,
+		pullFromMATLABDriveTool,
+		pushToMATLABDriveTool,
+		deployProductionArchiveTool,
+		invokeProductionFunctionTool,
+		getProductionServerStatusTool,
+		getServerInfoTool,
+		nil,
+		matlabVariableInGlobalMATLABSessionResource,
+		matlabFigureInGlobalMATLABSessionResource,
+		matlabArtifactResource,
+		matlabDriveResource,
 	)
 
 	// Act
@@ -141,6 +454,1241 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_HappyPath(t *testing.T)
 		checkMATLABCodeInGlobalMATLABSession,
 		runMATLABFileInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		runMATLABTestsInGlobalMATLABSessionTool,
 		detectMATLABToolboxesInSingleSessionTool,
+		startJobInGlobalMATLABSessionTool,
+		getJobStatusInGlobalMATLABSessionTool,
+		getJobOutputInGlobalMATLABSessionTool,
+		cancelJobInGlobalMATLABSessionTool,
+		getPythonEnvironmentInGlobalMATLABSessionTool,
+		setPythonEnvironmentInGlobalMATLABSessionTool,
+		checkPythonPackagesInGlobalMATLABSessionTool,
+		runPythonCodeInGlobalMATLABSessionTool,
+		exportLiveScriptInGlobalMATLABSessionTool,
+		captureMATLABFiguresInGlobalMATLABSessionTool,
+		getMATLABVariableInGlobalMATLABSessionTool,
+		setMATLABVariableInGlobalMATLABSessionTool,
+		listMATFileVariablesInGlobalMATLABSessionTool,
+		loadMATFileVariablesInGlobalMATLABSessionTool,
+		loadSimulinkModelInGlobalMATLABSessionTool,
+		simulateSimulinkModelInGlobalMATLABSessionTool,
+		getSimulinkBlockParametersInGlobalMATLABSessionTool,
+		setSimulinkParameterInGlobalMATLABSessionTool,
+		getMATLABCodeDiagnosticsTool,
+		findMATLABDefinitionTool,
+		getServerInfoTool,
 	}, "GetToolsToAdd should all injected tools for single session")
 }
+
+func TestConfigurator_GetToolsToAdd_MultipleMATLABSession_ReadOnly(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	listAvailableMATLABsTool := &listavailablematlabs.Tool{}
+	startMATLABSessionTool := &startmatlabsession.Tool{}
+	listMATLABSessionsTool := &listmatlabsessions.Tool{}
+	stopMATLABSessionTool := &stopmatlabsession.Tool{}
+	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
+	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
+	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
+	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
+	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
+	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	runMATLABTestsInGlobalMATLABSessionTool := &runmatlabtests.Tool{}
+	startJobInGlobalMATLABSessionTool := &startjob.Tool{}
+	getJobStatusInGlobalMATLABSessionTool := &getjobstatus.Tool{}
+	getJobOutputInGlobalMATLABSessionTool := &getjoboutput.Tool{}
+	cancelJobInGlobalMATLABSessionTool := &canceljob.Tool{}
+	getPythonEnvironmentInGlobalMATLABSessionTool := &getpythonenvironment.Tool{}
+	setPythonEnvironmentInGlobalMATLABSessionTool := &setpythonenvironment.Tool{}
+	checkPythonPackagesInGlobalMATLABSessionTool := &checkpythonpackages.Tool{}
+	runPythonCodeInGlobalMATLABSessionTool := &runpythoncode.Tool{}
+	exportLiveScriptInGlobalMATLABSessionTool := &exportlivescript.Tool{}
+	captureMATLABFiguresInGlobalMATLABSessionTool := &capturematlabfigures.Tool{}
+	getMATLABVariableInGlobalMATLABSessionTool := &getmatlabvariable.Tool{}
+	setMATLABVariableInGlobalMATLABSessionTool := &setmatlabvariable.Tool{}
+	listMATFileVariablesInGlobalMATLABSessionTool := &listmatfilevariables.Tool{}
+	loadMATFileVariablesInGlobalMATLABSessionTool := &loadmatfilevariables.Tool{}
+	loadSimulinkModelInGlobalMATLABSessionTool := &loadsimulinkmodel.Tool{}
+	simulateSimulinkModelInGlobalMATLABSessionTool := &simulatesimulinkmodel.Tool{}
+	getSimulinkBlockParametersInGlobalMATLABSessionTool := &getsimulinkblockparameters.Tool{}
+	setSimulinkParameterInGlobalMATLABSessionTool := &setsimulinkparameter.Tool{}
+	buildRealTimeApplicationInGlobalMATLABSessionTool := &buildrealtimeapplication.Tool{}
+	deployRealTimeApplicationInGlobalMATLABSessionTool := &deployrealtimeapplication.Tool{}
+	controlRealTimeApplicationInGlobalMATLABSessionTool := &controlrealtimeapplication.Tool{}
+	streamRealTimeSignalsInGlobalMATLABSessionTool := &streamrealtimesignals.Tool{}
+	packageProductionArchiveInGlobalMATLABSessionTool := &packageproductionarchive.Tool{}
+	getMATLABCodeDiagnosticsTool := &getmatlabdiagnostics.Tool{}
+	findMATLABDefinitionTool := &findmatlabdefinition.Tool{}
+	pullFromMATLABDriveTool := &pullfrommatlabdrive.Tool{}
+	pushToMATLABDriveTool := &pushtomatlabdrive.Tool{}
+	deployProductionArchiveTool := &deployproductionarchive.Tool{}
+	invokeProductionFunctionTool := &invokeproductionfunction.Tool{}
+	getProductionServerStatusTool := &getproductionserverstatus.Tool{}
+	getServerInfoTool := &getserverinfo.Tool{}
+	matlabVariableInGlobalMATLABSessionResource := &matlabvariable.Resource{}
+	matlabFigureInGlobalMATLABSessionResource := &matlabfigure.Resource{}
+	matlabArtifactResource := &matlabartifact.Resource{}
+	matlabDriveResource := &matlabdrive.Resource{}
+
+	mockConfig.EXPECT().
+		DisabledTools().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ReadOnly().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		ProductionServerURL().
+		Return("").
+		Once()
+
+	c := configurator.New(
+		mockConfig,
+		listAvailableMATLABsTool,
+		startMATLABSessionTool,
+		listMATLABSessionsTool,
+		stopMATLABSessionTool,
+		evalInMATLABSessionTool,
+		evalInGlobalMATLABSessionTool,
+		checkMATLABCodeInGlobalMATLABSession,
+		detectMATLABToolboxesInSingleSessionTool,
+		runMATLABFileInGlobalMATLABSessionTool,
+		runMATLABTestFileInGlobalMATLABSessionTool,
+		runMATLABTestsInGlobalMATLABSessionTool,
+		startJobInGlobalMATLABSessionTool,
+		getJobStatusInGlobalMATLABSessionTool,
+		getJobOutputInGlobalMATLABSessionTool,
+		cancelJobInGlobalMATLABSessionTool,
+		getPythonEnvironmentInGlobalMATLABSessionTool,
+		setPythonEnvironmentInGlobalMATLABSessionTool,
+		checkPythonPackagesInGlobalMATLABSessionTool,
+		runPythonCodeInGlobalMATLABSessionTool,
+		exportLiveScriptInGlobalMATLABSessionTool,
+		captureMATLABFiguresInGlobalMATLABSessionTool,
+		getMATLABVariableInGlobalMATLABSessionTool,
+		setMATLABVariableInGlobalMATLABSessionTool,
+		listMATFileVariablesInGlobalMATLABSessionTool,
+		loadMATFileVariablesInGlobalMATLABSessionTool,
+		loadSimulinkModelInGlobalMATLABSessionTool,
+		simulateSimulinkModelInGlobalMATLABSessionTool,
+		getSimulinkBlockParametersInGlobalMATLABSessionTool,
+		setSimulinkParameterInGlobalMATLABSessionTool,
+		buildRealTimeApplicationInGlobalMATLABSessionTool,
+		deployRealTimeApplicationInGlobalMATLABSessionTool,
+		controlRealTimeApplicationInGlobalMATLABSessionTool,
+		streamRealTimeSignalsInGlobalMATLABSessionTool,
+		packageProductionArchiveInGlobalMATLABSessionTool,
+		getMATLABCodeDiagnosticsTool,
+		findMATLABDefinitionTool,
+		pullFromMATLABDriveTool,
+		pushToMATLABDriveTool,
+		deployProductionArchiveTool,
+		invokeProductionFunctionTool,
+		getProductionServerStatusTool,
+		getServerInfoTool,
+		nil,
+		matlabVariableInGlobalMATLABSessionResource,
+		matlabFigureInGlobalMATLABSessionResource,
+		matlabArtifactResource,
+		matlabDriveResource,
+	)
+
+	// Act
+	toolsToAdd := c.GetToolsToAdd()
+
+	// Assert
+	assert.ElementsMatch(t, toolsToAdd, []tools.Tool{
+		listAvailableMATLABsTool,
+		getMATLABCodeDiagnosticsTool,
+		findMATLABDefinitionTool,
+		getServerInfoTool,
+	}, "GetToolsToAdd should only return the read-only tools for multi session")
+}
+
+func TestConfigurator_GetToolsToAdd_SingleMATLABSession_ReadOnly(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	listAvailableMATLABsTool := &listavailablematlabs.Tool{}
+	startMATLABSessionTool := &startmatlabsession.Tool{}
+	listMATLABSessionsTool := &listmatlabsessions.Tool{}
+	stopMATLABSessionTool := &stopmatlabsession.Tool{}
+	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
+	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
+	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
+	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
+	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
+	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	runMATLABTestsInGlobalMATLABSessionTool := &runmatlabtests.Tool{}
+	startJobInGlobalMATLABSessionTool := &startjob.Tool{}
+	getJobStatusInGlobalMATLABSessionTool := &getjobstatus.Tool{}
+	getJobOutputInGlobalMATLABSessionTool := &getjoboutput.Tool{}
+	cancelJobInGlobalMATLABSessionTool := &canceljob.Tool{}
+	getPythonEnvironmentInGlobalMATLABSessionTool := &getpythonenvironment.Tool{}
+	setPythonEnvironmentInGlobalMATLABSessionTool := &setpythonenvironment.Tool{}
+	checkPythonPackagesInGlobalMATLABSessionTool := &checkpythonpackages.Tool{}
+	runPythonCodeInGlobalMATLABSessionTool := &runpythoncode.Tool{}
+	exportLiveScriptInGlobalMATLABSessionTool := &exportlivescript.Tool{}
+	captureMATLABFiguresInGlobalMATLABSessionTool := &capturematlabfigures.Tool{}
+	getMATLABVariableInGlobalMATLABSessionTool := &getmatlabvariable.Tool{}
+	setMATLABVariableInGlobalMATLABSessionTool := &setmatlabvariable.Tool{}
+	listMATFileVariablesInGlobalMATLABSessionTool := &listmatfilevariables.Tool{}
+	loadMATFileVariablesInGlobalMATLABSessionTool := &loadmatfilevariables.Tool{}
+	loadSimulinkModelInGlobalMATLABSessionTool := &loadsimulinkmodel.Tool{}
+	simulateSimulinkModelInGlobalMATLABSessionTool := &simulatesimulinkmodel.Tool{}
+	getSimulinkBlockParametersInGlobalMATLABSessionTool := &getsimulinkblockparameters.Tool{}
+	setSimulinkParameterInGlobalMATLABSessionTool := &setsimulinkparameter.Tool{}
+	buildRealTimeApplicationInGlobalMATLABSessionTool := &buildrealtimeapplication.Tool{}
+	deployRealTimeApplicationInGlobalMATLABSessionTool := &deployrealtimeapplication.Tool{}
+	controlRealTimeApplicationInGlobalMATLABSessionTool := &controlrealtimeapplication.Tool{}
+	streamRealTimeSignalsInGlobalMATLABSessionTool := &streamrealtimesignals.Tool{}
+	packageProductionArchiveInGlobalMATLABSessionTool := &packageproductionarchive.Tool{}
+	getMATLABCodeDiagnosticsTool := &getmatlabdiagnostics.Tool{}
+	findMATLABDefinitionTool := &findmatlabdefinition.Tool{}
+	pullFromMATLABDriveTool := &pullfrommatlabdrive.Tool{}
+	pushToMATLABDriveTool := &pushtomatlabdrive.Tool{}
+	deployProductionArchiveTool := &deployproductionarchive.Tool{}
+	invokeProductionFunctionTool := &invokeproductionfunction.Tool{}
+	getProductionServerStatusTool := &getproductionserverstatus.Tool{}
+	getServerInfoTool := &getserverinfo.Tool{}
+	matlabVariableInGlobalMATLABSessionResource := &matlabvariable.Resource{}
+	matlabFigureInGlobalMATLABSessionResource := &matlabfigure.Resource{}
+	matlabArtifactResource := &matlabartifact.Resource{}
+	matlabDriveResource := &matlabdrive.Resource{}
+
+	mockConfig.EXPECT().
+		DisabledTools().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ReadOnly().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		RealTimeTargets().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ProductionServerURL().
+		Return("").
+		Once()
+
+	c := configurator.New(
+		mockConfig,
+		listAvailableMATLABsTool,
+		startMATLABSessionTool,
+		listMATLABSessionsTool,
+		stopMATLABSessionTool,
+		evalInMATLABSessionTool,
+		evalInGlobalMATLABSessionTool,
+		checkMATLABCodeInGlobalMATLABSession,
+		detectMATLABToolboxesInSingleSessionTool,
+		runMATLABFileInGlobalMATLABSessionTool,
+		runMATLABTestFileInGlobalMATLABSessionTool,
+		runMATLABTestsInGlobalMATLABSessionTool,
+		startJobInGlobalMATLABSessionTool,
+		getJobStatusInGlobalMATLABSessionTool,
+		getJobOutputInGlobalMATLABSessionTool,
+		cancelJobInGlobalMATLABSessionTool,
+		getPythonEnvironmentInGlobalMATLABSessionTool,
+		setPythonEnvironmentInGlobalMATLABSessionTool,
+		checkPythonPackagesInGlobalMATLABSessionTool,
+		runPythonCodeInGlobalMATLABSessionTool,
+		exportLiveScriptInGlobalMATLABSessionTool,
+		captureMATLABFiguresInGlobalMATLABSessionTool,
+		getMATLABVariableInGlobalMATLABSessionTool,
+		setMATLABVariableInGlobalMATLABSessionTool,
+		listMATFileVariablesInGlobalMATLABSessionTool,
+		loadMATFileVariablesInGlobalMATLABSessionTool,
+		loadSimulinkModelInGlobalMATLABSessionTool,
+		simulateSimulinkModelInGlobalMATLABSessionTool,
+		getSimulinkBlockParametersInGlobalMATLABSessionTool,
+		setSimulinkParameterInGlobalMATLABSessionTool,
+		buildRealTimeApplicationInGlobalMATLABSessionTool,
+		deployRealTimeApplicationInGlobalMATLABSessionTool,
+		controlRealTimeApplicationInGlobalMATLABSessionTool,
+		streamRealTimeSignalsInGlobalMATLABSessionTool,
+		packageProductionArchiveInGlobalMATLABSessionTool,
+		getMATLABCodeDiagnosticsTool,
+		findMATLABDefinitionTool,
+		pullFromMATLABDriveTool,
+		pushToMATLABDriveTool,
+		deployProductionArchiveTool,
+		invokeProductionFunctionTool,
+		getProductionServerStatusTool,
+		getServerInfoTool,
+		nil,
+		matlabVariableInGlobalMATLABSessionResource,
+		matlabFigureInGlobalMATLABSessionResource,
+		matlabArtifactResource,
+		matlabDriveResource,
+	)
+
+	// Act
+	toolsToAdd := c.GetToolsToAdd()
+
+	// Assert
+	assert.ElementsMatch(t, toolsToAdd, []tools.Tool{
+		checkMATLABCodeInGlobalMATLABSession,
+		detectMATLABToolboxesInSingleSessionTool,
+		getPythonEnvironmentInGlobalMATLABSessionTool,
+		captureMATLABFiguresInGlobalMATLABSessionTool,
+		getMATLABVariableInGlobalMATLABSessionTool,
+		listMATFileVariablesInGlobalMATLABSessionTool,
+		getSimulinkBlockParametersInGlobalMATLABSessionTool,
+		getMATLABCodeDiagnosticsTool,
+		findMATLABDefinitionTool,
+		getServerInfoTool,
+	}, "GetToolsToAdd should only return the read-only tools for single session")
+}
+
+func TestConfigurator_GetResourcesToAdd_SingleMATLABSession(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	matlabVariableInGlobalMATLABSessionResource := &matlabvariable.Resource{}
+	matlabFigureInGlobalMATLABSessionResource := &matlabfigure.Resource{}
+	matlabArtifactResource := &matlabartifact.Resource{}
+	matlabDriveResource := &matlabdrive.Resource{}
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABDriveFolder().
+		Return("").
+		Once()
+
+	c := configurator.New(
+		mockConfig,
+		&listavailablematlabs.Tool{},
+		&startmatlabsession.Tool{},
+		&listmatlabsessions.Tool{},
+		&stopmatlabsession.Tool{},
+		&evalmatlabmultisession.Tool{},
+		&evalmatlabsinglesession.Tool{},
+		&checkmatlabcode.Tool{},
+		&detectmatlabtoolboxes.Tool{},
+		&runmatlabfile.Tool{},
+		&runmatlabtestfile.Tool{},
+		&runmatlabtests.Tool{},
+		&startjob.Tool{},
+		&getjobstatus.Tool{},
+		&getjoboutput.Tool{},
+		&canceljob.Tool{},
+		&getpythonenvironment.Tool{},
+		&setpythonenvironment.Tool{},
+		&checkpythonpackages.Tool{},
+		&runpythoncode.Tool{},
+		&exportlivescript.Tool{},
+		&capturematlabfigures.Tool{},
+		&getmatlabvariable.Tool{},
+		&setmatlabvariable.Tool{},
+		&listmatfilevariables.Tool{},
+		&loadmatfilevariables.Tool{},
+		&loadsimulinkmodel.Tool{},
+		&simulatesimulinkmodel.Tool{},
+		&getsimulinkblockparameters.Tool{},
+		&setsimulinkparameter.Tool{},
+		&buildrealtimeapplication.Tool{},
+		&deployrealtimeapplication.Tool{},
+		&controlrealtimeapplication.Tool{},
+		&streamrealtimesignals.Tool{},
+		&packageproductionarchive.Tool{},
+		&getmatlabdiagnostics.Tool{},
+		&findmatlabdefinition.Tool{},
+		&pullfrommatlabdrive.Tool{},
+		&pushtomatlabdrive.Tool{},
+		&deployproductionarchive.Tool{},
+		&invokeproductionfunction.Tool{},
+		&getproductionserverstatus.Tool{},
+		&getserverinfo.Tool{},
+		nil,
+		matlabVariableInGlobalMATLABSessionResource,
+		matlabFigureInGlobalMATLABSessionResource,
+		matlabArtifactResource,
+		matlabDriveResource,
+	)
+
+	// Act
+	resourcesToAdd := c.GetResourcesToAdd()
+
+	// Assert
+	assert.Equal(t, []resources.Resource{
+		matlabVariableInGlobalMATLABSessionResource,
+		matlabFigureInGlobalMATLABSessionResource,
+		matlabArtifactResource,
+	}, resourcesToAdd)
+}
+
+func TestConfigurator_GetResourcesToAdd_MultipleMATLABSession(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABDriveFolder().
+		Return("").
+		Once()
+
+	c := configurator.New(
+		mockConfig,
+		&listavailablematlabs.Tool{},
+		&startmatlabsession.Tool{},
+		&listmatlabsessions.Tool{},
+		&stopmatlabsession.Tool{},
+		&evalmatlabmultisession.Tool{},
+		&evalmatlabsinglesession.Tool{},
+		&checkmatlabcode.Tool{},
+		&detectmatlabtoolboxes.Tool{},
+		&runmatlabfile.Tool{},
+		&runmatlabtestfile.Tool{},
+		&runmatlabtests.Tool{},
+		&startjob.Tool{},
+		&getjobstatus.Tool{},
+		&getjoboutput.Tool{},
+		&canceljob.Tool{},
+		&getpythonenvironment.Tool{},
+		&setpythonenvironment.Tool{},
+		&checkpythonpackages.Tool{},
+		&runpythoncode.Tool{},
+		&exportlivescript.Tool{},
+		&capturematlabfigures.Tool{},
+		&getmatlabvariable.Tool{},
+		&setmatlabvariable.Tool{},
+		&listmatfilevariables.Tool{},
+		&loadmatfilevariables.Tool{},
+		&loadsimulinkmodel.Tool{},
+		&simulatesimulinkmodel.Tool{},
+		&getsimulinkblockparameters.Tool{},
+		&setsimulinkparameter.Tool{},
+		&buildrealtimeapplication.Tool{},
+		&deployrealtimeapplication.Tool{},
+		&controlrealtimeapplication.Tool{},
+		&streamrealtimesignals.Tool{},
+		&packageproductionarchive.Tool{},
+		&getmatlabdiagnostics.Tool{},
+		&findmatlabdefinition.Tool{},
+		&pullfrommatlabdrive.Tool{},
+		&pushtomatlabdrive.Tool{},
+		&deployproductionarchive.Tool{},
+		&invokeproductionfunction.Tool{},
+		&getproductionserverstatus.Tool{},
+		&getserverinfo.Tool{},
+		nil,
+		&matlabvariable.Resource{},
+		&matlabfigure.Resource{},
+		&matlabartifact.Resource{},
+		&matlabdrive.Resource{},
+	)
+
+	// Act
+	resourcesToAdd := c.GetResourcesToAdd()
+
+	// Assert
+	assert.Empty(t, resourcesToAdd, "Variables and figures can only be read from the global MATLAB session")
+}
+
+func TestConfigurator_GetToolsToAdd_MATLABDrive(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	pullFromMATLABDriveTool := &pullfrommatlabdrive.Tool{}
+	pushToMATLABDriveTool := &pushtomatlabdrive.Tool{}
+	deployProductionArchiveTool := &deployproductionarchive.Tool{}
+	invokeProductionFunctionTool := &invokeproductionfunction.Tool{}
+	getProductionServerStatusTool := &getproductionserverstatus.Tool{}
+	getServerInfoTool := &getserverinfo.Tool{}
+
+	mockConfig.EXPECT().
+		DisabledTools().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ReadOnly().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABDriveFolder().
+		Return("/home/user/MATLAB Drive").
+		Once()
+
+	mockConfig.EXPECT().
+		ProductionServerURL().
+		Return("").
+		Once()
+
+	c := configurator.New(
+		mockConfig,
+		&listavailablematlabs.Tool{},
+		&startmatlabsession.Tool{},
+		&listmatlabsessions.Tool{},
+		&stopmatlabsession.Tool{},
+		&evalmatlabmultisession.Tool{},
+		&evalmatlabsinglesession.Tool{},
+		&checkmatlabcode.Tool{},
+		&detectmatlabtoolboxes.Tool{},
+		&runmatlabfile.Tool{},
+		&runmatlabtestfile.Tool{},
+		&runmatlabtests.Tool{},
+		&startjob.Tool{},
+		&getjobstatus.Tool{},
+		&getjoboutput.Tool{},
+		&canceljob.Tool{},
+		&getpythonenvironment.Tool{},
+		&setpythonenvironment.Tool{},
+		&checkpythonpackages.Tool{},
+		&runpythoncode.Tool{},
+		&exportlivescript.Tool{},
+		&capturematlabfigures.Tool{},
+		&getmatlabvariable.Tool{},
+		&setmatlabvariable.Tool{},
+		&listmatfilevariables.Tool{},
+		&loadmatfilevariables.Tool{},
+		&loadsimulinkmodel.Tool{},
+		&simulatesimulinkmodel.Tool{},
+		&getsimulinkblockparameters.Tool{},
+		&setsimulinkparameter.Tool{},
+		&buildrealtimeapplication.Tool{},
+		&deployrealtimeapplication.Tool{},
+		&controlrealtimeapplication.Tool{},
+		&streamrealtimesignals.Tool{},
+		&packageproductionarchive.Tool{},
+		&getmatlabdiagnostics.Tool{},
+		&findmatlabdefinition.Tool{},
+		pullFromMATLABDriveTool,
+		pushToMATLABDriveTool,
+		deployProductionArchiveTool,
+		invokeProductionFunctionTool,
+		getProductionServerStatusTool,
+		getServerInfoTool,
+		nil,
+		&matlabvariable.Resource{},
+		&matlabfigure.Resource{},
+		&matlabartifact.Resource{},
+		&matlabdrive.Resource{},
+	)
+
+	// Act
+	toolsToAdd := c.GetToolsToAdd()
+
+	// Assert
+	assert.Contains(t, toolsToAdd, tools.Tool(pullFromMATLABDriveTool))
+	assert.Contains(t, toolsToAdd, tools.Tool(pushToMATLABDriveTool))
+}
+
+func TestConfigurator_GetResourcesToAdd_MATLABDrive(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	matlabDriveResource := &matlabdrive.Resource{}
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABDriveFolder().
+		Return("/home/user/MATLAB Drive").
+		Once()
+
+	c := configurator.New(
+		mockConfig,
+		&listavailablematlabs.Tool{},
+		&startmatlabsession.Tool{},
+		&listmatlabsessions.Tool{},
+		&stopmatlabsession.Tool{},
+		&evalmatlabmultisession.Tool{},
+		&evalmatlabsinglesession.Tool{},
+		&checkmatlabcode.Tool{},
+		&detectmatlabtoolboxes.Tool{},
+		&runmatlabfile.Tool{},
+		&runmatlabtestfile.Tool{},
+		&runmatlabtests.Tool{},
+		&startjob.Tool{},
+		&getjobstatus.Tool{},
+		&getjoboutput.Tool{},
+		&canceljob.Tool{},
+		&getpythonenvironment.Tool{},
+		&setpythonenvironment.Tool{},
+		&checkpythonpackages.Tool{},
+		&runpythoncode.Tool{},
+		&exportlivescript.Tool{},
+		&capturematlabfigures.Tool{},
+		&getmatlabvariable.Tool{},
+		&setmatlabvariable.Tool{},
+		&listmatfilevariables.Tool{},
+		&loadmatfilevariables.Tool{},
+		&loadsimulinkmodel.Tool{},
+		&simulatesimulinkmodel.Tool{},
+		&getsimulinkblockparameters.Tool{},
+		&setsimulinkparameter.Tool{},
+		&buildrealtimeapplication.Tool{},
+		&deployrealtimeapplication.Tool{},
+		&controlrealtimeapplication.Tool{},
+		&streamrealtimesignals.Tool{},
+		&packageproductionarchive.Tool{},
+		&getmatlabdiagnostics.Tool{},
+		&findmatlabdefinition.Tool{},
+		&pullfrommatlabdrive.Tool{},
+		&pushtomatlabdrive.Tool{},
+		&deployproductionarchive.Tool{},
+		&invokeproductionfunction.Tool{},
+		&getproductionserverstatus.Tool{},
+		&getserverinfo.Tool{},
+		nil,
+		&matlabvariable.Resource{},
+		&matlabfigure.Resource{},
+		&matlabartifact.Resource{},
+		matlabDriveResource,
+	)
+
+	// Act
+	resourcesToAdd := c.GetResourcesToAdd()
+
+	// Assert
+	assert.Equal(t, []resources.Resource{matlabDriveResource}, resourcesToAdd, "MATLAB Drive should be available without a MATLAB session")
+}
+
+func TestConfigurator_GetToolsToAdd_RealTimeTargets(t *testing.T) {
+	testConfigs := []struct {
+		name             string
+		readOnly         bool
+		expectedMutating bool
+	}{
+		{
+			name:             "all real-time tools",
+			readOnly:         false,
+			expectedMutating: true,
+		},
+		{
+			name:             "read-only mode only streams signals",
+			readOnly:         true,
+			expectedMutating: false,
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			buildRealTimeApplicationTool := &buildrealtimeapplication.Tool{}
+			deployRealTimeApplicationTool := &deployrealtimeapplication.Tool{}
+			controlRealTimeApplicationTool := &controlrealtimeapplication.Tool{}
+			streamRealTimeSignalsTool := &streamrealtimesignals.Tool{}
+
+			mockConfig.EXPECT().
+				DisabledTools().
+				Return(nil).
+				Once()
+
+			mockConfig.EXPECT().
+				ReadOnly().
+				Return(testConfig.readOnly).
+				Once()
+
+			mockConfig.EXPECT().
+				UseSingleMATLABSession().
+				Return(true).
+				Once()
+
+			if !testConfig.readOnly {
+				mockConfig.EXPECT().
+					MATLABDriveFolder().
+					Return("").
+					Once()
+			}
+
+			mockConfig.EXPECT().
+				RealTimeTargets().
+				Return([]string{"rig1"}).
+				Once()
+
+			mockConfig.EXPECT().
+				ProductionServerURL().
+				Return("").
+				Once()
+
+			c := configurator.New(
+				mockConfig,
+				&listavailablematlabs.Tool{},
+				&startmatlabsession.Tool{},
+				&listmatlabsessions.Tool{},
+				&stopmatlabsession.Tool{},
+				&evalmatlabmultisession.Tool{},
+				&evalmatlabsinglesession.Tool{},
+				&checkmatlabcode.Tool{},
+				&detectmatlabtoolboxes.Tool{},
+				&runmatlabfile.Tool{},
+				&runmatlabtestfile.Tool{},
+				&runmatlabtests.Tool{},
+				&startjob.Tool{},
+				&getjobstatus.Tool{},
+				&getjoboutput.Tool{},
+				&canceljob.Tool{},
+				&getpythonenvironment.Tool{},
+				&setpythonenvironment.Tool{},
+				&checkpythonpackages.Tool{},
+				&runpythoncode.Tool{},
+				&exportlivescript.Tool{},
+				&capturematlabfigures.Tool{},
+				&getmatlabvariable.Tool{},
+				&setmatlabvariable.Tool{},
+				&listmatfilevariables.Tool{},
+				&loadmatfilevariables.Tool{},
+				&loadsimulinkmodel.Tool{},
+				&simulatesimulinkmodel.Tool{},
+				&getsimulinkblockparameters.Tool{},
+				&setsimulinkparameter.Tool{},
+				buildRealTimeApplicationTool,
+				deployRealTimeApplicationTool,
+				controlRealTimeApplicationTool,
+				streamRealTimeSignalsTool,
+				&packageproductionarchive.Tool{},
+				&getmatlabdiagnostics.Tool{},
+				&findmatlabdefinition.Tool{},
+				&pullfrommatlabdrive.Tool{},
+				&pushtomatlabdrive.Tool{},
+				&deployproductionarchive.Tool{},
+				&invokeproductionfunction.Tool{},
+				&getproductionserverstatus.Tool{},
+				&getserverinfo.Tool{},
+				nil,
+				&matlabvariable.Resource{},
+				&matlabfigure.Resource{},
+				&matlabartifact.Resource{},
+				&matlabdrive.Resource{},
+			)
+
+			// Act
+			toolsToAdd := c.GetToolsToAdd()
+
+			// Assert
+			assert.Contains(t, toolsToAdd, tools.Tool(streamRealTimeSignalsTool))
+			for _, mutatingTool := range []tools.Tool{buildRealTimeApplicationTool, deployRealTimeApplicationTool, controlRealTimeApplicationTool} {
+				if testConfig.expectedMutating {
+					assert.Contains(t, toolsToAdd, mutatingTool)
+				} else {
+					assert.NotContains(t, toolsToAdd, mutatingTool)
+				}
+			}
+		})
+	}
+}
+
+func TestConfigurator_GetToolsToAdd_ProductionServer(t *testing.T) {
+	testConfigs := []struct {
+		name            string
+		readOnly        bool
+		singleSession   bool
+		deployFolder    string
+		expectedPackage bool
+		expectedInvoke  bool
+		expectedDeploy  bool
+	}{
+		{
+			name:           "multi session without MATLAB to package archives",
+			singleSession:  false,
+			deployFolder:   "/opt/mps/auto_deploy",
+			expectedInvoke: true,
+			expectedDeploy: true,
+		},
+		{
+			name:            "single session packages archives",
+			singleSession:   true,
+			deployFolder:    "/opt/mps/auto_deploy",
+			expectedPackage: true,
+			expectedInvoke:  true,
+			expectedDeploy:  true,
+		},
+		{
+			name:            "no deployment without auto_deploy folder",
+			singleSession:   true,
+			expectedPackage: true,
+			expectedInvoke:  true,
+		},
+		{
+			name:          "read-only mode only reports the status",
+			readOnly:      true,
+			singleSession: true,
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			packageProductionArchiveTool := &packageproductionarchive.Tool{}
+			deployProductionArchiveTool := &deployproductionarchive.Tool{}
+			invokeProductionFunctionTool := &invokeproductionfunction.Tool{}
+			getProductionServerStatusTool := &getproductionserverstatus.Tool{}
+			getServerInfoTool := &getserverinfo.Tool{}
+
+			mockConfig.EXPECT().
+				DisabledTools().
+				Return(nil).
+				Once()
+
+			mockConfig.EXPECT().
+				ReadOnly().
+				Return(testConfig.readOnly).
+				Once()
+
+			mockConfig.EXPECT().
+				UseSingleMATLABSession().
+				Return(testConfig.singleSession).
+				Once()
+
+			mockConfig.EXPECT().
+				MATLABDriveFolder().
+				Return("").
+				Maybe()
+
+			mockConfig.EXPECT().
+				RealTimeTargets().
+				Return(nil).
+				Maybe()
+
+			mockConfig.EXPECT().
+				ProductionServerURL().
+				Return("http://mps.example.com:9910").
+				Once()
+
+			mockConfig.EXPECT().
+				ProductionServerDeployFolder().
+				Return(testConfig.deployFolder).
+				Maybe()
+
+			c := configurator.New(
+				mockConfig,
+				&listavailablematlabs.Tool{},
+				&startmatlabsession.Tool{},
+				&listmatlabsessions.Tool{},
+				&stopmatlabsession.Tool{},
+				&evalmatlabmultisession.Tool{},
+				&evalmatlabsinglesession.Tool{},
+				&checkmatlabcode.Tool{},
+				&detectmatlabtoolboxes.Tool{},
+				&runmatlabfile.Tool{},
+				&runmatlabtestfile.Tool{},
+				&runmatlabtests.Tool{},
+				&startjob.Tool{},
+				&getjobstatus.Tool{},
+				&getjoboutput.Tool{},
+				&canceljob.Tool{},
+				&getpythonenvironment.Tool{},
+				&setpythonenvironment.Tool{},
+				&checkpythonpackages.Tool{},
+				&runpythoncode.Tool{},
+				&exportlivescript.Tool{},
+				&capturematlabfigures.Tool{},
+				&getmatlabvariable.Tool{},
+				&setmatlabvariable.Tool{},
+				&listmatfilevariables.Tool{},
+				&loadmatfilevariables.Tool{},
+				&loadsimulinkmodel.Tool{},
+				&simulatesimulinkmodel.Tool{},
+				&getsimulinkblockparameters.Tool{},
+				&setsimulinkparameter.Tool{},
+				&buildrealtimeapplication.Tool{},
+				&deployrealtimeapplication.Tool{},
+				&controlrealtimeapplication.Tool{},
+				&streamrealtimesignals.Tool{},
+				packageProductionArchiveTool,
+				&getmatlabdiagnostics.Tool{},
+				&findmatlabdefinition.Tool{},
+				&pullfrommatlabdrive.Tool{},
+				&pushtomatlabdrive.Tool{},
+				deployProductionArchiveTool,
+				invokeProductionFunctionTool,
+				getProductionServerStatusTool,
+				getServerInfoTool,
+				nil,
+				&matlabvariable.Resource{},
+				&matlabfigure.Resource{},
+				&matlabartifact.Resource{},
+				&matlabdrive.Resource{},
+			)
+
+			// Act
+			toolsToAdd := c.GetToolsToAdd()
+
+			// Assert
+			assert.Contains(t, toolsToAdd, tools.Tool(getProductionServerStatusTool))
+			for tool, expected := range map[tools.Tool]bool{
+				packageProductionArchiveTool: testConfig.expectedPackage,
+				invokeProductionFunctionTool: testConfig.expectedInvoke,
+				deployProductionArchiveTool:  testConfig.expectedDeploy,
+			} {
+				if expected {
+					assert.Contains(t, toolsToAdd, tool)
+				} else {
+					assert.NotContains(t, toolsToAdd, tool)
+				}
+			}
+		})
+	}
+}
+
+func TestConfigurator_GetToolsToAdd_PluginTools(t *testing.T) {
+	testConfigs := []struct {
+		name              string
+		readOnly          bool
+		dryRun            bool
+		expectedReadWrite bool
+	}{
+		{
+			name:              "all plugin tools",
+			expectedReadWrite: true,
+		},
+		{
+			name:              "read-only mode",
+			readOnly:          true,
+			expectedReadWrite: false,
+		},
+		{
+			name:              "dry run",
+			dryRun:            true,
+			expectedReadWrite: false,
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockToolProvider := &toolsmocks.MockToolProvider{}
+			defer mockToolProvider.AssertExpectations(t)
+
+			readOnlyPluginTool := newPluginTool(t, "lookup_ticket", true)
+			readWritePluginTool := newPluginTool(t, "update_ticket", false)
+
+			mockConfig.EXPECT().
+				DisabledTools().
+				Return(nil).
+				Once()
+
+			mockConfig.EXPECT().
+				ReadOnly().
+				Return(testConfig.readOnly)
+
+			mockConfig.EXPECT().
+				DryRun().
+				Return(testConfig.dryRun).
+				Maybe()
+
+			mockConfig.EXPECT().
+				UseSingleMATLABSession().
+				Return(false).
+				Once()
+
+			mockConfig.EXPECT().
+				MATLABDriveFolder().
+				Return("").
+				Maybe()
+
+			mockToolProvider.EXPECT().
+				Tools().
+				Return([]tools.ProvidedTool{readOnlyPluginTool, readWritePluginTool}).
+				Once()
+
+			mockConfig.EXPECT().
+				ProductionServerURL().
+				Return("").
+				Once()
+
+			c := newConfiguratorWithToolProviders(mockConfig, mockToolProvider)
+
+			// Act
+			toolsToAdd := c.GetToolsToAdd()
+
+			// Assert
+			assert.Contains(t, toolsToAdd, tools.Tool(readOnlyPluginTool))
+			if testConfig.expectedReadWrite {
+				assert.Contains(t, toolsToAdd, tools.Tool(readWritePluginTool))
+			} else {
+				assert.NotContains(t, toolsToAdd, tools.Tool(readWritePluginTool))
+			}
+		})
+	}
+}
+
+func TestConfigurator_GetToolsToAdd_PluginToolCannotReplaceServerTool(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockToolProvider := &toolsmocks.MockToolProvider{}
+	defer mockToolProvider.AssertExpectations(t)
+
+	// The tools of the server are built without a name in these tests.
+	shadowingPluginTool := newPluginTool(t, "", false)
+
+	mockConfig.EXPECT().
+		DisabledTools().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ReadOnly().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABDriveFolder().
+		Return("").
+		Once()
+
+	mockToolProvider.EXPECT().
+		Tools().
+		Return([]tools.ProvidedTool{shadowingPluginTool}).
+		Once()
+
+	mockConfig.EXPECT().
+		ProductionServerURL().
+		Return("").
+		Once()
+
+	c := newConfiguratorWithToolProviders(mockConfig, mockToolProvider)
+
+	// Act
+	toolsToAdd := c.GetToolsToAdd()
+
+	// Assert
+	assert.NotContains(t, toolsToAdd, tools.Tool(shadowingPluginTool))
+}
+
+func TestConfigurator_GetToolsToAdd_ToolsOfEveryProvider(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockPluginToolProvider := &toolsmocks.MockToolProvider{}
+	defer mockPluginToolProvider.AssertExpectations(t)
+
+	mockDownstreamToolProvider := &toolsmocks.MockToolProvider{}
+	defer mockDownstreamToolProvider.AssertExpectations(t)
+
+	pluginTool := newPluginTool(t, "lookup_ticket", true)
+	downstreamTool := newPluginTool(t, "docs__search", true)
+
+	mockConfig.EXPECT().
+		DisabledTools().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ReadOnly().
+		Return(false)
+
+	mockConfig.EXPECT().
+		DryRun().
+		Return(false).
+		Maybe()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABDriveFolder().
+		Return("").
+		Once()
+
+	mockPluginToolProvider.EXPECT().
+		Tools().
+		Return([]tools.ProvidedTool{pluginTool}).
+		Once()
+
+	mockDownstreamToolProvider.EXPECT().
+		Tools().
+		Return([]tools.ProvidedTool{downstreamTool}).
+		Once()
+
+	mockConfig.EXPECT().
+		ProductionServerURL().
+		Return("").
+		Once()
+
+	c := newConfiguratorWithToolProviders(mockConfig, mockPluginToolProvider, mockDownstreamToolProvider)
+
+	// Act
+	toolsToAdd := c.GetToolsToAdd()
+
+	// Assert
+	assert.Equal(t, []tools.Tool{pluginTool, downstreamTool}, toolsToAdd[len(toolsToAdd)-2:])
+}
+
+func TestConfigurator_GetToolsToAdd_DisabledTools(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockToolProvider := &toolsmocks.MockToolProvider{}
+	defer mockToolProvider.AssertExpectations(t)
+
+	disabledTool := newPluginTool(t, "lookup_ticket", true)
+	enabledTool := newPluginTool(t, "create_ticket", true)
+
+	mockConfig.EXPECT().
+		DisabledTools().
+		Return([]string{"lookup_ticket", "unknown_tool"}).
+		Once()
+
+	mockConfig.EXPECT().
+		ReadOnly().
+		Return(false)
+
+	mockConfig.EXPECT().
+		DryRun().
+		Return(false).
+		Maybe()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABDriveFolder().
+		Return("").
+		Once()
+
+	mockToolProvider.EXPECT().
+		Tools().
+		Return([]tools.ProvidedTool{disabledTool, enabledTool}).
+		Once()
+
+	mockConfig.EXPECT().
+		ProductionServerURL().
+		Return("").
+		Once()
+
+	c := newConfiguratorWithToolProviders(mockConfig, mockToolProvider)
+
+	// Act
+	toolsToAdd := c.GetToolsToAdd()
+
+	// Assert
+	assert.NotContains(t, toolsToAdd, tools.Tool(disabledTool))
+	assert.Contains(t, toolsToAdd, tools.Tool(enabledTool))
+}
+
+func newPluginTool(t *testing.T, name string, readOnly bool) *toolsmocks.MockProvidedTool {
+	mockProvidedTool := &toolsmocks.MockProvidedTool{}
+	t.Cleanup(func() { mockProvidedTool.AssertExpectations(t) })
+
+	mockProvidedTool.EXPECT().
+		Name().
+		Return(name).
+		Maybe()
+
+	mockProvidedTool.EXPECT().
+		ReadOnly().
+		Return(readOnly).
+		Maybe()
+
+	return mockProvidedTool
+}
+
+func newConfiguratorWithToolProviders(config configurator.Config, toolProviders ...tools.ToolProvider) *configurator.Configurator {
+	return configurator.New(
+		config,
+		&listavailablematlabs.Tool{},
+		&startmatlabsession.Tool{},
+		&listmatlabsessions.Tool{},
+		&stopmatlabsession.Tool{},
+		&evalmatlabmultisession.Tool{},
+		&evalmatlabsinglesession.Tool{},
+		&checkmatlabcode.Tool{},
+		&detectmatlabtoolboxes.Tool{},
+		&runmatlabfile.Tool{},
+		&runmatlabtestfile.Tool{},
+		&runmatlabtests.Tool{},
+		&startjob.Tool{},
+		&getjobstatus.Tool{},
+		&getjoboutput.Tool{},
+		&canceljob.Tool{},
+		&getpythonenvironment.Tool{},
+		&setpythonenvironment.Tool{},
+		&checkpythonpackages.Tool{},
+		&runpythoncode.Tool{},
+		&exportlivescript.Tool{},
+		&capturematlabfigures.Tool{},
+		&getmatlabvariable.Tool{},
+		&setmatlabvariable.Tool{},
+		&listmatfilevariables.Tool{},
+		&loadmatfilevariables.Tool{},
+		&loadsimulinkmodel.Tool{},
+		&simulatesimulinkmodel.Tool{},
+		&getsimulinkblockparameters.Tool{},
+		&setsimulinkparameter.Tool{},
+		&buildrealtimeapplication.Tool{},
+		&deployrealtimeapplication.Tool{},
+		&controlrealtimeapplication.Tool{},
+		&streamrealtimesignals.Tool{},
+		&packageproductionarchive.Tool{},
+		&getmatlabdiagnostics.Tool{},
+		&findmatlabdefinition.Tool{},
+		&pullfrommatlabdrive.Tool{},
+		&pushtomatlabdrive.Tool{},
+		&deployproductionarchive.Tool{},
+		&invokeproductionfunction.Tool{},
+		&getproductionserverstatus.Tool{},
+		&getserverinfo.Tool{},
+		toolProviders,
+		&matlabvariable.Resource{},
+		&matlabfigure.Resource{},
+		&matlabartifact.Resource{},
+		&matlabdrive.Resource{},
+	)
+}
@@ -0,0 +1,21 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/correlationid"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// correlationIDMiddleware assigns a correlation ID to every incoming request, as early as possible,
+// so that it is available to all the code that runs on behalf of that request.
+func correlationIDMiddleware(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		if _, ok := correlationid.FromContext(ctx); !ok {
+			ctx = correlationid.NewContext(ctx, correlationid.New())
+		}
+		return next(ctx, method, req)
+	}
+}
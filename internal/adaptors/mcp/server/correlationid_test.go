@@ -0,0 +1,79 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/correlationid"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCorrelationIDMiddleware_AssignsCorrelationID(t *testing.T) {
+	// Arrange
+	var capturedContext context.Context
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		capturedContext = ctx
+		return nil, nil
+	}
+
+	handler := server.CorrelationIDMiddleware(next)
+
+	// Act
+	_, err := handler(t.Context(), "tools/call", &mcp.CallToolRequest{})
+
+	// Assert
+	require.NoError(t, err)
+	correlationID, ok := correlationid.FromContext(capturedContext)
+	require.True(t, ok, "Correlation ID should be set")
+	assert.NotEmpty(t, correlationID)
+}
+
+func TestCorrelationIDMiddleware_UniquePerRequest(t *testing.T) {
+	// Arrange
+	var correlationIDs []string
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		correlationID, _ := correlationid.FromContext(ctx)
+		correlationIDs = append(correlationIDs, correlationID)
+		return nil, nil
+	}
+
+	handler := server.CorrelationIDMiddleware(next)
+
+	// Act
+	_, err := handler(t.Context(), "tools/call", &mcp.CallToolRequest{})
+	require.NoError(t, err)
+	_, err = handler(t.Context(), "tools/call", &mcp.CallToolRequest{})
+	require.NoError(t, err)
+
+	// Assert
+	require.Len(t, correlationIDs, 2)
+	assert.NotEqual(t, correlationIDs[0], correlationIDs[1])
+}
+
+func TestCorrelationIDMiddleware_KeepsExistingCorrelationID(t *testing.T) {
+	// Arrange
+	expectedCorrelationID := "existing-id"
+
+	var capturedContext context.Context
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		capturedContext = ctx
+		return nil, nil
+	}
+
+	handler := server.CorrelationIDMiddleware(next)
+	ctx := correlationid.NewContext(t.Context(), expectedCorrelationID)
+
+	// Act
+	_, err := handler(ctx, "tools/call", &mcp.CallToolRequest{})
+
+	// Assert
+	require.NoError(t, err)
+	correlationID, ok := correlationid.FromContext(capturedContext)
+	require.True(t, ok)
+	assert.Equal(t, expectedCorrelationID, correlationID)
+}
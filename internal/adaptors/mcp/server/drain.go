@@ -0,0 +1,184 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/correlationid"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/i18n"
+	"github.com/modelcontextprotocol/go-sdk/jsonrpc"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	// defaultDrainTimeout is the time the tool calls in flight are given to complete when the server stops,
+	// before they are cancelled.
+	defaultDrainTimeout = 30 * time.Second
+
+	// defaultCancelTimeout is the time the cancelled tool calls are given to answer their clients.
+	defaultCancelTimeout = 10 * time.Second
+)
+
+// drainer tracks the tool calls in flight, so that the server answers them before MATLAB stops.
+type drainer struct {
+	lock     *sync.Mutex
+	draining bool
+	inFlight int
+
+	// drained is closed once the server is draining and no tool call is in flight.
+	drained chan struct{}
+
+	// cancelCtx is cancelled to cancel the tool calls in flight.
+	cancelCtx context.Context
+	cancel    context.CancelFunc
+
+	drainTimeout  time.Duration
+	cancelTimeout time.Duration
+}
+
+func newDrainer() *drainer {
+	cancelCtx, cancel := context.WithCancel(context.Background())
+	return &drainer{
+		lock:          new(sync.Mutex),
+		drained:       make(chan struct{}),
+		cancelCtx:     cancelCtx,
+		cancel:        cancel,
+		drainTimeout:  defaultDrainTimeout,
+		cancelTimeout: defaultCancelTimeout,
+	}
+}
+
+// enter registers a new tool call, unless the server is draining.
+func (d *drainer) enter() bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if d.draining {
+		return false
+	}
+	d.inFlight++
+	return true
+}
+
+func (d *drainer) leave() {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.inFlight--
+	if d.draining && d.inFlight == 0 {
+		close(d.drained)
+	}
+}
+
+// startDraining refuses the new tool calls.
+func (d *drainer) startDraining() {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if !d.draining {
+		d.draining = true
+		if d.inFlight == 0 {
+			close(d.drained)
+		}
+	}
+}
+
+// drain waits for the tool calls in flight to complete, and cancels the calls still in flight after the drain timeout.
+// It fails if calls had to be cancelled.
+func (d *drainer) drain() error {
+	d.startDraining()
+
+	select {
+	case <-d.drained:
+		return nil
+	case <-time.After(d.drainTimeout):
+	}
+
+	d.lock.Lock()
+	inFlight := d.inFlight
+	d.lock.Unlock()
+	d.cancel()
+
+	select {
+	case <-d.drained:
+		return fmt.Errorf("cancelled %d tool calls still in flight after %s", inFlight, d.drainTimeout)
+	case <-time.After(d.cancelTimeout):
+		return fmt.Errorf("%d tool calls still in flight after %s did not stop once cancelled", inFlight, d.drainTimeout)
+	}
+}
+
+// abandon refuses the new tool calls, and cancels the calls in flight, as their client cannot receive their results.
+func (d *drainer) abandon() {
+	d.startDraining()
+	d.cancel()
+}
+
+// drainMiddleware rejects the tool calls received while the server stops, and cancels the calls in flight when the
+// drain timeout expires, so that every call is answered before the server stops.
+func drainMiddleware(d *drainer, logger entities.Logger) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			if method != methodCallTool {
+				return next(ctx, method, req)
+			}
+
+			if !d.enter() {
+				logger := logger
+				if params, ok := req.GetParams().(*mcp.CallToolParamsRaw); ok {
+					logger = logger.With("tool-name", params.Name)
+				}
+				if correlationID, ok := correlationid.FromContext(ctx); ok {
+					logger = logger.With(correlationid.LogKey, correlationID)
+				}
+				logger.Warn("Tool call rejected as the server is shutting down")
+				return rejectedCallResult(ctx, entities.ErrorCodeShuttingDown, i18n.Translate(i18n.FromContext(ctx), i18n.MessageShuttingDown)), nil
+			}
+			defer d.leave()
+
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			stop := context.AfterFunc(d.cancelCtx, cancel)
+			defer stop()
+
+			return next(ctx, method, req)
+		}
+	}
+}
+
+// clientClosureTransport reports when the client closes its side of the connection, such as the standard input of
+// the server when the AI application stops.
+type clientClosureTransport struct {
+	mcp.Transport
+	onClientClosure func()
+}
+
+func (t *clientClosureTransport) Connect(ctx context.Context) (mcp.Connection, error) {
+	conn, err := t.Transport.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &clientClosureConnection{
+		Connection:      conn,
+		onClientClosure: sync.OnceFunc(t.onClientClosure),
+	}, nil
+}
+
+type clientClosureConnection struct {
+	mcp.Connection
+	onClientClosure func()
+}
+
+func (c *clientClosureConnection) Read(ctx context.Context) (jsonrpc.Message, error) {
+	message, err := c.Connection.Read(ctx)
+	if errors.Is(err, io.EOF) {
+		c.onClientClosure()
+	}
+	return message, err
+}
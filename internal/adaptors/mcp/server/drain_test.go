@@ -0,0 +1,143 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// connectBlockingTool connects to a server whose tool signals started when it runs, and runs until release is closed or
+// the call is cancelled.
+func connectBlockingTool(t *testing.T, drainer *server.Drainer, started chan<- struct{}, release <-chan struct{}) *mcp.ClientSession {
+	t.Helper()
+
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test-server"}, nil)
+	mcpServer.AddReceivingMiddleware(server.DrainMiddleware(drainer, testutils.NewInspectableLogger()))
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "evaluate_matlab_code"}, func(ctx context.Context, req *mcp.CallToolRequest, input struct{}) (*mcp.CallToolResult, any, error) {
+		started <- struct{}{}
+		select {
+		case <-release:
+			return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: "done"}}}, nil, nil
+		case <-ctx.Done():
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "cancelled"}}}, nil, nil
+		}
+	})
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := mcpServer.Connect(t.Context(), serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = serverSession.Close() })
+
+	clientSession, err := client.Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = clientSession.Close() })
+
+	return clientSession
+}
+
+// startCall calls the tool, and returns once the tool runs.
+func startCall(t *testing.T, clientSession *mcp.ClientSession, started <-chan struct{}) <-chan *mcp.CallToolResult {
+	t.Helper()
+
+	resultC := make(chan *mcp.CallToolResult, 1)
+	go func() {
+		result, err := clientSession.CallTool(context.Background(), &mcp.CallToolParams{Name: "evaluate_matlab_code", Arguments: map[string]any{}})
+		assert.NoError(t, err)
+		resultC <- result
+	}()
+	<-started
+
+	return resultC
+}
+
+func resultText(t *testing.T, result *mcp.CallToolResult) string {
+	t.Helper()
+
+	require.Len(t, result.Content, 1)
+	textContent, ok := result.Content[0].(*mcp.TextContent)
+	require.True(t, ok)
+	return textContent.Text
+}
+
+func TestDrainMiddleware_DrainsCallsInFlight(t *testing.T) {
+	// Arrange
+	drainer := server.NewDrainer(time.Minute, time.Minute)
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	clientSession := connectBlockingTool(t, drainer, started, release)
+	resultC := startCall(t, clientSession, started)
+
+	// Act
+	drainErrC := make(chan error, 1)
+	go func() {
+		drainErrC <- drainer.Drain()
+	}()
+
+	// Assert
+	select {
+	case <-drainErrC:
+		t.Fatal("Drain should wait for the tool calls in flight")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	require.NoError(t, <-drainErrC)
+	assert.Equal(t, "done", resultText(t, <-resultC))
+}
+
+func TestDrainMiddleware_RejectsNewCalls(t *testing.T) {
+	// Arrange
+	drainer := server.NewDrainer(time.Minute, time.Minute)
+	clientSession := connectBlockingTool(t, drainer, make(chan struct{}, 1), make(chan struct{}))
+	require.NoError(t, drainer.Drain())
+
+	// Act
+	result, err := clientSession.CallTool(t.Context(), &mcp.CallToolParams{Name: "evaluate_matlab_code", Arguments: map[string]any{}})
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, resultText(t, result), "SHUTTING_DOWN: the server is shutting down")
+}
+
+func TestDrainMiddleware_CancelsCallsAfterTimeout(t *testing.T) {
+	// Arrange
+	drainer := server.NewDrainer(10*time.Millisecond, time.Minute)
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	clientSession := connectBlockingTool(t, drainer, started, release)
+	resultC := startCall(t, clientSession, started)
+
+	// Act
+	err := drainer.Drain()
+
+	// Assert
+	require.ErrorContains(t, err, "cancelled 1 tool calls still in flight after 10ms")
+	assert.Equal(t, "cancelled", resultText(t, <-resultC))
+}
+
+func TestDrainMiddleware_Abandon(t *testing.T) {
+	// Arrange
+	drainer := server.NewDrainer(time.Minute, time.Minute)
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	clientSession := connectBlockingTool(t, drainer, started, release)
+	resultC := startCall(t, clientSession, started)
+
+	// Act
+	drainer.Abandon()
+
+	// Assert
+	assert.Equal(t, "cancelled", resultText(t, <-resultC))
+	require.NoError(t, drainer.Drain(), "Abandoned calls should not be reported as cancelled by the drain")
+}
@@ -0,0 +1,56 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/toolpolicy"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/correlationid"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// dryRunMiddleware answers the calls to describe with the plan of the call, without running the tool.
+// The plan reports the decision of the tool policy, but the user is not asked to confirm the call, as nothing runs.
+func dryRunMiddleware(planner DryRunPlanner, policy ToolPolicy, logger entities.Logger) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			if method != methodCallTool {
+				return next(ctx, method, req)
+			}
+
+			params, ok := req.GetParams().(*mcp.CallToolParamsRaw)
+			if !ok || !planner.Applies(params.Name, params.Arguments) {
+				return next(ctx, method, req)
+			}
+
+			logger := logger.With("tool-name", params.Name)
+			if correlationID, ok := correlationid.FromContext(ctx); ok {
+				logger = logger.With(correlationid.LogKey, correlationID)
+			}
+			logger.Info("Tool call dry run")
+
+			var plan strings.Builder
+			plan.WriteString(planner.Plan(params.Name, params.Arguments))
+			plan.WriteString(describeToolPolicyDecision(params.Name, policy.Evaluate(toolPolicyCall(params))))
+
+			return &mcp.CallToolResult{
+				Content: []mcp.Content{&mcp.TextContent{Text: plan.String()}},
+			}, nil
+		}
+	}
+}
+
+func describeToolPolicyDecision(tool string, decision toolpolicy.Decision) string {
+	switch decision.Action {
+	case toolpolicy.ActionAllow:
+		return "\nTool policy: the call would be allowed.\n"
+	case toolpolicy.ActionConfirm:
+		return withReason(fmt.Sprintf("\nTool policy: the user would be asked to confirm the call to %s", tool), decision.Explanation()) + "\n"
+	default:
+		return withReason(fmt.Sprintf("\nTool policy: the call to %s would be denied", tool), decision.Explanation()) + "\n"
+	}
+}
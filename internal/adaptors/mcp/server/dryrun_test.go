@@ -0,0 +1,139 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/toolpolicy"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/server"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// callRunMATLABFileDryRun calls a run_matlab_file tool with dry_run set through the dry run middleware, and returns the
+// result received by the client and whether the tool ran.
+func callRunMATLABFileDryRun(t *testing.T, planner server.DryRunPlanner, policy server.ToolPolicy) (*mcp.CallToolResult, bool) {
+	t.Helper()
+
+	toolCalled := false
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test-server"}, nil)
+	mcpServer.AddReceivingMiddleware(server.DryRunMiddleware(planner, policy, testutils.NewInspectableLogger()))
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "run_matlab_file"}, func(ctx context.Context, req *mcp.CallToolRequest, input struct {
+		ScriptPath string `json:"script_path"`
+		DryRun     bool   `json:"dry_run,omitempty"`
+	}) (*mcp.CallToolResult, any, error) {
+		toolCalled = true
+		return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: "ran"}}}, nil, nil
+	})
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := mcpServer.Connect(t.Context(), serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = serverSession.Close() })
+
+	clientSession, err := client.Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = clientSession.Close() })
+
+	result, err := clientSession.CallTool(t.Context(), &mcp.CallToolParams{
+		Name:      "run_matlab_file",
+		Arguments: map[string]any{"script_path": "/home/user/script.m", "dry_run": true},
+	})
+	require.NoError(t, err)
+
+	return result, toolCalled
+}
+
+func TestDryRunMiddleware_NotApplied(t *testing.T) {
+	// Arrange
+	mockDryRunPlanner := &mocks.MockDryRunPlanner{}
+	defer mockDryRunPlanner.AssertExpectations(t)
+
+	mockToolPolicy := &mocks.MockToolPolicy{}
+	defer mockToolPolicy.AssertExpectations(t)
+
+	arguments := json.RawMessage(`{"dry_run":true,"script_path":"/home/user/script.m"}`)
+
+	mockDryRunPlanner.EXPECT().
+		Applies("run_matlab_file", arguments).
+		Return(false).
+		Once()
+
+	// Act
+	result, toolCalled := callRunMATLABFileDryRun(t, mockDryRunPlanner, mockToolPolicy)
+
+	// Assert
+	assert.False(t, result.IsError)
+	assert.True(t, toolCalled, "Calls without a dry run should run the tool")
+}
+
+func TestDryRunMiddleware_Applied(t *testing.T) {
+	testCases := []struct {
+		name         string
+		decision     toolpolicy.Decision
+		expectedText string
+	}{
+		{
+			name:         "allowed",
+			decision:     toolpolicy.Decision{Action: toolpolicy.ActionAllow},
+			expectedText: "Dry run: the call to run_matlab_file was not run.\n\nTool policy: the call would be allowed.\n",
+		},
+		{
+			name:         "confirmed",
+			decision:     toolpolicy.Decision{Action: toolpolicy.ActionConfirm, Reason: "scripts can modify files"},
+			expectedText: "Dry run: the call to run_matlab_file was not run.\n\nTool policy: the user would be asked to confirm the call to run_matlab_file: scripts can modify files\n",
+		},
+		{
+			name:         "denied",
+			decision:     toolpolicy.Decision{Action: toolpolicy.ActionDeny, Rule: "no-scripts"},
+			expectedText: "Dry run: the call to run_matlab_file was not run.\n\nTool policy: the call to run_matlab_file would be denied: rule \"no-scripts\"\n",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockDryRunPlanner := &mocks.MockDryRunPlanner{}
+			defer mockDryRunPlanner.AssertExpectations(t)
+
+			mockToolPolicy := &mocks.MockToolPolicy{}
+			defer mockToolPolicy.AssertExpectations(t)
+
+			arguments := json.RawMessage(`{"dry_run":true,"script_path":"/home/user/script.m"}`)
+
+			mockDryRunPlanner.EXPECT().
+				Applies("run_matlab_file", arguments).
+				Return(true).
+				Once()
+
+			mockDryRunPlanner.EXPECT().
+				Plan("run_matlab_file", arguments).
+				Return("Dry run: the call to run_matlab_file was not run.\n").
+				Once()
+
+			mockToolPolicy.EXPECT().
+				Evaluate(toolpolicy.Call{Tool: "run_matlab_file", Paths: []string{"/home/user/script.m"}}).
+				Return(testCase.decision).
+				Once()
+
+			// Act
+			result, toolCalled := callRunMATLABFileDryRun(t, mockDryRunPlanner, mockToolPolicy)
+
+			// Assert
+			assert.False(t, result.IsError, "Dry runs should not be reported as failures")
+			assert.False(t, toolCalled, "Dry runs should not run the tool")
+			require.Len(t, result.Content, 1)
+			textContent, ok := result.Content[0].(*mcp.TextContent)
+			require.True(t, ok)
+			assert.Equal(t, testCase.expectedText, textContent.Text)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/elicitation"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const elicitationActionAccept = "accept"
+
+// elicitationMiddleware lets tool calls ask the user of the client for a confirmation, if the client supports elicitation.
+func elicitationMiddleware(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		if method != methodCallTool {
+			return next(ctx, method, req)
+		}
+
+		session, ok := req.GetSession().(*mcp.ServerSession)
+		if !ok || session == nil || !supportsElicitation(session) {
+			return next(ctx, method, req)
+		}
+
+		ctx = elicitation.NewContext(ctx, func(ctx context.Context, message string) (bool, error) {
+			return confirmWithClient(ctx, session, message)
+		})
+		return next(ctx, method, req)
+	}
+}
+
+func supportsElicitation(session *mcp.ServerSession) bool {
+	initializeParams := session.InitializeParams()
+	return initializeParams != nil && initializeParams.Capabilities != nil && initializeParams.Capabilities.Elicitation != nil
+}
+
+func confirmWithClient(ctx context.Context, session *mcp.ServerSession, message string) (bool, error) {
+	result, err := session.Elicit(ctx, &mcp.ElicitParams{
+		Message: message,
+		RequestedSchema: map[string]any{
+			"type":       "object",
+			"properties": map[string]any{},
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return result.Action == elicitationActionAccept, nil
+}
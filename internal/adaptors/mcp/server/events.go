@@ -0,0 +1,124 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/clientidentity"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/correlationid"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	EventsResourceURI      = "matlab://server/events"
+	eventsResourceName     = "server-events"
+	eventsResourceMIMEType = "application/json"
+)
+
+const methodCallTool = "tools/call"
+
+// toolCallFailureMiddleware records every failed tool call in the event buffer,
+// whether the tool returned an error result or the call itself failed.
+// Calls rejected by the tool policy, the code policy or the user are recorded as policy violations.
+func toolCallFailureMiddleware(eventBuffer EventBuffer) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			result, err := next(ctx, method, req)
+			if method != methodCallTool {
+				return result, err
+			}
+
+			callToolResult, isCallToolResult := result.(*mcp.CallToolResult)
+			if err == nil && (!isCallToolResult || !callToolResult.IsError) {
+				return result, err
+			}
+
+			details := map[string]any{}
+			if params, ok := req.GetParams().(*mcp.CallToolParamsRaw); ok {
+				details["tool-name"] = params.Name
+			}
+			if correlationID, ok := correlationid.FromContext(ctx); ok {
+				details[correlationid.LogKey] = correlationID
+			}
+			if identity, ok := clientidentity.FromContext(ctx); ok {
+				details[clientidentity.UserLogKey] = identity.User
+				details[clientidentity.ClientLogKey] = identity.Client
+			}
+			if err != nil {
+				details["error"] = err.Error()
+			}
+			kind, message := entities.EventKindToolCallFailed, "Tool call failed"
+			if failure, ok := toolFailureOf(result); ok {
+				details["error-code"] = string(failure.Code)
+				details["error"] = failure.Message
+				if failure.Code == entities.ErrorCodePolicyViolation {
+					kind, message = entities.EventKindPolicyViolation, "Tool call rejected by the policy"
+				}
+			}
+			eventBuffer.Record(kind, message, details)
+
+			return result, err
+		}
+	}
+}
+
+// toolCallEventMiddleware publishes every tool call to the event sink, with its duration and whether it failed, but
+// not its arguments and result, which the session recording holds.
+func toolCallEventMiddleware(eventSink EventSink) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			start := time.Now()
+			result, err := next(ctx, method, req)
+			if method != methodCallTool {
+				return result, err
+			}
+
+			callToolResult, isCallToolResult := result.(*mcp.CallToolResult)
+			details := map[string]any{
+				"duration-ms": time.Since(start).Milliseconds(),
+				"failed":      err != nil || (isCallToolResult && callToolResult.IsError),
+			}
+			if params, ok := req.GetParams().(*mcp.CallToolParamsRaw); ok {
+				details["tool-name"] = params.Name
+			}
+			if correlationID, ok := correlationid.FromContext(ctx); ok {
+				details[correlationid.LogKey] = correlationID
+			}
+			if identity, ok := clientidentity.FromContext(ctx); ok {
+				details[clientidentity.UserLogKey] = identity.User
+				details[clientidentity.ClientLogKey] = identity.Client
+			}
+			eventSink.Publish(entities.Event{
+				Time:    start,
+				Kind:    entities.EventKindToolCalled,
+				Message: "Tool called",
+				Details: details,
+			})
+
+			return result, err
+		}
+	}
+}
+
+func eventsResourceHandler(eventBuffer EventBuffer) mcp.ResourceHandler {
+	return func(_ context.Context, _ *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+		data, err := json.Marshal(eventBuffer.Events())
+		if err != nil {
+			return nil, err
+		}
+
+		return &mcp.ReadResourceResult{
+			Contents: []*mcp.ResourceContents{
+				{
+					URI:      EventsResourceURI,
+					MIMEType: eventsResourceMIMEType,
+					Text:     string(data),
+				},
+			},
+		}, nil
+	}
+}
@@ -0,0 +1,299 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/correlationid"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/toolfailure"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/server"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToolCallFailureMiddleware_RecordsErrorResult(t *testing.T) {
+	// Arrange
+	mockEventBuffer := &mocks.MockEventBuffer{}
+	defer mockEventBuffer.AssertExpectations(t)
+
+	expectedCorrelationID := "test-correlation-id"
+	expectedResult := &mcp.CallToolResult{IsError: true}
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return expectedResult, nil
+	}
+
+	mockEventBuffer.EXPECT().
+		Record(entities.EventKindToolCallFailed, "Tool call failed", map[string]any{
+			"tool-name":          "evaluate_matlab_code",
+			correlationid.LogKey: expectedCorrelationID,
+		}).
+		Return().
+		Once()
+
+	handler := server.ToolCallFailureMiddleware(mockEventBuffer)(next)
+	ctx := correlationid.NewContext(t.Context(), expectedCorrelationID)
+
+	// Act
+	result, err := handler(ctx, "tools/call", &mcp.CallToolRequest{
+		Params: &mcp.CallToolParamsRaw{Name: "evaluate_matlab_code"},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedResult, result, "Result should be passed through")
+}
+
+func TestToolCallFailureMiddleware_RecordsError(t *testing.T) {
+	// Arrange
+	mockEventBuffer := &mocks.MockEventBuffer{}
+	defer mockEventBuffer.AssertExpectations(t)
+
+	expectedError := assert.AnError
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return nil, expectedError
+	}
+
+	mockEventBuffer.EXPECT().
+		Record(entities.EventKindToolCallFailed, "Tool call failed", map[string]any{
+			"tool-name": "evaluate_matlab_code",
+			"error":     expectedError.Error(),
+		}).
+		Return().
+		Once()
+
+	handler := server.ToolCallFailureMiddleware(mockEventBuffer)(next)
+
+	// Act
+	_, err := handler(t.Context(), "tools/call", &mcp.CallToolRequest{
+		Params: &mcp.CallToolParamsRaw{Name: "evaluate_matlab_code"},
+	})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestToolCallFailureMiddleware_IgnoresSuccessfulCallsAndOtherMethods(t *testing.T) {
+	// Arrange
+	mockEventBuffer := &mocks.MockEventBuffer{}
+	defer mockEventBuffer.AssertExpectations(t)
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		if method == "tools/call" {
+			return &mcp.CallToolResult{}, nil
+		}
+		return nil, assert.AnError
+	}
+
+	handler := server.ToolCallFailureMiddleware(mockEventBuffer)(next)
+
+	// Act
+	_, successErr := handler(t.Context(), "tools/call", &mcp.CallToolRequest{})
+	_, otherErr := handler(t.Context(), "resources/read", &mcp.ReadResourceRequest{})
+
+	// Assert
+	require.NoError(t, successErr)
+	require.ErrorIs(t, otherErr, assert.AnError)
+}
+
+func TestEventsResourceHandler_HappyPath(t *testing.T) {
+	// Arrange
+	mockEventBuffer := &mocks.MockEventBuffer{}
+	defer mockEventBuffer.AssertExpectations(t)
+
+	expectedEvents := []entities.Event{
+		{
+			Time:    time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+			Kind:    entities.EventKindServerStarted,
+			Message: "Server started",
+		},
+	}
+
+	mockEventBuffer.EXPECT().
+		Events().
+		Return(expectedEvents).
+		Once()
+
+	handler := server.EventsResourceHandler(mockEventBuffer)
+
+	// Act
+	result, err := handler(t.Context(), &mcp.ReadResourceRequest{})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.Contents, 1)
+	assert.Equal(t, server.EventsResourceURI, result.Contents[0].URI)
+	assert.Equal(t, "application/json", result.Contents[0].MIMEType)
+
+	var actualEvents []entities.Event
+	require.NoError(t, json.Unmarshal([]byte(result.Contents[0].Text), &actualEvents))
+	assert.Equal(t, expectedEvents, actualEvents)
+}
+
+func TestToolCallFailureMiddleware_RecordsErrorCode(t *testing.T) {
+	// Arrange
+	mockEventBuffer := &mocks.MockEventBuffer{}
+	defer mockEventBuffer.AssertExpectations(t)
+
+	expectedResult := &mcp.CallToolResult{
+		IsError: true,
+		Meta: mcp.Meta{
+			toolfailure.MetaKey: toolfailure.Failure{
+				Code:    entities.ErrorCodeEvalTimeout,
+				Message: "failed to send request: context deadline exceeded",
+			},
+		},
+	}
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return expectedResult, nil
+	}
+
+	mockEventBuffer.EXPECT().
+		Record(entities.EventKindToolCallFailed, "Tool call failed", map[string]any{
+			"tool-name":  "evaluate_matlab_code",
+			"error-code": "EVAL_TIMEOUT",
+			"error":      "failed to send request: context deadline exceeded",
+		}).
+		Return().
+		Once()
+
+	handler := server.ToolCallFailureMiddleware(mockEventBuffer)(next)
+
+	// Act
+	_, err := handler(t.Context(), "tools/call", &mcp.CallToolRequest{
+		Params: &mcp.CallToolParamsRaw{Name: "evaluate_matlab_code"},
+	})
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestToolCallFailureMiddleware_RecordsPolicyViolation(t *testing.T) {
+	// Arrange
+	mockEventBuffer := &mocks.MockEventBuffer{}
+	defer mockEventBuffer.AssertExpectations(t)
+
+	expectedResult := &mcp.CallToolResult{
+		IsError: true,
+		Meta: mcp.Meta{
+			toolfailure.MetaKey: toolfailure.Failure{
+				Code:    entities.ErrorCodePolicyViolation,
+				Message: "the call to evaluate_matlab_code is denied by the tool policy",
+			},
+		},
+	}
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return expectedResult, nil
+	}
+
+	mockEventBuffer.EXPECT().
+		Record(entities.EventKindPolicyViolation, "Tool call rejected by the policy", map[string]any{
+			"tool-name":  "evaluate_matlab_code",
+			"error-code": "POLICY_VIOLATION",
+			"error":      "the call to evaluate_matlab_code is denied by the tool policy",
+		}).
+		Return().
+		Once()
+
+	handler := server.ToolCallFailureMiddleware(mockEventBuffer)(next)
+
+	// Act
+	_, err := handler(t.Context(), "tools/call", &mcp.CallToolRequest{
+		Params: &mcp.CallToolParamsRaw{Name: "evaluate_matlab_code"},
+	})
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestToolCallEventMiddleware_PublishesToolCalls(t *testing.T) {
+	testConfigs := []struct {
+		name           string
+		result         mcp.Result
+		err            error
+		expectedFailed bool
+	}{
+		{
+			name:   "succeeded",
+			result: &mcp.CallToolResult{},
+		},
+		{
+			name:           "error result",
+			result:         &mcp.CallToolResult{IsError: true},
+			expectedFailed: true,
+		},
+		{
+			name:           "error",
+			err:            assert.AnError,
+			expectedFailed: true,
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockEventSink := &mocks.MockEventSink{}
+			defer mockEventSink.AssertExpectations(t)
+
+			next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+				return testConfig.result, testConfig.err
+			}
+
+			var publishedEvent entities.Event
+			mockEventSink.EXPECT().
+				Publish(mock.Anything).
+				Run(func(event entities.Event) {
+					publishedEvent = event
+				}).
+				Return().
+				Once()
+
+			handler := server.ToolCallEventMiddleware(mockEventSink)(next)
+			ctx := correlationid.NewContext(t.Context(), "test-correlation-id")
+
+			// Act
+			_, err := handler(ctx, "tools/call", &mcp.CallToolRequest{
+				Params: &mcp.CallToolParamsRaw{Name: "evaluate_matlab_code", Arguments: json.RawMessage(`{"code":"x = 1"}`)},
+			})
+
+			// Assert
+			require.ErrorIs(t, err, testConfig.err)
+			assert.Equal(t, entities.EventKindToolCalled, publishedEvent.Kind)
+			assert.False(t, publishedEvent.Time.IsZero(), "Event time should be set")
+			assert.Equal(t, "evaluate_matlab_code", publishedEvent.Details["tool-name"])
+			assert.Equal(t, "test-correlation-id", publishedEvent.Details[correlationid.LogKey])
+			assert.Equal(t, testConfig.expectedFailed, publishedEvent.Details["failed"])
+			assert.Contains(t, publishedEvent.Details, "duration-ms")
+			assert.NotContains(t, publishedEvent.Details, "arguments", "Arguments should not be published")
+		})
+	}
+}
+
+func TestToolCallEventMiddleware_IgnoresOtherMethods(t *testing.T) {
+	// Arrange
+	mockEventSink := &mocks.MockEventSink{}
+	defer mockEventSink.AssertExpectations(t)
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return &mcp.ListToolsResult{}, nil
+	}
+
+	handler := server.ToolCallEventMiddleware(mockEventSink)(next)
+
+	// Act
+	_, err := handler(t.Context(), "tools/list", &mcp.ListToolsRequest{})
+
+	// Assert
+	require.NoError(t, err)
+}
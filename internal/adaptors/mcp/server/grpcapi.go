@@ -0,0 +1,603 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/protowire"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/toolfailure"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	// grpcServicePath is the path prefix of the methods of the gRPC service, described in assets/matlab.proto.
+	grpcServicePath = "/matlabmcp.v1.MATLAB/"
+
+	// grpcSessionID is the session ID of the MCP session the gRPC service calls the tools in. It has the prefix of the
+	// HTTP transport, so that the limits of the HTTP transport apply to the calls of the gRPC service.
+	grpcSessionID = httpSessionIDPrefix + "grpc"
+
+	// grpcClientName is the client name the calls of the gRPC service are attributed to.
+	grpcClientName = "grpc"
+
+	// maxGRPCMessageBytes is the size of the largest message accepted from a gRPC client.
+	maxGRPCMessageBytes = maxWebSocketMessageBytes
+
+	// grpcOutputChunkBytes is the size of the chunks ExecuteCodeStream sends the output in, below the 4 MiB maximum
+	// message size of the gRPC clients by default.
+	grpcOutputChunkBytes = 64 << 10
+
+	grpcContentType = "application/grpc"
+)
+
+// The tools and resources the gRPC service calls.
+const (
+	evaluateMATLABCodeTool  = "evaluate_matlab_code"
+	evalInMATLABSessionTool = "eval_in_matlab_session"
+	runMATLABTestFileTool   = "run_matlab_test_file"
+	matlabVariableURIPrefix = "matlab://workspace/"
+)
+
+const (
+	grpcStatusTrailer  = "Grpc-Status"
+	grpcMessageTrailer = "Grpc-Message"
+)
+
+// grpcCode is a status code of gRPC.
+type grpcCode int
+
+const (
+	grpcOK                 grpcCode = 0
+	grpcCanceled           grpcCode = 1
+	grpcUnknown            grpcCode = 2
+	grpcInvalidArgument    grpcCode = 3
+	grpcDeadlineExceeded   grpcCode = 4
+	grpcNotFound           grpcCode = 5
+	grpcPermissionDenied   grpcCode = 7
+	grpcResourceExhausted  grpcCode = 8
+	grpcFailedPrecondition grpcCode = 9
+	grpcUnimplemented      grpcCode = 12
+	grpcInternal           grpcCode = 13
+	grpcUnavailable        grpcCode = 14
+)
+
+// jsonRPCInvalidParams is the code of the JSON-RPC error answered to tool calls whose arguments do not match the input
+// schema of the tool.
+const jsonRPCInvalidParams = -32602
+
+// grpcError is an error answered to a gRPC client with its code.
+type grpcError struct {
+	code    grpcCode
+	message string
+}
+
+func (e *grpcError) Error() string {
+	return e.message
+}
+
+func newGRPCError(code grpcCode, format string, args ...any) *grpcError {
+	return &grpcError{code: code, message: fmt.Sprintf(format, args...)}
+}
+
+// grpcAPI serves the MATLAB gRPC service, for services that want typed access to MATLAB without speaking JSON-RPC.
+// gRPC runs over HTTP/2 without TLS, as the server only listens on loopback addresses, and messages are encoded
+// without generated code, as the messages of the service are flat.
+type grpcAPI struct {
+	session *mcp.ClientSession
+
+	lock      *sync.Mutex
+	lastToken int
+	streams   map[string]*grpcOutputStream
+}
+
+// newGRPCAPI connects the gRPC service to the MCP server, in a session that lasts until ctx is done.
+func (s *Server) newGRPCAPI(ctx context.Context) (*grpcAPI, error) {
+	api := &grpcAPI{
+		lock:    new(sync.Mutex),
+		streams: map[string]*grpcOutputStream{},
+	}
+
+	session, err := s.connectInProcess(ctx, grpcSessionID, grpcClientName, &mcp.ClientOptions{
+		ProgressNotificationHandler: api.handleProgress,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	api.session = session
+	return api, nil
+}
+
+// register adds the methods of the gRPC service to mux.
+func (a *grpcAPI) register(mux *http.ServeMux) {
+	mux.Handle("POST "+grpcServicePath+"{method}", loopbackOnly(http.HandlerFunc(a.serve)))
+}
+
+func (a *grpcAPI) close() error {
+	return a.session.Close()
+}
+
+// serve reads the request message of a call, runs the method of the path, and answers with its response messages
+// and status.
+func (a *grpcAPI) serve(w http.ResponseWriter, r *http.Request) {
+	if r.ProtoMajor != 2 {
+		http.Error(w, "HTTP Version Not Supported: gRPC needs HTTP/2", http.StatusHTTPVersionNotSupported)
+		return
+	}
+
+	if !strings.HasPrefix(r.Header.Get("Content-Type"), grpcContentType) {
+		http.Error(w, "Unsupported Media Type: expected "+grpcContentType, http.StatusUnsupportedMediaType)
+		return
+	}
+
+	w.Header().Set("Content-Type", grpcContentType)
+	w.Header().Set("Trailer", grpcStatusTrailer+", "+grpcMessageTrailer)
+	w.WriteHeader(http.StatusOK)
+
+	request, err := readGRPCMessage(r.Body)
+	if err != nil {
+		writeGRPCStatus(w, err)
+		return
+	}
+
+	send := func(message []byte) error {
+		return writeGRPCMessage(w, message)
+	}
+
+	switch method := r.PathValue("method"); method {
+	case "ExecuteCode":
+		err = a.executeCode(r.Context(), request, send)
+	case "ExecuteCodeStream":
+		err = a.executeCodeStream(r.Context(), request, send)
+	case "RunTests":
+		err = a.runTests(r.Context(), request, send)
+	case "GetVariable":
+		err = a.getVariable(r.Context(), request, send)
+	default:
+		err = newGRPCError(grpcUnimplemented, "unknown method %s", method)
+	}
+
+	if r.Context().Err() != nil {
+		err = newGRPCError(grpcCanceled, "the call was cancelled")
+	}
+	writeGRPCStatus(w, err)
+}
+
+func (a *grpcAPI) executeCode(ctx context.Context, request protowire.Fields, send func([]byte) error) error {
+	result, err := a.callEvaluation(ctx, request, "")
+	if err != nil {
+		return err
+	}
+
+	return send(encodeToolResult(result))
+}
+
+// executeCodeStream sends the output the server streams as progress notifications while the call runs, and then the
+// rest of the output, from the result of the call.
+func (a *grpcAPI) executeCodeStream(ctx context.Context, request protowire.Fields, send func([]byte) error) error {
+	progressToken, stream := a.openStream(send)
+	defer a.closeStream(progressToken)
+
+	result, err := a.callEvaluation(ctx, request, progressToken)
+	if err != nil {
+		return err
+	}
+
+	// The notifications of the client are handled after the responses, so the last chunks may still be on their way.
+	streamed, _ := result.Meta[StreamedOutputMetaKey].(float64)
+	if err := stream.wait(ctx, int(streamed)); err != nil {
+		return err
+	}
+	a.closeStream(progressToken)
+
+	output := resultText(result)
+	if streamed > 0 {
+		output = trimStreamedOutputNote(output)
+	}
+	return sendOutputChunks(send, output, result.IsError)
+}
+
+// sendOutputChunks sends output as OutputChunk messages of at most grpcOutputChunkBytes, with isError on the last one.
+func sendOutputChunks(send func([]byte) error, output string, isError bool) error {
+	for {
+		chunk := output[:chunkEnd(output, grpcOutputChunkBytes)]
+		output = output[len(chunk):]
+
+		encoder := &protowire.Encoder{}
+		encoder.String(1, chunk)
+		encoder.Bool(2, output == "" && isError)
+		if err := send(encoder.Bytes()); err != nil {
+			return err
+		}
+
+		if output == "" {
+			return nil
+		}
+	}
+}
+
+// openStream starts forwarding the output chunks of the progress notifications of a new progress token to send.
+func (a *grpcAPI) openStream(send func([]byte) error) (string, *grpcOutputStream) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	a.lastToken++
+	progressToken := fmt.Sprintf("%s-%d", grpcClientName, a.lastToken)
+	stream := newGRPCOutputStream(send)
+	a.streams[progressToken] = stream
+	return progressToken, stream
+}
+
+// closeStream stops forwarding the output chunks of progressToken. Closing a closed stream does nothing.
+func (a *grpcAPI) closeStream(progressToken string) {
+	a.lock.Lock()
+	stream, ok := a.streams[progressToken]
+	delete(a.streams, progressToken)
+	a.lock.Unlock()
+
+	if ok {
+		stream.close()
+	}
+}
+
+// handleProgress forwards the output chunks of the progress notifications to the ExecuteCodeStream call of their
+// progress token. The notifications reporting the time elapsed are ignored.
+func (a *grpcAPI) handleProgress(_ context.Context, req *mcp.ProgressNotificationClientRequest) {
+	if isOutput, _ := req.Params.Meta[OutputChunkMetaKey].(bool); !isOutput {
+		return
+	}
+
+	progressToken, ok := req.Params.ProgressToken.(string)
+	if !ok {
+		return
+	}
+
+	a.lock.Lock()
+	stream, ok := a.streams[progressToken]
+	a.lock.Unlock()
+
+	if ok {
+		stream.forward(req.Params.Message)
+	}
+}
+
+// grpcOutputStream sends the output chunks of an ExecuteCodeStream call to its client as they arrive, until it is closed.
+type grpcOutputStream struct {
+	send func([]byte) error
+
+	lock      *sync.Mutex
+	closed    bool
+	forwarded int
+	err       error
+	// progressed is closed and replaced every time output is forwarded.
+	progressed chan struct{}
+}
+
+func newGRPCOutputStream(send func([]byte) error) *grpcOutputStream {
+	return &grpcOutputStream{
+		send:       send,
+		lock:       new(sync.Mutex),
+		progressed: make(chan struct{}),
+	}
+}
+
+func (s *grpcOutputStream) forward(output string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.closed {
+		return
+	}
+
+	if s.err == nil {
+		s.err = sendOutputChunks(s.send, output, false)
+	}
+	s.forwarded += len(output)
+	close(s.progressed)
+	s.progressed = make(chan struct{})
+}
+
+// wait waits until at least n bytes of output were forwarded, and returns the error of sending them, if any.
+func (s *grpcOutputStream) wait(ctx context.Context, n int) error {
+	for {
+		s.lock.Lock()
+		forwarded, progressed, err := s.forwarded, s.progressed, s.err
+		s.lock.Unlock()
+
+		if err != nil {
+			return err
+		}
+		if forwarded >= n {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-progressed:
+		}
+	}
+}
+
+func (s *grpcOutputStream) close() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.closed = true
+}
+
+// callEvaluation evaluates the code of an ExecuteCodeRequest, in the MATLAB session it names, if any. progressToken is
+// the progress token of the call, empty for none.
+func (a *grpcAPI) callEvaluation(ctx context.Context, request protowire.Fields, progressToken string) (*mcp.CallToolResult, error) {
+	arguments := map[string]any{
+		"code":         request.String(1),
+		"project_path": request.String(2),
+	}
+
+	tool := evaluateMATLABCodeTool
+	if sessionID := request.Int64(3); sessionID != 0 {
+		tool = evalInMATLABSessionTool
+		arguments["session_id"] = sessionID
+	}
+
+	return a.callTool(ctx, tool, arguments, progressToken)
+}
+
+func (a *grpcAPI) runTests(ctx context.Context, request protowire.Fields, send func([]byte) error) error {
+	arguments := map[string]any{
+		"script_path": request.String(1),
+	}
+	if request.Bool(2) {
+		arguments["parallel"] = true
+	}
+
+	result, err := a.callTool(ctx, runMATLABTestFileTool, arguments, "")
+	if err != nil {
+		return err
+	}
+
+	return send(encodeToolResult(result))
+}
+
+func (a *grpcAPI) getVariable(ctx context.Context, request protowire.Fields, send func([]byte) error) error {
+	name := request.String(1)
+	if name == "" {
+		return newGRPCError(grpcInvalidArgument, "the name of the variable is missing")
+	}
+
+	result, err := a.session.ReadResource(ctx, &mcp.ReadResourceParams{URI: matlabVariableURIPrefix + url.PathEscape(name)})
+	if err != nil {
+		return newGRPCError(grpcNotFound, "failed to read variable %s: %v", name, err)
+	}
+	if len(result.Contents) == 0 {
+		return newGRPCError(grpcNotFound, "variable %s has no contents", name)
+	}
+
+	encoder := &protowire.Encoder{}
+	encoder.String(1, result.Contents[0].Text)
+	encoder.String(2, result.Contents[0].MIMEType)
+	return send(encoder.Bytes())
+}
+
+// callTool calls tool, and answers with UNIMPLEMENTED when the server does not have it, for example because the
+// server manages several MATLAB sessions. Failed calls are answered with the code of their failure, but the calls whose
+// MATLAB code raised an error, which are returned with is_error set.
+func (a *grpcAPI) callTool(ctx context.Context, tool string, arguments map[string]any, progressToken string) (*mcp.CallToolResult, error) {
+	tools, err := listTools(ctx, a.session)
+	if err != nil {
+		return nil, newGRPCError(grpcInternal, "failed to list the tools: %v", err)
+	}
+	if _, ok := tools[tool]; !ok {
+		return nil, newGRPCError(grpcUnimplemented, "the server does not have the %s tool", tool)
+	}
+
+	params := &mcp.CallToolParams{
+		Meta:      apiKeyMeta(ctx, traceMeta(ctx)),
+		Name:      tool,
+		Arguments: arguments,
+	}
+	if progressToken != "" {
+		if params.Meta == nil {
+			params.Meta = mcp.Meta{}
+		}
+		params.SetProgressToken(progressToken)
+	}
+
+	result, err := a.session.CallTool(ctx, params)
+	if err != nil {
+		return nil, callError(ctx, err)
+	}
+
+	if failure, ok := failureOf(result); ok {
+		if code := grpcCodeOf(failure.Code); code != grpcOK {
+			return nil, newGRPCError(code, "%s: %s", failure.Code, failure.Message)
+		}
+	}
+	return result, nil
+}
+
+// callError is the gRPC error of a tool call that failed without a result.
+func callError(ctx context.Context, err error) *grpcError {
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return newGRPCError(grpcDeadlineExceeded, "the call timed out: %v", err)
+	case ctx.Err() != nil:
+		return newGRPCError(grpcCanceled, "the call was cancelled: %v", err)
+	case errors.Is(err, mcp.ErrConnectionClosed):
+		return newGRPCError(grpcUnavailable, "%v", err)
+	case jsonRPCErrorCode(err) == jsonRPCInvalidParams:
+		return newGRPCError(grpcInvalidArgument, "%v", err)
+	default:
+		return newGRPCError(grpcInternal, "%v", err)
+	}
+}
+
+// jsonRPCErrorCode is the code of the JSON-RPC error in the chain of err, 0 when there is none. The SDK does not export
+// the type of its errors, so the code is read from their JSON encoding.
+func jsonRPCErrorCode(err error) int64 {
+	for ; err != nil; err = errors.Unwrap(err) {
+		data, marshalErr := json.Marshal(err)
+		if marshalErr != nil {
+			continue
+		}
+
+		var wireError struct {
+			Code int64 `json:"code"`
+		}
+		if json.Unmarshal(data, &wireError) == nil && wireError.Code != 0 {
+			return wireError.Code
+		}
+	}
+	return 0
+}
+
+// failureOf returns the structured failure of a failed tool call result, from its `_meta` field.
+func failureOf(result *mcp.CallToolResult) (toolfailure.Failure, bool) {
+	meta, ok := result.Meta[toolfailure.MetaKey]
+	if !ok || !result.IsError {
+		return toolfailure.Failure{}, false
+	}
+
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return toolfailure.Failure{}, false
+	}
+
+	var failure toolfailure.Failure
+	if err := json.Unmarshal(data, &failure); err != nil || failure.Code == "" {
+		return toolfailure.Failure{}, false
+	}
+	return failure, true
+}
+
+// grpcCodeOf is the gRPC code of a tool call that failed with code. It is OK for the errors raised by the MATLAB code,
+// as the evaluation ran, and its output tells what failed.
+func grpcCodeOf(code entities.ErrorCode) grpcCode {
+	switch code {
+	case entities.ErrorCodeMATLABError:
+		return grpcOK
+	case entities.ErrorCodeInvalidInput, entities.ErrorCodeSyntaxError:
+		return grpcInvalidArgument
+	case entities.ErrorCodeCancelled:
+		return grpcCanceled
+	case entities.ErrorCodeEvalTimeout:
+		return grpcDeadlineExceeded
+	case entities.ErrorCodeSessionNotFound:
+		return grpcNotFound
+	case entities.ErrorCodePermissionDenied, entities.ErrorCodePolicyViolation:
+		return grpcPermissionDenied
+	case entities.ErrorCodeLimitExceeded, entities.ErrorCodeRateLimited:
+		return grpcResourceExhausted
+	case entities.ErrorCodeMATLABNotFound, entities.ErrorCodeLicenseUnavailable:
+		return grpcFailedPrecondition
+	case entities.ErrorCodeMATLABStartFailed, entities.ErrorCodeSessionCrashed, entities.ErrorCodeShuttingDown, entities.ErrorCodeDownstreamError:
+		return grpcUnavailable
+	default:
+		return grpcInternal
+	}
+}
+
+// resultText is the text content of result, one content per line.
+func resultText(result *mcp.CallToolResult) string {
+	var texts []string
+	for _, content := range result.Content {
+		if textContent, ok := content.(*mcp.TextContent); ok {
+			texts = append(texts, textContent.Text)
+		}
+	}
+	return strings.Join(texts, "\n")
+}
+
+func encodeToolResult(result *mcp.CallToolResult) []byte {
+	encoder := &protowire.Encoder{}
+	encoder.String(1, resultText(result))
+	encoder.Bool(2, result.IsError)
+	if result.StructuredContent != nil {
+		if data, err := json.Marshal(result.StructuredContent); err == nil {
+			encoder.String(3, string(data))
+		}
+	}
+	return encoder.Bytes()
+}
+
+// readGRPCMessage reads the single length-prefixed message of a unary or server streaming call.
+func readGRPCMessage(body io.Reader) (protowire.Fields, error) {
+	prefix := make([]byte, 5)
+	if _, err := io.ReadFull(body, prefix); err != nil {
+		return nil, newGRPCError(grpcInvalidArgument, "failed to read the request message: %v", err)
+	}
+
+	if prefix[0] != 0 {
+		return nil, newGRPCError(grpcUnimplemented, "compressed messages are not supported")
+	}
+
+	length := binary.BigEndian.Uint32(prefix[1:])
+	if length > maxGRPCMessageBytes {
+		return nil, newGRPCError(grpcResourceExhausted, "the request message is larger than %d bytes", maxGRPCMessageBytes)
+	}
+
+	data := make([]byte, length)
+	if _, err := io.ReadFull(body, data); err != nil {
+		return nil, newGRPCError(grpcInvalidArgument, "failed to read the request message: %v", err)
+	}
+
+	fields, err := protowire.Decode(data)
+	if err != nil {
+		return nil, newGRPCError(grpcInvalidArgument, "failed to decode the request message: %v", err)
+	}
+	return fields, nil
+}
+
+func writeGRPCMessage(w http.ResponseWriter, message []byte) error {
+	frame := binary.BigEndian.AppendUint32([]byte{0}, uint32(len(message)))
+	if _, err := w.Write(append(frame, message...)); err != nil {
+		return err
+	}
+	return http.NewResponseController(w).Flush()
+}
+
+// writeGRPCStatus sets the status of the call in the trailers of the response, OK when err is nil.
+func writeGRPCStatus(w http.ResponseWriter, err error) {
+	code := grpcOK
+	message := ""
+	if err != nil {
+		code = grpcUnknown
+		message = err.Error()
+
+		var statusErr *grpcError
+		if errors.As(err, &statusErr) {
+			code = statusErr.code
+		}
+	}
+
+	w.Header().Set(grpcStatusTrailer, strconv.Itoa(int(code)))
+	if message != "" {
+		w.Header().Set(grpcMessageTrailer, percentEncode(message))
+	}
+}
+
+// percentEncode encodes message as the grpc-message trailer expects, with the bytes outside of printable ASCII and
+// the percent sign percent-encoded.
+func percentEncode(message string) string {
+	var builder strings.Builder
+	for i := 0; i < len(message); i++ {
+		c := message[i]
+		if c < 0x20 || c > 0x7E || c == '%' {
+			fmt.Fprintf(&builder, "%%%02X", c)
+			continue
+		}
+		builder.WriteByte(c)
+	}
+	return builder.String()
+}
@@ -0,0 +1,464 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/protowire"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/toolfailure"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type evalInput struct {
+	Code        string `json:"code"`
+	ProjectPath string `json:"project_path"`
+}
+
+type sessionEvalInput struct {
+	Code      string `json:"code"`
+	SessionID string `json:"session_id"`
+}
+
+type testFileInput struct {
+	ScriptPath string `json:"script_path"`
+	Parallel   bool   `json:"parallel,omitempty"`
+}
+
+type testFileOutput struct {
+	Passed int `json:"passed"`
+}
+
+// newGRPCServer serves the gRPC service of an MCP server whose evaluate_matlab_code tool echoes the code and the
+// project path, and fails when the code is "error", with a run_matlab_test_file tool and a workspace variable x.
+// The code "fail CODE" fails the call with the error code CODE, and the code "stream" streams its output as the server
+// does.
+func newGRPCServer(t *testing.T, withTools bool) *httptest.Server {
+	t.Helper()
+
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "v1.2.3"}, nil)
+	if withTools {
+		mcp.AddTool(mcpServer, &mcp.Tool{Name: "evaluate_matlab_code"}, func(ctx context.Context, req *mcp.CallToolRequest, input evalInput) (*mcp.CallToolResult, any, error) {
+			if code, ok := strings.CutPrefix(input.Code, "fail "); ok {
+				return &mcp.CallToolResult{
+					IsError: true,
+					Content: []mcp.Content{&mcp.TextContent{Text: "the call failed"}},
+					Meta:    mcp.Meta{toolfailure.MetaKey: toolfailure.Failure{Code: entities.ErrorCode(code), Message: "the call failed"}},
+				}, nil, nil
+			}
+
+			switch input.Code {
+			case "stream":
+				notifyOutput(ctx, req, "first ", 6)
+				require.NoError(t, req.Session.NotifyProgress(ctx, &mcp.ProgressNotificationParams{
+					ProgressToken: req.Params.GetProgressToken(),
+					Message:       "evaluate_matlab_code has been running for 1s",
+					Progress:      7,
+				}))
+				notifyOutput(ctx, req, "second ", 14)
+				return &mcp.CallToolResult{
+					Content: []mcp.Content{&mcp.TextContent{Text: "[The first 13 bytes of this output were sent as progress notifications.]\nthird"}},
+					Meta:    mcp.Meta{server.StreamedOutputMetaKey: 13},
+				}, nil, nil
+			}
+
+			return &mcp.CallToolResult{
+				IsError: input.Code == "error",
+				Content: []mcp.Content{&mcp.TextContent{Text: input.ProjectPath + ": " + input.Code}},
+			}, nil, nil
+		})
+		mcp.AddTool(mcpServer, &mcp.Tool{Name: "eval_in_matlab_session"}, func(_ context.Context, _ *mcp.CallToolRequest, input sessionEvalInput) (*mcp.CallToolResult, any, error) {
+			return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: input.SessionID + ": " + input.Code}}}, nil, nil
+		})
+		mcp.AddTool(mcpServer, &mcp.Tool{Name: "run_matlab_test_file"}, func(_ context.Context, _ *mcp.CallToolRequest, input testFileInput) (*mcp.CallToolResult, testFileOutput, error) {
+			return nil, testFileOutput{Passed: 3}, nil
+		})
+	}
+	mcpServer.AddResourceTemplate(&mcp.ResourceTemplate{URITemplate: "matlab://workspace/{name}", Name: "matlab-variable"}, func(_ context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+		if req.Params.URI != "matlab://workspace/x" {
+			return nil, mcp.ResourceNotFoundError(req.Params.URI)
+		}
+		return &mcp.ReadResourceResult{Contents: []*mcp.ResourceContents{{URI: req.Params.URI, MIMEType: "application/json", Text: "[1,2,3]"}}}, nil
+	})
+
+	return serveGRPC(t, mcpServer)
+}
+
+// serveGRPC serves the gRPC service of mcpServer.
+func serveGRPC(t *testing.T, mcpServer *mcp.Server) *httptest.Server {
+	t.Helper()
+
+	srv := server.NewWithMCPServer(mcpServer, testutils.NewInspectableLogger())
+	handler, closeGRPCAPI, err := srv.NewGRPCHandler(t.Context())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = closeGRPCAPI() })
+
+	httpServer := httptest.NewUnstartedServer(handler)
+	httpServer.Config.Protocols = &http.Protocols{}
+	httpServer.Config.Protocols.SetHTTP1(true)
+	httpServer.Config.Protocols.SetUnencryptedHTTP2(true)
+	httpServer.Start()
+	t.Cleanup(httpServer.Close)
+	return httpServer
+}
+
+// notifyOutput sends output as a progress notification, as the server streams the output of tool calls.
+func notifyOutput(ctx context.Context, req *mcp.CallToolRequest, output string, progress float64) {
+	_ = req.Session.NotifyProgress(ctx, &mcp.ProgressNotificationParams{
+		Meta:          mcp.Meta{server.OutputChunkMetaKey: true},
+		ProgressToken: req.Params.GetProgressToken(),
+		Message:       output,
+		Progress:      progress,
+	})
+}
+
+type grpcResponse struct {
+	messages []protowire.Fields
+	status   string
+	message  string
+}
+
+// postGRPC starts a call of method with the request message, over HTTP/2 without TLS, as gRPC clients do.
+func postGRPC(t *testing.T, ctx context.Context, httpServer *httptest.Server, method string, request []byte) *http.Response {
+	t.Helper()
+
+	protocols := &http.Protocols{}
+	protocols.SetUnencryptedHTTP2(true)
+	client := &http.Client{Transport: &http.Transport{Protocols: protocols}}
+
+	body := binary.BigEndian.AppendUint32([]byte{0}, uint32(len(request)))
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, httpServer.URL+"/matlabmcp.v1.MATLAB/"+method, bytes.NewReader(append(body, request...)))
+	require.NoError(t, err)
+	httpRequest.Header.Set("Content-Type", "application/grpc")
+	httpRequest.Header.Set("TE", "trailers")
+
+	response, err := client.Do(httpRequest)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	require.Equal(t, "application/grpc", response.Header.Get("Content-Type"))
+	return response
+}
+
+// callGRPC calls method with the request message, and reads every response message and the status of the call.
+func callGRPC(t *testing.T, httpServer *httptest.Server, method string, request []byte) grpcResponse {
+	t.Helper()
+
+	response := postGRPC(t, t.Context(), httpServer, method, request)
+	defer func() { _ = response.Body.Close() }()
+
+	data, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+
+	var messages []protowire.Fields
+	for len(data) > 0 {
+		require.GreaterOrEqual(t, len(data), 5)
+		length := int(binary.BigEndian.Uint32(data[1:5]))
+		fields, err := protowire.Decode(data[5 : 5+length])
+		require.NoError(t, err)
+		messages = append(messages, fields)
+		data = data[5+length:]
+	}
+
+	return grpcResponse{
+		messages: messages,
+		status:   response.Trailer.Get("Grpc-Status"),
+		message:  response.Trailer.Get("Grpc-Message"),
+	}
+}
+
+func encode(build func(encoder *protowire.Encoder)) []byte {
+	encoder := &protowire.Encoder{}
+	build(encoder)
+	return encoder.Bytes()
+}
+
+func TestGRPCAPI_ExecuteCode(t *testing.T) {
+	testConfigs := []struct {
+		name            string
+		code            string
+		expectedOutput  string
+		expectedIsError bool
+	}{
+		{
+			name:           "happy path",
+			code:           "disp(1)",
+			expectedOutput: "/home/user/project: disp(1)",
+		},
+		{
+			name:            "failed evaluation",
+			code:            "error",
+			expectedOutput:  "/home/user/project: error",
+			expectedIsError: true,
+		},
+		{
+			name:            "MATLAB error",
+			code:            "fail MATLAB_ERROR",
+			expectedOutput:  "the call failed",
+			expectedIsError: true,
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			httpServer := newGRPCServer(t, true)
+			request := encode(func(encoder *protowire.Encoder) {
+				encoder.String(1, testConfig.code)
+				encoder.String(2, "/home/user/project")
+			})
+
+			// Act
+			response := callGRPC(t, httpServer, "ExecuteCode", request)
+
+			// Assert
+			assert.Equal(t, "0", response.status)
+			require.Len(t, response.messages, 1)
+			assert.Equal(t, testConfig.expectedOutput, response.messages[0].String(1))
+			assert.Equal(t, testConfig.expectedIsError, response.messages[0].Bool(2))
+		})
+	}
+}
+
+func TestGRPCAPI_ExecuteCodeStream(t *testing.T) {
+	// Arrange
+	httpServer := newGRPCServer(t, true)
+	code := strings.Repeat("x", 100<<10)
+	request := encode(func(encoder *protowire.Encoder) {
+		encoder.String(1, code)
+		encoder.String(2, "/home/user/project")
+	})
+
+	// Act
+	response := callGRPC(t, httpServer, "ExecuteCodeStream", request)
+
+	// Assert
+	assert.Equal(t, "0", response.status)
+	require.Len(t, response.messages, 2, "The output should be streamed in chunks")
+	assert.Equal(t, "/home/user/project: "+code, response.messages[0].String(1)+response.messages[1].String(1))
+}
+
+func TestGRPCAPI_ExecuteCodeStream_ForwardsStreamedOutput(t *testing.T) {
+	// Arrange
+	httpServer := newGRPCServer(t, true)
+	request := encode(func(encoder *protowire.Encoder) {
+		encoder.String(1, "stream")
+	})
+
+	// Act
+	response := callGRPC(t, httpServer, "ExecuteCodeStream", request)
+
+	// Assert
+	assert.Equal(t, "0", response.status)
+	require.Len(t, response.messages, 3)
+	assert.Equal(t, "first ", response.messages[0].String(1))
+	assert.Equal(t, "second ", response.messages[1].String(1))
+	assert.Equal(t, "third", response.messages[2].String(1))
+}
+
+func TestGRPCAPI_ExecuteCodeStream_SendsOutputWhileTheCallRuns(t *testing.T) {
+	// Arrange
+	released := make(chan struct{})
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "v1.2.3"}, nil)
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "evaluate_matlab_code"}, func(ctx context.Context, req *mcp.CallToolRequest, _ evalInput) (*mcp.CallToolResult, any, error) {
+		notifyOutput(ctx, req, "first ", 6)
+		<-released
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{&mcp.TextContent{Text: "[The first 6 bytes of this output were sent as progress notifications.]\nsecond"}},
+			Meta:    mcp.Meta{server.StreamedOutputMetaKey: 6},
+		}, nil, nil
+	})
+	httpServer := serveGRPC(t, mcpServer)
+	request := encode(func(encoder *protowire.Encoder) {
+		encoder.String(1, "disp(1)")
+	})
+
+	// Act
+	response := postGRPC(t, t.Context(), httpServer, "ExecuteCodeStream", request)
+	defer func() { _ = response.Body.Close() }()
+
+	prefix := make([]byte, 5)
+	_, err := io.ReadFull(response.Body, prefix)
+	require.NoError(t, err)
+	data := make([]byte, binary.BigEndian.Uint32(prefix[1:]))
+	_, err = io.ReadFull(response.Body, data)
+	require.NoError(t, err)
+	close(released)
+
+	rest, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+
+	// Assert
+	message, err := protowire.Decode(data)
+	require.NoError(t, err)
+	assert.Equal(t, "first ", message.String(1), "The output should be sent before the call returns")
+	assert.NotEmpty(t, rest)
+	assert.Equal(t, "0", response.Trailer.Get("Grpc-Status"))
+}
+
+func TestGRPCAPI_RunTests(t *testing.T) {
+	// Arrange
+	httpServer := newGRPCServer(t, true)
+	request := encode(func(encoder *protowire.Encoder) {
+		encoder.String(1, "/home/user/project/testFoo.m")
+		encoder.Bool(2, true)
+	})
+
+	// Act
+	response := callGRPC(t, httpServer, "RunTests", request)
+
+	// Assert
+	assert.Equal(t, "0", response.status)
+	require.Len(t, response.messages, 1)
+	assert.False(t, response.messages[0].Bool(2))
+	assert.JSONEq(t, `{"passed":3}`, response.messages[0].String(3))
+}
+
+func TestGRPCAPI_GetVariable(t *testing.T) {
+	// Arrange
+	httpServer := newGRPCServer(t, true)
+	request := encode(func(encoder *protowire.Encoder) {
+		encoder.String(1, "x")
+	})
+
+	// Act
+	response := callGRPC(t, httpServer, "GetVariable", request)
+
+	// Assert
+	assert.Equal(t, "0", response.status)
+	require.Len(t, response.messages, 1)
+	assert.Equal(t, "[1,2,3]", response.messages[0].String(1))
+	assert.Equal(t, "application/json", response.messages[0].String(2))
+}
+
+func TestGRPCAPI_Errors(t *testing.T) {
+	testConfigs := []struct {
+		name           string
+		withTools      bool
+		method         string
+		request        []byte
+		expectedStatus string
+	}{
+		{
+			name:           "unknown method",
+			withTools:      true,
+			method:         "DeleteEverything",
+			expectedStatus: "12",
+		},
+		{
+			name:           "tool not available",
+			withTools:      false,
+			method:         "ExecuteCode",
+			request:        encode(func(encoder *protowire.Encoder) { encoder.String(1, "disp(1)") }),
+			expectedStatus: "12",
+		},
+		{
+			name:           "unknown variable",
+			withTools:      true,
+			method:         "GetVariable",
+			request:        encode(func(encoder *protowire.Encoder) { encoder.String(1, "y") }),
+			expectedStatus: "5",
+		},
+		{
+			name:           "missing variable name",
+			withTools:      true,
+			method:         "GetVariable",
+			expectedStatus: "3",
+		},
+		{
+			name:           "invalid arguments",
+			withTools:      true,
+			method:         "ExecuteCode",
+			request:        encode(func(encoder *protowire.Encoder) { encoder.String(1, "disp(1)"); encoder.Int64(3, 7) }),
+			expectedStatus: "3",
+		},
+		{
+			name:           "invalid input",
+			withTools:      true,
+			method:         "ExecuteCode",
+			request:        encode(func(encoder *protowire.Encoder) { encoder.String(1, "fail INVALID_INPUT") }),
+			expectedStatus: "3",
+		},
+		{
+			name:           "evaluation timed out",
+			withTools:      true,
+			method:         "ExecuteCode",
+			request:        encode(func(encoder *protowire.Encoder) { encoder.String(1, "fail EVAL_TIMEOUT") }),
+			expectedStatus: "4",
+		},
+		{
+			name:           "evaluation cancelled",
+			withTools:      true,
+			method:         "ExecuteCode",
+			request:        encode(func(encoder *protowire.Encoder) { encoder.String(1, "fail CANCELLED") }),
+			expectedStatus: "1",
+		},
+		{
+			name:           "MATLAB not found",
+			withTools:      true,
+			method:         "ExecuteCode",
+			request:        encode(func(encoder *protowire.Encoder) { encoder.String(1, "fail MATLAB_NOT_FOUND") }),
+			expectedStatus: "9",
+		},
+		{
+			name:           "session crashed",
+			withTools:      true,
+			method:         "ExecuteCodeStream",
+			request:        encode(func(encoder *protowire.Encoder) { encoder.String(1, "fail SESSION_CRASHED") }),
+			expectedStatus: "14",
+		},
+		{
+			name:           "internal error",
+			withTools:      true,
+			method:         "ExecuteCode",
+			request:        encode(func(encoder *protowire.Encoder) { encoder.String(1, "fail INTERNAL_ERROR") }),
+			expectedStatus: "13",
+		},
+		{
+			name:           "malformed request message",
+			withTools:      true,
+			method:         "ExecuteCode",
+			request:        []byte{0x0A, 0x05, 'a'},
+			expectedStatus: "3",
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			httpServer := newGRPCServer(t, testConfig.withTools)
+
+			// Act
+			response := callGRPC(t, httpServer, testConfig.method, testConfig.request)
+
+			// Assert
+			assert.Equal(t, testConfig.expectedStatus, response.status)
+			assert.NotEmpty(t, response.message)
+			assert.Empty(t, response.messages)
+		})
+	}
+}
+
+func TestGRPCAPI_RejectsHTTP1(t *testing.T) {
+	// Arrange
+	httpServer := newGRPCServer(t, true)
+
+	// Act
+	response, err := http.Post(httpServer.URL+"/matlabmcp.v1.MATLAB/ExecuteCode", "application/grpc", bytes.NewReader([]byte{0, 0, 0, 0, 0}))
+	require.NoError(t, err)
+	require.NoError(t, response.Body.Close())
+
+	// Assert
+	assert.Equal(t, http.StatusHTTPVersionNotSupported, response.StatusCode)
+}
@@ -0,0 +1,142 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/clientidentity"
+	"github.com/modelcontextprotocol/go-sdk/auth"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// mcpEndpointPath is the path the HTTP and WebSocket transports serve MCP clients on.
+const mcpEndpointPath = "/mcp"
+
+// authRealm is the realm of the bearer token challenge of the requests rejected for lack of a valid API key.
+const authRealm = "matlab-mcp-core-server"
+
+// readHeaderTimeout bounds the time a client can take to send the headers of a request.
+const readHeaderTimeout = 10 * time.Second
+
+// streamableHTTPHandler serves MCP clients with the streamable HTTP transport of the MCP specification.
+func (s *Server) streamableHTTPHandler() http.Handler {
+	return mcp.NewStreamableHTTPHandler(func(*http.Request) *mcp.Server {
+		return s.mcpServer
+	}, nil)
+}
+
+// webSocketHandler serves every client upgrading its request to a WebSocket in its own MCP session.
+// The sessions are added to sessions, so that the server can wait for them to end when it stops.
+func (s *Server) webSocketHandler(ctx context.Context, sessions *sync.WaitGroup) http.Handler {
+	var clientNumber atomic.Int64
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, reader, err := upgradeWebSocket(w, r)
+		if err != nil {
+			s.serverLogger.WithError(err).Warn("Failed to upgrade request to a WebSocket")
+			return
+		}
+
+		sessionID := webSocketSessionIDPrefix + strconv.FormatInt(clientNumber.Add(1), 10)
+		logger := s.serverLogger.With("session-id", sessionID)
+
+		// The handlers of the session run with the context of the session, which carries the client identity of the upgrade.
+		sessionContext := ctx
+		if identity, ok := clientidentity.FromContext(r.Context()); ok {
+			sessionContext = clientidentity.NewContext(ctx, identity)
+		}
+
+		session, err := s.mcpServer.Connect(sessionContext, newWebSocketTransport(conn, reader, sessionID, s.transportConfig.WebSocketPingInterval()), nil)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to connect WebSocket client")
+			_ = conn.Close()
+			return
+		}
+		logger.Info("Client connected with WebSocket")
+
+		sessions.Add(1)
+		go func() {
+			defer sessions.Done()
+			_ = session.Wait()
+			logger.Info("Client disconnected from WebSocket")
+		}()
+	})
+}
+
+// loopbackOnly rejects the requests whose Host or Origin is not a loopback host. Checking the host protects against
+// DNS rebinding, and checking the origin prevents web pages opened in a browser from calling the server.
+func loopbackOnly(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host, _, err := net.SplitHostPort(r.Host)
+		if err != nil {
+			host = r.Host
+		}
+		if !isLoopbackHost(host) {
+			http.Error(w, "Forbidden: the host must be a loopback address", http.StatusForbidden)
+			return
+		}
+
+		if origin := r.Header.Get("Origin"); origin != "" {
+			originURL, err := url.Parse(origin)
+			if err != nil || !isLoopbackHost(originURL.Hostname()) {
+				http.Error(w, "Forbidden: cross-origin requests are not allowed", http.StatusForbidden)
+				return
+			}
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// authenticated rejects the requests without the bearer token of one of the API keys of authenticator, so that other
+// local processes and users cannot call the tools of the server. The name of the API key of the other requests is bound
+// to them as their client identity, and as the token info the MCP SDK hands to the handlers of the streamable HTTP
+// transport, whose contexts do not derive from the context of the request.
+func authenticated(authenticator Authenticator, logger entities.Logger, next http.Handler) http.Handler {
+	next = auth.RequireBearerToken(apiKeyTokenInfo, nil)(next)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		client, err := authenticator.Authenticate(r)
+		if err != nil {
+			logger.
+				WithError(err).
+				With("remote-address", r.RemoteAddr).
+				Warn("Rejected unauthenticated request")
+			w.Header().Set("WWW-Authenticate", `Bearer realm="`+authRealm+`"`)
+			http.Error(w, "Unauthorized: a valid API key is required", http.StatusUnauthorized)
+			return
+		}
+
+		logger.With("api-key", client).Debug("Authenticated request")
+		ctx := clientidentity.NewContext(r.Context(), clientidentity.Identity{Client: client, Authenticated: true})
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// apiKeyTokenInfo is the token info of a request authenticated by authenticated, holding the name of its API key.
+func apiKeyTokenInfo(ctx context.Context, _ string, _ *http.Request) (*auth.TokenInfo, error) {
+	identity, ok := clientidentity.FromContext(ctx)
+	if !ok || !identity.Authenticated {
+		return nil, auth.ErrInvalidToken
+	}
+	// The API keys do not expire, but the MCP SDK rejects the tokens without an expiration.
+	return &auth.TokenInfo{
+		Expiration: time.Now().Add(readHeaderTimeout),
+		Extra:      map[string]any{apiKeyTokenExtra: identity.Client},
+	}, nil
+}
+
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
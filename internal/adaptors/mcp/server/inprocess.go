@@ -0,0 +1,46 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server
+
+import (
+	"context"
+	"net"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// connectInProcess connects an MCP client named clientName to the server, in a session with sessionID that lasts until
+// ctx is done. The APIs serving the tools to clients that do not speak MCP call them in such a session, so that their
+// calls take the same path as the calls of MCP clients, through the tool policy, the rate limits, the redaction and
+// the session recording. options are the options of the client, nil for the defaults.
+func (s *Server) connectInProcess(ctx context.Context, sessionID string, clientName string, options *mcp.ClientOptions) (*mcp.ClientSession, error) {
+	serverConn, clientConn := net.Pipe()
+
+	if _, err := s.mcpServer.Connect(ctx, newSocketTransport(serverConn, sessionID), nil); err != nil {
+		_ = serverConn.Close()
+		_ = clientConn.Close()
+		return nil, err
+	}
+
+	client := mcp.NewClient(&mcp.Implementation{Name: clientName}, options)
+	session, err := client.Connect(ctx, newSocketTransport(clientConn, ""), nil)
+	if err != nil {
+		_ = serverConn.Close()
+		_ = clientConn.Close()
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// listTools lists the tools of the server in session, by name.
+func listTools(ctx context.Context, session *mcp.ClientSession) (map[string]*mcp.Tool, error) {
+	tools := map[string]*mcp.Tool{}
+	for tool, err := range session.Tools(ctx, nil) {
+		if err != nil {
+			return nil, err
+		}
+		tools[tool.Name] = tool
+	}
+	return tools, nil
+}
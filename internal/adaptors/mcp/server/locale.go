@@ -0,0 +1,20 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/i18n"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// localeMiddleware sets the language of the messages shown to users, such as confirmations, for every incoming request.
+func localeMiddleware(locale entities.Locale) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			return next(i18n.NewContext(ctx, locale), method, req)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/i18n"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocaleMiddleware_SetsLocaleOfRequests(t *testing.T) {
+	// Arrange
+	var toolLocale entities.Locale
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test-server"}, nil)
+	mcpServer.AddReceivingMiddleware(server.LocaleMiddleware(entities.LocaleGerman))
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "evaluate_matlab_code"}, func(ctx context.Context, req *mcp.CallToolRequest, input struct{}) (*mcp.CallToolResult, any, error) {
+		toolLocale = i18n.FromContext(ctx)
+		return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: "done"}}}, nil, nil
+	})
+
+	clientSession := connectLocaleTestClient(t, mcpServer)
+
+	// Act
+	_, err := clientSession.CallTool(t.Context(), &mcp.CallToolParams{Name: "evaluate_matlab_code", Arguments: map[string]any{}})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, entities.LocaleGerman, toolLocale)
+}
+
+func TestLocaleMiddleware_TranslatesRejections(t *testing.T) {
+	// Arrange
+	drainer := server.NewDrainer(time.Minute, time.Minute)
+	require.NoError(t, drainer.Drain())
+
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test-server"}, nil)
+	mcpServer.AddReceivingMiddleware(
+		server.LocaleMiddleware(entities.LocaleJapanese),
+		server.DrainMiddleware(drainer, testutils.NewInspectableLogger()),
+	)
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "evaluate_matlab_code"}, func(ctx context.Context, req *mcp.CallToolRequest, input struct{}) (*mcp.CallToolResult, any, error) {
+		return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: "done"}}}, nil, nil
+	})
+
+	clientSession := connectLocaleTestClient(t, mcpServer)
+
+	// Act
+	result, err := clientSession.CallTool(t.Context(), &mcp.CallToolParams{Name: "evaluate_matlab_code", Arguments: map[string]any{}})
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, resultText(t, result), "SHUTTING_DOWN: サーバーはシャットダウン中のため、新しいツール呼び出しを受け付けません")
+}
+
+func connectLocaleTestClient(t *testing.T, mcpServer *mcp.Server) *mcp.ClientSession {
+	t.Helper()
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := mcpServer.Connect(t.Context(), serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = serverSession.Close() })
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	clientSession, err := client.Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = clientSession.Close() })
+
+	return clientSession
+}
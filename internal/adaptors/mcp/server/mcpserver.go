@@ -3,11 +3,21 @@
 package server
 
 import (
+	"context"
+	"crypto/rand"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
+// BuildInfoMetaKey is the `_meta` field of the initialize result holding the build metadata of the server.
+const BuildInfoMetaKey = "build"
+
+const methodInitialize = "initialize"
+
 type ServerConfig interface {
 	Version() string
+	BuildInfo() entities.BuildInfo
 }
 
 func NewMCPSDKServer(config ServerConfig) *mcp.Server {
@@ -17,6 +27,48 @@ func NewMCPSDKServer(config ServerConfig) *mcp.Server {
 	}
 	options := &mcp.ServerOptions{
 		Instructions: instructions,
+		// The SDK keeps track of the subscriptions, so that resources such as rendered figures can notify their readers when they are updated.
+		SubscribeHandler:   acceptSubscription,
+		UnsubscribeHandler: acceptUnsubscription,
+		// Only the HTTP transport asks for session IDs, which are prefixed so that the middlewares know the transport of a client.
+		GetSessionID: func() string {
+			return httpSessionIDPrefix + rand.Text()
+		},
 	}
-	return mcp.NewServer(impl, options)
+	server := mcp.NewServer(impl, options)
+	server.AddReceivingMiddleware(buildInfoMiddleware(config.BuildInfo()))
+	return server
+}
+
+// buildInfoMiddleware adds the build metadata of the server to the initialize result, next to the server info,
+// as the server info only has room for the version.
+func buildInfoMiddleware(buildInfo entities.BuildInfo) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			result, err := next(ctx, method, req)
+			if err != nil || method != methodInitialize {
+				return result, err
+			}
+
+			initializeResult, ok := result.(*mcp.InitializeResult)
+			if !ok || initializeResult == nil {
+				return result, err
+			}
+
+			if initializeResult.Meta == nil {
+				initializeResult.Meta = mcp.Meta{}
+			}
+			initializeResult.Meta[BuildInfoMetaKey] = buildInfo
+
+			return result, err
+		}
+	}
+}
+
+func acceptSubscription(context.Context, *mcp.SubscribeRequest) error {
+	return nil
+}
+
+func acceptUnsubscription(context.Context, *mcp.UnsubscribeRequest) error {
+	return nil
 }
@@ -0,0 +1,62 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/server"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMCPSDKServer_InitializeResultHasBuildInfo(t *testing.T) {
+	// Arrange
+	mockServerConfig := &mocks.MockServerConfig{}
+	defer mockServerConfig.AssertExpectations(t)
+
+	mockServerConfig.EXPECT().
+		Version().
+		Return("github.com/matlab/matlab-mcp-core-server v1.2.3").
+		Once()
+
+	mockServerConfig.EXPECT().
+		BuildInfo().
+		Return(entities.BuildInfo{
+			Version:   "v1.2.3",
+			Commit:    "0123abcd",
+			BuildDate: "2025-06-01T09:00:00Z",
+			GoVersion: "go1.24.4",
+			OS:        "linux",
+			Arch:      "amd64",
+		}).
+		Once()
+
+	mcpServer := server.NewMCPSDKServer(mockServerConfig)
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := mcpServer.Connect(t.Context(), serverTransport, nil)
+	require.NoError(t, err)
+	defer func() { _ = serverSession.Close() }()
+
+	// Act
+	clientSession, err := client.Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+	defer func() { _ = clientSession.Close() }()
+
+	// Assert
+	initializeResult := clientSession.InitializeResult()
+	assert.Equal(t, "github.com/matlab/matlab-mcp-core-server v1.2.3", initializeResult.ServerInfo.Version)
+	assert.Equal(t, map[string]any{
+		"version":   "v1.2.3",
+		"commit":    "0123abcd",
+		"buildDate": "2025-06-01T09:00:00Z",
+		"goVersion": "go1.24.4",
+		"os":        "linux",
+		"arch":      "amd64",
+	}, initializeResult.Meta[server.BuildInfoMetaKey])
+}
@@ -0,0 +1,69 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// metricsMiddleware counts the tool calls and their duration. The calls that failed before reaching a tool, such as
+// the calls of unknown tools, are counted without their tool name.
+func metricsMiddleware(metrics Metrics) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			if method != methodCallTool {
+				return next(ctx, method, req)
+			}
+
+			startedAt := time.Now()
+			result, err := next(ctx, method, req)
+			duration := time.Since(startedAt)
+
+			if err != nil {
+				metrics.RecordToolCall("", duration, true)
+				return result, err
+			}
+
+			toolName := ""
+			if params, ok := req.GetParams().(*mcp.CallToolParamsRaw); ok {
+				toolName = params.Name
+			}
+
+			callToolResult, ok := result.(*mcp.CallToolResult)
+			metrics.RecordToolCall(toolName, duration, ok && callToolResult.IsError)
+			return result, err
+		}
+	}
+}
+
+// countActiveSessions counts the sessions connected to mcpServer.
+func countActiveSessions(mcpServer *mcp.Server) func() int {
+	return func() int {
+		count := 0
+		for range mcpServer.Sessions() {
+			count++
+		}
+		return count
+	}
+}
+
+// measured counts the HTTP requests by status code, including the requests rejected for their missing API key. Like
+// traced, it leaves the WebSocket upgrades alone.
+func measured(metrics Metrics, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+
+		metrics.RecordHTTPRequest(r.Method, recorder.status)
+	})
+}
@@ -0,0 +1,120 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/server"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsMiddleware_RecordsToolCalls(t *testing.T) {
+	testConfigs := []struct {
+		name           string
+		result         mcp.Result
+		err            error
+		expectedTool   string
+		expectedFailed bool
+	}{
+		{name: "success", result: &mcp.CallToolResult{}, expectedTool: "evaluate_matlab_code", expectedFailed: false},
+		{name: "tool error", result: &mcp.CallToolResult{IsError: true}, expectedTool: "evaluate_matlab_code", expectedFailed: true},
+		{name: "request error", err: assert.AnError, expectedTool: "", expectedFailed: true},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockMetrics := &mocks.MockMetrics{}
+			defer mockMetrics.AssertExpectations(t)
+
+			mockMetrics.EXPECT().
+				RecordToolCall(testConfig.expectedTool, mock.Anything, testConfig.expectedFailed).
+				Return().
+				Once()
+
+			next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+				return testConfig.result, testConfig.err
+			}
+
+			handler := server.MetricsMiddleware(mockMetrics)(next)
+
+			// Act
+			result, err := handler(t.Context(), "tools/call", &mcp.CallToolRequest{
+				Params: &mcp.CallToolParamsRaw{Name: "evaluate_matlab_code"},
+			})
+
+			// Assert
+			assert.Equal(t, testConfig.result, result)
+			assert.Equal(t, testConfig.err, err)
+		})
+	}
+}
+
+func TestMetricsMiddleware_IgnoresOtherMethods(t *testing.T) {
+	// Arrange
+	mockMetrics := &mocks.MockMetrics{}
+	defer mockMetrics.AssertExpectations(t)
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return &mcp.ListToolsResult{}, nil
+	}
+
+	handler := server.MetricsMiddleware(mockMetrics)(next)
+
+	// Act
+	_, err := handler(t.Context(), "tools/list", &mcp.ListToolsRequest{Params: &mcp.ListToolsParams{}})
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestMeasured_RecordsRequests(t *testing.T) {
+	// Arrange
+	mockMetrics := &mocks.MockMetrics{}
+	defer mockMetrics.AssertExpectations(t)
+
+	mockMetrics.EXPECT().
+		RecordHTTPRequest(http.MethodPost, http.StatusUnauthorized).
+		Return().
+		Once()
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	recorder := httptest.NewRecorder()
+
+	// Act
+	server.Measured(mockMetrics, next).ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/mcp", nil))
+
+	// Assert
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+}
+
+func TestMeasured_WebSocketUpgrade(t *testing.T) {
+	// Arrange
+	mockMetrics := &mocks.MockMetrics{}
+	defer mockMetrics.AssertExpectations(t)
+
+	called := false
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	})
+
+	request := httptest.NewRequest(http.MethodGet, "/mcp", nil)
+	request.Header.Set("Upgrade", "websocket")
+
+	// Act
+	server.Measured(mockMetrics, next).ServeHTTP(httptest.NewRecorder(), request)
+
+	// Assert
+	assert.True(t, called)
+}
@@ -0,0 +1,219 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/correlationid"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// StreamedOutputMetaKey is the `_meta` field of a tool call result holding the number of output bytes sent as progress notifications.
+const StreamedOutputMetaKey = "streamedOutputBytes"
+
+// DroppedOutputMetaKey is the `_meta` field of a tool call result holding the number of output bytes dropped, because the
+// progress notifications exceeded the notification rate limit of the client.
+const DroppedOutputMetaKey = "droppedOutputBytes"
+
+// OutputChunkMetaKey is the `_meta` field set to true on the progress notifications carrying a chunk of the output of a tool
+// call, to tell them from the notifications reporting the time elapsed.
+const OutputChunkMetaKey = "outputChunk"
+
+type progressNotifier func(ctx context.Context, params *mcp.ProgressNotificationParams) error
+
+// outputStreamingMiddleware sends the text of tool call results longer than the chunk size to the client as progress notifications,
+// one chunk at a time, so that the final result does not exceed the message size limits of the client. The result keeps the last
+// chunk of the text, so the client still sees how the output ends. The result is only chunked once the call returned: MATLAB
+// returns the output of an evaluation all at once when the code has run, so the output is held in memory in full until then,
+// and --max-output-bytes is what bounds it. The output that tools can read while it is produced, such as the output of a
+// background job, is sent while the call runs by the progress middleware instead. The chunks above the notification rate
+// limit of the client are dropped rather than delayed, so that a tight loop printing output cannot hold the call open, and the
+// result says how many bytes were dropped.
+// Only the calls with a progress token are streamed, as the notifications of other calls would be dropped by the client.
+func outputStreamingMiddleware(config OutputStreamingConfig, throttle NotificationThrottle, logger entities.Logger) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			ctx = withProgressCounter(ctx)
+			result, err := next(ctx, method, req)
+			if err != nil || method != methodCallTool {
+				return result, err
+			}
+
+			chunkSize := config.StreamOutputChunkSize()
+			if chunkSize == 0 {
+				return result, err
+			}
+
+			callToolResult, ok := result.(*mcp.CallToolResult)
+			if !ok || callToolResult == nil || callToolResult.StructuredContent != nil {
+				return result, err
+			}
+
+			params, ok := req.GetParams().(*mcp.CallToolParamsRaw)
+			if !ok || params.GetProgressToken() == nil {
+				return result, err
+			}
+
+			session, ok := req.GetSession().(*mcp.ServerSession)
+			if !ok || session == nil {
+				return result, err
+			}
+
+			logger := logger.With("tool-name", params.Name)
+			if correlationID, ok := correlationid.FromContext(ctx); ok {
+				logger = logger.With(correlationid.LogKey, correlationID)
+			}
+
+			clientID := sessionID(req)
+			allow := func() bool {
+				return throttle.Allow(clientID)
+			}
+
+			streamed, dropped, streamErr := streamCallToolResult(ctx, session.NotifyProgress, allow, params.GetProgressToken(), progressCounterFromContext(ctx).get(), chunkSize, callToolResult)
+			if streamErr != nil {
+				logger.WithError(streamErr).Warn("Failed to stream tool output, returning it in full")
+				return result, err
+			}
+			if streamed > 0 {
+				logger.With("streamed-bytes", streamed).Debug("Streamed tool output to the client")
+			}
+			if dropped > 0 {
+				logger.With("dropped-bytes", dropped).Warn("Dropped tool output above the notification rate limit")
+			}
+
+			return result, err
+		}
+	}
+}
+
+// streamCallToolResult sends the text content of result longer than chunkSize as progress notifications, and returns the number of bytes
+// sent and dropped. The progress of the notifications continues from base, the last progress already sent for the call. Once allow
+// refuses a chunk, the rest of the output that was to be streamed is dropped, and summarized in the result.
+// The text content is only replaced by its tail once every chunk was sent or dropped, so that result is left in full if the stream fails.
+func streamCallToolResult(ctx context.Context, notify progressNotifier, allow func() bool, progressToken any, base float64, chunkSize int, result *mcp.CallToolResult) (int, int, error) {
+	heads := make(map[*mcp.TextContent]string)
+	total := 0
+	for _, content := range result.Content {
+		textContent, ok := content.(*mcp.TextContent)
+		if !ok || len(textContent.Text) <= chunkSize {
+			continue
+		}
+
+		head := textContent.Text[:tailStart(textContent.Text, chunkSize)]
+		heads[textContent] = head
+		total += len(head)
+	}
+
+	if total == 0 {
+		return 0, 0, nil
+	}
+
+	sent := 0
+	sentOf := make(map[*mcp.TextContent]int, len(heads))
+	throttled := false
+	for _, content := range result.Content {
+		textContent, ok := content.(*mcp.TextContent)
+		if !ok {
+			continue
+		}
+
+		head, ok := heads[textContent]
+		for ok && len(head) > 0 && !throttled {
+			if !allow() {
+				throttled = true
+				break
+			}
+
+			chunk := head[:chunkEnd(head, chunkSize)]
+			if err := notify(ctx, &mcp.ProgressNotificationParams{
+				Meta:          mcp.Meta{OutputChunkMetaKey: true},
+				ProgressToken: progressToken,
+				Message:       chunk,
+				Progress:      base + float64(sent+len(chunk)),
+				Total:         base + float64(total),
+			}); err != nil {
+				return sent, 0, err
+			}
+
+			sent += len(chunk)
+			sentOf[textContent] += len(chunk)
+			head = head[len(chunk):]
+		}
+	}
+
+	dropped := 0
+	for textContent, head := range heads {
+		streamed := sentOf[textContent]
+		droppedHere := len(head) - streamed
+		dropped += droppedHere
+
+		var note string
+		switch {
+		case droppedHere == 0:
+			note = fmt.Sprintf(streamedOutputNote, streamed)
+		case streamed == 0:
+			note = fmt.Sprintf("[The first %d bytes of this output were dropped, because the notification rate limit was reached.]", droppedHere)
+		default:
+			note = fmt.Sprintf("[The first %d bytes of this output were sent as progress notifications, and the next %d bytes were dropped, because the notification rate limit was reached.]", streamed, droppedHere)
+		}
+		textContent.Text = note + "\n" + textContent.Text[len(head):]
+	}
+
+	if result.Meta == nil {
+		result.Meta = mcp.Meta{}
+	}
+	result.Meta[StreamedOutputMetaKey] = sent
+	if dropped > 0 {
+		result.Meta[DroppedOutputMetaKey] = dropped
+	}
+
+	return sent, dropped, nil
+}
+
+// streamedOutputNote starts the text of a result whose output was streamed in full, followed by the last chunk of the output.
+const streamedOutputNote = "[The first %d bytes of this output were sent as progress notifications.]"
+
+// trimStreamedOutputNote removes the note streamCallToolResult starts text with when the output before it was streamed in
+// full, so that text is the rest of the output. Notes about dropped output are kept, as the output is not complete.
+func trimStreamedOutputNote(text string) string {
+	note, rest, ok := strings.Cut(text, "\n")
+	if !ok {
+		return text
+	}
+
+	var streamed int
+	if _, err := fmt.Sscanf(note, streamedOutputNote, &streamed); err != nil || note != fmt.Sprintf(streamedOutputNote, streamed) {
+		return text
+	}
+	return rest
+}
+
+// chunkEnd is the length of the first chunk of s, at most n bytes, without splitting a multi-byte character.
+func chunkEnd(s string, n int) int {
+	if len(s) <= n {
+		return len(s)
+	}
+
+	end := n
+	for end > 0 && !utf8.RuneStart(s[end]) {
+		end--
+	}
+	if end == 0 {
+		_, end = utf8.DecodeRuneInString(s)
+	}
+	return end
+}
+
+// tailStart is the offset of the last n bytes of s, moved forward so that it does not split a multi-byte character.
+func tailStart(s string, n int) int {
+	start := len(s) - n
+	for start < len(s) && !utf8.RuneStart(s[start]) {
+		start++
+	}
+	return start
+}
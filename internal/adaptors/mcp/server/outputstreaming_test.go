@@ -0,0 +1,295 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server_test
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/server"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type progressCollector struct {
+	mu            sync.Mutex
+	notifications []*mcp.ProgressNotificationParams
+}
+
+func (c *progressCollector) handle(ctx context.Context, req *mcp.ProgressNotificationClientRequest) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.notifications = append(c.notifications, req.Params)
+}
+
+// received returns the notifications in the order of their progress, as the client may handle them concurrently.
+func (c *progressCollector) received() []*mcp.ProgressNotificationParams {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	notifications := slices.Clone(c.notifications)
+	slices.SortFunc(notifications, func(a, b *mcp.ProgressNotificationParams) int {
+		return cmp.Compare(a.Progress, b.Progress)
+	})
+	return notifications
+}
+
+// allowAll returns a notification throttle allowing every notification.
+func allowAll(t *testing.T) *mocks.MockNotificationThrottle {
+	mockThrottle := &mocks.MockNotificationThrottle{}
+	t.Cleanup(func() { mockThrottle.AssertExpectations(t) })
+
+	mockThrottle.EXPECT().
+		Allow(mock.Anything).
+		Return(true).
+		Maybe()
+
+	return mockThrottle
+}
+
+// callStreamingTool calls a tool returning result, through the output streaming middleware, and returns the result received by the client.
+func callStreamingTool(t *testing.T, config server.OutputStreamingConfig, throttle server.NotificationThrottle, result *mcp.CallToolResult, progressToken any) (*mcp.CallToolResult, *progressCollector) {
+	t.Helper()
+
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test-server"}, nil)
+	mcpServer.AddReceivingMiddleware(server.OutputStreamingMiddleware(config, throttle, testutils.NewInspectableLogger()))
+	mcpServer.AddTool(&mcp.Tool{Name: "test-tool", InputSchema: map[string]any{"type": "object"}}, func(ctx context.Context, req *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return result, nil
+	})
+
+	collector := &progressCollector{}
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, &mcp.ClientOptions{
+		ProgressNotificationHandler: collector.handle,
+	})
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := mcpServer.Connect(t.Context(), serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = serverSession.Close() })
+
+	clientSession, err := client.Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = clientSession.Close() })
+
+	params := &mcp.CallToolParams{Name: "test-tool", Arguments: map[string]any{}}
+	if progressToken != nil {
+		params.Meta = mcp.Meta{"progressToken": progressToken}
+	}
+
+	received, err := clientSession.CallTool(t.Context(), params)
+	require.NoError(t, err)
+
+	return received, collector
+}
+
+func TestOutputStreamingMiddleware_StreamsLongOutput(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockOutputStreamingConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		StreamOutputChunkSize().
+		Return(4).
+		Once()
+
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{&mcp.TextContent{Text: "0123456789ab"}},
+	}
+
+	// Act
+	received, collector := callStreamingTool(t, mockConfig, allowAll(t), result, "token")
+
+	// Assert
+	require.Len(t, received.Content, 1)
+	assert.Equal(t, "[The first 8 bytes of this output were sent as progress notifications.]\n89ab", received.Content[0].(*mcp.TextContent).Text, "Only the tail should be returned")
+	assert.InDelta(t, 8, received.Meta[server.StreamedOutputMetaKey], 0)
+
+	require.Eventually(t, func() bool { return len(collector.received()) == 2 }, time.Second, 10*time.Millisecond)
+	notifications := collector.received()
+	assert.Equal(t, "0123", notifications[0].Message)
+	assert.InDelta(t, 4, notifications[0].Progress, 0)
+	assert.Equal(t, "4567", notifications[1].Message)
+	assert.InDelta(t, 8, notifications[1].Progress, 0)
+	for _, notification := range notifications {
+		assert.Equal(t, "token", notification.ProgressToken)
+		assert.InDelta(t, 8, notification.Total, 0)
+	}
+}
+
+func TestOutputStreamingMiddleware_DoesNotSplitCharacters(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockOutputStreamingConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		StreamOutputChunkSize().
+		Return(4).
+		Once()
+
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{&mcp.TextContent{Text: "aéééé"}},
+	}
+
+	// Act
+	received, collector := callStreamingTool(t, mockConfig, allowAll(t), result, "token")
+
+	// Assert
+	require.Len(t, received.Content, 1)
+	assert.Equal(t, "[The first 5 bytes of this output were sent as progress notifications.]\néé", received.Content[0].(*mcp.TextContent).Text)
+
+	require.Eventually(t, func() bool { return len(collector.received()) == 2 }, time.Second, 10*time.Millisecond)
+	notifications := collector.received()
+	assert.Equal(t, "aé", notifications[0].Message)
+	assert.Equal(t, "é", notifications[1].Message)
+}
+
+func TestOutputStreamingMiddleware_ShortOutput(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockOutputStreamingConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		StreamOutputChunkSize().
+		Return(4).
+		Once()
+
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{&mcp.TextContent{Text: "0123"}},
+	}
+
+	// Act
+	received, collector := callStreamingTool(t, mockConfig, allowAll(t), result, "token")
+
+	// Assert
+	require.Len(t, received.Content, 1)
+	assert.Equal(t, "0123", received.Content[0].(*mcp.TextContent).Text)
+	assert.NotContains(t, received.Meta, server.StreamedOutputMetaKey)
+	assert.Empty(t, collector.received())
+}
+
+func TestOutputStreamingMiddleware_NoProgressToken(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockOutputStreamingConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		StreamOutputChunkSize().
+		Return(4).
+		Once()
+
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{&mcp.TextContent{Text: "0123456789ab"}},
+	}
+
+	// Act
+	received, collector := callStreamingTool(t, mockConfig, allowAll(t), result, nil)
+
+	// Assert
+	require.Len(t, received.Content, 1)
+	assert.Equal(t, "0123456789ab", received.Content[0].(*mcp.TextContent).Text, "Output should be returned in full when the client cannot receive progress notifications")
+	assert.Empty(t, collector.received())
+}
+
+func TestOutputStreamingMiddleware_StructuredContent(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockOutputStreamingConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		StreamOutputChunkSize().
+		Return(4).
+		Once()
+
+	result := &mcp.CallToolResult{
+		Content:           []mcp.Content{&mcp.TextContent{Text: `{"output":"0123456789"}`}},
+		StructuredContent: map[string]any{"output": "0123456789"},
+	}
+
+	// Act
+	received, collector := callStreamingTool(t, mockConfig, allowAll(t), result, "token")
+
+	// Assert
+	require.Len(t, received.Content, 1)
+	assert.JSONEq(t, `{"output":"0123456789"}`, received.Content[0].(*mcp.TextContent).Text, "Structured results should not be split")
+	assert.Empty(t, collector.received())
+}
+
+func TestOutputStreamingMiddleware_Disabled(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockOutputStreamingConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	expectedResult := &mcp.CallToolResult{
+		Content: []mcp.Content{&mcp.TextContent{Text: "0123456789ab"}},
+	}
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return expectedResult, nil
+	}
+
+	mockConfig.EXPECT().
+		StreamOutputChunkSize().
+		Return(0).
+		Once()
+
+	handler := server.OutputStreamingMiddleware(mockConfig, allowAll(t), testutils.NewInspectableLogger())(next)
+
+	// Act
+	result, err := handler(t.Context(), "tools/call", &mcp.CallToolRequest{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedResult, result)
+	assert.Equal(t, "0123456789ab", expectedResult.Content[0].(*mcp.TextContent).Text)
+}
+
+func TestOutputStreamingMiddleware_DropsOutputAboveNotificationRate(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockOutputStreamingConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockThrottle := &mocks.MockNotificationThrottle{}
+	defer mockThrottle.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		StreamOutputChunkSize().
+		Return(4).
+		Once()
+
+	mockThrottle.EXPECT().
+		Allow(mock.Anything).
+		Return(true).
+		Once()
+
+	mockThrottle.EXPECT().
+		Allow(mock.Anything).
+		Return(false).
+		Once()
+
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: "0123456789ab"},
+			&mcp.TextContent{Text: "ABCDEFGHIJ"},
+		},
+	}
+
+	// Act
+	received, collector := callStreamingTool(t, mockConfig, mockThrottle, result, "token")
+
+	// Assert
+	require.Len(t, received.Content, 2)
+	assert.Equal(t, "[The first 4 bytes of this output were sent as progress notifications, and the next 4 bytes were dropped, because the notification rate limit was reached.]\n89ab", received.Content[0].(*mcp.TextContent).Text)
+	assert.Equal(t, "[The first 6 bytes of this output were dropped, because the notification rate limit was reached.]\nGHIJ", received.Content[1].(*mcp.TextContent).Text, "Once throttled, the rest of the output should be dropped without asking again")
+	assert.InDelta(t, 4, received.Meta[server.StreamedOutputMetaKey], 0)
+	assert.InDelta(t, 10, received.Meta[server.DroppedOutputMetaKey], 0)
+
+	require.Eventually(t, func() bool { return len(collector.received()) == 1 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, "0123", collector.received()[0].Message)
+}
@@ -0,0 +1,165 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/correlationid"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/outputstream"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type ProgressConfig interface {
+	ProgressInterval() time.Duration
+}
+
+// progressCounter holds the last progress sent for the progress token of a tool call. The progress of a token must
+// increase with every notification, so the middlewares sending progress notifications for the same call continue from it.
+type progressCounter struct {
+	lock *sync.Mutex
+	last float64
+}
+
+type progressCounterKey struct{}
+
+func withProgressCounter(ctx context.Context) context.Context {
+	return context.WithValue(ctx, progressCounterKey{}, &progressCounter{lock: new(sync.Mutex)})
+}
+
+// progressCounterFromContext returns the progress counter of the call, or a counter of its own if the call has none.
+func progressCounterFromContext(ctx context.Context) *progressCounter {
+	if counter, ok := ctx.Value(progressCounterKey{}).(*progressCounter); ok {
+		return counter
+	}
+	return &progressCounter{lock: new(sync.Mutex)}
+}
+
+func (c *progressCounter) get() float64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.last
+}
+
+// send sends a progress notification with message. Its progress is at least progress, and at least step past the last
+// progress sent, so that the progress keeps increasing whichever middleware sends the notification.
+func (c *progressCounter) send(ctx context.Context, notify progressNotifier, progressToken any, message string, progress float64, step float64) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	progress = max(progress, c.last+step)
+	if err := notify(ctx, &mcp.ProgressNotificationParams{
+		ProgressToken: progressToken,
+		Message:       message,
+		Progress:      progress,
+	}); err != nil {
+		return err
+	}
+
+	c.last = progress
+	return nil
+}
+
+// errNotificationThrottled is returned when output is not sent, because the notification rate limit of the client was reached.
+var errNotificationThrottled = errors.New("the notification rate limit of the client was reached")
+
+// progressMiddleware sends a progress notification with the time elapsed at every progress interval while a tool call
+// runs, so that clients show that the call is still running instead of an opaque spinner. MATLAB only returns the
+// output of code once it has run, so the notifications report the time elapsed, not the output produced so far.
+// The tools that can read output while it is produced, such as the output of a background job, send it as progress
+// notifications with outputstream.Write, redacted with redactor, as the notifications do not go through the redaction
+// of the results.
+// The notifications stop before the result is returned, and the notifications above the notification rate limit of the
+// client are skipped. Only the calls with a progress token are reported, as the notifications of other calls would be
+// dropped by the client.
+func progressMiddleware(config ProgressConfig, throttle NotificationThrottle, redactor Redactor, logger entities.Logger) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			if method != methodCallTool {
+				return next(ctx, method, req)
+			}
+
+			params, ok := req.GetParams().(*mcp.CallToolParamsRaw)
+			if !ok || params.GetProgressToken() == nil {
+				return next(ctx, method, req)
+			}
+
+			session, ok := req.GetSession().(*mcp.ServerSession)
+			if !ok || session == nil {
+				return next(ctx, method, req)
+			}
+
+			logger := logger.With("tool-name", params.Name)
+			if correlationID, ok := correlationid.FromContext(ctx); ok {
+				logger = logger.With(correlationid.LogKey, correlationID)
+			}
+
+			clientID := sessionID(req)
+			allow := func() bool {
+				return throttle.Allow(clientID)
+			}
+
+			counter := progressCounterFromContext(ctx)
+			progressToken := params.GetProgressToken()
+			ctx = outputstream.NewContext(ctx, func(ctx context.Context, text string) error {
+				if !allow() {
+					return errNotificationThrottled
+				}
+				if redactor.Enabled() {
+					text, _ = redactor.Redact(text)
+				}
+				return counter.send(ctx, session.NotifyProgress, progressToken, text, 0, float64(len(text)))
+			})
+
+			interval := config.ProgressInterval()
+			if interval == 0 {
+				return next(ctx, method, req)
+			}
+
+			done := make(chan struct{})
+			stopped := make(chan struct{})
+			go func() {
+				defer close(stopped)
+				reportProgress(ctx, session.NotifyProgress, allow, counter, progressToken, params.Name, interval, done, logger)
+			}()
+
+			result, err := next(ctx, method, req)
+			close(done)
+			<-stopped
+
+			return result, err
+		}
+	}
+}
+
+// reportProgress sends a progress notification with the time elapsed at every interval, until done is closed.
+func reportProgress(ctx context.Context, notify progressNotifier, allow func() bool, counter *progressCounter, progressToken any, toolName string, interval time.Duration, done <-chan struct{}, logger entities.Logger) {
+	start := time.Now()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if !allow() {
+			continue
+		}
+
+		elapsed := time.Since(start)
+		message := fmt.Sprintf("%s has been running for %s", toolName, elapsed.Round(time.Second))
+		if err := counter.send(ctx, notify, progressToken, message, elapsed.Seconds(), interval.Seconds()); err != nil {
+			logger.WithError(err).Debug("Failed to send progress notification")
+		}
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/clientidentity"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/correlationid"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// apiKeyClientIDPrefix is the prefix of the rate limiter client IDs of the clients authenticated with an API key, so that
+// they cannot collide with session IDs.
+const apiKeyClientIDPrefix = "api-key:"
+
+// rateLimitMiddleware rejects the tool calls of a client above its rate limit or its cap on concurrent calls,
+// so that one client cannot starve the others. Clients are identified by the API key they authenticated with, so that
+// opening a new session does not reset their limits, and the callers of the REST API and the gRPC service, which share
+// one session, have their own limits. Without API keys, clients are identified by their MCP session.
+func rateLimitMiddleware(rateLimiter RateLimiter, logger entities.Logger) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			if method != methodCallTool {
+				return next(ctx, method, req)
+			}
+
+			clientID := rateLimitClientID(ctx, req)
+			release, err := rateLimiter.Acquire(clientID)
+			if err != nil {
+				logger := logger.With("client-id", clientID)
+				if correlationID, ok := correlationid.FromContext(ctx); ok {
+					logger = logger.With(correlationid.LogKey, correlationID)
+				}
+				logger.WithError(err).Warn("Tool call rejected by the rate limiter")
+				return rejectedCallResult(ctx, entities.ErrorCodeOf(err), err.Error()), nil
+			}
+			defer release()
+
+			return next(ctx, method, req)
+		}
+	}
+}
+
+// rateLimitClientID identifies the client of a tool call for the rate limiter.
+func rateLimitClientID(ctx context.Context, req mcp.Request) string {
+	if identity, ok := clientidentity.FromContext(ctx); ok && identity.Authenticated {
+		return apiKeyClientIDPrefix + identity.Client
+	}
+	return sessionID(req)
+}
+
+// sessionID is empty for transports without sessions, such as stdio, which only serve one client.
+func sessionID(req mcp.Request) string {
+	session, ok := req.GetSession().(*mcp.ServerSession)
+	if !ok || session == nil {
+		return ""
+	}
+	return session.ID()
+}
@@ -0,0 +1,147 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/clientidentity"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/server"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitMiddleware_AdmittedCall(t *testing.T) {
+	// Arrange
+	mockRateLimiter := &mocks.MockRateLimiter{}
+	defer mockRateLimiter.AssertExpectations(t)
+
+	nextCalled := false
+	released := false
+	expectedResult := &mcp.CallToolResult{}
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		nextCalled = true
+		assert.False(t, released, "The call should only be released once it is complete")
+		return expectedResult, nil
+	}
+
+	mockRateLimiter.EXPECT().
+		Acquire("").
+		Return(func() { released = true }, nil).
+		Once()
+
+	handler := server.RateLimitMiddleware(mockRateLimiter, testutils.NewInspectableLogger())(next)
+
+	// Act
+	result, err := handler(t.Context(), "tools/call", &mcp.CallToolRequest{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedResult, result)
+	assert.True(t, nextCalled)
+	assert.True(t, released)
+}
+
+func TestRateLimitMiddleware_AuthenticatedClient(t *testing.T) {
+	testConfigs := []struct {
+		name             string
+		identity         clientidentity.Identity
+		expectedClientID string
+	}{
+		{
+			name:             "authenticated client",
+			identity:         clientidentity.Identity{User: "alice", Client: "ci", Authenticated: true},
+			expectedClientID: "api-key:ci",
+		},
+		{
+			name:             "unauthenticated client",
+			identity:         clientidentity.Identity{User: "alice", Client: "claude-code 1.0.0"},
+			expectedClientID: "",
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockRateLimiter := &mocks.MockRateLimiter{}
+			defer mockRateLimiter.AssertExpectations(t)
+
+			next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+				return &mcp.CallToolResult{}, nil
+			}
+
+			mockRateLimiter.EXPECT().
+				Acquire(testConfig.expectedClientID).
+				Return(func() {}, nil).
+				Once()
+
+			handler := server.RateLimitMiddleware(mockRateLimiter, testutils.NewInspectableLogger())(next)
+			ctx := clientidentity.NewContext(t.Context(), testConfig.identity)
+
+			// Act
+			_, err := handler(ctx, "tools/call", &mcp.CallToolRequest{})
+
+			// Assert
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRateLimitMiddleware_RejectedCall(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockRateLimiter := &mocks.MockRateLimiter{}
+	defer mockRateLimiter.AssertExpectations(t)
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		t.Fatal("A rejected call should not run")
+		return nil, nil
+	}
+
+	mockRateLimiter.EXPECT().
+		Acquire("").
+		Return(nil, entities.NewCodedError(entities.ErrorCodeRateLimited, fmt.Errorf("too many tool calls"))).
+		Once()
+
+	handler := server.RateLimitMiddleware(mockRateLimiter, mockLogger)(next)
+
+	// Act
+	result, err := handler(t.Context(), "tools/call", &mcp.CallToolRequest{})
+
+	// Assert
+	require.NoError(t, err)
+	callToolResult, ok := result.(*mcp.CallToolResult)
+	require.True(t, ok)
+	assert.True(t, callToolResult.IsError)
+	require.Len(t, callToolResult.Content, 1)
+	assert.Equal(t, "RATE_LIMITED: too many tool calls", callToolResult.Content[0].(*mcp.TextContent).Text)
+
+	_, found := mockLogger.WarnLogs()["Tool call rejected by the rate limiter"]
+	assert.True(t, found)
+}
+
+func TestRateLimitMiddleware_IgnoresOtherMethods(t *testing.T) {
+	// Arrange
+	mockRateLimiter := &mocks.MockRateLimiter{}
+	defer mockRateLimiter.AssertExpectations(t)
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return nil, nil
+	}
+
+	handler := server.RateLimitMiddleware(mockRateLimiter, testutils.NewInspectableLogger())(next)
+
+	// Act
+	_, err := handler(t.Context(), "resources/read", &mcp.ReadResourceRequest{})
+
+	// Assert
+	require.NoError(t, err)
+}
@@ -0,0 +1,35 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/clientidentity"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// recordingMiddleware writes every tool call, with its arguments and result, to the session recording.
+// It sees the results after redaction, so that the recording does not hold the redacted secrets.
+// Every call is recorded with the identity of the user and client that made it.
+func recordingMiddleware(sessionRecorder SessionRecorder) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			result, err := next(ctx, method, req)
+			if method != methodCallTool || !sessionRecorder.Enabled() {
+				return result, err
+			}
+
+			params, ok := req.GetParams().(*mcp.CallToolParamsRaw)
+			if !ok {
+				return result, err
+			}
+
+			callToolResult, _ := result.(*mcp.CallToolResult)
+			identity, _ := clientidentity.FromContext(ctx)
+			sessionRecorder.RecordToolCall(identity, params.Name, params.Arguments, callToolResult, err)
+
+			return result, err
+		}
+	}
+}
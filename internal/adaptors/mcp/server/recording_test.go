@@ -0,0 +1,106 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/clientidentity"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/server"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordingMiddleware_RecordsToolCalls(t *testing.T) {
+	// Arrange
+	mockSessionRecorder := &mocks.MockSessionRecorder{}
+	defer mockSessionRecorder.AssertExpectations(t)
+
+	arguments := json.RawMessage(`{"code":"x = 1"}`)
+	identity := clientidentity.Identity{User: "alice", Client: "claude-code 1.0.0"}
+	expectedResult := &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: "x = 1"}}}
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return expectedResult, nil
+	}
+
+	mockSessionRecorder.EXPECT().
+		Enabled().
+		Return(true).
+		Once()
+
+	mockSessionRecorder.EXPECT().
+		RecordToolCall(identity, "evaluate_matlab_code", arguments, expectedResult, nil).
+		Return().
+		Once()
+
+	handler := server.RecordingMiddleware(mockSessionRecorder)(next)
+
+	// Act
+	result, err := handler(clientidentity.NewContext(t.Context(), identity), "tools/call", &mcp.CallToolRequest{
+		Params: &mcp.CallToolParamsRaw{Name: "evaluate_matlab_code", Arguments: arguments},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestRecordingMiddleware_RecordsErrors(t *testing.T) {
+	// Arrange
+	mockSessionRecorder := &mocks.MockSessionRecorder{}
+	defer mockSessionRecorder.AssertExpectations(t)
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return nil, assert.AnError
+	}
+
+	mockSessionRecorder.EXPECT().
+		Enabled().
+		Return(true).
+		Once()
+
+	mockSessionRecorder.EXPECT().
+		RecordToolCall(clientidentity.Identity{}, "unknown_tool", json.RawMessage(nil), (*mcp.CallToolResult)(nil), assert.AnError).
+		Return().
+		Once()
+
+	handler := server.RecordingMiddleware(mockSessionRecorder)(next)
+
+	// Act
+	_, err := handler(t.Context(), "tools/call", &mcp.CallToolRequest{
+		Params: &mcp.CallToolParamsRaw{Name: "unknown_tool"},
+	})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+func TestRecordingMiddleware_Disabled(t *testing.T) {
+	// Arrange
+	mockSessionRecorder := &mocks.MockSessionRecorder{}
+	defer mockSessionRecorder.AssertExpectations(t)
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return &mcp.CallToolResult{}, nil
+	}
+
+	mockSessionRecorder.EXPECT().
+		Enabled().
+		Return(false).
+		Once()
+
+	handler := server.RecordingMiddleware(mockSessionRecorder)(next)
+
+	// Act
+	_, err := handler(t.Context(), "tools/call", &mcp.CallToolRequest{
+		Params: &mcp.CallToolParamsRaw{Name: "evaluate_matlab_code"},
+	})
+
+	// Assert
+	require.NoError(t, err)
+}
@@ -0,0 +1,125 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/toolfailure"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// RedactionsMetaKey is the `_meta` field of a tool call result holding the number of redacted values.
+const RedactionsMetaKey = "redactions"
+
+// redactionMiddleware removes secrets from the results of tool calls, before they reach the client or the recorded events.
+// When values are redacted, their number is noted in the result, so the client knows the output is incomplete.
+func redactionMiddleware(redactor Redactor) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			result, err := next(ctx, method, req)
+			if err != nil || method != methodCallTool || !redactor.Enabled() {
+				return result, err
+			}
+
+			callToolResult, ok := result.(*mcp.CallToolResult)
+			if !ok || callToolResult == nil {
+				return result, err
+			}
+
+			count := redactCallToolResult(redactor, callToolResult)
+			if count == 0 {
+				return result, err
+			}
+
+			if callToolResult.Meta == nil {
+				callToolResult.Meta = mcp.Meta{}
+			}
+			callToolResult.Meta[RedactionsMetaKey] = count
+			callToolResult.Content = append(callToolResult.Content, &mcp.TextContent{
+				Text: fmt.Sprintf("Note: %d value(s) were redacted from this result.", count),
+			})
+
+			return result, err
+		}
+	}
+}
+
+// redactCallToolResult redacts the result in place, and returns the number of redacted values.
+// The text content of tools with structured output repeats the structured content, so the values redacted from both are only counted once.
+func redactCallToolResult(redactor Redactor, result *mcp.CallToolResult) int {
+	textCount := 0
+	for _, content := range result.Content {
+		textContent, ok := content.(*mcp.TextContent)
+		if !ok {
+			continue
+		}
+
+		var n int
+		textContent.Text, n = redactor.Redact(textContent.Text)
+		textCount += n
+	}
+
+	structuredCount := 0
+	if result.StructuredContent != nil {
+		result.StructuredContent, structuredCount = redactStructuredContent(redactor, result.StructuredContent)
+	}
+
+	if failure, ok := toolFailureOf(result); ok {
+		failure.Message, _ = redactor.Redact(failure.Message)
+		result.Meta[toolfailure.MetaKey] = failure
+	}
+
+	return max(textCount, structuredCount)
+}
+
+func redactStructuredContent(redactor Redactor, structuredContent any) (any, int) {
+	data, err := json.Marshal(structuredContent)
+	if err != nil {
+		return structuredContent, 0
+	}
+
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return structuredContent, 0
+	}
+
+	value, count := redactValue(redactor, value)
+	if count == 0 {
+		return structuredContent, 0
+	}
+
+	data, err = json.Marshal(value)
+	if err != nil {
+		return structuredContent, 0
+	}
+
+	return json.RawMessage(data), count
+}
+
+func redactValue(redactor Redactor, value any) (any, int) {
+	switch v := value.(type) {
+	case string:
+		return redactor.Redact(v)
+	case []any:
+		count := 0
+		for i := range v {
+			var n int
+			v[i], n = redactValue(redactor, v[i])
+			count += n
+		}
+		return v, count
+	case map[string]any:
+		count := 0
+		for key := range v {
+			var n int
+			v[key], n = redactValue(redactor, v[key])
+			count += n
+		}
+		return v, count
+	default:
+		return value, 0
+	}
+}
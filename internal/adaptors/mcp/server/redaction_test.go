@@ -0,0 +1,127 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/server"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactionMiddleware_RedactsToolResults(t *testing.T) {
+	// Arrange
+	mockRedactor := &mocks.MockRedactor{}
+	defer mockRedactor.AssertExpectations(t)
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return &mcp.CallToolResult{
+			Content:           []mcp.Content{&mcp.TextContent{Text: `{"output":"key=hunter2"}`}},
+			StructuredContent: map[string]any{"output": "key=hunter2", "exitCode": 0},
+		}, nil
+	}
+
+	mockRedactor.EXPECT().
+		Enabled().
+		Return(true).
+		Once()
+
+	mockRedactor.EXPECT().
+		Redact(`{"output":"key=hunter2"}`).
+		Return(`{"output":"key=[REDACTED]"}`, 1).
+		Once()
+
+	mockRedactor.EXPECT().
+		Redact("key=hunter2").
+		Return("key=[REDACTED]", 1).
+		Once()
+
+	handler := server.RedactionMiddleware(mockRedactor)(next)
+
+	// Act
+	result, err := handler(t.Context(), "tools/call", &mcp.CallToolRequest{})
+
+	// Assert
+	require.NoError(t, err)
+	callToolResult, ok := result.(*mcp.CallToolResult)
+	require.True(t, ok)
+
+	require.Len(t, callToolResult.Content, 2)
+	assert.Equal(t, `{"output":"key=[REDACTED]"}`, callToolResult.Content[0].(*mcp.TextContent).Text)
+	assert.Equal(t, "Note: 1 value(s) were redacted from this result.", callToolResult.Content[1].(*mcp.TextContent).Text)
+
+	structuredContent, ok := callToolResult.StructuredContent.(json.RawMessage)
+	require.True(t, ok)
+	assert.JSONEq(t, `{"output":"key=[REDACTED]","exitCode":0}`, string(structuredContent))
+
+	assert.Equal(t, 1, callToolResult.Meta[server.RedactionsMetaKey], "Redactions in the text and structured content should be counted once")
+}
+
+func TestRedactionMiddleware_NothingRedacted(t *testing.T) {
+	// Arrange
+	mockRedactor := &mocks.MockRedactor{}
+	defer mockRedactor.AssertExpectations(t)
+
+	expectedResult := &mcp.CallToolResult{
+		Content: []mcp.Content{&mcp.TextContent{Text: "ans = 42"}},
+	}
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return expectedResult, nil
+	}
+
+	mockRedactor.EXPECT().
+		Enabled().
+		Return(true).
+		Once()
+
+	mockRedactor.EXPECT().
+		Redact("ans = 42").
+		Return("ans = 42", 0).
+		Once()
+
+	handler := server.RedactionMiddleware(mockRedactor)(next)
+
+	// Act
+	result, err := handler(t.Context(), "tools/call", &mcp.CallToolRequest{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedResult, result)
+	assert.Len(t, expectedResult.Content, 1, "No note should be added when nothing was redacted")
+	assert.Nil(t, expectedResult.Meta)
+}
+
+func TestRedactionMiddleware_Disabled(t *testing.T) {
+	// Arrange
+	mockRedactor := &mocks.MockRedactor{}
+	defer mockRedactor.AssertExpectations(t)
+
+	expectedResult := &mcp.CallToolResult{
+		Content: []mcp.Content{&mcp.TextContent{Text: "key=hunter2"}},
+	}
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return expectedResult, nil
+	}
+
+	mockRedactor.EXPECT().
+		Enabled().
+		Return(false).
+		Once()
+
+	handler := server.RedactionMiddleware(mockRedactor)(next)
+
+	// Act
+	result, err := handler(t.Context(), "tools/call", &mcp.CallToolRequest{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedResult, result)
+	assert.Equal(t, "key=hunter2", expectedResult.Content[0].(*mcp.TextContent).Text)
+}
@@ -0,0 +1,197 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/correlationid"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// TruncatedOutputMetaKey is the `_meta` field of a tool call result holding the number of output bytes omitted, because the
+// output exceeded the response size limit of the transport.
+const TruncatedOutputMetaKey = "truncatedOutputBytes"
+
+// daemonSessionIDPrefix is the prefix of the session IDs of the clients connected to the daemon socket.
+const daemonSessionIDPrefix = "daemon-client-"
+
+// httpSessionIDPrefix is the prefix of the session IDs of the clients connected with the HTTP transport.
+const httpSessionIDPrefix = "http-client-"
+
+// webSocketSessionIDPrefix is the prefix of the session IDs of the clients connected with the WebSocket transport.
+const webSocketSessionIDPrefix = "ws-client-"
+
+// maxSummaryLines is the maximum number of omitted lines mentioning errors and warnings kept by a summary.
+const maxSummaryLines = 20
+
+// maxSummaryLineBytes is the length beyond which the lines kept by a summary are cut.
+const maxSummaryLineBytes = 200
+
+const oversizeOutputMIMEType = "text/plain"
+
+// responseSizeMiddleware shrinks the text of tool call results longer than the response size limit of the transport, as some
+// clients silently drop large messages. The beginning and the end of the text are kept, with a note saying how many bytes were
+// omitted. Depending on the configuration, the omitted lines mentioning errors and warnings are also kept, or the full text is
+// saved as an artifact linked from the result.
+// Results with structured content are left alone, as their text content repeats the structured content.
+func responseSizeMiddleware(config ResponseSizeConfig, artifacts OutputArtifacts, logger entities.Logger) mcp.Middleware {
+	var outputCount atomic.Int64
+
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			result, err := next(ctx, method, req)
+			if err != nil || method != methodCallTool {
+				return result, err
+			}
+
+			limit := config.MaxResponseBytes(transportOf(req))
+			if limit == 0 {
+				return result, err
+			}
+
+			callToolResult, ok := result.(*mcp.CallToolResult)
+			if !ok || callToolResult == nil || callToolResult.StructuredContent != nil {
+				return result, err
+			}
+
+			logger := logger
+			if params, ok := req.GetParams().(*mcp.CallToolParamsRaw); ok {
+				logger = logger.With("tool-name", params.Name)
+			}
+			if correlationID, ok := correlationid.FromContext(ctx); ok {
+				logger = logger.With(correlationid.LogKey, correlationID)
+			}
+
+			mode := config.OversizeResponse()
+			omitted := 0
+			var links []mcp.Content
+			for _, content := range callToolResult.Content {
+				textContent, ok := content.(*mcp.TextContent)
+				if !ok || len(textContent.Text) <= limit {
+					continue
+				}
+
+				location := ""
+				if mode == entities.OversizeResponseResource {
+					name := fmt.Sprintf("output-%d.txt", outputCount.Add(1))
+					artifact, writeErr := artifacts.Write(logger, name, []byte(textContent.Text), oversizeOutputMIMEType)
+					if writeErr != nil {
+						logger.WithError(writeErr).Warn("Failed to save oversize tool output as an artifact")
+					} else {
+						location = artifact.URI
+						links = append(links, &mcp.ResourceLink{
+							URI:      artifact.URI,
+							Name:     artifact.Name,
+							MIMEType: artifact.MIMEType,
+							Size:     &artifact.Bytes,
+						})
+					}
+				}
+
+				var n int
+				textContent.Text, n = shrinkText(textContent.Text, limit, mode == entities.OversizeResponseSummarize, location)
+				omitted += n
+			}
+
+			if omitted == 0 {
+				return result, err
+			}
+
+			logger.With("omitted-bytes", omitted).With("max-response-bytes", limit).Warn("Shrunk tool output above the response size limit")
+
+			callToolResult.Content = append(callToolResult.Content, links...)
+			if callToolResult.Meta == nil {
+				callToolResult.Meta = mcp.Meta{}
+			}
+			callToolResult.Meta[TruncatedOutputMetaKey] = omitted
+
+			return result, err
+		}
+	}
+}
+
+// transportOf is the transport the client of req is connected with.
+func transportOf(req mcp.Request) entities.Transport {
+	id := sessionID(req)
+	switch {
+	case strings.HasPrefix(id, daemonSessionIDPrefix):
+		return entities.TransportDaemon
+	case strings.HasPrefix(id, httpSessionIDPrefix):
+		return entities.TransportHTTP
+	case strings.HasPrefix(id, webSocketSessionIDPrefix):
+		return entities.TransportWebSocket
+	default:
+		return entities.TransportStdio
+	}
+}
+
+// shrinkText keeps the first quarter and the last three quarters of the limit of text, without splitting multi-byte characters,
+// and returns it with the number of bytes omitted. When summarize is set, the last quarter of the limit is instead used to keep the
+// omitted lines mentioning errors and warnings. When location is set, the note says the full text can be read there.
+func shrinkText(text string, limit int, summarize bool, location string) (string, int) {
+	headBytes := limit / 4
+	tailBytes := limit - headBytes
+	if summarize {
+		tailBytes = limit / 2
+	}
+
+	headEnd := 0
+	if headBytes > 0 {
+		headEnd = chunkEnd(text, headBytes)
+	}
+	tailBegin := max(tailStart(text, tailBytes), headEnd)
+
+	omitted := text[headEnd:tailBegin]
+
+	var note strings.Builder
+	fmt.Fprintf(&note, "\n[... %d bytes omitted", len(omitted))
+	if location != "" {
+		fmt.Fprintf(&note, ", the full output is in the resource %s", location)
+	}
+	note.WriteString(" ...]\n")
+
+	if summarize {
+		if lines := problemLines(omitted, limit-headBytes-tailBytes); len(lines) > 0 {
+			note.WriteString("[Omitted lines mentioning errors or warnings:]\n")
+			for _, line := range lines {
+				note.WriteString(line)
+				note.WriteString("\n")
+			}
+		}
+	}
+
+	return text[:headEnd] + note.String() + text[tailBegin:], len(omitted)
+}
+
+// problemLines returns the lines of text mentioning errors or warnings, cut to maxSummaryLineBytes, until there are maxSummaryLines
+// of them or they add up to budget bytes.
+func problemLines(text string, budget int) []string {
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		if len(lines) == maxSummaryLines {
+			break
+		}
+
+		lower := strings.ToLower(line)
+		if !strings.Contains(lower, "error") && !strings.Contains(lower, "warning") {
+			continue
+		}
+
+		line = strings.TrimSpace(line)
+		if len(line) > maxSummaryLineBytes {
+			line = line[:chunkEnd(line, maxSummaryLineBytes)] + "..."
+		}
+		if len(line)+1 > budget {
+			break
+		}
+
+		budget -= len(line) + 1
+		lines = append(lines, line)
+	}
+	return lines
+}
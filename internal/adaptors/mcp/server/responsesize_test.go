@@ -0,0 +1,237 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/artifactstore"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/server"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// callSizedTool calls a tool returning result, through the response size middleware, and returns the result received by the client.
+func callSizedTool(t *testing.T, config server.ResponseSizeConfig, artifacts server.OutputArtifacts, result *mcp.CallToolResult) *mcp.CallToolResult {
+	t.Helper()
+
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test-server"}, nil)
+	mcpServer.AddReceivingMiddleware(server.ResponseSizeMiddleware(config, artifacts, testutils.NewInspectableLogger()))
+	mcpServer.AddTool(&mcp.Tool{Name: "test-tool", InputSchema: map[string]any{"type": "object"}}, func(ctx context.Context, req *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return result, nil
+	})
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := mcpServer.Connect(t.Context(), serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = serverSession.Close() })
+
+	clientSession, err := client.Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = clientSession.Close() })
+
+	received, err := clientSession.CallTool(t.Context(), &mcp.CallToolParams{Name: "test-tool", Arguments: map[string]any{}})
+	require.NoError(t, err)
+
+	return received
+}
+
+// sizeConfig returns a response size configuration limiting the outputs sent over stdio to limit bytes, shrunk as set by mode.
+func sizeConfig(t *testing.T, limit int, mode entities.OversizeResponse) *mocks.MockResponseSizeConfig {
+	mockConfig := &mocks.MockResponseSizeConfig{}
+	t.Cleanup(func() { mockConfig.AssertExpectations(t) })
+
+	mockConfig.EXPECT().
+		MaxResponseBytes(entities.TransportStdio).
+		Return(limit).
+		Once()
+
+	if limit > 0 {
+		mockConfig.EXPECT().
+			OversizeResponse().
+			Return(mode).
+			Maybe()
+	}
+
+	return mockConfig
+}
+
+func TestResponseSizeMiddleware_TruncatesLongOutput(t *testing.T) {
+	// Arrange
+	mockArtifacts := &mocks.MockOutputArtifacts{}
+	defer mockArtifacts.AssertExpectations(t)
+
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{&mcp.TextContent{Text: "0123456789abcdefghij"}},
+	}
+
+	// Act
+	received := callSizedTool(t, sizeConfig(t, 8, entities.OversizeResponseTruncate), mockArtifacts, result)
+
+	// Assert
+	require.Len(t, received.Content, 1)
+	assert.Equal(t, "01\n[... 12 bytes omitted ...]\nefghij", received.Content[0].(*mcp.TextContent).Text, "The head and the tail should be kept")
+	assert.InDelta(t, 12, received.Meta[server.TruncatedOutputMetaKey], 0)
+}
+
+func TestResponseSizeMiddleware_DoesNotSplitCharacters(t *testing.T) {
+	// Arrange
+	mockArtifacts := &mocks.MockOutputArtifacts{}
+	defer mockArtifacts.AssertExpectations(t)
+
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{&mcp.TextContent{Text: "éééééé"}},
+	}
+
+	// Act
+	received := callSizedTool(t, sizeConfig(t, 5, entities.OversizeResponseTruncate), mockArtifacts, result)
+
+	// Assert
+	require.Len(t, received.Content, 1)
+	assert.Equal(t, "é\n[... 6 bytes omitted ...]\néé", received.Content[0].(*mcp.TextContent).Text)
+}
+
+func TestResponseSizeMiddleware_LeavesShortOutput(t *testing.T) {
+	// Arrange
+	mockArtifacts := &mocks.MockOutputArtifacts{}
+	defer mockArtifacts.AssertExpectations(t)
+
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{&mcp.TextContent{Text: "01234567"}},
+	}
+
+	// Act
+	received := callSizedTool(t, sizeConfig(t, 8, entities.OversizeResponseTruncate), mockArtifacts, result)
+
+	// Assert
+	require.Len(t, received.Content, 1)
+	assert.Equal(t, "01234567", received.Content[0].(*mcp.TextContent).Text)
+	assert.NotContains(t, received.Meta, server.TruncatedOutputMetaKey)
+}
+
+func TestResponseSizeMiddleware_NoLimit(t *testing.T) {
+	// Arrange
+	mockArtifacts := &mocks.MockOutputArtifacts{}
+	defer mockArtifacts.AssertExpectations(t)
+
+	text := strings.Repeat("0123456789", 100)
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{&mcp.TextContent{Text: text}},
+	}
+
+	// Act
+	received := callSizedTool(t, sizeConfig(t, 0, entities.OversizeResponseTruncate), mockArtifacts, result)
+
+	// Assert
+	require.Len(t, received.Content, 1)
+	assert.Equal(t, text, received.Content[0].(*mcp.TextContent).Text)
+}
+
+func TestResponseSizeMiddleware_LeavesStructuredContent(t *testing.T) {
+	// Arrange
+	mockArtifacts := &mocks.MockOutputArtifacts{}
+	defer mockArtifacts.AssertExpectations(t)
+
+	text := `{"output":"0123456789abcdefghij"}`
+	result := &mcp.CallToolResult{
+		Content:           []mcp.Content{&mcp.TextContent{Text: text}},
+		StructuredContent: map[string]any{"output": "0123456789abcdefghij"},
+	}
+
+	// Act
+	received := callSizedTool(t, sizeConfig(t, 8, entities.OversizeResponseTruncate), mockArtifacts, result)
+
+	// Assert
+	require.Len(t, received.Content, 1)
+	assert.Equal(t, text, received.Content[0].(*mcp.TextContent).Text)
+}
+
+func TestResponseSizeMiddleware_SummarizesOmittedProblems(t *testing.T) {
+	// Arrange
+	mockArtifacts := &mocks.MockOutputArtifacts{}
+	defer mockArtifacts.AssertExpectations(t)
+
+	head := strings.Repeat("h", 40)
+	middle := "\niteration 1\nError: bad\niteration 2\n  Warning: slow  \n" + strings.Repeat("x", 100) + "\n"
+	tail := strings.Repeat("t", 80)
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{&mcp.TextContent{Text: head + middle + tail}},
+	}
+
+	// Act
+	received := callSizedTool(t, sizeConfig(t, 160, entities.OversizeResponseSummarize), mockArtifacts, result)
+
+	// Assert
+	require.Len(t, received.Content, 1)
+	expected := head + fmt.Sprintf("\n[... %d bytes omitted ...]\n", len(middle)) +
+		"[Omitted lines mentioning errors or warnings:]\nError: bad\nWarning: slow\n" + tail
+	assert.Equal(t, expected, received.Content[0].(*mcp.TextContent).Text)
+}
+
+func TestResponseSizeMiddleware_SavesOutputAsResource(t *testing.T) {
+	// Arrange
+	mockArtifacts := &mocks.MockOutputArtifacts{}
+	defer mockArtifacts.AssertExpectations(t)
+
+	text := "0123456789abcdefghij"
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{&mcp.TextContent{Text: text}},
+	}
+
+	mockArtifacts.EXPECT().
+		Write(mock.Anything, "output-1.txt", []byte(text), "text/plain").
+		Return(artifactstore.Artifact{
+			Name:     "output-1.txt",
+			URI:      "matlab://artifacts/output-1.txt",
+			MIMEType: "text/plain",
+			Bytes:    int64(len(text)),
+		}, nil).
+		Once()
+
+	// Act
+	received := callSizedTool(t, sizeConfig(t, 8, entities.OversizeResponseResource), mockArtifacts, result)
+
+	// Assert
+	require.Len(t, received.Content, 2)
+	assert.Equal(t, "01\n[... 12 bytes omitted, the full output is in the resource matlab://artifacts/output-1.txt ...]\nefghij", received.Content[0].(*mcp.TextContent).Text)
+
+	link, ok := received.Content[1].(*mcp.ResourceLink)
+	require.True(t, ok, "The full output should be linked")
+	assert.Equal(t, "matlab://artifacts/output-1.txt", link.URI)
+	assert.Equal(t, "output-1.txt", link.Name)
+	assert.Equal(t, "text/plain", link.MIMEType)
+	require.NotNil(t, link.Size)
+	assert.Equal(t, int64(len(text)), *link.Size)
+}
+
+func TestResponseSizeMiddleware_ResourceWriteError(t *testing.T) {
+	// Arrange
+	mockArtifacts := &mocks.MockOutputArtifacts{}
+	defer mockArtifacts.AssertExpectations(t)
+
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{&mcp.TextContent{Text: "0123456789abcdefghij"}},
+	}
+
+	mockArtifacts.EXPECT().
+		Write(mock.Anything, "output-1.txt", mock.Anything, "text/plain").
+		Return(artifactstore.Artifact{}, assert.AnError).
+		Once()
+
+	// Act
+	received := callSizedTool(t, sizeConfig(t, 8, entities.OversizeResponseResource), mockArtifacts, result)
+
+	// Assert
+	require.Len(t, received.Content, 1, "No resource should be linked")
+	assert.Equal(t, "01\n[... 12 bytes omitted ...]\nefghij", received.Content[0].(*mcp.TextContent).Text, "The output should still be truncated")
+}
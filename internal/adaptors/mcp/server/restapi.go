@@ -0,0 +1,217 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	// restAPIToolsPath is the path the REST API serves every tool under, as POST /api/tools/NAME.
+	restAPIToolsPath = "/api/tools/"
+
+	// openAPIPath is the path of the OpenAPI description of the REST API.
+	openAPIPath = "/api/openapi.json"
+
+	// restAPISessionID is the session ID of the MCP session the REST API calls the tools in. It has the prefix of the
+	// HTTP transport, so that the limits of the HTTP transport apply to the calls of the REST API.
+	restAPISessionID = httpSessionIDPrefix + "rest-api"
+
+	// restAPIClientName is the client name the calls of the REST API are attributed to.
+	restAPIClientName = "rest-api"
+
+	// maxRESTRequestBytes is the size of the largest request body accepted by the REST API.
+	maxRESTRequestBytes = maxWebSocketMessageBytes
+)
+
+// restAPI serves the tools of the MCP server as a REST API, for automation that does not speak MCP, such as CI scripts.
+type restAPI struct {
+	session *mcp.ClientSession
+}
+
+// newRESTAPI connects the REST API to the MCP server, in a session that lasts until ctx is done.
+func (s *Server) newRESTAPI(ctx context.Context) (*restAPI, error) {
+	session, err := s.connectInProcess(ctx, restAPISessionID, restAPIClientName, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &restAPI{session: session}, nil
+}
+
+// register adds the routes of the REST API to mux.
+func (a *restAPI) register(mux *http.ServeMux) {
+	mux.Handle("POST "+restAPIToolsPath+"{name}", loopbackOnly(http.HandlerFunc(a.callTool)))
+	mux.Handle("GET "+openAPIPath, loopbackOnly(http.HandlerFunc(a.openAPI)))
+}
+
+func (a *restAPI) close() error {
+	return a.session.Close()
+}
+
+// callTool calls the tool named in the path with the JSON object of the body as arguments, and writes the result of the call.
+// Tool calls that fail are answered with 422 Unprocessable Entity, with the result of the call as body.
+func (a *restAPI) callTool(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+
+	tools, err := listTools(r.Context(), a.session)
+	if err != nil {
+		writeRESTError(w, http.StatusInternalServerError, "failed to list the tools: "+err.Error())
+		return
+	}
+	if _, ok := tools[name]; !ok {
+		writeRESTError(w, http.StatusNotFound, "unknown tool: "+name)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRESTRequestBytes))
+	if err != nil {
+		writeRESTError(w, http.StatusRequestEntityTooLarge, "the request body is too large")
+		return
+	}
+
+	arguments := map[string]any{}
+	if len(bytes.TrimSpace(body)) > 0 {
+		if err := json.Unmarshal(body, &arguments); err != nil || arguments == nil {
+			writeRESTError(w, http.StatusBadRequest, "the request body must be a JSON object of the arguments of the tool")
+			return
+		}
+	}
+
+	result, err := a.session.CallTool(r.Context(), &mcp.CallToolParams{
+		Meta:      apiKeyMeta(r.Context(), traceMeta(r.Context())),
+		Name:      name,
+		Arguments: arguments,
+	})
+	if err != nil {
+		if errors.Is(r.Context().Err(), context.Canceled) {
+			return
+		}
+		writeRESTError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	status := http.StatusOK
+	if result.IsError {
+		status = http.StatusUnprocessableEntity
+	}
+	writeRESTResponse(w, status, result)
+}
+
+// openAPI writes the OpenAPI description of the REST API, with a path for every tool of the server.
+func (a *restAPI) openAPI(w http.ResponseWriter, r *http.Request) {
+	tools, err := listTools(r.Context(), a.session)
+	if err != nil {
+		writeRESTError(w, http.StatusInternalServerError, "failed to list the tools: "+err.Error())
+		return
+	}
+
+	version := ""
+	if initializeResult := a.session.InitializeResult(); initializeResult != nil && initializeResult.ServerInfo != nil {
+		version = initializeResult.ServerInfo.Version
+	}
+
+	writeRESTResponse(w, http.StatusOK, openAPIDocument(version, tools))
+}
+
+// openAPIDocument describes the REST API of tools in an OpenAPI 3.1 document. The request body of every tool is
+// described by its input schema, and its response by the result of a tool call, with the output schema of the tool.
+func openAPIDocument(version string, tools map[string]*mcp.Tool) map[string]any {
+	paths := map[string]any{}
+	for name, tool := range tools {
+		var inputSchema any = map[string]any{"type": "object"}
+		if tool.InputSchema != nil {
+			inputSchema = tool.InputSchema
+		}
+
+		var structuredContentSchema any = map[string]any{"type": "object"}
+		if tool.OutputSchema != nil {
+			structuredContentSchema = tool.OutputSchema
+		}
+
+		resultContent := map[string]any{
+			"application/json": map[string]any{
+				"schema": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"content":           map[string]any{"type": "array", "items": map[string]any{"type": "object"}},
+						"structuredContent": structuredContentSchema,
+						"isError":           map[string]any{"type": "boolean"},
+					},
+				},
+			},
+		}
+
+		summary := tool.Title
+		if summary == "" && tool.Annotations != nil {
+			summary = tool.Annotations.Title
+		}
+
+		paths[restAPIToolsPath+name] = map[string]any{
+			"post": map[string]any{
+				"operationId": name,
+				"summary":     summary,
+				"description": tool.Description,
+				"requestBody": map[string]any{
+					"required": true,
+					"content": map[string]any{
+						"application/json": map[string]any{"schema": inputSchema},
+					},
+				},
+				"responses": map[string]any{
+					"200": map[string]any{"description": "The result of the tool call.", "content": resultContent},
+					"400": openAPIErrorResponse("The arguments are not valid for the tool."),
+					"404": openAPIErrorResponse("The tool does not exist."),
+					"422": map[string]any{"description": "The tool call failed, the content of the result tells why.", "content": resultContent},
+				},
+			},
+		}
+	}
+
+	return map[string]any{
+		"openapi": "3.1.0",
+		"info": map[string]any{
+			"title":       "MATLAB MCP Core Server",
+			"description": "The tools of the MATLAB MCP Core Server, called with the same tool policy and limits as the calls of MCP clients.",
+			"version":     version,
+		},
+		"paths": paths,
+	}
+}
+
+func openAPIErrorResponse(description string) map[string]any {
+	return map[string]any{
+		"description": description,
+		"content": map[string]any{
+			"application/json": map[string]any{
+				"schema": map[string]any{
+					"type":       "object",
+					"properties": map[string]any{"error": map[string]any{"type": "string"}},
+				},
+			},
+		},
+	}
+}
+
+func writeRESTError(w http.ResponseWriter, status int, message string) {
+	writeRESTResponse(w, status, map[string]string{"error": message})
+}
+
+func writeRESTResponse(w http.ResponseWriter, status int, body any) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		http.Error(w, "Internal Server Error: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(data)
+}
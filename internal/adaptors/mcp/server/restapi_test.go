@@ -0,0 +1,169 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type echoInput struct {
+	Text string `json:"text" jsonschema:"The text to echo."`
+}
+
+// newRESTAPIServer serves the REST API of an MCP server with an echo tool, which fails when the text is "fail".
+func newRESTAPIServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "v1.2.3"}, nil)
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "echo", Title: "Echo", Description: "Echoes the text."}, func(_ context.Context, _ *mcp.CallToolRequest, input echoInput) (*mcp.CallToolResult, any, error) {
+		return &mcp.CallToolResult{
+			IsError: input.Text == "fail",
+			Content: []mcp.Content{&mcp.TextContent{Text: input.Text}},
+		}, nil, nil
+	})
+
+	srv := server.NewWithMCPServer(mcpServer, testutils.NewInspectableLogger())
+	handler, closeRESTAPI, err := srv.NewRESTAPIHandler(t.Context())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = closeRESTAPI() })
+
+	httpServer := httptest.NewServer(handler)
+	t.Cleanup(httpServer.Close)
+	return httpServer
+}
+
+func TestRESTAPI_CallTool(t *testing.T) {
+	testConfigs := []struct {
+		name           string
+		path           string
+		body           string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "happy path",
+			path:           "/api/tools/echo",
+			body:           `{"text": "hello"}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"content":[{"type":"text","text":"hello"}]}`,
+		},
+		{
+			name:           "failed tool call",
+			path:           "/api/tools/echo",
+			body:           `{"text": "fail"}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedBody:   `{"content":[{"type":"text","text":"fail"}],"isError":true}`,
+		},
+		{
+			name:           "unknown tool",
+			path:           "/api/tools/missing",
+			body:           `{}`,
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   `{"error":"unknown tool: missing"}`,
+		},
+		{
+			name:           "body is not an object",
+			path:           "/api/tools/echo",
+			body:           `["hello"]`,
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":"the request body must be a JSON object of the arguments of the tool"}`,
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			httpServer := newRESTAPIServer(t)
+
+			// Act
+			response, err := http.Post(httpServer.URL+testConfig.path, "application/json", strings.NewReader(testConfig.body))
+			require.NoError(t, err)
+			body, err := io.ReadAll(response.Body)
+			require.NoError(t, err)
+			require.NoError(t, response.Body.Close())
+
+			// Assert
+			assert.Equal(t, testConfig.expectedStatus, response.StatusCode)
+			assert.Equal(t, "application/json", response.Header.Get("Content-Type"))
+			assert.JSONEq(t, testConfig.expectedBody, string(body))
+		})
+	}
+}
+
+func TestRESTAPI_CallTool_InvalidArguments(t *testing.T) {
+	// Arrange
+	httpServer := newRESTAPIServer(t)
+
+	// Act
+	response, err := http.Post(httpServer.URL+"/api/tools/echo", "application/json", strings.NewReader(`{"text": 42}`))
+	require.NoError(t, err)
+	require.NoError(t, response.Body.Close())
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+}
+
+func TestRESTAPI_CallTool_MethodNotAllowed(t *testing.T) {
+	// Arrange
+	httpServer := newRESTAPIServer(t)
+
+	// Act
+	response, err := http.Get(httpServer.URL + "/api/tools/echo")
+	require.NoError(t, err)
+	require.NoError(t, response.Body.Close())
+
+	// Assert
+	assert.Equal(t, http.StatusMethodNotAllowed, response.StatusCode)
+}
+
+func TestRESTAPI_OpenAPI(t *testing.T) {
+	// Arrange
+	httpServer := newRESTAPIServer(t)
+
+	// Act
+	response, err := http.Get(httpServer.URL + "/api/openapi.json")
+	require.NoError(t, err)
+	var document struct {
+		OpenAPI string `json:"openapi"`
+		Info    struct {
+			Version string `json:"version"`
+		} `json:"info"`
+		Paths map[string]struct {
+			Post struct {
+				OperationID string `json:"operationId"`
+				Summary     string `json:"summary"`
+				RequestBody struct {
+					Content map[string]struct {
+						Schema struct {
+							Properties map[string]any `json:"properties"`
+						} `json:"schema"`
+					} `json:"content"`
+				} `json:"requestBody"`
+			} `json:"post"`
+		} `json:"paths"`
+	}
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&document))
+	require.NoError(t, response.Body.Close())
+
+	// Assert
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "3.1.0", document.OpenAPI)
+	assert.Equal(t, "v1.2.3", document.Info.Version)
+	require.Contains(t, document.Paths, "/api/tools/echo")
+	operation := document.Paths["/api/tools/echo"].Post
+	assert.Equal(t, "echo", operation.OperationID)
+	assert.Equal(t, "Echo", operation.Summary)
+	assert.Contains(t, operation.RequestBody.Content["application/json"].Schema.Properties, "text", "The request body should be described by the input schema of the tool")
+}
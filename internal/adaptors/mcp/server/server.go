@@ -4,14 +4,30 @@ package server
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"iter"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
 
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/auditlog"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/toolpolicy"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/artifactstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/sessiontranscript"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/clientidentity"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/tracing"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
 type LoggerFactory interface {
 	GetGlobalLogger() entities.Logger
+	ForwardToSessions(sessions func() iter.Seq[*mcp.ServerSession])
 }
 
 type LifecycleSignaler interface {
@@ -20,6 +36,109 @@ type LifecycleSignaler interface {
 
 type MCPServerConfigurator interface {
 	GetToolsToAdd() []tools.Tool
+	GetResourcesToAdd() []resources.Resource
+}
+
+type UsageRecorder interface {
+	RecordToolCall(toolName string, errorClass string)
+}
+
+type EventBuffer interface {
+	Record(kind entities.EventKind, message string, details map[string]any)
+	Events() []entities.Event
+}
+
+type EventSink interface {
+	Publish(event entities.Event)
+}
+
+type ToolPolicy interface {
+	Evaluate(call toolpolicy.Call) toolpolicy.Decision
+}
+
+type DryRunPlanner interface {
+	Applies(tool string, arguments json.RawMessage) bool
+	Plan(tool string, arguments json.RawMessage) string
+}
+
+type Redactor interface {
+	Enabled() bool
+	Redact(text string) (string, int)
+}
+
+type RateLimiter interface {
+	Acquire(clientID string) (func(), error)
+}
+
+type SessionRecorder interface {
+	Enabled() bool
+	RecordToolCall(identity clientidentity.Identity, tool string, arguments json.RawMessage, result *mcp.CallToolResult, err error)
+}
+
+type AuditLog interface {
+	Enabled() bool
+	RecordToolCall(call auditlog.ToolCall)
+}
+
+type SessionTranscript interface {
+	Record(tool string, arguments json.RawMessage, output string, figures []sessiontranscript.Figure, failed bool)
+}
+
+type IdentityProvider interface {
+	User() string
+}
+
+type OutputStreamingConfig interface {
+	StreamOutputChunkSize() int
+}
+
+type ResponseSizeConfig interface {
+	MaxResponseBytes(transport entities.Transport) int
+	OversizeResponse() entities.OversizeResponse
+}
+
+type OutputArtifacts interface {
+	Write(logger entities.Logger, name string, content []byte, mimeType string) (artifactstore.Artifact, error)
+}
+
+type NotificationThrottle interface {
+	Allow(clientID string) bool
+}
+
+// notificationLogger is the name of the logger of the log message notifications sent to the clients.
+const notificationLogger = "matlab-mcp-core-server"
+
+type DaemonSocket interface {
+	Enabled() bool
+	Listen() (net.Listener, error)
+}
+
+type TransportConfig interface {
+	ServeTransport() entities.Transport
+	ListenAddress() string
+	RESTAPI() bool
+	GRPC() bool
+	WebSocketPingInterval() time.Duration
+}
+
+type Localizer interface {
+	Locale() entities.Locale
+}
+
+type Authenticator interface {
+	Enabled() bool
+	Load() error
+	Authenticate(r *http.Request) (string, error)
+}
+
+type Tracer interface {
+	Start(ctx context.Context, name string, kind tracing.SpanKind, attributes ...tracing.Attribute) (context.Context, *tracing.Span)
+}
+
+type Metrics interface {
+	RecordToolCall(tool string, duration time.Duration, failed bool)
+	RecordHTTPRequest(method string, statusCode int)
+	ObserveActiveSessions(count func() int)
 }
 
 type Server struct {
@@ -27,6 +146,17 @@ type Server struct {
 	serverLogger      entities.Logger
 	lifecycleSignaler LifecycleSignaler
 	serverTransport   mcp.Transport
+	daemonSocket      DaemonSocket
+	transportConfig   TransportConfig
+	authenticator     Authenticator
+	tracer            Tracer
+	metrics           Metrics
+	listen            func(network string, address string) (net.Listener, error)
+	drainer           *drainer
+
+	configurator MCPServerConfigurator
+	toolsLock    *sync.Mutex
+	toolNames    map[string]bool
 }
 
 func New(
@@ -34,21 +164,116 @@ func New(
 	loggerFactory LoggerFactory,
 	lifecycleSignaler LifecycleSignaler,
 	configurator MCPServerConfigurator,
+	eventBuffer EventBuffer,
+	eventSink EventSink,
+	usageRecorder UsageRecorder,
+	toolPolicy ToolPolicy,
+	dryRunPlanner DryRunPlanner,
+	redactor Redactor,
+	rateLimiter RateLimiter,
+	sessionRecorder SessionRecorder,
+	sessionTranscript SessionTranscript,
+	identityProvider IdentityProvider,
+	outputStreamingConfig OutputStreamingConfig,
+	notificationThrottle NotificationThrottle,
+	progressConfig ProgressConfig,
+	responseSizeConfig ResponseSizeConfig,
+	outputArtifacts OutputArtifacts,
+	daemonSocket DaemonSocket,
+	transportConfig TransportConfig,
+	localizer Localizer,
+	authenticator Authenticator,
+	auditLog AuditLog,
+	tracer Tracer,
+	metrics Metrics,
 ) (*Server, error) {
 	logger := loggerFactory.GetGlobalLogger()
+	drainer := newDrainer()
 
 	logger.Debug("Adding tools to MCP SDK server")
+	toolNames := make(map[string]bool)
 	for _, tool := range configurator.GetToolsToAdd() {
 		if err := tool.AddToServer(mcpserver); err != nil {
 			return nil, err
 		}
+		if name := toolName(tool); name != "" {
+			toolNames[name] = true
+		}
 	}
 
+	logger.Debug("Adding resource templates to MCP SDK server")
+	for _, resource := range configurator.GetResourcesToAdd() {
+		if err := resource.AddToServer(mcpserver); err != nil {
+			return nil, err
+		}
+	}
+
+	// The correlation ID, the client identity and the locale are assigned first, so that they are available to every other middleware.
+	// The span of the tool call starts right after the correlation ID, so that it covers the time spent in every other middleware.
+	// Oversize outputs are shrunk next, once they were streamed, so that only what is left of them counts towards the response size limit.
+	// Long outputs are streamed next, so that the other middlewares, such as the session recording, see the full result.
+	// The progress of the call is reported next, and stops before the output is streamed. The output sent while the call runs is redacted there, as it does not go through the redaction of the result.
+	// Results are redacted before the failures are recorded as events, and before the calls are recorded to the session recording,
+	// to the audit log and to the session transcript.
+	// The tool failure context is installed next to last, so that the failure is attached to the result before the other middlewares see it.
+	// The calls received while the server stops, the rate limits and the tool policy are evaluated last, so that rejected calls are
+	// reported like any other failed tool call.
+	// Dry runs are answered just before the tool policy, which they report without asking the user to confirm the call.
+	mcpserver.AddReceivingMiddleware(
+		correlationIDMiddleware,
+		tracingMiddleware(tracer),
+		clientIdentityMiddleware(identityProvider),
+		localeMiddleware(localizer.Locale()),
+		responseSizeMiddleware(responseSizeConfig, outputArtifacts, logger),
+		outputStreamingMiddleware(outputStreamingConfig, notificationThrottle, logger),
+		progressMiddleware(progressConfig, notificationThrottle, redactor, logger),
+		toolCallFailureMiddleware(eventBuffer),
+		usageMiddleware(usageRecorder),
+		metricsMiddleware(metrics),
+		toolCallEventMiddleware(eventSink),
+		recordingMiddleware(sessionRecorder),
+		auditMiddleware(auditLog),
+		transcriptMiddleware(sessionTranscript, dryRunPlanner),
+		redactionMiddleware(redactor),
+		clientRootsMiddleware,
+		elicitationMiddleware,
+		toolFailureMiddleware,
+		drainMiddleware(drainer, logger),
+		rateLimitMiddleware(rateLimiter, logger),
+		dryRunMiddleware(dryRunPlanner, toolPolicy, logger),
+		toolPolicyMiddleware(toolPolicy, logger),
+	)
+
+	logger.Debug("Adding resources to MCP SDK server")
+	mcpserver.AddResource(&mcp.Resource{
+		URI:         EventsResourceURI,
+		Name:        eventsResourceName,
+		Title:       "Server Events",
+		Description: "The most recent notable events of the MATLAB MCP Core Server, such as MATLAB session starts, failed tool calls and instance takeovers.",
+		MIMEType:    eventsResourceMIMEType,
+	}, eventsResourceHandler(eventBuffer))
+
+	metrics.ObserveActiveSessions(countActiveSessions(mcpserver))
+
+	// The clients which set a log level receive the log entries of the server, not only those of their tool calls.
+	loggerFactory.ForwardToSessions(mcpserver.Sessions)
+
 	return &Server{
 		mcpServer:         mcpserver,
 		serverLogger:      logger,
 		lifecycleSignaler: lifecycleSignaler,
 		serverTransport:   &mcp.StdioTransport{},
+		daemonSocket:      daemonSocket,
+		transportConfig:   transportConfig,
+		authenticator:     authenticator,
+		tracer:            tracer,
+		metrics:           metrics,
+		listen:            net.Listen,
+		drainer:           drainer,
+
+		configurator: configurator,
+		toolsLock:    new(sync.Mutex),
+		toolNames:    toolNames,
 	}, nil
 }
 
@@ -65,7 +290,19 @@ func (s *Server) Run() error {
 
 	serverErrC := make(chan error)
 	go func() {
-		serverErrC <- s.mcpServer.Run(ctx, s.serverTransport)
+		if s.daemonSocket.Enabled() {
+			serverErrC <- s.serveDaemon(ctx)
+			return
+		}
+		switch transport := s.transportConfig.ServeTransport(); transport {
+		case entities.TransportHTTP, entities.TransportWebSocket:
+			serverErrC <- s.serveHTTP(ctx, transport)
+		default:
+			serverErrC <- s.mcpServer.Run(ctx, &clientClosureTransport{
+				Transport:       s.serverTransport,
+				onClientClosure: s.clientClosed,
+			})
+		}
 	}()
 	s.serverLogger.Debug("Started MCP server")
 
@@ -84,3 +321,183 @@ func (s *Server) Run() error {
 
 	return nil
 }
+
+// Drain stops accepting tool calls, and waits for the calls in flight to complete, so that they are answered before
+// MATLAB stops. The calls still in flight after the drain timeout are cancelled, and answered as cancelled calls.
+// It fails if calls had to be cancelled.
+func (s *Server) Drain() error {
+	s.serverLogger.Debug("Draining the tool calls in flight")
+	return s.drainer.drain()
+}
+
+// clientClosed cancels the tool calls in flight once the client closed the standard input of the server, as their
+// results cannot be returned.
+func (s *Server) clientClosed() {
+	s.serverLogger.Info("Client closed the connection, cancelling the tool calls in flight")
+	s.drainer.abandon()
+}
+
+// serveDaemon serves every client connecting to the daemon socket in its own MCP session, until the server is stopped.
+// Clients connect and disconnect without stopping the server, so that the MATLAB session stays warm between them.
+func (s *Server) serveDaemon(ctx context.Context) error {
+	listener, err := s.daemonSocket.Listen()
+	if err != nil {
+		s.serverLogger.WithError(err).Error("Failed to listen on daemon socket")
+		return entities.NewExitError(entities.ExitCodeTransportBindFailed, err)
+	}
+	s.serverLogger.With("address", listener.Addr().String()).Info("Daemon listening for MCP clients")
+
+	var sessions sync.WaitGroup
+	defer sessions.Wait()
+
+	go func() {
+		<-ctx.Done()
+		_ = listener.Close()
+		for session := range s.mcpServer.Sessions() {
+			_ = session.Close()
+		}
+	}()
+
+	for clientNumber := 1; ; clientNumber++ {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+
+		sessionID := daemonSessionIDPrefix + strconv.Itoa(clientNumber)
+		logger := s.serverLogger.With("session-id", sessionID)
+
+		session, err := s.mcpServer.Connect(ctx, newSocketTransport(conn, sessionID), nil)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to connect daemon client")
+			_ = conn.Close()
+			continue
+		}
+		logger.Info("Client connected to daemon")
+
+		sessions.Add(1)
+		go func() {
+			defer sessions.Done()
+			_ = session.Wait()
+			logger.Info("Client disconnected from daemon")
+		}()
+	}
+}
+
+// serveHTTP serves the clients connecting to the listen address with transport, each in its own MCP session, until the
+// server is stopped. Only requests addressed to a loopback host are served, so that web pages cannot reach the server.
+// With the HTTP transport, the tools can also be served as a REST API and a gRPC service next to the MCP endpoint.
+func (s *Server) serveHTTP(ctx context.Context, transport entities.Transport) error {
+	listener, err := s.listen("tcp", s.transportConfig.ListenAddress())
+	if err != nil {
+		s.serverLogger.WithError(err).Error("Failed to listen for MCP clients")
+		return entities.NewExitError(entities.ExitCodeTransportBindFailed, err)
+	}
+
+	var sessions sync.WaitGroup
+	defer sessions.Wait()
+
+	scheme := "http"
+	handler := s.streamableHTTPHandler()
+	if transport == entities.TransportWebSocket {
+		scheme = "ws"
+		handler = s.webSocketHandler(ctx, &sessions)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(mcpEndpointPath, loopbackOnly(handler))
+
+	if transport == entities.TransportHTTP && s.transportConfig.RESTAPI() {
+		restAPI, err := s.newRESTAPI(ctx)
+		if err != nil {
+			_ = listener.Close()
+			s.serverLogger.WithError(err).Error("Failed to start the REST API")
+			return err
+		}
+		defer func() { _ = restAPI.close() }()
+
+		restAPI.register(mux)
+		s.serverLogger.
+			With("url", "http://"+listener.Addr().String()+openAPIPath).
+			Info("Serving the tools as a REST API")
+	}
+	httpServer := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	// The API keys protect every endpoint of the listen address: MCP, the REST API and the gRPC service.
+	if s.authenticator.Enabled() {
+		if err := s.authenticator.Load(); err != nil {
+			_ = listener.Close()
+			s.serverLogger.WithError(err).Error("Failed to load the API keys")
+			return entities.NewExitError(entities.ExitCodeConfigError, err)
+		}
+		httpServer.Handler = authenticated(s.authenticator, s.serverLogger, mux)
+	} else {
+		s.serverLogger.Warn("No API keys are set: any local process can call the tools of the server")
+	}
+	httpServer.Handler = measured(s.metrics, traced(s.tracer, httpServer.Handler))
+
+	if transport == entities.TransportHTTP && s.transportConfig.GRPC() {
+		grpcAPI, err := s.newGRPCAPI(ctx)
+		if err != nil {
+			_ = listener.Close()
+			s.serverLogger.WithError(err).Error("Failed to start the gRPC service")
+			return err
+		}
+		defer func() { _ = grpcAPI.close() }()
+
+		grpcAPI.register(mux)
+
+		// gRPC clients connect with HTTP/2 without TLS, the MCP clients keep connecting with HTTP/1.1.
+		protocols := &http.Protocols{}
+		protocols.SetHTTP1(true)
+		protocols.SetUnencryptedHTTP2(true)
+		httpServer.Protocols = protocols
+
+		s.serverLogger.
+			With("address", listener.Addr().String()).
+			Info("Serving the gRPC service")
+	}
+
+	go func() {
+		<-ctx.Done()
+		_ = httpServer.Close()
+		for session := range s.mcpServer.Sessions() {
+			_ = session.Close()
+		}
+	}()
+
+	s.serverLogger.
+		With("transport", string(transport)).
+		With("url", scheme+"://"+listener.Addr().String()+mcpEndpointPath).
+		Info("Listening for MCP clients")
+
+	err = httpServer.Serve(listener)
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+// NotifyClients sends a log message notification to every connected client, at level, such as "warning".
+// Clients only receive the notification if they set a log level at or below level.
+func (s *Server) NotifyClients(level string, message string) {
+	for session := range s.mcpServer.Sessions() {
+		err := session.Log(context.Background(), &mcp.LoggingMessageParams{
+			Level:  mcp.LoggingLevel(level),
+			Logger: notificationLogger,
+			Data:   message,
+		})
+		if err != nil {
+			s.serverLogger.WithError(err).With("session-id", session.ID()).Warn("Failed to notify client")
+		}
+	}
+}
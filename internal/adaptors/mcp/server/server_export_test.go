@@ -3,9 +3,122 @@
 package server
 
 import (
+	"bufio"
+	"context"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
 func (s *Server) SetServerTransport(serverTransport mcp.Transport) {
 	s.serverTransport = serverTransport
 }
+
+// NewWithMCPServer returns a server of mcpServer, without the tools, resources and middlewares added by New.
+func NewWithMCPServer(mcpServer *mcp.Server, logger entities.Logger) *Server {
+	return &Server{
+		mcpServer:    mcpServer,
+		serverLogger: logger,
+		drainer:      newDrainer(),
+	}
+}
+
+// NewWithConfigurator returns a server of mcpServer, which exposes the tools named toolNames, and reloads its tools
+// from configurator.
+func NewWithConfigurator(mcpServer *mcp.Server, logger entities.Logger, configurator MCPServerConfigurator, toolNames ...string) *Server {
+	server := NewWithMCPServer(mcpServer, logger)
+	server.configurator = configurator
+	server.toolsLock = new(sync.Mutex)
+	server.toolNames = make(map[string]bool)
+	for _, name := range toolNames {
+		server.toolNames[name] = true
+	}
+	return server
+}
+
+var CorrelationIDMiddleware = correlationIDMiddleware
+
+var ToolCallFailureMiddleware = toolCallFailureMiddleware
+var ToolCallEventMiddleware = toolCallEventMiddleware
+
+var EventsResourceHandler = eventsResourceHandler
+
+var UsageMiddleware = usageMiddleware
+var ToolFailureMiddleware = toolFailureMiddleware
+var ClientRootsMiddleware = clientRootsMiddleware
+var ToolPolicyMiddleware = toolPolicyMiddleware
+var DryRunMiddleware = dryRunMiddleware
+var ElicitationMiddleware = elicitationMiddleware
+var RedactionMiddleware = redactionMiddleware
+var RateLimitMiddleware = rateLimitMiddleware
+var RecordingMiddleware = recordingMiddleware
+var AuditMiddleware = auditMiddleware
+var TracingMiddleware = tracingMiddleware
+var MetricsMiddleware = metricsMiddleware
+var TranscriptMiddleware = transcriptMiddleware
+var ClientIdentityMiddleware = clientIdentityMiddleware
+var OutputStreamingMiddleware = outputStreamingMiddleware
+var ProgressMiddleware = progressMiddleware
+var ResponseSizeMiddleware = responseSizeMiddleware
+var DrainMiddleware = drainMiddleware
+var LocaleMiddleware = localeMiddleware
+
+type Drainer = drainer
+
+// NewDrainer returns a drainer waiting drainTimeout for the tool calls in flight, and cancelTimeout once they are cancelled.
+func NewDrainer(drainTimeout time.Duration, cancelTimeout time.Duration) *Drainer {
+	d := newDrainer()
+	d.drainTimeout = drainTimeout
+	d.cancelTimeout = cancelTimeout
+	return d
+}
+
+func (d *drainer) Drain() error {
+	return d.drain()
+}
+
+func (d *drainer) Abandon() {
+	d.abandon()
+}
+
+func (s *Server) SetListen(listen func(network string, address string) (net.Listener, error)) {
+	s.listen = listen
+}
+
+var LoopbackOnly = loopbackOnly
+var Authenticated = authenticated
+var Traced = traced
+var Measured = measured
+
+// NewRESTAPIHandler returns the routes of the REST API of the server, and a function closing its MCP session.
+func (s *Server) NewRESTAPIHandler(ctx context.Context) (http.Handler, func() error, error) {
+	restAPI, err := s.newRESTAPI(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mux := http.NewServeMux()
+	restAPI.register(mux)
+	return mux, restAPI.close, nil
+}
+
+// NewGRPCHandler returns the methods of the gRPC service of the server, and a function closing its MCP session.
+func (s *Server) NewGRPCHandler(ctx context.Context) (http.Handler, func() error, error) {
+	grpcAPI, err := s.newGRPCAPI(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mux := http.NewServeMux()
+	grpcAPI.register(mux)
+	return mux, grpcAPI.close, nil
+}
+
+// NewWebSocketTransport returns the transport of a client connected with conn, after the handshake.
+func NewWebSocketTransport(conn net.Conn, pingInterval time.Duration) mcp.Transport {
+	return newWebSocketTransport(conn, bufio.NewReader(conn), "ws-test", pingInterval)
+}
@@ -3,11 +3,24 @@
 package server_test
 
 import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/tracing"
+	resourcesmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/resources"
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/server"
 	toolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
@@ -27,12 +40,86 @@ func TestNew_HappyPath(t *testing.T) {
 	mockConfigurator := &mocks.MockMCPServerConfigurator{}
 	defer mockConfigurator.AssertExpectations(t)
 
+	mockEventBuffer := &mocks.MockEventBuffer{}
+	defer mockEventBuffer.AssertExpectations(t)
+
+	mockEventSink := &mocks.MockEventSink{}
+	defer mockEventSink.AssertExpectations(t)
+
+	mockUsageRecorder := &mocks.MockUsageRecorder{}
+	defer mockUsageRecorder.AssertExpectations(t)
+
+	mockToolPolicy := &mocks.MockToolPolicy{}
+	defer mockToolPolicy.AssertExpectations(t)
+
+	mockDryRunPlanner := &mocks.MockDryRunPlanner{}
+	defer mockDryRunPlanner.AssertExpectations(t)
+
+	mockRedactor := &mocks.MockRedactor{}
+	defer mockRedactor.AssertExpectations(t)
+
+	mockRateLimiter := &mocks.MockRateLimiter{}
+	defer mockRateLimiter.AssertExpectations(t)
+
+	mockSessionRecorder := &mocks.MockSessionRecorder{}
+	defer mockSessionRecorder.AssertExpectations(t)
+
+	mockSessionTranscript := &mocks.MockSessionTranscript{}
+	defer mockSessionTranscript.AssertExpectations(t)
+
+	mockIdentityProvider := &mocks.MockIdentityProvider{}
+	defer mockIdentityProvider.AssertExpectations(t)
+
+	mockOutputStreamingConfig := &mocks.MockOutputStreamingConfig{}
+	defer mockOutputStreamingConfig.AssertExpectations(t)
+
+	mockNotificationThrottle := &mocks.MockNotificationThrottle{}
+	defer mockNotificationThrottle.AssertExpectations(t)
+
+	mockProgressConfig := &mocks.MockProgressConfig{}
+	defer mockProgressConfig.AssertExpectations(t)
+
+	mockResponseSizeConfig := &mocks.MockResponseSizeConfig{}
+	defer mockResponseSizeConfig.AssertExpectations(t)
+
+	mockOutputArtifacts := &mocks.MockOutputArtifacts{}
+	defer mockOutputArtifacts.AssertExpectations(t)
+
+	mockDaemonSocket := &mocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockTransportConfig := &mocks.MockTransportConfig{}
+	defer mockTransportConfig.AssertExpectations(t)
+
+	mockLocalizer := &mocks.MockLocalizer{}
+	defer mockLocalizer.AssertExpectations(t)
+
+	mockAuthenticator := &mocks.MockAuthenticator{}
+	defer mockAuthenticator.AssertExpectations(t)
+
+	mockAuditLog := &mocks.MockAuditLog{}
+	defer mockAuditLog.AssertExpectations(t)
+
+	mockTracer := &mocks.MockTracer{}
+	defer mockTracer.AssertExpectations(t)
+
+	mockMetrics := &mocks.MockMetrics{}
+	defer mockMetrics.AssertExpectations(t)
+
+	mockLocalizer.EXPECT().
+		Locale().
+		Return(entities.LocaleEnglish).
+		Once()
+
 	mockFirstTool := &toolsmocks.MockTool{}
 	defer mockFirstTool.AssertExpectations(t)
 
 	mockSecondTool := &toolsmocks.MockTool{}
 	defer mockSecondTool.AssertExpectations(t)
 
+	mockResource := &resourcesmocks.MockResource{}
+	defer mockResource.AssertExpectations(t)
+
 	mockServerConfig := &mocks.MockServerConfig{}
 	defer mockServerConfig.AssertExpectations(t)
 
@@ -41,6 +128,11 @@ func TestNew_HappyPath(t *testing.T) {
 		Return("1.0.0").
 		Once()
 
+	mockServerConfig.EXPECT().
+		BuildInfo().
+		Return(entities.BuildInfo{}).
+		Once()
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	mcpserver := server.NewMCPSDKServer(mockServerConfig)
@@ -65,8 +157,28 @@ func TestNew_HappyPath(t *testing.T) {
 		Return(nil).
 		Once()
 
+	mockConfigurator.EXPECT().
+		GetResourcesToAdd().
+		Return([]resources.Resource{mockResource}).
+		Once()
+
+	mockResource.EXPECT().
+		AddToServer(mcpserver).
+		Return(nil).
+		Once()
+
+	mockMetrics.EXPECT().
+		ObserveActiveSessions(mock.Anything).
+		Return().
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		ForwardToSessions(mock.Anything).
+		Return().
+		Once()
+
 	// Act
-	server, err := server.New(mcpserver, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator)
+	server, err := server.New(mcpserver, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockEventBuffer, mockEventSink, mockUsageRecorder, mockToolPolicy, mockDryRunPlanner, mockRedactor, mockRateLimiter, mockSessionRecorder, mockSessionTranscript, mockIdentityProvider, mockOutputStreamingConfig, mockNotificationThrottle, mockProgressConfig, mockResponseSizeConfig, mockOutputArtifacts, mockDaemonSocket, mockTransportConfig, mockLocalizer, mockAuthenticator, mockAuditLog, mockTracer, mockMetrics)
 
 	// Assert
 	require.NoError(t, err, "New should not return an error")
@@ -84,6 +196,72 @@ func TestNew_AddToServerReturnsError(t *testing.T) {
 	mockConfigurator := &mocks.MockMCPServerConfigurator{}
 	defer mockConfigurator.AssertExpectations(t)
 
+	mockEventBuffer := &mocks.MockEventBuffer{}
+	defer mockEventBuffer.AssertExpectations(t)
+
+	mockEventSink := &mocks.MockEventSink{}
+	defer mockEventSink.AssertExpectations(t)
+
+	mockUsageRecorder := &mocks.MockUsageRecorder{}
+	defer mockUsageRecorder.AssertExpectations(t)
+
+	mockToolPolicy := &mocks.MockToolPolicy{}
+	defer mockToolPolicy.AssertExpectations(t)
+
+	mockDryRunPlanner := &mocks.MockDryRunPlanner{}
+	defer mockDryRunPlanner.AssertExpectations(t)
+
+	mockRedactor := &mocks.MockRedactor{}
+	defer mockRedactor.AssertExpectations(t)
+
+	mockRateLimiter := &mocks.MockRateLimiter{}
+	defer mockRateLimiter.AssertExpectations(t)
+
+	mockSessionRecorder := &mocks.MockSessionRecorder{}
+	defer mockSessionRecorder.AssertExpectations(t)
+
+	mockSessionTranscript := &mocks.MockSessionTranscript{}
+	defer mockSessionTranscript.AssertExpectations(t)
+
+	mockIdentityProvider := &mocks.MockIdentityProvider{}
+	defer mockIdentityProvider.AssertExpectations(t)
+
+	mockOutputStreamingConfig := &mocks.MockOutputStreamingConfig{}
+	defer mockOutputStreamingConfig.AssertExpectations(t)
+
+	mockNotificationThrottle := &mocks.MockNotificationThrottle{}
+	defer mockNotificationThrottle.AssertExpectations(t)
+
+	mockProgressConfig := &mocks.MockProgressConfig{}
+	defer mockProgressConfig.AssertExpectations(t)
+
+	mockResponseSizeConfig := &mocks.MockResponseSizeConfig{}
+	defer mockResponseSizeConfig.AssertExpectations(t)
+
+	mockOutputArtifacts := &mocks.MockOutputArtifacts{}
+	defer mockOutputArtifacts.AssertExpectations(t)
+
+	mockDaemonSocket := &mocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockTransportConfig := &mocks.MockTransportConfig{}
+	defer mockTransportConfig.AssertExpectations(t)
+
+	mockLocalizer := &mocks.MockLocalizer{}
+	defer mockLocalizer.AssertExpectations(t)
+
+	mockAuthenticator := &mocks.MockAuthenticator{}
+	defer mockAuthenticator.AssertExpectations(t)
+
+	mockAuditLog := &mocks.MockAuditLog{}
+	defer mockAuditLog.AssertExpectations(t)
+
+	mockTracer := &mocks.MockTracer{}
+	defer mockTracer.AssertExpectations(t)
+
+	mockMetrics := &mocks.MockMetrics{}
+	defer mockMetrics.AssertExpectations(t)
+
 	mockTool := &toolsmocks.MockTool{}
 	defer mockTool.AssertExpectations(t)
 
@@ -95,6 +273,11 @@ func TestNew_AddToServerReturnsError(t *testing.T) {
 		Return("1.0.0").
 		Once()
 
+	mockServerConfig.EXPECT().
+		BuildInfo().
+		Return(entities.BuildInfo{}).
+		Once()
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	mcpserver := server.NewMCPSDKServer(mockServerConfig)
@@ -117,7 +300,135 @@ func TestNew_AddToServerReturnsError(t *testing.T) {
 		Once()
 
 	// Act
-	server, err := server.New(mcpserver, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator)
+	server, err := server.New(mcpserver, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockEventBuffer, mockEventSink, mockUsageRecorder, mockToolPolicy, mockDryRunPlanner, mockRedactor, mockRateLimiter, mockSessionRecorder, mockSessionTranscript, mockIdentityProvider, mockOutputStreamingConfig, mockNotificationThrottle, mockProgressConfig, mockResponseSizeConfig, mockOutputArtifacts, mockDaemonSocket, mockTransportConfig, mockLocalizer, mockAuthenticator, mockAuditLog, mockTracer, mockMetrics)
+
+	// Assert
+	require.Error(t, err, "New should return an error")
+	assert.Equal(t, expectedError, err, "Error should match expected error")
+	assert.Empty(t, server, "Server should be nil when error occurs")
+}
+
+func TestNew_AddResourceToServerReturnsError(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfigurator := &mocks.MockMCPServerConfigurator{}
+	defer mockConfigurator.AssertExpectations(t)
+
+	mockEventBuffer := &mocks.MockEventBuffer{}
+	defer mockEventBuffer.AssertExpectations(t)
+
+	mockEventSink := &mocks.MockEventSink{}
+	defer mockEventSink.AssertExpectations(t)
+
+	mockUsageRecorder := &mocks.MockUsageRecorder{}
+	defer mockUsageRecorder.AssertExpectations(t)
+
+	mockToolPolicy := &mocks.MockToolPolicy{}
+	defer mockToolPolicy.AssertExpectations(t)
+
+	mockDryRunPlanner := &mocks.MockDryRunPlanner{}
+	defer mockDryRunPlanner.AssertExpectations(t)
+
+	mockRedactor := &mocks.MockRedactor{}
+	defer mockRedactor.AssertExpectations(t)
+
+	mockRateLimiter := &mocks.MockRateLimiter{}
+	defer mockRateLimiter.AssertExpectations(t)
+
+	mockSessionRecorder := &mocks.MockSessionRecorder{}
+	defer mockSessionRecorder.AssertExpectations(t)
+
+	mockSessionTranscript := &mocks.MockSessionTranscript{}
+	defer mockSessionTranscript.AssertExpectations(t)
+
+	mockIdentityProvider := &mocks.MockIdentityProvider{}
+	defer mockIdentityProvider.AssertExpectations(t)
+
+	mockOutputStreamingConfig := &mocks.MockOutputStreamingConfig{}
+	defer mockOutputStreamingConfig.AssertExpectations(t)
+
+	mockNotificationThrottle := &mocks.MockNotificationThrottle{}
+	defer mockNotificationThrottle.AssertExpectations(t)
+
+	mockProgressConfig := &mocks.MockProgressConfig{}
+	defer mockProgressConfig.AssertExpectations(t)
+
+	mockResponseSizeConfig := &mocks.MockResponseSizeConfig{}
+	defer mockResponseSizeConfig.AssertExpectations(t)
+
+	mockOutputArtifacts := &mocks.MockOutputArtifacts{}
+	defer mockOutputArtifacts.AssertExpectations(t)
+
+	mockDaemonSocket := &mocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockTransportConfig := &mocks.MockTransportConfig{}
+	defer mockTransportConfig.AssertExpectations(t)
+
+	mockLocalizer := &mocks.MockLocalizer{}
+	defer mockLocalizer.AssertExpectations(t)
+
+	mockAuthenticator := &mocks.MockAuthenticator{}
+	defer mockAuthenticator.AssertExpectations(t)
+
+	mockAuditLog := &mocks.MockAuditLog{}
+	defer mockAuditLog.AssertExpectations(t)
+
+	mockTracer := &mocks.MockTracer{}
+	defer mockTracer.AssertExpectations(t)
+
+	mockMetrics := &mocks.MockMetrics{}
+	defer mockMetrics.AssertExpectations(t)
+
+	mockResource := &resourcesmocks.MockResource{}
+	defer mockResource.AssertExpectations(t)
+
+	mockServerConfig := &mocks.MockServerConfig{}
+	defer mockServerConfig.AssertExpectations(t)
+
+	mockServerConfig.EXPECT().
+		Version().
+		Return("1.0.0").
+		Once()
+
+	mockServerConfig.EXPECT().
+		BuildInfo().
+		Return(entities.BuildInfo{}).
+		Once()
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mcpserver := server.NewMCPSDKServer(mockServerConfig)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetToolsToAdd().
+		Return(nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetResourcesToAdd().
+		Return([]resources.Resource{mockResource}).
+		Once()
+
+	expectedError := assert.AnError
+
+	mockResource.EXPECT().
+		AddToServer(mcpserver).
+		Return(expectedError).
+		Once()
+
+	// Act
+	server, err := server.New(mcpserver, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockEventBuffer, mockEventSink, mockUsageRecorder, mockToolPolicy, mockDryRunPlanner, mockRedactor, mockRateLimiter, mockSessionRecorder, mockSessionTranscript, mockIdentityProvider, mockOutputStreamingConfig, mockNotificationThrottle, mockProgressConfig, mockResponseSizeConfig, mockOutputArtifacts, mockDaemonSocket, mockTransportConfig, mockLocalizer, mockAuthenticator, mockAuditLog, mockTracer, mockMetrics)
 
 	// Assert
 	require.Error(t, err, "New should return an error")
@@ -136,6 +447,77 @@ func TestNew_HandlesNoTools(t *testing.T) {
 	mockConfigurator := &mocks.MockMCPServerConfigurator{}
 	defer mockConfigurator.AssertExpectations(t)
 
+	mockEventBuffer := &mocks.MockEventBuffer{}
+	defer mockEventBuffer.AssertExpectations(t)
+
+	mockEventSink := &mocks.MockEventSink{}
+	defer mockEventSink.AssertExpectations(t)
+
+	mockUsageRecorder := &mocks.MockUsageRecorder{}
+	defer mockUsageRecorder.AssertExpectations(t)
+
+	mockToolPolicy := &mocks.MockToolPolicy{}
+	defer mockToolPolicy.AssertExpectations(t)
+
+	mockDryRunPlanner := &mocks.MockDryRunPlanner{}
+	defer mockDryRunPlanner.AssertExpectations(t)
+
+	mockRedactor := &mocks.MockRedactor{}
+	defer mockRedactor.AssertExpectations(t)
+
+	mockRateLimiter := &mocks.MockRateLimiter{}
+	defer mockRateLimiter.AssertExpectations(t)
+
+	mockSessionRecorder := &mocks.MockSessionRecorder{}
+	defer mockSessionRecorder.AssertExpectations(t)
+
+	mockSessionTranscript := &mocks.MockSessionTranscript{}
+	defer mockSessionTranscript.AssertExpectations(t)
+
+	mockIdentityProvider := &mocks.MockIdentityProvider{}
+	defer mockIdentityProvider.AssertExpectations(t)
+
+	mockOutputStreamingConfig := &mocks.MockOutputStreamingConfig{}
+	defer mockOutputStreamingConfig.AssertExpectations(t)
+
+	mockNotificationThrottle := &mocks.MockNotificationThrottle{}
+	defer mockNotificationThrottle.AssertExpectations(t)
+
+	mockProgressConfig := &mocks.MockProgressConfig{}
+	defer mockProgressConfig.AssertExpectations(t)
+
+	mockResponseSizeConfig := &mocks.MockResponseSizeConfig{}
+	defer mockResponseSizeConfig.AssertExpectations(t)
+
+	mockOutputArtifacts := &mocks.MockOutputArtifacts{}
+	defer mockOutputArtifacts.AssertExpectations(t)
+
+	mockDaemonSocket := &mocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockTransportConfig := &mocks.MockTransportConfig{}
+	defer mockTransportConfig.AssertExpectations(t)
+
+	mockLocalizer := &mocks.MockLocalizer{}
+	defer mockLocalizer.AssertExpectations(t)
+
+	mockAuthenticator := &mocks.MockAuthenticator{}
+	defer mockAuthenticator.AssertExpectations(t)
+
+	mockAuditLog := &mocks.MockAuditLog{}
+	defer mockAuditLog.AssertExpectations(t)
+
+	mockTracer := &mocks.MockTracer{}
+	defer mockTracer.AssertExpectations(t)
+
+	mockMetrics := &mocks.MockMetrics{}
+	defer mockMetrics.AssertExpectations(t)
+
+	mockLocalizer.EXPECT().
+		Locale().
+		Return(entities.LocaleEnglish).
+		Once()
+
 	mockServerConfig := &mocks.MockServerConfig{}
 	defer mockServerConfig.AssertExpectations(t)
 
@@ -144,6 +526,11 @@ func TestNew_HandlesNoTools(t *testing.T) {
 		Return("1.0.0").
 		Once()
 
+	mockServerConfig.EXPECT().
+		BuildInfo().
+		Return(entities.BuildInfo{}).
+		Once()
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	mcpserver := server.NewMCPSDKServer(mockServerConfig)
@@ -158,8 +545,23 @@ func TestNew_HandlesNoTools(t *testing.T) {
 		Return(nil).
 		Once()
 
+	mockConfigurator.EXPECT().
+		GetResourcesToAdd().
+		Return(nil).
+		Once()
+
+	mockMetrics.EXPECT().
+		ObserveActiveSessions(mock.Anything).
+		Return().
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		ForwardToSessions(mock.Anything).
+		Return().
+		Once()
+
 	// Act
-	server, err := server.New(mcpserver, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator)
+	server, err := server.New(mcpserver, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockEventBuffer, mockEventSink, mockUsageRecorder, mockToolPolicy, mockDryRunPlanner, mockRedactor, mockRateLimiter, mockSessionRecorder, mockSessionTranscript, mockIdentityProvider, mockOutputStreamingConfig, mockNotificationThrottle, mockProgressConfig, mockResponseSizeConfig, mockOutputArtifacts, mockDaemonSocket, mockTransportConfig, mockLocalizer, mockAuthenticator, mockAuditLog, mockTracer, mockMetrics)
 
 	// Assert
 	require.NoError(t, err, "New should not return an error")
@@ -177,6 +579,77 @@ func TestServer_Run_HappyPath(t *testing.T) {
 	mockConfigurator := &mocks.MockMCPServerConfigurator{}
 	defer mockConfigurator.AssertExpectations(t)
 
+	mockEventBuffer := &mocks.MockEventBuffer{}
+	defer mockEventBuffer.AssertExpectations(t)
+
+	mockEventSink := &mocks.MockEventSink{}
+	defer mockEventSink.AssertExpectations(t)
+
+	mockUsageRecorder := &mocks.MockUsageRecorder{}
+	defer mockUsageRecorder.AssertExpectations(t)
+
+	mockToolPolicy := &mocks.MockToolPolicy{}
+	defer mockToolPolicy.AssertExpectations(t)
+
+	mockDryRunPlanner := &mocks.MockDryRunPlanner{}
+	defer mockDryRunPlanner.AssertExpectations(t)
+
+	mockRedactor := &mocks.MockRedactor{}
+	defer mockRedactor.AssertExpectations(t)
+
+	mockRateLimiter := &mocks.MockRateLimiter{}
+	defer mockRateLimiter.AssertExpectations(t)
+
+	mockSessionRecorder := &mocks.MockSessionRecorder{}
+	defer mockSessionRecorder.AssertExpectations(t)
+
+	mockSessionTranscript := &mocks.MockSessionTranscript{}
+	defer mockSessionTranscript.AssertExpectations(t)
+
+	mockIdentityProvider := &mocks.MockIdentityProvider{}
+	defer mockIdentityProvider.AssertExpectations(t)
+
+	mockOutputStreamingConfig := &mocks.MockOutputStreamingConfig{}
+	defer mockOutputStreamingConfig.AssertExpectations(t)
+
+	mockNotificationThrottle := &mocks.MockNotificationThrottle{}
+	defer mockNotificationThrottle.AssertExpectations(t)
+
+	mockProgressConfig := &mocks.MockProgressConfig{}
+	defer mockProgressConfig.AssertExpectations(t)
+
+	mockResponseSizeConfig := &mocks.MockResponseSizeConfig{}
+	defer mockResponseSizeConfig.AssertExpectations(t)
+
+	mockOutputArtifacts := &mocks.MockOutputArtifacts{}
+	defer mockOutputArtifacts.AssertExpectations(t)
+
+	mockDaemonSocket := &mocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockTransportConfig := &mocks.MockTransportConfig{}
+	defer mockTransportConfig.AssertExpectations(t)
+
+	mockLocalizer := &mocks.MockLocalizer{}
+	defer mockLocalizer.AssertExpectations(t)
+
+	mockAuthenticator := &mocks.MockAuthenticator{}
+	defer mockAuthenticator.AssertExpectations(t)
+
+	mockAuditLog := &mocks.MockAuditLog{}
+	defer mockAuditLog.AssertExpectations(t)
+
+	mockTracer := &mocks.MockTracer{}
+	defer mockTracer.AssertExpectations(t)
+
+	mockMetrics := &mocks.MockMetrics{}
+	defer mockMetrics.AssertExpectations(t)
+
+	mockLocalizer.EXPECT().
+		Locale().
+		Return(entities.LocaleEnglish).
+		Once()
+
 	mockServerConfig := &mocks.MockServerConfig{}
 	defer mockServerConfig.AssertExpectations(t)
 
@@ -185,6 +658,11 @@ func TestServer_Run_HappyPath(t *testing.T) {
 		Return("1.0.0").
 		Once()
 
+	mockServerConfig.EXPECT().
+		BuildInfo().
+		Return(entities.BuildInfo{}).
+		Once()
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	mcpserver := server.NewMCPSDKServer(mockServerConfig)
@@ -199,6 +677,11 @@ func TestServer_Run_HappyPath(t *testing.T) {
 		Return(nil).
 		Once()
 
+	mockConfigurator.EXPECT().
+		GetResourcesToAdd().
+		Return(nil).
+		Once()
+
 	capturedShutdownFuncC := make(chan func() error)
 	mockLifecycleSignaler.EXPECT().
 		AddShutdownFunction(mock.AnythingOfType("func() error")).
@@ -208,9 +691,29 @@ func TestServer_Run_HappyPath(t *testing.T) {
 		Return().
 		Once()
 
-	server, err := server.New(mcpserver, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator)
+	mockMetrics.EXPECT().
+		ObserveActiveSessions(mock.Anything).
+		Return().
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		ForwardToSessions(mock.Anything).
+		Return().
+		Once()
+
+	server, err := server.New(mcpserver, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockEventBuffer, mockEventSink, mockUsageRecorder, mockToolPolicy, mockDryRunPlanner, mockRedactor, mockRateLimiter, mockSessionRecorder, mockSessionTranscript, mockIdentityProvider, mockOutputStreamingConfig, mockNotificationThrottle, mockProgressConfig, mockResponseSizeConfig, mockOutputArtifacts, mockDaemonSocket, mockTransportConfig, mockLocalizer, mockAuthenticator, mockAuditLog, mockTracer, mockMetrics)
 	require.NoError(t, err)
 
+	mockDaemonSocket.EXPECT().
+		Enabled().
+		Return(false).
+		Once()
+
+	mockTransportConfig.EXPECT().
+		ServeTransport().
+		Return(entities.TransportStdio).
+		Once()
+
 	// The MCP STDIO transport will hijack os.Stdout, which will cause issues with code coverage reporting.
 	// To avoid this, we replace the transport with an in memory transport.
 	_, serverTransport := mcp.NewInMemoryTransports()
@@ -231,3 +734,746 @@ func TestServer_Run_HappyPath(t *testing.T) {
 	serverErr := <-errC
 	require.NoError(t, serverErr, "Server run should exit without error after shutdown")
 }
+
+func TestServer_Run_Daemon(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfigurator := &mocks.MockMCPServerConfigurator{}
+	defer mockConfigurator.AssertExpectations(t)
+
+	mockEventBuffer := &mocks.MockEventBuffer{}
+	defer mockEventBuffer.AssertExpectations(t)
+
+	mockEventSink := &mocks.MockEventSink{}
+	defer mockEventSink.AssertExpectations(t)
+
+	mockUsageRecorder := &mocks.MockUsageRecorder{}
+	defer mockUsageRecorder.AssertExpectations(t)
+
+	mockToolPolicy := &mocks.MockToolPolicy{}
+	defer mockToolPolicy.AssertExpectations(t)
+
+	mockDryRunPlanner := &mocks.MockDryRunPlanner{}
+	defer mockDryRunPlanner.AssertExpectations(t)
+
+	mockRedactor := &mocks.MockRedactor{}
+	defer mockRedactor.AssertExpectations(t)
+
+	mockRateLimiter := &mocks.MockRateLimiter{}
+	defer mockRateLimiter.AssertExpectations(t)
+
+	mockSessionRecorder := &mocks.MockSessionRecorder{}
+	defer mockSessionRecorder.AssertExpectations(t)
+
+	mockSessionTranscript := &mocks.MockSessionTranscript{}
+	defer mockSessionTranscript.AssertExpectations(t)
+
+	mockIdentityProvider := &mocks.MockIdentityProvider{}
+	defer mockIdentityProvider.AssertExpectations(t)
+
+	mockOutputStreamingConfig := &mocks.MockOutputStreamingConfig{}
+	defer mockOutputStreamingConfig.AssertExpectations(t)
+
+	mockNotificationThrottle := &mocks.MockNotificationThrottle{}
+	defer mockNotificationThrottle.AssertExpectations(t)
+
+	mockProgressConfig := &mocks.MockProgressConfig{}
+	defer mockProgressConfig.AssertExpectations(t)
+
+	mockResponseSizeConfig := &mocks.MockResponseSizeConfig{}
+	defer mockResponseSizeConfig.AssertExpectations(t)
+
+	mockOutputArtifacts := &mocks.MockOutputArtifacts{}
+	defer mockOutputArtifacts.AssertExpectations(t)
+
+	mockDaemonSocket := &mocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockTransportConfig := &mocks.MockTransportConfig{}
+	defer mockTransportConfig.AssertExpectations(t)
+
+	mockLocalizer := &mocks.MockLocalizer{}
+	defer mockLocalizer.AssertExpectations(t)
+
+	mockAuthenticator := &mocks.MockAuthenticator{}
+	defer mockAuthenticator.AssertExpectations(t)
+
+	mockAuditLog := &mocks.MockAuditLog{}
+	defer mockAuditLog.AssertExpectations(t)
+
+	mockTracer := &mocks.MockTracer{}
+	defer mockTracer.AssertExpectations(t)
+
+	mockMetrics := &mocks.MockMetrics{}
+	defer mockMetrics.AssertExpectations(t)
+
+	mockLocalizer.EXPECT().
+		Locale().
+		Return(entities.LocaleEnglish).
+		Once()
+
+	mockServerConfig := &mocks.MockServerConfig{}
+	defer mockServerConfig.AssertExpectations(t)
+
+	mockServerConfig.EXPECT().
+		Version().
+		Return("1.0.0").
+		Once()
+
+	mockServerConfig.EXPECT().
+		BuildInfo().
+		Return(entities.BuildInfo{}).
+		Once()
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mcpserver := server.NewMCPSDKServer(mockServerConfig)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetToolsToAdd().
+		Return(nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetResourcesToAdd().
+		Return(nil).
+		Once()
+
+	capturedShutdownFuncC := make(chan func() error)
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFuncC <- shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockMetrics.EXPECT().
+		ObserveActiveSessions(mock.Anything).
+		Return().
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		ForwardToSessions(mock.Anything).
+		Return().
+		Once()
+
+	server, err := server.New(mcpserver, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockEventBuffer, mockEventSink, mockUsageRecorder, mockToolPolicy, mockDryRunPlanner, mockRedactor, mockRateLimiter, mockSessionRecorder, mockSessionTranscript, mockIdentityProvider, mockOutputStreamingConfig, mockNotificationThrottle, mockProgressConfig, mockResponseSizeConfig, mockOutputArtifacts, mockDaemonSocket, mockTransportConfig, mockLocalizer, mockAuthenticator, mockAuditLog, mockTracer, mockMetrics)
+	require.NoError(t, err)
+
+	listener, err := net.Listen("unix", filepath.Join(t.TempDir(), "daemon.sock"))
+	require.NoError(t, err)
+
+	mockDaemonSocket.EXPECT().
+		Enabled().
+		Return(true).
+		Once()
+
+	mockIdentityProvider.EXPECT().
+		User().
+		Return("jdoe").
+		Times(2)
+
+	mockDaemonSocket.EXPECT().
+		Listen().
+		Return(listener, nil).
+		Once()
+
+	errC := make(chan error)
+	go func() {
+		errC <- server.Run()
+	}()
+
+	capturedShutdownFunc := <-capturedShutdownFuncC
+
+	// Act
+	firstResponse := initializeDaemonClient(t, listener.Addr().String())
+	secondResponse := initializeDaemonClient(t, listener.Addr().String())
+	err = capturedShutdownFunc()
+
+	// Assert
+	assert.Contains(t, firstResponse, `"serverInfo"`)
+	assert.Contains(t, secondResponse, `"serverInfo"`, "The daemon should keep serving clients after one disconnected")
+	require.NoError(t, err, "Shutdown function should not return an error")
+	serverErr := <-errC
+	require.NoError(t, serverErr, "Server run should exit without error after shutdown")
+}
+
+// initializeDaemonClient connects to the daemon socket, sends an initialize request, and returns the response.
+func initializeDaemonClient(t *testing.T, address string) string {
+	t.Helper()
+
+	conn, err := net.Dial("unix", address)
+	require.NoError(t, err)
+	defer conn.Close() //nolint:errcheck // Test connection
+
+	_, err = conn.Write([]byte(initializeRequest + "\n"))
+	require.NoError(t, err)
+
+	response, err := bufio.NewReader(conn).ReadString('\n')
+	require.NoError(t, err)
+
+	return response
+}
+
+const initializeRequest = `{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2025-06-18","capabilities":{},"clientInfo":{"name":"test","version":"1.0.0"}}}`
+
+// dialWebSocket connects to the MCP endpoint at address, and completes the WebSocket handshake.
+func dialWebSocket(t *testing.T, address string) (net.Conn, *bufio.Reader) {
+	t.Helper()
+
+	conn, err := net.Dial("tcp", address)
+	require.NoError(t, err)
+
+	_, err = conn.Write([]byte("GET /mcp HTTP/1.1\r\n" +
+		"Host: " + address + "\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: Upgrade\r\n" +
+		"Sec-WebSocket-Key: dGhlIHNhbXBsZSBub25jZQ==\r\n" +
+		"Sec-WebSocket-Version: 13\r\n\r\n"))
+	require.NoError(t, err)
+
+	reader := bufio.NewReader(conn)
+	response, err := http.ReadResponse(reader, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusSwitchingProtocols, response.StatusCode)
+	// The accept key of the sample nonce of RFC 6455.
+	require.Equal(t, "s3pPLMBiTxaQ9kYGzzhZRbK+xOo=", response.Header.Get("Sec-WebSocket-Accept"))
+
+	return conn, reader
+}
+
+// writeWebSocketFrame writes a single masked frame, as clients do.
+func writeWebSocketFrame(t *testing.T, conn net.Conn, opcode byte, payload []byte) {
+	t.Helper()
+
+	mask := []byte{1, 2, 3, 4}
+	frame := []byte{0x80 | opcode}
+	if len(payload) < 126 {
+		frame = append(frame, 0x80|byte(len(payload)))
+	} else {
+		frame = append(frame, 0x80|126)
+		frame = binary.BigEndian.AppendUint16(frame, uint16(len(payload)))
+	}
+	frame = append(frame, mask...)
+	for i, b := range payload {
+		frame = append(frame, b^mask[i%4])
+	}
+
+	_, err := conn.Write(frame)
+	require.NoError(t, err)
+}
+
+// readWebSocketFrame reads a single unmasked frame, as servers send.
+func readWebSocketFrame(t *testing.T, reader *bufio.Reader) (byte, []byte) {
+	t.Helper()
+
+	var header [2]byte
+	_, err := io.ReadFull(reader, header[:])
+	require.NoError(t, err)
+
+	length := int(header[1] & 0x7F)
+	switch length {
+	case 126:
+		var extended [2]byte
+		_, err = io.ReadFull(reader, extended[:])
+		require.NoError(t, err)
+		length = int(binary.BigEndian.Uint16(extended[:]))
+	case 127:
+		var extended [8]byte
+		_, err = io.ReadFull(reader, extended[:])
+		require.NoError(t, err)
+		length = int(binary.BigEndian.Uint64(extended[:]))
+	}
+
+	payload := make([]byte, length)
+	_, err = io.ReadFull(reader, payload)
+	require.NoError(t, err)
+
+	return header[0] & 0x0F, payload
+}
+
+func TestServer_Run_HTTP(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfigurator := &mocks.MockMCPServerConfigurator{}
+	defer mockConfigurator.AssertExpectations(t)
+
+	mockEventBuffer := &mocks.MockEventBuffer{}
+	defer mockEventBuffer.AssertExpectations(t)
+
+	mockEventSink := &mocks.MockEventSink{}
+	defer mockEventSink.AssertExpectations(t)
+
+	mockUsageRecorder := &mocks.MockUsageRecorder{}
+	defer mockUsageRecorder.AssertExpectations(t)
+
+	mockToolPolicy := &mocks.MockToolPolicy{}
+	defer mockToolPolicy.AssertExpectations(t)
+
+	mockDryRunPlanner := &mocks.MockDryRunPlanner{}
+	defer mockDryRunPlanner.AssertExpectations(t)
+
+	mockRedactor := &mocks.MockRedactor{}
+	defer mockRedactor.AssertExpectations(t)
+
+	mockRateLimiter := &mocks.MockRateLimiter{}
+	defer mockRateLimiter.AssertExpectations(t)
+
+	mockSessionRecorder := &mocks.MockSessionRecorder{}
+	defer mockSessionRecorder.AssertExpectations(t)
+
+	mockSessionTranscript := &mocks.MockSessionTranscript{}
+	defer mockSessionTranscript.AssertExpectations(t)
+
+	mockIdentityProvider := &mocks.MockIdentityProvider{}
+	defer mockIdentityProvider.AssertExpectations(t)
+
+	mockOutputStreamingConfig := &mocks.MockOutputStreamingConfig{}
+	defer mockOutputStreamingConfig.AssertExpectations(t)
+
+	mockNotificationThrottle := &mocks.MockNotificationThrottle{}
+	defer mockNotificationThrottle.AssertExpectations(t)
+
+	mockProgressConfig := &mocks.MockProgressConfig{}
+	defer mockProgressConfig.AssertExpectations(t)
+
+	mockResponseSizeConfig := &mocks.MockResponseSizeConfig{}
+	defer mockResponseSizeConfig.AssertExpectations(t)
+
+	mockOutputArtifacts := &mocks.MockOutputArtifacts{}
+	defer mockOutputArtifacts.AssertExpectations(t)
+
+	mockDaemonSocket := &mocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockTransportConfig := &mocks.MockTransportConfig{}
+	defer mockTransportConfig.AssertExpectations(t)
+
+	mockLocalizer := &mocks.MockLocalizer{}
+	defer mockLocalizer.AssertExpectations(t)
+
+	mockAuthenticator := &mocks.MockAuthenticator{}
+	defer mockAuthenticator.AssertExpectations(t)
+
+	mockAuditLog := &mocks.MockAuditLog{}
+	defer mockAuditLog.AssertExpectations(t)
+
+	mockTracer := &mocks.MockTracer{}
+	defer mockTracer.AssertExpectations(t)
+
+	mockMetrics := &mocks.MockMetrics{}
+	defer mockMetrics.AssertExpectations(t)
+
+	mockLocalizer.EXPECT().
+		Locale().
+		Return(entities.LocaleEnglish).
+		Once()
+
+	mockServerConfig := &mocks.MockServerConfig{}
+	defer mockServerConfig.AssertExpectations(t)
+
+	mockServerConfig.EXPECT().
+		Version().
+		Return("1.0.0").
+		Once()
+
+	mockServerConfig.EXPECT().
+		BuildInfo().
+		Return(entities.BuildInfo{}).
+		Once()
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mcpserver := server.NewMCPSDKServer(mockServerConfig)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetToolsToAdd().
+		Return(nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetResourcesToAdd().
+		Return(nil).
+		Once()
+
+	capturedShutdownFuncC := make(chan func() error)
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFuncC <- shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockMetrics.EXPECT().
+		ObserveActiveSessions(mock.Anything).
+		Return().
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		ForwardToSessions(mock.Anything).
+		Return().
+		Once()
+
+	server, err := server.New(mcpserver, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockEventBuffer, mockEventSink, mockUsageRecorder, mockToolPolicy, mockDryRunPlanner, mockRedactor, mockRateLimiter, mockSessionRecorder, mockSessionTranscript, mockIdentityProvider, mockOutputStreamingConfig, mockNotificationThrottle, mockProgressConfig, mockResponseSizeConfig, mockOutputArtifacts, mockDaemonSocket, mockTransportConfig, mockLocalizer, mockAuthenticator, mockAuditLog, mockTracer, mockMetrics)
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server.SetListen(func(string, string) (net.Listener, error) {
+		return listener, nil
+	})
+
+	mockDaemonSocket.EXPECT().
+		Enabled().
+		Return(false).
+		Once()
+
+	mockTransportConfig.EXPECT().
+		ServeTransport().
+		Return(entities.TransportHTTP).
+		Once()
+
+	mockTransportConfig.EXPECT().
+		ListenAddress().
+		Return("127.0.0.1:0").
+		Once()
+
+	mockAuthenticator.EXPECT().
+		Enabled().
+		Return(false).
+		Once()
+
+	mockTransportConfig.EXPECT().
+		RESTAPI().
+		Return(false).
+		Once()
+
+	mockTransportConfig.EXPECT().
+		GRPC().
+		Return(false).
+		Once()
+
+	mockIdentityProvider.EXPECT().
+		User().
+		Return("jdoe").
+		Once()
+
+	mockTracer.EXPECT().
+		Start(mock.Anything, "POST /mcp", tracing.SpanKindServer, []tracing.Attribute{tracing.String("http.request.method", "POST"), tracing.String("url.path", "/mcp")}).
+		RunAndReturn(func(ctx context.Context, name string, kind tracing.SpanKind, attributes ...tracing.Attribute) (context.Context, *tracing.Span) {
+			return ctx, nil
+		}).
+		Once()
+
+	mockMetrics.EXPECT().
+		RecordHTTPRequest(http.MethodPost, http.StatusOK).
+		Return().
+		Once()
+
+	errC := make(chan error)
+	go func() {
+		errC <- server.Run()
+	}()
+
+	capturedShutdownFunc := <-capturedShutdownFuncC
+
+	request, err := http.NewRequest(http.MethodPost, "http://"+listener.Addr().String()+"/mcp", strings.NewReader(initializeRequest))
+	require.NoError(t, err)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Accept", "application/json, text/event-stream")
+
+	// Act
+	response, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	body, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+	require.NoError(t, response.Body.Close())
+	err = capturedShutdownFunc()
+
+	// Assert
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Contains(t, string(body), `"serverInfo"`)
+	assert.True(t, strings.HasPrefix(response.Header.Get("Mcp-Session-Id"), "http-client-"), "The session ID should tell the transport of the client")
+	require.NoError(t, err, "Shutdown function should not return an error")
+	serverErr := <-errC
+	require.NoError(t, serverErr, "Server run should exit without error after shutdown")
+}
+
+func TestServer_Run_WebSocket(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfigurator := &mocks.MockMCPServerConfigurator{}
+	defer mockConfigurator.AssertExpectations(t)
+
+	mockEventBuffer := &mocks.MockEventBuffer{}
+	defer mockEventBuffer.AssertExpectations(t)
+
+	mockEventSink := &mocks.MockEventSink{}
+	defer mockEventSink.AssertExpectations(t)
+
+	mockUsageRecorder := &mocks.MockUsageRecorder{}
+	defer mockUsageRecorder.AssertExpectations(t)
+
+	mockToolPolicy := &mocks.MockToolPolicy{}
+	defer mockToolPolicy.AssertExpectations(t)
+
+	mockDryRunPlanner := &mocks.MockDryRunPlanner{}
+	defer mockDryRunPlanner.AssertExpectations(t)
+
+	mockRedactor := &mocks.MockRedactor{}
+	defer mockRedactor.AssertExpectations(t)
+
+	mockRateLimiter := &mocks.MockRateLimiter{}
+	defer mockRateLimiter.AssertExpectations(t)
+
+	mockSessionRecorder := &mocks.MockSessionRecorder{}
+	defer mockSessionRecorder.AssertExpectations(t)
+
+	mockSessionTranscript := &mocks.MockSessionTranscript{}
+	defer mockSessionTranscript.AssertExpectations(t)
+
+	mockIdentityProvider := &mocks.MockIdentityProvider{}
+	defer mockIdentityProvider.AssertExpectations(t)
+
+	mockOutputStreamingConfig := &mocks.MockOutputStreamingConfig{}
+	defer mockOutputStreamingConfig.AssertExpectations(t)
+
+	mockNotificationThrottle := &mocks.MockNotificationThrottle{}
+	defer mockNotificationThrottle.AssertExpectations(t)
+
+	mockProgressConfig := &mocks.MockProgressConfig{}
+	defer mockProgressConfig.AssertExpectations(t)
+
+	mockResponseSizeConfig := &mocks.MockResponseSizeConfig{}
+	defer mockResponseSizeConfig.AssertExpectations(t)
+
+	mockOutputArtifacts := &mocks.MockOutputArtifacts{}
+	defer mockOutputArtifacts.AssertExpectations(t)
+
+	mockDaemonSocket := &mocks.MockDaemonSocket{}
+	defer mockDaemonSocket.AssertExpectations(t)
+
+	mockTransportConfig := &mocks.MockTransportConfig{}
+	defer mockTransportConfig.AssertExpectations(t)
+
+	mockLocalizer := &mocks.MockLocalizer{}
+	defer mockLocalizer.AssertExpectations(t)
+
+	mockAuthenticator := &mocks.MockAuthenticator{}
+	defer mockAuthenticator.AssertExpectations(t)
+
+	mockAuditLog := &mocks.MockAuditLog{}
+	defer mockAuditLog.AssertExpectations(t)
+
+	mockTracer := &mocks.MockTracer{}
+	defer mockTracer.AssertExpectations(t)
+
+	mockMetrics := &mocks.MockMetrics{}
+	defer mockMetrics.AssertExpectations(t)
+
+	mockLocalizer.EXPECT().
+		Locale().
+		Return(entities.LocaleEnglish).
+		Once()
+
+	mockServerConfig := &mocks.MockServerConfig{}
+	defer mockServerConfig.AssertExpectations(t)
+
+	mockServerConfig.EXPECT().
+		Version().
+		Return("1.0.0").
+		Once()
+
+	mockServerConfig.EXPECT().
+		BuildInfo().
+		Return(entities.BuildInfo{}).
+		Once()
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mcpserver := server.NewMCPSDKServer(mockServerConfig)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetToolsToAdd().
+		Return(nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetResourcesToAdd().
+		Return(nil).
+		Once()
+
+	capturedShutdownFuncC := make(chan func() error)
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFuncC <- shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockMetrics.EXPECT().
+		ObserveActiveSessions(mock.Anything).
+		Return().
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		ForwardToSessions(mock.Anything).
+		Return().
+		Once()
+
+	server, err := server.New(mcpserver, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockEventBuffer, mockEventSink, mockUsageRecorder, mockToolPolicy, mockDryRunPlanner, mockRedactor, mockRateLimiter, mockSessionRecorder, mockSessionTranscript, mockIdentityProvider, mockOutputStreamingConfig, mockNotificationThrottle, mockProgressConfig, mockResponseSizeConfig, mockOutputArtifacts, mockDaemonSocket, mockTransportConfig, mockLocalizer, mockAuthenticator, mockAuditLog, mockTracer, mockMetrics)
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server.SetListen(func(string, string) (net.Listener, error) {
+		return listener, nil
+	})
+
+	mockDaemonSocket.EXPECT().
+		Enabled().
+		Return(false).
+		Once()
+
+	mockTransportConfig.EXPECT().
+		ServeTransport().
+		Return(entities.TransportWebSocket).
+		Once()
+
+	mockTransportConfig.EXPECT().
+		ListenAddress().
+		Return("127.0.0.1:0").
+		Once()
+
+	mockTransportConfig.EXPECT().
+		WebSocketPingInterval().
+		Return(time.Minute).
+		Once()
+
+	mockAuthenticator.EXPECT().
+		Enabled().
+		Return(false).
+		Once()
+
+	mockIdentityProvider.EXPECT().
+		User().
+		Return("jdoe").
+		Once()
+
+	errC := make(chan error)
+	go func() {
+		errC <- server.Run()
+	}()
+
+	capturedShutdownFunc := <-capturedShutdownFuncC
+
+	conn, reader := dialWebSocket(t, listener.Addr().String())
+	defer conn.Close() //nolint:errcheck // Test connection
+
+	// Act
+	writeWebSocketFrame(t, conn, 0x9, []byte("keepalive"))
+	pongOpcode, pongPayload := readWebSocketFrame(t, reader)
+	writeWebSocketFrame(t, conn, 0x1, []byte(initializeRequest))
+	responseOpcode, response := readWebSocketFrame(t, reader)
+	err = capturedShutdownFunc()
+
+	// Assert
+	assert.Equal(t, byte(0xA), pongOpcode, "Pings should be answered with pongs")
+	assert.Equal(t, "keepalive", string(pongPayload))
+	assert.Equal(t, byte(0x1), responseOpcode, "Messages should be sent as text frames")
+	assert.Contains(t, string(response), `"serverInfo"`)
+	require.NoError(t, err, "Shutdown function should not return an error")
+	serverErr := <-errC
+	require.NoError(t, serverErr, "Server run should exit without error after shutdown")
+}
+
+func TestServer_NotifyClients_HappyPath(t *testing.T) {
+	// Arrange
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test-server"}, nil)
+	srv := server.NewWithMCPServer(mcpServer, testutils.NewInspectableLogger())
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := mcpServer.Connect(t.Context(), serverTransport, nil)
+	require.NoError(t, err)
+	defer func() { _ = serverSession.Close() }()
+
+	received := make(chan *mcp.LoggingMessageParams, 1)
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, &mcp.ClientOptions{
+		LoggingMessageHandler: func(_ context.Context, req *mcp.LoggingMessageRequest) {
+			received <- req.Params
+		},
+	})
+	clientSession, err := client.Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+	defer func() { _ = clientSession.Close() }()
+
+	require.NoError(t, clientSession.SetLoggingLevel(t.Context(), &mcp.SetLoggingLevelParams{Level: "info"}))
+
+	// Act
+	srv.NotifyClients("warning", "MATLAB is running out of memory")
+
+	// Assert
+	params := <-received
+	assert.Equal(t, mcp.LoggingLevel("warning"), params.Level)
+	assert.Equal(t, "MATLAB is running out of memory", params.Data)
+}
+
+func TestServer_NotifyClients_ClientWithoutLogLevel(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test-server"}, nil)
+	srv := server.NewWithMCPServer(mcpServer, mockLogger)
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := mcpServer.Connect(t.Context(), serverTransport, nil)
+	require.NoError(t, err)
+	defer func() { _ = serverSession.Close() }()
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, &mcp.ClientOptions{
+		LoggingMessageHandler: func(_ context.Context, _ *mcp.LoggingMessageRequest) {
+			t.Error("unexpected log message notification")
+		},
+	})
+	clientSession, err := client.Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+	defer func() { _ = clientSession.Close() }()
+
+	// Act
+	srv.NotifyClients("warning", "MATLAB is running out of memory")
+
+	// Assert
+	assert.Empty(t, mockLogger.WarnLogs())
+}
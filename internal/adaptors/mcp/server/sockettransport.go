@@ -0,0 +1,79 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"net"
+	"sync"
+
+	"github.com/modelcontextprotocol/go-sdk/jsonrpc"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// socketTransport is an MCP transport over a connection accepted on the daemon socket.
+// As with the standard input and output transport, messages are newline-delimited JSON,
+// so that a client can relay its standard input and output to the socket as is.
+type socketTransport struct {
+	conn      net.Conn
+	sessionID string
+}
+
+func newSocketTransport(conn net.Conn, sessionID string) *socketTransport {
+	return &socketTransport{
+		conn:      conn,
+		sessionID: sessionID,
+	}
+}
+
+func (t *socketTransport) Connect(context.Context) (mcp.Connection, error) {
+	return &socketConnection{
+		conn:      t.conn,
+		reader:    bufio.NewReader(t.conn),
+		sessionID: t.sessionID,
+		writeLock: new(sync.Mutex),
+	}, nil
+}
+
+type socketConnection struct {
+	conn      net.Conn
+	reader    *bufio.Reader
+	sessionID string
+	writeLock *sync.Mutex
+}
+
+func (c *socketConnection) Read(context.Context) (jsonrpc.Message, error) {
+	for {
+		line, err := c.reader.ReadBytes('\n')
+		line = bytes.TrimSpace(line)
+		if len(line) > 0 {
+			return jsonrpc.DecodeMessage(line)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (c *socketConnection) Write(_ context.Context, message jsonrpc.Message) error {
+	data, err := jsonrpc.EncodeMessage(message)
+	if err != nil {
+		return err
+	}
+
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+
+	_, err = c.conn.Write(append(data, '\n'))
+	return err
+}
+
+func (c *socketConnection) Close() error {
+	return c.conn.Close()
+}
+
+func (c *socketConnection) SessionID() string {
+	return c.sessionID
+}
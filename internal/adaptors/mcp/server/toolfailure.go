@@ -0,0 +1,54 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/toolfailure"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// toolFailureMiddleware lets tool handlers report a structured failure, and attaches it to the
+// `_meta` field of the error result, so clients can branch on the error code instead of the message.
+func toolFailureMiddleware(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		if method != methodCallTool {
+			return next(ctx, method, req)
+		}
+
+		ctx = toolfailure.NewContext(ctx)
+		result, err := next(ctx, method, req)
+		if err != nil {
+			return result, err
+		}
+
+		callToolResult, ok := result.(*mcp.CallToolResult)
+		if !ok || !callToolResult.IsError {
+			return result, err
+		}
+
+		failure, ok := toolfailure.FromContext(ctx)
+		if !ok {
+			return result, err
+		}
+
+		if callToolResult.Meta == nil {
+			callToolResult.Meta = mcp.Meta{}
+		}
+		callToolResult.Meta[toolfailure.MetaKey] = failure
+
+		return result, err
+	}
+}
+
+// toolFailureOf returns the structured failure attached to a tool call result by toolFailureMiddleware.
+func toolFailureOf(result mcp.Result) (toolfailure.Failure, bool) {
+	callToolResult, ok := result.(*mcp.CallToolResult)
+	if !ok {
+		return toolfailure.Failure{}, false
+	}
+
+	failure, ok := callToolResult.Meta[toolfailure.MetaKey].(toolfailure.Failure)
+	return failure, ok
+}
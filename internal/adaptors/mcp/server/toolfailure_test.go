@@ -0,0 +1,77 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/toolfailure"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToolFailureMiddleware_AttachesReportedFailure(t *testing.T) {
+	// Arrange
+	expectedFailure := toolfailure.Failure{
+		Code:          entities.ErrorCodeSyntaxError,
+		Message:       "matlab error: Invalid expression.",
+		CorrelationID: "test-correlation-id",
+	}
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		toolfailure.Report(ctx, expectedFailure)
+		return &mcp.CallToolResult{IsError: true}, nil
+	}
+
+	handler := server.ToolFailureMiddleware(next)
+
+	// Act
+	result, err := handler(t.Context(), "tools/call", &mcp.CallToolRequest{})
+
+	// Assert
+	require.NoError(t, err)
+	callToolResult, ok := result.(*mcp.CallToolResult)
+	require.True(t, ok, "Result should be a tool call result")
+	assert.Equal(t, expectedFailure, callToolResult.Meta[toolfailure.MetaKey], "Failure should be attached to the result metadata")
+}
+
+func TestToolFailureMiddleware_SuccessfulResultIsUnchanged(t *testing.T) {
+	// Arrange
+	expectedResult := &mcp.CallToolResult{}
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return expectedResult, nil
+	}
+
+	handler := server.ToolFailureMiddleware(next)
+
+	// Act
+	result, err := handler(t.Context(), "tools/call", &mcp.CallToolRequest{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedResult, result)
+	assert.Nil(t, expectedResult.Meta, "Successful result should not have metadata")
+}
+
+func TestToolFailureMiddleware_OtherMethodsHaveNoFailureContext(t *testing.T) {
+	// Arrange
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		toolfailure.Report(ctx, toolfailure.Failure{Code: entities.ErrorCodeInternal})
+		_, ok := toolfailure.FromContext(ctx)
+		assert.False(t, ok, "Failure context should only be installed for tool calls")
+		return nil, nil
+	}
+
+	handler := server.ToolFailureMiddleware(next)
+
+	// Act
+	_, err := handler(t.Context(), "tools/list", &mcp.ListToolsRequest{})
+
+	// Assert
+	require.NoError(t, err)
+}
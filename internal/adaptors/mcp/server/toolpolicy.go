@@ -0,0 +1,148 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/toolpolicy"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/clientidentity"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/correlationid"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/elicitation"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/i18n"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/toolfailure"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	codeArgument       = "code"
+	pathArgumentSuffix = "_path"
+)
+
+// toolPolicyMiddleware evaluates every tool call against the tool policy, before the tool runs.
+// Calls requiring a confirmation are only run once the user accepted them.
+func toolPolicyMiddleware(policy ToolPolicy, logger entities.Logger) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			if method != methodCallTool {
+				return next(ctx, method, req)
+			}
+
+			params, ok := req.GetParams().(*mcp.CallToolParamsRaw)
+			if !ok {
+				return next(ctx, method, req)
+			}
+
+			call := toolPolicyCall(params)
+			decision := policy.Evaluate(call)
+			logger := logger.With("tool-name", call.Tool).With("policy-action", decision.Action)
+			if decision.Rule != "" {
+				logger = logger.With("policy-rule", decision.Rule)
+			}
+			if correlationID, ok := correlationid.FromContext(ctx); ok {
+				logger = logger.With(correlationid.LogKey, correlationID)
+			}
+			if identity, ok := clientidentity.FromContext(ctx); ok {
+				logger = logger.With(clientidentity.UserLogKey, identity.User).With(clientidentity.ClientLogKey, identity.Client)
+			}
+
+			switch decision.Action {
+			case toolpolicy.ActionAllow:
+				return next(ctx, method, req)
+			case toolpolicy.ActionConfirm:
+				accepted, err := confirmToolCall(ctx, params, decision)
+				if err != nil {
+					logger.WithError(err).Warn("Tool call requiring confirmation could not be confirmed")
+					return policyViolationResult(ctx, fmt.Sprintf("the call to %s requires a confirmation from the user, which could not be requested: %v", call.Tool, err)), nil
+				}
+				if !accepted {
+					logger.Info("Tool call declined by the user")
+					return policyViolationResult(ctx, fmt.Sprintf("the call to %s was declined by the user", call.Tool)), nil
+				}
+				logger.Info("Tool call confirmed by the user")
+				return next(ctx, method, req)
+			default:
+				logger.Warn("Tool call denied by the policy")
+				return policyViolationResult(ctx, withReason(fmt.Sprintf("the call to %s is denied by the tool policy", call.Tool), decision.Explanation())), nil
+			}
+		}
+	}
+}
+
+// toolPolicyCall extracts the parts of a tool call that the policy can match.
+// Paths are the string arguments whose name ends with `_path`, and code is the `code` argument.
+func toolPolicyCall(params *mcp.CallToolParamsRaw) toolpolicy.Call {
+	call := toolpolicy.Call{
+		Tool: params.Name,
+	}
+
+	var arguments map[string]any
+	if err := json.Unmarshal(params.Arguments, &arguments); err != nil {
+		return call
+	}
+
+	for name, value := range arguments {
+		stringValue, ok := value.(string)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case name == codeArgument:
+			call.Code = stringValue
+		case strings.HasSuffix(name, pathArgumentSuffix):
+			call.Paths = append(call.Paths, stringValue)
+		}
+	}
+
+	return call
+}
+
+func confirmToolCall(ctx context.Context, params *mcp.CallToolParamsRaw, decision toolpolicy.Decision) (bool, error) {
+	locale := i18n.FromContext(ctx)
+	message := i18n.Translate(locale, i18n.MessageAllowToolCall, params.Name)
+	if explanation := decision.Explanation(); explanation != "" {
+		message += "\n\n" + explanation
+	}
+	if len(params.Arguments) > 0 {
+		message += "\n\n" + i18n.Translate(locale, i18n.MessageToolCallArguments) + "\n" + string(params.Arguments)
+	}
+
+	return elicitation.Confirm(ctx, message)
+}
+
+// policyViolationResult returns the error result of a tool call rejected by the policy.
+func policyViolationResult(ctx context.Context, message string) *mcp.CallToolResult {
+	return rejectedCallResult(ctx, entities.ErrorCodePolicyViolation, message)
+}
+
+// rejectedCallResult returns the error result of a tool call rejected before the tool runs, formatted as the failures of the tools.
+func rejectedCallResult(ctx context.Context, code entities.ErrorCode, message string) *mcp.CallToolResult {
+	failure := toolfailure.Failure{
+		Code:    code,
+		Message: message,
+	}
+	text := fmt.Sprintf("%s: %s", failure.Code, message)
+
+	if correlationID, ok := correlationid.FromContext(ctx); ok {
+		failure.CorrelationID = correlationID
+		text = fmt.Sprintf("%s (correlation ID: %s)", text, correlationID)
+	}
+
+	toolfailure.Report(ctx, failure)
+	return &mcp.CallToolResult{
+		IsError: true,
+		Content: []mcp.Content{&mcp.TextContent{Text: text}},
+	}
+}
+
+func withReason(message string, reason string) string {
+	if reason == "" {
+		return message
+	}
+	return fmt.Sprintf("%s: %s", message, reason)
+}
@@ -0,0 +1,205 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/toolpolicy"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/toolfailure"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/server"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// callRunMATLABFile calls a run_matlab_file tool through the tool policy middleware, and returns the result received by
+// the client and whether the tool ran.
+func callRunMATLABFile(t *testing.T, policy server.ToolPolicy, clientOptions *mcp.ClientOptions) (*mcp.CallToolResult, bool) {
+	t.Helper()
+
+	toolCalled := false
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test-server"}, nil)
+	mcpServer.AddReceivingMiddleware(server.ElicitationMiddleware, server.ToolFailureMiddleware, server.ToolPolicyMiddleware(policy, testutils.NewInspectableLogger()))
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "run_matlab_file"}, func(ctx context.Context, req *mcp.CallToolRequest, input struct {
+		ScriptPath string `json:"script_path"`
+	}) (*mcp.CallToolResult, any, error) {
+		toolCalled = true
+		return &mcp.CallToolResult{}, nil, nil
+	})
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, clientOptions)
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := mcpServer.Connect(t.Context(), serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = serverSession.Close() })
+
+	clientSession, err := client.Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = clientSession.Close() })
+
+	result, err := clientSession.CallTool(t.Context(), &mcp.CallToolParams{
+		Name:      "run_matlab_file",
+		Arguments: map[string]any{"script_path": "/home/user/script.m"},
+	})
+	require.NoError(t, err)
+
+	return result, toolCalled
+}
+
+func TestToolPolicyMiddleware_Allow(t *testing.T) {
+	// Arrange
+	mockToolPolicy := &mocks.MockToolPolicy{}
+	defer mockToolPolicy.AssertExpectations(t)
+
+	mockToolPolicy.EXPECT().
+		Evaluate(toolpolicy.Call{Tool: "run_matlab_file", Paths: []string{"/home/user/script.m"}}).
+		Return(toolpolicy.Decision{Action: toolpolicy.ActionAllow}).
+		Once()
+
+	// Act
+	result, toolCalled := callRunMATLABFile(t, mockToolPolicy, nil)
+
+	// Assert
+	assert.False(t, result.IsError, "Allowed call should succeed")
+	assert.True(t, toolCalled, "Allowed call should run the tool")
+}
+
+func TestToolPolicyMiddleware_Deny(t *testing.T) {
+	// Arrange
+	mockToolPolicy := &mocks.MockToolPolicy{}
+	defer mockToolPolicy.AssertExpectations(t)
+
+	mockToolPolicy.EXPECT().
+		Evaluate(toolpolicy.Call{Tool: "run_matlab_file", Paths: []string{"/home/user/script.m"}}).
+		Return(toolpolicy.Decision{Action: toolpolicy.ActionDeny, Reason: "scripts must be reviewed first"}).
+		Once()
+
+	// Act
+	result, toolCalled := callRunMATLABFile(t, mockToolPolicy, nil)
+
+	// Assert
+	require.True(t, result.IsError, "Denied call should fail")
+	assert.False(t, toolCalled, "Denied call should not run the tool")
+	require.Len(t, result.Content, 1)
+	textContent, ok := result.Content[0].(*mcp.TextContent)
+	require.True(t, ok)
+	assert.Equal(t, "POLICY_VIOLATION: the call to run_matlab_file is denied by the tool policy: scripts must be reviewed first", textContent.Text)
+
+	failure, ok := result.Meta[toolfailure.MetaKey].(map[string]any)
+	require.True(t, ok, "Failure should be attached to the result metadata")
+	assert.Equal(t, string(entities.ErrorCodePolicyViolation), failure["code"])
+}
+
+func TestToolPolicyMiddleware_DenyExplainsMatchedRule(t *testing.T) {
+	// Arrange
+	mockToolPolicy := &mocks.MockToolPolicy{}
+	defer mockToolPolicy.AssertExpectations(t)
+
+	mockToolPolicy.EXPECT().
+		Evaluate(toolpolicy.Call{Tool: "run_matlab_file", Paths: []string{"/home/user/script.m"}}).
+		Return(toolpolicy.Decision{Action: toolpolicy.ActionDeny, Reason: "removing the MATLAB folders from the search path breaks MATLAB for the rest of the session", Rule: "rmpath-matlabroot", Match: "rmpath(matlabroot", Line: 2}).
+		Once()
+
+	// Act
+	result, _ := callRunMATLABFile(t, mockToolPolicy, nil)
+
+	// Assert
+	require.True(t, result.IsError)
+	require.Len(t, result.Content, 1)
+	textContent, ok := result.Content[0].(*mcp.TextContent)
+	require.True(t, ok)
+	assert.Equal(t, `POLICY_VIOLATION: the call to run_matlab_file is denied by the tool policy: rule "rmpath-matlabroot" matched "rmpath(matlabroot" on line 2: removing the MATLAB folders from the search path breaks MATLAB for the rest of the session`, textContent.Text)
+}
+
+func TestToolPolicyMiddleware_Confirm(t *testing.T) {
+	testCases := []struct {
+		name             string
+		elicitAction     string
+		expectToolCalled bool
+		expectedText     string
+	}{
+		{
+			name:             "accepted",
+			elicitAction:     "accept",
+			expectToolCalled: true,
+		},
+		{
+			name:         "declined",
+			elicitAction: "decline",
+			expectedText: "POLICY_VIOLATION: the call to run_matlab_file was declined by the user",
+		},
+		{
+			name:         "cancelled",
+			elicitAction: "cancel",
+			expectedText: "POLICY_VIOLATION: the call to run_matlab_file was declined by the user",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockToolPolicy := &mocks.MockToolPolicy{}
+			defer mockToolPolicy.AssertExpectations(t)
+
+			mockToolPolicy.EXPECT().
+				Evaluate(toolpolicy.Call{Tool: "run_matlab_file", Paths: []string{"/home/user/script.m"}}).
+				Return(toolpolicy.Decision{Action: toolpolicy.ActionConfirm, Reason: "scripts can modify files"}).
+				Once()
+
+			var elicitMessage string
+			clientOptions := &mcp.ClientOptions{
+				ElicitationHandler: func(ctx context.Context, req *mcp.ElicitRequest) (*mcp.ElicitResult, error) {
+					elicitMessage = req.Params.Message
+					return &mcp.ElicitResult{Action: testCase.elicitAction}, nil
+				},
+			}
+
+			// Act
+			result, toolCalled := callRunMATLABFile(t, mockToolPolicy, clientOptions)
+
+			// Assert
+			assert.Contains(t, elicitMessage, "Allow the call to the run_matlab_file tool?")
+			assert.Contains(t, elicitMessage, "scripts can modify files")
+			assert.Contains(t, elicitMessage, "/home/user/script.m", "The arguments should be shown to the user")
+			assert.Equal(t, testCase.expectToolCalled, toolCalled)
+			if testCase.expectToolCalled {
+				assert.False(t, result.IsError)
+				return
+			}
+
+			require.True(t, result.IsError)
+			require.Len(t, result.Content, 1)
+			textContent, ok := result.Content[0].(*mcp.TextContent)
+			require.True(t, ok)
+			assert.Equal(t, testCase.expectedText, textContent.Text)
+		})
+	}
+}
+
+func TestToolPolicyMiddleware_ConfirmWithoutElicitationSupport(t *testing.T) {
+	// Arrange
+	mockToolPolicy := &mocks.MockToolPolicy{}
+	defer mockToolPolicy.AssertExpectations(t)
+
+	mockToolPolicy.EXPECT().
+		Evaluate(toolpolicy.Call{Tool: "run_matlab_file", Paths: []string{"/home/user/script.m"}}).
+		Return(toolpolicy.Decision{Action: toolpolicy.ActionConfirm}).
+		Once()
+
+	// Act
+	result, toolCalled := callRunMATLABFile(t, mockToolPolicy, nil)
+
+	// Assert
+	require.True(t, result.IsError, "Call requiring a confirmation should fail when the client cannot confirm it")
+	assert.False(t, toolCalled)
+	require.Len(t, result.Content, 1)
+	textContent, ok := result.Content[0].(*mcp.TextContent)
+	require.True(t, ok)
+	assert.Contains(t, textContent.Text, "the MCP client does not support elicitation")
+}
@@ -0,0 +1,55 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server
+
+import (
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+)
+
+// ReloadTools exposes the tools the configurator chooses now, such as when tools were disabled or enabled again by a
+// reload of the configuration. The tools are added and removed without restarting the server, and the MCP SDK
+// notifies the connected clients that the list of tools changed. Tools without a name are never removed.
+func (s *Server) ReloadTools() error {
+	s.toolsLock.Lock()
+	defer s.toolsLock.Unlock()
+
+	toolNames := make(map[string]bool)
+	for _, tool := range s.configurator.GetToolsToAdd() {
+		name := toolName(tool)
+		if name == "" {
+			continue
+		}
+		toolNames[name] = true
+
+		if s.toolNames[name] {
+			continue
+		}
+		if err := tool.AddToServer(s.mcpServer); err != nil {
+			return err
+		}
+		s.serverLogger.With("tool", name).Info("Tool enabled")
+	}
+
+	var removedTools []string
+	for name := range s.toolNames {
+		if !toolNames[name] {
+			removedTools = append(removedTools, name)
+			s.serverLogger.With("tool", name).Info("Tool disabled")
+		}
+	}
+	if len(removedTools) > 0 {
+		s.mcpServer.RemoveTools(removedTools...)
+	}
+
+	s.toolNames = toolNames
+	return nil
+}
+
+// toolName is the name of tool, or empty if it does not tell its name.
+func toolName(tool tools.Tool) string {
+	namedTool, ok := tool.(interface{ Name() string })
+	if !ok {
+		return ""
+	}
+	return namedTool.Name()
+}
@@ -0,0 +1,127 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/server"
+	toolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_ReloadTools_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfigurator := &mocks.MockMCPServerConfigurator{}
+	defer mockConfigurator.AssertExpectations(t)
+
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test-server"}, nil)
+	addTestTool(mcpServer, "check_matlab_code")
+	addTestTool(mcpServer, "evaluate_matlab_code")
+
+	keptTool := newNamedTool(t, "check_matlab_code")
+
+	enabledTool := newNamedTool(t, "detect_matlab_toolboxes")
+	enabledTool.EXPECT().
+		AddToServer(mcpServer).
+		RunAndReturn(func(mcpServer *mcp.Server) error {
+			addTestTool(mcpServer, "detect_matlab_toolboxes")
+			return nil
+		}).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetToolsToAdd().
+		Return([]tools.Tool{keptTool, enabledTool}).
+		Once()
+
+	mockLogger := testutils.NewInspectableLogger()
+	srv := server.NewWithConfigurator(mcpServer, mockLogger, mockConfigurator, "check_matlab_code", "evaluate_matlab_code")
+
+	clientSession := connectTestClient(t, mcpServer)
+
+	// Act
+	err := srv.ReloadTools()
+
+	// Assert
+	require.NoError(t, err)
+
+	result, err := clientSession.ListTools(t.Context(), nil)
+	require.NoError(t, err)
+	var names []string
+	for _, tool := range result.Tools {
+		names = append(names, tool.Name)
+	}
+	assert.ElementsMatch(t, []string{"check_matlab_code", "detect_matlab_toolboxes"}, names)
+
+	infoLogs := mockLogger.InfoLogs()
+	assert.Contains(t, infoLogs, "Tool enabled")
+	assert.Contains(t, infoLogs, "Tool disabled")
+}
+
+func TestServer_ReloadTools_AddToServerError(t *testing.T) {
+	// Arrange
+	mockConfigurator := &mocks.MockMCPServerConfigurator{}
+	defer mockConfigurator.AssertExpectations(t)
+
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test-server"}, nil)
+
+	expectedError := assert.AnError
+	failingTool := newNamedTool(t, "detect_matlab_toolboxes")
+	failingTool.EXPECT().
+		AddToServer(mcpServer).
+		Return(expectedError).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetToolsToAdd().
+		Return([]tools.Tool{failingTool}).
+		Once()
+
+	srv := server.NewWithConfigurator(mcpServer, testutils.NewInspectableLogger(), mockConfigurator)
+
+	// Act
+	err := srv.ReloadTools()
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+}
+
+func newNamedTool(t *testing.T, name string) *toolsmocks.MockProvidedTool {
+	mockTool := &toolsmocks.MockProvidedTool{}
+	t.Cleanup(func() { mockTool.AssertExpectations(t) })
+
+	mockTool.EXPECT().
+		Name().
+		Return(name).
+		Maybe()
+
+	return mockTool
+}
+
+func addTestTool(mcpServer *mcp.Server, name string) {
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: name}, func(ctx context.Context, req *mcp.CallToolRequest, input struct{}) (*mcp.CallToolResult, any, error) {
+		return &mcp.CallToolResult{}, nil, nil
+	})
+}
+
+func connectTestClient(t *testing.T, mcpServer *mcp.Server) *mcp.ClientSession {
+	t.Helper()
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := mcpServer.Connect(t.Context(), serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = serverSession.Close() })
+
+	clientSession, err := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil).Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = clientSession.Close() })
+
+	return clientSession
+}
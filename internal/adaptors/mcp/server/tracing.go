@@ -0,0 +1,136 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/correlationid"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/tracing"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// tracingMiddleware records a span for every tool call, the parent of the spans of the work done for the call, such as
+// waiting for MATLAB, starting MATLAB and evaluating code.
+// The span is a child of the span of the traceparent of the _meta of the call, or of the traceparent header of the
+// HTTP request of the call, set by the HTTP transport to the span of the request.
+func tracingMiddleware(tracer Tracer) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			params, ok := req.GetParams().(*mcp.CallToolParamsRaw)
+			if method != methodCallTool || !ok {
+				return next(ctx, method, req)
+			}
+
+			if parent, ok := remoteParent(params, req.GetExtra()); ok {
+				ctx = tracing.ContextWithRemoteParent(ctx, parent)
+			}
+
+			attributes := []tracing.Attribute{
+				tracing.String("mcp.method.name", method),
+				tracing.String("gen_ai.operation.name", "execute_tool"),
+				tracing.String("gen_ai.tool.name", params.Name),
+			}
+			if id := sessionID(req); id != "" {
+				attributes = append(attributes, tracing.String("mcp.session.id", id))
+			}
+			if correlationID, ok := correlationid.FromContext(ctx); ok {
+				attributes = append(attributes, tracing.String(correlationid.LogKey, correlationID))
+			}
+
+			ctx, span := tracer.Start(ctx, method+" "+params.Name, tracing.SpanKindServer, attributes...)
+			defer span.End()
+
+			result, err := next(ctx, method, req)
+			if err != nil {
+				span.RecordError(err)
+			} else if callToolResult, ok := result.(*mcp.CallToolResult); ok && callToolResult.IsError {
+				span.SetError("the tool call failed")
+			}
+
+			return result, err
+		}
+	}
+}
+
+func remoteParent(params *mcp.CallToolParamsRaw, extra *mcp.RequestExtra) (tracing.SpanContext, bool) {
+	if traceParent, ok := params.Meta[tracing.TraceParentHeader].(string); ok {
+		return tracing.ParseTraceParent(traceParent)
+	}
+	if extra != nil && extra.Header != nil {
+		return tracing.ParseTraceParent(extra.Header.Get(tracing.TraceParentHeader))
+	}
+	return tracing.SpanContext{}, false
+}
+
+// traceMeta is the _meta of the tool calls made on behalf of the span of ctx, such as the calls of the REST API, so
+// that the spans of the calls are its children.
+func traceMeta(ctx context.Context) mcp.Meta {
+	span := tracing.SpanFromContext(ctx)
+	if span == nil {
+		return nil
+	}
+	return mcp.Meta{tracing.TraceParentHeader: span.SpanContext().TraceParent()}
+}
+
+// traced records a span for every HTTP request, but the WebSocket upgrades, whose connections last as long as the
+// session. The traceparent header of the request is replaced with the span of the request, so that the spans of its
+// tool calls are children of that span.
+func traced(tracer Tracer, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := r.Context()
+		if parent, ok := tracing.ParseTraceParent(r.Header.Get(tracing.TraceParentHeader)); ok {
+			ctx = tracing.ContextWithRemoteParent(ctx, parent)
+		}
+
+		ctx, span := tracer.Start(ctx, r.Method+" "+r.URL.Path, tracing.SpanKindServer,
+			tracing.String("http.request.method", r.Method),
+			tracing.String("url.path", r.URL.Path),
+		)
+		if span == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		defer span.End()
+
+		r = r.WithContext(ctx)
+		r.Header.Set(tracing.TraceParentHeader, span.SpanContext().TraceParent())
+
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+
+		span.SetAttributes(tracing.Int("http.response.status_code", recorder.status))
+		if recorder.status >= http.StatusInternalServerError {
+			span.SetError(http.StatusText(recorder.status))
+		}
+	})
+}
+
+// statusRecorder records the status code of a response. It keeps the response writer flushable, as the streamable
+// HTTP transport streams its responses.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
@@ -0,0 +1,196 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/correlationid"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/tracing"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/server"
+	tracingmocks "github.com/matlab/matlab-mcp-core-server/mocks/utils/tracing"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const clientTraceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+// newRecordingTracer returns a tracer, and the spans it exported.
+func newRecordingTracer(t *testing.T) (*tracing.Tracer, *[]*tracing.Span) {
+	t.Helper()
+
+	mockExporter := &tracingmocks.MockExporter{}
+	t.Cleanup(func() { mockExporter.AssertExpectations(t) })
+
+	mockExporter.EXPECT().
+		Enabled().
+		Return(true).
+		Maybe()
+
+	var exported []*tracing.Span
+	mockExporter.EXPECT().
+		ExportSpan(mock.Anything).
+		Run(func(span *tracing.Span) {
+			exported = append(exported, span)
+		}).
+		Return().
+		Maybe()
+
+	return tracing.NewTracer(mockExporter), &exported
+}
+
+func TestTracingMiddleware_RecordsToolCalls(t *testing.T) {
+	// Arrange
+	tracer, exported := newRecordingTracer(t)
+
+	var spanInHandler *tracing.Span
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		spanInHandler = tracing.SpanFromContext(ctx)
+		return &mcp.CallToolResult{}, nil
+	}
+
+	handler := server.TracingMiddleware(tracer)(next)
+
+	// Act
+	_, err := handler(correlationid.NewContext(t.Context(), "c0ffee"), "tools/call", &mcp.CallToolRequest{
+		Params: &mcp.CallToolParamsRaw{
+			Meta: mcp.Meta{"traceparent": clientTraceParent},
+			Name: "evaluate_matlab_code",
+		},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, *exported, 1)
+
+	span := (*exported)[0]
+	assert.Same(t, span, spanInHandler)
+	assert.Equal(t, "tools/call evaluate_matlab_code", span.Name)
+	assert.Equal(t, tracing.SpanKindServer, span.Kind)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.TraceID.String())
+	assert.Equal(t, "00f067aa0ba902b7", span.ParentSpanID.String())
+	assert.Contains(t, span.Attributes, tracing.String("gen_ai.tool.name", "evaluate_matlab_code"))
+	assert.Contains(t, span.Attributes, tracing.String("correlation-id", "c0ffee"))
+	assert.False(t, span.Failed)
+}
+
+func TestTracingMiddleware_ParentFromHTTPHeader(t *testing.T) {
+	// Arrange
+	tracer, exported := newRecordingTracer(t)
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return &mcp.CallToolResult{IsError: true}, nil
+	}
+
+	handler := server.TracingMiddleware(tracer)(next)
+
+	header := http.Header{}
+	header.Set("traceparent", clientTraceParent)
+
+	// Act
+	_, err := handler(t.Context(), "tools/call", &mcp.CallToolRequest{
+		Params: &mcp.CallToolParamsRaw{Name: "evaluate_matlab_code"},
+		Extra:  &mcp.RequestExtra{Header: header},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, *exported, 1)
+	assert.Equal(t, "00f067aa0ba902b7", (*exported)[0].ParentSpanID.String())
+	assert.True(t, (*exported)[0].Failed)
+}
+
+func TestTracingMiddleware_RecordsErrors(t *testing.T) {
+	// Arrange
+	tracer, exported := newRecordingTracer(t)
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return nil, assert.AnError
+	}
+
+	handler := server.TracingMiddleware(tracer)(next)
+
+	// Act
+	_, err := handler(t.Context(), "tools/call", &mcp.CallToolRequest{
+		Params: &mcp.CallToolParamsRaw{Name: "unknown_tool"},
+	})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	require.Len(t, *exported, 1)
+	assert.False(t, (*exported)[0].ParentSpanID.IsValid())
+	assert.True(t, (*exported)[0].Failed)
+	assert.Equal(t, assert.AnError.Error(), (*exported)[0].StatusMessage)
+}
+
+func TestTracingMiddleware_IgnoresOtherMethods(t *testing.T) {
+	// Arrange
+	mockTracer := &mocks.MockTracer{}
+	defer mockTracer.AssertExpectations(t)
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return &mcp.ListToolsResult{}, nil
+	}
+
+	handler := server.TracingMiddleware(mockTracer)(next)
+
+	// Act
+	_, err := handler(t.Context(), "tools/list", &mcp.ListToolsRequest{Params: &mcp.ListToolsParams{}})
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestTraced_RecordsRequests(t *testing.T) {
+	// Arrange
+	tracer, exported := newRecordingTracer(t)
+
+	var traceParentInHandler string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceParentInHandler = r.Header.Get("traceparent")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	request := httptest.NewRequest(http.MethodPost, "/mcp", nil)
+	request.Header.Set("traceparent", clientTraceParent)
+	recorder := httptest.NewRecorder()
+
+	// Act
+	server.Traced(tracer, next).ServeHTTP(recorder, request)
+
+	// Assert
+	assert.Equal(t, http.StatusAccepted, recorder.Code)
+	require.Len(t, *exported, 1)
+
+	span := (*exported)[0]
+	assert.Equal(t, "POST /mcp", span.Name)
+	assert.Equal(t, "00f067aa0ba902b7", span.ParentSpanID.String())
+	assert.Contains(t, span.Attributes, tracing.Int("http.response.status_code", http.StatusAccepted))
+	assert.Equal(t, span.SpanContext().TraceParent(), traceParentInHandler)
+}
+
+func TestTraced_WebSocketUpgrade(t *testing.T) {
+	// Arrange
+	mockTracer := &mocks.MockTracer{}
+	defer mockTracer.AssertExpectations(t)
+
+	called := false
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	})
+
+	request := httptest.NewRequest(http.MethodGet, "/mcp", nil)
+	request.Header.Set("Upgrade", "websocket")
+
+	// Act
+	server.Traced(mockTracer, next).ServeHTTP(httptest.NewRecorder(), request)
+
+	// Assert
+	assert.True(t, called)
+}
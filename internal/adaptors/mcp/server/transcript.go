@@ -0,0 +1,49 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/sessiontranscript"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// transcriptMiddleware adds the tool calls that returned a result to the session transcript, from which the code run in
+// the session is exported. Like the session recording, it sees the results after redaction.
+// Dry runs are not added, as their code did not run.
+func transcriptMiddleware(sessionTranscript SessionTranscript, planner DryRunPlanner) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			result, err := next(ctx, method, req)
+			if method != methodCallTool || err != nil {
+				return result, err
+			}
+
+			params, ok := req.GetParams().(*mcp.CallToolParamsRaw)
+			if !ok {
+				return result, err
+			}
+
+			callToolResult, ok := result.(*mcp.CallToolResult)
+			if !ok || callToolResult == nil || planner.Applies(params.Name, params.Arguments) {
+				return result, err
+			}
+
+			var output strings.Builder
+			var figures []sessiontranscript.Figure
+			for _, content := range callToolResult.Content {
+				switch content := content.(type) {
+				case *mcp.TextContent:
+					output.WriteString(content.Text)
+				case *mcp.ImageContent:
+					figures = append(figures, sessiontranscript.Figure{MIMEType: content.MIMEType, Data: content.Data})
+				}
+			}
+			sessionTranscript.Record(params.Name, params.Arguments, output.String(), figures, callToolResult.IsError)
+
+			return result, err
+		}
+	}
+}
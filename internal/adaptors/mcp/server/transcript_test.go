@@ -0,0 +1,112 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/sessiontranscript"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/server"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranscriptMiddleware_RecordsToolCalls(t *testing.T) {
+	// Arrange
+	mockSessionTranscript := &mocks.MockSessionTranscript{}
+	defer mockSessionTranscript.AssertExpectations(t)
+
+	mockDryRunPlanner := &mocks.MockDryRunPlanner{}
+	defer mockDryRunPlanner.AssertExpectations(t)
+
+	arguments := json.RawMessage(`{"code":"plot(1:10)"}`)
+	expectedResult := &mcp.CallToolResult{
+		IsError: true,
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: "ans = 1\n"},
+			&mcp.ImageContent{MIMEType: "image/png", Data: []byte("png")},
+		},
+	}
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return expectedResult, nil
+	}
+
+	mockDryRunPlanner.EXPECT().
+		Applies("evaluate_matlab_code", arguments).
+		Return(false).
+		Once()
+
+	mockSessionTranscript.EXPECT().
+		Record("evaluate_matlab_code", arguments, "ans = 1\n", []sessiontranscript.Figure{{MIMEType: "image/png", Data: []byte("png")}}, true).
+		Return().
+		Once()
+
+	handler := server.TranscriptMiddleware(mockSessionTranscript, mockDryRunPlanner)(next)
+
+	// Act
+	result, err := handler(t.Context(), "tools/call", &mcp.CallToolRequest{
+		Params: &mcp.CallToolParamsRaw{Name: "evaluate_matlab_code", Arguments: arguments},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestTranscriptMiddleware_IgnoresDryRuns(t *testing.T) {
+	// Arrange
+	mockSessionTranscript := &mocks.MockSessionTranscript{}
+	defer mockSessionTranscript.AssertExpectations(t)
+
+	mockDryRunPlanner := &mocks.MockDryRunPlanner{}
+	defer mockDryRunPlanner.AssertExpectations(t)
+
+	arguments := json.RawMessage(`{"code":"delete('*.mat')","dry_run":true}`)
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: "Plan"}}}, nil
+	}
+
+	mockDryRunPlanner.EXPECT().
+		Applies("evaluate_matlab_code", arguments).
+		Return(true).
+		Once()
+
+	handler := server.TranscriptMiddleware(mockSessionTranscript, mockDryRunPlanner)(next)
+
+	// Act
+	_, err := handler(t.Context(), "tools/call", &mcp.CallToolRequest{
+		Params: &mcp.CallToolParamsRaw{Name: "evaluate_matlab_code", Arguments: arguments},
+	})
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestTranscriptMiddleware_IgnoresErrors(t *testing.T) {
+	// Arrange
+	mockSessionTranscript := &mocks.MockSessionTranscript{}
+	defer mockSessionTranscript.AssertExpectations(t)
+
+	mockDryRunPlanner := &mocks.MockDryRunPlanner{}
+	defer mockDryRunPlanner.AssertExpectations(t)
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return nil, assert.AnError
+	}
+
+	handler := server.TranscriptMiddleware(mockSessionTranscript, mockDryRunPlanner)(next)
+
+	// Act
+	_, err := handler(t.Context(), "tools/call", &mcp.CallToolRequest{
+		Params: &mcp.CallToolParamsRaw{Name: "evaluate_matlab_code"},
+	})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
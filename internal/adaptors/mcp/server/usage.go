@@ -0,0 +1,40 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/telemetry"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// usageMiddleware counts tool calls and their failures for the opt-in usage telemetry.
+func usageMiddleware(usageRecorder UsageRecorder) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			result, err := next(ctx, method, req)
+			if method != methodCallTool {
+				return result, err
+			}
+
+			if err != nil {
+				usageRecorder.RecordToolCall(telemetry.UnknownToolName, telemetry.ErrorClassRequestError)
+				return result, err
+			}
+
+			toolName := telemetry.UnknownToolName
+			if params, ok := req.GetParams().(*mcp.CallToolParamsRaw); ok {
+				toolName = params.Name
+			}
+
+			errorClass := ""
+			if callToolResult, ok := result.(*mcp.CallToolResult); ok && callToolResult.IsError {
+				errorClass = telemetry.ErrorClassToolError
+			}
+
+			usageRecorder.RecordToolCall(toolName, errorClass)
+			return result, err
+		}
+	}
+}
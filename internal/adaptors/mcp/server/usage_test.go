@@ -0,0 +1,90 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/telemetry"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/server"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsageMiddleware_RecordsToolCalls(t *testing.T) {
+	testConfigs := []struct {
+		name               string
+		result             mcp.Result
+		err                error
+		expectedToolName   string
+		expectedErrorClass string
+	}{
+		{
+			name:               "successful call",
+			result:             &mcp.CallToolResult{},
+			expectedToolName:   "evaluate_matlab_code",
+			expectedErrorClass: "",
+		},
+		{
+			name:               "tool error",
+			result:             &mcp.CallToolResult{IsError: true},
+			expectedToolName:   "evaluate_matlab_code",
+			expectedErrorClass: telemetry.ErrorClassToolError,
+		},
+		{
+			name:               "request error",
+			err:                assert.AnError,
+			expectedToolName:   telemetry.UnknownToolName,
+			expectedErrorClass: telemetry.ErrorClassRequestError,
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockUsageRecorder := &mocks.MockUsageRecorder{}
+			defer mockUsageRecorder.AssertExpectations(t)
+
+			next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+				return testConfig.result, testConfig.err
+			}
+
+			mockUsageRecorder.EXPECT().
+				RecordToolCall(testConfig.expectedToolName, testConfig.expectedErrorClass).
+				Return().
+				Once()
+
+			handler := server.UsageMiddleware(mockUsageRecorder)(next)
+
+			// Act
+			result, err := handler(t.Context(), "tools/call", &mcp.CallToolRequest{
+				Params: &mcp.CallToolParamsRaw{Name: "evaluate_matlab_code"},
+			})
+
+			// Assert
+			require.ErrorIs(t, err, testConfig.err)
+			assert.Equal(t, testConfig.result, result)
+		})
+	}
+}
+
+func TestUsageMiddleware_IgnoresOtherMethods(t *testing.T) {
+	// Arrange
+	mockUsageRecorder := &mocks.MockUsageRecorder{}
+	defer mockUsageRecorder.AssertExpectations(t)
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return nil, nil
+	}
+
+	handler := server.UsageMiddleware(mockUsageRecorder)(next)
+
+	// Act
+	_, err := handler(t.Context(), "resources/read", &mcp.ReadResourceRequest{})
+
+	// Assert
+	require.NoError(t, err)
+}
@@ -0,0 +1,371 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha1" //nolint:gosec // SHA-1 is mandated by the WebSocket handshake, it is not used for security
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/jsonrpc"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// webSocketGUID is appended to the key of the client to compute the accept key of the handshake, as set by RFC 6455.
+const webSocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// maxWebSocketMessageBytes is the size of the largest message accepted from a client, so that a client cannot exhaust
+// the memory of the server.
+const maxWebSocketMessageBytes = 32 << 20
+
+const (
+	webSocketOpContinuation byte = 0x0
+	webSocketOpText         byte = 0x1
+	webSocketOpBinary       byte = 0x2
+	webSocketOpClose        byte = 0x8
+	webSocketOpPing         byte = 0x9
+	webSocketOpPong         byte = 0xA
+)
+
+const (
+	webSocketCloseNormal        uint16 = 1000
+	webSocketCloseProtocolError uint16 = 1002
+	webSocketCloseTooLarge      uint16 = 1009
+)
+
+// upgradeWebSocket completes the WebSocket handshake of r, and returns the connection taken over from the HTTP server.
+// When the request is not a valid WebSocket handshake, an error response is written and an error is returned.
+func upgradeWebSocket(w http.ResponseWriter, r *http.Request) (net.Conn, *bufio.Reader, error) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method Not Allowed: WebSocket handshakes use GET", http.StatusMethodNotAllowed)
+		return nil, nil, fmt.Errorf("unexpected method %s", r.Method)
+	}
+
+	if !headerHasToken(r.Header, "Connection", "upgrade") || !headerHasToken(r.Header, "Upgrade", "websocket") {
+		http.Error(w, "Bad Request: expected a WebSocket handshake", http.StatusBadRequest)
+		return nil, nil, errors.New("not a WebSocket handshake")
+	}
+
+	if r.Header.Get("Sec-WebSocket-Version") != "13" {
+		w.Header().Set("Sec-WebSocket-Version", "13")
+		http.Error(w, "Upgrade Required: unsupported WebSocket version", http.StatusUpgradeRequired)
+		return nil, nil, errors.New("unsupported WebSocket version")
+	}
+
+	key := r.Header.Get("Sec-WebSocket-Key")
+	if decoded, err := base64.StdEncoding.DecodeString(key); err != nil || len(decoded) != 16 {
+		http.Error(w, "Bad Request: invalid WebSocket key", http.StatusBadRequest)
+		return nil, nil, errors.New("invalid WebSocket key")
+	}
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "Internal Server Error: the connection cannot be upgraded", http.StatusInternalServerError)
+		return nil, nil, errors.New("the response writer does not support hijacking")
+	}
+
+	conn, readWriter, err := hijacker.Hijack()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := "HTTP/1.1 101 Switching Protocols\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: Upgrade\r\n" +
+		"Sec-WebSocket-Accept: " + webSocketAcceptKey(key) + "\r\n\r\n"
+	if _, err := readWriter.WriteString(response); err != nil {
+		_ = conn.Close()
+		return nil, nil, err
+	}
+	if err := readWriter.Flush(); err != nil {
+		_ = conn.Close()
+		return nil, nil, err
+	}
+
+	return conn, readWriter.Reader, nil
+}
+
+func webSocketAcceptKey(key string) string {
+	hash := sha1.Sum([]byte(key + webSocketGUID)) //nolint:gosec // Mandated by the WebSocket handshake
+	return base64.StdEncoding.EncodeToString(hash[:])
+}
+
+// headerHasToken is true when one of the comma-separated values of the header is token, ignoring case.
+func headerHasToken(header http.Header, name string, token string) bool {
+	for _, value := range header.Values(name) {
+		for _, field := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(field), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// webSocketTransport is an MCP transport over a WebSocket connection.
+// Each message is sent as a single text frame holding one JSON-RPC message.
+type webSocketTransport struct {
+	conn         net.Conn
+	reader       *bufio.Reader
+	sessionID    string
+	pingInterval time.Duration
+}
+
+// newWebSocketTransport serves the client connected with conn. Unless pingInterval is 0, the client is pinged at that
+// interval, and the connection is closed when the client sent nothing since the previous ping.
+func newWebSocketTransport(conn net.Conn, reader *bufio.Reader, sessionID string, pingInterval time.Duration) *webSocketTransport {
+	return &webSocketTransport{
+		conn:         conn,
+		reader:       reader,
+		sessionID:    sessionID,
+		pingInterval: pingInterval,
+	}
+}
+
+func (t *webSocketTransport) Connect(context.Context) (mcp.Connection, error) {
+	connection := &webSocketConnection{
+		conn:      t.conn,
+		reader:    t.reader,
+		sessionID: t.sessionID,
+		writeLock: new(sync.Mutex),
+		closed:    make(chan struct{}),
+		closeOnce: new(sync.Once),
+	}
+
+	if t.pingInterval > 0 {
+		go connection.keepAlive(t.pingInterval)
+	}
+
+	return connection, nil
+}
+
+type webSocketConnection struct {
+	conn      net.Conn
+	reader    *bufio.Reader
+	sessionID string
+	writeLock *sync.Mutex
+
+	// awaitingPong is true from each keepalive ping until the next frame of the client, whatever its kind.
+	awaitingPong atomic.Bool
+
+	closed    chan struct{}
+	closeOnce *sync.Once
+}
+
+type webSocketFrame struct {
+	fin     bool
+	opcode  byte
+	payload []byte
+}
+
+// webSocketProtocolError is a violation of the WebSocket protocol by the client, reported to it with code when closing.
+type webSocketProtocolError struct {
+	code    uint16
+	message string
+}
+
+func (e *webSocketProtocolError) Error() string {
+	return "WebSocket protocol error: " + e.message
+}
+
+// Read returns the next message of the client. Pings are answered while reading, and a close frame from the client
+// is acknowledged and reported as the end of the connection.
+func (c *webSocketConnection) Read(context.Context) (jsonrpc.Message, error) {
+	var message []byte
+	fragmented := false
+	for {
+		frame, err := c.readFrame(len(message))
+		if err != nil {
+			return nil, c.fail(err)
+		}
+
+		switch frame.opcode {
+		case webSocketOpPing:
+			if err := c.writeFrame(webSocketOpPong, frame.payload); err != nil {
+				return nil, err
+			}
+			continue
+		case webSocketOpPong:
+			continue
+		case webSocketOpClose:
+			_ = c.writeFrame(webSocketOpClose, frame.payload[:min(len(frame.payload), 2)])
+			return nil, io.EOF
+		case webSocketOpText, webSocketOpBinary:
+			if fragmented {
+				return nil, c.fail(&webSocketProtocolError{code: webSocketCloseProtocolError, message: "new message before the end of the previous one"})
+			}
+			message = frame.payload
+		case webSocketOpContinuation:
+			if !fragmented {
+				return nil, c.fail(&webSocketProtocolError{code: webSocketCloseProtocolError, message: "continuation frame without a message"})
+			}
+			message = append(message, frame.payload...)
+		default:
+			return nil, c.fail(&webSocketProtocolError{code: webSocketCloseProtocolError, message: fmt.Sprintf("unknown opcode %d", frame.opcode)})
+		}
+
+		fragmented = !frame.fin
+		if fragmented {
+			continue
+		}
+
+		message = bytes.TrimSpace(message)
+		if len(message) > 0 {
+			return jsonrpc.DecodeMessage(message)
+		}
+		message = nil
+	}
+}
+
+// readFrame reads the next frame of the client, and unmasks its payload. buffered is the size of the fragments of the
+// current message already read, so that the size of the whole message is limited.
+func (c *webSocketConnection) readFrame(buffered int) (webSocketFrame, error) {
+	var header [2]byte
+	if _, err := io.ReadFull(c.reader, header[:]); err != nil {
+		return webSocketFrame{}, err
+	}
+
+	// Any frame shows that the client is still there, not only the answers to the pings.
+	c.awaitingPong.Store(false)
+
+	frame := webSocketFrame{
+		fin:    header[0]&0x80 != 0,
+		opcode: header[0] & 0x0F,
+	}
+	if header[0]&0x70 != 0 {
+		return webSocketFrame{}, &webSocketProtocolError{code: webSocketCloseProtocolError, message: "reserved bits are set"}
+	}
+	if header[1]&0x80 == 0 {
+		return webSocketFrame{}, &webSocketProtocolError{code: webSocketCloseProtocolError, message: "frames from clients must be masked"}
+	}
+
+	length := uint64(header[1] & 0x7F)
+	switch length {
+	case 126:
+		var extended [2]byte
+		if _, err := io.ReadFull(c.reader, extended[:]); err != nil {
+			return webSocketFrame{}, err
+		}
+		length = uint64(binary.BigEndian.Uint16(extended[:]))
+	case 127:
+		var extended [8]byte
+		if _, err := io.ReadFull(c.reader, extended[:]); err != nil {
+			return webSocketFrame{}, err
+		}
+		length = binary.BigEndian.Uint64(extended[:])
+	}
+
+	if frame.opcode >= webSocketOpClose && (length > 125 || !frame.fin) {
+		return webSocketFrame{}, &webSocketProtocolError{code: webSocketCloseProtocolError, message: "invalid control frame"}
+	}
+	if length > uint64(maxWebSocketMessageBytes-buffered) {
+		return webSocketFrame{}, &webSocketProtocolError{code: webSocketCloseTooLarge, message: fmt.Sprintf("messages are limited to %d bytes", maxWebSocketMessageBytes)}
+	}
+
+	var mask [4]byte
+	if _, err := io.ReadFull(c.reader, mask[:]); err != nil {
+		return webSocketFrame{}, err
+	}
+
+	frame.payload = make([]byte, length)
+	if _, err := io.ReadFull(c.reader, frame.payload); err != nil {
+		return webSocketFrame{}, err
+	}
+	for i := range frame.payload {
+		frame.payload[i] ^= mask[i%4]
+	}
+
+	return frame, nil
+}
+
+// fail closes the connection with the code of err when it is a protocol error, and returns err.
+func (c *webSocketConnection) fail(err error) error {
+	var protocolErr *webSocketProtocolError
+	if errors.As(err, &protocolErr) {
+		_ = c.writeFrame(webSocketOpClose, binary.BigEndian.AppendUint16(nil, protocolErr.code))
+		_ = c.conn.Close()
+	}
+	return err
+}
+
+// writeFrame writes a single unmasked frame, as frames from the server are not masked.
+func (c *webSocketConnection) writeFrame(opcode byte, payload []byte) error {
+	frame := make([]byte, 0, len(payload)+10)
+	frame = append(frame, 0x80|opcode)
+	switch {
+	case len(payload) < 126:
+		frame = append(frame, byte(len(payload)))
+	case len(payload) <= 0xFFFF:
+		frame = append(frame, 126)
+		frame = binary.BigEndian.AppendUint16(frame, uint16(len(payload)))
+	default:
+		frame = append(frame, 127)
+		frame = binary.BigEndian.AppendUint64(frame, uint64(len(payload)))
+	}
+	frame = append(frame, payload...)
+
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+
+	_, err := c.conn.Write(frame)
+	return err
+}
+
+func (c *webSocketConnection) Write(_ context.Context, message jsonrpc.Message) error {
+	data, err := jsonrpc.EncodeMessage(message)
+	if err != nil {
+		return err
+	}
+
+	return c.writeFrame(webSocketOpText, data)
+}
+
+// Close sends a close frame to the client before closing the connection, so that it knows the server closed it on purpose.
+func (c *webSocketConnection) Close() error {
+	c.closeOnce.Do(func() { close(c.closed) })
+	_ = c.writeFrame(webSocketOpClose, binary.BigEndian.AppendUint16(nil, webSocketCloseNormal))
+	return c.conn.Close()
+}
+
+// keepAlive pings the client at interval, so that the proxies between the client and the server keep an idle
+// connection open, and closes the connection when the client sent nothing since the previous ping, as it is gone.
+// Closing the connection ends the read of the MCP session, which then ends like when the client disconnects.
+func (c *webSocketConnection) keepAlive(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.closed:
+			return
+		case <-ticker.C:
+		}
+
+		if c.awaitingPong.Load() {
+			_ = c.conn.Close()
+			return
+		}
+
+		c.awaitingPong.Store(true)
+		if err := c.writeFrame(webSocketOpPing, nil); err != nil {
+			_ = c.conn.Close()
+			return
+		}
+	}
+}
+
+func (c *webSocketConnection) SessionID() string {
+	return c.sessionID
+}
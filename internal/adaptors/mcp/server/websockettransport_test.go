@@ -0,0 +1,82 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server_test
+
+import (
+	"bufio"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebSocketConnection_KeepAlive_PingsClient(t *testing.T) {
+	// Arrange
+	serverConn, clientConn := net.Pipe()
+
+	connection, err := server.NewWebSocketTransport(serverConn, 10*time.Millisecond).Connect(t.Context())
+	require.NoError(t, err)
+	defer func() {
+		_ = clientConn.Close()
+		_ = connection.Close()
+	}()
+
+	readErrC := make(chan error, 1)
+	go func() {
+		_, err := connection.Read(t.Context())
+		readErrC <- err
+	}()
+
+	reader := bufio.NewReader(clientConn)
+
+	// Act
+	var opcodes []byte
+	for range 3 {
+		opcode, _ := readWebSocketFrame(t, reader)
+		opcodes = append(opcodes, opcode)
+		writeWebSocketFrame(t, clientConn, 0xA, nil)
+	}
+
+	// Assert
+	assert.Equal(t, []byte{0x9, 0x9, 0x9}, opcodes, "The server should keep pinging a client answering its pings")
+	select {
+	case err := <-readErrC:
+		t.Fatalf("the connection should stay open, but the read ended with %v", err)
+	default:
+	}
+}
+
+func TestWebSocketConnection_KeepAlive_ClosesUnresponsiveClient(t *testing.T) {
+	// Arrange
+	serverConn, clientConn := net.Pipe()
+
+	connection, err := server.NewWebSocketTransport(serverConn, 10*time.Millisecond).Connect(t.Context())
+	require.NoError(t, err)
+	defer func() {
+		_ = clientConn.Close()
+		_ = connection.Close()
+	}()
+
+	readErrC := make(chan error, 1)
+	go func() {
+		_, err := connection.Read(t.Context())
+		readErrC <- err
+	}()
+
+	reader := bufio.NewReader(clientConn)
+
+	// Act
+	opcode, _ := readWebSocketFrame(t, reader)
+
+	// Assert
+	assert.Equal(t, byte(0x9), opcode)
+	select {
+	case err := <-readErrC:
+		require.Error(t, err, "The connection of a client not answering the ping should be closed")
+	case <-time.After(5 * time.Second):
+		t.Fatal("the connection of a client not answering the ping was not closed")
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package serverlauncher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const clientName = "matlab-mcp-core-server-replay"
+
+// mcpEndpointPath is the path the HTTP and WebSocket transports of the server serve MCP clients on.
+const mcpEndpointPath = "/mcp"
+
+const (
+	// listenTimeout bounds the time the server takes to listen on its address once started.
+	listenTimeout = 30 * time.Second
+
+	// connectRetryInterval is the time between the attempts to connect to a server which is not listening yet.
+	connectRetryInterval = 200 * time.Millisecond
+
+	// stopTimeout is the time the server is given to stop after it was interrupted, before it is killed.
+	stopTimeout = 30 * time.Second
+)
+
+type Config interface {
+	Version() string
+}
+
+type OSLayer interface {
+	Args() []string
+	Command(name string, arg ...string) osfacade.Cmd
+}
+
+// ServerLauncher starts a new instance of the MATLAB MCP Core Server in a child process, and connects to it as an MCP client.
+type ServerLauncher struct {
+	config  Config
+	osLayer OSLayer
+}
+
+func New(
+	config Config,
+	osLayer OSLayer,
+) *ServerLauncher {
+	return &ServerLauncher{
+		config:  config,
+		osLayer: osLayer,
+	}
+}
+
+// Launch starts the server with the given arguments. Closing the returned session stops the server.
+func (l *ServerLauncher) Launch(ctx context.Context, args []string) (*mcp.ClientSession, error) {
+	programPath := l.osLayer.Args()[0]
+	cmd := l.osLayer.Command(programPath, args...)
+
+	client := mcp.NewClient(&mcp.Implementation{Name: clientName, Version: l.config.Version()}, nil)
+	session, err := client.Connect(ctx, &mcp.CommandTransport{Command: cmd.Unwrap()}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start the MATLAB MCP Core Server: %w", err)
+	}
+
+	return session, nil
+}
+
+// LaunchServing starts the server with the given arguments, which make it serve MCP clients with transport on address,
+// and connects to it once it listens. The returned function stops the server, after the session is closed.
+func (l *ServerLauncher) LaunchServing(ctx context.Context, args []string, transport entities.Transport, address string) (*mcp.ClientSession, func(), error) {
+	var clientTransport mcp.Transport
+	switch transport {
+	case entities.TransportHTTP:
+		clientTransport = &mcp.StreamableClientTransport{Endpoint: "http://" + address + mcpEndpointPath}
+	case entities.TransportWebSocket:
+		clientTransport = &webSocketClientTransport{address: address}
+	default:
+		return nil, nil, fmt.Errorf("unsupported transport: %s", transport)
+	}
+
+	programPath := l.osLayer.Args()[0]
+	cmd := l.osLayer.Command(programPath, args...)
+	if err := cmd.Start(); err != nil {
+		return nil, nil, fmt.Errorf("failed to start the MATLAB MCP Core Server: %w", err)
+	}
+
+	process := cmd.Unwrap()
+	exited := make(chan error, 1)
+	go func() {
+		exited <- process.Wait()
+	}()
+
+	// The server is interrupted, so that it stops its MATLAB session, and killed if it does not stop in time.
+	// Interrupts are not supported on Windows, where the server is killed.
+	stop := func() {
+		if err := process.Process.Signal(os.Interrupt); err != nil {
+			_ = process.Process.Kill()
+		}
+		select {
+		case <-exited:
+		case <-time.After(stopTimeout):
+			_ = process.Process.Kill()
+			<-exited
+		}
+	}
+
+	client := mcp.NewClient(&mcp.Implementation{Name: clientName, Version: l.config.Version()}, nil)
+	deadline := time.After(listenTimeout)
+	for {
+		session, err := client.Connect(ctx, clientTransport, nil)
+		if err == nil {
+			return session, stop, nil
+		}
+
+		select {
+		case exitErr := <-exited:
+			exited <- exitErr
+			return nil, nil, fmt.Errorf("the MATLAB MCP Core Server stopped before serving on %s: %w", address, errors.Join(exitErr, err))
+		case <-deadline:
+			stop()
+			return nil, nil, fmt.Errorf("the MATLAB MCP Core Server did not serve on %s in %s: %w", address, listenTimeout, err)
+		case <-ctx.Done():
+			stop()
+			return nil, nil, ctx.Err()
+		case <-time.After(connectRetryInterval):
+		}
+	}
+}
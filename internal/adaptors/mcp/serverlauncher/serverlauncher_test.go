@@ -0,0 +1,118 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package serverlauncher_test
+
+import (
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/serverlauncher"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/serverlauncher"
+	osfacademocks "github.com/matlab/matlab-mcp-core-server/mocks/facades/osfacade"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerLauncher_Launch_StartError(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockCmd := &osfacademocks.MockCmd{}
+	defer mockCmd.AssertExpectations(t)
+
+	programPath := filepath.Join(t.TempDir(), "matlab-mcp-core-server")
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return([]string{programPath, "replay", "recording.jsonl"}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Command(programPath, []string{"--log-level=debug"}).
+		Return(mockCmd).
+		Once()
+
+	mockConfig.EXPECT().
+		Version().
+		Return("matlab-mcp-core-server v0.1.0").
+		Once()
+
+	mockCmd.EXPECT().
+		Unwrap().
+		Return(exec.Command(programPath, "--log-level=debug")).
+		Once()
+
+	launcher := serverlauncher.New(mockConfig, mockOSLayer)
+
+	// Act
+	session, err := launcher.Launch(t.Context(), []string{"--log-level=debug"})
+
+	// Assert
+	require.ErrorContains(t, err, "failed to start the MATLAB MCP Core Server")
+	assert.Nil(t, session)
+}
+
+func TestServerLauncher_LaunchServing_UnsupportedTransport(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	launcher := serverlauncher.New(mockConfig, mockOSLayer)
+
+	// Act
+	session, stop, err := launcher.LaunchServing(t.Context(), []string{"--transport=stdio"}, entities.TransportStdio, "localhost:9911")
+
+	// Assert
+	require.ErrorContains(t, err, "unsupported transport: stdio")
+	assert.Nil(t, session)
+	assert.Nil(t, stop)
+}
+
+func TestServerLauncher_LaunchServing_StartError(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockCmd := &osfacademocks.MockCmd{}
+	defer mockCmd.AssertExpectations(t)
+
+	programPath := filepath.Join(t.TempDir(), "matlab-mcp-core-server")
+	args := []string{"--transport=http", "--listen=localhost:9911"}
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return([]string{programPath, "selftest", "serve"}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Command(programPath, args).
+		Return(mockCmd).
+		Once()
+
+	mockCmd.EXPECT().
+		Start().
+		Return(assert.AnError).
+		Once()
+
+	launcher := serverlauncher.New(mockConfig, mockOSLayer)
+
+	// Act
+	session, stop, err := launcher.LaunchServing(t.Context(), args, entities.TransportHTTP, "localhost:9911")
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, session)
+	assert.Nil(t, stop)
+}
@@ -0,0 +1,212 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package serverlauncher
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // SHA-1 is mandated by the WebSocket handshake, it is not used for security
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+
+	"github.com/modelcontextprotocol/go-sdk/jsonrpc"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// webSocketGUID is appended to the key of the client to compute the accept key of the handshake, as set by RFC 6455.
+const webSocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+const (
+	webSocketOpContinuation byte = 0x0
+	webSocketOpText         byte = 0x1
+	webSocketOpClose        byte = 0x8
+	webSocketOpPing         byte = 0x9
+	webSocketOpPong         byte = 0xA
+)
+
+// webSocketClientTransport connects to the WebSocket transport of the server, listening on address.
+// Each message is sent as a single text frame holding one JSON-RPC message, as the server does.
+type webSocketClientTransport struct {
+	address string
+}
+
+func (t *webSocketClientTransport) Connect(ctx context.Context) (mcp.Connection, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", t.address)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := webSocketHandshake(conn, t.address)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	return &webSocketClientConnection{
+		conn:      conn,
+		reader:    reader,
+		writeLock: new(sync.Mutex),
+	}, nil
+}
+
+func webSocketHandshake(conn net.Conn, address string) (*bufio.Reader, error) {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	key := base64.StdEncoding.EncodeToString(nonce)
+
+	request := "GET " + mcpEndpointPath + " HTTP/1.1\r\n" +
+		"Host: " + address + "\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: Upgrade\r\n" +
+		"Sec-WebSocket-Key: " + key + "\r\n" +
+		"Sec-WebSocket-Version: 13\r\n\r\n"
+	if _, err := io.WriteString(conn, request); err != nil {
+		return nil, err
+	}
+
+	reader := bufio.NewReader(conn)
+	response, err := http.ReadResponse(reader, nil)
+	if err != nil {
+		return nil, err
+	}
+	_ = response.Body.Close()
+
+	if response.StatusCode != http.StatusSwitchingProtocols {
+		return nil, fmt.Errorf("the WebSocket handshake failed: %s", response.Status)
+	}
+
+	hash := sha1.Sum([]byte(key + webSocketGUID)) //nolint:gosec // Mandated by the WebSocket handshake
+	if response.Header.Get("Sec-WebSocket-Accept") != base64.StdEncoding.EncodeToString(hash[:]) {
+		return nil, errors.New("the WebSocket handshake failed: invalid accept key")
+	}
+
+	return reader, nil
+}
+
+type webSocketClientConnection struct {
+	conn      net.Conn
+	reader    *bufio.Reader
+	writeLock *sync.Mutex
+}
+
+// Read returns the next message of the server. Pings are answered while reading, and a close frame is reported as the
+// end of the connection.
+func (c *webSocketClientConnection) Read(context.Context) (jsonrpc.Message, error) {
+	var message []byte
+	for {
+		fin, opcode, payload, err := c.readFrame()
+		if err != nil {
+			return nil, err
+		}
+
+		switch opcode {
+		case webSocketOpPing:
+			if err := c.writeFrame(webSocketOpPong, payload); err != nil {
+				return nil, err
+			}
+			continue
+		case webSocketOpPong:
+			continue
+		case webSocketOpClose:
+			return nil, io.EOF
+		case webSocketOpContinuation:
+			message = append(message, payload...)
+		default:
+			message = payload
+		}
+
+		if fin {
+			return jsonrpc.DecodeMessage(message)
+		}
+	}
+}
+
+// readFrame reads the next frame of the server, which is not masked.
+func (c *webSocketClientConnection) readFrame() (bool, byte, []byte, error) {
+	var header [2]byte
+	if _, err := io.ReadFull(c.reader, header[:]); err != nil {
+		return false, 0, nil, err
+	}
+
+	length := uint64(header[1] & 0x7F)
+	switch length {
+	case 126:
+		var extended [2]byte
+		if _, err := io.ReadFull(c.reader, extended[:]); err != nil {
+			return false, 0, nil, err
+		}
+		length = uint64(binary.BigEndian.Uint16(extended[:]))
+	case 127:
+		var extended [8]byte
+		if _, err := io.ReadFull(c.reader, extended[:]); err != nil {
+			return false, 0, nil, err
+		}
+		length = binary.BigEndian.Uint64(extended[:])
+	}
+
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(c.reader, payload); err != nil {
+		return false, 0, nil, err
+	}
+
+	return header[0]&0x80 != 0, header[0] & 0x0F, payload, nil
+}
+
+// writeFrame writes a single frame, masked as the frames of clients must be.
+func (c *webSocketClientConnection) writeFrame(opcode byte, payload []byte) error {
+	var mask [4]byte
+	if _, err := rand.Read(mask[:]); err != nil {
+		return err
+	}
+
+	frame := make([]byte, 0, len(payload)+14)
+	frame = append(frame, 0x80|opcode)
+	switch {
+	case len(payload) < 126:
+		frame = append(frame, 0x80|byte(len(payload)))
+	case len(payload) <= 0xFFFF:
+		frame = append(frame, 0x80|126)
+		frame = binary.BigEndian.AppendUint16(frame, uint16(len(payload)))
+	default:
+		frame = append(frame, 0x80|127)
+		frame = binary.BigEndian.AppendUint64(frame, uint64(len(payload)))
+	}
+	frame = append(frame, mask[:]...)
+	for i, b := range payload {
+		frame = append(frame, b^mask[i%4])
+	}
+
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+
+	_, err := c.conn.Write(frame)
+	return err
+}
+
+func (c *webSocketClientConnection) Write(_ context.Context, message jsonrpc.Message) error {
+	data, err := jsonrpc.EncodeMessage(message)
+	if err != nil {
+		return err
+	}
+
+	return c.writeFrame(webSocketOpText, data)
+}
+
+func (c *webSocketClientConnection) Close() error {
+	_ = c.writeFrame(webSocketOpClose, binary.BigEndian.AppendUint16(nil, 1000))
+	return c.conn.Close()
+}
+
+func (c *webSocketClientConnection) SessionID() string {
+	return ""
+}
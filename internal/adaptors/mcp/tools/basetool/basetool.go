@@ -3,8 +3,13 @@
 package basetool
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/correlationid"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/toolfailure"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -25,6 +30,9 @@ type tool[ToolInput any, ToolOutput any] struct {
 	description   string
 	loggerFactory LoggerFactory
 	toolAdder     ToolAdder[ToolInput, ToolOutput]
+
+	// inputSchema replaces the schema derived from ToolInput, for tools whose input is only known at run time.
+	inputSchema *jsonschema.Schema
 }
 
 func (t tool[_, _]) Name() string {
@@ -39,6 +47,36 @@ func (t tool[_, _]) Description() string {
 	return t.description
 }
 
-func (_ tool[ToolInput, _]) GetInputSchema() (any, error) {
+func (t tool[ToolInput, _]) GetInputSchema() (any, error) {
+	if t.inputSchema != nil {
+		return t.inputSchema, nil
+	}
 	return jsonschema.For[ToolInput](&jsonschema.ForOptions{})
 }
+
+// ToolCallFailed classifies the error returned by a handler, reports it as the structured failure of the tool call,
+// and returns the error to send to the client, prefixed with its error code.
+func ToolCallFailed(ctx context.Context, logger entities.Logger, message string, err error) error {
+	code := entities.ErrorCodeOf(err)
+	logger.With("error-code", code).WithError(err).Warn(message)
+
+	failure := toolfailure.Failure{
+		Code:    code,
+		Message: err.Error(),
+	}
+	err = fmt.Errorf("%s: %w", code, err)
+
+	if correlationID, ok := correlationid.FromContext(ctx); ok {
+		failure.CorrelationID = correlationID
+		err = withCorrelationID(err, correlationID)
+	}
+
+	toolfailure.Report(ctx, failure)
+	return err
+}
+
+// withCorrelationID appends the correlation ID to the error returned to the client,
+// so a failure reported by the client can be matched with the server logs.
+func withCorrelationID(err error, correlationID string) error {
+	return fmt.Errorf("%w (correlation ID: %s)", err, correlationID)
+}
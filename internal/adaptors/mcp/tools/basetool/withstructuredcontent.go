@@ -9,6 +9,8 @@ import (
 	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/mcpfacade"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/clientidentity"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/correlationid"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -70,6 +72,13 @@ func (t ToolWithStructuredContentOutput[ToolInput, ToolOutput]) Handler() mcp.To
 	return func(ctx context.Context, req *mcp.CallToolRequest, input ToolInput) (*mcp.CallToolResult, ToolOutput, error) {
 		logger := t.loggerFactory.NewMCPSessionLogger(req.Session).
 			With("tool-name", t.name)
+		correlationID, hasCorrelationID := correlationid.FromContext(ctx)
+		if hasCorrelationID {
+			logger = logger.With(correlationid.LogKey, correlationID)
+		}
+		if identity, ok := clientidentity.FromContext(ctx); ok {
+			logger = logger.With(clientidentity.UserLogKey, identity.User).With(clientidentity.ClientLogKey, identity.Client)
+		}
 		logger.Debug("Handling tool call request")
 		defer logger.Debug("Handled tool call request")
 
@@ -77,14 +86,12 @@ func (t ToolWithStructuredContentOutput[ToolInput, ToolOutput]) Handler() mcp.To
 
 		if t.structuredContentHandler == nil {
 			err := fmt.Errorf(UnexpectedErrorPrefixForLLM + "no structured handler available")
-			logger.WithError(err).Warn("Structured content handler is nil")
-			return nil, toolOutputZeroValue, err
+			return nil, toolOutputZeroValue, ToolCallFailed(ctx, logger, "Structured content handler is nil", err)
 		}
 
 		toolOutput, err := t.structuredContentHandler(ctx, logger, input)
 		if err != nil {
-			logger.WithError(err).Warn("Structured handler returned an error")
-			return nil, toolOutputZeroValue, err
+			return nil, toolOutputZeroValue, ToolCallFailed(ctx, logger, "Structured handler returned an error", err)
 		}
 		return nil, toolOutput, nil
 	}
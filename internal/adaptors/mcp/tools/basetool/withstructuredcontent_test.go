@@ -4,12 +4,15 @@ package basetool_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/correlationid"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/toolfailure"
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/stretchr/testify/assert"
@@ -275,3 +278,118 @@ func TestToolWithStructuredContentOutput_Handler_ContextPropagation(t *testing.T
 	require.NoError(t, err, "Handler should not return an error")
 	assert.Equal(t, t.Context(), capturedContext, "Context should be propagated to handler")
 }
+
+func TestToolWithStructuredContentOutput_Handler_CorrelationID(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockGlobalLogger := testutils.NewInspectableLogger()
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockGlobalLogger).
+		Once()
+
+	mockSession := &mcp.ServerSession{}
+
+	expectedInput := TestInput{Message: "test message"}
+	expectedError := assert.AnError
+	expectedCorrelationID := "some-correlation-id"
+
+	mockSessionLogger := testutils.NewInspectableLogger()
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mockSession).
+		Return(mockSessionLogger).
+		Once()
+
+	var capturedLogger entities.Logger
+	handler := func(ctx context.Context, logger entities.Logger, input TestInput) (TestOutput, error) {
+		capturedLogger = logger
+		return TestOutput{}, expectedError
+	}
+
+	tool := basetool.NewToolWithStructuredContent(
+		"test-tool",
+		"Test Tool",
+		"A test tool",
+		mockLoggerFactory,
+		handler,
+	)
+
+	req := &mcp.CallToolRequest{
+		Session: mockSession,
+	}
+
+	ctx := correlationid.NewContext(t.Context(), expectedCorrelationID)
+
+	// Act
+	_, _, err := tool.Handler()(ctx, req, expectedInput)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Contains(t, err.Error(), expectedCorrelationID, "Error should include the correlation ID")
+
+	inspectableLogger, ok := capturedLogger.(*testutils.InspectableLogger)
+	require.True(t, ok)
+	assert.Equal(t, expectedCorrelationID, inspectableLogger.Fields[correlationid.LogKey])
+}
+
+func TestToolWithStructuredContentOutput_Handler_ReportsErrorCode(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockGlobalLogger := testutils.NewInspectableLogger()
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockGlobalLogger).
+		Once()
+
+	mockSession := &mcp.ServerSession{}
+
+	mockSessionLogger := testutils.NewInspectableLogger()
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mockSession).
+		Return(mockSessionLogger).
+		Once()
+
+	expectedCorrelationID := "some-correlation-id"
+	expectedError := entities.NewCodedError(entities.ErrorCodeSessionNotFound, assert.AnError)
+
+	handler := func(ctx context.Context, logger entities.Logger, input TestInput) (TestOutput, error) {
+		return TestOutput{}, expectedError
+	}
+
+	tool := basetool.NewToolWithStructuredContent(
+		"test-tool",
+		"Test Tool",
+		"A test tool",
+		mockLoggerFactory,
+		handler,
+	)
+
+	req := &mcp.CallToolRequest{
+		Session: mockSession,
+	}
+
+	ctx := toolfailure.NewContext(correlationid.NewContext(t.Context(), expectedCorrelationID))
+
+	// Act
+	_, _, err := tool.Handler()(ctx, req, TestInput{Message: "test message"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.True(t, strings.HasPrefix(err.Error(), "SESSION_NOT_FOUND: "), "Error should start with the error code")
+
+	failure, ok := toolfailure.FromContext(ctx)
+	require.True(t, ok, "Failure should be reported")
+	assert.Equal(t, toolfailure.Failure{
+		Code:          entities.ErrorCodeSessionNotFound,
+		Message:       assert.AnError.Error(),
+		CorrelationID: expectedCorrelationID,
+	}, failure)
+
+	warnLogs := mockSessionLogger.WarnLogs()
+	require.Contains(t, warnLogs, "Structured handler returned an error")
+	assert.Equal(t, entities.ErrorCodeSessionNotFound, warnLogs["Structured handler returned an error"]["error-code"])
+}
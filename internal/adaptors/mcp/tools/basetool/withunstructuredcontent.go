@@ -4,11 +4,16 @@ package basetool
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/responseconverter"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/mcpfacade"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/clientidentity"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/correlationid"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -41,6 +46,13 @@ func NewToolWithUnstructuredContent[ToolInput any](
 	}
 }
 
+// WithInputSchema returns the tool with an input schema given at run time, such as the schema of a plugin tool, instead
+// of the schema derived from ToolInput.
+func (t ToolWithUnstructuredContentOutput[ToolInput]) WithInputSchema(inputSchema *jsonschema.Schema) ToolWithUnstructuredContentOutput[ToolInput] {
+	t.inputSchema = inputSchema
+	return t
+}
+
 func (t ToolWithUnstructuredContentOutput[_]) AddToServer(server *mcp.Server) error {
 	inputSchema, err := t.GetInputSchema()
 	if err != nil {
@@ -66,24 +78,56 @@ func (t ToolWithUnstructuredContentOutput[ToolInput]) Handler() mcp.ToolHandlerF
 	return func(ctx context.Context, req *mcp.CallToolRequest, input ToolInput) (*mcp.CallToolResult, any, error) {
 		logger := t.loggerFactory.NewMCPSessionLogger(req.Session).
 			With("tool-name", t.name)
+		correlationID, hasCorrelationID := correlationid.FromContext(ctx)
+		if hasCorrelationID {
+			logger = logger.With(correlationid.LogKey, correlationID)
+		}
+		if identity, ok := clientidentity.FromContext(ctx); ok {
+			logger = logger.With(clientidentity.UserLogKey, identity.User).With(clientidentity.ClientLogKey, identity.Client)
+		}
 		logger.Debug("Handling tool call request")
 		defer logger.Debug("Handled tool call request")
 
 		if t.unstructuredContentHandler == nil {
 			err := fmt.Errorf(UnexpectedErrorPrefixForLLM + "no unstructured handler available")
-			logger.WithError(err).Warn("Unstructured content handler is nil")
-			return nil, nil, err
+			return nil, nil, ToolCallFailed(ctx, logger, "Unstructured content handler is nil", err)
 		}
 
 		richContent, err := t.unstructuredContentHandler(ctx, logger, input)
 		if err != nil {
-			logger.WithError(err).Warn("Unstructured handler returned an error")
+			err = ToolCallFailed(ctx, logger, "Unstructured handler returned an error", err)
+			if partialContent, ok := partialContentOf(err); ok {
+				return partialContentResult(err, partialContent), nil, nil
+			}
 			return nil, nil, err
 		}
 		return richContentToUnstructuredContent(richContent), nil, nil
 	}
 }
 
+// partialContentOf returns the output produced by a MATLAB call before it exceeded a resource limit, if any.
+func partialContentOf(err error) (tools.RichContent, bool) {
+	var limitErr *entities.LimitExceededError
+	if !errors.As(err, &limitErr) {
+		return tools.RichContent{}, false
+	}
+
+	partial := limitErr.Partial
+	if partial.ConsoleOutput == "" && len(partial.Images) == 0 {
+		return tools.RichContent{}, false
+	}
+
+	return responseconverter.ConvertEvalResponseToRichContent(partial), true
+}
+
+// partialContentResult is a failed result holding the error, followed by the partial content.
+func partialContentResult(err error, partialContent tools.RichContent) *mcp.CallToolResult {
+	result := richContentToUnstructuredContent(partialContent)
+	result.IsError = true
+	result.Content = append([]mcp.Content{&mcp.TextContent{Text: err.Error()}}, result.Content...)
+	return result
+}
+
 func richContentToUnstructuredContent(content tools.RichContent) *mcp.CallToolResult {
 	unstructuredContent := &mcp.CallToolResult{
 		Content: []mcp.Content{},
@@ -97,6 +141,19 @@ func richContentToUnstructuredContent(content tools.RichContent) *mcp.CallToolRe
 			Data:     base64ImageData,
 		})
 	}
+	for _, svgImageData := range content.SVGImageContent {
+		unstructuredContent.Content = append(unstructuredContent.Content, &mcp.ImageContent{
+			MIMEType: "image/svg+xml",
+			Data:     svgImageData,
+		})
+	}
+	for _, link := range content.ResourceLinks {
+		unstructuredContent.Content = append(unstructuredContent.Content, &mcp.ResourceLink{
+			URI:      link.URI,
+			Name:     link.Name,
+			MIMEType: link.MIMEType,
+		})
+	}
 
 	return unstructuredContent
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/correlationid"
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/stretchr/testify/assert"
@@ -126,6 +127,71 @@ func TestToolWithUnstructuredContentOutput_AddToServer_HappyPath(t *testing.T) {
 	require.NoError(t, err, "AddToServer should not return an error")
 }
 
+func TestToolWithUnstructuredContentOutput_WithInputSchema_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockAdder := &mocks.MockToolAdder[map[string]any, any]{}
+	defer mockAdder.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	server := mcp.NewServer(&mcp.Implementation{}, &mcp.ServerOptions{})
+
+	const (
+		toolName        = "test-plugin-tool"
+		toolTitle       = "Test Plugin Tool"
+		toolDescription = "A test tool with an input schema given at run time"
+	)
+
+	expectedInputSchema := &jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"ticket": {Type: "string"},
+		},
+		Required: []string{"ticket"},
+	}
+
+	handler := func(ctx context.Context, logger entities.Logger, input map[string]any) (tools.RichContent, error) {
+		return tools.RichContent{}, nil
+	}
+
+	tool := basetool.NewToolWithUnstructuredContent(
+		toolName,
+		toolTitle,
+		toolDescription,
+		mockLoggerFactory,
+		handler,
+	).WithInputSchema(expectedInputSchema)
+
+	mockAdder.EXPECT().AddTool(
+		server,
+		&mcp.Tool{
+			Name:         toolName,
+			Title:        toolTitle,
+			Description:  toolDescription,
+			InputSchema:  expectedInputSchema,
+			OutputSchema: nil,
+		},
+		mock.Anything,
+	)
+
+	tool.SetToolAdder(mockAdder)
+
+	// Act
+	err := tool.AddToServer(server)
+
+	// Assert
+	require.NoError(t, err, "AddToServer should not return an error")
+	inputSchema, err := tool.GetInputSchema()
+	require.NoError(t, err)
+	assert.Same(t, expectedInputSchema, inputSchema, "Input schema should be the one given")
+}
+
 func TestToolWithUnstructuredContentOutput_Handler_HappyPath(t *testing.T) {
 	// Arrange
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
@@ -307,6 +373,116 @@ func TestToolWithUnstructuredContentOutput_Handler_ImageContentOnly(t *testing.T
 	assert.Equal(t, []byte(expectedRichContent.ImageContent[1]), imageContent2.Data, "Second image data should match")
 }
 
+func TestToolWithUnstructuredContentOutput_Handler_SVGImageContent(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockSession := &mcp.ServerSession{}
+
+	expectedInput := TestUnstructuredInput{Query: "test query"}
+	expectedRichContent := tools.RichContent{
+		SVGImageContent: []tools.SVGImageData{[]byte("<svg/>")},
+	}
+
+	mockSessionLogger := testutils.NewInspectableLogger()
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mockSession).
+		Return(mockSessionLogger).
+		Once()
+
+	handler := func(ctx context.Context, logger entities.Logger, input TestUnstructuredInput) (tools.RichContent, error) {
+		return expectedRichContent, nil
+	}
+
+	tool := basetool.NewToolWithUnstructuredContent(
+		"test-tool",
+		"Test Tool",
+		"A test tool",
+		mockLoggerFactory,
+		handler,
+	)
+
+	req := &mcp.CallToolRequest{
+		Session: mockSession,
+	}
+
+	// Act
+	result, _, err := tool.Handler()(t.Context(), req, expectedInput)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	require.Len(t, result.Content, 1, "Should have 1 content item")
+
+	imageContent, ok := result.Content[0].(*mcp.ImageContent)
+	require.True(t, ok, "Content should be image content")
+	assert.Equal(t, "image/svg+xml", imageContent.MIMEType, "Image MIME type should be SVG")
+	assert.Equal(t, []byte("<svg/>"), imageContent.Data, "Image data should match")
+}
+
+func TestToolWithUnstructuredContentOutput_Handler_ResourceLinks(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockSession := &mcp.ServerSession{}
+
+	expectedInput := TestUnstructuredInput{Query: "test query"}
+	expectedRichContent := tools.RichContent{
+		TextContent: []string{"ans = 1"},
+		ResourceLinks: []tools.ResourceLink{
+			{URI: "matlab://figures/1", Name: "Figure 1", MIMEType: "image/png"},
+		},
+	}
+
+	mockSessionLogger := testutils.NewInspectableLogger()
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mockSession).
+		Return(mockSessionLogger).
+		Once()
+
+	handler := func(ctx context.Context, logger entities.Logger, input TestUnstructuredInput) (tools.RichContent, error) {
+		return expectedRichContent, nil
+	}
+
+	tool := basetool.NewToolWithUnstructuredContent(
+		"test-tool",
+		"Test Tool",
+		"A test tool",
+		mockLoggerFactory,
+		handler,
+	)
+
+	req := &mcp.CallToolRequest{
+		Session: mockSession,
+	}
+
+	// Act
+	result, _, err := tool.Handler()(t.Context(), req, expectedInput)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	require.Len(t, result.Content, 2, "Should have 2 content items")
+
+	resourceLink, ok := result.Content[1].(*mcp.ResourceLink)
+	require.True(t, ok, "Second content should be a resource link")
+	assert.Equal(t, "matlab://figures/1", resourceLink.URI)
+	assert.Equal(t, "Figure 1", resourceLink.Name)
+	assert.Equal(t, "image/png", resourceLink.MIMEType)
+}
+
 func TestToolWithUnstructuredContentOutput_Handler_NoContent(t *testing.T) {
 	// Arrange
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
@@ -405,6 +581,114 @@ func TestToolWithUnstructuredContentOutput_Handler_UnstructuredHandlerError(t *t
 	assert.Nil(t, output, "Output should be nil when error occurs")
 }
 
+func TestToolWithUnstructuredContentOutput_Handler_LimitExceededReturnsPartialOutput(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockSession := &mcp.ServerSession{}
+
+	mockSessionLogger := testutils.NewInspectableLogger()
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mockSession).
+		Return(mockSessionLogger).
+		Once()
+
+	limitErr := entities.NewLimitExceededError(entities.ResourceLimitWallTime, "30s", entities.EvalResponse{
+		ConsoleOutput: "iteration 1",
+		Images:        [][]byte{[]byte("figure")},
+	})
+
+	handler := func(ctx context.Context, logger entities.Logger, input TestUnstructuredInput) (tools.RichContent, error) {
+		return tools.RichContent{}, limitErr
+	}
+
+	tool := basetool.NewToolWithUnstructuredContent(
+		"test-tool",
+		"Test Tool",
+		"A test tool",
+		mockLoggerFactory,
+		handler,
+	)
+
+	req := &mcp.CallToolRequest{
+		Session: mockSession,
+	}
+
+	// Act
+	result, output, err := tool.Handler()(t.Context(), req, TestUnstructuredInput{})
+
+	// Assert
+	require.NoError(t, err, "The failure should be returned in the result, with the partial output")
+	assert.Nil(t, output)
+	require.NotNil(t, result)
+	assert.True(t, result.IsError)
+	require.Len(t, result.Content, 3)
+
+	errorContent, ok := result.Content[0].(*mcp.TextContent)
+	require.True(t, ok)
+	assert.Equal(t, "LIMIT_EXCEEDED: the MATLAB call exceeded the wall time limit of 30s and was interrupted", errorContent.Text)
+
+	outputContent, ok := result.Content[1].(*mcp.TextContent)
+	require.True(t, ok)
+	assert.Equal(t, "iteration 1", outputContent.Text)
+
+	imageContent, ok := result.Content[2].(*mcp.ImageContent)
+	require.True(t, ok)
+	assert.Equal(t, []byte("figure"), imageContent.Data)
+}
+
+func TestToolWithUnstructuredContentOutput_Handler_LimitExceededWithoutPartialOutput(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockSession := &mcp.ServerSession{}
+
+	mockSessionLogger := testutils.NewInspectableLogger()
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mockSession).
+		Return(mockSessionLogger).
+		Once()
+
+	limitErr := entities.NewLimitExceededError(entities.ResourceLimitWallTime, "30s", entities.EvalResponse{})
+
+	handler := func(ctx context.Context, logger entities.Logger, input TestUnstructuredInput) (tools.RichContent, error) {
+		return tools.RichContent{}, limitErr
+	}
+
+	tool := basetool.NewToolWithUnstructuredContent(
+		"test-tool",
+		"Test Tool",
+		"A test tool",
+		mockLoggerFactory,
+		handler,
+	)
+
+	req := &mcp.CallToolRequest{
+		Session: mockSession,
+	}
+
+	// Act
+	result, _, err := tool.Handler()(t.Context(), req, TestUnstructuredInput{})
+
+	// Assert
+	require.ErrorIs(t, err, limitErr)
+	assert.Nil(t, result)
+}
+
 func TestToolWithUnstructuredContentOutput_Handler_ContextPropagation(t *testing.T) {
 	// Arrange
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
@@ -454,3 +738,58 @@ func TestToolWithUnstructuredContentOutput_Handler_ContextPropagation(t *testing
 	require.NoError(t, err, "Handler should not return an error")
 	assert.Equal(t, t.Context(), <-contextReceived, "Context should be propagated to handler")
 }
+
+func TestToolWithUnstructuredContentOutput_Handler_CorrelationID(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockSession := &mcp.ServerSession{}
+
+	expectedInput := TestUnstructuredInput{Query: "test query"}
+	expectedError := assert.AnError
+	expectedCorrelationID := "some-correlation-id"
+
+	mockSessionLogger := testutils.NewInspectableLogger()
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mockSession).
+		Return(mockSessionLogger).
+		Once()
+
+	var capturedLogger entities.Logger
+	handler := func(ctx context.Context, logger entities.Logger, input TestUnstructuredInput) (tools.RichContent, error) {
+		capturedLogger = logger
+		return tools.RichContent{}, expectedError
+	}
+
+	tool := basetool.NewToolWithUnstructuredContent(
+		"test-tool",
+		"Test Tool",
+		"A test tool",
+		mockLoggerFactory,
+		handler,
+	)
+
+	req := &mcp.CallToolRequest{
+		Session: mockSession,
+	}
+
+	ctx := correlationid.NewContext(t.Context(), expectedCorrelationID)
+
+	// Act
+	_, _, err := tool.Handler()(ctx, req, expectedInput)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Contains(t, err.Error(), expectedCorrelationID, "Error should include the correlation ID")
+
+	inspectableLogger, ok := capturedLogger.(*testutils.InspectableLogger)
+	require.True(t, ok)
+	assert.Equal(t, expectedCorrelationID, inspectableLogger.Fields[correlationid.LogKey])
+}
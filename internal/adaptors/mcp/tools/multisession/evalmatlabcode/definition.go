@@ -5,11 +5,13 @@ package evalmatlabcode
 const (
 	name        = "eval_in_matlab_session"
 	title       = "Evaluate MATLAB Code in a MATLAB Session"
-	description = "Evaluate arbitrary MATLAB code (`code`) within a specified project directory (`project_path`) context in an existing MATLAB session, given its session ID (`session_id`)."
+	description = "Evaluate arbitrary MATLAB code (`code`) within a specified project directory (`project_path`) context in an existing MATLAB session, given its name or ID (`session`), or its session ID (`session_id`)."
 )
 
 type Args struct {
-	SessionID   int    `json:"session_id"   jsonschema:"The ID of the MATLAB session in which to evaluate the code."`
-	ProjectPath string `json:"project_path" jsonschema:"The full path to the project directory - Becomes MATLAB's working directory during execution - Folder must exist - Example: C:\\Users\\username\\matlab-project or /home/user/research."`
-	Code        string `json:"code"         jsonschema:"The MATLAB code to evaluate."`
+	SessionID   int    `json:"session_id,omitempty" jsonschema:"The ID of the MATLAB session in which to evaluate the code - Ignored when session is set."`
+	Session     string `json:"session,omitempty"    jsonschema:"The name or ID of the MATLAB session in which to evaluate the code - Example: analysis."`
+	ProjectPath string `json:"project_path"      jsonschema:"The full path to the project directory - Becomes MATLAB's working directory during execution - Folder must exist - Example: C:\\Users\\username\\matlab-project or /home/user/research."`
+	Code        string `json:"code"              jsonschema:"The MATLAB code to evaluate."`
+	DryRun      bool   `json:"dry_run,omitempty" jsonschema:"If true, the call is not run, and the result describes what it would do instead, such as the code it would run and the files, folders and MATLAB path it would change - Use it to propose changes for review. Defaults to false."`
 }
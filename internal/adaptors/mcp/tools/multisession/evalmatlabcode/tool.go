@@ -8,6 +8,7 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/responseconverter"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/sessionselector"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/evalmatlabcode"
 )
@@ -32,7 +33,10 @@ func New(
 
 func Handler(usecase Usecase, matlabManager entities.MATLABManager) basetool.HandlerWithUnstructuredContentOutput[Args] {
 	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (tools.RichContent, error) {
-		sessionID := entities.SessionID(inputs.SessionID)
+		sessionID, err := sessionselector.Select(ctx, sessionLogger, matlabManager, inputs.SessionID, inputs.Session)
+		if err != nil {
+			return tools.RichContent{}, err
+		}
 
 		sessionLogger = sessionLogger.With("session_id", sessionID)
 
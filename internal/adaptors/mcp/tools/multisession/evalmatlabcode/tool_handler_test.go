@@ -238,3 +238,60 @@ func TestTool_Handler_UsecaseReturnsEmptyResponse(t *testing.T) {
 	assert.Empty(t, result.TextContent[0], "Text content should be empty")
 	assert.Empty(t, result.ImageContent, "Image content should be empty")
 }
+
+func TestTool_Handler_SessionByName(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const sessionID = 2
+	const code = "x = 1"
+	const projectPath = "/some/path"
+
+	expectedResponse := entities.EvalResponse{
+		ConsoleOutput: "x = 1",
+	}
+
+	mockMATLABManager.EXPECT().
+		FindMATLABSession(ctx, mockLogger.AsMockArg(), "analysis").
+		Return(entities.SessionID(sessionID), nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), entities.SessionID(sessionID)).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(
+			ctx,
+			mockLogger.AsMockArg(),
+			mockMATLABSessionClient,
+			evalmatlabcodeusecase.Args{Code: code, ProjectPath: projectPath},
+		).
+		Return(expectedResponse, nil).
+		Once()
+
+	args := evalmatlabcode.Args{
+		Session:     "analysis",
+		Code:        code,
+		ProjectPath: projectPath,
+	}
+
+	// Act
+	result, err := evalmatlabcode.Handler(mockUsecase, mockMATLABManager)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	require.Len(t, result.TextContent, 1, "Should have one text content item")
+	assert.Equal(t, expectedResponse.ConsoleOutput, result.TextContent[0], "Text content should match")
+}
@@ -5,7 +5,7 @@ package listavailablematlabs
 const (
 	name        = "list_available_matlabs"
 	title       = "List Available MATLABs"
-	description = "List the installed MATLAB versions on the host and their root directories."
+	description = "List the installed MATLAB versions on the host and their root directories, found on the system PATH and in the standard installation folders."
 )
 
 type Args struct{}
@@ -0,0 +1,21 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package listmatlabsessions
+
+const (
+	name        = "list_matlab_sessions"
+	title       = "List MATLAB Sessions"
+	description = "List the running MATLAB sessions, with their session ID (`session_id`), their name (`name`) if they were given one, and their MATLAB root directory."
+)
+
+type Args struct{}
+
+type ReturnArgs struct {
+	Sessions []SessionInfo `json:"sessions" jsonschema:"The running MATLAB sessions, ordered by session ID."`
+}
+
+type SessionInfo struct {
+	SessionID  int    `json:"session_id"     jsonschema:"The ID of the MATLAB session."`
+	Name       string `json:"name,omitempty" jsonschema:"The name of the MATLAB session, if it was given one."`
+	MATLABRoot string `json:"matlab_root"    jsonschema:"The MATLAB installation root directory of the session."`
+}
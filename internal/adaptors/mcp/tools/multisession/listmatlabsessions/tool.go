@@ -0,0 +1,53 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package listmatlabsessions
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listmatlabsessions"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger) listmatlabsessions.ReturnArgs
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase)),
+	}
+}
+
+func Handler(usecase Usecase) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing list MATLAB sessions tool")
+		defer sessionLogger.Info("Done - Executing list MATLAB sessions tool")
+
+		sessions := usecase.Execute(ctx, sessionLogger)
+
+		return convertToAnnotatedEquivalentType(sessions), nil
+	}
+}
+
+func convertToAnnotatedEquivalentType(sessions listmatlabsessions.ReturnArgs) ReturnArgs {
+	convertedSessions := make([]SessionInfo, len(sessions))
+	for i, session := range sessions {
+		convertedSessions[i] = SessionInfo{
+			SessionID:  int(session.ID),
+			Name:       session.Name,
+			MATLABRoot: session.MATLABRoot,
+		}
+	}
+	return ReturnArgs{
+		Sessions: convertedSessions,
+	}
+}
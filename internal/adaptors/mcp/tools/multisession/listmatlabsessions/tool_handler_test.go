@@ -0,0 +1,86 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package listmatlabsessions_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/listmatlabsessions"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/multisession/listmatlabsessions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := listmatlabsessions.New(mockLoggerFactory, mockUsecase)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	ctx := t.Context()
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg()).
+		Return([]entities.SessionInfo{
+			{ID: 1, Name: "analysis", MATLABRoot: "/path/to/matlab/R2023a"},
+			{ID: 2, MATLABRoot: "/path/to/matlab/R2022b"},
+		}).
+		Once()
+
+	// Act
+	result, err := listmatlabsessions.Handler(mockUsecase)(ctx, mockLogger, listmatlabsessions.Args{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []listmatlabsessions.SessionInfo{
+		{SessionID: 1, Name: "analysis", MATLABRoot: "/path/to/matlab/R2023a"},
+		{SessionID: 2, MATLABRoot: "/path/to/matlab/R2022b"},
+	}, result.Sessions)
+}
+
+func TestTool_Handler_NoSessions(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	ctx := t.Context()
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg()).
+		Return([]entities.SessionInfo{}).
+		Once()
+
+	// Act
+	result, err := listmatlabsessions.Handler(mockUsecase)(ctx, mockLogger, listmatlabsessions.Args{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotNil(t, result.Sessions)
+	assert.Empty(t, result.Sessions)
+}
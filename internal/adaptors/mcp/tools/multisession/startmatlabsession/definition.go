@@ -5,16 +5,18 @@ package startmatlabsession
 const (
 	name        = "start_matlab_session"
 	title       = "Start MATLAB Session"
-	description = "Starts a new MATLAB session for the provided MATLAB root (`matlab_root`) and returns a session ID (`session_id`)."
+	description = "Starts a new MATLAB session for the provided MATLAB root (`matlab_root`) and returns a session ID (`session_id`). Give the session a name (`name`) to refer to it by name in the other tools, for example to keep a separate workspace for each task."
 )
 
 type Args struct {
-	MATLABRoot string `json:"matlab_root" jsonschema:"MATLAB root directory for session."`
+	MATLABRoot string `json:"matlab_root"    jsonschema:"MATLAB root directory for session."`
+	Name       string `json:"name,omitempty" jsonschema:"An optional name for the session, unique among the running sessions - Up to 64 letters, digits, '_', '.' and '-', starting with a letter - Example: analysis."`
 }
 
 type ReturnArgs struct {
 	ResponseText string `json:"response_text"  jsonschema:"A message indicating the result of the operation."`
 	SessionID    int    `json:"session_id"     jsonschema:"The ID of the newly started MATLAB session."`
+	Name         string `json:"name,omitempty" jsonschema:"The name of the newly started MATLAB session, if it was given one."`
 	VerOutput    string `json:"ver_output"     jsonschema:"Output of the ver command, listing installed MATLAB Toolboxes."`
 	AddOnsOutput string `json:"add_ons_output" jsonschema:"List of installed Add-Ons, other than MATLAB Toolboxes (e.g. Support Packages, community Add-Ons)."`
 }
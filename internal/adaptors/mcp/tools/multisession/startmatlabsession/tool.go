@@ -33,6 +33,7 @@ func Handler(usecase Usecase) basetool.HandlerWithStructuredContentOutput[Args,
 		defer sessionLogger.Info("Done - Executing Start MATLAB Session tool")
 
 		startSessionRequest := entities.LocalSessionDetails{
+			Name:       inputs.Name,
 			MATLABRoot: inputs.MATLABRoot,
 		}
 		response, err := usecase.Execute(ctx, sessionLogger, startSessionRequest)
@@ -40,14 +41,15 @@ func Handler(usecase Usecase) basetool.HandlerWithStructuredContentOutput[Args,
 			return ReturnArgs{}, err
 		}
 
-		return convertToAnnotatedEquivalentType(response), nil
+		return convertToAnnotatedEquivalentType(response, inputs.Name), nil
 	}
 }
 
-func convertToAnnotatedEquivalentType(response startmatlabsession.ReturnArgs) ReturnArgs {
+func convertToAnnotatedEquivalentType(response startmatlabsession.ReturnArgs, name string) ReturnArgs {
 	return ReturnArgs{
 		ResponseText: responseTextIfMATLABSessionStartedSuccesfully,
 		SessionID:    int(response.SessionID),
+		Name:         name,
 		VerOutput:    response.VerOutput,
 		AddOnsOutput: response.AddOnsOutput,
 	}
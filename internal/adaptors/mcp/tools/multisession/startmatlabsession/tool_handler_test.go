@@ -101,3 +101,33 @@ func TestTool_Handler_UsecaseError(t *testing.T) {
 	require.ErrorIs(t, err, expectedError, "Handler should return an error")
 	assert.Empty(t, result.ResponseText, "Response text should be empty on error")
 }
+
+func TestTool_Handler_Named(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	ctx := t.Context()
+	const matlabRoot = "/path/to/matlab"
+	const sessionName = "analysis"
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), entities.LocalSessionDetails{Name: sessionName, MATLABRoot: matlabRoot}).
+		Return(startmatlabsessionusecase.ReturnArgs{SessionID: 2}, nil).
+		Once()
+
+	args := startmatlabsession.Args{
+		MATLABRoot: matlabRoot,
+		Name:       sessionName,
+	}
+
+	// Act
+	result, err := startmatlabsession.Handler(mockUsecase)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.Equal(t, 2, result.SessionID, "Session ID should match")
+	assert.Equal(t, sessionName, result.Name, "Name should match")
+}
@@ -5,11 +5,13 @@ package stopmatlabsession
 const (
 	name        = "stop_matlab_session"
 	title       = "Stop MATLAB Session"
-	description = "Stops an existing MATLAB session, given its session ID (`session_id`)."
+	description = "Stops an existing MATLAB session, given its name or ID (`session`), or its session ID (`session_id`)."
 )
 
 type Args struct {
-	SessionID int `json:"session_id" jsonschema:"The ID of the MATLAB session to stop."`
+	SessionID int    `json:"session_id,omitempty" jsonschema:"The ID of the MATLAB session to stop - Ignored when session is set."`
+	Session   string `json:"session,omitempty"    jsonschema:"The name or ID of the MATLAB session to stop - Example: analysis."`
+	DryRun    bool   `json:"dry_run,omitempty"    jsonschema:"If true, the session is not stopped, and the result describes what the call would do instead. Defaults to false."`
 }
 
 type ReturnArgs struct {
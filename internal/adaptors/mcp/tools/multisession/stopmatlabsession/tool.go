@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/sessionselector"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 )
 
@@ -20,15 +21,21 @@ type Tool struct {
 func New(
 	loggerFactory basetool.LoggerFactory,
 	usecase Usecase,
+	matlabManager entities.MATLABManager,
 ) *Tool {
 	return &Tool{
-		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase)),
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase, matlabManager)),
 	}
 }
 
-func Handler(usecase Usecase) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+func Handler(usecase Usecase, matlabManager entities.MATLABManager) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
 	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
-		err := usecase.Execute(ctx, sessionLogger, entities.SessionID(inputs.SessionID))
+		sessionID, err := sessionselector.Select(ctx, sessionLogger, matlabManager, inputs.SessionID, inputs.Session)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		err = usecase.Execute(ctx, sessionLogger, sessionID)
 		if err != nil {
 			return ReturnArgs{}, err
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
 	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/multisession/stopmatlabsession"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -24,13 +25,16 @@ func TestNew_HappyPath(t *testing.T) {
 	mockUsecase := &mocks.MockUsecase{}
 	defer mockUsecase.AssertExpectations(t)
 
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
 	mockLoggerFactory.EXPECT().
 		GetGlobalLogger().
 		Return(mockLogger).
 		Once()
 
 	// Act
-	tool := stopmatlabsession.New(mockLoggerFactory, mockUsecase)
+	tool := stopmatlabsession.New(mockLoggerFactory, mockUsecase, mockMATLABManager)
 
 	// Assert
 	assert.NotNil(t, tool)
@@ -43,6 +47,9 @@ func TestTool_Handler_HappyPath(t *testing.T) {
 	mockUsecase := &mocks.MockUsecase{}
 	defer mockUsecase.AssertExpectations(t)
 
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
 	ctx := t.Context()
 	const sessionID = 3
 	mockUsecase.EXPECT().
@@ -55,7 +62,7 @@ func TestTool_Handler_HappyPath(t *testing.T) {
 	}
 
 	// Act
-	result, err := stopmatlabsession.Handler(mockUsecase)(ctx, mockLogger, args)
+	result, err := stopmatlabsession.Handler(mockUsecase, mockMATLABManager)(ctx, mockLogger, args)
 
 	// Assert
 	require.NoError(t, err, "Handler should not return an error")
@@ -69,6 +76,9 @@ func TestTool_Handler_UsecaseReturnsError(t *testing.T) {
 	mockUsecase := &mocks.MockUsecase{}
 	defer mockUsecase.AssertExpectations(t)
 
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
 	ctx := t.Context()
 	const sessionID = 3
 	expectedError := assert.AnError
@@ -82,9 +92,61 @@ func TestTool_Handler_UsecaseReturnsError(t *testing.T) {
 	}
 
 	// Act
-	result, err := stopmatlabsession.Handler(mockUsecase)(ctx, mockLogger, args)
+	result, err := stopmatlabsession.Handler(mockUsecase, mockMATLABManager)(ctx, mockLogger, args)
 
 	// Assert
 	require.ErrorIs(t, err, expectedError, "Handler should return an error")
 	assert.Empty(t, result.ResponseText, "Response text should be empty when there's an error")
 }
+
+func TestTool_Handler_SessionByName(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	ctx := t.Context()
+	const sessionID = 3
+	mockMATLABManager.EXPECT().
+		FindMATLABSession(ctx, mockLogger.AsMockArg(), "analysis").
+		Return(entities.SessionID(sessionID), nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), entities.SessionID(sessionID)).
+		Return(nil).
+		Once()
+
+	args := stopmatlabsession.Args{
+		Session: "analysis",
+	}
+
+	// Act
+	result, err := stopmatlabsession.Handler(mockUsecase, mockMATLABManager)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.NotEmpty(t, result.ResponseText, "Response text should not be empty")
+}
+
+func TestTool_Handler_NoSession(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	// Act
+	_, err := stopmatlabsession.Handler(mockUsecase, mockMATLABManager)(t.Context(), mockLogger, stopmatlabsession.Args{})
+
+	// Assert
+	require.Error(t, err)
+	assert.Equal(t, entities.ErrorCodeInvalidInput, entities.ErrorCodeOf(err))
+}
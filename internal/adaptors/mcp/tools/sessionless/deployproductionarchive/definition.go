@@ -0,0 +1,20 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package deployproductionarchive
+
+const (
+	name        = "deploy_production_archive"
+	title       = "Deploy Production Archive"
+	description = "Deploy a deployable archive (`archive_path`), as built by `package_production_archive`, to the MATLAB Production Server instance configured on the server, replacing the archive with the same name. The archive is copied to the auto_deploy folder of the instance, which deploys it within seconds: check it with `get_production_server_status`. Return where the archive was copied."
+)
+
+type Args struct {
+	ArchivePath string `json:"archive_path"      jsonschema:"The full absolute path to the deployable archive - Must be a .ctf file - Example: /home/user/pricing/pricing_archive/pricing.ctf."`
+	DryRun      bool   `json:"dry_run,omitempty" jsonschema:"If true, the call is not run, and the result describes what it would do instead - Use it to propose changes for review. Defaults to false."`
+}
+
+type ReturnArgs struct {
+	Archive      string `json:"archive"       jsonschema:"The name of the deployed archive, which is the first part of the URL of its functions."`
+	DeployedPath string `json:"deployed_path" jsonschema:"The path of the archive in the auto_deploy folder of the instance."`
+	Replaced     bool   `json:"replaced"      jsonschema:"Whether the archive replaced a deployed archive with the same name."`
+}
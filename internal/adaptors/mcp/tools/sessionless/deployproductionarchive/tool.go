@@ -0,0 +1,48 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package deployproductionarchive
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/deployproductionarchive"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, request deployproductionarchive.Args) (deployproductionarchive.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase)),
+	}
+}
+
+func Handler(usecase Usecase) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Deploy Production Archive tool")
+		defer sessionLogger.Info("Done - Executing Deploy Production Archive tool")
+
+		response, err := usecase.Execute(ctx, sessionLogger, deployproductionarchive.Args{
+			ArchivePath: inputs.ArchivePath,
+		})
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		return ReturnArgs{
+			Archive:      response.Archive,
+			DeployedPath: response.DeployedPath,
+			Replaced:     response.Replaced,
+		}, nil
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package deployproductionarchive_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/deployproductionarchive"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	deployproductionarchiveusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/deployproductionarchive"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/sessionless/deployproductionarchive"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := deployproductionarchive.New(mockLoggerFactory, mockUsecase)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	ctx := t.Context()
+	const archivePath = "/home/user/pricing/pricing_archive/pricing.ctf"
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), deployproductionarchiveusecase.Args{ArchivePath: archivePath}).
+		Return(deployproductionarchiveusecase.ReturnArgs{Archive: "pricing", DeployedPath: "/opt/mps/auto_deploy/pricing.ctf", Replaced: true}, nil).
+		Once()
+
+	// Act
+	result, err := deployproductionarchive.Handler(mockUsecase)(ctx, mockLogger, deployproductionarchive.Args{ArchivePath: archivePath})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, deployproductionarchive.ReturnArgs{
+		Archive:      "pricing",
+		DeployedPath: "/opt/mps/auto_deploy/pricing.ctf",
+		Replaced:     true,
+	}, result)
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	ctx := t.Context()
+	const archivePath = "/home/user/pricing/pricing_archive/pricing.ctf"
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), deployproductionarchiveusecase.Args{ArchivePath: archivePath}).
+		Return(deployproductionarchiveusecase.ReturnArgs{}, assert.AnError).
+		Once()
+
+	// Act
+	result, err := deployproductionarchive.Handler(mockUsecase)(ctx, mockLogger, deployproductionarchive.Args{ArchivePath: archivePath})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
@@ -0,0 +1,27 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package findmatlabdefinition
+
+const (
+	name        = "find_matlab_definition"
+	title       = "Find MATLAB Definition"
+	description = "Find where a MATLAB function, class or method (`name`) is defined in the MATLAB files of a project (`project_path`), without running MATLAB. Package functions are named with their package, as in `pkg.fn`, and methods with their class, as in `MyClass.method`. Returns the file, line and column of each definition, with its signature and help text, to navigate to it or show its documentation. Functions of MATLAB and its toolboxes are not found; use the `help` function in a MATLAB session for those."
+)
+
+type Args struct {
+	ProjectPath string `json:"project_path" jsonschema:"The full absolute path to the project folder to search - Example: C:\\Users\\username\\matlab\\project or /home/user/project."`
+	Name        string `json:"name"         jsonschema:"The name to find, qualified with its package or class if any - Example: myFunction, geometry.area or Account.deposit."`
+}
+
+type ReturnArgs struct {
+	Locations []Location `json:"locations" jsonschema:"The definitions found, empty when the name is not defined in the project."`
+}
+
+type Location struct {
+	FilePath  string `json:"file_path"      jsonschema:"The full absolute path to the file of the definition."`
+	Kind      string `json:"kind"           jsonschema:"The kind of definition: function, local function, nested function, class or method."`
+	Line      int    `json:"line"           jsonschema:"The line of the declaration, starting at 1."`
+	Column    int    `json:"column"         jsonschema:"The column of the declaration, starting at 1."`
+	Signature string `json:"signature"      jsonschema:"The declaration line."`
+	Help      string `json:"help,omitempty" jsonschema:"The help text of the definition, from the comments right after or before its declaration."`
+}
@@ -0,0 +1,61 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package findmatlabdefinition
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/findmatlabdefinition"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, request findmatlabdefinition.Args) (findmatlabdefinition.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase)),
+	}
+}
+
+func Handler(usecase Usecase) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing find MATLAB definition tool")
+		defer sessionLogger.Info("Done - Executing find MATLAB definition tool")
+
+		// Not returning nil for empty slices, to comply with MCP spec.
+		response := ReturnArgs{
+			Locations: []Location{},
+		}
+
+		result, err := usecase.Execute(ctx, sessionLogger, findmatlabdefinition.Args{
+			ProjectPath: inputs.ProjectPath,
+			Name:        inputs.Name,
+		})
+		if err != nil {
+			return response, err
+		}
+
+		for _, location := range result.Locations {
+			response.Locations = append(response.Locations, Location{
+				FilePath:  location.FilePath,
+				Kind:      string(location.Definition.Kind),
+				Line:      location.Definition.Line,
+				Column:    location.Definition.Column,
+				Signature: location.Definition.Signature,
+				Help:      location.Definition.Help,
+			})
+		}
+
+		return response, nil
+	}
+}
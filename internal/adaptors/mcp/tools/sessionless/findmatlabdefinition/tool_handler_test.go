@@ -0,0 +1,93 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package findmatlabdefinition_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/findmatlabdefinition"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	findmatlabdefinitionusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/findmatlabdefinition"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/mcode"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/sessionless/findmatlabdefinition"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := findmatlabdefinition.New(mockLoggerFactory, mockUsecase)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	ctx := t.Context()
+	args := findmatlabdefinition.Args{ProjectPath: "/home/user/project", Name: "geometry.area"}
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), findmatlabdefinitionusecase.Args{ProjectPath: args.ProjectPath, Name: args.Name}).
+		Return(findmatlabdefinitionusecase.ReturnArgs{
+			Locations: []findmatlabdefinitionusecase.Location{
+				{
+					FilePath:   "/home/user/project/+geometry/area.m",
+					Definition: mcode.Definition{Name: "area", Kind: mcode.DefinitionKindFunction, Line: 1, Column: 1, Signature: "function a = area(r)", Help: "AREA Area of a disk."},
+				},
+			},
+		}, nil).
+		Once()
+
+	// Act
+	result, err := findmatlabdefinition.Handler(mockUsecase)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []findmatlabdefinition.Location{
+		{FilePath: "/home/user/project/+geometry/area.m", Kind: "function", Line: 1, Column: 1, Signature: "function a = area(r)", Help: "AREA Area of a disk."},
+	}, result.Locations)
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	ctx := t.Context()
+	args := findmatlabdefinition.Args{ProjectPath: "/home/user/project", Name: "area"}
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), findmatlabdefinitionusecase.Args{ProjectPath: args.ProjectPath, Name: args.Name}).
+		Return(findmatlabdefinitionusecase.ReturnArgs{}, assert.AnError).
+		Once()
+
+	// Act
+	result, err := findmatlabdefinition.Handler(mockUsecase)(ctx, mockLogger, args)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.NotNil(t, result.Locations, "Locations should not be nil")
+}
@@ -0,0 +1,34 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getmatlabdiagnostics
+
+const (
+	name        = "get_matlab_code_diagnostics"
+	title       = "Get MATLAB Code Diagnostics"
+	description = "Analyze a MATLAB file (`script_path`) without running MATLAB, and return its syntax errors (blocks missing their end, unbalanced brackets, unterminated strings) and an outline of the functions, classes and methods it defines, with their signature and help text. This is fast enough to run after every edit, and does not need a MATLAB session; use check_matlab_code for MATLAB's own checkcode warnings."
+)
+
+type Args struct {
+	ScriptPath string `json:"script_path" jsonschema:"The full absolute path to the MATLAB file to analyze - Must be a .m file that exists - Example: C:\\Users\\username\\matlab\\myFunction.m or /home/user/scripts/analysis.m."`
+}
+
+type ReturnArgs struct {
+	Diagnostics []Diagnostic `json:"diagnostics" jsonschema:"The problems found in the file, empty when there are none."`
+	Definitions []Definition `json:"definitions" jsonschema:"The functions, classes and methods defined in the file, in order."`
+}
+
+type Diagnostic struct {
+	Line     int    `json:"line"     jsonschema:"The line of the problem, starting at 1."`
+	Column   int    `json:"column"   jsonschema:"The column of the problem, starting at 1."`
+	Severity string `json:"severity" jsonschema:"The severity of the problem: error or warning."`
+	Message  string `json:"message"  jsonschema:"The description of the problem."`
+}
+
+type Definition struct {
+	Name      string `json:"name"           jsonschema:"The name of the function, class or method."`
+	Kind      string `json:"kind"           jsonschema:"The kind of definition: function, local function, nested function, class or method."`
+	Line      int    `json:"line"           jsonschema:"The line of the declaration, starting at 1."`
+	Column    int    `json:"column"         jsonschema:"The column of the declaration, starting at 1."`
+	Signature string `json:"signature"      jsonschema:"The declaration line."`
+	Help      string `json:"help,omitempty" jsonschema:"The help text of the definition, from the comments right after or before its declaration."`
+}
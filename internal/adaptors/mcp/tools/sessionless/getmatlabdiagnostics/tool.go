@@ -0,0 +1,70 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getmatlabdiagnostics
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getmatlabdiagnostics"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, request getmatlabdiagnostics.Args) (getmatlabdiagnostics.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase)),
+	}
+}
+
+func Handler(usecase Usecase) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing get MATLAB code diagnostics tool")
+		defer sessionLogger.Info("Done - Executing get MATLAB code diagnostics tool")
+
+		// Not returning nil for empty slices, to comply with MCP spec.
+		response := ReturnArgs{
+			Diagnostics: []Diagnostic{},
+			Definitions: []Definition{},
+		}
+
+		analysis, err := usecase.Execute(ctx, sessionLogger, getmatlabdiagnostics.Args{
+			ScriptPath: inputs.ScriptPath,
+		})
+		if err != nil {
+			return response, err
+		}
+
+		for _, diagnostic := range analysis.Diagnostics {
+			response.Diagnostics = append(response.Diagnostics, Diagnostic{
+				Line:     diagnostic.Line,
+				Column:   diagnostic.Column,
+				Severity: string(diagnostic.Severity),
+				Message:  diagnostic.Message,
+			})
+		}
+
+		for _, definition := range analysis.Definitions {
+			response.Definitions = append(response.Definitions, Definition{
+				Name:      definition.Name,
+				Kind:      string(definition.Kind),
+				Line:      definition.Line,
+				Column:    definition.Column,
+				Signature: definition.Signature,
+				Help:      definition.Help,
+			})
+		}
+
+		return response, nil
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getmatlabdiagnostics_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/getmatlabdiagnostics"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	getmatlabdiagnosticsusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/getmatlabdiagnostics"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/mcode"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/sessionless/getmatlabdiagnostics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := getmatlabdiagnostics.New(mockLoggerFactory, mockUsecase)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	ctx := t.Context()
+	const scriptPath = "/home/user/project/analysis.m"
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), getmatlabdiagnosticsusecase.Args{ScriptPath: scriptPath}).
+		Return(getmatlabdiagnosticsusecase.ReturnArgs{
+			Diagnostics: []mcode.Diagnostic{
+				{Line: 3, Column: 1, Severity: mcode.SeverityError, Message: "'if' at line 3 is missing its 'end'."},
+			},
+			Definitions: []mcode.Definition{
+				{Name: "analysis", Kind: mcode.DefinitionKindFunction, Line: 1, Column: 1, Signature: "function analysis()", Help: "ANALYSIS Analyzes."},
+			},
+		}, nil).
+		Once()
+
+	// Act
+	result, err := getmatlabdiagnostics.Handler(mockUsecase)(ctx, mockLogger, getmatlabdiagnostics.Args{ScriptPath: scriptPath})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, getmatlabdiagnostics.ReturnArgs{
+		Diagnostics: []getmatlabdiagnostics.Diagnostic{
+			{Line: 3, Column: 1, Severity: "error", Message: "'if' at line 3 is missing its 'end'."},
+		},
+		Definitions: []getmatlabdiagnostics.Definition{
+			{Name: "analysis", Kind: "function", Line: 1, Column: 1, Signature: "function analysis()", Help: "ANALYSIS Analyzes."},
+		},
+	}, result)
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	ctx := t.Context()
+	const scriptPath = "/home/user/project/analysis.m"
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), getmatlabdiagnosticsusecase.Args{ScriptPath: scriptPath}).
+		Return(getmatlabdiagnosticsusecase.ReturnArgs{}, assert.AnError).
+		Once()
+
+	// Act
+	result, err := getmatlabdiagnostics.Handler(mockUsecase)(ctx, mockLogger, getmatlabdiagnostics.Args{ScriptPath: scriptPath})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.NotNil(t, result.Diagnostics, "Diagnostics should not be nil")
+	assert.NotNil(t, result.Definitions, "Definitions should not be nil")
+}
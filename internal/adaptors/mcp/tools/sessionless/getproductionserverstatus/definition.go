@@ -0,0 +1,28 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getproductionserverstatus
+
+const (
+	name        = "get_production_server_status"
+	title       = "Get Production Server Status"
+	description = "Report the health of the MATLAB Production Server instance configured on the server, from its health endpoint, and the archives deployed to it with their functions, when its discovery service is enabled. An instance that cannot be reached is reported as such."
+)
+
+type Args struct{}
+
+type ReturnArgs struct {
+	URL              string    `json:"url"                   jsonschema:"The URL of the instance."`
+	Reachable        bool      `json:"reachable"             jsonschema:"Whether the instance answered."`
+	Healthy          bool      `json:"healthy"               jsonschema:"Whether the health endpoint of the instance reported it healthy."`
+	HTTPStatus       int       `json:"http_status,omitempty" jsonschema:"The HTTP status of the health endpoint."`
+	Status           string    `json:"status,omitempty"      jsonschema:"The status reported by the health endpoint."`
+	Error            string    `json:"error,omitempty"       jsonschema:"Why the instance could not be reached, or its archives could not be listed."`
+	DiscoveryEnabled bool      `json:"discovery_enabled"     jsonschema:"Whether the discovery service of the instance is enabled, so that its archives are listed."`
+	Archives         []Archive `json:"archives"              jsonschema:"The archives deployed to the instance, when its discovery service is enabled."`
+}
+
+type Archive struct {
+	Name                 string   `json:"name"                             jsonschema:"The name of the archive."`
+	MATLABRuntimeVersion string   `json:"matlab_runtime_version,omitempty" jsonschema:"The version of MATLAB Runtime the archive runs on."`
+	Functions            []string `json:"functions"                        jsonschema:"The functions of the archive."`
+}
@@ -0,0 +1,60 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getproductionserverstatus
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getproductionserverstatus"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger) (getproductionserverstatus.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase)),
+	}
+}
+
+func Handler(usecase Usecase) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Get Production Server Status tool")
+		defer sessionLogger.Info("Done - Executing Get Production Server Status tool")
+
+		response, err := usecase.Execute(ctx, sessionLogger)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		archives := make([]Archive, 0, len(response.Archives))
+		for _, archive := range response.Archives {
+			archives = append(archives, Archive{
+				Name:                 archive.Name,
+				MATLABRuntimeVersion: archive.MATLABRuntimeVersion,
+				Functions:            archive.Functions,
+			})
+		}
+
+		return ReturnArgs{
+			URL:              response.URL,
+			Reachable:        response.Reachable,
+			Healthy:          response.Healthy,
+			HTTPStatus:       response.HTTPStatus,
+			Status:           response.Status,
+			Error:            response.Error,
+			DiscoveryEnabled: response.DiscoveryEnabled,
+			Archives:         archives,
+		}, nil
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getproductionserverstatus_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/getproductionserverstatus"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	getproductionserverstatususecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/getproductionserverstatus"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/productionserver"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/sessionless/getproductionserverstatus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := getproductionserverstatus.New(mockLoggerFactory, mockUsecase)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg()).
+		Return(getproductionserverstatususecase.ReturnArgs{
+			URL:              "http://mps.example.com:9910",
+			Reachable:        true,
+			Healthy:          true,
+			HTTPStatus:       200,
+			Status:           "ok",
+			DiscoveryEnabled: true,
+			Archives: []productionserver.Archive{
+				{Name: "pricing", MATLABRuntimeVersion: "R2025a", Functions: []string{"price"}},
+			},
+		}, nil).
+		Once()
+
+	// Act
+	result, err := getproductionserverstatus.Handler(mockUsecase)(ctx, mockLogger, getproductionserverstatus.Args{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, getproductionserverstatus.ReturnArgs{
+		URL:              "http://mps.example.com:9910",
+		Reachable:        true,
+		Healthy:          true,
+		HTTPStatus:       200,
+		Status:           "ok",
+		DiscoveryEnabled: true,
+		Archives: []getproductionserverstatus.Archive{
+			{Name: "pricing", MATLABRuntimeVersion: "R2025a", Functions: []string{"price"}},
+		},
+	}, result)
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg()).
+		Return(getproductionserverstatususecase.ReturnArgs{}, assert.AnError).
+		Once()
+
+	// Act
+	result, err := getproductionserverstatus.Handler(mockUsecase)(ctx, mockLogger, getproductionserverstatus.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
@@ -0,0 +1,28 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getserverinfo
+
+const (
+	name        = "server_info"
+	title       = "Server Info"
+	description = "Report the version of the MATLAB MCP Core Server and how it was built, the MCP protocol versions it supports, the MATLAB installations it found on the host, and the tool sets it exposes. Use it to check what the server can do, or to include the exact server setup in a bug report."
+)
+
+type Args struct{}
+
+type ReturnArgs struct {
+	Version          string            `json:"version"               jsonschema:"The version of the server."`
+	Commit           string            `json:"commit"                jsonschema:"The git commit the server was built from."`
+	BuildDate        string            `json:"build_date"            jsonschema:"The date the server was built."`
+	GoVersion        string            `json:"go_version"            jsonschema:"The Go version the server was built with."`
+	Platform         string            `json:"platform"              jsonschema:"The operating system and architecture the server runs on - Example: linux/amd64."`
+	ProtocolVersions []string          `json:"mcp_protocol_versions" jsonschema:"The versions of the Model Context Protocol the server supports, from the latest."`
+	MATLABs          []EnvironmentInfo `json:"matlabs"               jsonschema:"The MATLAB installations found on the host, on the system PATH and in the standard installation folders."`
+	ToolSets         []string          `json:"tool_sets"             jsonschema:"The groups of tools the server exposes - Example: matlab, python, simulink, code-analysis."`
+	ReadOnly         bool              `json:"read_only"             jsonschema:"True when the server only exposes the tools that neither run code nor modify files."`
+}
+
+type EnvironmentInfo struct {
+	Version    string `json:"version"     jsonschema:"The MATLAB version."`
+	MATLABRoot string `json:"matlab_root" jsonschema:"The MATLAB installation root directory."`
+}
@@ -0,0 +1,63 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getserverinfo
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getserverinfo"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger) getserverinfo.ReturnArgs
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase)),
+	}
+}
+
+func Handler(usecase Usecase) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing server info tool")
+		defer sessionLogger.Info("Done - Executing server info tool")
+
+		info := usecase.Execute(ctx, sessionLogger)
+
+		// Not returning nil for empty slices, to comply with MCP spec.
+		response := ReturnArgs{
+			Version:          info.BuildInfo.Version,
+			Commit:           info.BuildInfo.Commit,
+			BuildDate:        info.BuildInfo.BuildDate,
+			GoVersion:        info.BuildInfo.GoVersion,
+			Platform:         info.BuildInfo.OS + "/" + info.BuildInfo.Arch,
+			ProtocolVersions: append([]string{}, info.ProtocolVersions...),
+			MATLABs:          make([]EnvironmentInfo, 0, len(info.MATLABs)),
+			ToolSets:         make([]string, 0, len(info.ToolSets)),
+			ReadOnly:         info.ReadOnly,
+		}
+
+		for _, environment := range info.MATLABs {
+			response.MATLABs = append(response.MATLABs, EnvironmentInfo{
+				Version:    environment.Version,
+				MATLABRoot: environment.MATLABRoot,
+			})
+		}
+
+		for _, toolSet := range info.ToolSets {
+			response.ToolSets = append(response.ToolSets, string(toolSet))
+		}
+
+		return response, nil
+	}
+}
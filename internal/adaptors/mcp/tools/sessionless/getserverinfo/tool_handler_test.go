@@ -0,0 +1,111 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getserverinfo_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/getserverinfo"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	usecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/getserverinfo"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/sessionless/getserverinfo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := getserverinfo.New(mockLoggerFactory, mockUsecase)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	ctx := t.Context()
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg()).
+		Return(usecase.ReturnArgs{
+			BuildInfo: entities.BuildInfo{
+				Version:   "v25.6.68",
+				Commit:    "0123abcd",
+				BuildDate: "2025-06-01T09:00:00Z",
+				GoVersion: "go1.24.4",
+				OS:        "linux",
+				Arch:      "amd64",
+			},
+			ProtocolVersions: []string{"2025-06-18", "2024-11-05"},
+			MATLABs: []entities.EnvironmentInfo{
+				{MATLABRoot: "/usr/local/MATLAB/R2024a", Version: "R2024a"},
+			},
+			ToolSets: []entities.ToolSet{entities.ToolSetMATLAB, entities.ToolSetCodeAnalysis},
+			ReadOnly: true,
+		}).
+		Once()
+
+	// Act
+	result, err := getserverinfo.Handler(mockUsecase)(ctx, mockLogger, getserverinfo.Args{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, getserverinfo.ReturnArgs{
+		Version:          "v25.6.68",
+		Commit:           "0123abcd",
+		BuildDate:        "2025-06-01T09:00:00Z",
+		GoVersion:        "go1.24.4",
+		Platform:         "linux/amd64",
+		ProtocolVersions: []string{"2025-06-18", "2024-11-05"},
+		MATLABs: []getserverinfo.EnvironmentInfo{
+			{Version: "R2024a", MATLABRoot: "/usr/local/MATLAB/R2024a"},
+		},
+		ToolSets: []string{"matlab", "code-analysis"},
+		ReadOnly: true,
+	}, result)
+	assert.Len(t, mockLogger.InfoLogs(), 2, "Bounding info logs should be created")
+}
+
+func TestTool_Handler_NoMATLAB(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	ctx := t.Context()
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg()).
+		Return(usecase.ReturnArgs{}).
+		Once()
+
+	// Act
+	result, err := getserverinfo.Handler(mockUsecase)(ctx, mockLogger, getserverinfo.Args{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotNil(t, result.MATLABs, "MATLABs should be an empty slice, not nil")
+	assert.Empty(t, result.MATLABs)
+	assert.NotNil(t, result.ToolSets, "ToolSets should be an empty slice, not nil")
+	assert.NotNil(t, result.ProtocolVersions, "ProtocolVersions should be an empty slice, not nil")
+}
@@ -0,0 +1,27 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package invokeproductionfunction
+
+const (
+	name        = "invoke_production_function"
+	title       = "Invoke Production Function"
+	description = "Call a function (`function`) of an archive (`archive`) deployed to the MATLAB Production Server instance configured on the server, with test inputs (`inputs`), as a client application would, through the RESTful JSON API of the instance. Inputs and outputs use the JSON representation of MATLAB data of MATLAB Production Server: numbers, strings, booleans and arrays of them are written as JSON values. Return the outputs, or the MATLAB error the function threw."
+)
+
+type Args struct {
+	Archive    string `json:"archive"               jsonschema:"The name of the deployed archive - Example: pricing."`
+	Function   string `json:"function"              jsonschema:"The name of the function of the archive - Example: price."`
+	Inputs     []any  `json:"inputs,omitempty"      jsonschema:"The inputs of the function, in order - Example: [100, \"call\", [0.2, 0.3]]."`
+	NumOutputs int    `json:"num_outputs,omitempty" jsonschema:"The number of outputs to return. Defaults to 1 when not set."`
+	DryRun     bool   `json:"dry_run,omitempty"     jsonschema:"If true, the call is not run, and the result describes what it would do instead - Use it to propose changes for review. Defaults to false."`
+}
+
+type ReturnArgs struct {
+	Outputs []any        `json:"outputs"         jsonschema:"The outputs of the function, in order."`
+	Error   *MATLABError `json:"error,omitempty" jsonschema:"The MATLAB error the function threw, if it failed."`
+}
+
+type MATLABError struct {
+	ID      string `json:"id"      jsonschema:"The identifier of the MATLAB error."`
+	Message string `json:"message" jsonschema:"The message of the MATLAB error."`
+}
@@ -0,0 +1,85 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package invokeproductionfunction
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/invokeproductionfunction"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/productionserver"
+)
+
+const defaultNumOutputs = 1
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, request invokeproductionfunction.Args) (productionserver.InvocationResult, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase)),
+	}
+}
+
+func Handler(usecase Usecase) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Invoke Production Function tool")
+		defer sessionLogger.Info("Done - Executing Invoke Production Function tool")
+
+		functionInputs := make([]json.RawMessage, 0, len(inputs.Inputs))
+		for _, input := range inputs.Inputs {
+			data, err := json.Marshal(input)
+			if err != nil {
+				return ReturnArgs{}, entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("invalid input: %w", err))
+			}
+			functionInputs = append(functionInputs, data)
+		}
+
+		numOutputs := inputs.NumOutputs
+		if numOutputs == 0 {
+			numOutputs = defaultNumOutputs
+		}
+
+		response, err := usecase.Execute(ctx, sessionLogger, invokeproductionfunction.Args{
+			Archive:    inputs.Archive,
+			Function:   inputs.Function,
+			Inputs:     functionInputs,
+			NumOutputs: numOutputs,
+		})
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		outputs := make([]any, 0, len(response.Outputs))
+		for _, output := range response.Outputs {
+			var value any
+			if err := json.Unmarshal(output, &value); err != nil {
+				return ReturnArgs{}, fmt.Errorf("failed to parse output: %w", err)
+			}
+			outputs = append(outputs, value)
+		}
+
+		result := ReturnArgs{
+			Outputs: outputs,
+		}
+		if response.Error != nil {
+			result.Error = &MATLABError{
+				ID:      response.Error.ID,
+				Message: response.Error.Message,
+			}
+		}
+
+		return result, nil
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package invokeproductionfunction_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/invokeproductionfunction"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	invokeproductionfunctionusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/invokeproductionfunction"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/productionserver"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/sessionless/invokeproductionfunction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := invokeproductionfunction.New(mockLoggerFactory, mockUsecase)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), invokeproductionfunctionusecase.Args{
+			Archive:    "pricing",
+			Function:   "price",
+			Inputs:     []json.RawMessage{json.RawMessage(`100`), json.RawMessage(`"call"`)},
+			NumOutputs: 1,
+		}).
+		Return(productionserver.InvocationResult{Outputs: []json.RawMessage{json.RawMessage(`12.5`)}}, nil).
+		Once()
+
+	// Act
+	result, err := invokeproductionfunction.Handler(mockUsecase)(ctx, mockLogger, invokeproductionfunction.Args{
+		Archive:  "pricing",
+		Function: "price",
+		Inputs:   []any{100, "call"},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, invokeproductionfunction.ReturnArgs{Outputs: []any{12.5}}, result)
+}
+
+func TestTool_Handler_MATLABError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), invokeproductionfunctionusecase.Args{
+			Archive:    "pricing",
+			Function:   "price",
+			Inputs:     []json.RawMessage{},
+			NumOutputs: 2,
+		}).
+		Return(productionserver.InvocationResult{Error: &productionserver.MATLABError{ID: "pricing:invalidStrike", Message: "Strike must be positive."}}, nil).
+		Once()
+
+	// Act
+	result, err := invokeproductionfunction.Handler(mockUsecase)(ctx, mockLogger, invokeproductionfunction.Args{
+		Archive:    "pricing",
+		Function:   "price",
+		NumOutputs: 2,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, invokeproductionfunction.ReturnArgs{
+		Outputs: []any{},
+		Error:   &invokeproductionfunction.MATLABError{ID: "pricing:invalidStrike", Message: "Strike must be positive."},
+	}, result)
+}
@@ -0,0 +1,20 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package pullfrommatlabdrive
+
+const (
+	name        = "pull_from_matlab_drive"
+	title       = "Pull From MATLAB Drive"
+	description = "Copy a file of MATLAB Drive (`drive_path`) into a project folder (`project_path`), so that MATLAB code evaluated for the project can read it. Browse the matlab://drive/ resources to find the path of a file. Existing files are only replaced when `overwrite` is set."
+)
+
+type Args struct {
+	DrivePath   string `json:"drive_path"          jsonschema:"The path of the file in MATLAB Drive, relative to the top folder of MATLAB Drive, with forward slashes - Example: data/measurements.csv."`
+	ProjectPath string `json:"project_path"        jsonschema:"The full absolute path to the project folder to copy the file into - Example: C:\\Users\\username\\matlab\\project or /home/user/project."`
+	Overwrite   bool   `json:"overwrite,omitempty" jsonschema:"Whether to replace the file of the same name in the project folder. Defaults to false."`
+}
+
+type ReturnArgs struct {
+	FilePath string `json:"file_path" jsonschema:"The full absolute path of the copied file."`
+	Bytes    int    `json:"bytes"     jsonschema:"The size of the file in bytes."`
+}
@@ -0,0 +1,49 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package pullfrommatlabdrive
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/pullfrommatlabdrive"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, request pullfrommatlabdrive.Args) (pullfrommatlabdrive.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase)),
+	}
+}
+
+func Handler(usecase Usecase) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing pull from MATLAB Drive tool")
+		defer sessionLogger.Info("Done - Executing pull from MATLAB Drive tool")
+
+		response, err := usecase.Execute(ctx, sessionLogger, pullfrommatlabdrive.Args{
+			DrivePath:   inputs.DrivePath,
+			ProjectPath: inputs.ProjectPath,
+			Overwrite:   inputs.Overwrite,
+		})
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		return ReturnArgs{
+			FilePath: response.FilePath,
+			Bytes:    response.Bytes,
+		}, nil
+	}
+}
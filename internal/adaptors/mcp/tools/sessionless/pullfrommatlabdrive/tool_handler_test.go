@@ -0,0 +1,90 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package pullfrommatlabdrive_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/pullfrommatlabdrive"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	pullfrommatlabdriveusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/pullfrommatlabdrive"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/sessionless/pullfrommatlabdrive"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := pullfrommatlabdrive.New(mockLoggerFactory, mockUsecase)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	ctx := t.Context()
+	args := pullfrommatlabdrive.Args{
+		DrivePath:   "data/results.csv",
+		ProjectPath: "/home/user/project",
+		Overwrite:   true,
+	}
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), pullfrommatlabdriveusecase.Args{
+			DrivePath:   "data/results.csv",
+			ProjectPath: "/home/user/project",
+			Overwrite:   true,
+		}).
+		Return(pullfrommatlabdriveusecase.ReturnArgs{FilePath: "/home/user/project/results.csv", Bytes: 8}, nil).
+		Once()
+
+	// Act
+	result, err := pullfrommatlabdrive.Handler(mockUsecase)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, pullfrommatlabdrive.ReturnArgs{FilePath: "/home/user/project/results.csv", Bytes: 8}, result)
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), pullfrommatlabdriveusecase.Args{DrivePath: "missing.csv", ProjectPath: "/home/user/project"}).
+		Return(pullfrommatlabdriveusecase.ReturnArgs{}, assert.AnError).
+		Once()
+
+	// Act
+	result, err := pullfrommatlabdrive.Handler(mockUsecase)(ctx, mockLogger, pullfrommatlabdrive.Args{DrivePath: "missing.csv", ProjectPath: "/home/user/project"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
@@ -0,0 +1,21 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package pushtomatlabdrive
+
+const (
+	name        = "push_to_matlab_drive"
+	title       = "Push To MATLAB Drive"
+	description = "Copy a file (`source`), given by its absolute path or by the matlab://artifacts/ URI of an artifact written by MATLAB, to a folder of MATLAB Drive (`drive_folder`), from where MATLAB Drive Connector uploads it to the cloud. The folder is created if needed. Existing files are only replaced when `overwrite` is set."
+)
+
+type Args struct {
+	Source      string `json:"source"                 jsonschema:"The full absolute path to the file to copy, or the URI of an artifact - Example: /home/user/project/results.csv or matlab://artifacts/tp1234.mat."`
+	DriveFolder string `json:"drive_folder,omitempty" jsonschema:"The folder of MATLAB Drive to copy the file to, relative to the top folder of MATLAB Drive, with forward slashes. Defaults to the top folder - Example: results/2025."`
+	Overwrite   bool   `json:"overwrite,omitempty"    jsonschema:"Whether to replace the file of the same name in the MATLAB Drive folder. Defaults to false."`
+}
+
+type ReturnArgs struct {
+	DrivePath string `json:"drive_path" jsonschema:"The path of the file in MATLAB Drive."`
+	URI       string `json:"uri"        jsonschema:"The URI of the resource of the file in MATLAB Drive."`
+	Bytes     int64  `json:"bytes"      jsonschema:"The size of the file in bytes."`
+}
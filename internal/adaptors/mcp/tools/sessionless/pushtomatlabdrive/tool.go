@@ -0,0 +1,50 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package pushtomatlabdrive
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/pushtomatlabdrive"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, request pushtomatlabdrive.Args) (pushtomatlabdrive.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase)),
+	}
+}
+
+func Handler(usecase Usecase) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing push to MATLAB Drive tool")
+		defer sessionLogger.Info("Done - Executing push to MATLAB Drive tool")
+
+		response, err := usecase.Execute(ctx, sessionLogger, pushtomatlabdrive.Args{
+			Source:      inputs.Source,
+			DriveFolder: inputs.DriveFolder,
+			Overwrite:   inputs.Overwrite,
+		})
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		return ReturnArgs{
+			DrivePath: response.DrivePath,
+			URI:       response.URI,
+			Bytes:     response.Bytes,
+		}, nil
+	}
+}
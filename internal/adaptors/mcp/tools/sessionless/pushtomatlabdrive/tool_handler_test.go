@@ -0,0 +1,99 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package pushtomatlabdrive_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/pushtomatlabdrive"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	pushtomatlabdriveusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/pushtomatlabdrive"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/sessionless/pushtomatlabdrive"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := pushtomatlabdrive.New(mockLoggerFactory, mockUsecase)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	ctx := t.Context()
+	args := pushtomatlabdrive.Args{
+		Source:      "matlab://artifacts/tp1234.mat",
+		DriveFolder: "results",
+		Overwrite:   true,
+	}
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), pushtomatlabdriveusecase.Args{
+			Source:      "matlab://artifacts/tp1234.mat",
+			DriveFolder: "results",
+			Overwrite:   true,
+		}).
+		Return(pushtomatlabdriveusecase.ReturnArgs{
+			DrivePath: "results/tp1234.mat",
+			URI:       "matlab://drive/results/tp1234.mat",
+			Bytes:     19,
+		}, nil).
+		Once()
+
+	// Act
+	result, err := pushtomatlabdrive.Handler(mockUsecase)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, pushtomatlabdrive.ReturnArgs{
+		DrivePath: "results/tp1234.mat",
+		URI:       "matlab://drive/results/tp1234.mat",
+		Bytes:     19,
+	}, result)
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	ctx := t.Context()
+	const source = "/home/user/project/report.pdf"
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), pushtomatlabdriveusecase.Args{Source: source}).
+		Return(pushtomatlabdriveusecase.ReturnArgs{}, assert.AnError).
+		Once()
+
+	// Act
+	result, err := pushtomatlabdrive.Handler(mockUsecase)(ctx, mockLogger, pushtomatlabdrive.Args{Source: source})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
@@ -0,0 +1,19 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package buildrealtimeapplication
+
+const (
+	name        = "build_realtime_application"
+	title       = "Build Real-Time Application"
+	description = "Build a Simulink model configured for Simulink Real-Time (`model_path`, with the slrealtime.tlc system target file) into a real-time application, with slbuild, in the folder of the model. Return the path of the application (.mldatx) and the build log. Deploy the application to a target computer with `deploy_realtime_application`."
+)
+
+type Args struct {
+	ModelPath string `json:"model_path"        jsonschema:"The full absolute path to the Simulink model to build - Must be a .slx or .mdl file - Example: C:\\Users\\username\\rig\\controller.slx or /home/user/rig/controller.slx."`
+	DryRun    bool   `json:"dry_run,omitempty" jsonschema:"If true, the call is not run, and the result describes what it would do instead - Use it to propose changes for review. Defaults to false."`
+}
+
+type ReturnArgs struct {
+	ApplicationPath string `json:"application_path" jsonschema:"The full path of the real-time application built from the model."`
+	Log             string `json:"log"              jsonschema:"The build log."`
+}
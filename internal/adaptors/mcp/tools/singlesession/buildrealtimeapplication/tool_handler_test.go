@@ -0,0 +1,99 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package buildrealtimeapplication_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/buildrealtimeapplication"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	buildrealtimeapplicationusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/buildrealtimeapplication"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/buildrealtimeapplication"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := buildrealtimeapplication.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, buildrealtimeapplicationusecase.Args{ModelPath: "/home/user/rig/controller.slx"}).
+		Return(buildrealtimeapplicationusecase.ReturnArgs{ApplicationPath: "/home/user/rig/controller.mldatx", Log: "build log"}, nil).
+		Once()
+
+	// Act
+	result, err := buildrealtimeapplication.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, buildrealtimeapplication.Args{ModelPath: "/home/user/rig/controller.slx"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, buildrealtimeapplication.ReturnArgs{ApplicationPath: "/home/user/rig/controller.mldatx", Log: "build log"}, result)
+}
+
+func TestTool_Handler_ClientError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	result, err := buildrealtimeapplication.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, buildrealtimeapplication.Args{ModelPath: "/home/user/rig/controller.slx"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
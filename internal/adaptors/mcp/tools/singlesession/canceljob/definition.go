@@ -0,0 +1,19 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package canceljob
+
+const (
+	name        = "cancel_job"
+	title       = "Cancel Background Job"
+	description = "Cancel a background job started with `start_job`, given its job ID (`job_id`). Jobs running in the MATLAB session are interrupted, as Ctrl+C does. Cancelling a finished job does nothing, and returns its final state."
+)
+
+type Args struct {
+	JobID  string `json:"job_id"            jsonschema:"The ID of the background job, as returned by start_job."`
+	DryRun bool   `json:"dry_run,omitempty" jsonschema:"If true, the job is not cancelled, and the result describes what the call would do instead. Defaults to false."`
+}
+
+type ReturnArgs struct {
+	JobID string `json:"job_id" jsonschema:"The ID of the background job."`
+	State string `json:"state"  jsonschema:"The state of the job: cancelled, or the final state of a job that was already finished."`
+}
@@ -0,0 +1,48 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package canceljob
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/canceljob"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/jobmanager"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, request canceljob.Args) (jobmanager.Job, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase)),
+	}
+}
+
+func Handler(usecase Usecase) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Cancel Job tool")
+		defer sessionLogger.Info("Done - Executing Cancel Job tool")
+
+		job, err := usecase.Execute(ctx, sessionLogger, canceljob.Args{
+			JobID: inputs.JobID,
+		})
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		return ReturnArgs{
+			JobID: job.ID,
+			State: string(job.State),
+		}, nil
+	}
+}
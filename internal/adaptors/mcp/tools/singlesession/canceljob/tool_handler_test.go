@@ -0,0 +1,82 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package canceljob_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/canceljob"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	canceljobusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/canceljob"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/jobmanager"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/canceljob"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := canceljob.New(mockLoggerFactory, mockUsecase)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), canceljobusecase.Args{JobID: "job-1"}).
+		Return(jobmanager.Job{ID: "job-1", Mode: jobmanager.ModeSession, State: jobmanager.StateCancelled}, nil).
+		Once()
+
+	// Act
+	result, err := canceljob.Handler(mockUsecase)(ctx, mockLogger, canceljob.Args{JobID: "job-1"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, canceljob.ReturnArgs{JobID: "job-1", State: "cancelled"}, result)
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), canceljobusecase.Args{JobID: "job-42"}).
+		Return(jobmanager.Job{}, assert.AnError).
+		Once()
+
+	// Act
+	result, err := canceljob.Handler(mockUsecase)(ctx, mockLogger, canceljob.Args{JobID: "job-42"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
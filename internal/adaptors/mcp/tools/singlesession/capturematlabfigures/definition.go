@@ -0,0 +1,15 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package capturematlabfigures
+
+const (
+	name        = "capture_matlab_figures"
+	title       = "Capture MATLAB Figures"
+	description = "Capture the figures open in the MATLAB session as images, so that you can see the plots your code produced. Give the `figures` to capture by number, or none to capture every open figure. Images are PNG by default, at `resolution` dots per inch, or SVG with `format` svg, for vector drawings that stay sharp when zoomed. Each image follows the list of the captured figures, in the same order. Figures created with uifigure have no number, and cannot be captured."
+)
+
+type Args struct {
+	Figures    []int  `json:"figures,omitempty"    jsonschema:"The numbers of the figures to capture. Defaults to every open figure."`
+	Format     string `json:"format,omitempty"     jsonschema:"The format of the images: png or svg. Defaults to png."`
+	Resolution int    `json:"resolution,omitempty" jsonschema:"The resolution of PNG images, in dots per inch. Defaults to 150."`
+}
@@ -0,0 +1,86 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package capturematlabfigures
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/capturematlabfigures"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request capturematlabfigures.Args) (capturematlabfigures.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithUnstructuredContentOutput[Args]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithUnstructuredContentOutput: basetool.NewToolWithUnstructuredContent(name, title, description, loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithUnstructuredContentOutput[Args] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (tools.RichContent, error) {
+		sessionLogger.Info("Executing Capture MATLAB Figures tool")
+		defer sessionLogger.Info("Done - Executing Capture MATLAB Figures tool")
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return tools.RichContent{}, err
+		}
+
+		response, err := usecase.Execute(ctx, sessionLogger, client, capturematlabfigures.Args{
+			Numbers:    inputs.Figures,
+			Format:     capturematlabfigures.Format(strings.ToLower(inputs.Format)),
+			Resolution: inputs.Resolution,
+		})
+		if err != nil {
+			return tools.RichContent{}, err
+		}
+
+		if len(response.Figures) == 0 {
+			return tools.RichContent{TextContent: []string{"No figure is open."}}, nil
+		}
+
+		content := tools.RichContent{
+			TextContent: []string{formatFigures(response.Figures)},
+		}
+		for _, figure := range response.Figures {
+			if figure.MIMEType == "image/svg+xml" {
+				content.SVGImageContent = append(content.SVGImageContent, figure.Data)
+			} else {
+				content.ImageContent = append(content.ImageContent, figure.Data)
+			}
+		}
+
+		return content, nil
+	}
+}
+
+// formatFigures lists the captured figures, one per line, in the order of their images.
+func formatFigures(figures []capturematlabfigures.Figure) string {
+	var builder strings.Builder
+	builder.WriteString("Captured figures:")
+
+	for _, figure := range figures {
+		if figure.Name != "" {
+			builder.WriteString(fmt.Sprintf("\nFigure %d: %s", figure.Number, figure.Name))
+		} else {
+			builder.WriteString(fmt.Sprintf("\nFigure %d", figure.Number))
+		}
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,227 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package capturematlabfigures_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/capturematlabfigures"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	capturematlabfiguresusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/capturematlabfigures"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/capturematlabfigures"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := capturematlabfigures.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_PNG(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockClient, capturematlabfiguresusecase.Args{}).
+		Return(capturematlabfiguresusecase.ReturnArgs{
+			Figures: []capturematlabfiguresusecase.Figure{
+				{Number: 1, MIMEType: "image/png", Data: []byte("figure 1")},
+				{Number: 3, Name: "Residuals", MIMEType: "image/png", Data: []byte("figure 3")},
+			},
+		}, nil).
+		Once()
+
+	handler := capturematlabfigures.Handler(mockUsecase, mockGlobalMATLAB)
+
+	// Act
+	result, err := handler(ctx, mockLogger, capturematlabfigures.Args{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, tools.RichContent{
+		TextContent:  []string{"Captured figures:\nFigure 1\nFigure 3: Residuals"},
+		ImageContent: []tools.PNGImageData{[]byte("figure 1"), []byte("figure 3")},
+	}, result)
+}
+
+func TestTool_Handler_SVG(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockClient, capturematlabfiguresusecase.Args{
+			Numbers: []int{2},
+			Format:  capturematlabfiguresusecase.FormatSVG,
+		}).
+		Return(capturematlabfiguresusecase.ReturnArgs{
+			Figures: []capturematlabfiguresusecase.Figure{
+				{Number: 2, Name: "Spectrum", MIMEType: "image/svg+xml", Data: []byte("<svg/>")},
+			},
+		}, nil).
+		Once()
+
+	handler := capturematlabfigures.Handler(mockUsecase, mockGlobalMATLAB)
+
+	// Act
+	result, err := handler(ctx, mockLogger, capturematlabfigures.Args{Figures: []int{2}, Format: "SVG"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, tools.RichContent{
+		TextContent:     []string{"Captured figures:\nFigure 2: Spectrum"},
+		SVGImageContent: []tools.SVGImageData{[]byte("<svg/>")},
+	}, result)
+}
+
+func TestTool_Handler_NoFigure(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockClient, capturematlabfiguresusecase.Args{}).
+		Return(capturematlabfiguresusecase.ReturnArgs{}, nil).
+		Once()
+
+	handler := capturematlabfigures.Handler(mockUsecase, mockGlobalMATLAB)
+
+	// Act
+	result, err := handler(ctx, mockLogger, capturematlabfigures.Args{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, tools.RichContent{TextContent: []string{"No figure is open."}}, result)
+}
+
+func TestTool_Handler_ClientError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, assert.AnError).
+		Once()
+
+	handler := capturematlabfigures.Handler(mockUsecase, mockGlobalMATLAB)
+
+	// Act
+	result, err := handler(ctx, mockLogger, capturematlabfigures.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockClient, capturematlabfiguresusecase.Args{Numbers: []int{7}}).
+		Return(capturematlabfiguresusecase.ReturnArgs{}, assert.AnError).
+		Once()
+
+	handler := capturematlabfigures.Handler(mockUsecase, mockGlobalMATLAB)
+
+	// Act
+	result, err := handler(ctx, mockLogger, capturematlabfigures.Args{Figures: []int{7}})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
@@ -5,13 +5,27 @@ package checkmatlabcode
 const (
 	name        = "check_matlab_code"
 	title       = "Check MATLAB Code"
-	description = "Perform static code analysis on a MATLAB script (`script_path`) using MATLAB's built-in checkcode function in an existing MATLAB session. Returns warnings about coding style, potential errors, deprecated functions, performance issues, and best practice violations. This is a non-destructive, read-only operation that helps identify code quality issues without executing the script."
+	description = "Perform static code analysis on a MATLAB script (`script_path`), or on every MATLAB file of a folder and its subfolders (`folder_path`), using MATLAB's built-in Code Analyzer in an existing MATLAB session. Returns warnings about coding style, potential errors, deprecated functions, performance issues, and best practice violations, as diagnostics with their file, line, columns, severity, check identifier, and whether Code Analyzer can fix them automatically. This is a non-destructive, read-only operation that helps identify code quality issues without executing the code, such as before running it."
 )
 
 type Args struct {
-	ScriptPath string `json:"script_path" jsonschema:"The full absolute path to the MATLAB script file to analyze - Must be a .m file that exists - File is not modified during analysis - Example: C:\\Users\\username\\matlab\\myFunction.m or /home/user/scripts/analysis.m."`
+	ScriptPath string `json:"script_path,omitempty" jsonschema:"The full absolute path to the MATLAB script file to analyze - Must be a .m file that exists - File is not modified during analysis - Set either script_path or folder_path - Example: C:\\Users\\username\\matlab\\myFunction.m or /home/user/scripts/analysis.m."`
+	FolderPath string `json:"folder_path,omitempty" jsonschema:"The full absolute path to a folder whose .m files, including those of its subfolders, are analyzed - Set either script_path or folder_path - Example: C:\\Users\\username\\matlab-project or /home/user/research."`
 }
 
 type ReturnArgs struct {
-	CheckCodeOutput []string `json:"checkcode_messages" jsonschema:"List of code style and correctness warnings."`
+	CheckCodeOutput    []string     `json:"checkcode_messages"            jsonschema:"List of code style and correctness warnings, as displayed by checkcode."`
+	Diagnostics        []Diagnostic `json:"diagnostics"                   jsonschema:"The issues found by Code Analyzer, empty when there are none."`
+	OmittedDiagnostics int          `json:"omitted_diagnostics,omitempty" jsonschema:"The number of issues found but not listed, as the result holds at most 500 diagnostics."`
+}
+
+type Diagnostic struct {
+	File      string `json:"file"       jsonschema:"The full path of the file of the issue."`
+	Line      int    `json:"line"       jsonschema:"The line of the issue, starting at 1."`
+	Column    int    `json:"column"     jsonschema:"The first column of the issue, starting at 1."`
+	EndColumn int    `json:"end_column" jsonschema:"The last column of the issue."`
+	Severity  string `json:"severity"   jsonschema:"The severity of the issue: error, warning or info. MATLAB releases before R2022b report every issue as a warning."`
+	ID        string `json:"id"         jsonschema:"The identifier of the Code Analyzer check, such as NASGU, which can be suppressed with a %#ok<NASGU> comment."`
+	Message   string `json:"message"    jsonschema:"The description of the issue, often with the suggested change."`
+	Fix       string `json:"fix"        jsonschema:"How the issue is fixed: automatic when Code Analyzer can fix it, such as with the fix function or in the MATLAB Editor, manual otherwise, or empty when the MATLAB release does not report it."`
 }
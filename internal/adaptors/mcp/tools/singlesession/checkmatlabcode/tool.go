@@ -21,14 +21,14 @@ type Tool struct {
 func New(
 	loggerFactory basetool.LoggerFactory,
 	usecase Usecase,
-	globalMATLAB entities.GlobalMATLAB,
+	workerPool entities.WorkerPool,
 ) *Tool {
 	return &Tool{
-		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase, globalMATLAB)),
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase, workerPool)),
 	}
 }
 
-func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+func Handler(usecase Usecase, workerPool entities.WorkerPool) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
 	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
 		sessionLogger.Info("Executing Check MATLAB code tool")
 		defer sessionLogger.Info("Done - Executing Check MATLAB code tool")
@@ -36,26 +36,41 @@ func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.Handl
 		// Not returning nil for empty slices, to comply with MCP spec.
 		mcpCompliantZeroValue := ReturnArgs{
 			CheckCodeOutput: []string{},
+			Diagnostics:     []Diagnostic{},
 		}
 
-		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		client, release, err := workerPool.Client(ctx, sessionLogger)
 		if err != nil {
 			return mcpCompliantZeroValue, err
 		}
+		defer release()
 
 		checkcodeResponse, err := usecase.Execute(ctx, sessionLogger, client, checkmatlabcode.Args{
 			ScriptPath: inputs.ScriptPath,
+			FolderPath: inputs.FolderPath,
 		})
 		if err != nil {
 			return mcpCompliantZeroValue, err
 		}
 
-		if checkcodeResponse.CheckCodeOutput == nil {
-			return mcpCompliantZeroValue, nil
+		result := mcpCompliantZeroValue
+		if checkcodeResponse.CheckCodeOutput != nil {
+			result.CheckCodeOutput = checkcodeResponse.CheckCodeOutput
 		}
+		for _, diagnostic := range checkcodeResponse.Diagnostics {
+			result.Diagnostics = append(result.Diagnostics, Diagnostic{
+				File:      diagnostic.File,
+				Line:      diagnostic.Line,
+				Column:    diagnostic.Column,
+				EndColumn: diagnostic.EndColumn,
+				Severity:  diagnostic.Severity,
+				ID:        diagnostic.ID,
+				Message:   diagnostic.Message,
+				Fix:       diagnostic.Fix,
+			})
+		}
+		result.OmittedDiagnostics = checkcodeResponse.Omitted
 
-		return ReturnArgs{
-			CheckCodeOutput: checkcodeResponse.CheckCodeOutput,
-		}, nil
+		return result, nil
 	}
 }
@@ -25,8 +25,8 @@ func TestNew_HappyPath(t *testing.T) {
 	mockUsecase := &mocks.MockUsecase{}
 	defer mockUsecase.AssertExpectations(t)
 
-	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
-	defer mockGlobalMATLAB.AssertExpectations(t)
+	mockWorkerPool := &entitiesmocks.MockWorkerPool{}
+	defer mockWorkerPool.AssertExpectations(t)
 
 	mockLoggerFactory.EXPECT().
 		GetGlobalLogger().
@@ -34,7 +34,7 @@ func TestNew_HappyPath(t *testing.T) {
 		Once()
 
 	// Act
-	tool := checkmatlabcode.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+	tool := checkmatlabcode.New(mockLoggerFactory, mockUsecase, mockWorkerPool)
 
 	// Assert
 	assert.NotNil(t, tool)
@@ -47,8 +47,8 @@ func TestTool_Handler_HappyPath(t *testing.T) {
 	mockUsecase := &mocks.MockUsecase{}
 	defer mockUsecase.AssertExpectations(t)
 
-	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
-	defer mockGlobalMATLAB.AssertExpectations(t)
+	mockWorkerPool := &entitiesmocks.MockWorkerPool{}
+	defer mockWorkerPool.AssertExpectations(t)
 
 	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockMATLABSessionClient.AssertExpectations(t)
@@ -58,11 +58,16 @@ func TestTool_Handler_HappyPath(t *testing.T) {
 	expectedCheckCodeOutput := []string{"Line 1: Warning message", "Line 3: Error message"}
 	expectedResponse := checkmatlabcodeusecase.ReturnArgs{
 		CheckCodeOutput: expectedCheckCodeOutput,
+		Diagnostics: []checkmatlabcodeusecase.Diagnostic{
+			{File: scriptPath, Line: 1, Column: 2, EndColumn: 4, Severity: "warning", ID: "NASGU", Message: "Warning message", Fix: "manual"},
+		},
+		Omitted: 2,
 	}
 
-	mockGlobalMATLAB.EXPECT().
+	released := false
+	mockWorkerPool.EXPECT().
 		Client(ctx, mockLogger.AsMockArg()).
-		Return(mockMATLABSessionClient, nil).
+		Return(mockMATLABSessionClient, func() { released = true }, nil).
 		Once()
 
 	mockUsecase.EXPECT().
@@ -75,12 +80,57 @@ func TestTool_Handler_HappyPath(t *testing.T) {
 	}
 
 	// Act
-	result, err := checkmatlabcode.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+	result, err := checkmatlabcode.Handler(mockUsecase, mockWorkerPool)(ctx, mockLogger, args)
 
 	// Assert
 	require.NoError(t, err, "Handler should not return an error")
 	expectedCleanedOutput := []string{"Line 1: Warning message", "Line 3: Error message"}
 	assert.Equal(t, expectedCleanedOutput, result.CheckCodeOutput, "Check code output should match")
+	assert.Equal(t, []checkmatlabcode.Diagnostic{
+		{File: scriptPath, Line: 1, Column: 2, EndColumn: 4, Severity: "warning", ID: "NASGU", Message: "Warning message", Fix: "manual"},
+	}, result.Diagnostics)
+	assert.Equal(t, 2, result.OmittedDiagnostics)
+	assert.True(t, released, "MATLAB session client should be released")
+}
+
+func TestTool_Handler_Folder(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockWorkerPool := &entitiesmocks.MockWorkerPool{}
+	defer mockWorkerPool.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const folderPath = "/path/to/project"
+
+	mockWorkerPool.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, func() {}, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, checkmatlabcodeusecase.Args{FolderPath: folderPath}).
+		Return(checkmatlabcodeusecase.ReturnArgs{CheckCodeOutput: []string{"No issues found by checkcode"}}, nil).
+		Once()
+
+	args := checkmatlabcode.Args{
+		FolderPath: folderPath,
+	}
+
+	// Act
+	result, err := checkmatlabcode.Handler(mockUsecase, mockWorkerPool)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.Equal(t, []string{"No issues found by checkcode"}, result.CheckCodeOutput)
+	assert.NotNil(t, result.Diagnostics, "Diagnostics should not be nil")
+	assert.Empty(t, result.Diagnostics)
 }
 
 func TestTool_Handler_EmptyOutput(t *testing.T) {
@@ -90,8 +140,8 @@ func TestTool_Handler_EmptyOutput(t *testing.T) {
 	mockUsecase := &mocks.MockUsecase{}
 	defer mockUsecase.AssertExpectations(t)
 
-	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
-	defer mockGlobalMATLAB.AssertExpectations(t)
+	mockWorkerPool := &entitiesmocks.MockWorkerPool{}
+	defer mockWorkerPool.AssertExpectations(t)
 
 	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockMATLABSessionClient.AssertExpectations(t)
@@ -103,9 +153,9 @@ func TestTool_Handler_EmptyOutput(t *testing.T) {
 		CheckCodeOutput: expectedCheckCodeOutput,
 	}
 
-	mockGlobalMATLAB.EXPECT().
+	mockWorkerPool.EXPECT().
 		Client(ctx, mockLogger.AsMockArg()).
-		Return(mockMATLABSessionClient, nil).
+		Return(mockMATLABSessionClient, func() {}, nil).
 		Once()
 
 	mockUsecase.EXPECT().
@@ -118,7 +168,7 @@ func TestTool_Handler_EmptyOutput(t *testing.T) {
 	}
 
 	// Act
-	result, err := checkmatlabcode.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+	result, err := checkmatlabcode.Handler(mockUsecase, mockWorkerPool)(ctx, mockLogger, args)
 
 	// Assert
 	require.NoError(t, err, "Handler should not return an error")
@@ -133,8 +183,8 @@ func TestTool_Handler_ClientError(t *testing.T) {
 	mockUsecase := &mocks.MockUsecase{}
 	defer mockUsecase.AssertExpectations(t)
 
-	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
-	defer mockGlobalMATLAB.AssertExpectations(t)
+	mockWorkerPool := &entitiesmocks.MockWorkerPool{}
+	defer mockWorkerPool.AssertExpectations(t)
 
 	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockMATLABSessionClient.AssertExpectations(t)
@@ -143,9 +193,9 @@ func TestTool_Handler_ClientError(t *testing.T) {
 	const scriptPath = "/path/to/script.m"
 	expectedError := assert.AnError
 
-	mockGlobalMATLAB.EXPECT().
+	mockWorkerPool.EXPECT().
 		Client(ctx, mockLogger.AsMockArg()).
-		Return(nil, expectedError).
+		Return(nil, nil, expectedError).
 		Once()
 
 	args := checkmatlabcode.Args{
@@ -153,7 +203,7 @@ func TestTool_Handler_ClientError(t *testing.T) {
 	}
 
 	// Act
-	result, err := checkmatlabcode.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+	result, err := checkmatlabcode.Handler(mockUsecase, mockWorkerPool)(ctx, mockLogger, args)
 
 	// Assert
 	require.ErrorIs(t, err, expectedError, "Handler should return an error")
@@ -168,8 +218,8 @@ func TestTool_Handler_UsecaseError(t *testing.T) {
 	mockUsecase := &mocks.MockUsecase{}
 	defer mockUsecase.AssertExpectations(t)
 
-	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
-	defer mockGlobalMATLAB.AssertExpectations(t)
+	mockWorkerPool := &entitiesmocks.MockWorkerPool{}
+	defer mockWorkerPool.AssertExpectations(t)
 
 	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockMATLABSessionClient.AssertExpectations(t)
@@ -178,9 +228,9 @@ func TestTool_Handler_UsecaseError(t *testing.T) {
 	const scriptPath = "/path/to/script.m"
 	expectedError := assert.AnError
 
-	mockGlobalMATLAB.EXPECT().
+	mockWorkerPool.EXPECT().
 		Client(ctx, mockLogger.AsMockArg()).
-		Return(mockMATLABSessionClient, nil).
+		Return(mockMATLABSessionClient, func() {}, nil).
 		Once()
 
 	mockUsecase.EXPECT().
@@ -193,7 +243,7 @@ func TestTool_Handler_UsecaseError(t *testing.T) {
 	}
 
 	// Act
-	result, err := checkmatlabcode.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+	result, err := checkmatlabcode.Handler(mockUsecase, mockWorkerPool)(ctx, mockLogger, args)
 
 	// Assert
 	require.ErrorIs(t, err, expectedError, "Handler should return an error")
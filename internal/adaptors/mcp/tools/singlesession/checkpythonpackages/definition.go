@@ -0,0 +1,24 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package checkpythonpackages
+
+const (
+	name        = "check_python_packages"
+	title       = "Check Python Packages"
+	description = "Check that Python packages (`packages`) can be imported in the Python environment of the MATLAB session, and return the version of each package, or the error raised when importing it. Importing a package loads Python, and runs the code of the package. Use it to validate the requirements of a workflow before calling Python from MATLAB."
+)
+
+type Args struct {
+	Packages []string `json:"packages" jsonschema:"The import names of the Python packages or modules to check, at most 50 - Use the name passed to import, not the name of the distribution - Example: numpy, sklearn or matplotlib.pyplot."`
+}
+
+type ReturnArgs struct {
+	Packages []Package `json:"packages" jsonschema:"The result of the check of each package, in the order of the request."`
+}
+
+type Package struct {
+	Name       string `json:"name"              jsonschema:"The import name of the package."`
+	Importable bool   `json:"importable"        jsonschema:"Whether the package could be imported."`
+	Version    string `json:"version,omitempty" jsonschema:"The version of the package, when it is known."`
+	Error      string `json:"error,omitempty"   jsonschema:"The error raised when importing the package."`
+}
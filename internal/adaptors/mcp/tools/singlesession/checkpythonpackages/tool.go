@@ -0,0 +1,67 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package checkpythonpackages
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/checkpythonpackages"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request checkpythonpackages.Args) (checkpythonpackages.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Check Python Packages tool")
+		defer sessionLogger.Info("Done - Executing Check Python Packages tool")
+
+		// Not returning nil for empty slices, to comply with MCP spec.
+		mcpCompliantZeroValue := ReturnArgs{
+			Packages: []Package{},
+		}
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return mcpCompliantZeroValue, err
+		}
+
+		response, err := usecase.Execute(ctx, sessionLogger, client, checkpythonpackages.Args{
+			Packages: inputs.Packages,
+		})
+		if err != nil {
+			return mcpCompliantZeroValue, err
+		}
+
+		packages := make([]Package, len(response.Packages))
+		for i, pkg := range response.Packages {
+			packages[i] = Package{
+				Name:       pkg.Name,
+				Importable: pkg.Importable,
+				Version:    pkg.Version,
+				Error:      pkg.Error,
+			}
+		}
+
+		return ReturnArgs{
+			Packages: packages,
+		}, nil
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package checkpythonpackages_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/checkpythonpackages"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	checkpythonpackagesusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/checkpythonpackages"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/checkpythonpackages"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := checkpythonpackages.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, checkpythonpackagesusecase.Args{Packages: []string{"numpy", "torch"}}).
+		Return(checkpythonpackagesusecase.ReturnArgs{Packages: []checkpythonpackagesusecase.Package{
+			{Name: "numpy", Importable: true, Version: "1.26.4"},
+			{Name: "torch", Error: "ModuleNotFoundError: No module named 'torch'"},
+		}}, nil).
+		Once()
+
+	// Act
+	result, err := checkpythonpackages.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, checkpythonpackages.Args{Packages: []string{"numpy", "torch"}})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, checkpythonpackages.ReturnArgs{
+		Packages: []checkpythonpackages.Package{
+			{Name: "numpy", Importable: true, Version: "1.26.4"},
+			{Name: "torch", Error: "ModuleNotFoundError: No module named 'torch'"},
+		},
+	}, result)
+}
+
+func TestTool_Handler_ClientError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	result, err := checkpythonpackages.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, checkpythonpackages.Args{Packages: []string{"numpy", "torch"}})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Equal(t, []checkpythonpackages.Package{}, result.Packages, "Packages should be an empty slice, not nil")
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, checkpythonpackagesusecase.Args{Packages: []string{"numpy", "torch"}}).
+		Return(checkpythonpackagesusecase.ReturnArgs{}, assert.AnError).
+		Once()
+
+	// Act
+	result, err := checkpythonpackages.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, checkpythonpackages.Args{Packages: []string{"numpy", "torch"}})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Equal(t, []checkpythonpackages.Package{}, result.Packages, "Packages should be an empty slice, not nil")
+}
@@ -0,0 +1,24 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package controlrealtimeapplication
+
+import (
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/deployrealtimeapplication"
+)
+
+const (
+	name        = "control_realtime_application"
+	title       = "Control Real-Time Application"
+	description = "Start or stop (`action`) the real-time application loaded on a Simulink Real-Time target computer (`target`), as loaded by `deploy_realtime_application`. When starting, optionally set the stop time of the application (`stop_time`). Only the targets configured on the server can be used. Return the status of the target."
+)
+
+type Args struct {
+	Target   string  `json:"target"              jsonschema:"The name of the target computer, as listed by slrealtime.Targets - Example: TargetPC1."`
+	Action   string  `json:"action"              jsonschema:"start, to start the loaded application, or stop, to stop it."`
+	StopTime float64 `json:"stop_time,omitempty" jsonschema:"When starting, the stop time of the application in seconds - Defaults to the stop time set in the model."`
+	DryRun   bool    `json:"dry_run,omitempty"   jsonschema:"If true, the call is not run, and the result describes what it would do instead - Use it to propose changes for review. Defaults to false."`
+}
+
+type ReturnArgs struct {
+	Status deployrealtimeapplication.TargetStatus `json:"status" jsonschema:"The status of the target computer, after the action."`
+}
@@ -0,0 +1,57 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package controlrealtimeapplication
+
+import (
+	"context"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/deployrealtimeapplication"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/controlrealtimeapplication"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/realtimetarget"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request controlrealtimeapplication.Args) (realtimetarget.Status, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Control Real-Time Application tool")
+		defer sessionLogger.Info("Done - Executing Control Real-Time Application tool")
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		status, err := usecase.Execute(ctx, sessionLogger, client, controlrealtimeapplication.Args{
+			Target:   inputs.Target,
+			Action:   controlrealtimeapplication.Action(strings.ToLower(strings.TrimSpace(inputs.Action))),
+			StopTime: inputs.StopTime,
+		})
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		return ReturnArgs{
+			Status: deployrealtimeapplication.NewTargetStatus(status),
+		}, nil
+	}
+}
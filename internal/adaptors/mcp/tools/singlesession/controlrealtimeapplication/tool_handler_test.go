@@ -0,0 +1,103 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package controlrealtimeapplication_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/controlrealtimeapplication"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/deployrealtimeapplication"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	controlrealtimeapplicationusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/controlrealtimeapplication"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/realtimetarget"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/controlrealtimeapplication"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := controlrealtimeapplication.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, controlrealtimeapplicationusecase.Args{Target: "rig1", Action: controlrealtimeapplicationusecase.ActionStart, StopTime: 10}).
+		Return(realtimetarget.Status{Target: "rig1", Connected: true, Loaded: true, Running: true, Application: "controller"}, nil).
+		Once()
+
+	// Act
+	result, err := controlrealtimeapplication.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, controlrealtimeapplication.Args{Target: "rig1", Action: " Start ", StopTime: 10})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, controlrealtimeapplication.ReturnArgs{
+		Status: deployrealtimeapplication.TargetStatus{Target: "rig1", Connected: true, Loaded: true, Running: true, Application: "controller"},
+	}, result)
+}
+
+func TestTool_Handler_ClientError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	result, err := controlrealtimeapplication.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, controlrealtimeapplication.Args{Target: "rig1", Action: "stop"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
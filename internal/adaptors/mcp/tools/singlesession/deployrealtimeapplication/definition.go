@@ -0,0 +1,27 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package deployrealtimeapplication
+
+const (
+	name        = "deploy_realtime_application"
+	title       = "Deploy Real-Time Application"
+	description = "Connect to a Simulink Real-Time target computer (`target`), and load a real-time application (`application_path`) on it, replacing the application loaded before. The application does not start: start it with `control_realtime_application`. Only the targets configured on the server can be used. Return the status of the target."
+)
+
+type Args struct {
+	Target          string `json:"target"            jsonschema:"The name of the target computer, as listed by slrealtime.Targets - Example: TargetPC1."`
+	ApplicationPath string `json:"application_path"  jsonschema:"The full absolute path to the real-time application to load, as returned by build_realtime_application - Must be a .mldatx file."`
+	DryRun          bool   `json:"dry_run,omitempty" jsonschema:"If true, the call is not run, and the result describes what it would do instead - Use it to propose changes for review. Defaults to false."`
+}
+
+type ReturnArgs struct {
+	Status TargetStatus `json:"status" jsonschema:"The status of the target computer, after the application was loaded."`
+}
+
+type TargetStatus struct {
+	Target      string `json:"target"                jsonschema:"The name of the target computer."`
+	Connected   bool   `json:"connected"             jsonschema:"Whether MATLAB is connected to the target computer."`
+	Loaded      bool   `json:"loaded"                jsonschema:"Whether a real-time application is loaded on the target computer."`
+	Running     bool   `json:"running"               jsonschema:"Whether the loaded application is running."`
+	Application string `json:"application,omitempty" jsonschema:"The name of the loaded application, when the release of Simulink Real-Time reports it."`
+}
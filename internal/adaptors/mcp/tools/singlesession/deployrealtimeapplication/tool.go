@@ -0,0 +1,65 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package deployrealtimeapplication
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/deployrealtimeapplication"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/realtimetarget"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request deployrealtimeapplication.Args) (realtimetarget.Status, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Deploy Real-Time Application tool")
+		defer sessionLogger.Info("Done - Executing Deploy Real-Time Application tool")
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		status, err := usecase.Execute(ctx, sessionLogger, client, deployrealtimeapplication.Args{
+			Target:          inputs.Target,
+			ApplicationPath: inputs.ApplicationPath,
+		})
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		return ReturnArgs{
+			Status: NewTargetStatus(status),
+		}, nil
+	}
+}
+
+// NewTargetStatus converts the status of a real-time target to its representation in tool results.
+func NewTargetStatus(status realtimetarget.Status) TargetStatus {
+	return TargetStatus{
+		Target:      status.Target,
+		Connected:   status.Connected,
+		Loaded:      status.Loaded,
+		Running:     status.Running,
+		Application: status.Application,
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package deployrealtimeapplication_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/deployrealtimeapplication"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	deployrealtimeapplicationusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/deployrealtimeapplication"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/realtimetarget"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/deployrealtimeapplication"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := deployrealtimeapplication.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, deployrealtimeapplicationusecase.Args{Target: "rig1", ApplicationPath: "/home/user/rig/controller.mldatx"}).
+		Return(realtimetarget.Status{Target: "rig1", Connected: true, Loaded: true, Application: "controller"}, nil).
+		Once()
+
+	// Act
+	result, err := deployrealtimeapplication.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, deployrealtimeapplication.Args{Target: "rig1", ApplicationPath: "/home/user/rig/controller.mldatx"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, deployrealtimeapplication.ReturnArgs{
+		Status: deployrealtimeapplication.TargetStatus{Target: "rig1", Connected: true, Loaded: true, Application: "controller"},
+	}, result)
+}
+
+func TestTool_Handler_ClientError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	result, err := deployrealtimeapplication.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, deployrealtimeapplication.Args{Target: "rig1", ApplicationPath: "/home/user/rig/controller.mldatx"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
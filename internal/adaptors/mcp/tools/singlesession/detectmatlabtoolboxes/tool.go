@@ -21,10 +21,10 @@ type Tool struct {
 func New(
 	loggerFactory basetool.LoggerFactory,
 	usecase Usecase,
-	globalMATLAB entities.GlobalMATLAB,
+	workerPool entities.WorkerPool,
 ) *Tool {
 	return &Tool{
-		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase, globalMATLAB)),
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase, workerPool)),
 	}
 }
 
@@ -36,15 +36,16 @@ func (Tool) Description() string {
 	return description
 }
 
-func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+func Handler(usecase Usecase, workerPool entities.WorkerPool) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
 	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
 		sessionLogger.Info("Executing detect MATLAB toolboxes tool")
 		defer sessionLogger.Info("Done - Executing detect MATLAB toolboxes tool")
 
-		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		client, release, err := workerPool.Client(ctx, sessionLogger)
 		if err != nil {
 			return ReturnArgs{}, err
 		}
+		defer release()
 
 		tbxInfo, err := usecase.Execute(ctx, sessionLogger, client)
 
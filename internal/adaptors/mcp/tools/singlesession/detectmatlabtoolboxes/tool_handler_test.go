@@ -25,8 +25,8 @@ func TestNew_HappyPath(t *testing.T) {
 	mockUsecase := &mocks.MockUsecase{}
 	defer mockUsecase.AssertExpectations(t)
 
-	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
-	defer mockGlobalMATLAB.AssertExpectations(t)
+	mockWorkerPool := &entitiesmocks.MockWorkerPool{}
+	defer mockWorkerPool.AssertExpectations(t)
 
 	mockLoggerFactory.EXPECT().
 		GetGlobalLogger().
@@ -34,7 +34,7 @@ func TestNew_HappyPath(t *testing.T) {
 		Once()
 
 	// Act
-	tool := detectmatlabtoolboxes.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+	tool := detectmatlabtoolboxes.New(mockLoggerFactory, mockUsecase, mockWorkerPool)
 
 	// Assert
 	assert.NotNil(t, tool)
@@ -47,8 +47,8 @@ func TestTool_Handler_HappyPath(t *testing.T) {
 	mockUsecase := &mocks.MockUsecase{}
 	defer mockUsecase.AssertExpectations(t)
 
-	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
-	defer mockGlobalMATLAB.AssertExpectations(t)
+	mockWorkerPool := &entitiesmocks.MockWorkerPool{}
+	defer mockWorkerPool.AssertExpectations(t)
 
 	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockMATLABSessionClient.AssertExpectations(t)
@@ -58,9 +58,9 @@ func TestTool_Handler_HappyPath(t *testing.T) {
 		Toolboxes: "Toolbox list",
 	}
 
-	mockGlobalMATLAB.EXPECT().
+	mockWorkerPool.EXPECT().
 		Client(ctx, mockLogger.AsMockArg()).
-		Return(mockMATLABSessionClient, nil).
+		Return(mockMATLABSessionClient, func() {}, nil).
 		Once()
 
 	mockUsecase.EXPECT().
@@ -71,7 +71,7 @@ func TestTool_Handler_HappyPath(t *testing.T) {
 	args := detectmatlabtoolboxes.Args{}
 
 	// Act
-	result, err := detectmatlabtoolboxes.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+	result, err := detectmatlabtoolboxes.Handler(mockUsecase, mockWorkerPool)(ctx, mockLogger, args)
 
 	// Assert
 	require.NoError(t, err, "Handler should not return an error")
@@ -85,8 +85,8 @@ func TestTool_Handler_ClientReturnsError(t *testing.T) {
 	mockUsecase := &mocks.MockUsecase{}
 	defer mockUsecase.AssertExpectations(t)
 
-	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
-	defer mockGlobalMATLAB.AssertExpectations(t)
+	mockWorkerPool := &entitiesmocks.MockWorkerPool{}
+	defer mockWorkerPool.AssertExpectations(t)
 
 	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockMATLABSessionClient.AssertExpectations(t)
@@ -94,15 +94,15 @@ func TestTool_Handler_ClientReturnsError(t *testing.T) {
 	ctx := t.Context()
 	expectedError := assert.AnError
 
-	mockGlobalMATLAB.EXPECT().
+	mockWorkerPool.EXPECT().
 		Client(ctx, mockLogger.AsMockArg()).
-		Return(nil, expectedError).
+		Return(nil, nil, expectedError).
 		Once()
 
 	args := detectmatlabtoolboxes.Args{}
 
 	// Act
-	result, err := detectmatlabtoolboxes.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+	result, err := detectmatlabtoolboxes.Handler(mockUsecase, mockWorkerPool)(ctx, mockLogger, args)
 
 	// Assert
 	require.ErrorIs(t, err, expectedError, "Handler should return an error")
@@ -116,8 +116,8 @@ func TestTool_Handler_UsecaseError(t *testing.T) {
 	mockUsecase := &mocks.MockUsecase{}
 	defer mockUsecase.AssertExpectations(t)
 
-	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
-	defer mockGlobalMATLAB.AssertExpectations(t)
+	mockWorkerPool := &entitiesmocks.MockWorkerPool{}
+	defer mockWorkerPool.AssertExpectations(t)
 
 	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockMATLABSessionClient.AssertExpectations(t)
@@ -125,9 +125,9 @@ func TestTool_Handler_UsecaseError(t *testing.T) {
 	ctx := t.Context()
 	expectedError := assert.AnError
 
-	mockGlobalMATLAB.EXPECT().
+	mockWorkerPool.EXPECT().
 		Client(ctx, mockLogger.AsMockArg()).
-		Return(mockMATLABSessionClient, nil).
+		Return(mockMATLABSessionClient, func() {}, nil).
 		Once()
 
 	mockUsecase.EXPECT().
@@ -138,7 +138,7 @@ func TestTool_Handler_UsecaseError(t *testing.T) {
 	args := detectmatlabtoolboxes.Args{}
 
 	// Act
-	result, err := detectmatlabtoolboxes.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+	result, err := detectmatlabtoolboxes.Handler(mockUsecase, mockWorkerPool)(ctx, mockLogger, args)
 
 	// Assert
 	require.ErrorIs(t, err, expectedError)
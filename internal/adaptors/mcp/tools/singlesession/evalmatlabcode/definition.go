@@ -5,10 +5,12 @@ package evalmatlabcode
 const (
 	name        = "evaluate_matlab_code"
 	title       = "Evaluate MATLAB Code"
-	description = "Evaluate arbitrary MATLAB code (`code`) within a specified project directory (`project_path`) context in an existing MATLAB session. Returns the command window output from code execution."
+	description = "Evaluate arbitrary MATLAB code (`code`) within a specified project directory (`project_path`) context in an existing MATLAB session. Returns the command window output from code execution, and the variables named in `return_variables` as typed JSON, with the class and size of each value. For long simulations and parameter sweeps, which would make the call time out, use `start_job` instead."
 )
 
 type Args struct {
-	ProjectPath string `json:"project_path" jsonschema:"The full path to the project directory - Becomes MATLAB's working directory during execution - Folder must exist - Example: C:\\Users\\username\\matlab-project or /home/user/research."`
-	Code        string `json:"code"         jsonschema:"The MATLAB code to evaluate."`
+	ProjectPath     string   `json:"project_path"               jsonschema:"The full path to the project directory - Becomes MATLAB's working directory during execution - Folder must exist - Example: C:\\Users\\username\\matlab-project or /home/user/research."`
+	Code            string   `json:"code"                       jsonschema:"The MATLAB code to evaluate."`
+	ReturnVariables []string `json:"return_variables,omitempty" jsonschema:"The names of workspace variables to return after the code ran, as typed JSON holding the class, size and elements of each value, rather than printing them - Use it to chain computations on the results. Large variables are returned as a MAT-file or a preview instead. Example: [\"result\", \"stats\"]."`
+	DryRun          bool     `json:"dry_run,omitempty"          jsonschema:"If true, the call is not run, and the result describes what it would do instead, such as the code it would run and the files, folders and MATLAB path it would change - Use it to propose changes for review. Defaults to false."`
 }
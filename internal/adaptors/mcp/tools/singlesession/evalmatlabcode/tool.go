@@ -4,18 +4,34 @@ package evalmatlabcode
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/responseconverter"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/diffmatlabworkspace"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/evalmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getmatlabvariable"
 )
 
 type Usecase interface {
 	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request evalmatlabcode.Args) (entities.EvalResponse, error)
 }
 
+type FigureRenderer interface {
+	Submit(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) ([]tools.ResourceLink, error)
+}
+
+type WorkspaceDiffer interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) (diffmatlabworkspace.ReturnArgs, error)
+}
+
+type VariableReader interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request getmatlabvariable.Args) (getmatlabvariable.ReturnArgs, error)
+}
+
 type Tool struct {
 	basetool.ToolWithUnstructuredContentOutput[Args]
 }
@@ -24,13 +40,16 @@ func New(
 	loggerFactory basetool.LoggerFactory,
 	usecase Usecase,
 	globalMATLAB entities.GlobalMATLAB,
+	figureRenderer FigureRenderer,
+	workspaceDiffer WorkspaceDiffer,
+	variableReader VariableReader,
 ) *Tool {
 	return &Tool{
-		ToolWithUnstructuredContentOutput: basetool.NewToolWithUnstructuredContent(name, title, description, loggerFactory, Handler(usecase, globalMATLAB)),
+		ToolWithUnstructuredContentOutput: basetool.NewToolWithUnstructuredContent(name, title, description, loggerFactory, Handler(usecase, globalMATLAB, figureRenderer, workspaceDiffer, variableReader)),
 	}
 }
 
-func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithUnstructuredContentOutput[Args] {
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB, figureRenderer FigureRenderer, workspaceDiffer WorkspaceDiffer, variableReader VariableReader) basetool.HandlerWithUnstructuredContentOutput[Args] {
 	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (tools.RichContent, error) {
 		sessionLogger.Info("Executing Eval tool")
 		defer sessionLogger.Info("Done - Executing Eval tool")
@@ -48,6 +67,86 @@ func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.Handl
 			return tools.RichContent{}, err
 		}
 
-		return responseconverter.ConvertEvalResponseToRichContent(response), nil
+		content := responseconverter.ConvertEvalResponseToRichContent(response)
+
+		// The figures are rendered in the background, so that the result is not delayed by the rendering.
+		content.ResourceLinks, err = figureRenderer.Submit(ctx, sessionLogger, client)
+		if err != nil {
+			sessionLogger.WithError(err).Warn("Failed to queue figure rendering")
+		}
+
+		// A failure to diff the workspace does not fail the evaluation, which already ran.
+		diff, err := workspaceDiffer.Execute(ctx, sessionLogger, client)
+		if err != nil {
+			sessionLogger.WithError(err).Warn("Failed to diff MATLAB workspace")
+		} else if !diff.Empty() {
+			content.TextContent = append(content.TextContent, formatWorkspaceDiff(diff))
+		}
+
+		// A failure to read a variable, such as a name the code did not assign, does not fail the evaluation, which already ran.
+		for _, name := range inputs.ReturnVariables {
+			variable, err := variableReader.Execute(ctx, sessionLogger, client, getmatlabvariable.Args{Name: name})
+			if err != nil {
+				sessionLogger.WithError(err).With("variable", name).Warn("Failed to read MATLAB variable")
+				content.TextContent = append(content.TextContent, fmt.Sprintf("Variable %s could not be read: %v", name, err))
+				continue
+			}
+
+			content.TextContent = append(content.TextContent, formatReturnedVariable(variable))
+			if variable.Encoding == getmatlabvariable.EncodingMAT {
+				content.ResourceLinks = append(content.ResourceLinks, tools.ResourceLink{
+					URI:      variable.Artifact.URI,
+					Name:     variable.Artifact.Name,
+					MIMEType: variable.Artifact.MIMEType,
+				})
+			}
+		}
+
+		return content, nil
+	}
+}
+
+// formatReturnedVariable describes a variable read after the evaluation, followed by its typed JSON, its preview, or the
+// MAT-file holding it.
+func formatReturnedVariable(variable getmatlabvariable.ReturnArgs) string {
+	header := fmt.Sprintf("Variable %s (%s %s, %s encoding)", variable.Name, formatSize(variable.Size), variable.Class, variable.Encoding)
+	if variable.Encoding == getmatlabvariable.EncodingMAT {
+		return fmt.Sprintf("%s: saved to %s, also available as the %s resource", header, variable.Artifact.Path, variable.Artifact.URI)
+	}
+	return header + ":\n" + string(variable.Data)
+}
+
+// formatWorkspaceDiff lists the changed variables, one per line, with the preview of their values.
+func formatWorkspaceDiff(diff diffmatlabworkspace.ReturnArgs) string {
+	var builder strings.Builder
+	builder.WriteString("Workspace changes:")
+
+	for _, variable := range diff.Added {
+		builder.WriteString("\n" + formatVariable("Added", variable))
+	}
+	for _, variable := range diff.Modified {
+		builder.WriteString("\n" + formatVariable("Modified", variable))
+	}
+	for _, name := range diff.Removed {
+		builder.WriteString("\nRemoved " + name)
+	}
+
+	return builder.String()
+}
+
+func formatVariable(change string, variable diffmatlabworkspace.Variable) string {
+	line := fmt.Sprintf("%s %s (%s %s, %d bytes)", change, variable.Name, formatSize(variable.Size), variable.Class, variable.Bytes)
+	if variable.Preview != "" {
+		line += ": " + variable.Preview
+	}
+	return line
+}
+
+// formatSize formats the size of a variable as MATLAB displays it, such as 1x3.
+func formatSize(size []int) string {
+	dimensions := make([]string, len(size))
+	for i, dimension := range size {
+		dimensions[i] = fmt.Sprint(dimension)
 	}
+	return strings.Join(dimensions, "x")
 }
@@ -5,10 +5,14 @@ package evalmatlabcode_test
 import (
 	"testing"
 
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/evalmatlabcode"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/diffmatlabworkspace"
 	evalmatlabcodeusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/evalmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getmatlabvariable"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/artifactstore"
 	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/evalmatlabcode"
 	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
@@ -29,13 +33,22 @@ func TestNew_HappyPath(t *testing.T) {
 	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
 	defer mockGlobalMATLAB.AssertExpectations(t)
 
+	mockFigureRenderer := &mocks.MockFigureRenderer{}
+	defer mockFigureRenderer.AssertExpectations(t)
+
+	mockWorkspaceDiffer := &mocks.MockWorkspaceDiffer{}
+	defer mockWorkspaceDiffer.AssertExpectations(t)
+
+	mockVariableReader := &mocks.MockVariableReader{}
+	defer mockVariableReader.AssertExpectations(t)
+
 	mockLoggerFactory.EXPECT().
 		GetGlobalLogger().
 		Return(mockLogger).
 		Once()
 
 	// Act
-	tool := evalmatlabcode.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+	tool := evalmatlabcode.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB, mockFigureRenderer, mockWorkspaceDiffer, mockVariableReader)
 
 	// Assert
 	assert.NotNil(t, tool)
@@ -51,6 +64,15 @@ func TestTool_Handler_HappyPath(t *testing.T) {
 	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
 	defer mockGlobalMATLAB.AssertExpectations(t)
 
+	mockFigureRenderer := &mocks.MockFigureRenderer{}
+	defer mockFigureRenderer.AssertExpectations(t)
+
+	mockWorkspaceDiffer := &mocks.MockWorkspaceDiffer{}
+	defer mockWorkspaceDiffer.AssertExpectations(t)
+
+	mockVariableReader := &mocks.MockVariableReader{}
+	defer mockVariableReader.AssertExpectations(t)
+
 	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockMATLABSessionClient.AssertExpectations(t)
 
@@ -85,8 +107,18 @@ func TestTool_Handler_HappyPath(t *testing.T) {
 		ProjectPath: projectPath,
 	}
 
+	mockFigureRenderer.EXPECT().
+		Submit(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient).
+		Return(nil, nil).
+		Once()
+
+	mockWorkspaceDiffer.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient).
+		Return(diffmatlabworkspace.ReturnArgs{}, nil).
+		Once()
+
 	// Act
-	result, err := evalmatlabcode.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+	result, err := evalmatlabcode.Handler(mockUsecase, mockGlobalMATLAB, mockFigureRenderer, mockWorkspaceDiffer, mockVariableReader)(ctx, mockLogger, args)
 
 	// Assert
 	require.NoError(t, err, "Handler should not return an error")
@@ -109,6 +141,15 @@ func TestTool_Handler_ClientReturnsError(t *testing.T) {
 	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
 	defer mockGlobalMATLAB.AssertExpectations(t)
 
+	mockFigureRenderer := &mocks.MockFigureRenderer{}
+	defer mockFigureRenderer.AssertExpectations(t)
+
+	mockWorkspaceDiffer := &mocks.MockWorkspaceDiffer{}
+	defer mockWorkspaceDiffer.AssertExpectations(t)
+
+	mockVariableReader := &mocks.MockVariableReader{}
+	defer mockVariableReader.AssertExpectations(t)
+
 	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockMATLABSessionClient.AssertExpectations(t)
 
@@ -128,7 +169,7 @@ func TestTool_Handler_ClientReturnsError(t *testing.T) {
 	}
 
 	// Act
-	result, err := evalmatlabcode.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+	result, err := evalmatlabcode.Handler(mockUsecase, mockGlobalMATLAB, mockFigureRenderer, mockWorkspaceDiffer, mockVariableReader)(ctx, mockLogger, args)
 
 	// Assert
 	require.ErrorIs(t, err, expectedError, "Handler should return an error")
@@ -145,6 +186,15 @@ func TestTool_Handler_UsecaseReturnsError(t *testing.T) {
 	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
 	defer mockGlobalMATLAB.AssertExpectations(t)
 
+	mockFigureRenderer := &mocks.MockFigureRenderer{}
+	defer mockFigureRenderer.AssertExpectations(t)
+
+	mockWorkspaceDiffer := &mocks.MockWorkspaceDiffer{}
+	defer mockWorkspaceDiffer.AssertExpectations(t)
+
+	mockVariableReader := &mocks.MockVariableReader{}
+	defer mockVariableReader.AssertExpectations(t)
+
 	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockMATLABSessionClient.AssertExpectations(t)
 
@@ -177,7 +227,7 @@ func TestTool_Handler_UsecaseReturnsError(t *testing.T) {
 	}
 
 	// Act
-	result, err := evalmatlabcode.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+	result, err := evalmatlabcode.Handler(mockUsecase, mockGlobalMATLAB, mockFigureRenderer, mockWorkspaceDiffer, mockVariableReader)(ctx, mockLogger, args)
 
 	// Assert
 	require.ErrorIs(t, err, expectedError, "Handler should return an error")
@@ -194,6 +244,15 @@ func TestTool_Handler_UsecaseReturnsEmptyResponse(t *testing.T) {
 	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
 	defer mockGlobalMATLAB.AssertExpectations(t)
 
+	mockFigureRenderer := &mocks.MockFigureRenderer{}
+	defer mockFigureRenderer.AssertExpectations(t)
+
+	mockWorkspaceDiffer := &mocks.MockWorkspaceDiffer{}
+	defer mockWorkspaceDiffer.AssertExpectations(t)
+
+	mockVariableReader := &mocks.MockVariableReader{}
+	defer mockVariableReader.AssertExpectations(t)
+
 	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockMATLABSessionClient.AssertExpectations(t)
 
@@ -224,12 +283,22 @@ func TestTool_Handler_UsecaseReturnsEmptyResponse(t *testing.T) {
 		Return(emptyResponse, nil).
 		Once()
 
+	mockFigureRenderer.EXPECT().
+		Submit(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient).
+		Return(nil, nil).
+		Once()
+
+	mockWorkspaceDiffer.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient).
+		Return(diffmatlabworkspace.ReturnArgs{}, nil).
+		Once()
+
 	// Act
 	args := evalmatlabcode.Args{
 		Code:        code,
 		ProjectPath: projectPath,
 	}
-	result, err := evalmatlabcode.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+	result, err := evalmatlabcode.Handler(mockUsecase, mockGlobalMATLAB, mockFigureRenderer, mockWorkspaceDiffer, mockVariableReader)(ctx, mockLogger, args)
 
 	// Assert
 	require.NoError(t, err, "Handler should not return an error")
@@ -238,3 +307,354 @@ func TestTool_Handler_UsecaseReturnsEmptyResponse(t *testing.T) {
 	assert.Empty(t, result.TextContent[0], "Text content should be empty")
 	assert.Empty(t, result.ImageContent, "Image content should be empty")
 }
+
+func TestTool_Handler_ReturnsFigureLinks(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockFigureRenderer := &mocks.MockFigureRenderer{}
+	defer mockFigureRenderer.AssertExpectations(t)
+
+	mockWorkspaceDiffer := &mocks.MockWorkspaceDiffer{}
+	defer mockWorkspaceDiffer.AssertExpectations(t)
+
+	mockVariableReader := &mocks.MockVariableReader{}
+	defer mockVariableReader.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	args := evalmatlabcode.Args{
+		Code:        "plot(1:10)",
+		ProjectPath: "/some/path",
+	}
+	expectedLinks := []tools.ResourceLink{
+		{URI: "matlab://figures/1", Name: "Figure 1", MIMEType: "image/png"},
+	}
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, evalmatlabcodeusecase.Args{
+			Code:        args.Code,
+			ProjectPath: args.ProjectPath,
+		}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockFigureRenderer.EXPECT().
+		Submit(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient).
+		Return(expectedLinks, nil).
+		Once()
+
+	mockWorkspaceDiffer.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient).
+		Return(diffmatlabworkspace.ReturnArgs{}, nil).
+		Once()
+
+	// Act
+	result, err := evalmatlabcode.Handler(mockUsecase, mockGlobalMATLAB, mockFigureRenderer, mockWorkspaceDiffer, mockVariableReader)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedLinks, result.ResourceLinks)
+}
+
+func TestTool_Handler_FigureRendererReturnsError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockFigureRenderer := &mocks.MockFigureRenderer{}
+	defer mockFigureRenderer.AssertExpectations(t)
+
+	mockWorkspaceDiffer := &mocks.MockWorkspaceDiffer{}
+	defer mockWorkspaceDiffer.AssertExpectations(t)
+
+	mockVariableReader := &mocks.MockVariableReader{}
+	defer mockVariableReader.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	args := evalmatlabcode.Args{
+		Code:        "x = 1",
+		ProjectPath: "/some/path",
+	}
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, evalmatlabcodeusecase.Args{
+			Code:        args.Code,
+			ProjectPath: args.ProjectPath,
+		}).
+		Return(entities.EvalResponse{ConsoleOutput: "x = 1"}, nil).
+		Once()
+
+	mockFigureRenderer.EXPECT().
+		Submit(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient).
+		Return(nil, assert.AnError).
+		Once()
+
+	mockWorkspaceDiffer.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient).
+		Return(diffmatlabworkspace.ReturnArgs{}, nil).
+		Once()
+
+	// Act
+	result, err := evalmatlabcode.Handler(mockUsecase, mockGlobalMATLAB, mockFigureRenderer, mockWorkspaceDiffer, mockVariableReader)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err, "A failure to queue the figures should not fail the evaluation")
+	assert.Equal(t, []string{"x = 1"}, result.TextContent)
+	assert.Empty(t, result.ResourceLinks)
+	assert.Contains(t, mockLogger.WarnLogs(), "Failed to queue figure rendering")
+}
+
+func TestTool_Handler_ReturnsWorkspaceDiff(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockFigureRenderer := &mocks.MockFigureRenderer{}
+	defer mockFigureRenderer.AssertExpectations(t)
+
+	mockWorkspaceDiffer := &mocks.MockWorkspaceDiffer{}
+	defer mockWorkspaceDiffer.AssertExpectations(t)
+
+	mockVariableReader := &mocks.MockVariableReader{}
+	defer mockVariableReader.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	args := evalmatlabcode.Args{
+		Code:        "x = 5; data = rand(1000); clear tmp",
+		ProjectPath: "/some/path",
+	}
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, evalmatlabcodeusecase.Args{
+			Code:        args.Code,
+			ProjectPath: args.ProjectPath,
+		}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockFigureRenderer.EXPECT().
+		Submit(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient).
+		Return(nil, nil).
+		Once()
+
+	mockWorkspaceDiffer.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient).
+		Return(diffmatlabworkspace.ReturnArgs{
+			Added: []diffmatlabworkspace.Variable{
+				{Name: "x", Class: "double", Size: []int{1, 1}, Bytes: 8, Preview: "5"},
+			},
+			Modified: []diffmatlabworkspace.Variable{
+				{Name: "data", Class: "double", Size: []int{1000, 1000}, Bytes: 8000000},
+			},
+			Removed: []string{"tmp"},
+		}, nil).
+		Once()
+
+	// Act
+	result, err := evalmatlabcode.Handler(mockUsecase, mockGlobalMATLAB, mockFigureRenderer, mockWorkspaceDiffer, mockVariableReader)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.TextContent, 2)
+	assert.Equal(t, "Workspace changes:\nAdded x (1x1 double, 8 bytes): 5\nModified data (1000x1000 double, 8000000 bytes)\nRemoved tmp", result.TextContent[1])
+}
+
+func TestTool_Handler_WorkspaceDifferReturnsError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockFigureRenderer := &mocks.MockFigureRenderer{}
+	defer mockFigureRenderer.AssertExpectations(t)
+
+	mockWorkspaceDiffer := &mocks.MockWorkspaceDiffer{}
+	defer mockWorkspaceDiffer.AssertExpectations(t)
+
+	mockVariableReader := &mocks.MockVariableReader{}
+	defer mockVariableReader.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	args := evalmatlabcode.Args{
+		Code:        "x = 1",
+		ProjectPath: "/some/path",
+	}
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, evalmatlabcodeusecase.Args{
+			Code:        args.Code,
+			ProjectPath: args.ProjectPath,
+		}).
+		Return(entities.EvalResponse{ConsoleOutput: "x = 1"}, nil).
+		Once()
+
+	mockFigureRenderer.EXPECT().
+		Submit(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient).
+		Return(nil, nil).
+		Once()
+
+	mockWorkspaceDiffer.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient).
+		Return(diffmatlabworkspace.ReturnArgs{}, assert.AnError).
+		Once()
+
+	// Act
+	result, err := evalmatlabcode.Handler(mockUsecase, mockGlobalMATLAB, mockFigureRenderer, mockWorkspaceDiffer, mockVariableReader)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err, "A failure to diff the workspace should not fail the evaluation")
+	assert.Equal(t, []string{"x = 1"}, result.TextContent)
+	assert.Contains(t, mockLogger.WarnLogs(), "Failed to diff MATLAB workspace")
+}
+
+func TestTool_Handler_ReturnsVariables(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockFigureRenderer := &mocks.MockFigureRenderer{}
+	defer mockFigureRenderer.AssertExpectations(t)
+
+	mockWorkspaceDiffer := &mocks.MockWorkspaceDiffer{}
+	defer mockWorkspaceDiffer.AssertExpectations(t)
+
+	mockVariableReader := &mocks.MockVariableReader{}
+	defer mockVariableReader.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	args := evalmatlabcode.Args{
+		Code:            "x = [1 2 3]; data = rand(1000);",
+		ProjectPath:     "/some/path",
+		ReturnVariables: []string{"x", "data", "missing"},
+	}
+	artifact := artifactstore.Artifact{
+		Name:     "tp1234.mat",
+		URI:      artifactstore.URIPrefix + "tp1234.mat",
+		Path:     "/tmp/artifacts/tp1234.mat",
+		MIMEType: getmatlabvariable.MATMIMEType,
+	}
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, evalmatlabcodeusecase.Args{
+			Code:        args.Code,
+			ProjectPath: args.ProjectPath,
+		}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockFigureRenderer.EXPECT().
+		Submit(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient).
+		Return(nil, nil).
+		Once()
+
+	mockWorkspaceDiffer.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient).
+		Return(diffmatlabworkspace.ReturnArgs{}, nil).
+		Once()
+
+	mockVariableReader.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, getmatlabvariable.Args{Name: "x"}).
+		Return(getmatlabvariable.ReturnArgs{
+			Name:     "x",
+			Class:    "double",
+			Size:     []int{1, 3},
+			Bytes:    24,
+			Encoding: getmatlabvariable.EncodingTyped,
+			Data:     []byte(`{"class":"double","size":[1,3],"data":[1,2,3]}`),
+		}, nil).
+		Once()
+
+	mockVariableReader.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, getmatlabvariable.Args{Name: "data"}).
+		Return(getmatlabvariable.ReturnArgs{
+			Name:     "data",
+			Class:    "double",
+			Size:     []int{1000, 1000},
+			Bytes:    8000000,
+			Encoding: getmatlabvariable.EncodingMAT,
+			Artifact: artifact,
+		}, nil).
+		Once()
+
+	mockVariableReader.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, getmatlabvariable.Args{Name: "missing"}).
+		Return(getmatlabvariable.ReturnArgs{}, assert.AnError).
+		Once()
+
+	// Act
+	result, err := evalmatlabcode.Handler(mockUsecase, mockGlobalMATLAB, mockFigureRenderer, mockWorkspaceDiffer, mockVariableReader)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err, "A failure to read a variable should not fail the evaluation")
+	require.Len(t, result.TextContent, 4)
+	assert.Equal(t, "Variable x (1x3 double, typed encoding):\n{\"class\":\"double\",\"size\":[1,3],\"data\":[1,2,3]}", result.TextContent[1])
+	assert.Equal(t, "Variable data (1000x1000 double, mat encoding): saved to /tmp/artifacts/tp1234.mat, also available as the "+artifact.URI+" resource", result.TextContent[2])
+	assert.Equal(t, "Variable missing could not be read: "+assert.AnError.Error(), result.TextContent[3])
+	assert.Equal(t, []tools.ResourceLink{{URI: artifact.URI, Name: artifact.Name, MIMEType: artifact.MIMEType}}, result.ResourceLinks)
+	assert.Contains(t, mockLogger.WarnLogs(), "Failed to read MATLAB variable")
+}
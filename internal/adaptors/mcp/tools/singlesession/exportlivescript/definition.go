@@ -0,0 +1,21 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package exportlivescript
+
+const (
+	name        = "export_live_script"
+	title       = "Export Live Script"
+	description = "Export the code run in this session with `evaluate_matlab_code`, `run_matlab_file`, `run_matlab_test_file` and `run_python_code`, in the order it ran, with its outputs and figures, as a script (`file_path`) the user can run again. A .mlx file is a live script, converted by MATLAB; a .m file is a script with publish markup. Each call is a section, followed by its output and figures, which are written as image files next to the script. The code of failed calls is included as text, so that the script runs to its end. Use it at the end of a task, to give the user a reproducible record of what was done. Existing files are only replaced when `overwrite` is set."
+)
+
+type Args struct {
+	FilePath  string `json:"file_path"           jsonschema:"The full absolute path of the .mlx or .m file to write - Folder must exist - Example: C:\\Users\\username\\matlab\\project\\analysis.mlx or /home/user/project/analysis.mlx."`
+	Title     string `json:"title,omitempty"     jsonschema:"The title of the script. Defaults to the name of the file."`
+	Overwrite bool   `json:"overwrite,omitempty" jsonschema:"Whether to replace an existing file. Defaults to false."`
+}
+
+type ReturnArgs struct {
+	FilePath string   `json:"file_path"         jsonschema:"The full absolute path of the written file."`
+	Cells    int      `json:"cells"             jsonschema:"The number of calls exported."`
+	Figures  []string `json:"figures,omitempty" jsonschema:"The full absolute paths of the figures written next to the script."`
+}
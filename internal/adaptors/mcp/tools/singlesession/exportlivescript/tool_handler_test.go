@@ -0,0 +1,132 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package exportlivescript_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/exportlivescript"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	exportlivescriptusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/exportlivescript"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/exportlivescript"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := exportlivescript.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, exportlivescriptusecase.Args{FilePath: "/home/user/project/analysis.mlx", Title: "Analysis"}).
+		Return(exportlivescriptusecase.ReturnArgs{FilePath: "/home/user/project/analysis.mlx", Cells: 2, Figures: []string{"/home/user/project/analysis_step2_figure1.png"}}, nil).
+		Once()
+
+	// Act
+	result, err := exportlivescript.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, exportlivescript.Args{FilePath: "/home/user/project/analysis.mlx", Title: "Analysis"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, exportlivescript.ReturnArgs{FilePath: "/home/user/project/analysis.mlx", Cells: 2, Figures: []string{"/home/user/project/analysis_step2_figure1.png"}}, result)
+}
+
+func TestTool_Handler_ClientError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	result, err := exportlivescript.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, exportlivescript.Args{FilePath: "/home/user/project/analysis.mlx", Title: "Analysis"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, exportlivescriptusecase.Args{FilePath: "/home/user/project/analysis.mlx", Title: "Analysis"}).
+		Return(exportlivescriptusecase.ReturnArgs{}, assert.AnError).
+		Once()
+
+	// Act
+	result, err := exportlivescript.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, exportlivescript.Args{FilePath: "/home/user/project/analysis.mlx", Title: "Analysis"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
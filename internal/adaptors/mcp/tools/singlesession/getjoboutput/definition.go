@@ -0,0 +1,20 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getjoboutput
+
+const (
+	name        = "get_job_output"
+	title       = "Get Background Job Output"
+	description = "Return the command window output of a background job started with `start_job`, given its job ID (`job_id`), with the state of the job. Jobs running in the MATLAB session only return their output once they are finished. Jobs running with parfeval return the output produced so far. Set `wait_seconds` to wait for the job to finish first: the output that a parfeval job produces while the call waits is also sent as progress notifications, when the client asks for them."
+)
+
+type Args struct {
+	JobID       string `json:"job_id"                 jsonschema:"The ID of the background job, as returned by start_job."`
+	WaitSeconds int    `json:"wait_seconds,omitempty" jsonschema:"The maximum number of seconds to wait for the job to finish before returning, up to 300. Returns as soon as the job finishes. Defaults to 0, to return at once."`
+}
+
+type ReturnArgs struct {
+	JobID  string `json:"job_id" jsonschema:"The ID of the background job."`
+	State  string `json:"state"  jsonschema:"The state of the job: queued, running, completed, failed or cancelled."`
+	Output string `json:"output" jsonschema:"The command window output of the job."`
+}
@@ -0,0 +1,48 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getjoboutput
+
+import (
+	"context"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getjob"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/jobmanager"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, request getjob.Args) (jobmanager.Job, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase)),
+	}
+}
+
+func Handler(usecase Usecase) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		job, err := usecase.Execute(ctx, sessionLogger, getjob.Args{
+			JobID: inputs.JobID,
+			Wait:  time.Duration(inputs.WaitSeconds) * time.Second,
+		})
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		return ReturnArgs{
+			JobID:  job.ID,
+			State:  string(job.State),
+			Output: job.Output,
+		}, nil
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getjoboutput_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/getjoboutput"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getjob"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/jobmanager"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/getjoboutput"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := getjoboutput.New(mockLoggerFactory, mockUsecase)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), getjob.Args{JobID: "job-1"}).
+		Return(jobmanager.Job{ID: "job-1", Mode: jobmanager.ModeBatch, State: jobmanager.StateCompleted, Output: "Sweep done"}, nil).
+		Once()
+
+	// Act
+	result, err := getjoboutput.Handler(mockUsecase)(ctx, mockLogger, getjoboutput.Args{JobID: "job-1"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, getjoboutput.ReturnArgs{JobID: "job-1", State: "completed", Output: "Sweep done"}, result)
+}
+
+func TestTool_Handler_WaitsForJob(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), getjob.Args{JobID: "job-1", Wait: 30 * time.Second}).
+		Return(jobmanager.Job{ID: "job-1", Mode: jobmanager.ModeSession, State: jobmanager.StateCompleted, Output: "Sweep done"}, nil).
+		Once()
+
+	// Act
+	result, err := getjoboutput.Handler(mockUsecase)(ctx, mockLogger, getjoboutput.Args{JobID: "job-1", WaitSeconds: 30})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, getjoboutput.ReturnArgs{JobID: "job-1", State: "completed", Output: "Sweep done"}, result)
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), getjob.Args{JobID: "job-42"}).
+		Return(jobmanager.Job{}, assert.AnError).
+		Once()
+
+	// Act
+	result, err := getjoboutput.Handler(mockUsecase)(ctx, mockLogger, getjoboutput.Args{JobID: "job-42"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
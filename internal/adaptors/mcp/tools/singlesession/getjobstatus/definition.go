@@ -0,0 +1,24 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getjobstatus
+
+const (
+	name        = "get_job_status"
+	title       = "Get Background Job Status"
+	description = "Return the state of a background job started with `start_job`, given its job ID (`job_id`): queued, running, completed, failed or cancelled. Also returns the error message of a failed job, and the size of the output it produced so far. Read the output with `get_job_output`. Rather than calling it repeatedly, set `wait_seconds` to wait for the job to finish."
+)
+
+type Args struct {
+	JobID       string `json:"job_id"                 jsonschema:"The ID of the background job, as returned by start_job."`
+	WaitSeconds int    `json:"wait_seconds,omitempty" jsonschema:"The maximum number of seconds to wait for the job to finish before returning, up to 300. Returns as soon as the job finishes. Defaults to 0, to return at once."`
+}
+
+type ReturnArgs struct {
+	JobID       string `json:"job_id"                jsonschema:"The ID of the background job."`
+	Mode        string `json:"mode"                  jsonschema:"Where the job runs: session, batch or parfeval."`
+	State       string `json:"state"                 jsonschema:"The state of the job: queued, running, completed, failed or cancelled."`
+	StartedAt   string `json:"started_at"            jsonschema:"When the job was started, in RFC 3339 format."`
+	FinishedAt  string `json:"finished_at,omitempty" jsonschema:"When the job finished, in RFC 3339 format. Empty while the job is queued or running."`
+	Error       string `json:"error,omitempty"       jsonschema:"The error message of a failed job."`
+	OutputBytes int    `json:"output_bytes"          jsonschema:"The size in bytes of the command window output available for the job."`
+}
@@ -0,0 +1,166 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getjobstatus_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/getjobstatus"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getjob"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/jobmanager"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/getjobstatus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := getjobstatus.New(mockLoggerFactory, mockUsecase)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_RunningJob(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	ctx := t.Context()
+	startedAt := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), getjob.Args{JobID: "job-1"}).
+		Return(jobmanager.Job{
+			ID:        "job-1",
+			Mode:      jobmanager.ModeParfeval,
+			State:     jobmanager.StateRunning,
+			StartedAt: startedAt,
+			Output:    "Iteration 1\n",
+		}, nil).
+		Once()
+
+	// Act
+	result, err := getjobstatus.Handler(mockUsecase)(ctx, mockLogger, getjobstatus.Args{JobID: "job-1"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, getjobstatus.ReturnArgs{
+		JobID:       "job-1",
+		Mode:        "parfeval",
+		State:       "running",
+		StartedAt:   "2025-06-01T09:00:00Z",
+		OutputBytes: 12,
+	}, result)
+}
+
+func TestTool_Handler_WaitsForJob(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	ctx := t.Context()
+	startedAt := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+	finishedAt := startedAt.Add(time.Minute)
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), getjob.Args{JobID: "job-1", Wait: 2 * time.Minute}).
+		Return(jobmanager.Job{
+			ID:         "job-1",
+			Mode:       jobmanager.ModeSession,
+			State:      jobmanager.StateCompleted,
+			StartedAt:  startedAt,
+			FinishedAt: finishedAt,
+			Output:     "Sweep done",
+		}, nil).
+		Once()
+
+	// Act
+	result, err := getjobstatus.Handler(mockUsecase)(ctx, mockLogger, getjobstatus.Args{JobID: "job-1", WaitSeconds: 120})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, getjobstatus.ReturnArgs{
+		JobID:       "job-1",
+		Mode:        "session",
+		State:       "completed",
+		StartedAt:   "2025-06-01T09:00:00Z",
+		FinishedAt:  "2025-06-01T09:01:00Z",
+		OutputBytes: 10,
+	}, result)
+}
+
+func TestTool_Handler_FailedJob(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	ctx := t.Context()
+	startedAt := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), getjob.Args{JobID: "job-1"}).
+		Return(jobmanager.Job{
+			ID:         "job-1",
+			Mode:       jobmanager.ModeSession,
+			State:      jobmanager.StateFailed,
+			StartedAt:  startedAt,
+			FinishedAt: startedAt.Add(2 * time.Hour),
+			Error:      "Undefined function 'runSweep'.",
+		}, nil).
+		Once()
+
+	// Act
+	result, err := getjobstatus.Handler(mockUsecase)(ctx, mockLogger, getjobstatus.Args{JobID: "job-1"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "failed", result.State)
+	assert.Equal(t, "2025-06-01T11:00:00Z", result.FinishedAt)
+	assert.Equal(t, "Undefined function 'runSweep'.", result.Error)
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), getjob.Args{JobID: "job-42"}).
+		Return(jobmanager.Job{}, assert.AnError).
+		Once()
+
+	// Act
+	result, err := getjobstatus.Handler(mockUsecase)(ctx, mockLogger, getjobstatus.Args{JobID: "job-42"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
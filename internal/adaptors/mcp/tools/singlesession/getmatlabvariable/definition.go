@@ -0,0 +1,24 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getmatlabvariable
+
+const (
+	name        = "get_matlab_variable"
+	title       = "Get MATLAB Variable"
+	description = "Read a variable (`name`) of the workspace of the MATLAB session, with its class, size and number of bytes. Small values are returned as typed JSON (`typed` encoding), which keeps the class and size of the value and of its elements, and can be passed back unchanged to `set_matlab_variable`. Larger values are saved to a MAT-file, returned as an artifact (`mat` encoding). Huge values are summarized instead, with statistics and a sample of their elements (`preview` encoding)."
+)
+
+type Args struct {
+	Name string `json:"name" jsonschema:"The name of the variable - Example: results."`
+}
+
+type ReturnArgs struct {
+	Name         string `json:"name"                    jsonschema:"The name of the variable."`
+	Class        string `json:"class"                   jsonschema:"The class of the variable - Example: double."`
+	Size         []int  `json:"size"                    jsonschema:"The size of the variable - Example: [1, 3]."`
+	Bytes        int    `json:"bytes"                   jsonschema:"The number of bytes of the variable in MATLAB."`
+	Encoding     string `json:"encoding"                jsonschema:"How the value is returned: typed, mat or preview."`
+	Value        any    `json:"value,omitempty"         jsonschema:"The typed JSON of the value, or its preview. Empty for the mat encoding."`
+	ArtifactURI  string `json:"artifact_uri,omitempty"  jsonschema:"The URI of the resource of the MAT-file holding the variable, for the mat encoding."`
+	ArtifactPath string `json:"artifact_path,omitempty" jsonschema:"The path of the MAT-file holding the variable, for the mat encoding."`
+}
@@ -0,0 +1,70 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getmatlabvariable
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getmatlabvariable"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request getmatlabvariable.Args) (getmatlabvariable.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Get MATLAB Variable tool")
+		defer sessionLogger.Info("Done - Executing Get MATLAB Variable tool")
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		response, err := usecase.Execute(ctx, sessionLogger, client, getmatlabvariable.Args{
+			Name: inputs.Name,
+		})
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		result := ReturnArgs{
+			Name:     response.Name,
+			Class:    response.Class,
+			Size:     response.Size,
+			Bytes:    response.Bytes,
+			Encoding: string(response.Encoding),
+		}
+
+		if response.Encoding == getmatlabvariable.EncodingMAT {
+			result.ArtifactURI = response.Artifact.URI
+			result.ArtifactPath = response.Artifact.Path
+			return result, nil
+		}
+
+		if err := json.Unmarshal(response.Data, &result.Value); err != nil {
+			return ReturnArgs{}, fmt.Errorf("failed to parse the value of %s: %w", response.Name, err)
+		}
+
+		return result, nil
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getmatlabvariable_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/getmatlabvariable"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	getmatlabvariableusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/getmatlabvariable"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/artifactstore"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/getmatlabvariable"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := getmatlabvariable.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, getmatlabvariableusecase.Args{Name: "x"}).
+		Return(getmatlabvariableusecase.ReturnArgs{
+			Name:     "x",
+			Class:    "double",
+			Size:     []int{1, 2},
+			Bytes:    16,
+			Encoding: getmatlabvariableusecase.EncodingTyped,
+			Data:     []byte(`{"class":"double","size":[1,2],"data":[1,"NaN"]}`),
+		}, nil).
+		Once()
+
+	// Act
+	result, err := getmatlabvariable.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, getmatlabvariable.Args{Name: "x"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, getmatlabvariable.ReturnArgs{
+		Name:     "x",
+		Class:    "double",
+		Size:     []int{1, 2},
+		Bytes:    16,
+		Encoding: "typed",
+		Value: map[string]any{
+			"class": "double",
+			"size":  []any{1.0, 2.0},
+			"data":  []any{1.0, "NaN"},
+		},
+	}, result)
+}
+
+func TestTool_Handler_MATVariable(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, getmatlabvariableusecase.Args{Name: "big"}).
+		Return(getmatlabvariableusecase.ReturnArgs{
+			Name:     "big",
+			Class:    "double",
+			Size:     []int{1000, 1000},
+			Bytes:    8000000,
+			Encoding: getmatlabvariableusecase.EncodingMAT,
+			Artifact: artifactstore.Artifact{
+				URI:  "artifact://big.mat",
+				Path: "/tmp/artifacts/big.mat",
+			},
+		}, nil).
+		Once()
+
+	// Act
+	result, err := getmatlabvariable.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, getmatlabvariable.Args{Name: "big"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, getmatlabvariable.ReturnArgs{
+		Name:         "big",
+		Class:        "double",
+		Size:         []int{1000, 1000},
+		Bytes:        8000000,
+		Encoding:     "mat",
+		ArtifactURI:  "artifact://big.mat",
+		ArtifactPath: "/tmp/artifacts/big.mat",
+	}, result)
+}
+
+func TestTool_Handler_ClientError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	_, err := getmatlabvariable.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, getmatlabvariable.Args{Name: "x"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
@@ -0,0 +1,26 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getpythonenvironment
+
+const (
+	name        = "get_python_environment"
+	title       = "Get Python Environment"
+	description = "Return the Python environment of the MATLAB session, as shown by MATLAB's pyenv function: the Python version, executable, library and home folder, whether Python is loaded, and whether it runs in the MATLAB process (InProcess) or in a separate process (OutOfProcess). Python is not loaded by this call. Use it before calling Python with `run_python_code` or with the py. interface of MATLAB."
+)
+
+type Args struct {
+}
+
+type ReturnArgs struct {
+	Environment Environment `json:"environment" jsonschema:"The Python environment of the MATLAB session."`
+}
+
+type Environment struct {
+	Version       string `json:"version"                 jsonschema:"The version of Python - Empty when MATLAB found no Python."`
+	Executable    string `json:"executable"              jsonschema:"The path of the Python executable."`
+	Library       string `json:"library"                 jsonschema:"The path of the Python library."`
+	Home          string `json:"home"                    jsonschema:"The home folder of Python."`
+	Status        string `json:"status"                  jsonschema:"Loaded once MATLAB has used Python, NotLoaded before, and Terminated after an out-of-process Python was stopped."`
+	ExecutionMode string `json:"execution_mode"          jsonschema:"InProcess or OutOfProcess."`
+	ProcessID     string `json:"process_id,omitempty"    jsonschema:"The ID of the process running Python, while it is loaded."`
+}
@@ -0,0 +1,63 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getpythonenvironment
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/pythonenv"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) (pythonenv.Environment, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Get Python Environment tool")
+		defer sessionLogger.Info("Done - Executing Get Python Environment tool")
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		environment, err := usecase.Execute(ctx, sessionLogger, client)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		return ReturnArgs{
+			Environment: NewEnvironment(environment),
+		}, nil
+	}
+}
+
+// NewEnvironment converts the Python environment of the MATLAB session to its representation in tool results.
+func NewEnvironment(environment pythonenv.Environment) Environment {
+	return Environment{
+		Version:       environment.Version,
+		Executable:    environment.Executable,
+		Library:       environment.Library,
+		Home:          environment.Home,
+		Status:        environment.Status,
+		ExecutionMode: string(environment.ExecutionMode),
+		ProcessID:     environment.ProcessID,
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getsimulinkblockparameters
+
+const (
+	name        = "get_simulink_block_parameters"
+	title       = "Get Simulink Block Parameters"
+	description = "Get the dialog parameters of a block (`block_path`) of a Simulink model (`model_path`) loaded in the MATLAB session, with their values as text, as entered in the block dialog. The model must be loaded first with `load_simulink_model`. Return the full path and type of the block, with its parameters."
+)
+
+type Args struct {
+	ModelPath string `json:"model_path" jsonschema:"The full absolute path to the Simulink model - Must be a .slx or .mdl file - Example: C:\\Users\\username\\models\\plant.slx or /home/user/models/plant.slx."`
+	BlockPath string `json:"block_path" jsonschema:"The path of the block, relative to the model or starting with its name - Example: Controller/Gain or plant/Controller/Gain."`
+}
+
+type BlockParameter struct {
+	Name  string `json:"name"  jsonschema:"The name of the parameter."`
+	Value string `json:"value" jsonschema:"The value of the parameter, as text."`
+}
+
+type ReturnArgs struct {
+	Model      string           `json:"model"      jsonschema:"The name of the model."`
+	Block      string           `json:"block"      jsonschema:"The full path of the block."`
+	Type       string           `json:"type"       jsonschema:"The type of the block."`
+	Parameters []BlockParameter `json:"parameters" jsonschema:"The dialog parameters of the block."`
+}
@@ -0,0 +1,67 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getsimulinkblockparameters
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getsimulinkblockparameters"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request getsimulinkblockparameters.Args) (getsimulinkblockparameters.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Get Simulink Block Parameters tool")
+		defer sessionLogger.Info("Done - Executing Get Simulink Block Parameters tool")
+
+		// Not returning nil for empty slices, to comply with MCP spec.
+		response := ReturnArgs{
+			Parameters: []BlockParameter{},
+		}
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return response, err
+		}
+
+		result, err := usecase.Execute(ctx, sessionLogger, client, getsimulinkblockparameters.Args{
+			ModelPath: inputs.ModelPath,
+			BlockPath: inputs.BlockPath,
+		})
+		if err != nil {
+			return response, err
+		}
+
+		response.Model = result.Model
+		response.Block = result.Block
+		response.Type = result.Type
+
+		for _, parameter := range result.Parameters {
+			response.Parameters = append(response.Parameters, BlockParameter{
+				Name:  parameter.Name,
+				Value: parameter.Value,
+			})
+		}
+
+		return response, nil
+	}
+}
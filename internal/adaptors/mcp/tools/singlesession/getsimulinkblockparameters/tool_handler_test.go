@@ -0,0 +1,141 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getsimulinkblockparameters_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/getsimulinkblockparameters"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	getsimulinkblockparametersusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/getsimulinkblockparameters"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/getsimulinkblockparameters"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := getsimulinkblockparameters.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, getsimulinkblockparametersusecase.Args{ModelPath: "/home/user/models/plant.slx", BlockPath: "Gain"}).
+		Return(getsimulinkblockparametersusecase.ReturnArgs{
+			Model:      "plant",
+			Block:      "plant/Gain",
+			Type:       "Gain",
+			Parameters: []getsimulinkblockparametersusecase.BlockParameter{{Name: "Gain", Value: "K"}},
+		}, nil).
+		Once()
+
+	// Act
+	result, err := getsimulinkblockparameters.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, getsimulinkblockparameters.Args{ModelPath: "/home/user/models/plant.slx", BlockPath: "Gain"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, getsimulinkblockparameters.ReturnArgs{
+		Model:      "plant",
+		Block:      "plant/Gain",
+		Type:       "Gain",
+		Parameters: []getsimulinkblockparameters.BlockParameter{{Name: "Gain", Value: "K"}},
+	}, result)
+}
+
+func TestTool_Handler_EmptyResult(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, getsimulinkblockparametersusecase.Args{ModelPath: "/home/user/models/plant.slx", BlockPath: "Gain"}).
+		Return(getsimulinkblockparametersusecase.ReturnArgs{Model: "plant", Block: "plant/Ground", Type: "Ground"}, nil).
+		Once()
+
+	// Act
+	result, err := getsimulinkblockparameters.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, getsimulinkblockparameters.Args{ModelPath: "/home/user/models/plant.slx", BlockPath: "Gain"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotNil(t, result.Parameters)
+}
+
+func TestTool_Handler_ClientError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	_, err := getsimulinkblockparameters.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, getsimulinkblockparameters.Args{ModelPath: "/home/user/models/plant.slx", BlockPath: "Gain"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
@@ -0,0 +1,27 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package listmatfilevariables
+
+const (
+	name        = "list_mat_file_variables"
+	title       = "List MAT-File Variables"
+	description = "List the variables of a MAT-file (`file_path`) with their class, size and number of bytes, with whos, without loading their values. Use it to inspect a dataset before loading only the variables you need with `load_mat_file_variables`."
+)
+
+type Args struct {
+	FilePath string `json:"file_path" jsonschema:"The full absolute path to the MAT-file - Must be a .mat file - Example: C:\\Users\\username\\data\\measurements.mat or /home/user/data/measurements.mat."`
+}
+
+type Variable struct {
+	Name    string `json:"name"    jsonschema:"The name of the variable."`
+	Class   string `json:"class"   jsonschema:"The class of the variable - Example: double."`
+	Size    []int  `json:"size"    jsonschema:"The size of the variable - Example: [1000, 3]."`
+	Bytes   int    `json:"bytes"   jsonschema:"The number of bytes of the variable once loaded in MATLAB."`
+	Complex bool   `json:"complex" jsonschema:"True if the variable holds complex numbers."`
+}
+
+type ReturnArgs struct {
+	File             string     `json:"file"               jsonschema:"The full path of the MAT-file."`
+	Variables        []Variable `json:"variables"          jsonschema:"The variables of the MAT-file."`
+	OmittedVariables int        `json:"omitted_variables"  jsonschema:"The number of variables of the MAT-file not listed in variables."`
+}
@@ -0,0 +1,68 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package listmatfilevariables
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listmatfilevariables"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request listmatfilevariables.Args) (listmatfilevariables.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing List MAT-File Variables tool")
+		defer sessionLogger.Info("Done - Executing List MAT-File Variables tool")
+
+		// Not returning nil for empty slices, to comply with MCP spec.
+		response := ReturnArgs{
+			Variables: []Variable{},
+		}
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return response, err
+		}
+
+		result, err := usecase.Execute(ctx, sessionLogger, client, listmatfilevariables.Args{
+			FilePath: inputs.FilePath,
+		})
+		if err != nil {
+			return response, err
+		}
+
+		response.File = result.File
+		response.OmittedVariables = result.VariableCount - len(result.Variables)
+
+		for _, variable := range result.Variables {
+			response.Variables = append(response.Variables, Variable{
+				Name:    variable.Name,
+				Class:   variable.Class,
+				Size:    variable.Size,
+				Bytes:   variable.Bytes,
+				Complex: variable.Complex,
+			})
+		}
+
+		return response, nil
+	}
+}
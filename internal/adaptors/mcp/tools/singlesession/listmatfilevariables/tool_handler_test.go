@@ -0,0 +1,113 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package listmatfilevariables_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/listmatfilevariables"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	listmatfilevariablesusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/listmatfilevariables"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/matfile"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/listmatfilevariables"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := listmatfilevariables.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const filePath = "/home/user/data/measurements.mat"
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, listmatfilevariablesusecase.Args{FilePath: filePath}).
+		Return(listmatfilevariablesusecase.ReturnArgs{
+			File: filePath,
+			Variables: []matfile.Variable{
+				{Name: "t", Class: "double", Size: []int{1000, 1}, Bytes: 8000},
+			},
+			VariableCount: 3,
+		}, nil).
+		Once()
+
+	// Act
+	result, err := listmatfilevariables.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, listmatfilevariables.Args{FilePath: filePath})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, listmatfilevariables.ReturnArgs{
+		File: filePath,
+		Variables: []listmatfilevariables.Variable{
+			{Name: "t", Class: "double", Size: []int{1000, 1}, Bytes: 8000},
+		},
+		OmittedVariables: 2,
+	}, result)
+}
+
+func TestTool_Handler_ClientError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	result, err := listmatfilevariables.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, listmatfilevariables.Args{FilePath: "/home/user/data/measurements.mat"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.NotNil(t, result.Variables, "Variables should not be nil, to comply with MCP spec")
+}
@@ -0,0 +1,30 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package loadmatfilevariables
+
+const (
+	name        = "load_mat_file_variables"
+	title       = "Load MAT-File Variables"
+	description = "Load the named variables (`variables`) of a MAT-file (`file_path`) into the workspace of the MATLAB session, and only those, so that a large dataset does not flood the workspace. List the variables of the file first with `list_mat_file_variables`. Loading fails, before any variable is loaded, if a variable is not in the file, or already exists in the workspace and `overwrite` is false. Return the class, size and number of bytes of the loaded variables, and the names of the variables of the workspace that were replaced."
+)
+
+type Args struct {
+	FilePath  string   `json:"file_path"           jsonschema:"The full absolute path to the MAT-file - Must be a .mat file - Example: C:\\Users\\username\\data\\measurements.mat or /home/user/data/measurements.mat."`
+	Variables []string `json:"variables"           jsonschema:"The names of the variables to load - Example: [\"t\", \"y\"]."`
+	Overwrite bool     `json:"overwrite,omitempty" jsonschema:"If true, variables of the workspace with the same names are replaced. Defaults to false."`
+	DryRun    bool     `json:"dry_run,omitempty"   jsonschema:"If true, the call is not run, and the result describes what it would do instead - Use it to propose changes for review. Defaults to false."`
+}
+
+type Variable struct {
+	Name    string `json:"name"    jsonschema:"The name of the variable."`
+	Class   string `json:"class"   jsonschema:"The class of the variable - Example: double."`
+	Size    []int  `json:"size"    jsonschema:"The size of the variable - Example: [1000, 3]."`
+	Bytes   int    `json:"bytes"   jsonschema:"The number of bytes of the variable in MATLAB."`
+	Complex bool   `json:"complex" jsonschema:"True if the variable holds complex numbers."`
+}
+
+type ReturnArgs struct {
+	File      string     `json:"file"      jsonschema:"The full path of the MAT-file."`
+	Variables []Variable `json:"variables" jsonschema:"The variables loaded into the workspace."`
+	Replaced  []string   `json:"replaced"  jsonschema:"The names of the variables of the workspace that were replaced."`
+}
@@ -0,0 +1,71 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package loadmatfilevariables
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/loadmatfilevariables"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request loadmatfilevariables.Args) (loadmatfilevariables.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Load MAT-File Variables tool")
+		defer sessionLogger.Info("Done - Executing Load MAT-File Variables tool")
+
+		// Not returning nil for empty slices, to comply with MCP spec.
+		response := ReturnArgs{
+			Variables: []Variable{},
+			Replaced:  []string{},
+		}
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return response, err
+		}
+
+		result, err := usecase.Execute(ctx, sessionLogger, client, loadmatfilevariables.Args{
+			FilePath:  inputs.FilePath,
+			Variables: inputs.Variables,
+			Overwrite: inputs.Overwrite,
+		})
+		if err != nil {
+			return response, err
+		}
+
+		response.File = result.File
+		response.Replaced = append(response.Replaced, result.Replaced...)
+
+		for _, variable := range result.Variables {
+			response.Variables = append(response.Variables, Variable{
+				Name:    variable.Name,
+				Class:   variable.Class,
+				Size:    variable.Size,
+				Bytes:   variable.Bytes,
+				Complex: variable.Complex,
+			})
+		}
+
+		return response, nil
+	}
+}
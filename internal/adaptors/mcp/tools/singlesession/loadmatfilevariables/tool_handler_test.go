@@ -0,0 +1,111 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package loadmatfilevariables_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/loadmatfilevariables"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	loadmatfilevariablesusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/loadmatfilevariables"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/matfile"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/loadmatfilevariables"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := loadmatfilevariables.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const filePath = "/home/user/data/measurements.mat"
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, loadmatfilevariablesusecase.Args{FilePath: filePath, Variables: []string{"t"}}).
+		Return(loadmatfilevariablesusecase.ReturnArgs{
+			File: filePath,
+			Variables: []matfile.Variable{
+				{Name: "t", Class: "double", Size: []int{1000, 1}, Bytes: 8000},
+			},
+		}, nil).
+		Once()
+
+	// Act
+	result, err := loadmatfilevariables.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, loadmatfilevariables.Args{FilePath: filePath, Variables: []string{"t"}})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, loadmatfilevariables.ReturnArgs{
+		File: filePath,
+		Variables: []loadmatfilevariables.Variable{
+			{Name: "t", Class: "double", Size: []int{1000, 1}, Bytes: 8000},
+		},
+		Replaced: []string{},
+	}, result)
+}
+
+func TestTool_Handler_ClientError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	_, err := loadmatfilevariables.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, loadmatfilevariables.Args{FilePath: "/home/user/data/measurements.mat", Variables: []string{"t"}})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
@@ -0,0 +1,29 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package loadsimulinkmodel
+
+const (
+	name        = "load_simulink_model"
+	title       = "Load Simulink Model"
+	description = "Load a Simulink model (`model_path`) in the MATLAB session, with load_system, without opening it. Loading runs the load callbacks of the model. Return the name of the model, its solver and stop time, whether it has unsaved changes, and its blocks with their type, including those under masks and in linked libraries. Use it before `get_simulink_block_parameters` and `set_simulink_parameter`, which only use loaded models."
+)
+
+type Args struct {
+	ModelPath string `json:"model_path"        jsonschema:"The full absolute path to the Simulink model - Must be a .slx or .mdl file - Example: C:\\Users\\username\\models\\plant.slx or /home/user/models/plant.slx."`
+	DryRun    bool   `json:"dry_run,omitempty" jsonschema:"If true, the call is not run, and the result describes what it would do instead - Use it to propose changes for review. Defaults to false."`
+}
+
+type Block struct {
+	Path string `json:"path" jsonschema:"The path of the block, starting with the name of the model."`
+	Type string `json:"type" jsonschema:"The type of the block, such as Gain or SubSystem."`
+}
+
+type ReturnArgs struct {
+	Name          string  `json:"name"           jsonschema:"The name of the model."`
+	File          string  `json:"file"           jsonschema:"The full path of the model file."`
+	Solver        string  `json:"solver"         jsonschema:"The solver of the model."`
+	StopTime      string  `json:"stop_time"      jsonschema:"The stop time of the model, as set in its configuration."`
+	Dirty         bool    `json:"dirty"          jsonschema:"True if the model has changes that are not saved."`
+	Blocks        []Block `json:"blocks"         jsonschema:"The blocks of the model."`
+	OmittedBlocks int     `json:"omitted_blocks" jsonschema:"The number of blocks of the model not listed in blocks."`
+}
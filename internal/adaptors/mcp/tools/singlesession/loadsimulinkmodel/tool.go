@@ -0,0 +1,69 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package loadsimulinkmodel
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/loadsimulinkmodel"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request loadsimulinkmodel.Args) (loadsimulinkmodel.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Load Simulink Model tool")
+		defer sessionLogger.Info("Done - Executing Load Simulink Model tool")
+
+		// Not returning nil for empty slices, to comply with MCP spec.
+		response := ReturnArgs{
+			Blocks: []Block{},
+		}
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return response, err
+		}
+
+		result, err := usecase.Execute(ctx, sessionLogger, client, loadsimulinkmodel.Args{
+			ModelPath: inputs.ModelPath,
+		})
+		if err != nil {
+			return response, err
+		}
+
+		response.Name = result.Name
+		response.File = result.File
+		response.Solver = result.Solver
+		response.StopTime = result.StopTime
+		response.Dirty = result.Dirty
+		response.OmittedBlocks = result.BlockCount - len(result.Blocks)
+
+		for _, block := range result.Blocks {
+			response.Blocks = append(response.Blocks, Block{
+				Path: block.Path,
+				Type: block.Type,
+			})
+		}
+
+		return response, nil
+	}
+}
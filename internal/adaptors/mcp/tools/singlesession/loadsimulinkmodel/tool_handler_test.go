@@ -0,0 +1,145 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package loadsimulinkmodel_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/loadsimulinkmodel"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	loadsimulinkmodelusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/loadsimulinkmodel"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/loadsimulinkmodel"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := loadsimulinkmodel.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, loadsimulinkmodelusecase.Args{ModelPath: "/home/user/models/plant.slx"}).
+		Return(loadsimulinkmodelusecase.ReturnArgs{
+			Name:       "plant",
+			File:       "/home/user/models/plant.slx",
+			Solver:     "ode45",
+			StopTime:   "10",
+			Blocks:     []loadsimulinkmodelusecase.Block{{Path: "plant/Gain", Type: "Gain"}},
+			BlockCount: 3,
+		}, nil).
+		Once()
+
+	// Act
+	result, err := loadsimulinkmodel.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, loadsimulinkmodel.Args{ModelPath: "/home/user/models/plant.slx"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, loadsimulinkmodel.ReturnArgs{
+		Name:          "plant",
+		File:          "/home/user/models/plant.slx",
+		Solver:        "ode45",
+		StopTime:      "10",
+		Blocks:        []loadsimulinkmodel.Block{{Path: "plant/Gain", Type: "Gain"}},
+		OmittedBlocks: 2,
+	}, result)
+}
+
+func TestTool_Handler_EmptyResult(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, loadsimulinkmodelusecase.Args{ModelPath: "/home/user/models/plant.slx"}).
+		Return(loadsimulinkmodelusecase.ReturnArgs{Name: "plant"}, nil).
+		Once()
+
+	// Act
+	result, err := loadsimulinkmodel.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, loadsimulinkmodel.Args{ModelPath: "/home/user/models/plant.slx"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotNil(t, result.Blocks)
+}
+
+func TestTool_Handler_ClientError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	_, err := loadsimulinkmodel.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, loadsimulinkmodel.Args{ModelPath: "/home/user/models/plant.slx"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
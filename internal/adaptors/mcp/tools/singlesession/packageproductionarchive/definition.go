@@ -0,0 +1,21 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package packageproductionarchive
+
+const (
+	name        = "package_production_archive"
+	title       = "Package Production Archive"
+	description = "Package MATLAB functions (`function_paths`) into a deployable archive (`archive_name`) for MATLAB Production Server, with MATLAB Compiler SDK. The archive is written to the folder `<archive_name>_archive`, next to the first function. Deploy it with `deploy_production_archive`. Return the path of the archive and the build log."
+)
+
+type Args struct {
+	ArchiveName   string   `json:"archive_name"      jsonschema:"The name of the archive, which is the first part of the URL of its functions - Must be a MATLAB identifier - Example: pricing."`
+	FunctionPaths []string `json:"function_paths"    jsonschema:"The full absolute paths to the .m files of the functions that clients of the archive call - Example: [/home/user/pricing/price.m]."`
+	DryRun        bool     `json:"dry_run,omitempty" jsonschema:"If true, the call is not run, and the result describes what it would do instead - Use it to propose changes for review. Defaults to false."`
+}
+
+type ReturnArgs struct {
+	ArchivePath string   `json:"archive_path" jsonschema:"The full absolute path to the deployable archive, a .ctf file."`
+	Functions   []string `json:"functions"    jsonschema:"The paths of the functions packaged in the archive."`
+	Log         string   `json:"log"          jsonschema:"The log of the build."`
+}
@@ -0,0 +1,104 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package packageproductionarchive_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/packageproductionarchive"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	packageproductionarchiveusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/packageproductionarchive"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/packageproductionarchive"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := packageproductionarchive.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	functionPaths := []string{"/home/user/pricing/price.m"}
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, packageproductionarchiveusecase.Args{ArchiveName: "pricing", FunctionPaths: functionPaths}).
+		Return(packageproductionarchiveusecase.ReturnArgs{ArchivePath: "/home/user/pricing/pricing_archive/pricing.ctf", Functions: functionPaths, Log: "Build succeeded."}, nil).
+		Once()
+
+	// Act
+	result, err := packageproductionarchive.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, packageproductionarchive.Args{ArchiveName: "pricing", FunctionPaths: functionPaths})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, packageproductionarchive.ReturnArgs{
+		ArchivePath: "/home/user/pricing/pricing_archive/pricing.ctf",
+		Functions:   functionPaths,
+		Log:         "Build succeeded.",
+	}, result)
+}
+
+func TestTool_Handler_ClientError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	result, err := packageproductionarchive.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, packageproductionarchive.Args{ArchiveName: "pricing", FunctionPaths: []string{"/home/user/pricing/price.m"}})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
@@ -9,5 +9,6 @@ const (
 )
 
 type Args struct {
-	ScriptPath string `json:"script_path" jsonschema:"The full absolute path to the MATLAB script file to execute - Must be a .m file that exists - Example: C:\\Users\\username\\projects\\analysis.m or /home/user/matlab/simulation.m."`
+	ScriptPath string `json:"script_path"       jsonschema:"The full absolute path to the MATLAB script file to execute - Must be a .m file that exists - Example: C:\\Users\\username\\projects\\analysis.m or /home/user/matlab/simulation.m."`
+	DryRun     bool   `json:"dry_run,omitempty" jsonschema:"If true, the call is not run, and the result describes what it would do instead, such as the code it would run and the files, folders and MATLAB path it would change - Use it to propose changes for review. Defaults to false."`
 }
@@ -5,9 +5,11 @@ package runmatlabtestfile
 const (
 	name        = "run_matlab_test_file"
 	title       = "Run MATLAB test file"
-	description = "Execute a MATLAB test script (`script_path`) using MATLAB's built-in runtests function and return comprehensive test results. Designed specifically for MATLAB unit test files that follow MATLAB's testing framework conventions."
+	description = "Execute a MATLAB test script (`script_path`) using MATLAB's built-in runtests function and return comprehensive test results. Designed specifically for MATLAB unit test files that follow MATLAB's testing framework conventions. Set `parallel` to shard the tests across the workers of a parallel pool, and get a summary of the merged results with the time saved."
 )
 
 type Args struct {
-	ScriptPath string `json:"script_path" jsonschema:"The full absolute path to the MATLAB test script file - Must be a .m file containing MATLAB unit tests - Example: C:\\Users\\username\\tests\\testMyFunction.m or /home/user/matlab/tests/test_analysis.m."`
+	ScriptPath string `json:"script_path"        jsonschema:"The full absolute path to the MATLAB test script file - Must be a .m file containing MATLAB unit tests - Example: C:\\Users\\username\\tests\\testMyFunction.m or /home/user/matlab/tests/test_analysis.m."`
+	Parallel   bool   `json:"parallel,omitempty" jsonschema:"Whether to run the tests in parallel, on the workers of the parallel pool of the MATLAB session, which is started if needed - Requires the Parallel Computing Toolbox - Without it, the tests run one after the other. Defaults to false."`
+	DryRun     bool   `json:"dry_run,omitempty"  jsonschema:"If true, the call is not run, and the result describes what it would do instead, such as the code it would run and the files, folders and MATLAB path it would change - Use it to propose changes for review. Defaults to false."`
 }
@@ -42,6 +42,7 @@ func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.Handl
 
 		response, err := usecase.Execute(ctx, sessionLogger, client, runmatlabtestfile.Args{
 			ScriptPath: inputs.ScriptPath,
+			Parallel:   inputs.Parallel,
 		})
 		if err != nil {
 			return tools.RichContent{}, err
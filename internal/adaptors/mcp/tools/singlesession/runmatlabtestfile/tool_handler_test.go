@@ -215,3 +215,48 @@ func TestTool_Handler_UsecaseReturnsEmptyResponse(t *testing.T) {
 	assert.Empty(t, result.TextContent[0], "Text content should be empty")
 	assert.Empty(t, result.ImageContent, "Image content should be empty")
 }
+
+func TestTool_Handler_Parallel(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const scriptPath = "/some/script/tofile/testFile.m"
+	expectedResponse := entities.EvalResponse{
+		ConsoleOutput: "Ran 3 tests on 4 parallel workers in 10.0 s: 3 passed, 0 failed, 0 incomplete.",
+	}
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(
+			ctx,
+			mockLogger.AsMockArg(),
+			mockMATLABSessionClient,
+			runmatlabtestfileusecase.Args{ScriptPath: scriptPath, Parallel: true},
+		).
+		Return(expectedResponse, nil).
+		Once()
+
+	args := runmatlabtestfile.Args{ScriptPath: scriptPath, Parallel: true}
+
+	// Act
+	result, err := runmatlabtestfile.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.TextContent, 1)
+	assert.Equal(t, expectedResponse.ConsoleOutput, result.TextContent[0])
+}
@@ -0,0 +1,46 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package runmatlabtests
+
+const (
+	name        = "run_matlab_tests"
+	title       = "Run MATLAB Tests"
+	description = "Run the MATLAB unit tests of a test file, or of the test files of a folder and its subfolders (`test_path`), with the MATLAB unit testing framework in an existing MATLAB session, and return structured results: the number of passed, failed and incomplete tests, their duration, and, for each test that did not pass, the failed qualifications or uncaught exceptions with their message and stack trace. Select the tests to run by test class (`test_class`), tag (`tag`), or name pattern (`name_pattern`). Use it to drive test-driven development: run the tests, fix the code at the lines of the stack traces, and run them again."
+)
+
+type Args struct {
+	TestPath    string `json:"test_path"              jsonschema:"The full absolute path to a MATLAB test file, or to a folder whose test files, including those of its subfolders, are run - Example: C:\\Users\\username\\project\\tests or /home/user/project/tests/SolverTest.m."`
+	TestClass   string `json:"test_class,omitempty"   jsonschema:"Only run the tests of this test class, qualified with its package if any - Example: SolverTest or mypackage.SolverTest."`
+	Tag         string `json:"tag,omitempty"          jsonschema:"Only run the tests with this tag, set with the TestTags attribute of test classes and methods - Example: Unit."`
+	NamePattern string `json:"name_pattern,omitempty" jsonschema:"Only run the tests whose name matches this pattern, where * matches any characters - Test names are ClassName/methodName, with the parameters of parameterized tests in parentheses - Example: SolverTest/testConverge*."`
+	DryRun      bool   `json:"dry_run,omitempty"      jsonschema:"If true, the call is not run, and the result describes what it would do instead, such as the code it would run and the files, folders and MATLAB path it would change - Use it to propose changes for review. Defaults to false."`
+}
+
+type ReturnArgs struct {
+	Total           int           `json:"total"                      jsonschema:"The number of tests run."`
+	Passed          int           `json:"passed"                     jsonschema:"The number of tests that passed."`
+	Failed          int           `json:"failed"                     jsonschema:"The number of tests that failed."`
+	Incomplete      int           `json:"incomplete"                 jsonschema:"The number of tests that did not run to their end, such as when an assumption failed."`
+	DurationSeconds float64       `json:"duration_seconds"           jsonschema:"The total duration of the tests, in seconds."`
+	Failures        []TestFailure `json:"failures"                   jsonschema:"The tests that failed or are incomplete, empty when all tests passed."`
+	OmittedFailures int           `json:"omitted_failures,omitempty" jsonschema:"The number of failed or incomplete tests not listed, as at most 50 are."`
+}
+
+type TestFailure struct {
+	Name            string         `json:"name"             jsonschema:"The name of the test."`
+	Status          string         `json:"status"           jsonschema:"The outcome of the test: failed or incomplete."`
+	DurationSeconds float64        `json:"duration_seconds" jsonschema:"The duration of the test, in seconds."`
+	Events          []FailureEvent `json:"events"           jsonschema:"The failed qualifications and uncaught exceptions of the test, in order."`
+}
+
+type FailureEvent struct {
+	Event   string       `json:"event"   jsonschema:"The kind of failure, such as VerificationFailed, AssertionFailed, FatalAssertionFailed, AssumptionFailed or ExceptionThrown."`
+	Message string       `json:"message" jsonschema:"The diagnostics of the failure, such as the actual and expected values, or the message of the exception."`
+	Stack   []StackFrame `json:"stack"   jsonschema:"The stack of the test code where the failure happened, innermost frame first."`
+}
+
+type StackFrame struct {
+	File string `json:"file" jsonschema:"The full path of the file."`
+	Name string `json:"name" jsonschema:"The name of the function or method."`
+	Line int    `json:"line" jsonschema:"The line in the file, starting at 1."`
+}
@@ -0,0 +1,90 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package runmatlabtests
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/runmatlabtests"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request runmatlabtests.Args) (runmatlabtests.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Run MATLAB Tests tool")
+		defer sessionLogger.Info("Done - Executing Run MATLAB Tests tool")
+
+		// Not returning nil for empty slices, to comply with MCP spec.
+		response := ReturnArgs{
+			Failures: []TestFailure{},
+		}
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return response, err
+		}
+
+		result, err := usecase.Execute(ctx, sessionLogger, client, runmatlabtests.Args{
+			TestPath:    inputs.TestPath,
+			TestClass:   inputs.TestClass,
+			Tag:         inputs.Tag,
+			NamePattern: inputs.NamePattern,
+		})
+		if err != nil {
+			return response, err
+		}
+
+		response.Total = result.Total
+		response.Passed = result.Passed
+		response.Failed = result.Failed
+		response.Incomplete = result.Incomplete
+		response.DurationSeconds = result.Duration
+		response.OmittedFailures = result.OmittedFailures
+
+		for _, failure := range result.Failures {
+			events := []FailureEvent{}
+			for _, event := range failure.Events {
+				stack := []StackFrame{}
+				for _, frame := range event.Stack {
+					stack = append(stack, StackFrame{
+						File: frame.File,
+						Name: frame.Name,
+						Line: frame.Line,
+					})
+				}
+				events = append(events, FailureEvent{
+					Event:   event.Event,
+					Message: event.Message,
+					Stack:   stack,
+				})
+			}
+			response.Failures = append(response.Failures, TestFailure{
+				Name:            failure.Name,
+				Status:          failure.Status,
+				DurationSeconds: failure.Duration,
+				Events:          events,
+			})
+		}
+
+		return response, nil
+	}
+}
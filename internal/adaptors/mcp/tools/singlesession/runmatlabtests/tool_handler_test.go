@@ -0,0 +1,224 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package runmatlabtests_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabtests"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	runmatlabtestsusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/runmatlabtests"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/runmatlabtests"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := runmatlabtests.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const testPath = "/home/user/project/tests"
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, runmatlabtestsusecase.Args{
+			TestPath:    testPath,
+			TestClass:   "SolverTest",
+			Tag:         "Unit",
+			NamePattern: "SolverTest/testConverge*",
+		}).
+		Return(runmatlabtestsusecase.ReturnArgs{
+			Total:    2,
+			Passed:   1,
+			Failed:   1,
+			Duration: 0.5,
+			Failures: []runmatlabtestsusecase.TestFailure{
+				{
+					Name:     "SolverTest/testConvergeFast",
+					Status:   "failed",
+					Duration: 0.25,
+					Events: []runmatlabtestsusecase.FailureEvent{
+						{
+							Event:   "VerificationFailed",
+							Message: "Actual Value: 2\nExpected Value: 3",
+							Stack: []runmatlabtestsusecase.StackFrame{
+								{File: "/home/user/project/tests/SolverTest.m", Name: "SolverTest.testConvergeFast", Line: 12},
+							},
+						},
+					},
+				},
+			},
+			OmittedFailures: 1,
+		}, nil).
+		Once()
+
+	// Act
+	result, err := runmatlabtests.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, runmatlabtests.Args{
+		TestPath:    testPath,
+		TestClass:   "SolverTest",
+		Tag:         "Unit",
+		NamePattern: "SolverTest/testConverge*",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, runmatlabtests.ReturnArgs{
+		Total:           2,
+		Passed:          1,
+		Failed:          1,
+		DurationSeconds: 0.5,
+		Failures: []runmatlabtests.TestFailure{
+			{
+				Name:            "SolverTest/testConvergeFast",
+				Status:          "failed",
+				DurationSeconds: 0.25,
+				Events: []runmatlabtests.FailureEvent{
+					{
+						Event:   "VerificationFailed",
+						Message: "Actual Value: 2\nExpected Value: 3",
+						Stack: []runmatlabtests.StackFrame{
+							{File: "/home/user/project/tests/SolverTest.m", Name: "SolverTest.testConvergeFast", Line: 12},
+						},
+					},
+				},
+			},
+		},
+		OmittedFailures: 1,
+	}, result)
+}
+
+func TestTool_Handler_AllPassed(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const testPath = "/home/user/project/tests/SolverTest.m"
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, runmatlabtestsusecase.Args{TestPath: testPath}).
+		Return(runmatlabtestsusecase.ReturnArgs{Total: 3, Passed: 3}, nil).
+		Once()
+
+	// Act
+	result, err := runmatlabtests.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, runmatlabtests.Args{TestPath: testPath})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.Passed)
+	assert.NotNil(t, result.Failures, "Failures should not be nil")
+	assert.Empty(t, result.Failures)
+}
+
+func TestTool_Handler_ClientError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	result, err := runmatlabtests.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, runmatlabtests.Args{TestPath: "/home/user/project/tests"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.NotNil(t, result.Failures, "Failures should not be nil")
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const testPath = "/home/user/project/tests"
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, runmatlabtestsusecase.Args{TestPath: testPath}).
+		Return(runmatlabtestsusecase.ReturnArgs{}, assert.AnError).
+		Once()
+
+	// Act
+	result, err := runmatlabtests.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, runmatlabtests.Args{TestPath: testPath})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.NotNil(t, result.Failures, "Failures should not be nil")
+}
@@ -0,0 +1,22 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package runpythoncode
+
+const (
+	name        = "run_python_code"
+	title       = "Run Python Code"
+	description = "Run a short snippet of Python code (`code`) in the Python environment of the MATLAB session, with MATLAB's pyrun function, within a specified project directory (`project_path`), which is added to the Python module search path. Return what the code printed, the traceback of the exception it raised, if any, and the representation of the Python variables named in `variables`. Variables are kept between calls, and are shared with the code that MATLAB runs with pyrun. Use it to prepare or check data on the Python side of a workflow that crosses the MATLAB and Python boundary; use `evaluate_matlab_code` to call Python from MATLAB code with the py. interface."
+)
+
+type Args struct {
+	ProjectPath string   `json:"project_path"        jsonschema:"The full path to the project directory - Becomes the working directory of MATLAB and Python - Folder must exist - Example: C:\\Users\\username\\project or /home/user/research."`
+	Code        string   `json:"code"                jsonschema:"The Python code to run."`
+	Variables   []string `json:"variables,omitempty" jsonschema:"The names of the Python variables to return the representation of, after the code has run - Example: df or model."`
+	DryRun      bool     `json:"dry_run,omitempty"   jsonschema:"If true, the call is not run, and the result describes what it would do instead, such as the code it would run - Use it to propose changes for review. Defaults to false."`
+}
+
+type ReturnArgs struct {
+	Output    string            `json:"output"              jsonschema:"What the code printed to its standard output and standard error."`
+	Error     string            `json:"error,omitempty"     jsonschema:"The traceback of the exception raised by the code - The code ran up to the exception."`
+	Variables map[string]string `json:"variables,omitempty" jsonschema:"The representation of each requested variable, as returned by repr, truncated to 2000 characters - Variables that do not exist are omitted."`
+}
@@ -0,0 +1,22 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package setmatlabvariable
+
+const (
+	name        = "set_matlab_variable"
+	title       = "Set MATLAB Variable"
+	description = "Set a variable (`name`) of the workspace of the MATLAB session to a JSON value (`value`), replacing any previous value. Typed JSON, as returned by `get_matlab_variable`, is rebuilt with its class and size, such as `{\"class\": \"int32\", \"size\": [2, 2], \"data\": [1, 2, 3, 4]}`. Any other JSON is decoded by jsondecode: numbers become doubles, arrays of numbers become column vectors and objects become structures. Values larger than the maximum variable payload of the server are rejected. Return the class, size and number of bytes of the variable."
+)
+
+type Args struct {
+	Name   string `json:"name"              jsonschema:"The name of the variable - Example: gains."`
+	Value  any    `json:"value"             jsonschema:"The value of the variable, as typed JSON or plain JSON - Example: {\"class\": \"double\", \"size\": [1, 3], \"data\": [0.5, 1, \"Inf\"]} or [0.5, 1, 2]."`
+	DryRun bool   `json:"dry_run,omitempty" jsonschema:"If true, the call is not run, and the result describes what it would do instead - Use it to propose changes for review. Defaults to false."`
+}
+
+type ReturnArgs struct {
+	Name  string `json:"name"  jsonschema:"The name of the variable."`
+	Class string `json:"class" jsonschema:"The class of the variable - Example: double."`
+	Size  []int  `json:"size"  jsonschema:"The size of the variable - Example: [1, 3]."`
+	Bytes int    `json:"bytes" jsonschema:"The number of bytes of the variable in MATLAB."`
+}
@@ -0,0 +1,63 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package setmatlabvariable
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/setmatlabvariable"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request setmatlabvariable.Args) (setmatlabvariable.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Set MATLAB Variable tool")
+		defer sessionLogger.Info("Done - Executing Set MATLAB Variable tool")
+
+		value, err := json.Marshal(inputs.Value)
+		if err != nil {
+			return ReturnArgs{}, fmt.Errorf("failed to encode the value of %s: %w", inputs.Name, err)
+		}
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		response, err := usecase.Execute(ctx, sessionLogger, client, setmatlabvariable.Args{
+			Name:  inputs.Name,
+			Value: value,
+		})
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		return ReturnArgs{
+			Name:  response.Name,
+			Class: response.Class,
+			Size:  response.Size,
+			Bytes: response.Bytes,
+		}, nil
+	}
+}
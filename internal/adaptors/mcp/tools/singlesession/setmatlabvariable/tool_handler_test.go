@@ -0,0 +1,109 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package setmatlabvariable_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/setmatlabvariable"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	setmatlabvariableusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/setmatlabvariable"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/setmatlabvariable"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := setmatlabvariable.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, setmatlabvariableusecase.Args{Name: "gains", Value: json.RawMessage(`[0.5,1,2]`)}).
+		Return(setmatlabvariableusecase.ReturnArgs{
+			Name:  "gains",
+			Class: "double",
+			Size:  []int{3, 1},
+			Bytes: 24,
+		}, nil).
+		Once()
+
+	// Act
+	result, err := setmatlabvariable.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, setmatlabvariable.Args{Name: "gains", Value: []any{0.5, 1, 2}})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, setmatlabvariable.ReturnArgs{
+		Name:  "gains",
+		Class: "double",
+		Size:  []int{3, 1},
+		Bytes: 24,
+	}, result)
+}
+
+func TestTool_Handler_ClientError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	_, err := setmatlabvariable.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, setmatlabvariable.Args{Name: "x", Value: 1})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
@@ -0,0 +1,21 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package setpythonenvironment
+
+import "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/getpythonenvironment"
+
+const (
+	name        = "set_python_environment"
+	title       = "Set Python Environment"
+	description = "Set the Python that the MATLAB session uses (`version`), and whether it runs in the MATLAB process or in a separate process (`execution_mode`), with MATLAB's pyenv function, and return the resulting environment. A Python running out of process is stopped, losing its variables, and started again with the new settings when it is next used. A Python already loaded in the MATLAB process cannot be changed until MATLAB restarts: use OutOfProcess to switch between Python environments, such as virtual environments, without restarting MATLAB."
+)
+
+type Args struct {
+	Version       string `json:"version,omitempty"        jsonschema:"The full absolute path of a Python executable, such as the python executable of a virtual environment, or a Python version installed on the system - Example: /home/user/venv/bin/python, C:\\Users\\username\\venv\\Scripts\\python.exe or 3.11."`
+	ExecutionMode string `json:"execution_mode,omitempty" jsonschema:"InProcess, to run Python in the MATLAB process, or OutOfProcess, to run it in a separate process."`
+	DryRun        bool   `json:"dry_run,omitempty"        jsonschema:"If true, the call is not run, and the result describes what it would do instead - Use it to propose changes for review. Defaults to false."`
+}
+
+type ReturnArgs struct {
+	Environment getpythonenvironment.Environment `json:"environment" jsonschema:"The Python environment of the MATLAB session, after the change."`
+}
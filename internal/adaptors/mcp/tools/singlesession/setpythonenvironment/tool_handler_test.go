@@ -0,0 +1,136 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package setpythonenvironment_test
+
+import (
+	"testing"
+
+	getpythonenvironmenttool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/getpythonenvironment"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/setpythonenvironment"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	setpythonenvironmentusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/setpythonenvironment"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/pythonenv"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/setpythonenvironment"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := setpythonenvironment.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, setpythonenvironmentusecase.Args{Version: "3.12", ExecutionMode: "OutOfProcess"}).
+		Return(pythonenv.Environment{Version: "3.12", Status: "NotLoaded", ExecutionMode: pythonenv.ExecutionModeOutOfProcess}, nil).
+		Once()
+
+	// Act
+	result, err := setpythonenvironment.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, setpythonenvironment.Args{Version: "3.12", ExecutionMode: "OutOfProcess"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, setpythonenvironment.ReturnArgs{
+		Environment: getpythonenvironmenttool.Environment{Version: "3.12", Status: "NotLoaded", ExecutionMode: "OutOfProcess"},
+	}, result)
+}
+
+func TestTool_Handler_ClientError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	result, err := setpythonenvironment.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, setpythonenvironment.Args{Version: "3.12", ExecutionMode: "OutOfProcess"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, setpythonenvironmentusecase.Args{Version: "3.12", ExecutionMode: "OutOfProcess"}).
+		Return(pythonenv.Environment{}, assert.AnError).
+		Once()
+
+	// Act
+	result, err := setpythonenvironment.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, setpythonenvironment.Args{Version: "3.12", ExecutionMode: "OutOfProcess"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
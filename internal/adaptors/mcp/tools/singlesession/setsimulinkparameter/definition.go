@@ -0,0 +1,25 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package setsimulinkparameter
+
+const (
+	name        = "set_simulink_parameter"
+	title       = "Set Simulink Parameter"
+	description = "Set a parameter (`parameter`) of a block (`block_path`) of a Simulink model (`model_path`) loaded in the MATLAB session, or of the model itself when `block_path` is empty, with set_param. The model must be loaded first with `load_simulink_model`, and it is not saved. The value is text, as entered in the block dialog: most block parameters are MATLAB expressions, evaluated when the model is simulated. Return the previous and new values of the parameter."
+)
+
+type Args struct {
+	ModelPath string `json:"model_path"           jsonschema:"The full absolute path to the Simulink model - Must be a .slx or .mdl file - Example: C:\\Users\\username\\models\\plant.slx or /home/user/models/plant.slx."`
+	BlockPath string `json:"block_path,omitempty" jsonschema:"The path of the block, relative to the model or starting with its name - Example: Controller/Gain - Defaults to the model itself."`
+	Parameter string `json:"parameter"            jsonschema:"The name of the parameter - Example: Gain for a block, or StopTime for the model."`
+	Value     string `json:"value"                jsonschema:"The new value of the parameter, as text - Example: 2*K or 10."`
+	DryRun    bool   `json:"dry_run,omitempty"    jsonschema:"If true, the call is not run, and the result describes what it would do instead - Use it to propose changes for review. Defaults to false."`
+}
+
+type ReturnArgs struct {
+	Target        string `json:"target"         jsonschema:"The full path of the block, or the name of the model, whose parameter was set."`
+	Parameter     string `json:"parameter"      jsonschema:"The name of the parameter."`
+	PreviousValue string `json:"previous_value" jsonschema:"The value of the parameter before it was set."`
+	Value         string `json:"value"          jsonschema:"The value of the parameter after it was set."`
+	Dirty         bool   `json:"dirty"          jsonschema:"True if the model has changes that are not saved."`
+}
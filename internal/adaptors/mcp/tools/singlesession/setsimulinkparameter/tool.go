@@ -0,0 +1,59 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package setsimulinkparameter
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/setsimulinkparameter"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request setsimulinkparameter.Args) (setsimulinkparameter.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Set Simulink Parameter tool")
+		defer sessionLogger.Info("Done - Executing Set Simulink Parameter tool")
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		response, err := usecase.Execute(ctx, sessionLogger, client, setsimulinkparameter.Args{
+			ModelPath: inputs.ModelPath,
+			BlockPath: inputs.BlockPath,
+			Parameter: inputs.Parameter,
+			Value:     inputs.Value,
+		})
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		return ReturnArgs{
+			Target:        response.Target,
+			Parameter:     response.Parameter,
+			PreviousValue: response.PreviousValue,
+			Value:         response.Value,
+			Dirty:         response.Dirty,
+		}, nil
+	}
+}
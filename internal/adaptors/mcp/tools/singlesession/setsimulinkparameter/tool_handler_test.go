@@ -0,0 +1,111 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package setsimulinkparameter_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/setsimulinkparameter"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	setsimulinkparameterusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/setsimulinkparameter"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/setsimulinkparameter"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := setsimulinkparameter.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, setsimulinkparameterusecase.Args{ModelPath: "/home/user/models/plant.slx", BlockPath: "Gain", Parameter: "Gain", Value: "2*K"}).
+		Return(setsimulinkparameterusecase.ReturnArgs{
+			Model:         "plant",
+			Target:        "plant/Gain",
+			Parameter:     "Gain",
+			PreviousValue: "K",
+			Value:         "2*K",
+			Dirty:         true,
+		}, nil).
+		Once()
+
+	// Act
+	result, err := setsimulinkparameter.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, setsimulinkparameter.Args{ModelPath: "/home/user/models/plant.slx", BlockPath: "Gain", Parameter: "Gain", Value: "2*K"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, setsimulinkparameter.ReturnArgs{
+		Target:        "plant/Gain",
+		Parameter:     "Gain",
+		PreviousValue: "K",
+		Value:         "2*K",
+		Dirty:         true,
+	}, result)
+}
+
+func TestTool_Handler_ClientError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	_, err := setsimulinkparameter.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, setsimulinkparameter.Args{ModelPath: "/home/user/models/plant.slx", BlockPath: "Gain", Parameter: "Gain", Value: "2*K"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
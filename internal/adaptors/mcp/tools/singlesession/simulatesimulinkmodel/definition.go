@@ -0,0 +1,36 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package simulatesimulinkmodel
+
+const (
+	name        = "simulate_simulink_model"
+	title       = "Simulate Simulink Model"
+	description = "Simulate a Simulink model (`model_path`) in the MATLAB session with sim, loading the model if needed, optionally with another stop time (`stop_time`). The model is not changed. Return the signals logged in datasets, such as logsout with signal logging and yout with output logging, each with its time and values, sampled evenly down to `max_samples` samples. Buses are not returned. An error stopping the simulation is returned in `error`, with the signals logged before it."
+)
+
+type Args struct {
+	ModelPath  string  `json:"model_path"            jsonschema:"The full absolute path to the Simulink model to simulate - Must be a .slx or .mdl file - Example: C:\\Users\\username\\models\\plant.slx or /home/user/models/plant.slx."`
+	StopTime   float64 `json:"stop_time,omitempty"   jsonschema:"The stop time of the simulation in seconds - Defaults to the stop time set in the model."`
+	MaxSamples int     `json:"max_samples,omitempty" jsonschema:"The number of samples returned at most for each signal, between 2 and 10000 - Defaults to 1000."`
+	DryRun     bool    `json:"dry_run,omitempty"     jsonschema:"If true, the call is not run, and the result describes what it would do instead - Use it to propose changes for review. Defaults to false."`
+}
+
+type Signal struct {
+	Name        string       `json:"name"         jsonschema:"The name of the signal."`
+	Dataset     string       `json:"dataset"      jsonschema:"The dataset logging the signal, such as logsout or yout."`
+	BlockPath   string       `json:"block_path"   jsonschema:"The path of the block whose output port the signal comes from."`
+	PortIndex   int          `json:"port_index"   jsonschema:"The index of the output port the signal comes from."`
+	SampleCount int          `json:"sample_count" jsonschema:"The number of samples logged, including those not returned."`
+	Time        []float64    `json:"time"         jsonschema:"The times of the returned samples, in seconds."`
+	Values      [][]*float64 `json:"values"       jsonschema:"The values of the signal at each time, as arrays of its elements - null for NaN and infinite elements."`
+}
+
+type ReturnArgs struct {
+	Model          string   `json:"model"            jsonschema:"The name of the model."`
+	StopTime       string   `json:"stop_time"        jsonschema:"The stop time of the model, as set in its configuration."`
+	ElapsedSeconds float64  `json:"elapsed_seconds"  jsonschema:"The duration of the simulation, in seconds."`
+	Error          string   `json:"error"            jsonschema:"The error that stopped the simulation - Empty if the simulation completed."`
+	Warnings       []string `json:"warnings"         jsonschema:"The warnings raised during the simulation."`
+	Signals        []Signal `json:"signals"          jsonschema:"The logged signals."`
+	OmittedSignals int      `json:"omitted_signals"  jsonschema:"The number of logged signals not returned, such as buses."`
+}
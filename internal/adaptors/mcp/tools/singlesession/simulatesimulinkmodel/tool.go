@@ -0,0 +1,79 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package simulatesimulinkmodel
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/simulatesimulinkmodel"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request simulatesimulinkmodel.Args) (simulatesimulinkmodel.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Simulate Simulink Model tool")
+		defer sessionLogger.Info("Done - Executing Simulate Simulink Model tool")
+
+		// Not returning nil for empty slices, to comply with MCP spec.
+		response := ReturnArgs{
+			Warnings: []string{},
+			Signals:  []Signal{},
+		}
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return response, err
+		}
+
+		result, err := usecase.Execute(ctx, sessionLogger, client, simulatesimulinkmodel.Args{
+			ModelPath:  inputs.ModelPath,
+			StopTime:   inputs.StopTime,
+			MaxSamples: inputs.MaxSamples,
+		})
+		if err != nil {
+			return response, err
+		}
+
+		response.Model = result.Model
+		response.StopTime = result.StopTime
+		response.ElapsedSeconds = result.Elapsed
+		response.Error = result.Error
+		response.Warnings = append(response.Warnings, result.Warnings...)
+		response.OmittedSignals = result.OmittedSignals
+
+		for _, signal := range result.Signals {
+			time := append([]float64{}, signal.Time...)
+			values := append([][]*float64{}, signal.Values...)
+			response.Signals = append(response.Signals, Signal{
+				Name:        signal.Name,
+				Dataset:     signal.Dataset,
+				BlockPath:   signal.BlockPath,
+				PortIndex:   signal.PortIndex,
+				SampleCount: signal.SampleCount,
+				Time:        time,
+				Values:      values,
+			})
+		}
+
+		return response, nil
+	}
+}
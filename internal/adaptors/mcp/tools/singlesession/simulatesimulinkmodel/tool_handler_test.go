@@ -0,0 +1,149 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package simulatesimulinkmodel_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/simulatesimulinkmodel"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	simulatesimulinkmodelusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/simulatesimulinkmodel"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/simulatesimulinkmodel"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := simulatesimulinkmodel.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	zero := 0.0
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, simulatesimulinkmodelusecase.Args{ModelPath: "/home/user/models/plant.slx", StopTime: 2.5, MaxSamples: 100}).
+		Return(simulatesimulinkmodelusecase.ReturnArgs{
+			Model:    "plant",
+			StopTime: "10",
+			Elapsed:  0.5,
+			Signals: []simulatesimulinkmodelusecase.Signal{
+				{Name: "speed", Dataset: "logsout", BlockPath: "plant/Integrator", PortIndex: 1, SampleCount: 2, Time: []float64{0, 2.5}, Values: [][]*float64{{&zero}, {nil}}},
+			},
+		}, nil).
+		Once()
+
+	// Act
+	result, err := simulatesimulinkmodel.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, simulatesimulinkmodel.Args{ModelPath: "/home/user/models/plant.slx", StopTime: 2.5, MaxSamples: 100})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, simulatesimulinkmodel.ReturnArgs{
+		Model:          "plant",
+		StopTime:       "10",
+		ElapsedSeconds: 0.5,
+		Warnings:       []string{},
+		Signals: []simulatesimulinkmodel.Signal{
+			{Name: "speed", Dataset: "logsout", BlockPath: "plant/Integrator", PortIndex: 1, SampleCount: 2, Time: []float64{0, 2.5}, Values: [][]*float64{{&zero}, {nil}}},
+		},
+	}, result)
+}
+
+func TestTool_Handler_EmptyResult(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, simulatesimulinkmodelusecase.Args{ModelPath: "/home/user/models/plant.slx", StopTime: 2.5, MaxSamples: 100}).
+		Return(simulatesimulinkmodelusecase.ReturnArgs{Model: "plant", Error: "Division by zero"}, nil).
+		Once()
+
+	// Act
+	result, err := simulatesimulinkmodel.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, simulatesimulinkmodel.Args{ModelPath: "/home/user/models/plant.slx", StopTime: 2.5, MaxSamples: 100})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "Division by zero", result.Error)
+	assert.NotNil(t, result.Warnings)
+	assert.NotNil(t, result.Signals)
+}
+
+func TestTool_Handler_ClientError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	_, err := simulatesimulinkmodel.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, simulatesimulinkmodel.Args{ModelPath: "/home/user/models/plant.slx", StopTime: 2.5, MaxSamples: 100})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
@@ -0,0 +1,22 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package startjob
+
+const (
+	name        = "start_job"
+	title       = "Start Background Job"
+	description = "Start MATLAB code (`code`) as a background job within a specified project directory (`project_path`), and return its job ID immediately, without waiting for the code to complete. Use it for long simulations and parameter sweeps, then poll the job with `get_job_status`, read its command window output with `get_job_output`, and stop it with `cancel_job`. By default (`mode` = `session`), the job runs in the MATLAB session, which runs no other code until the job is finished. With `mode` = `batch` or `parfeval`, the job runs on a Parallel Computing Toolbox worker, and the MATLAB session stays available."
+)
+
+type Args struct {
+	ProjectPath string `json:"project_path"      jsonschema:"The full path to the project directory - Becomes MATLAB's working directory while the job runs - Folder must exist - Example: C:\\Users\\username\\matlab-project or /home/user/research."`
+	Code        string `json:"code"              jsonschema:"The MATLAB code to run as a background job."`
+	Mode        string `json:"mode,omitempty"    jsonschema:"Where the job runs - session (default): in the MATLAB session - batch: as a batch job of the default cluster profile - parfeval: on a worker of the parallel pool of the MATLAB session. batch and parfeval require the Parallel Computing Toolbox."`
+	DryRun      bool   `json:"dry_run,omitempty" jsonschema:"If true, the call is not run, and the result describes what it would do instead, such as the code it would run and the files, folders and MATLAB path it would change - Use it to propose changes for review. Defaults to false."`
+}
+
+type ReturnArgs struct {
+	JobID string `json:"job_id" jsonschema:"The ID of the background job, to pass to get_job_status, get_job_output and cancel_job."`
+	Mode  string `json:"mode"   jsonschema:"Where the job runs."`
+	State string `json:"state"  jsonschema:"The state of the job: queued or running."`
+}
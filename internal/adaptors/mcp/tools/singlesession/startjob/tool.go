@@ -0,0 +1,57 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package startjob
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/startjob"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/jobmanager"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request startjob.Args) (jobmanager.Job, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Start Job tool")
+		defer sessionLogger.Info("Done - Executing Start Job tool")
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		job, err := usecase.Execute(ctx, sessionLogger, client, startjob.Args{
+			Code:        inputs.Code,
+			ProjectPath: inputs.ProjectPath,
+			Mode:        jobmanager.Mode(inputs.Mode),
+		})
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		return ReturnArgs{
+			JobID: job.ID,
+			Mode:  string(job.Mode),
+			State: string(job.State),
+		}, nil
+	}
+}
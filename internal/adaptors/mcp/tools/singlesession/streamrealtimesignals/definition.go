@@ -0,0 +1,37 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package streamrealtimesignals
+
+import (
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/deployrealtimeapplication"
+)
+
+const (
+	name        = "stream_realtime_signals"
+	title       = "Stream Real-Time Signals"
+	description = "Sample scalar signals (`signals`) of the real-time application running on a Simulink Real-Time target computer (`target`), every `interval_seconds` for `duration_seconds`, and return their values with the time of each sample. Signals are identified by the path of the block that outputs them and the index of its output port. Use it to check the behavior of a running hardware-in-the-loop test. Only the targets configured on the server can be used."
+)
+
+type Args struct {
+	Target          string   `json:"target"                     jsonschema:"The name of the target computer, as listed by slrealtime.Targets - Example: TargetPC1."`
+	Signals         []Signal `json:"signals"                    jsonschema:"The signals to sample, at most 20."`
+	DurationSeconds float64  `json:"duration_seconds,omitempty" jsonschema:"How long to sample the signals for, in seconds, at most 60 - Defaults to 1."`
+	IntervalSeconds float64  `json:"interval_seconds,omitempty" jsonschema:"The interval between samples, in seconds, at least 0.01 - Defaults to 0.1."`
+}
+
+type Signal struct {
+	BlockPath string `json:"block_path" jsonschema:"The path of the block that outputs the signal, in the model of the application - Example: controller/Gain."`
+	PortIndex int    `json:"port_index" jsonschema:"The index of the output port of the block, from 1."`
+}
+
+type ReturnArgs struct {
+	Status  deployrealtimeapplication.TargetStatus `json:"status"  jsonschema:"The status of the target computer, after sampling."`
+	Time    []float64                              `json:"time"    jsonschema:"The time of each sample, in seconds since the first one, as measured by MATLAB."`
+	Signals []SampledSignal                        `json:"signals" jsonschema:"The values of each signal, one per sample, in the order of the request."`
+}
+
+type SampledSignal struct {
+	BlockPath string    `json:"block_path" jsonschema:"The path of the block that outputs the signal."`
+	PortIndex int       `json:"port_index" jsonschema:"The index of the output port of the block."`
+	Values    []float64 `json:"values"     jsonschema:"The value of the signal at each sample."`
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package streamrealtimesignals
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/deployrealtimeapplication"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/streamrealtimesignals"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request streamrealtimesignals.Args) (streamrealtimesignals.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Stream Real-Time Signals tool")
+		defer sessionLogger.Info("Done - Executing Stream Real-Time Signals tool")
+
+		// Not returning nil for empty slices, to comply with MCP spec.
+		mcpCompliantZeroValue := ReturnArgs{
+			Time:    []float64{},
+			Signals: []SampledSignal{},
+		}
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return mcpCompliantZeroValue, err
+		}
+
+		signals := make([]streamrealtimesignals.Signal, len(inputs.Signals))
+		for i, signal := range inputs.Signals {
+			signals[i] = streamrealtimesignals.Signal{
+				BlockPath: signal.BlockPath,
+				PortIndex: signal.PortIndex,
+			}
+		}
+
+		response, err := usecase.Execute(ctx, sessionLogger, client, streamrealtimesignals.Args{
+			Target:          inputs.Target,
+			Signals:         signals,
+			DurationSeconds: inputs.DurationSeconds,
+			IntervalSeconds: inputs.IntervalSeconds,
+		})
+		if err != nil {
+			return mcpCompliantZeroValue, err
+		}
+
+		sampledSignals := make([]SampledSignal, len(response.Signals))
+		for i, signal := range response.Signals {
+			sampledSignals[i] = SampledSignal{
+				BlockPath: signal.BlockPath,
+				PortIndex: signal.PortIndex,
+				Values:    signal.Values,
+			}
+		}
+
+		return ReturnArgs{
+			Status:  deployrealtimeapplication.NewTargetStatus(response.Status),
+			Time:    response.Time,
+			Signals: sampledSignals,
+		}, nil
+	}
+}
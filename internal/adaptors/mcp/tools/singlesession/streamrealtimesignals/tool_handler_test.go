@@ -0,0 +1,117 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package streamrealtimesignals_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/deployrealtimeapplication"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/streamrealtimesignals"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	streamrealtimesignalsusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/streamrealtimesignals"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/realtimetarget"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/streamrealtimesignals"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := streamrealtimesignals.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, streamrealtimesignalsusecase.Args{
+			Target:          "rig1",
+			Signals:         []streamrealtimesignalsusecase.Signal{{BlockPath: "controller/Gain", PortIndex: 1}},
+			DurationSeconds: 0.2,
+		}).
+		Return(streamrealtimesignalsusecase.ReturnArgs{
+			Status:  realtimetarget.Status{Target: "rig1", Connected: true, Loaded: true, Running: true, Application: "controller"},
+			Time:    []float64{0, 0.1},
+			Signals: []streamrealtimesignalsusecase.SampledSignal{{BlockPath: "controller/Gain", PortIndex: 1, Values: []float64{0.5, 0.75}}},
+		}, nil).
+		Once()
+
+	// Act
+	result, err := streamrealtimesignals.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, streamrealtimesignals.Args{
+		Target:          "rig1",
+		Signals:         []streamrealtimesignals.Signal{{BlockPath: "controller/Gain", PortIndex: 1}},
+		DurationSeconds: 0.2,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, streamrealtimesignals.ReturnArgs{
+		Status:  deployrealtimeapplication.TargetStatus{Target: "rig1", Connected: true, Loaded: true, Running: true, Application: "controller"},
+		Time:    []float64{0, 0.1},
+		Signals: []streamrealtimesignals.SampledSignal{{BlockPath: "controller/Gain", PortIndex: 1, Values: []float64{0.5, 0.75}}},
+	}, result)
+}
+
+func TestTool_Handler_ClientError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	result, err := streamrealtimesignals.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, streamrealtimesignals.Args{Target: "rig1", Signals: []streamrealtimesignals.Signal{{BlockPath: "controller/Gain", PortIndex: 1}}})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Equal(t, streamrealtimesignals.ReturnArgs{Time: []float64{}, Signals: []streamrealtimesignals.SampledSignal{}}, result, "The result should comply with the MCP specification")
+}
@@ -8,18 +8,43 @@ import (
 
 type PNGImageData []byte
 
+type SVGImageData []byte
+
 // RichContent is used as a tool output, when unstructured content should be used.
 // That is, the tool will have no output schema and `structuredContent` will be `nil`.
 // This should only be used when the tool needs to return content like images, sound, or resources.
 type RichContent struct {
-	TextContent  []string
-	ImageContent []PNGImageData
+	TextContent     []string
+	ImageContent    []PNGImageData
+	SVGImageContent []SVGImageData
+	ResourceLinks   []ResourceLink
+}
+
+// ResourceLink points to a resource the client can read after the tool call, such as a figure rendered in the background.
+type ResourceLink struct {
+	URI      string
+	Name     string
+	MIMEType string
 }
 
 type Tool interface {
 	AddToServer(server *mcp.Server) error
 }
 
+// ToolProvider provides the tools that are not built into the server, such as the tools of plugins.
+type ToolProvider interface {
+	Tools() []ProvidedTool
+}
+
+// ProvidedTool is a tool of a ToolProvider.
+type ProvidedTool interface {
+	Tool
+	Name() string
+	// ReadOnly is true when the tool neither runs code provided by the client nor modifies anything, so that it is
+	// also available in read-only mode and in dry runs.
+	ReadOnly() bool
+}
+
 type ToolWithUnstructuredContentOutput[ToolInput any] interface {
 	Tool
 	Handler() mcp.ToolHandlerFor[ToolInput, any]
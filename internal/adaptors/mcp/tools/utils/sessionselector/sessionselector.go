@@ -0,0 +1,24 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package sessionselector
+
+import (
+	"context"
+	"errors"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// Select returns the ID of the MATLAB session a tool call is for: the session named, or with the ID, given in session,
+// or else the session with the ID sessionID. Session IDs start at 1, so a sessionID of 0 means it was not given.
+func Select(ctx context.Context, sessionLogger entities.Logger, matlabManager entities.MATLABManager, sessionID int, session string) (entities.SessionID, error) {
+	if session != "" {
+		return matlabManager.FindMATLABSession(ctx, sessionLogger, session)
+	}
+
+	if sessionID == 0 {
+		return 0, entities.NewCodedError(entities.ErrorCodeInvalidInput, errors.New("no MATLAB session given: set session to the name or ID of a session, or session_id to its ID"))
+	}
+
+	return entities.SessionID(sessionID), nil
+}
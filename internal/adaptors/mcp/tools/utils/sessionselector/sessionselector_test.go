@@ -0,0 +1,83 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package sessionselector_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/sessionselector"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelect_Session(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABManager.EXPECT().
+		FindMATLABSession(t.Context(), mockLogger.AsMockArg(), "analysis").
+		Return(entities.SessionID(3), nil).
+		Once()
+
+	// Act
+	sessionID, err := sessionselector.Select(t.Context(), mockLogger, mockMATLABManager, 1, "analysis")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, entities.SessionID(3), sessionID, "The session should take precedence over the session ID")
+}
+
+func TestSelect_SessionNotFound(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABManager.EXPECT().
+		FindMATLABSession(t.Context(), mockLogger.AsMockArg(), "analysis").
+		Return(0, assert.AnError).
+		Once()
+
+	// Act
+	_, err := sessionselector.Select(t.Context(), mockLogger, mockMATLABManager, 0, "analysis")
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+func TestSelect_SessionID(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	// Act
+	sessionID, err := sessionselector.Select(t.Context(), mockLogger, mockMATLABManager, 2, "")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, entities.SessionID(2), sessionID)
+}
+
+func TestSelect_NoSession(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	// Act
+	_, err := sessionselector.Select(t.Context(), mockLogger, mockMATLABManager, 0, "")
+
+	// Assert
+	require.Error(t, err)
+	assert.Equal(t, entities.ErrorCodeInvalidInput, entities.ErrorCodeOf(err))
+}
@@ -0,0 +1,388 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package memorywatchdog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/artifactstore"
+)
+
+const (
+	megabyte = 1 << 20
+
+	// queryTimeout bounds the calls describing the workspace and relieving the memory of the MATLAB session,
+	// which wait for the code running in the session to finish.
+	queryTimeout = 10 * time.Second
+
+	// checkpointTimeout bounds the time taken to save the workspace before the MATLAB session is restarted.
+	checkpointTimeout = 5 * time.Minute
+
+	checkpointMIMEType = "application/x-matlab-data"
+
+	notificationLevelWarning = "warning"
+	notificationLevelError   = "error"
+)
+
+type Config interface {
+	UseSingleMATLABSession() bool
+	MemoryWarningMB() int
+	MemoryRestartMB() int
+	MemoryMitigation() bool
+	MemoryCheckInterval() time.Duration
+	EncryptAtRest() bool
+}
+
+type LoggerFactory interface {
+	GetGlobalLogger() entities.Logger
+}
+
+type LifecycleSignaler interface {
+	AddShutdownFunction(shutdownFcn func() error)
+}
+
+type GlobalMATLAB interface {
+	Client(ctx context.Context, logger entities.Logger) (entities.MATLABSessionClient, error)
+	Restart(ctx context.Context, logger entities.Logger) error
+}
+
+type ProcessMemory interface {
+	ResidentBytes(pid int) (int64, error)
+}
+
+type ArtifactStore interface {
+	Dir() (string, error)
+	Register(logger entities.Logger, filePath string, mimeType string) (artifactstore.Artifact, error)
+}
+
+type EventRecorder interface {
+	Record(kind entities.EventKind, message string, details map[string]any)
+}
+
+type ClientNotifier interface {
+	NotifyClients(level string, message string)
+}
+
+type workspaceUsage struct {
+	PID              int             `json:"pid"`
+	WorkspaceBytes   int64           `json:"workspaceBytes"`
+	LargestVariables []variableUsage `json:"largestVariables"`
+}
+
+type variableUsage struct {
+	Name  string `json:"name"`
+	Bytes int64  `json:"bytes"`
+}
+
+// Watchdog checks the memory used by the MATLAB session, and acts before the operating system stops the session
+// for running out of memory: it warns the MCP clients, optionally clears the caches of the session, and as a last
+// resort saves the workspace to a MAT-file and restarts the session.
+//
+// The memory is read from the operating system, so that it is checked while the session is busy evaluating code.
+type Watchdog struct {
+	config            Config
+	logger            entities.Logger
+	lifecycleSignaler LifecycleSignaler
+	globalMATLAB      GlobalMATLAB
+	processMemory     ProcessMemory
+	artifactStore     ArtifactStore
+	eventRecorder     EventRecorder
+	clientNotifier    ClientNotifier
+
+	// The state is only accessed by the goroutine checking the memory.
+	pid    int
+	warned bool
+}
+
+func New(
+	config Config,
+	loggerFactory LoggerFactory,
+	lifecycleSignaler LifecycleSignaler,
+	globalMATLAB GlobalMATLAB,
+	processMemory ProcessMemory,
+	artifactStore ArtifactStore,
+	eventRecorder EventRecorder,
+	clientNotifier ClientNotifier,
+) *Watchdog {
+	return &Watchdog{
+		config:            config,
+		logger:            loggerFactory.GetGlobalLogger().With("component", "memory-watchdog"),
+		lifecycleSignaler: lifecycleSignaler,
+		globalMATLAB:      globalMATLAB,
+		processMemory:     processMemory,
+		artifactStore:     artifactStore,
+		eventRecorder:     eventRecorder,
+		clientNotifier:    clientNotifier,
+	}
+}
+
+// Start checks the memory used by the MATLAB session at every check interval, until the server shuts down or ctx is
+// cancelled.
+// It does nothing unless a memory threshold is set.
+func (w *Watchdog) Start(ctx context.Context) {
+	if w.config.MemoryWarningMB() == 0 && w.config.MemoryRestartMB() == 0 {
+		return
+	}
+
+	if !w.config.UseSingleMATLABSession() {
+		w.logger.Warn("Memory thresholds only apply to a single MATLAB session, ignoring them")
+		return
+	}
+
+	ctx, stop := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		w.run(ctx)
+	}()
+
+	w.lifecycleSignaler.AddShutdownFunction(func() error {
+		w.logger.Debug("Stopping memory watchdog")
+		stop()
+		<-done
+		return nil
+	})
+
+	w.logger.
+		With("warning-mb", w.config.MemoryWarningMB()).
+		With("restart-mb", w.config.MemoryRestartMB()).
+		With("interval", w.config.MemoryCheckInterval().String()).
+		Info("Memory watchdog started")
+}
+
+func (w *Watchdog) run(ctx context.Context) {
+	ticker := time.NewTicker(w.config.MemoryCheckInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.check(ctx)
+		}
+	}
+}
+
+func (w *Watchdog) check(ctx context.Context) {
+	client, err := w.globalMATLAB.Client(ctx, w.logger)
+	if err != nil {
+		w.logger.WithError(err).Debug("MATLAB session unavailable, skipping memory check")
+		return
+	}
+
+	if w.pid == 0 {
+		usage, err := w.workspaceUsage(ctx, client)
+		if err != nil {
+			w.logger.WithError(err).Debug("Failed to find the MATLAB process, skipping memory check")
+			return
+		}
+		w.pid = usage.PID
+	}
+
+	residentBytes, err := w.processMemory.ResidentBytes(w.pid)
+	if err != nil {
+		// The process may have been replaced, for example if MATLAB was restarted, so it is looked up again at the next check.
+		w.logger.WithError(err).Debug("Failed to read the memory of the MATLAB process")
+		w.pid = 0
+		return
+	}
+	residentMB := int(residentBytes / megabyte)
+
+	warningMB := w.config.MemoryWarningMB()
+	restartMB := w.config.MemoryRestartMB()
+
+	switch {
+	case restartMB > 0 && residentMB >= restartMB:
+		w.restart(ctx, client, residentMB)
+	case warningMB > 0 && residentMB >= warningMB:
+		// Warn once each time the threshold is crossed, rather than at every check.
+		if !w.warned {
+			w.warned = true
+			w.warn(ctx, client, residentMB)
+		}
+	default:
+		w.warned = false
+	}
+}
+
+func (w *Watchdog) warn(ctx context.Context, client entities.MATLABSessionClient, residentMB int) {
+	warningMB := w.config.MemoryWarningMB()
+
+	message := fmt.Sprintf("The MATLAB session uses %d MB of memory, above the warning threshold of %d MB.", residentMB, warningMB)
+	details := map[string]any{
+		"resident-mb":  residentMB,
+		"threshold-mb": warningMB,
+	}
+
+	usage, err := w.workspaceUsage(ctx, client)
+	if err != nil {
+		w.logger.WithError(err).Debug("Failed to describe the workspace, the MATLAB session may be busy")
+	} else {
+		message += " " + describeWorkspace(usage)
+		details["workspace-mb"] = usage.WorkspaceBytes / megabyte
+	}
+
+	if w.config.MemoryMitigation() {
+		message += " " + w.mitigate(ctx, client)
+	}
+
+	message += " Clear the variables that are no longer needed, or save them to a file."
+	if restartMB := w.config.MemoryRestartMB(); restartMB > 0 {
+		if w.config.EncryptAtRest() {
+			message += fmt.Sprintf(" Above %d MB, the code running in the session is interrupted, and the session is restarted without saving the workspace.", restartMB)
+		} else {
+			message += fmt.Sprintf(" Above %d MB, the code running in the session is interrupted, the workspace is saved to a MAT-file, and the session is restarted.", restartMB)
+		}
+	}
+
+	w.logger.With("resident-mb", residentMB).Warn("MATLAB session memory above warning threshold")
+	w.notify(entities.EventKindMATLABMemoryWarning, notificationLevelWarning, message, details)
+}
+
+// mitigate clears the caches of the MATLAB session, and returns a sentence describing the outcome.
+func (w *Watchdog) mitigate(ctx context.Context, client entities.MATLABSessionClient) string {
+	queryCtx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	_, err := client.FEval(queryCtx, w.logger, entities.FEvalRequest{
+		Function:   "matlab_mcp.relieveMemory",
+		NumOutputs: 0,
+	})
+	if err != nil {
+		w.logger.WithError(err).Warn("Failed to clear the caches of the MATLAB session")
+		return "The caches of the MATLAB session could not be cleared, as it is busy."
+	}
+
+	residentBytes, err := w.processMemory.ResidentBytes(w.pid)
+	if err != nil {
+		w.logger.WithError(err).Debug("Failed to read the memory of the MATLAB process")
+		return "The caches of the MATLAB session were cleared."
+	}
+
+	return fmt.Sprintf("The caches of the MATLAB session were cleared, which brought its memory to %d MB.", residentBytes/megabyte)
+}
+
+func (w *Watchdog) restart(ctx context.Context, client entities.MATLABSessionClient, residentMB int) {
+	restartMB := w.config.MemoryRestartMB()
+	w.logger.With("resident-mb", residentMB).Warn("MATLAB session memory above restart threshold, restarting it")
+
+	message := fmt.Sprintf("The MATLAB session used %d MB of memory, above the restart threshold of %d MB, so the code running in it was interrupted and the session was restarted, before the operating system stopped it.", residentMB, restartMB)
+	details := map[string]any{
+		"resident-mb":  residentMB,
+		"threshold-mb": restartMB,
+	}
+
+	// The running code is most likely what allocates the memory, and the workspace cannot be saved until it stops.
+	if err := client.Interrupt(ctx, w.logger); err != nil {
+		w.logger.WithError(err).Warn("Failed to interrupt the MATLAB session")
+	}
+
+	// MATLAB writes the MAT-file of the checkpoint unencrypted, so the workspace is not saved when encryption at rest is on.
+	if w.config.EncryptAtRest() {
+		w.logger.Warn("Not saving the workspace of the MATLAB session, as encryption at rest is on")
+		message += " The workspace was not saved, as encryption at rest is on and MATLAB cannot save it encrypted."
+	} else if artifact, err := w.checkpoint(ctx, client); err != nil {
+		w.logger.WithError(err).Warn("Failed to save the workspace of the MATLAB session")
+		message += fmt.Sprintf(" The workspace could not be saved: %v.", err)
+	} else {
+		message += fmt.Sprintf(" The workspace was saved to %s, which is also available as the %s resource. Run load('%s') to restore it.", artifact.Path, artifact.URI, strings.ReplaceAll(artifact.Path, "'", "''"))
+		details["checkpoint"] = artifact.URI
+	}
+
+	if err := w.globalMATLAB.Restart(ctx, w.logger); err != nil {
+		w.logger.WithError(err).Error("Failed to restart the MATLAB session")
+		message += fmt.Sprintf(" The new MATLAB session failed to start: %v.", err)
+	}
+
+	w.pid = 0
+	w.warned = false
+
+	w.notify(entities.EventKindMATLABMemoryRestart, notificationLevelError, message, details)
+}
+
+// checkpoint saves the workspace of the MATLAB session to a MAT-file of the artifact directory.
+func (w *Watchdog) checkpoint(ctx context.Context, client entities.MATLABSessionClient) (artifactstore.Artifact, error) {
+	dir, err := w.artifactStore.Dir()
+	if err != nil {
+		return artifactstore.Artifact{}, err
+	}
+
+	checkpointCtx, cancel := context.WithTimeout(ctx, checkpointTimeout)
+	defer cancel()
+
+	response, err := client.FEval(checkpointCtx, w.logger, entities.FEvalRequest{
+		Function:   "matlab_mcp.checkpointWorkspace",
+		Arguments:  []string{dir},
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return artifactstore.Artifact{}, err
+	}
+
+	if len(response.Outputs) != 1 {
+		return artifactstore.Artifact{}, fmt.Errorf("unexpected number of outputs from MATLAB session")
+	}
+
+	file, ok := response.Outputs[0].(string)
+	if !ok {
+		return artifactstore.Artifact{}, fmt.Errorf("failed to cast output to string")
+	}
+
+	return w.artifactStore.Register(w.logger, file, checkpointMIMEType)
+}
+
+func (w *Watchdog) workspaceUsage(ctx context.Context, client entities.MATLABSessionClient) (workspaceUsage, error) {
+	queryCtx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	response, err := client.FEval(queryCtx, w.logger, entities.FEvalRequest{
+		Function:   "matlab_mcp.memoryUsage",
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return workspaceUsage{}, err
+	}
+
+	if len(response.Outputs) != 1 {
+		return workspaceUsage{}, fmt.Errorf("unexpected number of outputs from MATLAB session")
+	}
+
+	output, ok := response.Outputs[0].(string)
+	if !ok {
+		return workspaceUsage{}, fmt.Errorf("failed to cast output to string")
+	}
+
+	var usage workspaceUsage
+	if err := json.Unmarshal([]byte(output), &usage); err != nil {
+		return workspaceUsage{}, fmt.Errorf("failed to parse memory usage: %w", err)
+	}
+
+	return usage, nil
+}
+
+func (w *Watchdog) notify(kind entities.EventKind, level string, message string, details map[string]any) {
+	w.eventRecorder.Record(kind, message, details)
+	w.clientNotifier.NotifyClients(level, message)
+}
+
+// describeWorkspace returns a sentence with the size of the workspace, and its largest variables.
+func describeWorkspace(usage workspaceUsage) string {
+	description := fmt.Sprintf("The workspace holds %.1f MB", float64(usage.WorkspaceBytes)/megabyte)
+	if len(usage.LargestVariables) == 0 {
+		return description + "."
+	}
+
+	variables := make([]string, 0, len(usage.LargestVariables))
+	for _, variable := range usage.LargestVariables {
+		variables = append(variables, fmt.Sprintf("%s (%.1f MB)", variable.Name, float64(variable.Bytes)/megabyte))
+	}
+
+	return description + ", the largest variables being " + strings.Join(variables, ", ") + "."
+}
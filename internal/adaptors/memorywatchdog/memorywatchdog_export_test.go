@@ -0,0 +1,9 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package memorywatchdog
+
+import "context"
+
+func (w *Watchdog) Check(ctx context.Context) {
+	w.check(ctx)
+}
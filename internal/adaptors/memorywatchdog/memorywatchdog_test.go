@@ -0,0 +1,1028 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package memorywatchdog_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/memorywatchdog"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/artifactstore"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/memorywatchdog"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	matlabPID         = 4321
+	memoryUsageOutput = `{"pid":4321,"workspaceBytes":3145728,"largestVariables":[{"name":"data","bytes":2097152},{"name":"results","bytes":1048576}]}`
+)
+
+func expectMemoryUsage(mockClient *entitiesmocks.MockMATLABSessionClient, mockLogger *testutils.InspectableLogger, times int) {
+	mockClient.EXPECT().
+		FEval(mock.Anything, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.memoryUsage",
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{memoryUsageOutput}}, nil).
+		Times(times)
+}
+
+func expectResidentMB(mockProcessMemory *mocks.MockProcessMemory, residentMB int64) {
+	mockProcessMemory.EXPECT().
+		ResidentBytes(matlabPID).
+		Return(residentMB<<20, nil).
+		Once()
+}
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockProcessMemory := &mocks.MockProcessMemory{}
+	defer mockProcessMemory.AssertExpectations(t)
+
+	mockArtifactStore := &mocks.MockArtifactStore{}
+	defer mockArtifactStore.AssertExpectations(t)
+
+	mockEventRecorder := &mocks.MockEventRecorder{}
+	defer mockEventRecorder.AssertExpectations(t)
+
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	watchdog := memorywatchdog.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler, mockGlobalMATLAB, mockProcessMemory, mockArtifactStore, mockEventRecorder, mockClientNotifier)
+
+	// Assert
+	assert.NotNil(t, watchdog)
+}
+
+func TestWatchdog_Start_DisabledWithoutThresholds(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockProcessMemory := &mocks.MockProcessMemory{}
+	defer mockProcessMemory.AssertExpectations(t)
+
+	mockArtifactStore := &mocks.MockArtifactStore{}
+	defer mockArtifactStore.AssertExpectations(t)
+
+	mockEventRecorder := &mocks.MockEventRecorder{}
+	defer mockEventRecorder.AssertExpectations(t)
+
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockConfig.EXPECT().
+		MemoryWarningMB().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		MemoryRestartMB().
+		Return(0).
+		Once()
+
+	watchdog := memorywatchdog.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler, mockGlobalMATLAB, mockProcessMemory, mockArtifactStore, mockEventRecorder, mockClientNotifier)
+
+	// Act
+	watchdog.Start(t.Context())
+
+	// Assert
+	mockLifecycleSignaler.AssertNotCalled(t, "AddShutdownFunction", mock.Anything)
+}
+
+func TestWatchdog_Start_IgnoredWithMultipleSessions(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockProcessMemory := &mocks.MockProcessMemory{}
+	defer mockProcessMemory.AssertExpectations(t)
+
+	mockArtifactStore := &mocks.MockArtifactStore{}
+	defer mockArtifactStore.AssertExpectations(t)
+
+	mockEventRecorder := &mocks.MockEventRecorder{}
+	defer mockEventRecorder.AssertExpectations(t)
+
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockConfig.EXPECT().
+		MemoryWarningMB().
+		Return(1024).
+		Once()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false).
+		Once()
+
+	watchdog := memorywatchdog.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler, mockGlobalMATLAB, mockProcessMemory, mockArtifactStore, mockEventRecorder, mockClientNotifier)
+
+	// Act
+	watchdog.Start(t.Context())
+
+	// Assert
+	mockLifecycleSignaler.AssertNotCalled(t, "AddShutdownFunction", mock.Anything)
+	assert.NotEmpty(t, mockLogger.WarnLogs())
+}
+
+func TestWatchdog_Start_StopsOnShutdown(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockProcessMemory := &mocks.MockProcessMemory{}
+	defer mockProcessMemory.AssertExpectations(t)
+
+	mockArtifactStore := &mocks.MockArtifactStore{}
+	defer mockArtifactStore.AssertExpectations(t)
+
+	mockEventRecorder := &mocks.MockEventRecorder{}
+	defer mockEventRecorder.AssertExpectations(t)
+
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockConfig.EXPECT().
+		MemoryWarningMB().
+		Return(1024).
+		Twice()
+
+	mockConfig.EXPECT().
+		MemoryRestartMB().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		MemoryCheckInterval().
+		Return(time.Hour).
+		Twice()
+
+	var shutdownFcn func() error
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.Anything).
+		Run(func(fcn func() error) {
+			shutdownFcn = fcn
+		}).
+		Once()
+
+	watchdog := memorywatchdog.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler, mockGlobalMATLAB, mockProcessMemory, mockArtifactStore, mockEventRecorder, mockClientNotifier)
+
+	// Act
+	watchdog.Start(t.Context())
+
+	// Assert
+	require.NotNil(t, shutdownFcn)
+	require.NoError(t, shutdownFcn())
+}
+
+func TestWatchdog_Check_BelowThresholds(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockProcessMemory := &mocks.MockProcessMemory{}
+	defer mockProcessMemory.AssertExpectations(t)
+
+	mockArtifactStore := &mocks.MockArtifactStore{}
+	defer mockArtifactStore.AssertExpectations(t)
+
+	mockEventRecorder := &mocks.MockEventRecorder{}
+	defer mockEventRecorder.AssertExpectations(t)
+
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockConfig.EXPECT().
+		MemoryWarningMB().
+		Return(1024).
+		Once()
+
+	mockConfig.EXPECT().
+		MemoryRestartMB().
+		Return(2048).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(mock.Anything, mockLogger.AsMockArg()).
+		Return(mockClient, nil).
+		Once()
+
+	expectMemoryUsage(mockClient, mockLogger, 1)
+	expectResidentMB(mockProcessMemory, 512)
+
+	watchdog := memorywatchdog.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler, mockGlobalMATLAB, mockProcessMemory, mockArtifactStore, mockEventRecorder, mockClientNotifier)
+
+	// Act
+	watchdog.Check(t.Context())
+
+	// Assert
+	mockClientNotifier.AssertNotCalled(t, "NotifyClients", mock.Anything, mock.Anything)
+}
+
+func TestWatchdog_Check_WarnsOncePerCrossing(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockProcessMemory := &mocks.MockProcessMemory{}
+	defer mockProcessMemory.AssertExpectations(t)
+
+	mockArtifactStore := &mocks.MockArtifactStore{}
+	defer mockArtifactStore.AssertExpectations(t)
+
+	mockEventRecorder := &mocks.MockEventRecorder{}
+	defer mockEventRecorder.AssertExpectations(t)
+
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockConfig.EXPECT().
+		MemoryWarningMB().
+		Return(1024).
+		Times(6)
+
+	mockConfig.EXPECT().
+		MemoryRestartMB().
+		Return(0).
+		Times(6)
+
+	mockConfig.EXPECT().
+		MemoryMitigation().
+		Return(false).
+		Twice()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(mock.Anything, mockLogger.AsMockArg()).
+		Return(mockClient, nil).
+		Times(4)
+
+	// Once to find the MATLAB process, and once to describe the workspace in each warning.
+	expectMemoryUsage(mockClient, mockLogger, 3)
+	expectResidentMB(mockProcessMemory, 1536)
+	expectResidentMB(mockProcessMemory, 1600)
+	expectResidentMB(mockProcessMemory, 512)
+	expectResidentMB(mockProcessMemory, 1536)
+
+	var messages []string
+	mockEventRecorder.EXPECT().
+		Record(entities.EventKindMATLABMemoryWarning, mock.Anything, mock.Anything).
+		Return().
+		Twice()
+
+	mockClientNotifier.EXPECT().
+		NotifyClients("warning", mock.Anything).
+		Run(func(_ string, message string) {
+			messages = append(messages, message)
+		}).
+		Return().
+		Twice()
+
+	watchdog := memorywatchdog.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler, mockGlobalMATLAB, mockProcessMemory, mockArtifactStore, mockEventRecorder, mockClientNotifier)
+
+	// Act
+	for range 4 {
+		watchdog.Check(t.Context())
+	}
+
+	// Assert
+	require.Len(t, messages, 2)
+	assert.Contains(t, messages[0], "uses 1536 MB of memory, above the warning threshold of 1024 MB")
+	assert.Contains(t, messages[0], "The workspace holds 3.0 MB, the largest variables being data (2.0 MB), results (1.0 MB).")
+	assert.NotContains(t, messages[0], "caches")
+}
+
+func TestWatchdog_Check_WarningWithMitigation(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockProcessMemory := &mocks.MockProcessMemory{}
+	defer mockProcessMemory.AssertExpectations(t)
+
+	mockArtifactStore := &mocks.MockArtifactStore{}
+	defer mockArtifactStore.AssertExpectations(t)
+
+	mockEventRecorder := &mocks.MockEventRecorder{}
+	defer mockEventRecorder.AssertExpectations(t)
+
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockConfig.EXPECT().
+		MemoryWarningMB().
+		Return(1024).
+		Twice()
+
+	mockConfig.EXPECT().
+		MemoryRestartMB().
+		Return(0).
+		Twice()
+
+	mockConfig.EXPECT().
+		MemoryMitigation().
+		Return(true).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(mock.Anything, mockLogger.AsMockArg()).
+		Return(mockClient, nil).
+		Once()
+
+	expectMemoryUsage(mockClient, mockLogger, 2)
+	expectResidentMB(mockProcessMemory, 1536)
+	expectResidentMB(mockProcessMemory, 900)
+
+	mockClient.EXPECT().
+		FEval(mock.Anything, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.relieveMemory",
+			NumOutputs: 0,
+		}).
+		Return(entities.FEvalResponse{}, nil).
+		Once()
+
+	mockEventRecorder.EXPECT().
+		Record(entities.EventKindMATLABMemoryWarning, mock.Anything, map[string]any{
+			"resident-mb":  1536,
+			"threshold-mb": 1024,
+			"workspace-mb": int64(3),
+		}).
+		Return().
+		Once()
+
+	var message string
+	mockClientNotifier.EXPECT().
+		NotifyClients("warning", mock.Anything).
+		Run(func(_ string, msg string) {
+			message = msg
+		}).
+		Return().
+		Once()
+
+	watchdog := memorywatchdog.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler, mockGlobalMATLAB, mockProcessMemory, mockArtifactStore, mockEventRecorder, mockClientNotifier)
+
+	// Act
+	watchdog.Check(t.Context())
+
+	// Assert
+	assert.Contains(t, message, "The caches of the MATLAB session were cleared, which brought its memory to 900 MB.")
+}
+
+func TestWatchdog_Check_WarningWithEncryptionAtRest(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockProcessMemory := &mocks.MockProcessMemory{}
+	defer mockProcessMemory.AssertExpectations(t)
+
+	mockArtifactStore := &mocks.MockArtifactStore{}
+	defer mockArtifactStore.AssertExpectations(t)
+
+	mockEventRecorder := &mocks.MockEventRecorder{}
+	defer mockEventRecorder.AssertExpectations(t)
+
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockConfig.EXPECT().
+		MemoryWarningMB().
+		Return(1024).
+		Twice()
+
+	mockConfig.EXPECT().
+		MemoryRestartMB().
+		Return(2048).
+		Twice()
+
+	mockConfig.EXPECT().
+		MemoryMitigation().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		EncryptAtRest().
+		Return(true).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(mock.Anything, mockLogger.AsMockArg()).
+		Return(mockClient, nil).
+		Once()
+
+	expectMemoryUsage(mockClient, mockLogger, 2)
+	expectResidentMB(mockProcessMemory, 1536)
+
+	mockEventRecorder.EXPECT().
+		Record(entities.EventKindMATLABMemoryWarning, mock.Anything, mock.Anything).
+		Return().
+		Once()
+
+	var message string
+	mockClientNotifier.EXPECT().
+		NotifyClients("warning", mock.Anything).
+		Run(func(_ string, msg string) {
+			message = msg
+		}).
+		Return().
+		Once()
+
+	watchdog := memorywatchdog.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler, mockGlobalMATLAB, mockProcessMemory, mockArtifactStore, mockEventRecorder, mockClientNotifier)
+
+	// Act
+	watchdog.Check(t.Context())
+
+	// Assert
+	assert.Contains(t, message, "Above 2048 MB, the code running in the session is interrupted, and the session is restarted without saving the workspace.")
+	assert.NotContains(t, message, "MAT-file")
+}
+
+func TestWatchdog_Check_RestartsAboveRestartThreshold(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockProcessMemory := &mocks.MockProcessMemory{}
+	defer mockProcessMemory.AssertExpectations(t)
+
+	mockArtifactStore := &mocks.MockArtifactStore{}
+	defer mockArtifactStore.AssertExpectations(t)
+
+	mockEventRecorder := &mocks.MockEventRecorder{}
+	defer mockEventRecorder.AssertExpectations(t)
+
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockConfig.EXPECT().
+		MemoryWarningMB().
+		Return(1024).
+		Once()
+
+	mockConfig.EXPECT().
+		MemoryRestartMB().
+		Return(2048).
+		Twice()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(mock.Anything, mockLogger.AsMockArg()).
+		Return(mockClient, nil).
+		Once()
+
+	expectMemoryUsage(mockClient, mockLogger, 1)
+	expectResidentMB(mockProcessMemory, 3000)
+
+	artifactDir := "/tmp/artifacts-123"
+	checkpointFile := artifactDir + "/workspace-checkpoint-20250101-120000.mat"
+	checkpoint := artifactstore.Artifact{
+		Name: "workspace-checkpoint-20250101-120000.mat",
+		URI:  artifactstore.URIPrefix + "workspace-checkpoint-20250101-120000.mat",
+		Path: checkpointFile,
+	}
+
+	mockClient.EXPECT().
+		Interrupt(mock.Anything, mockLogger.AsMockArg()).
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		EncryptAtRest().
+		Return(false).
+		Once()
+
+	mockArtifactStore.EXPECT().
+		Dir().
+		Return(artifactDir, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(mock.Anything, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.checkpointWorkspace",
+			Arguments:  []string{artifactDir},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{checkpointFile}}, nil).
+		Once()
+
+	mockArtifactStore.EXPECT().
+		Register(mockLogger.AsMockArg(), checkpointFile, "application/x-matlab-data").
+		Return(checkpoint, nil).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Restart(mock.Anything, mockLogger.AsMockArg()).
+		Return(nil).
+		Once()
+
+	mockEventRecorder.EXPECT().
+		Record(entities.EventKindMATLABMemoryRestart, mock.Anything, map[string]any{
+			"resident-mb":  3000,
+			"threshold-mb": 2048,
+			"checkpoint":   checkpoint.URI,
+		}).
+		Return().
+		Once()
+
+	var message string
+	mockClientNotifier.EXPECT().
+		NotifyClients("error", mock.Anything).
+		Run(func(_ string, msg string) {
+			message = msg
+		}).
+		Return().
+		Once()
+
+	watchdog := memorywatchdog.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler, mockGlobalMATLAB, mockProcessMemory, mockArtifactStore, mockEventRecorder, mockClientNotifier)
+
+	// Act
+	watchdog.Check(t.Context())
+
+	// Assert
+	assert.Contains(t, message, "used 3000 MB of memory, above the restart threshold of 2048 MB")
+	assert.Contains(t, message, "The workspace was saved to "+checkpointFile+", which is also available as the "+checkpoint.URI+" resource.")
+	assert.Contains(t, message, "Run load('"+checkpointFile+"') to restore it.")
+}
+
+func TestWatchdog_Check_RestartsEvenIfCheckpointFails(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockProcessMemory := &mocks.MockProcessMemory{}
+	defer mockProcessMemory.AssertExpectations(t)
+
+	mockArtifactStore := &mocks.MockArtifactStore{}
+	defer mockArtifactStore.AssertExpectations(t)
+
+	mockEventRecorder := &mocks.MockEventRecorder{}
+	defer mockEventRecorder.AssertExpectations(t)
+
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockConfig.EXPECT().
+		MemoryWarningMB().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		MemoryRestartMB().
+		Return(2048).
+		Twice()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(mock.Anything, mockLogger.AsMockArg()).
+		Return(mockClient, nil).
+		Once()
+
+	expectMemoryUsage(mockClient, mockLogger, 1)
+	expectResidentMB(mockProcessMemory, 3000)
+
+	mockClient.EXPECT().
+		Interrupt(mock.Anything, mockLogger.AsMockArg()).
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		EncryptAtRest().
+		Return(false).
+		Once()
+
+	mockArtifactStore.EXPECT().
+		Dir().
+		Return("", errors.New("disk full")).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Restart(mock.Anything, mockLogger.AsMockArg()).
+		Return(nil).
+		Once()
+
+	mockEventRecorder.EXPECT().
+		Record(entities.EventKindMATLABMemoryRestart, mock.Anything, mock.Anything).
+		Return().
+		Once()
+
+	var message string
+	mockClientNotifier.EXPECT().
+		NotifyClients("error", mock.Anything).
+		Run(func(_ string, msg string) {
+			message = msg
+		}).
+		Return().
+		Once()
+
+	watchdog := memorywatchdog.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler, mockGlobalMATLAB, mockProcessMemory, mockArtifactStore, mockEventRecorder, mockClientNotifier)
+
+	// Act
+	watchdog.Check(t.Context())
+
+	// Assert
+	assert.Contains(t, message, "The workspace could not be saved: disk full.")
+}
+
+func TestWatchdog_Check_RestartsWithoutCheckpointWithEncryptionAtRest(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockProcessMemory := &mocks.MockProcessMemory{}
+	defer mockProcessMemory.AssertExpectations(t)
+
+	mockArtifactStore := &mocks.MockArtifactStore{}
+	defer mockArtifactStore.AssertExpectations(t)
+
+	mockEventRecorder := &mocks.MockEventRecorder{}
+	defer mockEventRecorder.AssertExpectations(t)
+
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockConfig.EXPECT().
+		MemoryWarningMB().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		MemoryRestartMB().
+		Return(2048).
+		Twice()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(mock.Anything, mockLogger.AsMockArg()).
+		Return(mockClient, nil).
+		Once()
+
+	expectMemoryUsage(mockClient, mockLogger, 1)
+	expectResidentMB(mockProcessMemory, 3000)
+
+	mockClient.EXPECT().
+		Interrupt(mock.Anything, mockLogger.AsMockArg()).
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		EncryptAtRest().
+		Return(true).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Restart(mock.Anything, mockLogger.AsMockArg()).
+		Return(nil).
+		Once()
+
+	mockEventRecorder.EXPECT().
+		Record(entities.EventKindMATLABMemoryRestart, mock.Anything, map[string]any{
+			"resident-mb":  3000,
+			"threshold-mb": 2048,
+		}).
+		Return().
+		Once()
+
+	var message string
+	mockClientNotifier.EXPECT().
+		NotifyClients("error", mock.Anything).
+		Run(func(_ string, msg string) {
+			message = msg
+		}).
+		Return().
+		Once()
+
+	watchdog := memorywatchdog.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler, mockGlobalMATLAB, mockProcessMemory, mockArtifactStore, mockEventRecorder, mockClientNotifier)
+
+	// Act
+	watchdog.Check(t.Context())
+
+	// Assert
+	assert.Contains(t, message, "The workspace was not saved, as encryption at rest is on")
+	assert.NotContains(t, message, "The workspace was saved")
+}
+
+func TestWatchdog_Check_LooksUpProcessAgainAfterReadError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockProcessMemory := &mocks.MockProcessMemory{}
+	defer mockProcessMemory.AssertExpectations(t)
+
+	mockArtifactStore := &mocks.MockArtifactStore{}
+	defer mockArtifactStore.AssertExpectations(t)
+
+	mockEventRecorder := &mocks.MockEventRecorder{}
+	defer mockEventRecorder.AssertExpectations(t)
+
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockConfig.EXPECT().
+		MemoryWarningMB().
+		Return(1024).
+		Once()
+
+	mockConfig.EXPECT().
+		MemoryRestartMB().
+		Return(0).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(mock.Anything, mockLogger.AsMockArg()).
+		Return(mockClient, nil).
+		Twice()
+
+	expectMemoryUsage(mockClient, mockLogger, 2)
+
+	mockProcessMemory.EXPECT().
+		ResidentBytes(matlabPID).
+		Return(0, errors.New("no such process")).
+		Once()
+	expectResidentMB(mockProcessMemory, 512)
+
+	watchdog := memorywatchdog.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler, mockGlobalMATLAB, mockProcessMemory, mockArtifactStore, mockEventRecorder, mockClientNotifier)
+
+	// Act
+	watchdog.Check(t.Context())
+	watchdog.Check(t.Context())
+
+	// Assert
+	mockClientNotifier.AssertNotCalled(t, "NotifyClients", mock.Anything, mock.Anything)
+}
+
+func TestWatchdog_Check_MATLABUnavailable(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockProcessMemory := &mocks.MockProcessMemory{}
+	defer mockProcessMemory.AssertExpectations(t)
+
+	mockArtifactStore := &mocks.MockArtifactStore{}
+	defer mockArtifactStore.AssertExpectations(t)
+
+	mockEventRecorder := &mocks.MockEventRecorder{}
+	defer mockEventRecorder.AssertExpectations(t)
+
+	mockClientNotifier := &mocks.MockClientNotifier{}
+	defer mockClientNotifier.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(mock.Anything, mockLogger.AsMockArg()).
+		Return(nil, errors.New("MATLAB failed to start")).
+		Once()
+
+	watchdog := memorywatchdog.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler, mockGlobalMATLAB, mockProcessMemory, mockArtifactStore, mockEventRecorder, mockClientNotifier)
+
+	// Act
+	watchdog.Check(t.Context())
+
+	// Assert
+	mockProcessMemory.AssertNotCalled(t, "ResidentBytes", mock.Anything)
+}
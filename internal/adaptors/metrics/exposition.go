@@ -0,0 +1,164 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// The types below keep the series of the metrics, and write them in the Prometheus text exposition format. They are
+// not safe for concurrent use: Metrics holds its lock while using them.
+
+// labelValuesSeparator joins the label values of a series into its key. It cannot be part of a valid UTF-8 label value.
+const labelValuesSeparator = "\xff"
+
+type counterVec struct {
+	name       string
+	help       string
+	labelNames []string
+	series     map[string]*counterSeries
+}
+
+type counterSeries struct {
+	labelValues []string
+	value       float64
+}
+
+func newCounterVec(name string, help string, labelNames ...string) *counterVec {
+	return &counterVec{
+		name:       name,
+		help:       help,
+		labelNames: labelNames,
+		series:     map[string]*counterSeries{},
+	}
+}
+
+func (c *counterVec) inc(labelValues ...string) {
+	key := strings.Join(labelValues, labelValuesSeparator)
+	series, ok := c.series[key]
+	if !ok {
+		series = &counterSeries{labelValues: labelValues}
+		c.series[key] = series
+	}
+	series.value++
+}
+
+func (c *counterVec) write(buffer *bytes.Buffer) {
+	writeHeader(buffer, c.name, c.help, "counter")
+	for _, key := range sortedKeys(c.series) {
+		series := c.series[key]
+		writeSample(buffer, c.name, formatLabels(c.labelNames, series.labelValues), series.value)
+	}
+}
+
+type histogramVec struct {
+	name       string
+	help       string
+	labelNames []string
+	buckets    []float64
+	series     map[string]*histogramSeries
+}
+
+type histogramSeries struct {
+	labelValues  []string
+	bucketCounts []uint64
+	sum          float64
+	count        uint64
+}
+
+func newHistogramVec(name string, help string, buckets []float64, labelNames ...string) *histogramVec {
+	return &histogramVec{
+		name:       name,
+		help:       help,
+		labelNames: labelNames,
+		buckets:    buckets,
+		series:     map[string]*histogramSeries{},
+	}
+}
+
+func (h *histogramVec) observe(value float64, labelValues ...string) {
+	key := strings.Join(labelValues, labelValuesSeparator)
+	series, ok := h.series[key]
+	if !ok {
+		series = &histogramSeries{labelValues: labelValues, bucketCounts: make([]uint64, len(h.buckets))}
+		h.series[key] = series
+	}
+
+	for i, upperBound := range h.buckets {
+		if value <= upperBound {
+			series.bucketCounts[i]++
+		}
+	}
+	series.sum += value
+	series.count++
+}
+
+func (h *histogramVec) write(buffer *bytes.Buffer) {
+	writeHeader(buffer, h.name, h.help, "histogram")
+	for _, key := range sortedKeys(h.series) {
+		series := h.series[key]
+		for i, upperBound := range h.buckets {
+			labels := formatLabels(append(slices.Clone(h.labelNames), "le"), append(slices.Clone(series.labelValues), formatValue(upperBound)))
+			writeSample(buffer, h.name+"_bucket", labels, float64(series.bucketCounts[i]))
+		}
+		labels := formatLabels(append(slices.Clone(h.labelNames), "le"), append(slices.Clone(series.labelValues), "+Inf"))
+		writeSample(buffer, h.name+"_bucket", labels, float64(series.count))
+
+		labels = formatLabels(h.labelNames, series.labelValues)
+		writeSample(buffer, h.name+"_sum", labels, series.sum)
+		writeSample(buffer, h.name+"_count", labels, float64(series.count))
+	}
+}
+
+func writeGauge(buffer *bytes.Buffer, name string, help string, value float64) {
+	writeHeader(buffer, name, help, "gauge")
+	writeSample(buffer, name, "", value)
+}
+
+func writeHeader(buffer *bytes.Buffer, name string, help string, metricType string) {
+	fmt.Fprintf(buffer, "# HELP %s %s\n", name, help)
+	fmt.Fprintf(buffer, "# TYPE %s %s\n", name, metricType)
+}
+
+func writeSample(buffer *bytes.Buffer, name string, labels string, value float64) {
+	fmt.Fprintf(buffer, "%s%s %s\n", name, labels, formatValue(value))
+}
+
+func formatLabels(names []string, values []string) string {
+	if len(names) == 0 {
+		return ""
+	}
+
+	pairs := make([]string, len(names))
+	for i, name := range names {
+		pairs[i] = name + `="` + escapeLabelValue(values[i]) + `"`
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabelValue(value string) string {
+	return labelValueEscaper.Replace(value)
+}
+
+func formatValue(value float64) string {
+	if math.IsInf(value, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+func sortedKeys[V any](series map[string]V) []string {
+	keys := make([]string, 0, len(series))
+	for key := range series {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
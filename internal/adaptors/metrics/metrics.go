@@ -0,0 +1,245 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+const (
+	shutdownTimeout = 5 * time.Second
+
+	metricsPath = "/metrics"
+
+	// contentType is the content type of the Prometheus text exposition format.
+	contentType = "text/plain; version=0.0.4; charset=utf-8"
+
+	statusSuccess = "success"
+	statusError   = "error"
+
+	// unknownToolName is the tool label of the calls that failed before reaching a tool, such as the calls of unknown
+	// tools, so that clients cannot create a series per name they send.
+	unknownToolName = "unknown"
+)
+
+// durationBuckets are the upper bounds, in seconds, of the buckets of the duration histograms. They go up to minutes,
+// as MATLAB code can run for that long.
+var durationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300}
+
+type Config interface {
+	MetricsListenAddress() string
+}
+
+type LoggerFactory interface {
+	GetGlobalLogger() entities.Logger
+}
+
+type LifecycleSignaler interface {
+	AddShutdownFunction(shutdownFcn func() error)
+}
+
+// Metrics counts the tool calls, the evaluations in MATLAB, the restarts of MATLAB and the HTTP requests of the
+// server, and serves them in the Prometheus text format for scraping.
+// Nothing is recorded unless a metrics listen address is configured.
+type Metrics struct {
+	config            Config
+	logger            entities.Logger
+	lifecycleSignaler LifecycleSignaler
+	enabled           bool
+
+	lock               *sync.Mutex
+	toolCalls          *counterVec
+	toolCallDuration   *histogramVec
+	evaluationDuration *histogramVec
+	restarts           *counterVec
+	httpRequests       *counterVec
+	activeSessions     func() int
+
+	listener net.Listener
+}
+
+func New(
+	config Config,
+	loggerFactory LoggerFactory,
+	lifecycleSignaler LifecycleSignaler,
+) *Metrics {
+	return &Metrics{
+		config:            config,
+		logger:            loggerFactory.GetGlobalLogger().With("component", "metrics"),
+		lifecycleSignaler: lifecycleSignaler,
+		enabled:           config.MetricsListenAddress() != "",
+
+		lock:               new(sync.Mutex),
+		toolCalls:          newCounterVec("matlab_mcp_tool_calls_total", "Number of tool calls, by tool and status.", "tool", "status"),
+		toolCallDuration:   newHistogramVec("matlab_mcp_tool_call_duration_seconds", "Duration of tool calls, by tool.", durationBuckets, "tool"),
+		evaluationDuration: newHistogramVec("matlab_mcp_matlab_evaluation_duration_seconds", "Duration of the requests sent to MATLAB, by operation and status.", durationBuckets, "operation", "status"),
+		restarts:           newCounterVec("matlab_mcp_matlab_restarts_total", "Number of restarts of the MATLAB session, by reason.", "reason"),
+		httpRequests:       newCounterVec("matlab_mcp_http_requests_total", "Number of HTTP requests of the HTTP transport, by method and status code.", "method", "code"),
+	}
+}
+
+// Start serves the metrics on the metrics listen address, if configured.
+func (m *Metrics) Start() error {
+	address := m.config.MetricsListenAddress()
+	if address == "" {
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+	m.listener = listener
+
+	httpServer := &http.Server{
+		Handler:           m.handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	logger := m.logger.With("address", listener.Addr().String())
+
+	go func() {
+		if err := httpServer.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.WithError(err).Warn("Metrics server stopped unexpectedly")
+		}
+	}()
+
+	m.lifecycleSignaler.AddShutdownFunction(func() error {
+		logger.Debug("Stopping metrics server")
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		return httpServer.Shutdown(ctx)
+	})
+
+	logger.Info("Serving Prometheus metrics")
+	return nil
+}
+
+// Addr returns the address the metrics are served on, or nil if they are not.
+func (m *Metrics) Addr() net.Addr {
+	if m.listener == nil {
+		return nil
+	}
+	return m.listener.Addr()
+}
+
+// RecordToolCall records a tool call, which took duration. The tool is empty for the calls that failed before reaching
+// a tool.
+func (m *Metrics) RecordToolCall(tool string, duration time.Duration, failed bool) {
+	if !m.enabled {
+		return
+	}
+
+	if tool == "" {
+		tool = unknownToolName
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.toolCalls.inc(tool, status(failed))
+	m.toolCallDuration.observe(duration.Seconds(), tool)
+}
+
+// RecordEvaluation records a request sent to MATLAB, such as eval or feval, which took duration.
+func (m *Metrics) RecordEvaluation(operation string, duration time.Duration, failed bool) {
+	if !m.enabled {
+		return
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.evaluationDuration.observe(duration.Seconds(), operation, status(failed))
+}
+
+// RecordEvent counts the restarts of MATLAB from the events of the server.
+func (m *Metrics) RecordEvent(kind entities.EventKind) {
+	if !m.enabled {
+		return
+	}
+
+	var reason string
+	switch kind {
+	case entities.EventKindMATLABSessionRestarted:
+		reason = "crash"
+	case entities.EventKindMATLABMemoryRestart:
+		reason = "memory"
+	default:
+		return
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.restarts.inc(reason)
+}
+
+// RecordHTTPRequest records an HTTP request of the HTTP transport, answered with statusCode.
+func (m *Metrics) RecordHTTPRequest(method string, statusCode int) {
+	if !m.enabled {
+		return
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.httpRequests.inc(method, strconv.Itoa(statusCode))
+}
+
+// ObserveActiveSessions sets the function counting the active MCP sessions, called on every scrape.
+func (m *Metrics) ObserveActiveSessions(count func() int) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.activeSessions = count
+}
+
+func (m *Metrics) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+metricsPath, m.handleMetrics)
+	return mux
+}
+
+func (m *Metrics) handleMetrics(responseWriter http.ResponseWriter, _ *http.Request) {
+	responseWriter.Header().Set("Content-Type", contentType)
+	if _, err := responseWriter.Write(m.exposition()); err != nil {
+		m.logger.WithError(err).Debug("Failed to write metrics")
+	}
+}
+
+// exposition returns the metrics in the Prometheus text format.
+func (m *Metrics) exposition() []byte {
+	m.lock.Lock()
+	activeSessions := m.activeSessions
+	var buffer bytes.Buffer
+	m.toolCalls.write(&buffer)
+	m.toolCallDuration.write(&buffer)
+	m.evaluationDuration.write(&buffer)
+	m.restarts.write(&buffer)
+	m.httpRequests.write(&buffer)
+	m.lock.Unlock()
+
+	// The sessions are counted without holding the lock, as counting them waits for the lock of the MCP server.
+	if activeSessions != nil {
+		writeGauge(&buffer, "matlab_mcp_sessions_active", "Number of active MCP sessions.", float64(activeSessions()))
+	}
+
+	return buffer.Bytes()
+}
+
+func status(failed bool) string {
+	if failed {
+		return statusError
+	}
+	return statusSuccess
+}
@@ -0,0 +1,180 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package metrics_test
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/metrics"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newLoggerFactory(t *testing.T) *mocks.MockLoggerFactory {
+	t.Helper()
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	t.Cleanup(func() { mockLoggerFactory.AssertExpectations(t) })
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	return mockLoggerFactory
+}
+
+// scrape returns the metrics served at address.
+func scrape(t *testing.T, address string) string {
+	t.Helper()
+
+	response, err := http.Get("http://" + address + "/metrics")
+	require.NoError(t, err)
+	defer func() { _ = response.Body.Close() }()
+
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "text/plain; version=0.0.4; charset=utf-8", response.Header.Get("Content-Type"))
+
+	body, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestMetrics_Start_DisabledWhenNoAddress(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		MetricsListenAddress().
+		Return("").
+		Times(2)
+
+	metricsServer := metrics.New(mockConfig, newLoggerFactory(t), mockLifecycleSignaler)
+
+	// Act
+	err := metricsServer.Start()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Nil(t, metricsServer.Addr())
+}
+
+func TestMetrics_Start_ServesMetrics(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		MetricsListenAddress().
+		Return("127.0.0.1:0").
+		Times(2)
+
+	var shutdownFcn func() error
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.Anything).
+		Run(func(fcn func() error) {
+			shutdownFcn = fcn
+		}).
+		Return().
+		Once()
+
+	metricsServer := metrics.New(mockConfig, newLoggerFactory(t), mockLifecycleSignaler)
+
+	metricsServer.RecordToolCall("evaluate_matlab_code", 200*time.Millisecond, false)
+	metricsServer.RecordToolCall("evaluate_matlab_code", 3*time.Second, true)
+	metricsServer.RecordToolCall("", time.Millisecond, true)
+	metricsServer.RecordEvaluation("eval_with_capture", 2*time.Second, false)
+	metricsServer.RecordEvent(entities.EventKindMATLABSessionRestarted)
+	metricsServer.RecordEvent(entities.EventKindMATLABMemoryRestart)
+	metricsServer.RecordEvent(entities.EventKindToolCalled)
+	metricsServer.RecordHTTPRequest(http.MethodPost, http.StatusOK)
+	metricsServer.RecordHTTPRequest(http.MethodPost, http.StatusUnauthorized)
+	metricsServer.ObserveActiveSessions(func() int { return 2 })
+
+	// Act
+	err := metricsServer.Start()
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, metricsServer.Addr())
+	require.NotNil(t, shutdownFcn)
+	defer func() {
+		require.NoError(t, shutdownFcn())
+	}()
+
+	body := scrape(t, metricsServer.Addr().String())
+
+	for _, expectedLine := range []string{
+		"# TYPE matlab_mcp_tool_calls_total counter",
+		`matlab_mcp_tool_calls_total{tool="evaluate_matlab_code",status="error"} 1`,
+		`matlab_mcp_tool_calls_total{tool="evaluate_matlab_code",status="success"} 1`,
+		`matlab_mcp_tool_calls_total{tool="unknown",status="error"} 1`,
+		"# TYPE matlab_mcp_tool_call_duration_seconds histogram",
+		`matlab_mcp_tool_call_duration_seconds_bucket{tool="evaluate_matlab_code",le="0.1"} 0`,
+		`matlab_mcp_tool_call_duration_seconds_bucket{tool="evaluate_matlab_code",le="0.25"} 1`,
+		`matlab_mcp_tool_call_duration_seconds_bucket{tool="evaluate_matlab_code",le="5"} 2`,
+		`matlab_mcp_tool_call_duration_seconds_bucket{tool="evaluate_matlab_code",le="+Inf"} 2`,
+		`matlab_mcp_tool_call_duration_seconds_sum{tool="evaluate_matlab_code"} 3.2`,
+		`matlab_mcp_tool_call_duration_seconds_count{tool="evaluate_matlab_code"} 2`,
+		`matlab_mcp_matlab_evaluation_duration_seconds_count{operation="eval_with_capture",status="success"} 1`,
+		`matlab_mcp_matlab_restarts_total{reason="crash"} 1`,
+		`matlab_mcp_matlab_restarts_total{reason="memory"} 1`,
+		`matlab_mcp_http_requests_total{method="POST",code="200"} 1`,
+		`matlab_mcp_http_requests_total{method="POST",code="401"} 1`,
+		"# TYPE matlab_mcp_sessions_active gauge",
+		"matlab_mcp_sessions_active 2",
+	} {
+		assert.Contains(t, strings.Split(body, "\n"), expectedLine)
+	}
+}
+
+func TestMetrics_RecordToolCall_EscapesLabelValues(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		MetricsListenAddress().
+		Return("127.0.0.1:0").
+		Times(2)
+
+	var shutdownFcn func() error
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.Anything).
+		Run(func(fcn func() error) {
+			shutdownFcn = fcn
+		}).
+		Return().
+		Once()
+
+	metricsServer := metrics.New(mockConfig, newLoggerFactory(t), mockLifecycleSignaler)
+	require.NoError(t, metricsServer.Start())
+	defer func() {
+		require.NoError(t, shutdownFcn())
+	}()
+
+	// Act
+	metricsServer.RecordToolCall("plugin\\\"tool\"\n", time.Second, false)
+
+	// Assert
+	body := scrape(t, metricsServer.Addr().String())
+	assert.Contains(t, body, `matlab_mcp_tool_calls_total{tool="plugin\\\"tool\"\n",status="success"} 1`)
+}
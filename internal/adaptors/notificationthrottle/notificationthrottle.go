@@ -0,0 +1,94 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package notificationthrottle
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// pruneThreshold is the number of tracked clients above which idle clients are forgotten.
+const pruneThreshold = 1024
+
+type Config interface {
+	StreamNotificationRate() float64
+}
+
+type clientState struct {
+	tokens    float64
+	updatedAt time.Time
+}
+
+// NotificationThrottle limits the progress notifications sent to each client with a token bucket, refilled at the
+// notification rate up to a burst of one second of notifications. Notifications above the limit are not queued:
+// the caller drops them, so that output produced faster than the client reads it does not pile up in memory.
+type NotificationThrottle struct {
+	rate  float64
+	burst float64
+	now   func() time.Time
+
+	lock    sync.Mutex
+	clients map[string]*clientState
+}
+
+func New(
+	config Config,
+) *NotificationThrottle {
+	rate := config.StreamNotificationRate()
+
+	return &NotificationThrottle{
+		rate:    rate,
+		burst:   math.Max(1, math.Ceil(rate)),
+		now:     time.Now,
+		clients: map[string]*clientState{},
+	}
+}
+
+// Allow reports whether a progress notification can be sent to the client now, and consumes a token if it can.
+func (n *NotificationThrottle) Allow(clientID string) bool {
+	if n.rate == 0 {
+		return true
+	}
+
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	now := n.now()
+	client := n.client(clientID, now)
+
+	client.tokens = math.Min(n.burst, client.tokens+now.Sub(client.updatedAt).Seconds()*n.rate)
+	client.updatedAt = now
+	if client.tokens < 1 {
+		return false
+	}
+
+	client.tokens--
+	return true
+}
+
+func (n *NotificationThrottle) client(clientID string, now time.Time) *clientState {
+	if client, ok := n.clients[clientID]; ok {
+		return client
+	}
+
+	if len(n.clients) >= pruneThreshold {
+		n.pruneIdleClients(now)
+	}
+
+	client := &clientState{
+		tokens:    n.burst,
+		updatedAt: now,
+	}
+	n.clients[clientID] = client
+	return client
+}
+
+// pruneIdleClients forgets the clients whose bucket is full again, as they are in the same state as new clients.
+func (n *NotificationThrottle) pruneIdleClients(now time.Time) {
+	for clientID, client := range n.clients {
+		if client.tokens+now.Sub(client.updatedAt).Seconds()*n.rate >= n.burst {
+			delete(n.clients, clientID)
+		}
+	}
+}
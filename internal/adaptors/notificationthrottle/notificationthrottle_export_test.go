@@ -0,0 +1,9 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package notificationthrottle
+
+import "time"
+
+func (n *NotificationThrottle) SetNow(now func() time.Time) {
+	n.now = now
+}
@@ -0,0 +1,95 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package notificationthrottle_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/notificationthrottle"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/notificationthrottle"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func newNotificationThrottle(t *testing.T, rate float64) (*notificationthrottle.NotificationThrottle, *fakeClock) {
+	t.Helper()
+
+	mockConfig := &mocks.MockConfig{}
+	t.Cleanup(func() { mockConfig.AssertExpectations(t) })
+
+	mockConfig.EXPECT().
+		StreamNotificationRate().
+		Return(rate).
+		Once()
+
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	throttle := notificationthrottle.New(mockConfig)
+	throttle.SetNow(clock.Now)
+	return throttle, clock
+}
+
+func TestNotificationThrottle_Allow_Disabled(t *testing.T) {
+	// Arrange
+	throttle, _ := newNotificationThrottle(t, 0)
+
+	// Act & Assert
+	for range 1000 {
+		assert.True(t, throttle.Allow("client"))
+	}
+}
+
+func TestNotificationThrottle_Allow_BurstIsOneSecondOfNotifications(t *testing.T) {
+	// Arrange
+	throttle, _ := newNotificationThrottle(t, 2.5)
+
+	// Act
+	allowed := 0
+	for range 10 {
+		if throttle.Allow("client") {
+			allowed++
+		}
+	}
+
+	// Assert
+	assert.Equal(t, 3, allowed, "The burst should be the rate, rounded up")
+}
+
+func TestNotificationThrottle_Allow_TokensRefill(t *testing.T) {
+	// Arrange
+	throttle, clock := newNotificationThrottle(t, 2)
+
+	assert.True(t, throttle.Allow("client"))
+	assert.True(t, throttle.Allow("client"))
+	assert.False(t, throttle.Allow("client"))
+
+	// Act
+	clock.now = clock.now.Add(500 * time.Millisecond)
+	refilled := throttle.Allow("client")
+
+	// Assert
+	assert.True(t, refilled, "A token should be refilled after 1/rate seconds")
+	assert.False(t, throttle.Allow("client"))
+}
+
+func TestNotificationThrottle_Allow_ClientsAreLimitedSeparately(t *testing.T) {
+	// Arrange
+	throttle, _ := newNotificationThrottle(t, 1)
+
+	// Act
+	first := throttle.Allow("client-1")
+	second := throttle.Allow("client-1")
+	other := throttle.Allow("client-2")
+
+	// Assert
+	assert.True(t, first)
+	assert.False(t, second)
+	assert.True(t, other, "Another client should have its own bucket")
+}
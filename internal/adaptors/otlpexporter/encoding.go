@@ -0,0 +1,133 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package otlpexporter
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/tracing"
+)
+
+// The types below are the JSON encoding of the ExportTraceServiceRequest message of the OTLP protocol. IDs are hex
+// encoded, and 64-bit integers are strings, as the protocol asks.
+
+// statusCodeError is the STATUS_CODE_ERROR of the status of a span.
+const statusCodeError = 2
+
+type tracesRequest struct {
+	ResourceSpans []resourceSpans `json:"resourceSpans"`
+}
+
+type resourceSpans struct {
+	Resource   resource     `json:"resource"`
+	ScopeSpans []scopeSpans `json:"scopeSpans"`
+}
+
+type resource struct {
+	Attributes []attribute `json:"attributes"`
+}
+
+type scopeSpans struct {
+	Scope scope  `json:"scope"`
+	Spans []span `json:"spans"`
+}
+
+type scope struct {
+	Name string `json:"name"`
+}
+
+type span struct {
+	TraceID           string      `json:"traceId"`
+	SpanID            string      `json:"spanId"`
+	ParentSpanID      string      `json:"parentSpanId,omitempty"`
+	Name              string      `json:"name"`
+	Kind              int         `json:"kind"`
+	StartTimeUnixNano string      `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string      `json:"endTimeUnixNano"`
+	Attributes        []attribute `json:"attributes,omitempty"`
+	Status            *status     `json:"status,omitempty"`
+}
+
+type status struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type attribute struct {
+	Key   string         `json:"key"`
+	Value attributeValue `json:"value"`
+}
+
+type attributeValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+}
+
+func newTracesRequest(resourceAttributes []attribute, batch []*tracing.Span) tracesRequest {
+	spans := make([]span, 0, len(batch))
+	for _, tracedSpan := range batch {
+		spans = append(spans, newSpan(tracedSpan))
+	}
+
+	return tracesRequest{
+		ResourceSpans: []resourceSpans{{
+			Resource: resource{Attributes: resourceAttributes},
+			ScopeSpans: []scopeSpans{{
+				Scope: scope{Name: scopeName},
+				Spans: spans,
+			}},
+		}},
+	}
+}
+
+func newSpan(tracedSpan *tracing.Span) span {
+	encoded := span{
+		TraceID:           tracedSpan.TraceID.String(),
+		SpanID:            tracedSpan.SpanID.String(),
+		Name:              tracedSpan.Name,
+		Kind:              int(tracedSpan.Kind),
+		StartTimeUnixNano: unixNano(tracedSpan.StartTime),
+		EndTimeUnixNano:   unixNano(tracedSpan.EndTime),
+	}
+
+	if tracedSpan.ParentSpanID.IsValid() {
+		encoded.ParentSpanID = tracedSpan.ParentSpanID.String()
+	}
+
+	for _, tracedAttribute := range tracedSpan.Attributes {
+		encoded.Attributes = append(encoded.Attributes, newAttribute(tracedAttribute))
+	}
+
+	if tracedSpan.Failed {
+		encoded.Status = &status{Code: statusCodeError, Message: tracedSpan.StatusMessage}
+	}
+
+	return encoded
+}
+
+func newAttribute(tracedAttribute tracing.Attribute) attribute {
+	var value attributeValue
+	switch typedValue := tracedAttribute.Value.(type) {
+	case int64:
+		intValue := strconv.FormatInt(typedValue, 10)
+		value.IntValue = &intValue
+	case float64:
+		value.DoubleValue = &typedValue
+	case bool:
+		value.BoolValue = &typedValue
+	case string:
+		value.StringValue = &typedValue
+	default:
+		stringValue := ""
+		value.StringValue = &stringValue
+	}
+
+	return attribute{Key: tracedAttribute.Key, Value: value}
+}
+
+func unixNano(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
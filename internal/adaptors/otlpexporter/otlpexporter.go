@@ -0,0 +1,381 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package otlpexporter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/tracing"
+)
+
+const (
+	defaultServiceName = "matlab-mcp-core-server"
+	defaultTimeout     = 10 * time.Second
+
+	// scopeName is the instrumentation scope of the spans, the module of the server.
+	scopeName = "github.com/matlab/matlab-mcp-core-server"
+
+	// protocolHTTPJSON is the only protocol of the exporter, OTLP over HTTP with JSON encoding.
+	protocolHTTPJSON = "http/json"
+
+	// exportInterval, maxBatchSize and maxQueueSize are the defaults of the batch span processor of OpenTelemetry.
+	exportInterval = 5 * time.Second
+	maxBatchSize   = 512
+	maxQueueSize   = 2048
+)
+
+type Config interface {
+	Version() string
+}
+
+type OSLayer interface {
+	Getenv(key string) string
+}
+
+type LoggerFactory interface {
+	GetGlobalLogger() entities.Logger
+}
+
+type LifecycleSignaler interface {
+	AddShutdownFunction(shutdownFcn func() error)
+}
+
+type HTTPClientFactory interface {
+	NewClient(timeout time.Duration) *http.Client
+}
+
+// Exporter sends spans to an OpenTelemetry collector with the OTLP/HTTP protocol, using its JSON encoding.
+// It is configured with the environment variables of the OpenTelemetry SDKs, and only sends spans once an OTLP
+// endpoint is set. Spans are sent in batches, in the background, so that tool calls never wait for the collector.
+type Exporter struct {
+	logger     entities.Logger
+	httpClient *http.Client
+	endpoint   string
+	headers    map[string]string
+	resource   []attribute
+
+	lock    *sync.Mutex
+	queue   []*tracing.Span
+	dropped int
+	wake    chan struct{}
+	stop    chan struct{}
+	stopped chan struct{}
+}
+
+func New(
+	config Config,
+	osLayer OSLayer,
+	loggerFactory LoggerFactory,
+	lifecycleSignaler LifecycleSignaler,
+	httpClientFactory HTTPClientFactory,
+) (*Exporter, error) {
+	exporter := &Exporter{
+		logger: loggerFactory.GetGlobalLogger().With("component", "tracing"),
+		lock:   new(sync.Mutex),
+	}
+
+	settings, err := readSettings(osLayer)
+	if err != nil {
+		return nil, err
+	}
+	if settings.endpoint == "" {
+		return exporter, nil
+	}
+
+	if settings.protocol != protocolHTTPJSON {
+		exporter.logger.With("protocol", settings.protocol).Warn("Only the http/json OTLP protocol is supported: sending spans with it")
+	}
+
+	exporter.httpClient = httpClientFactory.NewClient(settings.timeout)
+	exporter.endpoint = settings.endpoint
+	exporter.headers = settings.headers
+	exporter.resource = resourceAttributes(settings, config.Version())
+	exporter.wake = make(chan struct{}, 1)
+	exporter.stop = make(chan struct{})
+	exporter.stopped = make(chan struct{})
+
+	go exporter.run()
+
+	lifecycleSignaler.AddShutdownFunction(func() error {
+		close(exporter.stop)
+		<-exporter.stopped
+		return nil
+	})
+
+	exporter.logger.With("endpoint", settings.endpoint).Info("Sending OpenTelemetry traces")
+
+	return exporter, nil
+}
+
+// Enabled is true when spans are sent to a collector.
+func (e *Exporter) Enabled() bool {
+	return e.endpoint != ""
+}
+
+// ExportSpan queues a span that ended, to send it with the next batch. When the collector is too slow to keep up, the
+// oldest spans are dropped, rather than holding on to memory.
+func (e *Exporter) ExportSpan(span *tracing.Span) {
+	if !e.Enabled() {
+		return
+	}
+
+	e.lock.Lock()
+	if len(e.queue) >= maxQueueSize {
+		e.queue = e.queue[1:]
+		e.dropped++
+	}
+	e.queue = append(e.queue, span)
+	full := len(e.queue) >= maxBatchSize
+	e.lock.Unlock()
+
+	if full {
+		select {
+		case e.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (e *Exporter) run() {
+	defer close(e.stopped)
+
+	ticker := time.NewTicker(exportInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-e.wake:
+		case <-e.stop:
+			e.flush()
+			return
+		}
+		e.flush()
+	}
+}
+
+// flush sends the queued spans, in batches of at most maxBatchSize spans. Spans that fail to be sent are dropped, as
+// the OTLP exporters of OpenTelemetry do.
+func (e *Exporter) flush() {
+	for {
+		e.lock.Lock()
+		batch := e.queue[:min(len(e.queue), maxBatchSize)]
+		e.queue = e.queue[len(batch):]
+		dropped := e.dropped
+		e.dropped = 0
+		e.lock.Unlock()
+
+		if dropped > 0 {
+			e.logger.With("spans", dropped).Warn("Dropped spans, as the OpenTelemetry collector does not keep up")
+		}
+		if len(batch) == 0 {
+			return
+		}
+
+		if err := e.send(batch); err != nil {
+			e.logger.WithError(err).With("spans", len(batch)).Warn("Failed to send spans to the OpenTelemetry collector")
+		}
+	}
+}
+
+func (e *Exporter) send(batch []*tracing.Span) error {
+	data, err := json.Marshal(newTracesRequest(e.resource, batch))
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodPost, e.endpoint, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	for name, value := range e.headers {
+		request.Header.Set(name, value)
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := e.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close() //nolint:errcheck // Nothing is read from the response
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code: %d", response.StatusCode)
+	}
+
+	return nil
+}
+
+type settings struct {
+	endpoint           string
+	protocol           string
+	headers            map[string]string
+	timeout            time.Duration
+	serviceName        string
+	resourceAttributes []attribute
+}
+
+// readSettings reads the OTEL_* environment variables of the OpenTelemetry SDKs. Traces are exported when an OTLP
+// endpoint is set, unless OTEL_SDK_DISABLED or OTEL_TRACES_EXPORTER turn them off.
+func readSettings(osLayer OSLayer) (settings, error) {
+	if strings.EqualFold(strings.TrimSpace(osLayer.Getenv("OTEL_SDK_DISABLED")), "true") {
+		return settings{}, nil
+	}
+
+	switch exporters := strings.TrimSpace(osLayer.Getenv("OTEL_TRACES_EXPORTER")); exporters {
+	case "", "otlp":
+	case "none":
+		return settings{}, nil
+	default:
+		return settings{}, fmt.Errorf("invalid OTEL_TRACES_EXPORTER: %s, only otlp and none are supported", exporters)
+	}
+
+	endpoint, err := tracesEndpoint(osLayer)
+	if err != nil || endpoint == "" {
+		return settings{}, err
+	}
+
+	headers, err := parseHeaders(osLayer.Getenv("OTEL_EXPORTER_OTLP_HEADERS"))
+	if err != nil {
+		return settings{}, fmt.Errorf("invalid OTEL_EXPORTER_OTLP_HEADERS: %w", err)
+	}
+	tracesHeaders, err := parseHeaders(osLayer.Getenv("OTEL_EXPORTER_OTLP_TRACES_HEADERS"))
+	if err != nil {
+		return settings{}, fmt.Errorf("invalid OTEL_EXPORTER_OTLP_TRACES_HEADERS: %w", err)
+	}
+	for name, value := range tracesHeaders {
+		headers[name] = value
+	}
+
+	timeout, err := parseTimeout(osLayer, "OTEL_EXPORTER_OTLP_TRACES_TIMEOUT", "OTEL_EXPORTER_OTLP_TIMEOUT")
+	if err != nil {
+		return settings{}, err
+	}
+
+	resourceAttributes, err := parseResourceAttributes(osLayer.Getenv("OTEL_RESOURCE_ATTRIBUTES"))
+	if err != nil {
+		return settings{}, fmt.Errorf("invalid OTEL_RESOURCE_ATTRIBUTES: %w", err)
+	}
+
+	return settings{
+		endpoint:           endpoint,
+		protocol:           firstSet(osLayer, "OTEL_EXPORTER_OTLP_TRACES_PROTOCOL", "OTEL_EXPORTER_OTLP_PROTOCOL", protocolHTTPJSON),
+		headers:            headers,
+		timeout:            timeout,
+		serviceName:        strings.TrimSpace(osLayer.Getenv("OTEL_SERVICE_NAME")),
+		resourceAttributes: resourceAttributes,
+	}, nil
+}
+
+// tracesEndpoint is OTEL_EXPORTER_OTLP_TRACES_ENDPOINT as is, or the /v1/traces path of OTEL_EXPORTER_OTLP_ENDPOINT.
+func tracesEndpoint(osLayer OSLayer) (string, error) {
+	variable, endpoint := "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", strings.TrimSpace(osLayer.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"))
+	if endpoint == "" {
+		variable, endpoint = "OTEL_EXPORTER_OTLP_ENDPOINT", strings.TrimSpace(osLayer.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"))
+		if endpoint == "" {
+			return "", nil
+		}
+		endpoint = strings.TrimSuffix(endpoint, "/") + "/v1/traces"
+	}
+
+	parsedURL, err := url.Parse(endpoint)
+	if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Host == "" {
+		return "", fmt.Errorf("invalid %s: %s is not an http or https URL", variable, endpoint)
+	}
+	return endpoint, nil
+}
+
+func parseTimeout(osLayer OSLayer, variables ...string) (time.Duration, error) {
+	for _, variable := range variables {
+		value := strings.TrimSpace(osLayer.Getenv(variable))
+		if value == "" {
+			continue
+		}
+		milliseconds, err := strconv.Atoi(value)
+		if err != nil || milliseconds <= 0 {
+			return 0, fmt.Errorf("invalid %s: %s is not a positive number of milliseconds", variable, value)
+		}
+		return time.Duration(milliseconds) * time.Millisecond, nil
+	}
+	return defaultTimeout, nil
+}
+
+func firstSet(osLayer OSLayer, variable string, fallbackVariable string, defaultValue string) string {
+	for _, name := range []string{variable, fallbackVariable} {
+		if value := strings.TrimSpace(osLayer.Getenv(name)); value != "" {
+			return value
+		}
+	}
+	return defaultValue
+}
+
+// parseHeaders parses a list of headers, as name=value pairs separated by commas, with URL-encoded values.
+func parseHeaders(value string) (map[string]string, error) {
+	headers := map[string]string{}
+	pairs, err := parsePairs(value)
+	for _, pair := range pairs {
+		headers[pair[0]] = pair[1]
+	}
+	return headers, err
+}
+
+func parseResourceAttributes(value string) ([]attribute, error) {
+	pairs, err := parsePairs(value)
+	attributes := make([]attribute, 0, len(pairs))
+	for _, pair := range pairs {
+		attributes = append(attributes, newAttribute(tracing.String(pair[0], pair[1])))
+	}
+	return attributes, err
+}
+
+func parsePairs(value string) ([][2]string, error) {
+	var pairs [][2]string
+	for _, item := range strings.Split(value, ",") {
+		if strings.TrimSpace(item) == "" {
+			continue
+		}
+		key, rawValue, found := strings.Cut(item, "=")
+		key = strings.TrimSpace(key)
+		if !found || key == "" {
+			return nil, fmt.Errorf("%q is not a key=value pair", strings.TrimSpace(item))
+		}
+		decoded, err := url.QueryUnescape(strings.TrimSpace(rawValue))
+		if err != nil {
+			return nil, fmt.Errorf("the value of %s is not URL-encoded: %w", key, err)
+		}
+		pairs = append(pairs, [2]string{key, decoded})
+	}
+	return pairs, nil
+}
+
+// resourceAttributes describe the server that sent the spans. OTEL_SERVICE_NAME takes precedence over the service.name
+// of OTEL_RESOURCE_ATTRIBUTES.
+func resourceAttributes(settings settings, version string) []attribute {
+	serviceName := defaultServiceName
+	var attributes []attribute
+	for _, resourceAttribute := range settings.resourceAttributes {
+		if resourceAttribute.Key == "service.name" {
+			serviceName = *resourceAttribute.Value.StringValue
+			continue
+		}
+		attributes = append(attributes, resourceAttribute)
+	}
+	if settings.serviceName != "" {
+		serviceName = settings.serviceName
+	}
+
+	return append([]attribute{
+		newAttribute(tracing.String("service.name", serviceName)),
+		newAttribute(tracing.String("service.version", version)),
+	}, attributes...)
+}
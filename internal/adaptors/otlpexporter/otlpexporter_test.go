@@ -0,0 +1,297 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package otlpexporter_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/otlpexporter"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/tracing"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/otlpexporter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newOSLayer returns an OS layer whose environment holds the variables of environment.
+func newOSLayer(t *testing.T, environment map[string]string) *mocks.MockOSLayer {
+	t.Helper()
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	t.Cleanup(func() { mockOSLayer.AssertExpectations(t) })
+
+	mockOSLayer.EXPECT().
+		Getenv(mock.Anything).
+		RunAndReturn(func(key string) string {
+			return environment[key]
+		})
+
+	return mockOSLayer
+}
+
+func newLoggerFactory(t *testing.T) *mocks.MockLoggerFactory {
+	t.Helper()
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	t.Cleanup(func() { mockLoggerFactory.AssertExpectations(t) })
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	return mockLoggerFactory
+}
+
+type receivedRequest struct {
+	path          string
+	contentType   string
+	authorization string
+	body          map[string]any
+}
+
+// newCollector returns the URL of a collector, and the requests it received.
+func newCollector(t *testing.T) (string, chan receivedRequest) {
+	t.Helper()
+
+	requests := make(chan receivedRequest, 10)
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		var body map[string]any
+		assert.NoError(t, json.Unmarshal(data, &body))
+
+		requests <- receivedRequest{
+			path:          r.URL.Path,
+			contentType:   r.Header.Get("Content-Type"),
+			authorization: r.Header.Get("Authorization"),
+			body:          body,
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(collector.Close)
+
+	return collector.URL, requests
+}
+
+func TestNew_Disabled(t *testing.T) {
+	testConfigs := []struct {
+		name        string
+		environment map[string]string
+	}{
+		{name: "no endpoint", environment: map[string]string{}},
+		{name: "SDK disabled", environment: map[string]string{"OTEL_EXPORTER_OTLP_ENDPOINT": "http://localhost:4318", "OTEL_SDK_DISABLED": "true"}},
+		{name: "no traces exporter", environment: map[string]string{"OTEL_EXPORTER_OTLP_ENDPOINT": "http://localhost:4318", "OTEL_TRACES_EXPORTER": "none"}},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Act
+			exporter, err := otlpexporter.New(&mocks.MockConfig{}, newOSLayer(t, testConfig.environment), newLoggerFactory(t), &mocks.MockLifecycleSignaler{}, &mocks.MockHTTPClientFactory{})
+
+			// Assert
+			require.NoError(t, err)
+			assert.False(t, exporter.Enabled())
+		})
+	}
+}
+
+func TestNew_InvalidEnvironment(t *testing.T) {
+	testConfigs := []struct {
+		name          string
+		environment   map[string]string
+		expectedError string
+	}{
+		{
+			name:          "unsupported exporter",
+			environment:   map[string]string{"OTEL_EXPORTER_OTLP_ENDPOINT": "http://localhost:4318", "OTEL_TRACES_EXPORTER": "zipkin"},
+			expectedError: "invalid OTEL_TRACES_EXPORTER",
+		},
+		{
+			name:          "endpoint without scheme",
+			environment:   map[string]string{"OTEL_EXPORTER_OTLP_ENDPOINT": "localhost:4318"},
+			expectedError: "invalid OTEL_EXPORTER_OTLP_ENDPOINT",
+		},
+		{
+			name:          "grpc traces endpoint",
+			environment:   map[string]string{"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT": "grpc://localhost:4317"},
+			expectedError: "invalid OTEL_EXPORTER_OTLP_TRACES_ENDPOINT",
+		},
+		{
+			name:          "header without value",
+			environment:   map[string]string{"OTEL_EXPORTER_OTLP_ENDPOINT": "http://localhost:4318", "OTEL_EXPORTER_OTLP_HEADERS": "api-key"},
+			expectedError: "invalid OTEL_EXPORTER_OTLP_HEADERS",
+		},
+		{
+			name:          "timeout in seconds",
+			environment:   map[string]string{"OTEL_EXPORTER_OTLP_ENDPOINT": "http://localhost:4318", "OTEL_EXPORTER_OTLP_TIMEOUT": "10s"},
+			expectedError: "invalid OTEL_EXPORTER_OTLP_TIMEOUT",
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Act
+			exporter, err := otlpexporter.New(&mocks.MockConfig{}, newOSLayer(t, testConfig.environment), newLoggerFactory(t), &mocks.MockLifecycleSignaler{}, &mocks.MockHTTPClientFactory{})
+
+			// Assert
+			require.ErrorContains(t, err, testConfig.expectedError)
+			assert.Nil(t, exporter)
+		})
+	}
+}
+
+func TestExporter_ExportSpan_SendsSpansOnShutdown(t *testing.T) {
+	// Arrange
+	collectorURL, requests := newCollector(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockHTTPClientFactory := &mocks.MockHTTPClientFactory{}
+	defer mockHTTPClientFactory.AssertExpectations(t)
+
+	mockOSLayer := newOSLayer(t, map[string]string{
+		"OTEL_EXPORTER_OTLP_ENDPOINT": collectorURL + "/",
+		"OTEL_EXPORTER_OTLP_HEADERS":  "Authorization=Bearer%20secret",
+		"OTEL_EXPORTER_OTLP_TIMEOUT":  "2000",
+		"OTEL_SERVICE_NAME":           "matlab-mcp-lab",
+		"OTEL_RESOURCE_ATTRIBUTES":    "service.name=ignored,deployment.environment=lab",
+	})
+
+	mockConfig.EXPECT().
+		Version().
+		Return("v0.4.0").
+		Once()
+
+	mockHTTPClientFactory.EXPECT().
+		NewClient(2 * time.Second).
+		Return(&http.Client{Timeout: 2 * time.Second}).
+		Once()
+
+	var shutdown func() error
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.Anything).
+		Run(func(shutdownFcn func() error) {
+			shutdown = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	exporter, err := otlpexporter.New(mockConfig, mockOSLayer, newLoggerFactory(t), mockLifecycleSignaler, mockHTTPClientFactory)
+	require.NoError(t, err)
+	require.True(t, exporter.Enabled())
+
+	tracer := tracing.NewTracer(exporter)
+	ctx, root := tracer.Start(t.Context(), "tools/call evaluate_matlab_code", tracing.SpanKindInternal, tracing.String("gen_ai.tool.name", "evaluate_matlab_code"))
+	_, child := tracing.Start(ctx, "matlab eval", tracing.SpanKindClient, tracing.Int("matlab.output.bytes", 12), tracing.Bool("matlab.cancelled", false))
+	child.SetError("Undefined function 'foo'")
+
+	// Act
+	child.End()
+	root.End()
+	require.NoError(t, shutdown())
+
+	// Assert
+	var request receivedRequest
+	select {
+	case request = <-requests:
+	default:
+		require.FailNow(t, "the collector received no spans")
+	}
+
+	assert.Equal(t, "/v1/traces", request.path)
+	assert.Equal(t, "application/json", request.contentType)
+	assert.Equal(t, "Bearer secret", request.authorization)
+
+	expectedBody := `{"resourceSpans":[{
+		"resource":{"attributes":[
+			{"key":"service.name","value":{"stringValue":"matlab-mcp-lab"}},
+			{"key":"service.version","value":{"stringValue":"v0.4.0"}},
+			{"key":"deployment.environment","value":{"stringValue":"lab"}}
+		]},
+		"scopeSpans":[{"scope":{"name":"github.com/matlab/matlab-mcp-core-server"},"spans":[
+			{"traceId":"` + child.TraceID.String() + `","spanId":"` + child.SpanID.String() + `","parentSpanId":"` + root.SpanID.String() + `",
+			 "name":"matlab eval","kind":3,"startTimeUnixNano":"` + unixNano(child.StartTime) + `","endTimeUnixNano":"` + unixNano(child.EndTime) + `",
+			 "attributes":[{"key":"matlab.output.bytes","value":{"intValue":"12"}},{"key":"matlab.cancelled","value":{"boolValue":false}}],
+			 "status":{"code":2,"message":"Undefined function 'foo'"}},
+			{"traceId":"` + root.TraceID.String() + `","spanId":"` + root.SpanID.String() + `",
+			 "name":"tools/call evaluate_matlab_code","kind":1,"startTimeUnixNano":"` + unixNano(root.StartTime) + `","endTimeUnixNano":"` + unixNano(root.EndTime) + `",
+			 "attributes":[{"key":"gen_ai.tool.name","value":{"stringValue":"evaluate_matlab_code"}}]}
+		]}]
+	}]}`
+	body, err := json.Marshal(request.body)
+	require.NoError(t, err)
+	assert.JSONEq(t, expectedBody, string(body))
+}
+
+func TestExporter_ExportSpan_TracesEndpoint(t *testing.T) {
+	// Arrange
+	collectorURL, requests := newCollector(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockHTTPClientFactory := &mocks.MockHTTPClientFactory{}
+	defer mockHTTPClientFactory.AssertExpectations(t)
+
+	mockOSLayer := newOSLayer(t, map[string]string{
+		"OTEL_EXPORTER_OTLP_ENDPOINT":        "http://ignored.example.com",
+		"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT": collectorURL + "/custom/traces",
+		"OTEL_EXPORTER_OTLP_PROTOCOL":        "http/protobuf",
+	})
+
+	mockConfig.EXPECT().
+		Version().
+		Return("v0.4.0").
+		Once()
+
+	mockHTTPClientFactory.EXPECT().
+		NewClient(10 * time.Second).
+		Return(&http.Client{}).
+		Once()
+
+	var shutdown func() error
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.Anything).
+		Run(func(shutdownFcn func() error) {
+			shutdown = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	exporter, err := otlpexporter.New(mockConfig, mockOSLayer, newLoggerFactory(t), mockLifecycleSignaler, mockHTTPClientFactory)
+	require.NoError(t, err)
+
+	_, span := tracing.NewTracer(exporter).Start(t.Context(), "matlab start_session", tracing.SpanKindInternal)
+
+	// Act
+	span.End()
+	require.NoError(t, shutdown())
+
+	// Assert
+	select {
+	case request := <-requests:
+		assert.Equal(t, "/custom/traces", request.path)
+	default:
+		require.FailNow(t, "the collector received no spans")
+	}
+}
+
+func unixNano(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
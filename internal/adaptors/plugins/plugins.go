@@ -0,0 +1,155 @@
+// Copyright 2025 The MathWorks, Inc.
+
+// Package plugins provides the tools of plugins, which are executables outside of the server.
+//
+// A plugin is run with the describe argument to list its tools, as JSON on its standard output. Each call to one of
+// its tools runs it again with the call argument and the name of the tool, the arguments of the call as JSON on its
+// standard input, and its standard output as the result of the call. Plugins can be written in any language, and
+// their tools are called through the same middlewares as the tools of the server, such as the tool policy.
+package plugins
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+)
+
+const (
+	describeArgument = "describe"
+	callArgument     = "call"
+
+	describeTimeout = 10 * time.Second
+)
+
+// toolNamePattern are the names a plugin tool can have, so that they are valid MCP tool names.
+var toolNamePattern = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
+type Config interface {
+	Plugins() []string
+}
+
+// Description is what a plugin writes on its standard output when run with the describe argument.
+type Description struct {
+	Tools []ToolDescription `json:"tools"`
+}
+
+type ToolDescription struct {
+	Name        string             `json:"name"`
+	Title       string             `json:"title"`
+	Description string             `json:"description"`
+	InputSchema *jsonschema.Schema `json:"inputSchema"`
+	ReadOnly    bool               `json:"readOnly"`
+}
+
+// Registry loads the tools of the plugins set in the configuration, once, the first time they are needed.
+type Registry struct {
+	config        Config
+	loggerFactory basetool.LoggerFactory
+
+	once  *sync.Once
+	tools []tools.ProvidedTool
+}
+
+func New(
+	config Config,
+	loggerFactory basetool.LoggerFactory,
+) *Registry {
+	return &Registry{
+		config:        config,
+		loggerFactory: loggerFactory,
+
+		once: new(sync.Once),
+	}
+}
+
+// Tools returns the tools of all the plugins. A plugin that fails to describe its tools is logged and skipped, so that
+// a broken plugin does not prevent the server from starting, as do tools with an invalid description, and tools with
+// the name of a tool of a previous plugin.
+func (r *Registry) Tools() []tools.ProvidedTool {
+	r.once.Do(func() {
+		logger := r.loggerFactory.GetGlobalLogger()
+		names := map[string]string{}
+
+		for _, path := range r.config.Plugins() {
+			pluginLogger := logger.With("plugin", path)
+
+			description, err := describe(path)
+			if err != nil {
+				pluginLogger.WithError(err).Warn("Failed to load plugin")
+				continue
+			}
+
+			for _, toolDescription := range description.Tools {
+				toolLogger := pluginLogger.With("tool-name", toolDescription.Name)
+
+				if err := validate(toolDescription); err != nil {
+					toolLogger.WithError(err).Warn("Ignoring invalid plugin tool")
+					continue
+				}
+
+				if otherPath, found := names[toolDescription.Name]; found {
+					toolLogger.With("other-plugin", otherPath).Warn("Ignoring plugin tool with the name of a tool of another plugin")
+					continue
+				}
+				names[toolDescription.Name] = path
+
+				r.tools = append(r.tools, newTool(r.loggerFactory, path, toolDescription))
+			}
+
+			pluginLogger.With("tools", len(description.Tools)).Info("Loaded plugin")
+		}
+	})
+
+	return r.tools
+}
+
+func describe(path string) (Description, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), describeTimeout)
+	defer cancel()
+
+	output, err := exec.CommandContext(ctx, path, describeArgument).Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+			return Description{}, fmt.Errorf("%w: %s", err, strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return Description{}, err
+	}
+
+	var description Description
+	if err := json.Unmarshal(output, &description); err != nil {
+		return Description{}, fmt.Errorf("invalid description: %w", err)
+	}
+
+	return description, nil
+}
+
+func validate(description ToolDescription) error {
+	if !toolNamePattern.MatchString(description.Name) {
+		return fmt.Errorf("invalid name %q", description.Name)
+	}
+
+	if description.InputSchema == nil {
+		return nil
+	}
+
+	if description.InputSchema.Type != "object" {
+		return errors.New(`the input schema must have type "object"`)
+	}
+
+	if _, err := description.InputSchema.Resolve(nil); err != nil {
+		return fmt.Errorf("invalid input schema: %w", err)
+	}
+
+	return nil
+}
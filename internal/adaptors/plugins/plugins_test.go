@@ -0,0 +1,131 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package plugins_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/plugins"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	basetoolmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/plugins"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const ticketsPlugin = `#!/bin/sh
+if [ "$1" = "describe" ]; then
+  cat <<'EOF'
+{"tools": [
+  {"name": "lookup_ticket", "title": "Look Up Ticket", "description": "Reads a ticket.", "readOnly": true,
+   "inputSchema": {"type": "object", "properties": {"id": {"type": "string"}}, "required": ["id"]}},
+  {"name": "close_ticket", "description": "Closes a ticket."}
+]}
+EOF
+fi
+`
+
+func TestRegistry_Tools_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &basetoolmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	pluginPath := writePlugin(t, "tickets", ticketsPlugin)
+
+	mockConfig.EXPECT().
+		Plugins().
+		Return([]string{pluginPath}).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger())
+
+	registry := plugins.New(mockConfig, mockLoggerFactory)
+
+	// Act
+	pluginTools := registry.Tools()
+	pluginToolsAgain := registry.Tools()
+
+	// Assert
+	require.Len(t, pluginTools, 2)
+	assert.Equal(t, pluginTools, pluginToolsAgain, "Plugins should only be loaded once")
+
+	lookupTool, ok := pluginTools[0].(*plugins.Tool)
+	require.True(t, ok)
+	assert.Equal(t, "lookup_ticket", lookupTool.Name())
+	assert.Equal(t, "Look Up Ticket", lookupTool.Title())
+	assert.Equal(t, "Reads a ticket.", lookupTool.Description())
+	assert.True(t, lookupTool.ReadOnly())
+
+	closeTool, ok := pluginTools[1].(*plugins.Tool)
+	require.True(t, ok)
+	assert.Equal(t, "close_ticket", closeTool.Name())
+	assert.Equal(t, "close_ticket", closeTool.Title(), "The title should default to the name")
+	assert.False(t, closeTool.ReadOnly())
+}
+
+func TestRegistry_Tools_SkipsInvalidPluginsAndTools(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &basetoolmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	failingPluginPath := writePlugin(t, "failing", "#!/bin/sh\necho 'no credentials' >&2\nexit 1\n")
+	garbagePluginPath := writePlugin(t, "garbage", "#!/bin/sh\necho 'not json'\n")
+	invalidToolsPluginPath := writePlugin(t, "invalid", `#!/bin/sh
+cat <<'EOF'
+{"tools": [
+  {"name": "bad name"},
+  {"name": "array_input", "inputSchema": {"type": "array"}},
+  {"name": "lookup_ticket"},
+  {"name": "valid_tool"}
+]}
+EOF
+`)
+	ticketsPluginPath := writePlugin(t, "tickets", ticketsPlugin)
+	missingPluginPath := filepath.Join(t.TempDir(), "missing")
+
+	mockConfig.EXPECT().
+		Plugins().
+		Return([]string{failingPluginPath, garbagePluginPath, missingPluginPath, ticketsPluginPath, invalidToolsPluginPath}).
+		Once()
+
+	logger := testutils.NewInspectableLogger()
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(logger)
+
+	registry := plugins.New(mockConfig, mockLoggerFactory)
+
+	// Act
+	pluginTools := registry.Tools()
+
+	// Assert
+	var names []string
+	for _, tool := range pluginTools {
+		names = append(names, tool.Name())
+	}
+	assert.Equal(t, []string{"lookup_ticket", "close_ticket", "valid_tool"}, names)
+
+	warnLogs := logger.WarnLogs()
+	assert.Contains(t, warnLogs, "Failed to load plugin")
+	assert.Contains(t, warnLogs, "Ignoring invalid plugin tool")
+	assert.Equal(t, invalidToolsPluginPath, warnLogs["Ignoring plugin tool with the name of a tool of another plugin"]["plugin"])
+	assert.Equal(t, ticketsPluginPath, warnLogs["Ignoring plugin tool with the name of a tool of another plugin"]["other-plugin"])
+}
+
+func writePlugin(t *testing.T, name string, script string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(script), 0o755)) //nolint:gosec // The plugin must be executable
+	return path
+}
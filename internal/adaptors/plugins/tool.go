@@ -0,0 +1,138 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package plugins
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/clientidentity"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/correlationid"
+)
+
+const (
+	// invalidInputExitCode is the exit code of a plugin rejecting the arguments of a call.
+	invalidInputExitCode = 2
+
+	// waitDelay is how long a call waits for the output of a plugin once it exited or was cancelled, in case it
+	// started processes that keep its output open.
+	waitDelay = time.Second
+
+	correlationIDEnvironmentVariable = "MATLAB_MCP_CORRELATION_ID"
+	userEnvironmentVariable          = "MATLAB_MCP_USER"
+	clientEnvironmentVariable        = "MATLAB_MCP_CLIENT"
+)
+
+// Tool is a tool of a plugin. Its calls are handled like those of the tools of the server, so they are logged, and
+// their failures reported, the same way.
+type Tool struct {
+	basetool.ToolWithUnstructuredContentOutput[map[string]any]
+	readOnly bool
+}
+
+func newTool(loggerFactory basetool.LoggerFactory, path string, description ToolDescription) *Tool {
+	inputSchema := description.InputSchema
+	if inputSchema == nil {
+		inputSchema = &jsonschema.Schema{Type: "object"}
+	}
+
+	title := description.Title
+	if title == "" {
+		title = description.Name
+	}
+
+	return &Tool{
+		ToolWithUnstructuredContentOutput: basetool.NewToolWithUnstructuredContent(description.Name, title, description.Description, loggerFactory, Handler(path, description.Name)).
+			WithInputSchema(inputSchema),
+		readOnly: description.ReadOnly,
+	}
+}
+
+func (t *Tool) ReadOnly() bool {
+	return t.readOnly
+}
+
+// Handler runs the plugin at path to call its tool. The correlation ID of the call, and the identity of the client
+// when known, are set in the environment of the plugin, so that it can log them.
+func Handler(path string, toolName string) basetool.HandlerWithUnstructuredContentOutput[map[string]any] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs map[string]any) (tools.RichContent, error) {
+		sessionLogger = sessionLogger.With("plugin", path)
+		sessionLogger.Info("Executing plugin tool")
+		defer sessionLogger.Info("Done - Executing plugin tool")
+
+		if inputs == nil {
+			inputs = map[string]any{}
+		}
+
+		arguments, err := json.Marshal(inputs)
+		if err != nil {
+			return tools.RichContent{}, err
+		}
+
+		var stdout, stderr bytes.Buffer
+		cmd := exec.CommandContext(ctx, path, callArgument, toolName)
+		cmd.Stdin = bytes.NewReader(arguments)
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+		cmd.Env = environment(ctx)
+		cmd.WaitDelay = waitDelay
+
+		err = cmd.Run()
+		if ctx.Err() != nil {
+			return tools.RichContent{}, ctx.Err()
+		}
+		if err != nil {
+			return tools.RichContent{}, callFailed(err, stderr.String())
+		}
+
+		if stderr.Len() > 0 {
+			sessionLogger.With("stderr", strings.TrimSpace(stderr.String())).Debug("Plugin wrote to its standard error")
+		}
+
+		output := strings.TrimRight(stdout.String(), "\n")
+		if output == "" {
+			return tools.RichContent{}, nil
+		}
+
+		return tools.RichContent{
+			TextContent: []string{output},
+		}, nil
+	}
+}
+
+func environment(ctx context.Context) []string {
+	env := os.Environ()
+	if correlationID, ok := correlationid.FromContext(ctx); ok {
+		env = append(env, correlationIDEnvironmentVariable+"="+correlationID)
+	}
+	if identity, ok := clientidentity.FromContext(ctx); ok {
+		env = append(env, userEnvironmentVariable+"="+identity.User, clientEnvironmentVariable+"="+identity.Client)
+	}
+	return env
+}
+
+// callFailed returns the error of a failed plugin call, with the standard error of the plugin as its message.
+func callFailed(err error, stderr string) error {
+	message := strings.TrimSpace(stderr)
+	if message == "" {
+		message = err.Error()
+	}
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == invalidInputExitCode {
+		return entities.NewCodedError(entities.ErrorCodeInvalidInput, errors.New(message))
+	}
+
+	return entities.NewCodedError(entities.ErrorCodePluginError, fmt.Errorf("plugin failed: %s", message))
+}
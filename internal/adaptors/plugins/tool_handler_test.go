@@ -0,0 +1,120 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package plugins_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/plugins"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/clientidentity"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/correlationid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// echoPlugin writes the name of the tool, the correlation ID and user of the call, and the arguments of the call.
+const echoPlugin = `#!/bin/sh
+echo "$2 $MATLAB_MCP_CORRELATION_ID $MATLAB_MCP_USER"
+cat
+echo 'called' >&2
+`
+
+func TestHandler_HappyPath(t *testing.T) {
+	// Arrange
+	pluginPath := writePlugin(t, "echo", echoPlugin)
+	logger := testutils.NewInspectableLogger()
+
+	ctx := correlationid.NewContext(t.Context(), "test-correlation-id")
+	ctx = clientidentity.NewContext(ctx, clientidentity.Identity{User: "alice", Client: "cursor"})
+
+	handler := plugins.Handler(pluginPath, "lookup_ticket")
+
+	// Act
+	result, err := handler(ctx, logger, map[string]any{"id": "TICKET-1"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, tools.RichContent{
+		TextContent: []string{"lookup_ticket test-correlation-id alice\n{\"id\":\"TICKET-1\"}"},
+	}, result)
+	assert.Equal(t, "called", logger.DebugLogs()["Plugin wrote to its standard error"]["stderr"])
+}
+
+func TestHandler_NoArguments(t *testing.T) {
+	// Arrange
+	pluginPath := writePlugin(t, "cat", "#!/bin/sh\ncat\n")
+
+	handler := plugins.Handler(pluginPath, "list_tickets")
+
+	// Act
+	result, err := handler(t.Context(), testutils.NewInspectableLogger(), nil)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"{}"}, result.TextContent, "The plugin should receive an empty object")
+}
+
+func TestHandler_Failures(t *testing.T) {
+	testConfigs := []struct {
+		name            string
+		script          string
+		expectedCode    entities.ErrorCode
+		expectedMessage string
+	}{
+		{
+			name:            "invalid input",
+			script:          "#!/bin/sh\necho 'unknown ticket TICKET-0' >&2\nexit 2\n",
+			expectedCode:    entities.ErrorCodeInvalidInput,
+			expectedMessage: "unknown ticket TICKET-0",
+		},
+		{
+			name:            "plugin error",
+			script:          "#!/bin/sh\necho 'ticket service unavailable' >&2\nexit 1\n",
+			expectedCode:    entities.ErrorCodePluginError,
+			expectedMessage: "plugin failed: ticket service unavailable",
+		},
+		{
+			name:            "plugin error without message",
+			script:          "#!/bin/sh\nexit 3\n",
+			expectedCode:    entities.ErrorCodePluginError,
+			expectedMessage: "plugin failed: exit status 3",
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			pluginPath := writePlugin(t, "failing", testConfig.script)
+
+			handler := plugins.Handler(pluginPath, "lookup_ticket")
+
+			// Act
+			_, err := handler(t.Context(), testutils.NewInspectableLogger(), map[string]any{})
+
+			// Assert
+			require.EqualError(t, err, testConfig.expectedMessage)
+			assert.Equal(t, testConfig.expectedCode, entities.ErrorCodeOf(err))
+		})
+	}
+}
+
+func TestHandler_Cancelled(t *testing.T) {
+	// Arrange
+	pluginPath := writePlugin(t, "slow", "#!/bin/sh\nexec sleep 60\n")
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	handler := plugins.Handler(pluginPath, "lookup_ticket")
+
+	// Act
+	_, err := handler(ctx, testutils.NewInspectableLogger(), map[string]any{})
+
+	// Assert
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, entities.ErrorCodeCancelled, entities.ErrorCodeOf(err))
+}
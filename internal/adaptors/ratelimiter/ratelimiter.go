@@ -0,0 +1,125 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package ratelimiter
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// pruneThreshold is the number of tracked clients above which idle clients are forgotten.
+const pruneThreshold = 1024
+
+type Config interface {
+	RateLimit() float64
+	RateLimitBurst() int
+	MaxConcurrentCalls() int
+}
+
+type clientState struct {
+	tokens    float64
+	updatedAt time.Time
+	inFlight  int
+}
+
+// RateLimiter limits the tool calls of each client with a token bucket, refilled at the rate limit up to the burst,
+// and with a cap on the number of calls running at the same time.
+type RateLimiter struct {
+	rate          float64
+	burst         float64
+	maxConcurrent int
+	now           func() time.Time
+
+	lock    sync.Mutex
+	clients map[string]*clientState
+}
+
+func New(
+	config Config,
+) *RateLimiter {
+	rate := config.RateLimit()
+
+	burst := float64(config.RateLimitBurst())
+	if burst == 0 {
+		burst = math.Max(1, math.Ceil(rate))
+	}
+
+	return &RateLimiter{
+		rate:          rate,
+		burst:         burst,
+		maxConcurrent: config.MaxConcurrentCalls(),
+		now:           time.Now,
+		clients:       map[string]*clientState{},
+	}
+}
+
+// Acquire admits a tool call of the client, or returns an error coded entities.ErrorCodeRateLimited.
+// The returned function must be called once the admitted call is complete.
+func (r *RateLimiter) Acquire(clientID string) (func(), error) {
+	if r.rate == 0 && r.maxConcurrent == 0 {
+		return func() {}, nil
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := r.now()
+	client := r.client(clientID, now)
+
+	if r.maxConcurrent > 0 && client.inFlight >= r.maxConcurrent {
+		return nil, entities.NewCodedError(entities.ErrorCodeRateLimited, fmt.Errorf("too many concurrent tool calls: at most %d calls can run at the same time", r.maxConcurrent))
+	}
+
+	if r.rate > 0 {
+		client.tokens = math.Min(r.burst, client.tokens+now.Sub(client.updatedAt).Seconds()*r.rate)
+		client.updatedAt = now
+		if client.tokens < 1 {
+			retryAfter := time.Duration((1 - client.tokens) / r.rate * float64(time.Second)).Round(time.Millisecond)
+			return nil, entities.NewCodedError(entities.ErrorCodeRateLimited, fmt.Errorf("too many tool calls: the limit is %g calls per second, retry in %s", r.rate, retryAfter))
+		}
+		client.tokens--
+	}
+
+	client.inFlight++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			r.lock.Lock()
+			defer r.lock.Unlock()
+			client.inFlight--
+		})
+	}, nil
+}
+
+func (r *RateLimiter) client(clientID string, now time.Time) *clientState {
+	if client, ok := r.clients[clientID]; ok {
+		return client
+	}
+
+	if len(r.clients) >= pruneThreshold {
+		r.pruneIdleClients(now)
+	}
+
+	client := &clientState{
+		tokens:    r.burst,
+		updatedAt: now,
+	}
+	r.clients[clientID] = client
+	return client
+}
+
+// pruneIdleClients forgets the clients without calls in flight whose bucket is full again,
+// as they are in the same state as new clients.
+func (r *RateLimiter) pruneIdleClients(now time.Time) {
+	for clientID, client := range r.clients {
+		refilled := r.rate == 0 || client.tokens+now.Sub(client.updatedAt).Seconds()*r.rate >= r.burst
+		if client.inFlight == 0 && refilled {
+			delete(r.clients, clientID)
+		}
+	}
+}
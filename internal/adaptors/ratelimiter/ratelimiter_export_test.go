@@ -0,0 +1,9 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package ratelimiter
+
+import "time"
+
+func (r *RateLimiter) SetNow(now func() time.Time) {
+	r.now = now
+}
@@ -0,0 +1,150 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package ratelimiter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/ratelimiter"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/ratelimiter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func newRateLimiter(t *testing.T, rate float64, burst int, maxConcurrent int) (*ratelimiter.RateLimiter, *fakeClock) {
+	t.Helper()
+
+	mockConfig := &mocks.MockConfig{}
+	t.Cleanup(func() { mockConfig.AssertExpectations(t) })
+
+	mockConfig.EXPECT().
+		RateLimit().
+		Return(rate).
+		Once()
+
+	mockConfig.EXPECT().
+		RateLimitBurst().
+		Return(burst).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxConcurrentCalls().
+		Return(maxConcurrent).
+		Once()
+
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	rateLimiter := ratelimiter.New(mockConfig)
+	rateLimiter.SetNow(clock.Now)
+	return rateLimiter, clock
+}
+
+func TestRateLimiter_Acquire_Disabled(t *testing.T) {
+	// Arrange
+	rateLimiter, _ := newRateLimiter(t, 0, 0, 0)
+
+	// Act & Assert
+	for range 100 {
+		release, err := rateLimiter.Acquire("client")
+		require.NoError(t, err)
+		require.NotNil(t, release)
+	}
+}
+
+func TestRateLimiter_Acquire_RateLimitExceeded(t *testing.T) {
+	// Arrange
+	rateLimiter, _ := newRateLimiter(t, 1, 2, 0)
+
+	// Act
+	_, firstErr := rateLimiter.Acquire("client")
+	_, secondErr := rateLimiter.Acquire("client")
+	_, thirdErr := rateLimiter.Acquire("client")
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, secondErr, "Calls up to the burst should be admitted")
+	require.ErrorContains(t, thirdErr, "the limit is 1 calls per second, retry in 1s")
+	assert.Equal(t, entities.ErrorCodeRateLimited, entities.ErrorCodeOf(thirdErr))
+}
+
+func TestRateLimiter_Acquire_TokensRefill(t *testing.T) {
+	// Arrange
+	rateLimiter, clock := newRateLimiter(t, 2, 1, 0)
+
+	_, err := rateLimiter.Acquire("client")
+	require.NoError(t, err)
+	_, err = rateLimiter.Acquire("client")
+	require.Error(t, err)
+
+	clock.now = clock.now.Add(500 * time.Millisecond)
+
+	// Act
+	_, err = rateLimiter.Acquire("client")
+
+	// Assert
+	require.NoError(t, err, "A token should be refilled after 1/rate seconds")
+}
+
+func TestRateLimiter_Acquire_DefaultBurstIsRate(t *testing.T) {
+	// Arrange
+	rateLimiter, _ := newRateLimiter(t, 2.5, 0, 0)
+
+	// Act
+	admitted := 0
+	for range 10 {
+		if _, err := rateLimiter.Acquire("client"); err == nil {
+			admitted++
+		}
+	}
+
+	// Assert
+	assert.Equal(t, 3, admitted, "The burst should default to the rate, rounded up")
+}
+
+func TestRateLimiter_Acquire_ClientsAreLimitedSeparately(t *testing.T) {
+	// Arrange
+	rateLimiter, _ := newRateLimiter(t, 1, 1, 0)
+
+	_, err := rateLimiter.Acquire("client-a")
+	require.NoError(t, err)
+
+	// Act
+	_, otherClientErr := rateLimiter.Acquire("client-b")
+	_, sameClientErr := rateLimiter.Acquire("client-a")
+
+	// Assert
+	require.NoError(t, otherClientErr, "A client should not be limited by the calls of another client")
+	require.Error(t, sameClientErr)
+}
+
+func TestRateLimiter_Acquire_MaxConcurrentCalls(t *testing.T) {
+	// Arrange
+	rateLimiter, _ := newRateLimiter(t, 0, 0, 2)
+
+	releaseFirst, err := rateLimiter.Acquire("client")
+	require.NoError(t, err)
+	_, err = rateLimiter.Acquire("client")
+	require.NoError(t, err)
+
+	// Act
+	_, rejectedErr := rateLimiter.Acquire("client")
+	releaseFirst()
+	releaseFirst()
+	_, admittedErr := rateLimiter.Acquire("client")
+	_, rejectedAgainErr := rateLimiter.Acquire("client")
+
+	// Assert
+	require.ErrorContains(t, rejectedErr, "at most 2 calls can run at the same time")
+	assert.Equal(t, entities.ErrorCodeRateLimited, entities.ErrorCodeOf(rejectedErr))
+	require.NoError(t, admittedErr, "A call should be admitted once a running call is complete")
+	require.Error(t, rejectedAgainErr, "Releasing a call twice should only free one slot")
+}
@@ -0,0 +1,160 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package redactor
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"unicode"
+)
+
+// Placeholder replaces every redacted value.
+const Placeholder = "[REDACTED]"
+
+// secretGroup is the name of the regular expression group to redact, when only part of a match is secret.
+const secretGroup = "secret"
+
+const (
+	minHighEntropyTokenLength = 24
+	minHighEntropyBitsPerChar = 4.0
+	minHighEntropyDigits      = 3
+)
+
+type Config interface {
+	RedactOutput() bool
+	RedactionPatterns() []string
+}
+
+// builtInRules match common credentials and personal data, such as API keys, tokens, private keys,
+// MATLAB license numbers and email addresses.
+var builtInRules = []*regexp.Regexp{
+	regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`),
+	regexp.MustCompile(`\b(AKIA|ASIA)[0-9A-Z]{16}\b`),
+	regexp.MustCompile(`\bgh[pousr]_[A-Za-z0-9]{36,}\b`),
+	regexp.MustCompile(`\bgithub_pat_[A-Za-z0-9_]{22,}\b`),
+	regexp.MustCompile(`\bxox[abprs]-[A-Za-z0-9-]{10,}`),
+	regexp.MustCompile(`\bsk-[A-Za-z0-9_-]{20,}`),
+	regexp.MustCompile(`\bAIza[0-9A-Za-z_-]{35}\b`),
+	regexp.MustCompile(`\beyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`),
+	regexp.MustCompile(`(?i)\bbearer\s+(?P<secret>[A-Za-z0-9._~+/=-]{16,})`),
+	regexp.MustCompile(`(?i)\b(api[_-]?key|secret|password|passwd|pwd|token|access[_-]?key)\b\s*[:=]\s*["']?(?P<secret>[^\s"',;]{6,})`),
+	regexp.MustCompile(`(?i)\blicen[cs]e\s*(number|no\.?|#)\s*:?\s*(?P<secret>\d{5,})`),
+	regexp.MustCompile(`\b[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}\b`),
+}
+
+// highEntropyToken matches candidate tokens for the entropy check, such as random API keys.
+var highEntropyToken = regexp.MustCompile(`[A-Za-z0-9+/=_]{` + fmt.Sprint(minHighEntropyTokenLength) + `,}`)
+
+// Redactor removes secrets and personal data from text returned to the MCP client or written to the logs.
+type Redactor struct {
+	rules       []*regexp.Regexp
+	highEntropy bool
+}
+
+func New(
+	config Config,
+) (*Redactor, error) {
+	redactor := &Redactor{}
+
+	if config.RedactOutput() {
+		redactor.rules = append(redactor.rules, builtInRules...)
+		redactor.highEntropy = true
+	}
+
+	for _, pattern := range config.RedactionPatterns() {
+		rule, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid redaction pattern %q: %w", pattern, err)
+		}
+		redactor.rules = append(redactor.rules, rule)
+	}
+
+	return redactor, nil
+}
+
+// Enabled is true when there is anything to redact.
+func (r *Redactor) Enabled() bool {
+	return len(r.rules) > 0 || r.highEntropy
+}
+
+// Redact replaces the secrets in text with Placeholder, and returns the number of replaced values.
+func (r *Redactor) Redact(text string) (string, int) {
+	count := 0
+
+	for _, rule := range r.rules {
+		var n int
+		text, n = redactRule(rule, text)
+		count += n
+	}
+
+	if r.highEntropy {
+		text = highEntropyToken.ReplaceAllStringFunc(text, func(token string) string {
+			if !isHighEntropy(token) {
+				return token
+			}
+			count++
+			return Placeholder
+		})
+	}
+
+	return text, count
+}
+
+func redactRule(rule *regexp.Regexp, text string) (string, int) {
+	groupIndex := rule.SubexpIndex(secretGroup)
+
+	matches := rule.FindAllStringSubmatchIndex(text, -1)
+	if len(matches) == 0 {
+		return text, 0
+	}
+
+	redacted := make([]byte, 0, len(text))
+	last := 0
+	count := 0
+	for _, match := range matches {
+		start, end := match[0], match[1]
+		if groupIndex > 0 && match[2*groupIndex] >= 0 {
+			start, end = match[2*groupIndex], match[2*groupIndex+1]
+		}
+		if start < last {
+			continue
+		}
+
+		redacted = append(redacted, text[last:start]...)
+		redacted = append(redacted, Placeholder...)
+		last = end
+		count++
+	}
+	redacted = append(redacted, text[last:]...)
+
+	return string(redacted), count
+}
+
+// isHighEntropy is true for tokens that look random: mixing upper case letters, lower case letters and several digits,
+// with a high Shannon entropy. This is typical of generated keys, and rare in code, prose and identifiers.
+func isHighEntropy(token string) bool {
+	var hasUpper, hasLower bool
+	digits := 0
+	frequencies := map[rune]int{}
+	for _, c := range token {
+		hasUpper = hasUpper || unicode.IsUpper(c)
+		hasLower = hasLower || unicode.IsLower(c)
+		if unicode.IsDigit(c) {
+			digits++
+		}
+		frequencies[c]++
+	}
+	if !hasUpper || !hasLower || digits < minHighEntropyDigits {
+		return false
+	}
+
+	entropy := 0.0
+	length := float64(len(token))
+	for _, frequency := range frequencies {
+		p := float64(frequency) / length
+		entropy -= p * math.Log2(p)
+	}
+
+	return entropy >= minHighEntropyBitsPerChar
+}
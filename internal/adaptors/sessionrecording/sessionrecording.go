@@ -0,0 +1,304 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package sessionrecording
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/clientidentity"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// maxEntrySize is the largest recorded entry the reader accepts, as results can hold figures.
+const maxEntrySize = 256 * 1024 * 1024
+
+type EntryKind string
+
+const (
+	EntryKindStart    EntryKind = "start"
+	EntryKindToolCall EntryKind = "tool_call"
+)
+
+// Entry is a line of a recording.
+// Every entry holds the hash of the previous one, so that removing, reordering or editing entries breaks the chain.
+type Entry struct {
+	Sequence     int             `json:"sequence"`
+	Time         time.Time       `json:"time"`
+	Kind         EntryKind       `json:"kind"`
+	Version      string          `json:"version,omitempty"`
+	User         string          `json:"user,omitempty"`
+	Client       string          `json:"client,omitempty"`
+	Tool         string          `json:"tool,omitempty"`
+	Arguments    json.RawMessage `json:"arguments,omitempty"`
+	Result       json.RawMessage `json:"result,omitempty"`
+	Error        string          `json:"error,omitempty"`
+	PreviousHash string          `json:"previous_hash"`
+	Hash         string          `json:"hash,omitempty"`
+}
+
+type Config interface {
+	Version() string
+	RecordSessionFolder() string
+}
+
+type OSLayer interface {
+	Create(name string) (osfacade.File, error)
+}
+
+type LoggerFactory interface {
+	GetGlobalLogger() entities.Logger
+}
+
+type LifecycleSignaler interface {
+	AddShutdownFunction(shutdownFcn func() error)
+}
+
+type Encryptor interface {
+	Encrypt(data []byte) ([]byte, error)
+}
+
+// Recorder writes the tool calls of the server, with their arguments and results, to a hash-chained recording,
+// that can be audited, and replayed against a fresh MATLAB session.
+type Recorder struct {
+	logger    entities.Logger
+	encryptor Encryptor
+
+	lock         *sync.Mutex
+	file         osfacade.File
+	sequence     int
+	previousHash string
+}
+
+func New(
+	config Config,
+	osLayer OSLayer,
+	loggerFactory LoggerFactory,
+	lifecycleSignaler LifecycleSignaler,
+	encryptor Encryptor,
+) (*Recorder, error) {
+	recorder := &Recorder{
+		logger:    loggerFactory.GetGlobalLogger().With("component", "session-recorder"),
+		encryptor: encryptor,
+		lock:      new(sync.Mutex),
+	}
+
+	folder := config.RecordSessionFolder()
+	if folder == "" {
+		return recorder, nil
+	}
+
+	path := filepath.Join(folder, fmt.Sprintf("recording-%s.jsonl", time.Now().UTC().Format("20060102T150405.000000000Z")))
+	file, err := osLayer.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session recording: %w", err)
+	}
+	recorder.file = file
+
+	lifecycleSignaler.AddShutdownFunction(recorder.close)
+
+	if err := recorder.append(Entry{Kind: EntryKindStart, Version: config.Version()}); err != nil {
+		return nil, fmt.Errorf("failed to write session recording: %w", err)
+	}
+
+	recorder.logger.With("path", path).Info("Recording the session")
+
+	return recorder, nil
+}
+
+// Enabled is true when the session is recorded.
+func (r *Recorder) Enabled() bool {
+	return r.file != nil
+}
+
+// RecordToolCall appends a tool call to the recording, with the identity of the user and client that made it.
+// Failing to record is logged, and does not fail the tool call.
+func (r *Recorder) RecordToolCall(identity clientidentity.Identity, tool string, arguments json.RawMessage, result *mcp.CallToolResult, callErr error) {
+	entry := Entry{
+		Kind:      EntryKindToolCall,
+		User:      identity.User,
+		Client:    identity.Client,
+		Tool:      tool,
+		Arguments: arguments,
+	}
+
+	if callErr != nil {
+		entry.Error = callErr.Error()
+	}
+
+	if result != nil {
+		data, err := json.Marshal(result)
+		if err != nil {
+			r.logger.WithError(err).Warn("Failed to serialize tool call result for the session recording")
+		}
+		entry.Result = data
+	}
+
+	if err := r.append(entry); err != nil {
+		r.logger.WithError(err).Error("Failed to write session recording")
+	}
+}
+
+func (r *Recorder) append(entry Entry) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.file == nil {
+		return nil
+	}
+
+	entry.Sequence = r.sequence + 1
+	entry.Time = time.Now().UTC()
+	entry.PreviousHash = r.previousHash
+
+	hash, err := hashOf(entry)
+	if err != nil {
+		return err
+	}
+	entry.Hash = hash
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	// Entries are encrypted one by one, so that a recording that was cut short can still be read.
+	line, err = r.encryptor.Encrypt(line)
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.file.Write(append(line, '\n')); err != nil {
+		return err
+	}
+
+	r.sequence = entry.Sequence
+	r.previousHash = hash
+
+	return nil
+}
+
+func (r *Recorder) close() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.file == nil {
+		return nil
+	}
+
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+// hashOf is the SHA-256 of the entry without its own hash. The entry holds the hash of the previous entry, which chains them.
+func hashOf(entry Entry) (string, error) {
+	entry.Hash = ""
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+type ReaderOSLayer interface {
+	ReadFile(name string) ([]byte, error)
+}
+
+type Decryptor interface {
+	Decrypt(data []byte) ([]byte, error)
+}
+
+// Reader reads recordings, encrypted or not, and verifies their hash chain.
+type Reader struct {
+	osLayer   ReaderOSLayer
+	decryptor Decryptor
+}
+
+func NewReader(
+	osLayer ReaderOSLayer,
+	decryptor Decryptor,
+) *Reader {
+	return &Reader{
+		osLayer:   osLayer,
+		decryptor: decryptor,
+	}
+}
+
+// Read returns the entries of a recording, and fails if the recording was truncated at its start, or modified.
+func (r *Reader) Read(path string) ([]Entry, error) {
+	data, err := r.osLayer.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read recording: %w", err)
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, maxEntrySize)
+
+	var entries []Entry
+	previousHash := ""
+	for scanner.Scan() {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		line, err := r.decryptor.Decrypt(scanner.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt entry %d of recording %s: %w", len(entries)+1, path, err)
+		}
+
+		var entry Entry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return nil, fmt.Errorf("failed to parse entry %d of recording %s: %w", len(entries)+1, path, err)
+		}
+
+		if err := verify(entry, len(entries)+1, previousHash); err != nil {
+			return nil, fmt.Errorf("recording %s was modified at entry %d: %w", path, len(entries)+1, err)
+		}
+
+		entries = append(entries, entry)
+		previousHash = entry.Hash
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read recording: %w", err)
+	}
+
+	if len(entries) == 0 || entries[0].Kind != EntryKindStart {
+		return nil, fmt.Errorf("recording %s does not start with a start entry", path)
+	}
+
+	return entries, nil
+}
+
+func verify(entry Entry, expectedSequence int, expectedPreviousHash string) error {
+	if entry.Sequence != expectedSequence {
+		return fmt.Errorf("expected sequence number %d, got %d", expectedSequence, entry.Sequence)
+	}
+
+	if entry.PreviousHash != expectedPreviousHash {
+		return fmt.Errorf("previous hash does not match the previous entry")
+	}
+
+	hash, err := hashOf(entry)
+	if err != nil {
+		return err
+	}
+
+	if hash != entry.Hash {
+		return fmt.Errorf("hash does not match the content of the entry")
+	}
+
+	return nil
+}
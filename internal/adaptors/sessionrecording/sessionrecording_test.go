@@ -0,0 +1,366 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package sessionrecording_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/sessionrecording"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/clientidentity"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/sessionrecording"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const recordingFolder = "/home/user/recordings"
+
+// passthrough leaves the data unchanged, as the encryptor does when encryption is turned off.
+func passthrough(data []byte) ([]byte, error) {
+	return data, nil
+}
+
+// encodeForTest stands in for encryption, so that tests can check that the recording only holds the transformed entries.
+func encodeForTest(data []byte) ([]byte, error) {
+	return []byte("encrypted:" + base64.StdEncoding.EncodeToString(data)), nil
+}
+
+func decodeForTest(data []byte) ([]byte, error) {
+	return base64.StdEncoding.DecodeString(strings.TrimPrefix(string(data), "encrypted:"))
+}
+
+// record records a session with two tool calls, and returns the content of the recording.
+func record(t *testing.T) []byte {
+	t.Helper()
+
+	return recordWith(t, passthrough)
+}
+
+func recordWith(t *testing.T, encrypt func(data []byte) ([]byte, error)) []byte {
+	t.Helper()
+
+	mockEncryptor := &mocks.MockEncryptor{}
+	defer mockEncryptor.AssertExpectations(t)
+
+	mockEncryptor.EXPECT().
+		Encrypt(mock.Anything).
+		RunAndReturn(encrypt).
+		Times(3)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	path := filepath.Join(t.TempDir(), "recording.jsonl")
+	file, err := os.Create(path)
+	require.NoError(t, err)
+
+	mockConfig.EXPECT().
+		RecordSessionFolder().
+		Return(recordingFolder).
+		Once()
+
+	mockConfig.EXPECT().
+		Version().
+		Return("matlab-mcp-core-server v0.1.0").
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Create(mock.MatchedBy(func(name string) bool {
+			return filepath.Dir(name) == recordingFolder && strings.HasPrefix(filepath.Base(name), "recording-") && strings.HasSuffix(name, ".jsonl")
+		})).
+		Return(&osfacade.FileWrapper{File: file}, nil).
+		Once()
+
+	var shutdown func() error
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.Anything).
+		Run(func(shutdownFcn func() error) {
+			shutdown = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	recorder, err := sessionrecording.New(mockConfig, mockOSLayer, mockLoggerFactory, mockLifecycleSignaler, mockEncryptor)
+	require.NoError(t, err)
+	require.True(t, recorder.Enabled())
+
+	recorder.RecordToolCall(clientidentity.Identity{User: "alice", Client: "claude-code 1.0.0"}, "evaluate_matlab_code", json.RawMessage(`{"code": "x = 1"}`), &mcp.CallToolResult{
+		Content: []mcp.Content{&mcp.TextContent{Text: "x = 1"}},
+	}, nil)
+	recorder.RecordToolCall(clientidentity.Identity{}, "unknown_tool", nil, nil, assert.AnError)
+
+	require.NoError(t, shutdown())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return data
+}
+
+func read(t *testing.T, data []byte) ([]sessionrecording.Entry, error) {
+	t.Helper()
+
+	return readWith(t, data, passthrough)
+}
+
+func readWith(t *testing.T, data []byte, decrypt func(data []byte) ([]byte, error)) ([]sessionrecording.Entry, error) {
+	t.Helper()
+
+	mockOSLayer := &mocks.MockReaderOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockDecryptor := &mocks.MockDecryptor{}
+	defer mockDecryptor.AssertExpectations(t)
+
+	mockDecryptor.EXPECT().
+		Decrypt(mock.Anything).
+		RunAndReturn(decrypt).
+		Maybe()
+
+	mockOSLayer.EXPECT().
+		ReadFile("recording.jsonl").
+		Return(data, nil).
+		Once()
+
+	return sessionrecording.NewReader(mockOSLayer, mockDecryptor).Read("recording.jsonl")
+}
+
+func TestNew_Disabled(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		RecordSessionFolder().
+		Return("").
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	// Act
+	recorder, err := sessionrecording.New(mockConfig, mockOSLayer, mockLoggerFactory, mockLifecycleSignaler, &mocks.MockEncryptor{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, recorder.Enabled())
+	recorder.RecordToolCall(clientidentity.Identity{}, "evaluate_matlab_code", nil, &mcp.CallToolResult{}, nil)
+}
+
+func TestNew_CreateError(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		RecordSessionFolder().
+		Return(recordingFolder).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Create(mock.Anything).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	recorder, err := sessionrecording.New(mockConfig, mockOSLayer, mockLoggerFactory, mockLifecycleSignaler, &mocks.MockEncryptor{})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, recorder)
+}
+
+func TestRecorder_RecordToolCall_HashChainedRecording(t *testing.T) {
+	// Arrange
+	data := record(t)
+
+	// Act
+	entries, err := read(t, data)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+
+	assert.Equal(t, sessionrecording.EntryKindStart, entries[0].Kind)
+	assert.Equal(t, "matlab-mcp-core-server v0.1.0", entries[0].Version)
+	assert.Empty(t, entries[0].PreviousHash)
+
+	assert.Equal(t, sessionrecording.EntryKindToolCall, entries[1].Kind)
+	assert.Equal(t, "evaluate_matlab_code", entries[1].Tool)
+	assert.Equal(t, "alice", entries[1].User)
+	assert.Equal(t, "claude-code 1.0.0", entries[1].Client)
+	assert.JSONEq(t, `{"code": "x = 1"}`, string(entries[1].Arguments))
+	assert.JSONEq(t, `{"content": [{"type": "text", "text": "x = 1"}]}`, string(entries[1].Result))
+	assert.Equal(t, entries[0].Hash, entries[1].PreviousHash)
+
+	assert.Equal(t, "unknown_tool", entries[2].Tool)
+	assert.Equal(t, assert.AnError.Error(), entries[2].Error)
+	assert.Equal(t, entries[1].Hash, entries[2].PreviousHash)
+
+	for i, entry := range entries {
+		assert.Equal(t, i+1, entry.Sequence)
+	}
+}
+
+func TestRecorder_RecordToolCall_EncryptedRecording(t *testing.T) {
+	// Arrange
+	data := recordWith(t, encodeForTest)
+
+	// Act
+	entries, err := readWith(t, data, decodeForTest)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	assert.Equal(t, "evaluate_matlab_code", entries[1].Tool)
+	assert.NotContains(t, string(data), "x = 1", "Entries should only be written encrypted")
+	for _, line := range bytes.Split(bytes.TrimSpace(data), []byte("\n")) {
+		assert.True(t, bytes.HasPrefix(line, []byte("encrypted:")), "Every entry should be encrypted on its own line")
+	}
+}
+
+func TestReader_Read_DecryptError(t *testing.T) {
+	// Arrange
+	data := recordWith(t, encodeForTest)
+
+	// Act
+	entries, err := readWith(t, data, func([]byte) ([]byte, error) { return nil, assert.AnError })
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	require.ErrorContains(t, err, "failed to decrypt entry 1")
+	assert.Nil(t, entries)
+}
+
+func TestReader_Read_DetectsModifications(t *testing.T) {
+	testCases := []struct {
+		name          string
+		modify        func(lines [][]byte) [][]byte
+		expectedError string
+	}{
+		{
+			name: "edited result",
+			modify: func(lines [][]byte) [][]byte {
+				lines[1] = bytes.Replace(lines[1], []byte("x = 1"), []byte("x = 2"), 1)
+				return lines
+			},
+			expectedError: "was modified at entry 2: hash does not match the content of the entry",
+		},
+		{
+			name: "removed entry",
+			modify: func(lines [][]byte) [][]byte {
+				return append(lines[:1], lines[2:]...)
+			},
+			expectedError: "was modified at entry 2: expected sequence number 2, got 3",
+		},
+		{
+			name: "reordered entries",
+			modify: func(lines [][]byte) [][]byte {
+				lines[1], lines[2] = lines[2], lines[1]
+				return lines
+			},
+			expectedError: "was modified at entry 2",
+		},
+		{
+			name: "removed start",
+			modify: func(lines [][]byte) [][]byte {
+				return lines[1:]
+			},
+			expectedError: "was modified at entry 1",
+		},
+		{
+			name: "not a recording",
+			modify: func(lines [][]byte) [][]byte {
+				return [][]byte{[]byte("not json")}
+			},
+			expectedError: "failed to parse entry 1",
+		},
+		{
+			name: "empty",
+			modify: func(lines [][]byte) [][]byte {
+				return nil
+			},
+			expectedError: "does not start with a start entry",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			lines := bytes.Split(bytes.TrimSpace(record(t)), []byte("\n"))
+			data := bytes.Join(testCase.modify(lines), []byte("\n"))
+
+			// Act
+			entries, err := read(t, data)
+
+			// Assert
+			require.ErrorContains(t, err, testCase.expectedError)
+			assert.Nil(t, entries)
+		})
+	}
+}
+
+func TestReader_Read_ReadFileError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockReaderOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		ReadFile("recording.jsonl").
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	entries, err := sessionrecording.NewReader(mockOSLayer, &mocks.MockDecryptor{}).Read("recording.jsonl")
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, entries)
+}
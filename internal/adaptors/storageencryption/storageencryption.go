@@ -0,0 +1,181 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package storageencryption
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/keychainfacade"
+)
+
+const (
+	keychainService = "MATLAB MCP Core Server"
+	keychainAccount = "storage-encryption-key"
+
+	keySize = 32
+)
+
+// prefix starts encrypted data, so that data written before encryption was turned on can still be read.
+var prefix = []byte("matlab-mcp-encrypted:v1:")
+
+type Config interface {
+	EncryptAtRest() bool
+}
+
+type Keychain interface {
+	Get(service string, account string) (string, error)
+	Set(service string, account string, secret string) error
+}
+
+// Encryptor encrypts the data the server writes to disk with AES-256-GCM, using a key held in the keychain of the operating system,
+// so that the data cannot be read from a copy of the disk, or from a backup, without the keychain of the user.
+// Encrypted data is text, without line breaks, so that it can be written to line-based files.
+type Encryptor struct {
+	keychain Keychain
+	enabled  bool
+
+	lock *sync.Mutex
+	aead cipher.AEAD
+}
+
+// New creates an encryptor. When encryption is turned on, the key is read from the keychain, or created on first use,
+// so that the server does not start if it cannot encrypt the data it writes.
+func New(
+	config Config,
+	keychain Keychain,
+) (*Encryptor, error) {
+	encryptor := &Encryptor{
+		keychain: keychain,
+		enabled:  config.EncryptAtRest(),
+		lock:     new(sync.Mutex),
+	}
+
+	if encryptor.enabled {
+		if _, err := encryptor.cipher(true); err != nil {
+			return nil, err
+		}
+	}
+
+	return encryptor, nil
+}
+
+// Enabled is true when the data written to disk is encrypted.
+func (e *Encryptor) Enabled() bool {
+	return e.enabled
+}
+
+// Encrypt encrypts the data when encryption is turned on, and returns it unchanged otherwise.
+func (e *Encryptor) Encrypt(data []byte) ([]byte, error) {
+	if !e.enabled {
+		return data, nil
+	}
+
+	aead, err := e.cipher(true)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	sealed := aead.Seal(nonce, nonce, data, prefix)
+	encrypted := make([]byte, len(prefix)+base64.StdEncoding.EncodedLen(len(sealed)))
+	copy(encrypted, prefix)
+	base64.StdEncoding.Encode(encrypted[len(prefix):], sealed)
+	return encrypted, nil
+}
+
+// Decrypt decrypts data written by Encrypt, and returns data that is not encrypted unchanged, whether encryption is turned on or not.
+func (e *Encryptor) Decrypt(data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, prefix) {
+		return data, nil
+	}
+
+	aead, err := e.cipher(false)
+	if err != nil {
+		return nil, err
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(string(data[len(prefix):]))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode encrypted data: %w", err)
+	}
+
+	if len(sealed) < aead.NonceSize() {
+		return nil, fmt.Errorf("failed to decrypt data: data is truncated")
+	}
+
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], prefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt data, it was modified, or encrypted with another key: %w", err)
+	}
+	return plaintext, nil
+}
+
+// cipher returns the cipher of the key held in the keychain. Without a key, it creates one if create is true.
+func (e *Encryptor) cipher(create bool) (cipher.AEAD, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.aead != nil {
+		return e.aead, nil
+	}
+
+	key, err := e.key(create)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	e.aead = aead
+	return aead, nil
+}
+
+func (e *Encryptor) key(create bool) ([]byte, error) {
+	encodedKey, err := e.keychain.Get(keychainService, keychainAccount)
+	if errors.Is(err, keychainfacade.ErrNotFound) && create {
+		return e.createKey()
+	}
+	if errors.Is(err, keychainfacade.ErrNotFound) {
+		return nil, fmt.Errorf("data is encrypted, but the storage encryption key is not in the keychain")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the storage encryption key from the keychain: %w", err)
+	}
+
+	key, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil || len(key) != keySize {
+		return nil, fmt.Errorf("the storage encryption key in the keychain is invalid")
+	}
+	return key, nil
+}
+
+func (e *Encryptor) createKey() ([]byte, error) {
+	key := make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate the storage encryption key: %w", err)
+	}
+
+	if err := e.keychain.Set(keychainService, keychainAccount, base64.StdEncoding.EncodeToString(key)); err != nil {
+		return nil, fmt.Errorf("failed to store the storage encryption key in the keychain: %w", err)
+	}
+	return key, nil
+}
@@ -0,0 +1,255 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package storageencryption_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/storageencryption"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/keychainfacade"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/storageencryption"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	keychainService = "MATLAB MCP Core Server"
+	keychainAccount = "storage-encryption-key"
+)
+
+var key = base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{7}, 32))
+
+func newEncryptor(t *testing.T, enabled bool, mockKeychain *mocks.MockKeychain) *storageencryption.Encryptor {
+	t.Helper()
+
+	mockConfig := &mocks.MockConfig{}
+	t.Cleanup(func() { mockConfig.AssertExpectations(t) })
+
+	mockConfig.EXPECT().
+		EncryptAtRest().
+		Return(enabled).
+		Once()
+
+	encryptor, err := storageencryption.New(mockConfig, mockKeychain)
+	require.NoError(t, err)
+	return encryptor
+}
+
+func TestEncryptor_Disabled(t *testing.T) {
+	// Arrange
+	mockKeychain := &mocks.MockKeychain{}
+	defer mockKeychain.AssertExpectations(t)
+
+	encryptor := newEncryptor(t, false, mockKeychain)
+
+	// Act
+	encrypted, encryptErr := encryptor.Encrypt([]byte(`{"tool": "evaluate_matlab_code"}`))
+	decrypted, decryptErr := encryptor.Decrypt([]byte(`{"tool": "evaluate_matlab_code"}`))
+
+	// Assert
+	require.NoError(t, encryptErr)
+	require.NoError(t, decryptErr)
+	assert.False(t, encryptor.Enabled())
+	assert.JSONEq(t, `{"tool": "evaluate_matlab_code"}`, string(encrypted), "Data should be written unchanged when encryption is turned off")
+	assert.JSONEq(t, `{"tool": "evaluate_matlab_code"}`, string(decrypted))
+}
+
+func TestEncryptor_CreatesKeyOnFirstUse(t *testing.T) {
+	// Arrange
+	mockKeychain := &mocks.MockKeychain{}
+	defer mockKeychain.AssertExpectations(t)
+
+	var storedKey string
+	mockKeychain.EXPECT().
+		Get(keychainService, keychainAccount).
+		Return("", keychainfacade.ErrNotFound).
+		Once()
+
+	mockKeychain.EXPECT().
+		Set(keychainService, keychainAccount, mock.Anything).
+		Run(func(_ string, _ string, secret string) {
+			storedKey = secret
+		}).
+		Return(nil).
+		Once()
+
+	encryptor := newEncryptor(t, true, mockKeychain)
+
+	// Act
+	encrypted, err := encryptor.Encrypt([]byte("x = 1"))
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, encryptor.Enabled())
+	assert.True(t, bytes.HasPrefix(encrypted, []byte("matlab-mcp-encrypted:v1:")))
+	assert.NotContains(t, string(encrypted), "x = 1")
+	assert.NotContains(t, string(encrypted), "\n", "Encrypted data should fit on a line")
+
+	decodedKey, err := base64.StdEncoding.DecodeString(storedKey)
+	require.NoError(t, err)
+	assert.Len(t, decodedKey, 32)
+
+	decrypted, err := encryptor.Decrypt(encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, "x = 1", string(decrypted))
+}
+
+func TestEncryptor_DecryptsWithTheKeyOfTheKeychain(t *testing.T) {
+	// Arrange
+	writerKeychain := &mocks.MockKeychain{}
+	defer writerKeychain.AssertExpectations(t)
+
+	readerKeychain := &mocks.MockKeychain{}
+	defer readerKeychain.AssertExpectations(t)
+
+	writerKeychain.EXPECT().
+		Get(keychainService, keychainAccount).
+		Return(key, nil).
+		Once()
+
+	readerKeychain.EXPECT().
+		Get(keychainService, keychainAccount).
+		Return(key, nil).
+		Once()
+
+	encrypted, err := newEncryptor(t, true, writerKeychain).Encrypt([]byte("x = 1"))
+	require.NoError(t, err)
+
+	reader := newEncryptor(t, false, readerKeychain)
+
+	// Act
+	decrypted, err := reader.Decrypt(encrypted)
+
+	// Assert
+	require.NoError(t, err, "Encrypted data should be readable when encryption is turned off")
+	assert.Equal(t, "x = 1", string(decrypted))
+}
+
+func TestEncryptor_Decrypt_ModifiedData(t *testing.T) {
+	// Arrange
+	mockKeychain := &mocks.MockKeychain{}
+	defer mockKeychain.AssertExpectations(t)
+
+	mockKeychain.EXPECT().
+		Get(keychainService, keychainAccount).
+		Return(key, nil).
+		Once()
+
+	encryptor := newEncryptor(t, true, mockKeychain)
+	encrypted, err := encryptor.Encrypt([]byte("x = 1"))
+	require.NoError(t, err)
+
+	sealed, err := base64.StdEncoding.DecodeString(string(encrypted[len("matlab-mcp-encrypted:v1:"):]))
+	require.NoError(t, err)
+	sealed[len(sealed)-1] ^= 1
+	modified := append([]byte("matlab-mcp-encrypted:v1:"), base64.StdEncoding.EncodeToString(sealed)...)
+
+	// Act
+	decrypted, err := encryptor.Decrypt(modified)
+
+	// Assert
+	require.ErrorContains(t, err, "failed to decrypt data, it was modified, or encrypted with another key")
+	assert.Nil(t, decrypted)
+}
+
+func TestEncryptor_Decrypt_KeyNotInKeychain(t *testing.T) {
+	// Arrange
+	mockKeychain := &mocks.MockKeychain{}
+	defer mockKeychain.AssertExpectations(t)
+
+	mockKeychain.EXPECT().
+		Get(keychainService, keychainAccount).
+		Return("", keychainfacade.ErrNotFound).
+		Once()
+
+	encryptor := newEncryptor(t, false, mockKeychain)
+
+	// Act
+	decrypted, err := encryptor.Decrypt([]byte("matlab-mcp-encrypted:v1:c2VjcmV0"))
+
+	// Assert
+	require.ErrorContains(t, err, "the storage encryption key is not in the keychain")
+	assert.Nil(t, decrypted)
+}
+
+func TestNew_KeychainError(t *testing.T) {
+	testCases := []struct {
+		name          string
+		key           string
+		err           error
+		expectedError string
+	}{
+		{
+			name:          "keychain unavailable",
+			err:           assert.AnError,
+			expectedError: "failed to read the storage encryption key from the keychain",
+		},
+		{
+			name:          "invalid key",
+			key:           base64.StdEncoding.EncodeToString([]byte("short")),
+			expectedError: "the storage encryption key in the keychain is invalid",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockKeychain := &mocks.MockKeychain{}
+			defer mockKeychain.AssertExpectations(t)
+
+			mockConfig.EXPECT().
+				EncryptAtRest().
+				Return(true).
+				Once()
+
+			mockKeychain.EXPECT().
+				Get(keychainService, keychainAccount).
+				Return(testCase.key, testCase.err).
+				Once()
+
+			// Act
+			encryptor, err := storageencryption.New(mockConfig, mockKeychain)
+
+			// Assert
+			require.ErrorContains(t, err, testCase.expectedError)
+			assert.Nil(t, encryptor)
+		})
+	}
+}
+
+func TestNew_StoreKeyError(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockKeychain := &mocks.MockKeychain{}
+	defer mockKeychain.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		EncryptAtRest().
+		Return(true).
+		Once()
+
+	mockKeychain.EXPECT().
+		Get(keychainService, keychainAccount).
+		Return("", keychainfacade.ErrNotFound).
+		Once()
+
+	mockKeychain.EXPECT().
+		Set(keychainService, keychainAccount, mock.Anything).
+		Return(assert.AnError).
+		Once()
+
+	// Act
+	encryptor, err := storageencryption.New(mockConfig, mockKeychain)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, encryptor)
+}
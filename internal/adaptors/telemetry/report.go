@@ -0,0 +1,52 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package telemetry
+
+import "time"
+
+// ReportSchemaVersion is incremented whenever the shape of Report changes.
+const ReportSchemaVersion = 1
+
+// Error classes reported in Report.ToolErrors.
+const (
+	// ErrorClassToolError is a tool call that completed, but reported a failure to the client.
+	ErrorClassToolError = "tool-error"
+	// ErrorClassRequestError is a tool call rejected before reaching the tool, for example an unknown tool or invalid arguments.
+	ErrorClassRequestError = "request-error"
+)
+
+// UnknownToolName replaces the name of tool calls that were rejected before reaching a tool,
+// as the name is then provided by the client and could contain anything.
+const UnknownToolName = "unknown"
+
+// Report is the complete payload sent to the telemetry endpoint.
+// It only contains aggregate counts: no code, paths, arguments, outputs or identifiers are ever included.
+type Report struct {
+	SchemaVersion  int            `json:"schema-version"`
+	ServerVersion  string         `json:"server-version"`
+	OS             string         `json:"os"`
+	Arch           string         `json:"arch"`
+	PeriodStart    time.Time      `json:"period-start"`
+	PeriodEnd      time.Time      `json:"period-end"`
+	ToolCalls      map[string]int `json:"tool-calls"`
+	ToolErrors     map[string]int `json:"tool-errors"`
+	MATLABReleases map[string]int `json:"matlab-releases"`
+}
+
+func newReport(serverVersion string, goos string, goarch string, periodStart time.Time) Report {
+	return Report{
+		SchemaVersion:  ReportSchemaVersion,
+		ServerVersion:  serverVersion,
+		OS:             goos,
+		Arch:           goarch,
+		PeriodStart:    periodStart,
+		PeriodEnd:      periodStart,
+		ToolCalls:      map[string]int{},
+		ToolErrors:     map[string]int{},
+		MATLABReleases: map[string]int{},
+	}
+}
+
+func (r Report) isEmpty() bool {
+	return len(r.ToolCalls) == 0 && len(r.ToolErrors) == 0 && len(r.MATLABReleases) == 0
+}
@@ -0,0 +1,291 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+const (
+	reportInterval  = 24 * time.Hour
+	sendTimeout     = 10 * time.Second
+	pendingFileName = "matlab-mcp-core-server-telemetry.json"
+)
+
+type Config interface {
+	TelemetryEnabled() bool
+	TelemetryEndpoint() string
+	Version() string
+}
+
+type OSLayer interface {
+	TempDir() string
+	ReadFile(filePath string) ([]byte, error)
+	WriteFile(name string, data []byte, perm os.FileMode) error
+	RemoveAll(path string) error
+	GOOS() string
+}
+
+type MATLABVersionGetter interface {
+	Get(matlabRootLocation string) (datatypes.MatlabVersionInfo, error)
+}
+
+type LoggerFactory interface {
+	GetGlobalLogger() entities.Logger
+}
+
+type LifecycleSignaler interface {
+	AddShutdownFunction(shutdownFcn func() error)
+}
+
+type HTTPClientFactory interface {
+	NewClient(timeout time.Duration) *http.Client
+}
+
+// Collector aggregates anonymized usage counts, and periodically reports them to the telemetry endpoint.
+// Nothing is collected unless the user explicitly opted in.
+// The pending report is kept in a file in the temporary directory, so it can be previewed, and survives restarts.
+type Collector struct {
+	config        Config
+	osLayer       OSLayer
+	versionGetter MATLABVersionGetter
+	logger        entities.Logger
+	httpClient    *http.Client
+
+	lock    *sync.Mutex
+	report  Report
+	sending bool
+}
+
+func New(
+	config Config,
+	osLayer OSLayer,
+	versionGetter MATLABVersionGetter,
+	loggerFactory LoggerFactory,
+	lifecycleSignaler LifecycleSignaler,
+	httpClientFactory HTTPClientFactory,
+) *Collector {
+	collector := &Collector{
+		config:        config,
+		osLayer:       osLayer,
+		versionGetter: versionGetter,
+		logger:        loggerFactory.GetGlobalLogger().With("component", "telemetry"),
+		httpClient:    httpClientFactory.NewClient(sendTimeout),
+
+		lock: new(sync.Mutex),
+	}
+
+	if !config.TelemetryEnabled() {
+		// Discard anything collected while telemetry was enabled, but not sent yet.
+		if err := osLayer.RemoveAll(pendingPath(osLayer)); err != nil {
+			collector.logger.WithError(err).Debug("Failed to remove pending usage report")
+		}
+		return collector
+	}
+
+	collector.logger.With("endpoint", config.TelemetryEndpoint()).Info("Anonymized usage telemetry is enabled")
+
+	report, err := readPending(osLayer)
+	if err != nil {
+		report = collector.newReport()
+	}
+	collector.report = report
+
+	lifecycleSignaler.AddShutdownFunction(func() error {
+		collector.flush()
+		return nil
+	})
+
+	return collector
+}
+
+// RecordToolCall counts a call to a tool. errorClass is empty when the call succeeded.
+func (c *Collector) RecordToolCall(toolName string, errorClass string) {
+	c.record(func(report *Report) {
+		report.ToolCalls[toolName]++
+		if errorClass != "" {
+			report.ToolErrors[errorClass]++
+		}
+	})
+}
+
+// RecordMATLABSessionStarted counts the release of a MATLAB session that was started.
+// Only the release name is reported, never the MATLAB root.
+func (c *Collector) RecordMATLABSessionStarted(matlabRoot string) {
+	if !c.config.TelemetryEnabled() {
+		return
+	}
+
+	versionInfo, err := c.versionGetter.Get(matlabRoot)
+	release := versionInfo.ReleaseFamily
+	if err != nil || release == "" {
+		release = "unknown"
+	}
+
+	c.record(func(report *Report) {
+		report.MATLABReleases[release]++
+	})
+}
+
+func (c *Collector) record(update func(report *Report)) {
+	if !c.config.TelemetryEnabled() {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	update(&c.report)
+	c.report.PeriodEnd = time.Now()
+	c.savePending()
+
+	if !c.sending && c.report.PeriodEnd.Sub(c.report.PeriodStart) >= reportInterval {
+		c.sending = true
+		go c.send()
+	}
+}
+
+func (c *Collector) flush() {
+	c.lock.Lock()
+	if c.sending || c.report.isEmpty() {
+		c.lock.Unlock()
+		return
+	}
+	c.sending = true
+	c.lock.Unlock()
+
+	c.send()
+}
+
+func (c *Collector) send() {
+	c.lock.Lock()
+	report := c.report
+	c.report = c.newReport()
+	c.lock.Unlock()
+
+	err := c.post(report)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.sending = false
+	if err != nil {
+		// Keep the counts for the next attempt. Telemetry must never get in the way, so this is only a debug log.
+		c.logger.WithError(err).Debug("Failed to send usage report")
+		c.report = merge(report, c.report)
+	} else {
+		c.logger.Debug("Sent usage report")
+	}
+	c.savePending()
+}
+
+func (c *Collector) post(report Report) error {
+	data, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), sendTimeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, c.config.TelemetryEndpoint(), bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close() //nolint:errcheck // Nothing is read from the response
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code: %d", response.StatusCode)
+	}
+
+	return nil
+}
+
+func (c *Collector) savePending() {
+	data, err := json.Marshal(c.report)
+	if err == nil {
+		err = c.osLayer.WriteFile(pendingPath(c.osLayer), data, 0o600)
+	}
+	if err != nil {
+		c.logger.WithError(err).Debug("Failed to save pending usage report")
+	}
+}
+
+func (c *Collector) newReport() Report {
+	return newReport(c.config.Version(), c.osLayer.GOOS(), runtime.GOARCH, time.Now())
+}
+
+// Reader reads the usage report pending to be sent by a server, possibly running in another process.
+type Reader struct {
+	osLayer OSLayer
+}
+
+func NewReader(osLayer OSLayer) *Reader {
+	return &Reader{
+		osLayer: osLayer,
+	}
+}
+
+func (r *Reader) Read() (Report, error) {
+	return readPending(r.osLayer)
+}
+
+func readPending(osLayer OSLayer) (Report, error) {
+	data, err := osLayer.ReadFile(pendingPath(osLayer))
+	if err != nil {
+		return Report{}, err
+	}
+
+	var report Report
+	if err := json.Unmarshal(data, &report); err != nil {
+		return Report{}, err
+	}
+
+	if report.ToolCalls == nil {
+		report.ToolCalls = map[string]int{}
+	}
+	if report.ToolErrors == nil {
+		report.ToolErrors = map[string]int{}
+	}
+	if report.MATLABReleases == nil {
+		report.MATLABReleases = map[string]int{}
+	}
+	return report, nil
+}
+
+func pendingPath(osLayer OSLayer) string {
+	return filepath.Join(osLayer.TempDir(), pendingFileName)
+}
+
+func merge(older Report, newer Report) Report {
+	merged := older
+	merged.PeriodEnd = newer.PeriodEnd
+	for name, count := range newer.ToolCalls {
+		merged.ToolCalls[name] += count
+	}
+	for class, count := range newer.ToolErrors {
+		merged.ToolErrors[class] += count
+	}
+	for release, count := range newer.MATLABReleases {
+		merged.MATLABReleases[release] += count
+	}
+	return merged
+}
@@ -0,0 +1,307 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package telemetry_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/telemetry"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/telemetry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const pendingFileName = "matlab-mcp-core-server-telemetry.json"
+
+func TestNew_DisabledDiscardsPendingReportAndCollectsNothing(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockVersionGetter := &mocks.MockMATLABVersionGetter{}
+	defer mockVersionGetter.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	tempDir := t.TempDir()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	mockConfig.EXPECT().
+		TelemetryEnabled().
+		Return(false)
+
+	mockOSLayer.EXPECT().
+		TempDir().
+		Return(tempDir).
+		Once()
+
+	mockOSLayer.EXPECT().
+		RemoveAll(filepath.Join(tempDir, pendingFileName)).
+		Return(nil).
+		Once()
+
+	mockHTTPClientFactory := &mocks.MockHTTPClientFactory{}
+	defer mockHTTPClientFactory.AssertExpectations(t)
+
+	mockHTTPClientFactory.EXPECT().
+		NewClient(mock.Anything).
+		Return(&http.Client{}).
+		Once()
+
+	collector := telemetry.New(mockConfig, mockOSLayer, mockVersionGetter, mockLoggerFactory, mockLifecycleSignaler, mockHTTPClientFactory)
+
+	// Act
+	collector.RecordToolCall("evaluate_matlab_code", "")
+	collector.RecordMATLABSessionStarted("/path/to/matlab")
+
+	// Assert
+	mockOSLayer.AssertNotCalled(t, "WriteFile", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCollector_ShutdownSendsReport(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockVersionGetter := &mocks.MockMATLABVersionGetter{}
+	defer mockVersionGetter.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	receivedBodyC := make(chan []byte, 1)
+	endpoint := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		receivedBodyC <- body
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer endpoint.Close()
+
+	matlabRoot := "/home/user/MATLAB/R2025a"
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	mockConfig.EXPECT().
+		TelemetryEnabled().
+		Return(true)
+
+	mockConfig.EXPECT().
+		TelemetryEndpoint().
+		Return(endpoint.URL)
+
+	mockConfig.EXPECT().
+		Version().
+		Return("1.2.3")
+
+	mockOSLayer.EXPECT().
+		TempDir().
+		Return(t.TempDir())
+
+	mockOSLayer.EXPECT().
+		GOOS().
+		Return("linux")
+
+	mockOSLayer.EXPECT().
+		ReadFile(mock.Anything).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	mockOSLayer.EXPECT().
+		WriteFile(mock.Anything, mock.Anything, os.FileMode(0o600)).
+		Return(nil)
+
+	mockVersionGetter.EXPECT().
+		Get(matlabRoot).
+		Return(datatypes.MatlabVersionInfo{ReleaseFamily: "R2025a"}, nil).
+		Once()
+
+	var shutdownFcn func() error
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(fcn func() error) {
+			shutdownFcn = fcn
+		}).
+		Return().
+		Once()
+
+	mockHTTPClientFactory := &mocks.MockHTTPClientFactory{}
+	defer mockHTTPClientFactory.AssertExpectations(t)
+
+	mockHTTPClientFactory.EXPECT().
+		NewClient(mock.Anything).
+		Return(&http.Client{}).
+		Once()
+
+	collector := telemetry.New(mockConfig, mockOSLayer, mockVersionGetter, mockLoggerFactory, mockLifecycleSignaler, mockHTTPClientFactory)
+	collector.RecordToolCall("evaluate_matlab_code", "")
+	collector.RecordToolCall("evaluate_matlab_code", telemetry.ErrorClassToolError)
+	collector.RecordMATLABSessionStarted(matlabRoot)
+
+	// Act
+	err := shutdownFcn()
+
+	// Assert
+	require.NoError(t, err)
+
+	body := <-receivedBodyC
+	assert.NotContains(t, string(body), matlabRoot, "The MATLAB root must never be reported")
+
+	var report telemetry.Report
+	require.NoError(t, json.Unmarshal(body, &report))
+	assert.Equal(t, telemetry.ReportSchemaVersion, report.SchemaVersion)
+	assert.Equal(t, "1.2.3", report.ServerVersion)
+	assert.Equal(t, "linux", report.OS)
+	assert.Equal(t, map[string]int{"evaluate_matlab_code": 2}, report.ToolCalls)
+	assert.Equal(t, map[string]int{telemetry.ErrorClassToolError: 1}, report.ToolErrors)
+	assert.Equal(t, map[string]int{"R2025a": 1}, report.MATLABReleases)
+}
+
+func TestCollector_FailedSendKeepsCounts(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockVersionGetter := &mocks.MockMATLABVersionGetter{}
+	defer mockVersionGetter.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	endpoint := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer endpoint.Close()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	mockConfig.EXPECT().
+		TelemetryEnabled().
+		Return(true)
+
+	mockConfig.EXPECT().
+		TelemetryEndpoint().
+		Return(endpoint.URL)
+
+	mockConfig.EXPECT().
+		Version().
+		Return("1.2.3")
+
+	mockOSLayer.EXPECT().
+		TempDir().
+		Return(t.TempDir())
+
+	mockOSLayer.EXPECT().
+		GOOS().
+		Return("linux")
+
+	mockOSLayer.EXPECT().
+		ReadFile(mock.Anything).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	var lastPendingReport []byte
+	mockOSLayer.EXPECT().
+		WriteFile(mock.Anything, mock.Anything, os.FileMode(0o600)).
+		Run(func(_ string, data []byte, _ os.FileMode) {
+			lastPendingReport = data
+		}).
+		Return(nil)
+
+	var shutdownFcn func() error
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(fcn func() error) {
+			shutdownFcn = fcn
+		}).
+		Return().
+		Once()
+
+	mockHTTPClientFactory := &mocks.MockHTTPClientFactory{}
+	defer mockHTTPClientFactory.AssertExpectations(t)
+
+	mockHTTPClientFactory.EXPECT().
+		NewClient(mock.Anything).
+		Return(&http.Client{}).
+		Once()
+
+	collector := telemetry.New(mockConfig, mockOSLayer, mockVersionGetter, mockLoggerFactory, mockLifecycleSignaler, mockHTTPClientFactory)
+	collector.RecordToolCall("run_matlab_file", telemetry.ErrorClassToolError)
+
+	// Act
+	err := shutdownFcn()
+
+	// Assert
+	require.NoError(t, err, "Telemetry failures must not fail the shutdown")
+
+	var report telemetry.Report
+	require.NoError(t, json.Unmarshal(lastPendingReport, &report))
+	assert.Equal(t, map[string]int{"run_matlab_file": 1}, report.ToolCalls)
+	assert.Equal(t, map[string]int{telemetry.ErrorClassToolError: 1}, report.ToolErrors)
+}
+
+func TestReader_Read_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	tempDir := t.TempDir()
+
+	mockOSLayer.EXPECT().
+		TempDir().
+		Return(tempDir).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(tempDir, pendingFileName)).
+		Return([]byte(`{"schema-version":1,"tool-calls":{"check_matlab_code":3}}`), nil).
+		Once()
+
+	reader := telemetry.NewReader(mockOSLayer)
+
+	// Act
+	report, err := reader.Read()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"check_matlab_code": 3}, report.ToolCalls)
+	assert.Empty(t, report.ToolErrors)
+	assert.Empty(t, report.MATLABReleases)
+}
@@ -0,0 +1,91 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package toolpolicy
+
+import "slices"
+
+// dangerousCalls are the calls that are denied, or require a confirmation from the user, in addition to any policy file.
+// They catch common mistakes of AI applications that damage the machine, or the MATLAB installation, rather than attempts to get around the policy.
+// Policy files can disable them by name with `disable-dangerous-calls`.
+var dangerousCalls = []rule{
+	{
+		Name: "delete-wildcard",
+		Code: []string{
+			`\bdelete\s*\([^;\n]*?(['"])[^'"\n]*\*[^'"\n]*['"]`,
+			`(?m)^\s*delete\s+[^\s(=;,]*\*[^\s;,]*`,
+		},
+		Action: ActionConfirm,
+		Reason: "deleting files with a wildcard can delete more files than intended",
+	},
+	{
+		Name: "rmdir-parent-recursive",
+		Code: []string{
+			`\brmdir\s*\(\s*(['"])(\.\.|~|[/\\]|[A-Za-z]:)[/\\]?['"]\s*,\s*['"]s['"]\s*\)`,
+			`\brmdir\s*\(\s*(matlabroot|userpath|pwd|tempdir|getenv\s*\(\s*['"](HOME|USERPROFILE)['"]\s*\))\s*,\s*['"]s['"]\s*\)`,
+			`(?m)^\s*rmdir\s+(\.\.|~|[/\\]|[A-Za-z]:)[/\\]?\s+s\s*;?\s*$`,
+		},
+		Action: ActionDeny,
+		Reason: "recursively removing the parent, home, root or MATLAB folder deletes files outside of the work of the session",
+	},
+	{
+		Name: "rmdir-recursive",
+		Code: []string{
+			`\brmdir\s*\([^)\n]*,\s*['"]s['"]\s*\)`,
+			`(?m)^\s*rmdir\s+\S+\s+s\s*;?\s*$`,
+		},
+		Action: ActionConfirm,
+		Reason: "recursively removing a folder deletes every file it holds",
+	},
+	{
+		Name: "format-drive",
+		Code: []string{
+			`(?i)\b(system|dos|unix)\s*\(\s*['"][^'"\n]*\b(format(\.com)?\s+[A-Z]:|diskpart|mkfs(\.\w+)?\s|dd\s+[^'"\n]*\bof=/dev/)`,
+			`(?im)^\s*![^\n]*\b(format(\.com)?\s+[A-Z]:|diskpart|mkfs(\.\w+)?\s|dd\s+[^\n]*\bof=/dev/)`,
+		},
+		Action: ActionDeny,
+		Reason: "formatting or overwriting a drive destroys all of its data",
+	},
+	{
+		Name: "shell-recursive-delete",
+		Code: []string{
+			`(?i)\b(system|dos|unix)\s*\(\s*['"][^'"\n]*\b(rm\s+-[a-z]*r[a-z]*f|rm\s+-[a-z]*f[a-z]*r|rd\s+/s|rmdir\s+/s|del\s+/s)\b`,
+			`(?im)^\s*![^\n]*\b(rm\s+-[a-z]*r[a-z]*f|rm\s+-[a-z]*f[a-z]*r|rd\s+/s|rmdir\s+/s|del\s+/s)\b`,
+		},
+		Action: ActionConfirm,
+		Reason: "recursively deleting files from the shell cannot be undone",
+	},
+	{
+		Name: "rmpath-matlabroot",
+		Code: []string{
+			`\brmpath\s*\(\s*(genpath\s*\(\s*)?(matlabroot|fullfile\s*\(\s*matlabroot)`,
+			`\bpath\s*\(\s*['"]['"]\s*\)`,
+		},
+		Action: ActionDeny,
+		Reason: "removing the MATLAB folders from the search path breaks MATLAB for the rest of the session",
+	},
+}
+
+// compiledDangerousCalls are the dangerous calls that are not disabled.
+func compiledDangerousCalls(disabled []string) []compiledRule {
+	var compiled []compiledRule
+	for _, r := range dangerousCalls {
+		if slices.Contains(disabled, r.Name) {
+			continue
+		}
+		c, err := compileRule(r)
+		if err != nil {
+			panic(err)
+		}
+		compiled = append(compiled, c)
+	}
+	return compiled
+}
+
+// dangerousCallNames returns the names of the dangerous calls, in the order they are evaluated.
+func dangerousCallNames() []string {
+	names := make([]string, 0, len(dangerousCalls))
+	for _, r := range dangerousCalls {
+		names = append(names, r.Name)
+	}
+	return names
+}
@@ -0,0 +1,386 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package toolpolicy
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+)
+
+type Config interface {
+	PolicyFile() string
+	ManagedToolPolicy() []byte
+}
+
+type OSLayer interface {
+	ReadFile(name string) ([]byte, error)
+}
+
+type Action string
+
+const (
+	ActionAllow   Action = "allow"
+	ActionDeny    Action = "deny"
+	ActionConfirm Action = "confirm"
+)
+
+var strictness = map[Action]int{
+	ActionAllow:   0,
+	ActionConfirm: 1,
+	ActionDeny:    2,
+}
+
+// Call describes a tool call, as seen by the policy.
+type Call struct {
+	Tool  string
+	Paths []string
+	Code  string
+}
+
+// Decision is the outcome of the evaluation of a tool call against the policy.
+// Rule, Match and Line are set when a named rule, or a code pattern, decided.
+type Decision struct {
+	Action Action
+	Reason string
+	Rule   string
+	Match  string
+	Line   int
+}
+
+// Explanation describes the rule that decided, the code it matched, and its reason.
+func (d Decision) Explanation() string {
+	var explanation strings.Builder
+	if d.Rule != "" {
+		fmt.Fprintf(&explanation, "rule %q", d.Rule)
+	}
+	if d.Match != "" {
+		if explanation.Len() > 0 {
+			explanation.WriteString(" ")
+		}
+		fmt.Fprintf(&explanation, "matched %q on line %d", d.Match, d.Line)
+	}
+	if d.Reason != "" {
+		if explanation.Len() > 0 {
+			explanation.WriteString(": ")
+		}
+		explanation.WriteString(d.Reason)
+	}
+	return explanation.String()
+}
+
+type document struct {
+	Default               Action   `json:"default"`
+	Rules                 []rule   `json:"rules"`
+	DisableDangerousCalls []string `json:"disable-dangerous-calls"`
+}
+
+type rule struct {
+	Name   string   `json:"name"`
+	Tool   string   `json:"tool"`
+	Paths  []string `json:"paths"`
+	Code   []string `json:"code"`
+	Action Action   `json:"action"`
+	Reason string   `json:"reason"`
+}
+
+type compiledRule struct {
+	name   string
+	tool   string
+	paths  []*regexp.Regexp
+	code   []*regexp.Regexp
+	action Action
+	reason string
+}
+
+// Policy decides whether tool calls are allowed, denied, or require a confirmation from the user.
+// Rules are evaluated in order, and the first rule matching the call decides.
+// Without a policy file, every call is allowed, except for the dangerous calls.
+type Policy struct {
+	config  Config
+	osLayer OSLayer
+
+	lock                  *sync.RWMutex
+	defaultAction         Action
+	rules                 []compiledRule
+	managed               *Policy
+	dangerousCalls        []compiledRule
+	disableDangerousCalls []string
+}
+
+func New(
+	config Config,
+	osLayer OSLayer,
+) (*Policy, error) {
+	policy, err := load(config, osLayer)
+	if err != nil {
+		return nil, err
+	}
+
+	policy.config = config
+	policy.osLayer = osLayer
+	policy.lock = new(sync.RWMutex)
+	return policy, nil
+}
+
+// Reload reads the policy file named by the configuration again, such as when the file or the configuration changed.
+// The calls evaluated from then on are decided by the new rules. When the policy file is invalid, the policy in effect
+// is kept, and the error is returned.
+func (p *Policy) Reload() error {
+	policy, err := load(p.config, p.osLayer)
+	if err != nil {
+		return err
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.defaultAction = policy.defaultAction
+	p.rules = policy.rules
+	p.managed = policy.managed
+	p.dangerousCalls = policy.dangerousCalls
+	p.disableDangerousCalls = policy.disableDangerousCalls
+	return nil
+}
+
+func load(config Config, osLayer OSLayer) (*Policy, error) {
+	policy := &Policy{
+		defaultAction: ActionAllow,
+	}
+
+	if managedToolPolicy := config.ManagedToolPolicy(); managedToolPolicy != nil {
+		managed, err := parse(managedToolPolicy, "the managed policy")
+		if err != nil {
+			return nil, err
+		}
+		policy.managed = managed
+	}
+
+	policyFile := config.PolicyFile()
+	if policyFile == "" {
+		policy.dangerousCalls = compiledDangerousCalls(nil)
+		return policy, nil
+	}
+
+	data, err := osLayer.ReadFile(policyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy file: %w", err)
+	}
+
+	local, err := parse(data, "policy file "+policyFile)
+	if err != nil {
+		return nil, err
+	}
+	policy.defaultAction = local.defaultAction
+	policy.rules = local.rules
+	policy.dangerousCalls = compiledDangerousCalls(local.disableDangerousCalls)
+
+	return policy, nil
+}
+
+func parse(data []byte, source string) (*Policy, error) {
+	policy := &Policy{
+		defaultAction: ActionAllow,
+	}
+
+	var doc document
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", source, err)
+	}
+
+	if doc.Default != "" {
+		if err := validateAction(doc.Default); err != nil {
+			return nil, fmt.Errorf("invalid default in %s: %w", source, err)
+		}
+		policy.defaultAction = doc.Default
+	}
+
+	for i, r := range doc.Rules {
+		compiled, err := compileRule(r)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rule %d in %s: %w", i+1, source, err)
+		}
+		policy.rules = append(policy.rules, compiled)
+	}
+
+	for _, name := range doc.DisableDangerousCalls {
+		if !slices.Contains(dangerousCallNames(), name) {
+			return nil, fmt.Errorf("invalid disable-dangerous-calls in %s: unknown dangerous call %q, must be one of %s", source, name, strings.Join(dangerousCallNames(), ", "))
+		}
+	}
+	policy.disableDangerousCalls = doc.DisableDangerousCalls
+
+	return policy, nil
+}
+
+// Evaluate decides on a call. With a managed policy, both policies are evaluated, and the stricter decision wins.
+// The dangerous calls are evaluated last, and decide when they are at least as strict, so that the user learns which call was dangerous.
+func (p *Policy) Evaluate(call Call) Decision {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	decision := p.evaluateRules(call)
+	if p.managed != nil {
+		if managedDecision := p.managed.evaluateRules(call); strictness[managedDecision.Action] >= strictness[decision.Action] {
+			decision = managedDecision
+		}
+	}
+
+	if dangerousDecision, ok := evaluateDangerousCalls(p.dangerousCalls, call); ok && strictness[dangerousDecision.Action] >= strictness[decision.Action] {
+		decision = dangerousDecision
+	}
+
+	return decision
+}
+
+func (p *Policy) evaluateRules(call Call) Decision {
+	for _, r := range p.rules {
+		if decision, ok := r.evaluate(call); ok {
+			return decision
+		}
+	}
+
+	return Decision{Action: p.defaultAction}
+}
+
+// evaluateDangerousCalls returns the decision of the strictest dangerous call matching the call, and false if none matches.
+func evaluateDangerousCalls(rules []compiledRule, call Call) (Decision, bool) {
+	var decision Decision
+	found := false
+	for _, r := range rules {
+		if d, ok := r.evaluate(call); ok && (!found || strictness[d.Action] > strictness[decision.Action]) {
+			decision = d
+			found = true
+		}
+	}
+	return decision, found
+}
+
+// evaluate returns the decision of the rule, and false if it does not match the call.
+func (r compiledRule) evaluate(call Call) (Decision, bool) {
+	if matched, _ := path.Match(r.tool, call.Tool); !matched {
+		return Decision{}, false
+	}
+
+	if len(r.paths) > 0 && !anyPathMatches(r.paths, call.Paths) {
+		return Decision{}, false
+	}
+
+	decision := Decision{Action: r.action, Reason: r.reason, Rule: r.name}
+	if len(r.code) > 0 {
+		match, line, ok := firstMatch(r.code, call.Code)
+		if !ok {
+			return Decision{}, false
+		}
+		decision.Match = match
+		decision.Line = line
+	}
+
+	return decision, true
+}
+
+func anyPathMatches(patterns []*regexp.Regexp, paths []string) bool {
+	for _, p := range paths {
+		if anyMatches(patterns, filepath.ToSlash(filepath.Clean(p))) {
+			return true
+		}
+	}
+	return false
+}
+
+func anyMatches(patterns []*regexp.Regexp, value string) bool {
+	for _, pattern := range patterns {
+		if pattern.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// firstMatch returns the text matched by the first matching pattern, trimmed, with the line of the code where it starts.
+func firstMatch(patterns []*regexp.Regexp, code string) (string, int, bool) {
+	for _, pattern := range patterns {
+		if location := pattern.FindStringIndex(code); location != nil {
+			match := code[location[0]:location[1]]
+			start := location[0] + len(match) - len(strings.TrimLeft(match, " \t\r\n"))
+			return strings.TrimSpace(match), strings.Count(code[:start], "\n") + 1, true
+		}
+	}
+	return "", 0, false
+}
+
+func compileRule(r rule) (compiledRule, error) {
+	if err := validateAction(r.Action); err != nil {
+		return compiledRule{}, err
+	}
+
+	tool := r.Tool
+	if tool == "" {
+		tool = "*"
+	}
+	if _, err := path.Match(tool, ""); err != nil {
+		return compiledRule{}, fmt.Errorf("invalid tool pattern %q: %w", r.Tool, err)
+	}
+
+	compiled := compiledRule{
+		name:   r.Name,
+		tool:   tool,
+		action: r.Action,
+		reason: r.Reason,
+	}
+
+	for _, pattern := range r.Paths {
+		compiled.paths = append(compiled.paths, globToRegexp(pattern))
+	}
+
+	for _, pattern := range r.Code {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return compiledRule{}, fmt.Errorf("invalid code pattern %q: %w", pattern, err)
+		}
+		compiled.code = append(compiled.code, re)
+	}
+
+	return compiled, nil
+}
+
+func validateAction(action Action) error {
+	switch action {
+	case ActionAllow, ActionDeny, ActionConfirm:
+		return nil
+	default:
+		return fmt.Errorf("unknown action %q, must be one of %s, %s or %s", action, ActionAllow, ActionDeny, ActionConfirm)
+	}
+}
+
+// globToRegexp converts a path pattern to a regular expression.
+// `*` matches any sequence of characters within a path element, `**` matches across path elements, and `?` matches a single character.
+func globToRegexp(pattern string) *regexp.Regexp {
+	runes := []rune(filepath.ToSlash(pattern))
+
+	var builder strings.Builder
+	builder.WriteString("^")
+	for i := 0; i < len(runes); i++ {
+		switch c := runes[i]; c {
+		case '*':
+			if i+1 < len(runes) && runes[i+1] == '*' {
+				builder.WriteString(".*")
+				i++
+			} else {
+				builder.WriteString("[^/]*")
+			}
+		case '?':
+			builder.WriteString("[^/]")
+		default:
+			builder.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	builder.WriteString("$")
+
+	return regexp.MustCompile(builder.String())
+}
@@ -0,0 +1,635 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package toolpolicy_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/toolpolicy"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/toolpolicy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const policyFile = "/home/user/policy.json"
+
+func newPolicy(t *testing.T, document string) *toolpolicy.Policy {
+	t.Helper()
+
+	mockConfig := &mocks.MockConfig{}
+	t.Cleanup(func() { mockConfig.AssertExpectations(t) })
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	t.Cleanup(func() { mockOSLayer.AssertExpectations(t) })
+
+	mockConfig.EXPECT().
+		ManagedToolPolicy().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		PolicyFile().
+		Return(policyFile).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(policyFile).
+		Return([]byte(document), nil).
+		Once()
+
+	policy, err := toolpolicy.New(mockConfig, mockOSLayer)
+	require.NoError(t, err)
+	return policy
+}
+
+func TestNew_NoPolicyFileAllowsEverythingButDangerousCalls(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		ManagedToolPolicy().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		PolicyFile().
+		Return("").
+		Once()
+
+	policy, err := toolpolicy.New(mockConfig, mockOSLayer)
+	require.NoError(t, err)
+
+	// Act
+	allowedDecision := policy.Evaluate(toolpolicy.Call{Tool: "evaluate_matlab_code", Code: "delete('data.mat')"})
+	dangerousDecision := policy.Evaluate(toolpolicy.Call{Tool: "evaluate_matlab_code", Code: "delete('*')"})
+
+	// Assert
+	assert.Equal(t, toolpolicy.ActionAllow, allowedDecision.Action)
+	assert.Equal(t, toolpolicy.ActionConfirm, dangerousDecision.Action)
+	assert.Equal(t, "delete-wildcard", dangerousDecision.Rule)
+}
+
+func TestNew_ReadFileError(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	expectedError := fmt.Errorf("file not found")
+
+	mockConfig.EXPECT().
+		ManagedToolPolicy().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		PolicyFile().
+		Return(policyFile).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(policyFile).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	policy, err := toolpolicy.New(mockConfig, mockOSLayer)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Nil(t, policy)
+}
+
+func TestNew_InvalidPolicyFile(t *testing.T) {
+	testCases := []struct {
+		name          string
+		document      string
+		expectedError string
+	}{
+		{
+			name:          "not JSON",
+			document:      "rules:",
+			expectedError: "failed to parse policy file",
+		},
+		{
+			name:          "unknown default",
+			document:      `{"default": "maybe"}`,
+			expectedError: `invalid default in policy file /home/user/policy.json: unknown action "maybe"`,
+		},
+		{
+			name:          "missing action",
+			document:      `{"rules": [{"tool": "*"}]}`,
+			expectedError: `invalid rule 1 in policy file /home/user/policy.json: unknown action ""`,
+		},
+		{
+			name:          "invalid tool pattern",
+			document:      `{"rules": [{"tool": "[", "action": "deny"}]}`,
+			expectedError: `invalid rule 1 in policy file /home/user/policy.json: invalid tool pattern "["`,
+		},
+		{
+			name:          "unknown dangerous call",
+			document:      `{"disable-dangerous-calls": ["delete-everything"]}`,
+			expectedError: `invalid disable-dangerous-calls in policy file /home/user/policy.json: unknown dangerous call "delete-everything"`,
+		},
+		{
+			name:          "invalid code pattern",
+			document:      `{"rules": [{"tool": "*", "action": "allow"}, {"code": ["("], "action": "deny"}]}`,
+			expectedError: `invalid rule 2 in policy file /home/user/policy.json: invalid code pattern "("`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockConfig.EXPECT().
+				ManagedToolPolicy().
+				Return(nil).
+				Once()
+
+			mockConfig.EXPECT().
+				PolicyFile().
+				Return(policyFile).
+				Once()
+
+			mockOSLayer.EXPECT().
+				ReadFile(policyFile).
+				Return([]byte(testCase.document), nil).
+				Once()
+
+			// Act
+			policy, err := toolpolicy.New(mockConfig, mockOSLayer)
+
+			// Assert
+			require.ErrorContains(t, err, testCase.expectedError)
+			assert.Nil(t, policy)
+		})
+	}
+}
+
+func TestPolicy_Evaluate(t *testing.T) {
+	document := `{
+		"default": "deny",
+		"rules": [
+			{"tool": "evaluate_matlab_code", "code": ["\\bdelete\\s*\\(", "rmdir"], "action": "deny", "reason": "deleting files is not allowed"},
+			{"tool": "evaluate_matlab_code", "action": "confirm"},
+			{"tool": "run_matlab_*", "paths": ["/home/user/project/**"], "action": "allow"},
+			{"tool": "check_matlab_code", "paths": ["/home/user/*.m"], "action": "allow"},
+			{"tool": "detect_matlab_toolboxes", "action": "allow"}
+		]
+	}`
+
+	testCases := []struct {
+		name           string
+		call           toolpolicy.Call
+		expectedAction toolpolicy.Action
+		expectedReason string
+	}{
+		{
+			name:           "code matching a pattern",
+			call:           toolpolicy.Call{Tool: "evaluate_matlab_code", Code: "x = 1;\ndelete ('data.mat')"},
+			expectedAction: toolpolicy.ActionDeny,
+			expectedReason: "deleting files is not allowed",
+		},
+		{
+			name:           "second code pattern",
+			call:           toolpolicy.Call{Tool: "evaluate_matlab_code", Code: "rmdir('out')"},
+			expectedAction: toolpolicy.ActionDeny,
+			expectedReason: "deleting files is not allowed",
+		},
+		{
+			name:           "code not matching falls through to the next rule",
+			call:           toolpolicy.Call{Tool: "evaluate_matlab_code", Code: "x = undelete(1)"},
+			expectedAction: toolpolicy.ActionConfirm,
+		},
+		{
+			name:           "tool pattern and recursive path pattern",
+			call:           toolpolicy.Call{Tool: "run_matlab_test_file", Paths: []string{"/home/user/project/tests/unit/testA.m"}},
+			expectedAction: toolpolicy.ActionAllow,
+		},
+		{
+			name:           "path is cleaned before matching",
+			call:           toolpolicy.Call{Tool: "run_matlab_file", Paths: []string{"/home/user/project/../secret/script.m"}},
+			expectedAction: toolpolicy.ActionDeny,
+		},
+		{
+			name:           "single star does not cross folders",
+			call:           toolpolicy.Call{Tool: "check_matlab_code", Paths: []string{"/home/user/project/script.m"}},
+			expectedAction: toolpolicy.ActionDeny,
+		},
+		{
+			name:           "single star within a folder",
+			call:           toolpolicy.Call{Tool: "check_matlab_code", Paths: []string{"/home/user/script.m"}},
+			expectedAction: toolpolicy.ActionAllow,
+		},
+		{
+			name:           "rule with paths does not match a call without paths",
+			call:           toolpolicy.Call{Tool: "run_matlab_file"},
+			expectedAction: toolpolicy.ActionDeny,
+		},
+		{
+			name:           "tool without conditions",
+			call:           toolpolicy.Call{Tool: "detect_matlab_toolboxes"},
+			expectedAction: toolpolicy.ActionAllow,
+		},
+		{
+			name:           "no matching rule uses the default",
+			call:           toolpolicy.Call{Tool: "list_available_matlabs"},
+			expectedAction: toolpolicy.ActionDeny,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			policy := newPolicy(t, document)
+
+			// Act
+			decision := policy.Evaluate(testCase.call)
+
+			// Assert
+			assert.Equal(t, testCase.expectedAction, decision.Action)
+			assert.Equal(t, testCase.expectedReason, decision.Reason)
+		})
+	}
+}
+
+func TestPolicy_Evaluate_DefaultIsAllow(t *testing.T) {
+	// Arrange
+	policy := newPolicy(t, `{"rules": [{"tool": "evaluate_matlab_code", "action": "deny"}]}`)
+
+	// Act
+	decision := policy.Evaluate(toolpolicy.Call{Tool: "check_matlab_code"})
+
+	// Assert
+	assert.Equal(t, toolpolicy.ActionAllow, decision.Action)
+}
+
+func TestPolicy_Evaluate_ManagedPolicy(t *testing.T) {
+	managedDocument := `{
+		"rules": [
+			{"tool": "evaluate_matlab_code", "code": ["\\bsystem\\s*\\("], "action": "deny", "reason": "shell commands are not allowed on managed workstations"},
+			{"tool": "run_matlab_file", "action": "confirm", "reason": "scripts need a review"}
+		]
+	}`
+	localDocument := `{
+		"rules": [
+			{"tool": "run_matlab_file", "paths": ["/home/user/scratch/**"], "action": "deny", "reason": "scratch scripts are not run"},
+			{"tool": "evaluate_matlab_code", "action": "confirm"}
+		]
+	}`
+
+	testCases := []struct {
+		name           string
+		call           toolpolicy.Call
+		expectedAction toolpolicy.Action
+		expectedReason string
+	}{
+		{
+			name:           "managed deny wins over local confirm",
+			call:           toolpolicy.Call{Tool: "evaluate_matlab_code", Code: "system('ls')"},
+			expectedAction: toolpolicy.ActionDeny,
+			expectedReason: "shell commands are not allowed on managed workstations",
+		},
+		{
+			name:           "local deny wins over managed confirm",
+			call:           toolpolicy.Call{Tool: "run_matlab_file", Paths: []string{"/home/user/scratch/script.m"}},
+			expectedAction: toolpolicy.ActionDeny,
+			expectedReason: "scratch scripts are not run",
+		},
+		{
+			name:           "managed confirm wins over local allow",
+			call:           toolpolicy.Call{Tool: "run_matlab_file", Paths: []string{"/home/user/project/script.m"}},
+			expectedAction: toolpolicy.ActionConfirm,
+			expectedReason: "scripts need a review",
+		},
+		{
+			name:           "allowed by both",
+			call:           toolpolicy.Call{Tool: "check_matlab_code"},
+			expectedAction: toolpolicy.ActionAllow,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockConfig.EXPECT().
+				ManagedToolPolicy().
+				Return([]byte(managedDocument)).
+				Once()
+
+			mockConfig.EXPECT().
+				PolicyFile().
+				Return(policyFile).
+				Once()
+
+			mockOSLayer.EXPECT().
+				ReadFile(policyFile).
+				Return([]byte(localDocument), nil).
+				Once()
+
+			policy, err := toolpolicy.New(mockConfig, mockOSLayer)
+			require.NoError(t, err)
+
+			// Act
+			decision := policy.Evaluate(testCase.call)
+
+			// Assert
+			assert.Equal(t, testCase.expectedAction, decision.Action)
+			assert.Equal(t, testCase.expectedReason, decision.Reason)
+		})
+	}
+}
+
+func TestNew_InvalidManagedToolPolicy(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		ManagedToolPolicy().
+		Return([]byte(`{"default": "maybe"}`)).
+		Once()
+
+	// Act
+	policy, err := toolpolicy.New(mockConfig, mockOSLayer)
+
+	// Assert
+	require.ErrorContains(t, err, `invalid default in the managed policy: unknown action "maybe"`)
+	assert.Nil(t, policy)
+}
+
+func TestPolicy_Evaluate_DangerousCalls(t *testing.T) {
+	testCases := []struct {
+		name           string
+		code           string
+		expectedAction toolpolicy.Action
+		expectedRule   string
+		expectedMatch  string
+		expectedLine   int
+	}{
+		{
+			name:           "delete with a wildcard",
+			code:           "x = 1;\ndelete(fullfile(pwd, '*.mat'))",
+			expectedAction: toolpolicy.ActionConfirm,
+			expectedRule:   "delete-wildcard",
+			expectedMatch:  "delete(fullfile(pwd, '*.mat'",
+			expectedLine:   2,
+		},
+		{
+			name:           "delete command syntax with a wildcard",
+			code:           "x = 1;\n\n  delete *.mat",
+			expectedAction: toolpolicy.ActionConfirm,
+			expectedRule:   "delete-wildcard",
+			expectedMatch:  "delete *.mat",
+			expectedLine:   3,
+		},
+		{
+			name:           "rmdir of the parent folder",
+			code:           "rmdir('..', 's')",
+			expectedAction: toolpolicy.ActionDeny,
+			expectedRule:   "rmdir-parent-recursive",
+			expectedMatch:  "rmdir('..', 's')",
+			expectedLine:   1,
+		},
+		{
+			name:           "rmdir of the MATLAB root",
+			code:           "rmdir(matlabroot, 's')",
+			expectedAction: toolpolicy.ActionDeny,
+			expectedRule:   "rmdir-parent-recursive",
+			expectedMatch:  "rmdir(matlabroot, 's')",
+			expectedLine:   1,
+		},
+		{
+			name:           "recursive rmdir of another folder",
+			code:           "rmdir('results', 's')",
+			expectedAction: toolpolicy.ActionConfirm,
+			expectedRule:   "rmdir-recursive",
+			expectedMatch:  "rmdir('results', 's')",
+			expectedLine:   1,
+		},
+		{
+			name:           "format of a drive through system",
+			code:           "[status, out] = system('format D: /q')",
+			expectedAction: toolpolicy.ActionDeny,
+			expectedRule:   "format-drive",
+			expectedMatch:  "system('format D:",
+			expectedLine:   1,
+		},
+		{
+			name:           "format of a drive with the shell escape",
+			code:           "!mkfs.ext4 /dev/sdb1",
+			expectedAction: toolpolicy.ActionDeny,
+			expectedRule:   "format-drive",
+			expectedMatch:  "!mkfs.ext4",
+			expectedLine:   1,
+		},
+		{
+			name:           "recursive delete from the shell",
+			code:           "system('rm -rf build')",
+			expectedAction: toolpolicy.ActionConfirm,
+			expectedRule:   "shell-recursive-delete",
+			expectedMatch:  "system('rm -rf",
+			expectedLine:   1,
+		},
+		{
+			name:           "rmpath of the MATLAB root",
+			code:           "rmpath(genpath(matlabroot))",
+			expectedAction: toolpolicy.ActionDeny,
+			expectedRule:   "rmpath-matlabroot",
+			expectedMatch:  "rmpath(genpath(matlabroot",
+			expectedLine:   1,
+		},
+		{
+			name:           "stricter dangerous call wins",
+			code:           "delete('*.txt');\nrmdir('/', 's')",
+			expectedAction: toolpolicy.ActionDeny,
+			expectedRule:   "rmdir-parent-recursive",
+			expectedMatch:  "rmdir('/', 's')",
+			expectedLine:   2,
+		},
+		{
+			name:           "safe code",
+			code:           "delete('data.mat');\nrmdir('empty');\nrmpath('/home/user/tools')",
+			expectedAction: toolpolicy.ActionAllow,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			policy := newPolicy(t, `{}`)
+
+			// Act
+			decision := policy.Evaluate(toolpolicy.Call{Tool: "evaluate_matlab_code", Code: testCase.code})
+
+			// Assert
+			assert.Equal(t, testCase.expectedAction, decision.Action)
+			assert.Equal(t, testCase.expectedRule, decision.Rule)
+			assert.Equal(t, testCase.expectedMatch, decision.Match)
+			assert.Equal(t, testCase.expectedLine, decision.Line)
+		})
+	}
+}
+
+func TestPolicy_Evaluate_DangerousCallsOverLocalRules(t *testing.T) {
+	// Arrange
+	policy := newPolicy(t, `{"rules": [{"tool": "evaluate_matlab_code", "action": "allow"}], "disable-dangerous-calls": ["rmdir-recursive"]}`)
+
+	// Act
+	deniedDecision := policy.Evaluate(toolpolicy.Call{Tool: "evaluate_matlab_code", Code: "rmpath(matlabroot)"})
+	disabledDecision := policy.Evaluate(toolpolicy.Call{Tool: "evaluate_matlab_code", Code: "rmdir('results', 's')"})
+
+	// Assert
+	assert.Equal(t, toolpolicy.ActionDeny, deniedDecision.Action, "Rules allowing a call should not allow dangerous calls")
+	assert.Equal(t, toolpolicy.ActionAllow, disabledDecision.Action, "Disabled dangerous calls should not be evaluated")
+}
+
+func TestPolicy_Evaluate_NamedRule(t *testing.T) {
+	// Arrange
+	policy := newPolicy(t, `{"rules": [{"name": "no-clear-all", "code": ["\\bclear\\s+all\\b"], "action": "deny", "reason": "clearing everything loses the work of the session"}]}`)
+
+	// Act
+	decision := policy.Evaluate(toolpolicy.Call{Tool: "evaluate_matlab_code", Code: "x = 1;\nclear all"})
+
+	// Assert
+	assert.Equal(t, toolpolicy.ActionDeny, decision.Action)
+	assert.Equal(t, `rule "no-clear-all" matched "clear all" on line 2: clearing everything loses the work of the session`, decision.Explanation())
+}
+
+func TestPolicy_Reload_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		ManagedToolPolicy().
+		Return(nil).
+		Twice()
+
+	mockConfig.EXPECT().
+		PolicyFile().
+		Return(policyFile).
+		Twice()
+
+	mockOSLayer.EXPECT().
+		ReadFile(policyFile).
+		Return([]byte(`{"rules": [{"tool": "evaluate_matlab_code", "action": "allow"}]}`), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(policyFile).
+		Return([]byte(`{"rules": [{"tool": "evaluate_matlab_code", "action": "deny", "reason": "no code"}]}`), nil).
+		Once()
+
+	policy, err := toolpolicy.New(mockConfig, mockOSLayer)
+	require.NoError(t, err)
+
+	// Act
+	err = policy.Reload()
+
+	// Assert
+	require.NoError(t, err)
+	decision := policy.Evaluate(toolpolicy.Call{Tool: "evaluate_matlab_code", Code: "x = 1;"})
+	assert.Equal(t, toolpolicy.ActionDeny, decision.Action, "Calls should be decided by the reloaded rules")
+	assert.Equal(t, "no code", decision.Reason)
+}
+
+func TestPolicy_Reload_InvalidPolicyFileKeepsPolicy(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		ManagedToolPolicy().
+		Return(nil).
+		Twice()
+
+	mockConfig.EXPECT().
+		PolicyFile().
+		Return(policyFile).
+		Twice()
+
+	mockOSLayer.EXPECT().
+		ReadFile(policyFile).
+		Return([]byte(`{"rules": [{"tool": "evaluate_matlab_code", "action": "deny"}]}`), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(policyFile).
+		Return([]byte(`{"rules": [`), nil).
+		Once()
+
+	policy, err := toolpolicy.New(mockConfig, mockOSLayer)
+	require.NoError(t, err)
+
+	// Act
+	err = policy.Reload()
+
+	// Assert
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to parse policy file")
+	decision := policy.Evaluate(toolpolicy.Call{Tool: "evaluate_matlab_code", Code: "x = 1;"})
+	assert.Equal(t, toolpolicy.ActionDeny, decision.Action, "The policy in effect should be kept")
+}
+
+func TestDecision_Explanation(t *testing.T) {
+	testCases := []struct {
+		name     string
+		decision toolpolicy.Decision
+		expected string
+	}{
+		{
+			name:     "reason only",
+			decision: toolpolicy.Decision{Action: toolpolicy.ActionDeny, Reason: "scripts must be reviewed first"},
+			expected: "scripts must be reviewed first",
+		},
+		{
+			name:     "match without a name",
+			decision: toolpolicy.Decision{Action: toolpolicy.ActionDeny, Match: "system(", Line: 3},
+			expected: `matched "system(" on line 3`,
+		},
+		{
+			name:     "nothing",
+			decision: toolpolicy.Decision{Action: toolpolicy.ActionDeny},
+			expected: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Act
+			explanation := testCase.decision.Explanation()
+
+			// Assert
+			assert.Equal(t, testCase.expected, explanation)
+		})
+	}
+}
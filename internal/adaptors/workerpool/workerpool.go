@@ -0,0 +1,202 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package workerpool
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/tracing"
+)
+
+const (
+	defaultReadyTimeout = 2 * time.Minute
+	defaultReadyRetry   = 500 * time.Millisecond
+)
+
+type Config interface {
+	WorkerPoolSize() int
+}
+
+type MATLABManager interface {
+	StartMATLABSession(ctx context.Context, sessionLogger entities.Logger, startRequest entities.SessionDetails) (entities.SessionID, error)
+	GetMATLABSessionClient(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) (entities.MATLABSessionClient, error)
+	StopMATLABSession(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) error
+}
+
+type MATLABRootSelector interface {
+	SelectFirstMATLABVersionOnPath(ctx context.Context, logger entities.Logger) (string, error)
+}
+
+type MATLABStartingDirSelector interface {
+	SelectMatlabStartingDir() (string, error)
+}
+
+// WorkerPool runs read-only tools on auxiliary MATLAB sessions, the workers, so that a quick lookup does not wait for
+// a long simulation in the global MATLAB session. Workers are started on first use, up to the size of the pool, and
+// each worker runs one call at a time. Without workers, every call runs in the global MATLAB session.
+type WorkerPool struct {
+	size                      int
+	globalMATLAB              entities.GlobalMATLAB
+	matlabManager             MATLABManager
+	matlabRootSelector        MATLABRootSelector
+	matlabStartingDirSelector MATLABStartingDirSelector
+
+	readyTimeout time.Duration
+	readyRetry   time.Duration
+
+	lock    sync.Mutex
+	started int
+	idle    chan entities.MATLABSessionClient
+}
+
+func New(
+	config Config,
+	globalMATLAB entities.GlobalMATLAB,
+	matlabManager MATLABManager,
+	matlabRootSelector MATLABRootSelector,
+	matlabStartingDirSelector MATLABStartingDirSelector,
+) *WorkerPool {
+	size := config.WorkerPoolSize()
+
+	return &WorkerPool{
+		size:                      size,
+		globalMATLAB:              globalMATLAB,
+		matlabManager:             matlabManager,
+		matlabRootSelector:        matlabRootSelector,
+		matlabStartingDirSelector: matlabStartingDirSelector,
+
+		readyTimeout: defaultReadyTimeout,
+		readyRetry:   defaultReadyRetry,
+
+		idle: make(chan entities.MATLABSessionClient, size),
+	}
+}
+
+// Client leases an idle worker, starts a new one if the pool is not full, or waits for a worker to be released.
+func (p *WorkerPool) Client(ctx context.Context, logger entities.Logger) (entities.MATLABSessionClient, func(), error) {
+	if p.size == 0 {
+		client, err := p.globalMATLAB.Client(ctx, logger)
+		return client, func() {}, err
+	}
+
+	select {
+	case client := <-p.idle:
+		return client, p.releaser(client), nil
+	default:
+	}
+
+	if p.reserve() {
+		client, err := p.startWorker(ctx, logger)
+		if err != nil {
+			p.unreserve()
+			return nil, nil, err
+		}
+		return client, p.releaser(client), nil
+	}
+
+	logger.Debug("Waiting for a MATLAB worker to be released")
+	_, span := tracing.Start(ctx, "matlab worker_wait", tracing.SpanKindInternal, tracing.Int("matlab.worker_pool.size", p.size))
+	defer span.End()
+
+	select {
+	case client := <-p.idle:
+		return client, p.releaser(client), nil
+	case <-ctx.Done():
+		span.RecordError(ctx.Err())
+		return nil, nil, ctx.Err()
+	}
+}
+
+func (p *WorkerPool) reserve() bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.started >= p.size {
+		return false
+	}
+	p.started++
+	return true
+}
+
+func (p *WorkerPool) unreserve() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.started--
+}
+
+func (p *WorkerPool) releaser(client entities.MATLABSessionClient) func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			p.idle <- client
+		})
+	}
+}
+
+func (p *WorkerPool) startWorker(ctx context.Context, logger entities.Logger) (entities.MATLABSessionClient, error) {
+	matlabRoot, err := p.matlabRootSelector.SelectFirstMATLABVersionOnPath(ctx, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	startingDir, err := p.matlabStartingDirSelector.SelectMatlabStartingDir()
+	if err != nil {
+		logger.WithError(err).Warn("failed to determine MATLAB starting directory for the worker, proceeding without one")
+	}
+
+	logger = logger.With("matlab_root", matlabRoot)
+	logger.Info("Starting MATLAB worker")
+
+	sessionID, err := p.matlabManager.StartMATLABSession(ctx, logger, entities.LocalSessionDetails{
+		MATLABRoot:        matlabRoot,
+		StartingDirectory: startingDir,
+		ShowMATLABDesktop: false,
+	})
+	if err != nil {
+		if entities.ErrorCodeOf(err) == entities.ErrorCodeInternal {
+			err = entities.NewCodedError(entities.ErrorCodeMATLABStartFailed, err)
+		}
+		return nil, err
+	}
+
+	logger = logger.With("session_id", sessionID)
+
+	client, err := p.matlabManager.GetMATLABSessionClient(ctx, logger, sessionID)
+	if err == nil {
+		err = p.waitForReady(ctx, logger, client)
+	}
+	if err != nil {
+		if stopErr := p.matlabManager.StopMATLABSession(context.WithoutCancel(ctx), logger, sessionID); stopErr != nil {
+			logger.WithError(stopErr).Warn("Failed to stop MATLAB worker")
+		}
+		return nil, err
+	}
+
+	logger.Info("MATLAB worker ready")
+	return client, nil
+}
+
+// waitForReady evaluates a trivial expression until the embedded connector of the worker accepts requests.
+func (p *WorkerPool) waitForReady(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) error {
+	readyCtx, cancel := context.WithTimeout(ctx, p.readyTimeout)
+	defer cancel()
+
+	for {
+		_, err := client.Eval(readyCtx, logger, entities.EvalRequest{Code: "1+1"})
+		if err == nil {
+			return nil
+		}
+		logger.WithError(err).Debug("MATLAB worker not ready yet, will retry")
+
+		select {
+		case <-readyCtx.Done():
+			return entities.NewCodedError(entities.ErrorCodeMATLABStartFailed, fmt.Errorf("MATLAB worker not ready: %w", err))
+		case <-time.After(p.readyRetry):
+		}
+	}
+}
@@ -0,0 +1,10 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package workerpool
+
+import "time"
+
+func (p *WorkerPool) SetReadyRetry(timeout time.Duration, retry time.Duration) {
+	p.readyTimeout = timeout
+	p.readyRetry = retry
+}
@@ -0,0 +1,569 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package workerpool_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/workerpool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/workerpool"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const matlabRoot = "/path/to/matlab"
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
+	defer mockMATLABStartingDirSelector.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		WorkerPoolSize().
+		Return(2).
+		Once()
+
+	// Act
+	pool := workerpool.New(mockConfig, mockGlobalMATLAB, mockMATLABManager, mockMATLABRootSelector, mockMATLABStartingDirSelector)
+
+	// Assert
+	assert.NotNil(t, pool)
+}
+
+func TestWorkerPool_Client_NoWorkersUsesGlobalMATLAB(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
+	defer mockMATLABStartingDirSelector.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		WorkerPoolSize().
+		Return(0).
+		Once()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(t.Context(), mockLogger.AsMockArg()).
+		Return(mockClient, nil).
+		Once()
+
+	pool := workerpool.New(mockConfig, mockGlobalMATLAB, mockMATLABManager, mockMATLABRootSelector, mockMATLABStartingDirSelector)
+	pool.SetReadyRetry(100*time.Millisecond, time.Millisecond)
+
+	// Act
+	client, release, err := pool.Client(t.Context(), mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, mockClient, client)
+	release()
+}
+
+func TestWorkerPool_Client_ReusesReleasedWorker(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
+	defer mockMATLABStartingDirSelector.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		WorkerPoolSize().
+		Return(2).
+		Once()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABRootSelector.EXPECT().
+		SelectFirstMATLABVersionOnPath(mock.Anything, mock.Anything).
+		Return(matlabRoot, nil).
+		Once()
+
+	mockMATLABStartingDirSelector.EXPECT().
+		SelectMatlabStartingDir().
+		Return("", nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		StartMATLABSession(mock.Anything, mock.Anything, entities.LocalSessionDetails{MATLABRoot: matlabRoot}).
+		Return(entities.SessionID(1), nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(mock.Anything, mock.Anything, entities.SessionID(1)).
+		Return(mockClient, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mock.Anything, entities.EvalRequest{Code: "1+1"}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	pool := workerpool.New(mockConfig, mockGlobalMATLAB, mockMATLABManager, mockMATLABRootSelector, mockMATLABStartingDirSelector)
+	pool.SetReadyRetry(100*time.Millisecond, time.Millisecond)
+
+	client, release, err := pool.Client(t.Context(), mockLogger)
+	require.NoError(t, err)
+	assert.Equal(t, mockClient, client)
+	release()
+	release()
+
+	// Act
+	client, release, err = pool.Client(t.Context(), mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, mockClient, client, "Released worker should be reused instead of starting a new one")
+	release()
+}
+
+func TestWorkerPool_Client_StartsWorkersConcurrently(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
+	defer mockMATLABStartingDirSelector.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		WorkerPoolSize().
+		Return(2).
+		Once()
+
+	firstClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer firstClient.AssertExpectations(t)
+
+	secondClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer secondClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABRootSelector.EXPECT().
+		SelectFirstMATLABVersionOnPath(mock.Anything, mock.Anything).
+		Return(matlabRoot, nil).
+		Once()
+
+	mockMATLABStartingDirSelector.EXPECT().
+		SelectMatlabStartingDir().
+		Return("", nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		StartMATLABSession(mock.Anything, mock.Anything, entities.LocalSessionDetails{MATLABRoot: matlabRoot}).
+		Return(entities.SessionID(1), nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(mock.Anything, mock.Anything, entities.SessionID(1)).
+		Return(firstClient, nil).
+		Once()
+
+	firstClient.EXPECT().
+		Eval(mock.Anything, mock.Anything, entities.EvalRequest{Code: "1+1"}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	pool := workerpool.New(mockConfig, mockGlobalMATLAB, mockMATLABManager, mockMATLABRootSelector, mockMATLABStartingDirSelector)
+	pool.SetReadyRetry(100*time.Millisecond, time.Millisecond)
+
+	client, release, err := pool.Client(t.Context(), mockLogger)
+	require.NoError(t, err)
+	require.Equal(t, firstClient, client)
+	defer release()
+
+	mockMATLABRootSelector.EXPECT().
+		SelectFirstMATLABVersionOnPath(mock.Anything, mock.Anything).
+		Return(matlabRoot, nil).
+		Once()
+
+	mockMATLABStartingDirSelector.EXPECT().
+		SelectMatlabStartingDir().
+		Return("", nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		StartMATLABSession(mock.Anything, mock.Anything, entities.LocalSessionDetails{MATLABRoot: matlabRoot}).
+		Return(entities.SessionID(2), nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(mock.Anything, mock.Anything, entities.SessionID(2)).
+		Return(secondClient, nil).
+		Once()
+
+	secondClient.EXPECT().
+		Eval(mock.Anything, mock.Anything, entities.EvalRequest{Code: "1+1"}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	// Act
+	client, secondRelease, err := pool.Client(t.Context(), mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, secondClient, client, "Busy worker should not be leased twice")
+	secondRelease()
+}
+
+func TestWorkerPool_Client_WaitsForReleaseWhenFull(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
+	defer mockMATLABStartingDirSelector.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		WorkerPoolSize().
+		Return(1).
+		Once()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABRootSelector.EXPECT().
+		SelectFirstMATLABVersionOnPath(mock.Anything, mock.Anything).
+		Return(matlabRoot, nil).
+		Once()
+
+	mockMATLABStartingDirSelector.EXPECT().
+		SelectMatlabStartingDir().
+		Return("", nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		StartMATLABSession(mock.Anything, mock.Anything, entities.LocalSessionDetails{MATLABRoot: matlabRoot}).
+		Return(entities.SessionID(1), nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(mock.Anything, mock.Anything, entities.SessionID(1)).
+		Return(mockClient, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mock.Anything, entities.EvalRequest{Code: "1+1"}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	pool := workerpool.New(mockConfig, mockGlobalMATLAB, mockMATLABManager, mockMATLABRootSelector, mockMATLABStartingDirSelector)
+	pool.SetReadyRetry(100*time.Millisecond, time.Millisecond)
+
+	_, release, err := pool.Client(t.Context(), mockLogger)
+	require.NoError(t, err)
+
+	type lease struct {
+		client entities.MATLABSessionClient
+		err    error
+	}
+	leased := make(chan lease)
+
+	// Act
+	go func() {
+		client, secondRelease, err := pool.Client(t.Context(), mockLogger)
+		if err == nil {
+			secondRelease()
+		}
+		leased <- lease{client: client, err: err}
+	}()
+
+	select {
+	case <-leased:
+		t.Fatal("Worker should not be leased before it is released")
+	case <-time.After(50 * time.Millisecond):
+	}
+	release()
+
+	// Assert
+	result := <-leased
+	require.NoError(t, result.err)
+	assert.Equal(t, mockClient, result.client)
+}
+
+func TestWorkerPool_Client_WaitCancelled(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
+	defer mockMATLABStartingDirSelector.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		WorkerPoolSize().
+		Return(1).
+		Once()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABRootSelector.EXPECT().
+		SelectFirstMATLABVersionOnPath(mock.Anything, mock.Anything).
+		Return(matlabRoot, nil).
+		Once()
+
+	mockMATLABStartingDirSelector.EXPECT().
+		SelectMatlabStartingDir().
+		Return("", nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		StartMATLABSession(mock.Anything, mock.Anything, entities.LocalSessionDetails{MATLABRoot: matlabRoot}).
+		Return(entities.SessionID(1), nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(mock.Anything, mock.Anything, entities.SessionID(1)).
+		Return(mockClient, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mock.Anything, entities.EvalRequest{Code: "1+1"}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	pool := workerpool.New(mockConfig, mockGlobalMATLAB, mockMATLABManager, mockMATLABRootSelector, mockMATLABStartingDirSelector)
+	pool.SetReadyRetry(100*time.Millisecond, time.Millisecond)
+
+	_, release, err := pool.Client(t.Context(), mockLogger)
+	require.NoError(t, err)
+	defer release()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	// Act
+	client, _, err := pool.Client(ctx, mockLogger)
+
+	// Assert
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, client)
+}
+
+func TestWorkerPool_Client_StartFailed(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
+	defer mockMATLABStartingDirSelector.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		WorkerPoolSize().
+		Return(1).
+		Once()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABRootSelector.EXPECT().
+		SelectFirstMATLABVersionOnPath(mock.Anything, mock.Anything).
+		Return(matlabRoot, nil).
+		Once()
+
+	mockMATLABStartingDirSelector.EXPECT().
+		SelectMatlabStartingDir().
+		Return("", nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		StartMATLABSession(mock.Anything, mock.Anything, entities.LocalSessionDetails{MATLABRoot: matlabRoot}).
+		Return(entities.SessionID(0), assert.AnError).
+		Once()
+
+	pool := workerpool.New(mockConfig, mockGlobalMATLAB, mockMATLABManager, mockMATLABRootSelector, mockMATLABStartingDirSelector)
+	pool.SetReadyRetry(100*time.Millisecond, time.Millisecond)
+
+	// Act
+	client, _, err := pool.Client(t.Context(), mockLogger)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Equal(t, entities.ErrorCodeMATLABStartFailed, entities.ErrorCodeOf(err))
+	assert.Nil(t, client)
+
+	// Act + Assert to check the failed worker does not count against the size of the pool
+	mockMATLABRootSelector.EXPECT().
+		SelectFirstMATLABVersionOnPath(mock.Anything, mock.Anything).
+		Return(matlabRoot, nil).
+		Once()
+
+	mockMATLABStartingDirSelector.EXPECT().
+		SelectMatlabStartingDir().
+		Return("", nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		StartMATLABSession(mock.Anything, mock.Anything, entities.LocalSessionDetails{MATLABRoot: matlabRoot}).
+		Return(entities.SessionID(2), nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(mock.Anything, mock.Anything, entities.SessionID(2)).
+		Return(mockClient, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mock.Anything, entities.EvalRequest{Code: "1+1"}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	client, release, err := pool.Client(t.Context(), mockLogger)
+	require.NoError(t, err)
+	assert.Equal(t, mockClient, client)
+	release()
+}
+
+func TestWorkerPool_Client_WorkerNeverReady(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
+	defer mockMATLABStartingDirSelector.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		WorkerPoolSize().
+		Return(1).
+		Once()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABRootSelector.EXPECT().
+		SelectFirstMATLABVersionOnPath(mock.Anything, mock.Anything).
+		Return(matlabRoot, nil).
+		Once()
+
+	mockMATLABStartingDirSelector.EXPECT().
+		SelectMatlabStartingDir().
+		Return("", nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		StartMATLABSession(mock.Anything, mock.Anything, entities.LocalSessionDetails{MATLABRoot: matlabRoot}).
+		Return(entities.SessionID(1), nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(mock.Anything, mock.Anything, entities.SessionID(1)).
+		Return(mockClient, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mock.Anything, entities.EvalRequest{Code: "1+1"}).
+		Return(entities.EvalResponse{}, assert.AnError)
+
+	mockMATLABManager.EXPECT().
+		StopMATLABSession(mock.Anything, mock.Anything, entities.SessionID(1)).
+		Return(nil).
+		Once()
+
+	pool := workerpool.New(mockConfig, mockGlobalMATLAB, mockMATLABManager, mockMATLABRootSelector, mockMATLABStartingDirSelector)
+	pool.SetReadyRetry(100*time.Millisecond, time.Millisecond)
+
+	// Act
+	client, _, err := pool.Client(t.Context(), mockLogger)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Equal(t, entities.ErrorCodeMATLABStartFailed, entities.ErrorCodeOf(err))
+	assert.Nil(t, client)
+}
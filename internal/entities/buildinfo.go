@@ -0,0 +1,19 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package entities
+
+import "fmt"
+
+// BuildInfo describes the build of the server, so that bug reports and compatibility checks can name the exact binary.
+type BuildInfo struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildDate string `json:"buildDate"`
+	GoVersion string `json:"goVersion"`
+	OS        string `json:"os"`
+	Arch      string `json:"arch"`
+}
+
+func (b BuildInfo) String() string {
+	return fmt.Sprintf("%s (commit %s, built %s, %s %s/%s)", b.Version, b.Commit, b.BuildDate, b.GoVersion, b.OS, b.Arch)
+}
@@ -0,0 +1,51 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package entities
+
+// Shell is a command-line shell the completion command generates completions for.
+type Shell string
+
+const (
+	ShellBash       Shell = "bash"
+	ShellZsh        Shell = "zsh"
+	ShellFish       Shell = "fish"
+	ShellPowerShell Shell = "powershell"
+)
+
+// Shells are the shells the completion command supports.
+var Shells = []Shell{ShellBash, ShellZsh, ShellFish, ShellPowerShell}
+
+// CLICommand is a command of the command line, as offered by the shell completions.
+type CLICommand struct {
+	Name        string
+	Description string
+	// Args are the values offered for the arguments of the command, if any.
+	Args []string
+}
+
+// CLICompletion is how the value of a flag is completed.
+type CLICompletion string
+
+const (
+	// CLICompletionNone is for boolean flags, which are set without a value.
+	CLICompletionNone CLICompletion = "none"
+	// CLICompletionText is for flags taking any value, which cannot be completed.
+	CLICompletionText CLICompletion = "text"
+	// CLICompletionValues is for flags taking one of the values of the flag.
+	CLICompletionValues CLICompletion = "values"
+	CLICompletionFile   CLICompletion = "file"
+	CLICompletionFolder CLICompletion = "folder"
+	// CLICompletionMATLABRoot is for flags taking a MATLAB root, completed with the MATLAB installations found on the machine.
+	CLICompletionMATLABRoot CLICompletion = "matlab-root"
+)
+
+// CLIFlag is a flag of the command line, as offered by the shell completions.
+type CLIFlag struct {
+	Name        string
+	Description string
+	Completion  CLICompletion
+	// Values are the values offered for a flag completed with CLICompletionValues.
+	Values []string
+	// Repeatable is true when the flag can be set more than once.
+	Repeatable bool
+}
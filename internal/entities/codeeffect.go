@@ -0,0 +1,22 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package entities
+
+// CodeEffectKind is a kind of change that MATLAB code makes outside of its workspace.
+type CodeEffectKind string
+
+const (
+	CodeEffectWritesFiles         CodeEffectKind = "writes files"
+	CodeEffectDeletesFiles        CodeEffectKind = "deletes files"
+	CodeEffectChangesFolder       CodeEffectKind = "changes the working folder"
+	CodeEffectChangesPath         CodeEffectKind = "changes the MATLAB path"
+	CodeEffectInstallsAddOns      CodeEffectKind = "installs or removes add-ons"
+	CodeEffectEditsSimulinkModels CodeEffectKind = "edits Simulink models"
+)
+
+// CodeEffect is a statement of MATLAB code which changes files, the MATLAB path, add-ons or Simulink models.
+type CodeEffect struct {
+	Kind      CodeEffectKind
+	Line      int
+	Statement string
+}
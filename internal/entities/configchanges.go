@@ -0,0 +1,16 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package entities
+
+// ConfigChanges are the settings changed by a reload of the configuration, by the name of their argument.
+// Applied settings are in effect once the configuration is reloaded, while the settings requiring a restart only take
+// effect once the server restarts.
+type ConfigChanges struct {
+	Applied          []string
+	RequiringRestart []string
+}
+
+// Empty is true when the reload changed no setting.
+func (c ConfigChanges) Empty() bool {
+	return len(c.Applied) == 0 && len(c.RequiringRestart) == 0
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package entities
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrorCode is a stable, machine-readable classification of a failure.
+// Codes are part of the public interface of the server: never rename or remove one, only add new ones.
+type ErrorCode string
+
+const (
+	ErrorCodeMATLABNotFound     ErrorCode = "MATLAB_NOT_FOUND"
+	ErrorCodeMATLABStartFailed  ErrorCode = "MATLAB_START_FAILED"
+	ErrorCodeLicenseUnavailable ErrorCode = "LICENSE_UNAVAILABLE"
+	ErrorCodeSessionNotFound    ErrorCode = "SESSION_NOT_FOUND"
+	ErrorCodeSessionCrashed     ErrorCode = "SESSION_CRASHED"
+	ErrorCodeEvalTimeout        ErrorCode = "EVAL_TIMEOUT"
+	ErrorCodeCancelled          ErrorCode = "CANCELLED"
+	ErrorCodeSyntaxError        ErrorCode = "SYNTAX_ERROR"
+	ErrorCodeMATLABError        ErrorCode = "MATLAB_ERROR"
+	ErrorCodeInvalidInput       ErrorCode = "INVALID_INPUT"
+	ErrorCodePermissionDenied   ErrorCode = "PERMISSION_DENIED"
+	ErrorCodePolicyViolation    ErrorCode = "POLICY_VIOLATION"
+	ErrorCodeLimitExceeded      ErrorCode = "LIMIT_EXCEEDED"
+	ErrorCodeRateLimited        ErrorCode = "RATE_LIMITED"
+	ErrorCodeShuttingDown       ErrorCode = "SHUTTING_DOWN"
+	ErrorCodePluginError        ErrorCode = "PLUGIN_ERROR"
+	ErrorCodeDownstreamError    ErrorCode = "DOWNSTREAM_ERROR"
+	ErrorCodeInternal           ErrorCode = "INTERNAL_ERROR"
+)
+
+// ErrorCoder is implemented by errors that know their own ErrorCode.
+type ErrorCoder interface {
+	ErrorCode() ErrorCode
+}
+
+// CodedError attaches an ErrorCode to an error, without changing its message.
+type CodedError struct {
+	code ErrorCode
+	err  error
+}
+
+func NewCodedError(code ErrorCode, err error) *CodedError {
+	return &CodedError{
+		code: code,
+		err:  err,
+	}
+}
+
+func (e *CodedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *CodedError) Unwrap() error {
+	return e.err
+}
+
+func (e *CodedError) ErrorCode() ErrorCode {
+	return e.code
+}
+
+// ErrorCodeOf returns the code of the outermost error in the chain that has one.
+// Errors without a code are classified as timeouts, cancellations, or internal errors.
+func ErrorCodeOf(err error) ErrorCode {
+	var coder ErrorCoder
+	if errors.As(err, &coder) {
+		return coder.ErrorCode()
+	}
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return ErrorCodeEvalTimeout
+	case errors.Is(err, context.Canceled):
+		return ErrorCodeCancelled
+	default:
+		return ErrorCodeInternal
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package entities
+
+import "time"
+
+type EventKind string
+
+const (
+	EventKindServerStarted            EventKind = "server-started"
+	EventKindServerStopping           EventKind = "server-stopping"
+	EventKindInstanceTakeover         EventKind = "instance-takeover"
+	EventKindMATLABSessionStarted     EventKind = "matlab-session-started"
+	EventKindMATLABSessionStartFailed EventKind = "matlab-session-start-failed"
+	EventKindToolCalled               EventKind = "tool-called"
+	EventKindToolCallFailed           EventKind = "tool-call-failed"
+	EventKindPolicyViolation          EventKind = "policy-violation"
+	EventKindMATLABMemoryWarning      EventKind = "matlab-memory-warning"
+	EventKindMATLABMemoryRestart      EventKind = "matlab-memory-restart"
+	EventKindMATLABSessionCrashed     EventKind = "matlab-session-crashed"
+	EventKindMATLABSessionRestarted   EventKind = "matlab-session-restarted"
+	EventKindMATLABRestartFailed      EventKind = "matlab-restart-failed"
+)
+
+// Event is a notable occurrence in the lifetime of the server, kept so users can find out what just happened without reading log files.
+type Event struct {
+	Time    time.Time      `json:"time"`
+	Kind    EventKind      `json:"kind"`
+	Message string         `json:"message"`
+	Details map[string]any `json:"details,omitempty"`
+}
@@ -0,0 +1,69 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package entities
+
+import "errors"
+
+// ExitCode is the exit code of the server process, so that scripts and IDE extensions starting the server can react
+// to its failures without parsing its logs.
+// Codes are part of the public interface of the server: never change the meaning of one, only add new ones.
+type ExitCode int
+
+const (
+	ExitCodeSuccess             ExitCode = 0
+	ExitCodeFailure             ExitCode = 1
+	ExitCodeConfigError         ExitCode = 2
+	ExitCodeAlreadyRunning      ExitCode = 3
+	ExitCodeMATLABNotFound      ExitCode = 4
+	ExitCodeTransportBindFailed ExitCode = 5
+)
+
+// ExitCoder is implemented by errors that know the exit code of the process they stop.
+type ExitCoder interface {
+	ExitCode() ExitCode
+}
+
+// ExitError attaches an ExitCode to an error, without changing its message.
+type ExitError struct {
+	code ExitCode
+	err  error
+}
+
+func NewExitError(code ExitCode, err error) *ExitError {
+	return &ExitError{
+		code: code,
+		err:  err,
+	}
+}
+
+func (e *ExitError) Error() string {
+	return e.err.Error()
+}
+
+func (e *ExitError) Unwrap() error {
+	return e.err
+}
+
+func (e *ExitError) ExitCode() ExitCode {
+	return e.code
+}
+
+// ExitCodeOf returns the exit code of the outermost error in the chain that has one.
+// Errors without an exit code are failures, unless MATLAB was not found.
+func ExitCodeOf(err error) ExitCode {
+	if err == nil {
+		return ExitCodeSuccess
+	}
+
+	var coder ExitCoder
+	if errors.As(err, &coder) {
+		return coder.ExitCode()
+	}
+
+	var errorCoder ErrorCoder
+	if errors.As(err, &errorCoder) && errorCoder.ErrorCode() == ErrorCodeMATLABNotFound {
+		return ExitCodeMATLABNotFound
+	}
+
+	return ExitCodeFailure
+}
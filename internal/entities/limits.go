@@ -0,0 +1,69 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package entities
+
+import "fmt"
+
+// ResourceLimit is a per-call ceiling on the resources used by a MATLAB call.
+type ResourceLimit string
+
+const (
+	ResourceLimitWallTime    ResourceLimit = "wall time"
+	ResourceLimitOutputBytes ResourceLimit = "output size"
+	ResourceLimitFigures     ResourceLimit = "figure count"
+)
+
+// LimitExceededError is returned when a MATLAB call exceeds a ResourceLimit.
+// Partial holds the output produced up to the limit, so it can still be returned to the client.
+// Interrupted is set when the call was interrupted as it exceeded the limit, rather than checked once it returned.
+type LimitExceededError struct {
+	Limit       ResourceLimit
+	Value       string
+	Partial     EvalResponse
+	Interrupted bool
+}
+
+func NewLimitExceededError(limit ResourceLimit, value string, partial EvalResponse) *LimitExceededError {
+	return &LimitExceededError{
+		Limit:   limit,
+		Value:   value,
+		Partial: partial,
+	}
+}
+
+func (e *LimitExceededError) Error() string {
+	if e.Limit == ResourceLimitWallTime || e.Interrupted {
+		return fmt.Sprintf("the MATLAB call exceeded the %s limit of %s and was interrupted", e.Limit, e.Value)
+	}
+	return fmt.Sprintf("the MATLAB call exceeded the %s limit of %s, and its output was truncated", e.Limit, e.Value)
+}
+
+func (e *LimitExceededError) ErrorCode() ErrorCode {
+	return ErrorCodeLimitExceeded
+}
+
+// Transport is the transport an MCP client is connected to the server with.
+type Transport string
+
+const (
+	TransportStdio     Transport = "stdio"
+	TransportDaemon    Transport = "daemon"
+	TransportHTTP      Transport = "http"
+	TransportWebSocket Transport = "ws"
+)
+
+// ServeTransports are the transports the serve command can serve MCP clients on.
+// The daemon transport is selected with the daemon flag instead.
+var ServeTransports = []Transport{TransportStdio, TransportHTTP, TransportWebSocket}
+
+// OversizeResponse is how the text of a tool call result larger than the response size limit of the transport is shrunk.
+type OversizeResponse string
+
+const (
+	// OversizeResponseTruncate keeps the beginning and the end of the text.
+	OversizeResponseTruncate OversizeResponse = "truncate"
+	// OversizeResponseSummarize keeps the beginning and the end of the text, and the omitted lines mentioning errors and warnings.
+	OversizeResponseSummarize OversizeResponse = "summarize"
+	// OversizeResponseResource keeps the beginning and the end of the text, and saves the full text as an artifact linked from the result.
+	OversizeResponseResource OversizeResponse = "resource"
+)
@@ -0,0 +1,13 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package entities
+
+// Locale is the language of the messages shown to users, such as confirmations and command output.
+type Locale string
+
+const (
+	LocaleEnglish  Locale = "en"
+	LocaleJapanese Locale = "ja"
+	LocaleGerman   Locale = "de"
+	LocaleChinese  Locale = "zh"
+)
@@ -8,6 +8,8 @@ type MATLABSessionClient interface {
 	Eval(ctx context.Context, sessionLogger Logger, request EvalRequest) (EvalResponse, error)
 	EvalWithCapture(ctx context.Context, logger Logger, input EvalRequest) (EvalResponse, error)
 	FEval(ctx context.Context, sessionLogger Logger, request FEvalRequest) (FEvalResponse, error)
+	// Interrupt stops the evaluation currently running in the MATLAB session, as Ctrl+C does.
+	Interrupt(ctx context.Context, sessionLogger Logger) error
 }
 
 type MATLABManager interface {
@@ -15,6 +17,9 @@ type MATLABManager interface {
 	StartMATLABSession(ctx context.Context, sessionLogger Logger, startRequest SessionDetails) (SessionID, error)
 	StopMATLABSession(ctx context.Context, sessionLogger Logger, sessionID SessionID) error
 	GetMATLABSessionClient(ctx context.Context, sessionLogger Logger, sessionID SessionID) (MATLABSessionClient, error)
+	// FindMATLABSession returns the ID of the MATLAB session with the given name, or with the given ID.
+	FindMATLABSession(ctx context.Context, sessionLogger Logger, session string) (SessionID, error)
+	ListMATLABSessions(ctx context.Context, sessionLogger Logger) []SessionInfo
 }
 
 type EnvironmentInfo struct {
@@ -24,12 +29,21 @@ type EnvironmentInfo struct {
 
 type SessionID int
 
+// SessionInfo describes a running MATLAB session. Sessions started without a name have an empty Name.
+type SessionInfo struct {
+	ID         SessionID
+	Name       string
+	MATLABRoot string
+}
+
 // SessionDetails is an interface to disambiguate which type of MATLAB session to start.
 type SessionDetails interface {
 	interfacelock()
 }
 
 type LocalSessionDetails struct {
+	// Name identifies the session, in addition to its ID. It is optional, and unique among the running sessions.
+	Name              string
 	MATLABRoot        string
 	StartingDirectory string
 	ShowMATLABDesktop bool
@@ -37,8 +51,21 @@ type LocalSessionDetails struct {
 
 func (l LocalSessionDetails) interfacelock() {}
 
+// SharedSessionDetails describe a MATLAB started by the user, such as a MATLAB desktop, which shared its session in
+// SessionFile. The server connects to it instead of starting MATLAB, and leaves it running when the session is stopped.
+type SharedSessionDetails struct {
+	// Name identifies the session, in addition to its ID. It is optional, and unique among the running sessions.
+	Name        string
+	SessionFile string
+}
+
+func (s SharedSessionDetails) interfacelock() {}
+
 type EvalRequest struct {
 	Code string
+	// OutputFile is a file the command window output of the code is also written to while the code runs, so that it
+	// can be checked before the call returns. It is empty for none.
+	OutputFile string
 }
 
 type EvalResponse struct {
@@ -0,0 +1,21 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package entities
+
+// MCPClient is an AI application the server can be registered with, by adding it to the MCP configuration file of the application.
+type MCPClient string
+
+const (
+	MCPClientClaudeCode    MCPClient = "claude-code"
+	MCPClientClaudeDesktop MCPClient = "claude-desktop"
+	MCPClientCursor        MCPClient = "cursor"
+	MCPClientVSCode        MCPClient = "vscode"
+)
+
+// MCPClients are the clients the server can be registered with, in the order they are reported in.
+var MCPClients = []MCPClient{
+	MCPClientClaudeCode,
+	MCPClientClaudeDesktop,
+	MCPClientCursor,
+	MCPClientVSCode,
+}
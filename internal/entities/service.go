@@ -0,0 +1,17 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package entities
+
+// ServiceAction is an action of the service command, which runs the server in the background as a service of the user.
+type ServiceAction string
+
+const (
+	ServiceActionInstall   ServiceAction = "install"
+	ServiceActionUninstall ServiceAction = "uninstall"
+	ServiceActionStart     ServiceAction = "start"
+	ServiceActionStop      ServiceAction = "stop"
+	ServiceActionStatus    ServiceAction = "status"
+)
+
+// ServiceActions are the actions of the service command.
+var ServiceActions = []ServiceAction{ServiceActionInstall, ServiceActionUninstall, ServiceActionStart, ServiceActionStop, ServiceActionStatus}
@@ -0,0 +1,33 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package entities
+
+// MCPProtocolVersions are the versions of the Model Context Protocol the server supports, from the latest. The server
+// answers each client with the version it asks for when supported, or with the latest one otherwise.
+var MCPProtocolVersions = []string{"2025-06-18", "2025-03-26", "2024-11-05"}
+
+// ToolSet is a group of related tools, which the server enables depending on its configuration.
+type ToolSet string
+
+const (
+	// ToolSetMATLAB evaluates code in the MATLAB session, runs files and tests, and reads and writes its variables and figures.
+	ToolSetMATLAB ToolSet = "matlab"
+	// ToolSetMATLABSessions starts, lists and stops MATLAB sessions, and evaluates code in them.
+	ToolSetMATLABSessions ToolSet = "matlab-sessions"
+	// ToolSetPython runs Python code and manages the Python environment of the MATLAB session.
+	ToolSetPython ToolSet = "python"
+	// ToolSetSimulink loads, simulates and parameterizes Simulink models in the MATLAB session.
+	ToolSetSimulink ToolSet = "simulink"
+	// ToolSetCodeAnalysis analyzes MATLAB files without MATLAB.
+	ToolSetCodeAnalysis ToolSet = "code-analysis"
+	// ToolSetMATLABDrive copies files from and to MATLAB Drive.
+	ToolSetMATLABDrive ToolSet = "matlab-drive"
+	// ToolSetSimulinkRealTime drives the applications of Simulink Real-Time targets.
+	ToolSetSimulinkRealTime ToolSet = "simulink-real-time"
+	// ToolSetProductionServer calls and deploys to a MATLAB Production Server instance.
+	ToolSetProductionServer ToolSet = "production-server"
+	// ToolSetPlugins are the tools of the plugins.
+	ToolSetPlugins ToolSet = "plugins"
+	// ToolSetDownstreamServers are the tools of the downstream MCP servers.
+	ToolSetDownstreamServers ToolSet = "downstream-servers"
+)
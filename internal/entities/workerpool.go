@@ -0,0 +1,11 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package entities
+
+import "context"
+
+// WorkerPool provides the MATLAB sessions running read-only tools, so that they do not wait for a long call in the global MATLAB session.
+type WorkerPool interface {
+	// Client leases a MATLAB session client to a single call. The returned function must be called once the call is complete.
+	Client(ctx context.Context, logger Logger) (MATLABSessionClient, func(), error)
+}
@@ -2,7 +2,10 @@
 
 package filefacade
 
-import "path/filepath"
+import (
+	"io/fs"
+	"path/filepath"
+)
 
 type FileFacade struct {
 }
@@ -15,3 +18,13 @@ func New() *FileFacade {
 func (ff *FileFacade) EvalSymlinks(path string) (string, error) {
 	return filepath.EvalSymlinks(path)
 }
+
+// Glob wraps the filepath.Glob function to list the files matching a pattern.
+func (ff *FileFacade) Glob(pattern string) ([]string, error) {
+	return filepath.Glob(pattern)
+}
+
+// WalkDir wraps the filepath.WalkDir function to walk the file tree rooted at root.
+func (ff *FileFacade) WalkDir(root string, fn fs.WalkDirFunc) error {
+	return filepath.WalkDir(root, fn)
+}
@@ -0,0 +1,27 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package keychainfacade
+
+import "errors"
+
+// ErrNotFound is returned by Get when the keychain holds no secret for the service and account.
+var ErrNotFound = errors.New("secret not found in the keychain")
+
+// KeychainFacade stores secrets in the keychain of the operating system:
+// the login keychain on macOS, the Secret Service on Linux, and files protected with DPAPI on Windows.
+type KeychainFacade struct {
+}
+
+func New() *KeychainFacade {
+	return &KeychainFacade{}
+}
+
+// Get returns the secret stored for the service and account.
+func (kf *KeychainFacade) Get(service string, account string) (string, error) {
+	return get(service, account)
+}
+
+// Set stores the secret for the service and account, replacing any previous secret.
+func (kf *KeychainFacade) Set(service string, account string, secret string) error {
+	return set(service, account, secret)
+}
@@ -0,0 +1,33 @@
+// Copyright 2025 The MathWorks, Inc.
+//go:build darwin
+
+package keychainfacade
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// errSecItemNotFound is the exit code of the security tool when the keychain holds no such item.
+const errSecItemNotFound = 44
+
+func get(service string, account string) (string, error) {
+	output, err := exec.Command("security", "find-generic-password", "-s", service, "-a", account, "-w").Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() == errSecItemNotFound {
+			return "", ErrNotFound
+		}
+		return "", fmt.Errorf("failed to read from the login keychain: %w", err)
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+func set(service string, account string, secret string) error {
+	if output, err := exec.Command("security", "add-generic-password", "-U", "-s", service, "-a", account, "-w", secret).CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to write to the login keychain: %w: %s", err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
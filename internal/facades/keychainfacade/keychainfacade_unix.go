@@ -0,0 +1,33 @@
+// Copyright 2025 The MathWorks, Inc.
+//go:build !windows && !darwin
+
+package keychainfacade
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// get and set use secret-tool, the command line client of the Secret Service, as implemented by GNOME Keyring and KWallet.
+func get(service string, account string) (string, error) {
+	output, err := exec.Command("secret-tool", "lookup", "service", service, "account", account).Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(output) == 0 && len(exitErr.Stderr) == 0 {
+			return "", ErrNotFound
+		}
+		return "", fmt.Errorf("failed to read from the Secret Service: %w", err)
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+func set(service string, account string, secret string) error {
+	cmd := exec.Command("secret-tool", "store", "--label="+service, "service", service, "account", account)
+	cmd.Stdin = strings.NewReader(secret)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to write to the Secret Service: %w: %s", err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
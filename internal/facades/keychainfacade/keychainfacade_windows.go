@@ -0,0 +1,74 @@
+// Copyright 2025 The MathWorks, Inc.
+//go:build windows
+
+package keychainfacade
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// get and set keep the secrets in files of the local application data folder,
+// encrypted with DPAPI, so that only the current Windows user can decrypt them.
+func get(service string, account string) (string, error) {
+	path, err := secretPath(service, account)
+	if err != nil {
+		return "", err
+	}
+
+	protected, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", ErrNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read secret: %w", err)
+	}
+
+	var out windows.DataBlob
+	if err := windows.CryptUnprotectData(newBlob(protected), nil, nil, 0, nil, windows.CRYPTPROTECT_UI_FORBIDDEN, &out); err != nil {
+		return "", fmt.Errorf("failed to decrypt secret with DPAPI: %w", err)
+	}
+	defer windows.LocalFree(windows.Handle(unsafe.Pointer(out.Data)))
+
+	return string(unsafe.Slice(out.Data, out.Size)), nil
+}
+
+func set(service string, account string, secret string) error {
+	path, err := secretPath(service, account)
+	if err != nil {
+		return err
+	}
+
+	var out windows.DataBlob
+	if err := windows.CryptProtectData(newBlob([]byte(secret)), nil, nil, 0, nil, windows.CRYPTPROTECT_UI_FORBIDDEN, &out); err != nil {
+		return fmt.Errorf("failed to encrypt secret with DPAPI: %w", err)
+	}
+	defer windows.LocalFree(windows.Handle(unsafe.Pointer(out.Data)))
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create secret folder: %w", err)
+	}
+
+	return os.WriteFile(path, unsafe.Slice(out.Data, out.Size), 0o600)
+}
+
+func secretPath(service string, account string) (string, error) {
+	localAppData := os.Getenv("LOCALAPPDATA")
+	if localAppData == "" {
+		return "", fmt.Errorf("failed to find the local application data folder: LOCALAPPDATA is not set")
+	}
+	return filepath.Join(localAppData, service, account+".dpapi"), nil
+}
+
+func newBlob(data []byte) *windows.DataBlob {
+	if len(data) == 0 {
+		return &windows.DataBlob{}
+	}
+	return &windows.DataBlob{Size: uint32(len(data)), Data: &data[0]}
+}
@@ -0,0 +1,25 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package netfacade
+
+import (
+	"net"
+	"time"
+)
+
+type NetFacade struct {
+}
+
+func New() *NetFacade {
+	return &NetFacade{}
+}
+
+// Listen wraps the net.Listen function to listen on a local network address.
+func (nf *NetFacade) Listen(network string, address string) (net.Listener, error) {
+	return net.Listen(network, address)
+}
+
+// DialTimeout wraps the net.DialTimeout function to connect to a network address.
+func (nf *NetFacade) DialTimeout(network string, address string, timeout time.Duration) (net.Conn, error) {
+	return net.DialTimeout(network, address, timeout)
+}
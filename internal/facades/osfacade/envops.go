@@ -5,6 +5,7 @@ package osfacade
 import (
 	"io"
 	"os"
+	"os/user"
 	"runtime"
 )
 
@@ -18,6 +19,16 @@ func (osw *OsFacade) Args() []string {
 	return os.Args
 }
 
+// Executable wraps the os.Executable function to get the path of the running binary.
+func (osw *OsFacade) Executable() (string, error) {
+	return os.Executable()
+}
+
+// Getwd wraps the os.Getwd function to get the working directory of the process.
+func (osw *OsFacade) Getwd() (string, error) {
+	return os.Getwd()
+}
+
 // Getenv wraps the os.Getenv function to retrieve the value of the environment variable named by the key.
 func (osw *OsFacade) Getenv(key string) string {
 	return os.Getenv(key)
@@ -42,3 +53,8 @@ func (osw *OsFacade) Stderr() io.Writer {
 func (osw *OsFacade) GOOS() string {
 	return runtime.GOOS
 }
+
+// CurrentUser wraps the user.Current function to retrieve the user running the process.
+func (osw *OsFacade) CurrentUser() (*user.User, error) {
+	return user.Current()
+}
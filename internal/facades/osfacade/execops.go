@@ -25,6 +25,10 @@ type Cmd interface {
 	StderrPipe() (io.Reader, error)
 	SetSysProcAttr(attr *syscall.SysProcAttr)
 	Start() error
+	CombinedOutput() ([]byte, error)
+
+	// Provide method for retrieving the original command to facilitate passing to transports running it
+	Unwrap() *exec.Cmd
 }
 
 // Command wraps the exec.Command
@@ -53,3 +57,8 @@ func (c *CmdWrapper) StderrPipe() (io.Reader, error) {
 func (c *CmdWrapper) SetSysProcAttr(attr *syscall.SysProcAttr) {
 	c.SysProcAttr = attr
 }
+
+// Unwrap returns the original command so it can be run by an MCP command transport
+func (c *CmdWrapper) Unwrap() *exec.Cmd {
+	return c.Cmd
+}
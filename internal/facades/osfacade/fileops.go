@@ -3,7 +3,9 @@
 package osfacade
 
 import (
+	"io/fs"
 	"os"
+	"path/filepath"
 	"time"
 )
 
@@ -84,6 +86,11 @@ func (osw *OsFacade) Mkdir(name string, perm os.FileMode) error {
 	return os.Mkdir(name, perm)
 }
 
+// MkdirAll wraps the os.MkdirAll function to create a directory and its missing parents.
+func (osw *OsFacade) MkdirAll(path string, perm os.FileMode) error {
+	return os.MkdirAll(path, perm)
+}
+
 // RemoveAll wraps the os.RemoveAll function to create a delete a directory and its children.
 func (osw *OsFacade) RemoveAll(path string) error {
 	return os.RemoveAll(path)
@@ -94,16 +101,41 @@ func (osw *OsFacade) ReadFile(filePath string) ([]byte, error) {
 	return os.ReadFile(filePath) //nolint:gosec // Intentional os.ReadFile usage in facade
 }
 
+// DirFS wraps the os.DirFS function to read the file tree rooted at a directory.
+func (osw *OsFacade) DirFS(dir string) fs.FS {
+	return os.DirFS(dir)
+}
+
 // WriteFile wraps the os.WriteFile function to write content to a file.
 func (osw *OsFacade) WriteFile(name string, data []byte, perm os.FileMode) error {
 	return os.WriteFile(name, data, perm)
 }
 
+// Rename wraps the os.Rename function to move a file.
+func (osw *OsFacade) Rename(oldPath string, newPath string) error {
+	return os.Rename(oldPath, newPath)
+}
+
+// TempDir wraps the os.TempDir function to retrieve the default directory for temporary files.
+func (osw *OsFacade) TempDir() string {
+	return os.TempDir()
+}
+
+// EvalSymlinks wraps the filepath.EvalSymlinks function to resolve the symbolic links in a path.
+func (osw *OsFacade) EvalSymlinks(path string) (string, error) {
+	return filepath.EvalSymlinks(path)
+}
+
 // UserHomeDir wraps the os.UserHomeDir function to get the user's home directory
 func (osw *OsFacade) UserHomeDir() (string, error) {
 	return os.UserHomeDir()
 }
 
+// UserConfigDir wraps the os.UserConfigDir function to get the user's configuration directory.
+func (osw *OsFacade) UserConfigDir() (string, error) {
+	return os.UserConfigDir()
+}
+
 // Create wraps the os.Create function to create a file.
 func (osw *OsFacade) Create(name string) (File, error) {
 	file, err := os.Create(name) //nolint:gosec // Intentional os.Create usage in facade
@@ -113,3 +145,13 @@ func (osw *OsFacade) Create(name string) (File, error) {
 
 	return &FileWrapper{file}, nil
 }
+
+// OpenFile wraps the os.OpenFile function to open a file with the given flags and permissions.
+func (osw *OsFacade) OpenFile(name string, flag int, perm os.FileMode) (File, error) {
+	file, err := os.OpenFile(name, flag, perm) //nolint:gosec // Intentional os.OpenFile usage in facade
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileWrapper{file}, nil
+}
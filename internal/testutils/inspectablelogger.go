@@ -29,6 +29,7 @@ type InspectableLogger struct {
 	// Each logger needs to store its own list of fields, so Fields is not a pointer
 	Fields fields
 
+	// The lock is shared with child loggers, as they share the log lists
 	lock *sync.Mutex
 }
 
@@ -116,7 +117,7 @@ func (il *InspectableLogger) With(key string, value interface{}) entities.Logger
 		errorLogs: il.errorLogs,
 		Fields:    newFields,
 
-		lock: new(sync.Mutex),
+		lock: il.lock,
 	}
 }
 
@@ -126,6 +127,15 @@ func (il *InspectableLogger) WithError(err error) entities.Logger {
 	return il.With(key, err)
 }
 
+// String formats the fields of the logger, without reading the log lists, which can be written concurrently
+// while the logger is formatted as an argument of a mock call.
+func (il *InspectableLogger) String() string {
+	il.lock.Lock()
+	defer il.lock.Unlock()
+
+	return fmt.Sprintf("InspectableLogger%v", il.Fields)
+}
+
 func (il *InspectableLogger) AsMockArg() any {
 	return mock.AnythingOfType(fmt.Sprintf("%T", il))
 }
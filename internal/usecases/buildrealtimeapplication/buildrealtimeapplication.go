@@ -0,0 +1,66 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package buildrealtimeapplication
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/realtimetarget"
+)
+
+type Args struct {
+	ModelPath string
+}
+
+type ReturnArgs struct {
+	ApplicationPath string `json:"application"`
+	Log             string `json:"log"`
+}
+
+type PathValidator interface {
+	ValidateFilePath(ctx context.Context, filePath string) (string, error)
+}
+
+type Usecase struct {
+	pathValidator PathValidator
+}
+
+func New(
+	pathValidator PathValidator,
+) *Usecase {
+	return &Usecase{
+		pathValidator: pathValidator,
+	}
+}
+
+// Execute builds a Simulink model configured for Simulink Real-Time into a real-time application, next to the model,
+// and returns the path of the application with the build log.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering BuildRealTimeApplication Usecase")
+	defer sessionLogger.Debug("Exiting BuildRealTimeApplication Usecase")
+
+	switch strings.ToLower(filepath.Ext(request.ModelPath)) {
+	case ".slx", ".mdl":
+	default:
+		return ReturnArgs{}, entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("%s is not a Simulink model: use a .slx or .mdl file", request.ModelPath))
+	}
+
+	validatedPath, err := u.pathValidator.ValidateFilePath(ctx, request.ModelPath)
+	if err != nil {
+		sessionLogger.WithError(err).With("path", request.ModelPath).Warn("Path validation failed")
+		return ReturnArgs{}, fmt.Errorf("path validation failed: %w", err)
+	}
+
+	var result ReturnArgs
+	if err := realtimetarget.Call(ctx, sessionLogger, client, []string{"build", validatedPath}, &result); err != nil {
+		return ReturnArgs{}, err
+	}
+
+	sessionLogger.With("application", result.ApplicationPath).Info("Built real-time application")
+
+	return result, nil
+}
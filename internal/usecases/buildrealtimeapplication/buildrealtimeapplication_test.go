@@ -0,0 +1,88 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package buildrealtimeapplication_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/buildrealtimeapplication"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/buildrealtimeapplication"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	// Act
+	usecase := buildrealtimeapplication.New(mockPathValidator)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const modelPath = "/home/user/rig/controller.slx"
+
+	mockPathValidator.EXPECT().
+		ValidateFilePath(ctx, modelPath).
+		Return(modelPath, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.realTimeTarget",
+			Arguments:  []string{"build", modelPath},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`{"application":"/home/user/rig/controller.mldatx","log":"### Successful completion of build procedure for: controller\n"}`}}, nil).
+		Once()
+
+	usecase := buildrealtimeapplication.New(mockPathValidator)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, buildrealtimeapplication.Args{ModelPath: modelPath})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, buildrealtimeapplication.ReturnArgs{
+		ApplicationPath: "/home/user/rig/controller.mldatx",
+		Log:             "### Successful completion of build procedure for: controller\n",
+	}, result)
+}
+
+func TestUsecase_Execute_NotAModel(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := buildrealtimeapplication.New(mockPathValidator)
+
+	// Act
+	_, err := usecase.Execute(t.Context(), mockLogger, mockClient, buildrealtimeapplication.Args{ModelPath: "/home/user/rig/controller.m"})
+
+	// Assert
+	var codedErr *entities.CodedError
+	require.ErrorAs(t, err, &codedErr)
+	assert.Equal(t, entities.ErrorCodeInvalidInput, codedErr.ErrorCode())
+}
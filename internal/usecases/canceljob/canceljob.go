@@ -0,0 +1,38 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package canceljob
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/jobmanager"
+)
+
+type Args struct {
+	JobID string
+}
+
+type JobManager interface {
+	Cancel(ctx context.Context, logger entities.Logger, id string) (jobmanager.Job, error)
+}
+
+type Usecase struct {
+	jobManager JobManager
+}
+
+func New(
+	jobManager JobManager,
+) *Usecase {
+	return &Usecase{
+		jobManager: jobManager,
+	}
+}
+
+// Execute cancels a background job. Jobs running in the MATLAB session are interrupted, as Ctrl+C does.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, request Args) (jobmanager.Job, error) {
+	sessionLogger.Debug("Entering CancelJob Usecase")
+	defer sessionLogger.Debug("Exiting CancelJob Usecase")
+
+	return u.jobManager.Cancel(ctx, sessionLogger, request.JobID)
+}
@@ -0,0 +1,75 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package canceljob_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/canceljob"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/jobmanager"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/canceljob"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockJobManager := &mocks.MockJobManager{}
+	defer mockJobManager.AssertExpectations(t)
+
+	// Act
+	usecase := canceljob.New(mockJobManager)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockJobManager := &mocks.MockJobManager{}
+	defer mockJobManager.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedJob := jobmanager.Job{ID: "job-1", Mode: jobmanager.ModeBatch, State: jobmanager.StateCancelled, Output: "Iteration 1"}
+
+	mockJobManager.EXPECT().
+		Cancel(ctx, mockLogger.AsMockArg(), "job-1").
+		Return(expectedJob, nil).
+		Once()
+
+	usecase := canceljob.New(mockJobManager)
+
+	// Act
+	job, err := usecase.Execute(ctx, mockLogger, canceljob.Args{JobID: "job-1"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedJob, job)
+}
+
+func TestUsecase_Execute_JobManagerError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockJobManager := &mocks.MockJobManager{}
+	defer mockJobManager.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockJobManager.EXPECT().
+		Cancel(ctx, mockLogger.AsMockArg(), "job-42").
+		Return(jobmanager.Job{}, assert.AnError).
+		Once()
+
+	usecase := canceljob.New(mockJobManager)
+
+	// Act
+	job, err := usecase.Execute(ctx, mockLogger, canceljob.Args{JobID: "job-42"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, job)
+}
@@ -0,0 +1,156 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package capturematlabfigures
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+type Format string
+
+const (
+	FormatPNG Format = "png"
+	FormatSVG Format = "svg"
+)
+
+// defaultResolution is the resolution, in dots per inch, of the PNG images when none is given.
+const defaultResolution = 150
+
+type Args struct {
+	// Numbers are the numbers of the figures to capture. Every open figure is captured when there are none.
+	Numbers    []int
+	Format     Format
+	Resolution int
+}
+
+type Figure struct {
+	Number   int
+	Name     string
+	MIMEType string
+	Data     []byte
+}
+
+type ReturnArgs struct {
+	Figures []Figure
+}
+
+type OSLayer interface {
+	ReadFile(name string) ([]byte, error)
+	RemoveAll(path string) error
+}
+
+// capturedFigure is a figure exported by MATLAB to a temporary file.
+type capturedFigure struct {
+	Number int    `json:"number"`
+	Name   string `json:"name"`
+	File   string `json:"file"`
+}
+
+type Usecase struct {
+	osLayer OSLayer
+}
+
+func New(
+	osLayer OSLayer,
+) *Usecase {
+	return &Usecase{
+		osLayer: osLayer,
+	}
+}
+
+// Execute exports figures of the MATLAB session as PNG or SVG images, and returns the images.
+// MATLAB writes each image to a temporary file, which is deleted once read.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering CaptureMATLABFigures Usecase")
+	defer sessionLogger.Debug("Exiting CaptureMATLABFigures Usecase")
+
+	format := request.Format
+	if format == "" {
+		format = FormatPNG
+	}
+	mimeType, ok := mimeTypes[format]
+	if !ok {
+		return ReturnArgs{}, entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("invalid format %q: use %s or %s", format, FormatPNG, FormatSVG))
+	}
+
+	resolution := request.Resolution
+	if resolution < 0 {
+		return ReturnArgs{}, entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("invalid resolution %d: use a positive number of dots per inch", resolution))
+	}
+	if resolution == 0 {
+		resolution = defaultResolution
+	}
+
+	numbers := request.Numbers
+	if numbers == nil {
+		numbers = []int{}
+	}
+	encodedNumbers, err := json.Marshal(numbers)
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	response, err := client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+		Function: "matlab_mcp.captureFigures",
+		Arguments: []string{
+			string(encodedNumbers),
+			string(format),
+			strconv.Itoa(resolution),
+		},
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	if len(response.Outputs) != 1 {
+		return ReturnArgs{}, fmt.Errorf("unexpected number of outputs from MATLAB session")
+	}
+
+	output, ok := response.Outputs[0].(string)
+	if !ok {
+		return ReturnArgs{}, fmt.Errorf("failed to cast output to string")
+	}
+
+	var captured []capturedFigure
+	if err := json.Unmarshal([]byte(output), &captured); err != nil {
+		return ReturnArgs{}, fmt.Errorf("failed to parse captured figures: %w", err)
+	}
+
+	defer func() {
+		for _, figure := range captured {
+			if err := u.osLayer.RemoveAll(figure.File); err != nil {
+				sessionLogger.WithError(err).With("file", figure.File).Warn("Failed to delete captured figure")
+			}
+		}
+	}()
+
+	figures := make([]Figure, 0, len(captured))
+	for _, figure := range captured {
+		data, err := u.osLayer.ReadFile(figure.File)
+		if err != nil {
+			return ReturnArgs{}, fmt.Errorf("failed to read captured figure %d: %w", figure.Number, err)
+		}
+
+		figures = append(figures, Figure{
+			Number:   figure.Number,
+			Name:     figure.Name,
+			MIMEType: mimeType,
+			Data:     data,
+		})
+	}
+
+	return ReturnArgs{
+		Figures: figures,
+	}, nil
+}
+
+var mimeTypes = map[Format]string{
+	FormatPNG: "image/png",
+	FormatSVG: "image/svg+xml",
+}
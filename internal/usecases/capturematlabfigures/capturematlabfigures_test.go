@@ -0,0 +1,228 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package capturematlabfigures_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/capturematlabfigures"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/capturematlabfigures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	// Act
+	usecase := capturematlabfigures.New(mockOSLayer)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_AllFigures(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.captureFigures",
+			Arguments:  []string{"[]", "png", "150"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{
+			Outputs: []any{`[{"number":1,"name":"","file":"/tmp/tp1.png"},{"number":3,"name":"Residuals","file":"/tmp/tp3.png"}]`},
+		}, nil).
+		Once()
+
+	for _, file := range []string{"/tmp/tp1.png", "/tmp/tp3.png"} {
+		mockOSLayer.EXPECT().
+			ReadFile(file).
+			Return([]byte("\x89PNG "+file), nil).
+			Once()
+
+		mockOSLayer.EXPECT().
+			RemoveAll(file).
+			Return(nil).
+			Once()
+	}
+
+	usecase := capturematlabfigures.New(mockOSLayer)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, capturematlabfigures.Args{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, capturematlabfigures.ReturnArgs{
+		Figures: []capturematlabfigures.Figure{
+			{Number: 1, Name: "", MIMEType: "image/png", Data: []byte("\x89PNG /tmp/tp1.png")},
+			{Number: 3, Name: "Residuals", MIMEType: "image/png", Data: []byte("\x89PNG /tmp/tp3.png")},
+		},
+	}, result)
+}
+
+func TestUsecase_Execute_SVG(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	svgFile := "/tmp/tp2.svg"
+	svgData := []byte("<svg/>")
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.captureFigures",
+			Arguments:  []string{"[2]", "svg", "300"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{
+			Outputs: []any{`[{"number":2,"name":"Spectrum","file":"/tmp/tp2.svg"}]`},
+		}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(svgFile).
+		Return(svgData, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		RemoveAll(svgFile).
+		Return(nil).
+		Once()
+
+	usecase := capturematlabfigures.New(mockOSLayer)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, capturematlabfigures.Args{
+		Numbers:    []int{2},
+		Format:     capturematlabfigures.FormatSVG,
+		Resolution: 300,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []capturematlabfigures.Figure{
+		{Number: 2, Name: "Spectrum", MIMEType: "image/svg+xml", Data: svgData},
+	}, result.Figures)
+}
+
+func TestUsecase_Execute_InvalidFormat(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := capturematlabfigures.New(mockOSLayer)
+
+	// Act
+	result, err := usecase.Execute(t.Context(), mockLogger, mockClient, capturematlabfigures.Args{Format: "jpeg"})
+
+	// Assert
+	require.Error(t, err)
+	assert.Equal(t, entities.ErrorCodeInvalidInput, entities.ErrorCodeOf(err))
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_FEvalError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.captureFigures",
+			Arguments:  []string{"[4]", "png", "150"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{}, assert.AnError).
+		Once()
+
+	usecase := capturematlabfigures.New(mockOSLayer)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, capturematlabfigures.Args{Numbers: []int{4}})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_ReadError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.captureFigures",
+			Arguments:  []string{"[]", "png", "150"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{
+			Outputs: []any{`[{"number":1,"name":"","file":"/tmp/tp1.png"},{"number":2,"name":"","file":"/tmp/tp2.png"}]`},
+		}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile("/tmp/tp1.png").
+		Return(nil, assert.AnError).
+		Once()
+
+	mockOSLayer.EXPECT().
+		RemoveAll("/tmp/tp1.png").
+		Return(nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		RemoveAll("/tmp/tp2.png").
+		Return(nil).
+		Once()
+
+	usecase := capturematlabfigures.New(mockOSLayer)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, capturematlabfigures.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
@@ -4,22 +4,43 @@ package checkmatlabcode
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 )
 
+// MaxDiagnostics is the maximum number of diagnostics returned by a call, so that checking a large folder does not
+// flood the context of the AI application.
+const MaxDiagnostics = 500
+
 type Args struct {
 	ScriptPath string
+	FolderPath string
+}
+
+// Diagnostic is an issue found by Code Analyzer.
+type Diagnostic struct {
+	File      string `json:"file"`
+	Line      int    `json:"line"`
+	Column    int    `json:"column"`
+	EndColumn int    `json:"endColumn"`
+	Severity  string `json:"severity"`
+	ID        string `json:"id"`
+	Message   string `json:"message"`
+	Fix       string `json:"fix"`
 }
 
 type ReturnArgs struct {
 	CheckCodeOutput []string
+	Diagnostics     []Diagnostic
+	Omitted         int
 }
 
 type PathValidator interface {
-	ValidateMATLABScript(filePath string) (string, error)
+	ValidateMATLABScript(ctx context.Context, filePath string) (string, error)
+	ValidateFolderPath(ctx context.Context, filePath string) (string, error)
 }
 
 type Usecase struct {
@@ -34,44 +55,90 @@ func New(
 	}
 }
 
+// Execute runs Code Analyzer on a MATLAB file, or on the MATLAB files of a folder and its subfolders, and returns the
+// issues it found, both as diagnostics and in the format of checkcode.
 func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, checkcodeRequest Args) (ReturnArgs, error) {
 	sessionLogger.Debug("Entering CheckMATLABCode Usecase")
 	defer sessionLogger.Debug("Exiting CheckMATLABCode Usecase")
 
-	validatedPath, err := u.pathValidator.ValidateMATLABScript(checkcodeRequest.ScriptPath)
+	validatedPath, err := u.validatePath(ctx, checkcodeRequest)
 	if err != nil {
-		return ReturnArgs{}, fmt.Errorf("path validation failed: %w", err)
+		return ReturnArgs{}, err
 	}
 
-	request := entities.EvalRequest{
-		Code: fmt.Sprintf("checkcode('%s')", strings.ReplaceAll(validatedPath, "'", "''")), // Escape single quotes
-	}
-	response, err := client.EvalWithCapture(ctx, sessionLogger, request)
+	response, err := client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+		Function:   "matlab_mcp.checkCode",
+		Arguments:  []string{validatedPath},
+		NumOutputs: 1,
+	})
 	if err != nil {
 		return ReturnArgs{}, err
 	}
 
-	output := response.ConsoleOutput
-	if output == "" {
-		output = "No issues found by checkcode"
+	if len(response.Outputs) != 1 {
+		return ReturnArgs{}, fmt.Errorf("unexpected number of outputs from MATLAB session")
+	}
+
+	output, ok := response.Outputs[0].(string)
+	if !ok {
+		return ReturnArgs{}, fmt.Errorf("failed to cast output to string")
+	}
+
+	diagnostics := []Diagnostic{}
+	if err := json.Unmarshal([]byte(output), &diagnostics); err != nil {
+		return ReturnArgs{}, fmt.Errorf("failed to parse checkcode diagnostics: %w", err)
+	}
+
+	omitted := 0
+	if len(diagnostics) > MaxDiagnostics {
+		omitted = len(diagnostics) - MaxDiagnostics
+		diagnostics = diagnostics[:MaxDiagnostics]
 	}
 
 	return ReturnArgs{
-		CheckCodeOutput: splitAndCleanLines(output),
+		CheckCodeOutput: formatMessages(diagnostics, checkcodeRequest.FolderPath != "", omitted),
+		Diagnostics:     diagnostics,
+		Omitted:         omitted,
 	}, nil
 }
 
-func splitAndCleanLines(text string) []string {
-	lines := strings.Split(text, "\n")
-	result := []string{}
+func (u *Usecase) validatePath(ctx context.Context, checkcodeRequest Args) (string, error) {
+	switch {
+	case checkcodeRequest.ScriptPath != "" && checkcodeRequest.FolderPath != "":
+		return "", entities.NewCodedError(entities.ErrorCodeInvalidInput, errors.New("set either script_path or folder_path, not both"))
+	case checkcodeRequest.FolderPath != "":
+		validatedPath, err := u.pathValidator.ValidateFolderPath(ctx, checkcodeRequest.FolderPath)
+		if err != nil {
+			return "", fmt.Errorf("path validation failed: %w", err)
+		}
+		return validatedPath, nil
+	default:
+		validatedPath, err := u.pathValidator.ValidateMATLABScript(ctx, checkcodeRequest.ScriptPath)
+		if err != nil {
+			return "", fmt.Errorf("path validation failed: %w", err)
+		}
+		return validatedPath, nil
+	}
+}
 
-	for _, line := range lines {
-		// Trim whitespace and skip empty lines
-		trimmed := strings.TrimSpace(line)
-		if trimmed != "" {
-			result = append(result, trimmed)
+// formatMessages lists the diagnostics as checkcode displays them, prefixed with their file when a folder was checked.
+func formatMessages(diagnostics []Diagnostic, withFile bool, omitted int) []string {
+	if len(diagnostics) == 0 {
+		return []string{"No issues found by checkcode"}
+	}
+
+	messages := make([]string, 0, len(diagnostics)+1)
+	for _, diagnostic := range diagnostics {
+		message := fmt.Sprintf("L %d (C %d-%d): %s", diagnostic.Line, diagnostic.Column, diagnostic.EndColumn, diagnostic.Message)
+		if withFile {
+			message = diagnostic.File + ": " + message
 		}
+		messages = append(messages, message)
+	}
+
+	if omitted > 0 {
+		messages = append(messages, fmt.Sprintf("%d more issues were found, but are not listed; check fewer files at once to list them", omitted))
 	}
 
-	return result
+	return messages
 }
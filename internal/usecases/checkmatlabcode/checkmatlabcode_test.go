@@ -3,6 +3,8 @@
 package checkmatlabcode_test
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
@@ -14,11 +16,16 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func checkCodeRequest(path string) entities.FEvalRequest {
+	return entities.FEvalRequest{
+		Function:   "matlab_mcp.checkCode",
+		Arguments:  []string{path},
+		NumOutputs: 1,
+	}
+}
+
 func TestNew_HappyPath(t *testing.T) {
 	// Arrange
-	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
-	defer mockGlobalMATLAB.AssertExpectations(t)
-
 	mockPathValidator := &checkmatlabcodemocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
@@ -45,20 +52,18 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 
 	ctx := t.Context()
 	const validatedPath = "/validated/path/to/script.m"
-	const expectedCheckCodeOutput = "L 5 (C 1-10): Variable 'x' might be unused."
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(checkcodeRequest.ScriptPath).
+		ValidateMATLABScript(ctx, checkcodeRequest.ScriptPath).
 		Return(validatedPath, nil).
 		Once()
 
-	expectedEvalRequest := entities.EvalRequest{
-		Code: "checkcode('" + validatedPath + "')",
-	}
-
 	mockClient.EXPECT().
-		EvalWithCapture(ctx, mockLogger.AsMockArg(), expectedEvalRequest).
-		Return(entities.EvalResponse{ConsoleOutput: expectedCheckCodeOutput}, nil).
+		FEval(ctx, mockLogger.AsMockArg(), checkCodeRequest(validatedPath)).
+		Return(entities.FEvalResponse{Outputs: []any{`[
+			{"file":"/validated/path/to/script.m","line":5,"column":1,"endColumn":10,"severity":"warning","id":"NASGU","message":"Variable 'x' might be unused.","fix":"manual"},
+			{"file":"/validated/path/to/script.m","line":8,"column":3,"endColumn":3,"severity":"error","id":"EOFER","message":"Parse error at END.","fix":"automatic"}
+		]`}}, nil).
 		Once()
 
 	usecase := checkmatlabcode.New(mockPathValidator)
@@ -68,10 +73,19 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 
 	// Assert
 	require.NoError(t, err, "Execute should not return an error")
-	assert.Equal(t, []string{expectedCheckCodeOutput}, response.CheckCodeOutput, "CheckCode output should match expected value")
+	assert.Equal(t, checkmatlabcode.ReturnArgs{
+		CheckCodeOutput: []string{
+			"L 5 (C 1-10): Variable 'x' might be unused.",
+			"L 8 (C 3-3): Parse error at END.",
+		},
+		Diagnostics: []checkmatlabcode.Diagnostic{
+			{File: validatedPath, Line: 5, Column: 1, EndColumn: 10, Severity: "warning", ID: "NASGU", Message: "Variable 'x' might be unused.", Fix: "manual"},
+			{File: validatedPath, Line: 8, Column: 3, EndColumn: 3, Severity: "error", ID: "EOFER", Message: "Parse error at END.", Fix: "automatic"},
+		},
+	}, response)
 }
 
-func TestUsecase_Execute_HappyPath_OutputWithWhitespaceAndEmptyLines(t *testing.T) {
+func TestUsecase_Execute_HappyPath_NoIssues(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
@@ -87,21 +101,15 @@ func TestUsecase_Execute_HappyPath_OutputWithWhitespaceAndEmptyLines(t *testing.
 
 	ctx := t.Context()
 	const validatedPath = "/validated/path/to/script.m"
-	const expectedCheckCodeOutput = "  Line 1: Warning  \n\n  \n\nLine 3: Error\n   \n"
-	expectedCleanedOutput := []string{"Line 1: Warning", "Line 3: Error"}
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(checkcodeRequest.ScriptPath).
+		ValidateMATLABScript(ctx, checkcodeRequest.ScriptPath).
 		Return(validatedPath, nil).
 		Once()
 
-	expectedEvalRequest := entities.EvalRequest{
-		Code: "checkcode('" + validatedPath + "')",
-	}
-
 	mockClient.EXPECT().
-		EvalWithCapture(ctx, mockLogger.AsMockArg(), expectedEvalRequest).
-		Return(entities.EvalResponse{ConsoleOutput: expectedCheckCodeOutput}, nil).
+		FEval(ctx, mockLogger.AsMockArg(), checkCodeRequest(validatedPath)).
+		Return(entities.FEvalResponse{Outputs: []any{"[]"}}, nil).
 		Once()
 
 	usecase := checkmatlabcode.New(mockPathValidator)
@@ -111,10 +119,11 @@ func TestUsecase_Execute_HappyPath_OutputWithWhitespaceAndEmptyLines(t *testing.
 
 	// Assert
 	require.NoError(t, err, "Execute should not return an error")
-	assert.Equal(t, expectedCleanedOutput, response.CheckCodeOutput, "CheckCode output should match expected value")
+	assert.Equal(t, []string{"No issues found by checkcode"}, response.CheckCodeOutput, "CheckCode output should match expected value")
+	assert.Empty(t, response.Diagnostics)
 }
 
-func TestUsecase_Execute_HappyPath_EmptyOutput(t *testing.T) {
+func TestUsecase_Execute_HappyPath_Folder(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
@@ -125,25 +134,22 @@ func TestUsecase_Execute_HappyPath_EmptyOutput(t *testing.T) {
 	defer mockClient.AssertExpectations(t)
 
 	checkcodeRequest := checkmatlabcode.Args{
-		ScriptPath: "/path/to/script.m",
+		FolderPath: "/path/to/project",
 	}
 
 	ctx := t.Context()
-	const validatedPath = "/validated/path/to/script.m"
-	const expectedCheckCodeOutput = "No issues found by checkcode"
+	const validatedPath = "/validated/path/to/project"
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(checkcodeRequest.ScriptPath).
+		ValidateFolderPath(ctx, checkcodeRequest.FolderPath).
 		Return(validatedPath, nil).
 		Once()
 
-	expectedEvalRequest := entities.EvalRequest{
-		Code: "checkcode('" + validatedPath + "')",
-	}
-
 	mockClient.EXPECT().
-		EvalWithCapture(ctx, mockLogger.AsMockArg(), expectedEvalRequest).
-		Return(entities.EvalResponse{ConsoleOutput: ""}, nil).
+		FEval(ctx, mockLogger.AsMockArg(), checkCodeRequest(validatedPath)).
+		Return(entities.FEvalResponse{Outputs: []any{`[
+			{"file":"/validated/path/to/project/util/helper.m","line":2,"column":5,"endColumn":7,"severity":"info","id":"AGROW","message":"Consider preallocating for speed.","fix":"manual"}
+		]`}}, nil).
 		Once()
 
 	usecase := checkmatlabcode.New(mockPathValidator)
@@ -153,10 +159,12 @@ func TestUsecase_Execute_HappyPath_EmptyOutput(t *testing.T) {
 
 	// Assert
 	require.NoError(t, err, "Execute should not return an error")
-	assert.Equal(t, []string{expectedCheckCodeOutput}, response.CheckCodeOutput, "CheckCode output should match expected value")
+	assert.Equal(t, []string{"/validated/path/to/project/util/helper.m: L 2 (C 5-7): Consider preallocating for speed."}, response.CheckCodeOutput)
+	require.Len(t, response.Diagnostics, 1)
+	assert.Equal(t, "/validated/path/to/project/util/helper.m", response.Diagnostics[0].File)
 }
 
-func TestUsecase_Execute_HappyPath_PathWithSingleQuotes(t *testing.T) {
+func TestUsecase_Execute_HappyPath_TooManyDiagnostics(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
@@ -167,25 +175,25 @@ func TestUsecase_Execute_HappyPath_PathWithSingleQuotes(t *testing.T) {
 	defer mockClient.AssertExpectations(t)
 
 	checkcodeRequest := checkmatlabcode.Args{
-		ScriptPath: "/path/to/script.m",
+		FolderPath: "/path/to/project",
 	}
 
 	ctx := t.Context()
-	const validatedPath = "/path/with'quote/script.m"
-	const expectedCheckCodeOutput = "L 5 (C 1-10): Variable 'x' might be unused."
+	const validatedPath = "/validated/path/to/project"
+
+	diagnostics := make([]string, checkmatlabcode.MaxDiagnostics+3)
+	for i := range diagnostics {
+		diagnostics[i] = fmt.Sprintf(`{"file":"/validated/path/to/project/script.m","line":%d,"column":1,"endColumn":1,"severity":"warning","id":"NOPRT","message":"Terminate statement with semicolon.","fix":"automatic"}`, i+1)
+	}
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(checkcodeRequest.ScriptPath).
+		ValidateFolderPath(ctx, checkcodeRequest.FolderPath).
 		Return(validatedPath, nil).
 		Once()
 
-	expectedEvalRequest := entities.EvalRequest{
-		Code: "checkcode('/path/with''quote/script.m')",
-	}
-
 	mockClient.EXPECT().
-		EvalWithCapture(ctx, mockLogger.AsMockArg(), expectedEvalRequest).
-		Return(entities.EvalResponse{ConsoleOutput: expectedCheckCodeOutput}, nil).
+		FEval(ctx, mockLogger.AsMockArg(), checkCodeRequest(validatedPath)).
+		Return(entities.FEvalResponse{Outputs: []any{"[" + strings.Join(diagnostics, ",") + "]"}}, nil).
 		Once()
 
 	usecase := checkmatlabcode.New(mockPathValidator)
@@ -195,7 +203,34 @@ func TestUsecase_Execute_HappyPath_PathWithSingleQuotes(t *testing.T) {
 
 	// Assert
 	require.NoError(t, err, "Execute should not return an error")
-	assert.Equal(t, []string{expectedCheckCodeOutput}, response.CheckCodeOutput, "CheckCode output should match expected value")
+	assert.Len(t, response.Diagnostics, checkmatlabcode.MaxDiagnostics)
+	assert.Equal(t, 3, response.Omitted)
+	require.Len(t, response.CheckCodeOutput, checkmatlabcode.MaxDiagnostics+1)
+	assert.Contains(t, response.CheckCodeOutput[checkmatlabcode.MaxDiagnostics], "3 more issues were found")
+}
+
+func TestUsecase_Execute_BothPaths(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &checkmatlabcodemocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := checkmatlabcode.New(mockPathValidator)
+
+	// Act
+	response, err := usecase.Execute(t.Context(), mockLogger, mockClient, checkmatlabcode.Args{
+		ScriptPath: "/path/to/script.m",
+		FolderPath: "/path/to/project",
+	})
+
+	// Assert
+	require.Error(t, err)
+	assert.Equal(t, entities.ErrorCodeInvalidInput, entities.ErrorCodeOf(err))
+	assert.Empty(t, response, "Response should be empty when there's an error")
 }
 
 func TestUsecase_Execute_PathValidationError(t *testing.T) {
@@ -216,7 +251,39 @@ func TestUsecase_Execute_PathValidationError(t *testing.T) {
 	expectedError := assert.AnError
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(checkcodeRequest.ScriptPath).
+		ValidateMATLABScript(ctx, checkcodeRequest.ScriptPath).
+		Return("", expectedError).
+		Once()
+
+	usecase := checkmatlabcode.New(mockPathValidator)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockClient, checkcodeRequest)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Error should be the original error")
+	assert.Empty(t, response, "Response should be empty when there's an error")
+}
+
+func TestUsecase_Execute_FolderValidationError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &checkmatlabcodemocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	checkcodeRequest := checkmatlabcode.Args{
+		FolderPath: "/path/to/project",
+	}
+
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(ctx, checkcodeRequest.FolderPath).
 		Return("", expectedError).
 		Once()
 
@@ -230,7 +297,7 @@ func TestUsecase_Execute_PathValidationError(t *testing.T) {
 	assert.Empty(t, response, "Response should be empty when there's an error")
 }
 
-func TestUsecase_Execute_EvalWithCaptureError(t *testing.T) {
+func TestUsecase_Execute_FEvalError(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
@@ -249,17 +316,50 @@ func TestUsecase_Execute_EvalWithCaptureError(t *testing.T) {
 	expectedError := assert.AnError
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(checkcodeRequest.ScriptPath).
+		ValidateMATLABScript(ctx, checkcodeRequest.ScriptPath).
 		Return(validatedPath, nil).
 		Once()
 
-	expectedEvalRequest := entities.EvalRequest{
-		Code: "checkcode('/validated/path/to/script.m')",
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), checkCodeRequest(validatedPath)).
+		Return(entities.FEvalResponse{}, expectedError).
+		Once()
+
+	usecase := checkmatlabcode.New(mockPathValidator)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockClient, checkcodeRequest)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Error should be the original error")
+	assert.Empty(t, response, "Response should be empty when there's an error")
+}
+
+func TestUsecase_Execute_InvalidOutput(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &checkmatlabcodemocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	checkcodeRequest := checkmatlabcode.Args{
+		ScriptPath: "/path/to/script.m",
 	}
 
+	ctx := t.Context()
+	const validatedPath = "/validated/path/to/script.m"
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(ctx, checkcodeRequest.ScriptPath).
+		Return(validatedPath, nil).
+		Once()
+
 	mockClient.EXPECT().
-		EvalWithCapture(ctx, mockLogger.AsMockArg(), expectedEvalRequest).
-		Return(entities.EvalResponse{}, expectedError).
+		FEval(ctx, mockLogger.AsMockArg(), checkCodeRequest(validatedPath)).
+		Return(entities.FEvalResponse{Outputs: []any{"not json"}}, nil).
 		Once()
 
 	usecase := checkmatlabcode.New(mockPathValidator)
@@ -268,6 +368,6 @@ func TestUsecase_Execute_EvalWithCaptureError(t *testing.T) {
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, checkcodeRequest)
 
 	// Assert
-	require.ErrorIs(t, err, expectedError, "Error should be the original error")
+	require.ErrorContains(t, err, "failed to parse checkcode diagnostics")
 	assert.Empty(t, response, "Response should be empty when there's an error")
 }
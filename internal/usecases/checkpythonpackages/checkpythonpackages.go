@@ -0,0 +1,112 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package checkpythonpackages
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// MaxPackages is the maximum number of packages checked by a call, as each of them is imported.
+const MaxPackages = 50
+
+// moduleName matches the dotted names of Python modules, so that names cannot be mistaken for code.
+var moduleName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*$`)
+
+type Args struct {
+	Packages []string
+}
+
+type Package struct {
+	Name       string `json:"name"`
+	Importable bool   `json:"importable"`
+	Version    string `json:"version"`
+	Error      string `json:"error"`
+}
+
+type ReturnArgs struct {
+	Packages []Package
+}
+
+type CodePolicy interface {
+	CheckPython() error
+}
+
+type Usecase struct {
+	codePolicy CodePolicy
+}
+
+func New(
+	codePolicy CodePolicy,
+) *Usecase {
+	return &Usecase{
+		codePolicy: codePolicy,
+	}
+}
+
+// Execute imports the Python packages in the Python environment of the MATLAB session, and returns which of them can
+// be imported, with their version. Importing a package runs its code, so it is subject to the same policy as
+// running Python code.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering CheckPythonPackages Usecase")
+	defer sessionLogger.Debug("Exiting CheckPythonPackages Usecase")
+
+	if err := u.codePolicy.CheckPython(); err != nil {
+		sessionLogger.WithError(err).Warn("Python package check rejected by the code policy")
+		return ReturnArgs{}, err
+	}
+
+	if err := validatePackages(request.Packages); err != nil {
+		return ReturnArgs{}, err
+	}
+
+	response, err := client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+		Function:   "matlab_mcp.checkPythonPackages",
+		Arguments:  request.Packages,
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	if len(response.Outputs) != 1 {
+		return ReturnArgs{}, fmt.Errorf("unexpected number of outputs from MATLAB session")
+	}
+
+	output, ok := response.Outputs[0].(string)
+	if !ok {
+		return ReturnArgs{}, fmt.Errorf("failed to cast output to string")
+	}
+
+	var packages []Package
+	if err := json.Unmarshal([]byte(output), &packages); err != nil {
+		return ReturnArgs{}, fmt.Errorf("failed to parse package check: %w", err)
+	}
+
+	return ReturnArgs{
+		Packages: packages,
+	}, nil
+}
+
+func validatePackages(packages []string) error {
+	if len(packages) == 0 {
+		return entities.NewCodedError(entities.ErrorCodeInvalidInput, errors.New("no package to check"))
+	}
+
+	if len(packages) > MaxPackages {
+		return entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("at most %d packages can be checked at once", MaxPackages))
+	}
+
+	for _, name := range packages {
+		if !moduleName.MatchString(name) {
+			return entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("%q is not the name of a Python module; use its import name, such as sklearn for scikit-learn", name))
+		}
+	}
+
+	return nil
+}
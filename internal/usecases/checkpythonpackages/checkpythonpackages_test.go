@@ -0,0 +1,156 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package checkpythonpackages_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/checkpythonpackages"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/checkpythonpackages"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	// Act
+	usecase := checkpythonpackages.New(mockCodePolicy)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockCodePolicy.EXPECT().
+		CheckPython().
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.checkPythonPackages",
+			Arguments:  []string{"numpy", "matplotlib.pyplot", "torch"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`[
+			{"name":"numpy","importable":true,"version":"1.26.4","error":""},
+			{"name":"matplotlib.pyplot","importable":true,"version":"","error":""},
+			{"name":"torch","importable":false,"version":"","error":"ModuleNotFoundError: No module named 'torch'"}
+		]`}}, nil).
+		Once()
+
+	usecase := checkpythonpackages.New(mockCodePolicy)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, checkpythonpackages.Args{
+		Packages: []string{"numpy", "matplotlib.pyplot", "torch"},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, checkpythonpackages.ReturnArgs{
+		Packages: []checkpythonpackages.Package{
+			{Name: "numpy", Importable: true, Version: "1.26.4"},
+			{Name: "matplotlib.pyplot", Importable: true},
+			{Name: "torch", Importable: false, Error: "ModuleNotFoundError: No module named 'torch'"},
+		},
+	}, result)
+}
+
+func TestUsecase_Execute_InvalidInput(t *testing.T) {
+	tooManyPackages := make([]string, checkpythonpackages.MaxPackages+1)
+	for i := range tooManyPackages {
+		tooManyPackages[i] = "package" + strconv.Itoa(i)
+	}
+
+	testConfigs := []struct {
+		name     string
+		packages []string
+	}{
+		{
+			name: "no package",
+		},
+		{
+			name:     "too many packages",
+			packages: tooManyPackages,
+		},
+		{
+			name:     "distribution name",
+			packages: []string{"scikit-learn"},
+		},
+		{
+			name:     "code",
+			packages: []string{"os; os.system('ls')"},
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockCodePolicy := &mocks.MockCodePolicy{}
+			defer mockCodePolicy.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			mockCodePolicy.EXPECT().
+				CheckPython().
+				Return(nil).
+				Once()
+
+			usecase := checkpythonpackages.New(mockCodePolicy)
+
+			// Act
+			_, err := usecase.Execute(t.Context(), mockLogger, mockClient, checkpythonpackages.Args{Packages: testConfig.packages})
+
+			// Assert
+			var codedErr *entities.CodedError
+			require.ErrorAs(t, err, &codedErr)
+			assert.Equal(t, entities.ErrorCodeInvalidInput, codedErr.ErrorCode())
+		})
+	}
+}
+
+func TestUsecase_Execute_PolicyViolation(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockCodePolicy.EXPECT().
+		CheckPython().
+		Return(assert.AnError).
+		Once()
+
+	usecase := checkpythonpackages.New(mockCodePolicy)
+
+	// Act
+	_, err := usecase.Execute(t.Context(), mockLogger, mockClient, checkpythonpackages.Args{Packages: []string{"numpy"}})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
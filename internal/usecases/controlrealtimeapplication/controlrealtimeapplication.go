@@ -0,0 +1,99 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package controlrealtimeapplication
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/realtimetarget"
+)
+
+type Action string
+
+const (
+	ActionStart Action = "start"
+	ActionStop  Action = "stop"
+)
+
+type Args struct {
+	Target string
+	Action Action
+	// StopTime is the stop time of the application in seconds, when starting it. Zero keeps the stop time of the
+	// application.
+	StopTime float64
+}
+
+type Config interface {
+	RealTimeTargets() []string
+}
+
+type ApprovalGate interface {
+	ApproveTargetAction(ctx context.Context, target string, description string) error
+}
+
+type Usecase struct {
+	config       Config
+	approvalGate ApprovalGate
+}
+
+func New(
+	config Config,
+	approvalGate ApprovalGate,
+) *Usecase {
+	return &Usecase{
+		config:       config,
+		approvalGate: approvalGate,
+	}
+}
+
+// Execute starts or stops the real-time application loaded on a Simulink Real-Time target.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (realtimetarget.Status, error) {
+	sessionLogger.Debug("Entering ControlRealTimeApplication Usecase")
+	defer sessionLogger.Debug("Exiting ControlRealTimeApplication Usecase")
+
+	if err := realtimetarget.ValidateTarget(u.config.RealTimeTargets(), request.Target); err != nil {
+		sessionLogger.WithError(err).With("target", request.Target).Warn("Real-time target rejected")
+		return realtimetarget.Status{}, err
+	}
+
+	var arguments []string
+	var description string
+	switch request.Action {
+	case ActionStart:
+		if request.StopTime < 0 {
+			return realtimetarget.Status{}, entities.NewCodedError(entities.ErrorCodeInvalidInput, errors.New("the stop time must be positive"))
+		}
+		stopTime := ""
+		description = "Start the real-time application loaded on the target."
+		if request.StopTime > 0 {
+			stopTime = strconv.FormatFloat(request.StopTime, 'g', -1, 64)
+			description = fmt.Sprintf("Start the real-time application loaded on the target, and stop it after %s seconds.", stopTime)
+		}
+		arguments = []string{string(ActionStart), request.Target, stopTime}
+	case ActionStop:
+		description = "Stop the real-time application running on the target."
+		arguments = []string{string(ActionStop), request.Target}
+	default:
+		return realtimetarget.Status{}, entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("invalid action %q: use %s or %s", request.Action, ActionStart, ActionStop))
+	}
+
+	if err := u.approvalGate.ApproveTargetAction(ctx, request.Target, description); err != nil {
+		sessionLogger.WithError(err).Warn("Real-time target action not approved by the user")
+		return realtimetarget.Status{}, err
+	}
+
+	var result struct {
+		Status realtimetarget.Status `json:"status"`
+	}
+	if err := realtimetarget.Call(ctx, sessionLogger, client, arguments, &result); err != nil {
+		return realtimetarget.Status{}, err
+	}
+
+	sessionLogger.With("target", request.Target).With("action", string(request.Action)).Info("Controlled real-time application")
+
+	return result.Status, nil
+}
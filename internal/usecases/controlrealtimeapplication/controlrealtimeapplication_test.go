@@ -0,0 +1,154 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package controlrealtimeapplication_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/controlrealtimeapplication"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/controlrealtimeapplication"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const runningStatusJSON = `{"status":{"target":"rig1","connected":true,"loaded":true,"running":true,"application":"controller"}}`
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	// Act
+	usecase := controlrealtimeapplication.New(mockConfig, mockApprovalGate)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	testConfigs := []struct {
+		name                string
+		request             controlrealtimeapplication.Args
+		expectedDescription string
+		expectedArguments   []string
+	}{
+		{
+			name:                "start with a stop time",
+			request:             controlrealtimeapplication.Args{Target: "rig1", Action: controlrealtimeapplication.ActionStart, StopTime: 10},
+			expectedDescription: "Start the real-time application loaded on the target, and stop it after 10 seconds.",
+			expectedArguments:   []string{"start", "rig1", "10"},
+		},
+		{
+			name:                "start until the stop time of the application",
+			request:             controlrealtimeapplication.Args{Target: "rig1", Action: controlrealtimeapplication.ActionStart},
+			expectedDescription: "Start the real-time application loaded on the target.",
+			expectedArguments:   []string{"start", "rig1", ""},
+		},
+		{
+			name:                "stop",
+			request:             controlrealtimeapplication.Args{Target: "rig1", Action: controlrealtimeapplication.ActionStop},
+			expectedDescription: "Stop the real-time application running on the target.",
+			expectedArguments:   []string{"stop", "rig1"},
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockApprovalGate := &mocks.MockApprovalGate{}
+			defer mockApprovalGate.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			ctx := t.Context()
+
+			mockConfig.EXPECT().
+				RealTimeTargets().
+				Return([]string{"rig1"}).
+				Once()
+
+			mockApprovalGate.EXPECT().
+				ApproveTargetAction(ctx, "rig1", testConfig.expectedDescription).
+				Return(nil).
+				Once()
+
+			mockClient.EXPECT().
+				FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+					Function:   "matlab_mcp.realTimeTarget",
+					Arguments:  testConfig.expectedArguments,
+					NumOutputs: 1,
+				}).
+				Return(entities.FEvalResponse{Outputs: []any{runningStatusJSON}}, nil).
+				Once()
+
+			usecase := controlrealtimeapplication.New(mockConfig, mockApprovalGate)
+
+			// Act
+			status, err := usecase.Execute(ctx, mockLogger, mockClient, testConfig.request)
+
+			// Assert
+			require.NoError(t, err)
+			assert.True(t, status.Running)
+			assert.Equal(t, "controller", status.Application)
+		})
+	}
+}
+
+func TestUsecase_Execute_InvalidInput(t *testing.T) {
+	testConfigs := []struct {
+		name    string
+		request controlrealtimeapplication.Args
+	}{
+		{
+			name:    "unknown action",
+			request: controlrealtimeapplication.Args{Target: "rig1", Action: "reboot"},
+		},
+		{
+			name:    "negative stop time",
+			request: controlrealtimeapplication.Args{Target: "rig1", Action: controlrealtimeapplication.ActionStart, StopTime: -1},
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockApprovalGate := &mocks.MockApprovalGate{}
+			defer mockApprovalGate.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			mockConfig.EXPECT().
+				RealTimeTargets().
+				Return([]string{"rig1"}).
+				Once()
+
+			usecase := controlrealtimeapplication.New(mockConfig, mockApprovalGate)
+
+			// Act
+			_, err := usecase.Execute(t.Context(), mockLogger, mockClient, testConfig.request)
+
+			// Assert
+			var codedErr *entities.CodedError
+			require.ErrorAs(t, err, &codedErr)
+			assert.Equal(t, entities.ErrorCodeInvalidInput, codedErr.ErrorCode())
+		})
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package deployproductionarchive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/productionserver"
+)
+
+const archiveFilePermissions = 0o644
+
+// partialSuffix is the suffix of an archive being copied, which MATLAB Production Server ignores, so that it never
+// deploys an incomplete archive.
+const partialSuffix = ".part"
+
+type Args struct {
+	ArchivePath string
+}
+
+type ReturnArgs struct {
+	Archive      string
+	DeployedPath string
+	Replaced     bool
+}
+
+type Config interface {
+	ProductionServerURL() string
+	ProductionServerDeployFolder() string
+}
+
+type PathValidator interface {
+	ValidateFilePath(ctx context.Context, filePath string) (string, error)
+}
+
+type ApprovalGate interface {
+	ApproveDeployment(ctx context.Context, server string, description string) error
+}
+
+type OSLayer interface {
+	ReadFile(name string) ([]byte, error)
+	WriteFile(name string, data []byte, perm os.FileMode) error
+	Rename(oldPath string, newPath string) error
+	Stat(name string) (osfacade.FileInfo, error)
+}
+
+type Usecase struct {
+	config        Config
+	pathValidator PathValidator
+	approvalGate  ApprovalGate
+	osLayer       OSLayer
+}
+
+func New(
+	config Config,
+	pathValidator PathValidator,
+	approvalGate ApprovalGate,
+	osLayer OSLayer,
+) *Usecase {
+	return &Usecase{
+		config:        config,
+		pathValidator: pathValidator,
+		approvalGate:  approvalGate,
+		osLayer:       osLayer,
+	}
+}
+
+// Execute deploys a deployable archive to the MATLAB Production Server instance, by copying it to its auto_deploy
+// folder, replacing the archive with the same name. The instance deploys the archive once it finds it there.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering DeployProductionArchive Usecase")
+	defer sessionLogger.Debug("Exiting DeployProductionArchive Usecase")
+
+	deployFolder := u.config.ProductionServerDeployFolder()
+	if deployFolder == "" {
+		return ReturnArgs{}, entities.NewCodedError(entities.ErrorCodePermissionDenied, errors.New("archives cannot be deployed: the auto_deploy folder of the MATLAB Production Server instance is not configured"))
+	}
+
+	if !strings.EqualFold(filepath.Ext(request.ArchivePath), ".ctf") {
+		return ReturnArgs{}, entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("%s is not a deployable archive: use the .ctf file returned by package_production_archive", request.ArchivePath))
+	}
+
+	archive := strings.TrimSuffix(filepath.Base(request.ArchivePath), filepath.Ext(request.ArchivePath))
+	if err := productionserver.ValidateName(archive); err != nil {
+		return ReturnArgs{}, err
+	}
+
+	validatedPath, err := u.pathValidator.ValidateFilePath(ctx, request.ArchivePath)
+	if err != nil {
+		sessionLogger.WithError(err).With("path", request.ArchivePath).Warn("Path validation failed")
+		return ReturnArgs{}, fmt.Errorf("path validation failed: %w", err)
+	}
+
+	deployedPath := filepath.Join(deployFolder, archive+".ctf")
+	replaced := true
+	if _, err := u.osLayer.Stat(deployedPath); errors.Is(err, fs.ErrNotExist) {
+		replaced = false
+	}
+
+	description := fmt.Sprintf("Deploy the archive %s as %s.", validatedPath, archive)
+	if replaced {
+		description += " It replaces the archive with the same name, and the functions it serves."
+	}
+	if err := u.approvalGate.ApproveDeployment(ctx, u.config.ProductionServerURL(), description); err != nil {
+		sessionLogger.WithError(err).Warn("Deployment not approved by the user")
+		return ReturnArgs{}, err
+	}
+
+	content, err := u.osLayer.ReadFile(validatedPath)
+	if err != nil {
+		return ReturnArgs{}, fmt.Errorf("failed to read archive: %w", err)
+	}
+
+	if err := u.osLayer.WriteFile(deployedPath+partialSuffix, content, archiveFilePermissions); err != nil {
+		return ReturnArgs{}, fmt.Errorf("failed to copy archive to the auto_deploy folder: %w", err)
+	}
+
+	if err := u.osLayer.Rename(deployedPath+partialSuffix, deployedPath); err != nil {
+		return ReturnArgs{}, fmt.Errorf("failed to copy archive to the auto_deploy folder: %w", err)
+	}
+
+	sessionLogger.With("archive", archive).With("path", deployedPath).Info("Deployed archive to MATLAB Production Server")
+
+	return ReturnArgs{
+		Archive:      archive,
+		DeployedPath: deployedPath,
+		Replaced:     replaced,
+	}, nil
+}
@@ -0,0 +1,285 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package deployproductionarchive_test
+
+import (
+	"errors"
+	"io/fs"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/deployproductionarchive"
+	osfacademocks "github.com/matlab/matlab-mcp-core-server/mocks/facades/osfacade"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/deployproductionarchive"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	serverURL    = "http://mps.example.com:9910"
+	deployFolder = "/opt/mps/auto_deploy"
+	archivePath  = "/home/user/pricing/pricing_archive/pricing.ctf"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	// Act
+	usecase := deployproductionarchive.New(mockConfig, mockPathValidator, mockApprovalGate, mockOSLayer)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	testConfigs := []struct {
+		name                string
+		statError           error
+		expectedDescription string
+		expectedReplaced    bool
+	}{
+		{
+			name:                "new archive",
+			statError:           fs.ErrNotExist,
+			expectedDescription: "Deploy the archive " + archivePath + " as pricing.",
+			expectedReplaced:    false,
+		},
+		{
+			name:                "replaced archive",
+			statError:           nil,
+			expectedDescription: "Deploy the archive " + archivePath + " as pricing. It replaces the archive with the same name, and the functions it serves.",
+			expectedReplaced:    true,
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			mockApprovalGate := &mocks.MockApprovalGate{}
+			defer mockApprovalGate.AssertExpectations(t)
+
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			usecase := deployproductionarchive.New(mockConfig, mockPathValidator, mockApprovalGate, mockOSLayer)
+
+			ctx := t.Context()
+			content := []byte("archive")
+			const deployedPath = deployFolder + "/pricing.ctf"
+
+			mockConfig.EXPECT().
+				ProductionServerDeployFolder().
+				Return(deployFolder).
+				Once()
+
+			mockConfig.EXPECT().
+				ProductionServerURL().
+				Return(serverURL).
+				Once()
+
+			mockPathValidator.EXPECT().
+				ValidateFilePath(ctx, archivePath).
+				Return(archivePath, nil).
+				Once()
+
+			mockOSLayer.EXPECT().
+				Stat(deployedPath).
+				Return(&osfacademocks.MockFileInfo{}, testConfig.statError).
+				Once()
+
+			mockApprovalGate.EXPECT().
+				ApproveDeployment(ctx, serverURL, testConfig.expectedDescription).
+				Return(nil).
+				Once()
+
+			mockOSLayer.EXPECT().
+				ReadFile(archivePath).
+				Return(content, nil).
+				Once()
+
+			mockOSLayer.EXPECT().
+				WriteFile(deployedPath+".part", content, fs.FileMode(0o644)).
+				Return(nil).
+				Once()
+
+			mockOSLayer.EXPECT().
+				Rename(deployedPath+".part", deployedPath).
+				Return(nil).
+				Once()
+
+			// Act
+			result, err := usecase.Execute(ctx, mockLogger, deployproductionarchive.Args{
+				ArchivePath: archivePath,
+			})
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, deployproductionarchive.ReturnArgs{
+				Archive:      "pricing",
+				DeployedPath: deployedPath,
+				Replaced:     testConfig.expectedReplaced,
+			}, result)
+		})
+	}
+}
+
+func TestUsecase_Execute_NoDeployFolder(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	usecase := deployproductionarchive.New(mockConfig, mockPathValidator, mockApprovalGate, mockOSLayer)
+
+	mockConfig.EXPECT().
+		ProductionServerDeployFolder().
+		Return("").
+		Once()
+
+	// Act
+	_, err := usecase.Execute(t.Context(), mockLogger, deployproductionarchive.Args{
+		ArchivePath: archivePath,
+	})
+
+	// Assert
+	var codedErr *entities.CodedError
+	require.ErrorAs(t, err, &codedErr)
+	assert.Equal(t, entities.ErrorCodePermissionDenied, codedErr.ErrorCode())
+}
+
+func TestUsecase_Execute_NotAnArchive(t *testing.T) {
+	testCases := []struct {
+		name        string
+		archivePath string
+	}{
+		{
+			name:        "not a .ctf file",
+			archivePath: "/home/user/pricing/price.m",
+		},
+		{
+			name:        "archive name is not a MATLAB identifier",
+			archivePath: "/home/user/pricing/pricing-v2.ctf",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			mockApprovalGate := &mocks.MockApprovalGate{}
+			defer mockApprovalGate.AssertExpectations(t)
+
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			usecase := deployproductionarchive.New(mockConfig, mockPathValidator, mockApprovalGate, mockOSLayer)
+
+			mockConfig.EXPECT().
+				ProductionServerDeployFolder().
+				Return(deployFolder).
+				Once()
+
+			// Act
+			_, err := usecase.Execute(t.Context(), mockLogger, deployproductionarchive.Args{
+				ArchivePath: testCase.archivePath,
+			})
+
+			// Assert
+			var codedErr *entities.CodedError
+			require.ErrorAs(t, err, &codedErr)
+			assert.Equal(t, entities.ErrorCodeInvalidInput, codedErr.ErrorCode())
+		})
+	}
+}
+
+func TestUsecase_Execute_NotApproved(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	usecase := deployproductionarchive.New(mockConfig, mockPathValidator, mockApprovalGate, mockOSLayer)
+
+	ctx := t.Context()
+	expectedError := entities.NewCodedError(entities.ErrorCodePermissionDenied, errors.New("not approved"))
+
+	mockConfig.EXPECT().
+		ProductionServerDeployFolder().
+		Return(deployFolder).
+		Once()
+
+	mockConfig.EXPECT().
+		ProductionServerURL().
+		Return(serverURL).
+		Once()
+
+	mockPathValidator.EXPECT().
+		ValidateFilePath(ctx, archivePath).
+		Return(archivePath, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(deployFolder+"/pricing.ctf").
+		Return(nil, fs.ErrNotExist).
+		Once()
+
+	mockApprovalGate.EXPECT().
+		ApproveDeployment(ctx, serverURL, "Deploy the archive "+archivePath+" as pricing.").
+		Return(expectedError).
+		Once()
+
+	// Act
+	_, err := usecase.Execute(ctx, mockLogger, deployproductionarchive.Args{
+		ArchivePath: archivePath,
+	})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+}
@@ -0,0 +1,87 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package deployrealtimeapplication
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/realtimetarget"
+)
+
+type Args struct {
+	Target          string
+	ApplicationPath string
+}
+
+type Config interface {
+	RealTimeTargets() []string
+}
+
+type PathValidator interface {
+	ValidateFilePath(ctx context.Context, filePath string) (string, error)
+}
+
+type ApprovalGate interface {
+	ApproveTargetAction(ctx context.Context, target string, description string) error
+}
+
+type Usecase struct {
+	config        Config
+	pathValidator PathValidator
+	approvalGate  ApprovalGate
+}
+
+func New(
+	config Config,
+	pathValidator PathValidator,
+	approvalGate ApprovalGate,
+) *Usecase {
+	return &Usecase{
+		config:        config,
+		pathValidator: pathValidator,
+		approvalGate:  approvalGate,
+	}
+}
+
+// Execute connects to a Simulink Real-Time target, and loads a real-time application on it, replacing the application
+// loaded before. The application does not start.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (realtimetarget.Status, error) {
+	sessionLogger.Debug("Entering DeployRealTimeApplication Usecase")
+	defer sessionLogger.Debug("Exiting DeployRealTimeApplication Usecase")
+
+	if err := realtimetarget.ValidateTarget(u.config.RealTimeTargets(), request.Target); err != nil {
+		sessionLogger.WithError(err).With("target", request.Target).Warn("Real-time target rejected")
+		return realtimetarget.Status{}, err
+	}
+
+	if !strings.EqualFold(filepath.Ext(request.ApplicationPath), ".mldatx") {
+		return realtimetarget.Status{}, entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("%s is not a real-time application: use the .mldatx file built from the model", request.ApplicationPath))
+	}
+
+	validatedPath, err := u.pathValidator.ValidateFilePath(ctx, request.ApplicationPath)
+	if err != nil {
+		sessionLogger.WithError(err).With("path", request.ApplicationPath).Warn("Path validation failed")
+		return realtimetarget.Status{}, fmt.Errorf("path validation failed: %w", err)
+	}
+
+	description := fmt.Sprintf("Load the real-time application %s, replacing the application loaded on the target.", validatedPath)
+	if err := u.approvalGate.ApproveTargetAction(ctx, request.Target, description); err != nil {
+		sessionLogger.WithError(err).Warn("Real-time target action not approved by the user")
+		return realtimetarget.Status{}, err
+	}
+
+	var result struct {
+		Status realtimetarget.Status `json:"status"`
+	}
+	if err := realtimetarget.Call(ctx, sessionLogger, client, []string{"deploy", request.Target, validatedPath}, &result); err != nil {
+		return realtimetarget.Status{}, err
+	}
+
+	sessionLogger.With("target", request.Target).With("application", validatedPath).Info("Deployed real-time application")
+
+	return result.Status, nil
+}
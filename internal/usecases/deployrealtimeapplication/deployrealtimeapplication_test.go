@@ -0,0 +1,213 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package deployrealtimeapplication_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/deployrealtimeapplication"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/realtimetarget"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/deployrealtimeapplication"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	// Act
+	usecase := deployrealtimeapplication.New(mockConfig, mockPathValidator, mockApprovalGate)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := deployrealtimeapplication.New(mockConfig, mockPathValidator, mockApprovalGate)
+
+	ctx := t.Context()
+	const applicationPath = "/home/user/rig/controller.mldatx"
+
+	mockConfig.EXPECT().
+		RealTimeTargets().
+		Return([]string{"rig1"}).
+		Once()
+
+	mockPathValidator.EXPECT().
+		ValidateFilePath(ctx, applicationPath).
+		Return(applicationPath, nil).
+		Once()
+
+	mockApprovalGate.EXPECT().
+		ApproveTargetAction(ctx, "rig1", "Load the real-time application /home/user/rig/controller.mldatx, replacing the application loaded on the target.").
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.realTimeTarget",
+			Arguments:  []string{"deploy", "rig1", applicationPath},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`{"status":{"target":"rig1","connected":true,"loaded":true,"running":false,"application":"controller"}}`}}, nil).
+		Once()
+
+	// Act
+	status, err := usecase.Execute(ctx, mockLogger, mockClient, deployrealtimeapplication.Args{
+		Target:          "rig1",
+		ApplicationPath: applicationPath,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, realtimetarget.Status{
+		Target:      "rig1",
+		Connected:   true,
+		Loaded:      true,
+		Application: "controller",
+	}, status)
+}
+
+func TestUsecase_Execute_TargetNotAllowed(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := deployrealtimeapplication.New(mockConfig, mockPathValidator, mockApprovalGate)
+
+	mockConfig.EXPECT().
+		RealTimeTargets().
+		Return([]string{"rig1"}).
+		Once()
+
+	// Act
+	_, err := usecase.Execute(t.Context(), mockLogger, mockClient, deployrealtimeapplication.Args{
+		Target:          "production",
+		ApplicationPath: "/home/user/rig/controller.mldatx",
+	})
+
+	// Assert
+	var codedErr *entities.CodedError
+	require.ErrorAs(t, err, &codedErr)
+	assert.Equal(t, entities.ErrorCodePermissionDenied, codedErr.ErrorCode())
+}
+
+func TestUsecase_Execute_NotAnApplication(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := deployrealtimeapplication.New(mockConfig, mockPathValidator, mockApprovalGate)
+
+	mockConfig.EXPECT().
+		RealTimeTargets().
+		Return([]string{"rig1"}).
+		Once()
+
+	// Act
+	_, err := usecase.Execute(t.Context(), mockLogger, mockClient, deployrealtimeapplication.Args{
+		Target:          "rig1",
+		ApplicationPath: "/home/user/rig/controller.slx",
+	})
+
+	// Assert
+	var codedErr *entities.CodedError
+	require.ErrorAs(t, err, &codedErr)
+	assert.Equal(t, entities.ErrorCodeInvalidInput, codedErr.ErrorCode())
+}
+
+func TestUsecase_Execute_NotApproved(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := deployrealtimeapplication.New(mockConfig, mockPathValidator, mockApprovalGate)
+
+	ctx := t.Context()
+	const applicationPath = "/home/user/rig/controller.mldatx"
+	expectedError := entities.NewCodedError(entities.ErrorCodePermissionDenied, errors.New("not approved"))
+
+	mockConfig.EXPECT().
+		RealTimeTargets().
+		Return([]string{"rig1"}).
+		Once()
+
+	mockPathValidator.EXPECT().
+		ValidateFilePath(ctx, applicationPath).
+		Return(applicationPath, nil).
+		Once()
+
+	mockApprovalGate.EXPECT().
+		ApproveTargetAction(ctx, "rig1", "Load the real-time application /home/user/rig/controller.mldatx, replacing the application loaded on the target.").
+		Return(expectedError).
+		Once()
+
+	// Act
+	_, err := usecase.Execute(ctx, mockLogger, mockClient, deployrealtimeapplication.Args{
+		Target:          "rig1",
+		ApplicationPath: applicationPath,
+	})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+}
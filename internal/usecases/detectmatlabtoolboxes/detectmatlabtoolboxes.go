@@ -8,11 +8,23 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 )
 
+// lookupCacheKey identifies the list of installed toolboxes in the lookup cache.
+const lookupCacheKey = "ver"
+
+type LookupCache interface {
+	Lookup(logger entities.Logger, key string, fetch func() (string, error)) (string, error)
+}
+
 type Usecase struct {
+	lookupCache LookupCache
 }
 
-func New() *Usecase {
-	return &Usecase{}
+func New(
+	lookupCache LookupCache,
+) *Usecase {
+	return &Usecase{
+		lookupCache: lookupCache,
+	}
 }
 
 type ReturnArgs struct {
@@ -23,16 +35,23 @@ func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, cl
 	sessionLogger.Debug("Entering DetectMATLABToolboxes Usecase")
 	defer sessionLogger.Debug("Exiting DetectMATLABToolboxes Usecase")
 
-	verRequest := entities.EvalRequest{
-		Code: "ver",
-	}
+	toolboxes, err := u.lookupCache.Lookup(sessionLogger, lookupCacheKey, func() (string, error) {
+		verRequest := entities.EvalRequest{
+			Code: "ver",
+		}
+
+		ver, err := client.Eval(ctx, sessionLogger, verRequest)
+		if err != nil {
+			return "", err
+		}
 
-	ver, err := client.Eval(ctx, sessionLogger, verRequest)
+		return ver.ConsoleOutput, nil
+	})
 	if err != nil {
 		return ReturnArgs{}, err
 	}
 
 	return ReturnArgs{
-		Toolboxes: ver.ConsoleOutput,
+		Toolboxes: toolboxes,
 	}, nil
 }
@@ -9,15 +9,19 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/detectmatlabtoolboxes"
 	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/detectmatlabtoolboxes"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
 func TestNew_HappyPath(t *testing.T) {
 	// Arrange
+	mockLookupCache := &mocks.MockLookupCache{}
+	defer mockLookupCache.AssertExpectations(t)
 
 	// Act
-	usecase := detectmatlabtoolboxes.New()
+	usecase := detectmatlabtoolboxes.New(mockLookupCache)
 
 	// Assert
 	assert.NotNil(t, usecase, "Usecase should not be nil")
@@ -27,6 +31,9 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
+	mockLookupCache := &mocks.MockLookupCache{}
+	defer mockLookupCache.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -45,12 +52,19 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 
 	ctx := t.Context()
 
+	mockLookupCache.EXPECT().
+		Lookup(mockLogger.AsMockArg(), "ver", mock.Anything).
+		RunAndReturn(func(logger entities.Logger, key string, fetch func() (string, error)) (string, error) {
+			return fetch()
+		}).
+		Once()
+
 	mockClient.EXPECT().
 		Eval(ctx, mockLogger.AsMockArg(), evalRequest).
 		Return(evalResponse, nil).
 		Once()
 
-	usecase := detectmatlabtoolboxes.New()
+	usecase := detectmatlabtoolboxes.New(mockLookupCache)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient)
@@ -64,6 +78,9 @@ func TestUsecase_Execute_EvalError(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
+	mockLookupCache := &mocks.MockLookupCache{}
+	defer mockLookupCache.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -75,12 +92,19 @@ func TestUsecase_Execute_EvalError(t *testing.T) {
 
 	ctx := t.Context()
 
+	mockLookupCache.EXPECT().
+		Lookup(mockLogger.AsMockArg(), "ver", mock.Anything).
+		RunAndReturn(func(logger entities.Logger, key string, fetch func() (string, error)) (string, error) {
+			return fetch()
+		}).
+		Once()
+
 	mockClient.EXPECT().
 		Eval(ctx, mockLogger.AsMockArg(), evalRequest).
 		Return(entities.EvalResponse{ConsoleOutput: "some output that shouldn't be because there's an error"}, expectedError).
 		Once()
 
-	usecase := detectmatlabtoolboxes.New()
+	usecase := detectmatlabtoolboxes.New(mockLookupCache)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient)
@@ -89,3 +113,28 @@ func TestUsecase_Execute_EvalError(t *testing.T) {
 	require.ErrorIs(t, err, expectedError, "Error should be the original error")
 	assert.Empty(t, response, "Response should be empty when there's an error")
 }
+
+func TestUsecase_Execute_Cached(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLookupCache := &mocks.MockLookupCache{}
+	defer mockLookupCache.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLookupCache.EXPECT().
+		Lookup(mockLogger.AsMockArg(), "ver", mock.Anything).
+		Return("Cached toolbox list", nil).
+		Once()
+
+	usecase := detectmatlabtoolboxes.New(mockLookupCache)
+
+	// Act
+	response, err := usecase.Execute(t.Context(), mockLogger, mockClient)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, detectmatlabtoolboxes.ReturnArgs{Toolboxes: "Cached toolbox list"}, response, "A cached result should not be fetched from MATLAB")
+}
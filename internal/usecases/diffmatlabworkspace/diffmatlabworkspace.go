@@ -0,0 +1,94 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package diffmatlabworkspace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// Variable is a variable of the workspace that was added or modified, with a short preview of its value.
+// The preview is empty for variables that are too large to be compared by value, or that cannot be displayed.
+type Variable struct {
+	Name    string `json:"name"`
+	Class   string `json:"class"`
+	Size    []int  `json:"size"`
+	Bytes   int    `json:"bytes"`
+	Preview string `json:"preview"`
+}
+
+type ReturnArgs struct {
+	Added    []Variable `json:"added"`
+	Modified []Variable `json:"modified"`
+	Removed  []string   `json:"removed"`
+}
+
+// Empty reports whether the workspace did not change.
+func (r ReturnArgs) Empty() bool {
+	return len(r.Added) == 0 && len(r.Modified) == 0 && len(r.Removed) == 0
+}
+
+type Config interface {
+	WorkspaceDiff() bool
+	VariablePreviewThreshold() int
+}
+
+type Usecase struct {
+	config Config
+}
+
+func New(
+	config Config,
+) *Usecase {
+	return &Usecase{
+		config: config,
+	}
+}
+
+// Execute returns the variables of the workspace of the MATLAB session that were added, modified or removed since
+// the previous call. MATLAB keeps the snapshot of the workspace, so the first call reports every variable as added.
+// Variables larger than the preview threshold are only compared by class, size and number of bytes.
+// It returns no changes if workspace diffing is disabled.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) (ReturnArgs, error) {
+	if !u.config.WorkspaceDiff() {
+		return ReturnArgs{}, nil
+	}
+
+	sessionLogger.Debug("Entering DiffMATLABWorkspace Usecase")
+	defer sessionLogger.Debug("Exiting DiffMATLABWorkspace Usecase")
+
+	response, err := client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+		Function:   "matlab_mcp.workspaceDiff",
+		Arguments:  []string{strconv.Itoa(u.config.VariablePreviewThreshold())},
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	if len(response.Outputs) != 1 {
+		return ReturnArgs{}, fmt.Errorf("unexpected number of outputs from MATLAB session")
+	}
+
+	output, ok := response.Outputs[0].(string)
+	if !ok {
+		return ReturnArgs{}, fmt.Errorf("failed to cast output to string")
+	}
+
+	var diff ReturnArgs
+	if err := json.Unmarshal([]byte(output), &diff); err != nil {
+		return ReturnArgs{}, fmt.Errorf("failed to parse workspace diff: %w", err)
+	}
+
+	sessionLogger.
+		With("added", len(diff.Added)).
+		With("modified", len(diff.Modified)).
+		With("removed", len(diff.Removed)).
+		Debug("Diffed MATLAB workspace")
+
+	return diff, nil
+}
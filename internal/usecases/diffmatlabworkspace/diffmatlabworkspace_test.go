@@ -0,0 +1,227 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package diffmatlabworkspace_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/diffmatlabworkspace"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/diffmatlabworkspace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	// Act
+	usecase := diffmatlabworkspace.New(mockConfig)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		WorkspaceDiff().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		VariablePreviewThreshold().
+		Return(1048576).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.workspaceDiff",
+			Arguments:  []string{"1048576"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{
+			Outputs: []any{`{"added":[{"name":"x","class":"double","size":[1,1],"bytes":8,"preview":"5"}],"modified":[{"name":"data","class":"double","size":[1000,1000],"bytes":8000000,"preview":""}],"removed":["tmp"]}`},
+		}, nil).
+		Once()
+
+	usecase := diffmatlabworkspace.New(mockConfig)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, diffmatlabworkspace.ReturnArgs{
+		Added: []diffmatlabworkspace.Variable{
+			{Name: "x", Class: "double", Size: []int{1, 1}, Bytes: 8, Preview: "5"},
+		},
+		Modified: []diffmatlabworkspace.Variable{
+			{Name: "data", Class: "double", Size: []int{1000, 1000}, Bytes: 8000000, Preview: ""},
+		},
+		Removed: []string{"tmp"},
+	}, result)
+	assert.False(t, result.Empty())
+}
+
+func TestUsecase_Execute_NoChanges(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		WorkspaceDiff().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		VariablePreviewThreshold().
+		Return(0).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.workspaceDiff",
+			Arguments:  []string{"0"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`{"added":[],"modified":[],"removed":[]}`}}, nil).
+		Once()
+
+	usecase := diffmatlabworkspace.New(mockConfig)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, result.Empty())
+}
+
+func TestUsecase_Execute_Disabled(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		WorkspaceDiff().
+		Return(false).
+		Once()
+
+	usecase := diffmatlabworkspace.New(mockConfig)
+
+	// Act
+	result, err := usecase.Execute(t.Context(), mockLogger, mockClient)
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, result.Empty())
+}
+
+func TestUsecase_Execute_FEvalError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		WorkspaceDiff().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		VariablePreviewThreshold().
+		Return(0).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.workspaceDiff",
+			Arguments:  []string{"0"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{}, assert.AnError).
+		Once()
+
+	usecase := diffmatlabworkspace.New(mockConfig)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_UnexpectedOutput(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		WorkspaceDiff().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		VariablePreviewThreshold().
+		Return(0).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.workspaceDiff",
+			Arguments:  []string{"0"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{"not json"}}, nil).
+		Once()
+
+	usecase := diffmatlabworkspace.New(mockConfig)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.Error(t, err)
+	assert.Empty(t, result)
+}
@@ -16,18 +16,39 @@ type Args struct {
 }
 
 type PathValidator interface {
-	ValidateFolderPath(filePath string) (string, error)
+	ValidateFolderPath(ctx context.Context, filePath string) (string, error)
+}
+
+type CodePolicy interface {
+	CheckCode(code string) error
+}
+
+type ApprovalGate interface {
+	ApproveCode(ctx context.Context, code string) error
+}
+
+type LookupCache interface {
+	InvalidateForCode(logger entities.Logger, code string)
 }
 
 type Usecase struct {
 	pathValidator PathValidator
+	codePolicy    CodePolicy
+	approvalGate  ApprovalGate
+	lookupCache   LookupCache
 }
 
 func New(
 	pathValidator PathValidator,
+	codePolicy CodePolicy,
+	approvalGate ApprovalGate,
+	lookupCache LookupCache,
 ) *Usecase {
 	return &Usecase{
 		pathValidator: pathValidator,
+		codePolicy:    codePolicy,
+		approvalGate:  approvalGate,
+		lookupCache:   lookupCache,
 	}
 }
 
@@ -35,12 +56,22 @@ func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, cl
 	sessionLogger.Debug("Entering EvalInlMATLAB Usecase")
 	defer sessionLogger.Debug("Exiting EvalInMATLAB Usecase")
 
-	validatedPath, err := u.pathValidator.ValidateFolderPath(request.ProjectPath)
+	if err := u.codePolicy.CheckCode(request.Code); err != nil {
+		sessionLogger.WithError(err).Warn("Code rejected by the code policy")
+		return entities.EvalResponse{}, err
+	}
+
+	validatedPath, err := u.pathValidator.ValidateFolderPath(ctx, request.ProjectPath)
 	if err != nil {
 		sessionLogger.WithError(err).With("path", request.ProjectPath).Warn("Path validation failed")
 		return entities.EvalResponse{}, fmt.Errorf("path validation failed: %w", err)
 	}
 
+	if err := u.approvalGate.ApproveCode(ctx, request.Code); err != nil {
+		sessionLogger.WithError(err).Warn("Code not approved by the user")
+		return entities.EvalResponse{}, err
+	}
+
 	cdRequest := entities.EvalRequest{
 		Code: fmt.Sprintf("cd('%s')", strings.ReplaceAll(validatedPath, "'", "''")), // Escape single quotes
 	}
@@ -52,6 +83,10 @@ func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, cl
 	response, err := client.Eval(ctx, sessionLogger, entities.EvalRequest{
 		Code: request.Code,
 	})
+
+	// The code may have changed the MATLAB path before failing.
+	u.lookupCache.InvalidateForCode(sessionLogger, request.Code)
+
 	if err != nil {
 		return entities.EvalResponse{}, err
 	}
@@ -19,8 +19,17 @@ func TestNew_HappyPath(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockLookupCache := &mocks.MockLookupCache{}
+	defer mockLookupCache.AssertExpectations(t)
+
 	// Act
-	usecase := evalmatlabcode.New(mockPathValidator)
+	usecase := evalmatlabcode.New(mockPathValidator, mockCodePolicy, mockApprovalGate, mockLookupCache)
 
 	// Assert
 	assert.NotNil(t, usecase, "Usecase should not be nil")
@@ -33,6 +42,15 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockLookupCache := &mocks.MockLookupCache{}
+	defer mockLookupCache.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -44,6 +62,11 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 		Code:        "disp('Hello, World!')",
 	}
 
+	mockCodePolicy.EXPECT().
+		CheckCode(evalRequest.Code).
+		Return(nil).
+		Once()
+
 	expectedResponse := entities.EvalResponse{
 		ConsoleOutput: "Hello, World!",
 		Images:        nil,
@@ -52,10 +75,15 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 	ctx := t.Context()
 
 	mockPathValidator.EXPECT().
-		ValidateFolderPath(projectPath).
+		ValidateFolderPath(ctx, projectPath).
 		Return(validatedProjectPath, nil).
 		Once()
 
+	mockApprovalGate.EXPECT().
+		ApproveCode(ctx, evalRequest.Code).
+		Return(nil).
+		Once()
+
 	mockClient.EXPECT().
 		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{
 			Code: "cd('" + validatedProjectPath + "')",
@@ -68,7 +96,11 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 		Return(expectedResponse, nil).
 		Once()
 
-	usecase := evalmatlabcode.New(mockPathValidator)
+	mockLookupCache.EXPECT().
+		InvalidateForCode(mockLogger.AsMockArg(), evalRequest.Code).
+		Once()
+
+	usecase := evalmatlabcode.New(mockPathValidator, mockCodePolicy, mockApprovalGate, mockLookupCache)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, evalRequest)
@@ -85,6 +117,15 @@ func TestUsecase_Execute_ValidatePathError(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockLookupCache := &mocks.MockLookupCache{}
+	defer mockLookupCache.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -97,12 +138,17 @@ func TestUsecase_Execute_ValidatePathError(t *testing.T) {
 		Code:        "disp('Hello, World!')",
 	}
 
+	mockCodePolicy.EXPECT().
+		CheckCode(evalRequest.Code).
+		Return(nil).
+		Once()
+
 	mockPathValidator.EXPECT().
-		ValidateFolderPath(projectPath).
+		ValidateFolderPath(ctx, projectPath).
 		Return("", expectedError).
 		Once()
 
-	usecase := evalmatlabcode.New(mockPathValidator)
+	usecase := evalmatlabcode.New(mockPathValidator, mockCodePolicy, mockApprovalGate, mockLookupCache)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, evalRequest)
@@ -119,6 +165,15 @@ func TestUsecase_Execute_CDEvalError(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockLookupCache := &mocks.MockLookupCache{}
+	defer mockLookupCache.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -130,15 +185,25 @@ func TestUsecase_Execute_CDEvalError(t *testing.T) {
 		ProjectPath: projectPath,
 	}
 
+	mockCodePolicy.EXPECT().
+		CheckCode(evalRequest.Code).
+		Return(nil).
+		Once()
+
 	expectedError := assert.AnError
 
 	ctx := t.Context()
 
 	mockPathValidator.EXPECT().
-		ValidateFolderPath(projectPath).
+		ValidateFolderPath(ctx, projectPath).
 		Return(validatedProjectPath, nil).
 		Once()
 
+	mockApprovalGate.EXPECT().
+		ApproveCode(ctx, evalRequest.Code).
+		Return(nil).
+		Once()
+
 	mockClient.EXPECT().
 		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{
 			Code: "cd('" + validatedProjectPath + "')",
@@ -146,7 +211,7 @@ func TestUsecase_Execute_CDEvalError(t *testing.T) {
 		Return(entities.EvalResponse{}, expectedError).
 		Once()
 
-	usecase := evalmatlabcode.New(mockPathValidator)
+	usecase := evalmatlabcode.New(mockPathValidator, mockCodePolicy, mockApprovalGate, mockLookupCache)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, evalRequest)
@@ -163,6 +228,15 @@ func TestUsecase_Execute_EvalError(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockLookupCache := &mocks.MockLookupCache{}
+	defer mockLookupCache.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -174,15 +248,25 @@ func TestUsecase_Execute_EvalError(t *testing.T) {
 		ProjectPath: projectPath,
 	}
 
+	mockCodePolicy.EXPECT().
+		CheckCode(evalRequest.Code).
+		Return(nil).
+		Once()
+
 	expectedError := assert.AnError
 
 	ctx := t.Context()
 
 	mockPathValidator.EXPECT().
-		ValidateFolderPath(projectPath).
+		ValidateFolderPath(ctx, projectPath).
 		Return(validatedProjectPath, nil).
 		Once()
 
+	mockApprovalGate.EXPECT().
+		ApproveCode(ctx, evalRequest.Code).
+		Return(nil).
+		Once()
+
 	mockClient.EXPECT().
 		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{
 			Code: "cd('" + validatedProjectPath + "')",
@@ -195,7 +279,11 @@ func TestUsecase_Execute_EvalError(t *testing.T) {
 		Return(entities.EvalResponse{ConsoleOutput: "some output that shouldn't be because there's an error"}, expectedError).
 		Once()
 
-	usecase := evalmatlabcode.New(mockPathValidator)
+	mockLookupCache.EXPECT().
+		InvalidateForCode(mockLogger.AsMockArg(), evalRequest.Code).
+		Once()
+
+	usecase := evalmatlabcode.New(mockPathValidator, mockCodePolicy, mockApprovalGate, mockLookupCache)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, evalRequest)
@@ -204,3 +292,97 @@ func TestUsecase_Execute_EvalError(t *testing.T) {
 	require.ErrorIs(t, err, expectedError, "Error should be the original error")
 	assert.Empty(t, response, "Response should be empty when there's an error")
 }
+
+func TestUsecase_Execute_CodePolicyError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockLookupCache := &mocks.MockLookupCache{}
+	defer mockLookupCache.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	expectedError := assert.AnError
+
+	evalRequest := evalmatlabcode.Args{
+		ProjectPath: "/some/path",
+		Code:        "system('ls')",
+	}
+
+	mockCodePolicy.EXPECT().
+		CheckCode(evalRequest.Code).
+		Return(expectedError).
+		Once()
+
+	usecase := evalmatlabcode.New(mockPathValidator, mockCodePolicy, mockApprovalGate, mockLookupCache)
+
+	// Act
+	response, err := usecase.Execute(t.Context(), mockLogger, mockClient, evalRequest)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, response)
+}
+
+func TestUsecase_Execute_ApprovalGateError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockLookupCache := &mocks.MockLookupCache{}
+	defer mockLookupCache.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	projectPath := "/some/path"
+	expectedError := assert.AnError
+
+	evalRequest := evalmatlabcode.Args{
+		ProjectPath: projectPath,
+		Code:        "x = 1",
+	}
+
+	mockCodePolicy.EXPECT().
+		CheckCode(evalRequest.Code).
+		Return(nil).
+		Once()
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(ctx, projectPath).
+		Return(projectPath, nil).
+		Once()
+
+	mockApprovalGate.EXPECT().
+		ApproveCode(ctx, evalRequest.Code).
+		Return(expectedError).
+		Once()
+
+	usecase := evalmatlabcode.New(mockPathValidator, mockCodePolicy, mockApprovalGate, mockLookupCache)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockClient, evalRequest)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, response)
+}
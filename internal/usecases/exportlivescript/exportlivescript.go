@@ -0,0 +1,159 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package exportlivescript
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/sessiontranscript"
+)
+
+const filePermissions = 0o644
+
+const (
+	scriptExtension     = ".m"
+	liveScriptExtension = ".mlx"
+)
+
+type Args struct {
+	FilePath  string
+	Title     string
+	Overwrite bool
+}
+
+type ReturnArgs struct {
+	FilePath string
+	Cells    int
+	Figures  []string
+}
+
+type Transcript interface {
+	Cells() ([]sessiontranscript.Cell, int)
+}
+
+type PathValidator interface {
+	ValidateFolderPath(ctx context.Context, filePath string) (string, error)
+}
+
+type OSLayer interface {
+	Stat(name string) (osfacade.FileInfo, error)
+	WriteFile(name string, data []byte, perm os.FileMode) error
+	RemoveAll(path string) error
+}
+
+type Usecase struct {
+	transcript    Transcript
+	pathValidator PathValidator
+	osLayer       OSLayer
+}
+
+func New(
+	transcript Transcript,
+	pathValidator PathValidator,
+	osLayer OSLayer,
+) *Usecase {
+	return &Usecase{
+		transcript:    transcript,
+		pathValidator: pathValidator,
+		osLayer:       osLayer,
+	}
+}
+
+// Execute writes the code run in the session, with its outputs and figures, as a script with publish markup, or as a
+// live script converted from that script by MATLAB. The figures are written next to the script, which refers to them.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering ExportLiveScript Usecase")
+	defer sessionLogger.Debug("Exiting ExportLiveScript Usecase")
+
+	extension := strings.ToLower(filepath.Ext(request.FilePath))
+	if extension != scriptExtension && extension != liveScriptExtension {
+		return ReturnArgs{}, entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("%s is neither a .m nor a .mlx file", request.FilePath))
+	}
+
+	cells, dropped := u.transcript.Cells()
+	if len(cells) == 0 {
+		return ReturnArgs{}, entities.NewCodedError(entities.ErrorCodeInvalidInput, errors.New("no code has run in this session yet"))
+	}
+
+	folder, err := u.pathValidator.ValidateFolderPath(ctx, filepath.Dir(request.FilePath))
+	if err != nil {
+		sessionLogger.WithError(err).With("path", request.FilePath).Warn("Path validation failed")
+		return ReturnArgs{}, fmt.Errorf("path validation failed: %w", err)
+	}
+
+	filePath := filepath.Join(folder, filepath.Base(request.FilePath))
+	name := strings.TrimSuffix(filepath.Base(filePath), filepath.Ext(filePath))
+
+	_, err = u.osLayer.Stat(filePath)
+	switch {
+	case err == nil && !request.Overwrite:
+		return ReturnArgs{}, entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("%s already exists, set overwrite to replace it", filePath))
+	case err != nil && !errors.Is(err, fs.ErrNotExist):
+		return ReturnArgs{}, fmt.Errorf("failed to access %s: %w", filePath, err)
+	}
+
+	title := request.Title
+	if title == "" {
+		title = name
+	}
+
+	script, figures := Render(title, name, cells, dropped)
+
+	figurePaths := make([]string, 0, len(figures))
+	for _, figure := range figures {
+		figurePath := filepath.Join(folder, figure.Name)
+		if err := u.osLayer.WriteFile(figurePath, figure.Data, filePermissions); err != nil {
+			return ReturnArgs{}, fmt.Errorf("failed to write %s: %w", figurePath, err)
+		}
+		figurePaths = append(figurePaths, figurePath)
+	}
+
+	if extension == scriptExtension {
+		if err := u.osLayer.WriteFile(filePath, script, filePermissions); err != nil {
+			return ReturnArgs{}, fmt.Errorf("failed to write %s: %w", filePath, err)
+		}
+	} else if err := u.convertToLiveScript(ctx, sessionLogger, client, folder, name, script, filePath); err != nil {
+		return ReturnArgs{}, err
+	}
+
+	sessionLogger.With("file-path", filePath).With("cells", len(cells)).Info("Exported session transcript")
+
+	return ReturnArgs{
+		FilePath: filePath,
+		Cells:    len(cells),
+		Figures:  figurePaths,
+	}, nil
+}
+
+// convertToLiveScript writes the script next to the live script, so that the figures it refers to are found, and
+// has MATLAB convert it. The script is deleted once converted.
+func (u *Usecase) convertToLiveScript(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, folder string, name string, script []byte, filePath string) error {
+	sourcePath := filepath.Join(folder, name+"_export"+scriptExtension)
+	if err := u.osLayer.WriteFile(sourcePath, script, filePermissions); err != nil {
+		return fmt.Errorf("failed to write %s: %w", sourcePath, err)
+	}
+	defer func() {
+		if err := u.osLayer.RemoveAll(sourcePath); err != nil {
+			sessionLogger.WithError(err).With("file-path", sourcePath).Warn("Failed to delete the exported script")
+		}
+	}()
+
+	_, err := client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+		Function:   "matlab.internal.liveeditor.openAndSave",
+		Arguments:  []string{sourcePath, filePath},
+		NumOutputs: 0,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to convert the script to a live script: %w", err)
+	}
+
+	return nil
+}
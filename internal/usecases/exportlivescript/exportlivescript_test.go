@@ -0,0 +1,307 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package exportlivescript_test
+
+import (
+	"io/fs"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/exportlivescript"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/sessiontranscript"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	osfacademocks "github.com/matlab/matlab-mcp-core-server/mocks/facades/osfacade"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/exportlivescript"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const projectPath = "/home/user/project"
+
+var cells = []sessiontranscript.Cell{
+	{
+		Time:        time.Date(2025, 6, 1, 9, 30, 0, 0, time.UTC),
+		Tool:        "evaluate_matlab_code",
+		ProjectPath: projectPath,
+		Code:        "plot(1:10)",
+		Figures:     []sessiontranscript.Figure{{MIMEType: "image/png", Data: []byte("png")}},
+	},
+}
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockTranscript := &mocks.MockTranscript{}
+	defer mockTranscript.AssertExpectations(t)
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	// Act
+	usecase := exportlivescript.New(mockTranscript, mockPathValidator, mockOSLayer)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_Script(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockTranscript := &mocks.MockTranscript{}
+	defer mockTranscript.AssertExpectations(t)
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const filePath = projectPath + "/analysis.m"
+	const figurePath = projectPath + "/analysis_step1_figure1.png"
+	expectedScript, _ := exportlivescript.Render("Analysis", "analysis", cells, 0)
+
+	mockTranscript.EXPECT().
+		Cells().
+		Return(cells, 0).
+		Once()
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(ctx, projectPath).
+		Return(projectPath, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(filePath).
+		Return(nil, fs.ErrNotExist).
+		Once()
+
+	mockOSLayer.EXPECT().
+		WriteFile(figurePath, []byte("png"), fs.FileMode(0o644)).
+		Return(nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		WriteFile(filePath, expectedScript, fs.FileMode(0o644)).
+		Return(nil).
+		Once()
+
+	usecase := exportlivescript.New(mockTranscript, mockPathValidator, mockOSLayer)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, exportlivescript.Args{FilePath: filePath, Title: "Analysis"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, exportlivescript.ReturnArgs{FilePath: filePath, Cells: 1, Figures: []string{figurePath}}, result)
+}
+
+func TestUsecase_Execute_LiveScript(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockTranscript := &mocks.MockTranscript{}
+	defer mockTranscript.AssertExpectations(t)
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const filePath = projectPath + "/analysis.mlx"
+	const sourcePath = projectPath + "/analysis_export.m"
+
+	mockTranscript.EXPECT().
+		Cells().
+		Return(cells, 0).
+		Once()
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(ctx, projectPath).
+		Return(projectPath, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(filePath).
+		Return(&osfacademocks.MockFileInfo{}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		WriteFile(projectPath+"/analysis_step1_figure1.png", []byte("png"), fs.FileMode(0o644)).
+		Return(nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		WriteFile(sourcePath, mock.Anything, fs.FileMode(0o644)).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:  "matlab.internal.liveeditor.openAndSave",
+			Arguments: []string{sourcePath, filePath},
+		}).
+		Return(entities.FEvalResponse{}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		RemoveAll(sourcePath).
+		Return(nil).
+		Once()
+
+	usecase := exportlivescript.New(mockTranscript, mockPathValidator, mockOSLayer)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, exportlivescript.Args{FilePath: filePath, Overwrite: true})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, filePath, result.FilePath)
+}
+
+func TestUsecase_Execute_ConversionError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockTranscript := &mocks.MockTranscript{}
+	defer mockTranscript.AssertExpectations(t)
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const sourcePath = projectPath + "/analysis_export.m"
+
+	mockTranscript.EXPECT().
+		Cells().
+		Return(cells, 0).
+		Once()
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(ctx, projectPath).
+		Return(projectPath, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(projectPath+"/analysis.mlx").
+		Return(nil, fs.ErrNotExist).
+		Once()
+
+	mockOSLayer.EXPECT().
+		WriteFile(mock.Anything, mock.Anything, fs.FileMode(0o644)).
+		Return(nil).
+		Twice()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), mock.Anything).
+		Return(entities.FEvalResponse{}, assert.AnError).
+		Once()
+
+	mockOSLayer.EXPECT().
+		RemoveAll(sourcePath).
+		Return(nil).
+		Once()
+
+	usecase := exportlivescript.New(mockTranscript, mockPathValidator, mockOSLayer)
+
+	// Act
+	_, err := usecase.Execute(ctx, mockLogger, mockClient, exportlivescript.Args{FilePath: projectPath + "/analysis.mlx"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+func TestUsecase_Execute_InvalidInput(t *testing.T) {
+	testConfigs := []struct {
+		name          string
+		filePath      string
+		cells         []sessiontranscript.Cell
+		exists        bool
+		expectedError string
+	}{
+		{
+			name:          "unsupported extension",
+			filePath:      projectPath + "/analysis.txt",
+			expectedError: "/home/user/project/analysis.txt is neither a .m nor a .mlx file",
+		},
+		{
+			name:          "empty transcript",
+			filePath:      projectPath + "/analysis.mlx",
+			expectedError: "no code has run in this session yet",
+		},
+		{
+			name:          "existing file",
+			filePath:      projectPath + "/analysis.m",
+			cells:         cells,
+			exists:        true,
+			expectedError: "/home/user/project/analysis.m already exists, set overwrite to replace it",
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockTranscript := &mocks.MockTranscript{}
+			defer mockTranscript.AssertExpectations(t)
+
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			ctx := t.Context()
+
+			mockTranscript.EXPECT().
+				Cells().
+				Return(testConfig.cells, 0).
+				Maybe()
+
+			if testConfig.exists {
+				mockPathValidator.EXPECT().
+					ValidateFolderPath(ctx, projectPath).
+					Return(projectPath, nil).
+					Once()
+
+				mockOSLayer.EXPECT().
+					Stat(testConfig.filePath).
+					Return(&osfacademocks.MockFileInfo{}, nil).
+					Once()
+			}
+
+			usecase := exportlivescript.New(mockTranscript, mockPathValidator, mockOSLayer)
+
+			// Act
+			_, err := usecase.Execute(ctx, mockLogger, mockClient, exportlivescript.Args{FilePath: testConfig.filePath})
+
+			// Assert
+			require.EqualError(t, err, testConfig.expectedError)
+			assert.Equal(t, entities.ErrorCodeInvalidInput, entities.ErrorCodeOf(err))
+		})
+	}
+}
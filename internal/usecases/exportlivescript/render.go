@@ -0,0 +1,88 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package exportlivescript
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/sessiontranscript"
+)
+
+// FigureFile is a figure of the transcript, written next to the script under its name.
+type FigureFile struct {
+	Name string
+	Data []byte
+}
+
+// Render returns the cells of a transcript as a MATLAB script with publish markup, and the figures it refers to.
+// Each call is a section with its code, followed by a section with its output and figures as text markup, so that
+// they show in the published document and in the live script, but do not run.
+// The code of failed calls is rendered as text, so that the script runs up to its end.
+func Render(title string, name string, cells []sessiontranscript.Cell, dropped int) ([]byte, []FigureFile) {
+	var script strings.Builder
+	var figures []FigureFile
+
+	fmt.Fprintf(&script, "%%%% %s\n", strings.Join(strings.Fields(title), " "))
+	script.WriteString("% The code run in the MATLAB session by the AI application, with its outputs and figures, exported by the\n")
+	script.WriteString("% MATLAB MCP Core Server.\n")
+	if dropped > 0 {
+		fmt.Fprintf(&script, "%% The %d oldest calls of the session are not included.\n", dropped)
+	}
+
+	projectPath := ""
+	for i, cell := range cells {
+		fmt.Fprintf(&script, "\n%%%% Step %d\n", i+1)
+		fmt.Fprintf(&script, "%% Run with %s at %s.\n", cell.Tool, cell.Time.Format("2006-01-02 15:04:05"))
+
+		if cell.Failed {
+			script.WriteString("% The call failed, so its code is not run again:\n%\n")
+			writePreformatted(&script, cell.Code)
+			script.WriteString("%\n")
+		} else {
+			if cell.ProjectPath != "" && cell.ProjectPath != projectPath {
+				fmt.Fprintf(&script, "cd('%s')\n", strings.ReplaceAll(cell.ProjectPath, "'", "''"))
+				projectPath = cell.ProjectPath
+			}
+			script.WriteString(strings.TrimRight(cell.Code, "\n"))
+			script.WriteString("\n")
+		}
+
+		output := strings.TrimRight(cell.Output, "\n")
+		if output == "" && len(cell.Figures) == 0 {
+			continue
+		}
+
+		script.WriteString("%%\n")
+		if output != "" {
+			script.WriteString("% Output:\n%\n")
+			writePreformatted(&script, output)
+			script.WriteString("%\n")
+		}
+		for j, figure := range cell.Figures {
+			figureName := fmt.Sprintf("%s_step%d_figure%d%s", name, i+1, j+1, imageExtension(figure.MIMEType))
+			figures = append(figures, FigureFile{Name: figureName, Data: figure.Data})
+			fmt.Fprintf(&script, "%% <<%s>>\n%%\n", figureName)
+		}
+	}
+
+	return []byte(script.String()), figures
+}
+
+// writePreformatted writes text as preformatted text markup, which is a comment indented by two spaces.
+func writePreformatted(script *strings.Builder, text string) {
+	for _, line := range strings.Split(text, "\n") {
+		fmt.Fprintf(script, "%%  %s\n", line)
+	}
+}
+
+func imageExtension(mimeType string) string {
+	switch mimeType {
+	case "image/jpeg":
+		return ".jpg"
+	case "image/svg+xml":
+		return ".svg"
+	default:
+		return ".png"
+	}
+}
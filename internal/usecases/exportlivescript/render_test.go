@@ -0,0 +1,64 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package exportlivescript_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/exportlivescript"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/sessiontranscript"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRender_HappyPath(t *testing.T) {
+	// Arrange
+	runAt := time.Date(2025, 6, 1, 9, 30, 0, 0, time.UTC)
+	transcriptCells := []sessiontranscript.Cell{
+		{Time: runAt, Tool: "evaluate_matlab_code", ProjectPath: "/home/user/project", Code: "x = 1\n", Output: "x =\n\n     1\n"},
+		{Time: runAt, Tool: "evaluate_matlab_code", ProjectPath: "/home/user/project", Code: "plot(1:10)", Figures: []sessiontranscript.Figure{{MIMEType: "image/png", Data: []byte("png")}}},
+		{Time: runAt, Tool: "run_matlab_file", Code: "run('/home/user/project/fails.m')", Output: "Undefined function 'foo'.", Failed: true},
+	}
+
+	// Act
+	script, figures := exportlivescript.Render("My\nanalysis", "analysis", transcriptCells, 2)
+
+	// Assert
+	assert.Equal(t, `%% My analysis
+% The code run in the MATLAB session by the AI application, with its outputs and figures, exported by the
+% MATLAB MCP Core Server.
+% The 2 oldest calls of the session are not included.
+
+%% Step 1
+% Run with evaluate_matlab_code at 2025-06-01 09:30:00.
+cd('/home/user/project')
+x = 1
+%%
+% Output:
+%
+%  x =
+%  
+%       1
+%
+
+%% Step 2
+% Run with evaluate_matlab_code at 2025-06-01 09:30:00.
+plot(1:10)
+%%
+% <<analysis_step2_figure1.png>>
+%
+
+%% Step 3
+% Run with run_matlab_file at 2025-06-01 09:30:00.
+% The call failed, so its code is not run again:
+%
+%  run('/home/user/project/fails.m')
+%
+%%
+% Output:
+%
+%  Undefined function 'foo'.
+%
+`, string(script))
+	assert.Equal(t, []exportlivescript.FigureFile{{Name: "analysis_step2_figure1.png", Data: []byte("png")}}, figures)
+}
@@ -0,0 +1,180 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package findmatlabdefinition
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/mcode"
+)
+
+const (
+	// MaxFiles is the number of MATLAB files searched at most in a project.
+	MaxFiles = 10000
+
+	// maxFileBytes is the size of the largest MATLAB file searched, larger files being generated data rather than code.
+	maxFileBytes = 1 << 20
+)
+
+var errTooManyFiles = errors.New("too many MATLAB files")
+
+type Args struct {
+	ProjectPath string
+	// Name is the name of the function, class or method, qualified with its package and class if any, as in
+	// "pkg.fn" or "MyClass.method".
+	Name string
+}
+
+type Location struct {
+	FilePath   string
+	Definition mcode.Definition
+}
+
+type ReturnArgs struct {
+	Locations []Location
+}
+
+type PathValidator interface {
+	ValidateFolderPath(ctx context.Context, filePath string) (string, error)
+}
+
+type OSLayer interface {
+	DirFS(dir string) fs.FS
+}
+
+type Usecase struct {
+	pathValidator PathValidator
+	osLayer       OSLayer
+}
+
+func New(
+	pathValidator PathValidator,
+	osLayer OSLayer,
+) *Usecase {
+	return &Usecase{
+		pathValidator: pathValidator,
+		osLayer:       osLayer,
+	}
+}
+
+// Execute finds where a name is defined in the MATLAB files of a project, resolving package folders (+pkg) and class
+// folders (@MyClass) as MATLAB does. Local functions match their bare name, as they can only be called from their file.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering FindMATLABDefinition Usecase")
+	defer sessionLogger.Debug("Exiting FindMATLABDefinition Usecase")
+
+	if strings.TrimSpace(request.Name) == "" {
+		return ReturnArgs{}, entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("the name to find is empty"))
+	}
+
+	projectPath, err := u.pathValidator.ValidateFolderPath(ctx, request.ProjectPath)
+	if err != nil {
+		return ReturnArgs{}, fmt.Errorf("path validation failed: %w", err)
+	}
+
+	project := u.osLayer.DirFS(projectPath)
+	locations := []Location{}
+	files := 0
+
+	err = fs.WalkDir(project, ".", func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+
+		if entry.IsDir() {
+			if filePath != "." && strings.HasPrefix(entry.Name(), ".") {
+				return fs.SkipDir
+			}
+			return nil
+		}
+
+		if path.Ext(filePath) != ".m" {
+			return nil
+		}
+
+		files++
+		if files > MaxFiles {
+			return errTooManyFiles
+		}
+
+		info, err := entry.Info()
+		if err != nil || info.Size() > maxFileBytes {
+			return nil //nolint:nilerr // Files that cannot be read are skipped, as the other files can still be searched.
+		}
+
+		source, err := fs.ReadFile(project, filePath)
+		if err != nil {
+			return nil //nolint:nilerr // Files that cannot be read are skipped, as the other files can still be searched.
+		}
+
+		absolutePath := filepath.Join(projectPath, filepath.FromSlash(filePath))
+		for _, definition := range mcode.Analyze(absolutePath, string(source)).Definitions {
+			if matches(filePath, definition, request.Name) {
+				locations = append(locations, Location{FilePath: absolutePath, Definition: definition})
+			}
+		}
+		return nil
+	})
+
+	switch {
+	case errors.Is(err, errTooManyFiles):
+		sessionLogger.With("project_path", projectPath).Warn("Stopped searching for a MATLAB definition after too many files")
+	case err != nil:
+		return ReturnArgs{}, fmt.Errorf("failed to search %s: %w", projectPath, err)
+	}
+
+	return ReturnArgs{
+		Locations: locations,
+	}, nil
+}
+
+// matches tells whether definition, in the file at filePath relative to the project, is the definition of name.
+func matches(filePath string, definition mcode.Definition, name string) bool {
+	packagePrefix, className := scope(filePath)
+
+	switch definition.Kind {
+	case mcode.DefinitionKindClass:
+		return name == packagePrefix+definition.Name
+	case mcode.DefinitionKindFunction:
+		if className != "" {
+			return name == packagePrefix+className+"."+definition.Name
+		}
+		return name == packagePrefix+definition.Name
+	case mcode.DefinitionKindMethod:
+		if className == "" {
+			className = strings.TrimSuffix(path.Base(filePath), ".m")
+		}
+		return name == packagePrefix+className+"."+definition.Name
+	default:
+		return name == definition.Name
+	}
+}
+
+// scope is the package prefix, as in "pkg.sub.", and the class of the class folder, of the file at filePath.
+func scope(filePath string) (string, string) {
+	var packagePrefix strings.Builder
+	className := ""
+	for _, folder := range strings.Split(path.Dir(filePath), "/") {
+		switch {
+		case strings.HasPrefix(folder, "+"):
+			packagePrefix.WriteString(strings.TrimPrefix(folder, "+") + ".")
+		case strings.HasPrefix(folder, "@"):
+			className = strings.TrimPrefix(folder, "@")
+		default:
+			// Package folders only qualify the names of the folders they contain.
+			packagePrefix.Reset()
+			className = ""
+		}
+	}
+	return packagePrefix.String(), className
+}
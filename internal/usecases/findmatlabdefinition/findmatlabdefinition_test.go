@@ -0,0 +1,217 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package findmatlabdefinition_test
+
+import (
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/findmatlabdefinition"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/mcode"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/findmatlabdefinition"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const projectPath = "/home/user/project"
+
+func newProject() fstest.MapFS {
+	return fstest.MapFS{
+		"main.m":                   {Data: []byte("function main()\nhelper();\nend\n\nfunction helper()\nend\n")},
+		"+geometry/area.m":         {Data: []byte("function a = area(r)\n% AREA Area of a disk.\na = pi * r^2;\nend\n")},
+		"+geometry/@Shape/Shape.m": {Data: []byte("classdef Shape\nmethods\nfunction s = Shape()\nend\nend\nend\n")},
+		"+geometry/@Shape/scale.m": {Data: []byte("function s = scale(s, factor)\nend\n")},
+		"Account.m":                {Data: []byte("classdef Account\nmethods\nfunction deposit(obj, amount)\nend\nend\nend\n")},
+		"helpers/helper.m":         {Data: []byte("function helper()\nend\n")},
+		".git/hooks/helper.m":      {Data: []byte("function helper()\nend\n")},
+		"README.md":                {Data: []byte("function helper()\n")},
+	}
+}
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	// Act
+	usecase := findmatlabdefinition.New(mockPathValidator, mockOSLayer)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	testConfigs := []struct {
+		name          string
+		searchedName  string
+		expectedFiles []string
+		expectedKinds []mcode.DefinitionKind
+	}{
+		{
+			name:          "function and local function",
+			searchedName:  "helper",
+			expectedFiles: []string{"helpers/helper.m", "main.m"},
+			expectedKinds: []mcode.DefinitionKind{mcode.DefinitionKindFunction, mcode.DefinitionKindLocalFunction},
+		},
+		{
+			name:          "package function",
+			searchedName:  "geometry.area",
+			expectedFiles: []string{"+geometry/area.m"},
+			expectedKinds: []mcode.DefinitionKind{mcode.DefinitionKindFunction},
+		},
+		{
+			name:          "class in a class folder",
+			searchedName:  "geometry.Shape",
+			expectedFiles: []string{"+geometry/@Shape/Shape.m"},
+			expectedKinds: []mcode.DefinitionKind{mcode.DefinitionKindClass},
+		},
+		{
+			name:          "method in a class folder",
+			searchedName:  "geometry.Shape.scale",
+			expectedFiles: []string{"+geometry/@Shape/scale.m"},
+			expectedKinds: []mcode.DefinitionKind{mcode.DefinitionKindFunction},
+		},
+		{
+			name:          "method in a class file",
+			searchedName:  "Account.deposit",
+			expectedFiles: []string{"Account.m"},
+			expectedKinds: []mcode.DefinitionKind{mcode.DefinitionKindMethod},
+		},
+		{
+			name:         "unknown name",
+			searchedName: "area",
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			ctx := t.Context()
+
+			mockPathValidator.EXPECT().
+				ValidateFolderPath(ctx, projectPath).
+				Return(projectPath, nil).
+				Once()
+
+			mockOSLayer.EXPECT().
+				DirFS(projectPath).
+				Return(newProject()).
+				Once()
+
+			usecase := findmatlabdefinition.New(mockPathValidator, mockOSLayer)
+
+			// Act
+			response, err := usecase.Execute(ctx, mockLogger, findmatlabdefinition.Args{ProjectPath: projectPath, Name: testConfig.searchedName})
+
+			// Assert
+			require.NoError(t, err)
+			require.NotNil(t, response.Locations, "Locations should not be nil, to comply with MCP spec")
+			require.Len(t, response.Locations, len(testConfig.expectedFiles))
+			for i, location := range response.Locations {
+				assert.Equal(t, filepath.Join(projectPath, filepath.FromSlash(testConfig.expectedFiles[i])), location.FilePath)
+				assert.Equal(t, testConfig.expectedKinds[i], location.Definition.Kind)
+			}
+		})
+	}
+}
+
+func TestUsecase_Execute_Help(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(ctx, projectPath).
+		Return(projectPath, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		DirFS(projectPath).
+		Return(newProject()).
+		Once()
+
+	usecase := findmatlabdefinition.New(mockPathValidator, mockOSLayer)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, findmatlabdefinition.Args{ProjectPath: projectPath, Name: "geometry.area"})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, response.Locations, 1)
+	assert.Equal(t, mcode.Definition{
+		Name:      "area",
+		Kind:      mcode.DefinitionKindFunction,
+		Line:      1,
+		Column:    1,
+		Signature: "function a = area(r)",
+		Help:      "AREA Area of a disk.",
+	}, response.Locations[0].Definition)
+}
+
+func TestUsecase_Execute_EmptyName(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	usecase := findmatlabdefinition.New(mockPathValidator, mockOSLayer)
+
+	// Act
+	_, err := usecase.Execute(t.Context(), mockLogger, findmatlabdefinition.Args{ProjectPath: projectPath, Name: " "})
+
+	// Assert
+	var codedErr *entities.CodedError
+	require.ErrorAs(t, err, &codedErr)
+	assert.Equal(t, entities.ErrorCodeInvalidInput, codedErr.ErrorCode())
+}
+
+func TestUsecase_Execute_PathValidationError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(ctx, projectPath).
+		Return("", assert.AnError).
+		Once()
+
+	usecase := findmatlabdefinition.New(mockPathValidator, mockOSLayer)
+
+	// Act
+	_, err := usecase.Execute(ctx, mockLogger, findmatlabdefinition.Args{ProjectPath: projectPath, Name: "main"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
@@ -0,0 +1,55 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getjob
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/jobmanager"
+)
+
+// MaxWait is the longest a call can wait for a background job to finish.
+const MaxWait = 5 * time.Minute
+
+type Args struct {
+	JobID string
+	// Wait is how long to wait for the job to finish before returning its state. 0 returns at once.
+	Wait time.Duration
+}
+
+type JobManager interface {
+	Get(ctx context.Context, logger entities.Logger, id string) (jobmanager.Job, error)
+	Wait(ctx context.Context, logger entities.Logger, id string, timeout time.Duration) (jobmanager.Job, error)
+}
+
+type Usecase struct {
+	jobManager JobManager
+}
+
+func New(
+	jobManager JobManager,
+) *Usecase {
+	return &Usecase{
+		jobManager: jobManager,
+	}
+}
+
+// Execute returns the state of a background job, and the output it produced so far.
+// With a wait, it first waits for the job to finish, so that clients do not need to poll it.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, request Args) (jobmanager.Job, error) {
+	sessionLogger.Debug("Entering GetJob Usecase")
+	defer sessionLogger.Debug("Exiting GetJob Usecase")
+
+	if request.Wait < 0 || request.Wait > MaxWait {
+		return jobmanager.Job{}, entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("the wait must be between 0 and %s", MaxWait))
+	}
+
+	if request.Wait == 0 {
+		return u.jobManager.Get(ctx, sessionLogger, request.JobID)
+	}
+
+	return u.jobManager.Wait(ctx, sessionLogger, request.JobID, request.Wait)
+}
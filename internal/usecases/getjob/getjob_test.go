@@ -0,0 +1,124 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getjob_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getjob"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/jobmanager"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/getjob"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockJobManager := &mocks.MockJobManager{}
+	defer mockJobManager.AssertExpectations(t)
+
+	// Act
+	usecase := getjob.New(mockJobManager)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockJobManager := &mocks.MockJobManager{}
+	defer mockJobManager.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedJob := jobmanager.Job{ID: "job-1", Mode: jobmanager.ModeBatch, State: jobmanager.StateRunning, Output: "Iteration 1"}
+
+	mockJobManager.EXPECT().
+		Get(ctx, mockLogger.AsMockArg(), "job-1").
+		Return(expectedJob, nil).
+		Once()
+
+	usecase := getjob.New(mockJobManager)
+
+	// Act
+	job, err := usecase.Execute(ctx, mockLogger, getjob.Args{JobID: "job-1"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedJob, job)
+}
+
+func TestUsecase_Execute_JobManagerError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockJobManager := &mocks.MockJobManager{}
+	defer mockJobManager.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockJobManager.EXPECT().
+		Get(ctx, mockLogger.AsMockArg(), "job-42").
+		Return(jobmanager.Job{}, assert.AnError).
+		Once()
+
+	usecase := getjob.New(mockJobManager)
+
+	// Act
+	job, err := usecase.Execute(ctx, mockLogger, getjob.Args{JobID: "job-42"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, job)
+}
+
+func TestUsecase_Execute_Wait(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockJobManager := &mocks.MockJobManager{}
+	defer mockJobManager.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedJob := jobmanager.Job{ID: "job-1", Mode: jobmanager.ModeSession, State: jobmanager.StateCompleted, Output: "Sweep done"}
+
+	mockJobManager.EXPECT().
+		Wait(ctx, mockLogger.AsMockArg(), "job-1", 30*time.Second).
+		Return(expectedJob, nil).
+		Once()
+
+	usecase := getjob.New(mockJobManager)
+
+	// Act
+	job, err := usecase.Execute(ctx, mockLogger, getjob.Args{JobID: "job-1", Wait: 30 * time.Second})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedJob, job)
+}
+
+func TestUsecase_Execute_InvalidWait(t *testing.T) {
+	for _, wait := range []time.Duration{-time.Second, getjob.MaxWait + time.Second} {
+		t.Run(wait.String(), func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockJobManager := &mocks.MockJobManager{}
+			defer mockJobManager.AssertExpectations(t)
+
+			usecase := getjob.New(mockJobManager)
+
+			// Act
+			job, err := usecase.Execute(t.Context(), mockLogger, getjob.Args{JobID: "job-1", Wait: wait})
+
+			// Assert
+			require.Error(t, err)
+			assert.Equal(t, entities.ErrorCodeInvalidInput, entities.ErrorCodeOf(err))
+			assert.Empty(t, job)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getmatlabdiagnostics
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/mcode"
+)
+
+type Args struct {
+	ScriptPath string
+}
+
+type ReturnArgs struct {
+	Diagnostics []mcode.Diagnostic
+	Definitions []mcode.Definition
+}
+
+type PathValidator interface {
+	ValidateMATLABScript(ctx context.Context, filePath string) (string, error)
+}
+
+type OSLayer interface {
+	ReadFile(name string) ([]byte, error)
+}
+
+type Usecase struct {
+	pathValidator PathValidator
+	osLayer       OSLayer
+}
+
+func New(
+	pathValidator PathValidator,
+	osLayer OSLayer,
+) *Usecase {
+	return &Usecase{
+		pathValidator: pathValidator,
+		osLayer:       osLayer,
+	}
+}
+
+// Execute analyzes a MATLAB file without MATLAB, so that diagnostics and the help of its functions are available
+// without evaluating code in a MATLAB session.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering GetMATLABDiagnostics Usecase")
+	defer sessionLogger.Debug("Exiting GetMATLABDiagnostics Usecase")
+
+	validatedPath, err := u.pathValidator.ValidateMATLABScript(ctx, request.ScriptPath)
+	if err != nil {
+		return ReturnArgs{}, fmt.Errorf("path validation failed: %w", err)
+	}
+
+	source, err := u.osLayer.ReadFile(validatedPath)
+	if err != nil {
+		return ReturnArgs{}, fmt.Errorf("failed to read %s: %w", validatedPath, err)
+	}
+
+	analysis := mcode.Analyze(validatedPath, string(source))
+
+	return ReturnArgs{
+		Diagnostics: analysis.Diagnostics,
+		Definitions: analysis.Definitions,
+	}, nil
+}
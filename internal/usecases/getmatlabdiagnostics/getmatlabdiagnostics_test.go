@@ -0,0 +1,126 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getmatlabdiagnostics_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getmatlabdiagnostics"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/mcode"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/getmatlabdiagnostics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	// Act
+	usecase := getmatlabdiagnostics.New(mockPathValidator, mockOSLayer)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	ctx := t.Context()
+	const scriptPath = "analysis.m"
+	const validatedPath = "/home/user/project/analysis.m"
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(ctx, scriptPath).
+		Return(validatedPath, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(validatedPath).
+		Return([]byte("function analysis()\n% ANALYSIS Analyzes.\nif true\n"), nil).
+		Once()
+
+	usecase := getmatlabdiagnostics.New(mockPathValidator, mockOSLayer)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, getmatlabdiagnostics.Args{ScriptPath: scriptPath})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []mcode.Diagnostic{
+		{Line: 3, Column: 1, Severity: mcode.SeverityError, Message: "'if' at line 3 is missing its 'end'."},
+	}, response.Diagnostics)
+	require.Len(t, response.Definitions, 1)
+	assert.Equal(t, "ANALYSIS Analyzes.", response.Definitions[0].Help)
+}
+
+func TestUsecase_Execute_PathValidationError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	ctx := t.Context()
+	const scriptPath = "/outside/script.m"
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(ctx, scriptPath).
+		Return("", assert.AnError).
+		Once()
+
+	usecase := getmatlabdiagnostics.New(mockPathValidator, mockOSLayer)
+
+	// Act
+	_, err := usecase.Execute(ctx, mockLogger, getmatlabdiagnostics.Args{ScriptPath: scriptPath})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+func TestUsecase_Execute_ReadFileError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	ctx := t.Context()
+	const validatedPath = "/home/user/project/analysis.m"
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(ctx, validatedPath).
+		Return(validatedPath, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(validatedPath).
+		Return(nil, assert.AnError).
+		Once()
+
+	usecase := getmatlabdiagnostics.New(mockPathValidator, mockOSLayer)
+
+	// Act
+	_, err := usecase.Execute(ctx, mockLogger, getmatlabdiagnostics.Args{ScriptPath: validatedPath})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
@@ -0,0 +1,150 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getmatlabvariable
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/artifactstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/variablename"
+)
+
+// Encoding is the format of the value of a variable.
+type Encoding string
+
+const (
+	// EncodingTyped is the typed JSON text of the value, holding the class and size of the value and of its elements,
+	// as returned by matlab_mcp.typedValue.
+	EncodingTyped Encoding = "typed"
+	// EncodingMAT is a MAT-file holding the variable, kept as an artifact of the shared artifact directory.
+	EncodingMAT Encoding = "mat"
+	// EncodingPreview is the JSON text of the statistics and a sample of the elements of a variable too large to be read in full.
+	EncodingPreview Encoding = "preview"
+)
+
+// MATMIMEType is the MIME type of the MAT-files holding exported variables.
+const MATMIMEType = "application/x-matlab-data"
+
+type Args struct {
+	Name string
+}
+
+type ReturnArgs struct {
+	Name     string
+	Class    string
+	Size     []int
+	Bytes    int
+	Encoding Encoding
+	Data     []byte
+	// Artifact is the MAT-file holding the variable, when it is encoded as a MAT-file. Data is empty then.
+	Artifact artifactstore.Artifact
+}
+
+type Config interface {
+	VariableBinaryThreshold() int
+	VariablePreviewThreshold() int
+}
+
+type ArtifactStore interface {
+	Dir() (string, error)
+	Register(logger entities.Logger, filePath string, mimeType string) (artifactstore.Artifact, error)
+}
+
+type exportedVariable struct {
+	Name     string   `json:"name"`
+	Class    string   `json:"class"`
+	Size     []int    `json:"size"`
+	Bytes    int      `json:"bytes"`
+	Encoding Encoding `json:"encoding"`
+	Data     string   `json:"data"`
+	File     string   `json:"file"`
+}
+
+type Usecase struct {
+	config        Config
+	artifactStore ArtifactStore
+}
+
+func New(
+	config Config,
+	artifactStore ArtifactStore,
+) *Usecase {
+	return &Usecase{
+		config:        config,
+		artifactStore: artifactStore,
+	}
+}
+
+// Execute reads a variable of the workspace of the MATLAB session. Variables larger than the binary threshold are
+// saved by MATLAB as MAT-files, which keep their class and exact values, to the shared artifact directory, and are
+// returned as artifacts rather than read into memory. Variables larger than the preview threshold are not transferred:
+// only their statistics and a sample of their elements are.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering GetMATLABVariable Usecase")
+	defer sessionLogger.Debug("Exiting GetMATLABVariable Usecase")
+
+	if err := variablename.Validate(request.Name); err != nil {
+		return ReturnArgs{}, err
+	}
+
+	artifactDir, err := u.artifactStore.Dir()
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	response, err := client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+		Function: "matlab_mcp.exportVariable",
+		Arguments: []string{
+			request.Name,
+			strconv.Itoa(u.config.VariableBinaryThreshold()),
+			strconv.Itoa(u.config.VariablePreviewThreshold()),
+			artifactDir,
+		},
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	if len(response.Outputs) != 1 {
+		return ReturnArgs{}, fmt.Errorf("unexpected number of outputs from MATLAB session")
+	}
+
+	output, ok := response.Outputs[0].(string)
+	if !ok {
+		return ReturnArgs{}, fmt.Errorf("failed to cast output to string")
+	}
+
+	var variable exportedVariable
+	if err := json.Unmarshal([]byte(output), &variable); err != nil {
+		return ReturnArgs{}, fmt.Errorf("failed to parse exported variable: %w", err)
+	}
+
+	result := ReturnArgs{
+		Name:     variable.Name,
+		Class:    variable.Class,
+		Size:     variable.Size,
+		Bytes:    variable.Bytes,
+		Encoding: variable.Encoding,
+	}
+
+	switch variable.Encoding {
+	case EncodingTyped, EncodingPreview:
+		result.Data = []byte(variable.Data)
+	case EncodingMAT:
+		result.Artifact, err = u.artifactStore.Register(sessionLogger, variable.File, MATMIMEType)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+	default:
+		return ReturnArgs{}, fmt.Errorf("unexpected variable encoding: %q", variable.Encoding)
+	}
+
+	sessionLogger.With("variable-bytes", variable.Bytes).With("encoding", string(variable.Encoding)).Debug("Read MATLAB variable")
+
+	return result, nil
+}
@@ -0,0 +1,425 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getmatlabvariable_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getmatlabvariable"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/artifactstore"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/getmatlabvariable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const artifactDir = "/tmp/matlab-mcp-core-server-123/artifacts-456"
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockArtifactStore := &mocks.MockArtifactStore{}
+	defer mockArtifactStore.AssertExpectations(t)
+
+	// Act
+	usecase := getmatlabvariable.New(mockConfig, mockArtifactStore)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_JSONVariable(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockArtifactStore := &mocks.MockArtifactStore{}
+	defer mockArtifactStore.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		VariableBinaryThreshold().
+		Return(65536).
+		Once()
+
+	mockConfig.EXPECT().
+		VariablePreviewThreshold().
+		Return(16777216).
+		Once()
+
+	mockArtifactStore.EXPECT().
+		Dir().
+		Return(artifactDir, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.exportVariable",
+			Arguments:  []string{"x", "65536", "16777216", artifactDir},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{
+			Outputs: []any{`{"name":"x","class":"double","size":[1,3],"bytes":24,"encoding":"typed","data":"{\"class\":\"double\",\"size\":[1,3],\"data\":[1,2,3]}","file":""}`},
+		}, nil).
+		Once()
+
+	usecase := getmatlabvariable.New(mockConfig, mockArtifactStore)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, getmatlabvariable.Args{Name: "x"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, getmatlabvariable.ReturnArgs{
+		Name:     "x",
+		Class:    "double",
+		Size:     []int{1, 3},
+		Bytes:    24,
+		Encoding: getmatlabvariable.EncodingTyped,
+		Data:     []byte(`{"class":"double","size":[1,3],"data":[1,2,3]}`),
+	}, result)
+}
+
+func TestUsecase_Execute_MATVariable(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockArtifactStore := &mocks.MockArtifactStore{}
+	defer mockArtifactStore.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	matFile := artifactDir + "/tp1234.mat"
+	expectedArtifact := artifactstore.Artifact{
+		Name:     "tp1234.mat",
+		URI:      "matlab://artifacts/tp1234.mat",
+		Path:     matFile,
+		MIMEType: getmatlabvariable.MATMIMEType,
+		Bytes:    2048,
+		SHA256:   "80fbccf21e8b41709a1790cc6921aedcbbb677bb315070e48a313e941de207b0",
+	}
+
+	mockConfig.EXPECT().
+		VariableBinaryThreshold().
+		Return(1024).
+		Once()
+
+	mockConfig.EXPECT().
+		VariablePreviewThreshold().
+		Return(16777216).
+		Once()
+
+	mockArtifactStore.EXPECT().
+		Dir().
+		Return(artifactDir, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.exportVariable",
+			Arguments:  []string{"bigArray", "1024", "16777216", artifactDir},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{
+			Outputs: []any{`{"name":"bigArray","class":"single","size":[1000,1000],"bytes":4000000,"encoding":"mat","data":"","file":"` + matFile + `"}`},
+		}, nil).
+		Once()
+
+	mockArtifactStore.EXPECT().
+		Register(mockLogger.AsMockArg(), matFile, getmatlabvariable.MATMIMEType).
+		Return(expectedArtifact, nil).
+		Once()
+
+	usecase := getmatlabvariable.New(mockConfig, mockArtifactStore)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, getmatlabvariable.Args{Name: "bigArray"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, getmatlabvariable.ReturnArgs{
+		Name:     "bigArray",
+		Class:    "single",
+		Size:     []int{1000, 1000},
+		Bytes:    4000000,
+		Encoding: getmatlabvariable.EncodingMAT,
+		Artifact: expectedArtifact,
+	}, result)
+}
+
+func TestUsecase_Execute_RegisterArtifactError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockArtifactStore := &mocks.MockArtifactStore{}
+	defer mockArtifactStore.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	matFile := artifactDir + "/tp1234.mat"
+
+	mockConfig.EXPECT().
+		VariableBinaryThreshold().
+		Return(1024).
+		Once()
+
+	mockConfig.EXPECT().
+		VariablePreviewThreshold().
+		Return(16777216).
+		Once()
+
+	mockArtifactStore.EXPECT().
+		Dir().
+		Return(artifactDir, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.exportVariable",
+			Arguments:  []string{"bigArray", "1024", "16777216", artifactDir},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{
+			Outputs: []any{`{"name":"bigArray","class":"single","size":[1000,1000],"bytes":4000000,"encoding":"mat","data":"","file":"` + matFile + `"}`},
+		}, nil).
+		Once()
+
+	mockArtifactStore.EXPECT().
+		Register(mockLogger.AsMockArg(), matFile, getmatlabvariable.MATMIMEType).
+		Return(artifactstore.Artifact{}, assert.AnError).
+		Once()
+
+	usecase := getmatlabvariable.New(mockConfig, mockArtifactStore)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, getmatlabvariable.Args{Name: "bigArray"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_InvalidName(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockArtifactStore := &mocks.MockArtifactStore{}
+	defer mockArtifactStore.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := getmatlabvariable.New(mockConfig, mockArtifactStore)
+
+	// Act
+	result, err := usecase.Execute(t.Context(), mockLogger, mockClient, getmatlabvariable.Args{Name: "x'); delete('*"})
+
+	// Assert
+	require.Error(t, err)
+	assert.Equal(t, entities.ErrorCodeInvalidInput, entities.ErrorCodeOf(err))
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_FEvalError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockArtifactStore := &mocks.MockArtifactStore{}
+	defer mockArtifactStore.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		VariableBinaryThreshold().
+		Return(65536).
+		Once()
+
+	mockConfig.EXPECT().
+		VariablePreviewThreshold().
+		Return(16777216).
+		Once()
+
+	mockArtifactStore.EXPECT().
+		Dir().
+		Return(artifactDir, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.exportVariable",
+			Arguments:  []string{"missing", "65536", "16777216", artifactDir},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{}, assert.AnError).
+		Once()
+
+	usecase := getmatlabvariable.New(mockConfig, mockArtifactStore)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, getmatlabvariable.Args{Name: "missing"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_UnexpectedOutput(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockArtifactStore := &mocks.MockArtifactStore{}
+	defer mockArtifactStore.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		VariableBinaryThreshold().
+		Return(65536).
+		Once()
+
+	mockConfig.EXPECT().
+		VariablePreviewThreshold().
+		Return(16777216).
+		Once()
+
+	mockArtifactStore.EXPECT().
+		Dir().
+		Return(artifactDir, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.exportVariable",
+			Arguments:  []string{"x", "65536", "16777216", artifactDir},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{42.0}}, nil).
+		Once()
+
+	usecase := getmatlabvariable.New(mockConfig, mockArtifactStore)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, getmatlabvariable.Args{Name: "x"})
+
+	// Assert
+	require.Error(t, err)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_PreviewVariable(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockArtifactStore := &mocks.MockArtifactStore{}
+	defer mockArtifactStore.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	preview := `{"sampleIndices":[1,500000,1000000],"sample":[0.5,null,2],"min":0,"max":3,"mean":1.5,"nanCount":1,"infCount":0,"nonzeroCount":999999}`
+
+	mockConfig.EXPECT().
+		VariableBinaryThreshold().
+		Return(65536).
+		Once()
+
+	mockConfig.EXPECT().
+		VariablePreviewThreshold().
+		Return(1048576).
+		Once()
+
+	mockArtifactStore.EXPECT().
+		Dir().
+		Return(artifactDir, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.exportVariable",
+			Arguments:  []string{"hugeArray", "65536", "1048576", artifactDir},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{
+			Outputs: []any{`{"name":"hugeArray","class":"double","size":[1000,1000],"bytes":8000000,"encoding":"preview","data":` + strconv.Quote(preview) + `,"file":""}`},
+		}, nil).
+		Once()
+
+	usecase := getmatlabvariable.New(mockConfig, mockArtifactStore)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, getmatlabvariable.Args{Name: "hugeArray"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, getmatlabvariable.EncodingPreview, result.Encoding)
+	assert.Equal(t, 8000000, result.Bytes)
+	assert.JSONEq(t, preview, string(result.Data))
+}
+
+func TestUsecase_Execute_ArtifactDirError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockArtifactStore := &mocks.MockArtifactStore{}
+	defer mockArtifactStore.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockArtifactStore.EXPECT().
+		Dir().
+		Return("", assert.AnError).
+		Once()
+
+	usecase := getmatlabvariable.New(mockConfig, mockArtifactStore)
+
+	// Act
+	result, err := usecase.Execute(t.Context(), mockLogger, mockClient, getmatlabvariable.Args{Name: "x"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
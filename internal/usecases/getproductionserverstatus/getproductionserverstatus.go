@@ -0,0 +1,85 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getproductionserverstatus
+
+import (
+	"context"
+	"errors"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/productionserver"
+)
+
+type ReturnArgs struct {
+	URL              string
+	Reachable        bool
+	Healthy          bool
+	HTTPStatus       int
+	Status           string
+	Error            string
+	DiscoveryEnabled bool
+	Archives         []productionserver.Archive
+}
+
+type Config interface {
+	ProductionServerURL() string
+}
+
+type ProductionServer interface {
+	Health(ctx context.Context) (productionserver.Health, error)
+	Archives(ctx context.Context) ([]productionserver.Archive, error)
+}
+
+type Usecase struct {
+	config           Config
+	productionServer ProductionServer
+}
+
+func New(
+	config Config,
+	productionServer ProductionServer,
+) *Usecase {
+	return &Usecase{
+		config:           config,
+		productionServer: productionServer,
+	}
+}
+
+// Execute reports the health of the MATLAB Production Server instance, and the archives deployed to it when its
+// discovery service is enabled. An instance that cannot be reached is reported, not an error.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering GetProductionServerStatus Usecase")
+	defer sessionLogger.Debug("Exiting GetProductionServerStatus Usecase")
+
+	status := ReturnArgs{
+		URL: u.config.ProductionServerURL(),
+	}
+
+	health, err := u.productionServer.Health(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ReturnArgs{}, ctx.Err()
+		}
+		sessionLogger.WithError(err).Warn("MATLAB Production Server instance is unreachable")
+		status.Error = err.Error()
+		return status, nil
+	}
+
+	status.Reachable = true
+	status.Healthy = health.Healthy
+	status.HTTPStatus = health.HTTPStatus
+	status.Status = health.Status
+
+	archives, err := u.productionServer.Archives(ctx)
+	switch {
+	case errors.Is(err, productionserver.ErrDiscoveryDisabled):
+	case err != nil:
+		sessionLogger.WithError(err).Warn("Failed to list the archives of the MATLAB Production Server instance")
+		status.Error = err.Error()
+	default:
+		status.DiscoveryEnabled = true
+		status.Archives = archives
+	}
+
+	return status, nil
+}
@@ -0,0 +1,150 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getproductionserverstatus_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getproductionserverstatus"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/productionserver"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/getproductionserverstatus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const serverURL = "http://mps.example.com:9910"
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockProductionServer := &mocks.MockProductionServer{}
+	defer mockProductionServer.AssertExpectations(t)
+
+	// Act
+	usecase := getproductionserverstatus.New(mockConfig, mockProductionServer)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockProductionServer := &mocks.MockProductionServer{}
+	defer mockProductionServer.AssertExpectations(t)
+
+	ctx := t.Context()
+	archives := []productionserver.Archive{
+		{Name: "pricing", MATLABRuntimeVersion: "R2025a", Functions: []string{"price"}},
+	}
+
+	mockConfig.EXPECT().
+		ProductionServerURL().
+		Return(serverURL).
+		Once()
+
+	mockProductionServer.EXPECT().
+		Health(ctx).
+		Return(productionserver.Health{Healthy: true, HTTPStatus: 200, Status: "ok"}, nil).
+		Once()
+
+	mockProductionServer.EXPECT().
+		Archives(ctx).
+		Return(archives, nil).
+		Once()
+
+	// Act
+	status, err := getproductionserverstatus.New(mockConfig, mockProductionServer).Execute(ctx, mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, getproductionserverstatus.ReturnArgs{
+		URL:              serverURL,
+		Reachable:        true,
+		Healthy:          true,
+		HTTPStatus:       200,
+		Status:           "ok",
+		DiscoveryEnabled: true,
+		Archives:         archives,
+	}, status)
+}
+
+func TestUsecase_Execute_DiscoveryDisabled(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockProductionServer := &mocks.MockProductionServer{}
+	defer mockProductionServer.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		ProductionServerURL().
+		Return(serverURL).
+		Once()
+
+	mockProductionServer.EXPECT().
+		Health(ctx).
+		Return(productionserver.Health{Healthy: true, HTTPStatus: 200, Status: "ok"}, nil).
+		Once()
+
+	mockProductionServer.EXPECT().
+		Archives(ctx).
+		Return(nil, productionserver.ErrDiscoveryDisabled).
+		Once()
+
+	// Act
+	status, err := getproductionserverstatus.New(mockConfig, mockProductionServer).Execute(ctx, mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, status.Healthy)
+	assert.False(t, status.DiscoveryEnabled)
+	assert.Empty(t, status.Error)
+}
+
+func TestUsecase_Execute_Unreachable(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockProductionServer := &mocks.MockProductionServer{}
+	defer mockProductionServer.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		ProductionServerURL().
+		Return(serverURL).
+		Once()
+
+	mockProductionServer.EXPECT().
+		Health(ctx).
+		Return(productionserver.Health{}, assert.AnError).
+		Once()
+
+	// Act
+	status, err := getproductionserverstatus.New(mockConfig, mockProductionServer).Execute(ctx, mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, getproductionserverstatus.ReturnArgs{
+		URL:   serverURL,
+		Error: assert.AnError.Error(),
+	}, status)
+
+	logs := mockLogger.WarnLogs()
+	require.Contains(t, logs, "MATLAB Production Server instance is unreachable")
+}
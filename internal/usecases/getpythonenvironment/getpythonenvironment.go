@@ -0,0 +1,25 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getpythonenvironment
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/pythonenv"
+)
+
+type Usecase struct{}
+
+func New() *Usecase {
+	return &Usecase{}
+}
+
+// Execute returns the Python environment of the MATLAB session. It does not load Python, so it is quick, and tells
+// whether MATLAB found a Python at all.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) (pythonenv.Environment, error) {
+	sessionLogger.Debug("Entering GetPythonEnvironment Usecase")
+	defer sessionLogger.Debug("Exiting GetPythonEnvironment Usecase")
+
+	return pythonenv.Get(ctx, sessionLogger, client)
+}
@@ -0,0 +1,47 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getpythonenvironment_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getpythonenvironment"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/pythonenv"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.pythonEnvironment",
+			Arguments:  []string{},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`{"version":"3.11","status":"NotLoaded","executionMode":"InProcess"}`}}, nil).
+		Once()
+
+	usecase := getpythonenvironment.New()
+
+	// Act
+	environment, err := usecase.Execute(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, pythonenv.Environment{
+		Version:       "3.11",
+		Status:        "NotLoaded",
+		ExecutionMode: pythonenv.ExecutionModeInProcess,
+	}, environment)
+}
@@ -0,0 +1,54 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getserverinfo
+
+import (
+	"context"
+	"slices"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+type Config interface {
+	BuildInfo() entities.BuildInfo
+	ToolSets() []entities.ToolSet
+	ReadOnly() bool
+}
+
+type ReturnArgs struct {
+	BuildInfo        entities.BuildInfo
+	ProtocolVersions []string
+	MATLABs          []entities.EnvironmentInfo
+	ToolSets         []entities.ToolSet
+	ReadOnly         bool
+}
+
+type Usecase struct {
+	config        Config
+	matlabManager entities.MATLABManager
+}
+
+func New(
+	config Config,
+	matlabManager entities.MATLABManager,
+) *Usecase {
+	return &Usecase{
+		config:        config,
+		matlabManager: matlabManager,
+	}
+}
+
+// Execute reports what the server is and what it can do: its build, the MCP protocol versions it supports, the MATLAB
+// installations it can start, and the tool sets it exposes, so that they can be pinned in a bug report.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger) ReturnArgs {
+	sessionLogger.Debug("Entering GetServerInfo Usecase")
+	defer sessionLogger.Debug("Exiting GetServerInfo Usecase")
+
+	return ReturnArgs{
+		BuildInfo:        u.config.BuildInfo(),
+		ProtocolVersions: slices.Clone(entities.MCPProtocolVersions),
+		MATLABs:          u.matlabManager.ListEnvironments(ctx, sessionLogger),
+		ToolSets:         u.config.ToolSets(),
+		ReadOnly:         u.config.ReadOnly(),
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getserverinfo_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getserverinfo"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/getserverinfo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	// Act
+	usecase := getserverinfo.New(mockConfig, mockMATLABManager)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	ctx := t.Context()
+	buildInfo := entities.BuildInfo{Version: "v25.6.68", Commit: "0123abcd"}
+	environments := []entities.EnvironmentInfo{
+		{MATLABRoot: "/usr/local/MATLAB/R2024a", Version: "R2024a"},
+	}
+	toolSets := []entities.ToolSet{entities.ToolSetMATLAB, entities.ToolSetProductionServer}
+
+	mockConfig.EXPECT().
+		BuildInfo().
+		Return(buildInfo).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		ListEnvironments(ctx, mockLogger).
+		Return(environments).
+		Once()
+
+	mockConfig.EXPECT().
+		ToolSets().
+		Return(toolSets).
+		Once()
+
+	mockConfig.EXPECT().
+		ReadOnly().
+		Return(false).
+		Once()
+
+	usecase := getserverinfo.New(mockConfig, mockMATLABManager)
+
+	// Act
+	result := usecase.Execute(ctx, mockLogger)
+
+	// Assert
+	assert.Equal(t, getserverinfo.ReturnArgs{
+		BuildInfo:        buildInfo,
+		ProtocolVersions: entities.MCPProtocolVersions,
+		MATLABs:          environments,
+		ToolSets:         toolSets,
+		ReadOnly:         false,
+	}, result)
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getsimulinkblockparameters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/simulinkmodel"
+)
+
+type Args struct {
+	ModelPath string
+	// BlockPath is the path of the block, relative to the model or starting with the name of the model.
+	BlockPath string
+}
+
+type BlockParameter struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type ReturnArgs struct {
+	Model      string           `json:"model"`
+	Block      string           `json:"block"`
+	Type       string           `json:"type"`
+	Parameters []BlockParameter `json:"parameters"`
+}
+
+type PathValidator interface {
+	ValidateFilePath(ctx context.Context, filePath string) (string, error)
+}
+
+type Usecase struct {
+	pathValidator PathValidator
+}
+
+func New(
+	pathValidator PathValidator,
+) *Usecase {
+	return &Usecase{
+		pathValidator: pathValidator,
+	}
+}
+
+// Execute returns the dialog parameters of a block of a Simulink model, with their values as text. The model must
+// already be loaded, so that reading parameters never runs the callbacks of a model.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering GetSimulinkBlockParameters Usecase")
+	defer sessionLogger.Debug("Exiting GetSimulinkBlockParameters Usecase")
+
+	if err := simulinkmodel.CheckModelPath(request.ModelPath); err != nil {
+		return ReturnArgs{}, err
+	}
+
+	if request.BlockPath == "" {
+		return ReturnArgs{}, entities.NewCodedError(entities.ErrorCodeInvalidInput, errors.New("no block path given"))
+	}
+
+	validatedPath, err := u.pathValidator.ValidateFilePath(ctx, request.ModelPath)
+	if err != nil {
+		sessionLogger.WithError(err).With("path", request.ModelPath).Warn("Path validation failed")
+		return ReturnArgs{}, fmt.Errorf("path validation failed: %w", err)
+	}
+
+	var result ReturnArgs
+	if err := simulinkmodel.Call(ctx, sessionLogger, client, []string{"blockParameters", validatedPath, request.BlockPath}, &result); err != nil {
+		return ReturnArgs{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,106 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getsimulinkblockparameters_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getsimulinkblockparameters"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/getsimulinkblockparameters"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	// Act
+	usecase := getsimulinkblockparameters.New(mockPathValidator)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const modelPath = "/home/user/models/plant.slx"
+
+	mockPathValidator.EXPECT().
+		ValidateFilePath(ctx, modelPath).
+		Return(modelPath, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.simulinkModel",
+			Arguments:  []string{"blockParameters", modelPath, "Gain"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`{"model":"plant","block":"plant/Gain","type":"Gain","parameters":[{"name":"Gain","value":"K"}]}`}}, nil).
+		Once()
+
+	usecase := getsimulinkblockparameters.New(mockPathValidator)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, getsimulinkblockparameters.Args{ModelPath: modelPath, BlockPath: "Gain"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, getsimulinkblockparameters.ReturnArgs{
+		Model:      "plant",
+		Block:      "plant/Gain",
+		Type:       "Gain",
+		Parameters: []getsimulinkblockparameters.BlockParameter{{Name: "Gain", Value: "K"}},
+	}, result)
+}
+
+func TestUsecase_Execute_InvalidInput(t *testing.T) {
+	testConfigs := []struct {
+		name    string
+		request getsimulinkblockparameters.Args
+	}{
+		{
+			name:    "not a model",
+			request: getsimulinkblockparameters.Args{ModelPath: "/home/user/models/plant.m", BlockPath: "Gain"},
+		},
+		{
+			name:    "no block path",
+			request: getsimulinkblockparameters.Args{ModelPath: "/home/user/models/plant.slx"},
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			usecase := getsimulinkblockparameters.New(mockPathValidator)
+
+			// Act
+			_, err := usecase.Execute(t.Context(), mockLogger, mockClient, testConfig.request)
+
+			// Assert
+			assert.Equal(t, entities.ErrorCodeInvalidInput, entities.ErrorCodeOf(err))
+		})
+	}
+}
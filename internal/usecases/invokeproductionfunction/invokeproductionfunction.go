@@ -0,0 +1,71 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package invokeproductionfunction
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/productionserver"
+)
+
+// maxNumOutputs bounds the number of outputs requested from a function.
+const maxNumOutputs = 32
+
+type Args struct {
+	Archive    string
+	Function   string
+	Inputs     []json.RawMessage
+	NumOutputs int
+}
+
+type ProductionServer interface {
+	Invoke(ctx context.Context, archive string, function string, inputs []json.RawMessage, numOutputs int) (productionserver.InvocationResult, error)
+}
+
+type Usecase struct {
+	productionServer ProductionServer
+}
+
+func New(
+	productionServer ProductionServer,
+) *Usecase {
+	return &Usecase{
+		productionServer: productionServer,
+	}
+}
+
+// Execute calls a function of an archive deployed to the MATLAB Production Server instance, as a client application
+// would, and returns its outputs, or the MATLAB error it threw.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, request Args) (productionserver.InvocationResult, error) {
+	sessionLogger.Debug("Entering InvokeProductionFunction Usecase")
+	defer sessionLogger.Debug("Exiting InvokeProductionFunction Usecase")
+
+	if err := productionserver.ValidateName(request.Archive); err != nil {
+		return productionserver.InvocationResult{}, err
+	}
+
+	if err := productionserver.ValidateName(request.Function); err != nil {
+		return productionserver.InvocationResult{}, err
+	}
+
+	if request.NumOutputs < 0 || request.NumOutputs > maxNumOutputs {
+		return productionserver.InvocationResult{}, entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("invalid number of outputs %d: use a number between 0 and %d", request.NumOutputs, maxNumOutputs))
+	}
+
+	result, err := u.productionServer.Invoke(ctx, request.Archive, request.Function, request.Inputs, request.NumOutputs)
+	if err != nil {
+		sessionLogger.WithError(err).With("archive", request.Archive).With("function", request.Function).Warn("Failed to invoke MATLAB Production Server function")
+		return productionserver.InvocationResult{}, err
+	}
+
+	sessionLogger.
+		With("archive", request.Archive).
+		With("function", request.Function).
+		With("failed", result.Error != nil).
+		Info("Invoked MATLAB Production Server function")
+
+	return result, nil
+}
@@ -0,0 +1,134 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package invokeproductionfunction_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/invokeproductionfunction"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/productionserver"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/invokeproductionfunction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockProductionServer := &mocks.MockProductionServer{}
+	defer mockProductionServer.AssertExpectations(t)
+
+	// Act
+	usecase := invokeproductionfunction.New(mockProductionServer)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockProductionServer := &mocks.MockProductionServer{}
+	defer mockProductionServer.AssertExpectations(t)
+
+	ctx := t.Context()
+	inputs := []json.RawMessage{json.RawMessage(`100`), json.RawMessage(`"call"`)}
+	expectedResult := productionserver.InvocationResult{
+		Outputs: []json.RawMessage{json.RawMessage(`12.5`)},
+	}
+
+	mockProductionServer.EXPECT().
+		Invoke(ctx, "pricing", "price", inputs, 1).
+		Return(expectedResult, nil).
+		Once()
+
+	// Act
+	result, err := invokeproductionfunction.New(mockProductionServer).Execute(ctx, mockLogger, invokeproductionfunction.Args{
+		Archive:    "pricing",
+		Function:   "price",
+		Inputs:     inputs,
+		NumOutputs: 1,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestUsecase_Execute_InvalidInput(t *testing.T) {
+	testCases := []struct {
+		name       string
+		archive    string
+		function   string
+		numOutputs int
+	}{
+		{
+			name:       "archive name is not a MATLAB identifier",
+			archive:    "../admin",
+			function:   "price",
+			numOutputs: 1,
+		},
+		{
+			name:       "function name is not a MATLAB identifier",
+			archive:    "pricing",
+			function:   "price?debug=1",
+			numOutputs: 1,
+		},
+		{
+			name:       "too many outputs",
+			archive:    "pricing",
+			function:   "price",
+			numOutputs: 100,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockProductionServer := &mocks.MockProductionServer{}
+			defer mockProductionServer.AssertExpectations(t)
+
+			// Act
+			_, err := invokeproductionfunction.New(mockProductionServer).Execute(t.Context(), mockLogger, invokeproductionfunction.Args{
+				Archive:    testCase.archive,
+				Function:   testCase.function,
+				NumOutputs: testCase.numOutputs,
+			})
+
+			// Assert
+			var codedErr *entities.CodedError
+			require.ErrorAs(t, err, &codedErr)
+			assert.Equal(t, entities.ErrorCodeInvalidInput, codedErr.ErrorCode())
+		})
+	}
+}
+
+func TestUsecase_Execute_ServerError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockProductionServer := &mocks.MockProductionServer{}
+	defer mockProductionServer.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockProductionServer.EXPECT().
+		Invoke(ctx, "pricing", "price", []json.RawMessage(nil), 1).
+		Return(productionserver.InvocationResult{}, assert.AnError).
+		Once()
+
+	// Act
+	_, err := invokeproductionfunction.New(mockProductionServer).Execute(ctx, mockLogger, invokeproductionfunction.Args{
+		Archive:    "pricing",
+		Function:   "price",
+		NumOutputs: 1,
+	})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
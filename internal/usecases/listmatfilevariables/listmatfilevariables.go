@@ -0,0 +1,68 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package listmatfilevariables
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/matfile"
+)
+
+// MaxVariables is the number of variables listed at most, so that a MAT-file with many variables does not flood the
+// context of the AI application.
+const MaxVariables = 1000
+
+type Args struct {
+	FilePath string
+}
+
+type ReturnArgs struct {
+	File      string             `json:"file"`
+	Variables []matfile.Variable `json:"variables"`
+	// VariableCount is the number of variables of the file, including those not listed in Variables.
+	VariableCount int `json:"variableCount"`
+}
+
+type PathValidator interface {
+	ValidateFilePath(ctx context.Context, filePath string) (string, error)
+}
+
+type Usecase struct {
+	pathValidator PathValidator
+}
+
+func New(
+	pathValidator PathValidator,
+) *Usecase {
+	return &Usecase{
+		pathValidator: pathValidator,
+	}
+}
+
+// Execute lists the variables of a MAT-file, with their class, size and number of bytes, without loading their values.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering ListMATFileVariables Usecase")
+	defer sessionLogger.Debug("Exiting ListMATFileVariables Usecase")
+
+	if err := matfile.CheckFilePath(request.FilePath); err != nil {
+		return ReturnArgs{}, err
+	}
+
+	validatedPath, err := u.pathValidator.ValidateFilePath(ctx, request.FilePath)
+	if err != nil {
+		sessionLogger.WithError(err).With("path", request.FilePath).Warn("Path validation failed")
+		return ReturnArgs{}, fmt.Errorf("path validation failed: %w", err)
+	}
+
+	var result ReturnArgs
+	if err := matfile.Call(ctx, sessionLogger, client, []string{"list", validatedPath, strconv.Itoa(MaxVariables)}, &result); err != nil {
+		return ReturnArgs{}, err
+	}
+
+	sessionLogger.With("file", result.File).With("variables", result.VariableCount).Debug("Listed MAT-file variables")
+
+	return result, nil
+}
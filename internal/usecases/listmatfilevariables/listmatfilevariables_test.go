@@ -0,0 +1,118 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package listmatfilevariables_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listmatfilevariables"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/matfile"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/listmatfilevariables"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	// Act
+	usecase := listmatfilevariables.New(mockPathValidator)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const filePath = "/home/user/data/measurements.mat"
+
+	mockPathValidator.EXPECT().
+		ValidateFilePath(ctx, filePath).
+		Return(filePath, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.matFile",
+			Arguments:  []string{"list", filePath, "1000"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`{"file":"/home/user/data/measurements.mat","variables":[{"name":"t","class":"double","size":[1000,1],"bytes":8000,"complex":false},{"name":"z","class":"single","size":[1,4],"bytes":32,"complex":true}],"variableCount":2}`}}, nil).
+		Once()
+
+	usecase := listmatfilevariables.New(mockPathValidator)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, listmatfilevariables.Args{FilePath: filePath})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, listmatfilevariables.ReturnArgs{
+		File: filePath,
+		Variables: []matfile.Variable{
+			{Name: "t", Class: "double", Size: []int{1000, 1}, Bytes: 8000},
+			{Name: "z", Class: "single", Size: []int{1, 4}, Bytes: 32, Complex: true},
+		},
+		VariableCount: 2,
+	}, result)
+}
+
+func TestUsecase_Execute_NotAMATFile(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := listmatfilevariables.New(mockPathValidator)
+
+	// Act
+	_, err := usecase.Execute(t.Context(), mockLogger, mockClient, listmatfilevariables.Args{FilePath: "/home/user/data/measurements.csv"})
+
+	// Assert
+	assert.Equal(t, entities.ErrorCodeInvalidInput, entities.ErrorCodeOf(err))
+}
+
+func TestUsecase_Execute_PathValidationError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const filePath = "/home/user/data/measurements.mat"
+
+	mockPathValidator.EXPECT().
+		ValidateFilePath(ctx, filePath).
+		Return("", assert.AnError).
+		Once()
+
+	usecase := listmatfilevariables.New(mockPathValidator)
+
+	// Act
+	_, err := usecase.Execute(ctx, mockLogger, mockClient, listmatfilevariables.Args{FilePath: filePath})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
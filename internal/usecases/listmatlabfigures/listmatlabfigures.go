@@ -0,0 +1,59 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package listmatlabfigures
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+type Figure struct {
+	Number int    `json:"number"`
+	Name   string `json:"name"`
+}
+
+type ReturnArgs struct {
+	Figures []Figure
+}
+
+type Usecase struct{}
+
+func New() *Usecase {
+	return &Usecase{}
+}
+
+// Execute lists the figures open in the MATLAB session. It does not render them, so it returns quickly.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering ListMATLABFigures Usecase")
+	defer sessionLogger.Debug("Exiting ListMATLABFigures Usecase")
+
+	response, err := client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+		Function:   "matlab_mcp.listFigures",
+		Arguments:  []string{},
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	if len(response.Outputs) != 1 {
+		return ReturnArgs{}, fmt.Errorf("unexpected number of outputs from MATLAB session")
+	}
+
+	output, ok := response.Outputs[0].(string)
+	if !ok {
+		return ReturnArgs{}, fmt.Errorf("failed to cast output to string")
+	}
+
+	var figures []Figure
+	if err := json.Unmarshal([]byte(output), &figures); err != nil {
+		return ReturnArgs{}, fmt.Errorf("failed to parse figure list: %w", err)
+	}
+
+	return ReturnArgs{
+		Figures: figures,
+	}, nil
+}
@@ -0,0 +1,143 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package listmatlabfigures_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listmatlabfigures"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+
+	// Act
+	usecase := listmatlabfigures.New()
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.listFigures",
+			Arguments:  []string{},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{
+			Outputs: []any{`[{"number":1,"name":""},{"number":3,"name":"Residuals"}]`},
+		}, nil).
+		Once()
+
+	usecase := listmatlabfigures.New()
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, listmatlabfigures.ReturnArgs{
+		Figures: []listmatlabfigures.Figure{
+			{Number: 1, Name: ""},
+			{Number: 3, Name: "Residuals"},
+		},
+	}, result)
+}
+
+func TestUsecase_Execute_NoFigures(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.listFigures",
+			Arguments:  []string{},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`[]`}}, nil).
+		Once()
+
+	usecase := listmatlabfigures.New()
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, result.Figures)
+}
+
+func TestUsecase_Execute_FEvalError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.listFigures",
+			Arguments:  []string{},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{}, assert.AnError).
+		Once()
+
+	usecase := listmatlabfigures.New()
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_UnexpectedOutput(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.listFigures",
+			Arguments:  []string{},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{"not json"}}, nil).
+		Once()
+
+	usecase := listmatlabfigures.New()
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.Error(t, err)
+	assert.Empty(t, result)
+}
@@ -0,0 +1,30 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package listmatlabsessions
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+type Usecase struct {
+	matlabManager entities.MATLABManager
+}
+
+type ReturnArgs []entities.SessionInfo
+
+func New(
+	matlabManager entities.MATLABManager,
+) *Usecase {
+	return &Usecase{
+		matlabManager: matlabManager,
+	}
+}
+
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger) ReturnArgs {
+	sessionLogger.Debug("Entering ListMATLABSessions Usecase")
+	defer sessionLogger.Debug("Exiting ListMATLABSessions Usecase")
+
+	return u.matlabManager.ListMATLABSessions(ctx, sessionLogger)
+}
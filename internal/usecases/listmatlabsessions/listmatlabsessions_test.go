@@ -0,0 +1,52 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package listmatlabsessions_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listmatlabsessions"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	// Act
+	usecase := listmatlabsessions.New(mockMATLABManager)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockSessions := []entities.SessionInfo{
+		{ID: 1, Name: "analysis", MATLABRoot: "/path/to/matlab/R2023a"},
+		{ID: 2, MATLABRoot: "/path/to/matlab/R2022b"},
+	}
+
+	mockMATLABManager.EXPECT().
+		ListMATLABSessions(mock.Anything, mockLogger).
+		Return(mockSessions).
+		Once()
+
+	usecase := listmatlabsessions.New(mockMATLABManager)
+
+	// Act
+	result := usecase.Execute(t.Context(), mockLogger)
+
+	// Assert
+	assert.Equal(t, listmatlabsessions.ReturnArgs(mockSessions), result)
+}
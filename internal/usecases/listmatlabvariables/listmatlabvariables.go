@@ -0,0 +1,62 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package listmatlabvariables
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+type Variable struct {
+	Name  string `json:"name"`
+	Class string `json:"class"`
+	Size  []int  `json:"size"`
+	Bytes int64  `json:"bytes"`
+}
+
+type ReturnArgs struct {
+	Variables []Variable
+}
+
+type Usecase struct{}
+
+func New() *Usecase {
+	return &Usecase{}
+}
+
+// Execute lists the variables of the workspace of the MATLAB session. It does not read their values, so it returns
+// quickly, whatever the size of the variables.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering ListMATLABVariables Usecase")
+	defer sessionLogger.Debug("Exiting ListMATLABVariables Usecase")
+
+	response, err := client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+		Function:   "matlab_mcp.listVariables",
+		Arguments:  []string{},
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	if len(response.Outputs) != 1 {
+		return ReturnArgs{}, fmt.Errorf("unexpected number of outputs from MATLAB session")
+	}
+
+	output, ok := response.Outputs[0].(string)
+	if !ok {
+		return ReturnArgs{}, fmt.Errorf("failed to cast output to string")
+	}
+
+	var variables []Variable
+	if err := json.Unmarshal([]byte(output), &variables); err != nil {
+		return ReturnArgs{}, fmt.Errorf("failed to parse variable list: %w", err)
+	}
+
+	return ReturnArgs{
+		Variables: variables,
+	}, nil
+}
@@ -0,0 +1,143 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package listmatlabvariables_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listmatlabvariables"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+
+	// Act
+	usecase := listmatlabvariables.New()
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.listVariables",
+			Arguments:  []string{},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{
+			Outputs: []any{`[{"name":"x","class":"double","size":[1,3],"bytes":24},{"name":"label","class":"char","size":[1,5],"bytes":10}]`},
+		}, nil).
+		Once()
+
+	usecase := listmatlabvariables.New()
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, listmatlabvariables.ReturnArgs{
+		Variables: []listmatlabvariables.Variable{
+			{Name: "x", Class: "double", Size: []int{1, 3}, Bytes: 24},
+			{Name: "label", Class: "char", Size: []int{1, 5}, Bytes: 10},
+		},
+	}, result)
+}
+
+func TestUsecase_Execute_NoVariables(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.listVariables",
+			Arguments:  []string{},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`[]`}}, nil).
+		Once()
+
+	usecase := listmatlabvariables.New()
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, result.Variables)
+}
+
+func TestUsecase_Execute_FEvalError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.listVariables",
+			Arguments:  []string{},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{}, assert.AnError).
+		Once()
+
+	usecase := listmatlabvariables.New()
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_UnexpectedOutput(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.listVariables",
+			Arguments:  []string{},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{"not json"}}, nil).
+		Once()
+
+	usecase := listmatlabvariables.New()
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.Error(t, err)
+	assert.Empty(t, result)
+}
@@ -0,0 +1,82 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package loadmatfilevariables
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/matfile"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/variablename"
+)
+
+type Args struct {
+	FilePath  string
+	Variables []string
+	// Overwrite replaces the variables of the workspace with the same names. Without it, loading fails if one exists.
+	Overwrite bool
+}
+
+type ReturnArgs struct {
+	File      string             `json:"file"`
+	Variables []matfile.Variable `json:"variables"`
+	// Replaced are the names of the variables of the workspace that were replaced.
+	Replaced []string `json:"replaced"`
+}
+
+type PathValidator interface {
+	ValidateFilePath(ctx context.Context, filePath string) (string, error)
+}
+
+type Usecase struct {
+	pathValidator PathValidator
+}
+
+func New(
+	pathValidator PathValidator,
+) *Usecase {
+	return &Usecase{
+		pathValidator: pathValidator,
+	}
+}
+
+// Execute loads the named variables of a MAT-file into the workspace of the MATLAB session, and returns their class,
+// size and number of bytes. Only the named variables are loaded, so that a large file does not flood the workspace.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering LoadMATFileVariables Usecase")
+	defer sessionLogger.Debug("Exiting LoadMATFileVariables Usecase")
+
+	if err := matfile.CheckFilePath(request.FilePath); err != nil {
+		return ReturnArgs{}, err
+	}
+
+	if len(request.Variables) == 0 {
+		return ReturnArgs{}, entities.NewCodedError(entities.ErrorCodeInvalidInput, errors.New("no variables given: list the variables of the file first"))
+	}
+
+	for _, name := range request.Variables {
+		if err := variablename.Validate(name); err != nil {
+			return ReturnArgs{}, err
+		}
+	}
+
+	validatedPath, err := u.pathValidator.ValidateFilePath(ctx, request.FilePath)
+	if err != nil {
+		sessionLogger.WithError(err).With("path", request.FilePath).Warn("Path validation failed")
+		return ReturnArgs{}, fmt.Errorf("path validation failed: %w", err)
+	}
+
+	arguments := append([]string{"load", validatedPath, strconv.FormatBool(request.Overwrite)}, request.Variables...)
+
+	var result ReturnArgs
+	if err := matfile.Call(ctx, sessionLogger, client, arguments, &result); err != nil {
+		return ReturnArgs{}, err
+	}
+
+	sessionLogger.With("file", result.File).With("variables", len(result.Variables)).Info("Loaded MAT-file variables")
+
+	return result, nil
+}
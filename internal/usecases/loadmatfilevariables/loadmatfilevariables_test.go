@@ -0,0 +1,140 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package loadmatfilevariables_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/loadmatfilevariables"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/matfile"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/loadmatfilevariables"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	// Act
+	usecase := loadmatfilevariables.New(mockPathValidator)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const filePath = "/home/user/data/measurements.mat"
+
+	mockPathValidator.EXPECT().
+		ValidateFilePath(ctx, filePath).
+		Return(filePath, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.matFile",
+			Arguments:  []string{"load", filePath, "true", "t", "y"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`{"file":"/home/user/data/measurements.mat","variables":[{"name":"t","class":"double","size":[1000,1],"bytes":8000,"complex":false},{"name":"y","class":"double","size":[1000,3],"bytes":24000,"complex":false}],"replaced":["y"]}`}}, nil).
+		Once()
+
+	usecase := loadmatfilevariables.New(mockPathValidator)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, loadmatfilevariables.Args{FilePath: filePath, Variables: []string{"t", "y"}, Overwrite: true})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, loadmatfilevariables.ReturnArgs{
+		File: filePath,
+		Variables: []matfile.Variable{
+			{Name: "t", Class: "double", Size: []int{1000, 1}, Bytes: 8000},
+			{Name: "y", Class: "double", Size: []int{1000, 3}, Bytes: 24000},
+		},
+		Replaced: []string{"y"},
+	}, result)
+}
+
+func TestUsecase_Execute_InvalidInput(t *testing.T) {
+	testConfigs := []struct {
+		name string
+		args loadmatfilevariables.Args
+	}{
+		{
+			name: "not a MAT-file",
+			args: loadmatfilevariables.Args{FilePath: "/home/user/data/measurements.csv", Variables: []string{"t"}},
+		},
+		{
+			name: "no variables",
+			args: loadmatfilevariables.Args{FilePath: "/home/user/data/measurements.mat"},
+		},
+		{
+			name: "invalid variable name",
+			args: loadmatfilevariables.Args{FilePath: "/home/user/data/measurements.mat", Variables: []string{"t", "x'); delete('*"}},
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			usecase := loadmatfilevariables.New(mockPathValidator)
+
+			// Act
+			_, err := usecase.Execute(t.Context(), mockLogger, mockClient, testConfig.args)
+
+			// Assert
+			assert.Equal(t, entities.ErrorCodeInvalidInput, entities.ErrorCodeOf(err))
+		})
+	}
+}
+
+func TestUsecase_Execute_PathValidationError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const filePath = "/home/user/data/measurements.mat"
+
+	mockPathValidator.EXPECT().
+		ValidateFilePath(ctx, filePath).
+		Return("", assert.AnError).
+		Once()
+
+	usecase := loadmatfilevariables.New(mockPathValidator)
+
+	// Act
+	_, err := usecase.Execute(ctx, mockLogger, mockClient, loadmatfilevariables.Args{FilePath: filePath, Variables: []string{"t"}})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
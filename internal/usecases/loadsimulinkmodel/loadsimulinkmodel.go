@@ -0,0 +1,78 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package loadsimulinkmodel
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/simulinkmodel"
+)
+
+// MaxBlocks is the number of blocks listed at most, so that a large block diagram does not flood the context of the AI
+// application.
+const MaxBlocks = 500
+
+type Args struct {
+	ModelPath string
+}
+
+type Block struct {
+	Path string `json:"path"`
+	Type string `json:"type"`
+}
+
+type ReturnArgs struct {
+	Name     string  `json:"name"`
+	File     string  `json:"file"`
+	Solver   string  `json:"solver"`
+	StopTime string  `json:"stopTime"`
+	Dirty    bool    `json:"dirty"`
+	Blocks   []Block `json:"blocks"`
+	// BlockCount is the number of blocks of the model, including those not listed in Blocks.
+	BlockCount int `json:"blockCount"`
+}
+
+type PathValidator interface {
+	ValidateFilePath(ctx context.Context, filePath string) (string, error)
+}
+
+type Usecase struct {
+	pathValidator PathValidator
+}
+
+func New(
+	pathValidator PathValidator,
+) *Usecase {
+	return &Usecase{
+		pathValidator: pathValidator,
+	}
+}
+
+// Execute loads a Simulink model in the MATLAB session, without opening it, and returns its solver settings with its
+// blocks, including those under masks and in linked libraries.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering LoadSimulinkModel Usecase")
+	defer sessionLogger.Debug("Exiting LoadSimulinkModel Usecase")
+
+	if err := simulinkmodel.CheckModelPath(request.ModelPath); err != nil {
+		return ReturnArgs{}, err
+	}
+
+	validatedPath, err := u.pathValidator.ValidateFilePath(ctx, request.ModelPath)
+	if err != nil {
+		sessionLogger.WithError(err).With("path", request.ModelPath).Warn("Path validation failed")
+		return ReturnArgs{}, fmt.Errorf("path validation failed: %w", err)
+	}
+
+	var result ReturnArgs
+	if err := simulinkmodel.Call(ctx, sessionLogger, client, []string{"load", validatedPath, strconv.Itoa(MaxBlocks)}, &result); err != nil {
+		return ReturnArgs{}, err
+	}
+
+	sessionLogger.With("model", result.Name).With("blocks", result.BlockCount).Info("Loaded Simulink model")
+
+	return result, nil
+}
@@ -0,0 +1,120 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package loadsimulinkmodel_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/loadsimulinkmodel"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/loadsimulinkmodel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	// Act
+	usecase := loadsimulinkmodel.New(mockPathValidator)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const modelPath = "/home/user/models/plant.slx"
+
+	mockPathValidator.EXPECT().
+		ValidateFilePath(ctx, modelPath).
+		Return(modelPath, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.simulinkModel",
+			Arguments:  []string{"load", modelPath, "500"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`{"name":"plant","file":"/home/user/models/plant.slx","solver":"ode45","stopTime":"10","dirty":false,"blockCount":2,"blocks":[{"path":"plant/Gain","type":"Gain"},{"path":"plant/Out1","type":"Outport"}]}`}}, nil).
+		Once()
+
+	usecase := loadsimulinkmodel.New(mockPathValidator)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, loadsimulinkmodel.Args{ModelPath: modelPath})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, loadsimulinkmodel.ReturnArgs{
+		Name:     "plant",
+		File:     "/home/user/models/plant.slx",
+		Solver:   "ode45",
+		StopTime: "10",
+		Blocks: []loadsimulinkmodel.Block{
+			{Path: "plant/Gain", Type: "Gain"},
+			{Path: "plant/Out1", Type: "Outport"},
+		},
+		BlockCount: 2,
+	}, result)
+}
+
+func TestUsecase_Execute_NotAModel(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := loadsimulinkmodel.New(mockPathValidator)
+
+	// Act
+	_, err := usecase.Execute(t.Context(), mockLogger, mockClient, loadsimulinkmodel.Args{ModelPath: "/home/user/models/plant.m"})
+
+	// Assert
+	assert.Equal(t, entities.ErrorCodeInvalidInput, entities.ErrorCodeOf(err))
+}
+
+func TestUsecase_Execute_PathValidationError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const modelPath = "/home/user/models/plant.slx"
+
+	mockPathValidator.EXPECT().
+		ValidateFilePath(ctx, modelPath).
+		Return("", assert.AnError).
+		Once()
+
+	usecase := loadsimulinkmodel.New(mockPathValidator)
+
+	// Act
+	_, err := usecase.Execute(ctx, mockLogger, mockClient, loadsimulinkmodel.Args{ModelPath: modelPath})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
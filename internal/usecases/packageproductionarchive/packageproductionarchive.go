@@ -0,0 +1,98 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package packageproductionarchive
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/productionserver"
+)
+
+type Args struct {
+	ArchiveName   string
+	FunctionPaths []string
+}
+
+type ReturnArgs struct {
+	ArchivePath string   `json:"archive"`
+	Functions   []string `json:"functions"`
+	Log         string   `json:"log"`
+}
+
+type PathValidator interface {
+	ValidateFilePath(ctx context.Context, filePath string) (string, error)
+}
+
+type Usecase struct {
+	pathValidator PathValidator
+}
+
+func New(
+	pathValidator PathValidator,
+) *Usecase {
+	return &Usecase{
+		pathValidator: pathValidator,
+	}
+}
+
+// Execute packages MATLAB functions into a deployable archive for MATLAB Production Server with MATLAB Compiler SDK,
+// next to the first function, and returns the path of the archive with the build log.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering PackageProductionArchive Usecase")
+	defer sessionLogger.Debug("Exiting PackageProductionArchive Usecase")
+
+	if err := productionserver.ValidateName(request.ArchiveName); err != nil {
+		return ReturnArgs{}, err
+	}
+
+	if len(request.FunctionPaths) == 0 {
+		return ReturnArgs{}, entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("no function given: give the .m files of the functions to deploy"))
+	}
+
+	arguments := []string{request.ArchiveName}
+	for _, functionPath := range request.FunctionPaths {
+		if !strings.EqualFold(filepath.Ext(functionPath), ".m") {
+			return ReturnArgs{}, entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("%s is not a MATLAB function: use a .m file", functionPath))
+		}
+
+		validatedPath, err := u.pathValidator.ValidateFilePath(ctx, functionPath)
+		if err != nil {
+			sessionLogger.WithError(err).With("path", functionPath).Warn("Path validation failed")
+			return ReturnArgs{}, fmt.Errorf("path validation failed: %w", err)
+		}
+
+		arguments = append(arguments, validatedPath)
+	}
+
+	response, err := client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+		Function:   "matlab_mcp.productionServerArchive",
+		Arguments:  arguments,
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	if len(response.Outputs) != 1 {
+		return ReturnArgs{}, fmt.Errorf("unexpected number of outputs from MATLAB session")
+	}
+
+	output, ok := response.Outputs[0].(string)
+	if !ok {
+		return ReturnArgs{}, fmt.Errorf("failed to cast output to string")
+	}
+
+	var result ReturnArgs
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		return ReturnArgs{}, fmt.Errorf("failed to parse the result of the packaging: %w", err)
+	}
+
+	sessionLogger.With("archive", result.ArchivePath).Info("Packaged deployable archive")
+
+	return result, nil
+}
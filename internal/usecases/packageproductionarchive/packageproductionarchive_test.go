@@ -0,0 +1,116 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package packageproductionarchive_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/packageproductionarchive"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/packageproductionarchive"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	// Act
+	usecase := packageproductionarchive.New(mockPathValidator)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const functionPath = "/home/user/pricing/price.m"
+
+	mockPathValidator.EXPECT().
+		ValidateFilePath(ctx, functionPath).
+		Return(functionPath, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.productionServerArchive",
+			Arguments:  []string{"pricing", functionPath},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`{"archive":"/home/user/pricing/pricing_archive/pricing.ctf","functions":["/home/user/pricing/price.m"],"log":"Build succeeded."}`}}, nil).
+		Once()
+
+	// Act
+	result, err := packageproductionarchive.New(mockPathValidator).Execute(ctx, mockLogger, mockClient, packageproductionarchive.Args{
+		ArchiveName:   "pricing",
+		FunctionPaths: []string{functionPath},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, packageproductionarchive.ReturnArgs{
+		ArchivePath: "/home/user/pricing/pricing_archive/pricing.ctf",
+		Functions:   []string{functionPath},
+		Log:         "Build succeeded.",
+	}, result)
+}
+
+func TestUsecase_Execute_InvalidInput(t *testing.T) {
+	testCases := []struct {
+		name          string
+		archiveName   string
+		functionPaths []string
+	}{
+		{
+			name:          "archive name is not a MATLAB identifier",
+			archiveName:   "pricing-v2",
+			functionPaths: []string{"/home/user/pricing/price.m"},
+		},
+		{
+			name:        "no function",
+			archiveName: "pricing",
+		},
+		{
+			name:          "not a MATLAB function",
+			archiveName:   "pricing",
+			functionPaths: []string{"/home/user/pricing/price.slx"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			// Act
+			_, err := packageproductionarchive.New(mockPathValidator).Execute(t.Context(), mockLogger, mockClient, packageproductionarchive.Args{
+				ArchiveName:   testCase.archiveName,
+				FunctionPaths: testCase.functionPaths,
+			})
+
+			// Assert
+			var codedErr *entities.CodedError
+			require.ErrorAs(t, err, &codedErr)
+			assert.Equal(t, entities.ErrorCodeInvalidInput, codedErr.ErrorCode())
+		})
+	}
+}
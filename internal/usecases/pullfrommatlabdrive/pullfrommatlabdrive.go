@@ -0,0 +1,98 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package pullfrommatlabdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+)
+
+const filePermissions = 0o644
+
+type Args struct {
+	DrivePath   string
+	ProjectPath string
+	Overwrite   bool
+}
+
+type ReturnArgs struct {
+	FilePath string
+	Bytes    int
+}
+
+type PathValidator interface {
+	ValidateFolderPath(ctx context.Context, filePath string) (string, error)
+}
+
+type Drive interface {
+	Read(drivePath string) ([]byte, error)
+}
+
+type OSLayer interface {
+	Stat(name string) (osfacade.FileInfo, error)
+	WriteFile(name string, data []byte, perm os.FileMode) error
+}
+
+type Usecase struct {
+	pathValidator PathValidator
+	drive         Drive
+	osLayer       OSLayer
+}
+
+func New(
+	pathValidator PathValidator,
+	drive Drive,
+	osLayer OSLayer,
+) *Usecase {
+	return &Usecase{
+		pathValidator: pathValidator,
+		drive:         drive,
+		osLayer:       osLayer,
+	}
+}
+
+// Execute copies a file of MATLAB Drive into a project folder, the working folder of the code evaluated for the
+// project, so that MATLAB code can read it.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering PullFromMATLABDrive Usecase")
+	defer sessionLogger.Debug("Exiting PullFromMATLABDrive Usecase")
+
+	projectPath, err := u.pathValidator.ValidateFolderPath(ctx, request.ProjectPath)
+	if err != nil {
+		return ReturnArgs{}, fmt.Errorf("path validation failed: %w", err)
+	}
+
+	content, err := u.drive.Read(request.DrivePath)
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	filePath := filepath.Join(projectPath, path.Base(filepath.ToSlash(request.DrivePath)))
+
+	_, err = u.osLayer.Stat(filePath)
+	switch {
+	case err == nil && !request.Overwrite:
+		return ReturnArgs{}, entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("%s already exists, set overwrite to replace it", filePath))
+	case err != nil && !errors.Is(err, fs.ErrNotExist):
+		return ReturnArgs{}, fmt.Errorf("failed to access %s: %w", filePath, err)
+	}
+
+	if err := u.osLayer.WriteFile(filePath, content, filePermissions); err != nil {
+		return ReturnArgs{}, fmt.Errorf("failed to write %s: %w", filePath, err)
+	}
+
+	sessionLogger.With("drive-path", request.DrivePath).With("file-path", filePath).Info("Pulled file from MATLAB Drive")
+
+	return ReturnArgs{
+		FilePath: filePath,
+		Bytes:    len(content),
+	}, nil
+}
@@ -0,0 +1,212 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package pullfrommatlabdrive_test
+
+import (
+	"io/fs"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/pullfrommatlabdrive"
+	osfacademocks "github.com/matlab/matlab-mcp-core-server/mocks/facades/osfacade"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/pullfrommatlabdrive"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockDrive := &mocks.MockDrive{}
+	defer mockDrive.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	// Act
+	usecase := pullfrommatlabdrive.New(mockPathValidator, mockDrive, mockOSLayer)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	testConfigs := []struct {
+		name      string
+		overwrite bool
+		statError error
+	}{
+		{
+			name:      "new file",
+			statError: fs.ErrNotExist,
+		},
+		{
+			name:      "overwritten file",
+			overwrite: true,
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			mockDrive := &mocks.MockDrive{}
+			defer mockDrive.AssertExpectations(t)
+
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			ctx := t.Context()
+			const projectPath = "/home/user/project"
+			const filePath = "/home/user/project/results.csv"
+			content := []byte("a,b\n1,2\n")
+
+			mockPathValidator.EXPECT().
+				ValidateFolderPath(ctx, projectPath).
+				Return(projectPath, nil).
+				Once()
+
+			mockDrive.EXPECT().
+				Read("data/results.csv").
+				Return(content, nil).
+				Once()
+
+			mockOSLayer.EXPECT().
+				Stat(filePath).
+				Return(&osfacademocks.MockFileInfo{}, testConfig.statError).
+				Once()
+
+			mockOSLayer.EXPECT().
+				WriteFile(filePath, content, fs.FileMode(0o644)).
+				Return(nil).
+				Once()
+
+			usecase := pullfrommatlabdrive.New(mockPathValidator, mockDrive, mockOSLayer)
+
+			// Act
+			response, err := usecase.Execute(ctx, mockLogger, pullfrommatlabdrive.Args{
+				DrivePath:   "data/results.csv",
+				ProjectPath: projectPath,
+				Overwrite:   testConfig.overwrite,
+			})
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, pullfrommatlabdrive.ReturnArgs{FilePath: filePath, Bytes: len(content)}, response)
+		})
+	}
+}
+
+func TestUsecase_Execute_FileExists(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockDrive := &mocks.MockDrive{}
+	defer mockDrive.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	ctx := t.Context()
+	const projectPath = "/home/user/project"
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(ctx, projectPath).
+		Return(projectPath, nil).
+		Once()
+
+	mockDrive.EXPECT().
+		Read("results.csv").
+		Return([]byte("a,b\n"), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat("/home/user/project/results.csv").
+		Return(&osfacademocks.MockFileInfo{}, nil).
+		Once()
+
+	usecase := pullfrommatlabdrive.New(mockPathValidator, mockDrive, mockOSLayer)
+
+	// Act
+	_, err := usecase.Execute(ctx, mockLogger, pullfrommatlabdrive.Args{DrivePath: "results.csv", ProjectPath: projectPath})
+
+	// Assert
+	var codedErr *entities.CodedError
+	require.ErrorAs(t, err, &codedErr)
+	assert.Equal(t, entities.ErrorCodeInvalidInput, codedErr.ErrorCode())
+}
+
+func TestUsecase_Execute_DriveError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockDrive := &mocks.MockDrive{}
+	defer mockDrive.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	ctx := t.Context()
+	const projectPath = "/home/user/project"
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(ctx, projectPath).
+		Return(projectPath, nil).
+		Once()
+
+	mockDrive.EXPECT().
+		Read("missing.csv").
+		Return(nil, assert.AnError).
+		Once()
+
+	usecase := pullfrommatlabdrive.New(mockPathValidator, mockDrive, mockOSLayer)
+
+	// Act
+	_, err := usecase.Execute(ctx, mockLogger, pullfrommatlabdrive.Args{DrivePath: "missing.csv", ProjectPath: projectPath})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+func TestUsecase_Execute_PathValidationError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockDrive := &mocks.MockDrive{}
+	defer mockDrive.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	ctx := t.Context()
+	const projectPath = "/outside"
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(ctx, projectPath).
+		Return("", assert.AnError).
+		Once()
+
+	usecase := pullfrommatlabdrive.New(mockPathValidator, mockDrive, mockOSLayer)
+
+	// Act
+	_, err := usecase.Execute(ctx, mockLogger, pullfrommatlabdrive.Args{DrivePath: "results.csv", ProjectPath: projectPath})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
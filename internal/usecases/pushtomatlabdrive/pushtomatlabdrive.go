@@ -0,0 +1,113 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package pushtomatlabdrive
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/artifactstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/matlabdrive"
+)
+
+type Args struct {
+	// Source is the absolute path of a file, or the URI of an artifact.
+	Source      string
+	DriveFolder string
+	Overwrite   bool
+}
+
+type ReturnArgs struct {
+	DrivePath string
+	URI       string
+	Bytes     int64
+}
+
+type PathValidator interface {
+	ValidateFilePath(ctx context.Context, filePath string) (string, error)
+}
+
+type Drive interface {
+	Write(drivePath string, content []byte, overwrite bool) (matlabdrive.Entry, error)
+}
+
+type ArtifactStore interface {
+	Read(logger entities.Logger, name string) (artifactstore.Artifact, []byte, error)
+}
+
+type OSLayer interface {
+	ReadFile(name string) ([]byte, error)
+}
+
+type Usecase struct {
+	pathValidator PathValidator
+	drive         Drive
+	artifactStore ArtifactStore
+	osLayer       OSLayer
+}
+
+func New(
+	pathValidator PathValidator,
+	drive Drive,
+	artifactStore ArtifactStore,
+	osLayer OSLayer,
+) *Usecase {
+	return &Usecase{
+		pathValidator: pathValidator,
+		drive:         drive,
+		artifactStore: artifactStore,
+		osLayer:       osLayer,
+	}
+}
+
+// Execute copies a file, or an artifact written by MATLAB, to a folder of MATLAB Drive, from where MATLAB Drive
+// Connector uploads it.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering PushToMATLABDrive Usecase")
+	defer sessionLogger.Debug("Exiting PushToMATLABDrive Usecase")
+
+	fileName, content, err := u.readSource(ctx, sessionLogger, request.Source)
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	entry, err := u.drive.Write(path.Join(filepath.ToSlash(request.DriveFolder), fileName), content, request.Overwrite)
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	sessionLogger.With("source", request.Source).With("drive-path", entry.Path).Info("Pushed file to MATLAB Drive")
+
+	return ReturnArgs{
+		DrivePath: entry.Path,
+		URI:       entry.URI,
+		Bytes:     entry.Bytes,
+	}, nil
+}
+
+// readSource returns the name and the content of the file or artifact source. Artifacts are read through the
+// artifact store, which checks their hash, as the artifact directory is outside of the allowed folders.
+func (u *Usecase) readSource(ctx context.Context, sessionLogger entities.Logger, source string) (string, []byte, error) {
+	if name, ok := strings.CutPrefix(source, artifactstore.URIPrefix); ok {
+		artifact, content, err := u.artifactStore.Read(sessionLogger, name)
+		if err != nil {
+			return "", nil, err
+		}
+		return artifact.Name, content, nil
+	}
+
+	filePath, err := u.pathValidator.ValidateFilePath(ctx, source)
+	if err != nil {
+		return "", nil, fmt.Errorf("path validation failed: %w", err)
+	}
+
+	content, err := u.osLayer.ReadFile(filePath)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to read %s: %w", filePath, err)
+	}
+	return filepath.Base(filePath), content, nil
+}
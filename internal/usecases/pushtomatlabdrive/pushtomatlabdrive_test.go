@@ -0,0 +1,204 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package pushtomatlabdrive_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/pushtomatlabdrive"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/artifactstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/matlabdrive"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/pushtomatlabdrive"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockDrive := &mocks.MockDrive{}
+	defer mockDrive.AssertExpectations(t)
+
+	mockArtifactStore := &mocks.MockArtifactStore{}
+	defer mockArtifactStore.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	// Act
+	usecase := pushtomatlabdrive.New(mockPathValidator, mockDrive, mockArtifactStore, mockOSLayer)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_File(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockDrive := &mocks.MockDrive{}
+	defer mockDrive.AssertExpectations(t)
+
+	mockArtifactStore := &mocks.MockArtifactStore{}
+	defer mockArtifactStore.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	ctx := t.Context()
+	const filePath = "/home/user/project/report.pdf"
+	content := []byte("%PDF")
+
+	mockPathValidator.EXPECT().
+		ValidateFilePath(ctx, filePath).
+		Return(filePath, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(filePath).
+		Return(content, nil).
+		Once()
+
+	mockDrive.EXPECT().
+		Write("reports/report.pdf", content, true).
+		Return(matlabdrive.Entry{Name: "report.pdf", Path: "reports/report.pdf", URI: "matlab://drive/reports/report.pdf", Bytes: 4}, nil).
+		Once()
+
+	usecase := pushtomatlabdrive.New(mockPathValidator, mockDrive, mockArtifactStore, mockOSLayer)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, pushtomatlabdrive.Args{
+		Source:      filePath,
+		DriveFolder: "reports",
+		Overwrite:   true,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, pushtomatlabdrive.ReturnArgs{
+		DrivePath: "reports/report.pdf",
+		URI:       "matlab://drive/reports/report.pdf",
+		Bytes:     4,
+	}, response)
+}
+
+func TestUsecase_Execute_Artifact(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockDrive := &mocks.MockDrive{}
+	defer mockDrive.AssertExpectations(t)
+
+	mockArtifactStore := &mocks.MockArtifactStore{}
+	defer mockArtifactStore.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	ctx := t.Context()
+	content := []byte("MATLAB 5.0 MAT-file")
+
+	mockArtifactStore.EXPECT().
+		Read(mockLogger.AsMockArg(), "tp1234.mat").
+		Return(artifactstore.Artifact{Name: "tp1234.mat"}, content, nil).
+		Once()
+
+	mockDrive.EXPECT().
+		Write("tp1234.mat", content, false).
+		Return(matlabdrive.Entry{Name: "tp1234.mat", Path: "tp1234.mat", URI: "matlab://drive/tp1234.mat", Bytes: 19}, nil).
+		Once()
+
+	usecase := pushtomatlabdrive.New(mockPathValidator, mockDrive, mockArtifactStore, mockOSLayer)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, pushtomatlabdrive.Args{Source: "matlab://artifacts/tp1234.mat"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "tp1234.mat", response.DrivePath)
+}
+
+func TestUsecase_Execute_DriveError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockDrive := &mocks.MockDrive{}
+	defer mockDrive.AssertExpectations(t)
+
+	mockArtifactStore := &mocks.MockArtifactStore{}
+	defer mockArtifactStore.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	ctx := t.Context()
+	const filePath = "/home/user/project/report.pdf"
+
+	mockPathValidator.EXPECT().
+		ValidateFilePath(ctx, filePath).
+		Return(filePath, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(filePath).
+		Return([]byte("%PDF"), nil).
+		Once()
+
+	mockDrive.EXPECT().
+		Write("report.pdf", []byte("%PDF"), false).
+		Return(matlabdrive.Entry{}, assert.AnError).
+		Once()
+
+	usecase := pushtomatlabdrive.New(mockPathValidator, mockDrive, mockArtifactStore, mockOSLayer)
+
+	// Act
+	_, err := usecase.Execute(ctx, mockLogger, pushtomatlabdrive.Args{Source: filePath})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+func TestUsecase_Execute_PathValidationError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockDrive := &mocks.MockDrive{}
+	defer mockDrive.AssertExpectations(t)
+
+	mockArtifactStore := &mocks.MockArtifactStore{}
+	defer mockArtifactStore.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	ctx := t.Context()
+	const filePath = "/outside/secret.txt"
+
+	mockPathValidator.EXPECT().
+		ValidateFilePath(ctx, filePath).
+		Return("", assert.AnError).
+		Once()
+
+	usecase := pushtomatlabdrive.New(mockPathValidator, mockDrive, mockArtifactStore, mockOSLayer)
+
+	// Act
+	_, err := usecase.Execute(ctx, mockLogger, pushtomatlabdrive.Args{Source: filePath})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
@@ -0,0 +1,86 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package rendermatlabfigure
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+type Args struct {
+	Number int
+}
+
+type ReturnArgs struct {
+	PNG []byte
+}
+
+type Config interface {
+	FigureResolution() int
+}
+
+type OSLayer interface {
+	ReadFile(name string) ([]byte, error)
+	RemoveAll(path string) error
+}
+
+type Usecase struct {
+	config  Config
+	osLayer OSLayer
+}
+
+func New(
+	config Config,
+	osLayer OSLayer,
+) *Usecase {
+	return &Usecase{
+		config:  config,
+		osLayer: osLayer,
+	}
+}
+
+// Execute exports a figure of the MATLAB session as a PNG image, at the configured resolution.
+// MATLAB writes the image to a temporary file, which is deleted once read.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering RenderMATLABFigure Usecase")
+	defer sessionLogger.Debug("Exiting RenderMATLABFigure Usecase")
+
+	response, err := client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+		Function: "matlab_mcp.renderFigure",
+		Arguments: []string{
+			strconv.Itoa(request.Number),
+			strconv.Itoa(u.config.FigureResolution()),
+		},
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	if len(response.Outputs) != 1 {
+		return ReturnArgs{}, fmt.Errorf("unexpected number of outputs from MATLAB session")
+	}
+
+	filePath, ok := response.Outputs[0].(string)
+	if !ok {
+		return ReturnArgs{}, fmt.Errorf("failed to cast output to string")
+	}
+
+	defer func() {
+		if err := u.osLayer.RemoveAll(filePath); err != nil {
+			sessionLogger.WithError(err).With("file", filePath).Warn("Failed to delete rendered figure")
+		}
+	}()
+
+	png, err := u.osLayer.ReadFile(filePath)
+	if err != nil {
+		return ReturnArgs{}, fmt.Errorf("failed to read rendered figure: %w", err)
+	}
+
+	return ReturnArgs{
+		PNG: png,
+	}, nil
+}
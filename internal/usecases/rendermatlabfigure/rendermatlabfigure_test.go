@@ -0,0 +1,171 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package rendermatlabfigure_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/rendermatlabfigure"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/rendermatlabfigure"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	// Act
+	usecase := rendermatlabfigure.New(mockConfig, mockOSLayer)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	pngFile := "/tmp/tp1234.png"
+	pngData := []byte("\x89PNG")
+
+	mockConfig.EXPECT().
+		FigureResolution().
+		Return(150).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.renderFigure",
+			Arguments:  []string{"2", "150"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{pngFile}}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(pngFile).
+		Return(pngData, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		RemoveAll(pngFile).
+		Return(nil).
+		Once()
+
+	usecase := rendermatlabfigure.New(mockConfig, mockOSLayer)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, rendermatlabfigure.Args{Number: 2})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, rendermatlabfigure.ReturnArgs{PNG: pngData}, result)
+}
+
+func TestUsecase_Execute_FEvalError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		FigureResolution().
+		Return(150).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.renderFigure",
+			Arguments:  []string{"2", "150"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{}, assert.AnError).
+		Once()
+
+	usecase := rendermatlabfigure.New(mockConfig, mockOSLayer)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, rendermatlabfigure.Args{Number: 2})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_ReadError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	pngFile := "/tmp/tp1234.png"
+
+	mockConfig.EXPECT().
+		FigureResolution().
+		Return(150).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.renderFigure",
+			Arguments:  []string{"2", "150"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{pngFile}}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(pngFile).
+		Return(nil, assert.AnError).
+		Once()
+
+	mockOSLayer.EXPECT().
+		RemoveAll(pngFile).
+		Return(assert.AnError).
+		Once()
+
+	usecase := rendermatlabfigure.New(mockConfig, mockOSLayer)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, rendermatlabfigure.Args{Number: 2})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+	assert.Contains(t, mockLogger.WarnLogs(), "Failed to delete rendered figure")
+}
@@ -15,18 +15,39 @@ type Args struct {
 }
 
 type PathValidator interface {
-	ValidateMATLABScript(filePath string) (string, error)
+	ValidateMATLABScript(ctx context.Context, filePath string) (string, error)
+}
+
+type CodePolicy interface {
+	CheckFile(filePath string) error
+}
+
+type ApprovalGate interface {
+	ApproveFile(ctx context.Context, filePath string) error
+}
+
+type LookupCache interface {
+	InvalidateForFile(logger entities.Logger, filePath string)
 }
 
 type Usecase struct {
 	pathValidator PathValidator
+	codePolicy    CodePolicy
+	approvalGate  ApprovalGate
+	lookupCache   LookupCache
 }
 
 func New(
 	pathValidator PathValidator,
+	codePolicy CodePolicy,
+	approvalGate ApprovalGate,
+	lookupCache LookupCache,
 ) *Usecase {
 	return &Usecase{
 		pathValidator: pathValidator,
+		codePolicy:    codePolicy,
+		approvalGate:  approvalGate,
+		lookupCache:   lookupCache,
 	}
 }
 
@@ -34,11 +55,21 @@ func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, cl
 	sessionLogger.Debug("Entering RunMATLABFile Usecase")
 	defer sessionLogger.Debug("Exiting RunMATLABFile Usecase")
 
-	validatedPath, err := u.pathValidator.ValidateMATLABScript(request.ScriptPath)
+	validatedPath, err := u.pathValidator.ValidateMATLABScript(ctx, request.ScriptPath)
 	if err != nil {
 		return entities.EvalResponse{}, err
 	}
 
+	if err := u.codePolicy.CheckFile(validatedPath); err != nil {
+		sessionLogger.WithError(err).With("path", validatedPath).Warn("Script rejected by the code policy")
+		return entities.EvalResponse{}, err
+	}
+
+	if err := u.approvalGate.ApproveFile(ctx, validatedPath); err != nil {
+		sessionLogger.WithError(err).With("path", validatedPath).Warn("Script not approved by the user")
+		return entities.EvalResponse{}, err
+	}
+
 	scriptDir, scriptName := pathextractor.ExtractPathComponents(validatedPath)
 
 	_, err = client.Eval(ctx, sessionLogger, entities.EvalRequest{
@@ -51,5 +82,10 @@ func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, cl
 	runCodeRequest := entities.EvalRequest{
 		Code: scriptName,
 	}
-	return client.Eval(ctx, sessionLogger, runCodeRequest)
+	response, err := client.Eval(ctx, sessionLogger, runCodeRequest)
+
+	// The script may have changed the MATLAB path before failing.
+	u.lookupCache.InvalidateForFile(sessionLogger, validatedPath)
+
+	return response, err
 }
@@ -21,8 +21,17 @@ func TestNew_HappyPath(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockLookupCache := &mocks.MockLookupCache{}
+	defer mockLookupCache.AssertExpectations(t)
+
 	// Act
-	usecase := runmatlabfile.New(mockPathValidator)
+	usecase := runmatlabfile.New(mockPathValidator, mockCodePolicy, mockApprovalGate, mockLookupCache)
 
 	// Assert
 	assert.NotNil(t, usecase, "Usecase should not be nil")
@@ -35,6 +44,15 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockLookupCache := &mocks.MockLookupCache{}
+	defer mockLookupCache.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -55,10 +73,20 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 	}
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(scriptPath).
+		ValidateMATLABScript(ctx, scriptPath).
 		Return(scriptPath, nil).
 		Once()
 
+	mockCodePolicy.EXPECT().
+		CheckFile(scriptPath).
+		Return(nil).
+		Once()
+
+	mockApprovalGate.EXPECT().
+		ApproveFile(ctx, scriptPath).
+		Return(nil).
+		Once()
+
 	mockClient.EXPECT().
 		Eval(ctx, mockLogger.AsMockArg(), cdRequest).
 		Return(entities.EvalResponse{}, nil).
@@ -69,7 +97,11 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 		Return(expectedResponse, nil).
 		Once()
 
-	usecase := runmatlabfile.New(mockPathValidator)
+	mockLookupCache.EXPECT().
+		InvalidateForFile(mockLogger.AsMockArg(), scriptPath).
+		Once()
+
+	usecase := runmatlabfile.New(mockPathValidator, mockCodePolicy, mockApprovalGate, mockLookupCache)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
@@ -86,6 +118,15 @@ func TestUsecase_Execute_ValidateMATLABScriptError(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockLookupCache := &mocks.MockLookupCache{}
+	defer mockLookupCache.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -98,11 +139,11 @@ func TestUsecase_Execute_ValidateMATLABScriptError(t *testing.T) {
 	usecaseRequest := runmatlabfile.Args{ScriptPath: scriptPath}
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(scriptPath).
+		ValidateMATLABScript(ctx, scriptPath).
 		Return("", expectedError).
 		Once()
 
-	usecase := runmatlabfile.New(mockPathValidator)
+	usecase := runmatlabfile.New(mockPathValidator, mockCodePolicy, mockApprovalGate, mockLookupCache)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
@@ -119,6 +160,15 @@ func TestUsecase_Execute_CdEvalError(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockLookupCache := &mocks.MockLookupCache{}
+	defer mockLookupCache.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -133,16 +183,26 @@ func TestUsecase_Execute_CdEvalError(t *testing.T) {
 	cdRequest := entities.EvalRequest{Code: fmt.Sprintf("cd('%s')", scriptDir)}
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(scriptPath).
+		ValidateMATLABScript(ctx, scriptPath).
 		Return(scriptPath, nil).
 		Once()
 
+	mockCodePolicy.EXPECT().
+		CheckFile(scriptPath).
+		Return(nil).
+		Once()
+
+	mockApprovalGate.EXPECT().
+		ApproveFile(ctx, scriptPath).
+		Return(nil).
+		Once()
+
 	mockClient.EXPECT().
 		Eval(ctx, mockLogger.AsMockArg(), cdRequest).
 		Return(entities.EvalResponse{}, expectedError).
 		Once()
 
-	usecase := runmatlabfile.New(mockPathValidator)
+	usecase := runmatlabfile.New(mockPathValidator, mockCodePolicy, mockApprovalGate, mockLookupCache)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
@@ -159,6 +219,15 @@ func TestUsecase_Execute_RunMATLABFileEvalError(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockLookupCache := &mocks.MockLookupCache{}
+	defer mockLookupCache.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -175,10 +244,20 @@ func TestUsecase_Execute_RunMATLABFileEvalError(t *testing.T) {
 	evalRequest := entities.EvalRequest{Code: fileName}
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(scriptPath).
+		ValidateMATLABScript(ctx, scriptPath).
 		Return(scriptPath, nil).
 		Once()
 
+	mockCodePolicy.EXPECT().
+		CheckFile(scriptPath).
+		Return(nil).
+		Once()
+
+	mockApprovalGate.EXPECT().
+		ApproveFile(ctx, scriptPath).
+		Return(nil).
+		Once()
+
 	mockClient.EXPECT().
 		Eval(ctx, mockLogger.AsMockArg(), cdRequest).
 		Return(entities.EvalResponse{}, nil).
@@ -189,7 +268,11 @@ func TestUsecase_Execute_RunMATLABFileEvalError(t *testing.T) {
 		Return(entities.EvalResponse{}, expectedError).
 		Once()
 
-	usecase := runmatlabfile.New(mockPathValidator)
+	mockLookupCache.EXPECT().
+		InvalidateForFile(mockLogger.AsMockArg(), scriptPath).
+		Once()
+
+	usecase := runmatlabfile.New(mockPathValidator, mockCodePolicy, mockApprovalGate, mockLookupCache)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
@@ -198,3 +281,94 @@ func TestUsecase_Execute_RunMATLABFileEvalError(t *testing.T) {
 	require.ErrorIs(t, err, expectedError)
 	assert.Empty(t, response, "Response should be empty")
 }
+
+func TestUsecase_Execute_CodePolicyError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockLookupCache := &mocks.MockLookupCache{}
+	defer mockLookupCache.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	scriptPath := filepath.Join("some", "path", "to", "file.m")
+	expectedError := assert.AnError
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(ctx, scriptPath).
+		Return(scriptPath, nil).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		CheckFile(scriptPath).
+		Return(expectedError).
+		Once()
+
+	usecase := runmatlabfile.New(mockPathValidator, mockCodePolicy, mockApprovalGate, mockLookupCache)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockClient, runmatlabfile.Args{ScriptPath: scriptPath})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, response)
+}
+
+func TestUsecase_Execute_ApprovalGateError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockLookupCache := &mocks.MockLookupCache{}
+	defer mockLookupCache.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	scriptPath := filepath.Join("some", "path", "to", "file.m")
+	expectedError := assert.AnError
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(ctx, scriptPath).
+		Return(scriptPath, nil).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		CheckFile(scriptPath).
+		Return(nil).
+		Once()
+
+	mockApprovalGate.EXPECT().
+		ApproveFile(ctx, scriptPath).
+		Return(expectedError).
+		Once()
+
+	usecase := runmatlabfile.New(mockPathValidator, mockCodePolicy, mockApprovalGate, mockLookupCache)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockClient, runmatlabfile.Args{ScriptPath: scriptPath})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, response)
+}
@@ -4,7 +4,9 @@ package runmatlabtestfile
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
@@ -12,21 +14,54 @@ import (
 
 type Args struct {
 	ScriptPath string
+	// Parallel shards the tests across the workers of the parallel pool of the MATLAB session.
+	Parallel bool
+}
+
+// TestResult is the outcome of a single test of a parallel run.
+type TestResult struct {
+	Name       string  `json:"name"`
+	Passed     bool    `json:"passed"`
+	Failed     bool    `json:"failed"`
+	Incomplete bool    `json:"incomplete"`
+	Duration   float64 `json:"duration"`
+	Details    string  `json:"details"`
+}
+
+type parallelRun struct {
+	Output   string       `json:"output"`
+	Workers  int          `json:"workers"`
+	WallTime float64      `json:"wallTime"`
+	Tests    []TestResult `json:"tests"`
 }
 
 type PathValidator interface {
-	ValidateMATLABScript(filePath string) (string, error)
+	ValidateMATLABScript(ctx context.Context, filePath string) (string, error)
+}
+
+type CodePolicy interface {
+	CheckFile(filePath string) error
+}
+
+type ApprovalGate interface {
+	ApproveFile(ctx context.Context, filePath string) error
 }
 
 type Usecase struct {
 	pathValidator PathValidator
+	codePolicy    CodePolicy
+	approvalGate  ApprovalGate
 }
 
 func New(
 	pathValidator PathValidator,
+	codePolicy CodePolicy,
+	approvalGate ApprovalGate,
 ) *Usecase {
 	return &Usecase{
 		pathValidator: pathValidator,
+		codePolicy:    codePolicy,
+		approvalGate:  approvalGate,
 	}
 }
 
@@ -34,11 +69,25 @@ func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, cl
 	sessionLogger.Debug("Entering RunMATLABTestFile Usecase")
 	defer sessionLogger.Debug("Exiting RunMATLABTestFile Usecase")
 
-	validatedPath, err := u.pathValidator.ValidateMATLABScript(request.ScriptPath)
+	validatedPath, err := u.pathValidator.ValidateMATLABScript(ctx, request.ScriptPath)
 	if err != nil {
 		return entities.EvalResponse{}, err
 	}
 
+	if err := u.codePolicy.CheckFile(validatedPath); err != nil {
+		sessionLogger.WithError(err).With("path", validatedPath).Warn("Script rejected by the code policy")
+		return entities.EvalResponse{}, err
+	}
+
+	if err := u.approvalGate.ApproveFile(ctx, validatedPath); err != nil {
+		sessionLogger.WithError(err).With("path", validatedPath).Warn("Script not approved by the user")
+		return entities.EvalResponse{}, err
+	}
+
+	if request.Parallel {
+		return u.runInParallel(ctx, sessionLogger, client, validatedPath)
+	}
+
 	runCodeRequest := entities.EvalRequest{
 		Code: fmt.Sprintf("runtests('%s')", strings.ReplaceAll(validatedPath, "'", "''")),
 	}
@@ -52,3 +101,98 @@ func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, cl
 		ConsoleOutput: response.ConsoleOutput,
 	}, nil
 }
+
+// runInParallel shards the tests across the workers of the parallel pool, and returns the output of the run followed by
+// a summary of the merged results. The results are sorted by test name, so that the summary does not depend on the order
+// in which the workers finished.
+func (u *Usecase) runInParallel(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, validatedPath string) (entities.EvalResponse, error) {
+	response, err := client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+		Function:   "matlab_mcp.runTestsInParallel",
+		Arguments:  []string{validatedPath},
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return entities.EvalResponse{}, err
+	}
+
+	if len(response.Outputs) != 1 {
+		return entities.EvalResponse{}, fmt.Errorf("unexpected number of outputs from MATLAB session")
+	}
+
+	output, ok := response.Outputs[0].(string)
+	if !ok {
+		return entities.EvalResponse{}, fmt.Errorf("failed to cast output to string")
+	}
+
+	var run parallelRun
+	if err := json.Unmarshal([]byte(output), &run); err != nil {
+		return entities.EvalResponse{}, fmt.Errorf("failed to parse test results: %w", err)
+	}
+
+	sort.SliceStable(run.Tests, func(i, j int) bool {
+		return run.Tests[i].Name < run.Tests[j].Name
+	})
+
+	sessionLogger.
+		With("tests", len(run.Tests)).
+		With("workers", run.Workers).
+		With("wall-time", run.WallTime).
+		Debug("Ran MATLAB tests in parallel")
+
+	consoleOutput := summarize(run)
+	if run.Output != "" {
+		consoleOutput = strings.TrimRight(run.Output, "\n") + "\n\n" + consoleOutput
+	}
+
+	return entities.EvalResponse{
+		ConsoleOutput: consoleOutput,
+	}, nil
+}
+
+func summarize(run parallelRun) string {
+	passed := 0
+	var serialTime float64
+	var failed, incomplete []TestResult
+	for _, test := range run.Tests {
+		serialTime += test.Duration
+		switch {
+		case test.Failed:
+			failed = append(failed, test)
+		case test.Incomplete:
+			incomplete = append(incomplete, test)
+		case test.Passed:
+			passed++
+		}
+	}
+
+	var summary strings.Builder
+	if run.Workers > 0 {
+		fmt.Fprintf(&summary, "Ran %d tests on %d parallel workers in %.1f s: %d passed, %d failed, %d incomplete.\n", len(run.Tests), run.Workers, run.WallTime, passed, len(failed), len(incomplete))
+		if saved := serialTime - run.WallTime; saved >= 0 {
+			fmt.Fprintf(&summary, "Run one after the other, the tests take %.1f s, so running them in parallel saved %.1f s.\n", serialTime, saved)
+		} else {
+			fmt.Fprintf(&summary, "Run one after the other, the tests take %.1f s, so running them in parallel took %.1f s longer.\n", serialTime, -saved)
+		}
+	} else {
+		fmt.Fprintf(&summary, "Ran %d tests one after the other in %.1f s, as no parallel pool is available: %d passed, %d failed, %d incomplete.\n", len(run.Tests), run.WallTime, passed, len(failed), len(incomplete))
+	}
+
+	writeTests(&summary, "Failed tests:", failed)
+	writeTests(&summary, "Incomplete tests:", incomplete)
+
+	return strings.TrimRight(summary.String(), "\n")
+}
+
+func writeTests(summary *strings.Builder, heading string, tests []TestResult) {
+	if len(tests) == 0 {
+		return
+	}
+
+	summary.WriteString(heading + "\n")
+	for _, test := range tests {
+		summary.WriteString("- " + test.Name + "\n")
+		if test.Details != "" {
+			summary.WriteString(test.Details + "\n")
+		}
+	}
+}
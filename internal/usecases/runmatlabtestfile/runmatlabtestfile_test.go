@@ -21,8 +21,14 @@ func TestNew_HappyPath(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
 	// Act
-	usecase := runmatlabtestfile.New(mockPathValidator)
+	usecase := runmatlabtestfile.New(mockPathValidator, mockCodePolicy, mockApprovalGate)
 
 	// Assert
 	assert.NotNil(t, usecase, "Usecase should not be nil")
@@ -35,6 +41,12 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -58,16 +70,26 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 	ctx := t.Context()
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(scriptPath).
+		ValidateMATLABScript(ctx, scriptPath).
 		Return(scriptPath, nil).
 		Once()
 
+	mockCodePolicy.EXPECT().
+		CheckFile(scriptPath).
+		Return(nil).
+		Once()
+
+	mockApprovalGate.EXPECT().
+		ApproveFile(ctx, scriptPath).
+		Return(nil).
+		Once()
+
 	mockClient.EXPECT().
 		EvalWithCapture(ctx, mockLogger.AsMockArg(), evalRequest).
 		Return(mockResponse, nil).
 		Once()
 
-	usecase := runmatlabtestfile.New(mockPathValidator)
+	usecase := runmatlabtestfile.New(mockPathValidator, mockCodePolicy, mockApprovalGate)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
@@ -84,6 +106,12 @@ func TestUsecase_Execute_ValidateMATLABScriptError(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -94,11 +122,11 @@ func TestUsecase_Execute_ValidateMATLABScriptError(t *testing.T) {
 	usecaseRequest := runmatlabtestfile.Args{ScriptPath: scriptPath}
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(scriptPath).
+		ValidateMATLABScript(ctx, scriptPath).
 		Return("", expectedError).
 		Once()
 
-	usecase := runmatlabtestfile.New(mockPathValidator)
+	usecase := runmatlabtestfile.New(mockPathValidator, mockCodePolicy, mockApprovalGate)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
@@ -115,6 +143,12 @@ func TestUsecase_Execute_RunMATLABTestFileEvalError(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -129,16 +163,26 @@ func TestUsecase_Execute_RunMATLABTestFileEvalError(t *testing.T) {
 	}
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(scriptPath).
+		ValidateMATLABScript(ctx, scriptPath).
 		Return(scriptPath, nil).
 		Once()
 
+	mockCodePolicy.EXPECT().
+		CheckFile(scriptPath).
+		Return(nil).
+		Once()
+
+	mockApprovalGate.EXPECT().
+		ApproveFile(ctx, scriptPath).
+		Return(nil).
+		Once()
+
 	mockClient.EXPECT().
 		EvalWithCapture(ctx, mockLogger.AsMockArg(), evalRequest).
 		Return(entities.EvalResponse{}, expectedError).
 		Once()
 
-	usecase := runmatlabtestfile.New(mockPathValidator)
+	usecase := runmatlabtestfile.New(mockPathValidator, mockCodePolicy, mockApprovalGate)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
@@ -147,3 +191,277 @@ func TestUsecase_Execute_RunMATLABTestFileEvalError(t *testing.T) {
 	require.ErrorIs(t, err, expectedError)
 	assert.Empty(t, response, "Response should be empty")
 }
+
+func TestUsecase_Execute_CodePolicyError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	scriptPath := filepath.Join("some", "path", "to", "file.m")
+	expectedError := assert.AnError
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(ctx, scriptPath).
+		Return(scriptPath, nil).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		CheckFile(scriptPath).
+		Return(expectedError).
+		Once()
+
+	usecase := runmatlabtestfile.New(mockPathValidator, mockCodePolicy, mockApprovalGate)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockClient, runmatlabtestfile.Args{ScriptPath: scriptPath})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, response)
+}
+
+func TestUsecase_Execute_ApprovalGateError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	scriptPath := filepath.Join("some", "path", "to", "file.m")
+	expectedError := assert.AnError
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(ctx, scriptPath).
+		Return(scriptPath, nil).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		CheckFile(scriptPath).
+		Return(nil).
+		Once()
+
+	mockApprovalGate.EXPECT().
+		ApproveFile(ctx, scriptPath).
+		Return(expectedError).
+		Once()
+
+	usecase := runmatlabtestfile.New(mockPathValidator, mockCodePolicy, mockApprovalGate)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockClient, runmatlabtestfile.Args{ScriptPath: scriptPath})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, response)
+}
+
+func TestUsecase_Execute_Parallel(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	scriptPath := filepath.Join("some", "path", "to", "testFile.m")
+
+	usecaseRequest := runmatlabtestfile.Args{ScriptPath: scriptPath, Parallel: true}
+
+	// The workers finish in any order, so the tests are not sorted.
+	const runJSON = `{"output":"Running testFile\n...\nDone testFile\n","workers":4,"wallTime":10,"tests":[` +
+		`{"name":"testFile/testC","passed":true,"failed":false,"incomplete":false,"duration":12,"details":""},` +
+		`{"name":"testFile/testA","passed":false,"failed":true,"incomplete":false,"duration":8,"details":"Verification failed."},` +
+		`{"name":"testFile/testB","passed":false,"failed":false,"incomplete":true,"duration":5,"details":""}]}`
+
+	expectedResponse := entities.EvalResponse{
+		ConsoleOutput: "Running testFile\n...\nDone testFile\n\n" +
+			"Ran 3 tests on 4 parallel workers in 10.0 s: 1 passed, 1 failed, 1 incomplete.\n" +
+			"Run one after the other, the tests take 25.0 s, so running them in parallel saved 15.0 s.\n" +
+			"Failed tests:\n" +
+			"- testFile/testA\n" +
+			"Verification failed.\n" +
+			"Incomplete tests:\n" +
+			"- testFile/testB",
+	}
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(ctx, scriptPath).
+		Return(scriptPath, nil).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		CheckFile(scriptPath).
+		Return(nil).
+		Once()
+
+	mockApprovalGate.EXPECT().
+		ApproveFile(ctx, scriptPath).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.runTestsInParallel",
+			Arguments:  []string{scriptPath},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{runJSON}}, nil).
+		Once()
+
+	usecase := runmatlabtestfile.New(mockPathValidator, mockCodePolicy, mockApprovalGate)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedResponse, response)
+}
+
+func TestUsecase_Execute_ParallelWithoutPool(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	scriptPath := filepath.Join("some", "path", "to", "testFile.m")
+
+	usecaseRequest := runmatlabtestfile.Args{ScriptPath: scriptPath, Parallel: true}
+
+	const runJSON = `{"output":"","workers":0,"wallTime":2.5,"tests":[` +
+		`{"name":"testFile/testA","passed":true,"failed":false,"incomplete":false,"duration":2.4,"details":""}]}`
+
+	expectedResponse := entities.EvalResponse{
+		ConsoleOutput: "Ran 1 tests one after the other in 2.5 s, as no parallel pool is available: 1 passed, 0 failed, 0 incomplete.",
+	}
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(ctx, scriptPath).
+		Return(scriptPath, nil).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		CheckFile(scriptPath).
+		Return(nil).
+		Once()
+
+	mockApprovalGate.EXPECT().
+		ApproveFile(ctx, scriptPath).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.runTestsInParallel",
+			Arguments:  []string{scriptPath},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{runJSON}}, nil).
+		Once()
+
+	usecase := runmatlabtestfile.New(mockPathValidator, mockCodePolicy, mockApprovalGate)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedResponse, response)
+}
+
+func TestUsecase_Execute_ParallelInvalidResults(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	scriptPath := filepath.Join("some", "path", "to", "testFile.m")
+
+	usecaseRequest := runmatlabtestfile.Args{ScriptPath: scriptPath, Parallel: true}
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(ctx, scriptPath).
+		Return(scriptPath, nil).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		CheckFile(scriptPath).
+		Return(nil).
+		Once()
+
+	mockApprovalGate.EXPECT().
+		ApproveFile(ctx, scriptPath).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.runTestsInParallel",
+			Arguments:  []string{scriptPath},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{"not json"}}, nil).
+		Once()
+
+	usecase := runmatlabtestfile.New(mockPathValidator, mockCodePolicy, mockApprovalGate)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
+
+	// Assert
+	require.Error(t, err)
+	assert.Empty(t, response)
+}
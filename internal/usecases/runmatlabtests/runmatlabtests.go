@@ -0,0 +1,234 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package runmatlabtests
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+const (
+	// MaxTestFiles is the number of MATLAB files a folder of tests may hold at most, as each of them is checked before
+	// the tests run.
+	MaxTestFiles = 1000
+
+	// MaxFailures is the number of failed or incomplete tests listed at most, so that a broken build does not flood the
+	// context of the AI application.
+	MaxFailures = 50
+)
+
+var errTooManyFiles = errors.New("too many MATLAB files")
+
+type Args struct {
+	// TestPath is a test file, or a folder whose test files, including those of its subfolders, are run.
+	TestPath string
+	// TestClass selects the tests of a test class, qualified with its package if any.
+	TestClass string
+	// Tag selects the tests with a tag.
+	Tag string
+	// NamePattern selects the tests whose name matches a pattern, with * wildcards.
+	NamePattern string
+}
+
+type StackFrame struct {
+	File string `json:"file"`
+	Name string `json:"name"`
+	Line int    `json:"line"`
+}
+
+// FailureEvent is a qualification that failed a test, or an exception the test did not catch.
+type FailureEvent struct {
+	Event   string       `json:"event"`
+	Message string       `json:"message"`
+	Stack   []StackFrame `json:"stack"`
+}
+
+// TestFailure is a test that failed or is incomplete.
+type TestFailure struct {
+	Name     string         `json:"name"`
+	Status   string         `json:"status"`
+	Duration float64        `json:"duration"`
+	Events   []FailureEvent `json:"events"`
+}
+
+type ReturnArgs struct {
+	Total      int
+	Passed     int
+	Failed     int
+	Incomplete int
+	// Duration is the total duration of the tests, in seconds.
+	Duration float64
+	Failures []TestFailure
+	// OmittedFailures is the number of failed or incomplete tests not listed in Failures.
+	OmittedFailures int
+}
+
+type testRun struct {
+	Total      int           `json:"total"`
+	Passed     int           `json:"passed"`
+	Failed     int           `json:"failed"`
+	Incomplete int           `json:"incomplete"`
+	Duration   float64       `json:"duration"`
+	Failures   []TestFailure `json:"failures"`
+}
+
+type PathValidator interface {
+	ValidateMATLABScript(ctx context.Context, filePath string) (string, error)
+	ValidateFolderPath(ctx context.Context, filePath string) (string, error)
+}
+
+type CodePolicy interface {
+	CheckFile(filePath string) error
+}
+
+type ApprovalGate interface {
+	ApproveFile(ctx context.Context, filePath string) error
+}
+
+type OSLayer interface {
+	DirFS(dir string) fs.FS
+}
+
+type Usecase struct {
+	pathValidator PathValidator
+	codePolicy    CodePolicy
+	approvalGate  ApprovalGate
+	osLayer       OSLayer
+}
+
+func New(
+	pathValidator PathValidator,
+	codePolicy CodePolicy,
+	approvalGate ApprovalGate,
+	osLayer OSLayer,
+) *Usecase {
+	return &Usecase{
+		pathValidator: pathValidator,
+		codePolicy:    codePolicy,
+		approvalGate:  approvalGate,
+		osLayer:       osLayer,
+	}
+}
+
+// Execute runs the selected tests of a test file, or of the test files of a folder, and returns the counts of their
+// outcomes, with the failure messages and stack traces of the tests that did not pass. Every MATLAB file of a folder is
+// checked by the code policy and approved, as any of them may run.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering RunMATLABTests Usecase")
+	defer sessionLogger.Debug("Exiting RunMATLABTests Usecase")
+
+	validatedPath, files, err := u.testFiles(ctx, request.TestPath)
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	for _, file := range files {
+		if err := u.codePolicy.CheckFile(file); err != nil {
+			sessionLogger.WithError(err).With("path", file).Warn("Test file rejected by the code policy")
+			return ReturnArgs{}, err
+		}
+	}
+
+	for _, file := range files {
+		if err := u.approvalGate.ApproveFile(ctx, file); err != nil {
+			sessionLogger.WithError(err).With("path", file).Warn("Test file not approved by the user")
+			return ReturnArgs{}, err
+		}
+	}
+
+	response, err := client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+		Function:   "matlab_mcp.runTests",
+		Arguments:  []string{validatedPath, request.TestClass, request.Tag, request.NamePattern},
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	if len(response.Outputs) != 1 {
+		return ReturnArgs{}, fmt.Errorf("unexpected number of outputs from MATLAB session")
+	}
+
+	output, ok := response.Outputs[0].(string)
+	if !ok {
+		return ReturnArgs{}, fmt.Errorf("failed to cast output to string")
+	}
+
+	var run testRun
+	if err := json.Unmarshal([]byte(output), &run); err != nil {
+		return ReturnArgs{}, fmt.Errorf("failed to parse test results: %w", err)
+	}
+
+	result := ReturnArgs{
+		Total:      run.Total,
+		Passed:     run.Passed,
+		Failed:     run.Failed,
+		Incomplete: run.Incomplete,
+		Duration:   run.Duration,
+		Failures:   run.Failures,
+	}
+	if len(result.Failures) > MaxFailures {
+		result.OmittedFailures = len(result.Failures) - MaxFailures
+		result.Failures = result.Failures[:MaxFailures]
+	}
+
+	sessionLogger.
+		With("total", result.Total).
+		With("failed", result.Failed).
+		With("incomplete", result.Incomplete).
+		Debug("Ran MATLAB tests")
+
+	return result, nil
+}
+
+// testFiles validates the test path, and returns it with the MATLAB files that may run: the test file, or the MATLAB
+// files of the folder and its subfolders.
+func (u *Usecase) testFiles(ctx context.Context, testPath string) (string, []string, error) {
+	if strings.EqualFold(filepath.Ext(testPath), ".m") {
+		validatedPath, err := u.pathValidator.ValidateMATLABScript(ctx, testPath)
+		if err != nil {
+			return "", nil, err
+		}
+		return validatedPath, []string{validatedPath}, nil
+	}
+
+	validatedPath, err := u.pathValidator.ValidateFolderPath(ctx, testPath)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var files []string
+	err = fs.WalkDir(u.osLayer.DirFS(validatedPath), ".", func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() || path.Ext(filePath) != ".m" {
+			return nil
+		}
+		if len(files) == MaxTestFiles {
+			return errTooManyFiles
+		}
+		files = append(files, filepath.Join(validatedPath, filepath.FromSlash(filePath)))
+		return nil
+	})
+	if errors.Is(err, errTooManyFiles) {
+		return "", nil, entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("the folder %s holds more than %d MATLAB files; select a subfolder of tests", validatedPath, MaxTestFiles))
+	}
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to list the test files of %s: %w", validatedPath, err)
+	}
+
+	if len(files) == 0 {
+		return "", nil, entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("the folder %s holds no MATLAB file", validatedPath))
+	}
+
+	return validatedPath, files, nil
+}
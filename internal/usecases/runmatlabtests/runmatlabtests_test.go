@@ -0,0 +1,504 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package runmatlabtests_test
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/runmatlabtests"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/runmatlabtests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testRunOutput = `{
+	"total": 3, "passed": 1, "failed": 1, "incomplete": 1, "duration": 0.75,
+	"failures": [
+		{"name": "SolverTest/testConverges", "status": "failed", "duration": 0.5, "events": [
+			{"event": "VerificationFailed", "message": "Actual Value: 2\nExpected Value: 3", "stack": [
+				{"file": "/home/user/project/tests/SolverTest.m", "name": "SolverTest.testConverges", "line": 12}
+			]}
+		]},
+		{"name": "SolverTest/testGPU", "status": "incomplete", "duration": 0.01, "events": [
+			{"event": "AssumptionFailed", "message": "No GPU device found", "stack": []}
+		]}
+	]
+}`
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	// Act
+	usecase := runmatlabtests.New(mockPathValidator, mockCodePolicy, mockApprovalGate, mockOSLayer)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath_File(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := runmatlabtests.New(mockPathValidator, mockCodePolicy, mockApprovalGate, mockOSLayer)
+
+	ctx := t.Context()
+	const testPath = "/home/user/project/tests/SolverTest.m"
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(ctx, testPath).
+		Return(testPath, nil).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		CheckFile(testPath).
+		Return(nil).
+		Once()
+
+	mockApprovalGate.EXPECT().
+		ApproveFile(ctx, testPath).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.runTests",
+			Arguments:  []string{testPath, "SolverTest", "", "test*"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{testRunOutput}}, nil).
+		Once()
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, runmatlabtests.Args{
+		TestPath:    testPath,
+		TestClass:   "SolverTest",
+		NamePattern: "test*",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, runmatlabtests.ReturnArgs{
+		Total:      3,
+		Passed:     1,
+		Failed:     1,
+		Incomplete: 1,
+		Duration:   0.75,
+		Failures: []runmatlabtests.TestFailure{
+			{
+				Name:     "SolverTest/testConverges",
+				Status:   "failed",
+				Duration: 0.5,
+				Events: []runmatlabtests.FailureEvent{
+					{
+						Event:   "VerificationFailed",
+						Message: "Actual Value: 2\nExpected Value: 3",
+						Stack: []runmatlabtests.StackFrame{
+							{File: "/home/user/project/tests/SolverTest.m", Name: "SolverTest.testConverges", Line: 12},
+						},
+					},
+				},
+			},
+			{
+				Name:     "SolverTest/testGPU",
+				Status:   "incomplete",
+				Duration: 0.01,
+				Events: []runmatlabtests.FailureEvent{
+					{Event: "AssumptionFailed", Message: "No GPU device found", Stack: []runmatlabtests.StackFrame{}},
+				},
+			},
+		},
+	}, result)
+}
+
+func TestUsecase_Execute_HappyPath_Folder(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := runmatlabtests.New(mockPathValidator, mockCodePolicy, mockApprovalGate, mockOSLayer)
+
+	ctx := t.Context()
+	testPath := filepath.FromSlash("/home/user/project/tests")
+	unitTest := filepath.Join(testPath, "unit", "SolverTest.m")
+	helper := filepath.Join(testPath, "helperData.m")
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(ctx, testPath).
+		Return(testPath, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		DirFS(testPath).
+		Return(fstest.MapFS{
+			"unit/SolverTest.m": {Data: []byte("classdef SolverTest < matlab.unittest.TestCase\nend\n")},
+			"helperData.m":      {Data: []byte("function d = helperData\nd = 1;\nend\n")},
+			"data/input.mat":    {Data: []byte{0}},
+		}).
+		Once()
+
+	for _, file := range []string{helper, unitTest} {
+		mockCodePolicy.EXPECT().
+			CheckFile(file).
+			Return(nil).
+			Once()
+
+		mockApprovalGate.EXPECT().
+			ApproveFile(ctx, file).
+			Return(nil).
+			Once()
+	}
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.runTests",
+			Arguments:  []string{testPath, "", "Unit", ""},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`{"total":2,"passed":2,"failed":0,"incomplete":0,"duration":0.2,"failures":[]}`}}, nil).
+		Once()
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, runmatlabtests.Args{
+		TestPath: testPath,
+		Tag:      "Unit",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Total)
+	assert.Equal(t, 2, result.Passed)
+	assert.Empty(t, result.Failures)
+}
+
+func TestUsecase_Execute_TooManyFailures(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := runmatlabtests.New(mockPathValidator, mockCodePolicy, mockApprovalGate, mockOSLayer)
+
+	ctx := t.Context()
+	const testPath = "/home/user/project/tests/SolverTest.m"
+
+	failures := make([]runmatlabtests.TestFailure, runmatlabtests.MaxFailures+5)
+	for i := range failures {
+		failures[i] = runmatlabtests.TestFailure{Name: "SolverTest/test", Status: "failed", Events: []runmatlabtests.FailureEvent{}}
+	}
+	output, err := json.Marshal(map[string]any{"total": len(failures), "failed": len(failures), "failures": failures})
+	require.NoError(t, err)
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(ctx, testPath).
+		Return(testPath, nil).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		CheckFile(testPath).
+		Return(nil).
+		Once()
+
+	mockApprovalGate.EXPECT().
+		ApproveFile(ctx, testPath).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.runTests",
+			Arguments:  []string{testPath, "", "", ""},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{string(output)}}, nil).
+		Once()
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, runmatlabtests.Args{TestPath: testPath})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, runmatlabtests.MaxFailures+5, result.Failed)
+	assert.Len(t, result.Failures, runmatlabtests.MaxFailures)
+	assert.Equal(t, 5, result.OmittedFailures)
+}
+
+func TestUsecase_Execute_EmptyFolder(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := runmatlabtests.New(mockPathValidator, mockCodePolicy, mockApprovalGate, mockOSLayer)
+
+	ctx := t.Context()
+	const testPath = "/home/user/project/tests"
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(ctx, testPath).
+		Return(testPath, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		DirFS(testPath).
+		Return(fstest.MapFS{"README.md": {Data: []byte("# Tests")}}).
+		Once()
+
+	// Act
+	_, err := usecase.Execute(ctx, mockLogger, mockClient, runmatlabtests.Args{TestPath: testPath})
+
+	// Assert
+	require.ErrorContains(t, err, "holds no MATLAB file")
+	assert.Equal(t, entities.ErrorCodeInvalidInput, entities.ErrorCodeOf(err))
+}
+
+func TestUsecase_Execute_PathValidationError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := runmatlabtests.New(mockPathValidator, mockCodePolicy, mockApprovalGate, mockOSLayer)
+
+	ctx := t.Context()
+	const testPath = "/home/user/project/tests/SolverTest.m"
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(ctx, testPath).
+		Return("", assert.AnError).
+		Once()
+
+	// Act
+	_, err := usecase.Execute(ctx, mockLogger, mockClient, runmatlabtests.Args{TestPath: testPath})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+func TestUsecase_Execute_CodePolicyViolation(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := runmatlabtests.New(mockPathValidator, mockCodePolicy, mockApprovalGate, mockOSLayer)
+
+	ctx := t.Context()
+	const testPath = "/home/user/project/tests/SolverTest.m"
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(ctx, testPath).
+		Return(testPath, nil).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		CheckFile(testPath).
+		Return(assert.AnError).
+		Once()
+
+	// Act
+	_, err := usecase.Execute(ctx, mockLogger, mockClient, runmatlabtests.Args{TestPath: testPath})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	mockApprovalGate.AssertNotCalled(t, "ApproveFile", mock.Anything, mock.Anything)
+}
+
+func TestUsecase_Execute_NotApproved(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := runmatlabtests.New(mockPathValidator, mockCodePolicy, mockApprovalGate, mockOSLayer)
+
+	ctx := t.Context()
+	const testPath = "/home/user/project/tests/SolverTest.m"
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(ctx, testPath).
+		Return(testPath, nil).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		CheckFile(testPath).
+		Return(nil).
+		Once()
+
+	mockApprovalGate.EXPECT().
+		ApproveFile(ctx, testPath).
+		Return(assert.AnError).
+		Once()
+
+	// Act
+	_, err := usecase.Execute(ctx, mockLogger, mockClient, runmatlabtests.Args{TestPath: testPath})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+func TestUsecase_Execute_FEvalError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := runmatlabtests.New(mockPathValidator, mockCodePolicy, mockApprovalGate, mockOSLayer)
+
+	ctx := t.Context()
+	const testPath = "/home/user/project/tests/SolverTest.m"
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(ctx, testPath).
+		Return(testPath, nil).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		CheckFile(testPath).
+		Return(nil).
+		Once()
+
+	mockApprovalGate.EXPECT().
+		ApproveFile(ctx, testPath).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.runTests",
+			Arguments:  []string{testPath, "", "", ""},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{}, assert.AnError).
+		Once()
+
+	// Act
+	_, err := usecase.Execute(ctx, mockLogger, mockClient, runmatlabtests.Args{TestPath: testPath})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
@@ -0,0 +1,129 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package runpythoncode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// MaxVariableCharacters is the maximum number of characters of the representation of a variable, as the
+// representation of a large collection holds all of its elements.
+const MaxVariableCharacters = 2000
+
+var identifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+type Args struct {
+	Code        string
+	ProjectPath string
+	Variables   []string
+}
+
+type ReturnArgs struct {
+	Output    string            `json:"output"`
+	Error     string            `json:"error"`
+	Variables map[string]string `json:"variables"`
+}
+
+type PathValidator interface {
+	ValidateFolderPath(ctx context.Context, filePath string) (string, error)
+}
+
+type CodePolicy interface {
+	CheckPython() error
+}
+
+type ApprovalGate interface {
+	ApprovePythonCode(ctx context.Context, code string) error
+}
+
+type Usecase struct {
+	pathValidator PathValidator
+	codePolicy    CodePolicy
+	approvalGate  ApprovalGate
+}
+
+func New(
+	pathValidator PathValidator,
+	codePolicy CodePolicy,
+	approvalGate ApprovalGate,
+) *Usecase {
+	return &Usecase{
+		pathValidator: pathValidator,
+		codePolicy:    codePolicy,
+		approvalGate:  approvalGate,
+	}
+}
+
+// Execute runs Python code with pyrun in the MATLAB session, and returns what it printed, the traceback of the
+// exception it raised, and the representation of the requested variables. An exception raised by the code is part
+// of the result, not an error, so that the output printed before it is not lost.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering RunPythonCode Usecase")
+	defer sessionLogger.Debug("Exiting RunPythonCode Usecase")
+
+	if err := u.codePolicy.CheckPython(); err != nil {
+		sessionLogger.WithError(err).Warn("Python code rejected by the code policy")
+		return ReturnArgs{}, err
+	}
+
+	for _, variable := range request.Variables {
+		if !identifier.MatchString(variable) {
+			return ReturnArgs{}, entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("%q is not a valid Python variable name", variable))
+		}
+	}
+
+	validatedPath, err := u.pathValidator.ValidateFolderPath(ctx, request.ProjectPath)
+	if err != nil {
+		sessionLogger.WithError(err).With("path", request.ProjectPath).Warn("Path validation failed")
+		return ReturnArgs{}, fmt.Errorf("path validation failed: %w", err)
+	}
+
+	if err := u.approvalGate.ApprovePythonCode(ctx, request.Code); err != nil {
+		sessionLogger.WithError(err).Warn("Python code not approved by the user")
+		return ReturnArgs{}, err
+	}
+
+	response, err := client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+		Function:   "matlab_mcp.runPython",
+		Arguments:  append([]string{request.Code, validatedPath}, request.Variables...),
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	if len(response.Outputs) != 1 {
+		return ReturnArgs{}, fmt.Errorf("unexpected number of outputs from MATLAB session")
+	}
+
+	output, ok := response.Outputs[0].(string)
+	if !ok {
+		return ReturnArgs{}, fmt.Errorf("failed to cast output to string")
+	}
+
+	var result ReturnArgs
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		return ReturnArgs{}, fmt.Errorf("failed to parse Python result: %w", err)
+	}
+
+	for name, representation := range result.Variables {
+		result.Variables[name] = truncate(representation)
+	}
+
+	return result, nil
+}
+
+func truncate(representation string) string {
+	if utf8.RuneCountInString(representation) <= MaxVariableCharacters {
+		return representation
+	}
+
+	runes := []rune(representation)
+	return string(runes[:MaxVariableCharacters]) + fmt.Sprintf("... (%d characters in total)", len(runes))
+}
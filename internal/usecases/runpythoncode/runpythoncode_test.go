@@ -0,0 +1,264 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package runpythoncode_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/runpythoncode"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/runpythoncode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	// Act
+	usecase := runpythoncode.New(mockPathValidator, mockCodePolicy, mockApprovalGate)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := runpythoncode.New(mockPathValidator, mockCodePolicy, mockApprovalGate)
+
+	ctx := t.Context()
+	const projectPath = "/home/user/project"
+	const code = "import numpy as np\nx = np.arange(3000)\nprint(x.sum())"
+	longRepresentation := strings.Repeat("1", runpythoncode.MaxVariableCharacters+10)
+
+	mockCodePolicy.EXPECT().
+		CheckPython().
+		Return(nil).
+		Once()
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(ctx, projectPath).
+		Return(projectPath, nil).
+		Once()
+
+	mockApprovalGate.EXPECT().
+		ApprovePythonCode(ctx, code).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.runPython",
+			Arguments:  []string{code, projectPath, "x", "np"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`{"output":"4498500\n","error":"","variables":{"x":"` + longRepresentation + `","np":"<module 'numpy'>"}}`}}, nil).
+		Once()
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, runpythoncode.Args{
+		Code:        code,
+		ProjectPath: projectPath,
+		Variables:   []string{"x", "np"},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "4498500\n", result.Output)
+	assert.Empty(t, result.Error)
+	assert.Equal(t, "<module 'numpy'>", result.Variables["np"])
+	assert.Equal(t, longRepresentation[:runpythoncode.MaxVariableCharacters]+"... (2010 characters in total)", result.Variables["x"])
+}
+
+func TestUsecase_Execute_PythonException(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := runpythoncode.New(mockPathValidator, mockCodePolicy, mockApprovalGate)
+
+	ctx := t.Context()
+	const projectPath = "/home/user/project"
+	const code = "print('start')\n1 / 0"
+	const traceback = "Traceback (most recent call last):\nZeroDivisionError: division by zero\n"
+
+	mockCodePolicy.EXPECT().CheckPython().Return(nil).Once()
+	mockPathValidator.EXPECT().ValidateFolderPath(ctx, projectPath).Return(projectPath, nil).Once()
+	mockApprovalGate.EXPECT().ApprovePythonCode(ctx, code).Return(nil).Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.runPython",
+			Arguments:  []string{code, projectPath},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`{"output":"start\n","error":"Traceback (most recent call last):\nZeroDivisionError: division by zero\n","variables":{}}`}}, nil).
+		Once()
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, runpythoncode.Args{Code: code, ProjectPath: projectPath})
+
+	// Assert
+	require.NoError(t, err, "An exception raised by the code should be part of the result")
+	assert.Equal(t, "start\n", result.Output)
+	assert.Equal(t, traceback, result.Error)
+}
+
+func TestUsecase_Execute_InvalidVariable(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := runpythoncode.New(mockPathValidator, mockCodePolicy, mockApprovalGate)
+
+	mockCodePolicy.EXPECT().CheckPython().Return(nil).Once()
+
+	// Act
+	_, err := usecase.Execute(t.Context(), mockLogger, mockClient, runpythoncode.Args{
+		Code:        "x = 1",
+		ProjectPath: "/home/user/project",
+		Variables:   []string{"x.__class__"},
+	})
+
+	// Assert
+	var codedErr *entities.CodedError
+	require.ErrorAs(t, err, &codedErr)
+	assert.Equal(t, entities.ErrorCodeInvalidInput, codedErr.ErrorCode())
+}
+
+func TestUsecase_Execute_PolicyViolation(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := runpythoncode.New(mockPathValidator, mockCodePolicy, mockApprovalGate)
+
+	mockCodePolicy.EXPECT().CheckPython().Return(assert.AnError).Once()
+
+	// Act
+	_, err := usecase.Execute(t.Context(), mockLogger, mockClient, runpythoncode.Args{Code: "x = 1", ProjectPath: "/home/user/project"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.NotEmpty(t, mockLogger.WarnLogs(), "The rejection should be logged")
+}
+
+func TestUsecase_Execute_PathValidationError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := runpythoncode.New(mockPathValidator, mockCodePolicy, mockApprovalGate)
+
+	ctx := t.Context()
+
+	mockCodePolicy.EXPECT().CheckPython().Return(nil).Once()
+	mockPathValidator.EXPECT().ValidateFolderPath(ctx, "relative/path").Return("", assert.AnError).Once()
+
+	// Act
+	_, err := usecase.Execute(ctx, mockLogger, mockClient, runpythoncode.Args{Code: "x = 1", ProjectPath: "relative/path"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+func TestUsecase_Execute_NotApproved(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := runpythoncode.New(mockPathValidator, mockCodePolicy, mockApprovalGate)
+
+	ctx := t.Context()
+	const projectPath = "/home/user/project"
+
+	mockCodePolicy.EXPECT().CheckPython().Return(nil).Once()
+	mockPathValidator.EXPECT().ValidateFolderPath(ctx, projectPath).Return(projectPath, nil).Once()
+	mockApprovalGate.EXPECT().ApprovePythonCode(ctx, "x = 1").Return(assert.AnError).Once()
+
+	// Act
+	_, err := usecase.Execute(ctx, mockLogger, mockClient, runpythoncode.Args{Code: "x = 1", ProjectPath: projectPath})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
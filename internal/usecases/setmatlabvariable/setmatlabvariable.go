@@ -0,0 +1,88 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package setmatlabvariable
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/variablename"
+)
+
+type Args struct {
+	Name string
+	// Value is the JSON text of the value: either typed JSON, as returned when reading a variable, or any other JSON,
+	// decoded by jsondecode.
+	Value json.RawMessage
+}
+
+type ReturnArgs struct {
+	Name  string `json:"name"`
+	Class string `json:"class"`
+	Size  []int  `json:"size"`
+	Bytes int    `json:"bytes"`
+}
+
+type Config interface {
+	MaxVariablePayload() int
+}
+
+type Usecase struct {
+	config Config
+}
+
+func New(
+	config Config,
+) *Usecase {
+	return &Usecase{
+		config: config,
+	}
+}
+
+// Execute sets a variable of the workspace of the MATLAB session to a value given as JSON text. Values larger than the
+// maximum variable payload are rejected before they are sent to MATLAB.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering SetMATLABVariable Usecase")
+	defer sessionLogger.Debug("Exiting SetMATLABVariable Usecase")
+
+	if err := variablename.Validate(request.Name); err != nil {
+		return ReturnArgs{}, err
+	}
+
+	if len(request.Value) == 0 || !json.Valid(request.Value) {
+		return ReturnArgs{}, entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("the value of %s is not valid JSON", request.Name))
+	}
+
+	if maxPayload := u.config.MaxVariablePayload(); maxPayload > 0 && len(request.Value) > maxPayload {
+		return ReturnArgs{}, entities.NewCodedError(entities.ErrorCodeLimitExceeded, fmt.Errorf("the value of %s is %d bytes of JSON, more than the maximum of %d bytes", request.Name, len(request.Value), maxPayload))
+	}
+
+	response, err := client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+		Function:   "matlab_mcp.setVariable",
+		Arguments:  []string{request.Name, string(request.Value)},
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	if len(response.Outputs) != 1 {
+		return ReturnArgs{}, fmt.Errorf("unexpected number of outputs from MATLAB session")
+	}
+
+	output, ok := response.Outputs[0].(string)
+	if !ok {
+		return ReturnArgs{}, fmt.Errorf("failed to cast output to string")
+	}
+
+	var result ReturnArgs
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		return ReturnArgs{}, fmt.Errorf("failed to parse set variable: %w", err)
+	}
+
+	sessionLogger.With("variable-bytes", result.Bytes).With("payload-bytes", len(request.Value)).Debug("Set MATLAB variable")
+
+	return result, nil
+}
@@ -0,0 +1,250 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package setmatlabvariable_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/setmatlabvariable"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/setmatlabvariable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	// Act
+	usecase := setmatlabvariable.New(mockConfig)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	value := `{"class":"int32","size":[1,3],"data":[1,2,3]}`
+
+	mockConfig.EXPECT().
+		MaxVariablePayload().
+		Return(1048576).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.setVariable",
+			Arguments:  []string{"x", value},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{
+			Outputs: []any{`{"name":"x","class":"int32","size":[1,3],"bytes":12}`},
+		}, nil).
+		Once()
+
+	usecase := setmatlabvariable.New(mockConfig)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, setmatlabvariable.Args{Name: "x", Value: json.RawMessage(value)})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, setmatlabvariable.ReturnArgs{
+		Name:  "x",
+		Class: "int32",
+		Size:  []int{1, 3},
+		Bytes: 12,
+	}, result)
+}
+
+func TestUsecase_Execute_NoPayloadLimit(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		MaxVariablePayload().
+		Return(0).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.setVariable",
+			Arguments:  []string{"s", `"some text"`},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{
+			Outputs: []any{`{"name":"s","class":"char","size":[1,9],"bytes":18}`},
+		}, nil).
+		Once()
+
+	usecase := setmatlabvariable.New(mockConfig)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, setmatlabvariable.Args{Name: "s", Value: json.RawMessage(`"some text"`)})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "char", result.Class)
+}
+
+func TestUsecase_Execute_PayloadTooLarge(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		MaxVariablePayload().
+		Return(8).
+		Once()
+
+	usecase := setmatlabvariable.New(mockConfig)
+
+	// Act
+	result, err := usecase.Execute(t.Context(), mockLogger, mockClient, setmatlabvariable.Args{Name: "x", Value: json.RawMessage(`[1,2,3,4,5,6]`)})
+
+	// Assert
+	require.Error(t, err)
+	assert.Equal(t, entities.ErrorCodeLimitExceeded, entities.ErrorCodeOf(err))
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_InvalidName(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := setmatlabvariable.New(mockConfig)
+
+	// Act
+	result, err := usecase.Execute(t.Context(), mockLogger, mockClient, setmatlabvariable.Args{Name: "x'); delete('*", Value: json.RawMessage(`1`)})
+
+	// Assert
+	require.Error(t, err)
+	assert.Equal(t, entities.ErrorCodeInvalidInput, entities.ErrorCodeOf(err))
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_InvalidJSON(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := setmatlabvariable.New(mockConfig)
+
+	// Act
+	result, err := usecase.Execute(t.Context(), mockLogger, mockClient, setmatlabvariable.Args{Name: "x", Value: json.RawMessage(`{"class":`)})
+
+	// Assert
+	require.Error(t, err)
+	assert.Equal(t, entities.ErrorCodeInvalidInput, entities.ErrorCodeOf(err))
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_FEvalError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		MaxVariablePayload().
+		Return(1048576).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.setVariable",
+			Arguments:  []string{"x", `{"class":"widget","size":[1,1]}`},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{}, assert.AnError).
+		Once()
+
+	usecase := setmatlabvariable.New(mockConfig)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, setmatlabvariable.Args{Name: "x", Value: json.RawMessage(`{"class":"widget","size":[1,1]}`)})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_UnexpectedOutput(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		MaxVariablePayload().
+		Return(1048576).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.setVariable",
+			Arguments:  []string{"x", `1`},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{42.0}}, nil).
+		Once()
+
+	usecase := setmatlabvariable.New(mockConfig)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, setmatlabvariable.Args{Name: "x", Value: json.RawMessage(`1`)})
+
+	// Assert
+	require.Error(t, err)
+	assert.Empty(t, result)
+}
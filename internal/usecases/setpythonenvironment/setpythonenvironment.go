@@ -0,0 +1,78 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package setpythonenvironment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/pythonenv"
+)
+
+type Args struct {
+	Version       string
+	ExecutionMode string
+}
+
+type CodePolicy interface {
+	CheckPython() error
+}
+
+type Usecase struct {
+	codePolicy CodePolicy
+}
+
+func New(
+	codePolicy CodePolicy,
+) *Usecase {
+	return &Usecase{
+		codePolicy: codePolicy,
+	}
+}
+
+// Execute sets the Python version or the execution mode of the MATLAB session, and returns the resulting environment.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (pythonenv.Environment, error) {
+	sessionLogger.Debug("Entering SetPythonEnvironment Usecase")
+	defer sessionLogger.Debug("Exiting SetPythonEnvironment Usecase")
+
+	if err := u.codePolicy.CheckPython(); err != nil {
+		sessionLogger.WithError(err).Warn("Python environment change rejected by the code policy")
+		return pythonenv.Environment{}, err
+	}
+
+	version := strings.TrimSpace(request.Version)
+	executionMode, err := parseExecutionMode(request.ExecutionMode)
+	if err != nil {
+		return pythonenv.Environment{}, err
+	}
+
+	if version == "" && executionMode == "" {
+		return pythonenv.Environment{}, entities.NewCodedError(entities.ErrorCodeInvalidInput, errors.New("set the version, the execution mode, or both"))
+	}
+
+	environment, err := pythonenv.Set(ctx, sessionLogger, client, version, executionMode)
+	if err != nil {
+		return pythonenv.Environment{}, err
+	}
+
+	sessionLogger.With("python-version", environment.Version).With("execution-mode", environment.ExecutionMode).Info("Set Python environment")
+
+	return environment, nil
+}
+
+// parseExecutionMode accepts the execution modes of pyenv whatever their case.
+func parseExecutionMode(executionMode string) (pythonenv.ExecutionMode, error) {
+	switch strings.ToLower(strings.TrimSpace(executionMode)) {
+	case "":
+		return "", nil
+	case strings.ToLower(string(pythonenv.ExecutionModeInProcess)):
+		return pythonenv.ExecutionModeInProcess, nil
+	case strings.ToLower(string(pythonenv.ExecutionModeOutOfProcess)):
+		return pythonenv.ExecutionModeOutOfProcess, nil
+	default:
+		return "", entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("invalid execution mode %q: use %s or %s", executionMode, pythonenv.ExecutionModeInProcess, pythonenv.ExecutionModeOutOfProcess))
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package setpythonenvironment_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/setpythonenvironment"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/pythonenv"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/setpythonenvironment"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	// Act
+	usecase := setpythonenvironment.New(mockCodePolicy)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockCodePolicy.EXPECT().
+		CheckPython().
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.pythonEnvironment",
+			Arguments:  []string{"/home/user/venv/bin/python", "OutOfProcess"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`{"version":"3.12","executable":"/home/user/venv/bin/python","status":"NotLoaded","executionMode":"OutOfProcess"}`}}, nil).
+		Once()
+
+	usecase := setpythonenvironment.New(mockCodePolicy)
+
+	// Act
+	environment, err := usecase.Execute(ctx, mockLogger, mockClient, setpythonenvironment.Args{
+		Version:       " /home/user/venv/bin/python ",
+		ExecutionMode: "outofprocess",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, pythonenv.Environment{
+		Version:       "3.12",
+		Executable:    "/home/user/venv/bin/python",
+		Status:        "NotLoaded",
+		ExecutionMode: pythonenv.ExecutionModeOutOfProcess,
+	}, environment)
+}
+
+func TestUsecase_Execute_InvalidInput(t *testing.T) {
+	testConfigs := []struct {
+		name    string
+		request setpythonenvironment.Args
+	}{
+		{
+			name:    "invalid execution mode",
+			request: setpythonenvironment.Args{ExecutionMode: "Remote"},
+		},
+		{
+			name:    "nothing to set",
+			request: setpythonenvironment.Args{Version: " "},
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockCodePolicy := &mocks.MockCodePolicy{}
+			defer mockCodePolicy.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			mockCodePolicy.EXPECT().
+				CheckPython().
+				Return(nil).
+				Once()
+
+			usecase := setpythonenvironment.New(mockCodePolicy)
+
+			// Act
+			_, err := usecase.Execute(t.Context(), mockLogger, mockClient, testConfig.request)
+
+			// Assert
+			var codedErr *entities.CodedError
+			require.ErrorAs(t, err, &codedErr)
+			assert.Equal(t, entities.ErrorCodeInvalidInput, codedErr.ErrorCode())
+		})
+	}
+}
+
+func TestUsecase_Execute_PolicyViolation(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockCodePolicy.EXPECT().
+		CheckPython().
+		Return(assert.AnError).
+		Once()
+
+	usecase := setpythonenvironment.New(mockCodePolicy)
+
+	// Act
+	_, err := usecase.Execute(t.Context(), mockLogger, mockClient, setpythonenvironment.Args{Version: "3.11"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.NotEmpty(t, mockLogger.WarnLogs(), "The rejection should be logged")
+}
@@ -0,0 +1,90 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package setsimulinkparameter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/simulinkmodel"
+)
+
+type Args struct {
+	ModelPath string
+	// BlockPath is the path of the block, relative to the model or starting with the name of the model. Empty to set
+	// a parameter of the model, such as StopTime.
+	BlockPath string
+	Parameter string
+	Value     string
+}
+
+type ReturnArgs struct {
+	Model         string `json:"model"`
+	Target        string `json:"target"`
+	Parameter     string `json:"parameter"`
+	PreviousValue string `json:"previousValue"`
+	Value         string `json:"value"`
+	// Dirty is true when the model has changes that are not saved.
+	Dirty bool `json:"dirty"`
+}
+
+type PathValidator interface {
+	ValidateFilePath(ctx context.Context, filePath string) (string, error)
+}
+
+type CodePolicy interface {
+	CheckCode(code string) error
+}
+
+type Usecase struct {
+	pathValidator PathValidator
+	codePolicy    CodePolicy
+}
+
+func New(
+	pathValidator PathValidator,
+	codePolicy CodePolicy,
+) *Usecase {
+	return &Usecase{
+		pathValidator: pathValidator,
+		codePolicy:    codePolicy,
+	}
+}
+
+// Execute sets a parameter of a Simulink model, or of one of its blocks, and returns its previous and new values. The
+// model must already be loaded, and it is not saved. The value is checked by the code policy, as Simulink evaluates
+// the values of most block parameters as MATLAB expressions.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering SetSimulinkParameter Usecase")
+	defer sessionLogger.Debug("Exiting SetSimulinkParameter Usecase")
+
+	if err := simulinkmodel.CheckModelPath(request.ModelPath); err != nil {
+		return ReturnArgs{}, err
+	}
+
+	if request.Parameter == "" {
+		return ReturnArgs{}, entities.NewCodedError(entities.ErrorCodeInvalidInput, errors.New("no parameter given"))
+	}
+
+	if err := u.codePolicy.CheckCode(request.Value); err != nil {
+		sessionLogger.WithError(err).Warn("Parameter value rejected by the code policy")
+		return ReturnArgs{}, err
+	}
+
+	validatedPath, err := u.pathValidator.ValidateFilePath(ctx, request.ModelPath)
+	if err != nil {
+		sessionLogger.WithError(err).With("path", request.ModelPath).Warn("Path validation failed")
+		return ReturnArgs{}, fmt.Errorf("path validation failed: %w", err)
+	}
+
+	var result ReturnArgs
+	if err := simulinkmodel.Call(ctx, sessionLogger, client, []string{"setParameter", validatedPath, request.BlockPath, request.Parameter, request.Value}, &result); err != nil {
+		return ReturnArgs{}, err
+	}
+
+	sessionLogger.With("target", result.Target).With("parameter", result.Parameter).Info("Set Simulink parameter")
+
+	return result, nil
+}
@@ -0,0 +1,151 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package setsimulinkparameter_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/setsimulinkparameter"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/setsimulinkparameter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	// Act
+	usecase := setsimulinkparameter.New(mockPathValidator, mockCodePolicy)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const modelPath = "/home/user/models/plant.slx"
+
+	mockCodePolicy.EXPECT().
+		CheckCode("2*K").
+		Return(nil).
+		Once()
+
+	mockPathValidator.EXPECT().
+		ValidateFilePath(ctx, modelPath).
+		Return(modelPath, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.simulinkModel",
+			Arguments:  []string{"setParameter", modelPath, "Gain", "Gain", "2*K"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`{"model":"plant","target":"plant/Gain","parameter":"Gain","previousValue":"K","value":"2*K","dirty":true}`}}, nil).
+		Once()
+
+	usecase := setsimulinkparameter.New(mockPathValidator, mockCodePolicy)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, setsimulinkparameter.Args{ModelPath: modelPath, BlockPath: "Gain", Parameter: "Gain", Value: "2*K"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, setsimulinkparameter.ReturnArgs{
+		Model:         "plant",
+		Target:        "plant/Gain",
+		Parameter:     "Gain",
+		PreviousValue: "K",
+		Value:         "2*K",
+		Dirty:         true,
+	}, result)
+}
+
+func TestUsecase_Execute_CodePolicyError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockCodePolicy.EXPECT().
+		CheckCode("system('ls')").
+		Return(assert.AnError).
+		Once()
+
+	usecase := setsimulinkparameter.New(mockPathValidator, mockCodePolicy)
+
+	// Act
+	_, err := usecase.Execute(t.Context(), mockLogger, mockClient, setsimulinkparameter.Args{ModelPath: "/home/user/models/plant.slx", BlockPath: "Gain", Parameter: "Gain", Value: "system('ls')"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	mockClient.AssertNotCalled(t, "FEval", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUsecase_Execute_InvalidInput(t *testing.T) {
+	testConfigs := []struct {
+		name    string
+		request setsimulinkparameter.Args
+	}{
+		{
+			name:    "not a model",
+			request: setsimulinkparameter.Args{ModelPath: "/home/user/models/plant.m", Parameter: "StopTime", Value: "10"},
+		},
+		{
+			name:    "no parameter",
+			request: setsimulinkparameter.Args{ModelPath: "/home/user/models/plant.slx", Value: "10"},
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			mockCodePolicy := &mocks.MockCodePolicy{}
+			defer mockCodePolicy.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			usecase := setsimulinkparameter.New(mockPathValidator, mockCodePolicy)
+
+			// Act
+			_, err := usecase.Execute(t.Context(), mockLogger, mockClient, testConfig.request)
+
+			// Assert
+			assert.Equal(t, entities.ErrorCodeInvalidInput, entities.ErrorCodeOf(err))
+		})
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package simulatesimulinkmodel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/simulinkmodel"
+)
+
+const (
+	// DefaultMaxSamples is the number of samples returned at most for each signal, unless the request sets it.
+	DefaultMaxSamples = 1000
+
+	// MaxSamples is the largest number of samples a request may ask for each signal.
+	MaxSamples = 10000
+
+	// MaxSignals is the number of logged signals returned at most, so that a model logging many signals does not flood
+	// the context of the AI application.
+	MaxSignals = 50
+)
+
+type Args struct {
+	ModelPath string
+	// StopTime is the stop time of the simulation in seconds. Zero keeps the stop time of the model.
+	StopTime float64
+	// MaxSamples is the number of samples returned at most for each signal. Zero uses DefaultMaxSamples.
+	MaxSamples int
+}
+
+// Signal is a signal logged by the simulation, sampled evenly when it has more samples than requested.
+type Signal struct {
+	Name      string `json:"name"`
+	Dataset   string `json:"dataset"`
+	BlockPath string `json:"blockPath"`
+	PortIndex int    `json:"portIndex"`
+	// SampleCount is the number of samples logged, including those not returned.
+	SampleCount int       `json:"sampleCount"`
+	Time        []float64 `json:"time"`
+	// Values holds the elements of the signal at each time. NaN and infinite elements are nil.
+	Values [][]*float64 `json:"values"`
+}
+
+type ReturnArgs struct {
+	Model    string `json:"model"`
+	StopTime string `json:"stopTime"`
+	// Elapsed is the duration of the simulation, in seconds.
+	Elapsed float64 `json:"elapsed"`
+	// Error is the error that stopped the simulation, if any.
+	Error    string   `json:"error"`
+	Warnings []string `json:"warnings"`
+	Signals  []Signal `json:"signals"`
+	// OmittedSignals is the number of logged signals not listed in Signals, such as buses.
+	OmittedSignals int `json:"omittedSignals"`
+}
+
+type PathValidator interface {
+	ValidateFilePath(ctx context.Context, filePath string) (string, error)
+}
+
+type Usecase struct {
+	pathValidator PathValidator
+}
+
+func New(
+	pathValidator PathValidator,
+) *Usecase {
+	return &Usecase{
+		pathValidator: pathValidator,
+	}
+}
+
+// Execute simulates a Simulink model in the MATLAB session, loading it if needed, and returns the signals it logged in
+// datasets, such as logsout and yout. Errors of the simulation are returned in the result, with the signals logged
+// before the error.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering SimulateSimulinkModel Usecase")
+	defer sessionLogger.Debug("Exiting SimulateSimulinkModel Usecase")
+
+	if err := simulinkmodel.CheckModelPath(request.ModelPath); err != nil {
+		return ReturnArgs{}, err
+	}
+
+	if request.StopTime < 0 {
+		return ReturnArgs{}, entities.NewCodedError(entities.ErrorCodeInvalidInput, errors.New("the stop time must be positive"))
+	}
+
+	maxSamples := request.MaxSamples
+	if maxSamples == 0 {
+		maxSamples = DefaultMaxSamples
+	}
+	if maxSamples < 2 || maxSamples > MaxSamples {
+		return ReturnArgs{}, entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("the maximum number of samples must be between 2 and %d", MaxSamples))
+	}
+
+	validatedPath, err := u.pathValidator.ValidateFilePath(ctx, request.ModelPath)
+	if err != nil {
+		sessionLogger.WithError(err).With("path", request.ModelPath).Warn("Path validation failed")
+		return ReturnArgs{}, fmt.Errorf("path validation failed: %w", err)
+	}
+
+	stopTime := ""
+	if request.StopTime > 0 {
+		stopTime = strconv.FormatFloat(request.StopTime, 'g', -1, 64)
+	}
+
+	var result ReturnArgs
+	if err := simulinkmodel.Call(ctx, sessionLogger, client, []string{"simulate", validatedPath, stopTime, strconv.Itoa(maxSamples), strconv.Itoa(MaxSignals)}, &result); err != nil {
+		return ReturnArgs{}, err
+	}
+
+	sessionLogger.
+		With("model", result.Model).
+		With("signals", len(result.Signals)).
+		With("failed", result.Error != "").
+		Info("Simulated Simulink model")
+
+	return result, nil
+}
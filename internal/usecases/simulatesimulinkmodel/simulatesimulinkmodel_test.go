@@ -0,0 +1,147 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package simulatesimulinkmodel_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/simulatesimulinkmodel"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/simulatesimulinkmodel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	// Act
+	usecase := simulatesimulinkmodel.New(mockPathValidator)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	testConfigs := []struct {
+		name              string
+		request           simulatesimulinkmodel.Args
+		expectedArguments []string
+	}{
+		{
+			name:              "defaults",
+			request:           simulatesimulinkmodel.Args{ModelPath: "/home/user/models/plant.slx"},
+			expectedArguments: []string{"simulate", "/home/user/models/plant.slx", "", "1000", "50"},
+		},
+		{
+			name:              "stop time and samples",
+			request:           simulatesimulinkmodel.Args{ModelPath: "/home/user/models/plant.slx", StopTime: 2.5, MaxSamples: 100},
+			expectedArguments: []string{"simulate", "/home/user/models/plant.slx", "2.5", "100", "50"},
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			ctx := t.Context()
+
+			mockPathValidator.EXPECT().
+				ValidateFilePath(ctx, testConfig.request.ModelPath).
+				Return(testConfig.request.ModelPath, nil).
+				Once()
+
+			mockClient.EXPECT().
+				FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+					Function:   "matlab_mcp.simulinkModel",
+					Arguments:  testConfig.expectedArguments,
+					NumOutputs: 1,
+				}).
+				Return(entities.FEvalResponse{Outputs: []any{`{"model":"plant","stopTime":"10","elapsed":0.5,"error":"","warnings":[],"signals":[{"name":"speed","dataset":"logsout","blockPath":"plant/Integrator","portIndex":1,"sampleCount":3,"time":[0,5,10],"values":[[0],[1.5],[null]]}],"omittedSignals":1}`}}, nil).
+				Once()
+
+			usecase := simulatesimulinkmodel.New(mockPathValidator)
+
+			// Act
+			result, err := usecase.Execute(ctx, mockLogger, mockClient, testConfig.request)
+
+			// Assert
+			require.NoError(t, err)
+			zero, speed := 0.0, 1.5
+			assert.Equal(t, simulatesimulinkmodel.ReturnArgs{
+				Model:    "plant",
+				StopTime: "10",
+				Elapsed:  0.5,
+				Warnings: []string{},
+				Signals: []simulatesimulinkmodel.Signal{
+					{
+						Name:        "speed",
+						Dataset:     "logsout",
+						BlockPath:   "plant/Integrator",
+						PortIndex:   1,
+						SampleCount: 3,
+						Time:        []float64{0, 5, 10},
+						Values:      [][]*float64{{&zero}, {&speed}, {nil}},
+					},
+				},
+				OmittedSignals: 1,
+			}, result)
+		})
+	}
+}
+
+func TestUsecase_Execute_InvalidInput(t *testing.T) {
+	testConfigs := []struct {
+		name    string
+		request simulatesimulinkmodel.Args
+	}{
+		{
+			name:    "not a model",
+			request: simulatesimulinkmodel.Args{ModelPath: "/home/user/models/plant.m"},
+		},
+		{
+			name:    "negative stop time",
+			request: simulatesimulinkmodel.Args{ModelPath: "/home/user/models/plant.slx", StopTime: -1},
+		},
+		{
+			name:    "too many samples",
+			request: simulatesimulinkmodel.Args{ModelPath: "/home/user/models/plant.slx", MaxSamples: simulatesimulinkmodel.MaxSamples + 1},
+		},
+		{
+			name:    "single sample",
+			request: simulatesimulinkmodel.Args{ModelPath: "/home/user/models/plant.slx", MaxSamples: 1},
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			usecase := simulatesimulinkmodel.New(mockPathValidator)
+
+			// Act
+			_, err := usecase.Execute(t.Context(), mockLogger, mockClient, testConfig.request)
+
+			// Assert
+			assert.Equal(t, entities.ErrorCodeInvalidInput, entities.ErrorCodeOf(err))
+		})
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package startjob
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/jobmanager"
+)
+
+type Args struct {
+	Code        string
+	ProjectPath string
+	Mode        jobmanager.Mode
+}
+
+type PathValidator interface {
+	ValidateFolderPath(ctx context.Context, filePath string) (string, error)
+}
+
+type CodePolicy interface {
+	CheckCode(code string) error
+}
+
+type ApprovalGate interface {
+	ApproveCode(ctx context.Context, code string) error
+}
+
+type JobManager interface {
+	Start(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, request jobmanager.StartRequest) (jobmanager.Job, error)
+}
+
+type Usecase struct {
+	pathValidator PathValidator
+	codePolicy    CodePolicy
+	approvalGate  ApprovalGate
+	jobManager    JobManager
+}
+
+func New(
+	pathValidator PathValidator,
+	codePolicy CodePolicy,
+	approvalGate ApprovalGate,
+	jobManager JobManager,
+) *Usecase {
+	return &Usecase{
+		pathValidator: pathValidator,
+		codePolicy:    codePolicy,
+		approvalGate:  approvalGate,
+		jobManager:    jobManager,
+	}
+}
+
+// Execute starts MATLAB code as a background job, after the same checks as evaluating it. Jobs run in the MATLAB session by default.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (jobmanager.Job, error) {
+	sessionLogger.Debug("Entering StartJob Usecase")
+	defer sessionLogger.Debug("Exiting StartJob Usecase")
+
+	if err := u.codePolicy.CheckCode(request.Code); err != nil {
+		sessionLogger.WithError(err).Warn("Code rejected by the code policy")
+		return jobmanager.Job{}, err
+	}
+
+	validatedPath, err := u.pathValidator.ValidateFolderPath(ctx, request.ProjectPath)
+	if err != nil {
+		sessionLogger.WithError(err).With("path", request.ProjectPath).Warn("Path validation failed")
+		return jobmanager.Job{}, fmt.Errorf("path validation failed: %w", err)
+	}
+
+	if err := u.approvalGate.ApproveCode(ctx, request.Code); err != nil {
+		sessionLogger.WithError(err).Warn("Code not approved by the user")
+		return jobmanager.Job{}, err
+	}
+
+	mode := request.Mode
+	if mode == "" {
+		mode = jobmanager.ModeSession
+	}
+
+	return u.jobManager.Start(ctx, sessionLogger, client, jobmanager.StartRequest{
+		Code:   request.Code,
+		Folder: validatedPath,
+		Mode:   mode,
+	})
+}
@@ -0,0 +1,241 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package startjob_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/startjob"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/jobmanager"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/startjob"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockJobManager := &mocks.MockJobManager{}
+	defer mockJobManager.AssertExpectations(t)
+
+	// Act
+	usecase := startjob.New(mockPathValidator, mockCodePolicy, mockApprovalGate, mockJobManager)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	testCases := []struct {
+		name         string
+		mode         jobmanager.Mode
+		expectedMode jobmanager.Mode
+	}{
+		{name: "default mode", mode: "", expectedMode: jobmanager.ModeSession},
+		{name: "batch mode", mode: jobmanager.ModeBatch, expectedMode: jobmanager.ModeBatch},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			mockCodePolicy := &mocks.MockCodePolicy{}
+			defer mockCodePolicy.AssertExpectations(t)
+
+			mockApprovalGate := &mocks.MockApprovalGate{}
+			defer mockApprovalGate.AssertExpectations(t)
+
+			mockJobManager := &mocks.MockJobManager{}
+			defer mockJobManager.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			ctx := t.Context()
+			const code = "runSweep"
+			expectedJob := jobmanager.Job{ID: "job-1", Mode: testCase.expectedMode, State: jobmanager.StateRunning}
+
+			mockCodePolicy.EXPECT().
+				CheckCode(code).
+				Return(nil).
+				Once()
+
+			mockPathValidator.EXPECT().
+				ValidateFolderPath(ctx, "/some/path/").
+				Return("/some/path", nil).
+				Once()
+
+			mockApprovalGate.EXPECT().
+				ApproveCode(ctx, code).
+				Return(nil).
+				Once()
+
+			mockJobManager.EXPECT().
+				Start(ctx, mockLogger.AsMockArg(), mockClient, jobmanager.StartRequest{
+					Code:   code,
+					Folder: "/some/path",
+					Mode:   testCase.expectedMode,
+				}).
+				Return(expectedJob, nil).
+				Once()
+
+			usecase := startjob.New(mockPathValidator, mockCodePolicy, mockApprovalGate, mockJobManager)
+
+			// Act
+			job, err := usecase.Execute(ctx, mockLogger, mockClient, startjob.Args{
+				Code:        code,
+				ProjectPath: "/some/path/",
+				Mode:        testCase.mode,
+			})
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, expectedJob, job)
+		})
+	}
+}
+
+func TestUsecase_Execute_CodePolicyError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockJobManager := &mocks.MockJobManager{}
+	defer mockJobManager.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockCodePolicy.EXPECT().
+		CheckCode("system('rm -rf /')").
+		Return(assert.AnError).
+		Once()
+
+	usecase := startjob.New(mockPathValidator, mockCodePolicy, mockApprovalGate, mockJobManager)
+
+	// Act
+	job, err := usecase.Execute(t.Context(), mockLogger, mockClient, startjob.Args{
+		Code:        "system('rm -rf /')",
+		ProjectPath: "/some/path",
+	})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, job)
+	assert.Contains(t, mockLogger.WarnLogs(), "Code rejected by the code policy")
+}
+
+func TestUsecase_Execute_PathValidationError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockJobManager := &mocks.MockJobManager{}
+	defer mockJobManager.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockCodePolicy.EXPECT().
+		CheckCode("runSweep").
+		Return(nil).
+		Once()
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(ctx, "/outside").
+		Return("", assert.AnError).
+		Once()
+
+	usecase := startjob.New(mockPathValidator, mockCodePolicy, mockApprovalGate, mockJobManager)
+
+	// Act
+	job, err := usecase.Execute(ctx, mockLogger, mockClient, startjob.Args{
+		Code:        "runSweep",
+		ProjectPath: "/outside",
+	})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, job)
+}
+
+func TestUsecase_Execute_ApprovalGateError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockApprovalGate := &mocks.MockApprovalGate{}
+	defer mockApprovalGate.AssertExpectations(t)
+
+	mockJobManager := &mocks.MockJobManager{}
+	defer mockJobManager.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockCodePolicy.EXPECT().
+		CheckCode("runSweep").
+		Return(nil).
+		Once()
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(ctx, "/some/path").
+		Return("/some/path", nil).
+		Once()
+
+	mockApprovalGate.EXPECT().
+		ApproveCode(ctx, "runSweep").
+		Return(assert.AnError).
+		Once()
+
+	usecase := startjob.New(mockPathValidator, mockCodePolicy, mockApprovalGate, mockJobManager)
+
+	// Act
+	job, err := usecase.Execute(ctx, mockLogger, mockClient, startjob.Args{
+		Code:        "runSweep",
+		ProjectPath: "/some/path",
+	})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, job)
+}
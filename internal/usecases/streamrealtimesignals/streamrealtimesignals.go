@@ -0,0 +1,135 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package streamrealtimesignals
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/realtimetarget"
+)
+
+const (
+	// MaxSignals is the maximum number of signals sampled by a call.
+	MaxSignals = 20
+	// MaxDurationSeconds is the longest a call samples signals for, as it holds the MATLAB session meanwhile.
+	MaxDurationSeconds = 60.0
+	// MinIntervalSeconds is the shortest interval between samples, as every sample is a request to the target.
+	MinIntervalSeconds = 0.01
+
+	defaultDurationSeconds = 1.0
+	defaultIntervalSeconds = 0.1
+)
+
+type Signal struct {
+	BlockPath string `json:"blockPath"`
+	PortIndex int    `json:"portIndex"`
+}
+
+type Args struct {
+	Target          string
+	Signals         []Signal
+	DurationSeconds float64
+	IntervalSeconds float64
+}
+
+type SampledSignal struct {
+	BlockPath string    `json:"blockPath"`
+	PortIndex int       `json:"portIndex"`
+	Values    []float64 `json:"values"`
+}
+
+type ReturnArgs struct {
+	Status  realtimetarget.Status `json:"status"`
+	Time    []float64             `json:"time"`
+	Signals []SampledSignal       `json:"signals"`
+}
+
+type Config interface {
+	RealTimeTargets() []string
+}
+
+type Usecase struct {
+	config Config
+}
+
+func New(
+	config Config,
+) *Usecase {
+	return &Usecase{
+		config: config,
+	}
+}
+
+// Execute samples signals of the real-time application running on a Simulink Real-Time target, at a fixed interval
+// for a duration, and returns their values with the time of each sample since the first one.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering StreamRealTimeSignals Usecase")
+	defer sessionLogger.Debug("Exiting StreamRealTimeSignals Usecase")
+
+	if err := realtimetarget.ValidateTarget(u.config.RealTimeTargets(), request.Target); err != nil {
+		sessionLogger.WithError(err).With("target", request.Target).Warn("Real-time target rejected")
+		return ReturnArgs{}, err
+	}
+
+	if err := validateSignals(request.Signals); err != nil {
+		return ReturnArgs{}, err
+	}
+
+	duration := request.DurationSeconds
+	if duration == 0 {
+		duration = defaultDurationSeconds
+	}
+	interval := request.IntervalSeconds
+	if interval == 0 {
+		interval = defaultIntervalSeconds
+	}
+
+	if duration < 0 || duration > MaxDurationSeconds {
+		return ReturnArgs{}, entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("the duration must be between 0 and %g seconds", MaxDurationSeconds))
+	}
+	if interval < MinIntervalSeconds {
+		return ReturnArgs{}, entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("the interval must be at least %g seconds", MinIntervalSeconds))
+	}
+
+	arguments := []string{"signals", request.Target, formatSeconds(duration), formatSeconds(interval)}
+	for _, signal := range request.Signals {
+		arguments = append(arguments, signal.BlockPath, strconv.Itoa(signal.PortIndex))
+	}
+
+	var result ReturnArgs
+	if err := realtimetarget.Call(ctx, sessionLogger, client, arguments, &result); err != nil {
+		return ReturnArgs{}, err
+	}
+
+	return result, nil
+}
+
+func validateSignals(signals []Signal) error {
+	if len(signals) == 0 {
+		return entities.NewCodedError(entities.ErrorCodeInvalidInput, errors.New("no signal to stream"))
+	}
+
+	if len(signals) > MaxSignals {
+		return entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("at most %d signals can be streamed at once", MaxSignals))
+	}
+
+	for _, signal := range signals {
+		if strings.TrimSpace(signal.BlockPath) == "" {
+			return entities.NewCodedError(entities.ErrorCodeInvalidInput, errors.New("the block path of a signal is empty"))
+		}
+		if signal.PortIndex < 1 {
+			return entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("invalid port index %d of %s: ports are numbered from 1", signal.PortIndex, signal.BlockPath))
+		}
+	}
+
+	return nil
+}
+
+func formatSeconds(seconds float64) string {
+	return strconv.FormatFloat(seconds, 'g', -1, 64)
+}
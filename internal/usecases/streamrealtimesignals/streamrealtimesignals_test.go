@@ -0,0 +1,132 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package streamrealtimesignals_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/streamrealtimesignals"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/streamrealtimesignals"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	// Act
+	usecase := streamrealtimesignals.New(mockConfig)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		RealTimeTargets().
+		Return([]string{"rig1"}).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.realTimeTarget",
+			Arguments:  []string{"signals", "rig1", "1", "0.1", "controller/Gain", "1"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`{"status":{"target":"rig1","connected":true,"loaded":true,"running":true,"application":"controller"},"time":[0,0.1],"signals":[{"blockPath":"controller/Gain","portIndex":1,"values":[0.5,0.75]}]}`}}, nil).
+		Once()
+
+	usecase := streamrealtimesignals.New(mockConfig)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, streamrealtimesignals.Args{
+		Target:  "rig1",
+		Signals: []streamrealtimesignals.Signal{{BlockPath: "controller/Gain", PortIndex: 1}},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, result.Status.Running)
+	assert.Equal(t, []float64{0, 0.1}, result.Time)
+	assert.Equal(t, []streamrealtimesignals.SampledSignal{
+		{BlockPath: "controller/Gain", PortIndex: 1, Values: []float64{0.5, 0.75}},
+	}, result.Signals)
+}
+
+func TestUsecase_Execute_InvalidInput(t *testing.T) {
+	gain := []streamrealtimesignals.Signal{{BlockPath: "controller/Gain", PortIndex: 1}}
+
+	testConfigs := []struct {
+		name    string
+		request streamrealtimesignals.Args
+	}{
+		{
+			name:    "no signal",
+			request: streamrealtimesignals.Args{Target: "rig1"},
+		},
+		{
+			name:    "too many signals",
+			request: streamrealtimesignals.Args{Target: "rig1", Signals: make([]streamrealtimesignals.Signal, streamrealtimesignals.MaxSignals+1)},
+		},
+		{
+			name:    "empty block path",
+			request: streamrealtimesignals.Args{Target: "rig1", Signals: []streamrealtimesignals.Signal{{BlockPath: "  ", PortIndex: 1}}},
+		},
+		{
+			name:    "port index from 0",
+			request: streamrealtimesignals.Args{Target: "rig1", Signals: []streamrealtimesignals.Signal{{BlockPath: "controller/Gain", PortIndex: 0}}},
+		},
+		{
+			name:    "duration too long",
+			request: streamrealtimesignals.Args{Target: "rig1", Signals: gain, DurationSeconds: streamrealtimesignals.MaxDurationSeconds + 1},
+		},
+		{
+			name:    "interval too short",
+			request: streamrealtimesignals.Args{Target: "rig1", Signals: gain, IntervalSeconds: streamrealtimesignals.MinIntervalSeconds / 2},
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			mockConfig.EXPECT().
+				RealTimeTargets().
+				Return([]string{"rig1"}).
+				Once()
+
+			usecase := streamrealtimesignals.New(mockConfig)
+
+			// Act
+			_, err := usecase.Execute(t.Context(), mockLogger, mockClient, testConfig.request)
+
+			// Assert
+			var codedErr *entities.CodedError
+			require.ErrorAs(t, err, &codedErr)
+			assert.Equal(t, entities.ErrorCodeInvalidInput, codedErr.ErrorCode())
+		})
+	}
+}
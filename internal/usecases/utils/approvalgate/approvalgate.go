@@ -0,0 +1,120 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package approvalgate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/elicitation"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/i18n"
+)
+
+type Config interface {
+	RequireApproval() bool
+}
+
+type OSLayer interface {
+	ReadFile(name string) ([]byte, error)
+}
+
+// ApprovalGate shows the exact MATLAB code about to run to the user, and only lets it run once the user approved it,
+// when approvals are required.
+type ApprovalGate struct {
+	config  Config
+	osLayer OSLayer
+}
+
+func New(
+	config Config,
+	osLayer OSLayer,
+) *ApprovalGate {
+	return &ApprovalGate{
+		config:  config,
+		osLayer: osLayer,
+	}
+}
+
+// ApproveCode returns an error if approvals are required, and the user did not approve running code.
+func (g *ApprovalGate) ApproveCode(ctx context.Context, code string) error {
+	if !g.config.RequireApproval() {
+		return nil
+	}
+
+	return approve(ctx, i18n.Translate(i18n.FromContext(ctx), i18n.MessageApproveCode), codePreview("matlab", code), i18n.MessageCodeNotApproved)
+}
+
+// ApprovePythonCode returns an error if approvals are required, and the user did not approve running Python code in
+// the MATLAB session.
+func (g *ApprovalGate) ApprovePythonCode(ctx context.Context, code string) error {
+	if !g.config.RequireApproval() {
+		return nil
+	}
+
+	return approve(ctx, i18n.Translate(i18n.FromContext(ctx), i18n.MessageApprovePythonCode), codePreview("python", code), i18n.MessageCodeNotApproved)
+}
+
+// ApproveFile returns an error if approvals are required, and the user did not approve running the MATLAB file.
+// The content of the file is shown to the user, as it is when approving.
+func (g *ApprovalGate) ApproveFile(ctx context.Context, filePath string) error {
+	if !g.config.RequireApproval() {
+		return nil
+	}
+
+	content, err := g.osLayer.ReadFile(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to read %s for the approval: %w", filePath, err)
+	}
+
+	return approve(ctx, i18n.Translate(i18n.FromContext(ctx), i18n.MessageApproveFile, filePath), codePreview("matlab", string(content)), i18n.MessageCodeNotApproved)
+}
+
+// ApproveTargetAction returns an error if approvals are required, and the user did not approve the action on the
+// Simulink Real-Time target, as it drives hardware. The description lists the action and its settings.
+func (g *ApprovalGate) ApproveTargetAction(ctx context.Context, target string, description string) error {
+	if !g.config.RequireApproval() {
+		return nil
+	}
+
+	return approve(ctx, i18n.Translate(i18n.FromContext(ctx), i18n.MessageApproveTargetAction, target), description, i18n.MessageTargetActionRejected)
+}
+
+// ApproveDeployment returns an error if approvals are required, and the user did not approve deploying to the MATLAB
+// Production Server instance, as it changes what the instance serves. The description lists the deployment.
+func (g *ApprovalGate) ApproveDeployment(ctx context.Context, server string, description string) error {
+	if !g.config.RequireApproval() {
+		return nil
+	}
+
+	return approve(ctx, i18n.Translate(i18n.FromContext(ctx), i18n.MessageApproveDeployment, server), description, i18n.MessageDeploymentNotApproved)
+}
+
+func approve(ctx context.Context, question string, preview string, notApproved i18n.Message) error {
+	approved, err := elicitation.Confirm(ctx, question+"\n\n"+preview)
+	if err != nil {
+		if errors.Is(err, elicitation.ErrNotSupported) {
+			return entities.NewCodedError(entities.ErrorCodePolicyViolation, fmt.Errorf("approval is required for this call, but %w", err))
+		}
+		return entities.NewCodedError(entities.ErrorCodePolicyViolation, fmt.Errorf("failed to request the approval of this call: %w", err))
+	}
+
+	if !approved {
+		return entities.NewCodedError(entities.ErrorCodePolicyViolation, errors.New(i18n.Translate(i18n.FromContext(ctx), notApproved)))
+	}
+
+	return nil
+}
+
+// codePreview formats code as a Markdown code block of language, so clients rendering Markdown highlight its syntax.
+// The fence is longer than any run of backticks in the code, so the code cannot end the block early.
+func codePreview(language string, code string) string {
+	fence := "```"
+	for strings.Contains(code, fence) {
+		fence += "`"
+	}
+
+	return fence + language + "\n" + strings.TrimRight(code, "\n") + "\n" + fence
+}
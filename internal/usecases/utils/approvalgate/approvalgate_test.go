@@ -0,0 +1,314 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package approvalgate_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/approvalgate"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/elicitation"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/utils/approvalgate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	// Act
+	gate := approvalgate.New(mockConfig, mockOSLayer)
+
+	// Assert
+	assert.NotNil(t, gate)
+}
+
+func TestApprovalGate_ApproveCode_ApprovalNotRequired(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		RequireApproval().
+		Return(false).
+		Once()
+
+	gate := approvalgate.New(mockConfig, mockOSLayer)
+
+	// Act
+	err := gate.ApproveCode(t.Context(), "x = 1")
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestApprovalGate_ApproveCode_Approved(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		RequireApproval().
+		Return(true).
+		Once()
+
+	var shownMessage string
+	ctx := elicitation.NewContext(t.Context(), func(ctx context.Context, message string) (bool, error) {
+		shownMessage = message
+		return true, nil
+	})
+
+	gate := approvalgate.New(mockConfig, mockOSLayer)
+
+	// Act
+	err := gate.ApproveCode(ctx, "x = 1;\ndisp(x)\n")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "Approve running this MATLAB code?\n\n```matlab\nx = 1;\ndisp(x)\n```", shownMessage)
+}
+
+func TestApprovalGate_ApproveCode_CodeWithBackticks(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		RequireApproval().
+		Return(true).
+		Once()
+
+	var shownMessage string
+	ctx := elicitation.NewContext(t.Context(), func(ctx context.Context, message string) (bool, error) {
+		shownMessage = message
+		return true, nil
+	})
+
+	gate := approvalgate.New(mockConfig, mockOSLayer)
+
+	// Act
+	err := gate.ApproveCode(ctx, "s = \"```\"")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "Approve running this MATLAB code?\n\n````matlab\ns = \"```\"\n````", shownMessage, "The fence should be longer than the backticks in the code")
+}
+
+func TestApprovalGate_ApprovePythonCode_Approved(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		RequireApproval().
+		Return(true).
+		Once()
+
+	var shownMessage string
+	ctx := elicitation.NewContext(t.Context(), func(ctx context.Context, message string) (bool, error) {
+		shownMessage = message
+		return true, nil
+	})
+
+	gate := approvalgate.New(mockConfig, mockOSLayer)
+
+	// Act
+	err := gate.ApprovePythonCode(ctx, "import numpy\nprint(numpy.__version__)\n")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "Approve running this Python code in the MATLAB session?\n\n```python\nimport numpy\nprint(numpy.__version__)\n```", shownMessage)
+}
+
+func TestApprovalGate_ApproveCode_NotApproved(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		RequireApproval().
+		Return(true).
+		Once()
+
+	ctx := elicitation.NewContext(t.Context(), func(ctx context.Context, message string) (bool, error) {
+		return false, nil
+	})
+
+	gate := approvalgate.New(mockConfig, mockOSLayer)
+
+	// Act
+	err := gate.ApproveCode(ctx, "x = 1")
+
+	// Assert
+	require.ErrorContains(t, err, "the user did not approve running the MATLAB code")
+	assert.Equal(t, entities.ErrorCodePolicyViolation, entities.ErrorCodeOf(err))
+}
+
+func TestApprovalGate_ApproveTargetAction_NotApproved(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		RequireApproval().
+		Return(true).
+		Once()
+
+	var shownMessage string
+	ctx := elicitation.NewContext(t.Context(), func(ctx context.Context, message string) (bool, error) {
+		shownMessage = message
+		return false, nil
+	})
+
+	gate := approvalgate.New(mockConfig, mockOSLayer)
+
+	// Act
+	err := gate.ApproveTargetAction(ctx, "rig1", "Start the application loaded on the target.")
+
+	// Assert
+	assert.Equal(t, "Approve this action on the real-time target rig1?\n\nStart the application loaded on the target.", shownMessage)
+	require.ErrorContains(t, err, "the user did not approve the action on the real-time target")
+	assert.Equal(t, entities.ErrorCodePolicyViolation, entities.ErrorCodeOf(err))
+}
+
+func TestApprovalGate_ApproveDeployment_NotApproved(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		RequireApproval().
+		Return(true).
+		Once()
+
+	var shownMessage string
+	ctx := elicitation.NewContext(t.Context(), func(ctx context.Context, message string) (bool, error) {
+		shownMessage = message
+		return false, nil
+	})
+
+	gate := approvalgate.New(mockConfig, mockOSLayer)
+
+	// Act
+	err := gate.ApproveDeployment(ctx, "https://mps.example.com:9910", "Deploy the archive pricing.ctf.")
+
+	// Assert
+	assert.Equal(t, "Approve this deployment to the MATLAB Production Server instance https://mps.example.com:9910?\n\nDeploy the archive pricing.ctf.", shownMessage)
+	require.ErrorContains(t, err, "the user did not approve the deployment to the MATLAB Production Server instance")
+	assert.Equal(t, entities.ErrorCodePolicyViolation, entities.ErrorCodeOf(err))
+}
+
+func TestApprovalGate_ApproveCode_ElicitationNotSupported(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		RequireApproval().
+		Return(true).
+		Once()
+
+	gate := approvalgate.New(mockConfig, mockOSLayer)
+
+	// Act
+	err := gate.ApproveCode(t.Context(), "x = 1")
+
+	// Assert
+	require.ErrorIs(t, err, elicitation.ErrNotSupported)
+	assert.Equal(t, entities.ErrorCodePolicyViolation, entities.ErrorCodeOf(err))
+}
+
+func TestApprovalGate_ApproveFile_ShowsFileContent(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	filePath := "/home/user/script.m"
+
+	mockConfig.EXPECT().
+		RequireApproval().
+		Return(true).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(filePath).
+		Return([]byte("plot(1:10)\n"), nil).
+		Once()
+
+	var shownMessage string
+	ctx := elicitation.NewContext(t.Context(), func(ctx context.Context, message string) (bool, error) {
+		shownMessage = message
+		return true, nil
+	})
+
+	gate := approvalgate.New(mockConfig, mockOSLayer)
+
+	// Act
+	err := gate.ApproveFile(ctx, filePath)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "Approve running the MATLAB file /home/user/script.m?\n\n```matlab\nplot(1:10)\n```", shownMessage)
+}
+
+func TestApprovalGate_ApproveFile_ReadFileError(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	filePath := "/home/user/script.m"
+
+	mockConfig.EXPECT().
+		RequireApproval().
+		Return(true).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(filePath).
+		Return(nil, assert.AnError).
+		Once()
+
+	gate := approvalgate.New(mockConfig, mockOSLayer)
+
+	// Act
+	err := gate.ApproveFile(t.Context(), filePath)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
@@ -0,0 +1,276 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package artifactstore
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+)
+
+// URIPrefix is the prefix of the URIs of the artifacts, followed by their name.
+const URIPrefix = "matlab://artifacts/"
+
+const artifactDirPattern = "artifacts-"
+
+const artifactFilePermissions = 0o600
+
+const bytesPerMB = 1024 * 1024
+
+// Artifact is a file exchanged between the server and MATLAB through the shared artifact directory.
+type Artifact struct {
+	Name     string
+	URI      string
+	Path     string
+	MIMEType string
+	Bytes    int64
+	SHA256   string
+	Created  time.Time
+}
+
+type Config interface {
+	MaxArtifacts() int
+	MaxArtifactsMB() int
+}
+
+type Directory interface {
+	MkdirTemp(pattern string) (string, error)
+}
+
+type OSLayer interface {
+	Open(path string) (osfacade.File, error)
+	ReadFile(name string) ([]byte, error)
+	WriteFile(name string, data []byte, perm os.FileMode) error
+	RemoveAll(path string) error
+}
+
+// Store manages the directory shared between the server and MATLAB. Large files, such as MAT-files, are written
+// there by MATLAB and handed to clients by URI, with their SHA-256 hash, instead of being inlined in JSON messages.
+// Files with the same content are stored once, and the oldest artifacts are deleted beyond the retention limits.
+type Store struct {
+	directory Directory
+	osLayer   OSLayer
+
+	maxArtifacts int
+	maxBytes     int64
+
+	lock       *sync.Mutex
+	dir        string
+	artifacts  map[string]Artifact
+	order      []string
+	totalBytes int64
+}
+
+func New(
+	config Config,
+	directory Directory,
+	osLayer OSLayer,
+) *Store {
+	return &Store{
+		directory: directory,
+		osLayer:   osLayer,
+
+		maxArtifacts: config.MaxArtifacts(),
+		maxBytes:     int64(config.MaxArtifactsMB()) * bytesPerMB,
+
+		lock:      new(sync.Mutex),
+		artifacts: make(map[string]Artifact),
+	}
+}
+
+// Dir returns the shared artifact directory, creating it on first use.
+func (s *Store) Dir() (string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.dir != "" {
+		return s.dir, nil
+	}
+
+	dir, err := s.directory.MkdirTemp(artifactDirPattern)
+	if err != nil {
+		return "", fmt.Errorf("failed to create artifact directory: %w", err)
+	}
+
+	s.dir = dir
+	return dir, nil
+}
+
+// Register records a file written to the shared artifact directory as an artifact, and hashes its content.
+// Files outside of the directory cannot be registered. A file with the same content and MIME type as another artifact
+// is deleted, and that artifact is returned instead.
+func (s *Store) Register(logger entities.Logger, filePath string, mimeType string) (Artifact, error) {
+	dir, err := s.Dir()
+	if err != nil {
+		return Artifact{}, err
+	}
+
+	if filepath.Dir(filepath.Clean(filePath)) != filepath.Clean(dir) {
+		return Artifact{}, fmt.Errorf("%q is not in the artifact directory", filePath)
+	}
+
+	hash, size, err := s.hashFile(filePath)
+	if err != nil {
+		return Artifact{}, err
+	}
+
+	name := filepath.Base(filePath)
+
+	s.lock.Lock()
+	if duplicate, ok := s.duplicateOf(name, hash, mimeType); ok {
+		s.touch(duplicate.Name)
+		s.lock.Unlock()
+
+		if err := s.osLayer.RemoveAll(filePath); err != nil {
+			logger.WithError(err).With("file", filePath).Warn("Failed to delete duplicate artifact")
+		}
+
+		logger.With("artifact", duplicate.URI).Debug("Deduplicated artifact")
+		return duplicate, nil
+	}
+
+	artifact := Artifact{
+		Name:     name,
+		URI:      URIPrefix + name,
+		Path:     filePath,
+		MIMEType: mimeType,
+		Bytes:    size,
+		SHA256:   hash,
+		Created:  time.Now(),
+	}
+
+	if previous, ok := s.artifacts[name]; ok {
+		s.totalBytes -= previous.Bytes
+	}
+	s.artifacts[name] = artifact
+	s.totalBytes += size
+	s.touch(name)
+	dropped := s.prune()
+	s.lock.Unlock()
+
+	for _, old := range dropped {
+		if err := s.osLayer.RemoveAll(old.Path); err != nil {
+			logger.WithError(err).With("file", old.Path).Warn("Failed to delete artifact")
+		}
+	}
+
+	logger.With("artifact", artifact.URI).With("artifact-bytes", size).Debug("Registered artifact")
+
+	return artifact, nil
+}
+
+// Write writes content to a file of the shared artifact directory, and registers it as an artifact.
+func (s *Store) Write(logger entities.Logger, name string, content []byte, mimeType string) (Artifact, error) {
+	if name == "" || filepath.Base(name) != name {
+		return Artifact{}, fmt.Errorf("%q is not a valid artifact name", name)
+	}
+
+	dir, err := s.Dir()
+	if err != nil {
+		return Artifact{}, err
+	}
+
+	filePath := filepath.Join(dir, name)
+	if err := s.osLayer.WriteFile(filePath, content, artifactFilePermissions); err != nil {
+		return Artifact{}, fmt.Errorf("failed to write artifact: %w", err)
+	}
+
+	return s.Register(logger, filePath, mimeType)
+}
+
+// Read returns an artifact and its content. It fails if the content no longer matches the hash taken when the
+// artifact was registered.
+func (s *Store) Read(logger entities.Logger, name string) (Artifact, []byte, error) {
+	s.lock.Lock()
+	artifact, ok := s.artifacts[name]
+	s.lock.Unlock()
+
+	if !ok {
+		return Artifact{}, nil, entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("there is no artifact named %q", name))
+	}
+
+	content, err := s.osLayer.ReadFile(artifact.Path)
+	if err != nil {
+		return Artifact{}, nil, fmt.Errorf("failed to read artifact: %w", err)
+	}
+
+	sum := sha256.Sum256(content)
+	if hex.EncodeToString(sum[:]) != artifact.SHA256 {
+		logger.With("artifact", artifact.URI).Warn("Artifact does not match its hash")
+		return Artifact{}, nil, fmt.Errorf("artifact %q was modified after it was registered", name)
+	}
+
+	return artifact, content, nil
+}
+
+func (s *Store) hashFile(filePath string) (string, int64, error) {
+	file, err := s.osLayer.Open(filePath)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to open artifact: %w", err)
+	}
+	defer file.Close() //nolint:errcheck // Read-only file
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, file)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to hash artifact: %w", err)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), size, nil
+}
+
+// duplicateOf returns the artifact with the given content and MIME type, unless name is already an artifact, which is
+// then replaced. The lock must be held.
+func (s *Store) duplicateOf(name string, hash string, mimeType string) (Artifact, bool) {
+	if _, ok := s.artifacts[name]; ok {
+		return Artifact{}, false
+	}
+
+	for _, artifact := range s.artifacts {
+		if artifact.SHA256 == hash && artifact.MIMEType == mimeType {
+			return artifact, true
+		}
+	}
+	return Artifact{}, false
+}
+
+// touch makes an artifact the most recent, so that it is deleted last.
+// The lock must be held.
+func (s *Store) touch(name string) {
+	for i, other := range s.order {
+		if other == name {
+			s.order = append(s.order[:i], s.order[i+1:]...)
+			break
+		}
+	}
+	s.order = append(s.order, name)
+}
+
+// prune drops the oldest artifacts beyond the maximum number and size of the artifacts, except the most recent one,
+// and returns them so that their files are deleted. The lock must be held.
+func (s *Store) prune() []Artifact {
+	var dropped []Artifact
+	for len(s.order) > 1 && s.overLimits() {
+		oldest := s.artifacts[s.order[0]]
+		dropped = append(dropped, oldest)
+		delete(s.artifacts, oldest.Name)
+		s.totalBytes -= oldest.Bytes
+		s.order = s.order[1:]
+	}
+
+	return dropped
+}
+
+// overLimits reports whether the artifacts exceed the retention limits. The lock must be held.
+func (s *Store) overLimits() bool {
+	return (s.maxArtifacts > 0 && len(s.order) > s.maxArtifacts) ||
+		(s.maxBytes > 0 && s.totalBytes > s.maxBytes)
+}
@@ -0,0 +1,582 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package artifactstore_test
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/artifactstore"
+	osfacademocks "github.com/matlab/matlab-mcp-core-server/mocks/facades/osfacade"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/utils/artifactstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	artifactDir = "/tmp/matlab-mcp-core-server-123/artifacts-456"
+	content     = "MATLAB 5.0 MAT-file"
+	// contentSHA256 is the SHA-256 hash of content.
+	contentSHA256 = "80fbccf21e8b41709a1790cc6921aedcbbb677bb315070e48a313e941de207b0"
+)
+
+// fileWith returns a file whose content is data.
+func fileWith(t *testing.T, data string) *osfacademocks.MockFile {
+	mockFile := &osfacademocks.MockFile{}
+	t.Cleanup(func() { mockFile.AssertExpectations(t) })
+
+	remaining := []byte(data)
+	mockFile.EXPECT().
+		Read(mock.Anything).
+		RunAndReturn(func(b []byte) (int, error) {
+			if len(remaining) == 0 {
+				return 0, io.EOF
+			}
+			n := copy(b, remaining)
+			remaining = remaining[n:]
+			return n, nil
+		})
+
+	mockFile.EXPECT().
+		Close().
+		Return(nil).
+		Once()
+
+	return mockFile
+}
+
+// newConfig returns a configuration keeping at most maxArtifacts artifacts, of at most maxArtifactsMB megabytes.
+func newConfig(t *testing.T, maxArtifacts int, maxArtifactsMB int) *mocks.MockConfig {
+	mockConfig := &mocks.MockConfig{}
+	t.Cleanup(func() { mockConfig.AssertExpectations(t) })
+
+	mockConfig.EXPECT().
+		MaxArtifacts().
+		Return(maxArtifacts).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxArtifactsMB().
+		Return(maxArtifactsMB).
+		Once()
+
+	return mockConfig
+}
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockDirectory := &mocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	// Act
+	store := artifactstore.New(newConfig(t, 100, 1024), mockDirectory, mockOSLayer)
+
+	// Assert
+	assert.NotNil(t, store)
+}
+
+func TestStore_Dir_CreatedOnce(t *testing.T) {
+	// Arrange
+	mockDirectory := &mocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockDirectory.EXPECT().
+		MkdirTemp("artifacts-").
+		Return(artifactDir, nil).
+		Once()
+
+	store := artifactstore.New(newConfig(t, 100, 1024), mockDirectory, mockOSLayer)
+
+	// Act
+	first, firstErr := store.Dir()
+	second, secondErr := store.Dir()
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, secondErr)
+	assert.Equal(t, artifactDir, first)
+	assert.Equal(t, artifactDir, second)
+}
+
+func TestStore_Dir_MkdirTempError(t *testing.T) {
+	// Arrange
+	mockDirectory := &mocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockDirectory.EXPECT().
+		MkdirTemp("artifacts-").
+		Return("", assert.AnError).
+		Once()
+
+	store := artifactstore.New(newConfig(t, 100, 1024), mockDirectory, mockOSLayer)
+
+	// Act
+	dir, err := store.Dir()
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, dir)
+}
+
+func TestStore_Register_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockDirectory := &mocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	filePath := filepath.Join(artifactDir, "tp1234.mat")
+
+	mockDirectory.EXPECT().
+		MkdirTemp("artifacts-").
+		Return(artifactDir, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Open(filePath).
+		Return(fileWith(t, content), nil).
+		Once()
+
+	store := artifactstore.New(newConfig(t, 100, 1024), mockDirectory, mockOSLayer)
+
+	// Act
+	artifact, err := store.Register(mockLogger, filePath, "application/x-matlab-data")
+
+	// Assert
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), artifact.Created, time.Minute)
+	artifact.Created = time.Time{}
+	assert.Equal(t, artifactstore.Artifact{
+		Name:     "tp1234.mat",
+		URI:      "matlab://artifacts/tp1234.mat",
+		Path:     filePath,
+		MIMEType: "application/x-matlab-data",
+		Bytes:    int64(len(content)),
+		SHA256:   contentSHA256,
+	}, artifact)
+}
+
+func TestStore_Register_OutsideArtifactDirectory(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockDirectory := &mocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockDirectory.EXPECT().
+		MkdirTemp("artifacts-").
+		Return(artifactDir, nil).
+		Once()
+
+	store := artifactstore.New(newConfig(t, 100, 1024), mockDirectory, mockOSLayer)
+
+	// Act
+	artifact, err := store.Register(mockLogger, filepath.Join(artifactDir, "..", "secrets.txt"), "text/plain")
+
+	// Assert
+	require.ErrorContains(t, err, "is not in the artifact directory")
+	assert.Empty(t, artifact)
+}
+
+func TestStore_Register_OpenError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockDirectory := &mocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	filePath := filepath.Join(artifactDir, "tp1234.mat")
+
+	mockDirectory.EXPECT().
+		MkdirTemp("artifacts-").
+		Return(artifactDir, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Open(filePath).
+		Return(nil, assert.AnError).
+		Once()
+
+	store := artifactstore.New(newConfig(t, 100, 1024), mockDirectory, mockOSLayer)
+
+	// Act
+	artifact, err := store.Register(mockLogger, filePath, "application/x-matlab-data")
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, artifact)
+}
+
+func TestStore_Register_DeletesOldestArtifacts(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockDirectory := &mocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	const maxArtifacts = 3
+
+	mockDirectory.EXPECT().
+		MkdirTemp("artifacts-").
+		Return(artifactDir, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Open(mock.Anything).
+		RunAndReturn(func(filePath string) (osfacade.File, error) {
+			return fileWith(t, filePath), nil
+		})
+
+	oldestPath := filepath.Join(artifactDir, "artifact-0.mat")
+	mockOSLayer.EXPECT().
+		RemoveAll(oldestPath).
+		Return(nil).
+		Once()
+
+	store := artifactstore.New(newConfig(t, maxArtifacts, 0), mockDirectory, mockOSLayer)
+
+	// Act
+	for i := range maxArtifacts + 1 {
+		_, err := store.Register(mockLogger, filepath.Join(artifactDir, fmt.Sprintf("artifact-%d.mat", i)), "application/x-matlab-data")
+		require.NoError(t, err)
+	}
+
+	// Assert
+	_, _, err := store.Read(mockLogger, "artifact-0.mat")
+	assert.Equal(t, entities.ErrorCodeInvalidInput, entities.ErrorCodeOf(err), "The oldest artifact should be dropped")
+}
+
+func TestStore_Register_DeletesOldestArtifactsBeyondMaxSize(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockDirectory := &mocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	halfMB := strings.Repeat("x", 512*1024)
+	firstPath := filepath.Join(artifactDir, "first.mat")
+	secondPath := filepath.Join(artifactDir, "second.mat")
+	thirdPath := filepath.Join(artifactDir, "third.mat")
+
+	mockDirectory.EXPECT().
+		MkdirTemp("artifacts-").
+		Return(artifactDir, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Open(firstPath).
+		Return(fileWith(t, halfMB+"1"), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Open(secondPath).
+		Return(fileWith(t, halfMB), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Open(thirdPath).
+		Return(fileWith(t, content), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		RemoveAll(firstPath).
+		Return(nil).
+		Once()
+
+	store := artifactstore.New(newConfig(t, 0, 1), mockDirectory, mockOSLayer)
+
+	// Act
+	for _, filePath := range []string{firstPath, secondPath, thirdPath} {
+		_, err := store.Register(mockLogger, filePath, "application/x-matlab-data")
+		require.NoError(t, err)
+	}
+
+	// Assert
+	_, _, err := store.Read(mockLogger, "first.mat")
+	assert.Equal(t, entities.ErrorCodeInvalidInput, entities.ErrorCodeOf(err), "The oldest artifact should be dropped once the artifacts exceed 1 MB")
+}
+
+func TestStore_Register_DeduplicatesContent(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockDirectory := &mocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	firstPath := filepath.Join(artifactDir, "tp1234.mat")
+	secondPath := filepath.Join(artifactDir, "tp5678.mat")
+
+	mockDirectory.EXPECT().
+		MkdirTemp("artifacts-").
+		Return(artifactDir, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Open(firstPath).
+		Return(fileWith(t, content), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Open(secondPath).
+		Return(fileWith(t, content), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		RemoveAll(secondPath).
+		Return(nil).
+		Once()
+
+	store := artifactstore.New(newConfig(t, 100, 1024), mockDirectory, mockOSLayer)
+	first, err := store.Register(mockLogger, firstPath, "application/x-matlab-data")
+	require.NoError(t, err)
+
+	// Act
+	second, err := store.Register(mockLogger, secondPath, "application/x-matlab-data")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+	_, _, err = store.Read(mockLogger, "tp5678.mat")
+	assert.Equal(t, entities.ErrorCodeInvalidInput, entities.ErrorCodeOf(err), "The duplicate should not be an artifact")
+}
+
+func TestStore_Write_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockDirectory := &mocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	filePath := filepath.Join(artifactDir, "output-1.txt")
+
+	mockDirectory.EXPECT().
+		MkdirTemp("artifacts-").
+		Return(artifactDir, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		WriteFile(filePath, []byte(content), os.FileMode(0o600)).
+		Return(nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Open(filePath).
+		Return(fileWith(t, content), nil).
+		Once()
+
+	store := artifactstore.New(newConfig(t, 100, 1024), mockDirectory, mockOSLayer)
+
+	// Act
+	artifact, err := store.Write(mockLogger, "output-1.txt", []byte(content), "text/plain")
+
+	// Assert
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), artifact.Created, time.Minute)
+	artifact.Created = time.Time{}
+	assert.Equal(t, artifactstore.Artifact{
+		Name:     "output-1.txt",
+		URI:      "matlab://artifacts/output-1.txt",
+		Path:     filePath,
+		MIMEType: "text/plain",
+		Bytes:    int64(len(content)),
+		SHA256:   contentSHA256,
+	}, artifact)
+}
+
+func TestStore_Write_InvalidName(t *testing.T) {
+	for _, name := range []string{"", "../output-1.txt", "folder/output-1.txt"} {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockDirectory := &mocks.MockDirectory{}
+			defer mockDirectory.AssertExpectations(t)
+
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			store := artifactstore.New(newConfig(t, 100, 1024), mockDirectory, mockOSLayer)
+
+			// Act
+			artifact, err := store.Write(mockLogger, name, []byte(content), "text/plain")
+
+			// Assert
+			require.ErrorContains(t, err, "is not a valid artifact name")
+			assert.Empty(t, artifact)
+		})
+	}
+}
+
+func TestStore_Write_WriteFileError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockDirectory := &mocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	filePath := filepath.Join(artifactDir, "output-1.txt")
+
+	mockDirectory.EXPECT().
+		MkdirTemp("artifacts-").
+		Return(artifactDir, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		WriteFile(filePath, []byte(content), os.FileMode(0o600)).
+		Return(assert.AnError).
+		Once()
+
+	store := artifactstore.New(newConfig(t, 100, 1024), mockDirectory, mockOSLayer)
+
+	// Act
+	artifact, err := store.Write(mockLogger, "output-1.txt", []byte(content), "text/plain")
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, artifact)
+}
+
+func TestStore_Read_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockDirectory := &mocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	filePath := filepath.Join(artifactDir, "tp1234.mat")
+
+	mockDirectory.EXPECT().
+		MkdirTemp("artifacts-").
+		Return(artifactDir, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Open(filePath).
+		Return(fileWith(t, content), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(filePath).
+		Return([]byte(content), nil).
+		Once()
+
+	store := artifactstore.New(newConfig(t, 100, 1024), mockDirectory, mockOSLayer)
+	registered, err := store.Register(mockLogger, filePath, "application/x-matlab-data")
+	require.NoError(t, err)
+
+	// Act
+	artifact, data, err := store.Read(mockLogger, "tp1234.mat")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, registered, artifact)
+	assert.Equal(t, []byte(content), data)
+}
+
+func TestStore_Read_UnknownArtifact(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockDirectory := &mocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	store := artifactstore.New(newConfig(t, 100, 1024), mockDirectory, mockOSLayer)
+
+	// Act
+	artifact, data, err := store.Read(mockLogger, "missing.mat")
+
+	// Assert
+	require.Error(t, err)
+	assert.Equal(t, entities.ErrorCodeInvalidInput, entities.ErrorCodeOf(err))
+	assert.Empty(t, artifact)
+	assert.Nil(t, data)
+}
+
+func TestStore_Read_ModifiedArtifact(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockDirectory := &mocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	filePath := filepath.Join(artifactDir, "tp1234.mat")
+
+	mockDirectory.EXPECT().
+		MkdirTemp("artifacts-").
+		Return(artifactDir, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Open(filePath).
+		Return(fileWith(t, content), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(filePath).
+		Return([]byte("tampered"), nil).
+		Once()
+
+	store := artifactstore.New(newConfig(t, 100, 1024), mockDirectory, mockOSLayer)
+	_, err := store.Register(mockLogger, filePath, "application/x-matlab-data")
+	require.NoError(t, err)
+
+	// Act
+	artifact, data, err := store.Read(mockLogger, "tp1234.mat")
+
+	// Assert
+	require.ErrorContains(t, err, "was modified after it was registered")
+	assert.Empty(t, artifact)
+	assert.Nil(t, data)
+	assert.Contains(t, mockLogger.WarnLogs(), "Artifact does not match its hash")
+}
@@ -0,0 +1,359 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package codepolicy
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+type Config interface {
+	SandboxEnabled() bool
+	BlockNetwork() bool
+	AllowedHosts() []string
+	RestrictFileAccess() bool
+	AllowedFolders() []string
+	AllowedFunctions() []string
+	DeniedFunctions() []string
+}
+
+type OSLayer interface {
+	ReadFile(name string) ([]byte, error)
+}
+
+// blockedFunctions run shell commands or external programs.
+var blockedFunctions = []string{"system", "dos", "unix", "perl"}
+
+// blockedClasses are Java, .NET and Python entry points that spawn processes.
+var blockedClasses = regexp.MustCompile(`\bjava\s*\.\s*lang\s*\.\s*(Runtime|ProcessBuilder)\b|\bSystem\s*\.\s*Diagnostics\s*\.\s*Process\b|\bpy\s*\.\s*(subprocess|os\s*\.\s*(system|popen|spawn\w*|exec\w*))\b`)
+
+// CodePolicy rejects code that could run shell commands or spawn processes when the sandbox is enabled,
+// and code that could access the network when the network is blocked.
+// In the sandbox, it also rejects the calls to the denied functions, and, when file access is restricted, the code
+// accessing files outside the allowed folders. The allowed functions are exempt from the checks of the functions that
+// run shell commands and of the functions that access files.
+// The scan is conservative: the contents of strings are scanned as code, so it also rejects code that only mentions a
+// blocked function in a string, because strings can be evaluated.
+type CodePolicy struct {
+	config  Config
+	osLayer OSLayer
+}
+
+func New(
+	config Config,
+	osLayer OSLayer,
+) *CodePolicy {
+	return &CodePolicy{
+		config:  config,
+		osLayer: osLayer,
+	}
+}
+
+// CheckCode returns an error if the sandbox is enabled, and code runs shell commands or spawns processes,
+// or if the network is blocked, and code accesses hosts that are not allowed.
+func (p *CodePolicy) CheckCode(code string) error {
+	sandbox, network := p.config.SandboxEnabled(), p.config.BlockNetwork()
+	if !sandbox && !network {
+		return nil
+	}
+
+	return p.check(code, sandbox, network)
+}
+
+// CheckFile returns an error if the MATLAB file breaks the same rules as CheckCode.
+func (p *CodePolicy) CheckFile(filePath string) error {
+	sandbox, network := p.config.SandboxEnabled(), p.config.BlockNetwork()
+	if !sandbox && !network {
+		return nil
+	}
+
+	content, err := p.osLayer.ReadFile(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to read %s for the sandbox check: %w", filePath, err)
+	}
+
+	return p.check(string(content), sandbox, network)
+}
+
+// CheckPython returns an error if the sandbox is enabled. Python code, and the Python executable that MATLAB loads, can
+// run shell commands, spawn processes and access the network without calling MATLAB, so their text cannot be checked.
+func (p *CodePolicy) CheckPython() error {
+	if !p.config.SandboxEnabled() {
+		return nil
+	}
+
+	return entities.NewCodedError(entities.ErrorCodePolicyViolation, errors.New("sandbox mode does not allow running Python code or changing the Python environment, as Python can run shell commands and spawn processes"))
+}
+
+func (p *CodePolicy) check(code string, sandbox bool, network bool) error {
+	lines := splitCode(code)
+
+	var messages []string
+	if sandbox {
+		allowedFunctions := p.config.AllowedFunctions()
+		if violations := scan(lines, allowedFunctions); len(violations) > 0 {
+			messages = append(messages, describeViolations("sandbox mode does not allow running shell commands or spawning processes", violations))
+		}
+
+		if violations := scanFunctions(lines, p.config.DeniedFunctions()); len(violations) > 0 {
+			messages = append(messages, describeViolations("sandbox mode does not allow calling the denied functions", violations))
+		}
+
+		if p.config.RestrictFileAccess() {
+			allowedFolders := p.config.AllowedFolders()
+			if violations := checkFileAccess(lines, allowedFolders, allowedFunctions); len(violations) > 0 {
+				rule := "file access is blocked"
+				if len(allowedFolders) > 0 {
+					rule = fmt.Sprintf("file access is only allowed in %s", strings.Join(allowedFolders, ", "))
+				}
+				messages = append(messages, describeViolations(rule, violations))
+			}
+		}
+	}
+
+	if network {
+		allowedHosts := p.config.AllowedHosts()
+		if violations := scanNetwork(lines, allowedHosts); len(violations) > 0 {
+			rule := "network access is blocked"
+			if len(allowedHosts) > 0 {
+				rule = fmt.Sprintf("network access is only allowed to %s", strings.Join(allowedHosts, ", "))
+			}
+			messages = append(messages, describeViolations(rule, violations))
+		}
+	}
+
+	if len(messages) == 0 {
+		return nil
+	}
+
+	return entities.NewCodedError(entities.ErrorCodePolicyViolation, errors.New(strings.Join(messages, "; ")))
+}
+
+type violation struct {
+	line  int
+	usage string
+}
+
+func describeViolations(rule string, violations []violation) string {
+	usages := make([]string, 0, len(violations))
+	for _, v := range violations {
+		usages = append(usages, fmt.Sprintf("%s on line %d", v.usage, v.line))
+	}
+
+	return fmt.Sprintf("%s: %s", rule, strings.Join(usages, ", "))
+}
+
+// scan returns the shell commands and processes spawned by the code, except for the calls to the functions of
+// allowedFunctions. Shell escapes with ! cannot be allowed. builtin is rejected, as it calls the functions that the
+// sandbox shadows, and so are the calls to eval, feval, evalin and str2func whose argument is built at run time, as it
+// cannot be checked before the call runs.
+func scan(lines []codeLine, allowedFunctions []string) []violation {
+	var names []string
+	for _, name := range blockedFunctions {
+		if !slices.Contains(allowedFunctions, name) {
+			names = append(names, name)
+		}
+	}
+	blockedFunctionCall := functionCallPattern(names)
+
+	return scanCode(lines, func(code string, inString bool) []string {
+		var usages []string
+		for _, match := range blockedFunctionCall.FindAllStringSubmatch(code, -1) {
+			usages = append(usages, functionUsage(match[2], inString))
+		}
+		switch {
+		case !inString && strings.Contains(code, "!"):
+			usages = append(usages, "`!`")
+		case inString && shellEscapeInString.MatchString(code):
+			usages = append(usages, "`!` in a string")
+		}
+		for _, match := range blockedClasses.FindAllString(code, -1) {
+			usages = append(usages, functionUsage(compact(match), inString))
+		}
+		return append(usages, dynamicCalls(code, allowedFunctions, inString)...)
+	})
+}
+
+// shellEscapeInString matches a shell escape starting a statement of a string evaluated as code.
+var shellEscapeInString = regexp.MustCompile(`(^|[;,])\s*!`)
+
+// dynamicCalls returns the calls of code to builtin, and to the functions of dynamicCallFunctions whose code or function
+// name is not a string literal or a function handle, except for the calls to the functions of allowedFunctions.
+func dynamicCalls(code string, allowedFunctions []string, inString bool) []string {
+	var usages []string
+	for _, match := range dynamicCallFunctions.FindAllStringSubmatchIndex(code, -1) {
+		function := code[match[4]:match[5]]
+		if slices.Contains(allowedFunctions, function) {
+			continue
+		}
+
+		if function == "builtin" {
+			usages = append(usages, functionUsage(function, inString))
+			continue
+		}
+
+		codeArgument := 0
+		if function == "evalin" {
+			codeArgument = 1
+		}
+		argument, _, ok := callArgument(code, match[5], codeArgument)
+		if ok && (argument == `""` || functionHandle.MatchString(argument)) {
+			continue
+		}
+		usages = append(usages, functionUsage(function, inString)+" with an argument built at run time")
+	}
+	return usages
+}
+
+// functionHandle matches a handle to a named function.
+var functionHandle = regexp.MustCompile(`^@\s*[A-Za-z]\w*(\.\w+)*$`)
+
+// scanFunctions returns the calls of the code to the functions of names, including the calls through a string naming them.
+func scanFunctions(lines []codeLine, names []string) []violation {
+	if len(names) == 0 {
+		return nil
+	}
+	functionCall := functionCallPattern(names)
+
+	return scanCode(lines, func(code string, inString bool) []string {
+		var usages []string
+		for _, match := range functionCall.FindAllStringSubmatch(code, -1) {
+			usages = append(usages, functionUsage(match[2], inString))
+		}
+		return usages
+	})
+}
+
+// scanCode returns the usages that find reports in the code of lines, and in their string literals, which are scanned
+// as code too, as strings can be evaluated.
+func scanCode(lines []codeLine, find func(code string, inString bool) []string) []violation {
+	var violations []violation
+	for _, line := range lines {
+		usages := find(line.code, false)
+		for _, literal := range line.strings {
+			usages = append(usages, scanString(literal, find)...)
+		}
+		for _, usage := range usages {
+			violations = append(violations, violation{line: line.number, usage: usage})
+		}
+	}
+	return violations
+}
+
+// scanString returns the usages that find reports in literal scanned as code, including in the string literals it holds.
+func scanString(literal string, find func(code string, inString bool) []string) []string {
+	var usages []string
+	for _, line := range splitCode(literal) {
+		usages = append(usages, find(line.code, true)...)
+		for _, nested := range line.strings {
+			usages = append(usages, scanString(nested, find)...)
+		}
+	}
+	return usages
+}
+
+// functionUsage describes the use of name, in code or in a string.
+func functionUsage(name string, inString bool) string {
+	if inString {
+		return fmt.Sprintf("%q in a string", name)
+	}
+	return "`" + name + "`"
+}
+
+func compact(s string) string {
+	return strings.Join(strings.Fields(s), "")
+}
+
+type codeLine struct {
+	number  int
+	code    string
+	strings []string
+}
+
+// splitCode splits MATLAB code into lines, with the comments removed and the string literals extracted.
+func splitCode(code string) []codeLine {
+	var lines []codeLine
+	blockCommentDepth := 0
+
+	for index, rawLine := range strings.Split(code, "\n") {
+		rawLine = strings.TrimSuffix(rawLine, "\r")
+
+		switch trimmed := strings.TrimSpace(rawLine); {
+		case trimmed == "%{":
+			blockCommentDepth++
+			continue
+		case trimmed == "%}" && blockCommentDepth > 0:
+			blockCommentDepth--
+			continue
+		case blockCommentDepth > 0:
+			continue
+		}
+
+		line := codeLine{number: index + 1}
+		var stripped strings.Builder
+		var previous rune
+
+		runes := []rune(rawLine)
+	scanLine:
+		for i := 0; i < len(runes); i++ {
+			r := runes[i]
+			switch {
+			case r == '%':
+				break scanLine
+			case r == '.' && strings.HasPrefix(string(runes[i:]), "..."):
+				break scanLine
+			case r == '"' || (r == '\'' && !isTransposeContext(previous)):
+				literal, end := readStringLiteral(runes, i)
+				line.strings = append(line.strings, literal)
+				stripped.WriteString(`""`)
+				i = end
+				previous = '"'
+				continue
+			}
+			stripped.WriteRune(r)
+			previous = r
+		}
+
+		line.code = stripped.String()
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+// isTransposeContext reports whether a quote following previous is the transpose operator, rather than the start of a string.
+func isTransposeContext(previous rune) bool {
+	switch {
+	case previous == ')' || previous == ']' || previous == '}' || previous == '.' || previous == '\'' || previous == '"':
+		return true
+	case previous == '_' || ('a' <= previous && previous <= 'z') || ('A' <= previous && previous <= 'Z') || ('0' <= previous && previous <= '9'):
+		return true
+	default:
+		return false
+	}
+}
+
+// readStringLiteral reads the string literal starting with the quote at start, and returns its content and the index of the closing quote.
+// Doubled quotes are escaped quotes. An unterminated literal ends with the line.
+func readStringLiteral(runes []rune, start int) (string, int) {
+	quote := runes[start]
+	var literal strings.Builder
+	for i := start + 1; i < len(runes); i++ {
+		if runes[i] != quote {
+			literal.WriteRune(runes[i])
+			continue
+		}
+		if i+1 < len(runes) && runes[i+1] == quote {
+			literal.WriteRune(quote)
+			i++
+			continue
+		}
+		return literal.String(), i
+	}
+	return literal.String(), len(runes)
+}
@@ -0,0 +1,822 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package codepolicy_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/codepolicy"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/utils/codepolicy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	// Act
+	policy := codepolicy.New(mockConfig, mockOSLayer)
+
+	// Assert
+	assert.NotNil(t, policy)
+}
+
+func TestCodePolicy_CheckCode_SandboxDisabled(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		SandboxEnabled().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		BlockNetwork().
+		Return(false).
+		Once()
+
+	policy := codepolicy.New(mockConfig, mockOSLayer)
+
+	// Act
+	err := policy.CheckCode("system('ls')")
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestCodePolicy_CheckCode_Rejected(t *testing.T) {
+	testCases := []struct {
+		name          string
+		code          string
+		expectedUsage string
+	}{
+		{name: "system call", code: "[status, out] = system('ls');", expectedUsage: "`system` on line 1"},
+		{name: "dos call", code: "dos('dir')", expectedUsage: "`dos` on line 1"},
+		{name: "unix command syntax", code: "x = 1;\nunix ls", expectedUsage: "`unix` on line 2"},
+		{name: "shell escape", code: "!rm -rf /tmp/data", expectedUsage: "`!` on line 1"},
+		{name: "shell escape after a statement", code: "x = 1; !ls", expectedUsage: "`!` on line 1"},
+		{name: "java runtime", code: "r = java.lang.Runtime.getRuntime();", expectedUsage: "`java.lang.Runtime` on line 1"},
+		{name: "java process builder", code: "pb = java.lang.ProcessBuilder({'ls'});", expectedUsage: "`java.lang.ProcessBuilder` on line 1"},
+		{name: "python subprocess", code: "py.subprocess.run({'ls'})", expectedUsage: "`py.subprocess` on line 1"},
+		{name: "dotnet process", code: "System.Diagnostics.Process.Start('cmd.exe')", expectedUsage: "`System.Diagnostics.Process` on line 1"},
+		{name: "function name evaluated from a string", code: "feval('system', 'ls')", expectedUsage: "\"system\" in a string on line 1"},
+		{name: "command evaluated from a string", code: "eval('system ls')", expectedUsage: "\"system\" in a string on line 1"},
+		{name: "builtin bypass", code: "builtin(\"unix\", \"ls\")", expectedUsage: "\"unix\" in a string on line 1"},
+		{name: "shell escape evaluated from a string", code: "eval('!ls')", expectedUsage: "`!` in a string on line 1"},
+		{name: "java class name in a string", code: "javaMethod('getRuntime', 'java.lang.Runtime')", expectedUsage: "\"java.lang.Runtime\" in a string on line 1"},
+		{name: "blocked name inside a sentence", code: "disp(\"the system is ready\")", expectedUsage: "\"system\" in a string on line 1"},
+		{name: "builtin call evaluated from a string", code: "eval('x=1; builtin(''system'',''id'')')", expectedUsage: "\"builtin\" in a string on line 1"},
+		{name: "blocked name in a string evaluated from a string", code: "eval('x=1; builtin(''system'',''id'')')", expectedUsage: "\"system\" in a string on line 1"},
+		{name: "builtin call", code: "builtin('disp', 1)", expectedUsage: "`builtin` on line 1"},
+		{name: "function name built at run time", code: "f=['sys' 'tem']; feval(f,'id')", expectedUsage: "`feval` with an argument built at run time on line 1"},
+		{name: "code built at run time", code: "eval(['sys' 'tem(''id'')'])", expectedUsage: "`eval` with an argument built at run time on line 1"},
+		{name: "code built at run time evaluated in the base workspace", code: "evalin('base', cmd)", expectedUsage: "`evalin` with an argument built at run time on line 1"},
+		{name: "function handle built at run time", code: "f = str2func(name);", expectedUsage: "`str2func` with an argument built at run time on line 1"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockConfig.EXPECT().
+				SandboxEnabled().
+				Return(true).
+				Once()
+
+			mockConfig.EXPECT().
+				BlockNetwork().
+				Return(false).
+				Once()
+
+			mockConfig.EXPECT().
+				AllowedFunctions().
+				Return(nil).
+				Once()
+
+			mockConfig.EXPECT().
+				DeniedFunctions().
+				Return(nil).
+				Once()
+
+			mockConfig.EXPECT().
+				RestrictFileAccess().
+				Return(false).
+				Once()
+
+			policy := codepolicy.New(mockConfig, mockOSLayer)
+
+			// Act
+			err := policy.CheckCode(testCase.code)
+
+			// Assert
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), testCase.expectedUsage)
+			assert.Equal(t, entities.ErrorCodePolicyViolation, entities.ErrorCodeOf(err))
+		})
+	}
+}
+
+func TestCodePolicy_CheckCode_Allowed(t *testing.T) {
+	testCases := []struct {
+		name string
+		code string
+	}{
+		{name: "plain code", code: "x = magic(4);\ndisp(x)"},
+		{name: "blocked name in a comment", code: "x = 1; % calls system('ls') later"},
+		{name: "blocked name in a block comment", code: "%{\nsystem('ls')\n!ls\n%}\nx = 1;"},
+		{name: "blocked name after a continuation", code: "x = [1, ... system('ls')\n2];"},
+		{name: "shell escape in a message", code: "disp('Hello, World!')"},
+		{name: "field named like a blocked function", code: "s.system = 1; s.unix = 2;"},
+		{name: "identifier containing a blocked name", code: "filesystem = 1; mysystem(2)"},
+		{name: "transpose followed by a string", code: "a = b'; c = 'the server is up';"},
+		{name: "code evaluated from a string literal", code: "eval('x = magic(4);')"},
+		{name: "function evaluated from a handle", code: "y = feval(@sin, pi)"},
+		{name: "function handle from a string literal", code: "f = str2func('cos');"},
+		{name: "escaped quotes", code: "disp('it''s not !important')"},
+		{name: "not equal operator", code: "if x ~= 1, disp(x), end"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockConfig.EXPECT().
+				SandboxEnabled().
+				Return(true).
+				Once()
+
+			mockConfig.EXPECT().
+				BlockNetwork().
+				Return(false).
+				Once()
+
+			mockConfig.EXPECT().
+				AllowedFunctions().
+				Return(nil).
+				Once()
+
+			mockConfig.EXPECT().
+				DeniedFunctions().
+				Return(nil).
+				Once()
+
+			mockConfig.EXPECT().
+				RestrictFileAccess().
+				Return(false).
+				Once()
+
+			policy := codepolicy.New(mockConfig, mockOSLayer)
+
+			// Act
+			err := policy.CheckCode(testCase.code)
+
+			// Assert
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCodePolicy_CheckFile_Rejected(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	filePath := "/home/user/script.m"
+
+	mockConfig.EXPECT().
+		SandboxEnabled().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		BlockNetwork().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		AllowedFunctions().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		DeniedFunctions().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		RestrictFileAccess().
+		Return(false).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(filePath).
+		Return([]byte("x = 1;\n\nsystem('curl example.com');\n"), nil).
+		Once()
+
+	policy := codepolicy.New(mockConfig, mockOSLayer)
+
+	// Act
+	err := policy.CheckFile(filePath)
+
+	// Assert
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "`system` on line 3")
+	assert.Equal(t, entities.ErrorCodePolicyViolation, entities.ErrorCodeOf(err))
+}
+
+func TestCodePolicy_CheckFile_ReadFileError(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	filePath := "/home/user/script.m"
+
+	mockConfig.EXPECT().
+		SandboxEnabled().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		BlockNetwork().
+		Return(false).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(filePath).
+		Return(nil, assert.AnError).
+		Once()
+
+	policy := codepolicy.New(mockConfig, mockOSLayer)
+
+	// Act
+	err := policy.CheckFile(filePath)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+func TestCodePolicy_CheckFile_SandboxDisabled(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		SandboxEnabled().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		BlockNetwork().
+		Return(false).
+		Once()
+
+	policy := codepolicy.New(mockConfig, mockOSLayer)
+
+	// Act
+	err := policy.CheckFile("/home/user/script.m")
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestCodePolicy_CheckCode_NetworkBlocked(t *testing.T) {
+	testCases := []struct {
+		name          string
+		code          string
+		expectedUsage string
+	}{
+		{name: "webread call", code: "data = webread('https://example.com/data.json');", expectedUsage: "`webread` on line 1"},
+		{name: "websave call", code: "x = 1;\nwebsave('data.csv', 'https://example.com/data.csv')", expectedUsage: "`websave` on line 2"},
+		{name: "tcpclient call", code: "t = tcpclient(\"example.com\", 80);", expectedUsage: "`tcpclient` on line 1"},
+		{name: "command syntax", code: "web https://example.com", expectedUsage: "`web` on line 1"},
+		{name: "matlab http interface", code: "r = matlab.net.http.RequestMessage;", expectedUsage: "`matlab.net` on line 1"},
+		{name: "java URL", code: "u = java.net.URL('https://example.com');", expectedUsage: "`java.net` on line 1"},
+		{name: "python requests", code: "r = py.requests.get('https://example.com');", expectedUsage: "`py.requests` on line 1"},
+		{name: "function name evaluated from a string", code: "feval('webwrite', url, data)", expectedUsage: "\"webwrite\" in a string on line 1"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockConfig.EXPECT().
+				SandboxEnabled().
+				Return(false).
+				Once()
+
+			mockConfig.EXPECT().
+				BlockNetwork().
+				Return(true).
+				Once()
+
+			mockConfig.EXPECT().
+				AllowedHosts().
+				Return(nil).
+				Once()
+
+			policy := codepolicy.New(mockConfig, mockOSLayer)
+
+			// Act
+			err := policy.CheckCode(testCase.code)
+
+			// Assert
+			require.ErrorContains(t, err, "network access is blocked: "+testCase.expectedUsage)
+			assert.Equal(t, entities.ErrorCodePolicyViolation, entities.ErrorCodeOf(err))
+		})
+	}
+}
+
+func TestCodePolicy_CheckCode_NetworkAllowedHosts(t *testing.T) {
+	testCases := []struct {
+		name          string
+		code          string
+		expectedUsage string
+	}{
+		{name: "allowed host", code: "data = webread('https://data.example.com/data.json');"},
+		{name: "subdomain of an allowed host", code: "data = webread(\"https://api.data.example.com\", weboptions('Timeout', 10));"},
+		{name: "host argument after the file name", code: "websave('data.csv', 'https://data.example.com/data.csv')"},
+		{name: "host and port", code: "t = tcpclient('data.example.com', 8080);"},
+		{name: "no network access", code: "disp('https://other.com')"},
+		{name: "other host", code: "webread('https://other.com/upload')", expectedUsage: "`webread` to \"other.com\" on line 1"},
+		{name: "user info before the host", code: "webread('https://data.example.com@other.com/')", expectedUsage: "`webread` to \"other.com\" on line 1"},
+		{name: "host suffix", code: "webread('https://baddata.example.com.other.com')", expectedUsage: "`webread` to \"baddata.example.com.other.com\" on line 1"},
+		{name: "variable host", code: "url = 'https://data.example.com';\nwebread(url)", expectedUsage: "`webread` with a host that is not a string literal on line 2"},
+		{name: "concatenated host", code: "webread(['https://data.example.com', suffix])", expectedUsage: "`webread` with a host that is not a string literal on line 1"},
+		{name: "command syntax", code: "web https://data.example.com", expectedUsage: "`web` with a host that is not a string literal on line 1"},
+		{name: "unknown destination", code: "u = udpport;", expectedUsage: "`udpport` on line 1"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockConfig.EXPECT().
+				SandboxEnabled().
+				Return(false).
+				Once()
+
+			mockConfig.EXPECT().
+				BlockNetwork().
+				Return(true).
+				Once()
+
+			mockConfig.EXPECT().
+				AllowedHosts().
+				Return([]string{"data.example.com"}).
+				Once()
+
+			policy := codepolicy.New(mockConfig, mockOSLayer)
+
+			// Act
+			err := policy.CheckCode(testCase.code)
+
+			// Assert
+			if testCase.expectedUsage == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, "network access is only allowed to data.example.com: "+testCase.expectedUsage)
+		})
+	}
+}
+
+func TestCodePolicy_CheckFile_NetworkBlockedAndSandbox(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	filePath := "/home/user/script.m"
+
+	mockConfig.EXPECT().
+		SandboxEnabled().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		BlockNetwork().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		AllowedFunctions().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		DeniedFunctions().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		RestrictFileAccess().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		AllowedHosts().
+		Return(nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(filePath).
+		Return([]byte("system('curl example.com');\nurlread('https://example.com');\n"), nil).
+		Once()
+
+	policy := codepolicy.New(mockConfig, mockOSLayer)
+
+	// Act
+	err := policy.CheckFile(filePath)
+
+	// Assert
+	require.EqualError(t, err, "sandbox mode does not allow running shell commands or spawning processes: `system` on line 1; network access is blocked: `urlread` on line 2")
+}
+
+func TestCodePolicy_CheckPython(t *testing.T) {
+	testCases := []struct {
+		name           string
+		sandbox        bool
+		expectRejected bool
+	}{
+		{name: "sandbox disabled", sandbox: false, expectRejected: false},
+		{name: "sandbox enabled", sandbox: true, expectRejected: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockConfig.EXPECT().
+				SandboxEnabled().
+				Return(tc.sandbox).
+				Once()
+
+			policy := codepolicy.New(mockConfig, mockOSLayer)
+
+			// Act
+			err := policy.CheckPython()
+
+			// Assert
+			if !tc.expectRejected {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, entities.ErrorCodePolicyViolation, entities.ErrorCodeOf(err))
+		})
+	}
+}
+
+func TestCodePolicy_Effects(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	code := "x = magic(3);\n" +
+		"save('results.mat', 'x') % keep the results\n" +
+		"% delete('results.mat')\n" +
+		"path = fullfile(pwd, 'data');\n" +
+		"addpath(path);\n" +
+		"fid = fopen('log.txt', 'a');\n" +
+		"fid = fopen('input.txt', 'r');\n" +
+		"disp('cd later')\n" +
+		"cd ..\n" +
+		"matlab.addons.install('tools.mltbx');\n" +
+		"set_param('model/Gain', 'Gain', '2'); delete('model.slxc')\r\n" +
+		"x.save();"
+
+	policy := codepolicy.New(mockConfig, mockOSLayer)
+
+	// Act
+	effects := policy.Effects(code)
+
+	// Assert
+	assert.Equal(t, []entities.CodeEffect{
+		{Kind: entities.CodeEffectWritesFiles, Line: 2, Statement: "save('results.mat', 'x') % keep the results"},
+		{Kind: entities.CodeEffectChangesPath, Line: 5, Statement: "addpath(path);"},
+		{Kind: entities.CodeEffectWritesFiles, Line: 6, Statement: "fid = fopen('log.txt', 'a');"},
+		{Kind: entities.CodeEffectChangesFolder, Line: 9, Statement: "cd .."},
+		{Kind: entities.CodeEffectInstallsAddOns, Line: 10, Statement: "matlab.addons.install('tools.mltbx');"},
+		{Kind: entities.CodeEffectDeletesFiles, Line: 11, Statement: "set_param('model/Gain', 'Gain', '2'); delete('model.slxc')"},
+		{Kind: entities.CodeEffectEditsSimulinkModels, Line: 11, Statement: "set_param('model/Gain', 'Gain', '2'); delete('model.slxc')"},
+	}, effects)
+}
+
+func TestCodePolicy_CheckCode_FileAccessRestricted(t *testing.T) {
+	testCases := []struct {
+		name          string
+		code          string
+		expectedUsage string
+	}{
+		{name: "path outside the allowed folders", code: "load('/etc/passwd')", expectedUsage: "`load` of \"/etc/passwd\" on line 1"},
+		{name: "relative path", code: "x = 1;\nsave(\"results.mat\", \"x\")", expectedUsage: "`save` of \"results.mat\" on line 2"},
+		{name: "path escaping an allowed folder", code: "fileread('/data/../etc/passwd')", expectedUsage: "`fileread` of \"/data/../etc/passwd\" on line 1"},
+		{name: "folder named like an allowed folder", code: "delete('/database/table.csv')", expectedUsage: "`delete` of \"/database/table.csv\" on line 1"},
+		{name: "path built at run time", code: "fopen(fullfile('/data', name), 'w')", expectedUsage: "`fopen` with a path that is not a string literal on line 1"},
+		{name: "command syntax", code: "cd /tmp", expectedUsage: "`cd` with a path that is not a string literal on line 1"},
+		{name: "destination outside the allowed folders", code: "copyfile('/data/a.csv', '/tmp/a.csv')", expectedUsage: "`copyfile` of \"/tmp/a.csv\" on line 1"},
+		{name: "function without a known path argument", code: "print('-dpng', '/data/figure.png')", expectedUsage: "`print` on line 1"},
+		{name: "java file", code: "f = java.io.File('/etc/passwd');", expectedUsage: "`java.io` on line 1"},
+		{name: "python file", code: "f = py.open('/etc/passwd');", expectedUsage: "`py.open` on line 1"},
+		{name: "function name evaluated from a string", code: "eval('delete /etc/passwd')", expectedUsage: "\"delete\" in a string on line 1"},
+		{name: "code evaluated in the base workspace", code: "evalin('base', 'save secrets')", expectedUsage: "\"save\" in a string on line 1"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockConfig.EXPECT().
+				SandboxEnabled().
+				Return(true).
+				Once()
+
+			mockConfig.EXPECT().
+				BlockNetwork().
+				Return(false).
+				Once()
+
+			mockConfig.EXPECT().
+				AllowedFunctions().
+				Return(nil).
+				Once()
+
+			mockConfig.EXPECT().
+				DeniedFunctions().
+				Return(nil).
+				Once()
+
+			mockConfig.EXPECT().
+				RestrictFileAccess().
+				Return(true).
+				Once()
+
+			mockConfig.EXPECT().
+				AllowedFolders().
+				Return([]string{"/data", "/home/user/project"}).
+				Once()
+
+			policy := codepolicy.New(mockConfig, mockOSLayer)
+
+			// Act
+			err := policy.CheckCode(testCase.code)
+
+			// Assert
+			require.ErrorContains(t, err, "file access is only allowed in /data, /home/user/project: "+testCase.expectedUsage)
+			assert.Equal(t, entities.ErrorCodePolicyViolation, entities.ErrorCodeOf(err))
+		})
+	}
+}
+
+func TestCodePolicy_CheckCode_FileAccessAllowed(t *testing.T) {
+	testCases := []struct {
+		name string
+		code string
+	}{
+		{name: "path in an allowed folder", code: "data = readtable('/data/input.csv');"},
+		{name: "path in a subfolder of an allowed folder", code: "save(\"/home/user/project/results/out.mat\", \"x\")"},
+		{name: "write to an allowed folder", code: "writematrix(magic(3), '/data/magic.csv')"},
+		{name: "copy between allowed folders", code: "copyfile('/data/a.csv', '/home/user/project/a.csv')"},
+		{name: "file function named in a message", code: "disp('save complete')"},
+		{name: "field named like a file function", code: "s.load = 1; obj.save();"},
+		{name: "allowed function", code: "delete(h)"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockConfig.EXPECT().
+				SandboxEnabled().
+				Return(true).
+				Once()
+
+			mockConfig.EXPECT().
+				BlockNetwork().
+				Return(false).
+				Once()
+
+			mockConfig.EXPECT().
+				AllowedFunctions().
+				Return([]string{"delete"}).
+				Once()
+
+			mockConfig.EXPECT().
+				DeniedFunctions().
+				Return(nil).
+				Once()
+
+			mockConfig.EXPECT().
+				RestrictFileAccess().
+				Return(true).
+				Once()
+
+			mockConfig.EXPECT().
+				AllowedFolders().
+				Return([]string{"/data", "/home/user/project"}).
+				Once()
+
+			policy := codepolicy.New(mockConfig, mockOSLayer)
+
+			// Act
+			err := policy.CheckCode(testCase.code)
+
+			// Assert
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCodePolicy_CheckCode_FileAccessBlocked(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		SandboxEnabled().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		BlockNetwork().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		AllowedFunctions().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		DeniedFunctions().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		RestrictFileAccess().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		AllowedFolders().
+		Return(nil).
+		Once()
+
+	policy := codepolicy.New(mockConfig, mockOSLayer)
+
+	// Act
+	err := policy.CheckCode("x = load('/data/input.mat');")
+
+	// Assert
+	require.EqualError(t, err, "file access is blocked: `load` on line 1")
+}
+
+func TestCodePolicy_CheckCode_FunctionLists(t *testing.T) {
+	testCases := []struct {
+		name             string
+		code             string
+		allowedFunctions []string
+		deniedFunctions  []string
+		expectedError    string
+	}{
+		{
+			name:            "denied function",
+			code:            "x = 1;\nrmpath('/opt/tools')",
+			deniedFunctions: []string{"rmpath", "matlab.addons.install"},
+			expectedError:   "sandbox mode does not allow calling the denied functions: `rmpath` on line 2",
+		},
+		{
+			name:            "denied package function",
+			code:            "matlab.addons.install('tools.mltbx')",
+			deniedFunctions: []string{"rmpath", "matlab.addons.install"},
+			expectedError:   "sandbox mode does not allow calling the denied functions: `matlab.addons.install` on line 1",
+		},
+		{
+			name:            "denied function evaluated from a string",
+			code:            "feval('rmpath', p)",
+			deniedFunctions: []string{"rmpath"},
+			expectedError:   "sandbox mode does not allow calling the denied functions: \"rmpath\" in a string on line 1",
+		},
+		{
+			name:             "allowed shell function",
+			code:             "dos('dir')",
+			allowedFunctions: []string{"dos"},
+		},
+		{
+			name:             "shell escape cannot be allowed",
+			code:             "!dir",
+			allowedFunctions: []string{"dos", "!"},
+			expectedError:    "sandbox mode does not allow running shell commands or spawning processes: `!` on line 1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockConfig.EXPECT().
+				SandboxEnabled().
+				Return(true).
+				Once()
+
+			mockConfig.EXPECT().
+				BlockNetwork().
+				Return(false).
+				Once()
+
+			mockConfig.EXPECT().
+				AllowedFunctions().
+				Return(testCase.allowedFunctions).
+				Once()
+
+			mockConfig.EXPECT().
+				DeniedFunctions().
+				Return(testCase.deniedFunctions).
+				Once()
+
+			mockConfig.EXPECT().
+				RestrictFileAccess().
+				Return(false).
+				Once()
+
+			policy := codepolicy.New(mockConfig, mockOSLayer)
+
+			// Act
+			err := policy.CheckCode(testCase.code)
+
+			// Assert
+			if testCase.expectedError == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, testCase.expectedError)
+		})
+	}
+}
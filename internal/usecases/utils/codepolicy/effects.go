@@ -0,0 +1,98 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package codepolicy
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// effectFunctions are the functions whose calls change files, the working folder, the MATLAB path or Simulink models.
+var effectFunctions = []struct {
+	kind      entities.CodeEffectKind
+	functions []string
+}{
+	{entities.CodeEffectWritesFiles, []string{
+		"save", "savefig", "saveas", "print", "exportgraphics", "exportapp", "imwrite", "audiowrite", "websave",
+		"writematrix", "writetable", "writecell", "writetimetable", "writestruct", "writelines", "csvwrite", "dlmwrite", "xlswrite",
+		"copyfile", "movefile", "mkdir", "fileattrib", "zip", "unzip", "tar", "untar", "gzip", "gunzip",
+	}},
+	{entities.CodeEffectDeletesFiles, []string{"delete", "rmdir", "recycle"}},
+	{entities.CodeEffectChangesFolder, []string{"cd"}},
+	{entities.CodeEffectChangesPath, []string{"addpath", "rmpath", "path", "savepath", "restoredefaultpath", "userpath", "javaaddpath", "javarmpath"}},
+	{entities.CodeEffectEditsSimulinkModels, []string{
+		"set_param", "add_param", "delete_param", "add_block", "delete_block", "replace_block", "add_line", "delete_line", "new_system", "save_system",
+	}},
+}
+
+var effectFunctionCalls = func() map[entities.CodeEffectKind]*regexp.Regexp {
+	calls := map[entities.CodeEffectKind]*regexp.Regexp{}
+	for _, effect := range effectFunctions {
+		calls[effect.kind] = regexp.MustCompile(`(^|[^.\w])(` + strings.Join(effect.functions, "|") + `)\b`)
+	}
+	return calls
+}()
+
+// addOnFunctions install, remove, enable or disable add-ons, such as toolboxes and support packages.
+var addOnFunctions = regexp.MustCompile(`\bmatlab\s*\.\s*addons\s*\.\s*(toolbox\s*\.\s*)?(install\w*|uninstall\w*|enableAddon|disableAddon)\b`)
+
+var fileOpen = regexp.MustCompile(`(^|[^.\w])fopen\b`)
+
+// writeMode matches the permissions of fopen opening a file to write or append to it.
+var writeMode = regexp.MustCompile(`^[wWaA]\+?[tb]?$`)
+
+// Effects returns the statements of the code that change files, the working folder, the MATLAB path, add-ons
+// or Simulink models, in the order of the code. Like the checks, the scan is conservative, and it cannot find the
+// changes made by evaluated strings or by the functions that the code calls.
+func (p *CodePolicy) Effects(code string) []entities.CodeEffect {
+	rawLines := strings.Split(code, "\n")
+
+	var effects []entities.CodeEffect
+	for _, line := range splitCode(code) {
+		statement := strings.TrimSpace(strings.TrimSuffix(rawLines[line.number-1], "\r"))
+		add := func(kind entities.CodeEffectKind) {
+			effects = append(effects, entities.CodeEffect{Kind: kind, Line: line.number, Statement: statement})
+		}
+
+		for _, effect := range effectFunctions {
+			found := callsEffectFunction(effectFunctionCalls[effect.kind], line.code)
+			// fopen only writes files when it opens them with a write or append permission.
+			if effect.kind == entities.CodeEffectWritesFiles {
+				found = found || opensFileToWrite(line)
+			}
+			if found {
+				add(effect.kind)
+			}
+		}
+		if addOnFunctions.MatchString(line.code) {
+			add(entities.CodeEffectInstallsAddOns)
+		}
+	}
+	return effects
+}
+
+// callsEffectFunction reports whether code calls one of the functions of calls, rather than assigning a variable of the same name.
+func callsEffectFunction(calls *regexp.Regexp, code string) bool {
+	for _, match := range calls.FindAllStringIndex(code, -1) {
+		rest := strings.TrimLeft(code[match[1]:], " \t")
+		if strings.HasPrefix(rest, "=") && !strings.HasPrefix(rest, "==") {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+func opensFileToWrite(line codeLine) bool {
+	if !fileOpen.MatchString(line.code) {
+		return false
+	}
+	for _, literal := range line.strings {
+		if writeMode.MatchString(literal) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,179 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package codepolicy
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// fileFunctions read, write or delete files and folders, with the positions of the arguments naming the paths they access.
+var fileFunctions = map[string][]int{
+	"load":           {0},
+	"save":           {0},
+	"matfile":        {0},
+	"fopen":          {0},
+	"fileread":       {0},
+	"readtable":      {0},
+	"readmatrix":     {0},
+	"readcell":       {0},
+	"readtimetable":  {0},
+	"readstruct":     {0},
+	"readlines":      {0},
+	"importdata":     {0},
+	"imread":         {0},
+	"audioread":      {0},
+	"csvread":        {0},
+	"dlmread":        {0},
+	"xlsread":        {0},
+	"writematrix":    {1},
+	"writetable":     {1},
+	"writecell":      {1},
+	"writetimetable": {1},
+	"writestruct":    {1},
+	"writelines":     {1},
+	"imwrite":        {1},
+	"saveas":         {1},
+	"exportgraphics": {1},
+	"audiowrite":     {0},
+	"csvwrite":       {0},
+	"dlmwrite":       {0},
+	"xlswrite":       {0},
+	"websave":        {0},
+	"fileattrib":     {0},
+	"delete":         {0},
+	"rmdir":          {0},
+	"mkdir":          {0},
+	"cd":             {0},
+	"gzip":           {0},
+	"gunzip":         {0},
+	"copyfile":       {0, 1},
+	"movefile":       {0, 1},
+	"zip":            {0, 1},
+	"unzip":          {0, 1},
+	"tar":            {0, 1},
+	"untar":          {0, 1},
+	// The file name of these functions cannot be told apart from their other arguments before the call runs.
+	"savefig": {},
+	"print":   {},
+}
+
+// fileClasses are Java, .NET and Python entry points to the file system, whose paths cannot be checked before the call runs.
+var fileClasses = regexp.MustCompile(`\bjava\s*\.\s*(io|nio\s*\.\s*file)\b|\bSystem\s*\.\s*IO\b|\bpy\s*\.\s*(open|io|os|shutil|pathlib)\b`)
+
+// dynamicCallFunctions call the function named by their first string argument, or evaluate it as code, except for
+// evalin, whose code is its second argument.
+var dynamicCallFunctions = regexp.MustCompile(`(^|[^.\w])(eval|evalc|evalin|feval|builtin|str2func)\b`)
+
+// checkFileAccess returns the file accesses of the code outside the allowed folders, except for the calls to the
+// functions of allowedFunctions.
+func checkFileAccess(lines []codeLine, allowedFolders []string, allowedFunctions []string) []violation {
+	names := fileFunctionNames(allowedFunctions)
+	functionCall, functionName := functionCallPattern(names), functionNamePattern(names)
+
+	var violations []violation
+	for _, line := range lines {
+		for _, match := range functionCall.FindAllStringSubmatchIndex(line.code, -1) {
+			function := line.code[match[4]:match[5]]
+			if usage, ok := checkFileCall(line, function, match[5], allowedFolders); !ok {
+				violations = append(violations, violation{line: line.number, usage: usage})
+			}
+		}
+		for _, match := range fileClasses.FindAllString(line.code, -1) {
+			violations = append(violations, violation{line: line.number, usage: "`" + compact(match) + "`"})
+		}
+
+		// Names of file functions are only looked for in the strings passed to eval and feval, as words such as
+		// "load" and "save" are common in the text of messages.
+		for _, match := range dynamicCallFunctions.FindAllStringSubmatchIndex(line.code, -1) {
+			codeArgument := 0
+			if line.code[match[4]:match[5]] == "evalin" {
+				codeArgument = 1
+			}
+			argument, start, ok := callArgument(line.code, match[5], codeArgument)
+			if !ok || argument != `""` {
+				continue
+			}
+			literal := line.strings[strings.Count(line.code[:start], `"`)/2]
+			if functionName.MatchString(literal) {
+				violations = append(violations, violation{line: line.number, usage: fmt.Sprintf("%q in a string", functionName.FindStringSubmatch(literal)[1])})
+			}
+		}
+	}
+	return violations
+}
+
+// checkFileCall checks the call to a file function ending at end, and describes it when it is not allowed.
+// A call is only allowed when each of its path arguments is a single string literal, naming an absolute path in one of
+// the allowed folders, so that the path cannot be built at run time, nor depend on the working folder.
+func checkFileCall(line codeLine, function string, end int, allowedFolders []string) (string, bool) {
+	usage := "`" + function + "`"
+
+	pathArguments := fileFunctions[function]
+	if len(allowedFolders) == 0 || len(pathArguments) == 0 {
+		return usage, false
+	}
+
+	for _, index := range pathArguments {
+		argument, start, ok := callArgument(line.code, end, index)
+		if !ok || argument != `""` {
+			return usage + " with a path that is not a string literal", false
+		}
+
+		path := line.strings[strings.Count(line.code[:start], `"`)/2]
+		if !isAllowedPath(path, allowedFolders) {
+			return fmt.Sprintf("%s of %q", usage, path), false
+		}
+	}
+
+	return "", true
+}
+
+// isAllowedPath reports whether path is an absolute path in one of the allowed folders.
+func isAllowedPath(path string, allowedFolders []string) bool {
+	if !filepath.IsAbs(path) {
+		return false
+	}
+
+	path = filepath.Clean(path)
+	return slices.ContainsFunc(allowedFolders, func(folder string) bool {
+		relativePath, err := filepath.Rel(filepath.Clean(folder), path)
+		return err == nil && relativePath != ".." && !strings.HasPrefix(relativePath, ".."+string(filepath.Separator))
+	})
+}
+
+func fileFunctionNames(allowedFunctions []string) []string {
+	names := make([]string, 0, len(fileFunctions))
+	for name := range fileFunctions {
+		if !slices.Contains(allowedFunctions, name) {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// functionCallPattern matches the calls to the functions of names, and never matches when names is empty.
+func functionCallPattern(names []string) *regexp.Regexp {
+	return regexp.MustCompile(`(^|[^.\w])(` + alternatives(names) + `)\b`)
+}
+
+// functionNamePattern matches a string literal naming one of the functions of names, as passed to feval, builtin or str2func.
+func functionNamePattern(names []string) *regexp.Regexp {
+	return regexp.MustCompile(`^\s*@?(` + alternatives(names) + `)\b`)
+}
+
+// alternatives returns a pattern matching any of names, or nothing when names is empty.
+func alternatives(names []string) string {
+	if len(names) == 0 {
+		return `[^\s\S]`
+	}
+	quoted := make([]string, 0, len(names))
+	for _, name := range names {
+		quoted = append(quoted, regexp.QuoteMeta(name))
+	}
+	return strings.Join(quoted, "|")
+}
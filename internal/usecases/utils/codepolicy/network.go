@@ -0,0 +1,164 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package codepolicy
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// networkFunctions access the network, with the position of the argument naming the host they access,
+// or -1 when the host cannot be known before the call runs.
+var networkFunctions = map[string]int{
+	"webread":   0,
+	"webwrite":  0,
+	"websave":   1,
+	"urlread":   0,
+	"urlwrite":  0,
+	"web":       0,
+	"tcpclient": 0,
+	"tcpip":     0,
+	"ftp":       0,
+	"sftp":      0,
+	"tcpserver": -1,
+	"udpport":   -1,
+	"udp":       -1,
+	"sendmail":  -1,
+}
+
+// networkClasses are MATLAB, Java, .NET and Python entry points to the network, whose hosts cannot be checked before the call runs.
+var networkClasses = regexp.MustCompile(`\bmatlab\s*\.\s*(net|internal\s*\.\s*webservices)\b|\bjava\s*\.\s*net\b|\bSystem\s*\.\s*Net\b|\bpy\s*\.\s*(urllib\w*|requests|httpx|aiohttp|http|socket|ftplib|smtplib|paramiko)\b`)
+
+var networkFunctionCall = regexp.MustCompile(`(^|[^.\w])(` + strings.Join(networkFunctionNames(), "|") + `)\b`)
+
+// networkFunctionName matches a string literal naming a network function, as passed to feval, builtin or str2func.
+var networkFunctionName = regexp.MustCompile(`^\s*@?(` + strings.Join(networkFunctionNames(), "|") + `)\b`)
+
+func networkFunctionNames() []string {
+	names := make([]string, 0, len(networkFunctions))
+	for name := range networkFunctions {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// scanNetwork returns the network accesses of the code, except for the calls to network functions
+// whose host is a string literal naming one of the allowed hosts.
+func scanNetwork(lines []codeLine, allowedHosts []string) []violation {
+	var violations []violation
+	for _, line := range lines {
+		for _, match := range networkFunctionCall.FindAllStringSubmatchIndex(line.code, -1) {
+			function := line.code[match[4]:match[5]]
+			if usage, ok := checkNetworkCall(line, function, match[5], allowedHosts); !ok {
+				violations = append(violations, violation{line: line.number, usage: usage})
+			}
+		}
+		for _, match := range networkClasses.FindAllString(line.code, -1) {
+			violations = append(violations, violation{line: line.number, usage: "`" + compact(match) + "`"})
+		}
+
+		for _, literal := range line.strings {
+			switch {
+			case networkFunctionName.MatchString(literal):
+				violations = append(violations, violation{line: line.number, usage: fmt.Sprintf("%q in a string", networkFunctionName.FindStringSubmatch(literal)[1])})
+			case networkClasses.MatchString(literal):
+				violations = append(violations, violation{line: line.number, usage: fmt.Sprintf("%q in a string", compact(networkClasses.FindString(literal)))})
+			}
+		}
+	}
+	return violations
+}
+
+// checkNetworkCall checks the call to a network function ending at end, and describes it when it is not allowed.
+// A call is only allowed when its host argument is a single string literal, so that the host cannot be built at run time.
+func checkNetworkCall(line codeLine, function string, end int, allowedHosts []string) (string, bool) {
+	usage := "`" + function + "`"
+
+	hostArgument := networkFunctions[function]
+	if len(allowedHosts) == 0 || hostArgument < 0 {
+		return usage, false
+	}
+
+	argument, start, ok := callArgument(line.code, end, hostArgument)
+	if !ok || argument != `""` {
+		return usage + " with a host that is not a string literal", false
+	}
+
+	// Every string literal is replaced with "" in the code, so the quotes before the argument count the literals before it.
+	host := hostOf(line.strings[strings.Count(line.code[:start], `"`)/2])
+	if !isAllowedHost(host, allowedHosts) {
+		return fmt.Sprintf("%s to %q", usage, host), false
+	}
+
+	return "", true
+}
+
+// callArgument returns the trimmed argument at position index of the call whose name ends at end,
+// with the offset where it starts, and false when the call has no such argument in parentheses.
+func callArgument(code string, end int, index int) (string, int, bool) {
+	open := end + len(code[end:]) - len(strings.TrimLeft(code[end:], " \t"))
+	if open >= len(code) || code[open] != '(' {
+		return "", 0, false
+	}
+
+	depth := 0
+	start := open + 1
+	for i := open + 1; i < len(code); i++ {
+		switch code[i] {
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			if depth > 0 {
+				depth--
+				continue
+			}
+			fallthrough
+		case ',':
+			if depth > 0 {
+				continue
+			}
+			if index == 0 {
+				argument := code[start:i]
+				trimmed := strings.TrimSpace(argument)
+				return trimmed, start + strings.Index(argument, trimmed), true
+			}
+			if code[i] != ',' {
+				return "", 0, false
+			}
+			index--
+			start = i + 1
+		}
+	}
+	return "", 0, false
+}
+
+// hostOf returns the host of a URL, or of a host name with an optional port, in lower case.
+func hostOf(address string) string {
+	address = strings.TrimSpace(address)
+	if !strings.Contains(address, "://") {
+		address = "//" + address
+	}
+
+	parsed, err := url.Parse(address)
+	if err != nil {
+		return address
+	}
+	return strings.ToLower(parsed.Hostname())
+}
+
+func isAllowedHost(host string, allowedHosts []string) bool {
+	if host == "" {
+		return false
+	}
+
+	for _, allowedHost := range allowedHosts {
+		if host == allowedHost || strings.HasSuffix(host, "."+allowedHost) {
+			return true
+		}
+	}
+	return false
+}
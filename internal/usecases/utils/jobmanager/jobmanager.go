@@ -0,0 +1,444 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package jobmanager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/outputstream"
+)
+
+// Mode is where a background job runs.
+type Mode string
+
+const (
+	// ModeSession runs the job in the MATLAB session, which runs no other code until the job is finished.
+	ModeSession Mode = "session"
+	// ModeBatch runs the job as a batch job of the default cluster profile of the Parallel Computing Toolbox.
+	ModeBatch Mode = "batch"
+	// ModeParfeval runs the job with parfeval, on a worker of the parallel pool of the MATLAB session.
+	ModeParfeval Mode = "parfeval"
+)
+
+// State is the state of a background job.
+type State string
+
+const (
+	StateQueued    State = "queued"
+	StateRunning   State = "running"
+	StateCompleted State = "completed"
+	StateFailed    State = "failed"
+	StateCancelled State = "cancelled"
+)
+
+// Finished reports whether the job is over, and its state will not change anymore.
+func (s State) Finished() bool {
+	return s == StateCompleted || s == StateFailed || s == StateCancelled
+}
+
+// maxFinishedJobs is the number of finished jobs kept, so that their output can still be read. Older finished jobs are dropped.
+const maxFinishedJobs = 100
+
+// Job is a snapshot of a background job.
+type Job struct {
+	ID         string
+	Mode       Mode
+	State      State
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Output     string
+	Error      string
+}
+
+type StartRequest struct {
+	Code   string
+	Folder string
+	Mode   Mode
+}
+
+type job struct {
+	Job
+	client          entities.MATLABSessionClient
+	cancelRequested bool
+	// finished is closed once the job is finished.
+	finished chan struct{}
+}
+
+type workerJobStatus struct {
+	State  State  `json:"state"`
+	Output string `json:"output"`
+	Error  string `json:"error"`
+}
+
+type Config interface {
+	JobPollInterval() time.Duration
+}
+
+// Manager runs MATLAB code as background jobs, so that long simulations and sweeps do not hold a tool call open.
+// Jobs are kept by the server rather than by the MCP session starting them, so they survive the disconnection of the client.
+type Manager struct {
+	config Config
+
+	lock   *sync.Mutex
+	jobs   map[string]*job
+	order  []string
+	nextID int
+	now    func() time.Time
+}
+
+func New(
+	config Config,
+) *Manager {
+	return &Manager{
+		config: config,
+
+		lock: new(sync.Mutex),
+		jobs: make(map[string]*job),
+		now:  time.Now,
+	}
+}
+
+// Start starts code as a background job in folder, and returns as soon as the job is started.
+func (m *Manager) Start(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, request StartRequest) (Job, error) {
+	j := &job{
+		Job: Job{
+			ID:        m.newID(),
+			Mode:      request.Mode,
+			StartedAt: m.now(),
+		},
+		client:   client,
+		finished: make(chan struct{}),
+	}
+	logger = logger.With("job-id", j.ID).With("job-mode", string(j.Mode))
+
+	switch request.Mode {
+	case ModeSession:
+		j.State = StateRunning
+		m.add(j)
+		// The job must outlive the tool call starting it.
+		go m.runInSession(context.WithoutCancel(ctx), logger, j, request)
+	case ModeBatch, ModeParfeval:
+		_, err := client.FEval(ctx, logger, entities.FEvalRequest{
+			Function:   "matlab_mcp.startJob",
+			Arguments:  []string{j.ID, string(request.Mode), request.Code, request.Folder},
+			NumOutputs: 0,
+		})
+		if err != nil {
+			return Job{}, err
+		}
+		j.State = StateQueued
+		m.add(j)
+	default:
+		return Job{}, entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("%q is not a valid job mode", request.Mode))
+	}
+
+	logger.Info("Started background job")
+
+	return m.snapshot(j), nil
+}
+
+// Get returns the current state of a job, and the output it produced so far.
+func (m *Manager) Get(ctx context.Context, logger entities.Logger, id string) (Job, error) {
+	j, err := m.find(id)
+	if err != nil {
+		return Job{}, err
+	}
+
+	current := m.snapshot(j)
+	if current.Mode == ModeSession || current.State.Finished() {
+		return current, nil
+	}
+
+	response, err := j.client.FEval(ctx, logger, entities.FEvalRequest{
+		Function:   "matlab_mcp.jobStatus",
+		Arguments:  []string{id},
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return Job{}, err
+	}
+
+	status, err := parseWorkerJobStatus(response)
+	if err != nil {
+		return Job{}, err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	// The job may have been cancelled while its status was being queried.
+	if j.State.Finished() {
+		return j.Job, nil
+	}
+
+	j.State = status.State
+	j.Output = status.Output
+	j.Error = status.Error
+	if j.State.Finished() {
+		m.finish(j)
+	}
+
+	return j.Job, nil
+}
+
+// Wait returns the state of a job once it is finished, or after timeout, whichever comes first. Jobs running in the MATLAB session
+// signal when they finish, so Wait returns as soon as they do. The state of jobs running on workers is queried at the job poll
+// interval, and only while a call is waiting, so that idle jobs generate no traffic with MATLAB. The output that a job running
+// with parfeval produces while Wait waits is sent to the client as it is queried, when the client asked for it.
+func (m *Manager) Wait(ctx context.Context, logger entities.Logger, id string, timeout time.Duration) (Job, error) {
+	current, err := m.Get(ctx, logger, id)
+	if err != nil || current.State.Finished() || timeout <= 0 {
+		return current, err
+	}
+	streamed := len(current.Output)
+
+	j, err := m.find(id)
+	if err != nil {
+		return Job{}, err
+	}
+
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	var poll <-chan time.Time
+	if j.Mode != ModeSession {
+		ticker := time.NewTicker(m.config.JobPollInterval())
+		defer ticker.Stop()
+		poll = ticker.C
+	}
+
+	for {
+		select {
+		case <-j.finished:
+			return m.snapshot(j), nil
+		case <-deadline.C:
+			return m.snapshot(j), nil
+		case <-ctx.Done():
+			return Job{}, ctx.Err()
+		case <-poll:
+			current, err := m.Get(ctx, logger, id)
+			if err != nil || current.State.Finished() {
+				return current, err
+			}
+			streamed = streamOutput(ctx, logger, current.Output, streamed)
+		}
+	}
+}
+
+// streamOutput sends the output of a job past the streamed bytes to the client, and returns the number of bytes streamed. The
+// output that cannot be sent now is sent with the next output.
+func streamOutput(ctx context.Context, logger entities.Logger, output string, streamed int) int {
+	if len(output) <= streamed {
+		return streamed
+	}
+
+	if err := outputstream.Write(ctx, output[streamed:]); err != nil {
+		if !errors.Is(err, outputstream.ErrNotSupported) {
+			logger.WithError(err).Debug("Failed to stream background job output")
+		}
+		return streamed
+	}
+	return len(output)
+}
+
+// Cancel stops a job. Cancelling a finished job does nothing.
+func (m *Manager) Cancel(ctx context.Context, logger entities.Logger, id string) (Job, error) {
+	j, err := m.find(id)
+	if err != nil {
+		return Job{}, err
+	}
+	logger = logger.With("job-id", id)
+
+	m.lock.Lock()
+	if j.State.Finished() {
+		m.lock.Unlock()
+		return m.snapshot(j), nil
+	}
+	j.cancelRequested = true
+	m.lock.Unlock()
+
+	var output string
+	if j.Mode == ModeSession {
+		err = j.client.Interrupt(ctx, logger)
+	} else {
+		output, err = m.cancelOnWorker(ctx, logger, j)
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if err != nil {
+		j.cancelRequested = false
+		return Job{}, err
+	}
+
+	if !j.State.Finished() {
+		j.State = StateCancelled
+		if output != "" {
+			j.Output = output
+		}
+		m.finish(j)
+	}
+
+	logger.Info("Cancelled background job")
+
+	return j.Job, nil
+}
+
+func (m *Manager) runInSession(ctx context.Context, logger entities.Logger, j *job, request StartRequest) {
+	_, err := j.client.Eval(ctx, logger, entities.EvalRequest{
+		Code: fmt.Sprintf("cd('%s')", strings.ReplaceAll(request.Folder, "'", "''")), // Escape single quotes
+	})
+
+	var response entities.EvalResponse
+	if err == nil {
+		response, err = j.client.Eval(ctx, logger, entities.EvalRequest{
+			Code: request.Code,
+		})
+	}
+
+	var limitErr *entities.LimitExceededError
+	if errors.As(err, &limitErr) {
+		response = limitErr.Partial
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	j.Output = response.ConsoleOutput
+
+	switch {
+	case j.cancelRequested:
+		j.State = StateCancelled
+		logger.Info("Background job cancelled")
+	case err != nil:
+		j.State = StateFailed
+		j.Error = err.Error()
+		logger.WithError(err).Warn("Background job failed")
+	default:
+		j.State = StateCompleted
+		logger.Info("Background job completed")
+	}
+
+	m.finish(j)
+}
+
+func (m *Manager) cancelOnWorker(ctx context.Context, logger entities.Logger, j *job) (string, error) {
+	response, err := j.client.FEval(ctx, logger, entities.FEvalRequest{
+		Function:   "matlab_mcp.cancelJob",
+		Arguments:  []string{j.ID},
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if len(response.Outputs) != 1 {
+		return "", fmt.Errorf("unexpected number of outputs from MATLAB session")
+	}
+
+	output, ok := response.Outputs[0].(string)
+	if !ok {
+		return "", fmt.Errorf("failed to cast output to string")
+	}
+
+	return output, nil
+}
+
+func parseWorkerJobStatus(response entities.FEvalResponse) (workerJobStatus, error) {
+	if len(response.Outputs) != 1 {
+		return workerJobStatus{}, fmt.Errorf("unexpected number of outputs from MATLAB session")
+	}
+
+	output, ok := response.Outputs[0].(string)
+	if !ok {
+		return workerJobStatus{}, fmt.Errorf("failed to cast output to string")
+	}
+
+	var status workerJobStatus
+	if err := json.Unmarshal([]byte(output), &status); err != nil {
+		return workerJobStatus{}, fmt.Errorf("failed to parse job status: %w", err)
+	}
+
+	switch status.State {
+	case StateQueued, StateRunning, StateCompleted, StateFailed:
+		return status, nil
+	default:
+		return workerJobStatus{}, fmt.Errorf("unexpected job state: %q", status.State)
+	}
+}
+
+func (m *Manager) newID() string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.nextID++
+	return "job-" + strconv.Itoa(m.nextID)
+}
+
+func (m *Manager) add(j *job) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.jobs[j.ID] = j
+	m.order = append(m.order, j.ID)
+}
+
+func (m *Manager) find(id string) (*job, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	j, ok := m.jobs[id]
+	if !ok {
+		return nil, entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("there is no background job with ID %q", id))
+	}
+	return j, nil
+}
+
+func (m *Manager) snapshot(j *job) Job {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return j.Job
+}
+
+// finish records when the job finished, wakes up the calls waiting for it, and drops the oldest finished jobs. A job cancelled while
+// running in the MATLAB session finishes again once its evaluation returns. The lock must be held.
+func (m *Manager) finish(j *job) {
+	j.FinishedAt = m.now()
+	select {
+	case <-j.finished:
+	default:
+		close(j.finished)
+	}
+	m.prune()
+}
+
+// prune drops the oldest finished jobs beyond maxFinishedJobs. The lock must be held.
+func (m *Manager) prune() {
+	finished := 0
+	for _, id := range m.order {
+		if m.jobs[id].State.Finished() {
+			finished++
+		}
+	}
+
+	kept := m.order[:0]
+	for _, id := range m.order {
+		if finished > maxFinishedJobs && m.jobs[id].State.Finished() {
+			delete(m.jobs, id)
+			finished--
+			continue
+		}
+		kept = append(kept, id)
+	}
+	m.order = kept
+}
@@ -0,0 +1,805 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package jobmanager_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/jobmanager"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/outputstream"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/utils/jobmanager"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	eventuallyTimeout = time.Second
+	eventuallyTick    = 5 * time.Millisecond
+)
+
+// waitForState polls the job until it reaches state.
+func waitForState(t *testing.T, manager *jobmanager.Manager, logger entities.Logger, id string, state jobmanager.State) jobmanager.Job {
+	t.Helper()
+
+	var job jobmanager.Job
+	require.Eventually(t, func() bool {
+		var err error
+		job, err = manager.Get(t.Context(), logger, id)
+		require.NoError(t, err)
+		return job.State == state
+	}, eventuallyTimeout, eventuallyTick)
+
+	return job
+}
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	// Act
+	manager := jobmanager.New(mockConfig)
+
+	// Assert
+	assert.NotNil(t, manager)
+}
+
+func TestManager_Start_SessionJobCompletes(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: "cd('/home/user/project''s')"}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: "runSweep"}).
+		Return(entities.EvalResponse{ConsoleOutput: "Sweep done"}, nil).
+		Once()
+
+	manager := jobmanager.New(&mocks.MockConfig{})
+
+	// Act
+	started, err := manager.Start(t.Context(), mockLogger, mockClient, jobmanager.StartRequest{
+		Code:   "runSweep",
+		Folder: "/home/user/project's",
+		Mode:   jobmanager.ModeSession,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "job-1", started.ID)
+	assert.Equal(t, jobmanager.ModeSession, started.Mode)
+
+	job := waitForState(t, manager, mockLogger, started.ID, jobmanager.StateCompleted)
+	assert.Equal(t, "Sweep done", job.Output)
+	assert.Empty(t, job.Error)
+	assert.False(t, job.FinishedAt.IsZero())
+}
+
+func TestManager_Start_SessionJobOutlivesTheCall(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), mock.Anything).
+		RunAndReturn(func(ctx context.Context, _ entities.Logger, _ entities.EvalRequest) (entities.EvalResponse, error) {
+			return entities.EvalResponse{}, ctx.Err()
+		}).
+		Twice()
+
+	manager := jobmanager.New(&mocks.MockConfig{})
+	ctx, cancel := context.WithCancel(t.Context())
+
+	// Act
+	started, err := manager.Start(ctx, mockLogger, mockClient, jobmanager.StartRequest{
+		Code:   "runSweep",
+		Folder: "/home/user/project",
+		Mode:   jobmanager.ModeSession,
+	})
+	cancel()
+
+	// Assert
+	require.NoError(t, err)
+	waitForState(t, manager, mockLogger, started.ID, jobmanager.StateCompleted)
+}
+
+func TestManager_Start_SessionJobFails(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: "cd('/home/user/project')"}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: "runSweep"}).
+		Return(entities.EvalResponse{}, assert.AnError).
+		Once()
+
+	manager := jobmanager.New(&mocks.MockConfig{})
+
+	// Act
+	started, err := manager.Start(t.Context(), mockLogger, mockClient, jobmanager.StartRequest{
+		Code:   "runSweep",
+		Folder: "/home/user/project",
+		Mode:   jobmanager.ModeSession,
+	})
+
+	// Assert
+	require.NoError(t, err)
+
+	job := waitForState(t, manager, mockLogger, started.ID, jobmanager.StateFailed)
+	assert.Equal(t, assert.AnError.Error(), job.Error)
+	assert.Contains(t, mockLogger.WarnLogs(), "Background job failed")
+}
+
+func TestManager_Start_SessionJobKeepsPartialOutputOfExceededLimit(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	limitErr := entities.NewLimitExceededError(entities.ResourceLimitWallTime, "1h0m0s", entities.EvalResponse{ConsoleOutput: "Iteration 1"})
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: "cd('/home/user/project')"}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: "runSweep"}).
+		Return(entities.EvalResponse{}, limitErr).
+		Once()
+
+	manager := jobmanager.New(&mocks.MockConfig{})
+
+	// Act
+	started, err := manager.Start(t.Context(), mockLogger, mockClient, jobmanager.StartRequest{
+		Code:   "runSweep",
+		Folder: "/home/user/project",
+		Mode:   jobmanager.ModeSession,
+	})
+
+	// Assert
+	require.NoError(t, err)
+
+	job := waitForState(t, manager, mockLogger, started.ID, jobmanager.StateFailed)
+	assert.Equal(t, "Iteration 1", job.Output)
+}
+
+func TestManager_Start_BatchJob(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.startJob",
+			Arguments:  []string{"job-1", "batch", "runSweep", "/home/user/project"},
+			NumOutputs: 0,
+		}).
+		Return(entities.FEvalResponse{}, nil).
+		Once()
+
+	statusRequest := entities.FEvalRequest{
+		Function:   "matlab_mcp.jobStatus",
+		Arguments:  []string{"job-1"},
+		NumOutputs: 1,
+	}
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), statusRequest).
+		Return(entities.FEvalResponse{Outputs: []any{`{"state":"running","output":"","error":""}`}}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), statusRequest).
+		Return(entities.FEvalResponse{Outputs: []any{`{"state":"completed","output":"Sweep done","error":""}`}}, nil).
+		Once()
+
+	manager := jobmanager.New(&mocks.MockConfig{})
+
+	// Act
+	started, startErr := manager.Start(ctx, mockLogger, mockClient, jobmanager.StartRequest{
+		Code:   "runSweep",
+		Folder: "/home/user/project",
+		Mode:   jobmanager.ModeBatch,
+	})
+	running, runningErr := manager.Get(ctx, mockLogger, "job-1")
+	completed, completedErr := manager.Get(ctx, mockLogger, "job-1")
+	cached, cachedErr := manager.Get(ctx, mockLogger, "job-1")
+
+	// Assert
+	require.NoError(t, startErr)
+	require.NoError(t, runningErr)
+	require.NoError(t, completedErr)
+	require.NoError(t, cachedErr)
+	assert.Equal(t, jobmanager.StateQueued, started.State)
+	assert.Equal(t, jobmanager.StateRunning, running.State)
+	assert.Equal(t, jobmanager.StateCompleted, completed.State)
+	assert.Equal(t, "Sweep done", completed.Output)
+	assert.Equal(t, completed, cached, "A finished job should not be queried again")
+}
+
+func TestManager_Start_WorkerError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), mock.Anything).
+		Return(entities.FEvalResponse{}, assert.AnError).
+		Once()
+
+	manager := jobmanager.New(&mocks.MockConfig{})
+
+	// Act
+	started, err := manager.Start(ctx, mockLogger, mockClient, jobmanager.StartRequest{
+		Code:   "runSweep",
+		Folder: "/home/user/project",
+		Mode:   jobmanager.ModeParfeval,
+	})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, started)
+
+	_, getErr := manager.Get(ctx, mockLogger, "job-1")
+	require.Error(t, getErr, "A job that failed to start should not be kept")
+}
+
+func TestManager_Start_InvalidMode(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	manager := jobmanager.New(&mocks.MockConfig{})
+
+	// Act
+	started, err := manager.Start(t.Context(), mockLogger, mockClient, jobmanager.StartRequest{
+		Code:   "runSweep",
+		Folder: "/home/user/project",
+		Mode:   "cloud",
+	})
+
+	// Assert
+	require.Error(t, err)
+	assert.Equal(t, entities.ErrorCodeInvalidInput, entities.ErrorCodeOf(err))
+	assert.Empty(t, started)
+}
+
+func TestManager_Get_UnknownJob(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	manager := jobmanager.New(&mocks.MockConfig{})
+
+	// Act
+	job, err := manager.Get(t.Context(), mockLogger, "job-42")
+
+	// Assert
+	require.Error(t, err)
+	assert.Equal(t, entities.ErrorCodeInvalidInput, entities.ErrorCodeOf(err))
+	assert.Empty(t, job)
+}
+
+func TestManager_Get_UnexpectedWorkerStatus(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), mock.MatchedBy(func(request entities.FEvalRequest) bool {
+			return request.Function == "matlab_mcp.startJob"
+		})).
+		Return(entities.FEvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), mock.MatchedBy(func(request entities.FEvalRequest) bool {
+			return request.Function == "matlab_mcp.jobStatus"
+		})).
+		Return(entities.FEvalResponse{Outputs: []any{`{"state":"paused","output":"","error":""}`}}, nil).
+		Once()
+
+	manager := jobmanager.New(&mocks.MockConfig{})
+	started, err := manager.Start(ctx, mockLogger, mockClient, jobmanager.StartRequest{Code: "runSweep", Folder: "/home/user/project", Mode: jobmanager.ModeBatch})
+	require.NoError(t, err)
+
+	// Act
+	job, err := manager.Get(ctx, mockLogger, started.ID)
+
+	// Assert
+	require.Error(t, err)
+	assert.Empty(t, job)
+}
+
+func TestManager_Cancel_SessionJob(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	running := make(chan struct{})
+	interrupted := make(chan struct{})
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: "cd('/home/user/project')"}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: "runSweep"}).
+		Run(func(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) {
+			close(running)
+			<-interrupted
+		}).
+		Return(entities.EvalResponse{}, assert.AnError).
+		Once()
+
+	mockClient.EXPECT().
+		Interrupt(mock.Anything, mockLogger.AsMockArg()).
+		Run(func(ctx context.Context, sessionLogger entities.Logger) {
+			close(interrupted)
+		}).
+		Return(nil).
+		Once()
+
+	manager := jobmanager.New(&mocks.MockConfig{})
+	started, err := manager.Start(t.Context(), mockLogger, mockClient, jobmanager.StartRequest{Code: "runSweep", Folder: "/home/user/project", Mode: jobmanager.ModeSession})
+	require.NoError(t, err)
+	<-running
+
+	// Act
+	cancelled, err := manager.Cancel(t.Context(), mockLogger, started.ID)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, jobmanager.StateCancelled, cancelled.State)
+
+	job := waitForState(t, manager, mockLogger, started.ID, jobmanager.StateCancelled)
+	assert.Empty(t, job.Error, "The interruption should not be reported as a failure")
+}
+
+func TestManager_Cancel_SessionJobInterruptError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	running := make(chan struct{})
+	release := make(chan struct{})
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: "cd('/home/user/project')"}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: "runSweep"}).
+		Run(func(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) {
+			close(running)
+			<-release
+		}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Interrupt(mock.Anything, mockLogger.AsMockArg()).
+		Return(assert.AnError).
+		Once()
+
+	manager := jobmanager.New(&mocks.MockConfig{})
+	started, err := manager.Start(t.Context(), mockLogger, mockClient, jobmanager.StartRequest{Code: "runSweep", Folder: "/home/user/project", Mode: jobmanager.ModeSession})
+	require.NoError(t, err)
+	<-running
+
+	// Act
+	cancelled, err := manager.Cancel(t.Context(), mockLogger, started.ID)
+	close(release)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, cancelled)
+	waitForState(t, manager, mockLogger, started.ID, jobmanager.StateCompleted)
+}
+
+func TestManager_Cancel_ParfevalJob(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.startJob",
+			Arguments:  []string{"job-1", "parfeval", "runSweep", "/home/user/project"},
+			NumOutputs: 0,
+		}).
+		Return(entities.FEvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.cancelJob",
+			Arguments:  []string{"job-1"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{"Iteration 1"}}, nil).
+		Once()
+
+	manager := jobmanager.New(&mocks.MockConfig{})
+	started, err := manager.Start(ctx, mockLogger, mockClient, jobmanager.StartRequest{Code: "runSweep", Folder: "/home/user/project", Mode: jobmanager.ModeParfeval})
+	require.NoError(t, err)
+
+	// Act
+	cancelled, cancelErr := manager.Cancel(ctx, mockLogger, started.ID)
+	job, getErr := manager.Get(ctx, mockLogger, started.ID)
+
+	// Assert
+	require.NoError(t, cancelErr)
+	require.NoError(t, getErr)
+	assert.Equal(t, jobmanager.StateCancelled, cancelled.State)
+	assert.Equal(t, "Iteration 1", cancelled.Output)
+	assert.Equal(t, cancelled, job, "A cancelled job should not be queried again")
+}
+
+func TestManager_Cancel_FinishedJob(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), mock.Anything).
+		Return(entities.EvalResponse{ConsoleOutput: "Sweep done"}, nil).
+		Twice()
+
+	manager := jobmanager.New(&mocks.MockConfig{})
+	started, err := manager.Start(t.Context(), mockLogger, mockClient, jobmanager.StartRequest{Code: "runSweep", Folder: "/home/user/project", Mode: jobmanager.ModeSession})
+	require.NoError(t, err)
+	waitForState(t, manager, mockLogger, started.ID, jobmanager.StateCompleted)
+
+	// Act
+	job, err := manager.Cancel(t.Context(), mockLogger, started.ID)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, jobmanager.StateCompleted, job.State)
+}
+
+func TestManager_DropsOldestFinishedJobs(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	const jobCount = 101
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), mock.Anything).
+		Return(entities.EvalResponse{}, nil).
+		Times(2 * jobCount)
+
+	manager := jobmanager.New(&mocks.MockConfig{})
+
+	// Act
+	for range jobCount {
+		started, err := manager.Start(t.Context(), mockLogger, mockClient, jobmanager.StartRequest{Code: "x = 1;", Folder: "/home/user/project", Mode: jobmanager.ModeSession})
+		require.NoError(t, err)
+		waitForState(t, manager, mockLogger, started.ID, jobmanager.StateCompleted)
+	}
+
+	// Assert
+	_, err := manager.Get(t.Context(), mockLogger, "job-1")
+	require.Error(t, err, "The oldest finished job should be dropped")
+
+	_, err = manager.Get(t.Context(), mockLogger, "job-"+strconv.Itoa(jobCount))
+	require.NoError(t, err)
+}
+
+func TestManager_Wait_SessionJobSignalsCompletion(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	release := make(chan struct{})
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: "cd('/home/user/project')"}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: "runSweep"}).
+		RunAndReturn(func(context.Context, entities.Logger, entities.EvalRequest) (entities.EvalResponse, error) {
+			<-release
+			return entities.EvalResponse{ConsoleOutput: "Sweep done"}, nil
+		}).
+		Once()
+
+	manager := jobmanager.New(mockConfig)
+	started, err := manager.Start(t.Context(), mockLogger, mockClient, jobmanager.StartRequest{Code: "runSweep", Folder: "/home/user/project", Mode: jobmanager.ModeSession})
+	require.NoError(t, err)
+
+	go close(release)
+
+	// Act
+	job, err := manager.Wait(t.Context(), mockLogger, started.ID, time.Minute)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, jobmanager.StateCompleted, job.State)
+	assert.Equal(t, "Sweep done", job.Output)
+}
+
+func TestManager_Wait_Timeout(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	release := make(chan struct{})
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), mock.Anything).
+		RunAndReturn(func(_ context.Context, _ entities.Logger, request entities.EvalRequest) (entities.EvalResponse, error) {
+			if request.Code == "runSweep" {
+				<-release
+			}
+			return entities.EvalResponse{}, nil
+		}).
+		Twice()
+
+	manager := jobmanager.New(mockConfig)
+	started, err := manager.Start(t.Context(), mockLogger, mockClient, jobmanager.StartRequest{Code: "runSweep", Folder: "/home/user/project", Mode: jobmanager.ModeSession})
+	require.NoError(t, err)
+
+	// Act
+	job, err := manager.Wait(t.Context(), mockLogger, started.ID, 10*time.Millisecond)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, jobmanager.StateRunning, job.State)
+
+	close(release)
+	waitForState(t, manager, mockLogger, started.ID, jobmanager.StateCompleted)
+}
+
+func TestManager_Wait_ContextCancelled(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	release := make(chan struct{})
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), mock.Anything).
+		RunAndReturn(func(_ context.Context, _ entities.Logger, request entities.EvalRequest) (entities.EvalResponse, error) {
+			if request.Code == "runSweep" {
+				<-release
+			}
+			return entities.EvalResponse{}, nil
+		}).
+		Twice()
+
+	manager := jobmanager.New(mockConfig)
+	started, err := manager.Start(t.Context(), mockLogger, mockClient, jobmanager.StartRequest{Code: "runSweep", Folder: "/home/user/project", Mode: jobmanager.ModeSession})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	// Act
+	job, err := manager.Wait(ctx, mockLogger, started.ID, time.Minute)
+
+	// Assert
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, job)
+
+	close(release)
+	waitForState(t, manager, mockLogger, started.ID, jobmanager.StateCompleted)
+}
+
+func TestManager_Wait_PollsWorkerJob(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), mock.MatchedBy(func(request entities.FEvalRequest) bool {
+			return request.Function == "matlab_mcp.startJob"
+		})).
+		Return(entities.FEvalResponse{}, nil).
+		Once()
+
+	statusRequest := entities.FEvalRequest{
+		Function:   "matlab_mcp.jobStatus",
+		Arguments:  []string{"job-1"},
+		NumOutputs: 1,
+	}
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), statusRequest).
+		Return(entities.FEvalResponse{Outputs: []any{`{"state":"running","output":"","error":""}`}}, nil).
+		Twice()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), statusRequest).
+		Return(entities.FEvalResponse{Outputs: []any{`{"state":"completed","output":"Sweep done","error":""}`}}, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		JobPollInterval().
+		Return(time.Millisecond).
+		Once()
+
+	manager := jobmanager.New(mockConfig)
+	started, err := manager.Start(ctx, mockLogger, mockClient, jobmanager.StartRequest{Code: "runSweep", Folder: "/home/user/project", Mode: jobmanager.ModeBatch})
+	require.NoError(t, err)
+
+	// Act
+	job, err := manager.Wait(ctx, mockLogger, started.ID, time.Minute)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, jobmanager.StateCompleted, job.State)
+	assert.Equal(t, "Sweep done", job.Output)
+}
+
+func TestManager_Wait_StreamsWorkerJobOutput(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	var streamed []string
+	writes := 0
+	ctx := outputstream.NewContext(t.Context(), func(ctx context.Context, text string) error {
+		writes++
+		if writes == 2 {
+			return assert.AnError
+		}
+		streamed = append(streamed, text)
+		return nil
+	})
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), mock.MatchedBy(func(request entities.FEvalRequest) bool {
+			return request.Function == "matlab_mcp.startJob"
+		})).
+		Return(entities.FEvalResponse{}, nil).
+		Once()
+
+	statusRequest := entities.FEvalRequest{
+		Function:   "matlab_mcp.jobStatus",
+		Arguments:  []string{"job-1"},
+		NumOutputs: 1,
+	}
+
+	for _, status := range []string{
+		`{"state":"running","output":"step 1\n","error":""}`,
+		`{"state":"running","output":"step 1\nstep 2\n","error":""}`,
+		`{"state":"running","output":"step 1\nstep 2\nstep 3\n","error":""}`,
+		`{"state":"running","output":"step 1\nstep 2\nstep 3\nstep 4\n","error":""}`,
+		`{"state":"running","output":"step 1\nstep 2\nstep 3\nstep 4\n","error":""}`,
+		`{"state":"completed","output":"step 1\nstep 2\nstep 3\nstep 4\ndone\n","error":""}`,
+	} {
+		mockClient.EXPECT().
+			FEval(ctx, mockLogger.AsMockArg(), statusRequest).
+			Return(entities.FEvalResponse{Outputs: []any{status}}, nil).
+			Once()
+	}
+
+	mockConfig.EXPECT().
+		JobPollInterval().
+		Return(time.Millisecond).
+		Once()
+
+	manager := jobmanager.New(mockConfig)
+	started, err := manager.Start(ctx, mockLogger, mockClient, jobmanager.StartRequest{Code: "runSweep", Folder: "/home/user/project", Mode: jobmanager.ModeParfeval})
+	require.NoError(t, err)
+
+	// Act
+	job, err := manager.Wait(ctx, mockLogger, started.ID, time.Minute)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, jobmanager.StateCompleted, job.State)
+	assert.Equal(t, "step 1\nstep 2\nstep 3\nstep 4\ndone\n", job.Output)
+	assert.Equal(t, []string{"step 2\n", "step 3\nstep 4\n"}, streamed, "Only the output produced while waiting should be streamed, and output that failed to be sent should be sent with the next output")
+}
+
+func TestManager_Wait_FinishedJob(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), mock.Anything).
+		Return(entities.EvalResponse{}, nil).
+		Twice()
+
+	manager := jobmanager.New(mockConfig)
+	started, err := manager.Start(t.Context(), mockLogger, mockClient, jobmanager.StartRequest{Code: "x = 1;", Folder: "/home/user/project", Mode: jobmanager.ModeSession})
+	require.NoError(t, err)
+	finished := waitForState(t, manager, mockLogger, started.ID, jobmanager.StateCompleted)
+
+	// Act
+	job, err := manager.Wait(t.Context(), mockLogger, started.ID, time.Minute)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, finished, job)
+}
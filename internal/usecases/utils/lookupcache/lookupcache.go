@@ -0,0 +1,118 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package lookupcache
+
+import (
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+type Config interface {
+	LookupCacheTTL() time.Duration
+}
+
+type OSLayer interface {
+	ReadFile(name string) ([]byte, error)
+}
+
+// pathOrInstallCall matches the calls that change the MATLAB search path, or install or remove toolboxes and add-ons,
+// which change the results of the cached lookups.
+var pathOrInstallCall = regexp.MustCompile(`(^|[^.\w])(addpath|rmpath|path|userpath|restoredefaultpath|savepath|rehash|genpath|installToolbox|uninstallToolbox)\b|\bmatlab\s*\.\s*addons\s*\.`)
+
+type entry struct {
+	value   string
+	expires time.Time
+}
+
+// Cache keeps the results of lookups whose answer only changes when the MATLAB search path or the installed toolboxes change,
+// such as the list of installed products, so that repeated identical queries do not wait for a MATLAB round trip.
+// Results are dropped when code that could change the search path or the installed toolboxes is run, and once the TTL elapses.
+type Cache struct {
+	config  Config
+	osLayer OSLayer
+
+	lock    *sync.Mutex
+	entries map[string]entry
+	now     func() time.Time
+}
+
+func New(
+	config Config,
+	osLayer OSLayer,
+) *Cache {
+	return &Cache{
+		config:  config,
+		osLayer: osLayer,
+
+		lock:    new(sync.Mutex),
+		entries: make(map[string]entry),
+		now:     time.Now,
+	}
+}
+
+// Lookup returns the cached result of the lookup identified by key, or calls fetch and caches its result.
+// Errors are not cached.
+func (c *Cache) Lookup(logger entities.Logger, key string, fetch func() (string, error)) (string, error) {
+	ttl := c.config.LookupCacheTTL()
+	if ttl == 0 {
+		return fetch()
+	}
+
+	c.lock.Lock()
+	cached, ok := c.entries[key]
+	c.lock.Unlock()
+
+	if ok && c.now().Before(cached.expires) {
+		logger.With("key", key).Debug("Lookup cache hit")
+		return cached.value, nil
+	}
+
+	value, err := fetch()
+	if err != nil {
+		return "", err
+	}
+
+	c.lock.Lock()
+	c.entries[key] = entry{
+		value:   value,
+		expires: c.now().Add(ttl),
+	}
+	c.lock.Unlock()
+
+	return value, nil
+}
+
+// InvalidateForCode drops every cached result if code could change the MATLAB search path or the installed toolboxes.
+func (c *Cache) InvalidateForCode(logger entities.Logger, code string) {
+	if pathOrInstallCall.MatchString(code) {
+		c.invalidate(logger)
+	}
+}
+
+// InvalidateForFile drops every cached result if the MATLAB file could change the search path or the installed toolboxes.
+// Every result is dropped if the file cannot be read.
+func (c *Cache) InvalidateForFile(logger entities.Logger, filePath string) {
+	content, err := c.osLayer.ReadFile(filePath)
+	if err != nil {
+		logger.WithError(err).With("path", filePath).Debug("Failed to read file, dropping the lookup cache")
+		c.invalidate(logger)
+		return
+	}
+
+	c.InvalidateForCode(logger, string(content))
+}
+
+func (c *Cache) invalidate(logger entities.Logger) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if len(c.entries) == 0 {
+		return
+	}
+
+	clear(c.entries)
+	logger.Debug("Dropped the lookup cache")
+}
@@ -0,0 +1,9 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package lookupcache
+
+import "time"
+
+func (c *Cache) SetNow(now func() time.Time) {
+	c.now = now
+}
@@ -0,0 +1,267 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package lookupcache_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/lookupcache"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/utils/lookupcache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingFetch returns a fetch function returning value, and a pointer to the number of times it was called.
+func countingFetch(value string) (func() (string, error), *int) {
+	calls := 0
+	return func() (string, error) {
+		calls++
+		return value, nil
+	}, &calls
+}
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	// Act
+	cache := lookupcache.New(mockConfig, mockOSLayer)
+
+	// Assert
+	assert.NotNil(t, cache)
+}
+
+func TestCache_Lookup_CachesResult(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		LookupCacheTTL().
+		Return(time.Hour).
+		Times(2)
+
+	cache := lookupcache.New(mockConfig, mockOSLayer)
+	fetch, calls := countingFetch("MATLAB Version 25.1")
+
+	// Act
+	first, firstErr := cache.Lookup(mockLogger, "ver", fetch)
+	second, secondErr := cache.Lookup(mockLogger, "ver", fetch)
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, secondErr)
+	assert.Equal(t, "MATLAB Version 25.1", first)
+	assert.Equal(t, "MATLAB Version 25.1", second)
+	assert.Equal(t, 1, *calls, "The second lookup should be served from the cache")
+}
+
+func TestCache_Lookup_Expires(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		LookupCacheTTL().
+		Return(time.Minute).
+		Times(2)
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache := lookupcache.New(mockConfig, mockOSLayer)
+	cache.SetNow(func() time.Time { return now })
+	fetch, calls := countingFetch("MATLAB Version 25.1")
+
+	// Act
+	_, err := cache.Lookup(mockLogger, "ver", fetch)
+	require.NoError(t, err)
+	now = now.Add(time.Minute)
+	_, err = cache.Lookup(mockLogger, "ver", fetch)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 2, *calls, "An expired result should be fetched again")
+}
+
+func TestCache_Lookup_Disabled(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		LookupCacheTTL().
+		Return(0).
+		Times(2)
+
+	cache := lookupcache.New(mockConfig, mockOSLayer)
+	fetch, calls := countingFetch("MATLAB Version 25.1")
+
+	// Act
+	_, err := cache.Lookup(mockLogger, "ver", fetch)
+	require.NoError(t, err)
+	_, err = cache.Lookup(mockLogger, "ver", fetch)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 2, *calls)
+}
+
+func TestCache_Lookup_ErrorNotCached(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		LookupCacheTTL().
+		Return(time.Hour).
+		Times(2)
+
+	cache := lookupcache.New(mockConfig, mockOSLayer)
+	failingFetch := func() (string, error) { return "", assert.AnError }
+	fetch, calls := countingFetch("MATLAB Version 25.1")
+
+	// Act
+	_, failedErr := cache.Lookup(mockLogger, "ver", failingFetch)
+	result, err := cache.Lookup(mockLogger, "ver", fetch)
+
+	// Assert
+	require.ErrorIs(t, failedErr, assert.AnError)
+	require.NoError(t, err)
+	assert.Equal(t, "MATLAB Version 25.1", result)
+	assert.Equal(t, 1, *calls)
+}
+
+func TestCache_InvalidateForCode(t *testing.T) {
+	testCases := []struct {
+		name        string
+		code        string
+		invalidates bool
+	}{
+		{name: "addpath", code: "addpath('C:\\work\\utils')", invalidates: true},
+		{name: "rmpath with genpath", code: "rmpath(genpath('lib'))", invalidates: true},
+		{name: "path command", code: "path(oldPath)", invalidates: true},
+		{name: "restoredefaultpath", code: "restoredefaultpath", invalidates: true},
+		{name: "add-on install", code: "matlab.addons.install('tbx.mltbx')", invalidates: true},
+		{name: "toolbox install", code: "matlab.addons.toolbox.installToolbox('tbx.mltbx')", invalidates: true},
+		{name: "plain code", code: "x = magic(4);\ndisp(sum(x))", invalidates: false},
+		{name: "path in an identifier", code: "filePath = fullfile(pwd, 'data.mat');", invalidates: false},
+		{name: "path as a field", code: "s.path = 'data';", invalidates: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockConfig.EXPECT().
+				LookupCacheTTL().
+				Return(time.Hour).
+				Times(2)
+
+			cache := lookupcache.New(mockConfig, mockOSLayer)
+			fetch, calls := countingFetch("MATLAB Version 25.1")
+
+			_, err := cache.Lookup(mockLogger, "ver", fetch)
+			require.NoError(t, err)
+
+			// Act
+			cache.InvalidateForCode(mockLogger, testCase.code)
+
+			// Assert
+			_, err = cache.Lookup(mockLogger, "ver", fetch)
+			require.NoError(t, err)
+			if testCase.invalidates {
+				assert.Equal(t, 2, *calls, "The cache should be dropped")
+			} else {
+				assert.Equal(t, 1, *calls, "The cache should be kept")
+			}
+		})
+	}
+}
+
+func TestCache_InvalidateForFile(t *testing.T) {
+	testCases := []struct {
+		name        string
+		content     []byte
+		readErr     error
+		invalidates bool
+	}{
+		{name: "changes path", content: []byte("addpath('lib');\nrun_analysis"), invalidates: true},
+		{name: "plain script", content: []byte("x = 1;\ndisp(x)"), invalidates: false},
+		{name: "unreadable file", readErr: assert.AnError, invalidates: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			const filePath = "/home/user/project/script.m"
+
+			mockConfig.EXPECT().
+				LookupCacheTTL().
+				Return(time.Hour).
+				Times(2)
+
+			mockOSLayer.EXPECT().
+				ReadFile(filePath).
+				Return(testCase.content, testCase.readErr).
+				Once()
+
+			cache := lookupcache.New(mockConfig, mockOSLayer)
+			fetch, calls := countingFetch("MATLAB Version 25.1")
+
+			_, err := cache.Lookup(mockLogger, "ver", fetch)
+			require.NoError(t, err)
+
+			// Act
+			cache.InvalidateForFile(mockLogger, filePath)
+
+			// Assert
+			_, err = cache.Lookup(mockLogger, "ver", fetch)
+			require.NoError(t, err)
+			if testCase.invalidates {
+				assert.Equal(t, 2, *calls, "The cache should be dropped")
+			} else {
+				assert.Equal(t, 1, *calls, "The cache should be kept")
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matfile
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// Variable is a variable of a MAT-file, as listed by whos.
+type Variable struct {
+	Name    string `json:"name"`
+	Class   string `json:"class"`
+	Size    []int  `json:"size"`
+	Bytes   int    `json:"bytes"`
+	Complex bool   `json:"complex"`
+}
+
+// CheckFilePath returns an error unless filePath names a MAT-file.
+func CheckFilePath(filePath string) error {
+	if !strings.EqualFold(filepath.Ext(filePath), ".mat") {
+		return entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("%s is not a MAT-file: use a .mat file", filePath))
+	}
+	return nil
+}
+
+// Call runs an action of matlab_mcp.matFile in the MATLAB session, and parses its JSON result into result.
+func Call(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, arguments []string, result any) error {
+	response, err := client.FEval(ctx, logger, entities.FEvalRequest{
+		Function:   "matlab_mcp.matFile",
+		Arguments:  arguments,
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(response.Outputs) != 1 {
+		return fmt.Errorf("unexpected number of outputs from MATLAB session")
+	}
+
+	output, ok := response.Outputs[0].(string)
+	if !ok {
+		return fmt.Errorf("failed to cast output to string")
+	}
+
+	if err := json.Unmarshal([]byte(output), result); err != nil {
+		return fmt.Errorf("failed to parse the result of the MAT-file: %w", err)
+	}
+
+	return nil
+}
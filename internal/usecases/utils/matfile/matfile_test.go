@@ -0,0 +1,136 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matfile_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/matfile"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckFilePath(t *testing.T) {
+	testConfigs := []struct {
+		name        string
+		filePath    string
+		expectError bool
+	}{
+		{
+			name:     "MAT-file",
+			filePath: "/home/user/data/measurements.mat",
+		},
+		{
+			name:     "MAT-file in capitals",
+			filePath: "/home/user/data/measurements.MAT",
+		},
+		{
+			name:        "MATLAB file",
+			filePath:    "/home/user/data/measurements.m",
+			expectError: true,
+		},
+		{
+			name:        "no extension",
+			filePath:    "measurements",
+			expectError: true,
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Act
+			err := matfile.CheckFilePath(testConfig.filePath)
+
+			// Assert
+			if !testConfig.expectError {
+				require.NoError(t, err)
+				return
+			}
+			assert.Equal(t, entities.ErrorCodeInvalidInput, entities.ErrorCodeOf(err))
+		})
+	}
+}
+
+func TestCall_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.matFile",
+			Arguments:  []string{"list", "/home/user/data/measurements.mat", "1000"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`{"file":"/home/user/data/measurements.mat","variableCount":2}`}}, nil).
+		Once()
+
+	var result struct {
+		File          string `json:"file"`
+		VariableCount int    `json:"variableCount"`
+	}
+
+	// Act
+	err := matfile.Call(ctx, mockLogger, mockClient, []string{"list", "/home/user/data/measurements.mat", "1000"}, &result)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "/home/user/data/measurements.mat", result.File)
+	assert.Equal(t, 2, result.VariableCount)
+}
+
+func TestCall_Errors(t *testing.T) {
+	testConfigs := []struct {
+		name     string
+		response entities.FEvalResponse
+	}{
+		{
+			name:     "no output",
+			response: entities.FEvalResponse{Outputs: []any{}},
+		},
+		{
+			name:     "output not a string",
+			response: entities.FEvalResponse{Outputs: []any{42.0}},
+		},
+		{
+			name:     "output not JSON",
+			response: entities.FEvalResponse{Outputs: []any{"not json"}},
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			ctx := t.Context()
+
+			mockClient.EXPECT().
+				FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+					Function:   "matlab_mcp.matFile",
+					Arguments:  []string{"list", "/home/user/data/measurements.mat", "1000"},
+					NumOutputs: 1,
+				}).
+				Return(testConfig.response, nil).
+				Once()
+
+			var result struct{}
+
+			// Act
+			err := matfile.Call(ctx, mockLogger, mockClient, []string{"list", "/home/user/data/measurements.mat", "1000"}, &result)
+
+			// Assert
+			require.Error(t, err)
+		})
+	}
+}
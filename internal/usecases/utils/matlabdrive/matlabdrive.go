@@ -0,0 +1,299 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabdrive
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+)
+
+// URIPrefix is the prefix of the URIs of the files and folders of MATLAB Drive, followed by their path in MATLAB Drive.
+const URIPrefix = "matlab://drive/"
+
+// MaxFileBytes is the size of the largest file read from or written to MATLAB Drive, as files are held in memory.
+const MaxFileBytes = 64 << 20
+
+const (
+	filePermissions   = 0o644
+	folderPermissions = 0o755
+)
+
+type Config interface {
+	MATLABDriveFolder() string
+}
+
+type OSLayer interface {
+	Stat(name string) (osfacade.FileInfo, error)
+	ReadFile(name string) ([]byte, error)
+	WriteFile(name string, data []byte, perm os.FileMode) error
+	MkdirAll(path string, perm os.FileMode) error
+	EvalSymlinks(path string) (string, error)
+	DirFS(dir string) fs.FS
+}
+
+// Entry is a file or folder of MATLAB Drive.
+type Entry struct {
+	Name    string
+	Path    string
+	URI     string
+	IsDir   bool
+	Bytes   int64
+	ModTime time.Time
+}
+
+// Drive reads and writes the local folder of MATLAB Drive, which MATLAB Drive Connector keeps in sync with the cloud,
+// so that files written there reach MATLAB Online and the other computers of the user. Paths in MATLAB Drive are
+// relative to its folder, with forward slashes, and cannot leave it.
+type Drive struct {
+	config  Config
+	osLayer OSLayer
+}
+
+func New(
+	config Config,
+	osLayer OSLayer,
+) *Drive {
+	return &Drive{
+		config:  config,
+		osLayer: osLayer,
+	}
+}
+
+// Enabled is true when a MATLAB Drive folder is configured.
+func (d *Drive) Enabled() bool {
+	return d.config.MATLABDriveFolder() != ""
+}
+
+// URI returns the URI of the resource of drivePath, with the segments of the path percent-encoded, as the names of
+// files in MATLAB Drive often have spaces.
+func URI(drivePath string) string {
+	segments := strings.Split(relativePath(drivePath), "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return URIPrefix + strings.Join(segments, "/")
+}
+
+// PathFromURI returns the path in MATLAB Drive of the resource uri.
+func PathFromURI(uri string) (string, error) {
+	if !strings.HasPrefix(uri, URIPrefix) {
+		return "", entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("%s is not a MATLAB Drive URI", uri))
+	}
+
+	drivePath, err := url.PathUnescape(strings.TrimPrefix(uri, URIPrefix))
+	if err != nil {
+		return "", entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("%s is not a valid MATLAB Drive URI: %w", uri, err))
+	}
+	return drivePath, nil
+}
+
+// Stat returns the entry of drivePath.
+func (d *Drive) Stat(drivePath string) (Entry, error) {
+	filePath, err := d.resolve(drivePath)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	info, err := d.osLayer.Stat(filePath)
+	if err != nil {
+		return Entry{}, notFound(drivePath, err)
+	}
+
+	return newEntry(cleanPath(drivePath), path.Base(cleanPath(drivePath)), info.IsDir(), info.Size(), info.ModTime()), nil
+}
+
+// List returns the entries of the folder drivePath, folders first, then by name.
+func (d *Drive) List(drivePath string) ([]Entry, error) {
+	folderPath, err := d.resolve(drivePath)
+	if err != nil {
+		return nil, err
+	}
+
+	dirEntries, err := fs.ReadDir(d.osLayer.DirFS(folderPath), ".")
+	if err != nil {
+		return nil, notFound(drivePath, err)
+	}
+
+	folder := cleanPath(drivePath)
+	entries := make([]Entry, 0, len(dirEntries))
+	for _, dirEntry := range dirEntries {
+		info, err := dirEntry.Info()
+		if err != nil {
+			continue
+		}
+		entries = append(entries, newEntry(path.Join(folder, dirEntry.Name()), dirEntry.Name(), dirEntry.IsDir(), info.Size(), info.ModTime()))
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].IsDir != entries[j].IsDir {
+			return entries[i].IsDir
+		}
+		return entries[i].Name < entries[j].Name
+	})
+
+	return entries, nil
+}
+
+// Read returns the content of the file drivePath.
+func (d *Drive) Read(drivePath string) ([]byte, error) {
+	entry, err := d.Stat(drivePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if entry.IsDir {
+		return nil, entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("%s is a folder of MATLAB Drive, not a file", entry.Path))
+	}
+	if entry.Bytes > MaxFileBytes {
+		return nil, entities.NewCodedError(entities.ErrorCodeLimitExceeded, fmt.Errorf("%s is larger than %d bytes", entry.Path, MaxFileBytes))
+	}
+
+	filePath, err := d.resolve(drivePath)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := d.osLayer.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s from MATLAB Drive: %w", entry.Path, err)
+	}
+	return content, nil
+}
+
+// Write writes content to the file drivePath, creating its folders. An existing file is only replaced when overwrite
+// is set.
+func (d *Drive) Write(drivePath string, content []byte, overwrite bool) (Entry, error) {
+	if len(content) > MaxFileBytes {
+		return Entry{}, entities.NewCodedError(entities.ErrorCodeLimitExceeded, fmt.Errorf("the file is larger than %d bytes", MaxFileBytes))
+	}
+
+	filePath, err := d.resolve(drivePath)
+	if err != nil {
+		return Entry{}, err
+	}
+	if cleanPath(drivePath) == "." {
+		return Entry{}, entities.NewCodedError(entities.ErrorCodeInvalidInput, errors.New("the path of the file in MATLAB Drive is empty"))
+	}
+
+	if info, err := d.osLayer.Stat(filePath); err == nil {
+		if info.IsDir() {
+			return Entry{}, entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("%s is a folder of MATLAB Drive", cleanPath(drivePath)))
+		}
+		if !overwrite {
+			return Entry{}, entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("%s already exists in MATLAB Drive, set overwrite to replace it", cleanPath(drivePath)))
+		}
+	}
+
+	if err := d.osLayer.MkdirAll(filepath.Dir(filePath), folderPermissions); err != nil {
+		return Entry{}, fmt.Errorf("failed to create the folder of %s in MATLAB Drive: %w", cleanPath(drivePath), err)
+	}
+
+	if err := d.osLayer.WriteFile(filePath, content, filePermissions); err != nil {
+		return Entry{}, fmt.Errorf("failed to write %s to MATLAB Drive: %w", cleanPath(drivePath), err)
+	}
+
+	return newEntry(cleanPath(drivePath), path.Base(cleanPath(drivePath)), false, int64(len(content)), time.Now()), nil
+}
+
+// resolve returns the local path of drivePath. Paths leaving the folder of MATLAB Drive, directly or through a symbolic
+// link, are rejected.
+func (d *Drive) resolve(drivePath string) (string, error) {
+	root := d.config.MATLABDriveFolder()
+	if root == "" {
+		return "", entities.NewCodedError(entities.ErrorCodeInvalidInput, errors.New("MATLAB Drive is not configured"))
+	}
+
+	cleaned := cleanPath(drivePath)
+	if cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", entities.NewCodedError(entities.ErrorCodePermissionDenied, fmt.Errorf("%s is outside of MATLAB Drive", drivePath))
+	}
+
+	filePath := filepath.Join(root, filepath.FromSlash(cleaned))
+
+	resolvedRoot := d.resolveSymlinks(root)
+	if !isWithin(d.resolveExisting(filePath), resolvedRoot) {
+		return "", entities.NewCodedError(entities.ErrorCodePermissionDenied, fmt.Errorf("%s links outside of MATLAB Drive", drivePath))
+	}
+
+	return filePath, nil
+}
+
+// resolveExisting resolves the symbolic links of the longest existing parent of filePath, so that files about to be
+// written are checked through the folder they are written to.
+func (d *Drive) resolveExisting(filePath string) string {
+	suffix := ""
+	for {
+		if resolvedPath, err := d.osLayer.EvalSymlinks(filePath); err == nil {
+			return filepath.Join(resolvedPath, suffix)
+		}
+
+		parent := filepath.Dir(filePath)
+		if parent == filePath {
+			return filepath.Join(filePath, suffix)
+		}
+		suffix = filepath.Join(filepath.Base(filePath), suffix)
+		filePath = parent
+	}
+}
+
+func (d *Drive) resolveSymlinks(filePath string) string {
+	resolvedPath, err := d.osLayer.EvalSymlinks(filePath)
+	if err != nil {
+		return filePath
+	}
+	return resolvedPath
+}
+
+func newEntry(drivePath string, name string, isDir bool, bytes int64, modTime time.Time) Entry {
+	if drivePath == "." {
+		name = ""
+	}
+	return Entry{
+		Name:    name,
+		Path:    relativePath(drivePath),
+		URI:     URI(drivePath),
+		IsDir:   isDir,
+		Bytes:   bytes,
+		ModTime: modTime,
+	}
+}
+
+// cleanPath cleans drivePath, "." being the folder of MATLAB Drive itself.
+func cleanPath(drivePath string) string {
+	return path.Clean(strings.TrimLeft(filepath.ToSlash(drivePath), "/"))
+}
+
+// relativePath is the path of drivePath in MATLAB Drive, empty for the folder of MATLAB Drive itself.
+func relativePath(drivePath string) string {
+	cleaned := cleanPath(drivePath)
+	if cleaned == "." {
+		return ""
+	}
+	return cleaned
+}
+
+func notFound(drivePath string, err error) error {
+	if errors.Is(err, fs.ErrNotExist) {
+		return entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("%s does not exist in MATLAB Drive", drivePath))
+	}
+	return fmt.Errorf("failed to access %s in MATLAB Drive: %w", drivePath, err)
+}
+
+func isWithin(filePath string, folder string) bool {
+	relativePath, err := filepath.Rel(folder, filePath)
+	if err != nil {
+		return false
+	}
+	return relativePath != ".." && !strings.HasPrefix(relativePath, ".."+string(filepath.Separator))
+}
@@ -0,0 +1,182 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabdrive_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/matlabdrive"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/utils/matlabdrive"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newDrive returns a Drive on a temporary MATLAB Drive folder holding data/results.csv and notes.txt.
+func newDrive(t *testing.T) (*matlabdrive.Drive, string) {
+	t.Helper()
+
+	root := filepath.Join(t.TempDir(), "MATLAB Drive")
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "data"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "data", "results.csv"), []byte("a,b\n1,2\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "notes.txt"), []byte("notes"), 0o600))
+
+	mockConfig := &mocks.MockConfig{}
+	mockConfig.EXPECT().MATLABDriveFolder().Return(root).Maybe()
+
+	return matlabdrive.New(mockConfig, osfacade.New()), root
+}
+
+func requireErrorCode(t *testing.T, err error, code entities.ErrorCode) {
+	t.Helper()
+
+	var codedErr *entities.CodedError
+	require.ErrorAs(t, err, &codedErr)
+	assert.Equal(t, code, codedErr.ErrorCode())
+}
+
+func TestDrive_Enabled(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		MATLABDriveFolder().
+		Return("").
+		Once()
+
+	drive := matlabdrive.New(mockConfig, osfacade.New())
+
+	// Act
+	enabled := drive.Enabled()
+
+	// Assert
+	assert.False(t, enabled)
+}
+
+func TestDrive_List_HappyPath(t *testing.T) {
+	// Arrange
+	drive, _ := newDrive(t)
+
+	// Act
+	entries, err := drive.List("/")
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "data", entries[0].Name, "Folders should be listed first")
+	assert.True(t, entries[0].IsDir)
+	assert.Equal(t, "matlab://drive/data", entries[0].URI)
+	assert.Equal(t, "notes.txt", entries[1].Name)
+	assert.Equal(t, int64(5), entries[1].Bytes)
+}
+
+func TestDrive_Read_HappyPath(t *testing.T) {
+	// Arrange
+	drive, _ := newDrive(t)
+
+	// Act
+	content, err := drive.Read("data/results.csv")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "a,b\n1,2\n", string(content))
+}
+
+func TestDrive_Read_Errors(t *testing.T) {
+	testConfigs := []struct {
+		name         string
+		drivePath    string
+		expectedCode entities.ErrorCode
+	}{
+		{
+			name:         "missing file",
+			drivePath:    "data/missing.csv",
+			expectedCode: entities.ErrorCodeInvalidInput,
+		},
+		{
+			name:         "folder",
+			drivePath:    "data",
+			expectedCode: entities.ErrorCodeInvalidInput,
+		},
+		{
+			name:         "path leaving MATLAB Drive",
+			drivePath:    "data/../../secret.txt",
+			expectedCode: entities.ErrorCodePermissionDenied,
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			drive, _ := newDrive(t)
+
+			// Act
+			_, err := drive.Read(testConfig.drivePath)
+
+			// Assert
+			requireErrorCode(t, err, testConfig.expectedCode)
+		})
+	}
+}
+
+func TestDrive_Read_SymlinkOutsideOfDrive(t *testing.T) {
+	// Arrange
+	drive, root := newDrive(t)
+	outside := filepath.Join(filepath.Dir(root), "secret.txt")
+	require.NoError(t, os.WriteFile(outside, []byte("secret"), 0o600))
+	if err := os.Symlink(outside, filepath.Join(root, "link.txt")); err != nil {
+		t.Skipf("cannot create symbolic links: %v", err)
+	}
+
+	// Act
+	_, err := drive.Read("link.txt")
+
+	// Assert
+	requireErrorCode(t, err, entities.ErrorCodePermissionDenied)
+}
+
+func TestDrive_Write_HappyPath(t *testing.T) {
+	// Arrange
+	drive, root := newDrive(t)
+
+	// Act
+	entry, err := drive.Write("results/2025/figure 1.png", []byte("PNG"), false)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "results/2025/figure 1.png", entry.Path)
+	assert.Equal(t, "matlab://drive/results/2025/figure%201.png", entry.URI)
+	content, err := os.ReadFile(filepath.Join(root, "results", "2025", "figure 1.png"))
+	require.NoError(t, err)
+	assert.Equal(t, "PNG", string(content))
+}
+
+func TestDrive_Write_Overwrite(t *testing.T) {
+	// Arrange
+	drive, root := newDrive(t)
+
+	// Act
+	_, errWithoutOverwrite := drive.Write("notes.txt", []byte("new notes"), false)
+	_, errWithOverwrite := drive.Write("notes.txt", []byte("new notes"), true)
+
+	// Assert
+	requireErrorCode(t, errWithoutOverwrite, entities.ErrorCodeInvalidInput)
+	require.NoError(t, errWithOverwrite)
+	content, err := os.ReadFile(filepath.Join(root, "notes.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "new notes", string(content))
+}
+
+func TestPathFromURI(t *testing.T) {
+	// Act
+	drivePath, err := matlabdrive.PathFromURI("matlab://drive/results/figure%201.png")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "results/figure 1.png", drivePath)
+	assert.Equal(t, "matlab://drive/results/figure%201.png", matlabdrive.URI(drivePath))
+}
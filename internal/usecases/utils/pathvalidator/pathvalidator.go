@@ -3,31 +3,44 @@
 package pathvalidator
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/clientroots"
 )
 
 type OSLayer interface {
 	Stat(filePath string) (osfacade.FileInfo, error)
+	EvalSymlinks(path string) (string, error)
+}
+
+type Config interface {
+	RestrictFileAccess() bool
+	AllowedFolders() []string
 }
 
 type PathValidator struct {
 	osLayer OSLayer
+	config  Config
 }
 
 func New(
 	osLayer OSLayer,
+	config Config,
 ) *PathValidator {
 	return &PathValidator{
 		osLayer: osLayer,
+		config:  config,
 	}
 }
 
-func (v *PathValidator) ValidateMATLABScript(filePath string) (string, error) {
+func (v *PathValidator) ValidateMATLABScript(ctx context.Context, filePath string) (string, error) {
 	absPath, err := resolveAbsolutePath(filePath)
 	if err != nil {
 		return "", err
@@ -35,7 +48,11 @@ func (v *PathValidator) ValidateMATLABScript(filePath string) (string, error) {
 
 	// Check if it's a .m file before doing any file system operations
 	if !strings.HasSuffix(absPath, ".m") {
-		return "", fmt.Errorf("file must be a MATLAB .m file: %s", absPath)
+		return "", entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("file must be a MATLAB .m file: %s", absPath))
+	}
+
+	if err := v.checkAccess(ctx, absPath); err != nil {
+		return "", err
 	}
 
 	fileInfo, err := v.getResourceInfo(absPath)
@@ -44,25 +61,52 @@ func (v *PathValidator) ValidateMATLABScript(filePath string) (string, error) {
 	}
 
 	if fileInfo.IsDir() {
-		return "", fmt.Errorf("path is not a file: %s", absPath)
+		return "", entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("path is not a file: %s", absPath))
 	}
 
 	return absPath, nil
 }
 
-func (v *PathValidator) ValidateFolderPath(filePath string) (string, error) {
+// ValidateFilePath validates the path of an existing file of any type.
+func (v *PathValidator) ValidateFilePath(ctx context.Context, filePath string) (string, error) {
 	absPath, err := resolveAbsolutePath(filePath)
 	if err != nil {
 		return "", err
 	}
 
+	if err := v.checkAccess(ctx, absPath); err != nil {
+		return "", err
+	}
+
+	fileInfo, err := v.getResourceInfo(absPath)
+	if err != nil {
+		return "", err
+	}
+
+	if fileInfo.IsDir() {
+		return "", entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("path is not a file: %s", absPath))
+	}
+
+	return absPath, nil
+}
+
+func (v *PathValidator) ValidateFolderPath(ctx context.Context, filePath string) (string, error) {
+	absPath, err := resolveAbsolutePath(filePath)
+	if err != nil {
+		return "", err
+	}
+
+	if err := v.checkAccess(ctx, absPath); err != nil {
+		return "", err
+	}
+
 	folderInfo, err := v.getResourceInfo(absPath)
 	if err != nil {
 		return "", err
 	}
 
 	if !folderInfo.IsDir() {
-		return "", fmt.Errorf("path is not a folder: %s", absPath)
+		return "", entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("path is not a folder: %s", absPath))
 	}
 
 	return absPath, nil
@@ -72,7 +116,10 @@ func (v *PathValidator) getResourceInfo(filePath string) (osfacade.FileInfo, err
 	resourceInfo, err := v.osLayer.Stat(filePath)
 	if err != nil {
 		if os.IsNotExist(err) {
-			return nil, fmt.Errorf("resource not found: %s", filePath)
+			return nil, entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("resource not found: %s", filePath))
+		}
+		if os.IsPermission(err) {
+			return nil, entities.NewCodedError(entities.ErrorCodePermissionDenied, fmt.Errorf("error accessing resource: %w", err))
 		}
 		return nil, fmt.Errorf("error accessing resource: %w", err)
 	}
@@ -80,11 +127,52 @@ func (v *PathValidator) getResourceInfo(filePath string) (osfacade.FileInfo, err
 	return resourceInfo, nil
 }
 
+// checkAccess rejects paths outside the roots of the MCP client and the allowed folders, when file access is restricted.
+// The check is done before accessing the path, so that it does not reveal whether paths outside these folders exist.
+func (v *PathValidator) checkAccess(ctx context.Context, absPath string) error {
+	if !v.config.RestrictFileAccess() {
+		return nil
+	}
+
+	roots, rootsErr := clientroots.FromContext(ctx)
+
+	resolvedPath := v.resolveSymlinks(absPath)
+	for _, folder := range slices.Concat(roots, v.config.AllowedFolders()) {
+		if isWithin(resolvedPath, v.resolveSymlinks(filepath.Clean(folder))) {
+			return nil
+		}
+	}
+
+	err := fmt.Errorf("access to %s is denied: the path is outside the roots of the MCP client and the allowed folders", absPath)
+	if rootsErr != nil {
+		err = fmt.Errorf("%w (failed to list the roots of the MCP client: %w)", err, rootsErr)
+	}
+	return entities.NewCodedError(entities.ErrorCodePermissionDenied, err)
+}
+
+// resolveSymlinks resolves the symbolic links in path, so that a link inside an allowed folder cannot point outside of it.
+// Paths that cannot be resolved, for example because they do not exist yet, are returned unchanged.
+func (v *PathValidator) resolveSymlinks(path string) string {
+	resolvedPath, err := v.osLayer.EvalSymlinks(path)
+	if err != nil {
+		return path
+	}
+	return resolvedPath
+}
+
+func isWithin(path string, folder string) bool {
+	relativePath, err := filepath.Rel(folder, path)
+	if err != nil {
+		return false
+	}
+	return relativePath != ".." && !strings.HasPrefix(relativePath, ".."+string(filepath.Separator))
+}
+
 func resolveAbsolutePath(filePath string) (string, error) {
 	cleanPath := filepath.Clean(filePath)
 
 	if !filepath.IsAbs(cleanPath) {
-		return "", fmt.Errorf("%s is not a valid absolute path", cleanPath)
+		return "", entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("%s is not a valid absolute path", cleanPath))
 	}
 
 	return cleanPath, nil
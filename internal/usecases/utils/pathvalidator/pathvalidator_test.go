@@ -3,24 +3,32 @@
 package pathvalidator_test
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/pathvalidator"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/clientroots"
 	osfacademocks "github.com/matlab/matlab-mcp-core-server/mocks/facades/osfacade"
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/utils/pathvalidator"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
 func TestNew_HappyPath(t *testing.T) {
 	// Arrange
 	mockOsLayer := &mocks.MockOSLayer{}
+	defer mockOsLayer.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
 
 	// Act
-	validator := pathvalidator.New(mockOsLayer)
+	validator := pathvalidator.New(mockOsLayer, mockConfig)
 
 	// Assert
 	assert.NotNil(t, validator, "New() should return a non-nil Validator")
@@ -31,14 +39,22 @@ func TestValidator_ValidateMATLABScript_HappyPath(t *testing.T) {
 	mockOsLayer := &mocks.MockOSLayer{}
 	defer mockOsLayer.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockFileInfo := &osfacademocks.MockFileInfo{}
 	defer mockFileInfo.AssertExpectations(t)
 
-	validator := pathvalidator.New(mockOsLayer)
+	validator := pathvalidator.New(mockOsLayer, mockConfig)
 
 	testPath, absErr := filepath.Abs("test.m")
 	require.NoError(t, absErr)
 
+	mockConfig.EXPECT().
+		RestrictFileAccess().
+		Return(false).
+		Once()
+
 	mockOsLayer.EXPECT().
 		Stat(testPath).
 		Return(mockFileInfo, nil).
@@ -50,7 +66,7 @@ func TestValidator_ValidateMATLABScript_HappyPath(t *testing.T) {
 		Once()
 
 	// Act
-	result, err := validator.ValidateMATLABScript(testPath)
+	result, err := validator.ValidateMATLABScript(t.Context(), testPath)
 
 	// Assert
 	require.NoError(t, err)
@@ -85,10 +101,13 @@ func TestValidator_ValidateMATLABScript_InvalidPath(t *testing.T) {
 			mockOsLayer := &mocks.MockOSLayer{}
 			defer mockOsLayer.AssertExpectations(t)
 
-			validator := pathvalidator.New(mockOsLayer)
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			validator := pathvalidator.New(mockOsLayer, mockConfig)
 
 			// Act
-			_, err := validator.ValidateMATLABScript(tt.filePath)
+			_, err := validator.ValidateMATLABScript(t.Context(), tt.filePath)
 
 			// Assert
 			require.Error(t, err)
@@ -121,13 +140,16 @@ func TestValidator_ValidateMATLABScript_NotMATLABScript(t *testing.T) {
 			mockOsLayer := &mocks.MockOSLayer{}
 			defer mockOsLayer.AssertExpectations(t)
 
-			validator := pathvalidator.New(mockOsLayer)
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			validator := pathvalidator.New(mockOsLayer, mockConfig)
 
 			filePath, absErr := filepath.Abs(tt.fileName)
 			require.NoError(t, absErr)
 
 			// Act
-			_, err := validator.ValidateMATLABScript(filePath)
+			_, err := validator.ValidateMATLABScript(t.Context(), filePath)
 
 			// Assert
 			require.Error(t, err)
@@ -140,15 +162,23 @@ func TestValidator_ValidateMATLABScript_MPathIsAFolder(t *testing.T) {
 	mockOsLayer := &mocks.MockOSLayer{}
 	defer mockOsLayer.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockFileInfo := &osfacademocks.MockFileInfo{}
 	defer mockFileInfo.AssertExpectations(t)
 
-	validator := pathvalidator.New(mockOsLayer)
+	validator := pathvalidator.New(mockOsLayer, mockConfig)
 
 	// path has .m extension to pass suffix check but is registered as a folder
 	testPath, absErr := filepath.Abs("folder.m")
 	require.NoError(t, absErr)
 
+	mockConfig.EXPECT().
+		RestrictFileAccess().
+		Return(false).
+		Once()
+
 	mockOsLayer.EXPECT().
 		Stat(testPath).
 		Return(mockFileInfo, nil).
@@ -160,7 +190,7 @@ func TestValidator_ValidateMATLABScript_MPathIsAFolder(t *testing.T) {
 		Once()
 
 	// Act
-	_, err := validator.ValidateMATLABScript(testPath)
+	_, err := validator.ValidateMATLABScript(t.Context(), testPath)
 
 	// Assert
 	require.Error(t, err)
@@ -171,20 +201,59 @@ func TestValidator_ValidateMATLABScript_StatFails(t *testing.T) {
 	mockOsLayer := &mocks.MockOSLayer{}
 	defer mockOsLayer.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	testPath, absErr := filepath.Abs("test.m")
 	require.NoError(t, absErr)
 
+	mockConfig.EXPECT().
+		RestrictFileAccess().
+		Return(false).
+		Once()
+
 	mockOsLayer.EXPECT().
 		Stat(testPath).
 		Return(nil, os.ErrNotExist)
 
-	validator := pathvalidator.New(mockOsLayer)
+	validator := pathvalidator.New(mockOsLayer, mockConfig)
 
 	// Act
-	_, err := validator.ValidateMATLABScript(testPath)
+	_, err := validator.ValidateMATLABScript(t.Context(), testPath)
 
 	// Assert
 	require.Error(t, err)
+	assert.Equal(t, entities.ErrorCodeInvalidInput, entities.ErrorCodeOf(err))
+}
+
+func TestValidator_ValidateMATLABScript_PermissionDenied(t *testing.T) {
+	// Arrange
+	mockOsLayer := &mocks.MockOSLayer{}
+	defer mockOsLayer.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	testPath, absErr := filepath.Abs("test.m")
+	require.NoError(t, absErr)
+
+	mockConfig.EXPECT().
+		RestrictFileAccess().
+		Return(false).
+		Once()
+
+	mockOsLayer.EXPECT().
+		Stat(testPath).
+		Return(nil, os.ErrPermission)
+
+	validator := pathvalidator.New(mockOsLayer, mockConfig)
+
+	// Act
+	_, err := validator.ValidateMATLABScript(t.Context(), testPath)
+
+	// Assert
+	require.ErrorIs(t, err, os.ErrPermission)
+	assert.Equal(t, entities.ErrorCodePermissionDenied, entities.ErrorCodeOf(err))
 }
 
 func TestValidator_ValidateMATLABScript_PathCleaning(t *testing.T) {
@@ -219,10 +288,18 @@ func TestValidator_ValidateMATLABScript_PathCleaning(t *testing.T) {
 			mockOsLayer := &mocks.MockOSLayer{}
 			defer mockOsLayer.AssertExpectations(t)
 
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
 			mockFileInfo := &osfacademocks.MockFileInfo{}
 			defer mockFileInfo.AssertExpectations(t)
 
-			validator := pathvalidator.New(mockOsLayer)
+			validator := pathvalidator.New(mockOsLayer, mockConfig)
+
+			mockConfig.EXPECT().
+				RestrictFileAccess().
+				Return(false).
+				Once()
 
 			mockOsLayer.EXPECT().
 				Stat(tt.expected).
@@ -235,7 +312,7 @@ func TestValidator_ValidateMATLABScript_PathCleaning(t *testing.T) {
 				Once()
 
 			// Act
-			result, err := validator.ValidateMATLABScript(tt.filePath)
+			result, err := validator.ValidateMATLABScript(t.Context(), tt.filePath)
 
 			// Assert
 			require.NoError(t, err)
@@ -244,19 +321,106 @@ func TestValidator_ValidateMATLABScript_PathCleaning(t *testing.T) {
 	}
 }
 
+func TestValidator_ValidateFilePath_HappyPath(t *testing.T) {
+	// Arrange
+	mockOsLayer := &mocks.MockOSLayer{}
+	defer mockOsLayer.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFileInfo.AssertExpectations(t)
+
+	validator := pathvalidator.New(mockOsLayer, mockConfig)
+
+	testPath, absErr := filepath.Abs("results.csv")
+	require.NoError(t, absErr)
+
+	mockConfig.EXPECT().
+		RestrictFileAccess().
+		Return(false).
+		Once()
+
+	mockOsLayer.EXPECT().
+		Stat(testPath).
+		Return(mockFileInfo, nil).
+		Once()
+
+	mockFileInfo.EXPECT().
+		IsDir().
+		Return(false).
+		Once()
+
+	// Act
+	result, err := validator.ValidateFilePath(t.Context(), testPath)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, testPath, result)
+}
+
+func TestValidator_ValidateFilePath_FailsForFolderPath(t *testing.T) {
+	// Arrange
+	mockOsLayer := &mocks.MockOSLayer{}
+	defer mockOsLayer.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFileInfo.AssertExpectations(t)
+
+	validator := pathvalidator.New(mockOsLayer, mockConfig)
+
+	testPath, absErr := filepath.Abs("./")
+	require.NoError(t, absErr)
+
+	mockConfig.EXPECT().
+		RestrictFileAccess().
+		Return(false).
+		Once()
+
+	mockOsLayer.EXPECT().
+		Stat(testPath).
+		Return(mockFileInfo, nil).
+		Once()
+
+	mockFileInfo.EXPECT().
+		IsDir().
+		Return(true).
+		Once()
+
+	// Act
+	_, err := validator.ValidateFilePath(t.Context(), testPath)
+
+	// Assert
+	var codedErr *entities.CodedError
+	require.ErrorAs(t, err, &codedErr)
+	assert.Equal(t, entities.ErrorCodeInvalidInput, codedErr.ErrorCode())
+}
+
 func TestValidator_ValidateFolderPath_HappyPath(t *testing.T) {
 	// Arrange
 	mockOsLayer := &mocks.MockOSLayer{}
 	defer mockOsLayer.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockFileInfo := &osfacademocks.MockFileInfo{}
 	defer mockFileInfo.AssertExpectations(t)
 
-	validator := pathvalidator.New(mockOsLayer)
+	validator := pathvalidator.New(mockOsLayer, mockConfig)
 
 	testPath, absErr := filepath.Abs("./")
 	require.NoError(t, absErr)
 
+	mockConfig.EXPECT().
+		RestrictFileAccess().
+		Return(false).
+		Once()
+
 	mockOsLayer.EXPECT().
 		Stat(testPath).
 		Return(mockFileInfo, nil).
@@ -268,7 +432,7 @@ func TestValidator_ValidateFolderPath_HappyPath(t *testing.T) {
 		Once()
 
 	// Act
-	result, err := validator.ValidateFolderPath(testPath)
+	result, err := validator.ValidateFolderPath(t.Context(), testPath)
 
 	// Assert
 	require.NoError(t, err)
@@ -280,12 +444,15 @@ func TestValidator_ValidateFolderPath_FailsForRelativePath(t *testing.T) {
 	mockOsLayer := &mocks.MockOSLayer{}
 	defer mockOsLayer.AssertExpectations(t)
 
-	validator := pathvalidator.New(mockOsLayer)
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	validator := pathvalidator.New(mockOsLayer, mockConfig)
 
 	testPath := filepath.Join(".", "relative", "folder")
 
 	// Act
-	_, err := validator.ValidateFolderPath(testPath)
+	_, err := validator.ValidateFolderPath(t.Context(), testPath)
 
 	// Assert
 	require.Error(t, err)
@@ -296,14 +463,22 @@ func TestValidator_ValidateFolderPath_FailsForFilePath(t *testing.T) {
 	mockOsLayer := &mocks.MockOSLayer{}
 	defer mockOsLayer.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockFileInfo := &osfacademocks.MockFileInfo{}
 	defer mockFileInfo.AssertExpectations(t)
 
-	validator := pathvalidator.New(mockOsLayer)
+	validator := pathvalidator.New(mockOsLayer, mockConfig)
 
 	testPath, absErr := filepath.Abs("test.m")
 	require.NoError(t, absErr)
 
+	mockConfig.EXPECT().
+		RestrictFileAccess().
+		Return(false).
+		Once()
+
 	mockOsLayer.EXPECT().
 		Stat(testPath).
 		Return(mockFileInfo, nil).
@@ -315,7 +490,7 @@ func TestValidator_ValidateFolderPath_FailsForFilePath(t *testing.T) {
 		Once()
 
 	// Act
-	_, err := validator.ValidateFolderPath(testPath)
+	_, err := validator.ValidateFolderPath(t.Context(), testPath)
 
 	// Assert
 	require.Error(t, err)
@@ -326,18 +501,186 @@ func TestValidator_ValidateFolderPath_StatFails(t *testing.T) {
 	mockOsLayer := &mocks.MockOSLayer{}
 	defer mockOsLayer.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	testPath, absErr := filepath.Abs("./")
 	require.NoError(t, absErr)
 
+	mockConfig.EXPECT().
+		RestrictFileAccess().
+		Return(false).
+		Once()
+
 	mockOsLayer.EXPECT().
 		Stat(testPath).
 		Return(nil, os.ErrNotExist)
 
-	validator := pathvalidator.New(mockOsLayer)
+	validator := pathvalidator.New(mockOsLayer, mockConfig)
+
+	// Act
+	_, err := validator.ValidateFolderPath(t.Context(), testPath)
+
+	// Assert
+	require.Error(t, err)
+}
+
+func TestValidator_ValidateFolderPath_RestrictedFileAccess(t *testing.T) {
+	rootFolder := filepath.Join(string(filepath.Separator), "home", "user", "project")
+	allowedFolder := filepath.Join(string(filepath.Separator), "data")
+
+	testCases := []struct {
+		name        string
+		path        string
+		expectedErr bool
+	}{
+		{name: "client root", path: rootFolder, expectedErr: false},
+		{name: "inside client root", path: filepath.Join(rootFolder, "src"), expectedErr: false},
+		{name: "inside allowed folder", path: filepath.Join(allowedFolder, "set1"), expectedErr: false},
+		{name: "outside allowed folders", path: filepath.Join(string(filepath.Separator), "etc"), expectedErr: true},
+		{name: "sibling with common prefix", path: rootFolder + "-secrets", expectedErr: true},
+		{name: "parent of client root", path: filepath.Dir(rootFolder), expectedErr: true},
+		{name: "escape with dot dot", path: filepath.Join(rootFolder, "..", "other"), expectedErr: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockOsLayer := &mocks.MockOSLayer{}
+			defer mockOsLayer.AssertExpectations(t)
+
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockFileInfo := &osfacademocks.MockFileInfo{}
+
+			mockConfig.EXPECT().
+				RestrictFileAccess().
+				Return(true).
+				Once()
+
+			mockConfig.EXPECT().
+				AllowedFolders().
+				Return([]string{allowedFolder}).
+				Once()
+
+			mockOsLayer.EXPECT().
+				EvalSymlinks(mock.AnythingOfType("string")).
+				RunAndReturn(func(path string) (string, error) { return path, nil })
+
+			if !testCase.expectedErr {
+				mockOsLayer.EXPECT().
+					Stat(testCase.path).
+					Return(mockFileInfo, nil).
+					Once()
+
+				mockFileInfo.EXPECT().
+					IsDir().
+					Return(true).
+					Once()
+			}
+
+			ctx := clientroots.NewContext(t.Context(), func(ctx context.Context) ([]string, error) {
+				return []string{rootFolder}, nil
+			})
+
+			validator := pathvalidator.New(mockOsLayer, mockConfig)
+
+			// Act
+			_, err := validator.ValidateFolderPath(ctx, testCase.path)
+
+			// Assert
+			if testCase.expectedErr {
+				require.Error(t, err)
+				assert.Equal(t, entities.ErrorCodePermissionDenied, entities.ErrorCodeOf(err))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidator_ValidateMATLABScript_RestrictedFileAccess_SymlinkOutsideRoot(t *testing.T) {
+	// Arrange
+	mockOsLayer := &mocks.MockOSLayer{}
+	defer mockOsLayer.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	rootFolder := filepath.Join(string(filepath.Separator), "home", "user", "project")
+	scriptPath := filepath.Join(rootFolder, "link.m")
+	linkTarget := filepath.Join(string(filepath.Separator), "etc", "script.m")
+
+	mockConfig.EXPECT().
+		RestrictFileAccess().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		AllowedFolders().
+		Return(nil).
+		Once()
+
+	mockOsLayer.EXPECT().
+		EvalSymlinks(scriptPath).
+		Return(linkTarget, nil).
+		Once()
+
+	mockOsLayer.EXPECT().
+		EvalSymlinks(rootFolder).
+		Return(rootFolder, nil).
+		Once()
+
+	ctx := clientroots.NewContext(t.Context(), func(ctx context.Context) ([]string, error) {
+		return []string{rootFolder}, nil
+	})
+
+	validator := pathvalidator.New(mockOsLayer, mockConfig)
 
 	// Act
-	_, err := validator.ValidateFolderPath(testPath)
+	_, err := validator.ValidateMATLABScript(ctx, scriptPath)
 
 	// Assert
 	require.Error(t, err)
+	assert.Equal(t, entities.ErrorCodePermissionDenied, entities.ErrorCodeOf(err))
+}
+
+func TestValidator_ValidateFolderPath_RestrictedFileAccess_ListRootsError(t *testing.T) {
+	// Arrange
+	mockOsLayer := &mocks.MockOSLayer{}
+	defer mockOsLayer.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	folderPath := filepath.Join(string(filepath.Separator), "home", "user", "project")
+
+	mockConfig.EXPECT().
+		RestrictFileAccess().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		AllowedFolders().
+		Return(nil).
+		Once()
+
+	mockOsLayer.EXPECT().
+		EvalSymlinks(folderPath).
+		Return(folderPath, nil).
+		Once()
+
+	ctx := clientroots.NewContext(t.Context(), func(ctx context.Context) ([]string, error) {
+		return nil, assert.AnError
+	})
+
+	validator := pathvalidator.New(mockOsLayer, mockConfig)
+
+	// Act
+	_, err := validator.ValidateFolderPath(ctx, folderPath)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Equal(t, entities.ErrorCodePermissionDenied, entities.ErrorCodeOf(err))
 }
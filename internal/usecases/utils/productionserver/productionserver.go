@@ -0,0 +1,225 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package productionserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+const requestTimeout = 60 * time.Second
+
+// namePattern are the valid names of archives and functions, which are MATLAB identifiers.
+var namePattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]{0,62}$`)
+
+// maxResponseBytes bounds the responses of the server read into memory.
+const maxResponseBytes = 16 * 1024 * 1024
+
+type Config interface {
+	ProductionServerURL() string
+}
+
+type HTTPClientFactory interface {
+	NewClient(timeout time.Duration) *http.Client
+}
+
+// Health is whether the MATLAB Production Server instance is up, as reported by its health endpoint.
+type Health struct {
+	Healthy    bool
+	HTTPStatus int
+	Status     string
+}
+
+// Archive is a deployable archive deployed to the MATLAB Production Server instance, with its functions.
+type Archive struct {
+	Name                 string
+	MATLABRuntimeVersion string
+	Functions            []string
+}
+
+// InvocationResult is the outputs of a function deployed to the MATLAB Production Server instance, or the MATLAB
+// error it threw.
+type InvocationResult struct {
+	Outputs []json.RawMessage
+	Error   *MATLABError
+}
+
+type MATLABError struct {
+	ID      string `json:"id"`
+	Message string `json:"message"`
+}
+
+// ErrDiscoveryDisabled is returned when the discovery service of the instance is not enabled, so that its archives
+// cannot be listed.
+var ErrDiscoveryDisabled = errors.New("the discovery service of the MATLAB Production Server instance is not enabled")
+
+// ValidateName returns an error unless name is a valid name of an archive or of a function, so that it can be part of
+// the URL of a function.
+func ValidateName(name string) error {
+	if !namePattern.MatchString(name) {
+		return entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("%q is not a valid archive or function name: use a MATLAB identifier", name))
+	}
+	return nil
+}
+
+// Client calls the RESTful API of the MATLAB Production Server instance of the configuration.
+type Client struct {
+	config     Config
+	httpClient *http.Client
+}
+
+func New(
+	config Config,
+	httpClientFactory HTTPClientFactory,
+) *Client {
+	return &Client{
+		config:     config,
+		httpClient: httpClientFactory.NewClient(requestTimeout),
+	}
+}
+
+// Health calls the health endpoint of the instance. An instance that answers with an error status is unhealthy,
+// while an instance that cannot be reached is an error.
+func (c *Client) Health(ctx context.Context) (Health, error) {
+	statusCode, body, err := c.do(ctx, http.MethodGet, "/api/health", nil)
+	if err != nil {
+		return Health{}, err
+	}
+
+	var response struct {
+		Status string `json:"status"`
+	}
+	_ = json.Unmarshal(body, &response)
+
+	return Health{
+		Healthy:    statusCode == http.StatusOK,
+		HTTPStatus: statusCode,
+		Status:     response.Status,
+	}, nil
+}
+
+// Archives lists the archives deployed to the instance, sorted by name, with their functions, through its discovery
+// service.
+func (c *Client) Archives(ctx context.Context) ([]Archive, error) {
+	statusCode, body, err := c.do(ctx, http.MethodGet, "/api/discovery", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode == http.StatusNotFound {
+		return nil, ErrDiscoveryDisabled
+	}
+
+	if statusCode != http.StatusOK {
+		return nil, fmt.Errorf("the discovery service of the MATLAB Production Server instance failed with status %d", statusCode)
+	}
+
+	var response struct {
+		Archives map[string]struct {
+			MATLABRuntimeVersion string                     `json:"matlabRuntimeVersion"`
+			Functions            map[string]json.RawMessage `json:"functions"`
+		} `json:"archives"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse the discovery response of the MATLAB Production Server instance: %w", err)
+	}
+
+	archives := make([]Archive, 0, len(response.Archives))
+	for name, archive := range response.Archives {
+		functions := make([]string, 0, len(archive.Functions))
+		for function := range archive.Functions {
+			functions = append(functions, function)
+		}
+		sort.Strings(functions)
+
+		archives = append(archives, Archive{
+			Name:                 name,
+			MATLABRuntimeVersion: archive.MATLABRuntimeVersion,
+			Functions:            functions,
+		})
+	}
+
+	sort.Slice(archives, func(i, j int) bool {
+		return archives[i].Name < archives[j].Name
+	})
+
+	return archives, nil
+}
+
+// Invoke calls a function of a deployed archive with the given inputs, using the JSON representation of MATLAB data
+// of MATLAB Production Server. A MATLAB error thrown by the function is returned in the result, not as an error.
+func (c *Client) Invoke(ctx context.Context, archive string, function string, inputs []json.RawMessage, numOutputs int) (InvocationResult, error) {
+	if inputs == nil {
+		inputs = []json.RawMessage{}
+	}
+
+	request, err := json.Marshal(map[string]any{
+		"nargout": numOutputs,
+		"rhs":     inputs,
+		"outputFormat": map[string]string{
+			"mode":         "small",
+			"nanInfFormat": "string",
+		},
+	})
+	if err != nil {
+		return InvocationResult{}, err
+	}
+
+	statusCode, body, err := c.do(ctx, http.MethodPost, "/"+url.PathEscape(archive)+"/"+url.PathEscape(function), request)
+	if err != nil {
+		return InvocationResult{}, err
+	}
+
+	var response struct {
+		LHS   []json.RawMessage `json:"lhs"`
+		Error *MATLABError      `json:"error"`
+	}
+	parseErr := json.Unmarshal(body, &response)
+
+	switch {
+	case parseErr == nil && response.Error != nil:
+		return InvocationResult{Error: response.Error}, nil
+	case statusCode == http.StatusNotFound:
+		return InvocationResult{}, entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("there is no function %s in a deployed archive %s", function, archive))
+	case statusCode != http.StatusOK:
+		return InvocationResult{}, fmt.Errorf("the MATLAB Production Server instance failed with status %d: %s", statusCode, bytes.TrimSpace(body))
+	case parseErr != nil:
+		return InvocationResult{}, fmt.Errorf("failed to parse the response of the MATLAB Production Server instance: %w", parseErr)
+	}
+
+	return InvocationResult{Outputs: response.LHS}, nil
+}
+
+func (c *Client) do(ctx context.Context, method string, path string, body []byte) (int, []byte, error) {
+	request, err := http.NewRequestWithContext(ctx, method, c.config.ProductionServerURL()+path, bytes.NewReader(body))
+	if err != nil {
+		return 0, nil, err
+	}
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to reach the MATLAB Production Server instance: %w", err)
+	}
+	defer response.Body.Close() //nolint:errcheck // Read-only body
+
+	responseBody, err := io.ReadAll(io.LimitReader(response.Body, maxResponseBytes))
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to read the response of the MATLAB Production Server instance: %w", err)
+	}
+
+	return response.StatusCode, responseBody, nil
+}
@@ -0,0 +1,187 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package productionserver_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/productionserver"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/utils/productionserver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newClient(t *testing.T, handler http.HandlerFunc) *productionserver.Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	mockConfig := &mocks.MockConfig{}
+	t.Cleanup(func() { mockConfig.AssertExpectations(t) })
+
+	mockHTTPClientFactory := &mocks.MockHTTPClientFactory{}
+	t.Cleanup(func() { mockHTTPClientFactory.AssertExpectations(t) })
+
+	mockConfig.EXPECT().
+		ProductionServerURL().
+		Return(server.URL)
+
+	mockHTTPClientFactory.EXPECT().
+		NewClient(mock.Anything).
+		Return(&http.Client{}).
+		Once()
+
+	return productionserver.New(mockConfig, mockHTTPClientFactory)
+}
+
+func TestValidateName(t *testing.T) {
+	for name, valid := range map[string]bool{
+		"pricing":       true,
+		"price_v2":      true,
+		"":              false,
+		"2pricing":      false,
+		"pricing-v2":    false,
+		"../api/health": false,
+	} {
+		err := productionserver.ValidateName(name)
+		if valid {
+			assert.NoError(t, err, name)
+		} else {
+			assert.Error(t, err, name)
+		}
+	}
+}
+
+func TestClient_Health(t *testing.T) {
+	testConfigs := []struct {
+		name           string
+		statusCode     int
+		expectedHealth productionserver.Health
+	}{
+		{
+			name:           "healthy",
+			statusCode:     http.StatusOK,
+			expectedHealth: productionserver.Health{Healthy: true, HTTPStatus: http.StatusOK, Status: "ok"},
+		},
+		{
+			name:           "unhealthy",
+			statusCode:     http.StatusServiceUnavailable,
+			expectedHealth: productionserver.Health{Healthy: false, HTTPStatus: http.StatusServiceUnavailable, Status: "ok"},
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/health", r.URL.Path)
+				w.WriteHeader(testConfig.statusCode)
+				_, _ = w.Write([]byte(`{"status":"ok"}`))
+			})
+
+			// Act
+			health, err := client.Health(t.Context())
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, testConfig.expectedHealth, health)
+		})
+	}
+}
+
+func TestClient_Archives_HappyPath(t *testing.T) {
+	// Arrange
+	client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/discovery", r.URL.Path)
+		_, _ = w.Write([]byte(`{"archives":{
+			"risk":{"matlabRuntimeVersion":"R2025a","functions":{"var":{},"cvar":{}}},
+			"pricing":{"matlabRuntimeVersion":"R2025a","functions":{"price":{}}}
+		}}`))
+	})
+
+	// Act
+	archives, err := client.Archives(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []productionserver.Archive{
+		{Name: "pricing", MATLABRuntimeVersion: "R2025a", Functions: []string{"price"}},
+		{Name: "risk", MATLABRuntimeVersion: "R2025a", Functions: []string{"cvar", "var"}},
+	}, archives)
+}
+
+func TestClient_Archives_DiscoveryDisabled(t *testing.T) {
+	// Arrange
+	client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	// Act
+	_, err := client.Archives(t.Context())
+
+	// Assert
+	require.ErrorIs(t, err, productionserver.ErrDiscoveryDisabled)
+}
+
+func TestClient_Invoke_HappyPath(t *testing.T) {
+	// Arrange
+	client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/pricing/price", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"nargout":2,"rhs":[100,"call"],"outputFormat":{"mode":"small","nanInfFormat":"string"}}`, string(body))
+
+		_, _ = w.Write([]byte(`{"lhs":[12.5,"USD"]}`))
+	})
+
+	// Act
+	result, err := client.Invoke(t.Context(), "pricing", "price", []json.RawMessage{json.RawMessage(`100`), json.RawMessage(`"call"`)}, 2)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, productionserver.InvocationResult{
+		Outputs: []json.RawMessage{json.RawMessage(`12.5`), json.RawMessage(`"USD"`)},
+	}, result)
+}
+
+func TestClient_Invoke_MATLABError(t *testing.T) {
+	// Arrange
+	client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"error":{"id":"pricing:invalidStrike","message":"Strike must be positive."}}`))
+	})
+
+	// Act
+	result, err := client.Invoke(t.Context(), "pricing", "price", nil, 1)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, productionserver.InvocationResult{
+		Error: &productionserver.MATLABError{ID: "pricing:invalidStrike", Message: "Strike must be positive."},
+	}, result)
+}
+
+func TestClient_Invoke_UnknownFunction(t *testing.T) {
+	// Arrange
+	client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	// Act
+	_, err := client.Invoke(t.Context(), "pricing", "unknown", nil, 1)
+
+	// Assert
+	var codedErr *entities.CodedError
+	require.ErrorAs(t, err, &codedErr)
+	assert.Equal(t, entities.ErrorCodeInvalidInput, codedErr.ErrorCode())
+}
@@ -0,0 +1,69 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package pythonenv
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+type ExecutionMode string
+
+const (
+	ExecutionModeInProcess    ExecutionMode = "InProcess"
+	ExecutionModeOutOfProcess ExecutionMode = "OutOfProcess"
+)
+
+// Environment is the Python environment of the MATLAB session, as pyenv shows it. Status is NotLoaded until Python is
+// first used, and ProcessID is only set while Python is loaded.
+type Environment struct {
+	Version       string        `json:"version"`
+	Executable    string        `json:"executable"`
+	Library       string        `json:"library"`
+	Home          string        `json:"home"`
+	Status        string        `json:"status"`
+	ExecutionMode ExecutionMode `json:"executionMode"`
+	ProcessID     string        `json:"processID"`
+}
+
+// Get returns the Python environment of the MATLAB session, without loading Python.
+func Get(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) (Environment, error) {
+	return call(ctx, logger, client, []string{})
+}
+
+// Set sets the Python version, the path of a Python executable or a version number, and the execution mode of the
+// MATLAB session, and returns the resulting environment. Empty values keep the current setting. Python is loaded
+// with the new settings when it is next used.
+func Set(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, version string, executionMode ExecutionMode) (Environment, error) {
+	return call(ctx, logger, client, []string{version, string(executionMode)})
+}
+
+func call(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, arguments []string) (Environment, error) {
+	response, err := client.FEval(ctx, logger, entities.FEvalRequest{
+		Function:   "matlab_mcp.pythonEnvironment",
+		Arguments:  arguments,
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return Environment{}, err
+	}
+
+	if len(response.Outputs) != 1 {
+		return Environment{}, fmt.Errorf("unexpected number of outputs from MATLAB session")
+	}
+
+	output, ok := response.Outputs[0].(string)
+	if !ok {
+		return Environment{}, fmt.Errorf("failed to cast output to string")
+	}
+
+	var environment Environment
+	if err := json.Unmarshal([]byte(output), &environment); err != nil {
+		return Environment{}, fmt.Errorf("failed to parse Python environment: %w", err)
+	}
+
+	return environment, nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package pythonenv_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/pythonenv"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const environmentJSON = `{"version":"3.11","executable":"/usr/bin/python3","library":"libpython3.11.so.1.0","home":"/usr","status":"Loaded","executionMode":"OutOfProcess","processID":"4242"}`
+
+func TestGet_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.pythonEnvironment",
+			Arguments:  []string{},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{environmentJSON}}, nil).
+		Once()
+
+	// Act
+	environment, err := pythonenv.Get(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, pythonenv.Environment{
+		Version:       "3.11",
+		Executable:    "/usr/bin/python3",
+		Library:       "libpython3.11.so.1.0",
+		Home:          "/usr",
+		Status:        "Loaded",
+		ExecutionMode: pythonenv.ExecutionModeOutOfProcess,
+		ProcessID:     "4242",
+	}, environment)
+}
+
+func TestSet_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.pythonEnvironment",
+			Arguments:  []string{"/home/user/venv/bin/python", "OutOfProcess"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{environmentJSON}}, nil).
+		Once()
+
+	// Act
+	environment, err := pythonenv.Set(ctx, mockLogger, mockClient, "/home/user/venv/bin/python", pythonenv.ExecutionModeOutOfProcess)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "3.11", environment.Version)
+}
+
+func TestGet_Errors(t *testing.T) {
+	testConfigs := []struct {
+		name     string
+		response entities.FEvalResponse
+		err      error
+	}{
+		{
+			name: "FEval error",
+			err:  assert.AnError,
+		},
+		{
+			name:     "no output",
+			response: entities.FEvalResponse{},
+		},
+		{
+			name:     "output is not a string",
+			response: entities.FEvalResponse{Outputs: []any{42.0}},
+		},
+		{
+			name:     "output is not JSON",
+			response: entities.FEvalResponse{Outputs: []any{"Python not found"}},
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			ctx := t.Context()
+
+			mockClient.EXPECT().
+				FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+					Function:   "matlab_mcp.pythonEnvironment",
+					Arguments:  []string{},
+					NumOutputs: 1,
+				}).
+				Return(testConfig.response, testConfig.err).
+				Once()
+
+			// Act
+			environment, err := pythonenv.Get(ctx, mockLogger, mockClient)
+
+			// Assert
+			require.Error(t, err)
+			assert.Empty(t, environment)
+		})
+	}
+}
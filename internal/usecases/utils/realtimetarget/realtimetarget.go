@@ -0,0 +1,62 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package realtimetarget
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// Status is the status of a Simulink Real-Time target computer, after an action.
+type Status struct {
+	Target      string `json:"target"`
+	Connected   bool   `json:"connected"`
+	Loaded      bool   `json:"loaded"`
+	Running     bool   `json:"running"`
+	Application string `json:"application"`
+}
+
+// ValidateTarget returns an error unless target is one of the configured targets, so that the tools cannot drive
+// hardware the user did not choose.
+func ValidateTarget(targets []string, target string) error {
+	if target == "" {
+		return entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("no target given: use one of %v", targets))
+	}
+
+	if !slices.Contains(targets, target) {
+		return entities.NewCodedError(entities.ErrorCodePermissionDenied, fmt.Errorf("%q is not one of the real-time targets the server may use: %v", target, targets))
+	}
+
+	return nil
+}
+
+// Call runs an action of matlab_mcp.realTimeTarget in the MATLAB session, and parses its JSON result into result.
+func Call(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, arguments []string, result any) error {
+	response, err := client.FEval(ctx, logger, entities.FEvalRequest{
+		Function:   "matlab_mcp.realTimeTarget",
+		Arguments:  arguments,
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(response.Outputs) != 1 {
+		return fmt.Errorf("unexpected number of outputs from MATLAB session")
+	}
+
+	output, ok := response.Outputs[0].(string)
+	if !ok {
+		return fmt.Errorf("failed to cast output to string")
+	}
+
+	if err := json.Unmarshal([]byte(output), result); err != nil {
+		return fmt.Errorf("failed to parse the result of the real-time target: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package realtimetarget_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/realtimetarget"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTarget(t *testing.T) {
+	testConfigs := []struct {
+		name              string
+		target            string
+		expectedErrorCode entities.ErrorCode
+	}{
+		{
+			name:   "configured target",
+			target: "rig1",
+		},
+		{
+			name:              "no target",
+			target:            "",
+			expectedErrorCode: entities.ErrorCodeInvalidInput,
+		},
+		{
+			name:              "other target",
+			target:            "production",
+			expectedErrorCode: entities.ErrorCodePermissionDenied,
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Act
+			err := realtimetarget.ValidateTarget([]string{"rig1", "rig2"}, testConfig.target)
+
+			// Assert
+			if testConfig.expectedErrorCode == "" {
+				require.NoError(t, err)
+				return
+			}
+			assert.Equal(t, testConfig.expectedErrorCode, entities.ErrorCodeOf(err))
+		})
+	}
+}
+
+func TestCall_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.realTimeTarget",
+			Arguments:  []string{"stop", "rig1"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`{"status":{"target":"rig1","connected":true,"loaded":true,"running":false,"application":"controller"}}`}}, nil).
+		Once()
+
+	var result struct {
+		Status realtimetarget.Status `json:"status"`
+	}
+
+	// Act
+	err := realtimetarget.Call(ctx, mockLogger, mockClient, []string{"stop", "rig1"}, &result)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, realtimetarget.Status{
+		Target:      "rig1",
+		Connected:   true,
+		Loaded:      true,
+		Running:     false,
+		Application: "controller",
+	}, result.Status)
+}
+
+func TestCall_Errors(t *testing.T) {
+	testConfigs := []struct {
+		name     string
+		response entities.FEvalResponse
+	}{
+		{
+			name:     "no output",
+			response: entities.FEvalResponse{Outputs: []any{}},
+		},
+		{
+			name:     "output not a string",
+			response: entities.FEvalResponse{Outputs: []any{42.0}},
+		},
+		{
+			name:     "output not JSON",
+			response: entities.FEvalResponse{Outputs: []any{"not json"}},
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			ctx := t.Context()
+
+			mockClient.EXPECT().
+				FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+					Function:   "matlab_mcp.realTimeTarget",
+					Arguments:  []string{"stop", "rig1"},
+					NumOutputs: 1,
+				}).
+				Return(testConfig.response, nil).
+				Once()
+
+			var result struct{}
+
+			// Act
+			err := realtimetarget.Call(ctx, mockLogger, mockClient, []string{"stop", "rig1"}, &result)
+
+			// Assert
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package sessiontranscript
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxCells is the number of cells kept. Older cells are dropped, and counted, so that an export can tell that the
+// beginning of the session is missing.
+const maxCells = 200
+
+// Figure is an image returned by a call that ran code.
+type Figure struct {
+	MIMEType string
+	Data     []byte
+}
+
+// Cell is a call that ran code, with the MATLAB code that reproduces it, and what the call returned.
+type Cell struct {
+	Time time.Time
+	Tool string
+	// ProjectPath is the working folder the code ran in, if the tool sets one.
+	ProjectPath string
+	Code        string
+	Output      string
+	Figures     []Figure
+	// Failed is set when the call failed or was rejected, in which case the code may not have run, or only in part.
+	Failed bool
+}
+
+type codeArguments struct {
+	ProjectPath string `json:"project_path"`
+	Code        string `json:"code"`
+	ScriptPath  string `json:"script_path"`
+}
+
+// Transcript keeps, in memory, the calls of the tools that run code in the MATLAB session, so that what the AI
+// application did can be exported as a script. The calls of the other tools are not kept.
+type Transcript struct {
+	now func() time.Time
+
+	lock    *sync.Mutex
+	cells   []Cell
+	dropped int
+}
+
+func New() *Transcript {
+	return &Transcript{
+		now: time.Now,
+
+		lock: new(sync.Mutex),
+	}
+}
+
+// Record adds a tool call to the transcript, if the tool runs code. The arguments are those of the call, from which
+// the MATLAB code that reproduces it is derived.
+func (t *Transcript) Record(tool string, arguments json.RawMessage, output string, figures []Figure, failed bool) {
+	var args codeArguments
+	if len(arguments) > 0 {
+		if err := json.Unmarshal(arguments, &args); err != nil {
+			return
+		}
+	}
+
+	code, ok := codeOf(tool, args)
+	if !ok {
+		return
+	}
+
+	cell := Cell{
+		Time:        t.now(),
+		Tool:        tool,
+		ProjectPath: args.ProjectPath,
+		Code:        code,
+		Output:      output,
+		Figures:     figures,
+		Failed:      failed,
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.cells = append(t.cells, cell)
+	if len(t.cells) > maxCells {
+		t.dropped += len(t.cells) - maxCells
+		t.cells = append([]Cell(nil), t.cells[len(t.cells)-maxCells:]...)
+	}
+}
+
+// Cells returns the cells of the transcript, oldest first, and the number of older cells that were dropped.
+func (t *Transcript) Cells() ([]Cell, int) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return append([]Cell(nil), t.cells...), t.dropped
+}
+
+// codeOf returns the MATLAB code that reproduces a call of a tool, and false if the tool does not run code.
+func codeOf(tool string, args codeArguments) (string, bool) {
+	switch tool {
+	case "evaluate_matlab_code":
+		return args.Code, args.Code != ""
+	case "run_matlab_file":
+		return fmt.Sprintf("run(%s)", quote(args.ScriptPath)), args.ScriptPath != ""
+	case "run_matlab_test_file":
+		return fmt.Sprintf("runtests(%s)", quote(args.ScriptPath)), args.ScriptPath != ""
+	case "run_python_code":
+		if args.Code == "" {
+			return "", false
+		}
+		lines := strings.Split(args.Code, "\n")
+		quoted := make([]string, 0, len(lines))
+		for _, line := range lines {
+			quoted = append(quoted, `"`+strings.ReplaceAll(line, `"`, `""`)+`"`)
+		}
+		return fmt.Sprintf("pyrun([%s])", strings.Join(quoted, " ...\n    ")), true
+	default:
+		return "", false
+	}
+}
+
+// quote returns a MATLAB character vector literal of a text.
+func quote(text string) string {
+	return "'" + strings.ReplaceAll(text, "'", "''") + "'"
+}
@@ -0,0 +1,11 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package sessiontranscript
+
+import "time"
+
+const MaxCells = maxCells
+
+func (t *Transcript) SetNow(now func() time.Time) {
+	t.now = now
+}
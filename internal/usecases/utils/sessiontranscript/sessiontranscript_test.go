@@ -0,0 +1,75 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package sessiontranscript_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/sessiontranscript"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var recordedAt = time.Date(2025, 6, 1, 9, 30, 0, 0, time.UTC)
+
+func newTranscript() *sessiontranscript.Transcript {
+	transcript := sessiontranscript.New()
+	transcript.SetNow(func() time.Time { return recordedAt })
+	return transcript
+}
+
+func TestTranscript_Record_HappyPath(t *testing.T) {
+	// Arrange
+	transcript := newTranscript()
+	figures := []sessiontranscript.Figure{{MIMEType: "image/png", Data: []byte("png")}}
+
+	// Act
+	transcript.Record("evaluate_matlab_code", json.RawMessage(`{"code":"plot(1:10)","project_path":"/home/user/project"}`), "", figures, false)
+	transcript.Record("run_matlab_file", json.RawMessage(`{"script_path":"/home/user/it's/analysis.m"}`), "Done\n", nil, false)
+	transcript.Record("run_matlab_test_file", json.RawMessage(`{"script_path":"/home/user/project/testFoo.m"}`), "", nil, true)
+	transcript.Record("run_python_code", json.RawMessage(`{"code":"print(\"a\")\nx = 1"}`), "a\n", nil, false)
+
+	// Assert
+	cells, dropped := transcript.Cells()
+	assert.Zero(t, dropped)
+	assert.Equal(t, []sessiontranscript.Cell{
+		{Time: recordedAt, Tool: "evaluate_matlab_code", ProjectPath: "/home/user/project", Code: "plot(1:10)", Figures: figures},
+		{Time: recordedAt, Tool: "run_matlab_file", Code: "run('/home/user/it''s/analysis.m')", Output: "Done\n"},
+		{Time: recordedAt, Tool: "run_matlab_test_file", Code: "runtests('/home/user/project/testFoo.m')", Failed: true},
+		{Time: recordedAt, Tool: "run_python_code", Code: "pyrun([\"print(\"\"a\"\")\" ...\n    \"x = 1\"])", Output: "a\n"},
+	}, cells)
+}
+
+func TestTranscript_Record_IgnoresOtherTools(t *testing.T) {
+	// Arrange
+	transcript := newTranscript()
+
+	// Act
+	transcript.Record("check_matlab_code", json.RawMessage(`{"script_path":"/home/user/project/analysis.m"}`), "No issues", nil, false)
+	transcript.Record("evaluate_matlab_code", json.RawMessage(`{"project_path":"/home/user/project"}`), "", nil, true)
+	transcript.Record("evaluate_matlab_code", json.RawMessage(`not json`), "", nil, true)
+
+	// Assert
+	cells, dropped := transcript.Cells()
+	assert.Empty(t, cells)
+	assert.Zero(t, dropped)
+}
+
+func TestTranscript_Record_DropsOldestCells(t *testing.T) {
+	// Arrange
+	transcript := newTranscript()
+
+	// Act
+	for i := range sessiontranscript.MaxCells + 3 {
+		transcript.Record("evaluate_matlab_code", json.RawMessage(fmt.Sprintf(`{"code":"x = %d"}`, i)), "", nil, false)
+	}
+
+	// Assert
+	cells, dropped := transcript.Cells()
+	require.Len(t, cells, sessiontranscript.MaxCells)
+	assert.Equal(t, 3, dropped)
+	assert.Equal(t, "x = 3", cells[0].Code)
+}
@@ -0,0 +1,50 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package simulinkmodel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// CheckModelPath returns an error unless modelPath names a Simulink model file.
+func CheckModelPath(modelPath string) error {
+	switch strings.ToLower(filepath.Ext(modelPath)) {
+	case ".slx", ".mdl":
+		return nil
+	default:
+		return entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("%s is not a Simulink model: use a .slx or .mdl file", modelPath))
+	}
+}
+
+// Call runs an action of matlab_mcp.simulinkModel in the MATLAB session, and parses its JSON result into result.
+func Call(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, arguments []string, result any) error {
+	response, err := client.FEval(ctx, logger, entities.FEvalRequest{
+		Function:   "matlab_mcp.simulinkModel",
+		Arguments:  arguments,
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(response.Outputs) != 1 {
+		return fmt.Errorf("unexpected number of outputs from MATLAB session")
+	}
+
+	output, ok := response.Outputs[0].(string)
+	if !ok {
+		return fmt.Errorf("failed to cast output to string")
+	}
+
+	if err := json.Unmarshal([]byte(output), result); err != nil {
+		return fmt.Errorf("failed to parse the result of the Simulink model: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package simulinkmodel_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/simulinkmodel"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckModelPath(t *testing.T) {
+	testConfigs := []struct {
+		name        string
+		modelPath   string
+		expectError bool
+	}{
+		{
+			name:      "SLX file",
+			modelPath: "/home/user/models/plant.slx",
+		},
+		{
+			name:      "MDL file in capitals",
+			modelPath: "/home/user/models/plant.MDL",
+		},
+		{
+			name:        "MATLAB file",
+			modelPath:   "/home/user/models/plant.m",
+			expectError: true,
+		},
+		{
+			name:        "no extension",
+			modelPath:   "plant",
+			expectError: true,
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Act
+			err := simulinkmodel.CheckModelPath(testConfig.modelPath)
+
+			// Assert
+			if !testConfig.expectError {
+				require.NoError(t, err)
+				return
+			}
+			assert.Equal(t, entities.ErrorCodeInvalidInput, entities.ErrorCodeOf(err))
+		})
+	}
+}
+
+func TestCall_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.simulinkModel",
+			Arguments:  []string{"load", "/home/user/models/plant.slx", "500"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`{"name":"plant","solver":"ode45"}`}}, nil).
+		Once()
+
+	var result struct {
+		Name   string `json:"name"`
+		Solver string `json:"solver"`
+	}
+
+	// Act
+	err := simulinkmodel.Call(ctx, mockLogger, mockClient, []string{"load", "/home/user/models/plant.slx", "500"}, &result)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "plant", result.Name)
+	assert.Equal(t, "ode45", result.Solver)
+}
+
+func TestCall_Errors(t *testing.T) {
+	testConfigs := []struct {
+		name     string
+		response entities.FEvalResponse
+	}{
+		{
+			name:     "no output",
+			response: entities.FEvalResponse{Outputs: []any{}},
+		},
+		{
+			name:     "output not a string",
+			response: entities.FEvalResponse{Outputs: []any{42.0}},
+		},
+		{
+			name:     "output not JSON",
+			response: entities.FEvalResponse{Outputs: []any{"not json"}},
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			ctx := t.Context()
+
+			mockClient.EXPECT().
+				FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+					Function:   "matlab_mcp.simulinkModel",
+					Arguments:  []string{"load", "/home/user/models/plant.slx", "500"},
+					NumOutputs: 1,
+				}).
+				Return(testConfig.response, nil).
+				Once()
+
+			var result struct{}
+
+			// Act
+			err := simulinkmodel.Call(ctx, mockLogger, mockClient, []string{"load", "/home/user/models/plant.slx", "500"}, &result)
+
+			// Assert
+			require.Error(t, err)
+		})
+	}
+}
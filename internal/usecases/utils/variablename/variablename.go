@@ -0,0 +1,21 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package variablename
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// pattern matches the valid MATLAB variable names, as isvarname does.
+var pattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]{0,62}$`)
+
+// Validate rejects the names which are not valid MATLAB variable names, before they are sent to MATLAB.
+func Validate(name string) error {
+	if !pattern.MatchString(name) {
+		return entities.NewCodedError(entities.ErrorCodeInvalidInput, fmt.Errorf("%q is not a valid MATLAB variable name", name))
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package variablename_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/variablename"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate_ValidNames(t *testing.T) {
+	for _, name := range []string{"x", "data", "results_2", "A" + strings.Repeat("b", 62)} {
+		t.Run(name, func(t *testing.T) {
+			// Act
+			err := variablename.Validate(name)
+
+			// Assert
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestValidate_InvalidNames(t *testing.T) {
+	testCases := []struct {
+		name     string
+		variable string
+	}{
+		{name: "empty", variable: ""},
+		{name: "leading digit", variable: "2x"},
+		{name: "leading underscore", variable: "_x"},
+		{name: "expression", variable: "x; delete('*')"},
+		{name: "too long", variable: "A" + strings.Repeat("b", 63)},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Act
+			err := variablename.Validate(testCase.variable)
+
+			// Assert
+			require.Error(t, err)
+			assert.Equal(t, entities.ErrorCodeInvalidInput, entities.ErrorCodeOf(err))
+			assert.Contains(t, err.Error(), "is not a valid MATLAB variable name")
+		})
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright 2025 The MathWorks, Inc.
+
+// Package clientidentity carries the identity a request is made on behalf of through a context.Context,
+// so that audit records, logs and the MATLAB session know who performed an action.
+package clientidentity
+
+import (
+	"context"
+)
+
+// UserLogKey and ClientLogKey are the keys used to record the identity in log records.
+const (
+	UserLogKey   = "user"
+	ClientLogKey = "client"
+)
+
+// UserEnvironmentVariable and ClientEnvironmentVariable are the environment variables of the MATLAB session holding the identity
+// of the latest call.
+const (
+	UserEnvironmentVariable   = "MATLAB_MCP_USER"
+	ClientEnvironmentVariable = "MATLAB_MCP_CLIENT"
+)
+
+// Identity is who a request is made on behalf of: the user the server runs for, and the MCP client acting for them.
+// Authenticated is set when Client is the name of the API key the request was authenticated with, rather than the name
+// the MCP client gave itself.
+type Identity struct {
+	User          string
+	Client        string
+	Authenticated bool
+}
+
+type contextKey struct{}
+
+func NewContext(ctx context.Context, identity Identity) context.Context {
+	return context.WithValue(ctx, contextKey{}, identity)
+}
+
+func FromContext(ctx context.Context) (Identity, bool) {
+	identity, ok := ctx.Value(contextKey{}).(Identity)
+	if !ok || identity == (Identity{}) {
+		return Identity{}, false
+	}
+	return identity, true
+}
@@ -0,0 +1,45 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package clientidentity_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/clientidentity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromContext_HappyPath(t *testing.T) {
+	// Arrange
+	expectedIdentity := clientidentity.Identity{User: "alice", Client: "claude-code 1.0.0"}
+	ctx := clientidentity.NewContext(t.Context(), expectedIdentity)
+
+	// Act
+	identity, ok := clientidentity.FromContext(ctx)
+
+	// Assert
+	require.True(t, ok)
+	assert.Equal(t, expectedIdentity, identity)
+}
+
+func TestFromContext_Missing(t *testing.T) {
+	// Act
+	identity, ok := clientidentity.FromContext(t.Context())
+
+	// Assert
+	require.False(t, ok)
+	assert.Empty(t, identity)
+}
+
+func TestFromContext_Empty(t *testing.T) {
+	// Arrange
+	ctx := clientidentity.NewContext(t.Context(), clientidentity.Identity{})
+
+	// Act
+	identity, ok := clientidentity.FromContext(ctx)
+
+	// Assert
+	require.False(t, ok)
+	assert.Empty(t, identity)
+}
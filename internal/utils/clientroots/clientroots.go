@@ -0,0 +1,64 @@
+// Copyright 2025 The MathWorks, Inc.
+
+// Package clientroots gives the code handling a request access to the roots of the MCP client that sent it.
+// The roots are only requested from the client when they are first needed.
+package clientroots
+
+import (
+	"context"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Lister lists the roots of the client, as local paths.
+type Lister func(ctx context.Context) ([]string, error)
+
+type contextKey struct{}
+
+type cachedLister struct {
+	once  sync.Once
+	list  Lister
+	roots []string
+	err   error
+}
+
+// NewContext returns a context in which the roots are listed with lister, at most once.
+func NewContext(ctx context.Context, lister Lister) context.Context {
+	return context.WithValue(ctx, contextKey{}, &cachedLister{list: lister})
+}
+
+// FromContext returns the roots of the client of the request running in ctx.
+// It returns no roots if ctx was not created by NewContext.
+func FromContext(ctx context.Context) ([]string, error) {
+	c, ok := ctx.Value(contextKey{}).(*cachedLister)
+	if !ok {
+		return nil, nil
+	}
+
+	c.once.Do(func() {
+		c.roots, c.err = c.list(ctx)
+	})
+	return c.roots, c.err
+}
+
+// PathFromURI converts a root URI to a local path. Only file URIs are supported.
+func PathFromURI(uri string) (string, bool) {
+	parsed, err := url.Parse(uri)
+	if err != nil || parsed.Scheme != "file" || parsed.Path == "" {
+		return "", false
+	}
+
+	path := parsed.Path
+	// Windows paths are sent as file:///C:/path
+	if len(path) >= 3 && path[0] == '/' && path[2] == ':' {
+		path = path[1:]
+	}
+	if parsed.Host != "" && parsed.Host != "localhost" {
+		// UNC path: file://server/share/path
+		path = "//" + parsed.Host + path
+	}
+
+	return filepath.Clean(filepath.FromSlash(strings.TrimSuffix(path, "/"))), true
+}
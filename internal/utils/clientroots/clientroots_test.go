@@ -0,0 +1,84 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package clientroots_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/clientroots"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromContext_ListsOnce(t *testing.T) {
+	// Arrange
+	expectedRoots := []string{"/home/user/project"}
+	calls := 0
+	ctx := clientroots.NewContext(t.Context(), func(ctx context.Context) ([]string, error) {
+		calls++
+		return expectedRoots, nil
+	})
+
+	// Act
+	first, firstErr := clientroots.FromContext(ctx)
+	second, secondErr := clientroots.FromContext(ctx)
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, secondErr)
+	assert.Equal(t, expectedRoots, first)
+	assert.Equal(t, expectedRoots, second)
+	assert.Equal(t, 1, calls, "Roots should only be listed once")
+}
+
+func TestFromContext_ListerError(t *testing.T) {
+	// Arrange
+	ctx := clientroots.NewContext(t.Context(), func(ctx context.Context) ([]string, error) {
+		return nil, assert.AnError
+	})
+
+	// Act
+	roots, err := clientroots.FromContext(ctx)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, roots)
+}
+
+func TestFromContext_Missing(t *testing.T) {
+	// Act
+	roots, err := clientroots.FromContext(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, roots)
+}
+
+func TestPathFromURI(t *testing.T) {
+	testCases := []struct {
+		name         string
+		uri          string
+		expectedPath string
+		expectedOK   bool
+	}{
+		{name: "unix path", uri: "file:///home/user/project", expectedPath: filepath.FromSlash("/home/user/project"), expectedOK: true},
+		{name: "trailing slash", uri: "file:///home/user/project/", expectedPath: filepath.FromSlash("/home/user/project"), expectedOK: true},
+		{name: "escaped characters", uri: "file:///home/user/my%20project", expectedPath: filepath.FromSlash("/home/user/my project"), expectedOK: true},
+		{name: "windows path", uri: "file:///C:/Users/user/project", expectedPath: filepath.FromSlash("C:/Users/user/project"), expectedOK: true},
+		{name: "not a file URI", uri: "https://example.com/project", expectedOK: false},
+		{name: "invalid URI", uri: "file://%zz", expectedOK: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Act
+			path, ok := clientroots.PathFromURI(testCase.uri)
+
+			// Assert
+			assert.Equal(t, testCase.expectedOK, ok)
+			assert.Equal(t, testCase.expectedPath, path)
+		})
+	}
+}
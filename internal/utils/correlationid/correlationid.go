@@ -0,0 +1,36 @@
+// Copyright 2025 The MathWorks, Inc.
+
+// Package correlationid carries a per-request identifier through a context.Context,
+// so that every log record, outgoing HTTP request and error produced while handling
+// a single MCP request can be tied back together.
+package correlationid
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// HTTPHeader is the header used to forward the correlation ID on outgoing HTTP requests.
+const HTTPHeader = "X-Correlation-ID"
+
+// LogKey is the key used to record the correlation ID in log records.
+const LogKey = "correlation-id"
+
+type contextKey struct{}
+
+func New() string {
+	return uuid.NewString()
+}
+
+func NewContext(ctx context.Context, correlationID string) context.Context {
+	return context.WithValue(ctx, contextKey{}, correlationID)
+}
+
+func FromContext(ctx context.Context) (string, bool) {
+	correlationID, ok := ctx.Value(contextKey{}).(string)
+	if !ok || correlationID == "" {
+		return "", false
+	}
+	return correlationID, true
+}
@@ -0,0 +1,57 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package correlationid_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/correlationid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_IsUnique(t *testing.T) {
+	// Act
+	first := correlationid.New()
+	second := correlationid.New()
+
+	// Assert
+	_, err := uuid.Parse(first)
+	require.NoError(t, err)
+	assert.NotEqual(t, first, second)
+}
+
+func TestFromContext_HappyPath(t *testing.T) {
+	// Arrange
+	expectedCorrelationID := "some-id"
+	ctx := correlationid.NewContext(t.Context(), expectedCorrelationID)
+
+	// Act
+	correlationID, ok := correlationid.FromContext(ctx)
+
+	// Assert
+	require.True(t, ok)
+	assert.Equal(t, expectedCorrelationID, correlationID)
+}
+
+func TestFromContext_Missing(t *testing.T) {
+	// Act
+	correlationID, ok := correlationid.FromContext(t.Context())
+
+	// Assert
+	require.False(t, ok)
+	assert.Empty(t, correlationID)
+}
+
+func TestFromContext_Empty(t *testing.T) {
+	// Arrange
+	ctx := correlationid.NewContext(t.Context(), "")
+
+	// Act
+	correlationID, ok := correlationid.FromContext(ctx)
+
+	// Assert
+	require.False(t, ok)
+	assert.Empty(t, correlationID)
+}
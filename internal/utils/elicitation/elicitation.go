@@ -0,0 +1,33 @@
+// Copyright 2025 The MathWorks, Inc.
+
+// Package elicitation lets the code handling a request ask the user of the MCP client that sent it for a confirmation.
+package elicitation
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNotSupported is returned when the client of the request cannot ask its user for a confirmation.
+var ErrNotSupported = errors.New("the MCP client does not support elicitation")
+
+// Confirmer shows message to the user, and returns true if the user accepts.
+type Confirmer func(ctx context.Context, message string) (bool, error)
+
+type contextKey struct{}
+
+// NewContext returns a context in which confirmations are requested with confirmer.
+func NewContext(ctx context.Context, confirmer Confirmer) context.Context {
+	return context.WithValue(ctx, contextKey{}, confirmer)
+}
+
+// Confirm asks the user of the client of the request running in ctx to accept message.
+// It returns ErrNotSupported if ctx was not created by NewContext.
+func Confirm(ctx context.Context, message string) (bool, error) {
+	confirmer, ok := ctx.Value(contextKey{}).(Confirmer)
+	if !ok {
+		return false, ErrNotSupported
+	}
+
+	return confirmer(ctx, message)
+}
@@ -0,0 +1,52 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package elicitation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/elicitation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfirm_UsesConfirmer(t *testing.T) {
+	// Arrange
+	var shownMessage string
+	ctx := elicitation.NewContext(t.Context(), func(ctx context.Context, message string) (bool, error) {
+		shownMessage = message
+		return true, nil
+	})
+
+	// Act
+	accepted, err := elicitation.Confirm(ctx, "Run this code?")
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, accepted)
+	assert.Equal(t, "Run this code?", shownMessage)
+}
+
+func TestConfirm_ConfirmerError(t *testing.T) {
+	// Arrange
+	ctx := elicitation.NewContext(t.Context(), func(ctx context.Context, message string) (bool, error) {
+		return false, assert.AnError
+	})
+
+	// Act
+	accepted, err := elicitation.Confirm(ctx, "Run this code?")
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.False(t, accepted)
+}
+
+func TestConfirm_Missing(t *testing.T) {
+	// Act
+	accepted, err := elicitation.Confirm(t.Context(), "Run this code?")
+
+	// Assert
+	require.ErrorIs(t, err, elicitation.ErrNotSupported)
+	assert.False(t, accepted)
+}
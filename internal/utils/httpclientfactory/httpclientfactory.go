@@ -6,8 +6,11 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
+	"net"
 	"net/http"
 	"net/http/cookiejar"
+	"net/url"
+	"strings"
 	"time"
 )
 
@@ -15,86 +18,194 @@ type HttpClient interface {
 	Do(request *http.Request) (*http.Response, error)
 }
 
-type HTTPClientFactory struct{}
+type Config interface {
+	StrictTLS() bool
+	Proxy() string
+	NoProxy() []string
+}
+
+type HTTPClientFactory struct {
+	config Config
+}
 
-func New() *HTTPClientFactory {
-	return &HTTPClientFactory{}
+func New(config Config) *HTTPClientFactory {
+	return &HTTPClientFactory{
+		config: config,
+	}
+}
+
+// NewClient returns a client for the HTTP(S) services the server calls, such as MATLAB Production Server, which goes
+// through the configured proxy, or else through the proxy set by the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment
+// variables.
+func (f *HTTPClientFactory) NewClient(timeout time.Duration) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = f.newProxyFunc()
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   timeout,
+	}
 }
 
 func (f *HTTPClientFactory) NewClientForSelfSignedTLSServer(certificatePEM []byte) (HttpClient, error) {
+	return f.newClientForTLSServer(certificatePEM, nil)
+}
+
+// NewClientForMutualTLS returns a client for a TLS server whose certificate is verified against caPEM, as with
+// NewClientForSelfSignedTLSServer, and which requires the client to authenticate with the certificate of clientCertPEM
+// and its private key, clientKeyPEM.
+func (f *HTTPClientFactory) NewClientForMutualTLS(clientCertPEM []byte, clientKeyPEM []byte, caPEM []byte) (HttpClient, error) {
+	clientCertificate, err := tls.X509KeyPair(clientCertPEM, clientKeyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load client certificate: %w", err)
+	}
+
+	return f.newClientForTLSServer(caPEM, []tls.Certificate{clientCertificate})
+}
+
+func (f *HTTPClientFactory) newClientForTLSServer(certificatePEM []byte, clientCertificates []tls.Certificate) (HttpClient, error) {
 	caCertPool := x509.NewCertPool()
 
 	if ok := caCertPool.AppendCertsFromPEM(certificatePEM); !ok {
 		return nil, fmt.Errorf("failed to append certificate to pool")
 	}
 
-	transport := &http.Transport{
-		TLSClientConfig: &tls.Config{
-			MinVersion:         tls.VersionTLS12,
-			InsecureSkipVerify: true, // We do full verification ourselves below
-			// Custom verification to allow clock skew tolerance
-			// We must use InsecureSkipVerify because Go's standard validation
-			// checks certificate dates BEFORE calling VerifyPeerCertificate,
-			// which prevents our clock skew tolerance from working
-			VerifyPeerCertificate: func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
-				// Allow up to 24 hours of clock skew for self-signed certificates
-				// This handles cases where system clocks are slightly out of sync
-				const clockSkewTolerance = 24 * time.Hour
-				
-				opts := x509.VerifyOptions{
-					Roots:         caCertPool,
-					Intermediates: x509.NewCertPool(),
+	tlsConfig := newClockSkewTolerantTLSConfig(caCertPool)
+	if f.config.StrictTLS() {
+		// Standard verification: the certificate must be valid now, and issued for the host it is reached on.
+		tlsConfig = &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			RootCAs:    caCertPool,
+		}
+	}
+	tlsConfig.Certificates = clientCertificates
+
+	jar, err := cookiejar.New(&cookiejar.Options{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cookie jar: %w", err)
+	}
+
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy:           f.newProxyFunc(),
+			TLSClientConfig: tlsConfig,
+		},
+		Jar: jar,
+	}, nil
+}
+
+// newProxyFunc returns the function choosing the proxy of a request: the configured proxy, unless the host of the
+// request is on the local machine or excluded by NoProxy, or else the proxy of the environment. The credentials in the
+// URL of the proxy are sent to authenticated proxies.
+func (f *HTTPClientFactory) newProxyFunc() func(*http.Request) (*url.URL, error) {
+	configuredProxy := f.config.Proxy()
+	if configuredProxy == "" {
+		return http.ProxyFromEnvironment
+	}
+
+	proxyURL, err := url.Parse(configuredProxy)
+	noProxy := f.config.NoProxy()
+	return func(request *http.Request) (*url.URL, error) {
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy: %w", err)
+		}
+		if bypassProxy(request.URL.Hostname(), noProxy) {
+			return nil, nil
+		}
+		return proxyURL, nil
+	}
+}
+
+// bypassProxy returns whether host is reached without the proxy: a host of the local machine, or a host matching an
+// entry of noProxy, which is a host name matching its subdomains, an IP address, a CIDR range, or * to match any host.
+func bypassProxy(host string, noProxy []string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+	if ip != nil && ip.IsLoopback() {
+		return true
+	}
+
+	for _, entry := range noProxy {
+		entry = strings.TrimPrefix(entry, ".")
+		switch {
+		case entry == "*":
+			return true
+		case strings.Contains(entry, "/"):
+			if _, network, err := net.ParseCIDR(entry); err == nil && ip != nil && network.Contains(ip) {
+				return true
+			}
+		case ip != nil:
+			if entryIP := net.ParseIP(entry); entryIP != nil && entryIP.Equal(ip) {
+				return true
+			}
+		case host == entry || strings.HasSuffix(host, "."+entry):
+			return true
+		}
+	}
+
+	return false
+}
+
+func newClockSkewTolerantTLSConfig(caCertPool *x509.CertPool) *tls.Config {
+	return &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: true, // We do full verification ourselves below
+		// Custom verification to allow clock skew tolerance
+		// We must use InsecureSkipVerify because Go's standard validation
+		// checks certificate dates BEFORE calling VerifyPeerCertificate,
+		// which prevents our clock skew tolerance from working
+		VerifyPeerCertificate: func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+			// Allow up to 24 hours of clock skew for self-signed certificates
+			// This handles cases where system clocks are slightly out of sync
+			const clockSkewTolerance = 24 * time.Hour
+
+			opts := x509.VerifyOptions{
+				Roots:         caCertPool,
+				Intermediates: x509.NewCertPool(),
+			}
+
+			// Verify each certificate in the chain with clock skew tolerance
+			for _, rawCert := range rawCerts {
+				cert, err := x509.ParseCertificate(rawCert)
+				if err != nil {
+					return fmt.Errorf("failed to parse certificate: %w", err)
 				}
 
-				// Verify each certificate in the chain with clock skew tolerance
-				for _, rawCert := range rawCerts {
-					cert, err := x509.ParseCertificate(rawCert)
-					if err != nil {
-						return fmt.Errorf("failed to parse certificate: %w", err)
-					}
-					
-					// Check certificate validity with clock skew tolerance
-					now := time.Now()
-					if cert.NotBefore.After(now.Add(clockSkewTolerance)) {
-						// Certificate is too far in the future, reject it
-						return fmt.Errorf("certificate not valid yet: notBefore is %v, current time is %v (skew tolerance: %v)", 
-							cert.NotBefore, now, clockSkewTolerance)
-					}
-					if cert.NotAfter.Before(now.Add(-clockSkewTolerance)) {
-						// Certificate is too far expired, reject it
-						return fmt.Errorf("certificate expired: notAfter is %v, current time is %v (skew tolerance: %v)", 
-							cert.NotAfter, now, clockSkewTolerance)
-					}
-					
-					// Try verification with current time
-					opts.CurrentTime = now
+				// Check certificate validity with clock skew tolerance
+				now := time.Now()
+				if cert.NotBefore.After(now.Add(clockSkewTolerance)) {
+					// Certificate is too far in the future, reject it
+					return fmt.Errorf("certificate not valid yet: notBefore is %v, current time is %v (skew tolerance: %v)",
+						cert.NotBefore, now, clockSkewTolerance)
+				}
+				if cert.NotAfter.Before(now.Add(-clockSkewTolerance)) {
+					// Certificate is too far expired, reject it
+					return fmt.Errorf("certificate expired: notAfter is %v, current time is %v (skew tolerance: %v)",
+						cert.NotAfter, now, clockSkewTolerance)
+				}
+
+				// Try verification with current time
+				opts.CurrentTime = now
+				_, err = cert.Verify(opts)
+				if err != nil {
+					// Try with positive clock skew (certificate is in the future)
+					opts.CurrentTime = now.Add(clockSkewTolerance)
 					_, err = cert.Verify(opts)
 					if err != nil {
-						// Try with positive clock skew (certificate is in the future)
-						opts.CurrentTime = now.Add(clockSkewTolerance)
+						// Try with negative clock skew (certificate is in the past)
+						opts.CurrentTime = now.Add(-clockSkewTolerance)
 						_, err = cert.Verify(opts)
 						if err != nil {
-							// Try with negative clock skew (certificate is in the past)
-							opts.CurrentTime = now.Add(-clockSkewTolerance)
-							_, err = cert.Verify(opts)
-							if err != nil {
-								return fmt.Errorf("certificate verification failed: %w", err)
-							}
+							return fmt.Errorf("certificate verification failed: %w", err)
 						}
 					}
 				}
-				return nil
-			},
+			}
+			return nil
 		},
 	}
-
-	jar, err := cookiejar.New(&cookiejar.Options{})
-	if err != nil {
-		return nil, fmt.Errorf("failed to create cookie jar: %w", err)
-	}
-
-	return &http.Client{
-		Transport: transport,
-		Jar:       jar,
-	}, nil
 }
@@ -3,21 +3,35 @@
 package httpclientfactory_test
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
 	"encoding/pem"
+	"math/big"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/utils/httpclientfactory"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/utils/httpclientfactory"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func TestNew_HappyPath(t *testing.T) {
 	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
 
 	// Act
-	factory := httpclientfactory.New()
+	factory := httpclientfactory.New(mockConfig)
 
 	// Assert
 	assert.NotNil(t, factory, "Factory should not be nil")
@@ -37,7 +51,20 @@ func TestHTTPClientFactory_NewClientForSelfSignedTLSServer_HappyPath(t *testing.
 		Bytes: serverCert.Raw,
 	})
 
-	factory := httpclientfactory.New()
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		StrictTLS().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		Proxy().
+		Return("").
+		Once()
+
+	factory := httpclientfactory.New(mockConfig)
 
 	// Act
 	client, err := factory.NewClientForSelfSignedTLSServer(certPEMBytes)
@@ -56,9 +83,100 @@ func TestHTTPClientFactory_NewClientForSelfSignedTLSServer_HappyPath(t *testing.
 	assert.Equal(t, expectedStatusCode, response.StatusCode)
 }
 
+func TestHTTPClientFactory_NewClientForSelfSignedTLSServer_StrictTLS(t *testing.T) {
+	// Arrange
+	expectedStatusCode := http.StatusOK
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(responseWriter http.ResponseWriter, request *http.Request) {
+		responseWriter.WriteHeader(expectedStatusCode)
+	}))
+	t.Cleanup(server.Close)
+
+	certPEMBytes := pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: server.Certificate().Raw,
+	})
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		StrictTLS().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		Proxy().
+		Return("").
+		Once()
+
+	factory := httpclientfactory.New(mockConfig)
+
+	// Act
+	client, err := factory.NewClientForSelfSignedTLSServer(certPEMBytes)
+
+	// Assert
+	require.NoError(t, err)
+
+	// Act + Assert to check the client accepts the certificate for the address it was issued for
+	request, err := http.NewRequest("GET", "https://"+server.Listener.Addr().String(), nil)
+	require.NoError(t, err)
+	response, err := client.Do(request)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, response.Body.Close())
+	})
+	assert.Equal(t, expectedStatusCode, response.StatusCode)
+}
+
+func TestHTTPClientFactory_NewClientForSelfSignedTLSServer_StrictTLSRejectsOtherHost(t *testing.T) {
+	// Arrange
+	server := httptest.NewTLSServer(http.HandlerFunc(func(responseWriter http.ResponseWriter, request *http.Request) {
+		responseWriter.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	certPEMBytes := pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: server.Certificate().Raw,
+	})
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		StrictTLS().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		Proxy().
+		Return("").
+		Once()
+
+	factory := httpclientfactory.New(mockConfig)
+
+	client, err := factory.NewClientForSelfSignedTLSServer(certPEMBytes)
+	require.NoError(t, err)
+
+	// The test certificate is issued for 127.0.0.1 and example.com, not for localhost
+	request, err := http.NewRequest("GET", "https://localhost:"+strconv.Itoa(server.Listener.Addr().(*net.TCPAddr).Port), nil)
+	require.NoError(t, err)
+
+	// Act
+	response, err := client.Do(request)
+
+	// Assert
+	require.Error(t, err)
+	assert.Nil(t, response)
+}
+
 func TestHTTPClientFactory_NewClientForSelfSignedTLSServer_InvalidCert(t *testing.T) {
 	// Arrange
-	factory := httpclientfactory.New()
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	factory := httpclientfactory.New(mockConfig)
 
 	// Act
 	client, err := factory.NewClientForSelfSignedTLSServer([]byte("invalid cert"))
@@ -67,3 +185,253 @@ func TestHTTPClientFactory_NewClientForSelfSignedTLSServer_InvalidCert(t *testin
 	require.Error(t, err)
 	assert.Nil(t, client)
 }
+
+func TestHTTPClientFactory_NewClient_ConfiguredProxy(t *testing.T) {
+	// Arrange
+	var proxiedHost, proxyAuthorization string
+	proxy := httptest.NewServer(http.HandlerFunc(func(responseWriter http.ResponseWriter, request *http.Request) {
+		proxiedHost = request.URL.Host
+		proxyAuthorization = request.Header.Get("Proxy-Authorization")
+		responseWriter.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(proxy.Close)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		Proxy().
+		Return("http://user:secret@" + proxy.Listener.Addr().String()).
+		Once()
+
+	mockConfig.EXPECT().
+		NoProxy().
+		Return([]string{"intranet.example.com"}).
+		Once()
+
+	factory := httpclientfactory.New(mockConfig)
+
+	// Act
+	client := factory.NewClient(time.Minute)
+
+	// Assert
+	assert.Equal(t, time.Minute, client.Timeout)
+
+	response, err := client.Get("http://service.example.com/api")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, response.Body.Close())
+	})
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "service.example.com", proxiedHost)
+	assert.Equal(t, "Basic "+base64.StdEncoding.EncodeToString([]byte("user:secret")), proxyAuthorization)
+}
+
+func TestHTTPClientFactory_NewClient_LocalHostBypassesProxy(t *testing.T) {
+	// Arrange
+	proxy := httptest.NewServer(http.HandlerFunc(func(responseWriter http.ResponseWriter, request *http.Request) {
+		responseWriter.WriteHeader(http.StatusBadGateway)
+	}))
+	t.Cleanup(proxy.Close)
+
+	server := httptest.NewServer(http.HandlerFunc(func(responseWriter http.ResponseWriter, request *http.Request) {
+		responseWriter.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		Proxy().
+		Return("http://" + proxy.Listener.Addr().String()).
+		Once()
+
+	mockConfig.EXPECT().
+		NoProxy().
+		Return(nil).
+		Once()
+
+	factory := httpclientfactory.New(mockConfig)
+	client := factory.NewClient(time.Minute)
+
+	// Act
+	response, err := client.Get(server.URL)
+
+	// Assert
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, response.Body.Close())
+	})
+	assert.Equal(t, http.StatusOK, response.StatusCode, "Connections to the local machine should not go through the proxy")
+}
+
+func TestBypassProxy(t *testing.T) {
+	testConfigs := []struct {
+		name     string
+		host     string
+		noProxy  []string
+		expected bool
+	}{
+		{name: "localhost", host: "localhost", expected: true},
+		{name: "loopback address", host: "127.0.0.1", expected: true},
+		{name: "loopback IPv6 address", host: "::1", expected: true},
+		{name: "other host", host: "service.example.com", noProxy: []string{"intranet.example.com"}, expected: false},
+		{name: "exact host", host: "intranet.example.com", noProxy: []string{"intranet.example.com"}, expected: true},
+		{name: "subdomain", host: "wiki.intranet.example.com", noProxy: []string{".intranet.example.com"}, expected: true},
+		{name: "suffix that is not a subdomain", host: "myintranet.example.com", noProxy: []string{"intranet.example.com"}, expected: false},
+		{name: "case insensitive", host: "Intranet.Example.com", noProxy: []string{"intranet.example.com"}, expected: true},
+		{name: "IP address", host: "192.168.1.10", noProxy: []string{"192.168.1.10"}, expected: true},
+		{name: "CIDR range", host: "10.1.2.3", noProxy: []string{"10.0.0.0/8"}, expected: true},
+		{name: "outside CIDR range", host: "11.1.2.3", noProxy: []string{"10.0.0.0/8"}, expected: false},
+		{name: "wildcard", host: "service.example.com", noProxy: []string{"*"}, expected: true},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Act
+			result := httpclientfactory.BypassProxy(testConfig.host, testConfig.noProxy)
+
+			// Assert
+			assert.Equal(t, testConfig.expected, result)
+		})
+	}
+}
+
+// newClientCertificate returns a self-signed client certificate and its private key, as PEM.
+func newClientCertificate(t *testing.T) ([]byte, []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "matlab-mcp-core-server"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	certificateDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificateDER}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+// newMutualTLSServer returns a TLS server requiring clients to present clientCertPEM, and the PEM of its certificate.
+func newMutualTLSServer(t *testing.T, clientCertPEM []byte) (*httptest.Server, []byte) {
+	t.Helper()
+
+	clientCAs := x509.NewCertPool()
+	require.True(t, clientCAs.AppendCertsFromPEM(clientCertPEM))
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(responseWriter http.ResponseWriter, request *http.Request) {
+		responseWriter.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	return server, pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: server.Certificate().Raw,
+	})
+}
+
+func TestHTTPClientFactory_NewClientForMutualTLS_HappyPath(t *testing.T) {
+	// Arrange
+	clientCertPEM, clientKeyPEM := newClientCertificate(t)
+	server, caPEM := newMutualTLSServer(t, clientCertPEM)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		StrictTLS().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		Proxy().
+		Return("").
+		Once()
+
+	factory := httpclientfactory.New(mockConfig)
+
+	// Act
+	client, err := factory.NewClientForMutualTLS(clientCertPEM, clientKeyPEM, caPEM)
+
+	// Assert
+	require.NoError(t, err)
+
+	// Act + Assert to check the client authenticates with its certificate
+	request, err := http.NewRequest("GET", server.URL, nil)
+	require.NoError(t, err)
+	response, err := client.Do(request)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, response.Body.Close())
+	})
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+}
+
+func TestHTTPClientFactory_NewClientForMutualTLS_ServerRejectsClientWithoutCertificate(t *testing.T) {
+	// Arrange
+	clientCertPEM, _ := newClientCertificate(t)
+	server, caPEM := newMutualTLSServer(t, clientCertPEM)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		StrictTLS().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		Proxy().
+		Return("").
+		Once()
+
+	factory := httpclientfactory.New(mockConfig)
+
+	client, err := factory.NewClientForSelfSignedTLSServer(caPEM)
+	require.NoError(t, err)
+
+	request, err := http.NewRequest("GET", server.URL, nil)
+	require.NoError(t, err)
+
+	// Act
+	response, err := client.Do(request)
+
+	// Assert
+	require.Error(t, err)
+	assert.Nil(t, response)
+}
+
+func TestHTTPClientFactory_NewClientForMutualTLS_InvalidKeyPair(t *testing.T) {
+	// Arrange
+	clientCertPEM, _ := newClientCertificate(t)
+	_, otherKeyPEM := newClientCertificate(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	factory := httpclientfactory.New(mockConfig)
+
+	// Act
+	client, err := factory.NewClientForMutualTLS(clientCertPEM, otherKeyPEM, clientCertPEM)
+
+	// Assert
+	require.ErrorContains(t, err, "failed to load client certificate")
+	assert.Nil(t, client)
+}
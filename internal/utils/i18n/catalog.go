@@ -0,0 +1,192 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package i18n
+
+import "github.com/matlab/matlab-mcp-core-server/internal/entities"
+
+// Message identifies a message shown to users. Its translations are format strings, all with the same verbs.
+type Message string
+
+// PluralMessage is a message about a count, with one form for a count of one, and one for any other count.
+type PluralMessage struct {
+	One   Message
+	Other Message
+}
+
+const (
+	MessageApproveCode           Message = "approve-code"
+	MessageApproveFile           Message = "approve-file"
+	MessageApprovePythonCode     Message = "approve-python-code"
+	MessageCodeNotApproved       Message = "code-not-approved"
+	MessageApproveTargetAction   Message = "approve-target-action"
+	MessageTargetActionRejected  Message = "target-action-not-approved"
+	MessageApproveDeployment     Message = "approve-deployment"
+	MessageDeploymentNotApproved Message = "deployment-not-approved"
+	MessageTrustCertificate      Message = "trust-certificate"
+	MessageCertificateNotTrusted Message = "certificate-not-trusted"
+	MessageAllowToolCall         Message = "allow-tool-call"
+	MessageToolCallArguments     Message = "tool-call-arguments"
+	MessageShuttingDown          Message = "shutting-down"
+
+	MessageDoctorSuggestedFix Message = "doctor-suggested-fix"
+	MessageDoctorNoProblems   Message = "doctor-no-problems"
+	MessageDoctorFound        Message = "doctor-found"
+	MessageDoctorFixHint      Message = "doctor-fix-hint"
+
+	messageProblemOne   Message = "problem-one"
+	messageProblemOther Message = "problem-other"
+	messageWarningOne   Message = "warning-one"
+	messageWarningOther Message = "warning-other"
+	messageFixOne       Message = "fix-one"
+	messageFixOther     Message = "fix-other"
+)
+
+var (
+	MessageProblemCount = PluralMessage{One: messageProblemOne, Other: messageProblemOther}
+	MessageWarningCount = PluralMessage{One: messageWarningOne, Other: messageWarningOther}
+	MessageFixCount     = PluralMessage{One: messageFixOne, Other: messageFixOther}
+)
+
+// catalog holds the translations of every message. Every message has an English translation.
+var catalog = map[Message]map[entities.Locale]string{
+	MessageApproveCode: {
+		entities.LocaleEnglish:  "Approve running this MATLAB code?",
+		entities.LocaleJapanese: "この MATLAB コードの実行を承認しますか?",
+		entities.LocaleGerman:   "Ausführung dieses MATLAB-Codes genehmigen?",
+		entities.LocaleChinese:  "是否批准运行此 MATLAB 代码?",
+	},
+	MessageApproveFile: {
+		entities.LocaleEnglish:  "Approve running the MATLAB file %s?",
+		entities.LocaleJapanese: "MATLAB ファイル %s の実行を承認しますか?",
+		entities.LocaleGerman:   "Ausführung der MATLAB-Datei %s genehmigen?",
+		entities.LocaleChinese:  "是否批准运行 MATLAB 文件 %s?",
+	},
+	MessageApprovePythonCode: {
+		entities.LocaleEnglish:  "Approve running this Python code in the MATLAB session?",
+		entities.LocaleJapanese: "この Python コードを MATLAB セッションで実行することを承認しますか?",
+		entities.LocaleGerman:   "Ausführung dieses Python-Codes in der MATLAB-Sitzung genehmigen?",
+		entities.LocaleChinese:  "是否批准在 MATLAB 会话中运行此 Python 代码?",
+	},
+	MessageCodeNotApproved: {
+		entities.LocaleEnglish:  "the user did not approve running the MATLAB code",
+		entities.LocaleJapanese: "ユーザーが MATLAB コードの実行を承認しませんでした",
+		entities.LocaleGerman:   "der Benutzer hat die Ausführung des MATLAB-Codes nicht genehmigt",
+		entities.LocaleChinese:  "用户未批准运行 MATLAB 代码",
+	},
+	MessageApproveTargetAction: {
+		entities.LocaleEnglish:  "Approve this action on the real-time target %s?",
+		entities.LocaleJapanese: "リアルタイム ターゲット %s でのこの操作を承認しますか?",
+		entities.LocaleGerman:   "Diese Aktion auf dem Echtzeit-Zielrechner %s genehmigen?",
+		entities.LocaleChinese:  "是否批准在实时目标机 %s 上执行此操作?",
+	},
+	MessageTargetActionRejected: {
+		entities.LocaleEnglish:  "the user did not approve the action on the real-time target",
+		entities.LocaleJapanese: "ユーザーがリアルタイム ターゲットでの操作を承認しませんでした",
+		entities.LocaleGerman:   "der Benutzer hat die Aktion auf dem Echtzeit-Zielrechner nicht genehmigt",
+		entities.LocaleChinese:  "用户未批准在实时目标机上执行该操作",
+	},
+	MessageApproveDeployment: {
+		entities.LocaleEnglish:  "Approve this deployment to the MATLAB Production Server instance %s?",
+		entities.LocaleJapanese: "MATLAB Production Server インスタンス %s へのこのデプロイを承認しますか?",
+		entities.LocaleGerman:   "Diese Bereitstellung auf der MATLAB Production Server-Instanz %s genehmigen?",
+		entities.LocaleChinese:  "是否批准此次部署到 MATLAB Production Server 实例 %s?",
+	},
+	MessageDeploymentNotApproved: {
+		entities.LocaleEnglish:  "the user did not approve the deployment to the MATLAB Production Server instance",
+		entities.LocaleJapanese: "ユーザーが MATLAB Production Server インスタンスへのデプロイを承認しませんでした",
+		entities.LocaleGerman:   "der Benutzer hat die Bereitstellung auf der MATLAB Production Server-Instanz nicht genehmigt",
+		entities.LocaleChinese:  "用户未批准部署到 MATLAB Production Server 实例",
+	},
+	MessageTrustCertificate: {
+		entities.LocaleEnglish:  "Trust the certificate of the new MATLAB session?\n\nSHA-256 fingerprint: %s",
+		entities.LocaleJapanese: "新しい MATLAB セッションの証明書を信頼しますか?\n\nSHA-256 フィンガープリント: %s",
+		entities.LocaleGerman:   "Dem Zertifikat der neuen MATLAB-Sitzung vertrauen?\n\nSHA-256-Fingerabdruck: %s",
+		entities.LocaleChinese:  "是否信任新 MATLAB 会话的证书?\n\nSHA-256 指纹: %s",
+	},
+	MessageCertificateNotTrusted: {
+		entities.LocaleEnglish:  "the user did not trust the certificate of the MATLAB session",
+		entities.LocaleJapanese: "ユーザーが MATLAB セッションの証明書を信頼しませんでした",
+		entities.LocaleGerman:   "der Benutzer hat dem Zertifikat der MATLAB-Sitzung nicht vertraut",
+		entities.LocaleChinese:  "用户未信任 MATLAB 会话的证书",
+	},
+	MessageAllowToolCall: {
+		entities.LocaleEnglish:  "Allow the call to the %s tool?",
+		entities.LocaleJapanese: "%s ツールの呼び出しを許可しますか?",
+		entities.LocaleGerman:   "Aufruf des Tools %s zulassen?",
+		entities.LocaleChinese:  "是否允许调用 %s 工具?",
+	},
+	MessageToolCallArguments: {
+		entities.LocaleEnglish:  "Arguments:",
+		entities.LocaleJapanese: "引数:",
+		entities.LocaleGerman:   "Argumente:",
+		entities.LocaleChinese:  "参数:",
+	},
+	MessageShuttingDown: {
+		entities.LocaleEnglish:  "the server is shutting down, and accepts no new tool calls",
+		entities.LocaleJapanese: "サーバーはシャットダウン中のため、新しいツール呼び出しを受け付けません",
+		entities.LocaleGerman:   "der Server wird beendet und nimmt keine neuen Tool-Aufrufe an",
+		entities.LocaleChinese:  "服务器正在关闭，不再接受新的工具调用",
+	},
+
+	MessageDoctorSuggestedFix: {
+		entities.LocaleEnglish:  "Suggested fix: %s",
+		entities.LocaleJapanese: "推奨される対処: %s",
+		entities.LocaleGerman:   "Empfohlene Lösung: %s",
+		entities.LocaleChinese:  "建议的修复: %s",
+	},
+	MessageDoctorNoProblems: {
+		entities.LocaleEnglish:  "No problems found.",
+		entities.LocaleJapanese: "問題は見つかりませんでした。",
+		entities.LocaleGerman:   "Keine Probleme gefunden.",
+		entities.LocaleChinese:  "未发现问题。",
+	},
+	MessageDoctorFound: {
+		entities.LocaleEnglish:  "Found %s and %s.",
+		entities.LocaleJapanese: "%sと%sが見つかりました。",
+		entities.LocaleGerman:   "%s und %s gefunden.",
+		entities.LocaleChinese:  "发现%s和%s。",
+	},
+	MessageDoctorFixHint: {
+		entities.LocaleEnglish:  "Run the doctor command with --fix to apply the %s that can be applied automatically.",
+		entities.LocaleJapanese: "自動で適用できる%sを適用するには、doctor コマンドに --fix を付けて実行してください。",
+		entities.LocaleGerman:   "Führen Sie den Befehl doctor mit --fix aus, um die %s anzuwenden, die automatisch angewendet werden können.",
+		entities.LocaleChinese:  "使用 --fix 运行 doctor 命令，以应用可自动应用的%s。",
+	},
+
+	messageProblemOne: {
+		entities.LocaleEnglish:  "%d problem",
+		entities.LocaleJapanese: "%d 件の問題",
+		entities.LocaleGerman:   "%d Problem",
+		entities.LocaleChinese:  "%d 个问题",
+	},
+	messageProblemOther: {
+		entities.LocaleEnglish:  "%d problems",
+		entities.LocaleJapanese: "%d 件の問題",
+		entities.LocaleGerman:   "%d Probleme",
+		entities.LocaleChinese:  "%d 个问题",
+	},
+	messageWarningOne: {
+		entities.LocaleEnglish:  "%d warning",
+		entities.LocaleJapanese: "%d 件の警告",
+		entities.LocaleGerman:   "%d Warnung",
+		entities.LocaleChinese:  "%d 个警告",
+	},
+	messageWarningOther: {
+		entities.LocaleEnglish:  "%d warnings",
+		entities.LocaleJapanese: "%d 件の警告",
+		entities.LocaleGerman:   "%d Warnungen",
+		entities.LocaleChinese:  "%d 个警告",
+	},
+	messageFixOne: {
+		entities.LocaleEnglish:  "%d fix",
+		entities.LocaleJapanese: "%d 件の修正",
+		entities.LocaleGerman:   "%d Korrektur",
+		entities.LocaleChinese:  "%d 项修复",
+	},
+	messageFixOther: {
+		entities.LocaleEnglish:  "%d fixes",
+		entities.LocaleJapanese: "%d 件の修正",
+		entities.LocaleGerman:   "%d Korrekturen",
+		entities.LocaleChinese:  "%d 项修复",
+	},
+}
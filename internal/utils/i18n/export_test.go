@@ -0,0 +1,5 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package i18n
+
+var Catalog = catalog
@@ -0,0 +1,102 @@
+// Copyright 2025 The MathWorks, Inc.
+
+// Package i18n translates the messages shown to users, such as confirmations and command output,
+// into the languages of the MATLAB audiences: English, Japanese, German and Chinese.
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// SupportedLocales are the locales that messages are translated into.
+var SupportedLocales = []entities.Locale{
+	entities.LocaleEnglish,
+	entities.LocaleJapanese,
+	entities.LocaleGerman,
+	entities.LocaleChinese,
+}
+
+// localeEnvironmentVariables are the environment variables of the system locale, from the one that takes precedence.
+var localeEnvironmentVariables = []string{"LC_ALL", "LC_MESSAGES", "LANG"}
+
+// ParseLocale returns the locale of a language tag, such as ja, de-DE or the value of LANG, such as zh_CN.GB18030.
+// It returns false if the language of the tag is not supported.
+func ParseLocale(tag string) (entities.Locale, bool) {
+	language := strings.ToLower(strings.TrimSpace(tag))
+	if i := strings.IndexAny(language, ".@"); i >= 0 {
+		language = language[:i]
+	}
+	if i := strings.IndexAny(language, "_-"); i >= 0 {
+		language = language[:i]
+	}
+
+	switch language {
+	case "c", "posix":
+		return entities.LocaleEnglish, true
+	}
+
+	for _, locale := range SupportedLocales {
+		if language == string(locale) {
+			return locale, true
+		}
+	}
+	return "", false
+}
+
+// DetectLocale returns the locale of the system locale, as set by the environment variables read with getenv.
+// It returns English if the language of the system locale is not supported, or not set, such as on Windows.
+func DetectLocale(getenv func(key string) string) entities.Locale {
+	for _, name := range localeEnvironmentVariables {
+		value := getenv(name)
+		if value == "" {
+			continue
+		}
+
+		if locale, ok := ParseLocale(value); ok {
+			return locale
+		}
+		return entities.LocaleEnglish
+	}
+	return entities.LocaleEnglish
+}
+
+// Translate returns message in locale, formatted with args. Messages without a translation are returned in English.
+func Translate(locale entities.Locale, message Message, args ...any) string {
+	template, ok := catalog[message][locale]
+	if !ok {
+		template = catalog[message][entities.LocaleEnglish]
+	}
+
+	if len(args) == 0 {
+		return template
+	}
+	return fmt.Sprintf(template, args...)
+}
+
+// TranslateCount returns message in locale for count, such as "1 problem" or "2 problems".
+func TranslateCount(locale entities.Locale, count int, message PluralMessage) string {
+	if count == 1 {
+		return Translate(locale, message.One, count)
+	}
+	return Translate(locale, message.Other, count)
+}
+
+type contextKey struct{}
+
+// NewContext returns a context in which messages are shown to users in locale.
+func NewContext(ctx context.Context, locale entities.Locale) context.Context {
+	return context.WithValue(ctx, contextKey{}, locale)
+}
+
+// FromContext returns the locale of ctx, or English if ctx was not created by NewContext.
+func FromContext(ctx context.Context) entities.Locale {
+	locale, ok := ctx.Value(contextKey{}).(entities.Locale)
+	if !ok {
+		return entities.LocaleEnglish
+	}
+	return locale
+}
@@ -0,0 +1,112 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package i18n_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/i18n"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLocale(t *testing.T) {
+	testCases := []struct {
+		tag            string
+		expectedLocale entities.Locale
+		expectedOK     bool
+	}{
+		{tag: "ja", expectedLocale: entities.LocaleJapanese, expectedOK: true},
+		{tag: "de-DE", expectedLocale: entities.LocaleGerman, expectedOK: true},
+		{tag: "zh_CN.GB18030", expectedLocale: entities.LocaleChinese, expectedOK: true},
+		{tag: "ja_JP.eucJP@euro", expectedLocale: entities.LocaleJapanese, expectedOK: true},
+		{tag: " EN_us.UTF-8 ", expectedLocale: entities.LocaleEnglish, expectedOK: true},
+		{tag: "C.UTF-8", expectedLocale: entities.LocaleEnglish, expectedOK: true},
+		{tag: "POSIX", expectedLocale: entities.LocaleEnglish, expectedOK: true},
+		{tag: "fr_FR", expectedOK: false},
+		{tag: "", expectedOK: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.tag, func(t *testing.T) {
+			// Act
+			locale, ok := i18n.ParseLocale(testCase.tag)
+
+			// Assert
+			assert.Equal(t, testCase.expectedOK, ok)
+			assert.Equal(t, testCase.expectedLocale, locale)
+		})
+	}
+}
+
+func TestDetectLocale(t *testing.T) {
+	testCases := []struct {
+		name           string
+		environment    map[string]string
+		expectedLocale entities.Locale
+	}{
+		{name: "LANG", environment: map[string]string{"LANG": "de_DE.UTF-8"}, expectedLocale: entities.LocaleGerman},
+		{name: "LC_ALL takes precedence", environment: map[string]string{"LC_ALL": "ja_JP.SJIS", "LANG": "de_DE.UTF-8"}, expectedLocale: entities.LocaleJapanese},
+		{name: "LC_MESSAGES takes precedence over LANG", environment: map[string]string{"LC_MESSAGES": "zh_CN", "LANG": "de_DE.UTF-8"}, expectedLocale: entities.LocaleChinese},
+		{name: "Unsupported language", environment: map[string]string{"LANG": "fr_FR.UTF-8"}, expectedLocale: entities.LocaleEnglish},
+		{name: "Not set", environment: map[string]string{}, expectedLocale: entities.LocaleEnglish},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Act
+			locale := i18n.DetectLocale(func(key string) string {
+				return testCase.environment[key]
+			})
+
+			// Assert
+			assert.Equal(t, testCase.expectedLocale, locale)
+		})
+	}
+}
+
+func TestTranslate_HappyPath(t *testing.T) {
+	// Act
+	english := i18n.Translate(entities.LocaleEnglish, i18n.MessageApproveFile, "test.m")
+	japanese := i18n.Translate(entities.LocaleJapanese, i18n.MessageApproveFile, "test.m")
+
+	// Assert
+	assert.Equal(t, "Approve running the MATLAB file test.m?", english)
+	assert.Equal(t, "MATLAB ファイル test.m の実行を承認しますか?", japanese)
+}
+
+func TestTranslate_UnsupportedLocaleFallsBackToEnglish(t *testing.T) {
+	// Act
+	message := i18n.Translate(entities.Locale("fr"), i18n.MessageDoctorNoProblems)
+
+	// Assert
+	assert.Equal(t, "No problems found.", message)
+}
+
+func TestTranslateCount(t *testing.T) {
+	// Act & Assert
+	assert.Equal(t, "1 problem", i18n.TranslateCount(entities.LocaleEnglish, 1, i18n.MessageProblemCount))
+	assert.Equal(t, "2 fixes", i18n.TranslateCount(entities.LocaleEnglish, 2, i18n.MessageFixCount))
+	assert.Equal(t, "0 Warnungen", i18n.TranslateCount(entities.LocaleGerman, 0, i18n.MessageWarningCount))
+	assert.Equal(t, "3 个问题", i18n.TranslateCount(entities.LocaleChinese, 3, i18n.MessageProblemCount))
+}
+
+func TestFromContext(t *testing.T) {
+	// Act & Assert
+	assert.Equal(t, entities.LocaleGerman, i18n.FromContext(i18n.NewContext(t.Context(), entities.LocaleGerman)))
+	assert.Equal(t, entities.LocaleEnglish, i18n.FromContext(context.Background()))
+}
+
+func TestCatalog_EveryMessageIsTranslated(t *testing.T) {
+	for message, translations := range i18n.Catalog {
+		english := translations[entities.LocaleEnglish]
+		for _, locale := range i18n.SupportedLocales {
+			translation, ok := translations[locale]
+			if assert.True(t, ok, "%s has no %s translation", message, locale) {
+				assert.Equal(t, strings.Count(english, "%"), strings.Count(translation, "%"), "%s in %s does not have the verbs of the English message", message, locale)
+			}
+		}
+	}
+}
@@ -11,18 +11,43 @@ import (
 	"time"
 )
 
-const lockFileName = "matlab-mcp-core-server.lock"
+const (
+	lockFileName       = "matlab-mcp-core-server.lock"
+	lockFileNamePrefix = "matlab-mcp-core-server-"
+	lockFileExtension  = ".lock"
+)
+
+type Config interface {
+	InstanceID() string
+}
 
-// InstanceLock manages a lock file to prevent multiple instances from running
+// takeOverTimeout bounds the time to wait for a terminated instance to release the lock. The instance releases it once it
+// stopped gracefully, after stopping its MATLAB sessions.
+const takeOverTimeout = 30 * time.Second
+
+// retryInterval is the time between two attempts to acquire a lock held by an instance which is stopping.
+const retryInterval = 100 * time.Millisecond
+
+// InstanceLock manages a lock file to prevent multiple instances from running. The lock is an advisory lock of the
+// operating system on the open lock file, flock on Unix and LockFileEx on Windows, so that two instances starting at the
+// same time cannot both acquire it, and that it is released when the instance holding it exits, even if it is killed.
+// The lock file holds the PID of the instance holding the lock, to report and stop that instance.
 type InstanceLock struct {
 	lockFilePath string
 	pid          int
+	takenOverPID int
+
+	file *os.File
 }
 
 // New creates a new instance lock. The lock file will be created in the user's temp directory.
-func New() (*InstanceLock, error) {
+// Named instances have their own lock file, so that they run side by side with the default instance and each other.
+func New(config Config) (*InstanceLock, error) {
 	tempDir := os.TempDir()
 	lockFilePath := filepath.Join(tempDir, lockFileName)
+	if instanceID := config.InstanceID(); instanceID != "" {
+		lockFilePath = filepath.Join(tempDir, lockFileNamePrefix+instanceID+lockFileExtension)
+	}
 
 	return &InstanceLock{
 		lockFilePath: lockFilePath,
@@ -36,76 +61,223 @@ func (l *InstanceLock) TryLock() (bool, error) {
 }
 
 // TryLockWithKill attempts to acquire the lock, optionally killing the existing instance if one is running.
-// If killExisting is true and an existing instance is found, it will be terminated and the lock acquired.
+// If killExisting is true and an existing instance is found, it will be terminated, and the lock acquired once that
+// instance released it.
 // Returns true if lock was acquired, false if another instance is running and killExisting is false.
 func (l *InstanceLock) TryLockWithKill(killExisting bool) (bool, error) {
-	// Check if lock file exists
-	if _, err := os.Stat(l.lockFilePath); err == nil {
-		// Lock file exists, read the PID
-		pidBytes, err := os.ReadFile(l.lockFilePath)
+	if l.file != nil {
+		// We already have the lock
+		return true, nil
+	}
+
+	acquired, err := l.lock()
+	if err != nil || acquired {
+		return acquired, err
+	}
+
+	if !killExisting {
+		return false, nil
+	}
+
+	existingPID := l.readPID()
+	if existingPID == 0 {
+		// The instance holding the lock has not written its PID yet
+		return false, nil
+	}
+
+	// Don't kill our own process (shouldn't happen, but safety check)
+	if existingPID == l.pid {
+		return false, nil
+	}
+
+	if err := l.killProcess(existingPID); err != nil {
+		return false, fmt.Errorf("failed to kill existing instance (PID %d): %w", existingPID, err)
+	}
+
+	// Wait for the existing instance to stop, and release the lock
+	deadline := time.Now().Add(takeOverTimeout)
+	for time.Now().Before(deadline) {
+		time.Sleep(retryInterval)
+
+		acquired, err := l.lock()
 		if err != nil {
-			// If we can't read it, assume it's stale and try to remove it
-			os.Remove(l.lockFilePath)
-			return l.createLock()
+			return false, err
+		}
+		if acquired {
+			l.takenOverPID = existingPID
+			return true, nil
 		}
+	}
+
+	return false, fmt.Errorf("existing instance (PID %d) did not release the lock within %s", existingPID, takeOverTimeout)
+}
+
+// Path returns the path of the lock file.
+func (l *InstanceLock) Path() string {
+	return l.lockFilePath
+}
+
+// Holder returns the PID written in the lock file, and whether the instance holding the lock is still running.
+// It returns an error wrapping fs.ErrNotExist when there is no lock file, and a PID of 0 when the file holds no valid PID.
+func (l *InstanceLock) Holder() (int, bool, error) {
+	pidBytes, err := os.ReadFile(l.lockFilePath)
+	if err != nil {
+		return 0, false, err
+	}
+
+	pid, err := strconv.Atoi(strings.TrimSpace(string(pidBytes)))
+	if err != nil || pid <= 0 {
+		return 0, false, nil
+	}
+
+	held, err := l.isHeld()
+	if err != nil {
+		return 0, false, err
+	}
+
+	return pid, held, nil
+}
+
+// RemoveStale removes the lock file if the instance holding it is no longer running.
+func (l *InstanceLock) RemoveStale() error {
+	file, acquired, err := l.acquire(false)
+	if err != nil {
+		return err
+	}
+	if !acquired {
+		return fmt.Errorf("the instance holding the lock (PID %d) is running", l.readPID())
+	}
+
+	// Remove the lock file while holding the lock, so that no instance acquires the lock on the removed file
+	err = os.Remove(l.lockFilePath)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
 
-		existingPID, err := strconv.Atoi(strings.TrimSpace(string(pidBytes)))
+// IsProcessRunning returns whether the process with the given PID is running.
+func (l *InstanceLock) IsProcessRunning(pid int) bool {
+	return l.isProcessRunning(pid)
+}
+
+// Terminate asks the process with the given PID to stop. On Unix, it is sent SIGTERM, so that it shuts down gracefully.
+func (l *InstanceLock) Terminate(pid int) error {
+	return l.killProcess(pid)
+}
+
+// TakenOverPID returns the PID of the instance that was terminated to acquire the lock, if any.
+func (l *InstanceLock) TakenOverPID() (int, bool) {
+	return l.takenOverPID, l.takenOverPID != 0
+}
+
+// Unlock removes the lock file, and releases the lock
+func (l *InstanceLock) Unlock() error {
+	if l.file == nil {
+		return nil
+	}
+
+	// Remove the lock file while holding the lock, so that no instance acquires the lock on the removed file
+	err := os.Remove(l.lockFilePath)
+	if closeErr := l.file.Close(); err == nil {
+		err = closeErr
+	}
+	l.file = nil
+	return err
+}
+
+// lock acquires the lock, and keeps the lock file open for as long as the lock is held, with the PID written in it.
+func (l *InstanceLock) lock() (bool, error) {
+	file, acquired, err := l.acquire(true)
+	if err != nil || !acquired {
+		return false, err
+	}
+
+	pidStr := strconv.Itoa(l.pid)
+	if err := file.Truncate(0); err != nil {
+		_ = file.Close()
+		return false, fmt.Errorf("failed to create lock file: %w", err)
+	}
+	if _, err := file.WriteAt([]byte(pidStr), 0); err != nil {
+		_ = file.Close()
+		return false, fmt.Errorf("failed to create lock file: %w", err)
+	}
+
+	l.file = file
+	return true, nil
+}
+
+// acquire opens the lock file, creating it if create is true, and tries to lock it without waiting. The lock file is
+// returned open when the lock is acquired, and closed otherwise.
+func (l *InstanceLock) acquire(create bool) (*os.File, bool, error) {
+	for {
+		file, err := openLockFilePlatformSpecific(l.lockFilePath, create)
 		if err != nil {
-			// Invalid PID in lock file, remove it
-			os.Remove(l.lockFilePath)
-			return l.createLock()
+			return nil, false, fmt.Errorf("failed to open lock file: %w", err)
 		}
 
-		// Don't kill our own process (shouldn't happen, but safety check)
-		if existingPID == l.pid {
-			// We already have the lock
-			return true, nil
+		acquired, err := tryLockFilePlatformSpecific(file)
+		if err != nil {
+			_ = file.Close()
+			return nil, false, fmt.Errorf("failed to lock lock file: %w", err)
+		}
+		if !acquired {
+			_ = file.Close()
+			return nil, false, nil
 		}
 
-		// Check if the process is still running
-		if l.isProcessRunning(existingPID) {
-			// Another instance is running
-			if killExisting {
-				// Kill the existing instance
-				if err := l.killProcess(existingPID); err != nil {
-					return false, fmt.Errorf("failed to kill existing instance (PID %d): %w", existingPID, err)
-				}
-				// Wait for the process to exit (with retries)
-				// We check up to 10 times with 100ms delay between checks (max 1 second wait)
-				for i := 0; i < 10; i++ {
-					if !l.isProcessRunning(existingPID) {
-						break
-					}
-					time.Sleep(100 * time.Millisecond)
-				}
-				// Remove the lock file (process should be dead now)
-				os.Remove(l.lockFilePath)
-				return l.createLock()
-			}
-			return false, nil
+		// The instance that held the lock may have removed the lock file between the time it was opened and locked,
+		// in which case the lock was acquired on a removed file, and is acquired again on a new lock file.
+		if l.isLockFile(file) {
+			return file, true, nil
 		}
+		_ = file.Close()
+	}
+}
 
-		// Process is dead, remove stale lock file
-		os.Remove(l.lockFilePath)
+// isLockFile returns whether file is the file currently at the path of the lock file.
+func (l *InstanceLock) isLockFile(file *os.File) bool {
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return false
 	}
 
-	// No lock file or stale lock, create new one
-	return l.createLock()
+	pathInfo, err := os.Stat(l.lockFilePath)
+	if err != nil {
+		return false
+	}
+
+	return os.SameFile(fileInfo, pathInfo)
 }
 
-// createLock creates the lock file with current PID
-func (l *InstanceLock) createLock() (bool, error) {
-	pidStr := strconv.Itoa(l.pid)
-	err := os.WriteFile(l.lockFilePath, []byte(pidStr), 0o644)
+// isHeld returns whether the lock is held, by trying to acquire it.
+func (l *InstanceLock) isHeld() (bool, error) {
+	if l.file != nil {
+		return true, nil
+	}
+
+	file, acquired, err := l.acquire(false)
 	if err != nil {
-		return false, fmt.Errorf("failed to create lock file: %w", err)
+		return false, err
 	}
-	return true, nil
+	if acquired {
+		_ = file.Close()
+	}
+	return !acquired, nil
 }
 
-// Unlock removes the lock file
-func (l *InstanceLock) Unlock() error {
-	return os.Remove(l.lockFilePath)
+// readPID returns the PID written in the lock file, or 0 when the file holds no valid PID.
+func (l *InstanceLock) readPID() int {
+	pidBytes, err := os.ReadFile(l.lockFilePath)
+	if err != nil {
+		return 0
+	}
+
+	pid, err := strconv.Atoi(strings.TrimSpace(string(pidBytes)))
+	if err != nil || pid <= 0 {
+		return 0
+	}
+	return pid
 }
 
 // isProcessRunning checks if a process with the given PID is still running
@@ -117,4 +289,3 @@ func (l *InstanceLock) isProcessRunning(pid int) bool {
 func (l *InstanceLock) killProcess(pid int) error {
 	return killProcessPlatformSpecific(pid)
 }
-
@@ -0,0 +1,214 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package instancelock_test
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/instancelock"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/utils/instancelock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setTempDir makes the lock files of the test be created in a temporary folder of the test.
+func setTempDir(t *testing.T) string {
+	t.Helper()
+
+	tempDir := t.TempDir()
+	t.Setenv("TMPDIR", tempDir)
+	t.Setenv("TMP", tempDir)
+	t.Setenv("TEMP", tempDir)
+	return tempDir
+}
+
+func newInstanceLock(t *testing.T, instanceID string) *instancelock.InstanceLock {
+	t.Helper()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		InstanceID().
+		Return(instanceID).
+		Once()
+
+	lock, err := instancelock.New(mockConfig)
+	require.NoError(t, err)
+	return lock
+}
+
+func TestInstanceLock_TryLock_HappyPath(t *testing.T) {
+	// Arrange
+	tempDir := setTempDir(t)
+
+	lock := newInstanceLock(t, "")
+	other := newInstanceLock(t, "")
+
+	// Act
+	acquired, err := lock.TryLock()
+	require.NoError(t, err)
+	otherAcquired, otherErr := other.TryLock()
+	pid, running, holderErr := other.Holder()
+
+	// Assert
+	assert.True(t, acquired)
+	require.NoError(t, otherErr)
+	assert.False(t, otherAcquired, "The lock should not be acquired twice")
+
+	require.NoError(t, holderErr)
+	assert.Equal(t, os.Getpid(), pid)
+	assert.True(t, running)
+	assert.Equal(t, filepath.Join(tempDir, "matlab-mcp-core-server.lock"), lock.Path())
+
+	require.NoError(t, lock.Unlock())
+	_, err = os.Stat(lock.Path())
+	require.ErrorIs(t, err, fs.ErrNotExist)
+
+	otherAcquired, otherErr = other.TryLock()
+	require.NoError(t, otherErr)
+	assert.True(t, otherAcquired, "The lock should be acquired once released")
+	require.NoError(t, other.Unlock())
+}
+
+func TestInstanceLock_TryLock_ConcurrentInstances(t *testing.T) {
+	// Arrange
+	setTempDir(t)
+
+	const instances = 20
+	locks := make([]*instancelock.InstanceLock, instances)
+	for i := range locks {
+		locks[i] = newInstanceLock(t, "")
+	}
+
+	var waitGroup sync.WaitGroup
+	results := make([]bool, instances)
+	errs := make([]error, instances)
+
+	// Act
+	for i, lock := range locks {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			results[i], errs[i] = lock.TryLock()
+		}()
+	}
+	waitGroup.Wait()
+
+	// Assert
+	acquired := 0
+	for i := range locks {
+		require.NoError(t, errs[i])
+		if results[i] {
+			acquired++
+			require.NoError(t, locks[i].Unlock())
+		}
+	}
+	assert.Equal(t, 1, acquired, "Exactly one of the instances starting at the same time should acquire the lock")
+}
+
+func TestInstanceLock_TryLock_StaleLockFile(t *testing.T) {
+	// Arrange
+	setTempDir(t)
+
+	lock := newInstanceLock(t, "")
+	require.NoError(t, os.WriteFile(lock.Path(), []byte(strconv.Itoa(os.Getpid()+1)), 0o644))
+
+	// Act
+	acquired, err := lock.TryLockWithKill(true)
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, acquired)
+
+	_, takenOver := lock.TakenOverPID()
+	assert.False(t, takenOver, "A lock file that is not locked should not be taken over")
+
+	data, err := os.ReadFile(lock.Path())
+	require.NoError(t, err)
+	assert.Equal(t, strconv.Itoa(os.Getpid()), string(data))
+
+	require.NoError(t, lock.Unlock())
+}
+
+func TestInstanceLock_TryLock_NamedInstances(t *testing.T) {
+	// Arrange
+	tempDir := setTempDir(t)
+
+	defaultLock := newInstanceLock(t, "")
+	projectLock := newInstanceLock(t, "project")
+
+	// Act
+	defaultAcquired, defaultErr := defaultLock.TryLock()
+	projectAcquired, projectErr := projectLock.TryLock()
+
+	// Assert
+	require.NoError(t, defaultErr)
+	require.NoError(t, projectErr)
+	assert.True(t, defaultAcquired)
+	assert.True(t, projectAcquired, "Named instances should run side by side with the default instance")
+	assert.Equal(t, filepath.Join(tempDir, "matlab-mcp-core-server-project.lock"), projectLock.Path())
+
+	require.NoError(t, defaultLock.Unlock())
+	require.NoError(t, projectLock.Unlock())
+}
+
+func TestInstanceLock_Holder_NoLockFile(t *testing.T) {
+	// Arrange
+	setTempDir(t)
+
+	lock := newInstanceLock(t, "")
+
+	// Act
+	_, _, err := lock.Holder()
+
+	// Assert
+	require.ErrorIs(t, err, fs.ErrNotExist)
+}
+
+func TestInstanceLock_RemoveStale_HappyPath(t *testing.T) {
+	// Arrange
+	setTempDir(t)
+
+	lock := newInstanceLock(t, "")
+	require.NoError(t, os.WriteFile(lock.Path(), []byte(strconv.Itoa(os.Getpid()+1)), 0o644))
+
+	_, running, err := lock.Holder()
+	require.NoError(t, err)
+	require.False(t, running)
+
+	// Act
+	err = lock.RemoveStale()
+
+	// Assert
+	require.NoError(t, err)
+	_, err = os.Stat(lock.Path())
+	require.ErrorIs(t, err, fs.ErrNotExist)
+}
+
+func TestInstanceLock_RemoveStale_LockHeld(t *testing.T) {
+	// Arrange
+	setTempDir(t)
+
+	holder := newInstanceLock(t, "")
+	lock := newInstanceLock(t, "")
+
+	acquired, err := holder.TryLock()
+	require.NoError(t, err)
+	require.True(t, acquired)
+
+	// Act
+	err = lock.RemoveStale()
+
+	// Assert
+	require.ErrorContains(t, err, "is running")
+	_, statErr := os.Stat(holder.Path())
+	require.NoError(t, statErr, "The lock file of a running instance should not be removed")
+
+	require.NoError(t, holder.Unlock())
+}
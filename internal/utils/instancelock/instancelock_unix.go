@@ -4,10 +4,33 @@
 package instancelock
 
 import (
+	"errors"
 	"os"
 	"syscall"
 )
 
+// openLockFilePlatformSpecific opens the lock file on Unix, creating it if create is true
+func openLockFilePlatformSpecific(path string, create bool) (*os.File, error) {
+	flag := os.O_RDWR
+	if create {
+		flag |= os.O_CREATE
+	}
+	return os.OpenFile(path, flag, 0o644)
+}
+
+// tryLockFilePlatformSpecific takes an exclusive flock on the lock file on Unix, without waiting.
+// The lock is released when the file is closed, or when the process exits.
+func tryLockFilePlatformSpecific(file *os.File) (bool, error) {
+	err := syscall.Flock(int(file.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+	if errors.Is(err, syscall.EWOULDBLOCK) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // checkProcessRunningPlatformSpecific performs Unix-specific process existence check
 func checkProcessRunningPlatformSpecific(pid int) bool {
 	process, err := os.FindProcess(pid)
@@ -4,9 +4,59 @@
 package instancelock
 
 import (
+	"errors"
+	"os"
+
 	"golang.org/x/sys/windows"
 )
 
+// lockOffsetHigh places the locked byte 4 GiB into the lock file, far beyond the PID, as a lock on Windows also prevents
+// the other processes from reading the locked bytes.
+const lockOffsetHigh = 1
+
+// openLockFilePlatformSpecific opens the lock file on Windows, creating it if create is true.
+// The file is shared for deletion, so that the instance holding the lock can remove it before releasing the lock.
+func openLockFilePlatformSpecific(path string, create bool) (*os.File, error) {
+	name, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var disposition uint32 = windows.OPEN_EXISTING
+	if create {
+		disposition = windows.OPEN_ALWAYS
+	}
+
+	handle, err := windows.CreateFile(
+		name,
+		windows.GENERIC_READ|windows.GENERIC_WRITE,
+		windows.FILE_SHARE_READ|windows.FILE_SHARE_WRITE|windows.FILE_SHARE_DELETE,
+		nil,
+		disposition,
+		windows.FILE_ATTRIBUTE_NORMAL,
+		0,
+	)
+	if err != nil {
+		return nil, &os.PathError{Op: "open", Path: path, Err: err}
+	}
+
+	return os.NewFile(uintptr(handle), path), nil
+}
+
+// tryLockFilePlatformSpecific takes an exclusive LockFileEx lock on the lock file on Windows, without waiting.
+// The lock is released when the file is closed, or when the process exits.
+func tryLockFilePlatformSpecific(file *os.File) (bool, error) {
+	overlapped := &windows.Overlapped{OffsetHigh: lockOffsetHigh}
+	err := windows.LockFileEx(windows.Handle(file.Fd()), windows.LOCKFILE_EXCLUSIVE_LOCK|windows.LOCKFILE_FAIL_IMMEDIATELY, 0, 1, 0, overlapped)
+	if errors.Is(err, windows.ERROR_LOCK_VIOLATION) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // checkProcessRunningPlatformSpecific performs Windows-specific process existence check
 func checkProcessRunningPlatformSpecific(pid int) bool {
 	handle, err := windows.OpenProcess(windows.PROCESS_QUERY_INFORMATION, false, uint32(pid))
@@ -0,0 +1,195 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package mcode
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+type tokenKind int
+
+const (
+	tokenIdentifier tokenKind = iota
+	tokenNumber
+	tokenString
+	tokenPunctuation
+	tokenNewline
+)
+
+// token is a token of MATLAB code. Comments and continuations are dropped, and a newline token ends every line that
+// is not continued.
+type token struct {
+	kind        tokenKind
+	text        string
+	line        int
+	column      int
+	spaceBefore bool
+}
+
+// lexer splits MATLAB code into tokens, and reports the character vectors and strings that are not terminated.
+type lexer struct {
+	tokens      []token
+	diagnostics []Diagnostic
+	// depth is the number of brackets open, as a quote right after a space inside brackets starts a character vector.
+	depth int
+}
+
+func tokenize(lines []string) ([]token, []Diagnostic) {
+	l := &lexer{}
+	blockCommentDepth := 0
+	for index, line := range lines {
+		lineNumber := index + 1
+
+		switch trimmed := strings.TrimSpace(line); {
+		case trimmed == "%{":
+			blockCommentDepth++
+			continue
+		case trimmed == "%}" && blockCommentDepth > 0:
+			blockCommentDepth--
+			continue
+		case blockCommentDepth > 0:
+			continue
+		}
+
+		if l.lexLine(line, lineNumber) {
+			l.emit(token{kind: tokenNewline, line: lineNumber, column: utf8.RuneCountInString(line) + 1})
+		}
+	}
+	return l.tokens, l.diagnostics
+}
+
+// lexLine adds the tokens of line, and returns false when the line is continued on the next one.
+func (l *lexer) lexLine(line string, lineNumber int) bool {
+	column := func(offset int) int {
+		return utf8.RuneCountInString(line[:offset]) + 1
+	}
+
+	spaceBefore := true
+	for i := 0; i < len(line); {
+		c := line[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\r':
+			spaceBefore = true
+			i++
+			continue
+		case c == '%':
+			return true
+		case strings.HasPrefix(line[i:], "..."):
+			return false
+		case c == '!' && l.atStatementStart():
+			// Shell escapes run the rest of the line in the shell of the system.
+			return true
+		case isIdentifierStart(c):
+			end := i + 1
+			for end < len(line) && isIdentifierPart(line[end]) {
+				end++
+			}
+			l.emit(token{kind: tokenIdentifier, text: line[i:end], line: lineNumber, column: column(i), spaceBefore: spaceBefore})
+			i = end
+		case isDigit(c) || (c == '.' && i+1 < len(line) && isDigit(line[i+1])):
+			end := i + 1
+			for end < len(line) && (isIdentifierPart(line[end]) || (line[end] == '.' && !strings.HasPrefix(line[end:], ".'") && !strings.HasPrefix(line[end:], "..."))) {
+				if (line[end] == 'e' || line[end] == 'E') && end+1 < len(line) && (line[end+1] == '+' || line[end+1] == '-') {
+					end++
+				}
+				end++
+			}
+			l.emit(token{kind: tokenNumber, text: line[i:end], line: lineNumber, column: column(i), spaceBefore: spaceBefore})
+			i = end
+		case c == '"' || (c == '\'' && !l.isTranspose(spaceBefore)):
+			end, terminated := scanQuoted(line, i)
+			if !terminated {
+				message := "Character vector is not terminated properly."
+				if c == '"' {
+					message = "String is not terminated properly."
+				}
+				l.diagnostics = append(l.diagnostics, Diagnostic{Line: lineNumber, Column: column(i), Severity: SeverityError, Message: message})
+			}
+			l.emit(token{kind: tokenString, text: line[i:end], line: lineNumber, column: column(i), spaceBefore: spaceBefore})
+			i = end
+		default:
+			end := i + 1
+			if c == '.' && i+1 < len(line) && line[i+1] == '\'' {
+				end++
+			}
+			text := line[i:end]
+			switch text {
+			case "(", "[", "{":
+				l.depth++
+			case ")", "]", "}":
+				if l.depth > 0 {
+					l.depth--
+				}
+			}
+			l.emit(token{kind: tokenPunctuation, text: text, line: lineNumber, column: column(i), spaceBefore: spaceBefore})
+			i = end
+		}
+		spaceBefore = false
+	}
+	return true
+}
+
+func (l *lexer) emit(t token) {
+	l.tokens = append(l.tokens, t)
+}
+
+// isTranspose tells whether a quote is the transpose operator rather than the start of a character vector: it follows
+// a value, and is not separated from it by a space inside brackets, where a space separates the elements.
+func (l *lexer) isTranspose(spaceBefore bool) bool {
+	if len(l.tokens) == 0 {
+		return false
+	}
+
+	previous := l.tokens[len(l.tokens)-1]
+	if spaceBefore && l.depth > 0 {
+		return false
+	}
+
+	switch previous.kind {
+	case tokenIdentifier, tokenNumber:
+		return !spaceBefore || !isKeyword(previous.text) || previous.text == "end"
+	case tokenPunctuation:
+		switch previous.text {
+		case ")", "]", "}", "'", ".'":
+			return true
+		}
+	}
+	return false
+}
+
+func (l *lexer) atStatementStart() bool {
+	if len(l.tokens) == 0 {
+		return true
+	}
+	previous := l.tokens[len(l.tokens)-1]
+	return previous.kind == tokenNewline || (previous.kind == tokenPunctuation && (previous.text == ";" || previous.text == ","))
+}
+
+// scanQuoted returns the end of the character vector or string starting at start, where doubled quotes escape the quote.
+func scanQuoted(line string, start int) (int, bool) {
+	quote := line[start]
+	for i := start + 1; i < len(line); i++ {
+		if line[i] != quote {
+			continue
+		}
+		if i+1 < len(line) && line[i+1] == quote {
+			i++
+			continue
+		}
+		return i + 1, true
+	}
+	return len(line), false
+}
+
+func isIdentifierStart(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentifierPart(c byte) bool {
+	return isIdentifierStart(c) || isDigit(c) || c == '_'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
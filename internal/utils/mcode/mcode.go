@@ -0,0 +1,491 @@
+// Copyright 2025 The MathWorks, Inc.
+
+// Package mcode analyzes the source of MATLAB files without MATLAB: it finds the functions and classes they define,
+// with their help text, and the syntax errors that keep them from running, such as blocks missing their end.
+// It is not a full MATLAB parser, so it only reports the errors it can find without resolving names.
+package mcode
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+type DefinitionKind string
+
+const (
+	DefinitionKindFunction       DefinitionKind = "function"
+	DefinitionKindLocalFunction  DefinitionKind = "local function"
+	DefinitionKindNestedFunction DefinitionKind = "nested function"
+	DefinitionKindClass          DefinitionKind = "class"
+	DefinitionKindMethod         DefinitionKind = "method"
+)
+
+// Definition is a function or class defined in a MATLAB file. Lines and columns start at 1.
+type Definition struct {
+	Name      string
+	Kind      DefinitionKind
+	Line      int
+	Column    int
+	Signature string
+	Help      string
+}
+
+// Diagnostic is a problem found in a MATLAB file. Lines and columns start at 1.
+type Diagnostic struct {
+	Line     int
+	Column   int
+	Severity Severity
+	Message  string
+}
+
+type Analysis struct {
+	Definitions []Definition
+	Diagnostics []Diagnostic
+}
+
+// blockKeywords are the keywords opening a block closed by end, besides function and the keywords of class definitions.
+var blockKeywords = map[string]bool{
+	"if": true, "for": true, "parfor": true, "while": true, "switch": true, "try": true, "spmd": true,
+}
+
+// classKeywords open the blocks of a class definition.
+var classKeywords = map[string]bool{
+	"properties": true, "methods": true, "events": true, "enumeration": true,
+}
+
+func isKeyword(text string) bool {
+	switch text {
+	case "function", "classdef", "end", "else", "elseif", "case", "otherwise", "catch", "break", "continue", "return", "global", "persistent":
+		return true
+	}
+	return blockKeywords[text]
+}
+
+type block struct {
+	keyword string
+	line    int
+	column  int
+	// implicit is set on the functions of files whose functions are not closed by end.
+	implicit bool
+	// abstract is set on the methods blocks declaring abstract methods, which have no body and no end.
+	abstract bool
+}
+
+type analyzer struct {
+	fileName    string
+	lines       []string
+	tokens      []token
+	position    int
+	stack       []block
+	brackets    []token
+	analysis    Analysis
+	isClassFile bool
+	// functionsEnd is whether the functions of the file are closed by end, which must be the same for every function.
+	functionsEnd bool
+	// functionFile is whether the file starts with a function, rather than being a script.
+	functionFile bool
+}
+
+// Analyze analyzes the source of the MATLAB file fileName.
+func Analyze(fileName string, source string) Analysis {
+	lines := strings.Split(strings.ReplaceAll(source, "\r\n", "\n"), "\n")
+	tokens, diagnostics := tokenize(lines)
+
+	a := &analyzer{
+		fileName: fileName,
+		lines:    lines,
+		tokens:   tokens,
+	}
+	a.analysis.Diagnostics = diagnostics
+	a.scanFile()
+	a.run()
+	return a.analysis
+}
+
+// scanFile finds the kind of the file, and whether its functions are closed by end, by comparing the number of ends
+// with the number of blocks.
+func (a *analyzer) scanFile() {
+	functions, blocks, ends := 0, 0, 0
+	depth := 0
+	first := true
+	for i, t := range a.tokens {
+		switch {
+		case t.kind == tokenPunctuation && (t.text == "(" || t.text == "[" || t.text == "{"):
+			depth++
+		case t.kind == tokenPunctuation && (t.text == ")" || t.text == "]" || t.text == "}"):
+			if depth > 0 {
+				depth--
+			}
+		case t.kind != tokenIdentifier || depth > 0 || a.afterDot(i):
+		case t.text == "end":
+			ends++
+		case t.text == "function" && a.statementStart(i):
+			functions++
+			if first {
+				a.functionFile = true
+			}
+		case t.text == "classdef" && a.statementStart(i):
+			a.isClassFile = true
+		case blockKeywords[t.text] || (t.text == "arguments" && a.statementStart(i) && a.opensBlock(i)):
+			blocks++
+		}
+		if t.kind != tokenNewline {
+			first = false
+		}
+	}
+
+	// The functions of classes and the local functions of scripts always end with end. Otherwise, the functions end with
+	// end when there are more ends than other blocks, and a function missing its end is reported as such.
+	a.functionsEnd = a.isClassFile || (!a.functionFile && functions > 0) || ends > blocks
+}
+
+func (a *analyzer) run() {
+	for a.position = 0; a.position < len(a.tokens); a.position++ {
+		i := a.position
+		t := a.tokens[i]
+
+		switch {
+		case t.kind == tokenPunctuation:
+			a.bracket(t)
+			continue
+		case t.kind == tokenNewline:
+			a.newline()
+			continue
+		case t.kind != tokenIdentifier || len(a.brackets) > 0 || a.afterDot(i):
+			continue
+		}
+
+		switch {
+		case t.text == "end":
+			a.end(t)
+		case t.text == "function" && a.statementStart(i):
+			a.function(t)
+		case t.text == "classdef" && a.statementStart(i):
+			a.classdef(t)
+		case classKeywords[t.text] && a.statementStart(i) && a.top().keyword == "classdef" && a.opensBlock(i):
+			a.push(block{keyword: t.text, line: t.line, column: t.column, abstract: t.text == "methods" && a.hasAbstractAttribute(i)})
+		case t.text == "arguments" && a.statementStart(i) && a.top().keyword == "function" && a.opensBlock(i):
+			a.push(block{keyword: t.text, line: t.line, column: t.column})
+		case blockKeywords[t.text]:
+			a.push(block{keyword: t.text, line: t.line, column: t.column})
+		}
+	}
+
+	a.closeBrackets()
+	for len(a.stack) > 0 {
+		b := a.pop()
+		if !b.implicit {
+			a.report(b.line, b.column, SeverityError, fmt.Sprintf("'%s' at line %d is missing its 'end'.", b.keyword, b.line))
+		}
+	}
+}
+
+func (a *analyzer) bracket(t token) {
+	switch t.text {
+	case "(", "[", "{":
+		a.brackets = append(a.brackets, t)
+	case ")", "]", "}":
+		if len(a.brackets) == 0 || !matches(a.brackets[len(a.brackets)-1].text, t.text) {
+			a.report(t.line, t.column, SeverityError, fmt.Sprintf("Unbalanced or unexpected parenthesis or bracket: '%s'.", t.text))
+			a.brackets = nil
+			return
+		}
+		a.brackets = a.brackets[:len(a.brackets)-1]
+	}
+}
+
+// newline ends the statement, unless brackets or braces are open, in which case it separates their rows. Parentheses
+// cannot span lines without a continuation.
+func (a *analyzer) newline() {
+	for _, open := range a.brackets {
+		if open.text == "(" {
+			a.closeBrackets()
+			return
+		}
+	}
+}
+
+func (a *analyzer) closeBrackets() {
+	if len(a.brackets) > 0 {
+		open := a.brackets[len(a.brackets)-1]
+		a.report(open.line, open.column, SeverityError, fmt.Sprintf("'%s' is not closed.", open.text))
+	}
+	a.brackets = nil
+}
+
+func (a *analyzer) end(t token) {
+	if len(a.stack) == 0 || a.top().implicit {
+		a.report(t.line, t.column, SeverityError, "'end' does not close any block.")
+		return
+	}
+	a.pop()
+}
+
+func (a *analyzer) function(t token) {
+	name, signature := a.declaration(a.position)
+	top := a.top()
+
+	if top.keyword == "methods" && top.abstract {
+		a.define(name, DefinitionKindMethod, t, signature)
+		return
+	}
+
+	kind := DefinitionKindLocalFunction
+	switch {
+	case top.keyword == "methods":
+		kind = DefinitionKindMethod
+	case a.inFunction():
+		kind = DefinitionKindNestedFunction
+	case a.functionFile && !a.hasDefinition():
+		kind = DefinitionKindFunction
+		a.checkFileName(name, t)
+	}
+
+	if !a.functionsEnd {
+		// Without end, a function ends where the next one starts, and closes the blocks left open in it.
+		for len(a.stack) > 0 {
+			b := a.pop()
+			if !b.implicit {
+				a.report(b.line, b.column, SeverityError, fmt.Sprintf("'%s' at line %d is missing its 'end'.", b.keyword, b.line))
+			}
+		}
+		if kind == DefinitionKindNestedFunction {
+			kind = DefinitionKindLocalFunction
+		}
+	}
+
+	a.define(name, kind, t, signature)
+	a.push(block{keyword: "function", line: t.line, column: t.column, implicit: !a.functionsEnd})
+}
+
+func (a *analyzer) classdef(t token) {
+	i := a.position + 1
+	if i < len(a.tokens) && a.tokens[i].text == "(" {
+		i = a.skipGroup(i)
+	}
+
+	name := ""
+	if i < len(a.tokens) && a.tokens[i].kind == tokenIdentifier {
+		name = a.tokens[i].text
+	}
+
+	a.checkFileName(name, t)
+	a.define(name, DefinitionKindClass, t, strings.TrimSpace(a.lines[t.line-1]))
+	a.push(block{keyword: "classdef", line: t.line, column: t.column})
+}
+
+// declaration returns the name and the signature of the function declared at the function keyword at index i, as in
+// `function [a, b] = name(x, y)`, or `function value = get.Name(obj)` for property access methods.
+func (a *analyzer) declaration(i int) (string, string) {
+	first := a.tokens[i]
+	end := i + 1
+	for end < len(a.tokens) && a.tokens[end].kind != tokenNewline {
+		end++
+	}
+	statement := a.tokens[i+1 : end]
+
+	nameStart := 0
+	depth := 0
+	for j, t := range statement {
+		switch t.text {
+		case "[", "(":
+			depth++
+		case "]", ")":
+			depth--
+		case "=":
+			if depth == 0 && t.kind == tokenPunctuation {
+				nameStart = j + 1
+			}
+		}
+	}
+
+	var name strings.Builder
+	for _, t := range statement[min(nameStart, len(statement)):] {
+		if t.kind != tokenIdentifier && t.text != "." {
+			break
+		}
+		name.WriteString(t.text)
+	}
+
+	lastLine := first.line
+	if end < len(a.tokens) {
+		lastLine = a.tokens[end].line
+	}
+	signatureLines := make([]string, 0, lastLine-first.line+1)
+	for line := first.line; line <= lastLine && line <= len(a.lines); line++ {
+		signatureLines = append(signatureLines, strings.TrimSpace(a.lines[line-1]))
+	}
+
+	return name.String(), strings.Join(signatureLines, " ")
+}
+
+func (a *analyzer) define(name string, kind DefinitionKind, t token, signature string) {
+	if name == "" {
+		a.report(t.line, t.column, SeverityError, fmt.Sprintf("The '%s' declaration has no name.", t.text))
+		return
+	}
+
+	lastLine := t.line
+	for j := a.position; j < len(a.tokens); j++ {
+		if a.tokens[j].kind == tokenNewline {
+			lastLine = a.tokens[j].line
+			break
+		}
+	}
+
+	a.analysis.Definitions = append(a.analysis.Definitions, Definition{
+		Name:      name,
+		Kind:      kind,
+		Line:      t.line,
+		Column:    t.column,
+		Signature: signature,
+		Help:      helpText(a.lines, t.line, lastLine),
+	})
+}
+
+// checkFileName warns when the main function or the class of a file is not named after the file, as MATLAB calls them
+// by the name of the file.
+func (a *analyzer) checkFileName(name string, t token) {
+	if a.fileName == "" || name == "" {
+		return
+	}
+
+	fileName := strings.TrimSuffix(filepath.Base(a.fileName), filepath.Ext(a.fileName))
+	if name != fileName {
+		a.report(t.line, t.column, SeverityWarning, fmt.Sprintf("The name '%s' should match the file name '%s'.", name, fileName))
+	}
+}
+
+func (a *analyzer) hasDefinition() bool {
+	return len(a.analysis.Definitions) > 0
+}
+
+func (a *analyzer) inFunction() bool {
+	for _, b := range a.stack {
+		if b.keyword == "function" {
+			return true
+		}
+	}
+	return false
+}
+
+// opensBlock tells whether the keyword at index i opens a block, rather than being used as a variable, as in
+// `arguments = 1`: it is alone on its line, or followed by attributes.
+func (a *analyzer) opensBlock(i int) bool {
+	if i+1 >= len(a.tokens) {
+		return true
+	}
+	next := a.tokens[i+1]
+	return next.kind == tokenNewline || next.text == "("
+}
+
+// hasAbstractAttribute tells whether the attributes of the methods block at index i make its methods abstract.
+func (a *analyzer) hasAbstractAttribute(i int) bool {
+	for j := i + 1; j < len(a.tokens) && a.tokens[j].kind != tokenNewline; j++ {
+		if a.tokens[j].text != "Abstract" {
+			continue
+		}
+		if j+2 < len(a.tokens) && a.tokens[j+1].text == "=" {
+			return a.tokens[j+2].text == "true"
+		}
+		return true
+	}
+	return false
+}
+
+// skipGroup returns the index of the token after the parentheses opening at index i.
+func (a *analyzer) skipGroup(i int) int {
+	depth := 0
+	for ; i < len(a.tokens) && a.tokens[i].kind != tokenNewline; i++ {
+		switch a.tokens[i].text {
+		case "(":
+			depth++
+		case ")":
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+	return i
+}
+
+func (a *analyzer) statementStart(i int) bool {
+	if i == 0 {
+		return true
+	}
+	previous := a.tokens[i-1]
+	return previous.kind == tokenNewline || (previous.kind == tokenPunctuation && (previous.text == ";" || previous.text == ","))
+}
+
+func (a *analyzer) afterDot(i int) bool {
+	return i > 0 && a.tokens[i-1].kind == tokenPunctuation && a.tokens[i-1].text == "." && !a.tokens[i].spaceBefore
+}
+
+func (a *analyzer) push(b block) {
+	a.stack = append(a.stack, b)
+}
+
+func (a *analyzer) pop() block {
+	b := a.stack[len(a.stack)-1]
+	a.stack = a.stack[:len(a.stack)-1]
+	return b
+}
+
+func (a *analyzer) top() block {
+	if len(a.stack) == 0 {
+		return block{}
+	}
+	return a.stack[len(a.stack)-1]
+}
+
+func (a *analyzer) report(line int, column int, severity Severity, message string) {
+	a.analysis.Diagnostics = append(a.analysis.Diagnostics, Diagnostic{Line: line, Column: column, Severity: severity, Message: message})
+}
+
+func matches(open string, closing string) bool {
+	return (open == "(" && closing == ")") || (open == "[" && closing == "]") || (open == "{" && closing == "}")
+}
+
+// helpText is the help of a definition declared from line first to line last: the comment lines right after the
+// declaration, or else the comment lines right before it.
+func helpText(lines []string, first int, last int) string {
+	var help []string
+	for line := last + 1; line <= len(lines); line++ {
+		text, ok := commentText(lines[line-1])
+		if !ok {
+			break
+		}
+		help = append(help, text)
+	}
+
+	if len(help) == 0 {
+		for line := first - 1; line >= 1; line-- {
+			text, ok := commentText(lines[line-1])
+			if !ok {
+				break
+			}
+			help = append([]string{text}, help...)
+		}
+	}
+
+	return strings.TrimSpace(strings.Join(help, "\n"))
+}
+
+// commentText is the text of a line holding only a comment, without the percent sign and the space following it.
+func commentText(line string) (string, bool) {
+	trimmed := strings.TrimSpace(line)
+	if !strings.HasPrefix(trimmed, "%") || trimmed == "%{" || trimmed == "%}" {
+		return "", false
+	}
+	text := strings.TrimLeft(trimmed, "%")
+	return strings.TrimPrefix(text, " "), true
+}
@@ -0,0 +1,219 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package mcode_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/mcode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyze_FunctionFile(t *testing.T) {
+	// Arrange
+	source := `function [total, count] = addAll(values, ...
+        scale)
+% ADDALL Adds the scaled values.
+%   total = addAll(values, scale)
+total = 0;
+count = 0;
+for value = values
+    if value > 0 % Only positive values
+        total = total + scale * value';
+        count = count + 1;
+    end
+end
+label = 'it''s done';
+disp(label(end))
+    function helper()
+        disp("nested")
+    end
+end
+
+function localHelper(x)
+y = x(end) + 1;
+end
+`
+
+	// Act
+	analysis := mcode.Analyze("/home/user/project/addAll.m", source)
+
+	// Assert
+	assert.Empty(t, analysis.Diagnostics)
+	require.Len(t, analysis.Definitions, 3)
+
+	assert.Equal(t, mcode.Definition{
+		Name:      "addAll",
+		Kind:      mcode.DefinitionKindFunction,
+		Line:      1,
+		Column:    1,
+		Signature: "function [total, count] = addAll(values, ... scale)",
+		Help:      "ADDALL Adds the scaled values.\n  total = addAll(values, scale)",
+	}, analysis.Definitions[0])
+	assert.Equal(t, "helper", analysis.Definitions[1].Name)
+	assert.Equal(t, mcode.DefinitionKindNestedFunction, analysis.Definitions[1].Kind)
+	assert.Equal(t, 15, analysis.Definitions[1].Line)
+	assert.Equal(t, 5, analysis.Definitions[1].Column)
+	assert.Equal(t, "localHelper", analysis.Definitions[2].Name)
+	assert.Equal(t, mcode.DefinitionKindLocalFunction, analysis.Definitions[2].Kind)
+}
+
+func TestAnalyze_FunctionsWithoutEnd(t *testing.T) {
+	// Arrange
+	source := `function main()
+if true
+    helper();
+end
+
+% HELPER Helps.
+function helper()
+disp('help')
+`
+
+	// Act
+	analysis := mcode.Analyze("main.m", source)
+
+	// Assert
+	assert.Empty(t, analysis.Diagnostics)
+	require.Len(t, analysis.Definitions, 2)
+	assert.Equal(t, mcode.DefinitionKindFunction, analysis.Definitions[0].Kind)
+	assert.Equal(t, mcode.DefinitionKindLocalFunction, analysis.Definitions[1].Kind)
+	assert.Equal(t, "HELPER Helps.", analysis.Definitions[1].Help, "The comment before the function should be its help when none follows")
+}
+
+func TestAnalyze_ClassFile(t *testing.T) {
+	// Arrange
+	source := `classdef (Sealed) Account < handle
+    % ACCOUNT A bank account.
+    properties (Access = private)
+        Balance = 0
+    end
+    events
+        Overdrawn
+    end
+    methods
+        function obj = Account(balance)
+            obj.Balance = balance;
+        end
+        function value = get.Balance(obj)
+            value = obj.Balance;
+        end
+    end
+    methods (Abstract)
+        withdraw(obj, amount)
+        function deposit(obj, amount)
+    end
+end
+`
+
+	// Act
+	analysis := mcode.Analyze("Account.m", source)
+
+	// Assert
+	assert.Empty(t, analysis.Diagnostics)
+	require.Len(t, analysis.Definitions, 4)
+	assert.Equal(t, "Account", analysis.Definitions[0].Name)
+	assert.Equal(t, mcode.DefinitionKindClass, analysis.Definitions[0].Kind)
+	assert.Equal(t, "ACCOUNT A bank account.", analysis.Definitions[0].Help)
+	assert.Equal(t, "Account", analysis.Definitions[1].Name)
+	assert.Equal(t, mcode.DefinitionKindMethod, analysis.Definitions[1].Kind)
+	assert.Equal(t, "get.Balance", analysis.Definitions[2].Name)
+	assert.Equal(t, "deposit", analysis.Definitions[3].Name)
+	assert.Equal(t, mcode.DefinitionKindMethod, analysis.Definitions[3].Kind)
+}
+
+func TestAnalyze_Diagnostics(t *testing.T) {
+	testConfigs := []struct {
+		name               string
+		fileName           string
+		source             string
+		expectedDiagnostic mcode.Diagnostic
+	}{
+		{
+			name:     "block missing its end",
+			fileName: "script.m",
+			source:   "x = 1;\nwhile x < 3\n    x = x + 1;\n",
+			expectedDiagnostic: mcode.Diagnostic{
+				Line: 2, Column: 1, Severity: mcode.SeverityError, Message: "'while' at line 2 is missing its 'end'.",
+			},
+		},
+		{
+			name:     "unexpected end",
+			fileName: "script.m",
+			source:   "x = 1;\nend\n",
+			expectedDiagnostic: mcode.Diagnostic{
+				Line: 2, Column: 1, Severity: mcode.SeverityError, Message: "'end' does not close any block.",
+			},
+		},
+		{
+			name:     "unbalanced parenthesis",
+			fileName: "script.m",
+			source:   "x = [1, 2);\n",
+			expectedDiagnostic: mcode.Diagnostic{
+				Line: 1, Column: 10, Severity: mcode.SeverityError, Message: "Unbalanced or unexpected parenthesis or bracket: ')'.",
+			},
+		},
+		{
+			name:     "parenthesis not closed",
+			fileName: "script.m",
+			source:   "disp(max(1, 2)\n",
+			expectedDiagnostic: mcode.Diagnostic{
+				Line: 1, Column: 5, Severity: mcode.SeverityError, Message: "'(' is not closed.",
+			},
+		},
+		{
+			name:     "character vector not terminated",
+			fileName: "script.m",
+			source:   "x = 'abc;\n",
+			expectedDiagnostic: mcode.Diagnostic{
+				Line: 1, Column: 5, Severity: mcode.SeverityError, Message: "Character vector is not terminated properly.",
+			},
+		},
+		{
+			name:     "string not terminated",
+			fileName: "script.m",
+			source:   "x = \"abc;\n",
+			expectedDiagnostic: mcode.Diagnostic{
+				Line: 1, Column: 5, Severity: mcode.SeverityError, Message: "String is not terminated properly.",
+			},
+		},
+		{
+			name:     "function not named after the file",
+			fileName: "/home/user/project/other.m",
+			source:   "function main()\ndisp(1)\n",
+			expectedDiagnostic: mcode.Diagnostic{
+				Line: 1, Column: 1, Severity: mcode.SeverityWarning, Message: "The name 'main' should match the file name 'other'.",
+			},
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Act
+			analysis := mcode.Analyze(testConfig.fileName, testConfig.source)
+
+			// Assert
+			assert.Equal(t, []mcode.Diagnostic{testConfig.expectedDiagnostic}, analysis.Diagnostics)
+		})
+	}
+}
+
+func TestAnalyze_IgnoresCommentsAndStrings(t *testing.T) {
+	// Arrange
+	source := `%{
+if this were code, it would miss its end
+%}
+x = "end if (";  % for while (
+y = {'end', 'if'};
+z = s.end + [1 2]';
+!echo if (
+`
+
+	// Act
+	analysis := mcode.Analyze("script.m", source)
+
+	// Assert
+	assert.Empty(t, analysis.Diagnostics)
+	assert.Empty(t, analysis.Definitions)
+}
@@ -0,0 +1,33 @@
+// Copyright 2025 The MathWorks, Inc.
+
+// Package outputstream lets the code handling a request send output to the MCP client that sent it while the request runs.
+package outputstream
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNotSupported is returned when the output of the request cannot be sent to its client while the request runs.
+var ErrNotSupported = errors.New("the MCP client did not ask for the output of the request while it runs")
+
+// Writer sends text to the client of the request.
+type Writer func(ctx context.Context, text string) error
+
+type contextKey struct{}
+
+// NewContext returns a context in which output is sent to the client with writer.
+func NewContext(ctx context.Context, writer Writer) context.Context {
+	return context.WithValue(ctx, contextKey{}, writer)
+}
+
+// Write sends text to the client of the request running in ctx.
+// It returns ErrNotSupported if ctx was not created by NewContext.
+func Write(ctx context.Context, text string) error {
+	writer, ok := ctx.Value(contextKey{}).(Writer)
+	if !ok {
+		return ErrNotSupported
+	}
+
+	return writer(ctx, text)
+}
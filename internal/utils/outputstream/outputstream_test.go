@@ -0,0 +1,49 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package outputstream_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/outputstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrite_UsesWriter(t *testing.T) {
+	// Arrange
+	var written string
+	ctx := outputstream.NewContext(t.Context(), func(ctx context.Context, text string) error {
+		written += text
+		return nil
+	})
+
+	// Act
+	err := outputstream.Write(ctx, "iteration 1\n")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "iteration 1\n", written)
+}
+
+func TestWrite_WriterError(t *testing.T) {
+	// Arrange
+	ctx := outputstream.NewContext(t.Context(), func(ctx context.Context, text string) error {
+		return assert.AnError
+	})
+
+	// Act
+	err := outputstream.Write(ctx, "iteration 1\n")
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+func TestWrite_Missing(t *testing.T) {
+	// Act
+	err := outputstream.Write(t.Context(), "iteration 1\n")
+
+	// Assert
+	require.ErrorIs(t, err, outputstream.ErrNotSupported)
+}
@@ -0,0 +1,51 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package processmemory
+
+import (
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// Reader reads the memory used by other processes, such as the MATLAB session, without going through them,
+// so that it can be read while they are busy.
+type Reader struct{}
+
+func New() *Reader {
+	return &Reader{}
+}
+
+// ResidentBytes returns the resident set size of the process, in bytes.
+func (r *Reader) ResidentBytes(pid int) (int64, error) {
+	name, args := residentMemoryCommand(pid)
+
+	output, err := exec.Command(name, args...).Output()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read the memory of process %d: %w", pid, err)
+	}
+
+	kilobytes, err := parseResidentKilobytes(string(output))
+	if err != nil {
+		return 0, fmt.Errorf("failed to read the memory of process %d: %w", pid, err)
+	}
+
+	return kilobytes * 1024, nil
+}
+
+// digitsOf returns the number made of the digits of text, ignoring the thousands separators and the units.
+func digitsOf(text string) (int64, error) {
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, text)
+
+	if digits == "" {
+		return 0, fmt.Errorf("no memory usage in %q", strings.TrimSpace(text))
+	}
+
+	return strconv.ParseInt(digits, 10, 64)
+}
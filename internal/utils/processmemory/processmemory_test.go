@@ -0,0 +1,36 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package processmemory_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/processmemory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReader_ResidentBytes_HappyPath(t *testing.T) {
+	// Arrange
+	reader := processmemory.New()
+
+	// Act
+	residentBytes, err := reader.ResidentBytes(os.Getpid())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Positive(t, residentBytes)
+}
+
+func TestReader_ResidentBytes_UnknownProcess(t *testing.T) {
+	// Arrange
+	reader := processmemory.New()
+
+	// Act
+	residentBytes, err := reader.ResidentBytes(999999999)
+
+	// Assert
+	require.Error(t, err)
+	assert.Zero(t, residentBytes)
+}
@@ -0,0 +1,17 @@
+// Copyright 2025 The MathWorks, Inc.
+//go:build !windows
+
+package processmemory
+
+import (
+	"strconv"
+)
+
+// residentMemoryCommand returns the command printing the resident set size of the process, in kilobytes.
+func residentMemoryCommand(pid int) (string, []string) {
+	return "ps", []string{"-o", "rss=", "-p", strconv.Itoa(pid)}
+}
+
+func parseResidentKilobytes(output string) (int64, error) {
+	return digitsOf(output)
+}
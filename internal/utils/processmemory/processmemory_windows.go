@@ -0,0 +1,26 @@
+// Copyright 2025 The MathWorks, Inc.
+//go:build windows
+
+package processmemory
+
+import (
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// residentMemoryCommand returns the command printing the process as a CSV row, with its working set in kilobytes as the last field.
+func residentMemoryCommand(pid int) (string, []string) {
+	return "tasklist", []string{"/FI", "PID eq " + strconv.Itoa(pid), "/FO", "CSV", "/NH"}
+}
+
+func parseResidentKilobytes(output string) (int64, error) {
+	record, err := csv.NewReader(strings.NewReader(output)).Read()
+	if err != nil || len(record) < 5 {
+		// tasklist prints an informational message, rather than a row, when no process matches.
+		return 0, fmt.Errorf("no memory usage in %q", strings.TrimSpace(output))
+	}
+
+	return digitsOf(record[len(record)-1])
+}
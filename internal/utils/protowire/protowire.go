@@ -0,0 +1,123 @@
+// Copyright 2025 The MathWorks, Inc.
+
+// Package protowire encodes and decodes the wire format of Protocol Buffers, for the few flat messages the server
+// exchanges with gRPC clients, without generated code.
+package protowire
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+var ErrTruncated = errors.New("truncated message")
+
+// Encoder appends the fields of a message. As in proto3, fields with their default value are not encoded.
+type Encoder struct {
+	buf []byte
+}
+
+func (e *Encoder) String(number int, value string) {
+	if value == "" {
+		return
+	}
+	e.buf = binary.AppendUvarint(e.buf, uint64(number)<<3|wireBytes)
+	e.buf = binary.AppendUvarint(e.buf, uint64(len(value)))
+	e.buf = append(e.buf, value...)
+}
+
+func (e *Encoder) Bool(number int, value bool) {
+	if !value {
+		return
+	}
+	e.buf = binary.AppendUvarint(e.buf, uint64(number)<<3|wireVarint)
+	e.buf = append(e.buf, 1)
+}
+
+func (e *Encoder) Int64(number int, value int64) {
+	if value == 0 {
+		return
+	}
+	e.buf = binary.AppendUvarint(e.buf, uint64(number)<<3|wireVarint)
+	e.buf = binary.AppendUvarint(e.buf, uint64(value))
+}
+
+// Bytes is the encoded message.
+func (e *Encoder) Bytes() []byte {
+	return e.buf
+}
+
+// Fields are the scalar and length-delimited fields of a decoded message, by field number.
+// As in proto3, the last occurrence of a field wins, and a missing field has its default value.
+type Fields map[int]field
+
+type field struct {
+	varint uint64
+	bytes  []byte
+}
+
+func (f Fields) String(number int) string {
+	return string(f[number].bytes)
+}
+
+func (f Fields) Bool(number int) bool {
+	return f[number].varint != 0
+}
+
+func (f Fields) Int64(number int) int64 {
+	return int64(f[number].varint)
+}
+
+// Decode parses the fields of a message. Fixed-size fields are skipped, as none of the messages of the server use them.
+func Decode(data []byte) (Fields, error) {
+	fields := Fields{}
+	for len(data) > 0 {
+		key, n := binary.Uvarint(data)
+		if n <= 0 {
+			return nil, ErrTruncated
+		}
+		data = data[n:]
+
+		number := int(key >> 3)
+		if number <= 0 {
+			return nil, fmt.Errorf("invalid field number %d", number)
+		}
+
+		switch wireType := key & 0x7; wireType {
+		case wireVarint:
+			value, n := binary.Uvarint(data)
+			if n <= 0 {
+				return nil, ErrTruncated
+			}
+			data = data[n:]
+			fields[number] = field{varint: value}
+		case wireBytes:
+			length, n := binary.Uvarint(data)
+			if n <= 0 || uint64(len(data)-n) < length {
+				return nil, ErrTruncated
+			}
+			fields[number] = field{bytes: data[n : n+int(length)]}
+			data = data[n+int(length):]
+		case wireFixed64:
+			if len(data) < 8 {
+				return nil, ErrTruncated
+			}
+			data = data[8:]
+		case wireFixed32:
+			if len(data) < 4 {
+				return nil, ErrTruncated
+			}
+			data = data[4:]
+		default:
+			return nil, fmt.Errorf("unsupported wire type %d", wireType)
+		}
+	}
+	return fields, nil
+}
@@ -0,0 +1,95 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package protowire_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/protowire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncoder_RoundTrip(t *testing.T) {
+	// Arrange
+	encoder := &protowire.Encoder{}
+	encoder.String(1, "disp('héllo')")
+	encoder.Bool(2, true)
+	encoder.Int64(3, 300)
+
+	// Act
+	fields, err := protowire.Decode(encoder.Bytes())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "disp('héllo')", fields.String(1))
+	assert.True(t, fields.Bool(2))
+	assert.Equal(t, int64(300), fields.Int64(3))
+}
+
+func TestEncoder_DefaultValuesAreOmitted(t *testing.T) {
+	// Arrange
+	encoder := &protowire.Encoder{}
+
+	// Act
+	encoder.String(1, "")
+	encoder.Bool(2, false)
+	encoder.Int64(3, 0)
+
+	// Assert
+	assert.Empty(t, encoder.Bytes())
+}
+
+func TestDecode_KnownEncoding(t *testing.T) {
+	// Arrange
+	// Field 1 as the string "ab", a fixed 64-bit field 4, a fixed 32-bit field 5, and field 2 as the varint 150.
+	data := []byte{0x0A, 0x02, 'a', 'b', 0x21, 1, 2, 3, 4, 5, 6, 7, 8, 0x2D, 1, 2, 3, 4, 0x10, 0x96, 0x01}
+
+	// Act
+	fields, err := protowire.Decode(data)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "ab", fields.String(1))
+	assert.Equal(t, int64(150), fields.Int64(2))
+	assert.Empty(t, fields.String(3), "Missing fields should have their default value")
+}
+
+func TestDecode_Invalid(t *testing.T) {
+	testConfigs := []struct {
+		name string
+		data []byte
+	}{
+		{
+			name: "truncated key",
+			data: []byte{0x80},
+		},
+		{
+			name: "truncated string",
+			data: []byte{0x0A, 0x05, 'a'},
+		},
+		{
+			name: "truncated fixed field",
+			data: []byte{0x21, 1, 2},
+		},
+		{
+			name: "field number zero",
+			data: []byte{0x00, 0x01},
+		},
+		{
+			name: "group wire type",
+			data: []byte{0x0B},
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Act
+			fields, err := protowire.Decode(testConfig.data)
+
+			// Assert
+			require.Error(t, err)
+			assert.Nil(t, fields)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2025 The MathWorks, Inc.
+
+// Package toolfailure carries the machine-readable description of a failed tool call,
+// from the tool handler that failed back up to the MCP server, through the request context.
+package toolfailure
+
+import (
+	"context"
+	"sync"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// MetaKey is the key of the failure in the `_meta` field of a failed tool call result.
+const MetaKey = "error"
+
+// Failure is the structured error payload returned to clients for every failed tool call.
+type Failure struct {
+	Code          entities.ErrorCode `json:"code"`
+	Message       string             `json:"message"`
+	CorrelationID string             `json:"correlation-id,omitempty"`
+}
+
+type contextKey struct{}
+
+type holder struct {
+	lock    sync.Mutex
+	failure *Failure
+}
+
+// NewContext returns a context in which a tool handler can report its failure.
+func NewContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKey{}, &holder{})
+}
+
+// Report records the failure of the tool call running in ctx. It is a no-op if ctx was not created by NewContext.
+func Report(ctx context.Context, failure Failure) {
+	h, ok := ctx.Value(contextKey{}).(*holder)
+	if !ok {
+		return
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.failure = &failure
+}
+
+// FromContext returns the failure reported in ctx, if any.
+func FromContext(ctx context.Context) (Failure, bool) {
+	h, ok := ctx.Value(contextKey{}).(*holder)
+	if !ok {
+		return Failure{}, false
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if h.failure == nil {
+		return Failure{}, false
+	}
+	return *h.failure, true
+}
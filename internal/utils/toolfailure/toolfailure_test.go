@@ -0,0 +1,50 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package toolfailure_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/toolfailure"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReport_HappyPath(t *testing.T) {
+	// Arrange
+	ctx := toolfailure.NewContext(t.Context())
+	expectedFailure := toolfailure.Failure{
+		Code:    entities.ErrorCodeSessionNotFound,
+		Message: "session not found: 1",
+	}
+
+	// Act
+	toolfailure.Report(ctx, expectedFailure)
+	failure, ok := toolfailure.FromContext(ctx)
+
+	// Assert
+	require.True(t, ok)
+	assert.Equal(t, expectedFailure, failure)
+}
+
+func TestFromContext_NothingReported(t *testing.T) {
+	// Arrange
+	ctx := toolfailure.NewContext(t.Context())
+
+	// Act
+	failure, ok := toolfailure.FromContext(ctx)
+
+	// Assert
+	require.False(t, ok)
+	assert.Empty(t, failure)
+}
+
+func TestReport_WithoutFailureContextIsNoOp(t *testing.T) {
+	// Act
+	toolfailure.Report(t.Context(), toolfailure.Failure{Code: entities.ErrorCodeInternal})
+	_, ok := toolfailure.FromContext(t.Context())
+
+	// Assert
+	assert.False(t, ok)
+}
@@ -0,0 +1,286 @@
+// Copyright 2025 The MathWorks, Inc.
+
+// Package tracing records the spans of the work done for a request, following the OpenTelemetry data model, so that
+// the time spent in each stage of a tool call, such as waiting for MATLAB, starting MATLAB and evaluating code, can be
+// seen in a tracing backend.
+//
+// The tracer travels in the context.Context of the request with the current span, so that code deep in the call
+// stack only calls Start, and records nothing when the request is not traced.
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TraceParentHeader is the W3C Trace Context header, and the _meta key, that carries the span a request belongs to.
+const TraceParentHeader = "traceparent"
+
+// SpanKind is the role of a span in a trace, with the values of the OpenTelemetry protocol.
+type SpanKind int
+
+const (
+	SpanKindInternal SpanKind = 1
+	SpanKindServer   SpanKind = 2
+	SpanKindClient   SpanKind = 3
+)
+
+type TraceID [16]byte
+
+func (id TraceID) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+func (id TraceID) IsValid() bool {
+	return id != TraceID{}
+}
+
+type SpanID [8]byte
+
+func (id SpanID) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+func (id SpanID) IsValid() bool {
+	return id != SpanID{}
+}
+
+// SpanContext identifies a span, possibly recorded by another process.
+type SpanContext struct {
+	TraceID TraceID
+	SpanID  SpanID
+	Sampled bool
+}
+
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceID.IsValid() && sc.SpanID.IsValid()
+}
+
+// TraceParent is the value of the traceparent header of a request made on behalf of the span.
+func (sc SpanContext) TraceParent() string {
+	flags := "00"
+	if sc.Sampled {
+		flags = "01"
+	}
+	return fmt.Sprintf("00-%s-%s-%s", sc.TraceID, sc.SpanID, flags)
+}
+
+// ParseTraceParent parses the value of a traceparent header. Versions other than 00 are parsed as version 00, as
+// the W3C Trace Context specification asks.
+func ParseTraceParent(value string) (SpanContext, bool) {
+	parts := strings.Split(strings.TrimSpace(value), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || parts[0] == "ff" || (parts[0] == "00" && len(parts) != 4) {
+		return SpanContext{}, false
+	}
+
+	var sc SpanContext
+	var flags [1]byte
+	if !decodeHex(sc.TraceID[:], parts[1]) || !decodeHex(sc.SpanID[:], parts[2]) || !decodeHex(flags[:], parts[3]) {
+		return SpanContext{}, false
+	}
+	if !sc.IsValid() {
+		return SpanContext{}, false
+	}
+
+	sc.Sampled = flags[0]&1 == 1
+	return sc, true
+}
+
+func decodeHex(destination []byte, value string) bool {
+	if len(value) != 2*len(destination) || strings.ToLower(value) != value {
+		return false
+	}
+	_, err := hex.Decode(destination, []byte(value))
+	return err == nil
+}
+
+// Attribute is a key and value describing a span. Values are strings, int64, float64 or bool.
+type Attribute struct {
+	Key   string
+	Value any
+}
+
+func String(key string, value string) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+func Int(key string, value int) Attribute {
+	return Attribute{Key: key, Value: int64(value)}
+}
+
+func Bool(key string, value bool) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Exporter sends the spans that ended to a tracing backend.
+type Exporter interface {
+	Enabled() bool
+	ExportSpan(span *Span)
+}
+
+// Tracer starts the root spans of requests, and hands the ended spans to its exporter.
+type Tracer struct {
+	exporter Exporter
+}
+
+func NewTracer(exporter Exporter) *Tracer {
+	return &Tracer{
+		exporter: exporter,
+	}
+}
+
+// Start starts a span, as a child of the span of ctx, of the remote span of ctx, or as the root of a new trace.
+// The returned context holds the span and the tracer, so that Start called with it records the children of the span.
+// It returns a nil span, whose methods do nothing, when the tracer does not export spans.
+func (t *Tracer) Start(ctx context.Context, name string, kind SpanKind, attributes ...Attribute) (context.Context, *Span) {
+	if t == nil || !t.exporter.Enabled() {
+		return ctx, nil
+	}
+	return start(context.WithValue(ctx, tracerKey{}, t), t, name, kind, attributes)
+}
+
+type tracerKey struct{}
+
+type spanKey struct{}
+
+type remoteParentKey struct{}
+
+// Start starts a child of the span of ctx. It records nothing when the request of ctx is not traced.
+func Start(ctx context.Context, name string, kind SpanKind, attributes ...Attribute) (context.Context, *Span) {
+	tracer, ok := ctx.Value(tracerKey{}).(*Tracer)
+	if !ok {
+		return ctx, nil
+	}
+	return start(ctx, tracer, name, kind, attributes)
+}
+
+func start(ctx context.Context, tracer *Tracer, name string, kind SpanKind, attributes []Attribute) (context.Context, *Span) {
+	span := &Span{
+		tracer:     tracer,
+		Name:       name,
+		Kind:       kind,
+		StartTime:  time.Now(),
+		Attributes: attributes,
+	}
+
+	switch parent, remoteParent := SpanFromContext(ctx), remoteParentFromContext(ctx); {
+	case parent != nil:
+		span.TraceID = parent.TraceID
+		span.ParentSpanID = parent.SpanID
+	case remoteParent.IsValid():
+		// The caller decided not to sample the trace, so that none of its spans are recorded.
+		if !remoteParent.Sampled {
+			return ctx, nil
+		}
+		span.TraceID = remoteParent.TraceID
+		span.ParentSpanID = remoteParent.SpanID
+	default:
+		_, _ = rand.Read(span.TraceID[:])
+	}
+	_, _ = rand.Read(span.SpanID[:])
+
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+// SpanFromContext returns the current span of ctx, or nil when there is none.
+func SpanFromContext(ctx context.Context) *Span {
+	span, _ := ctx.Value(spanKey{}).(*Span)
+	return span
+}
+
+// ContextWithRemoteParent returns a context whose next span is a child of a span of another process, such as the
+// span of the traceparent header of a request.
+func ContextWithRemoteParent(ctx context.Context, parent SpanContext) context.Context {
+	return context.WithValue(ctx, remoteParentKey{}, parent)
+}
+
+func remoteParentFromContext(ctx context.Context) SpanContext {
+	parent, _ := ctx.Value(remoteParentKey{}).(SpanContext)
+	return parent
+}
+
+// Span is an operation of a trace. The exported fields must not be changed once the span ended.
+// The methods of a nil span do nothing, so that code does not check whether its request is traced.
+type Span struct {
+	tracer *Tracer
+
+	Name          string
+	Kind          SpanKind
+	TraceID       TraceID
+	SpanID        SpanID
+	ParentSpanID  SpanID
+	StartTime     time.Time
+	EndTime       time.Time
+	Attributes    []Attribute
+	Failed        bool
+	StatusMessage string
+
+	lock  sync.Mutex
+	ended bool
+}
+
+// SpanContext identifies the span in the requests made on its behalf.
+func (s *Span) SpanContext() SpanContext {
+	if s == nil {
+		return SpanContext{}
+	}
+	return SpanContext{TraceID: s.TraceID, SpanID: s.SpanID, Sampled: true}
+}
+
+func (s *Span) SetAttributes(attributes ...Attribute) {
+	if s == nil {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if !s.ended {
+		s.Attributes = append(s.Attributes, attributes...)
+	}
+}
+
+// RecordError marks the span as failed with the error, when err is not nil.
+func (s *Span) RecordError(err error) {
+	if err != nil {
+		s.SetError(err.Error())
+	}
+}
+
+// SetError marks the span as failed.
+func (s *Span) SetError(message string) {
+	if s == nil {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if !s.ended {
+		s.Failed = true
+		s.StatusMessage = message
+	}
+}
+
+// End ends the span and exports it. Only the first call has an effect.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+
+	s.lock.Lock()
+	if s.ended {
+		s.lock.Unlock()
+		return
+	}
+	s.ended = true
+	s.EndTime = time.Now()
+	s.lock.Unlock()
+
+	s.tracer.exporter.ExportSpan(s)
+}
@@ -0,0 +1,171 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package tracing_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/tracing"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/utils/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const remoteTraceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+func newEnabledExporter(t *testing.T) *mocks.MockExporter {
+	t.Helper()
+
+	mockExporter := &mocks.MockExporter{}
+	t.Cleanup(func() { mockExporter.AssertExpectations(t) })
+
+	mockExporter.EXPECT().
+		Enabled().
+		Return(true).
+		Maybe()
+
+	return mockExporter
+}
+
+func TestTracer_Start_ChildSpans(t *testing.T) {
+	// Arrange
+	mockExporter := newEnabledExporter(t)
+
+	var exported []*tracing.Span
+	mockExporter.EXPECT().
+		ExportSpan(mock.Anything).
+		Run(func(span *tracing.Span) {
+			exported = append(exported, span)
+		}).
+		Return().
+		Twice()
+
+	tracer := tracing.NewTracer(mockExporter)
+
+	// Act
+	ctx, root := tracer.Start(t.Context(), "tools/call evaluate_matlab_code", tracing.SpanKindServer, tracing.String("mcp.method.name", "tools/call"))
+	_, child := tracing.Start(ctx, "matlab eval", tracing.SpanKindClient)
+	child.RecordError(assert.AnError)
+	child.End()
+	root.End()
+	root.End()
+
+	// Assert
+	require.Len(t, exported, 2)
+	assert.Equal(t, child, exported[0])
+	assert.Equal(t, root, exported[1])
+
+	assert.True(t, root.TraceID.IsValid())
+	assert.False(t, root.ParentSpanID.IsValid())
+	assert.Equal(t, []tracing.Attribute{{Key: "mcp.method.name", Value: "tools/call"}}, root.Attributes)
+	assert.False(t, root.Failed)
+
+	assert.Equal(t, root.TraceID, child.TraceID)
+	assert.Equal(t, root.SpanID, child.ParentSpanID)
+	assert.NotEqual(t, root.SpanID, child.SpanID)
+	assert.True(t, child.Failed)
+	assert.Equal(t, assert.AnError.Error(), child.StatusMessage)
+	assert.False(t, child.EndTime.Before(child.StartTime))
+}
+
+func TestTracer_Start_RemoteParent(t *testing.T) {
+	// Arrange
+	mockExporter := newEnabledExporter(t)
+	tracer := tracing.NewTracer(mockExporter)
+
+	remoteParent, ok := tracing.ParseTraceParent(remoteTraceParent)
+	require.True(t, ok)
+
+	// Act
+	_, span := tracer.Start(tracing.ContextWithRemoteParent(t.Context(), remoteParent), "HTTP POST", tracing.SpanKindServer)
+
+	// Assert
+	require.NotNil(t, span)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.TraceID.String())
+	assert.Equal(t, "00f067aa0ba902b7", span.ParentSpanID.String())
+	assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-"+span.SpanID.String()+"-01", span.SpanContext().TraceParent())
+}
+
+func TestTracer_Start_UnsampledRemoteParent(t *testing.T) {
+	// Arrange
+	mockExporter := newEnabledExporter(t)
+	tracer := tracing.NewTracer(mockExporter)
+
+	remoteParent, ok := tracing.ParseTraceParent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00")
+	require.True(t, ok)
+
+	// Act
+	ctx, span := tracer.Start(tracing.ContextWithRemoteParent(t.Context(), remoteParent), "HTTP POST", tracing.SpanKindServer)
+	_, child := tracing.Start(ctx, "matlab eval", tracing.SpanKindClient)
+
+	// Assert
+	assert.Nil(t, span)
+	assert.Nil(t, child)
+}
+
+func TestTracer_Start_Disabled(t *testing.T) {
+	// Arrange
+	mockExporter := &mocks.MockExporter{}
+	defer mockExporter.AssertExpectations(t)
+
+	mockExporter.EXPECT().
+		Enabled().
+		Return(false).
+		Once()
+
+	tracer := tracing.NewTracer(mockExporter)
+
+	// Act
+	ctx, span := tracer.Start(t.Context(), "tools/call evaluate_matlab_code", tracing.SpanKindServer)
+	_, child := tracing.Start(ctx, "matlab eval", tracing.SpanKindClient)
+	span.SetAttributes(tracing.Int("http.response.status_code", 200))
+	span.SetError("failed")
+	span.End()
+
+	// Assert
+	assert.Nil(t, span)
+	assert.Nil(t, child)
+	assert.Nil(t, tracing.SpanFromContext(ctx))
+}
+
+func TestStart_WithoutTracer(t *testing.T) {
+	// Act
+	ctx, span := tracing.Start(t.Context(), "matlab eval", tracing.SpanKindClient)
+
+	// Assert
+	assert.Nil(t, span)
+	assert.Equal(t, t.Context(), ctx)
+	assert.False(t, span.SpanContext().IsValid())
+}
+
+func TestParseTraceParent(t *testing.T) {
+	testConfigs := []struct {
+		name            string
+		value           string
+		expectedOK      bool
+		expectedSampled bool
+	}{
+		{name: "sampled", value: remoteTraceParent, expectedOK: true, expectedSampled: true},
+		{name: "not sampled", value: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00", expectedOK: true},
+		{name: "future version", value: "01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra", expectedOK: true, expectedSampled: true},
+		{name: "invalid version", value: "ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"},
+		{name: "extra field in version 00", value: remoteTraceParent + "-extra"},
+		{name: "zero trace ID", value: "00-00000000000000000000000000000000-00f067aa0ba902b7-01"},
+		{name: "zero span ID", value: "00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01"},
+		{name: "upper case", value: "00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01"},
+		{name: "short trace ID", value: "00-4bf92f3577b34da6-00f067aa0ba902b7-01"},
+		{name: "empty", value: ""},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Act
+			spanContext, ok := tracing.ParseTraceParent(testConfig.value)
+
+			// Assert
+			assert.Equal(t, testConfig.expectedOK, ok)
+			assert.Equal(t, testConfig.expectedSampled, spanContext.Sampled)
+		})
+	}
+}
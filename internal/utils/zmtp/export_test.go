@@ -0,0 +1,10 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package zmtp
+
+func (s *Socket) PeerCount() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return len(s.peers)
+}
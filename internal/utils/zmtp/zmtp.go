@@ -0,0 +1,363 @@
+// Copyright 2025 The MathWorks, Inc.
+
+// Package zmtp implements the server side of the ZeroMQ message transport protocol, ZMTP 3.0, with the NULL security
+// mechanism, for the ROUTER, PUB and REP sockets that a Jupyter kernel binds. It does not implement subscriptions,
+// so PUB sockets send every message to every peer, as Jupyter frontends subscribe to all messages of the kernel.
+package zmtp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+type SocketType string
+
+const (
+	SocketTypeRouter SocketType = "ROUTER"
+	SocketTypePub    SocketType = "PUB"
+	SocketTypeRep    SocketType = "REP"
+)
+
+const (
+	greetingSize     = 64
+	handshakeTimeout = 10 * time.Second
+
+	// maxFrameSize is the size of the largest frame read, so that a peer cannot exhaust the memory of the server.
+	maxFrameSize = 256 << 20
+
+	flagMore    byte = 0x01
+	flagLong    byte = 0x02
+	flagCommand byte = 0x04
+)
+
+var ErrClosed = errors.New("socket is closed")
+
+// Message is a multipart message received from a peer.
+type Message struct {
+	Frames [][]byte
+
+	peer *peer
+}
+
+type peer struct {
+	conn      net.Conn
+	writeLock sync.Mutex
+}
+
+// Socket accepts the connections of its peers on a listener, and exchanges messages with them.
+type Socket struct {
+	listener   net.Listener
+	socketType SocketType
+
+	incoming chan Message
+	closed   chan struct{}
+
+	lock      sync.Mutex
+	peers     map[*peer]struct{}
+	closeOnce sync.Once
+	waitGroup sync.WaitGroup
+}
+
+// NewSocket returns a socket of socketType, which accepts connections on listener until it is closed.
+func NewSocket(listener net.Listener, socketType SocketType) *Socket {
+	s := &Socket{
+		listener:   listener,
+		socketType: socketType,
+		incoming:   make(chan Message),
+		closed:     make(chan struct{}),
+		peers:      map[*peer]struct{}{},
+	}
+
+	s.waitGroup.Add(1)
+	go s.accept()
+
+	return s
+}
+
+// Addr returns the address the socket listens on.
+func (s *Socket) Addr() net.Addr {
+	return s.listener.Addr()
+}
+
+// Receive returns the next message received from any peer. Messages received by PUB sockets are discarded.
+func (s *Socket) Receive(ctx context.Context) (Message, error) {
+	select {
+	case message := <-s.incoming:
+		return message, nil
+	case <-s.closed:
+		return Message{}, ErrClosed
+	case <-ctx.Done():
+		return Message{}, ctx.Err()
+	}
+}
+
+// Reply sends frames to the peer that message was received from.
+func (s *Socket) Reply(message Message, frames [][]byte) error {
+	if message.peer == nil {
+		return fmt.Errorf("message was not received from a peer")
+	}
+	return message.peer.write(frames)
+}
+
+// Send sends frames to every peer, as a PUB socket does. Peers that fail to receive them are disconnected.
+func (s *Socket) Send(frames [][]byte) error {
+	s.lock.Lock()
+	peers := make([]*peer, 0, len(s.peers))
+	for p := range s.peers {
+		peers = append(peers, p)
+	}
+	s.lock.Unlock()
+
+	for _, p := range peers {
+		if err := p.write(frames); err != nil {
+			_ = p.conn.Close()
+		}
+	}
+	return nil
+}
+
+// Close stops accepting connections, disconnects every peer, and waits for their connections to end.
+func (s *Socket) Close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		close(s.closed)
+		err = s.listener.Close()
+
+		s.lock.Lock()
+		for p := range s.peers {
+			_ = p.conn.Close()
+		}
+		s.lock.Unlock()
+
+		s.waitGroup.Wait()
+	})
+	return err
+}
+
+func (s *Socket) accept() {
+	defer s.waitGroup.Done()
+
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		s.waitGroup.Add(1)
+		go s.serve(conn)
+	}
+}
+
+func (s *Socket) serve(conn net.Conn) {
+	defer s.waitGroup.Done()
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	reader := bufio.NewReader(conn)
+	if err := handshake(conn, reader, s.socketType); err != nil {
+		return
+	}
+
+	p := &peer{conn: conn}
+	if !s.addPeer(p) {
+		return
+	}
+	defer s.removePeer(p)
+
+	for {
+		frames, err := readMessage(reader)
+		if err != nil {
+			return
+		}
+
+		if s.socketType == SocketTypePub {
+			continue
+		}
+
+		select {
+		case s.incoming <- Message{Frames: frames, peer: p}:
+		case <-s.closed:
+			return
+		}
+	}
+}
+
+func (s *Socket) addPeer(p *peer) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	select {
+	case <-s.closed:
+		return false
+	default:
+	}
+
+	s.peers[p] = struct{}{}
+	return true
+}
+
+func (s *Socket) removePeer(p *peer) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.peers, p)
+}
+
+func (p *peer) write(frames [][]byte) error {
+	var buffer bytes.Buffer
+	for i, frame := range frames {
+		var flags byte
+		if i < len(frames)-1 {
+			flags |= flagMore
+		}
+		writeFrame(&buffer, flags, frame)
+	}
+
+	p.writeLock.Lock()
+	defer p.writeLock.Unlock()
+
+	_, err := p.conn.Write(buffer.Bytes())
+	return err
+}
+
+// handshake exchanges the greeting and the READY command with a peer that connected to a socket of socketType.
+func handshake(conn net.Conn, reader *bufio.Reader, socketType SocketType) error {
+	if err := conn.SetDeadline(time.Now().Add(handshakeTimeout)); err != nil {
+		return err
+	}
+
+	if _, err := conn.Write(greeting()); err != nil {
+		return err
+	}
+
+	peerGreeting := make([]byte, greetingSize)
+	if _, err := io.ReadFull(reader, peerGreeting); err != nil {
+		return err
+	}
+	if err := validateGreeting(peerGreeting); err != nil {
+		return err
+	}
+
+	var ready bytes.Buffer
+	writeFrame(&ready, flagCommand, readyCommand(socketType))
+	if _, err := conn.Write(ready.Bytes()); err != nil {
+		return err
+	}
+
+	flags, body, err := readFrame(reader)
+	if err != nil {
+		return err
+	}
+	if flags&flagCommand == 0 || !bytes.HasPrefix(body, []byte("\x05READY")) {
+		return fmt.Errorf("peer did not send a READY command")
+	}
+
+	return conn.SetDeadline(time.Time{})
+}
+
+func greeting() []byte {
+	g := make([]byte, greetingSize)
+	g[0] = 0xFF
+	g[9] = 0x7F
+	g[10] = 3
+	g[11] = 0
+	copy(g[12:32], "NULL")
+	return g
+}
+
+func validateGreeting(g []byte) error {
+	if g[0] != 0xFF || g[9]&0x01 == 0 {
+		return fmt.Errorf("peer is not a ZMTP peer")
+	}
+	if g[10] < 3 {
+		return fmt.Errorf("peer uses ZMTP %d.%d, which is not supported", g[10], g[11])
+	}
+	if mechanism := string(bytes.TrimRight(g[12:32], "\x00")); mechanism != "NULL" {
+		return fmt.Errorf("peer uses the %s security mechanism, which is not supported", mechanism)
+	}
+	return nil
+}
+
+func readyCommand(socketType SocketType) []byte {
+	var body bytes.Buffer
+	body.WriteByte(5)
+	body.WriteString("READY")
+
+	name := "Socket-Type"
+	body.WriteByte(byte(len(name)))
+	body.WriteString(name)
+	_ = binary.Write(&body, binary.BigEndian, uint32(len(socketType)))
+	body.WriteString(string(socketType))
+
+	return body.Bytes()
+}
+
+// readMessage reads the frames of the next message. Commands received between messages are ignored.
+func readMessage(reader *bufio.Reader) ([][]byte, error) {
+	var frames [][]byte
+	for {
+		flags, body, err := readFrame(reader)
+		if err != nil {
+			return nil, err
+		}
+
+		if flags&flagCommand != 0 {
+			continue
+		}
+
+		frames = append(frames, body)
+		if flags&flagMore == 0 {
+			return frames, nil
+		}
+	}
+}
+
+func readFrame(reader *bufio.Reader) (byte, []byte, error) {
+	flags, err := reader.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var size uint64
+	if flags&flagLong != 0 {
+		if err := binary.Read(reader, binary.BigEndian, &size); err != nil {
+			return 0, nil, err
+		}
+	} else {
+		shortSize, err := reader.ReadByte()
+		if err != nil {
+			return 0, nil, err
+		}
+		size = uint64(shortSize)
+	}
+
+	if size > maxFrameSize {
+		return 0, nil, fmt.Errorf("frame of %d bytes is larger than the limit of %d bytes", size, maxFrameSize)
+	}
+
+	body := make([]byte, size)
+	if _, err := io.ReadFull(reader, body); err != nil {
+		return 0, nil, err
+	}
+	return flags, body, nil
+}
+
+func writeFrame(buffer *bytes.Buffer, flags byte, body []byte) {
+	if len(body) > 255 {
+		buffer.WriteByte(flags | flagLong)
+		_ = binary.Write(buffer, binary.BigEndian, uint64(len(body)))
+	} else {
+		buffer.WriteByte(flags)
+		buffer.WriteByte(byte(len(body)))
+	}
+	buffer.Write(body)
+}
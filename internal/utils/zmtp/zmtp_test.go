@@ -0,0 +1,176 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package zmtp_test
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/zmtp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testPeer is the client side of a ZMTP connection, as a Jupyter frontend opens it.
+type testPeer struct {
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+func connect(t *testing.T, socket *zmtp.Socket, socketType string) *testPeer {
+	t.Helper()
+
+	conn, err := net.Dial("tcp", socket.Addr().String())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+
+	p := &testPeer{conn: conn, reader: bufio.NewReader(conn)}
+
+	greeting := make([]byte, 64)
+	greeting[0] = 0xFF
+	greeting[9] = 0x7F
+	greeting[10] = 3
+	copy(greeting[12:], "NULL")
+	_, err = conn.Write(greeting)
+	require.NoError(t, err)
+
+	serverGreeting := make([]byte, 64)
+	_, err = io.ReadFull(p.reader, serverGreeting)
+	require.NoError(t, err)
+	assert.Equal(t, byte(0xFF), serverGreeting[0])
+	assert.Equal(t, byte(3), serverGreeting[10])
+
+	ready := []byte("\x05READY\x0bSocket-Type")
+	ready = binary.BigEndian.AppendUint32(ready, uint32(len(socketType)))
+	ready = append(ready, socketType...)
+	p.write(t, 0x04, ready)
+
+	flags, body := p.read(t)
+	assert.Equal(t, byte(0x04), flags)
+	assert.Contains(t, string(body), "READY")
+
+	return p
+}
+
+func (p *testPeer) write(t *testing.T, flags byte, body []byte) {
+	t.Helper()
+
+	frame := []byte{flags, byte(len(body))}
+	if len(body) > 255 {
+		frame = binary.BigEndian.AppendUint64([]byte{flags | 0x02}, uint64(len(body)))
+	}
+	_, err := p.conn.Write(append(frame, body...))
+	require.NoError(t, err)
+}
+
+func (p *testPeer) read(t *testing.T) (byte, []byte) {
+	t.Helper()
+
+	flags, err := p.reader.ReadByte()
+	require.NoError(t, err)
+
+	var size uint64
+	if flags&0x02 != 0 {
+		require.NoError(t, binary.Read(p.reader, binary.BigEndian, &size))
+	} else {
+		shortSize, err := p.reader.ReadByte()
+		require.NoError(t, err)
+		size = uint64(shortSize)
+	}
+
+	body := make([]byte, size)
+	_, err = io.ReadFull(p.reader, body)
+	require.NoError(t, err)
+	return flags, body
+}
+
+func newSocket(t *testing.T, socketType zmtp.SocketType) *zmtp.Socket {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	socket := zmtp.NewSocket(listener, socketType)
+	t.Cleanup(func() { _ = socket.Close() })
+	return socket
+}
+
+func TestSocket_Router_ReceiveAndReply(t *testing.T) {
+	// Arrange
+	socket := newSocket(t, zmtp.SocketTypeRouter)
+	p := connect(t, socket, "DEALER")
+	longFrame := make([]byte, 300)
+
+	// Act
+	p.write(t, 0x01, []byte("header"))
+	p.write(t, 0x00, longFrame)
+	message, err := socket.Receive(t.Context())
+	require.NoError(t, err)
+	require.NoError(t, socket.Reply(message, [][]byte{[]byte("reply")}))
+
+	// Assert
+	assert.Equal(t, [][]byte{[]byte("header"), longFrame}, message.Frames)
+	flags, body := p.read(t)
+	assert.Equal(t, byte(0x00), flags)
+	assert.Equal(t, "reply", string(body))
+}
+
+func TestSocket_Pub_SendsToEveryPeer(t *testing.T) {
+	// Arrange
+	socket := newSocket(t, zmtp.SocketTypePub)
+	first := connect(t, socket, "SUB")
+	second := connect(t, socket, "SUB")
+	first.write(t, 0x00, []byte("\x01"))
+
+	// Peers are registered once the server has read their READY command.
+	require.Eventually(t, func() bool { return socket.PeerCount() == 2 }, time.Second, 10*time.Millisecond)
+
+	// Act
+	require.NoError(t, socket.Send([][]byte{[]byte("ping")}))
+	_, firstBody := first.read(t)
+	_, secondBody := second.read(t)
+
+	// Assert
+	assert.Equal(t, "ping", string(firstBody))
+	assert.Equal(t, "ping", string(secondBody))
+}
+
+func TestSocket_Receive_ReturnsErrClosedAfterClose(t *testing.T) {
+	// Arrange
+	socket := newSocket(t, zmtp.SocketTypeRep)
+	require.NoError(t, socket.Close())
+
+	// Act
+	_, err := socket.Receive(t.Context())
+
+	// Assert
+	require.ErrorIs(t, err, zmtp.ErrClosed)
+}
+
+func TestSocket_RejectsUnsupportedMechanism(t *testing.T) {
+	// Arrange
+	socket := newSocket(t, zmtp.SocketTypeRouter)
+	conn, err := net.Dial("tcp", socket.Addr().String())
+	require.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+	require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+
+	greeting := make([]byte, 64)
+	greeting[0] = 0xFF
+	greeting[9] = 0x7F
+	greeting[10] = 3
+	copy(greeting[12:], "CURVE")
+
+	// Act
+	_, err = conn.Write(greeting)
+	require.NoError(t, err)
+	_, err = io.ReadAll(conn)
+
+	// Assert
+	require.NoError(t, err, "the server closes the connection after the greetings")
+}
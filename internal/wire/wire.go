@@ -6,16 +6,47 @@ package wire
 
 import (
 	"github.com/google/wire"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/attach"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/cleanup"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/completion"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/config"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/debugserver"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/directory"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/doctor"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/install"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/jupyterkernel"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/lifecyclesignaler"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/logs"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/modeselector"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/orchestrator"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/pipeline"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/repl"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/replay"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/selftest"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/service"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/status"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/stop"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/telemetrypreview"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/version"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/auditlog"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/configfile"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/configreloader"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/daemon"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/downstream"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/dryrun"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/eventbuffer"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/eventsink"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/globalmatlab"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/globalmatlab/matlabrootselector"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/globalmatlab/matlabstartingdirselector"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/httpauth"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/localizer"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/localuser"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/logger"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/managedpolicy"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabfixture"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/certificatetrust"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directorymanager"
@@ -25,43 +56,150 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/matlablocator"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/matlablocator/matlabroot"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/matlablocator/matlabversion"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/sharedmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabsupervisor"
+	matlabartifactresource "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabartifact"
+	matlabdriveresource "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabdrive"
+	matlabfigureresource "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabfigure"
+	matlabvariableresource "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabvariable"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server/configurator"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/serverlauncher"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
 	evalmatlabcodemultisessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/evalmatlabcode"
 	listavailablematlabstool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/listavailablematlabs"
+	listmatlabsessionstool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/listmatlabsessions"
 	startmatlabsessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/startmatlabsession"
 	stopmatlabsessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/stopmatlabsession"
+	deployproductionarchivetool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/deployproductionarchive"
+	findmatlabdefinitiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/findmatlabdefinition"
+	getmatlabdiagnosticstool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/getmatlabdiagnostics"
+	getproductionserverstatustool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/getproductionserverstatus"
+	getserverinfotool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/getserverinfo"
+	invokeproductionfunctiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/invokeproductionfunction"
+	pullfrommatlabdrivetool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/pullfrommatlabdrive"
+	pushtomatlabdrivetool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/pushtomatlabdrive"
+	buildrealtimeapplicationsinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/buildrealtimeapplication"
+	canceljobsinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/canceljob"
+	capturematlabfiguressinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/capturematlabfigures"
 	checkmatlabcodesinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/checkmatlabcode"
+	checkpythonpackagessinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/checkpythonpackages"
+	controlrealtimeapplicationsinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/controlrealtimeapplication"
+	deployrealtimeapplicationsinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/deployrealtimeapplication"
 	detectmatlabtoolboxessinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/detectmatlabtoolboxes"
 	evalmatlabcodesinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/evalmatlabcode"
+	exportlivescriptsinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/exportlivescript"
+	getjoboutputsinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/getjoboutput"
+	getjobstatussinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/getjobstatus"
+	getmatlabvariablesinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/getmatlabvariable"
+	getpythonenvironmentsinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/getpythonenvironment"
+	getsimulinkblockparameterssinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/getsimulinkblockparameters"
+	listmatfilevariablessinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/listmatfilevariables"
+	loadmatfilevariablessinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/loadmatfilevariables"
+	loadsimulinkmodelsinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/loadsimulinkmodel"
+	packageproductionarchivesinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/packageproductionarchive"
 	runmatlabfilesinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabfile"
 	runmatlabtestfilesinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabtestfile"
+	runmatlabtestssinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabtests"
+	runpythoncodesinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runpythoncode"
+	setmatlabvariablesinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/setmatlabvariable"
+	setpythonenvironmentsinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/setpythonenvironment"
+	setsimulinkparametersinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/setsimulinkparameter"
+	simulatesimulinkmodelsinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/simulatesimulinkmodel"
+	startjobsinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/startjob"
+	streamrealtimesignalssinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/streamrealtimesignals"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/memorywatchdog"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/metrics"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/notificationthrottle"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/otlpexporter"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/plugins"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/ratelimiter"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/redactor"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/sessionrecording"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/storageencryption"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/telemetry"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/toolpolicy"
 	watchdogclient "github.com/matlab/matlab-mcp-core-server/internal/adaptors/watchdog"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/watchdog/process"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/workerpool"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/filefacade"
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/iofacade"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/keychainfacade"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/netfacade"
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/buildrealtimeapplication"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/canceljob"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/capturematlabfigures"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/checkmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/checkpythonpackages"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/controlrealtimeapplication"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/deployproductionarchive"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/deployrealtimeapplication"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/detectmatlabtoolboxes"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/diffmatlabworkspace"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/evalmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/exportlivescript"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/findmatlabdefinition"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getjob"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getmatlabdiagnostics"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getmatlabvariable"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getproductionserverstatus"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getpythonenvironment"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getserverinfo"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getsimulinkblockparameters"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/invokeproductionfunction"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listavailablematlabs"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listmatfilevariables"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listmatlabfigures"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listmatlabsessions"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listmatlabvariables"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/loadmatfilevariables"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/loadsimulinkmodel"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/packageproductionarchive"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/pullfrommatlabdrive"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/pushtomatlabdrive"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/rendermatlabfigure"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/runmatlabfile"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/runmatlabtestfile"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/runmatlabtests"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/runpythoncode"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/setmatlabvariable"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/setpythonenvironment"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/setsimulinkparameter"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/simulatesimulinkmodel"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/startjob"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/startmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/stopmatlabsession"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/streamrealtimesignals"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/approvalgate"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/artifactstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/codepolicy"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/jobmanager"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/lookupcache"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/matlabdrive"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/pathvalidator"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/productionserver"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/sessiontranscript"
 	"github.com/matlab/matlab-mcp-core-server/internal/utils/httpclientfactory"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/instancelock"
 	"github.com/matlab/matlab-mcp-core-server/internal/utils/ossignaler"
 	"github.com/matlab/matlab-mcp-core-server/internal/utils/oswrapper"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/processmemory"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/tracing"
 	watchdogprocess "github.com/matlab/matlab-mcp-core-server/internal/watchdog"
 	"github.com/matlab/matlab-mcp-core-server/internal/watchdog/processhandler"
 	"github.com/matlab/matlab-mcp-core-server/internal/watchdog/transport"
 )
 
+// newToolProviders lists the providers of the tools that are not built into the server.
+func newToolProviders(pluginRegistry *plugins.Registry, downstreamProxy *downstream.Proxy) []tools.ToolProvider {
+	return []tools.ToolProvider{pluginRegistry, downstreamProxy}
+}
+
 type orchestratorFactory struct{}
 
 func newOrchestratorFactory() *orchestratorFactory {
@@ -82,6 +220,166 @@ func (f *watchdogProcessFactory) Create() (entities.Mode, error) {
 	return initializeWatchdog()
 }
 
+type statusFactory struct{}
+
+func newStatusFactory() *statusFactory {
+	return &statusFactory{}
+}
+
+func (f *statusFactory) Create() (entities.Mode, error) {
+	return initializeStatus()
+}
+
+type telemetryPreviewFactory struct{}
+
+func newTelemetryPreviewFactory() *telemetryPreviewFactory {
+	return &telemetryPreviewFactory{}
+}
+
+func (f *telemetryPreviewFactory) Create() (entities.Mode, error) {
+	return initializeTelemetryPreview(), nil
+}
+
+type replayFactory struct{}
+
+func newReplayFactory() *replayFactory {
+	return &replayFactory{}
+}
+
+func (f *replayFactory) Create() (entities.Mode, error) {
+	return initializeReplay()
+}
+
+type attachFactory struct{}
+
+func newAttachFactory() *attachFactory {
+	return &attachFactory{}
+}
+
+func (f *attachFactory) Create() (entities.Mode, error) {
+	return initializeAttach()
+}
+
+type doctorFactory struct{}
+
+func newDoctorFactory() *doctorFactory {
+	return &doctorFactory{}
+}
+
+func (f *doctorFactory) Create() (entities.Mode, error) {
+	return initializeDoctor()
+}
+
+type installFactory struct{}
+
+func newInstallFactory() *installFactory {
+	return &installFactory{}
+}
+
+func (f *installFactory) Create() (entities.Mode, error) {
+	return initializeInstall()
+}
+
+type completionFactory struct{}
+
+func newCompletionFactory() *completionFactory {
+	return &completionFactory{}
+}
+
+func (f *completionFactory) Create() (entities.Mode, error) {
+	return initializeCompletion()
+}
+
+type logsFactory struct{}
+
+func newLogsFactory() *logsFactory {
+	return &logsFactory{}
+}
+
+func (f *logsFactory) Create() (entities.Mode, error) {
+	return initializeLogs()
+}
+
+type cleanupFactory struct{}
+
+func newCleanupFactory() *cleanupFactory {
+	return &cleanupFactory{}
+}
+
+func (f *cleanupFactory) Create() (entities.Mode, error) {
+	return initializeCleanup()
+}
+
+type stopFactory struct{}
+
+func newStopFactory() *stopFactory {
+	return &stopFactory{}
+}
+
+func (f *stopFactory) Create() (entities.Mode, error) {
+	return initializeStop()
+}
+
+type serviceFactory struct{}
+
+func newServiceFactory() *serviceFactory {
+	return &serviceFactory{}
+}
+
+func (f *serviceFactory) Create() (entities.Mode, error) {
+	return initializeService()
+}
+
+type replFactory struct{}
+
+func newREPLFactory() *replFactory {
+	return &replFactory{}
+}
+
+func (f *replFactory) Create() (entities.Mode, error) {
+	return initializeREPL()
+}
+
+type pipelineFactory struct{}
+
+func newPipelineFactory() *pipelineFactory {
+	return &pipelineFactory{}
+}
+
+func (f *pipelineFactory) Create() (entities.Mode, error) {
+	return initializePipeline()
+}
+
+type selfTestFactory struct{}
+
+func newSelfTestFactory() *selfTestFactory {
+	return &selfTestFactory{}
+}
+
+func (f *selfTestFactory) Create() (entities.Mode, error) {
+	return initializeSelfTest()
+}
+
+type kernelFactory struct{}
+
+func newKernelFactory() *kernelFactory {
+	return &kernelFactory{}
+}
+
+func (f *kernelFactory) Create() (entities.Mode, error) {
+	return initializeKernel()
+}
+
+type versionFactory struct{}
+
+func newVersionFactory() *versionFactory {
+	return &versionFactory{}
+}
+
+func (f *versionFactory) Create() (entities.Mode, error) {
+	return initializeVersion()
+}
+
 func InitializeModeSelector() (*modeselector.ModeSelector, error) {
 	wire.Build(
 		// Application
@@ -89,21 +387,520 @@ func InitializeModeSelector() (*modeselector.ModeSelector, error) {
 		wire.Bind(new(modeselector.Config), new(*config.Config)),
 		wire.Bind(new(modeselector.WatchdogProcessFactory), new(*watchdogProcessFactory)),
 		wire.Bind(new(modeselector.OrchestratorFactory), new(*orchestratorFactory)),
-		wire.Bind(new(modeselector.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(modeselector.StatusFactory), new(*statusFactory)),
+		wire.Bind(new(modeselector.TelemetryPreviewFactory), new(*telemetryPreviewFactory)),
+		wire.Bind(new(modeselector.ReplayFactory), new(*replayFactory)),
+		wire.Bind(new(modeselector.AttachFactory), new(*attachFactory)),
+		wire.Bind(new(modeselector.DoctorFactory), new(*doctorFactory)),
+		wire.Bind(new(modeselector.InstallFactory), new(*installFactory)),
+		wire.Bind(new(modeselector.CompletionFactory), new(*completionFactory)),
+		wire.Bind(new(modeselector.LogsFactory), new(*logsFactory)),
+		wire.Bind(new(modeselector.CleanupFactory), new(*cleanupFactory)),
+		wire.Bind(new(modeselector.StopFactory), new(*stopFactory)),
+		wire.Bind(new(modeselector.ServiceFactory), new(*serviceFactory)),
+		wire.Bind(new(modeselector.REPLFactory), new(*replFactory)),
+		wire.Bind(new(modeselector.PipelineFactory), new(*pipelineFactory)),
+		wire.Bind(new(modeselector.SelfTestFactory), new(*selfTestFactory)),
+		wire.Bind(new(modeselector.KernelFactory), new(*kernelFactory)),
+		wire.Bind(new(modeselector.VersionFactory), new(*versionFactory)),
 
 		// Factories
 		newWatchdogProcessFactory,
 		newOrchestratorFactory,
+		newStatusFactory,
+		newTelemetryPreviewFactory,
+		newReplayFactory,
+		newAttachFactory,
+		newDoctorFactory,
+		newInstallFactory,
+		newCompletionFactory,
+		newLogsFactory,
+		newCleanupFactory,
+		newStopFactory,
+		newServiceFactory,
+		newREPLFactory,
+		newPipelineFactory,
+		newSelfTestFactory,
+		newKernelFactory,
+		newVersionFactory,
+
+		// Low-level Interfaces
+		config.NewWithConfigFile,
+		wire.Bind(new(config.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(config.ConfigFile), new(*configfile.ConfigFile)),
+		configfile.New,
+		wire.Bind(new(configfile.OSLayer), new(*osfacade.OsFacade)),
+		osfacade.New,
+	)
+
+	return nil, nil
+}
+
+func initializeStatus() (*status.Status, error) {
+	wire.Build(
+		// Status
+		status.New,
+		wire.Bind(new(status.Config), new(*config.Config)),
+		wire.Bind(new(status.EventReader), new(*eventbuffer.Reader)),
+		wire.Bind(new(status.OSLayer), new(*osfacade.OsFacade)),
+
+		// Event Buffer Reader
+		eventbuffer.NewReader,
+		wire.Bind(new(eventbuffer.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(eventbuffer.FileLayer), new(*filefacade.FileFacade)),
+		wire.Bind(new(eventbuffer.Decryptor), new(*storageencryption.Encryptor)),
+
+		// Storage Encryption
+		storageencryption.New,
+		wire.Bind(new(storageencryption.Config), new(*config.Config)),
+		wire.Bind(new(storageencryption.Keychain), new(*keychainfacade.KeychainFacade)),
+		keychainfacade.New,
+
+		// Low-level Interfaces
+		config.NewWithConfigFile,
+		wire.Bind(new(config.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(config.ConfigFile), new(*configfile.ConfigFile)),
+		configfile.New,
+		wire.Bind(new(configfile.OSLayer), new(*osfacade.OsFacade)),
+		filefacade.New,
+		osfacade.New,
+	)
+
+	return nil, nil
+}
+
+func initializeReplay() (*replay.Replay, error) {
+	wire.Build(
+		// Replay
+		replay.New,
+		wire.Bind(new(replay.Config), new(*config.Config)),
+		wire.Bind(new(replay.RecordingReader), new(*sessionrecording.Reader)),
+		wire.Bind(new(replay.ServerLauncher), new(*serverlauncher.ServerLauncher)),
+		wire.Bind(new(replay.OSLayer), new(*osfacade.OsFacade)),
+
+		// Session Recording Reader
+		sessionrecording.NewReader,
+		wire.Bind(new(sessionrecording.ReaderOSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(sessionrecording.Decryptor), new(*storageencryption.Encryptor)),
+
+		// Storage Encryption
+		storageencryption.New,
+		wire.Bind(new(storageencryption.Config), new(*config.Config)),
+		wire.Bind(new(storageencryption.Keychain), new(*keychainfacade.KeychainFacade)),
+		keychainfacade.New,
+
+		// Server Launcher
+		serverlauncher.New,
+		wire.Bind(new(serverlauncher.Config), new(*config.Config)),
+		wire.Bind(new(serverlauncher.OSLayer), new(*osfacade.OsFacade)),
+
+		// Low-level Interfaces
+		config.NewWithConfigFile,
+		wire.Bind(new(config.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(config.ConfigFile), new(*configfile.ConfigFile)),
+		configfile.New,
+		wire.Bind(new(configfile.OSLayer), new(*osfacade.OsFacade)),
+		osfacade.New,
+	)
+
+	return nil, nil
+}
+
+func initializeAttach() (*attach.Attach, error) {
+	wire.Build(
+		// Attach
+		attach.New,
+		wire.Bind(new(attach.DaemonSocket), new(*daemon.Socket)),
+		wire.Bind(new(attach.DaemonLauncher), new(*daemon.Launcher)),
+		wire.Bind(new(attach.OSLayer), new(*osfacade.OsFacade)),
+
+		// Daemon
+		daemon.NewSocket,
+		wire.Bind(new(daemon.Config), new(*config.Config)),
+		wire.Bind(new(daemon.OSLayer), new(*osfacade.OsFacade)),
+		daemon.NewLauncher,
+		wire.Bind(new(daemon.LauncherOSLayer), new(*osfacade.OsFacade)),
+
+		// Low-level Interfaces
+		config.NewWithConfigFile,
+		wire.Bind(new(config.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(config.ConfigFile), new(*configfile.ConfigFile)),
+		configfile.New,
+		wire.Bind(new(configfile.OSLayer), new(*osfacade.OsFacade)),
+		osfacade.New,
+	)
+
+	return nil, nil
+}
+
+func initializeDoctor() (*doctor.Doctor, error) {
+	wire.Build(
+		// Doctor
+		doctor.New,
+		wire.Bind(new(doctor.Config), new(*config.Config)),
+		wire.Bind(new(doctor.LoggerFactory), new(*logger.Factory)),
+		wire.Bind(new(doctor.MATLABRootGetter), new(*matlabroot.Getter)),
+		wire.Bind(new(doctor.MATLABVersionGetter), new(*matlabversion.Getter)),
+		wire.Bind(new(doctor.InstanceLock), new(*instancelock.InstanceLock)),
+		wire.Bind(new(doctor.DaemonSocket), new(*daemon.Socket)),
+		wire.Bind(new(doctor.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(doctor.FileLayer), new(*filefacade.FileFacade)),
+		wire.Bind(new(doctor.NetworkLayer), new(*netfacade.NetFacade)),
+		wire.Bind(new(doctor.Localizer), new(*localizer.Localizer)),
+
+		// Localizer
+		localizer.New,
+		wire.Bind(new(localizer.Config), new(*config.Config)),
+		wire.Bind(new(localizer.OSLayer), new(*osfacade.OsFacade)),
+
+		// MATLAB Root Getter
+		matlabroot.New,
+		wire.Bind(new(matlabroot.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(matlabroot.FileLayer), new(*filefacade.FileFacade)),
+
+		// MATLAB Version Getter
+		matlabversion.New,
+		wire.Bind(new(matlabversion.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(matlabversion.IOLayer), new(*iofacade.IoFacade)),
+
+		// Instance Lock
+		instancelock.New,
+		wire.Bind(new(instancelock.Config), new(*config.Config)),
+
+		// Daemon
+		daemon.NewSocket,
+		wire.Bind(new(daemon.Config), new(*config.Config)),
+		wire.Bind(new(daemon.OSLayer), new(*osfacade.OsFacade)),
+
+		// Low-level Interfaces
+		logger.NewFactory,
+		wire.Bind(new(logger.Config), new(*config.Config)),
+		wire.Bind(new(logger.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(logger.Directory), new(*directory.Directory)),
+		directory.New,
+		wire.Bind(new(directory.OSLayer), new(*osfacade.OsFacade)),
+		config.NewWithConfigFile,
+		wire.Bind(new(config.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(config.ConfigFile), new(*configfile.ConfigFile)),
+		configfile.New,
+		wire.Bind(new(configfile.OSLayer), new(*osfacade.OsFacade)),
+		osfacade.New,
+		filefacade.New,
+		iofacade.New,
+		netfacade.New,
+	)
+
+	return nil, nil
+}
+
+func initializeREPL() (*repl.REPL, error) {
+	wire.Build(
+		// REPL
+		repl.New,
+		wire.Bind(new(repl.Config), new(*config.Config)),
+		wire.Bind(new(repl.ServerLauncher), new(*serverlauncher.ServerLauncher)),
+		wire.Bind(new(repl.OSLayer), new(*osfacade.OsFacade)),
+
+		// Server Launcher
+		serverlauncher.New,
+		wire.Bind(new(serverlauncher.Config), new(*config.Config)),
+		wire.Bind(new(serverlauncher.OSLayer), new(*osfacade.OsFacade)),
+
+		// Low-level Interfaces
+		config.NewWithConfigFile,
+		wire.Bind(new(config.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(config.ConfigFile), new(*configfile.ConfigFile)),
+		configfile.New,
+		wire.Bind(new(configfile.OSLayer), new(*osfacade.OsFacade)),
+		osfacade.New,
+	)
+
+	return nil, nil
+}
+
+func initializePipeline() (*pipeline.Pipeline, error) {
+	wire.Build(
+		// Pipeline
+		pipeline.New,
+		wire.Bind(new(pipeline.Config), new(*config.Config)),
+		wire.Bind(new(pipeline.ServerLauncher), new(*serverlauncher.ServerLauncher)),
+		wire.Bind(new(pipeline.OSLayer), new(*osfacade.OsFacade)),
+
+		// Server Launcher
+		serverlauncher.New,
+		wire.Bind(new(serverlauncher.Config), new(*config.Config)),
+		wire.Bind(new(serverlauncher.OSLayer), new(*osfacade.OsFacade)),
+
+		// Low-level Interfaces
+		config.NewWithConfigFile,
+		wire.Bind(new(config.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(config.ConfigFile), new(*configfile.ConfigFile)),
+		configfile.New,
+		wire.Bind(new(configfile.OSLayer), new(*osfacade.OsFacade)),
+		osfacade.New,
+	)
+
+	return nil, nil
+}
+
+func initializeSelfTest() (*selftest.SelfTest, error) {
+	wire.Build(
+		// Self-Test
+		selftest.New,
+		wire.Bind(new(selftest.Config), new(*config.Config)),
+		wire.Bind(new(selftest.ServerLauncher), new(*serverlauncher.ServerLauncher)),
+		wire.Bind(new(selftest.OSLayer), new(*osfacade.OsFacade)),
+
+		// Server Launcher
+		serverlauncher.New,
+		wire.Bind(new(serverlauncher.Config), new(*config.Config)),
+		wire.Bind(new(serverlauncher.OSLayer), new(*osfacade.OsFacade)),
+
+		// Low-level Interfaces
+		config.NewWithConfigFile,
+		wire.Bind(new(config.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(config.ConfigFile), new(*configfile.ConfigFile)),
+		configfile.New,
+		wire.Bind(new(configfile.OSLayer), new(*osfacade.OsFacade)),
+		osfacade.New,
+	)
+
+	return nil, nil
+}
+
+func initializeKernel() (*jupyterkernel.Kernel, error) {
+	wire.Build(
+		// Jupyter Kernel
+		jupyterkernel.New,
+		wire.Bind(new(jupyterkernel.Config), new(*config.Config)),
+		wire.Bind(new(jupyterkernel.DaemonSocket), new(*daemon.Socket)),
+		wire.Bind(new(jupyterkernel.NetLayer), new(*netfacade.NetFacade)),
+		wire.Bind(new(jupyterkernel.OSLayer), new(*osfacade.OsFacade)),
+
+		// Daemon
+		daemon.NewSocket,
+		wire.Bind(new(daemon.Config), new(*config.Config)),
+		wire.Bind(new(daemon.OSLayer), new(*osfacade.OsFacade)),
+
+		// Low-level Interfaces
+		config.NewWithConfigFile,
+		wire.Bind(new(config.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(config.ConfigFile), new(*configfile.ConfigFile)),
+		configfile.New,
+		wire.Bind(new(configfile.OSLayer), new(*osfacade.OsFacade)),
+		osfacade.New,
+		netfacade.New,
+	)
+
+	return nil, nil
+}
+
+func initializeInstall() (*install.Install, error) {
+	wire.Build(
+		// Install
+		install.New,
+		wire.Bind(new(install.Config), new(*config.Config)),
+		wire.Bind(new(install.OSLayer), new(*osfacade.OsFacade)),
+
+		// Low-level Interfaces
+		config.NewWithConfigFile,
+		wire.Bind(new(config.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(config.ConfigFile), new(*configfile.ConfigFile)),
+		configfile.New,
+		wire.Bind(new(configfile.OSLayer), new(*osfacade.OsFacade)),
+		osfacade.New,
+	)
+
+	return nil, nil
+}
+
+func initializeVersion() (*version.Version, error) {
+	wire.Build(
+		// Version
+		version.New,
+		wire.Bind(new(version.Config), new(*config.Config)),
+		wire.Bind(new(version.LoggerFactory), new(*logger.DiscardFactory)),
+		wire.Bind(new(version.MATLABRootGetter), new(*matlabroot.Getter)),
+		wire.Bind(new(version.MATLABVersionGetter), new(*matlabversion.Getter)),
+		wire.Bind(new(version.OSLayer), new(*osfacade.OsFacade)),
+
+		// MATLAB Root Getter
+		matlabroot.New,
+		wire.Bind(new(matlabroot.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(matlabroot.FileLayer), new(*filefacade.FileFacade)),
+
+		// MATLAB Version Getter
+		matlabversion.New,
+		wire.Bind(new(matlabversion.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(matlabversion.IOLayer), new(*iofacade.IoFacade)),
+
+		// Low-level Interfaces
+		logger.NewDiscardFactory,
+		config.NewWithConfigFile,
+		wire.Bind(new(config.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(config.ConfigFile), new(*configfile.ConfigFile)),
+		configfile.New,
+		wire.Bind(new(configfile.OSLayer), new(*osfacade.OsFacade)),
+		osfacade.New,
+		filefacade.New,
+		iofacade.New,
+	)
+
+	return nil, nil
+}
+
+func initializeCompletion() (*completion.Completion, error) {
+	wire.Build(
+		// Completion
+		completion.New,
+		wire.Bind(new(completion.Config), new(*config.Config)),
+		wire.Bind(new(completion.LoggerFactory), new(*logger.DiscardFactory)),
+		wire.Bind(new(completion.MATLABRootGetter), new(*matlabroot.Getter)),
+		wire.Bind(new(completion.OSLayer), new(*osfacade.OsFacade)),
+
+		// MATLAB Root Getter
+		matlabroot.New,
+		wire.Bind(new(matlabroot.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(matlabroot.FileLayer), new(*filefacade.FileFacade)),
+
+		// Low-level Interfaces
+		logger.NewDiscardFactory,
+		config.NewWithConfigFile,
+		wire.Bind(new(config.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(config.ConfigFile), new(*configfile.ConfigFile)),
+		configfile.New,
+		wire.Bind(new(configfile.OSLayer), new(*osfacade.OsFacade)),
+		osfacade.New,
+		filefacade.New,
+	)
+
+	return nil, nil
+}
+
+func initializeLogs() (*logs.Logs, error) {
+	wire.Build(
+		// Logs
+		logs.New,
+		wire.Bind(new(logs.Config), new(*config.Config)),
+		wire.Bind(new(logs.EventReader), new(*eventbuffer.Reader)),
+		wire.Bind(new(logs.InstanceLock), new(*instancelock.InstanceLock)),
+		wire.Bind(new(logs.OSLayer), new(*osfacade.OsFacade)),
+
+		// Event Buffer Reader
+		eventbuffer.NewReader,
+		wire.Bind(new(eventbuffer.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(eventbuffer.FileLayer), new(*filefacade.FileFacade)),
+		wire.Bind(new(eventbuffer.Decryptor), new(*storageencryption.Encryptor)),
+
+		// Storage Encryption
+		storageencryption.New,
+		wire.Bind(new(storageencryption.Config), new(*config.Config)),
+		wire.Bind(new(storageencryption.Keychain), new(*keychainfacade.KeychainFacade)),
+		keychainfacade.New,
+
+		// Instance Lock
+		instancelock.New,
+		wire.Bind(new(instancelock.Config), new(*config.Config)),
+
+		// Low-level Interfaces
+		config.NewWithConfigFile,
+		wire.Bind(new(config.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(config.ConfigFile), new(*configfile.ConfigFile)),
+		configfile.New,
+		wire.Bind(new(configfile.OSLayer), new(*osfacade.OsFacade)),
+		filefacade.New,
+		osfacade.New,
+	)
+
+	return nil, nil
+}
+
+func initializeCleanup() (*cleanup.Cleanup, error) {
+	wire.Build(
+		// Cleanup
+		cleanup.New,
+		wire.Bind(new(cleanup.Config), new(*config.Config)),
+		wire.Bind(new(cleanup.InstanceLock), new(*instancelock.InstanceLock)),
+		wire.Bind(new(cleanup.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(cleanup.FileLayer), new(*filefacade.FileFacade)),
+
+		// Instance Lock
+		instancelock.New,
+		wire.Bind(new(instancelock.Config), new(*config.Config)),
+
+		// Low-level Interfaces
+		config.NewWithConfigFile,
+		wire.Bind(new(config.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(config.ConfigFile), new(*configfile.ConfigFile)),
+		configfile.New,
+		wire.Bind(new(configfile.OSLayer), new(*osfacade.OsFacade)),
+		osfacade.New,
+		filefacade.New,
+	)
+
+	return nil, nil
+}
+
+func initializeStop() (*stop.Stop, error) {
+	wire.Build(
+		// Stop
+		stop.New,
+		wire.Bind(new(stop.InstanceLock), new(*instancelock.InstanceLock)),
+		wire.Bind(new(stop.OSLayer), new(*osfacade.OsFacade)),
+
+		// Instance Lock
+		instancelock.New,
+		wire.Bind(new(instancelock.Config), new(*config.Config)),
 
 		// Low-level Interfaces
-		config.New,
+		config.NewWithConfigFile,
 		wire.Bind(new(config.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(config.ConfigFile), new(*configfile.ConfigFile)),
+		configfile.New,
+		wire.Bind(new(configfile.OSLayer), new(*osfacade.OsFacade)),
 		osfacade.New,
 	)
 
 	return nil, nil
 }
 
+func initializeService() (*service.Service, error) {
+	wire.Build(
+		// Service
+		service.New,
+		wire.Bind(new(service.Config), new(*config.Config)),
+		wire.Bind(new(service.OSLayer), new(*osfacade.OsFacade)),
+
+		// Low-level Interfaces
+		config.NewWithConfigFile,
+		wire.Bind(new(config.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(config.ConfigFile), new(*configfile.ConfigFile)),
+		configfile.New,
+		wire.Bind(new(configfile.OSLayer), new(*osfacade.OsFacade)),
+		osfacade.New,
+	)
+
+	return nil, nil
+}
+
+func initializeTelemetryPreview() *telemetrypreview.TelemetryPreview {
+	wire.Build(
+		// Telemetry Preview
+		telemetrypreview.New,
+		wire.Bind(new(telemetrypreview.ReportReader), new(*telemetry.Reader)),
+		wire.Bind(new(telemetrypreview.OSLayer), new(*osfacade.OsFacade)),
+
+		// Telemetry Report Reader
+		telemetry.NewReader,
+		wire.Bind(new(telemetry.OSLayer), new(*osfacade.OsFacade)),
+
+		// Low-level Interfaces
+		osfacade.New,
+	)
+
+	return nil
+}
+
 func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 	wire.Build(
 		// Orchestrator
@@ -112,10 +909,86 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 		wire.Bind(new(orchestrator.Config), new(*config.Config)),
 		wire.Bind(new(orchestrator.Server), new(*server.Server)),
 		wire.Bind(new(orchestrator.WatchdogClient), new(*watchdogclient.Watchdog)),
+		wire.Bind(new(orchestrator.DebugServer), new(*debugserver.DebugServer)),
+		wire.Bind(new(orchestrator.MetricsServer), new(*metrics.Metrics)),
 		wire.Bind(new(orchestrator.LoggerFactory), new(*logger.Factory)),
 		wire.Bind(new(orchestrator.OSSignaler), new(*ossignaler.OSSignaler)),
 		wire.Bind(new(orchestrator.GlobalMATLAB), new(*globalmatlab.GlobalMATLAB)),
 		wire.Bind(new(orchestrator.Directory), new(*directory.Directory)),
+		wire.Bind(new(orchestrator.InstanceLock), new(*instancelock.InstanceLock)),
+		wire.Bind(new(orchestrator.EventRecorder), new(*eventbuffer.Buffer)),
+		wire.Bind(new(orchestrator.MemoryWatchdog), new(*memorywatchdog.Watchdog)),
+		wire.Bind(new(orchestrator.MATLABSupervisor), new(*matlabsupervisor.Supervisor)),
+		wire.Bind(new(orchestrator.ConfigReloader), new(*configreloader.Reloader)),
+
+		// Instance Lock
+		instancelock.New,
+		wire.Bind(new(instancelock.Config), new(*config.Config)),
+
+		// Event Buffer
+		eventbuffer.New,
+		wire.Bind(new(eventbuffer.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(eventbuffer.Directory), new(*directory.Directory)),
+		wire.Bind(new(eventbuffer.LoggerFactory), new(*logger.Factory)),
+		wire.Bind(new(eventbuffer.Encryptor), new(*storageencryption.Encryptor)),
+		wire.Bind(new(eventbuffer.Sink), new(*eventsink.Sink)),
+		wire.Bind(new(eventbuffer.Metrics), new(*metrics.Metrics)),
+
+		// Event Sink
+		eventsink.New,
+		wire.Bind(new(eventsink.Config), new(*config.Config)),
+		wire.Bind(new(eventsink.LoggerFactory), new(*logger.Factory)),
+		wire.Bind(new(eventsink.LifecycleSignaler), new(*lifecyclesignaler.LifecycleSignaler)),
+		wire.Bind(new(eventsink.HTTPClientFactory), new(*httpclientfactory.HTTPClientFactory)),
+
+		// Storage Encryption
+		storageencryption.New,
+		wire.Bind(new(storageencryption.Config), new(*config.Config)),
+		wire.Bind(new(storageencryption.Keychain), new(*keychainfacade.KeychainFacade)),
+		keychainfacade.New,
+
+		// Debug Server
+		debugserver.New,
+		wire.Bind(new(debugserver.Config), new(*config.Config)),
+		wire.Bind(new(debugserver.LoggerFactory), new(*logger.Factory)),
+		wire.Bind(new(debugserver.LifecycleSignaler), new(*lifecyclesignaler.LifecycleSignaler)),
+
+		// Metrics
+		metrics.New,
+		wire.Bind(new(metrics.Config), new(*config.Config)),
+		wire.Bind(new(metrics.LoggerFactory), new(*logger.Factory)),
+		wire.Bind(new(metrics.LifecycleSignaler), new(*lifecyclesignaler.LifecycleSignaler)),
+
+		// Memory Watchdog
+		memorywatchdog.New,
+		wire.Bind(new(memorywatchdog.Config), new(*config.Config)),
+		wire.Bind(new(memorywatchdog.LoggerFactory), new(*logger.Factory)),
+		wire.Bind(new(memorywatchdog.LifecycleSignaler), new(*lifecyclesignaler.LifecycleSignaler)),
+		wire.Bind(new(memorywatchdog.GlobalMATLAB), new(*globalmatlab.GlobalMATLAB)),
+		wire.Bind(new(memorywatchdog.ProcessMemory), new(*processmemory.Reader)),
+		wire.Bind(new(memorywatchdog.ArtifactStore), new(*artifactstore.Store)),
+		wire.Bind(new(memorywatchdog.EventRecorder), new(*eventbuffer.Buffer)),
+		wire.Bind(new(memorywatchdog.ClientNotifier), new(*server.Server)),
+		processmemory.New,
+
+		// MATLAB Supervisor
+		matlabsupervisor.New,
+		wire.Bind(new(matlabsupervisor.Config), new(*config.Config)),
+		wire.Bind(new(matlabsupervisor.LoggerFactory), new(*logger.Factory)),
+		wire.Bind(new(matlabsupervisor.LifecycleSignaler), new(*lifecyclesignaler.LifecycleSignaler)),
+		wire.Bind(new(matlabsupervisor.GlobalMATLAB), new(*globalmatlab.GlobalMATLAB)),
+		wire.Bind(new(matlabsupervisor.ProcessChecker), new(*instancelock.InstanceLock)),
+		wire.Bind(new(matlabsupervisor.EventRecorder), new(*eventbuffer.Buffer)),
+		wire.Bind(new(matlabsupervisor.ClientNotifier), new(*server.Server)),
+
+		// Config Reloader
+		configreloader.New,
+		wire.Bind(new(configreloader.Config), new(*config.Config)),
+		wire.Bind(new(configreloader.LoggerFactory), new(*logger.Factory)),
+		wire.Bind(new(configreloader.LifecycleSignaler), new(*lifecyclesignaler.LifecycleSignaler)),
+		wire.Bind(new(configreloader.ToolPolicy), new(*toolpolicy.Policy)),
+		wire.Bind(new(configreloader.ToolsReloader), new(*server.Server)),
+		wire.Bind(new(configreloader.OSLayer), new(*osfacade.OsFacade)),
 
 		// Watchdog Client
 		watchdogclient.New,
@@ -138,10 +1011,120 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 		wire.Bind(new(server.LoggerFactory), new(*logger.Factory)),
 		wire.Bind(new(server.LifecycleSignaler), new(*lifecyclesignaler.LifecycleSignaler)),
 		wire.Bind(new(server.MCPServerConfigurator), new(*configurator.Configurator)),
+		wire.Bind(new(server.EventBuffer), new(*eventbuffer.Buffer)),
+		wire.Bind(new(server.EventSink), new(*eventsink.Sink)),
+		wire.Bind(new(server.UsageRecorder), new(*telemetry.Collector)),
+		wire.Bind(new(server.ToolPolicy), new(*toolpolicy.Policy)),
+		wire.Bind(new(server.DryRunPlanner), new(*dryrun.Planner)),
+		wire.Bind(new(server.Redactor), new(*redactor.Redactor)),
+		wire.Bind(new(server.RateLimiter), new(*ratelimiter.RateLimiter)),
+		wire.Bind(new(server.NotificationThrottle), new(*notificationthrottle.NotificationThrottle)),
+		wire.Bind(new(server.DaemonSocket), new(*daemon.Socket)),
+		wire.Bind(new(server.SessionRecorder), new(*sessionrecording.Recorder)),
+		wire.Bind(new(server.AuditLog), new(*auditlog.AuditLog)),
+		wire.Bind(new(server.Tracer), new(*tracing.Tracer)),
+		wire.Bind(new(server.Metrics), new(*metrics.Metrics)),
+		wire.Bind(new(server.SessionTranscript), new(*sessiontranscript.Transcript)),
+		wire.Bind(new(server.IdentityProvider), new(*localuser.LocalUser)),
+		wire.Bind(new(server.OutputStreamingConfig), new(*config.Config)),
+		wire.Bind(new(server.ProgressConfig), new(*config.Config)),
+		wire.Bind(new(server.ResponseSizeConfig), new(*config.Config)),
+		wire.Bind(new(server.TransportConfig), new(*config.Config)),
+		wire.Bind(new(server.Localizer), new(*localizer.Localizer)),
+		wire.Bind(new(server.Authenticator), new(*httpauth.Authenticator)),
+		wire.Bind(new(server.OutputArtifacts), new(*artifactstore.Store)),
+
+		// Session Recorder
+		sessionrecording.New,
+		wire.Bind(new(sessionrecording.Config), new(*config.Config)),
+		wire.Bind(new(sessionrecording.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(sessionrecording.LoggerFactory), new(*logger.Factory)),
+		wire.Bind(new(sessionrecording.LifecycleSignaler), new(*lifecyclesignaler.LifecycleSignaler)),
+		wire.Bind(new(sessionrecording.Encryptor), new(*storageencryption.Encryptor)),
+
+		// Audit Log
+		auditlog.New,
+		wire.Bind(new(auditlog.Config), new(*config.Config)),
+		wire.Bind(new(auditlog.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(auditlog.LoggerFactory), new(*logger.Factory)),
+		wire.Bind(new(auditlog.LifecycleSignaler), new(*lifecyclesignaler.LifecycleSignaler)),
+
+		// Local User
+		localuser.New,
+		wire.Bind(new(localuser.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(localuser.LoggerFactory), new(*logger.Factory)),
+
+		// Rate Limiter
+		ratelimiter.New,
+		wire.Bind(new(ratelimiter.Config), new(*config.Config)),
+
+		// HTTP Authenticator
+		httpauth.New,
+		wire.Bind(new(httpauth.Config), new(*config.Config)),
+		wire.Bind(new(httpauth.OSLayer), new(*osfacade.OsFacade)),
+
+		// Notification Throttle
+		notificationthrottle.New,
+		wire.Bind(new(notificationthrottle.Config), new(*config.Config)),
+
+		// Daemon Socket
+		daemon.NewSocket,
+		wire.Bind(new(daemon.Config), new(*config.Config)),
+		wire.Bind(new(daemon.OSLayer), new(*osfacade.OsFacade)),
+
+		// Redactor
+		redactor.New,
+		wire.Bind(new(redactor.Config), new(*config.Config)),
+
+		// Localizer
+		localizer.New,
+		wire.Bind(new(localizer.Config), new(*config.Config)),
+		wire.Bind(new(localizer.OSLayer), new(*osfacade.OsFacade)),
+
+		// Tool Policy
+		toolpolicy.New,
+		wire.Bind(new(toolpolicy.Config), new(*config.Config)),
+		wire.Bind(new(toolpolicy.OSLayer), new(*osfacade.OsFacade)),
+
+		// Dry Run Planner
+		dryrun.New,
+		wire.Bind(new(dryrun.Config), new(*config.Config)),
+		wire.Bind(new(dryrun.CodePolicy), new(*codepolicy.CodePolicy)),
+		wire.Bind(new(dryrun.OSLayer), new(*osfacade.OsFacade)),
+
+		// Telemetry
+		telemetry.New,
+		wire.Bind(new(telemetry.Config), new(*config.Config)),
+		wire.Bind(new(telemetry.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(telemetry.MATLABVersionGetter), new(*matlabversion.Getter)),
+		wire.Bind(new(telemetry.LoggerFactory), new(*logger.Factory)),
+		wire.Bind(new(telemetry.LifecycleSignaler), new(*lifecyclesignaler.LifecycleSignaler)),
+		wire.Bind(new(telemetry.HTTPClientFactory), new(*httpclientfactory.HTTPClientFactory)),
+
+		// OpenTelemetry Tracing
+		tracing.NewTracer,
+		wire.Bind(new(tracing.Exporter), new(*otlpexporter.Exporter)),
+		otlpexporter.New,
+		wire.Bind(new(otlpexporter.Config), new(*config.Config)),
+		wire.Bind(new(otlpexporter.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(otlpexporter.LoggerFactory), new(*logger.Factory)),
+		wire.Bind(new(otlpexporter.LifecycleSignaler), new(*lifecyclesignaler.LifecycleSignaler)),
+		wire.Bind(new(otlpexporter.HTTPClientFactory), new(*httpclientfactory.HTTPClientFactory)),
 
 		// MCP Server Configurator
 		configurator.New,
 		wire.Bind(new(configurator.Config), new(*config.Config)),
+		newToolProviders,
+
+		// Plugins
+		plugins.New,
+		wire.Bind(new(plugins.Config), new(*config.Config)),
+
+		// Downstream MCP Servers
+		downstream.New,
+		wire.Bind(new(downstream.Config), new(*config.Config)),
+		wire.Bind(new(downstream.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(downstream.LifecycleSignaler), new(*lifecyclesignaler.LifecycleSignaler)),
 
 		// Tools
 		wire.Bind(new(basetool.LoggerFactory), new(*logger.Factory)),
@@ -152,6 +1135,9 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 		startmatlabsessiontool.New,
 		wire.Bind(new(startmatlabsessiontool.Usecase), new(*startmatlabsession.Usecase)),
 
+		listmatlabsessionstool.New,
+		wire.Bind(new(listmatlabsessionstool.Usecase), new(*listmatlabsessions.Usecase)),
+
 		stopmatlabsessiontool.New,
 		wire.Bind(new(stopmatlabsessiontool.Usecase), new(*stopmatlabsession.Usecase)),
 
@@ -160,6 +1146,9 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 
 		evalmatlabcodesinglesessiontool.New,
 		wire.Bind(new(evalmatlabcodesinglesessiontool.Usecase), new(*evalmatlabcode.Usecase)),
+		wire.Bind(new(evalmatlabcodesinglesessiontool.FigureRenderer), new(*matlabfigureresource.Resource)),
+		wire.Bind(new(evalmatlabcodesinglesessiontool.WorkspaceDiffer), new(*diffmatlabworkspace.Usecase)),
+		wire.Bind(new(evalmatlabcodesinglesessiontool.VariableReader), new(*getmatlabvariable.Usecase)),
 
 		checkmatlabcodesinglesessiontool.New,
 		wire.Bind(new(checkmatlabcodesinglesessiontool.Usecase), new(*checkmatlabcode.Usecase)),
@@ -173,33 +1162,292 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 		runmatlabtestfilesinglesessiontool.New,
 		wire.Bind(new(runmatlabtestfilesinglesessiontool.Usecase), new(*runmatlabtestfile.Usecase)),
 
+		runmatlabtestssinglesessiontool.New,
+		wire.Bind(new(runmatlabtestssinglesessiontool.Usecase), new(*runmatlabtests.Usecase)),
+
+		startjobsinglesessiontool.New,
+		wire.Bind(new(startjobsinglesessiontool.Usecase), new(*startjob.Usecase)),
+
+		getjobstatussinglesessiontool.New,
+		wire.Bind(new(getjobstatussinglesessiontool.Usecase), new(*getjob.Usecase)),
+
+		getjoboutputsinglesessiontool.New,
+		wire.Bind(new(getjoboutputsinglesessiontool.Usecase), new(*getjob.Usecase)),
+
+		canceljobsinglesessiontool.New,
+		wire.Bind(new(canceljobsinglesessiontool.Usecase), new(*canceljob.Usecase)),
+
+		getpythonenvironmentsinglesessiontool.New,
+		wire.Bind(new(getpythonenvironmentsinglesessiontool.Usecase), new(*getpythonenvironment.Usecase)),
+
+		setpythonenvironmentsinglesessiontool.New,
+		wire.Bind(new(setpythonenvironmentsinglesessiontool.Usecase), new(*setpythonenvironment.Usecase)),
+
+		checkpythonpackagessinglesessiontool.New,
+		wire.Bind(new(checkpythonpackagessinglesessiontool.Usecase), new(*checkpythonpackages.Usecase)),
+
+		runpythoncodesinglesessiontool.New,
+		wire.Bind(new(runpythoncodesinglesessiontool.Usecase), new(*runpythoncode.Usecase)),
+
+		exportlivescriptsinglesessiontool.New,
+		wire.Bind(new(exportlivescriptsinglesessiontool.Usecase), new(*exportlivescript.Usecase)),
+		capturematlabfiguressinglesessiontool.New,
+		wire.Bind(new(capturematlabfiguressinglesessiontool.Usecase), new(*capturematlabfigures.Usecase)),
+
+		loadsimulinkmodelsinglesessiontool.New,
+		wire.Bind(new(loadsimulinkmodelsinglesessiontool.Usecase), new(*loadsimulinkmodel.Usecase)),
+
+		simulatesimulinkmodelsinglesessiontool.New,
+		wire.Bind(new(simulatesimulinkmodelsinglesessiontool.Usecase), new(*simulatesimulinkmodel.Usecase)),
+
+		getsimulinkblockparameterssinglesessiontool.New,
+		wire.Bind(new(getsimulinkblockparameterssinglesessiontool.Usecase), new(*getsimulinkblockparameters.Usecase)),
+
+		setsimulinkparametersinglesessiontool.New,
+		wire.Bind(new(setsimulinkparametersinglesessiontool.Usecase), new(*setsimulinkparameter.Usecase)),
+
+		getmatlabvariablesinglesessiontool.New,
+		wire.Bind(new(getmatlabvariablesinglesessiontool.Usecase), new(*getmatlabvariable.Usecase)),
+
+		setmatlabvariablesinglesessiontool.New,
+		wire.Bind(new(setmatlabvariablesinglesessiontool.Usecase), new(*setmatlabvariable.Usecase)),
+
+		listmatfilevariablessinglesessiontool.New,
+		wire.Bind(new(listmatfilevariablessinglesessiontool.Usecase), new(*listmatfilevariables.Usecase)),
+
+		loadmatfilevariablessinglesessiontool.New,
+		wire.Bind(new(loadmatfilevariablessinglesessiontool.Usecase), new(*loadmatfilevariables.Usecase)),
+
+		buildrealtimeapplicationsinglesessiontool.New,
+		wire.Bind(new(buildrealtimeapplicationsinglesessiontool.Usecase), new(*buildrealtimeapplication.Usecase)),
+
+		deployrealtimeapplicationsinglesessiontool.New,
+		wire.Bind(new(deployrealtimeapplicationsinglesessiontool.Usecase), new(*deployrealtimeapplication.Usecase)),
+
+		controlrealtimeapplicationsinglesessiontool.New,
+		wire.Bind(new(controlrealtimeapplicationsinglesessiontool.Usecase), new(*controlrealtimeapplication.Usecase)),
+
+		streamrealtimesignalssinglesessiontool.New,
+		wire.Bind(new(streamrealtimesignalssinglesessiontool.Usecase), new(*streamrealtimesignals.Usecase)),
+
+		packageproductionarchivesinglesessiontool.New,
+		wire.Bind(new(packageproductionarchivesinglesessiontool.Usecase), new(*packageproductionarchive.Usecase)),
+
+		getmatlabdiagnosticstool.New,
+		wire.Bind(new(getmatlabdiagnosticstool.Usecase), new(*getmatlabdiagnostics.Usecase)),
+
+		findmatlabdefinitiontool.New,
+		wire.Bind(new(findmatlabdefinitiontool.Usecase), new(*findmatlabdefinition.Usecase)),
+
+		pullfrommatlabdrivetool.New,
+		wire.Bind(new(pullfrommatlabdrivetool.Usecase), new(*pullfrommatlabdrive.Usecase)),
+
+		pushtomatlabdrivetool.New,
+		wire.Bind(new(pushtomatlabdrivetool.Usecase), new(*pushtomatlabdrive.Usecase)),
+
+		deployproductionarchivetool.New,
+		wire.Bind(new(deployproductionarchivetool.Usecase), new(*deployproductionarchive.Usecase)),
+
+		invokeproductionfunctiontool.New,
+		wire.Bind(new(invokeproductionfunctiontool.Usecase), new(*invokeproductionfunction.Usecase)),
+
+		getproductionserverstatustool.New,
+		wire.Bind(new(getproductionserverstatustool.Usecase), new(*getproductionserverstatus.Usecase)),
+
+		getserverinfotool.New,
+		wire.Bind(new(getserverinfotool.Usecase), new(*getserverinfo.Usecase)),
+
+		// Resources
+		matlabvariableresource.New,
+		wire.Bind(new(matlabvariableresource.LoggerFactory), new(*logger.Factory)),
+		wire.Bind(new(matlabvariableresource.Usecase), new(*getmatlabvariable.Usecase)),
+		wire.Bind(new(matlabvariableresource.ListUsecase), new(*listmatlabvariables.Usecase)),
+		matlabfigureresource.New,
+		wire.Bind(new(matlabfigureresource.LoggerFactory), new(*logger.Factory)),
+		wire.Bind(new(matlabfigureresource.Config), new(*config.Config)),
+		wire.Bind(new(matlabfigureresource.ListUsecase), new(*listmatlabfigures.Usecase)),
+		wire.Bind(new(matlabfigureresource.RenderUsecase), new(*rendermatlabfigure.Usecase)),
+		matlabartifactresource.New,
+		wire.Bind(new(matlabartifactresource.LoggerFactory), new(*logger.Factory)),
+		wire.Bind(new(matlabartifactresource.ArtifactStore), new(*artifactstore.Store)),
+		matlabdriveresource.New,
+		wire.Bind(new(matlabdriveresource.LoggerFactory), new(*logger.Factory)),
+		wire.Bind(new(matlabdriveresource.Drive), new(*matlabdrive.Drive)),
+
 		// Use Cases
 		listavailablematlabs.New,
 		startmatlabsession.New,
+		listmatlabsessions.New,
 		stopmatlabsession.New,
 		evalmatlabcode.New,
 		wire.Bind(new(evalmatlabcode.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(evalmatlabcode.CodePolicy), new(*codepolicy.CodePolicy)),
+		wire.Bind(new(evalmatlabcode.ApprovalGate), new(*approvalgate.ApprovalGate)),
+		wire.Bind(new(evalmatlabcode.LookupCache), new(*lookupcache.Cache)),
 		checkmatlabcode.New,
 		wire.Bind(new(checkmatlabcode.PathValidator), new(*pathvalidator.PathValidator)),
 		detectmatlabtoolboxes.New,
+		wire.Bind(new(detectmatlabtoolboxes.LookupCache), new(*lookupcache.Cache)),
 		runmatlabfile.New,
 		wire.Bind(new(runmatlabfile.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(runmatlabfile.CodePolicy), new(*codepolicy.CodePolicy)),
+		wire.Bind(new(runmatlabfile.ApprovalGate), new(*approvalgate.ApprovalGate)),
+		wire.Bind(new(runmatlabfile.LookupCache), new(*lookupcache.Cache)),
 		runmatlabtestfile.New,
 		wire.Bind(new(runmatlabtestfile.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(runmatlabtestfile.CodePolicy), new(*codepolicy.CodePolicy)),
+		wire.Bind(new(runmatlabtestfile.ApprovalGate), new(*approvalgate.ApprovalGate)),
+		runmatlabtests.New,
+		wire.Bind(new(runmatlabtests.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(runmatlabtests.CodePolicy), new(*codepolicy.CodePolicy)),
+		wire.Bind(new(runmatlabtests.ApprovalGate), new(*approvalgate.ApprovalGate)),
+		wire.Bind(new(runmatlabtests.OSLayer), new(*osfacade.OsFacade)),
+		getmatlabvariable.New,
+		wire.Bind(new(getmatlabvariable.Config), new(*config.Config)),
+		wire.Bind(new(getmatlabvariable.ArtifactStore), new(*artifactstore.Store)),
+		setmatlabvariable.New,
+		wire.Bind(new(setmatlabvariable.Config), new(*config.Config)),
+		listmatfilevariables.New,
+		wire.Bind(new(listmatfilevariables.PathValidator), new(*pathvalidator.PathValidator)),
+		loadmatfilevariables.New,
+		wire.Bind(new(loadmatfilevariables.PathValidator), new(*pathvalidator.PathValidator)),
+		listmatlabfigures.New,
+		listmatlabvariables.New,
+		rendermatlabfigure.New,
+		wire.Bind(new(rendermatlabfigure.Config), new(*config.Config)),
+		wire.Bind(new(rendermatlabfigure.OSLayer), new(*osfacade.OsFacade)),
+		capturematlabfigures.New,
+		wire.Bind(new(capturematlabfigures.OSLayer), new(*osfacade.OsFacade)),
+		diffmatlabworkspace.New,
+		wire.Bind(new(diffmatlabworkspace.Config), new(*config.Config)),
+		startjob.New,
+		wire.Bind(new(startjob.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(startjob.CodePolicy), new(*codepolicy.CodePolicy)),
+		wire.Bind(new(startjob.ApprovalGate), new(*approvalgate.ApprovalGate)),
+		wire.Bind(new(startjob.JobManager), new(*jobmanager.Manager)),
+		getjob.New,
+		wire.Bind(new(getjob.JobManager), new(*jobmanager.Manager)),
+		canceljob.New,
+		wire.Bind(new(canceljob.JobManager), new(*jobmanager.Manager)),
+		getpythonenvironment.New,
+		setpythonenvironment.New,
+		wire.Bind(new(setpythonenvironment.CodePolicy), new(*codepolicy.CodePolicy)),
+		checkpythonpackages.New,
+		wire.Bind(new(checkpythonpackages.CodePolicy), new(*codepolicy.CodePolicy)),
+		runpythoncode.New,
+		wire.Bind(new(runpythoncode.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(runpythoncode.CodePolicy), new(*codepolicy.CodePolicy)),
+		wire.Bind(new(runpythoncode.ApprovalGate), new(*approvalgate.ApprovalGate)),
+		exportlivescript.New,
+		wire.Bind(new(exportlivescript.Transcript), new(*sessiontranscript.Transcript)),
+		wire.Bind(new(exportlivescript.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(exportlivescript.OSLayer), new(*osfacade.OsFacade)),
+		loadsimulinkmodel.New,
+		wire.Bind(new(loadsimulinkmodel.PathValidator), new(*pathvalidator.PathValidator)),
+		simulatesimulinkmodel.New,
+		wire.Bind(new(simulatesimulinkmodel.PathValidator), new(*pathvalidator.PathValidator)),
+		getsimulinkblockparameters.New,
+		wire.Bind(new(getsimulinkblockparameters.PathValidator), new(*pathvalidator.PathValidator)),
+		setsimulinkparameter.New,
+		wire.Bind(new(setsimulinkparameter.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(setsimulinkparameter.CodePolicy), new(*codepolicy.CodePolicy)),
+		buildrealtimeapplication.New,
+		wire.Bind(new(buildrealtimeapplication.PathValidator), new(*pathvalidator.PathValidator)),
+		deployrealtimeapplication.New,
+		wire.Bind(new(deployrealtimeapplication.Config), new(*config.Config)),
+		wire.Bind(new(deployrealtimeapplication.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(deployrealtimeapplication.ApprovalGate), new(*approvalgate.ApprovalGate)),
+		controlrealtimeapplication.New,
+		wire.Bind(new(controlrealtimeapplication.Config), new(*config.Config)),
+		wire.Bind(new(controlrealtimeapplication.ApprovalGate), new(*approvalgate.ApprovalGate)),
+		streamrealtimesignals.New,
+		wire.Bind(new(streamrealtimesignals.Config), new(*config.Config)),
+		getmatlabdiagnostics.New,
+		wire.Bind(new(getmatlabdiagnostics.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(getmatlabdiagnostics.OSLayer), new(*osfacade.OsFacade)),
+		findmatlabdefinition.New,
+		wire.Bind(new(findmatlabdefinition.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(findmatlabdefinition.OSLayer), new(*osfacade.OsFacade)),
+		pullfrommatlabdrive.New,
+		wire.Bind(new(pullfrommatlabdrive.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(pullfrommatlabdrive.Drive), new(*matlabdrive.Drive)),
+		wire.Bind(new(pullfrommatlabdrive.OSLayer), new(*osfacade.OsFacade)),
+		pushtomatlabdrive.New,
+		wire.Bind(new(pushtomatlabdrive.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(pushtomatlabdrive.Drive), new(*matlabdrive.Drive)),
+		wire.Bind(new(pushtomatlabdrive.ArtifactStore), new(*artifactstore.Store)),
+		wire.Bind(new(pushtomatlabdrive.OSLayer), new(*osfacade.OsFacade)),
+		packageproductionarchive.New,
+		wire.Bind(new(packageproductionarchive.PathValidator), new(*pathvalidator.PathValidator)),
+		deployproductionarchive.New,
+		wire.Bind(new(deployproductionarchive.Config), new(*config.Config)),
+		wire.Bind(new(deployproductionarchive.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(deployproductionarchive.ApprovalGate), new(*approvalgate.ApprovalGate)),
+		wire.Bind(new(deployproductionarchive.OSLayer), new(*osfacade.OsFacade)),
+		invokeproductionfunction.New,
+		wire.Bind(new(invokeproductionfunction.ProductionServer), new(*productionserver.Client)),
+		getproductionserverstatus.New,
+		wire.Bind(new(getproductionserverstatus.Config), new(*config.Config)),
+		wire.Bind(new(getproductionserverstatus.ProductionServer), new(*productionserver.Client)),
+		getserverinfo.New,
+		wire.Bind(new(getserverinfo.Config), new(*config.Config)),
 
 		// Use Cases Utilities
 		pathvalidator.New,
 		wire.Bind(new(pathvalidator.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(pathvalidator.Config), new(*config.Config)),
+		codepolicy.New,
+		wire.Bind(new(codepolicy.Config), new(*config.Config)),
+		wire.Bind(new(codepolicy.OSLayer), new(*osfacade.OsFacade)),
+		approvalgate.New,
+		wire.Bind(new(approvalgate.Config), new(*config.Config)),
+		wire.Bind(new(approvalgate.OSLayer), new(*osfacade.OsFacade)),
+		lookupcache.New,
+		wire.Bind(new(lookupcache.Config), new(*config.Config)),
+		wire.Bind(new(lookupcache.OSLayer), new(*osfacade.OsFacade)),
+		matlabdrive.New,
+		wire.Bind(new(matlabdrive.Config), new(*config.Config)),
+		wire.Bind(new(matlabdrive.OSLayer), new(*osfacade.OsFacade)),
+		jobmanager.New,
+		wire.Bind(new(jobmanager.Config), new(*config.Config)),
+		artifactstore.New,
+		wire.Bind(new(artifactstore.Config), new(*config.Config)),
+		wire.Bind(new(artifactstore.Directory), new(*directory.Directory)),
+		wire.Bind(new(artifactstore.OSLayer), new(*osfacade.OsFacade)),
+		sessiontranscript.New,
+		productionserver.New,
+		wire.Bind(new(productionserver.Config), new(*config.Config)),
+		wire.Bind(new(productionserver.HTTPClientFactory), new(*httpclientfactory.HTTPClientFactory)),
 
 		// Entities
 		wire.Bind(new(entities.GlobalMATLAB), new(*globalmatlab.GlobalMATLAB)),
 		wire.Bind(new(entities.MATLABManager), new(*matlabmanager.MATLABManager)),
+		wire.Bind(new(entities.WorkerPool), new(*workerpool.WorkerPool)),
 
 		// MATLAB Manager
 		matlabmanager.New,
 		wire.Bind(new(matlabmanager.MATLABServices), new(*matlabservices.MATLABServices)),
 		wire.Bind(new(matlabmanager.MATLABSessionStore), new(*matlabsessionstore.Store)),
 		wire.Bind(new(matlabmanager.MATLABSessionClientFactory), new(*matlabsessionclient.Factory)),
+		wire.Bind(new(matlabmanager.CertificateTrust), new(*certificatetrust.CertificateTrust)),
+		wire.Bind(new(matlabmanager.UsageRecorder), new(*telemetry.Collector)),
+		wire.Bind(new(matlabmanager.MATLABRecorder), new(*matlabfixture.Recorder)),
+		wire.Bind(new(matlabmanager.MATLABPlayer), new(*matlabfixture.Player)),
+
+		// MATLAB Fixtures
+		matlabfixture.NewRecorder,
+		wire.Bind(new(matlabfixture.RecorderConfig), new(*config.Config)),
+		wire.Bind(new(matlabfixture.RecorderOSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(matlabfixture.LoggerFactory), new(*logger.Factory)),
+		wire.Bind(new(matlabfixture.LifecycleSignaler), new(*lifecyclesignaler.LifecycleSignaler)),
+		wire.Bind(new(matlabfixture.Redactor), new(*redactor.Redactor)),
+		matlabfixture.NewPlayer,
+		wire.Bind(new(matlabfixture.PlayerConfig), new(*config.Config)),
+		wire.Bind(new(matlabfixture.PlayerOSLayer), new(*osfacade.OsFacade)),
+
+		// MATLAB Session Certificate Trust
+		certificatetrust.New,
+		wire.Bind(new(certificatetrust.Config), new(*config.Config)),
 
 		// MATLAB Session Store
 		matlabsessionstore.New,
@@ -209,13 +1457,28 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 		// MATLAB Session Client Factory
 		matlabsessionclient.NewFactory,
 		wire.Bind(new(matlabsessionclient.HttpClientFactory), new(*httpclientfactory.HTTPClientFactory)),
+		wire.Bind(new(matlabsessionclient.Metrics), new(*metrics.Metrics)),
+		wire.Bind(new(matlabsessionclient.Config), new(*config.Config)),
+		wire.Bind(new(matlabsessionclient.Redactor), new(*redactor.Redactor)),
+		wire.Bind(new(matlabsessionclient.OSLayer), new(*osfacade.OsFacade)),
+
+		// HTTP Client Factory
+		wire.Bind(new(httpclientfactory.Config), new(*config.Config)),
 
 		// Global MATLAB Session
 		globalmatlab.New,
+		wire.Bind(new(globalmatlab.Config), new(*config.Config)),
 		wire.Bind(new(globalmatlab.MATLABManager), new(*matlabmanager.MATLABManager)),
 		wire.Bind(new(globalmatlab.MATLABRootSelector), new(*matlabrootselector.MATLABRootSelector)),
 		wire.Bind(new(globalmatlab.MATLABStartingDirSelector), new(*matlabstartingdirselector.MATLABStartingDirSelector)),
 
+		// MATLAB Worker Pool
+		workerpool.New,
+		wire.Bind(new(workerpool.Config), new(*config.Config)),
+		wire.Bind(new(workerpool.MATLABManager), new(*matlabmanager.MATLABManager)),
+		wire.Bind(new(workerpool.MATLABRootSelector), new(*matlabrootselector.MATLABRootSelector)),
+		wire.Bind(new(workerpool.MATLABStartingDirSelector), new(*matlabstartingdirselector.MATLABStartingDirSelector)),
+
 		// MATLAB Root Selector
 		matlabrootselector.New,
 		wire.Bind(new(matlabrootselector.Config), new(*config.Config)),
@@ -230,6 +1493,7 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 		matlabservices.New,
 		wire.Bind(new(matlabservices.MATLABLocator), new(*matlablocator.MATLABLocator)),
 		wire.Bind(new(matlabservices.LocalMATLABSessionLauncher), new(*localmatlabsession.Starter)),
+		wire.Bind(new(matlabservices.SharedMATLABSessionConnector), new(*sharedmatlabsession.Connector)),
 
 		// MATLAB Locator
 		matlablocator.New,
@@ -243,11 +1507,17 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 		wire.Bind(new(localmatlabsession.MATLABProcessLauncher), new(*processlauncher.MATLABProcessLauncher)),
 		wire.Bind(new(localmatlabsession.Watchdog), new(*watchdogclient.Watchdog)),
 
+		// Shared MATLAB Session
+		sharedmatlabsession.NewConnector,
+		wire.Bind(new(sharedmatlabsession.SessionDirectoryFactory), new(*directorymanager.DirectoryFactory)),
+		wire.Bind(new(sharedmatlabsession.OSLayer), new(*osfacade.OsFacade)),
+
 		// Local MATLAB Session Directory Manager
 		directorymanager.NewFactory,
 		wire.Bind(new(directorymanager.OSLayer), new(*osfacade.OsFacade)),
 		wire.Bind(new(directorymanager.ApplicationDirectory), new(*directory.Directory)),
 		wire.Bind(new(directorymanager.MATLABFiles), new(matlabfiles.MATLABFiles)),
+		wire.Bind(new(directorymanager.Config), new(*config.Config)),
 
 		// Local MATLAB Session Process Details
 		processdetails.New,
@@ -281,8 +1551,14 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 				directory.New,
 				wire.Bind(new(directory.OSLayer), new(*osfacade.OsFacade)),
 				lifecyclesignaler.New,
-				config.New,
+				config.NewWithManagedPolicy,
 				wire.Bind(new(config.OSLayer), new(*osfacade.OsFacade)),
+				wire.Bind(new(config.ManagedPolicy), new(*managedpolicy.ManagedPolicy)),
+				wire.Bind(new(config.ConfigFile), new(*configfile.ConfigFile)),
+				configfile.New,
+				wire.Bind(new(configfile.OSLayer), new(*osfacade.OsFacade)),
+				managedpolicy.New,
+				wire.Bind(new(managedpolicy.OSLayer), new(*osfacade.OsFacade)),
 				osfacade.New,
 				iofacade.New,
 				filefacade.New,
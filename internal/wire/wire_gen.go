@@ -7,16 +7,47 @@
 package wire
 
 import (
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/attach"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/cleanup"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/completion"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/config"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/debugserver"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/directory"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/doctor"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/install"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/jupyterkernel"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/lifecyclesignaler"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/logs"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/modeselector"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/orchestrator"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/pipeline"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/repl"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/replay"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/selftest"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/service"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/status"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/stop"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/telemetrypreview"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/version"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/auditlog"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/configfile"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/configreloader"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/daemon"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/downstream"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/dryrun"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/eventbuffer"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/eventsink"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/globalmatlab"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/globalmatlab/matlabrootselector"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/globalmatlab/matlabstartingdirselector"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/httpauth"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/localizer"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/localuser"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/logger"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/managedpolicy"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabfixture"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/certificatetrust"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directorymanager"
@@ -26,37 +57,139 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/matlablocator"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/matlablocator/matlabroot"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/matlablocator/matlabversion"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/sharedmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabsupervisor"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabartifact"
+	matlabdrive2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabdrive"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabfigure"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabvariable"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server/configurator"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/serverlauncher"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
 	evalmatlabcode2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/evalmatlabcode"
 	listavailablematlabs2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/listavailablematlabs"
+	listmatlabsessions2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/listmatlabsessions"
 	startmatlabsession2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/startmatlabsession"
 	stopmatlabsession2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/stopmatlabsession"
+	deployproductionarchive2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/deployproductionarchive"
+	findmatlabdefinition2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/findmatlabdefinition"
+	getmatlabdiagnostics2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/getmatlabdiagnostics"
+	getproductionserverstatus2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/getproductionserverstatus"
+	getserverinfo2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/getserverinfo"
+	invokeproductionfunction2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/invokeproductionfunction"
+	pullfrommatlabdrive2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/pullfrommatlabdrive"
+	pushtomatlabdrive2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/sessionless/pushtomatlabdrive"
+	buildrealtimeapplication2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/buildrealtimeapplication"
+	canceljob2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/canceljob"
+	capturematlabfigures2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/capturematlabfigures"
 	checkmatlabcode2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/checkmatlabcode"
+	checkpythonpackages2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/checkpythonpackages"
+	controlrealtimeapplication2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/controlrealtimeapplication"
+	deployrealtimeapplication2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/deployrealtimeapplication"
 	detectmatlabtoolboxes2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/detectmatlabtoolboxes"
 	evalmatlabcode3 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/evalmatlabcode"
+	exportlivescript2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/exportlivescript"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/getjoboutput"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/getjobstatus"
+	getmatlabvariable2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/getmatlabvariable"
+	getpythonenvironment2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/getpythonenvironment"
+	getsimulinkblockparameters2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/getsimulinkblockparameters"
+	listmatfilevariables2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/listmatfilevariables"
+	loadmatfilevariables2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/loadmatfilevariables"
+	loadsimulinkmodel2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/loadsimulinkmodel"
+	packageproductionarchive2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/packageproductionarchive"
 	runmatlabfile2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabfile"
 	runmatlabtestfile2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabtestfile"
+	runmatlabtests2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabtests"
+	runpythoncode2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runpythoncode"
+	setmatlabvariable2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/setmatlabvariable"
+	setpythonenvironment2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/setpythonenvironment"
+	setsimulinkparameter2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/setsimulinkparameter"
+	simulatesimulinkmodel2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/simulatesimulinkmodel"
+	startjob2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/startjob"
+	streamrealtimesignals2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/streamrealtimesignals"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/memorywatchdog"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/metrics"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/notificationthrottle"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/otlpexporter"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/plugins"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/ratelimiter"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/redactor"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/sessionrecording"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/storageencryption"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/telemetry"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/toolpolicy"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/watchdog"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/watchdog/process"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/workerpool"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/filefacade"
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/iofacade"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/keychainfacade"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/netfacade"
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/buildrealtimeapplication"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/canceljob"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/capturematlabfigures"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/checkmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/checkpythonpackages"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/controlrealtimeapplication"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/deployproductionarchive"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/deployrealtimeapplication"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/detectmatlabtoolboxes"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/diffmatlabworkspace"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/evalmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/exportlivescript"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/findmatlabdefinition"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getjob"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getmatlabdiagnostics"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getmatlabvariable"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getproductionserverstatus"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getpythonenvironment"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getserverinfo"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getsimulinkblockparameters"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/invokeproductionfunction"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listavailablematlabs"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listmatfilevariables"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listmatlabfigures"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listmatlabsessions"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listmatlabvariables"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/loadmatfilevariables"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/loadsimulinkmodel"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/packageproductionarchive"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/pullfrommatlabdrive"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/pushtomatlabdrive"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/rendermatlabfigure"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/runmatlabfile"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/runmatlabtestfile"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/runmatlabtests"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/runpythoncode"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/setmatlabvariable"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/setpythonenvironment"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/setsimulinkparameter"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/simulatesimulinkmodel"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/startjob"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/startmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/stopmatlabsession"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/streamrealtimesignals"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/approvalgate"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/artifactstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/codepolicy"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/jobmanager"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/lookupcache"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/matlabdrive"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/pathvalidator"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/productionserver"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/sessiontranscript"
 	"github.com/matlab/matlab-mcp-core-server/internal/utils/httpclientfactory"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/instancelock"
 	"github.com/matlab/matlab-mcp-core-server/internal/utils/ossignaler"
 	"github.com/matlab/matlab-mcp-core-server/internal/utils/oswrapper"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/processmemory"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/tracing"
 	watchdog2 "github.com/matlab/matlab-mcp-core-server/internal/watchdog"
 	"github.com/matlab/matlab-mcp-core-server/internal/watchdog/processhandler"
 	"github.com/matlab/matlab-mcp-core-server/internal/watchdog/transport"
@@ -66,20 +199,282 @@ import (
 
 func InitializeModeSelector() (*modeselector.ModeSelector, error) {
 	osFacade := osfacade.New()
-	configConfig, err := config.New(osFacade)
+	configFile := configfile.New(osFacade)
+	configConfig, err := config.NewWithConfigFile(osFacade, configFile)
 	if err != nil {
 		return nil, err
 	}
 	wireWatchdogProcessFactory := newWatchdogProcessFactory()
 	wireOrchestratorFactory := newOrchestratorFactory()
-	modeSelector := modeselector.New(configConfig, wireWatchdogProcessFactory, wireOrchestratorFactory, osFacade)
+	wireStatusFactory := newStatusFactory()
+	wireTelemetryPreviewFactory := newTelemetryPreviewFactory()
+	wireReplayFactory := newReplayFactory()
+	wireAttachFactory := newAttachFactory()
+	wireDoctorFactory := newDoctorFactory()
+	wireInstallFactory := newInstallFactory()
+	wireCompletionFactory := newCompletionFactory()
+	wireLogsFactory := newLogsFactory()
+	wireCleanupFactory := newCleanupFactory()
+	wireStopFactory := newStopFactory()
+	wireServiceFactory := newServiceFactory()
+	wireReplFactory := newREPLFactory()
+	wirePipelineFactory := newPipelineFactory()
+	wireSelfTestFactory := newSelfTestFactory()
+	wireKernelFactory := newKernelFactory()
+	wireVersionFactory := newVersionFactory()
+	modeSelector := modeselector.New(configConfig, wireWatchdogProcessFactory, wireOrchestratorFactory, wireStatusFactory, wireTelemetryPreviewFactory, wireReplayFactory, wireAttachFactory, wireDoctorFactory, wireInstallFactory, wireCompletionFactory, wireLogsFactory, wireCleanupFactory, wireStopFactory, wireServiceFactory, wireReplFactory, wirePipelineFactory, wireSelfTestFactory, wireKernelFactory, wireVersionFactory)
 	return modeSelector, nil
 }
 
+func initializeStatus() (*status.Status, error) {
+	osFacade := osfacade.New()
+	configFile := configfile.New(osFacade)
+	configConfig, err := config.NewWithConfigFile(osFacade, configFile)
+	if err != nil {
+		return nil, err
+	}
+	fileFacade := filefacade.New()
+	keychainFacade := keychainfacade.New()
+	encryptor, err := storageencryption.New(configConfig, keychainFacade)
+	if err != nil {
+		return nil, err
+	}
+	reader := eventbuffer.NewReader(osFacade, fileFacade, encryptor)
+	statusStatus := status.New(configConfig, reader, osFacade)
+	return statusStatus, nil
+}
+
+func initializeReplay() (*replay.Replay, error) {
+	osFacade := osfacade.New()
+	configFile := configfile.New(osFacade)
+	configConfig, err := config.NewWithConfigFile(osFacade, configFile)
+	if err != nil {
+		return nil, err
+	}
+	keychainFacade := keychainfacade.New()
+	encryptor, err := storageencryption.New(configConfig, keychainFacade)
+	if err != nil {
+		return nil, err
+	}
+	reader := sessionrecording.NewReader(osFacade, encryptor)
+	serverLauncher := serverlauncher.New(configConfig, osFacade)
+	replayReplay := replay.New(configConfig, reader, serverLauncher, osFacade)
+	return replayReplay, nil
+}
+
+func initializeAttach() (*attach.Attach, error) {
+	osFacade := osfacade.New()
+	configFile := configfile.New(osFacade)
+	configConfig, err := config.NewWithConfigFile(osFacade, configFile)
+	if err != nil {
+		return nil, err
+	}
+	socket := daemon.NewSocket(configConfig, osFacade)
+	launcher := daemon.NewLauncher(osFacade)
+	attachAttach := attach.New(socket, launcher, osFacade)
+	return attachAttach, nil
+}
+
+func initializeDoctor() (*doctor.Doctor, error) {
+	osFacade := osfacade.New()
+	configFile := configfile.New(osFacade)
+	configConfig, err := config.NewWithConfigFile(osFacade, configFile)
+	if err != nil {
+		return nil, err
+	}
+	directoryDirectory, err := directory.New(osFacade)
+	if err != nil {
+		return nil, err
+	}
+	factory, err := logger.NewFactory(configConfig, directoryDirectory, osFacade)
+	if err != nil {
+		return nil, err
+	}
+	fileFacade := filefacade.New()
+	getter := matlabroot.New(osFacade, fileFacade)
+	ioFacade := iofacade.New()
+	matlabversionGetter := matlabversion.New(osFacade, ioFacade)
+	instanceLock, err := instancelock.New(configConfig)
+	if err != nil {
+		return nil, err
+	}
+	socket := daemon.NewSocket(configConfig, osFacade)
+	netFacade := netfacade.New()
+	localizerLocalizer := localizer.New(configConfig, osFacade)
+	doctorDoctor := doctor.New(configConfig, factory, getter, matlabversionGetter, instanceLock, socket, osFacade, fileFacade, netFacade, localizerLocalizer)
+	return doctorDoctor, nil
+}
+
+func initializeREPL() (*repl.REPL, error) {
+	osFacade := osfacade.New()
+	configFile := configfile.New(osFacade)
+	configConfig, err := config.NewWithConfigFile(osFacade, configFile)
+	if err != nil {
+		return nil, err
+	}
+	serverLauncher := serverlauncher.New(configConfig, osFacade)
+	replREPL := repl.New(configConfig, serverLauncher, osFacade)
+	return replREPL, nil
+}
+
+func initializePipeline() (*pipeline.Pipeline, error) {
+	osFacade := osfacade.New()
+	configFile := configfile.New(osFacade)
+	configConfig, err := config.NewWithConfigFile(osFacade, configFile)
+	if err != nil {
+		return nil, err
+	}
+	serverLauncher := serverlauncher.New(configConfig, osFacade)
+	pipelinePipeline := pipeline.New(configConfig, serverLauncher, osFacade)
+	return pipelinePipeline, nil
+}
+
+func initializeSelfTest() (*selftest.SelfTest, error) {
+	osFacade := osfacade.New()
+	configFile := configfile.New(osFacade)
+	configConfig, err := config.NewWithConfigFile(osFacade, configFile)
+	if err != nil {
+		return nil, err
+	}
+	serverLauncher := serverlauncher.New(configConfig, osFacade)
+	selfTest := selftest.New(configConfig, serverLauncher, osFacade)
+	return selfTest, nil
+}
+
+func initializeKernel() (*jupyterkernel.Kernel, error) {
+	osFacade := osfacade.New()
+	configFile := configfile.New(osFacade)
+	configConfig, err := config.NewWithConfigFile(osFacade, configFile)
+	if err != nil {
+		return nil, err
+	}
+	socket := daemon.NewSocket(configConfig, osFacade)
+	netFacade := netfacade.New()
+	kernel := jupyterkernel.New(configConfig, socket, netFacade, osFacade)
+	return kernel, nil
+}
+
+func initializeInstall() (*install.Install, error) {
+	osFacade := osfacade.New()
+	configFile := configfile.New(osFacade)
+	configConfig, err := config.NewWithConfigFile(osFacade, configFile)
+	if err != nil {
+		return nil, err
+	}
+	installInstall := install.New(configConfig, osFacade)
+	return installInstall, nil
+}
+
+func initializeVersion() (*version.Version, error) {
+	osFacade := osfacade.New()
+	configFile := configfile.New(osFacade)
+	configConfig, err := config.NewWithConfigFile(osFacade, configFile)
+	if err != nil {
+		return nil, err
+	}
+	discardFactory := logger.NewDiscardFactory()
+	fileFacade := filefacade.New()
+	getter := matlabroot.New(osFacade, fileFacade)
+	ioFacade := iofacade.New()
+	matlabversionGetter := matlabversion.New(osFacade, ioFacade)
+	versionVersion := version.New(configConfig, discardFactory, getter, matlabversionGetter, osFacade)
+	return versionVersion, nil
+}
+
+func initializeCompletion() (*completion.Completion, error) {
+	osFacade := osfacade.New()
+	configFile := configfile.New(osFacade)
+	configConfig, err := config.NewWithConfigFile(osFacade, configFile)
+	if err != nil {
+		return nil, err
+	}
+	discardFactory := logger.NewDiscardFactory()
+	fileFacade := filefacade.New()
+	getter := matlabroot.New(osFacade, fileFacade)
+	completionCompletion := completion.New(configConfig, discardFactory, getter, osFacade)
+	return completionCompletion, nil
+}
+
+func initializeLogs() (*logs.Logs, error) {
+	osFacade := osfacade.New()
+	configFile := configfile.New(osFacade)
+	configConfig, err := config.NewWithConfigFile(osFacade, configFile)
+	if err != nil {
+		return nil, err
+	}
+	fileFacade := filefacade.New()
+	keychainFacade := keychainfacade.New()
+	encryptor, err := storageencryption.New(configConfig, keychainFacade)
+	if err != nil {
+		return nil, err
+	}
+	reader := eventbuffer.NewReader(osFacade, fileFacade, encryptor)
+	instanceLock, err := instancelock.New(configConfig)
+	if err != nil {
+		return nil, err
+	}
+	logsLogs := logs.New(configConfig, reader, instanceLock, osFacade)
+	return logsLogs, nil
+}
+
+func initializeCleanup() (*cleanup.Cleanup, error) {
+	osFacade := osfacade.New()
+	configFile := configfile.New(osFacade)
+	configConfig, err := config.NewWithConfigFile(osFacade, configFile)
+	if err != nil {
+		return nil, err
+	}
+	instanceLock, err := instancelock.New(configConfig)
+	if err != nil {
+		return nil, err
+	}
+	fileFacade := filefacade.New()
+	cleanupCleanup := cleanup.New(configConfig, instanceLock, osFacade, fileFacade)
+	return cleanupCleanup, nil
+}
+
+func initializeStop() (*stop.Stop, error) {
+	osFacade := osfacade.New()
+	configFile := configfile.New(osFacade)
+	configConfig, err := config.NewWithConfigFile(osFacade, configFile)
+	if err != nil {
+		return nil, err
+	}
+	instanceLock, err := instancelock.New(configConfig)
+	if err != nil {
+		return nil, err
+	}
+	stopStop := stop.New(instanceLock, osFacade)
+	return stopStop, nil
+}
+
+func initializeService() (*service.Service, error) {
+	osFacade := osfacade.New()
+	configFile := configfile.New(osFacade)
+	configConfig, err := config.NewWithConfigFile(osFacade, configFile)
+	if err != nil {
+		return nil, err
+	}
+	serviceService := service.New(configConfig, osFacade)
+	return serviceService, nil
+}
+
+func initializeTelemetryPreview() *telemetrypreview.TelemetryPreview {
+	osFacade := osfacade.New()
+	reader := telemetry.NewReader(osFacade)
+	telemetryPreview := telemetrypreview.New(reader, osFacade)
+	return telemetryPreview
+}
+
 func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 	lifecycleSignaler := lifecyclesignaler.New()
 	osFacade := osfacade.New()
-	configConfig, err := config.New(osFacade)
+	managedPolicy, err := managedpolicy.New(osFacade)
+	if err != nil {
+		return nil, err
+	}
+	configFile := configfile.New(osFacade)
+	configConfig, err := config.NewWithManagedPolicy(osFacade, managedPolicy, configFile)
 	if err != nil {
 		return nil, err
 	}
@@ -98,7 +493,7 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 	matlabversionGetter := matlabversion.New(osFacade, ioFacade)
 	matlabLocator := matlablocator.New(getter, matlabversionGetter)
 	matlabFiles := matlabfiles.New()
-	directoryFactory := directorymanager.NewFactory(osFacade, directoryDirectory, matlabFiles)
+	directoryFactory := directorymanager.NewFactory(osFacade, directoryDirectory, matlabFiles, configConfig)
 	processDetails := processdetails.New(osFacade)
 	matlabProcessLauncher := processlauncher.New()
 	processProcess, err := process.New(osFacade, factory)
@@ -108,39 +503,183 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 	transportFactory := transport.NewFactory()
 	watchdogWatchdog := watchdog.New(processProcess, transportFactory, factory)
 	starter := localmatlabsession.NewStarter(directoryFactory, processDetails, matlabProcessLauncher, watchdogWatchdog)
-	matlabServices := matlabservices.New(matlabLocator, starter)
+	connector := sharedmatlabsession.NewConnector(directoryFactory, osFacade)
+	matlabServices := matlabservices.New(matlabLocator, starter, connector)
 	store := matlabsessionstore.New(factory, lifecycleSignaler)
-	httpClientFactory := httpclientfactory.New()
-	matlabsessionclientFactory := matlabsessionclient.NewFactory(httpClientFactory)
-	matlabManager := matlabmanager.New(matlabServices, store, matlabsessionclientFactory)
+	httpClientFactory := httpclientfactory.New(configConfig)
+	redactorRedactor, err := redactor.New(configConfig)
+	if err != nil {
+		return nil, err
+	}
+	metricsMetrics := metrics.New(configConfig, factory, lifecycleSignaler)
+	matlabsessionclientFactory := matlabsessionclient.NewFactory(httpClientFactory, configConfig, redactorRedactor, osFacade, metricsMetrics)
+	certificateTrust := certificatetrust.New(configConfig)
+	collector := telemetry.New(configConfig, osFacade, matlabversionGetter, factory, lifecycleSignaler, httpClientFactory)
+	recorder, err := matlabfixture.NewRecorder(configConfig, osFacade, factory, lifecycleSignaler, redactorRedactor)
+	if err != nil {
+		return nil, err
+	}
+	player, err := matlabfixture.NewPlayer(configConfig, osFacade)
+	if err != nil {
+		return nil, err
+	}
+	matlabManager := matlabmanager.New(matlabServices, store, matlabsessionclientFactory, certificateTrust, collector, recorder, player)
 	usecase := listavailablematlabs.New(matlabManager)
 	tool := listavailablematlabs2.New(factory, usecase)
 	startmatlabsessionUsecase := startmatlabsession.New(matlabManager)
 	startmatlabsessionTool := startmatlabsession2.New(factory, startmatlabsessionUsecase)
+	listmatlabsessionsUsecase := listmatlabsessions.New(matlabManager)
+	listmatlabsessionsTool := listmatlabsessions2.New(factory, listmatlabsessionsUsecase)
 	stopmatlabsessionUsecase := stopmatlabsession.New(matlabManager)
-	stopmatlabsessionTool := stopmatlabsession2.New(factory, stopmatlabsessionUsecase)
-	pathValidator := pathvalidator.New(osFacade)
-	evalmatlabcodeUsecase := evalmatlabcode.New(pathValidator)
+	stopmatlabsessionTool := stopmatlabsession2.New(factory, stopmatlabsessionUsecase, matlabManager)
+	pathValidator := pathvalidator.New(osFacade, configConfig)
+	codePolicy := codepolicy.New(configConfig, osFacade)
+	approvalGate := approvalgate.New(configConfig, osFacade)
+	cache := lookupcache.New(configConfig, osFacade)
+	evalmatlabcodeUsecase := evalmatlabcode.New(pathValidator, codePolicy, approvalGate, cache)
 	evalmatlabcodeTool := evalmatlabcode2.New(factory, evalmatlabcodeUsecase, matlabManager)
 	matlabRootSelector := matlabrootselector.New(configConfig, matlabManager)
 	matlabStartingDirSelector := matlabstartingdirselector.New(configConfig, osFacade)
-	globalMATLAB := globalmatlab.New(matlabManager, matlabRootSelector, matlabStartingDirSelector)
-	tool2 := evalmatlabcode3.New(factory, evalmatlabcodeUsecase, globalMATLAB)
+	globalMATLAB := globalmatlab.New(configConfig, matlabManager, matlabRootSelector, matlabStartingDirSelector)
+	listmatlabfiguresUsecase := listmatlabfigures.New()
+	rendermatlabfigureUsecase := rendermatlabfigure.New(configConfig, osFacade)
+	resource := matlabfigure.New(factory, configConfig, listmatlabfiguresUsecase, rendermatlabfigureUsecase, globalMATLAB)
+	diffmatlabworkspaceUsecase := diffmatlabworkspace.New(configConfig)
+	artifactstoreStore := artifactstore.New(configConfig, directoryDirectory, osFacade)
+	getmatlabvariableUsecase := getmatlabvariable.New(configConfig, artifactstoreStore)
+	tool2 := evalmatlabcode3.New(factory, evalmatlabcodeUsecase, globalMATLAB, resource, diffmatlabworkspaceUsecase, getmatlabvariableUsecase)
 	checkmatlabcodeUsecase := checkmatlabcode.New(pathValidator)
-	checkmatlabcodeTool := checkmatlabcode2.New(factory, checkmatlabcodeUsecase, globalMATLAB)
-	detectmatlabtoolboxesUsecase := detectmatlabtoolboxes.New()
-	detectmatlabtoolboxesTool := detectmatlabtoolboxes2.New(factory, detectmatlabtoolboxesUsecase, globalMATLAB)
-	runmatlabfileUsecase := runmatlabfile.New(pathValidator)
+	workerPool := workerpool.New(configConfig, globalMATLAB, matlabManager, matlabRootSelector, matlabStartingDirSelector)
+	checkmatlabcodeTool := checkmatlabcode2.New(factory, checkmatlabcodeUsecase, workerPool)
+	detectmatlabtoolboxesUsecase := detectmatlabtoolboxes.New(cache)
+	detectmatlabtoolboxesTool := detectmatlabtoolboxes2.New(factory, detectmatlabtoolboxesUsecase, workerPool)
+	runmatlabfileUsecase := runmatlabfile.New(pathValidator, codePolicy, approvalGate, cache)
 	runmatlabfileTool := runmatlabfile2.New(factory, runmatlabfileUsecase, globalMATLAB)
-	runmatlabtestfileUsecase := runmatlabtestfile.New(pathValidator)
+	runmatlabtestfileUsecase := runmatlabtestfile.New(pathValidator, codePolicy, approvalGate)
 	runmatlabtestfileTool := runmatlabtestfile2.New(factory, runmatlabtestfileUsecase, globalMATLAB)
-	configuratorConfigurator := configurator.New(configConfig, tool, startmatlabsessionTool, stopmatlabsessionTool, evalmatlabcodeTool, tool2, checkmatlabcodeTool, detectmatlabtoolboxesTool, runmatlabfileTool, runmatlabtestfileTool)
-	serverServer, err := server.New(mcpServer, factory, lifecycleSignaler, configuratorConfigurator)
+	runmatlabtestsUsecase := runmatlabtests.New(pathValidator, codePolicy, approvalGate, osFacade)
+	runmatlabtestsTool := runmatlabtests2.New(factory, runmatlabtestsUsecase, globalMATLAB)
+	manager := jobmanager.New(configConfig)
+	startjobUsecase := startjob.New(pathValidator, codePolicy, approvalGate, manager)
+	startjobTool := startjob2.New(factory, startjobUsecase, globalMATLAB)
+	getjobUsecase := getjob.New(manager)
+	getjobstatusTool := getjobstatus.New(factory, getjobUsecase)
+	getjoboutputTool := getjoboutput.New(factory, getjobUsecase)
+	canceljobUsecase := canceljob.New(manager)
+	canceljobTool := canceljob2.New(factory, canceljobUsecase)
+	getpythonenvironmentUsecase := getpythonenvironment.New()
+	getpythonenvironmentTool := getpythonenvironment2.New(factory, getpythonenvironmentUsecase, globalMATLAB)
+	setpythonenvironmentUsecase := setpythonenvironment.New(codePolicy)
+	setpythonenvironmentTool := setpythonenvironment2.New(factory, setpythonenvironmentUsecase, globalMATLAB)
+	checkpythonpackagesUsecase := checkpythonpackages.New(codePolicy)
+	checkpythonpackagesTool := checkpythonpackages2.New(factory, checkpythonpackagesUsecase, globalMATLAB)
+	runpythoncodeUsecase := runpythoncode.New(pathValidator, codePolicy, approvalGate)
+	runpythoncodeTool := runpythoncode2.New(factory, runpythoncodeUsecase, globalMATLAB)
+	transcript := sessiontranscript.New()
+	exportlivescriptUsecase := exportlivescript.New(transcript, pathValidator, osFacade)
+	exportlivescriptTool := exportlivescript2.New(factory, exportlivescriptUsecase, globalMATLAB)
+	capturematlabfiguresUsecase := capturematlabfigures.New(osFacade)
+	capturematlabfiguresTool := capturematlabfigures2.New(factory, capturematlabfiguresUsecase, globalMATLAB)
+	getmatlabvariableTool := getmatlabvariable2.New(factory, getmatlabvariableUsecase, globalMATLAB)
+	setmatlabvariableUsecase := setmatlabvariable.New(configConfig)
+	setmatlabvariableTool := setmatlabvariable2.New(factory, setmatlabvariableUsecase, globalMATLAB)
+	listmatfilevariablesUsecase := listmatfilevariables.New(pathValidator)
+	listmatfilevariablesTool := listmatfilevariables2.New(factory, listmatfilevariablesUsecase, globalMATLAB)
+	loadmatfilevariablesUsecase := loadmatfilevariables.New(pathValidator)
+	loadmatfilevariablesTool := loadmatfilevariables2.New(factory, loadmatfilevariablesUsecase, globalMATLAB)
+	loadsimulinkmodelUsecase := loadsimulinkmodel.New(pathValidator)
+	loadsimulinkmodelTool := loadsimulinkmodel2.New(factory, loadsimulinkmodelUsecase, globalMATLAB)
+	simulatesimulinkmodelUsecase := simulatesimulinkmodel.New(pathValidator)
+	simulatesimulinkmodelTool := simulatesimulinkmodel2.New(factory, simulatesimulinkmodelUsecase, globalMATLAB)
+	getsimulinkblockparametersUsecase := getsimulinkblockparameters.New(pathValidator)
+	getsimulinkblockparametersTool := getsimulinkblockparameters2.New(factory, getsimulinkblockparametersUsecase, globalMATLAB)
+	setsimulinkparameterUsecase := setsimulinkparameter.New(pathValidator, codePolicy)
+	setsimulinkparameterTool := setsimulinkparameter2.New(factory, setsimulinkparameterUsecase, globalMATLAB)
+	buildrealtimeapplicationUsecase := buildrealtimeapplication.New(pathValidator)
+	buildrealtimeapplicationTool := buildrealtimeapplication2.New(factory, buildrealtimeapplicationUsecase, globalMATLAB)
+	deployrealtimeapplicationUsecase := deployrealtimeapplication.New(configConfig, pathValidator, approvalGate)
+	deployrealtimeapplicationTool := deployrealtimeapplication2.New(factory, deployrealtimeapplicationUsecase, globalMATLAB)
+	controlrealtimeapplicationUsecase := controlrealtimeapplication.New(configConfig, approvalGate)
+	controlrealtimeapplicationTool := controlrealtimeapplication2.New(factory, controlrealtimeapplicationUsecase, globalMATLAB)
+	streamrealtimesignalsUsecase := streamrealtimesignals.New(configConfig)
+	streamrealtimesignalsTool := streamrealtimesignals2.New(factory, streamrealtimesignalsUsecase, globalMATLAB)
+	packageproductionarchiveUsecase := packageproductionarchive.New(pathValidator)
+	packageproductionarchiveTool := packageproductionarchive2.New(factory, packageproductionarchiveUsecase, globalMATLAB)
+	getmatlabdiagnosticsUsecase := getmatlabdiagnostics.New(pathValidator, osFacade)
+	getmatlabdiagnosticsTool := getmatlabdiagnostics2.New(factory, getmatlabdiagnosticsUsecase)
+	findmatlabdefinitionUsecase := findmatlabdefinition.New(pathValidator, osFacade)
+	findmatlabdefinitionTool := findmatlabdefinition2.New(factory, findmatlabdefinitionUsecase)
+	drive := matlabdrive.New(configConfig, osFacade)
+	pullfrommatlabdriveUsecase := pullfrommatlabdrive.New(pathValidator, drive, osFacade)
+	pullfrommatlabdriveTool := pullfrommatlabdrive2.New(factory, pullfrommatlabdriveUsecase)
+	pushtomatlabdriveUsecase := pushtomatlabdrive.New(pathValidator, drive, artifactstoreStore, osFacade)
+	pushtomatlabdriveTool := pushtomatlabdrive2.New(factory, pushtomatlabdriveUsecase)
+	deployproductionarchiveUsecase := deployproductionarchive.New(configConfig, pathValidator, approvalGate, osFacade)
+	deployproductionarchiveTool := deployproductionarchive2.New(factory, deployproductionarchiveUsecase)
+	client := productionserver.New(configConfig, httpClientFactory)
+	invokeproductionfunctionUsecase := invokeproductionfunction.New(client)
+	invokeproductionfunctionTool := invokeproductionfunction2.New(factory, invokeproductionfunctionUsecase)
+	getproductionserverstatusUsecase := getproductionserverstatus.New(configConfig, client)
+	getproductionserverstatusTool := getproductionserverstatus2.New(factory, getproductionserverstatusUsecase)
+	getserverinfoUsecase := getserverinfo.New(configConfig, matlabManager)
+	getserverinfoTool := getserverinfo2.New(factory, getserverinfoUsecase)
+	registry := plugins.New(configConfig, factory)
+	proxy, err := downstream.New(configConfig, osFacade, factory, lifecycleSignaler)
+	if err != nil {
+		return nil, err
+	}
+	v := newToolProviders(registry, proxy)
+	listmatlabvariablesUsecase := listmatlabvariables.New()
+	matlabvariableResource := matlabvariable.New(factory, getmatlabvariableUsecase, listmatlabvariablesUsecase, globalMATLAB)
+	matlabartifactResource := matlabartifact.New(factory, artifactstoreStore)
+	matlabdriveResource := matlabdrive2.New(factory, drive)
+	configuratorConfigurator := configurator.New(configConfig, tool, startmatlabsessionTool, listmatlabsessionsTool, stopmatlabsessionTool, evalmatlabcodeTool, tool2, checkmatlabcodeTool, detectmatlabtoolboxesTool, runmatlabfileTool, runmatlabtestfileTool, runmatlabtestsTool, startjobTool, getjobstatusTool, getjoboutputTool, canceljobTool, getpythonenvironmentTool, setpythonenvironmentTool, checkpythonpackagesTool, runpythoncodeTool, exportlivescriptTool, capturematlabfiguresTool, getmatlabvariableTool, setmatlabvariableTool, listmatfilevariablesTool, loadmatfilevariablesTool, loadsimulinkmodelTool, simulatesimulinkmodelTool, getsimulinkblockparametersTool, setsimulinkparameterTool, buildrealtimeapplicationTool, deployrealtimeapplicationTool, controlrealtimeapplicationTool, streamrealtimesignalsTool, packageproductionarchiveTool, getmatlabdiagnosticsTool, findmatlabdefinitionTool, pullfrommatlabdriveTool, pushtomatlabdriveTool, deployproductionarchiveTool, invokeproductionfunctionTool, getproductionserverstatusTool, getserverinfoTool, v, matlabvariableResource, resource, matlabartifactResource, matlabdriveResource)
+	keychainFacade := keychainfacade.New()
+	encryptor, err := storageencryption.New(configConfig, keychainFacade)
+	if err != nil {
+		return nil, err
+	}
+	sink := eventsink.New(configConfig, factory, lifecycleSignaler, httpClientFactory)
+	buffer := eventbuffer.New(osFacade, directoryDirectory, factory, encryptor, sink, metricsMetrics)
+	policy, err := toolpolicy.New(configConfig, osFacade)
 	if err != nil {
 		return nil, err
 	}
+	planner := dryrun.New(configConfig, codePolicy, osFacade)
+	rateLimiter := ratelimiter.New(configConfig)
+	sessionrecordingRecorder, err := sessionrecording.New(configConfig, osFacade, factory, lifecycleSignaler, encryptor)
+	if err != nil {
+		return nil, err
+	}
+	localUser := localuser.New(osFacade, factory)
+	notificationThrottle := notificationthrottle.New(configConfig)
+	socket := daemon.NewSocket(configConfig, osFacade)
+	localizerLocalizer := localizer.New(configConfig, osFacade)
+	authenticator := httpauth.New(configConfig, osFacade)
+	auditLog, err := auditlog.New(configConfig, osFacade, factory, lifecycleSignaler)
+	if err != nil {
+		return nil, err
+	}
+	exporter, err := otlpexporter.New(configConfig, osFacade, factory, lifecycleSignaler, httpClientFactory)
+	if err != nil {
+		return nil, err
+	}
+	tracer := tracing.NewTracer(exporter)
+	serverServer, err := server.New(mcpServer, factory, lifecycleSignaler, configuratorConfigurator, buffer, sink, collector, policy, planner, redactorRedactor, rateLimiter, sessionrecordingRecorder, transcript, localUser, configConfig, notificationThrottle, configConfig, configConfig, artifactstoreStore, socket, configConfig, localizerLocalizer, authenticator, auditLog, tracer, metricsMetrics)
+	if err != nil {
+		return nil, err
+	}
+	debugServer := debugserver.New(configConfig, factory, lifecycleSignaler)
 	osSignaler := ossignaler.New()
-	orchestratorOrchestrator := orchestrator.New(lifecycleSignaler, configConfig, serverServer, watchdogWatchdog, factory, osSignaler, globalMATLAB, directoryDirectory)
+	instanceLock, err := instancelock.New(configConfig)
+	if err != nil {
+		return nil, err
+	}
+	reader := processmemory.New()
+	memorywatchdogWatchdog := memorywatchdog.New(configConfig, factory, lifecycleSignaler, globalMATLAB, reader, artifactstoreStore, buffer, serverServer)
+	supervisor := matlabsupervisor.New(configConfig, factory, lifecycleSignaler, globalMATLAB, instanceLock, buffer, serverServer)
+	reloader := configreloader.New(configConfig, factory, lifecycleSignaler, policy, serverServer, osFacade)
+	orchestratorOrchestrator := orchestrator.New(lifecycleSignaler, configConfig, serverServer, watchdogWatchdog, debugServer, metricsMetrics, factory, osSignaler, globalMATLAB, directoryDirectory, instanceLock, buffer, memorywatchdogWatchdog, supervisor, reloader)
 	return orchestratorOrchestrator, nil
 }
 
@@ -156,6 +695,11 @@ func initializeWatchdog() (*watchdog2.Watchdog, error) {
 
 // wire.go:
 
+// newToolProviders lists the providers of the tools that are not built into the server.
+func newToolProviders(pluginRegistry *plugins.Registry, downstreamProxy *downstream.Proxy) []tools.ToolProvider {
+	return []tools.ToolProvider{pluginRegistry, downstreamProxy}
+}
+
 type orchestratorFactory struct{}
 
 func newOrchestratorFactory() *orchestratorFactory {
@@ -175,3 +719,163 @@ func newWatchdogProcessFactory() *watchdogProcessFactory {
 func (f *watchdogProcessFactory) Create() (entities.Mode, error) {
 	return initializeWatchdog()
 }
+
+type statusFactory struct{}
+
+func newStatusFactory() *statusFactory {
+	return &statusFactory{}
+}
+
+func (f *statusFactory) Create() (entities.Mode, error) {
+	return initializeStatus()
+}
+
+type telemetryPreviewFactory struct{}
+
+func newTelemetryPreviewFactory() *telemetryPreviewFactory {
+	return &telemetryPreviewFactory{}
+}
+
+func (f *telemetryPreviewFactory) Create() (entities.Mode, error) {
+	return initializeTelemetryPreview(), nil
+}
+
+type replayFactory struct{}
+
+func newReplayFactory() *replayFactory {
+	return &replayFactory{}
+}
+
+func (f *replayFactory) Create() (entities.Mode, error) {
+	return initializeReplay()
+}
+
+type attachFactory struct{}
+
+func newAttachFactory() *attachFactory {
+	return &attachFactory{}
+}
+
+func (f *attachFactory) Create() (entities.Mode, error) {
+	return initializeAttach()
+}
+
+type doctorFactory struct{}
+
+func newDoctorFactory() *doctorFactory {
+	return &doctorFactory{}
+}
+
+func (f *doctorFactory) Create() (entities.Mode, error) {
+	return initializeDoctor()
+}
+
+type installFactory struct{}
+
+func newInstallFactory() *installFactory {
+	return &installFactory{}
+}
+
+func (f *installFactory) Create() (entities.Mode, error) {
+	return initializeInstall()
+}
+
+type completionFactory struct{}
+
+func newCompletionFactory() *completionFactory {
+	return &completionFactory{}
+}
+
+func (f *completionFactory) Create() (entities.Mode, error) {
+	return initializeCompletion()
+}
+
+type logsFactory struct{}
+
+func newLogsFactory() *logsFactory {
+	return &logsFactory{}
+}
+
+func (f *logsFactory) Create() (entities.Mode, error) {
+	return initializeLogs()
+}
+
+type cleanupFactory struct{}
+
+func newCleanupFactory() *cleanupFactory {
+	return &cleanupFactory{}
+}
+
+func (f *cleanupFactory) Create() (entities.Mode, error) {
+	return initializeCleanup()
+}
+
+type stopFactory struct{}
+
+func newStopFactory() *stopFactory {
+	return &stopFactory{}
+}
+
+func (f *stopFactory) Create() (entities.Mode, error) {
+	return initializeStop()
+}
+
+type serviceFactory struct{}
+
+func newServiceFactory() *serviceFactory {
+	return &serviceFactory{}
+}
+
+func (f *serviceFactory) Create() (entities.Mode, error) {
+	return initializeService()
+}
+
+type replFactory struct{}
+
+func newREPLFactory() *replFactory {
+	return &replFactory{}
+}
+
+func (f *replFactory) Create() (entities.Mode, error) {
+	return initializeREPL()
+}
+
+type pipelineFactory struct{}
+
+func newPipelineFactory() *pipelineFactory {
+	return &pipelineFactory{}
+}
+
+func (f *pipelineFactory) Create() (entities.Mode, error) {
+	return initializePipeline()
+}
+
+type selfTestFactory struct{}
+
+func newSelfTestFactory() *selfTestFactory {
+	return &selfTestFactory{}
+}
+
+func (f *selfTestFactory) Create() (entities.Mode, error) {
+	return initializeSelfTest()
+}
+
+type kernelFactory struct{}
+
+func newKernelFactory() *kernelFactory {
+	return &kernelFactory{}
+}
+
+func (f *kernelFactory) Create() (entities.Mode, error) {
+	return initializeKernel()
+}
+
+type versionFactory struct{}
+
+func newVersionFactory() *versionFactory {
+	return &versionFactory{}
+}
+
+func (f *versionFactory) Create() (entities.Mode, error) {
+	return initializeVersion()
+}
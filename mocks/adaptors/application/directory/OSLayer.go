@@ -5,6 +5,8 @@
 package mocks
 
 import (
+	"os"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -100,3 +102,66 @@ func (_c *MockOSLayer_MkdirTemp_Call) RunAndReturn(run func(dir string, pattern
 	_c.Call.Return(run)
 	return _c
 }
+
+// WriteFile provides a mock function for the type MockOSLayer
+func (_mock *MockOSLayer) WriteFile(name string, data []byte, perm os.FileMode) error {
+	ret := _mock.Called(name, data, perm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WriteFile")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, []byte, os.FileMode) error); ok {
+		r0 = returnFunc(name, data, perm)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOSLayer_WriteFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WriteFile'
+type MockOSLayer_WriteFile_Call struct {
+	*mock.Call
+}
+
+// WriteFile is a helper method to define mock.On call
+//   - name string
+//   - data []byte
+//   - perm os.FileMode
+func (_e *MockOSLayer_Expecter) WriteFile(name interface{}, data interface{}, perm interface{}) *MockOSLayer_WriteFile_Call {
+	return &MockOSLayer_WriteFile_Call{Call: _e.mock.On("WriteFile", name, data, perm)}
+}
+
+func (_c *MockOSLayer_WriteFile_Call) Run(run func(name string, data []byte, perm os.FileMode)) *MockOSLayer_WriteFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 []byte
+		if args[1] != nil {
+			arg1 = args[1].([]byte)
+		}
+		var arg2 os.FileMode
+		if args[2] != nil {
+			arg2 = args[2].(os.FileMode)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOSLayer_WriteFile_Call) Return(err error) *MockOSLayer_WriteFile_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOSLayer_WriteFile_Call) RunAndReturn(run func(name string, data []byte, perm os.FileMode) error) *MockOSLayer_WriteFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
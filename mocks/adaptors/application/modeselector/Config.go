@@ -35,46 +35,706 @@ func (_m *MockConfig) EXPECT() *MockConfig_Expecter {
 	return &MockConfig_Expecter{mock: &_m.Mock}
 }
 
-// Version provides a mock function for the type MockConfig
-func (_mock *MockConfig) Version() string {
+// AttachMode provides a mock function for the type MockConfig
+func (_mock *MockConfig) AttachMode() bool {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for Version")
+		panic("no return value specified for AttachMode")
 	}
 
-	var r0 string
-	if returnFunc, ok := ret.Get(0).(func() string); ok {
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockConfig_AttachMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AttachMode'
+type MockConfig_AttachMode_Call struct {
+	*mock.Call
+}
+
+// AttachMode is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) AttachMode() *MockConfig_AttachMode_Call {
+	return &MockConfig_AttachMode_Call{Call: _e.mock.On("AttachMode")}
+}
+
+func (_c *MockConfig_AttachMode_Call) Run(run func()) *MockConfig_AttachMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_AttachMode_Call) Return(b bool) *MockConfig_AttachMode_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_AttachMode_Call) RunAndReturn(run func() bool) *MockConfig_AttachMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CleanupMode provides a mock function for the type MockConfig
+func (_mock *MockConfig) CleanupMode() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CleanupMode")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockConfig_CleanupMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CleanupMode'
+type MockConfig_CleanupMode_Call struct {
+	*mock.Call
+}
+
+// CleanupMode is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) CleanupMode() *MockConfig_CleanupMode_Call {
+	return &MockConfig_CleanupMode_Call{Call: _e.mock.On("CleanupMode")}
+}
+
+func (_c *MockConfig_CleanupMode_Call) Run(run func()) *MockConfig_CleanupMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_CleanupMode_Call) Return(b bool) *MockConfig_CleanupMode_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_CleanupMode_Call) RunAndReturn(run func() bool) *MockConfig_CleanupMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompletionMode provides a mock function for the type MockConfig
+func (_mock *MockConfig) CompletionMode() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompletionMode")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockConfig_CompletionMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompletionMode'
+type MockConfig_CompletionMode_Call struct {
+	*mock.Call
+}
+
+// CompletionMode is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) CompletionMode() *MockConfig_CompletionMode_Call {
+	return &MockConfig_CompletionMode_Call{Call: _e.mock.On("CompletionMode")}
+}
+
+func (_c *MockConfig_CompletionMode_Call) Run(run func()) *MockConfig_CompletionMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_CompletionMode_Call) Return(b bool) *MockConfig_CompletionMode_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_CompletionMode_Call) RunAndReturn(run func() bool) *MockConfig_CompletionMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DoctorMode provides a mock function for the type MockConfig
+func (_mock *MockConfig) DoctorMode() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DoctorMode")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockConfig_DoctorMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DoctorMode'
+type MockConfig_DoctorMode_Call struct {
+	*mock.Call
+}
+
+// DoctorMode is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) DoctorMode() *MockConfig_DoctorMode_Call {
+	return &MockConfig_DoctorMode_Call{Call: _e.mock.On("DoctorMode")}
+}
+
+func (_c *MockConfig_DoctorMode_Call) Run(run func()) *MockConfig_DoctorMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_DoctorMode_Call) Return(b bool) *MockConfig_DoctorMode_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_DoctorMode_Call) RunAndReturn(run func() bool) *MockConfig_DoctorMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InstallMode provides a mock function for the type MockConfig
+func (_mock *MockConfig) InstallMode() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for InstallMode")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
 		r0 = returnFunc()
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(bool)
 	}
 	return r0
 }
 
-// MockConfig_Version_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Version'
-type MockConfig_Version_Call struct {
+// MockConfig_InstallMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InstallMode'
+type MockConfig_InstallMode_Call struct {
 	*mock.Call
 }
 
-// Version is a helper method to define mock.On call
-func (_e *MockConfig_Expecter) Version() *MockConfig_Version_Call {
-	return &MockConfig_Version_Call{Call: _e.mock.On("Version")}
+// InstallMode is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) InstallMode() *MockConfig_InstallMode_Call {
+	return &MockConfig_InstallMode_Call{Call: _e.mock.On("InstallMode")}
 }
 
-func (_c *MockConfig_Version_Call) Run(run func()) *MockConfig_Version_Call {
+func (_c *MockConfig_InstallMode_Call) Run(run func()) *MockConfig_InstallMode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *MockConfig_Version_Call) Return(s string) *MockConfig_Version_Call {
-	_c.Call.Return(s)
+func (_c *MockConfig_InstallMode_Call) Return(b bool) *MockConfig_InstallMode_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_InstallMode_Call) RunAndReturn(run func() bool) *MockConfig_InstallMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// KernelMode provides a mock function for the type MockConfig
+func (_mock *MockConfig) KernelMode() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for KernelMode")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockConfig_KernelMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'KernelMode'
+type MockConfig_KernelMode_Call struct {
+	*mock.Call
+}
+
+// KernelMode is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) KernelMode() *MockConfig_KernelMode_Call {
+	return &MockConfig_KernelMode_Call{Call: _e.mock.On("KernelMode")}
+}
+
+func (_c *MockConfig_KernelMode_Call) Run(run func()) *MockConfig_KernelMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_KernelMode_Call) Return(b bool) *MockConfig_KernelMode_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_KernelMode_Call) RunAndReturn(run func() bool) *MockConfig_KernelMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogsMode provides a mock function for the type MockConfig
+func (_mock *MockConfig) LogsMode() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogsMode")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockConfig_LogsMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogsMode'
+type MockConfig_LogsMode_Call struct {
+	*mock.Call
+}
+
+// LogsMode is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) LogsMode() *MockConfig_LogsMode_Call {
+	return &MockConfig_LogsMode_Call{Call: _e.mock.On("LogsMode")}
+}
+
+func (_c *MockConfig_LogsMode_Call) Run(run func()) *MockConfig_LogsMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_LogsMode_Call) Return(b bool) *MockConfig_LogsMode_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_LogsMode_Call) RunAndReturn(run func() bool) *MockConfig_LogsMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PipelineMode provides a mock function for the type MockConfig
+func (_mock *MockConfig) PipelineMode() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PipelineMode")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockConfig_PipelineMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PipelineMode'
+type MockConfig_PipelineMode_Call struct {
+	*mock.Call
+}
+
+// PipelineMode is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) PipelineMode() *MockConfig_PipelineMode_Call {
+	return &MockConfig_PipelineMode_Call{Call: _e.mock.On("PipelineMode")}
+}
+
+func (_c *MockConfig_PipelineMode_Call) Run(run func()) *MockConfig_PipelineMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_PipelineMode_Call) Return(b bool) *MockConfig_PipelineMode_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_PipelineMode_Call) RunAndReturn(run func() bool) *MockConfig_PipelineMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// REPLMode provides a mock function for the type MockConfig
+func (_mock *MockConfig) REPLMode() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for REPLMode")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockConfig_REPLMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'REPLMode'
+type MockConfig_REPLMode_Call struct {
+	*mock.Call
+}
+
+// REPLMode is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) REPLMode() *MockConfig_REPLMode_Call {
+	return &MockConfig_REPLMode_Call{Call: _e.mock.On("REPLMode")}
+}
+
+func (_c *MockConfig_REPLMode_Call) Run(run func()) *MockConfig_REPLMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_REPLMode_Call) Return(b bool) *MockConfig_REPLMode_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_REPLMode_Call) RunAndReturn(run func() bool) *MockConfig_REPLMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplayMode provides a mock function for the type MockConfig
+func (_mock *MockConfig) ReplayMode() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayMode")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockConfig_ReplayMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayMode'
+type MockConfig_ReplayMode_Call struct {
+	*mock.Call
+}
+
+// ReplayMode is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) ReplayMode() *MockConfig_ReplayMode_Call {
+	return &MockConfig_ReplayMode_Call{Call: _e.mock.On("ReplayMode")}
+}
+
+func (_c *MockConfig_ReplayMode_Call) Run(run func()) *MockConfig_ReplayMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_ReplayMode_Call) Return(b bool) *MockConfig_ReplayMode_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_ReplayMode_Call) RunAndReturn(run func() bool) *MockConfig_ReplayMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelfTestMode provides a mock function for the type MockConfig
+func (_mock *MockConfig) SelfTestMode() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelfTestMode")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockConfig_SelfTestMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelfTestMode'
+type MockConfig_SelfTestMode_Call struct {
+	*mock.Call
+}
+
+// SelfTestMode is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) SelfTestMode() *MockConfig_SelfTestMode_Call {
+	return &MockConfig_SelfTestMode_Call{Call: _e.mock.On("SelfTestMode")}
+}
+
+func (_c *MockConfig_SelfTestMode_Call) Run(run func()) *MockConfig_SelfTestMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_SelfTestMode_Call) Return(b bool) *MockConfig_SelfTestMode_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_SelfTestMode_Call) RunAndReturn(run func() bool) *MockConfig_SelfTestMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ServiceMode provides a mock function for the type MockConfig
+func (_mock *MockConfig) ServiceMode() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServiceMode")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockConfig_ServiceMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServiceMode'
+type MockConfig_ServiceMode_Call struct {
+	*mock.Call
+}
+
+// ServiceMode is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) ServiceMode() *MockConfig_ServiceMode_Call {
+	return &MockConfig_ServiceMode_Call{Call: _e.mock.On("ServiceMode")}
+}
+
+func (_c *MockConfig_ServiceMode_Call) Run(run func()) *MockConfig_ServiceMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_ServiceMode_Call) Return(b bool) *MockConfig_ServiceMode_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_ServiceMode_Call) RunAndReturn(run func() bool) *MockConfig_ServiceMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StatusMode provides a mock function for the type MockConfig
+func (_mock *MockConfig) StatusMode() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for StatusMode")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockConfig_StatusMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StatusMode'
+type MockConfig_StatusMode_Call struct {
+	*mock.Call
+}
+
+// StatusMode is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) StatusMode() *MockConfig_StatusMode_Call {
+	return &MockConfig_StatusMode_Call{Call: _e.mock.On("StatusMode")}
+}
+
+func (_c *MockConfig_StatusMode_Call) Run(run func()) *MockConfig_StatusMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_StatusMode_Call) Return(b bool) *MockConfig_StatusMode_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_StatusMode_Call) RunAndReturn(run func() bool) *MockConfig_StatusMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StopMode provides a mock function for the type MockConfig
+func (_mock *MockConfig) StopMode() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for StopMode")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockConfig_StopMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StopMode'
+type MockConfig_StopMode_Call struct {
+	*mock.Call
+}
+
+// StopMode is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) StopMode() *MockConfig_StopMode_Call {
+	return &MockConfig_StopMode_Call{Call: _e.mock.On("StopMode")}
+}
+
+func (_c *MockConfig_StopMode_Call) Run(run func()) *MockConfig_StopMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_StopMode_Call) Return(b bool) *MockConfig_StopMode_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_StopMode_Call) RunAndReturn(run func() bool) *MockConfig_StopMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TelemetryPreviewMode provides a mock function for the type MockConfig
+func (_mock *MockConfig) TelemetryPreviewMode() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TelemetryPreviewMode")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockConfig_TelemetryPreviewMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TelemetryPreviewMode'
+type MockConfig_TelemetryPreviewMode_Call struct {
+	*mock.Call
+}
+
+// TelemetryPreviewMode is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) TelemetryPreviewMode() *MockConfig_TelemetryPreviewMode_Call {
+	return &MockConfig_TelemetryPreviewMode_Call{Call: _e.mock.On("TelemetryPreviewMode")}
+}
+
+func (_c *MockConfig_TelemetryPreviewMode_Call) Run(run func()) *MockConfig_TelemetryPreviewMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_TelemetryPreviewMode_Call) Return(b bool) *MockConfig_TelemetryPreviewMode_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_TelemetryPreviewMode_Call) RunAndReturn(run func() bool) *MockConfig_TelemetryPreviewMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UninstallMode provides a mock function for the type MockConfig
+func (_mock *MockConfig) UninstallMode() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UninstallMode")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockConfig_UninstallMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UninstallMode'
+type MockConfig_UninstallMode_Call struct {
+	*mock.Call
+}
+
+// UninstallMode is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) UninstallMode() *MockConfig_UninstallMode_Call {
+	return &MockConfig_UninstallMode_Call{Call: _e.mock.On("UninstallMode")}
+}
+
+func (_c *MockConfig_UninstallMode_Call) Run(run func()) *MockConfig_UninstallMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_UninstallMode_Call) Return(b bool) *MockConfig_UninstallMode_Call {
+	_c.Call.Return(b)
 	return _c
 }
 
-func (_c *MockConfig_Version_Call) RunAndReturn(run func() string) *MockConfig_Version_Call {
+func (_c *MockConfig_UninstallMode_Call) RunAndReturn(run func() bool) *MockConfig_UninstallMode_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -36,6 +36,138 @@ func (_m *MockConfig) EXPECT() *MockConfig_Expecter {
 	return &MockConfig_Expecter{mock: &_m.Mock}
 }
 
+// BuildInfo provides a mock function for the type MockConfig
+func (_mock *MockConfig) BuildInfo() entities.BuildInfo {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildInfo")
+	}
+
+	var r0 entities.BuildInfo
+	if returnFunc, ok := ret.Get(0).(func() entities.BuildInfo); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(entities.BuildInfo)
+	}
+	return r0
+}
+
+// MockConfig_BuildInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildInfo'
+type MockConfig_BuildInfo_Call struct {
+	*mock.Call
+}
+
+// BuildInfo is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) BuildInfo() *MockConfig_BuildInfo_Call {
+	return &MockConfig_BuildInfo_Call{Call: _e.mock.On("BuildInfo")}
+}
+
+func (_c *MockConfig_BuildInfo_Call) Run(run func()) *MockConfig_BuildInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_BuildInfo_Call) Return(buildInfo entities.BuildInfo) *MockConfig_BuildInfo_Call {
+	_c.Call.Return(buildInfo)
+	return _c
+}
+
+func (_c *MockConfig_BuildInfo_Call) RunAndReturn(run func() entities.BuildInfo) *MockConfig_BuildInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DaemonMode provides a mock function for the type MockConfig
+func (_mock *MockConfig) DaemonMode() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DaemonMode")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockConfig_DaemonMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DaemonMode'
+type MockConfig_DaemonMode_Call struct {
+	*mock.Call
+}
+
+// DaemonMode is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) DaemonMode() *MockConfig_DaemonMode_Call {
+	return &MockConfig_DaemonMode_Call{Call: _e.mock.On("DaemonMode")}
+}
+
+func (_c *MockConfig_DaemonMode_Call) Run(run func()) *MockConfig_DaemonMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_DaemonMode_Call) Return(b bool) *MockConfig_DaemonMode_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_DaemonMode_Call) RunAndReturn(run func() bool) *MockConfig_DaemonMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogFile provides a mock function for the type MockConfig
+func (_mock *MockConfig) LogFile() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogFile")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockConfig_LogFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogFile'
+type MockConfig_LogFile_Call struct {
+	*mock.Call
+}
+
+// LogFile is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) LogFile() *MockConfig_LogFile_Call {
+	return &MockConfig_LogFile_Call{Call: _e.mock.On("LogFile")}
+}
+
+func (_c *MockConfig_LogFile_Call) Run(run func()) *MockConfig_LogFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_LogFile_Call) Return(s string) *MockConfig_LogFile_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockConfig_LogFile_Call) RunAndReturn(run func() string) *MockConfig_LogFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RecordToLogger provides a mock function for the type MockConfig
 func (_mock *MockConfig) RecordToLogger(logger entities.Logger) {
 	_mock.Called(logger)
@@ -76,6 +208,50 @@ func (_c *MockConfig_RecordToLogger_Call) RunAndReturn(run func(logger entities.
 	return _c
 }
 
+// ServeTransport provides a mock function for the type MockConfig
+func (_mock *MockConfig) ServeTransport() entities.Transport {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServeTransport")
+	}
+
+	var r0 entities.Transport
+	if returnFunc, ok := ret.Get(0).(func() entities.Transport); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(entities.Transport)
+	}
+	return r0
+}
+
+// MockConfig_ServeTransport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServeTransport'
+type MockConfig_ServeTransport_Call struct {
+	*mock.Call
+}
+
+// ServeTransport is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) ServeTransport() *MockConfig_ServeTransport_Call {
+	return &MockConfig_ServeTransport_Call{Call: _e.mock.On("ServeTransport")}
+}
+
+func (_c *MockConfig_ServeTransport_Call) Run(run func()) *MockConfig_ServeTransport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_ServeTransport_Call) Return(transport entities.Transport) *MockConfig_ServeTransport_Call {
+	_c.Call.Return(transport)
+	return _c
+}
+
+func (_c *MockConfig_ServeTransport_Call) RunAndReturn(run func() entities.Transport) *MockConfig_ServeTransport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UseSingleMATLABSession provides a mock function for the type MockConfig
 func (_mock *MockConfig) UseSingleMATLABSession() bool {
 	ret := _mock.Called()
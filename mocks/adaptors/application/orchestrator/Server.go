@@ -35,6 +35,50 @@ func (_m *MockServer) EXPECT() *MockServer_Expecter {
 	return &MockServer_Expecter{mock: &_m.Mock}
 }
 
+// Drain provides a mock function for the type MockServer
+func (_mock *MockServer) Drain() error {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Drain")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func() error); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockServer_Drain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Drain'
+type MockServer_Drain_Call struct {
+	*mock.Call
+}
+
+// Drain is a helper method to define mock.On call
+func (_e *MockServer_Expecter) Drain() *MockServer_Drain_Call {
+	return &MockServer_Drain_Call{Call: _e.mock.On("Drain")}
+}
+
+func (_c *MockServer_Drain_Call) Run(run func()) *MockServer_Drain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockServer_Drain_Call) Return(err error) *MockServer_Drain_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockServer_Drain_Call) RunAndReturn(run func() error) *MockServer_Drain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Run provides a mock function for the type MockServer
 func (_mock *MockServer) Run() error {
 	ret := _mock.Called()
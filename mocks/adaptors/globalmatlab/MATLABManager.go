@@ -183,3 +183,66 @@ func (_c *MockMATLABManager_StartMATLABSession_Call) RunAndReturn(run func(ctx c
 	_c.Call.Return(run)
 	return _c
 }
+
+// StopMATLABSession provides a mock function for the type MockMATLABManager
+func (_mock *MockMATLABManager) StopMATLABSession(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) error {
+	ret := _mock.Called(ctx, sessionLogger, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StopMATLABSession")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.SessionID) error); ok {
+		r0 = returnFunc(ctx, sessionLogger, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMATLABManager_StopMATLABSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StopMATLABSession'
+type MockMATLABManager_StopMATLABSession_Call struct {
+	*mock.Call
+}
+
+// StopMATLABSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionLogger entities.Logger
+//   - sessionID entities.SessionID
+func (_e *MockMATLABManager_Expecter) StopMATLABSession(ctx interface{}, sessionLogger interface{}, sessionID interface{}) *MockMATLABManager_StopMATLABSession_Call {
+	return &MockMATLABManager_StopMATLABSession_Call{Call: _e.mock.On("StopMATLABSession", ctx, sessionLogger, sessionID)}
+}
+
+func (_c *MockMATLABManager_StopMATLABSession_Call) Run(run func(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID)) *MockMATLABManager_StopMATLABSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 entities.SessionID
+		if args[2] != nil {
+			arg2 = args[2].(entities.SessionID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMATLABManager_StopMATLABSession_Call) Return(err error) *MockMATLABManager_StopMATLABSession_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMATLABManager_StopMATLABSession_Call) RunAndReturn(run func(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) error) *MockMATLABManager_StopMATLABSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -35,6 +35,50 @@ func (_m *MockConfig) EXPECT() *MockConfig_Expecter {
 	return &MockConfig_Expecter{mock: &_m.Mock}
 }
 
+// MATLABRelease provides a mock function for the type MockConfig
+func (_mock *MockConfig) MATLABRelease() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MATLABRelease")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockConfig_MATLABRelease_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MATLABRelease'
+type MockConfig_MATLABRelease_Call struct {
+	*mock.Call
+}
+
+// MATLABRelease is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) MATLABRelease() *MockConfig_MATLABRelease_Call {
+	return &MockConfig_MATLABRelease_Call{Call: _e.mock.On("MATLABRelease")}
+}
+
+func (_c *MockConfig_MATLABRelease_Call) Run(run func()) *MockConfig_MATLABRelease_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_MATLABRelease_Call) Return(s string) *MockConfig_MATLABRelease_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockConfig_MATLABRelease_Call) RunAndReturn(run func() string) *MockConfig_MATLABRelease_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PreferredLocalMATLABRoot provides a mock function for the type MockConfig
 func (_mock *MockConfig) PreferredLocalMATLABRoot() string {
 	ret := _mock.Called()
@@ -5,6 +5,8 @@
 package mocks
 
 import (
+	"time"
+
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -36,6 +38,96 @@ func (_m *MockConfig) EXPECT() *MockConfig_Expecter {
 	return &MockConfig_Expecter{mock: &_m.Mock}
 }
 
+// LogComponentLevels provides a mock function for the type MockConfig
+func (_mock *MockConfig) LogComponentLevels() map[string]entities.LogLevel {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogComponentLevels")
+	}
+
+	var r0 map[string]entities.LogLevel
+	if returnFunc, ok := ret.Get(0).(func() map[string]entities.LogLevel); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]entities.LogLevel)
+		}
+	}
+	return r0
+}
+
+// MockConfig_LogComponentLevels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogComponentLevels'
+type MockConfig_LogComponentLevels_Call struct {
+	*mock.Call
+}
+
+// LogComponentLevels is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) LogComponentLevels() *MockConfig_LogComponentLevels_Call {
+	return &MockConfig_LogComponentLevels_Call{Call: _e.mock.On("LogComponentLevels")}
+}
+
+func (_c *MockConfig_LogComponentLevels_Call) Run(run func()) *MockConfig_LogComponentLevels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_LogComponentLevels_Call) Return(stringToLogLevel map[string]entities.LogLevel) *MockConfig_LogComponentLevels_Call {
+	_c.Call.Return(stringToLogLevel)
+	return _c
+}
+
+func (_c *MockConfig_LogComponentLevels_Call) RunAndReturn(run func() map[string]entities.LogLevel) *MockConfig_LogComponentLevels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogFile provides a mock function for the type MockConfig
+func (_mock *MockConfig) LogFile() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogFile")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockConfig_LogFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogFile'
+type MockConfig_LogFile_Call struct {
+	*mock.Call
+}
+
+// LogFile is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) LogFile() *MockConfig_LogFile_Call {
+	return &MockConfig_LogFile_Call{Call: _e.mock.On("LogFile")}
+}
+
+func (_c *MockConfig_LogFile_Call) Run(run func()) *MockConfig_LogFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_LogFile_Call) Return(s string) *MockConfig_LogFile_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockConfig_LogFile_Call) RunAndReturn(run func() string) *MockConfig_LogFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LogLevel provides a mock function for the type MockConfig
 func (_mock *MockConfig) LogLevel() entities.LogLevel {
 	ret := _mock.Called()
@@ -79,3 +171,223 @@ func (_c *MockConfig_LogLevel_Call) RunAndReturn(run func() entities.LogLevel) *
 	_c.Call.Return(run)
 	return _c
 }
+
+// LogMaxAge provides a mock function for the type MockConfig
+func (_mock *MockConfig) LogMaxAge() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogMaxAge")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockConfig_LogMaxAge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogMaxAge'
+type MockConfig_LogMaxAge_Call struct {
+	*mock.Call
+}
+
+// LogMaxAge is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) LogMaxAge() *MockConfig_LogMaxAge_Call {
+	return &MockConfig_LogMaxAge_Call{Call: _e.mock.On("LogMaxAge")}
+}
+
+func (_c *MockConfig_LogMaxAge_Call) Run(run func()) *MockConfig_LogMaxAge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_LogMaxAge_Call) Return(duration time.Duration) *MockConfig_LogMaxAge_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockConfig_LogMaxAge_Call) RunAndReturn(run func() time.Duration) *MockConfig_LogMaxAge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogMaxFiles provides a mock function for the type MockConfig
+func (_mock *MockConfig) LogMaxFiles() int {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogMaxFiles")
+	}
+
+	var r0 int
+	if returnFunc, ok := ret.Get(0).(func() int); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	return r0
+}
+
+// MockConfig_LogMaxFiles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogMaxFiles'
+type MockConfig_LogMaxFiles_Call struct {
+	*mock.Call
+}
+
+// LogMaxFiles is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) LogMaxFiles() *MockConfig_LogMaxFiles_Call {
+	return &MockConfig_LogMaxFiles_Call{Call: _e.mock.On("LogMaxFiles")}
+}
+
+func (_c *MockConfig_LogMaxFiles_Call) Run(run func()) *MockConfig_LogMaxFiles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_LogMaxFiles_Call) Return(n int) *MockConfig_LogMaxFiles_Call {
+	_c.Call.Return(n)
+	return _c
+}
+
+func (_c *MockConfig_LogMaxFiles_Call) RunAndReturn(run func() int) *MockConfig_LogMaxFiles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogMaxSizeMB provides a mock function for the type MockConfig
+func (_mock *MockConfig) LogMaxSizeMB() int {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogMaxSizeMB")
+	}
+
+	var r0 int
+	if returnFunc, ok := ret.Get(0).(func() int); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	return r0
+}
+
+// MockConfig_LogMaxSizeMB_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogMaxSizeMB'
+type MockConfig_LogMaxSizeMB_Call struct {
+	*mock.Call
+}
+
+// LogMaxSizeMB is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) LogMaxSizeMB() *MockConfig_LogMaxSizeMB_Call {
+	return &MockConfig_LogMaxSizeMB_Call{Call: _e.mock.On("LogMaxSizeMB")}
+}
+
+func (_c *MockConfig_LogMaxSizeMB_Call) Run(run func()) *MockConfig_LogMaxSizeMB_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_LogMaxSizeMB_Call) Return(n int) *MockConfig_LogMaxSizeMB_Call {
+	_c.Call.Return(n)
+	return _c
+}
+
+func (_c *MockConfig_LogMaxSizeMB_Call) RunAndReturn(run func() int) *MockConfig_LogMaxSizeMB_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Quiet provides a mock function for the type MockConfig
+func (_mock *MockConfig) Quiet() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Quiet")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockConfig_Quiet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Quiet'
+type MockConfig_Quiet_Call struct {
+	*mock.Call
+}
+
+// Quiet is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) Quiet() *MockConfig_Quiet_Call {
+	return &MockConfig_Quiet_Call{Call: _e.mock.On("Quiet")}
+}
+
+func (_c *MockConfig_Quiet_Call) Run(run func()) *MockConfig_Quiet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_Quiet_Call) Return(b bool) *MockConfig_Quiet_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_Quiet_Call) RunAndReturn(run func() bool) *MockConfig_Quiet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Verbose provides a mock function for the type MockConfig
+func (_mock *MockConfig) Verbose() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Verbose")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockConfig_Verbose_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Verbose'
+type MockConfig_Verbose_Call struct {
+	*mock.Call
+}
+
+// Verbose is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) Verbose() *MockConfig_Verbose_Call {
+	return &MockConfig_Verbose_Call{Call: _e.mock.On("Verbose")}
+}
+
+func (_c *MockConfig_Verbose_Call) Run(run func()) *MockConfig_Verbose_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_Verbose_Call) Return(b bool) *MockConfig_Verbose_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_Verbose_Call) RunAndReturn(run func() bool) *MockConfig_Verbose_Call {
+	_c.Call.Return(run)
+	return _c
+}
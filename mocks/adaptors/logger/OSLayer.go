@@ -5,6 +5,9 @@
 package mocks
 
 import (
+	"io/fs"
+	"os"
+
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -97,3 +100,238 @@ func (_c *MockOSLayer_Create_Call) RunAndReturn(run func(name string) (osfacade.
 	_c.Call.Return(run)
 	return _c
 }
+
+// DirFS provides a mock function for the type MockOSLayer
+func (_mock *MockOSLayer) DirFS(dir string) fs.FS {
+	ret := _mock.Called(dir)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DirFS")
+	}
+
+	var r0 fs.FS
+	if returnFunc, ok := ret.Get(0).(func(string) fs.FS); ok {
+		r0 = returnFunc(dir)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(fs.FS)
+		}
+	}
+	return r0
+}
+
+// MockOSLayer_DirFS_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DirFS'
+type MockOSLayer_DirFS_Call struct {
+	*mock.Call
+}
+
+// DirFS is a helper method to define mock.On call
+//   - dir string
+func (_e *MockOSLayer_Expecter) DirFS(dir interface{}) *MockOSLayer_DirFS_Call {
+	return &MockOSLayer_DirFS_Call{Call: _e.mock.On("DirFS", dir)}
+}
+
+func (_c *MockOSLayer_DirFS_Call) Run(run func(dir string)) *MockOSLayer_DirFS_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOSLayer_DirFS_Call) Return(fS fs.FS) *MockOSLayer_DirFS_Call {
+	_c.Call.Return(fS)
+	return _c
+}
+
+func (_c *MockOSLayer_DirFS_Call) RunAndReturn(run func(dir string) fs.FS) *MockOSLayer_DirFS_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpenFile provides a mock function for the type MockOSLayer
+func (_mock *MockOSLayer) OpenFile(name string, flag int, perm os.FileMode) (osfacade.File, error) {
+	ret := _mock.Called(name, flag, perm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenFile")
+	}
+
+	var r0 osfacade.File
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, int, os.FileMode) (osfacade.File, error)); ok {
+		return returnFunc(name, flag, perm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, int, os.FileMode) osfacade.File); ok {
+		r0 = returnFunc(name, flag, perm)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(osfacade.File)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, int, os.FileMode) error); ok {
+		r1 = returnFunc(name, flag, perm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOSLayer_OpenFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenFile'
+type MockOSLayer_OpenFile_Call struct {
+	*mock.Call
+}
+
+// OpenFile is a helper method to define mock.On call
+//   - name string
+//   - flag int
+//   - perm os.FileMode
+func (_e *MockOSLayer_Expecter) OpenFile(name interface{}, flag interface{}, perm interface{}) *MockOSLayer_OpenFile_Call {
+	return &MockOSLayer_OpenFile_Call{Call: _e.mock.On("OpenFile", name, flag, perm)}
+}
+
+func (_c *MockOSLayer_OpenFile_Call) Run(run func(name string, flag int, perm os.FileMode)) *MockOSLayer_OpenFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 os.FileMode
+		if args[2] != nil {
+			arg2 = args[2].(os.FileMode)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOSLayer_OpenFile_Call) Return(file osfacade.File, err error) *MockOSLayer_OpenFile_Call {
+	_c.Call.Return(file, err)
+	return _c
+}
+
+func (_c *MockOSLayer_OpenFile_Call) RunAndReturn(run func(name string, flag int, perm os.FileMode) (osfacade.File, error)) *MockOSLayer_OpenFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveAll provides a mock function for the type MockOSLayer
+func (_mock *MockOSLayer) RemoveAll(path string) error {
+	ret := _mock.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveAll")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(path)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOSLayer_RemoveAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAll'
+type MockOSLayer_RemoveAll_Call struct {
+	*mock.Call
+}
+
+// RemoveAll is a helper method to define mock.On call
+//   - path string
+func (_e *MockOSLayer_Expecter) RemoveAll(path interface{}) *MockOSLayer_RemoveAll_Call {
+	return &MockOSLayer_RemoveAll_Call{Call: _e.mock.On("RemoveAll", path)}
+}
+
+func (_c *MockOSLayer_RemoveAll_Call) Run(run func(path string)) *MockOSLayer_RemoveAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOSLayer_RemoveAll_Call) Return(err error) *MockOSLayer_RemoveAll_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOSLayer_RemoveAll_Call) RunAndReturn(run func(path string) error) *MockOSLayer_RemoveAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rename provides a mock function for the type MockOSLayer
+func (_mock *MockOSLayer) Rename(oldPath string, newPath string) error {
+	ret := _mock.Called(oldPath, newPath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rename")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = returnFunc(oldPath, newPath)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOSLayer_Rename_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rename'
+type MockOSLayer_Rename_Call struct {
+	*mock.Call
+}
+
+// Rename is a helper method to define mock.On call
+//   - oldPath string
+//   - newPath string
+func (_e *MockOSLayer_Expecter) Rename(oldPath interface{}, newPath interface{}) *MockOSLayer_Rename_Call {
+	return &MockOSLayer_Rename_Call{Call: _e.mock.On("Rename", oldPath, newPath)}
+}
+
+func (_c *MockOSLayer_Rename_Call) Run(run func(oldPath string, newPath string)) *MockOSLayer_Rename_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOSLayer_Rename_Call) Return(err error) *MockOSLayer_Rename_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOSLayer_Rename_Call) RunAndReturn(run func(oldPath string, newPath string) error) *MockOSLayer_Rename_Call {
+	_c.Call.Return(run)
+	return _c
+}
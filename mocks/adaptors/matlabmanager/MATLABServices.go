@@ -38,6 +38,86 @@ func (_m *MockMATLABServices) EXPECT() *MockMATLABServices_Expecter {
 	return &MockMATLABServices_Expecter{mock: &_m.Mock}
 }
 
+// ConnectToSharedMATLABSession provides a mock function for the type MockMATLABServices
+func (_mock *MockMATLABServices) ConnectToSharedMATLABSession(logger entities.Logger, request datatypes.SharedSessionDetails) (datatypes.SharedSession, embeddedconnector.ConnectionDetails, func() error, error) {
+	ret := _mock.Called(logger, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConnectToSharedMATLABSession")
+	}
+
+	var r0 datatypes.SharedSession
+	var r1 embeddedconnector.ConnectionDetails
+	var r2 func() error
+	var r3 error
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger, datatypes.SharedSessionDetails) (datatypes.SharedSession, embeddedconnector.ConnectionDetails, func() error, error)); ok {
+		return returnFunc(logger, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger, datatypes.SharedSessionDetails) datatypes.SharedSession); ok {
+		r0 = returnFunc(logger, request)
+	} else {
+		r0 = ret.Get(0).(datatypes.SharedSession)
+	}
+	if returnFunc, ok := ret.Get(1).(func(entities.Logger, datatypes.SharedSessionDetails) embeddedconnector.ConnectionDetails); ok {
+		r1 = returnFunc(logger, request)
+	} else {
+		r1 = ret.Get(1).(embeddedconnector.ConnectionDetails)
+	}
+	if returnFunc, ok := ret.Get(2).(func(entities.Logger, datatypes.SharedSessionDetails) func() error); ok {
+		r2 = returnFunc(logger, request)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(func() error)
+		}
+	}
+	if returnFunc, ok := ret.Get(3).(func(entities.Logger, datatypes.SharedSessionDetails) error); ok {
+		r3 = returnFunc(logger, request)
+	} else {
+		r3 = ret.Error(3)
+	}
+	return r0, r1, r2, r3
+}
+
+// MockMATLABServices_ConnectToSharedMATLABSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConnectToSharedMATLABSession'
+type MockMATLABServices_ConnectToSharedMATLABSession_Call struct {
+	*mock.Call
+}
+
+// ConnectToSharedMATLABSession is a helper method to define mock.On call
+//   - logger entities.Logger
+//   - request datatypes.SharedSessionDetails
+func (_e *MockMATLABServices_Expecter) ConnectToSharedMATLABSession(logger interface{}, request interface{}) *MockMATLABServices_ConnectToSharedMATLABSession_Call {
+	return &MockMATLABServices_ConnectToSharedMATLABSession_Call{Call: _e.mock.On("ConnectToSharedMATLABSession", logger, request)}
+}
+
+func (_c *MockMATLABServices_ConnectToSharedMATLABSession_Call) Run(run func(logger entities.Logger, request datatypes.SharedSessionDetails)) *MockMATLABServices_ConnectToSharedMATLABSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 entities.Logger
+		if args[0] != nil {
+			arg0 = args[0].(entities.Logger)
+		}
+		var arg1 datatypes.SharedSessionDetails
+		if args[1] != nil {
+			arg1 = args[1].(datatypes.SharedSessionDetails)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMATLABServices_ConnectToSharedMATLABSession_Call) Return(sharedSession datatypes.SharedSession, connectionDetails embeddedconnector.ConnectionDetails, fn func() error, err error) *MockMATLABServices_ConnectToSharedMATLABSession_Call {
+	_c.Call.Return(sharedSession, connectionDetails, fn, err)
+	return _c
+}
+
+func (_c *MockMATLABServices_ConnectToSharedMATLABSession_Call) RunAndReturn(run func(logger entities.Logger, request datatypes.SharedSessionDetails) (datatypes.SharedSession, embeddedconnector.ConnectionDetails, func() error, error)) *MockMATLABServices_ConnectToSharedMATLABSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListDiscoveredMatlabInfo provides a mock function for the type MockMATLABServices
 func (_mock *MockMATLABServices) ListDiscoveredMatlabInfo(logger entities.Logger) datatypes.ListMatlabInfo {
 	ret := _mock.Called(logger)
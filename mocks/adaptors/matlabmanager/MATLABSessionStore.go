@@ -38,20 +38,29 @@ func (_m *MockMATLABSessionStore) EXPECT() *MockMATLABSessionStore_Expecter {
 }
 
 // Add provides a mock function for the type MockMATLABSessionStore
-func (_mock *MockMATLABSessionStore) Add(client matlabsessionstore.MATLABSessionClientWithCleanup) entities.SessionID {
-	ret := _mock.Called(client)
+func (_mock *MockMATLABSessionStore) Add(name string, matlabRoot string, client matlabsessionstore.MATLABSessionClientWithCleanup) (entities.SessionID, error) {
+	ret := _mock.Called(name, matlabRoot, client)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Add")
 	}
 
 	var r0 entities.SessionID
-	if returnFunc, ok := ret.Get(0).(func(matlabsessionstore.MATLABSessionClientWithCleanup) entities.SessionID); ok {
-		r0 = returnFunc(client)
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string, matlabsessionstore.MATLABSessionClientWithCleanup) (entities.SessionID, error)); ok {
+		return returnFunc(name, matlabRoot, client)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string, matlabsessionstore.MATLABSessionClientWithCleanup) entities.SessionID); ok {
+		r0 = returnFunc(name, matlabRoot, client)
 	} else {
 		r0 = ret.Get(0).(entities.SessionID)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(string, string, matlabsessionstore.MATLABSessionClientWithCleanup) error); ok {
+		r1 = returnFunc(name, matlabRoot, client)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // MockMATLABSessionStore_Add_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Add'
@@ -60,30 +69,102 @@ type MockMATLABSessionStore_Add_Call struct {
 }
 
 // Add is a helper method to define mock.On call
+//   - name string
+//   - matlabRoot string
 //   - client matlabsessionstore.MATLABSessionClientWithCleanup
-func (_e *MockMATLABSessionStore_Expecter) Add(client interface{}) *MockMATLABSessionStore_Add_Call {
-	return &MockMATLABSessionStore_Add_Call{Call: _e.mock.On("Add", client)}
+func (_e *MockMATLABSessionStore_Expecter) Add(name interface{}, matlabRoot interface{}, client interface{}) *MockMATLABSessionStore_Add_Call {
+	return &MockMATLABSessionStore_Add_Call{Call: _e.mock.On("Add", name, matlabRoot, client)}
 }
 
-func (_c *MockMATLABSessionStore_Add_Call) Run(run func(client matlabsessionstore.MATLABSessionClientWithCleanup)) *MockMATLABSessionStore_Add_Call {
+func (_c *MockMATLABSessionStore_Add_Call) Run(run func(name string, matlabRoot string, client matlabsessionstore.MATLABSessionClientWithCleanup)) *MockMATLABSessionStore_Add_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 matlabsessionstore.MATLABSessionClientWithCleanup
+		var arg0 string
 		if args[0] != nil {
-			arg0 = args[0].(matlabsessionstore.MATLABSessionClientWithCleanup)
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 matlabsessionstore.MATLABSessionClientWithCleanup
+		if args[2] != nil {
+			arg2 = args[2].(matlabsessionstore.MATLABSessionClientWithCleanup)
 		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockMATLABSessionStore_Add_Call) Return(sessionID entities.SessionID) *MockMATLABSessionStore_Add_Call {
-	_c.Call.Return(sessionID)
+func (_c *MockMATLABSessionStore_Add_Call) Return(sessionID entities.SessionID, err error) *MockMATLABSessionStore_Add_Call {
+	_c.Call.Return(sessionID, err)
 	return _c
 }
 
-func (_c *MockMATLABSessionStore_Add_Call) RunAndReturn(run func(client matlabsessionstore.MATLABSessionClientWithCleanup) entities.SessionID) *MockMATLABSessionStore_Add_Call {
+func (_c *MockMATLABSessionStore_Add_Call) RunAndReturn(run func(name string, matlabRoot string, client matlabsessionstore.MATLABSessionClientWithCleanup) (entities.SessionID, error)) *MockMATLABSessionStore_Add_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Find provides a mock function for the type MockMATLABSessionStore
+func (_mock *MockMATLABSessionStore) Find(name string) (entities.SessionID, error) {
+	ret := _mock.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 entities.SessionID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (entities.SessionID, error)); ok {
+		return returnFunc(name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) entities.SessionID); ok {
+		r0 = returnFunc(name)
+	} else {
+		r0 = ret.Get(0).(entities.SessionID)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMATLABSessionStore_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockMATLABSessionStore_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - name string
+func (_e *MockMATLABSessionStore_Expecter) Find(name interface{}) *MockMATLABSessionStore_Find_Call {
+	return &MockMATLABSessionStore_Find_Call{Call: _e.mock.On("Find", name)}
+}
+
+func (_c *MockMATLABSessionStore_Find_Call) Run(run func(name string)) *MockMATLABSessionStore_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMATLABSessionStore_Find_Call) Return(sessionID entities.SessionID, err error) *MockMATLABSessionStore_Find_Call {
+	_c.Call.Return(sessionID, err)
+	return _c
+}
+
+func (_c *MockMATLABSessionStore_Find_Call) RunAndReturn(run func(name string) (entities.SessionID, error)) *MockMATLABSessionStore_Find_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -150,6 +231,52 @@ func (_c *MockMATLABSessionStore_Get_Call) RunAndReturn(run func(sessionID entit
 	return _c
 }
 
+// List provides a mock function for the type MockMATLABSessionStore
+func (_mock *MockMATLABSessionStore) List() []entities.SessionInfo {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []entities.SessionInfo
+	if returnFunc, ok := ret.Get(0).(func() []entities.SessionInfo); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.SessionInfo)
+		}
+	}
+	return r0
+}
+
+// MockMATLABSessionStore_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockMATLABSessionStore_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+func (_e *MockMATLABSessionStore_Expecter) List() *MockMATLABSessionStore_List_Call {
+	return &MockMATLABSessionStore_List_Call{Call: _e.mock.On("List")}
+}
+
+func (_c *MockMATLABSessionStore_List_Call) Run(run func()) *MockMATLABSessionStore_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockMATLABSessionStore_List_Call) Return(sessionInfos []entities.SessionInfo) *MockMATLABSessionStore_List_Call {
+	_c.Call.Return(sessionInfos)
+	return _c
+}
+
+func (_c *MockMATLABSessionStore_List_Call) RunAndReturn(run func() []entities.SessionInfo) *MockMATLABSessionStore_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Remove provides a mock function for the type MockMATLABSessionStore
 func (_mock *MockMATLABSessionStore) Remove(sessionID entities.SessionID) {
 	_mock.Called(sessionID)
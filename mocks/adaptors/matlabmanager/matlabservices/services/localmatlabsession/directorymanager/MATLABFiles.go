@@ -80,3 +80,95 @@ func (_c *MockMATLABFiles_GetAll_Call) RunAndReturn(run func() map[string][]byte
 	_c.Call.Return(run)
 	return _c
 }
+
+// GetNetworkBlock provides a mock function for the type MockMATLABFiles
+func (_mock *MockMATLABFiles) GetNetworkBlock() map[string][]byte {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNetworkBlock")
+	}
+
+	var r0 map[string][]byte
+	if returnFunc, ok := ret.Get(0).(func() map[string][]byte); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]byte)
+		}
+	}
+	return r0
+}
+
+// MockMATLABFiles_GetNetworkBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNetworkBlock'
+type MockMATLABFiles_GetNetworkBlock_Call struct {
+	*mock.Call
+}
+
+// GetNetworkBlock is a helper method to define mock.On call
+func (_e *MockMATLABFiles_Expecter) GetNetworkBlock() *MockMATLABFiles_GetNetworkBlock_Call {
+	return &MockMATLABFiles_GetNetworkBlock_Call{Call: _e.mock.On("GetNetworkBlock")}
+}
+
+func (_c *MockMATLABFiles_GetNetworkBlock_Call) Run(run func()) *MockMATLABFiles_GetNetworkBlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockMATLABFiles_GetNetworkBlock_Call) Return(stringToBytes map[string][]byte) *MockMATLABFiles_GetNetworkBlock_Call {
+	_c.Call.Return(stringToBytes)
+	return _c
+}
+
+func (_c *MockMATLABFiles_GetNetworkBlock_Call) RunAndReturn(run func() map[string][]byte) *MockMATLABFiles_GetNetworkBlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSandbox provides a mock function for the type MockMATLABFiles
+func (_mock *MockMATLABFiles) GetSandbox() map[string][]byte {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSandbox")
+	}
+
+	var r0 map[string][]byte
+	if returnFunc, ok := ret.Get(0).(func() map[string][]byte); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]byte)
+		}
+	}
+	return r0
+}
+
+// MockMATLABFiles_GetSandbox_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSandbox'
+type MockMATLABFiles_GetSandbox_Call struct {
+	*mock.Call
+}
+
+// GetSandbox is a helper method to define mock.On call
+func (_e *MockMATLABFiles_Expecter) GetSandbox() *MockMATLABFiles_GetSandbox_Call {
+	return &MockMATLABFiles_GetSandbox_Call{Call: _e.mock.On("GetSandbox")}
+}
+
+func (_c *MockMATLABFiles_GetSandbox_Call) Run(run func()) *MockMATLABFiles_GetSandbox_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockMATLABFiles_GetSandbox_Call) Return(stringToBytes map[string][]byte) *MockMATLABFiles_GetSandbox_Call {
+	_c.Call.Return(stringToBytes)
+	return _c
+}
+
+func (_c *MockMATLABFiles_GetSandbox_Call) RunAndReturn(run func() map[string][]byte) *MockMATLABFiles_GetSandbox_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -36,6 +36,80 @@ func (_m *MockHttpClientFactory) EXPECT() *MockHttpClientFactory_Expecter {
 	return &MockHttpClientFactory_Expecter{mock: &_m.Mock}
 }
 
+// NewClientForMutualTLS provides a mock function for the type MockHttpClientFactory
+func (_mock *MockHttpClientFactory) NewClientForMutualTLS(clientCertPEM []byte, clientKeyPEM []byte, caPEM []byte) (httpclientfactory.HttpClient, error) {
+	ret := _mock.Called(clientCertPEM, clientKeyPEM, caPEM)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NewClientForMutualTLS")
+	}
+
+	var r0 httpclientfactory.HttpClient
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]byte, []byte, []byte) (httpclientfactory.HttpClient, error)); ok {
+		return returnFunc(clientCertPEM, clientKeyPEM, caPEM)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]byte, []byte, []byte) httpclientfactory.HttpClient); ok {
+		r0 = returnFunc(clientCertPEM, clientKeyPEM, caPEM)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(httpclientfactory.HttpClient)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func([]byte, []byte, []byte) error); ok {
+		r1 = returnFunc(clientCertPEM, clientKeyPEM, caPEM)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHttpClientFactory_NewClientForMutualTLS_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NewClientForMutualTLS'
+type MockHttpClientFactory_NewClientForMutualTLS_Call struct {
+	*mock.Call
+}
+
+// NewClientForMutualTLS is a helper method to define mock.On call
+//   - clientCertPEM []byte
+//   - clientKeyPEM []byte
+//   - caPEM []byte
+func (_e *MockHttpClientFactory_Expecter) NewClientForMutualTLS(clientCertPEM interface{}, clientKeyPEM interface{}, caPEM interface{}) *MockHttpClientFactory_NewClientForMutualTLS_Call {
+	return &MockHttpClientFactory_NewClientForMutualTLS_Call{Call: _e.mock.On("NewClientForMutualTLS", clientCertPEM, clientKeyPEM, caPEM)}
+}
+
+func (_c *MockHttpClientFactory_NewClientForMutualTLS_Call) Run(run func(clientCertPEM []byte, clientKeyPEM []byte, caPEM []byte)) *MockHttpClientFactory_NewClientForMutualTLS_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []byte
+		if args[0] != nil {
+			arg0 = args[0].([]byte)
+		}
+		var arg1 []byte
+		if args[1] != nil {
+			arg1 = args[1].([]byte)
+		}
+		var arg2 []byte
+		if args[2] != nil {
+			arg2 = args[2].([]byte)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHttpClientFactory_NewClientForMutualTLS_Call) Return(httpClient httpclientfactory.HttpClient, err error) *MockHttpClientFactory_NewClientForMutualTLS_Call {
+	_c.Call.Return(httpClient, err)
+	return _c
+}
+
+func (_c *MockHttpClientFactory_NewClientForMutualTLS_Call) RunAndReturn(run func(clientCertPEM []byte, clientKeyPEM []byte, caPEM []byte) (httpclientfactory.HttpClient, error)) *MockHttpClientFactory_NewClientForMutualTLS_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewClientForSelfSignedTLSServer provides a mock function for the type MockHttpClientFactory
 func (_mock *MockHttpClientFactory) NewClientForSelfSignedTLSServer(certificatePEM []byte) (httpclientfactory.HttpClient, error) {
 	ret := _mock.Called(certificatePEM)
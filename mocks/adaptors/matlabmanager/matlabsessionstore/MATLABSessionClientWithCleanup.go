@@ -254,6 +254,63 @@ func (_c *MockMATLABSessionClientWithCleanup_FEval_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// Interrupt provides a mock function for the type MockMATLABSessionClientWithCleanup
+func (_mock *MockMATLABSessionClientWithCleanup) Interrupt(ctx context.Context, sessionLogger entities.Logger) error {
+	ret := _mock.Called(ctx, sessionLogger)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Interrupt")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger) error); ok {
+		r0 = returnFunc(ctx, sessionLogger)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMATLABSessionClientWithCleanup_Interrupt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Interrupt'
+type MockMATLABSessionClientWithCleanup_Interrupt_Call struct {
+	*mock.Call
+}
+
+// Interrupt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionLogger entities.Logger
+func (_e *MockMATLABSessionClientWithCleanup_Expecter) Interrupt(ctx interface{}, sessionLogger interface{}) *MockMATLABSessionClientWithCleanup_Interrupt_Call {
+	return &MockMATLABSessionClientWithCleanup_Interrupt_Call{Call: _e.mock.On("Interrupt", ctx, sessionLogger)}
+}
+
+func (_c *MockMATLABSessionClientWithCleanup_Interrupt_Call) Run(run func(ctx context.Context, sessionLogger entities.Logger)) *MockMATLABSessionClientWithCleanup_Interrupt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMATLABSessionClientWithCleanup_Interrupt_Call) Return(err error) *MockMATLABSessionClientWithCleanup_Interrupt_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMATLABSessionClientWithCleanup_Interrupt_Call) RunAndReturn(run func(ctx context.Context, sessionLogger entities.Logger) error) *MockMATLABSessionClientWithCleanup_Interrupt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StopSession provides a mock function for the type MockMATLABSessionClientWithCleanup
 func (_mock *MockMATLABSessionClientWithCleanup) StopSession(ctx context.Context, sessionLogger entities.Logger) error {
 	ret := _mock.Called(ctx, sessionLogger)
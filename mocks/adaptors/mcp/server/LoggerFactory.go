@@ -5,7 +5,10 @@
 package mocks
 
 import (
+	"iter"
+
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -36,6 +39,46 @@ func (_m *MockLoggerFactory) EXPECT() *MockLoggerFactory_Expecter {
 	return &MockLoggerFactory_Expecter{mock: &_m.Mock}
 }
 
+// ForwardToSessions provides a mock function for the type MockLoggerFactory
+func (_mock *MockLoggerFactory) ForwardToSessions(sessions func() iter.Seq[*mcp.ServerSession]) {
+	_mock.Called(sessions)
+	return
+}
+
+// MockLoggerFactory_ForwardToSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForwardToSessions'
+type MockLoggerFactory_ForwardToSessions_Call struct {
+	*mock.Call
+}
+
+// ForwardToSessions is a helper method to define mock.On call
+//   - sessions func() iter.Seq[*mcp.ServerSession]
+func (_e *MockLoggerFactory_Expecter) ForwardToSessions(sessions interface{}) *MockLoggerFactory_ForwardToSessions_Call {
+	return &MockLoggerFactory_ForwardToSessions_Call{Call: _e.mock.On("ForwardToSessions", sessions)}
+}
+
+func (_c *MockLoggerFactory_ForwardToSessions_Call) Run(run func(sessions func() iter.Seq[*mcp.ServerSession])) *MockLoggerFactory_ForwardToSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 func() iter.Seq[*mcp.ServerSession]
+		if args[0] != nil {
+			arg0 = args[0].(func() iter.Seq[*mcp.ServerSession])
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLoggerFactory_ForwardToSessions_Call) Return() *MockLoggerFactory_ForwardToSessions_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockLoggerFactory_ForwardToSessions_Call) RunAndReturn(run func(sessions func() iter.Seq[*mcp.ServerSession])) *MockLoggerFactory_ForwardToSessions_Call {
+	_c.Run(run)
+	return _c
+}
+
 // GetGlobalLogger provides a mock function for the type MockLoggerFactory
 func (_mock *MockLoggerFactory) GetGlobalLogger() entities.Logger {
 	ret := _mock.Called()
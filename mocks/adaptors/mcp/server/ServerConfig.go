@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -35,6 +36,50 @@ func (_m *MockServerConfig) EXPECT() *MockServerConfig_Expecter {
 	return &MockServerConfig_Expecter{mock: &_m.Mock}
 }
 
+// BuildInfo provides a mock function for the type MockServerConfig
+func (_mock *MockServerConfig) BuildInfo() entities.BuildInfo {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildInfo")
+	}
+
+	var r0 entities.BuildInfo
+	if returnFunc, ok := ret.Get(0).(func() entities.BuildInfo); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(entities.BuildInfo)
+	}
+	return r0
+}
+
+// MockServerConfig_BuildInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildInfo'
+type MockServerConfig_BuildInfo_Call struct {
+	*mock.Call
+}
+
+// BuildInfo is a helper method to define mock.On call
+func (_e *MockServerConfig_Expecter) BuildInfo() *MockServerConfig_BuildInfo_Call {
+	return &MockServerConfig_BuildInfo_Call{Call: _e.mock.On("BuildInfo")}
+}
+
+func (_c *MockServerConfig_BuildInfo_Call) Run(run func()) *MockServerConfig_BuildInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockServerConfig_BuildInfo_Call) Return(buildInfo entities.BuildInfo) *MockServerConfig_BuildInfo_Call {
+	_c.Call.Return(buildInfo)
+	return _c
+}
+
+func (_c *MockServerConfig_BuildInfo_Call) RunAndReturn(run func() entities.BuildInfo) *MockServerConfig_BuildInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Version provides a mock function for the type MockServerConfig
 func (_mock *MockServerConfig) Version() string {
 	ret := _mock.Called()
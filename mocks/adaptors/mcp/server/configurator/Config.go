@@ -35,6 +35,318 @@ func (_m *MockConfig) EXPECT() *MockConfig_Expecter {
 	return &MockConfig_Expecter{mock: &_m.Mock}
 }
 
+// DisabledTools provides a mock function for the type MockConfig
+func (_mock *MockConfig) DisabledTools() []string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisabledTools")
+	}
+
+	var r0 []string
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	return r0
+}
+
+// MockConfig_DisabledTools_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisabledTools'
+type MockConfig_DisabledTools_Call struct {
+	*mock.Call
+}
+
+// DisabledTools is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) DisabledTools() *MockConfig_DisabledTools_Call {
+	return &MockConfig_DisabledTools_Call{Call: _e.mock.On("DisabledTools")}
+}
+
+func (_c *MockConfig_DisabledTools_Call) Run(run func()) *MockConfig_DisabledTools_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_DisabledTools_Call) Return(strings []string) *MockConfig_DisabledTools_Call {
+	_c.Call.Return(strings)
+	return _c
+}
+
+func (_c *MockConfig_DisabledTools_Call) RunAndReturn(run func() []string) *MockConfig_DisabledTools_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DryRun provides a mock function for the type MockConfig
+func (_mock *MockConfig) DryRun() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DryRun")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockConfig_DryRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DryRun'
+type MockConfig_DryRun_Call struct {
+	*mock.Call
+}
+
+// DryRun is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) DryRun() *MockConfig_DryRun_Call {
+	return &MockConfig_DryRun_Call{Call: _e.mock.On("DryRun")}
+}
+
+func (_c *MockConfig_DryRun_Call) Run(run func()) *MockConfig_DryRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_DryRun_Call) Return(b bool) *MockConfig_DryRun_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_DryRun_Call) RunAndReturn(run func() bool) *MockConfig_DryRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MATLABDriveFolder provides a mock function for the type MockConfig
+func (_mock *MockConfig) MATLABDriveFolder() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MATLABDriveFolder")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockConfig_MATLABDriveFolder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MATLABDriveFolder'
+type MockConfig_MATLABDriveFolder_Call struct {
+	*mock.Call
+}
+
+// MATLABDriveFolder is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) MATLABDriveFolder() *MockConfig_MATLABDriveFolder_Call {
+	return &MockConfig_MATLABDriveFolder_Call{Call: _e.mock.On("MATLABDriveFolder")}
+}
+
+func (_c *MockConfig_MATLABDriveFolder_Call) Run(run func()) *MockConfig_MATLABDriveFolder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_MATLABDriveFolder_Call) Return(s string) *MockConfig_MATLABDriveFolder_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockConfig_MATLABDriveFolder_Call) RunAndReturn(run func() string) *MockConfig_MATLABDriveFolder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProductionServerDeployFolder provides a mock function for the type MockConfig
+func (_mock *MockConfig) ProductionServerDeployFolder() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProductionServerDeployFolder")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockConfig_ProductionServerDeployFolder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProductionServerDeployFolder'
+type MockConfig_ProductionServerDeployFolder_Call struct {
+	*mock.Call
+}
+
+// ProductionServerDeployFolder is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) ProductionServerDeployFolder() *MockConfig_ProductionServerDeployFolder_Call {
+	return &MockConfig_ProductionServerDeployFolder_Call{Call: _e.mock.On("ProductionServerDeployFolder")}
+}
+
+func (_c *MockConfig_ProductionServerDeployFolder_Call) Run(run func()) *MockConfig_ProductionServerDeployFolder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_ProductionServerDeployFolder_Call) Return(s string) *MockConfig_ProductionServerDeployFolder_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockConfig_ProductionServerDeployFolder_Call) RunAndReturn(run func() string) *MockConfig_ProductionServerDeployFolder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProductionServerURL provides a mock function for the type MockConfig
+func (_mock *MockConfig) ProductionServerURL() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProductionServerURL")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockConfig_ProductionServerURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProductionServerURL'
+type MockConfig_ProductionServerURL_Call struct {
+	*mock.Call
+}
+
+// ProductionServerURL is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) ProductionServerURL() *MockConfig_ProductionServerURL_Call {
+	return &MockConfig_ProductionServerURL_Call{Call: _e.mock.On("ProductionServerURL")}
+}
+
+func (_c *MockConfig_ProductionServerURL_Call) Run(run func()) *MockConfig_ProductionServerURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_ProductionServerURL_Call) Return(s string) *MockConfig_ProductionServerURL_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockConfig_ProductionServerURL_Call) RunAndReturn(run func() string) *MockConfig_ProductionServerURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadOnly provides a mock function for the type MockConfig
+func (_mock *MockConfig) ReadOnly() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadOnly")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockConfig_ReadOnly_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadOnly'
+type MockConfig_ReadOnly_Call struct {
+	*mock.Call
+}
+
+// ReadOnly is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) ReadOnly() *MockConfig_ReadOnly_Call {
+	return &MockConfig_ReadOnly_Call{Call: _e.mock.On("ReadOnly")}
+}
+
+func (_c *MockConfig_ReadOnly_Call) Run(run func()) *MockConfig_ReadOnly_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_ReadOnly_Call) Return(b bool) *MockConfig_ReadOnly_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_ReadOnly_Call) RunAndReturn(run func() bool) *MockConfig_ReadOnly_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RealTimeTargets provides a mock function for the type MockConfig
+func (_mock *MockConfig) RealTimeTargets() []string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RealTimeTargets")
+	}
+
+	var r0 []string
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	return r0
+}
+
+// MockConfig_RealTimeTargets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RealTimeTargets'
+type MockConfig_RealTimeTargets_Call struct {
+	*mock.Call
+}
+
+// RealTimeTargets is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) RealTimeTargets() *MockConfig_RealTimeTargets_Call {
+	return &MockConfig_RealTimeTargets_Call{Call: _e.mock.On("RealTimeTargets")}
+}
+
+func (_c *MockConfig_RealTimeTargets_Call) Run(run func()) *MockConfig_RealTimeTargets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_RealTimeTargets_Call) Return(strings []string) *MockConfig_RealTimeTargets_Call {
+	_c.Call.Return(strings)
+	return _c
+}
+
+func (_c *MockConfig_RealTimeTargets_Call) RunAndReturn(run func() []string) *MockConfig_RealTimeTargets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UseSingleMATLABSession provides a mock function for the type MockConfig
 func (_mock *MockConfig) UseSingleMATLABSession() bool {
 	ret := _mock.Called()
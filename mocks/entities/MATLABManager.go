@@ -38,6 +38,78 @@ func (_m *MockMATLABManager) EXPECT() *MockMATLABManager_Expecter {
 	return &MockMATLABManager_Expecter{mock: &_m.Mock}
 }
 
+// FindMATLABSession provides a mock function for the type MockMATLABManager
+func (_mock *MockMATLABManager) FindMATLABSession(ctx context.Context, sessionLogger entities.Logger, session string) (entities.SessionID, error) {
+	ret := _mock.Called(ctx, sessionLogger, session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMATLABSession")
+	}
+
+	var r0 entities.SessionID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, string) (entities.SessionID, error)); ok {
+		return returnFunc(ctx, sessionLogger, session)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, string) entities.SessionID); ok {
+		r0 = returnFunc(ctx, sessionLogger, session)
+	} else {
+		r0 = ret.Get(0).(entities.SessionID)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, string) error); ok {
+		r1 = returnFunc(ctx, sessionLogger, session)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMATLABManager_FindMATLABSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMATLABSession'
+type MockMATLABManager_FindMATLABSession_Call struct {
+	*mock.Call
+}
+
+// FindMATLABSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionLogger entities.Logger
+//   - session string
+func (_e *MockMATLABManager_Expecter) FindMATLABSession(ctx interface{}, sessionLogger interface{}, session interface{}) *MockMATLABManager_FindMATLABSession_Call {
+	return &MockMATLABManager_FindMATLABSession_Call{Call: _e.mock.On("FindMATLABSession", ctx, sessionLogger, session)}
+}
+
+func (_c *MockMATLABManager_FindMATLABSession_Call) Run(run func(ctx context.Context, sessionLogger entities.Logger, session string)) *MockMATLABManager_FindMATLABSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMATLABManager_FindMATLABSession_Call) Return(sessionID entities.SessionID, err error) *MockMATLABManager_FindMATLABSession_Call {
+	_c.Call.Return(sessionID, err)
+	return _c
+}
+
+func (_c *MockMATLABManager_FindMATLABSession_Call) RunAndReturn(run func(ctx context.Context, sessionLogger entities.Logger, session string) (entities.SessionID, error)) *MockMATLABManager_FindMATLABSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetMATLABSessionClient provides a mock function for the type MockMATLABManager
 func (_mock *MockMATLABManager) GetMATLABSessionClient(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) (entities.MATLABSessionClient, error) {
 	ret := _mock.Called(ctx, sessionLogger, sessionID)
@@ -171,6 +243,65 @@ func (_c *MockMATLABManager_ListEnvironments_Call) RunAndReturn(run func(ctx con
 	return _c
 }
 
+// ListMATLABSessions provides a mock function for the type MockMATLABManager
+func (_mock *MockMATLABManager) ListMATLABSessions(ctx context.Context, sessionLogger entities.Logger) []entities.SessionInfo {
+	ret := _mock.Called(ctx, sessionLogger)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMATLABSessions")
+	}
+
+	var r0 []entities.SessionInfo
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger) []entities.SessionInfo); ok {
+		r0 = returnFunc(ctx, sessionLogger)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.SessionInfo)
+		}
+	}
+	return r0
+}
+
+// MockMATLABManager_ListMATLABSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMATLABSessions'
+type MockMATLABManager_ListMATLABSessions_Call struct {
+	*mock.Call
+}
+
+// ListMATLABSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionLogger entities.Logger
+func (_e *MockMATLABManager_Expecter) ListMATLABSessions(ctx interface{}, sessionLogger interface{}) *MockMATLABManager_ListMATLABSessions_Call {
+	return &MockMATLABManager_ListMATLABSessions_Call{Call: _e.mock.On("ListMATLABSessions", ctx, sessionLogger)}
+}
+
+func (_c *MockMATLABManager_ListMATLABSessions_Call) Run(run func(ctx context.Context, sessionLogger entities.Logger)) *MockMATLABManager_ListMATLABSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMATLABManager_ListMATLABSessions_Call) Return(sessionInfos []entities.SessionInfo) *MockMATLABManager_ListMATLABSessions_Call {
+	_c.Call.Return(sessionInfos)
+	return _c
+}
+
+func (_c *MockMATLABManager_ListMATLABSessions_Call) RunAndReturn(run func(ctx context.Context, sessionLogger entities.Logger) []entities.SessionInfo) *MockMATLABManager_ListMATLABSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StartMATLABSession provides a mock function for the type MockMATLABManager
 func (_mock *MockMATLABManager) StartMATLABSession(ctx context.Context, sessionLogger entities.Logger, startRequest entities.SessionDetails) (entities.SessionID, error) {
 	ret := _mock.Called(ctx, sessionLogger, startRequest)
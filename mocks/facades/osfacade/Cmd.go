@@ -6,6 +6,7 @@ package mocks
 
 import (
 	"io"
+	"os/exec"
 	"syscall"
 
 	mock "github.com/stretchr/testify/mock"
@@ -38,6 +39,61 @@ func (_m *MockCmd) EXPECT() *MockCmd_Expecter {
 	return &MockCmd_Expecter{mock: &_m.Mock}
 }
 
+// CombinedOutput provides a mock function for the type MockCmd
+func (_mock *MockCmd) CombinedOutput() ([]byte, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CombinedOutput")
+	}
+
+	var r0 []byte
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]byte, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []byte); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCmd_CombinedOutput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CombinedOutput'
+type MockCmd_CombinedOutput_Call struct {
+	*mock.Call
+}
+
+// CombinedOutput is a helper method to define mock.On call
+func (_e *MockCmd_Expecter) CombinedOutput() *MockCmd_CombinedOutput_Call {
+	return &MockCmd_CombinedOutput_Call{Call: _e.mock.On("CombinedOutput")}
+}
+
+func (_c *MockCmd_CombinedOutput_Call) Run(run func()) *MockCmd_CombinedOutput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockCmd_CombinedOutput_Call) Return(bytes []byte, err error) *MockCmd_CombinedOutput_Call {
+	_c.Call.Return(bytes, err)
+	return _c
+}
+
+func (_c *MockCmd_CombinedOutput_Call) RunAndReturn(run func() ([]byte, error)) *MockCmd_CombinedOutput_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetSysProcAttr provides a mock function for the type MockCmd
 func (_mock *MockCmd) SetSysProcAttr(attr *syscall.SysProcAttr) {
 	_mock.Called(attr)
@@ -286,3 +342,49 @@ func (_c *MockCmd_StdoutPipe_Call) RunAndReturn(run func() (io.Reader, error)) *
 	_c.Call.Return(run)
 	return _c
 }
+
+// Unwrap provides a mock function for the type MockCmd
+func (_mock *MockCmd) Unwrap() *exec.Cmd {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unwrap")
+	}
+
+	var r0 *exec.Cmd
+	if returnFunc, ok := ret.Get(0).(func() *exec.Cmd); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*exec.Cmd)
+		}
+	}
+	return r0
+}
+
+// MockCmd_Unwrap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unwrap'
+type MockCmd_Unwrap_Call struct {
+	*mock.Call
+}
+
+// Unwrap is a helper method to define mock.On call
+func (_e *MockCmd_Expecter) Unwrap() *MockCmd_Unwrap_Call {
+	return &MockCmd_Unwrap_Call{Call: _e.mock.On("Unwrap")}
+}
+
+func (_c *MockCmd_Unwrap_Call) Run(run func()) *MockCmd_Unwrap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockCmd_Unwrap_Call) Return(cmd *exec.Cmd) *MockCmd_Unwrap_Call {
+	_c.Call.Return(cmd)
+	return _c
+}
+
+func (_c *MockCmd_Unwrap_Call) RunAndReturn(run func() *exec.Cmd) *MockCmd_Unwrap_Call {
+	_c.Call.Return(run)
+	return _c
+}